@@ -212,6 +212,18 @@ type ExchangeSim interface {
 	RunEventLoopOnce() (err error) // Run sim match for backtest only
 }
 
+// FundingExchangeSim 支持资金费率结算的模拟交易所
+type FundingExchangeSim interface {
+	// 累计资金费用盈亏
+	GetFundingPnl() float64
+}
+
+// FeeExchangeSim 可读取手续费率的模拟交易所
+type FeeExchangeSim interface {
+	// Maker/Taker 费率
+	GetFeeRates() (makerFeeRate float64, takerFeeRate float64)
+}
+
 // ExchangeLogger 交易所撮合日志
 type ExchangeLogger interface {
 	// Debug Using：log.Debug("test")
@@ -272,6 +284,12 @@ type Exchange interface {
 	// 获取当前设置的合约ID
 	GetContractID() (symbol string, err error)
 
+	// 获取所有交易标的规格
+	GetInstruments() (result []*Instrument, err error)
+
+	// 获取交易标的规格
+	GetInstrument(symbol string) (result *Instrument, err error)
+
 	// 设置杠杆大小
 	SetLeverRate(value float64) (err error)
 
@@ -0,0 +1,177 @@
+// CREX 回测报告图表，使用 ECharts 绘制
+// 单个策略: CrexChart.render(element, data)
+// data: {times: [ms], open/high/low/close: [], equity: [], drawdown: [], balances: [[]], fills: [{time, direction, price, amount, exchange}]}
+// 多个策略对比: CrexChart.compare(element, data)
+// data: {times: [ms], benchmark: [], series: [{name, returns: [], drawdown: []}]}
+(function (global) {
+    'use strict';
+
+    var COLORS = ['#5470c6', '#ee6666', '#91cc75', '#fac858', '#73c0de', '#3ba272', '#fc8452', '#9a60b4'];
+    var UP = '#26a69a';
+    var DOWN = '#ef5350';
+    var PANEL_HEIGHT = 160;
+    var PRICE_HEIGHT = 320;
+    var GAP = 40;
+    var TOP = 40;
+    var BOTTOM = 70;
+
+    function pad(n) {
+        return n < 10 ? '0' + n : '' + n;
+    }
+
+    function formatTime(ms) {
+        var d = new Date(ms);
+        return d.getFullYear() + '-' + pad(d.getMonth() + 1) + '-' + pad(d.getDate()) + ' ' +
+            pad(d.getHours()) + ':' + pad(d.getMinutes());
+    }
+
+    function percent(values) {
+        return (values || []).map(function (v) {
+            return parseFloat((v * 100).toFixed(4));
+        });
+    }
+
+    // 最后一个 times[i] <= t 的序号
+    function searchIndex(times, t) {
+        var lo = 0, hi = times.length - 1, result = 0;
+        while (lo <= hi) {
+            var mid = (lo + hi) >> 1;
+            if (times[mid] <= t) {
+                result = mid;
+                lo = mid + 1;
+            } else {
+                hi = mid - 1;
+            }
+        }
+        return result;
+    }
+
+    // 上下排列的多个面板，共用时间轴和缩放
+    function layout(element, categories, heights, names, percents) {
+        var option = {
+            animation: false,
+            legend: {top: 0, type: 'scroll'},
+            tooltip: {trigger: 'axis', axisPointer: {type: 'cross'}},
+            axisPointer: {link: [{xAxisIndex: 'all'}]},
+            grid: [],
+            xAxis: [],
+            yAxis: [],
+            dataZoom: [],
+            series: []
+        };
+        var top = TOP;
+        var indexes = [];
+        heights.forEach(function (height, i) {
+            var last = i === heights.length - 1;
+            option.grid.push({left: 90, right: 40, top: top, height: height});
+            option.xAxis.push({
+                type: 'category', gridIndex: i, data: categories, boundaryGap: true,
+                axisLabel: {show: last}, axisTick: {show: last}
+            });
+            option.yAxis.push({
+                type: 'value', gridIndex: i, scale: true, name: names[i],
+                axisLabel: {formatter: percents[i] ? '{value}%' : '{value}'}
+            });
+            indexes.push(i);
+            top += height + GAP;
+        });
+        option.dataZoom.push({type: 'inside', xAxisIndex: indexes});
+        option.dataZoom.push({type: 'slider', xAxisIndex: indexes, bottom: 10});
+        element.style.height = (top - GAP + BOTTOM) + 'px';
+        return option;
+    }
+
+    function draw(element, option) {
+        var chart = global.echarts.init(element);
+        chart.setOption(option);
+        global.addEventListener('resize', function () {
+            chart.resize();
+        });
+        return chart;
+    }
+
+    function render(element, data) {
+        if (!data || !data.times || data.times.length === 0) {
+            return;
+        }
+        var categories = data.times.map(formatTime);
+        var option = layout(element, categories,
+            [PRICE_HEIGHT, PANEL_HEIGHT, PANEL_HEIGHT, PANEL_HEIGHT],
+            ['Price', 'Equity', 'Drawdown', 'Balance'],
+            [false, false, true, false]);
+
+        option.series.push({
+            name: 'Price', type: 'candlestick', xAxisIndex: 0, yAxisIndex: 0,
+            data: data.times.map(function (t, i) {
+                return [data.open[i], data.close[i], data.low[i], data.high[i]];
+            }),
+            itemStyle: {color: UP, color0: DOWN, borderColor: UP, borderColor0: DOWN}
+        });
+        ['buy', 'sell'].forEach(function (direction) {
+            var buy = direction === 'buy';
+            option.series.push({
+                name: buy ? 'Buy' : 'Sell', type: 'scatter', xAxisIndex: 0, yAxisIndex: 0,
+                symbol: 'triangle', symbolSize: 9, symbolRotate: buy ? 0 : 180,
+                itemStyle: {color: buy ? UP : DOWN},
+                tooltip: {trigger: 'item'},
+                data: (data.fills || []).filter(function (f) {
+                    return f.direction === direction;
+                }).map(function (f) {
+                    return {
+                        value: [searchIndex(data.times, f.time), f.price],
+                        name: formatTime(f.time) + ' #' + f.exchange + ' ' + direction + ' ' + f.amount + ' @ ' + f.price
+                    };
+                })
+            });
+        });
+        option.series.push({
+            name: 'Equity', type: 'line', xAxisIndex: 1, yAxisIndex: 1, showSymbol: false,
+            data: data.equity
+        });
+        option.series.push({
+            name: 'Drawdown', type: 'line', xAxisIndex: 2, yAxisIndex: 2, showSymbol: false,
+            areaStyle: {opacity: 0.3}, itemStyle: {color: DOWN},
+            data: percent(data.drawdown)
+        });
+        (data.balances || []).forEach(function (balances, i) {
+            option.series.push({
+                name: 'Balance #' + i, type: 'line', xAxisIndex: 3, yAxisIndex: 3, showSymbol: false,
+                data: balances
+            });
+        });
+        return draw(element, option);
+    }
+
+    function compare(element, data) {
+        if (!data || !data.times || data.times.length === 0) {
+            return;
+        }
+        var categories = data.times.map(formatTime);
+        var option = layout(element, categories, [PRICE_HEIGHT, PANEL_HEIGHT],
+            ['Return', 'Drawdown'], [true, true]);
+
+        option.series.push({
+            name: 'Buy & Hold', type: 'line', xAxisIndex: 0, yAxisIndex: 0, showSymbol: false,
+            lineStyle: {type: 'dashed'}, itemStyle: {color: '#999999'},
+            data: percent(data.benchmark)
+        });
+        // 同一策略的收益率和回撤使用相同颜色，图例同时控制两者
+        (data.series || []).forEach(function (series, i) {
+            var itemStyle = {color: COLORS[i % COLORS.length]};
+            option.series.push({
+                name: series.name, type: 'line', xAxisIndex: 0, yAxisIndex: 0, showSymbol: false,
+                itemStyle: itemStyle, data: percent(series.returns)
+            });
+            option.series.push({
+                name: series.name, type: 'line', xAxisIndex: 1, yAxisIndex: 1, showSymbol: false,
+                itemStyle: itemStyle, data: percent(series.drawdown)
+            });
+        });
+        return draw(element, option);
+    }
+
+    global.CrexChart = {
+        render: render,
+        compare: compare
+    };
+})(window);
@@ -1,4 +1,4 @@
-//go:generate statik -f -src=./ -include=*.html
+//go:generate statik -f -src=./ -include=*.html,*.js
 package backtest
 
 import (
@@ -15,6 +15,7 @@ import (
 	slog "log"
 	"os"
 	"path/filepath"
+	"runtime"
 	"time"
 )
 
@@ -30,6 +31,10 @@ const (
 
 var (
 	reportHistoryTemplate string
+	reportTemplate        string
+	reportChartJs         string
+	echartsJs             string // 内嵌的 echarts.min.js
+	compareTemplate       string
 )
 
 var json = jsoniter.ConfigCompatibleWithStandardLibrary
@@ -39,15 +44,23 @@ func init() {
 	if err != nil {
 		slog.Fatal(err)
 	}
-	f, err := statikFS.Open("/ReportHistoryTemplate.html")
-	if err != nil {
-		slog.Fatal(err)
-	}
-	d, err := ioutil.ReadAll(f)
-	if err != nil {
-		slog.Fatal(err)
+	readFile := func(name string) string {
+		f, err := statikFS.Open(name)
+		if err != nil {
+			slog.Fatal(err)
+		}
+		defer f.Close()
+		d, err := ioutil.ReadAll(f)
+		if err != nil {
+			slog.Fatal(err)
+		}
+		return string(d)
 	}
-	reportHistoryTemplate = string(d)
+	reportHistoryTemplate = readFile("/ReportHistoryTemplate.html")
+	reportTemplate = readFile("/ReportTemplate.html")
+	reportChartJs = readFile("/ReportChart.js")
+	echartsJs = readFile("/echarts.min.js")
+	compareTemplate = readFile("/CompareTemplate.html")
 }
 
 type PlotData struct {
@@ -76,6 +89,8 @@ type Backtest struct {
 
 	startedAt time.Time // 运行开始时间
 	endedAt   time.Time // 运行结束时间
+
+	parallel int // 并行执行策略的协程数，0 为顺序执行
 }
 
 // NewBacktest 创建回测
@@ -153,6 +168,16 @@ func (b *Backtest) SetDatas(datas []*dataloader.Data) {
 	b.datas = datas
 }
 
+// SetParallel 多个策略时并行执行，workers 为协程数，<0 时使用 CPU 核数，0 为顺序执行
+// 每个策略内部仍按 OnTick -> RunEventLoopOnce -> addItemStats 的顺序执行
+// 策略应使用 StrategyBase.Logger() 记录日志
+func (b *Backtest) SetParallel(workers int) {
+	if workers < 0 {
+		workers = runtime.NumCPU()
+	}
+	b.parallel = workers
+}
+
 // GetTime get current time
 func (b *Backtest) GetTime() time.Time {
 	return time.Unix(0, b.currentTimeNS)
@@ -204,16 +229,28 @@ func (b *Backtest) Run() {
 		strategyTester.OnInit()
 	}
 
+	var runner *stepRunner
+	if b.parallel > 1 && len(b.strategyTesters) > 1 {
+		runner = newStepRunner(b.parallel)
+		defer runner.close()
+	}
+
 	for {
-		for _, strategyTester := range b.strategyTesters {
-			strategyTester.OnTick()
-		}
-		for _, strategyTester := range b.strategyTesters {
-			strategyTester.RunEventLoopOnce()
+		if runner != nil {
+			runner.run(b.strategyTesters)
+		} else {
+			for _, strategyTester := range b.strategyTesters {
+				strategyTester.OnTick()
+			}
+			for _, strategyTester := range b.strategyTesters {
+				strategyTester.RunEventLoopOnce()
+			}
+			for _, strategyTester := range b.strategyTesters {
+				strategyTester.addItemStats()
+			}
 		}
 		var stopped bool
 		for _, strategyTester := range b.strategyTesters {
-			strategyTester.addItemStats()
 			if strategyTester.strategy.IsStopped() {
 				stopped = true
 			}
@@ -260,7 +297,7 @@ func (b *Backtest) next() bool {
 		for i := 0; i < n; i++ {
 			data := b.datas[i]
 			for {
-				if data.GetOrderBook().Time.UnixNano() >= b.currentTimeNS {
+				if data.GetTime().UnixNano() >= b.currentTimeNS {
 					break
 				}
 				if !data.Next() {
@@ -298,7 +335,7 @@ func (b *Backtest) ensureMoveNext(ns int64) bool {
 	for i := 0; i < n; i++ {
 		data := b.datas[i]
 		for {
-			if data.GetOrderBook().Time.UnixNano() >= ns {
+			if data.GetTime().UnixNano() >= ns {
 				break
 			}
 			if !data.Next() {
@@ -323,7 +360,7 @@ func (b *Backtest) resetSortedDatas() {
 	for i := 0; i < nDatas; i++ {
 		index := i * 2
 		b.timeNsDatas[index] = b.datas[i].GetOrderBookRaw(1).Time.UnixNano()
-		b.timeNsDatas[index+1] = b.datas[i].GetOrderBook().Time.UnixNano()
+		b.timeNsDatas[index+1] = b.datas[i].GetTime().UnixNano()
 	}
 
 	utils.SortInt64(b.timeNsDatas)
@@ -332,7 +369,7 @@ func (b *Backtest) resetSortedDatas() {
 func (b *Backtest) nextOne() bool {
 	ret := b.datas[0].Next()
 	if ret {
-		b.currentTimeNS = b.datas[0].GetOrderBook().Time.UnixNano()
+		b.currentTimeNS = b.datas[0].GetTime().UnixNano()
 	}
 	return ret
 }
@@ -362,6 +399,18 @@ func (b *Backtest) ComputeStatsByIndex(index int) (result *Stats) {
 	return b.strategyTesters[index].ComputeStats()
 }
 
+// TradeLedger 开平仓记录
+func (b *Backtest) TradeLedger() (RoundTrips, error) {
+	return b.TradeLedgerByIndex(0)
+}
+
+func (b *Backtest) TradeLedgerByIndex(index int) (RoundTrips, error) {
+	if index >= len(b.strategyTesters) {
+		return nil, nil
+	}
+	return b.strategyTesters[index].TradeLedger()
+}
+
 // HTMLReport 创建Html报告文件
 func (b *Backtest) HtmlReport() {
 	b.strategyTesters[0].HtmlReport()
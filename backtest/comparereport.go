@@ -0,0 +1,237 @@
+package backtest
+
+import (
+	"fmt"
+	. "github.com/coinrust/crex"
+	"github.com/coinrust/crex/log"
+	"html"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// 对比报告图表数据，格式见 ReportChart.js
+type compareData struct {
+	Times     []int64         `json:"times"`     // ms
+	Benchmark []float64       `json:"benchmark"` // 买入持有收益率
+	Series    []compareSeries `json:"series"`
+}
+
+type compareSeries struct {
+	Name     string    `json:"name"`
+	Returns  []float64 `json:"returns"`  // 净值收益率
+	DrawDown []float64 `json:"drawdown"` // 周期内最大回撤(<=0)
+}
+
+// 单个策略的对比结果
+type compareResult struct {
+	Rank    int
+	Index   int // strategyTesters 中的索引
+	Name    string
+	Options [][2]string
+	Metric  float64
+	Stats   *Stats
+}
+
+// CompareReport 创建多策略对比报告 compare.html，按收益率排序
+// 叠加所有策略的收益率曲线和买入持有基准，并列出各策略的参数和统计结果
+func (b *Backtest) CompareReport() {
+	filename := filepath.Join(b.outputDir, "compare.html")
+	if err := b.WriteCompareReport(filename, "equity_return_pnt", false); err != nil {
+		log.Error(err)
+	}
+}
+
+// WriteCompareReport 创建多策略对比报告文件
+// metric: 排序指标，Stats 的 json 字段名，如 equity_return_pnt、sharpe_ratio
+// lowerIsBetter: 指标越小越好，如 max_draw_down
+func (b *Backtest) WriteCompareReport(filename string, metric string, lowerIsBetter bool) (err error) {
+	if _, ok := statsMetric(&Stats{}, metric); !ok {
+		err = ErrInvalidMetric
+		return
+	}
+
+	results := b.compareResults(metric, lowerIsBetter)
+	var data []byte
+	data, err = json.Marshal(b.buildCompareData(results))
+	if err != nil {
+		return
+	}
+
+	title := "Backtest Comparison"
+	s := strings.ReplaceAll(compareTemplate, "<!--{Title}-->", title)
+	s = strings.ReplaceAll(s, "<!--{Symbol}-->", html.EscapeString(b.symbol))
+	s = strings.ReplaceAll(s, "<!--{Period}-->", fmt.Sprintf("%v - %v", b.start.String(), b.end.String()))
+	s = strings.ReplaceAll(s, "<!--{Metric}-->", html.EscapeString(metric))
+	s = strings.ReplaceAll(s, "<!--{ranking-rows}-->", rankingRows(results))
+	s = strings.ReplaceAll(s, "/*{compare-data}*/null", string(data))
+	s = strings.ReplaceAll(s, "<!--{chart-js}-->", chartScripts())
+
+	err = ioutil.WriteFile(filename, []byte(s), os.ModePerm)
+	return
+}
+
+// 计算各策略的统计结果并按指标排序
+func (b *Backtest) compareResults(metric string, lowerIsBetter bool) (results []*compareResult) {
+	for i, v := range b.strategyTesters {
+		stats := v.ComputeStats()
+		value, _ := statsMetric(stats, metric)
+		results = append(results, &compareResult{
+			Index:   i,
+			Name:    v.strategyName(),
+			Options: v.optionRows(),
+			Metric:  value,
+			Stats:   stats,
+		})
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		if lowerIsBetter {
+			return results[i].Metric < results[j].Metric
+		}
+		return results[i].Metric > results[j].Metric
+	})
+	for i, v := range results {
+		v.Rank = i + 1
+	}
+	return
+}
+
+// 按第一个策略的时间合并为相同周期，缺少数据的周期沿用上一周期的值
+func (b *Backtest) buildCompareData(results []*compareResult) *compareData {
+	data := &compareData{}
+	if len(b.strategyTesters) == 0 {
+		return data
+	}
+	logs := b.strategyTesters[0].logs
+	if len(logs) == 0 {
+		return data
+	}
+
+	period := reportPeriod(logs[len(logs)-1].Time.Sub(logs[0].Time))
+	indexes := map[int64]int{}
+	entryPrice := logs[0].Prices[0]
+	for _, item := range logs {
+		tm := unixMilli(item.Time.Truncate(period))
+		if _, ok := indexes[tm]; !ok {
+			indexes[tm] = len(data.Times)
+			data.Times = append(data.Times, tm)
+			data.Benchmark = append(data.Benchmark, 0)
+		}
+		if entryPrice > 0 {
+			data.Benchmark[indexes[tm]] = item.Prices[0]/entryPrice - 1
+		}
+	}
+
+	for _, v := range results {
+		t := b.strategyTesters[v.Index]
+		series := compareSeries{
+			Name:     fmt.Sprintf("#%v %v", v.Index, v.Name),
+			Returns:  make([]float64, len(data.Times)),
+			DrawDown: make([]float64, len(data.Times)),
+		}
+		seen := make([]bool, len(data.Times))
+		var entryEquity, peak float64
+		for i, item := range t.logs {
+			equity := item.TotalEquity()
+			if i == 0 {
+				entryEquity = equity
+			}
+			peak = math.Max(peak, equity)
+			n, ok := indexes[unixMilli(item.Time.Truncate(period))]
+			if !ok {
+				continue
+			}
+			seen[n] = true
+			if entryEquity > 0 {
+				series.Returns[n] = equity/entryEquity - 1
+			}
+			if peak > 0 {
+				series.DrawDown[n] = math.Min(series.DrawDown[n], equity/peak-1)
+			}
+		}
+		for n := 1; n < len(seen); n++ {
+			if !seen[n] {
+				series.Returns[n] = series.Returns[n-1]
+				series.DrawDown[n] = series.DrawDown[n-1]
+			}
+		}
+		data.Series = append(data.Series, series)
+	}
+	return data
+}
+
+// 策略名称，未设置时为类型名
+func (t *StrategyTester) strategyName() string {
+	if t.strategy == nil {
+		return ""
+	}
+	if name := t.strategy.Name(); name != "" {
+		return name
+	}
+	typ := reflect.TypeOf(t.strategy)
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return typ.Name()
+}
+
+func rankingRows(results []*compareResult) string {
+	var sb strings.Builder
+	percent := func(v float64) string {
+		return fmt.Sprintf("%.4f", v*100)
+	}
+	for _, v := range results {
+		var options []string
+		for _, option := range v.Options {
+			options = append(options, option[0]+"="+option[1])
+		}
+		stats := v.Stats
+		sb.WriteString("<tr>")
+		sb.WriteString(fmt.Sprintf("<td>%v</td><td>%v</td>", v.Rank, v.Index))
+		sb.WriteString(fmt.Sprintf(`<td class="text">%v</td><td class="text">%v</td>`,
+			html.EscapeString(v.Name), html.EscapeString(strings.Join(options, ", "))))
+		for _, value := range []string{
+			fmt.Sprintf("%.8g", v.Metric),
+			percent(stats.EquityReturnPnt),
+			percent(stats.AnnReturn),
+			percent(stats.MaxDrawDown),
+			fmt.Sprintf("%.4f", stats.SharpeRatio),
+			fmt.Sprintf("%.4f", stats.SortinoRatio),
+			fmt.Sprintf("%.4f", stats.CalmarRatio),
+			fmt.Sprint(stats.NumTrades),
+			percent(stats.WinRate),
+			formatProfitFactor(stats.ProfitFactor),
+			fmt.Sprintf("%.8f", stats.Commission),
+			fmt.Sprintf("%.8f", stats.ExitEquity),
+		} {
+			sb.WriteString("<td>" + value + "</td>")
+		}
+		sb.WriteString("</tr>")
+	}
+	if len(results) > 0 {
+		// 买入持有基准，使用第一个策略的价格
+		var stats *Stats
+		for _, v := range results {
+			if v.Index == 0 {
+				stats = v.Stats
+			}
+		}
+		sb.WriteString(`<tr class="benchmark"><td>-</td><td>-</td><td class="text">Buy &amp; Hold</td><td class="text"></td><td>-</td>`)
+		sb.WriteString("<td>" + percent(stats.BaHReturnPnt) + "</td>")
+		sb.WriteString(strings.Repeat("<td>-</td>", 10))
+		sb.WriteString("</tr>")
+	}
+	return sb.String()
+}
+
+// 盈利因子，无亏损时为 ∞
+func formatProfitFactor(v float64) string {
+	if math.IsInf(v, 1) {
+		return "∞"
+	}
+	return fmt.Sprintf("%.4f", v)
+}
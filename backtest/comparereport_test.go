@@ -0,0 +1,98 @@
+package backtest
+
+import (
+	. "github.com/coinrust/crex"
+	"github.com/coinrust/crex/dataloader"
+	"github.com/coinrust/crex/exchanges/exsim"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBacktest_CompareReport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "compare")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	data := testData(start)
+	var paramsList []*StrategyTesterParams
+	for _, long := range []bool{false, true} {
+		strategy := &holdStrategy{Size: 10, Long: long}
+		ex := exsim.NewExSim(data, 1.0, 0, 0.00075, 1.0, false, false)
+		paramsList = append(paramsList, NewStrategyTesterParams(strategy, []ExchangeSim{ex}))
+	}
+	b := NewBacktestFromParams([]*dataloader.Data{data}, "BTC", start, start.Add(24*time.Hour), paramsList, dir)
+	b.Run()
+	b.CompareReport()
+
+	report, err := ioutil.ReadFile(filepath.Join(b.outputDir, "compare.html"))
+	if !assert.Nil(t, err) {
+		return
+	}
+	html := string(report)
+	assert.True(t, strings.Contains(html, "<td class=\"text\">holdStrategy</td><td class=\"text\">Long=false, Size=10</td>"))
+	assert.True(t, strings.Contains(html, "Buy &amp; Hold"))
+	assert.False(t, strings.Contains(html, "<!--{"))
+	assertChartScripts(t, html)
+
+	prefix := `CrexChart.compare(document.getElementById("chart"), `
+	i := strings.Index(html, prefix) + len(prefix)
+	j := strings.Index(html[i:], ");")
+	var cd compareData
+	if !assert.Nil(t, json.Unmarshal([]byte(html[i:i+j]), &cd)) || !assert.Equal(t, 2, len(cd.Series)) {
+		return
+	}
+	assert.Equal(t, len(cd.Times), len(cd.Benchmark))
+	stats0 := b.ComputeStatsByIndex(0)
+	stats1 := b.ComputeStatsByIndex(1)
+	assert.InDelta(t, stats0.BaHReturnPnt, cd.Benchmark[len(cd.Benchmark)-1], 1e-9)
+	// 按收益率排序
+	first, second := "#0 holdStrategy", "#1 holdStrategy"
+	if stats1.EquityReturnPnt > stats0.EquityReturnPnt {
+		first, second = second, first
+	}
+	assert.Equal(t, first, cd.Series[0].Name)
+	assert.Equal(t, second, cd.Series[1].Name)
+	for _, v := range cd.Series {
+		assert.Equal(t, len(cd.Times), len(v.Returns))
+		assert.Equal(t, len(cd.Times), len(v.DrawDown))
+	}
+
+	assert.Equal(t, ErrInvalidMetric, b.WriteCompareReport(filepath.Join(dir, "x.html"), "start", false))
+	assert.Nil(t, b.WriteCompareReport(filepath.Join(dir, "dd.html"), "max_draw_down", true))
+}
+
+func TestBacktest_buildCompareData(t *testing.T) {
+	start := time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC)
+	b := &Backtest{}
+	for i := 0; i < 2; i++ {
+		b.strategyTesters = append(b.strategyTesters, &StrategyTester{backtest: b})
+	}
+	b.strategyTesters[0].logs = equityLogs(start, time.Minute, 100, 110, 99)
+	for i, price := range []float64{10, 11, 12} {
+		b.strategyTesters[0].logs[i].Prices = []float64{price}
+	}
+	// 缺少第二分钟的数据
+	logs := equityLogs(start, 2*time.Minute, 50, 40)
+	b.strategyTesters[1].logs = logs
+
+	data := b.buildCompareData([]*compareResult{{Index: 1, Name: "b"}, {Index: 0, Name: "a"}})
+	assert.Equal(t, []int64{unixMilli(start), unixMilli(start.Add(time.Minute)), unixMilli(start.Add(2 * time.Minute))}, data.Times)
+	assert.InDeltaSlice(t, []float64{0, 0.1, 0.2}, data.Benchmark, 1e-9)
+	if !assert.Equal(t, 2, len(data.Series)) {
+		return
+	}
+	assert.Equal(t, "#1 b", data.Series[0].Name)
+	assert.InDeltaSlice(t, []float64{0, 0, -0.2}, data.Series[0].Returns, 1e-9)
+	assert.InDeltaSlice(t, []float64{0, 0, -0.2}, data.Series[0].DrawDown, 1e-9)
+	assert.InDeltaSlice(t, []float64{0, 0.1, -0.01}, data.Series[1].Returns, 1e-9)
+	assert.InDeltaSlice(t, []float64{0, 0, -0.1}, data.Series[1].DrawDown, 1e-9)
+}
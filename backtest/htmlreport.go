@@ -0,0 +1,238 @@
+package backtest
+
+import (
+	"fmt"
+	. "github.com/coinrust/crex"
+	"github.com/coinrust/crex/log"
+	"html"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 报告K线周期，选择K线数不超过 maxReportBars 的最小周期
+var reportPeriods = []time.Duration{
+	time.Minute,
+	5 * time.Minute,
+	15 * time.Minute,
+	30 * time.Minute,
+	time.Hour,
+	4 * time.Hour,
+	24 * time.Hour,
+	7 * 24 * time.Hour,
+}
+
+const maxReportBars = 1000
+
+// 报告图表数据，格式见 ReportChart.js
+type reportData struct {
+	Times    []int64      `json:"times"` // ms
+	Open     []float64    `json:"open"`
+	High     []float64    `json:"high"`
+	Low      []float64    `json:"low"`
+	Close    []float64    `json:"close"`
+	Equity   []float64    `json:"equity"`
+	DrawDown []float64    `json:"drawdown"` // 周期内最大回撤(<=0)
+	Balances [][]float64  `json:"balances"` // 各交易所余额
+	Fills    []reportFill `json:"fills"`
+}
+
+type reportFill struct {
+	Time      int64   `json:"time"` // ms
+	Exchange  int     `json:"exchange"`
+	Direction string  `json:"direction"` // buy/sell
+	Price     float64 `json:"price"`
+	Amount    float64 `json:"amount"`
+}
+
+func reportPeriod(d time.Duration) time.Duration {
+	for _, v := range reportPeriods {
+		if d/v <= maxReportBars {
+			return v
+		}
+	}
+	return reportPeriods[len(reportPeriods)-1]
+}
+
+func unixMilli(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+// Report 创建回测报告 report.html，多个策略时为 report_N.html
+// 包含K线及成交标记、净值、回撤、各交易所余额、策略参数、统计结果和开平仓记录
+// 图表使用内嵌的 ECharts，无需网络即可打开
+func (b *Backtest) Report() {
+	for _, v := range b.strategyTesters {
+		if err := v.Report(); err != nil {
+			log.Error(err)
+		}
+	}
+}
+
+// Report 创建回测报告，见 Backtest.Report
+func (t *StrategyTester) Report() error {
+	name := "report.html"
+	if len(t.backtest.strategyTesters) > 1 {
+		name = fmt.Sprintf("report_%v.html", t.index())
+	}
+	return t.WriteReport(filepath.Join(t.backtest.outputDir, name))
+}
+
+// WriteReport 创建回测报告文件
+func (t *StrategyTester) WriteReport(filename string) (err error) {
+	var roundTrips RoundTrips
+	var fills []*Fill
+	roundTrips, fills = t.readTradeHistory()
+
+	var data []byte
+	data, err = json.Marshal(t.buildReportData(fills))
+	if err != nil {
+		return
+	}
+
+	b := t.backtest
+	title := "Backtest Report"
+	if t.strategy != nil && t.strategy.Name() != "" {
+		title += " - " + t.strategy.Name()
+	}
+	s := strings.ReplaceAll(reportTemplate, "<!--{Title}-->", html.EscapeString(title))
+	s = strings.ReplaceAll(s, "<!--{Symbol}-->", html.EscapeString(b.symbol))
+	s = strings.ReplaceAll(s, "<!--{Period}-->", fmt.Sprintf("%v - %v", b.start.String(), b.end.String()))
+	s = strings.ReplaceAll(s, "<!--{option-rows}-->", keyValueRows(t.optionRows()))
+	s = strings.ReplaceAll(s, "<!--{stats-rows}-->", keyValueRows(statsRows(t.ComputeStats())))
+	s = strings.ReplaceAll(s, "<!--{round-trip-rows}-->", t.buildRoundTrips(roundTrips))
+	s = strings.ReplaceAll(s, "/*{report-data}*/null", string(data))
+	s = strings.ReplaceAll(s, "<!--{chart-js}-->", chartScripts())
+
+	err = ioutil.WriteFile(filename, []byte(s), os.ModePerm)
+	return
+}
+
+// 报告图表脚本，ECharts 和绘图脚本都内嵌在页面中
+func chartScripts() string {
+	var sb strings.Builder
+	sb.WriteString("<script type=\"text/javascript\">\n" + echartsJs + "\n</script>\n")
+	sb.WriteString("<script type=\"text/javascript\">\n" + reportChartJs + "\n</script>")
+	return sb.String()
+}
+
+// 按周期合并 LogItem 为K线，同时记录周期末的净值和余额
+func (t *StrategyTester) buildReportData(fills []*Fill) *reportData {
+	data := &reportData{}
+	logs := t.logs
+	for _, v := range fills {
+		data.Fills = append(data.Fills, reportFill{
+			Time:      unixMilli(v.Time),
+			Exchange:  v.Exchange,
+			Direction: strings.ToLower(v.Direction.String()),
+			Price:     v.Price,
+			Amount:    v.Amount,
+		})
+	}
+	if len(logs) == 0 {
+		return data
+	}
+
+	period := reportPeriod(logs[len(logs)-1].Time.Sub(logs[0].Time))
+	data.Balances = make([][]float64, len(logs[0].Stats))
+	var peak float64
+	var barTime time.Time
+	for i, item := range logs {
+		price := item.Prices[0]
+		equity := item.TotalEquity()
+		peak = math.Max(peak, equity)
+		var drawDown float64
+		if peak > 0 {
+			drawDown = equity/peak - 1
+		}
+
+		tm := item.Time.Truncate(period)
+		if i == 0 || !tm.Equal(barTime) {
+			barTime = tm
+			data.Times = append(data.Times, unixMilli(tm))
+			data.Open = append(data.Open, price)
+			data.High = append(data.High, price)
+			data.Low = append(data.Low, price)
+			data.Close = append(data.Close, price)
+			data.Equity = append(data.Equity, equity)
+			data.DrawDown = append(data.DrawDown, drawDown)
+			for j := range data.Balances {
+				var balance float64
+				if j < len(item.Stats) {
+					balance = item.Stats[j].Balance
+				}
+				data.Balances[j] = append(data.Balances[j], balance)
+			}
+			continue
+		}
+
+		n := len(data.Times) - 1
+		data.High[n] = math.Max(data.High[n], price)
+		data.Low[n] = math.Min(data.Low[n], price)
+		data.Close[n] = price
+		data.Equity[n] = equity
+		data.DrawDown[n] = math.Min(data.DrawDown[n], drawDown)
+		for j := range data.Balances {
+			if j < len(item.Stats) {
+				data.Balances[j][n] = item.Stats[j].Balance
+			}
+		}
+	}
+	return data
+}
+
+// 策略参数，只读取不修改策略
+func (t *StrategyTester) strategyOptions() map[string]*StrategyOption {
+	if t.strategy == nil {
+		return nil
+	}
+	return GetStrategyOptions(t.strategy)
+}
+
+// 策略参数，按参数名排序
+func (t *StrategyTester) optionRows() (result [][2]string) {
+	options := t.strategyOptions()
+	var names []string
+	for name := range options {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		result = append(result, [2]string{name, fmt.Sprint(options[name].Value)})
+	}
+	return
+}
+
+// Stats 的所有字段
+func statsRows(stats *Stats) (result [][2]string) {
+	v := reflect.ValueOf(stats).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		var value string
+		switch x := v.Field(i).Interface().(type) {
+		case time.Time:
+			value = x.Format(SimpleDateTimeFormat)
+		case time.Duration:
+			value = x.String()
+		case float64:
+			value = strconv.FormatFloat(x, 'g', 10, 64)
+		default:
+			value = fmt.Sprint(x)
+		}
+		result = append(result, [2]string{v.Type().Field(i).Name, value})
+	}
+	return
+}
+
+func keyValueRows(rows [][2]string) string {
+	var sb strings.Builder
+	for _, v := range rows {
+		sb.WriteString(fmt.Sprintf("<tr><td>%v</td><td>%v</td></tr>", html.EscapeString(v[0]), html.EscapeString(v[1])))
+	}
+	return sb.String()
+}
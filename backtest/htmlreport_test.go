@@ -0,0 +1,109 @@
+package backtest
+
+import (
+	. "github.com/coinrust/crex"
+	"github.com/coinrust/crex/dataloader"
+	"github.com/coinrust/crex/exchanges/exsim"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReportPeriod(t *testing.T) {
+	assert.Equal(t, time.Minute, reportPeriod(10*time.Hour))
+	assert.Equal(t, 5*time.Minute, reportPeriod(48*time.Hour))
+	assert.Equal(t, 4*time.Hour, reportPeriod(100*24*time.Hour))
+	assert.Equal(t, 7*24*time.Hour, reportPeriod(100*365*24*time.Hour))
+}
+
+func TestStrategyTester_buildReportData(t *testing.T) {
+	start := time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC)
+	st := StrategyTester{}
+	st.logs = equityLogs(start, time.Minute, 100, 90, 95, 110, 105)
+	for i, price := range []float64{10, 12, 9, 11, 13} {
+		st.logs[i].Prices = []float64{price}
+		st.logs[i].Stats = append(st.logs[i].Stats, LogStats{Balance: float64(i)})
+	}
+	// 2000 分钟，5 分钟K线
+	st.logs = append(st.logs, equityLogs(start.Add(2000*time.Minute), time.Minute, 120)...)
+	st.logs[5].Stats = append(st.logs[5].Stats, LogStats{Balance: 5})
+
+	data := st.buildReportData([]*Fill{{Time: start.Add(time.Minute), Direction: Sell, Price: 12, Amount: 10}})
+	assert.Equal(t, []int64{unixMilli(start), unixMilli(start.Add(2000 * time.Minute))}, data.Times)
+	assert.Equal(t, []float64{10, 5000}, data.Open)
+	assert.Equal(t, []float64{13, 5000}, data.High)
+	assert.Equal(t, []float64{9, 5000}, data.Low)
+	assert.Equal(t, []float64{13, 5000}, data.Close)
+	assert.Equal(t, []float64{105, 120}, data.Equity)
+	assert.InDeltaSlice(t, []float64{-0.1, 0}, data.DrawDown, 1e-9)
+	assert.Equal(t, [][]float64{{105, 120}, {4, 5}}, data.Balances)
+	assert.Equal(t, []reportFill{{Time: unixMilli(start.Add(time.Minute)), Direction: "sell", Price: 12, Amount: 10}}, data.Fills)
+}
+
+func TestBacktest_Report(t *testing.T) {
+	dir, err := ioutil.TempDir("", "report")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	data := testData(start)
+	strategy := &holdStrategy{Size: 10, Long: true}
+	var exchanges []ExchangeSim
+	for i := 0; i < 2; i++ {
+		exchanges = append(exchanges, exsim.NewExSim(data, 1.0, 0, 0.00075, 1.0, false, false))
+	}
+	b := NewBacktest([]*dataloader.Data{data, data}, "BTC", start, start.Add(24*time.Hour), strategy, exchanges, dir)
+	b.Run()
+	b.Report()
+
+	report, err := ioutil.ReadFile(filepath.Join(b.outputDir, "report.html"))
+	if !assert.Nil(t, err) {
+		return
+	}
+	html := string(report)
+	assert.True(t, strings.Contains(html, "CrexChart.render(document.getElementById"))
+	assert.True(t, strings.Contains(html, "<tr><td>Size</td><td>10</td></tr>"))
+	assert.True(t, strings.Contains(html, "<tr><td>SharpeRatio</td>"))
+	assert.False(t, strings.Contains(html, "<!--{"))
+	assert.False(t, strings.Contains(html, "/*{report-data}*/"))
+	assert.False(t, strings.Contains(html, "href=\"http"))
+	assertChartScripts(t, html)
+
+	prefix := `CrexChart.render(document.getElementById("chart"), `
+	i := strings.Index(html, prefix) + len(prefix)
+	j := strings.Index(html[i:], ");")
+	var rd reportData
+	if !assert.Nil(t, json.Unmarshal([]byte(html[i:i+j]), &rd)) {
+		return
+	}
+	assert.Equal(t, 2, len(rd.Balances))
+	assert.Equal(t, len(rd.Times), len(rd.Close))
+	assert.True(t, len(rd.Times) > 100)
+	if assert.Equal(t, 1, len(rd.Fills)) {
+		assert.Equal(t, "buy", rd.Fills[0].Direction)
+		assert.Equal(t, 10.0, rd.Fills[0].Amount)
+	}
+}
+
+func TestStrategyTester_optionRows(t *testing.T) {
+	strategy := &holdStrategy{Size: 5}
+	st := &StrategyTester{StrategyTesterParams: NewStrategyTesterParams(strategy, nil)}
+	assert.Equal(t, [][2]string{{"Long", "false"}, {"Size", "5"}}, st.optionRows())
+	// 读取参数不调用 SetSelf
+	assert.Equal(t, 0, len(strategy.GetOptions()))
+}
+
+// ECharts 内嵌在页面中，不引用外部脚本
+func assertChartScripts(t *testing.T, html string) {
+	assert.True(t, strings.Contains(html, "global.echarts.init(element)"))
+	assert.True(t, strings.Contains(html, echartsJs))
+	assert.False(t, regexp.MustCompile(`<script[^>]*\ssrc=`).MatchString(html))
+}
@@ -0,0 +1,303 @@
+package backtest
+
+import (
+	"encoding/csv"
+	"fmt"
+	. "github.com/coinrust/crex"
+	"io"
+	"math"
+	"sort"
+	"time"
+)
+
+// RoundTrip 一次完整的开平仓交易，从持仓为 0 到再次为 0
+type RoundTrip struct {
+	Exchange    int           `json:"exchange"`     // 交易所序号
+	Symbol      string        `json:"symbol"`       // 交易对
+	Direction   Direction     `json:"direction"`    // Buy-多仓 Sell-空仓
+	EntryTime   time.Time     `json:"entry_time"`   // 开仓时间
+	ExitTime    time.Time     `json:"exit_time"`    // 平仓时间，未平仓时为空
+	EntryPrice  float64       `json:"entry_price"`  // 开仓均价
+	ExitPrice   float64       `json:"exit_price"`   // 平仓均价
+	Size        float64       `json:"size"`         // 最大持仓量
+	Volume      float64       `json:"volume"`       // 开平仓成交量
+	HoldingTime time.Duration `json:"holding_time"` // 持仓时间
+	MAE         float64       `json:"mae"`          // 最大不利变动，相对开仓均价的比例(<=0)
+	MFE         float64       `json:"mfe"`          // 最大有利变动，相对开仓均价的比例(>=0)
+	Pnl         float64       `json:"pnl"`          // 平仓盈亏
+	Commission  float64       `json:"commission"`   // 手续费
+	NetPnl      float64       `json:"net_pnl"`      // 扣除手续费后的盈亏
+	Closed      bool          `json:"closed"`       // 是否已平仓
+
+	entrySize float64 // 累计开仓量
+	exitSize  float64 // 累计平仓量
+}
+
+// Side 多仓: long 空仓: short
+func (r *RoundTrip) Side() string {
+	if r.Direction == Sell {
+		return "short"
+	}
+	return "long"
+}
+
+func (r *RoundTrip) open(size float64, price float64, commission float64) {
+	r.EntryPrice = (r.EntryPrice*r.entrySize + price*size) / (r.entrySize + size)
+	r.entrySize += size
+	r.Volume += size
+	r.Commission += commission
+	r.NetPnl = r.Pnl - r.Commission
+	r.updateExcursion(price)
+}
+
+func (r *RoundTrip) close(size float64, price float64, pnl float64, commission float64) {
+	r.ExitPrice = (r.ExitPrice*r.exitSize + price*size) / (r.exitSize + size)
+	r.exitSize += size
+	r.Volume += size
+	r.Pnl += pnl
+	r.Commission += commission
+	r.NetPnl = r.Pnl - r.Commission
+	r.updateExcursion(price)
+}
+
+func (r *RoundTrip) finish(tm time.Time) {
+	r.ExitTime = tm
+	r.HoldingTime = tm.Sub(r.EntryTime)
+	r.Closed = true
+}
+
+// 持仓期间的价格，更新 MAE/MFE
+func (r *RoundTrip) updateExcursion(price float64) {
+	if r.EntryPrice == 0 || price == 0 {
+		return
+	}
+	excursion := price/r.EntryPrice - 1
+	if r.Direction == Sell {
+		excursion = -excursion
+	}
+	r.MAE = math.Min(r.MAE, excursion)
+	r.MFE = math.Max(r.MFE, excursion)
+}
+
+// RoundTrips 开平仓记录
+type RoundTrips []*RoundTrip
+
+// WriteCSV 输出 CSV 格式
+func (r RoundTrips) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"exchange", "symbol", "side", "entry_time", "exit_time", "entry_price", "exit_price",
+		"size", "volume", "holding_seconds", "mae", "mfe", "pnl", "commission", "net_pnl", "closed"})
+	for _, v := range r {
+		var exitTime string
+		if v.Closed {
+			exitTime = v.ExitTime.Format(csvTimeFormat)
+		}
+		cw.Write([]string{
+			fmt.Sprint(v.Exchange),
+			v.Symbol,
+			v.Side(),
+			v.EntryTime.Format(csvTimeFormat),
+			exitTime,
+			fmt.Sprint(v.EntryPrice),
+			fmt.Sprint(v.ExitPrice),
+			fmt.Sprint(v.Size),
+			fmt.Sprint(v.Volume),
+			fmt.Sprint(v.HoldingTime.Seconds()),
+			fmt.Sprint(v.MAE),
+			fmt.Sprint(v.MFE),
+			fmt.Sprint(v.Pnl),
+			fmt.Sprint(v.Commission),
+			fmt.Sprint(v.NetPnl),
+			fmt.Sprint(v.Closed),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// SaveCSV 保存为 CSV 文件
+func (r RoundTrips) SaveCSV(filename string) error {
+	return writeFile(filename, r.WriteCSV)
+}
+
+// 持仓时间，多个交易重叠的部分只计算一次，未平仓的计算到 end
+func (r RoundTrips) holdingTime(start time.Time, end time.Time) (result time.Duration) {
+	type interval struct {
+		start time.Time
+		end   time.Time
+	}
+	var intervals []interval
+	for _, v := range r {
+		exitTime := end
+		if v.Closed {
+			exitTime = v.ExitTime
+		}
+		intervals = append(intervals, interval{start: v.EntryTime, end: exitTime})
+	}
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].start.Before(intervals[j].start)
+	})
+	var last time.Time // 已计算到的时间
+	for _, v := range intervals {
+		if v.start.Before(last) {
+			v.start = last
+		}
+		result += overlap(v.start, v.end, start, end)
+		if v.end.After(last) {
+			last = v.end
+		}
+	}
+	return
+}
+
+// 区间 [start, end] 在 [from, to] 内的时长
+func overlap(start time.Time, end time.Time, from time.Time, to time.Time) time.Duration {
+	if start.Before(from) {
+		start = from
+	}
+	if end.After(to) {
+		end = to
+	}
+	if end.Before(start) {
+		return 0
+	}
+	return end.Sub(start)
+}
+
+// Fill 一次成交
+type Fill struct {
+	Exchange   int       `json:"exchange"`   // 交易所序号
+	Time       time.Time `json:"time"`       // 成交时间
+	OrderID    string    `json:"order_id"`   // 委托ID
+	Symbol     string    `json:"symbol"`     // 交易对
+	Direction  Direction `json:"direction"`  // 买卖方向
+	Price      float64   `json:"price"`      // 成交价
+	Amount     float64   `json:"amount"`     // 成交量
+	Pnl        float64   `json:"pnl"`        // 平仓盈亏
+	Commission float64   `json:"commission"` // 手续费
+}
+
+// TradeLedgerBuilder 按时间顺序读入撮合日志记录，根据持仓变化重建开平仓记录
+// 支持单向持仓和双向持仓，成交价、盈亏和手续费来自委托的累计成交信息
+type TradeLedgerBuilder struct {
+	exchange   int
+	orders     map[string]*Order     // 委托的上一条记录
+	sizes      map[string]float64    // 持仓量 key: symbol/持仓序号
+	open       map[string]*RoundTrip // 未平仓的交易
+	roundTrips RoundTrips            // 按开仓顺序
+	fills      []*Fill
+}
+
+// Add 添加一条撮合日志记录
+func (l *TradeLedgerBuilder) Add(so *SOrder) {
+	if so == nil || so.Order == nil {
+		return
+	}
+
+	// 本次记录的成交
+	order := so.Order
+	var filled, price, pnl, commission float64
+	if prev, ok := l.orders[order.ID]; ok {
+		filled = order.FilledAmount - prev.FilledAmount
+		pnl = order.Pnl - prev.Pnl
+		commission = order.Commission - prev.Commission
+		if filled > 0 {
+			price = (order.AvgPrice*order.FilledAmount - prev.AvgPrice*prev.FilledAmount) / filled
+		}
+	} else {
+		filled = order.FilledAmount
+		pnl = order.Pnl
+		commission = order.Commission
+		price = order.AvgPrice
+	}
+	l.orders[order.ID] = order
+	if filled > 0 {
+		l.fills = append(l.fills, &Fill{
+			Exchange:   l.exchange,
+			Time:       so.Ts,
+			OrderID:    order.ID,
+			Symbol:     order.Symbol,
+			Direction:  order.Direction,
+			Price:      price,
+			Amount:     filled,
+			Pnl:        pnl,
+			Commission: commission,
+		})
+	}
+	if price <= 0 {
+		price = so.Price
+	}
+
+	for i, position := range so.Positions {
+		key := fmt.Sprintf("%v/%v", position.Symbol, i)
+		size := l.sizes[key]
+		if size == position.Size {
+			continue
+		}
+		l.sizes[key] = position.Size
+		l.update(key, position.Symbol, size, position.Size, price, pnl, commission, so.Ts)
+		// 一次成交只改变一个持仓
+		pnl = 0
+		commission = 0
+	}
+}
+
+// 持仓量从 from 变为 to
+func (l *TradeLedgerBuilder) update(key string, symbol string, from float64, to float64, price float64,
+	pnl float64, commission float64, tm time.Time) {
+	// 反手时手续费按数量分摊到平仓和开仓
+	if from != 0 && to != 0 && (from > 0) != (to > 0) {
+		closeCommission := commission * math.Abs(from) / (math.Abs(from) + math.Abs(to))
+		l.update(key, symbol, from, 0, price, pnl, closeCommission, tm)
+		l.update(key, symbol, 0, to, price, 0, commission-closeCommission, tm)
+		return
+	}
+
+	r := l.open[key]
+	if r == nil {
+		r = &RoundTrip{
+			Exchange:  l.exchange,
+			Symbol:    symbol,
+			Direction: Buy,
+			EntryTime: tm,
+		}
+		if to < 0 {
+			r.Direction = Sell
+		}
+		l.open[key] = r
+		l.roundTrips = append(l.roundTrips, r)
+	}
+
+	if math.Abs(to) > math.Abs(from) {
+		r.open(math.Abs(to)-math.Abs(from), price, commission)
+		r.Size = math.Max(r.Size, math.Abs(to))
+		r.Pnl += pnl
+		r.NetPnl = r.Pnl - r.Commission
+		return
+	}
+	r.close(math.Abs(from)-math.Abs(to), price, pnl, commission)
+	if to == 0 {
+		r.finish(tm)
+		delete(l.open, key)
+	}
+}
+
+// RoundTrips 开平仓记录，包括未平仓的交易
+func (l *TradeLedgerBuilder) RoundTrips() RoundTrips {
+	return l.roundTrips
+}
+
+// Fills 按时间顺序的成交记录
+func (l *TradeLedgerBuilder) Fills() []*Fill {
+	return l.fills
+}
+
+// NewTradeLedgerBuilder 创建开平仓记录构建器，exchange 为交易所序号
+func NewTradeLedgerBuilder(exchange int) *TradeLedgerBuilder {
+	return &TradeLedgerBuilder{
+		exchange: exchange,
+		orders:   map[string]*Order{},
+		sizes:    map[string]float64{},
+		open:     map[string]*RoundTrip{},
+	}
+}
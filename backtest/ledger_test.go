@@ -0,0 +1,149 @@
+package backtest
+
+import (
+	"bytes"
+	"encoding/csv"
+	. "github.com/coinrust/crex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type testFill struct {
+	id         string
+	filled     float64
+	avgPrice   float64
+	pnl        float64
+	commission float64
+	positions  []float64
+}
+
+func testEvents(start time.Time, fills ...testFill) (result []*SOrder) {
+	for i, f := range fills {
+		var positions []*Position
+		for _, size := range f.positions {
+			positions = append(positions, &Position{Symbol: "BTC-PERPETUAL", Size: size})
+		}
+		result = append(result, &SOrder{
+			Event: SimEventDeal,
+			Ts:    start.Add(time.Duration(i) * time.Hour),
+			Order: &Order{
+				ID:           f.id,
+				Symbol:       "BTC-PERPETUAL",
+				FilledAmount: f.filled,
+				AvgPrice:     f.avgPrice,
+				Pnl:          f.pnl,
+				Commission:   f.commission,
+			},
+			Positions: positions,
+		})
+	}
+	return
+}
+
+func TestTradeLedgerBuilder_OneWay(t *testing.T) {
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	events := testEvents(start,
+		testFill{id: "1", filled: 10, avgPrice: 100, commission: 1, positions: []float64{10}},
+		testFill{id: "2", filled: 10, avgPrice: 110, commission: 1, positions: []float64{20}},
+		testFill{id: "3", filled: 5, avgPrice: 120, pnl: 5, commission: 0.5, positions: []float64{15}},
+		// 部分成交后继续成交，平仓并反手
+		testFill{id: "3", filled: 35, avgPrice: 115, pnl: 10, commission: 3.5, positions: []float64{-15}},
+		testFill{id: "4", filled: 15, avgPrice: 100, pnl: 3, commission: 1.5, positions: []float64{0}},
+		testFill{id: "5", filled: 0, positions: []float64{0}},
+		testFill{id: "6", filled: 5, avgPrice: 90, commission: 0.5, positions: []float64{5}},
+	)
+
+	builder := NewTradeLedgerBuilder(0)
+	for _, v := range events {
+		builder.Add(v)
+	}
+	roundTrips := builder.RoundTrips()
+	if !assert.Equal(t, 3, len(roundTrips)) {
+		return
+	}
+
+	long := roundTrips[0]
+	assert.Equal(t, Buy, long.Direction)
+	assert.Equal(t, "long", long.Side())
+	assert.True(t, long.Closed)
+	assert.Equal(t, start, long.EntryTime)
+	assert.Equal(t, start.Add(3*time.Hour), long.ExitTime)
+	assert.Equal(t, 3*time.Hour, long.HoldingTime)
+	assert.Equal(t, 20.0, long.Size)
+	assert.Equal(t, 40.0, long.Volume)
+	assert.InDelta(t, 105.0, long.EntryPrice, 1e-9)
+	// 第二次成交 30 @ (115*35-120*5)/30，其中 15 平多
+	assert.InDelta(t, (120*5+(115.0*35-120*5)/30*15)/20, long.ExitPrice, 1e-9)
+	assert.InDelta(t, 10.0, long.Pnl, 1e-9)
+	assert.InDelta(t, 2+0.5+3*15.0/30, long.Commission, 1e-9)
+	assert.InDelta(t, long.Pnl-long.Commission, long.NetPnl, 1e-9)
+	assert.InDelta(t, 120.0/105-1, long.MFE, 1e-9)
+	assert.Equal(t, 0.0, long.MAE)
+
+	short := roundTrips[1]
+	assert.Equal(t, Sell, short.Direction)
+	assert.True(t, short.Closed)
+	assert.Equal(t, start.Add(3*time.Hour), short.EntryTime)
+	assert.Equal(t, 15.0, short.Size)
+	assert.InDelta(t, 3.0, short.Pnl, 1e-9)
+	assert.InDelta(t, 1.5+1.5, short.Commission, 1e-9)
+	assert.Equal(t, 100.0, short.ExitPrice)
+
+	open := roundTrips[2]
+	assert.False(t, open.Closed)
+	assert.True(t, open.ExitTime.IsZero())
+	assert.Equal(t, 5.0, open.Volume)
+
+	// 重叠部分只计算一次，未平仓的计算到结束
+	assert.Equal(t, 4*time.Hour+time.Hour, roundTrips.holdingTime(start, start.Add(7*time.Hour)))
+}
+
+func TestTradeLedgerBuilder_Hedged(t *testing.T) {
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	events := testEvents(start,
+		testFill{id: "1", filled: 10, avgPrice: 100, positions: []float64{10, 0}},
+		testFill{id: "2", filled: 10, avgPrice: 101, positions: []float64{10, -10}},
+		testFill{id: "3", filled: 10, avgPrice: 98, pnl: 0.3, positions: []float64{10, 0}},
+		testFill{id: "4", filled: 10, avgPrice: 97, pnl: -0.3, positions: []float64{0, 0}},
+	)
+
+	builder := NewTradeLedgerBuilder(1)
+	for _, v := range events {
+		builder.Add(v)
+	}
+	roundTrips := builder.RoundTrips()
+	if !assert.Equal(t, 2, len(roundTrips)) {
+		return
+	}
+	assert.Equal(t, Buy, roundTrips[0].Direction)
+	assert.Equal(t, 1, roundTrips[0].Exchange)
+	assert.Equal(t, 3*time.Hour, roundTrips[0].HoldingTime)
+	assert.Equal(t, -0.3, roundTrips[0].Pnl)
+	assert.InDelta(t, 97.0/100-1, roundTrips[0].MAE, 1e-9)
+	assert.Equal(t, Sell, roundTrips[1].Direction)
+	assert.Equal(t, time.Hour, roundTrips[1].HoldingTime)
+	assert.Equal(t, 0.3, roundTrips[1].Pnl)
+	assert.InDelta(t, 1-98.0/101, roundTrips[1].MFE, 1e-9)
+	assert.Equal(t, 3*time.Hour, roundTrips.holdingTime(start, start.Add(4*time.Hour)))
+}
+
+func TestRoundTrips_WriteCSV(t *testing.T) {
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	roundTrips := RoundTrips{
+		{Symbol: "BTC-PERPETUAL", Direction: Sell, EntryTime: start, ExitTime: start.Add(time.Minute),
+			HoldingTime: time.Minute, EntryPrice: 100, ExitPrice: 99, Size: 10, Volume: 20, Pnl: 1, Closed: true},
+		{Symbol: "BTC-PERPETUAL", Direction: Buy, EntryTime: start.Add(time.Hour), EntryPrice: 99, Size: 10, Volume: 10},
+	}
+	var buf bytes.Buffer
+	assert.Nil(t, roundTrips.WriteCSV(&buf))
+	records, err := csv.NewReader(&buf).ReadAll()
+	if !assert.Nil(t, err) || !assert.Equal(t, 3, len(records)) {
+		return
+	}
+	assert.Equal(t, "side", records[0][2])
+	assert.Equal(t, []string{"0", "BTC-PERPETUAL", "short", "2019-10-01T00:00:00.000Z", "2019-10-01T00:01:00.000Z",
+		"100", "99", "10", "20", "60", "0", "0", "1", "0", "0", "true"}, records[1])
+	assert.Equal(t, "", records[2][4])
+	assert.Equal(t, "false", records[2][15])
+}
@@ -0,0 +1,357 @@
+package backtest
+
+import (
+	"errors"
+	"fmt"
+	. "github.com/coinrust/crex"
+	"github.com/coinrust/crex/dataloader"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+var (
+	ErrUnknownOption = errors.New("unknown strategy option")
+	ErrInvalidMetric = errors.New("invalid stats metric")
+	ErrInvalidWindow = errors.New("invalid walk-forward window")
+)
+
+// StrategyFactory 创建策略和模拟交易所，每个参数组合调用一次
+// 模拟交易所需使用 Optimizer 的 datas
+type StrategyFactory func() (Strategy, []ExchangeSim)
+
+// OptimizerResult 参数组合的回测结果
+type OptimizerResult struct {
+	Rank    int                    `json:"rank"`
+	Options map[string]interface{} `json:"options"`
+	Metric  float64                `json:"metric"`
+	Stats   *Stats                 `json:"stats"`
+}
+
+// OptimizerResults 按指标排序的回测结果
+type OptimizerResults []*OptimizerResult
+
+// Best 最优参数组合
+func (r OptimizerResults) Best() *OptimizerResult {
+	if len(r) == 0 {
+		return nil
+	}
+	return r[0]
+}
+
+// PrintTable 输出结果表格
+func (r OptimizerResults) PrintTable(w io.Writer) {
+	names := optionNames(r)
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "Rank\t%v\tMetric\tReturn [%%]\tAnn Return [%%]\tMax Drawdown [%%]\n", strings.Join(names, "\t"))
+	for _, v := range r {
+		fmt.Fprintf(tw, "%v\t%v\t%.8f\t%.4f\t%.4f\t%.4f\n", v.Rank, optionValues(v.Options, names), v.Metric,
+			v.Stats.EquityReturnPnt*100, v.Stats.AnnReturn*100, v.Stats.MaxDrawDown*100)
+	}
+	tw.Flush()
+}
+
+// SaveJSON 保存为 JSON 文件
+func (r OptimizerResults) SaveJSON(filename string) error {
+	return saveJSON(filename, r)
+}
+
+// WalkForwardResult 滚动窗口的样本内最优参数及样本外回测结果
+type WalkForwardResult struct {
+	InSampleStart  time.Time              `json:"in_sample_start"`
+	InSampleEnd    time.Time              `json:"in_sample_end"`
+	OutSampleStart time.Time              `json:"out_sample_start"`
+	OutSampleEnd   time.Time              `json:"out_sample_end"`
+	Options        map[string]interface{} `json:"options"`
+	InSample       *Stats                 `json:"in_sample"`
+	OutSample      *Stats                 `json:"out_sample"`
+	InMetric       float64                `json:"in_metric"`
+	OutMetric      float64                `json:"out_metric"`
+}
+
+// WalkForwardResults 按时间顺序的滚动窗口结果
+type WalkForwardResults []*WalkForwardResult
+
+// PrintTable 输出结果表格
+func (r WalkForwardResults) PrintTable(w io.Writer) {
+	var names []string
+	if len(r) > 0 {
+		names = sortedKeys(r[0].Options)
+	}
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "In Sample\tOut Sample\t%v\tIn Metric\tOut Metric\tOut Return [%%]\n", strings.Join(names, "\t"))
+	for _, v := range r {
+		fmt.Fprintf(tw, "%v - %v\t%v - %v\t%v\t%.8f\t%.8f\t%.4f\n",
+			v.InSampleStart.Format(SimpleDateTimeFormat), v.InSampleEnd.Format(SimpleDateTimeFormat),
+			v.OutSampleStart.Format(SimpleDateTimeFormat), v.OutSampleEnd.Format(SimpleDateTimeFormat),
+			optionValues(v.Options, names), v.InMetric, v.OutMetric, v.OutSample.EquityReturnPnt*100)
+	}
+	tw.Flush()
+}
+
+// SaveJSON 保存为 JSON 文件
+func (r WalkForwardResults) SaveJSON(filename string) error {
+	return saveJSON(filename, r)
+}
+
+// Optimizer 参数优化，网格搜索所有参数组合，每个组合一个 StrategyTester，在同一回测中运行
+type Optimizer struct {
+	datas         []*dataloader.Data
+	symbol        string
+	start         time.Time
+	end           time.Time
+	factory       StrategyFactory
+	outputDir     string
+	names         []string
+	params        map[string][]interface{} // key: 策略参数名
+	metric        string                   // Stats 的 json 字段名
+	lowerIsBetter bool
+	parallel      int
+}
+
+// AddParam 添加参数的取值，name 为策略参数名(字段名)
+func (o *Optimizer) AddParam(name string, values ...interface{}) {
+	if _, ok := o.params[name]; !ok {
+		o.names = append(o.names, name)
+	}
+	o.params[name] = values
+}
+
+// SetMetric 设置排序指标，使用 Stats 的 json 字段名，如 equity_return_pnt
+// lowerIsBetter: 越小越好，如 max_draw_down
+func (o *Optimizer) SetMetric(metric string, lowerIsBetter bool) error {
+	if _, ok := statsMetric(&Stats{}, metric); !ok {
+		return ErrInvalidMetric
+	}
+	o.metric = metric
+	o.lowerIsBetter = lowerIsBetter
+	return nil
+}
+
+// SetParallel 并行执行参数组合，见 Backtest.SetParallel
+func (o *Optimizer) SetParallel(workers int) {
+	o.parallel = workers
+}
+
+// Combinations 所有参数组合
+func (o *Optimizer) Combinations() (result []map[string]interface{}) {
+	if len(o.names) == 0 {
+		return
+	}
+	names := append([]string(nil), o.names...)
+	sort.Strings(names)
+	result = []map[string]interface{}{{}}
+	for _, name := range names {
+		var next []map[string]interface{}
+		for _, c := range result {
+			for _, v := range o.params[name] {
+				options := make(map[string]interface{}, len(c)+1)
+				for k, v := range c {
+					options[k] = v
+				}
+				options[name] = v
+				next = append(next, options)
+			}
+		}
+		result = next
+	}
+	return
+}
+
+// Run 在整个时间区间内运行所有参数组合，按指标排序
+func (o *Optimizer) Run() (OptimizerResults, error) {
+	return o.run(o.combinations(), o.start, o.end, o.outputDir)
+}
+
+// RunWalkForward 滚动窗口优化，每个窗口在样本内选出最优参数，然后在紧随的样本外区间回测
+// 窗口每次向后移动 outSample
+func (o *Optimizer) RunWalkForward(inSample time.Duration, outSample time.Duration) (result WalkForwardResults, err error) {
+	if inSample <= 0 || outSample <= 0 {
+		return nil, ErrInvalidWindow
+	}
+	combinations := o.combinations()
+	for start := o.start; start.Add(inSample).Before(o.end); start = start.Add(outSample) {
+		inEnd := start.Add(inSample)
+		outEnd := inEnd.Add(outSample)
+		if outEnd.After(o.end) {
+			outEnd = o.end
+		}
+		var inDir, outDir string
+		if o.outputDir != "" {
+			inDir = filepath.Join(o.outputDir, fmt.Sprintf("wf_%03d_in", len(result)))
+			outDir = filepath.Join(o.outputDir, fmt.Sprintf("wf_%03d_out", len(result)))
+		}
+
+		var inResults, outResults OptimizerResults
+		if inResults, err = o.run(combinations, start, inEnd, inDir); err != nil {
+			return
+		}
+		best := inResults.Best()
+		if outResults, err = o.run([]map[string]interface{}{best.Options}, inEnd, outEnd, outDir); err != nil {
+			return
+		}
+		result = append(result, &WalkForwardResult{
+			InSampleStart:  start,
+			InSampleEnd:    inEnd,
+			OutSampleStart: inEnd,
+			OutSampleEnd:   outEnd,
+			Options:        best.Options,
+			InSample:       best.Stats,
+			OutSample:      outResults[0].Stats,
+			InMetric:       best.Metric,
+			OutMetric:      outResults[0].Metric,
+		})
+	}
+	if len(result) == 0 {
+		err = ErrInvalidWindow
+	}
+	return
+}
+
+func (o *Optimizer) combinations() []map[string]interface{} {
+	combinations := o.Combinations()
+	if len(combinations) == 0 {
+		// 没有参数时只运行默认参数
+		combinations = []map[string]interface{}{{}}
+	}
+	return combinations
+}
+
+func (o *Optimizer) run(combinations []map[string]interface{}, start time.Time, end time.Time, outputDir string) (result OptimizerResults, err error) {
+	var paramsList []*StrategyTesterParams
+	for _, options := range combinations {
+		strategy, exchanges := o.factory()
+		if err = strategy.SetSelf(strategy); err != nil {
+			return
+		}
+		if err = checkOptions(strategy, options); err != nil {
+			return
+		}
+		if err = strategy.SetOptions(options); err != nil {
+			return
+		}
+		paramsList = append(paramsList, NewStrategyTesterParams(strategy, exchanges))
+	}
+
+	b := NewBacktestFromParams(o.datas, o.symbol, start, end, paramsList, outputDir)
+	b.SetParallel(o.parallel)
+	b.Run()
+
+	for i, options := range combinations {
+		stats := b.ComputeStatsByIndex(i)
+		metric, _ := statsMetric(stats, o.metric)
+		result = append(result, &OptimizerResult{
+			Options: options,
+			Metric:  metric,
+			Stats:   stats,
+		})
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if o.lowerIsBetter {
+			return result[i].Metric < result[j].Metric
+		}
+		return result[i].Metric > result[j].Metric
+	})
+	for i, v := range result {
+		v.Rank = i + 1
+	}
+	return
+}
+
+// 检查参数名是否为策略参数
+func checkOptions(strategy Strategy, options map[string]interface{}) error {
+	s, ok := strategy.(interface {
+		GetOptions() map[string]*StrategyOption
+	})
+	if !ok {
+		if len(options) > 0 {
+			return ErrUnknownOption
+		}
+		return nil
+	}
+	keys := map[string]bool{}
+	for name := range s.GetOptions() {
+		keys[strings.ReplaceAll(strings.ToLower(name), "_", "")] = true
+	}
+	for name := range options {
+		if !keys[strings.ReplaceAll(strings.ToLower(name), "_", "")] {
+			return fmt.Errorf("%w: %v", ErrUnknownOption, name)
+		}
+	}
+	return nil
+}
+
+// 按 json 字段名读取 Stats 的数值
+func statsMetric(stats *Stats, metric string) (float64, bool) {
+	v := reflect.ValueOf(stats).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if strings.Split(t.Field(i).Tag.Get("json"), ",")[0] != metric {
+			continue
+		}
+		field := v.Field(i)
+		switch field.Kind() {
+		case reflect.Float32, reflect.Float64:
+			return field.Float(), true
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return float64(field.Int()), true
+		}
+		return 0, false
+	}
+	return 0, false
+}
+
+func optionNames(results OptimizerResults) []string {
+	if len(results) == 0 {
+		return nil
+	}
+	return sortedKeys(results[0].Options)
+}
+
+func sortedKeys(m map[string]interface{}) (result []string) {
+	for k := range m {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return
+}
+
+func optionValues(options map[string]interface{}, names []string) string {
+	values := make([]string, len(names))
+	for i, name := range names {
+		values[i] = fmt.Sprint(options[name])
+	}
+	return strings.Join(values, "\t")
+}
+
+func saveJSON(filename string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, data, os.ModePerm)
+}
+
+// NewOptimizer 创建参数优化
+// datas: 数据，所有参数组合使用同一份数据
+// factory: 创建策略和模拟交易所
+// outputDir: 回测输出目录，为空时不输出日志
+func NewOptimizer(datas []*dataloader.Data, symbol string, start time.Time, end time.Time,
+	factory StrategyFactory, outputDir string) *Optimizer {
+	return &Optimizer{
+		datas:     datas,
+		symbol:    symbol,
+		start:     start,
+		end:       end,
+		factory:   factory,
+		outputDir: outputDir,
+		params:    make(map[string][]interface{}),
+		metric:    "equity_return_pnt",
+	}
+}
@@ -0,0 +1,205 @@
+package backtest
+
+import (
+	"bytes"
+	"errors"
+	. "github.com/coinrust/crex"
+	"github.com/coinrust/crex/dataloader"
+	"github.com/coinrust/crex/exchanges/exsim"
+	"github.com/coinrust/crex/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// 第一天上涨，第二天下跌
+func testData(start time.Time) *dataloader.Data {
+	var obs []*OrderBook
+	for i := 0; i <= 48*60; i++ {
+		price := 8000.0 + float64(i)
+		if i > 24*60 {
+			price = 8000.0 + float64(48*60-i)
+		}
+		obs = append(obs, &OrderBook{
+			Symbol: "BTC-PERPETUAL",
+			Time:   start.Add(time.Duration(i) * time.Minute),
+			Asks:   []Item{{Price: price + 0.5, Amount: 100000}},
+			Bids:   []Item{{Price: price, Amount: 100000}},
+		})
+	}
+	return dataloader.NewData(testutil.NewDataLoader(obs))
+}
+
+// 开始时开仓并持有到结束
+type holdStrategy struct {
+	StrategyBase
+
+	Size float64 `opt:"size,10"`
+	Long bool    `opt:"long,true"`
+
+	opened  bool
+	orderID string
+}
+
+func (s *holdStrategy) OnInit() error {
+	return nil
+}
+
+func (s *holdStrategy) OnTick() error {
+	if s.opened || s.Size == 0 {
+		return nil
+	}
+	direction := Buy
+	if !s.Long {
+		direction = Sell
+	}
+	order, err := s.Exchange.PlaceOrder("BTC-PERPETUAL", direction, OrderTypeMarket, 0, s.Size)
+	if err != nil {
+		return err
+	}
+	s.opened = true
+	s.orderID = order.ID
+	s.Logger().Infof("order %v", order.ID)
+	return nil
+}
+
+func (s *holdStrategy) Run() error {
+	return nil
+}
+
+func (s *holdStrategy) OnExit() error {
+	return nil
+}
+
+func newTestOptimizer(start time.Time, end time.Time, outputDir string) *Optimizer {
+	data := testData(start)
+	return NewOptimizer([]*dataloader.Data{data}, "BTC", start, end, func() (Strategy, []ExchangeSim) {
+		ex := exsim.NewExSim(data, 1.0, 0, 0, 1.0, false, false)
+		return &holdStrategy{}, []ExchangeSim{ex}
+	}, outputDir)
+}
+
+func TestOptimizer_Run(t *testing.T) {
+	dir, err := ioutil.TempDir("", "optimizer")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	o := newTestOptimizer(start, start.Add(24*time.Hour), dir)
+	o.AddParam("Size", 0.0, 10.0, 100.0)
+	o.AddParam("Long", true, false)
+	assert.Equal(t, 6, len(o.Combinations()))
+	assert.Equal(t, ErrInvalidMetric, o.SetMetric("start", false))
+	assert.Equal(t, ErrInvalidMetric, o.SetMetric("unknown", false))
+
+	results, err := o.Run()
+	if !assert.Nil(t, err) || !assert.Equal(t, 6, len(results)) {
+		return
+	}
+	best := results.Best()
+	assert.Equal(t, 1, best.Rank)
+	assert.Equal(t, map[string]interface{}{"Size": 100.0, "Long": true}, best.Options)
+	assert.True(t, best.Metric > 0)
+	assert.Equal(t, best.Stats.EquityReturnPnt, best.Metric)
+	last := results[len(results)-1]
+	assert.Equal(t, map[string]interface{}{"Size": 100.0, "Long": false}, last.Options)
+	for i := 1; i < len(results); i++ {
+		assert.True(t, results[i-1].Metric >= results[i].Metric)
+	}
+
+	var buf bytes.Buffer
+	results.PrintTable(&buf)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Equal(t, 7, len(lines))
+	assert.True(t, strings.HasPrefix(lines[0], "Rank"))
+
+	filename := filepath.Join(dir, "result.json")
+	assert.Nil(t, results.SaveJSON(filename))
+	var saved OptimizerResults
+	data, _ := ioutil.ReadFile(filename)
+	assert.Nil(t, json.Unmarshal(data, &saved))
+	if assert.Equal(t, 6, len(saved)) {
+		assert.Equal(t, best.Options, saved[0].Options)
+	}
+
+	// 最大回撤越小越好
+	assert.Nil(t, o.SetMetric("max_draw_down", true))
+	results, err = o.Run()
+	assert.Nil(t, err)
+	assert.Equal(t, 0.0, results.Best().Metric)
+
+	o.AddParam("Unknown", 1)
+	_, err = o.Run()
+	assert.True(t, errors.Is(err, ErrUnknownOption))
+}
+
+func TestOptimizer_RunWalkForward(t *testing.T) {
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	o := newTestOptimizer(start, start.Add(48*time.Hour), "")
+	o.AddParam("Size", 10.0)
+	o.AddParam("Long", true, false)
+
+	_, err := o.RunWalkForward(0, time.Hour)
+	assert.Equal(t, ErrInvalidWindow, err)
+
+	results, err := o.RunWalkForward(12*time.Hour, 12*time.Hour)
+	if !assert.Nil(t, err) || !assert.Equal(t, 3, len(results)) {
+		return
+	}
+	// 上涨时做多，下跌时做空
+	assert.Equal(t, true, results[0].Options["Long"])
+	assert.True(t, results[0].OutMetric > 0)
+	assert.Equal(t, start.Add(12*time.Hour), results[0].OutSampleStart)
+	assert.Equal(t, start.Add(24*time.Hour), results[0].OutSampleEnd)
+	assert.Equal(t, false, results[2].Options["Long"])
+	assert.True(t, results[2].OutMetric > 0)
+	assert.Equal(t, start.Add(48*time.Hour), results[2].OutSampleEnd)
+
+	var buf bytes.Buffer
+	results.PrintTable(&buf)
+	assert.Equal(t, 4, len(strings.Split(strings.TrimSpace(buf.String()), "\n")))
+}
+
+func TestBacktest_SetParallel(t *testing.T) {
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	run := func(parallel int) (results []*Stats, orderIDs []string) {
+		data := testData(start)
+		var paramsList []*StrategyTesterParams
+		var strategies []*holdStrategy
+		for i := 0; i < 8; i++ {
+			s := &holdStrategy{Size: float64(10 * i), Long: i%2 == 0}
+			s.SetSelf(s)
+			ex := exsim.NewExSim(data, 1.0, 0, 0.00075, 1.0, false, false)
+			strategies = append(strategies, s)
+			paramsList = append(paramsList, NewStrategyTesterParams(s, []ExchangeSim{ex}))
+		}
+		b := NewBacktestFromParams([]*dataloader.Data{data}, "BTC", start, start.Add(24*time.Hour), paramsList, "")
+		b.SetParallel(parallel)
+		b.Run()
+		for i, s := range strategies {
+			results = append(results, b.ComputeStatsByIndex(i))
+			orderIDs = append(orderIDs, s.orderID)
+		}
+		return
+	}
+
+	serial, serialOrderIDs := run(0)
+	parallel, parallelOrderIDs := run(4)
+	for i := range serial {
+		assert.Equal(t, serial[i].ExitEquity, parallel[i].ExitEquity)
+		assert.Equal(t, serial[i].MaxDrawDown, parallel[i].MaxDrawDown)
+	}
+	// 每个策略独立生成订单ID
+	assert.Equal(t, serialOrderIDs, parallelOrderIDs)
+	assert.Equal(t, "", serialOrderIDs[0])
+	assert.Equal(t, serialOrderIDs[1], serialOrderIDs[7])
+	assert.True(t, serial[7].EquityReturnPnt < 0)
+	assert.True(t, serial[6].EquityReturnPnt > 0)
+}
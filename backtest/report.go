@@ -9,9 +9,10 @@ import (
 
 // SOrder "event":"order"/"deal"
 type SOrder struct {
+	Event     string      // event: order/deal
 	Ts        time.Time   // ts: 2019-10-02T07:03:53.584+0800
 	Order     *Order      // order
-	OrderBook *OrderBook  // orderbook
+	Price     float64     // orderbook 中间价
 	Positions []*Position // positions
 	Balances  []float64   // balances
 	Comment   string      // msg: Place order/Match order
@@ -0,0 +1,376 @@
+package backtest
+
+import (
+	"encoding/csv"
+	"fmt"
+	. "github.com/coinrust/crex"
+	"github.com/coinrust/crex/log"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ResultsVersion 回测结果格式版本，字段或文件变更时增加
+const ResultsVersion = 1
+
+// CSV 时间格式
+const csvTimeFormat = "2006-01-02T15:04:05.000Z07:00"
+
+// Results 回测结果，供外部程序分析和比较
+// Save 输出的文件:
+// results.json: 全部内容
+// series.csv: 价格和净值序列
+// orders.csv/deals.csv: 委托和成交记录
+// round_trips.csv: 开平仓记录
+// stats.csv/options.csv: 统计结果和策略参数(name,value)
+type Results struct {
+	Version    int                    `json:"version"`
+	Metadata   *ResultsMetadata       `json:"metadata"`
+	Options    map[string]interface{} `json:"options"`
+	Stats      *Stats                 `json:"stats"`
+	Series     []*ResultsPoint        `json:"series"`
+	Orders     []*ResultsOrder        `json:"orders"`
+	Deals      []*ResultsOrder        `json:"deals"`
+	RoundTrips RoundTrips             `json:"round_trips"`
+}
+
+// ResultsMetadata 回测信息
+type ResultsMetadata struct {
+	Symbol    string             `json:"symbol"`
+	Strategy  string             `json:"strategy"`   // 策略名称
+	Tester    int                `json:"tester"`     // 策略序号
+	Start     time.Time          `json:"start"`      // 回测开始时间
+	End       time.Time          `json:"end"`        // 回测结束时间
+	DataStart time.Time          `json:"data_start"` // 第一条数据时间
+	DataEnd   time.Time          `json:"data_end"`   // 最后一条数据时间
+	Exchanges []*ResultsExchange `json:"exchanges"`
+}
+
+// ResultsExchange 模拟交易所设置
+type ResultsExchange struct {
+	Index        int     `json:"index"`
+	Name         string  `json:"name"`
+	MakerFeeRate float64 `json:"maker_fee_rate"`
+	TakerFeeRate float64 `json:"taker_fee_rate"`
+}
+
+// ResultsPoint 净值序列中的一项
+type ResultsPoint struct {
+	Time     time.Time `json:"time"`
+	Prices   []float64 `json:"prices"`   // 各交易所价格
+	Equity   float64   `json:"equity"`   // 总净值
+	Balances []float64 `json:"balances"` // 各交易所余额
+	Equities []float64 `json:"equities"` // 各交易所净值
+}
+
+// ResultsOrder 委托或成交记录，数量和价格为记录时的累计值
+type ResultsOrder struct {
+	Time         time.Time `json:"time"`
+	Exchange     int       `json:"exchange"` // 交易所序号
+	ID           string    `json:"id"`
+	Symbol       string    `json:"symbol"`
+	Type         string    `json:"type"`
+	Direction    string    `json:"direction"`
+	Price        float64   `json:"price"`
+	StopPx       float64   `json:"stop_px"`
+	Amount       float64   `json:"amount"`
+	AvgPrice     float64   `json:"avg_price"`
+	FilledAmount float64   `json:"filled_amount"`
+	Commission   float64   `json:"commission"`
+	Pnl          float64   `json:"pnl"`
+	Status       string    `json:"status"`
+	Comment      string    `json:"comment"`
+}
+
+// SaveJSON 保存为 JSON 文件
+func (r *Results) SaveJSON(filename string) error {
+	return saveJSON(filename, r)
+}
+
+// WriteSeriesCSV 输出价格和净值序列
+func (r *Results) WriteSeriesCSV(w io.Writer) error {
+	var prices, exchanges int
+	if len(r.Series) > 0 {
+		prices = len(r.Series[0].Prices)
+		exchanges = len(r.Series[0].Balances)
+	}
+	header := []string{"time"}
+	for i := 0; i < prices; i++ {
+		header = append(header, fmt.Sprintf("price_%v", i))
+	}
+	header = append(header, "equity")
+	for i := 0; i < exchanges; i++ {
+		header = append(header, fmt.Sprintf("balance_%v", i), fmt.Sprintf("equity_%v", i))
+	}
+
+	cw := csv.NewWriter(w)
+	cw.Write(header)
+	for _, v := range r.Series {
+		record := []string{v.Time.Format(csvTimeFormat)}
+		for i := 0; i < prices; i++ {
+			record = append(record, formatIndex(v.Prices, i))
+		}
+		record = append(record, formatFloat(v.Equity))
+		for i := 0; i < exchanges; i++ {
+			record = append(record, formatIndex(v.Balances, i), formatIndex(v.Equities, i))
+		}
+		cw.Write(record)
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteOrdersCSV 输出委托或成交记录
+func WriteOrdersCSV(w io.Writer, orders []*ResultsOrder) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"time", "exchange", "id", "symbol", "type", "direction", "price", "stop_px", "amount",
+		"avg_price", "filled_amount", "commission", "pnl", "status", "comment"})
+	for _, v := range orders {
+		cw.Write([]string{
+			v.Time.Format(csvTimeFormat),
+			fmt.Sprint(v.Exchange),
+			v.ID,
+			v.Symbol,
+			v.Type,
+			v.Direction,
+			formatFloat(v.Price),
+			formatFloat(v.StopPx),
+			formatFloat(v.Amount),
+			formatFloat(v.AvgPrice),
+			formatFloat(v.FilledAmount),
+			formatFloat(v.Commission),
+			formatFloat(v.Pnl),
+			v.Status,
+			v.Comment,
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteStatsCSV 输出统计结果，名称为 Stats 的 json 字段名，时间长度单位为纳秒(与 JSON 一致)
+func (r *Results) WriteStatsCSV(w io.Writer) error {
+	var rows [][2]string
+	if r.Stats != nil {
+		v := reflect.ValueOf(r.Stats).Elem()
+		for i := 0; i < v.NumField(); i++ {
+			name := strings.Split(v.Type().Field(i).Tag.Get("json"), ",")[0]
+			var value string
+			switch x := v.Field(i).Interface().(type) {
+			case time.Time:
+				value = x.Format(csvTimeFormat)
+			case time.Duration:
+				value = strconv.FormatInt(int64(x), 10)
+			case float64:
+				value = formatFloat(x)
+			default:
+				value = fmt.Sprint(x)
+			}
+			rows = append(rows, [2]string{name, value})
+		}
+	}
+	return writeKeyValueCSV(w, rows)
+}
+
+// WriteOptionsCSV 输出策略参数，按参数名排序
+func (r *Results) WriteOptionsCSV(w io.Writer) error {
+	var rows [][2]string
+	for _, name := range sortedKeys(r.Options) {
+		rows = append(rows, [2]string{name, fmt.Sprint(r.Options[name])})
+	}
+	return writeKeyValueCSV(w, rows)
+}
+
+// Save 保存所有结果文件到 dir
+func (r *Results) Save(dir string) (err error) {
+	if err = os.MkdirAll(dir, os.ModePerm); err != nil {
+		return
+	}
+	if err = r.SaveJSON(filepath.Join(dir, "results.json")); err != nil {
+		return
+	}
+	files := []struct {
+		name  string
+		write func(w io.Writer) error
+	}{
+		{"series.csv", r.WriteSeriesCSV},
+		{"orders.csv", func(w io.Writer) error { return WriteOrdersCSV(w, r.Orders) }},
+		{"deals.csv", func(w io.Writer) error { return WriteOrdersCSV(w, r.Deals) }},
+		{"round_trips.csv", r.RoundTrips.WriteCSV},
+		{"stats.csv", r.WriteStatsCSV},
+		{"options.csv", r.WriteOptionsCSV},
+	}
+	for _, v := range files {
+		if err = writeFile(filepath.Join(dir, v.name), v.write); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// SaveResults 导出回测结果到输出目录的 results 目录，多个策略时为 results_N，文件见 Results
+func (b *Backtest) SaveResults() {
+	for _, v := range b.strategyTesters {
+		if err := v.SaveResults(); err != nil {
+			log.Error(err)
+		}
+	}
+}
+
+// SaveResults 导出回测结果，见 Backtest.SaveResults
+func (t *StrategyTester) SaveResults() error {
+	name := "results"
+	if len(t.backtest.strategyTesters) > 1 {
+		name = fmt.Sprintf("results_%v", t.index())
+	}
+	return t.WriteResults(filepath.Join(t.backtest.outputDir, name))
+}
+
+// WriteResults 导出回测结果到 dir
+func (t *StrategyTester) WriteResults(dir string) error {
+	results, err := t.Results()
+	if err != nil {
+		return err
+	}
+	return results.Save(dir)
+}
+
+// Results 回测结果
+func (t *StrategyTester) Results() (result *Results, err error) {
+	b := t.backtest
+	result = &Results{
+		Version: ResultsVersion,
+		Metadata: &ResultsMetadata{
+			Symbol:   b.symbol,
+			Strategy: t.strategyName(),
+			Tester:   t.index(),
+			Start:    b.start,
+			End:      b.end,
+		},
+		Options: map[string]interface{}{},
+		Stats:   t.ComputeStats(),
+	}
+	if n := len(t.logs); n > 0 {
+		result.Metadata.DataStart = t.logs[0].Time
+		result.Metadata.DataEnd = t.logs[n-1].Time
+	}
+	if t.StrategyTesterParams != nil {
+		for i, v := range t.exchanges {
+			ex := &ResultsExchange{Index: i}
+			if s, ok := v.(interface{ GetName() string }); ok {
+				ex.Name = s.GetName()
+			}
+			if s, ok := v.(FeeExchangeSim); ok {
+				ex.MakerFeeRate, ex.TakerFeeRate = s.GetFeeRates()
+			}
+			result.Metadata.Exchanges = append(result.Metadata.Exchanges, ex)
+		}
+	}
+	for name, option := range t.strategyOptions() {
+		result.Options[name] = option.Value
+	}
+
+	for _, item := range t.logs {
+		point := &ResultsPoint{
+			Time:   item.Time,
+			Prices: item.Prices,
+			Equity: item.TotalEquity(),
+		}
+		for _, v := range item.Stats {
+			point.Balances = append(point.Balances, v.Balance)
+			point.Equities = append(point.Equities, v.Equity)
+		}
+		result.Series = append(result.Series, point)
+	}
+
+	for i := range t.tradeLoggers {
+		events := t.tradeEvents(i)
+		for _, so := range events {
+			switch so.Event {
+			case SimEventOrder:
+				result.Orders = append(result.Orders, newResultsOrder(i, so))
+			case SimEventDeal:
+				result.Deals = append(result.Deals, newResultsOrder(i, so))
+			}
+		}
+		result.RoundTrips = append(result.RoundTrips, t.buildTradeLedger(i, events).RoundTrips()...)
+	}
+	// 与 TradeLedger 一致，按时间排序，同一时间保持交易所顺序
+	sortResultsOrders(result.Orders)
+	sortResultsOrders(result.Deals)
+	sort.SliceStable(result.RoundTrips, func(i, j int) bool {
+		return result.RoundTrips[i].EntryTime.Before(result.RoundTrips[j].EntryTime)
+	})
+	return
+}
+
+func sortResultsOrders(orders []*ResultsOrder) {
+	sort.SliceStable(orders, func(i, j int) bool {
+		return orders[i].Time.Before(orders[j].Time)
+	})
+}
+
+func writeKeyValueCSV(w io.Writer, rows [][2]string) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"name", "value"})
+	for _, v := range rows {
+		cw.Write([]string{v[0], v[1]})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func writeFile(filename string, write func(w io.Writer) error) (err error) {
+	var file *os.File
+	file, err = os.Create(filename)
+	if err != nil {
+		return
+	}
+	if err = write(file); err != nil {
+		file.Close()
+		return
+	}
+	return file.Close()
+}
+
+// 格式化浮点数，正无穷(无亏损时的盈利因子)与 JSON 一致为 inf
+func formatFloat(v float64) string {
+	if math.IsInf(v, 1) {
+		return "inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+func formatIndex(values []float64, i int) string {
+	if i < len(values) {
+		return formatFloat(values[i])
+	}
+	return ""
+}
+
+func newResultsOrder(exchange int, so *SOrder) *ResultsOrder {
+	o := so.Order
+	return &ResultsOrder{
+		Time:         so.Ts,
+		Exchange:     exchange,
+		ID:           o.ID,
+		Symbol:       o.Symbol,
+		Type:         o.Type.String(),
+		Direction:    o.Direction.String(),
+		Price:        o.Price,
+		StopPx:       o.StopPx,
+		Amount:       o.Amount,
+		AvgPrice:     o.AvgPrice,
+		FilledAmount: o.FilledAmount,
+		Commission:   o.Commission,
+		Pnl:          o.Pnl,
+		Status:       o.Status.String(),
+		Comment:      so.Comment,
+	}
+}
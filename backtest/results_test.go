@@ -0,0 +1,108 @@
+package backtest
+
+import (
+	"encoding/csv"
+	. "github.com/coinrust/crex"
+	"github.com/coinrust/crex/dataloader"
+	"github.com/coinrust/crex/exchanges/exsim"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func readCSV(t *testing.T, filename string) [][]string {
+	f, err := os.Open(filename)
+	if !assert.Nil(t, err) {
+		return nil
+	}
+	defer f.Close()
+	records, err := csv.NewReader(f).ReadAll()
+	assert.Nil(t, err)
+	return records
+}
+
+func TestBacktest_SaveResults(t *testing.T) {
+	dir, err := ioutil.TempDir("", "results")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+	data := testData(start)
+	strategy := &holdStrategy{Size: 10, Long: true}
+	ex := exsim.NewExSim(data, 1.0, -0.00025, 0.00075, 1.0, false, false)
+	b := NewBacktest([]*dataloader.Data{data}, "BTC", start, end, strategy, []ExchangeSim{ex}, dir)
+	b.Run()
+	b.SaveResults()
+
+	resultsDir := filepath.Join(b.outputDir, "results")
+	var results Results
+	d, err := ioutil.ReadFile(filepath.Join(resultsDir, "results.json"))
+	if !assert.Nil(t, err) || !assert.Nil(t, json.Unmarshal(d, &results)) {
+		return
+	}
+	assert.Equal(t, ResultsVersion, results.Version)
+	assert.Equal(t, "BTC", results.Metadata.Symbol)
+	assert.Equal(t, "holdStrategy", results.Metadata.Strategy)
+	assert.True(t, results.Metadata.Start.Equal(start))
+	assert.True(t, results.Metadata.End.Equal(end))
+	assert.Equal(t, []*ResultsExchange{{Index: 0, Name: "exsim", MakerFeeRate: -0.00025, TakerFeeRate: 0.00075}},
+		results.Metadata.Exchanges)
+	assert.Equal(t, map[string]interface{}{"Size": 10.0, "Long": true}, results.Options)
+	assert.Equal(t, len(b.GetLogs(0)), len(results.Series))
+	assert.Equal(t, b.ComputeStats().ExitEquity, results.Stats.ExitEquity)
+	if assert.Equal(t, 1, len(results.Orders)) {
+		order := results.Orders[0]
+		assert.Equal(t, strategy.orderID, order.ID)
+		assert.Equal(t, "Buy", order.Direction)
+		assert.Equal(t, 10.0, order.FilledAmount)
+	}
+	assert.Equal(t, 1, len(results.RoundTrips))
+
+	series := readCSV(t, filepath.Join(resultsDir, "series.csv"))
+	if assert.Equal(t, len(results.Series)+1, len(series)) {
+		assert.Equal(t, []string{"time", "price_0", "equity", "balance_0", "equity_0"}, series[0])
+		assert.Equal(t, b.GetLogs(0)[0].Time.Format(csvTimeFormat), series[1][0])
+		assert.Equal(t, "1", series[1][2])
+	}
+	orders := readCSV(t, filepath.Join(resultsDir, "orders.csv"))
+	if assert.Equal(t, 2, len(orders)) {
+		assert.Equal(t, "id", orders[0][2])
+		assert.Equal(t, strategy.orderID, orders[1][2])
+	}
+	deals := readCSV(t, filepath.Join(resultsDir, "deals.csv"))
+	assert.Equal(t, len(results.Deals)+1, len(deals))
+	assert.Equal(t, 2, len(readCSV(t, filepath.Join(resultsDir, "round_trips.csv"))))
+	stats := readCSV(t, filepath.Join(resultsDir, "stats.csv"))
+	assert.Contains(t, stats, []string{"start", results.Stats.Start.Format(csvTimeFormat)})
+	assert.Contains(t, stats, []string{"duration", "86400000000000"})
+	assert.Contains(t, stats, []string{"num_trades", "0"})
+	assert.Equal(t, [][]string{{"name", "value"}, {"Long", "true"}, {"Size", "10"}},
+		readCSV(t, filepath.Join(resultsDir, "options.csv")))
+}
+
+// 未设置输出目录时委托和开平仓记录来自内存
+func TestStrategyTester_ResultsWithoutOutputDir(t *testing.T) {
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+	data := testData(start)
+	strategy := &holdStrategy{Size: 10, Long: true}
+	ex := exsim.NewExSim(data, 1.0, -0.00025, 0.00075, 1.0, false, false)
+	b := NewBacktest([]*dataloader.Data{data}, "BTC", start, end, strategy, []ExchangeSim{ex}, "")
+	b.Run()
+
+	results, err := b.strategyTesters[0].Results()
+	if !assert.Nil(t, err) {
+		return
+	}
+	if assert.Equal(t, 1, len(results.Orders)) {
+		assert.Equal(t, strategy.orderID, results.Orders[0].ID)
+	}
+	assert.Equal(t, 1, len(results.RoundTrips))
+}
@@ -6,7 +6,9 @@ import (
 	"github.com/rakyll/statik/fs"
 )
 
+
 func init() {
-	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00Z1\xc3P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00ReportHistoryTemplate.htmlUT\x05\x00\x01\xed>\xd7^\xec\x9a\xddo\x9bH\x10\xc0\xdf+\xf5\x7f\xd8\x12\xdd=\x15X\xbe\xfcA0wm>.\x95z\xd7(qt:]\xeeam6\xf6J\xc0\xd2e\x9d\xc4\x8a\xf2\xbf\x9f\xf8r\xb0\x17\xcc\xe2\xa6R\x1f\x9a<\x18\x96\xd9\x99\xd9\xdf\xcc,\x03\xb6\xf7\xee\xf4\xcb\xc9\xf4\x9f\xcb3\xb0\xe4Q\x08.o>~\xfet\x02\x14U\xd7\xff\xb6Nt\xfdtz\n.\xa6\x7f~\x06\xb6\x06\x0d0e(N	'4F\xa1\xae\x9f\xfd\xa5\x00e\xc9y\xe2\xea\xfa\xc3\xc3\x83\xf6`i\x94-\xf4\xe9\x95\x9e\xa9\xb2\xf5\x90\xd2\x14k\x01\x0f\x14\xff\xed\x1b/\x1b\xf3\xdf\xbe\x01\xc0[b\x14\xe4G\x00x\x11\xe6\x08d:T\xfcuE\xee'\xca\x9c\xc6\x1c\xc7\\\xe5\xeb\x04+\xa0<\x9b(\x1c?\xf2\\\xed1\x98/\x11K1\x9f\xdcL\xcf\xd5\x91R)\xe2\x84\x87\xd8\x9f2\x14`pARN\xd9\x1a\\\xe1\x842\xee\xe9\xc5\xb5\xba\xc5\x18Ex\xa2,p\x8c\x19\xe2\x94\xd5\x0c\xcdC\x82c\x0e8f\x11\x89Q\xb8\xd1\x9f\xf2u\x88A\xe6U\xe9\xcc<M7\x17\xdf\xa9jq\xf4{\x84\x03\x82@:g\x18\xc7\xe0\xa9\x18\x04\x80\x07\xe0	\xdc\xd1\x98\xbb`\x94p\x00\xa6hI#\xf4\xfe\x03#(<\x06\xcf\x1b\xb1\xe5F\xcc\x80	o\x16{\xde\xb2\x940\x12\xf3FC\xc36\x05[v\xc6\x1df\xb4(\x0d\x10\xc7\xe0	D)U\xe3U4\xc3L\xbd\xa3,B\xdcU\xfe\xc8\xf9\x85\xe0\x14q\xac\x1c\xd7\xa6dkl\x9cr{t\xfb\xfe\xf6\xe8\xf6\x08\xdej\x10\x1eo\xac\xcch\xb0\x06O\x11b\x0b\x12\xbbF\xf2x\\\x8e\xeb\xba\xaaV\x8c\xf5<\x02E\x06\xe9e\ny\xd9\xc4\xec3 \xf7\x00\x85d\x11O\x949\x8e9fy\xcaq4\x0b1\x98\xe30L\x134'\xf1b\xa2\x18J~\x9e\xa0 \xc8\xcf-\x05\xcc(\x0b0\x9b(\xb0\x0c\xa7\xc7\x99\xa8\xab\x00\xe7\xf1\x00\xcci\xa6-\x9e(\x86\xa5\xf8\xb9\xe1\xdc\xb1\x89R\x06\xce\xde\x10U|o\xd6\x92\x913\xdf\x9b1\xdf\xd3\x03r\xef{:/\xcb\xc1\xd39+\x8f\xde\xa9j\xcd\x8f\x10\xdf\xf1\x17/\x80\xc7\x97/nX\n\x88\xe9\x03CI%\xcc\xc8b\xc9\x95\xca\xab\x07\x12\xf0\xa5\x0bL\x13&\x8f\xc7`\x89\xb3\x8b.\xc8\xce\x14?\x8b\x9b\xeb\xe9|\xd9\xa2\xda\x80\xbb\xbasGdT{3\xdf\x84&\xd4\xa0\xa3\x1960\x0c\xd7p\xf2Uo\x8c\xe5k\xad\xa2[[j\xe1}+\xf1\xcave\xcd(\xac5!\xac\x0e\x9a\xb4\xec\xc4\xb7!\x8cp;\x8c\xd7\x98s\x12/\xd2b\x11\x92akXK\x89\xb3\x16=\xff\xec1\xc1\x8c\xbb/\xfaZD\xb3hl\xe5\x837\xf3\x7f+\xa1\x06\xbd\xa168r\xbd\x8ef4<\xcc\x91l\xe5O\x85\x82gU\xf5\x1b\xdc:\xcc\xa7K\xcc\x08\x0d\xbe\xc1\xa7B\xc1\xeb\xfa\x84\x18\x8a0\xc7,=\xdc\xaf\x17\x1d\x07{\xf6#\x94\xc5\x15NW!\xef\xaa\x8a\xbe\x80?\xc5\x84\x13\x14\x82\xb3\xaf+\xc2\xd7m\x90+\x94\xdb\xd2\x02\xce}\x85G\xb8\xa4\x8d\x9ah\x0f\x03\xa7+\x86\xb2f\xa9K{%wp*4\xd8\xbe\xc2|\xc5:-\x17R=\x96TL\x00\xff\xfe\xf2\x9f\x9c\xeaL\xb2\x8f\xfaU\x0cd\xa9\xd5e_\x93\xdc\xc7\xd5\x1a\xfc\n.h\x18\x009\x88\xc2\x84\x1e\x0b\x16\xe6\xca\xa0m\x9c\xb4\x1fA\x93\x1f\xdb\xb7q\xc3\xb4\x93\xc7\xe3\xb6\x0dd\xdf\xccAv\xff\xff)\\\n\x0f\x7f\x0ca\xc3\xec#m\xf7\x116\xe0\xaetc\xb5\x89\xcd\xf3r\xef=\xcbt\xf2\x94\xeb\xbc\xe7|\xc9\xda\xf5\xed[\xce\xb2\xdb\x0d0[\xcciH\xd9D9:s\xce\xe1\xf9I\xe3>\xb0\xe3\x92U\xf5\xb2_\x12\x1c\x83)\x89\xf0\x9e\xba\xde\xf8\xd6!StI\x1dB\xd3u\xd2e\xebCDW1\xef\x10\xbadd\xde\xa9\xe8~\x01d\xe4.\x19\xbd#]\x06Oh\x14\x914%4\xee\x10\xfc\x88B\x14w\xda\xbcI\xf2\xc7O	\xf4\xd7\x1c\xf1.\x99KZ\xbc\xbdh\xdd(\xb3\x8e1\x7f\x1cT\x19}H\x9fk\xadt]\xa3hb\x93\xd7C\xb1\x88\xfc\x9a\xd6\xf9\x86\x8e\xca)Ge\xaf\xdc\xa6\xcc\x96k\xe1\x9aswo\x0b\xf8\xbdk\xf4\x14\xa3\xf0g\x89\xfe,\xd1\xefT\xa2\x01F\xa1\xfa}\xea4W\xfd:eZ\xbd\x15\xcc2\xfd%\xb5\xcf\xf3?e\xa7'\xadA0\xa11\xd2\xe0@\x83\x06\x80\x96\x0bG\xaec\xe5\xeak\"\x06\xb4L\xe8\x8cG\xbb\xe3\xbb\xe7\xb3*\x80\xfb\xc5\xfa\x9eC\x0dB\x991\x07\x02\x08{](\xce\xb7\xf6\x88\x1a\xb9a\xf6\xdfE\xce05h\x018vm\xc7\xb5l\x91\xdch\x0c\x07\xcepw\xfc\xe6\xfa\xf4\xe4\xe2|w4\xc5a\xb8;Fbq\xe9f\x03\x8e\xf1x0\x18\x0b\xe6\x8d\xf1\xc86\x1c)z\xb2c\x07\xc2\x94J\xc3\x17\x98c\x17\x1a\xcd0\x87\xa6\x1c\xcc\xd9j\xbd;DW\\\x1e\xa6\x10\xb3\x02\xe6\xc0\x91\x81\xd4<f\xb7\xc1\x14/\xbcff:\xd0u\xc4\xd4(`\n\xb5.\x0d\xb3Gb:B\xc8J\x96\x02cy\x96\xad\x89\xd9\x97e\xbf\xc4t\x8c\xd6*\x1f\x0e\xe4X6Uy\xaf\xcc\x14\x0b#\xa79\x14\xc6e\xc9\x19\xdaH\x98\x9b\xd345S\xb8\xf0\xaa\x99i\xb9v\x0bM\xd1#y\x9a}RS\x08Z	S\x18\x97\x85\xd9\x9a\x9a\xfda\xf6LM\xdb5\xc4B+`\x1e^\xe6}2\xd3\x11\xf6\xc6\x12\xa6\xd09\xc8\x82k2U\xc2\xb4{\xc2\xec\x99\x99\x03\xd7n\xd93G\xc2\xb84\xcc>\x89)0+X\x8e^\x7f\xcf\xec\xcf\xb2gb\x0e](\xdeMs\x96c\xc9\xc4\xfc\xe6=S\xecis\x9a\xe3o\xd83[hZ\xdd47\xcf\x0f\xf5\x96]\xfe\xa5\xf1HxN\xb6\xf6\xbd>\xcd\x1es\x8a\x15\xec}\x12\x92\x10)\x96\xb6W\xa4\x86`\x8f\x9c.>\xbe\xc8}\x8dx\xf0\x97P\xaf\xab\xd3\xd3\xf3\xef\xfa\xf3\xa3\x80\xdc\xe7\x9f\xd5o\x03\xf2\x9f\x8d\xf8\xff\x07\x00\x00\xff\xffPK\x07\x08\x13O\xcc9\xc9\x05\x00\x00\xe8\"\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00Z1\xc3P\x13O\xcc9\xc9\x05\x00\x00\xe8\"\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb6\x81\x00\x00\x00\x00ReportHistoryTemplate.htmlUT\x05\x00\x01\xed>\xd7^PK\x05\x06\x00\x00\x00\x00\x01\x00\x01\x00Q\x00\x00\x00\x1a\x06\x00\x00\x00\x00"
-	fs.Register(data)
-}
+	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\xfd9R]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00CompareTemplate.htmlUT\x05\x00\x01/r\xd4jtTMo\xe36\x10\xbd\xfbW\xcc:\xd8\"Y\x84\x96?\xf6\x0b\xb2b`\xebu\x80\x1e\x16\x0d\x12\x03EQ\xf40\x12\xc7&7\x14\xa9\x92\xe3X\xae\x91\xff^P\xb2\xe3\xc4v\xa3C\x88\x99\xf7\x1e93o\x9c\xbd\xfb\xfe\xfbt\xfe\xe7\xdd\x0c\x14\x97f\xd2\xc9\xf6\xff\x08\xe5\xa4\x03\x00YI\x8cP(\xf4\x81\xf8\xa6\xbb\xe2\x85\xf8\xdam3\xac\xd9\xd0${'\xc4v\x1e\x8f\xcfBL\xb2\xa4\x8d6\x80\xc0\x1bC\xc0\x9b\x8an\xbaL5'E\x08-\x17\x00r'7\xb0]8\xcb)\x0c\xfa\x15\xc3\x1c\x95+\xf1\x1a\xbey\x8d\xe6\x1a\x02\xda \x02y\xbd\x18C\x89~\xa9m\n\xc3~U\x8f\xa1p\xc6\xf9\x14.F\xa3\xd1h4\x1a?w\xa2\x1c\x80\x1a\xb4r\"\xe8\x7f)\x85\xc1\xc7\x8a\x0f\xcc>\xf4\xe1cUC\xff\x00\x1f\xbe\x85\x0f^\xc3\x87\x0d\x16\xbe\xbea\xf4\xc2*o\xaa\x83\xed\xfe	_\x9a\xbf=O\xe4\x8e\xd9\x95)\x0c>W\xf5\xcbE\x17\xb1y\x0c\xdb\xb5\x96\xacb\xad\xfd\xf7\x91P\x8b}\xe0s\xbf)+w^\x92OaP\xd5\x10\x9c\xd1\x12.\xe8S\xfc\xc6\xb0\n\xe4E C\x05\xa7`\x9d\xa5\x17q\xc6<\xbe\xa7\xe5\x8a\xc2\x19\x83U\xa0\x14\xf6\xa7\x03P^\x03+\xd8V(\xa5\xb6\xcb\x14FU\x1d\xfb^\x8fa\xad4\x93\x08\x15\x16\x14\xd5\xd7\x1e\xab\x03M\xc16\xc7\xe2q\xe9\xdd\xca\xca\x14.f\x9fn\xfb\xb7\xd31\xc4\xc1\x895\xe9\xa5\xe2\x14rg\xe4\x81\xe2S\xcbJ\x14J\x1byIOd\xaf\x80\xe5\x91\xca\xed\x97\xf8\xbdPz\x1e\xed\xa3\xb6\xcb\x06\x18\x9d\"\xd0\xe8\xa5M\xc1G\xfds\xb0^D\xbd\xc5\x1aZ\x9c\x83\xfa^N\xb6P%\xfa\xc7F\xfextM!\x8dSS\xd0\x8cF\x17\xadH\x964\xc1\xc6\xae\x8d\xc7\x9b1\x8a\x9f!\xda\xbc\x93%\xed\x86d\xd1\xc6qm\x06'\x8b\xa0\x06\x93N&\xf5\x13\x14\x06C\xb8\xe9\xee\xdd\xd3m\x91\x0f\x9b2w&\x8a\xc1/6\x0f\xd5\xb8\xbd\xe5\x8e\xbcv\xf2u\xf8\x1e\xed#I\xc87-\xe0\x07\xb1\xd7E\x04d\x89\xd4O\xbb;\xb4\xbc\xe96\x0f\xec\xbe\x84\xd5p\x12\xa9\xda.\xb3D\x0d'\x9d\xac\xf5\xca\xee5\xbb\xfe\xecW\xd9\xef\xd72c\xd5\xd0\xb2\x84\xd5\xeb\xd8\x9c\x02\x93?\x8e>\xb0G\xa6\xe5\xe68~\x87\x1eKb\xf2\xe18sR\xc3\xdb\xf4=\xf1\xca[\xf8\xeb\xfd\xdf\xc7\xc4o\xd6\xc2\xffg\x7f`\x0d\xdf=\xae\xa5[\x9f\xcd?(\xf4\x15\xc1=\xb2v'9\xe7Y[w>9ES\xa2?\x9f\x9b{\x94tR\xe1\x1f\xdaF8\x9d{\xc6\x9dw\x0b\xcdp\x8b\x05\xbb\x93fN]Y\xea\x10\xb4\xb3\xc7\x99Y\xad\x19f\xff\xac4\x1f:\x9d%\xbb\xa15\x1d\xdd\x8dSx\xb7\xde\x1b\xb4\x19\xf7\xa4\x93\x85\xc2\xeb\x8a_\xff\x0c\xff\xc4'l\xa3\xed\xf8\xa7\x9e\xeai4O\xafpe\x85\x9e.\xa5+V%Y\xee-\x89g\x86\xe2\xf1\xd7\xcdo\xf2r\xe7\xb1\xabkH>lw`!\x91\xf1\xf9CbW\xc6\\\x8d;Y\xd2J\xc7\x1d\xd9-G\xa2\xb84\x93\xce\x7f\x03\x00PK\x07\x08\x1a\x96\xe7\xed\x08\x03\x00\x00l\x06\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe79R]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0e\x00	\x00ReportChart.jsUT\x05\x00\x01\x03r\xd4j\xdcYmo\x1b\xc7\xf1\x7f\xafO1\x7f\x18\xf9\x1fY\x9d)RO\xb6Na\\[\x96\x1f\x00'\x12l\x19} \x88by\xb7$\x17^\xde\xb2wKK\x8cB\xc0/\x12\xd4\xb5U\xc75\x9c\xba\xb0\x93\x02\x0d\x1c\xd4\x0dP\x04-RWm\x1d\xe7\xcb\x88\xa4\xfd*_\xa1\x98\xdd\xbd\xe3\xdd\xf1,\xd9F_u_\x88s\xfb0;\xb33\xf3\x9b\xd9\xd5\xdc\x1c\xac]^\xff)\x0c\x1f\xfda\xf4\xf7\xdb\xa3[_\x0d\x7f{k\xf8\xe8\xf9\x8b?>\xf9\xe1\xd9\xde\xc1w\xdf\x8f\xef?\x81\xf5\xb56	d\x08\xe3\x7f\xff~x\xf3\xe9\xcc\xdc\x1c\x0c\x7f\xf3\xd9\xc1\xfe\xd7\xe3\xbf\xfcn\xfc\xd9W\x0e\xac\x05tGM)\x05\xd4\xf7hP\xa0\x9cv\xa8/m\xf0\x88$E\\\x80\x84\x03\xbb\x92uh\xe8@\xad\x13\xd6m\x10]\xea\xcf\xb5Y\xab=\xc7\xc5\xf6\x9c\xcbEH\x1d\xa8\xd5m\xa0\xbf\xec1\xd9\xd7\xb4\x17\x90mOl\xfb\xfa\xabA8\xf1]\xc5\xa2V\xaf\xdb\xd0d\x9c\xe3\x87bl\x83\xc7\x02\xeaJ&|\x1b\xba\x01s\xa9\x0d\xa4#z(\x08\xddq\xdb\xc4o\xd1A}\x80\xe2\x0c\x1f?\x8c\xe5\x1f~\xf3\xcf\xd17\xf7\x93Z\xb8\xa2\xd3%\x01}M5\x1a\xd4w\xdb\x1d\x12\\\xd3\"\x864`J\xc0]\x9f\xa0L\x01\x95\xbd\xc0\x0f\xa7\xb5AQ\n\xcd\x9e\xaf\x04\x86B\x8b\x8b\x06\xe1E\xd8\x9d\x01\x00\xb0z!\x85P\x06\xcc\x95\xd6\xea\x8c\xea\xbaN\x02X\xdb\xb8\xb4q\xf9\nT\xa1f\x1d[Z<Qv\x97-\x1b\xacc\x94.//kr\xa5\xe2\xba'\x96\x14\xd9$\xee\xc9\xa5\x93\x8a<\xb1\xe0\x96=\xaa\xc8\x85\x06\x99?1\xaf\xc8\xa6{rqI\x93+d\xb9\xdcX\xb4\xea\xab\xf1VW7\xa1\n\xd6\xb1\xf9e\xb2\xbcB\xacI\xff\xd9\x8d\x9f|\xa0Fhsia\xa9\x9c\x18\xd9<\xfd\xc1\xfa\xa5_\\X\xbfx\xfe\xc2\x16T\xa1\xb2\\N\x8c]\xbe\xb8\xb6>\x19[\x98O\x8c\x9d?\x8d[-&z\xb66\xb2=g6\xb6\xb66\xde\x87*\x9c(\x9b\xe3\x88O\xaeK\xbc\x82\x1f\x9d\x1b6}\xe2\xe0\xc3\xbbP)\xc3)\xb0\xca\x16\xcc\x82\x0f\x0eX\x8a\xd0\\\x07\x196M\x11t\x88\xdcb\x1dZ\xe8\x84Iv\xb8\xbd\x07U\xf0\xe96\x9c%R\x0d\xaff7\xf3J-*\xcf\xf58\xff\x19%A\xa1\x08\xb3`\x1d\xc7\xcdP85\xf6\xbe\xf0e[\x0dTrF\x15\xdf\xa2\x1a\x00\x0bfc\xee\xd8b\x16\x17D/\x08\xcd,'\xcd\x9c\xf9=Iq,_\xb7.\x0d\\\xea\xcb\xc2u\xc2{4\xcc9+3\x02\x1f}\x04\xb5z\xb1\xd4!\xdd\x84g^O.H,\xea\x92 \xa4\xe7\xb8 \xb2P\xb8\x0e?\x82J\xb9\\,Iq\x8e\xedP\xaf\xb0\x18\xc9\x82mPL\x9d\xf9\xdc\x1c\x8c>\xbf1\xbc{\xe7`\xff\xc6\xc1\xfe\xd7\x80\xc1\x1b\xd6X\x1d\xde\xad\x82\x84\xf1\xc3\x8f\x87\xff\xfat\xf8\xe9?\xd2:\x84\x94\x04n\xfb\xa2\xef\xd1\x9d\x82Z`\x83L\n\x86f\xe2\x02\xaaP\xb6\xa1\xcd\xa0\xaa\xb9\x968\xf5[\xb2\x0d\xc7\xa1bC@\xc3\x1e\x978e\"\xdav\x9bq\n\x05.p\xf36\xcb\xaa\x8a\\;\x0c\xcd\x8fSf\xd5\x8c\xf7\xde\x83\xca\x84\x016\xd6\x04-S\xad\xc3<\xadF\x96\x11\xb6x\xff\x0e\xf3\xd2\x0c\xb0)\xe1q\xaf\xd9,\xfb\x01P\x1e\xd2\x1c\x86JO\\r|j\xc9\xcc4e\xe2BK\x915\xc8\xc1\xfe\xad\x83\xfd\xdb\xa3;\xf7\x867\x1f\xa0\x05\x14@\xbe\xfc\xe2\xcb\xd1\x17\xdf\xff\xf0lo\xf8\xc9_\xc7\xf7\x9f\x8c\x1e<}\xf9\xe0\xdb\x17\xdf};\xbc\xb77~\xf6\xe7\xd1\xfd\xe7i\x13q\xd2\x17=9\x81M\x97H\xda\x12\x08\x876\xb4)k\xb5eh\x03\xa2bhG.9\x15i\xa2\xabXU3\xda\x12\x9fu\x08\x8e8\xd0$<\xa4vj\x94\xd3\x16\xf5=\xcc/\xa2\xeb\xa0\x03\xc8~\x97:`\x85n 8\xb7\x06\xe9\xd9R\x08.Y\x17\xa7\x07\xac\xd5\xa2\x81\x03\x16\xd9a\xa1e\x03\xfel\n\xe6K\xec\xdc5\\\xdc@\x84\xa15\xc8pIO\xe5\xccG\xfc\xdf\xdd9\xbd\xc3B\xe5\xa4\xc8\x14\xf7\xaeg\xd6\xb5\x02\xe6\xa9d\x90\xeaU\xeb\xa6\xbb\xfb\xf9\xdd\x98M\x7f.DgzA\x9c}\xeaq\xf7`\xe2\x19\xe8\xcdRt\xa1\x8a\x08\x9b\xeef(3\x0d1\xb5\x98$\x80\xcdX\xad\xd4\x14\xc1:q\xdb	P\xd0#6\xe4F\x0c'!\x86\x19\x83j\xb5\x1a\xf3\x98D\xe2\x84?6m\xf1\x12\x9eK\xa9\xdb\x0b\xdb\x85]N\x9b\xd2\x81\x95\xb2\x0d\x01.u`\x11M\x8a\xa6\x95\xa2\x1b\xb9\x92c~#l\xc9\xb0S\x07j\xf8\xa5\xb6\xc3\x16\x19V\xfbg\xdf\xb2\x95U\x8c\xd9\x98m\xaa\x95\xa4\xfb6D\xcf\xf7H\xd0?OP\x8a\xa0\x97q\xc0\xc8!.\x91\x06\xe5\x0e\xec\x86m\xb1\xed\xa8c\x18h\xa7\xdab\xee\xb5t\x7fj\xfd+\x94\xe8\xbf\x86\x12\n\xb9\xa74\x08]\xc2\xa9\x91T\x85\x9c\xa3\xfe\"\xca\x1e.\xb9N\x83\xca\xff\xa3\x10Ed>\x05\xd6\xae\xdai\xf0\x8e\x05N\xfca\x1d\xae\x86q*m\x06\x96\xd1\x11\x1dq6r\x0f\x98\xc52`u&\x8f\x93\xb1h\xe4\xf4\xc6\xa8\xc6\x86\xcc\x0f\x99\xaal\x92\x91g\xf6}m&!g\x1e\x0d\xf2\x99\xd8\xd0\x10R\x8a\x8e\x03\x95r\x92\xa1A\xb9R(\xfb\x9c\x96\x8c\x16U(\xa0Z\xc7Q\x19\x985U\x8bJ\xeb\xdd\x1dk5\x8b\xc4Z\xa6\x14\x12\xc7\x01\x86\x95\xe2\x04I\xf5\xccd\xa8a(\xbbXsC\x15t\xe9X\xa2\xea;,1\x9f\xc5 \x9c\x10X\x8d\x96B*7\x14\xb3\x82\xe19\x99`\xd8\x10\xcf[\xbfN}y\x89\x85\x92\xfa4(X\x01\x0d\xd9\x87x\xc6\x93\xf0\xcfF\xbdk\xca\x7f\x9cXH\xf0L\x9e\x98\xc9?jj\xbe\xd2\xb9\xd7\x87\xc4N\x98g\xff\x0f\xfd\x00\x0b\x15E\x94T\xda\xc5\xcf\xc9W\x844\x08>\xe5\xac\xa0Z\x88\x84\x801\xa5N4\x0ey\xa8&9\xaa\x82(.\x10\x13:\xa5R\xd6!\xf9/^\x80\xad\x96\xac\x86\xedT\xdd|\xd8W&rk\xd6&\xdeq\xb0|_W\x17&\xa4\xce\x9a\xeb\x12\xd2g\xf4e\xc9\xca\xae\xd3	\xd4\xe4Q\x03\x11\xea\xa3^\\\x9d\xc9\x06\x8cN(y@\x8ax\xe2@,D\x8c\xa9\xbe\xc7i(\x99{-\x13Oe\x1b\xfa\xa9\xcf\x94Tx\xd8\xce\xd4\x91\xc7\xfe\x96\x97i\x12>US\x0b\xf1B\x89\x00\xa7\xd9\xa8\x0b\xe5\xe4\x93\x8b\xed\xc9\x07\xde:k\xac\x9e\xc8t\xd8\x06\xc5\xb4LL\xd2\xce\x15\x0co\x07v]\xc1E\xe0\xc0\xd5M\x1b\x14Yv\xd4M\x08\xd1!\xf0h\xb06\x19Nt\x98I\x83\xdcp\xa8Y\x8d\x9e\xb2YH9\xb7\xea996\xbe\xcef\x9d\x18\xbd\xae\xd1\xeb\xa3\x8fFS\x94\xb7+\x8ei\x9d\x8e4\xe3\xc4\x94\xc8\xf1\x14Xgz}\x05\xf1WP\xacD)\xa5\xb2\xc2\x1b\xd9\x14[\xd8\xef4\x04w\xc0\x92\x01#~\x8b\xa3\xbf\xea\xbe+\xecC\xea\xc0J\xf4yYH\"c)\xca\xe0@\xe5d\xc6G^a\x13\xbd\xe2\xea&\x98\xd3\x9e^\x94W\xf1\xa1q\xb3\x95!6\xf4\x1d\x07\n\xf8SR\xcf\x0b\xd1\xa5\xa8\xc9\xb8\xa4A\xc2<\xcd\xacY2@\xd7,\xa5\xad\x13\x7f\xa5-\x84m\x90\xbds\x1d\xc5;\x7fcl*1;PK\xde\x97&aeCSAf\x11\x89.\xe2G\x06\x1d\x92MGx\xe2bl\xd6b>\x83cx\x03m\x96\xa2\x87\x95\xe8\xea\x9a\xf0\xc8\xa8\xa7Y\xd2\xaf0\xaa\xe3\xc7\xa6K\xed\x9d\xbbs\xa2^\x8d\xda\xa0\x98\xeaJFQ\x92>\xd2\xd7\x0dd\xc5pi|\x9b3?[AT\xd2`U\xb1\x01K\xb7+\xc6\x99s\xae 	\xfc\xd2\xcfW\xf1\xc6o#a\x02\xc6_-\xe3|Z\xc6\xf9#e$\x01%Q\xec\x88.q\xd5#[\xb9\xb40\xb0\xf3\xa0.'\x96P\xbd\xb8.\xd4^\x15\xbd\xcfM,\x94\xd4W\xcf\x89^\xed\xe2H\x9aB\xbahF\x0e\xca\xbf\x01\x80E\x19O\xfb&;\xcc\xbe\x0b\xe9\xb3[8\xf2\xec&\xd0\x10\xc9\x9a\x1aN*\x9d\xa4\x0d\x14\xe4Vs\xf95P\xfe\xe3\xe3\xffD\x11tX\xe1\x93\x81\xa1\x9auY\x9d\\\xaa\xa6\xa9\xdbP\xd3\xe5\n\xfe}\xabj\xe5L\xaf\x0f\xff\x0f\x17\x04\xf7\x0e\x0d\xad\xa9Z\xe5(\xf7@\x17\x8b\xe2\xc7x\x9dG\xc26\xf5\xac\xfc\xe0\xb2\x8e\xad\xa8f\x1d\x1da\xf1\xfbr~\x88\xe1k\xf6\xdd\xbd\x83\xfd\x1b\xfa5{\xfc\xf0\xe3\xd1\xfd\xa7\xe3G\xb7\xc6w~5\xbc\xb7\x87\xcf\xfa\xf7\x1e\xebw\xfc\xf1\xa3\xfd\xe1\xdd\xbd\x97_~\xfe\xe2\xd7\x7f\xc37\x9dG\xcf\x0f\x9e\xdf\x1e\xde\xdd\x1b=x:\xba\xf3\xa7\xe1\xcd\xa7\x07\xfb\x8f_\xdc\xf8$\xdeE\x07\xaf.\x00_\x1d\xbaz<'p\xb1H\x89a\x05\xaaq	\xa5\xdf\xb0k\x0c\xde1\xa4\xa9\xd7\xeb\x83\xd5\xb7\x0d|3\x07\xc1\xf3\xbfj\xd5L\xc1\x11kcg\x90\xd0\xeco\x9e\xfa'v\xca\xa2\xc1k\xa5\x807V\xeb\x8ds\xd5\x1b\xaa5\x0d\xf1Y\xbd\xde\n\xf1\xccu3\xfe\xb7K\xea\xcdO\xdf\x04\x1d\xf3;\x11\xdf\xa0\xa3\x13\x113\xa6b\x18\x14\x0b\xdb\xcc\xf7\xc4vqu\xe6?\x03\x00PK\x07\x08R\xf5R\xdc\xdf\x08\x00\x00\xd3\x1a\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe92R]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00ReportHistoryTemplate.htmlUT\x05\x00\x01\xd6e\xd4j\xecZmo\xe3\xb8\x11\xfe\x9e_\xc1\xd5\xa2E\x0b\x9c,\xca\xb6\xfc\xa2\xc8n\xb3y\xe9\x1e\xb0\xb9\x0d\x12o\x17E\xd3\x0f\xb4\xc5\xb5\x89J\xa4\x8e\x1a\xc7q\x83\xfc\xf7\x82\x92\x1d\xcbz\xb1(\x9f\xf7Z\xe0n\xf7KD?\x9cy\xe6\xe1\xcc\x88\x94\xe4\xbd\xbb\xfa|9\xf9\xc7\xdd5Z@\x18\xa0\xbb/\x1f>\xfdx\x89\x0c\xd3\xb2\xbev.-\xebjr\x85>Nn?\xa1n\x0b\xdbh\"	\x8f\x190\xc1I`Y\xd7?\x19\xc8X\x00D\xaee\xadV\xab\xd6\xaa\xd3\x12rnM\xee\xad\x05\x84A\xd7\n\x84\x88i\xcb\x07\xdf\x18\x9fy\xca\xfa\xf8\x0c!oA\x89\xaf\xfe@\xc8\x0b)\x10\xa4\x0c\x98\xf4\xe7%{\x1a\x193\xc1\x81r0a\x1dQ\x03m\xaeF\x06\xd0gHl\x9e\xa3\xd9\x82\xc8\x98\xc2\xe8\xcb\xe4\xc6\x1c\x18\x1b;\xc0 \xa0\xe3\x89$>E\x1fY\x0cB\xae\xd1=\x8d\x84\x04\xcfJ\x7f\xcb\xf8\xe3$\xa4#cN9\x95\x04\x84\xcc\xb8\x99\x05\x8cr@@e\xc88	\xb6\xd6cX\x07\x14)J\x1b&\xb38\xde\xfe\xf6\xce4\x13\xd3\x7f\x0d\xa9\xcf\x08\x8ag\x92R\x8e^\x921\x84\xc0G/\xe8\x9b\xe0\xe0\xa2A\x04\x08M\xc8B\x84\xe4\x87\x0b\xc9Hp\x8e^\xb7\xa8\xc5\x1b\xca\xc6\x11\x94\xa2^\xb3^\"\xc98\x949\xe9GP\xefcx\xd0E+\x8c}\x02\x14\xbd\xa00\x16&_\x86S*\xcdoB\x86\x04\\\xe3o\x89h\x01\xba\"@\x8dm\x00\xad0V\xb1\x95\xcex|\xff\xf8\xc3\xe3\xfb\xc7\xf7\xf8\xb1\x85\xf1\xf9\xd6\xc7T\xf8k\xf4\x12\x129g\xdc\xb5\xa3\xe7\xf3\x94\xa5e\x99\xe6FV+\xd1\\]xV\x9a/\x9e\x9a4>\xf3|\xf6\x84H\xc0\xe6|d\xcc(\x07*Un\x01\x99\x06\x14\xcdh\x10\xc4\x11\x991>\x1f\x19\xb6\x91\\G\xc4\xf7\x93\xeb\x8e\x81\xa6B\xfaT\x8e\x0c\x9c.\x9e\x07\xb2`)\xd1\ny\xe0\xa3\x99P\xb6\xf8\xc8\xb0;\xc68\xf1\x9aP\x1a\x19\x9b\x85\xea\xbe\xa9h\x8c\xbdiE\xeeM\xc7\xdeT\x8e=\xcbgOc\xcf\x824\xed=\x0bd\xfa\xc7;\xd3\xcc\x90\x08\xe87x\xa3\x80<X\xec8t\x0c\xc4\xc5J\x92hKX\xb2\xf9\x02\x8c-\xa5\x15\xf3a\xe1\xa2v\x1bG\xcf\xe7hA\xd5\x8f.RW\xc6X-\x95\xebY\xb0(\xb7l\xe3\xbc\xe9\x84\x86\x8eeo:n\xe36na\xa7ew\x91m\xbb\xb6\x93D\xbc\xf5\x95\xc4\xb9Y\xd1L\x98)\xf5*\xa9\xb7\x8e\xb7A\xd8I\x10%\xda\x81\xac4\x91[\xd4\x92\xc5\xdbUY\xb2x\x0f\x14\x80\xf1y\x9c\xd2\xd7Z\xacb\x14\x1b\x153k6\xbe~\x8e\xa8\x04\xf7\x8d}\x05\xd2\xc6o\x9c\x13\xf1\x15\xa5\xbfl\xa4\xf4\x9bIYB\xe2a\x1dNEp\x14	\x95\xa0/\xe9\xfcW\xd3\x1c\x17)\xe5\xcaHK\x94;*\x99\xf0\x8f\xe7\x93\xce?!\x1f\"IH\x81\xca\xf8hN;\x13\xc7\xb1\xdae\xc2\xff\xa8\x00\xeei\xbc\x0c\xe0p\xfe7\\\xe6\x1f9\x03F\x02t\xfd\xf3\x92\xc1\xbaB\xda\xad\x80\xfb\xe0\xbc\x88\x15\xab\x91\x14\x18\x03=\x0f\x19\xa4\xbe\xf9\xab\xa5$j\xafS\xc3~\x0b\xcb\x1b>Z\xbb{\nKY\xe75\x05\xe9\x07\x93\xe2\xd1?\xff\xf0/-\xc3\n\xd8\xc0\xf8\x92#M\xb5\xb2\xd0\x93)\xf6a\xb9F\x7fD\x1fE\xe0#-\xf1\nx\xfdP\x0bS5$-\x9d\xa3\xef\xf2\x82s}_\xfb\xe0\x93\xe5\xe4-yFW\x92\xac|\xb1\xd2\x898\x0f\xd7\x0fvo\xa6fN\x95\xce\xd1w\xf9w\x11\x10`\x01\x835\xfa\xd3\x05\xe7\xad?k\x04\x98\x99sJ\x99\x1f\x16DF\x14\xdd\xab\xa8k\x82\xceB\xf5c}\x10\x12\x18\x17z\x1e\xb2X}\x17\x97$\x08\x89\xd4\xf2\x90\x85\x9e\xac}&{\xf1\xb8\xc6s\n\xd2\x0f\xea+\xe3\x8a&\xd5H\x8d,T\xdf\xc1\x9d\x14\xdf\x18\xa0\x1b2\x03!k\xc8\xefaOv\xdb\xb9x\x9a\xa3\xaf\xac\xaeunP\xfa\x81\xa9	\x9fD\\\xb7 [\x98\xbe\xe1	\x0b)b\x1c\xdd\x12\xf9o\n\x1a\x0bS\x9cp\xb2[\xf6\xa5\x08C\x16\xc7\xf5\xadj\x07l\x10\xe8Rr\xf1D\xeb\xb2b\x0b\xd37|\xb3\xe4\xeat\x8c\xee\xf8\xa7\x1a\xdb\x19\xe4\xa1mN\x89\xbf\xfd\x93\xa4\xdd\xeeF\xcf\xe7\x15\xa7\xbbC\x13{\xeal\xfb\x1b\xc7*l\xbf\x01\x87\xef\x85\xb5\xdb\x0d\x0cw\x1b`m\x9c\x03\x97m\xa1\x0b\x0fk\x16\x07\x0fPm'z\xd6:\x03\x89%\xf7\xd1D\xb2h\xff\x1c\xb4\xa8#\x82\xa6\xf3\x99\x08\x84\x1c\x19\xef\xaf\x9d\x1b|sY\xd6+r\xa4:\xdb'(\xd7\x1c\xe4\x1a\xa9\xc6\xb4w\xba\xde\xcb,U\xdf\xc9\xf9\xa5\x1e\x96\x1e\xd6\x0f\x9bz`~\x8d\xb3\x07\xf6\x9f::	\xed;\xc9fu@\xc5[\x03\xa7\xf6\xc6\xaa\x13\xd5\x87x{q\xadZ\xfdas\xb77\x1a\xa0\xf4.z\x18\xb3k\xd7\x87q?Q@%\xf6vi\xa3\x1e]H\x95b&H\x16\x99R\xac\xe2\xd7f\x0f\xc7p\xa1\x92\xc7y\xab\x11\x0fL\x10@6\x8fk\x0e\x83go\xa1\xe9\xcf\xe1\x14*\x9cd\x0b\xa4\xb8p\xf9\xfc?\xf0X/W]\xa7.\xf3\xcf\xd2\xa7\xf2\xd7\xac\xf0\xcf\x11\xe5\x1ai\x9d\xf0:\x0c\xd1)\xee\xc9:\xaa\xa9\xc8\x8bP,yM\xd6k\x14\xac\xda\xaei\xc0NYc\x1fH@x]\xc3\xf9\x12%\xaf/\xea\x05\x7f\x00\x025\xb6\xeeD\xfa\xaek\x0f\xb5\xcb\x13U\x1b\xc9+\x85|5g\xc2,0}\xbbU\xf5+\xca9\xb5\xa8Q\x9c;S]\xa3\x8c\x1e\xc8bT\xff?uxEI\xf0{\x19\xfe^\x86\xa7)C\x9f\x92\xc0<}-&fOP\x8a\x9b\xb7\xc4\xea\xee\xb6\xdb,\xde$\xff\x8c\xdc\x93\xe1\x8c\x8cml\x0fZ\xb8\xa7\xde\xbb\xe3\x8e\x8b\x07\xae\xd3Ilg 6\xee\xb4\xb13\x1c\xe4\xc7\xf3\xd7\xd3m\xeb<\x0ckz\x8d[\x18\xeb\x8c9\x18a\xdc\xe8\x87\xd4j\xb6\x1de\x84\xeb\xab\xffu\xc2\xd9\xed\x16\xee <t\xbb\x8e\xdb\xe9\xe6\xed\xdbx0\xc4=\xa7\x9f\x1f\xff\xf2pu\xf9\xf1&?\x1a\xd3 \xc8\x8f1\x9e\x1f\xc1\xadv\x89\x1a\xc3a\xaf7\xcc\x0f\xdb\xf6p\xd0\xb5\x1d-\xf1t\xc7\x8e\xd3R+	wZ\x0e]l\xe7I\xa7Z\xf6\xdbzZN\x97\xeb<P,A_\xcb\xc2\x92\xa5Z\xf6\x9c\xfcx\x99\x14\xe5c\xdd*-\xbb\xdf3/\x1d\xec:\xc5\xc4H\xb5\xec\xe8\xe5e\x89\x96\x0d\xd2\xd2iWH\xd9\xd7\x93MO\xde\xe3\xa4l\x96\x96\x8e]Y\xe2\xfd\xde\xf1%\xde(/\x8be\x91\xe4e\xdf\xd6\xcb\xc1\xa2pvkP\x98\x9b\x88\xd9n\xb5\xed\xef\x99\x97\x1d\xb7[!\xe6\xc0\xfeU\xfa\xa5\xd3\xab\xd0\xb2\xa7W\xcfzu\x7f\x9c\x96\x0d\x13\xb3\xeb\xda\xc5*K\xb5<\xbe\xc6\x9b\xe4\xa5\xe3T\xe4\xe5@\xaf7b\xac\xd3O6Zv\x9bi\xd9\xec>\xee\xf4\xdcnE\xbf\x1c\x0c\x8f\xd6\xb2I\xbf,H\x96\x96\xf8\xe0\xf4\xfd\xb2\xb1\x94\x0d\xd3\xb2\xef\xe2\x02\xe94-\x87\x9ai\xf9\x8b\xfbe\xc1O*\xe6\xd0>VL\xbb\x04\x97\xe4e\xa7VL\xd3,\xec\xd3\xf7o\xe2\x85s\xc9n\xc7?0*\x1e\xeeV\x1eyR\xf2\xd3_\x84Hc:d#\x13z5l\xcb\xa0\xa0\xc4!\x05\x8e\xfc\\\xe9\x84\x06=+\xf9\xe2s|\x96~Zy\xe6Y\x9b\xafC\xad\x05\x84\xc1\xf8\xbf\x03\x00PK\x07\x08sD\xdb\x9e\x1d\x07\x00\x00\xd3,\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xfd9R]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x13\x00	\x00ReportTemplate.htmlUT\x05\x00\x01/r\xd4j\x94UMo\xe36\x10\xbd\xfbW\xcc:\xd8\"Y\x84\x96?\xb2\x1f\x90\x14\x03[\xd7A{hkt})\x8a\x1e(qlqC\x89\x049\xb6\xe55\xf2\xdf\x0bR\x92\xbd\xb6s\xe8F\x80B\xcc\xcc{3\xc3y#\xa7o~\xf9s\xb6\xfc{1\x87\x82J5\xed\xa5\xdd?\xe4b\xda\x03\x80\xb4D\xe2\x90\x17\xdc:\xa4\xc7\xfe\x86V\xecS\xbf\xf1\x90$\x85\xd3\xf4\x0dc\x87\xa5?\xbe06M\xa3\xc6\x1a\x02\x1c\xed\x15\x02\xed\x0d>\xf6	k\x8ar\xe7\x1a,\x00dZ\xec\xe1\xb0\xd2\x15\xc50\x1a\x1a\x82%/t\xc9\xef\xe1\xb3\x95\\\xdd\x83\xe3\x95c\x0e\xad\\%Pr\xbb\x96U\x0c\xe3\xa1\xa9\x13\xc8\xb5\xd26\x86\x9b\xc9d2\x99L\x92\x97\x9e\xa7\x03(F\x0d\x1ds\xf2\x1b\xc60z0tB\x0ea\x08\x0f\xa6\x86\xe1)||\x1e>\xfa>|\x1cb\xe1\xd3\x19b\xe06Y\xe8\x0e\x0e]	\x1f\xc3_\x87c\x99&\xd2e\x0c\xa3\x0f\xa6>&\xba\xf1\x97Gp\xd8IA\x85\xefu\xf8\xd6\x03j\xd6\x19>\x0cC[\x99\xb6\x02m\x0c#S\x83\xd3J\n\xb8\xc1\xf7\xfeI`\xe3\xd02\x87\ns\x8a\xa1\xd2\x15\x1e\xc9\x89g\xbe\x9e\x06\xcbr\xad\x147\x0ec\xe8N\xa7@q\x0fT\xc0\xc1p!d\xb5\x8eabj\x7f\xefu\x02\xbbB\x122gx\x8e\x9e}g\xb99\xc1\n8d<\x7f^[\xbd\xa9D\x0c7\xf3\xf7O\xc3\xa7Y\x02~pl\x87r]P\x0c\x99V\xe2\x04\xb1qE\x05\xcb\x0b\xa9\xc4-n\xb1\xba\x03\x12\x17,O\x1f\xfds\x84\x0c\x9e\xb7@\"^I\xeb\xa8\x01^]\xf1E\xa8\xe2]\xe4=\x0c\xc8r\x81.d\xf12c\\\xc9u\x15\x83\xf5\xc5\x9d\x80\xb9V\x9b\xb2rp\x10\xd2\x19\xc5\xf71\xac\x14\xd6Ix3\xdft\x0c\xfe\x9d\xc0\x9a\x9b\x18\x1e\x86\xdd\x08\xd3(\xe88\xe86\x88=\xcc\x93}u^\xef\xbd4jV%\xf5z\xf6\xfb3\xba\xda\x88b4\xed\xa5Bn!W\xdc\xb9\xc7~'\xa3~\x13\xf9e_fZy2\xf8\xa9\xca\x9cI\x9a,\x0b\xb4R\x0boN#!\xb7-\x85\x14\x8f\xfd\x90\xbf\x7ffn\x99\xdb\x16\xdb\xfd\x0c\xee\xa6\xf9\xb4\x18O\x17\xdc\xf2\x12	\xadK\xa3b\xdc\xada\xda(\xa8%x\xde\x1e\xf7\xb3\xebV\x1b\x92\xbabV\xef\x9a\x86[X\x14p!\xb8-\xc4[/R\xfe\x85n\xa3\xe8\xc7\xf29\xe2\xe4\xfeG\xba\xae}\x9f\xc6k\x13\x96V\x9a6\xd5yS\x8d<\xba\x8f\x96=URL\xe7\x15\xd9=,e\x89iD\xc5\x99\xa7\x96\xf4\xaa\xa3\x19\xd7\x95U\x8a+\x8a/\xf2\xdb5mH\xb8\xb02\xbfv\xf9\x8c\xafz~\xd5\xca\xef\xec\xab\xe5\xfc\xfey\x0e\xff\xbc\xfd\xf7\xca\xfc\xf4\xaaya\xf5J\xd2\xa5u\xa6\xcbR:'uu\xe9\xf9\x03	.0i\xd4^aPG\xf8,0\xb2\xd2\x9cFv\xd4F\xear+\x0d}\xff\x0b\xf0\x95oycm\xe61\xb3X\xcf\xbc\x9e\x07\x16+\x81\xf6V\xe8|SbE\x835\xd2\\\xa1?\xfe\xbc\xffM\xdc\xb6\xaa\xbf\xbb\x87\xe8\xdd\xc1\xa2\xd1\x96\x98\xe0\xc4_\xdeE\xd5F\xa9\xbb\xa4\x97F\x0d\xb1\x97F\xbb\x8cQA\xa5\x9a\xf6\xfe\x1b\x00PK\x07\x08\xadv\xd2F#\x03\x00\x00\xe5\x06\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xc0<R]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0e\x00	\x00echarts.min.jsUT\x05\x00\x01Xw\xd4j\xac\xbd\xeb\x96\xdb6\xb20\xfa\x7f\x9eB\xcd/\xe1\x00\"\xa8\x96\xd4vf6%\xb4\x96\xe3\xcb\xa4g\xdcv\x92v&v\x14\xed^\x10	I\x88)R!\xc1V\xcb\xad~\x92\xf3,\xe7q\xce{\x9cU\xb8\x90\xa0$'\x9e\xbd\xbf\xe5\xe5\x16\x88K\xa1P\x00\n\x85\x02Pu\xde\xfdK\xb7\xf3Z\xc4<+y\xd2\x91yG\xaex\xe7\xd9\x86\xc5+\xde\xb9\xc9\x17r\xcb\n\xdey\x95WY\xc2\xa4\xc8\xb3\x0ezv\xf3\nw\xaa,\xe1E'\xcf\xf8_\xba\x9d\xbc\xe8\xac\xf3\x82w\xe2<\x93\x85\x98W2/:\xa9\x06\xd8a\xcb\x82\xf35\xcfd\xd9\xebtn8W\xd0\xdf\xbc}w\xf5\xfceg!R(\x9e\x88R\x17\xe3Ig+\xe4\xaa#W\xa2\xecl\xf3\xe2cg\x91\x17\x1d\x96$\x02*fiGd\x8b\xbcX+4\xfe\xd2\xed\x14|\xc9\x8aDd\xcbN\x9cov\x85X\xaed'\xdff\xbc(Wb\xd3\xebt\xdeA;n^YTJ\x0d\xd7T*\xf3\xce.\xafL3\x9c\x16\x1bB\x90\xce\xbfyQBs\x87\xbd~\x07\xc9\x15\x94\xf1L\xa2\x87G\xaa\xf0\x9a\xed:Y.;U\xc9\x1b\xd8\x1d~\x1f\xf3\x8d\xec\x88\xac\x13\xe7\xebM*X\x16Ca\xd3\xb2\xba\x86^\xa7\xf3\xc1\xc0\xc8\xe7\x92\x89\xac\xc3T;:\xf9\xc2\xcd\xd6a\xf2/\xd0A\x9d\xceJ\xcaMt~\xbe\xddn{L\xf5N//\x96\xe7\xb6q\xe7\xaf\xaf\x9e\xbf|s\xf32\x1c\xf6\xfa\xaa\xc0OY\xca\xcb\xb2S\xf0\xdf+Q\xf0\xa43\xdfu\xd8f\x93\x8a\x98\xcdS\xdeI\xd9\xb6\x03\xb4\x85\xdeQ\x9d.\xb2\xce\xb6\x10RdK\xf2\x97n\xa7\xb4\xfd\xee\xf6MC+\x8b\x9b([\x19\xf2\xac\xc3\xa0c\xbcg7\x9d\xab\x1b\xaf\xf3\xed\xb3\x9b\xab\x1b\xd2\xf9\xf9\xea\xddwo\x7fz\xd7\xf9\xf9\xd9\x8f?>{\xf3\xee\xea\xe5M\xe7\xed\x8f\x9d\xe7o\xdf\xbc\xb8zw\xf5\xf6\xcdM\xe7\xed\xab\xce\xb37\x1f\xfe\xd2\xed\xfc\xeb\xea\xcd\x0b\xd2\xe1B\xaex\xd1\xe1\xf7\x9b\x02Z\x90\x17\x1d\x01t\xe4\x893\x82,\x060@t\xe7\x94\x1b\x1e\x8b\x85\x88;)\xcb\x96\x15[\xf2\xce2\xbf\xe3E\x06\xe3c\xc3\x8b\xb5(\xa1;\xcb\x0e\xcb\x92N*\xd6B\xaaaT\xfe\xa5{\xdc\xae\xde_\xba\xe7\x7f\xf9\xcb\xd9\xa2\xcab\xc8\x83$\xe1\xf8\xc1\xcb\xe7\xbf\xf1Xz\x94\xca\xdd\x86\xe7\x8b\x0e\xbf\xdf\xe4\x85,}\xdf\x03\x00\x0b\x91\xf1\xc4;\xb3\x89\xeb<\xa9R>\xe1\xc8\xe4\xc2\x91g\xc15\x10t)\xdf\xd7\xbf=\xb6N&:\x88\xa6\x9e)\xe7\xcd\x08\xc7\x11G\xb2\xc7\xe3\x15+dI\x1f\x1e\xf1#\x82\xd1F\x1a\xfc\xf0\x83\x07c\x10z\"\x96\xde\xc8&t\xb8A=.8\x93\xfc9\xcb\xeeX\xe9QJ\xa5\xef\xa3\xf5-\xcd\xaa4\xc5ds;\x953\xca\x1f\xebR\x02I\xfc \x16\x08\x92)\x95\xfb\xbdmz\xdd:\x89\x0b.\xab\"\xeb\xc8\xd1\x1d+:\x9cJ\x92\xd1\xf2\xb6\x17\xb34E\x12\x8f\xc4\x02yS]\xa8\xf3\xac(\xd8n\x06\xb5f\n\xea\xd9[$1~\xe0t:\x1b-\xf2\x02\x01\x80\x9c\xf6	\xa3\xb2\x97\xf2l)W\xa3|\xccFy\x10`>\xcdgT 9\xcdg\xf8\xf1\x91\xa7%\xef\x88\x05*n\xa7\xd9\xcc\x05\x05\x10\n*{q\x9e\x95\xb2\xa8b\x99\x17\x80A+\xa2\xb7(\xf25\xe6T\x07\x00G\x00\xf7\xc0i\xc6\xb7\x9d\x02\xd9\xaa\xf1\x7f\x86R\x8d\xd3\x19\x03\xa4|_5\xce\xf7\xcf\xaeM\x1b\x1f\x1ek\x80%L1\x89eo\xc5\xca\xb7\xdb\xec\xfb\"\xdf\xf0B\xeeP\x89}\x1f\xf1i\xa9[Z\xce0~4\xc4u\xbaD\x8dA\x92\xebVo\x11\xc7\xfb\xfd\xd9\x16\xea0Y\xf3\x89@\x1cG\xb2\xae\x8dAm\x1c\x8b\x05\xe2\x87\x15\xb2\x86dS6#%\xe5S6\x1b\x9dmQ\xa9\xa1\x16x\xbf\x07\xb4\xe0/\x84\xafU\xf8Z\x85\xe7*<W\xe1\xb7*\xfc\x16\x15xr\x96\xfb\xbe\xaaQ\xee\xf7\x08\xa0R\x81\x00*9\xebc\x1ce\xa8 %\xc9\xebv\xc9\xa6]9\xb4\x0b?X\xac\x05\x95\xd3\xfe\x8c\xe4tpj8\x08\x9a!A\x80\xf4\x84\xe3\x91\x01&\x1a`\xec\x00\x18 \xc4\xf1Q\xfb\x05\x10\\N\xc5\x8c\xf2\xa9\x98\xe1\xd11V\x05\x00\"\xa2\xc1+\xfb\x0c\xa8\x0c@\x89	L\x943\xca\xa7\xd9,2s\x06F\xa8\xaa%\x83Z\xb2\x93\xb5\x94\x08?\x98F\xaco\xf7{\x98\x90\xcb[\x84{K.\x9f\xe7\x99\xe4\xf7\x12y\xc3\xc4\xc3\x98\xaco\x9bR)\xe0\xa6\xc6\x81\x9e\xa4\xb2'\xb2\x84\xdf\xbf]\xd4S\xd2F \xde\x8cfA\xfb$k(*\xc6\xd9H\x04\x01\x0c\x0fE\x08J\xb9-.L/\x85\x83\xa6\xce\xca\x10\xd6~\x0b\x84\x1f\x1e\x01,\x80\xdc\x14\xb9\xcc\x81\xa1\x8dD\x13\xa6\xbc	\x13'\x8f\x9an\xa2FK-;\x19v2\xc0\xf4\xca\xa6\xf9l\xe4\xc4\xb9\xf3\x98J\"{e\xb5\xe1\xc5\xf3\x94\x95\xa5\xcb\xb6V\xb6\xd7\n$\xa9W\x97\xf6`\xdaM\x1cp\x91$\xfc \x9dO\x1c|#\x00\xd2\x80\x8d-\xa1\x0d\x85<\xe0\xb2\xd9\xd2a\x86\xbe\xefe\xd5z\xce\x8b\x86\xb3[J7`\x12\x8b\x1d\xd0\xdc\xf7\xd5\xd4\x94\xbdE^\xbcd\xf1\xca\xf7\xeb \xa5\xb4\xba\xc5\xf5'\x822#\xcbf,XJi`\xc3\xd8\x123\xa3}\x927}\x9c\x8d\xf3Q\x06LTse\x988\xd9\x8cd\xc4\xb0\xbe\x8e-\xc6>\xc3\x97\x18\xf6}\xa7,\x9b\x11F\xa4C\x97\xc5a\x83T\xcbzk\xb6\x81\xd6\xac\xd9\x86R\x9a\xdc\xdaa\xa5\x12tc\x1a|\xa73\xf2\x196\x9b\xf56U\xb9BN\xfd\xf9\x8c\xe4D\xe2z\"e\x8f\x0d*\x1b\x8d\n\xc9\x0e\x91)xR\xc5\x1c\xf0\xd1!J\xe9\xc2AIG\x02V$\xfbS\xce/\xa8\xc1&#\x86	\x01>\x16\x1d\xe1\xa0\xb3<M\x99\x85H%/\x00\x19\x1d\xa2\x94\xae\x1cdt\xe4\x97\x93\xe8\x986\xbeo\xc8\x061'	\xb5\x06\xcc4\xf7\x1746\xeb5+\x96\x95\x12\xd0\xc9\xb0.d\xdb\xd2\xb0(\xd9\x03\xe9q\x07\xf8\xc1|\x8c\x99DG\x000\xc6\x0e\x19\xee`\xe2\x00A\xf9\x89\xaa\x06_P\x95\x12r\xf8\x17\xd6\xb6\x83\xda\xcc\x0c=\x16=\x1a\xd9\xa4)q\xef\x94\xb0\x91\xce\x0cn2\xde\x9e\x00}\xa3\x98\xc0gao\x9b\xb6[\x91it\\\x15\xe5\xfb\xfd\xd9\x19\xf0\x0f#`Q\x97\x9f\xcd\x9bj\xcf@\xbeh\xea\x995\x99n\xdcL\xc5g2]7\x99\x9a\x9a\xfe\x90{ey\xc2\xdf\xed6\xdcE\xadNT{\xac\x17y\xac\xba\xb2A\xe5\xaa\xa9\xa5#\xcf(u\x92\xdeA\x92\x9d_\x9c\xf6\x89\xa0\xf5\xa0\xb1\xe3\x9a\x8f\xc5\x88\xc3<\xd3\x82\xe7Y\x93c\xcagv\x9a\xb8q\x0d\xf8\x17zX\x9b<\xb0\x04\x9fQ9\x91\x91C\xccgvN\x1ee2\x8b\xf7\x84G\x8e(\xf1\xbc\x19\x8d\xafL-j\x04\x9bI\x10\xdf\x92\x1a\x13\xa7\xd7_\x9b\xa5\xe2x90\xf8O%Q\xfffVl\xb6\x8d7X\x0da%\x9eL\x95\x10$\xa7\x83\x19\xa9C\xb3\xe8\xe28m\xa8C\xb3\xc8!\xf5\xc7Z>8\x93X\xae\x8a|\xdb\x815\xf7eQ\xe4\x05\xe2\x0e\xb2\xdf;\xdde\xa4\x16%\xc8\x9c\xda\xa8\xc8\x9e,\xc4z\xa2\x7f\x10\x8e\x80unR\x16st\xfe\xdf\xd3_\xcb_\xabW/_\xbd\xfa\xf5\xfeY\x7f\x16\xec\x0f\xbe\xbf:_\x12\xcfs\xea}\x03\xf5\xca\xe9\xdd\xed\x8c\x9e\xf5\x9b\xe8\xb7\x0e:*\xb5Iz	I\x87{\x1a1\xc9z%\x97\xaa\xb5\x91\x0erX\x9f\x80\xb0\x82\x027\x00\x81g%\xca\x11l\xc5K	\xbb\xf0|\xd1y9\x91=\xae\xd6U\x1cI\xdfW\xab\xb2\x83\xdc\x8f.Q\x80l\xadY\xfd\xe9@\xc0T\xd2Lk\x9bQ\xaf\xd0\x017\x01@\xa3?\xca\xc66A\xad\xc9\x02\xe4B\x10\x0e\xd58p\x16m\x00\xad\xf3\xf3\x83\xfcA\xae%I;T\x9c\xc1\xfa-\x88c\xf5\xd7\xbf]\x1e\x0f\x08\xde\xdf\xa2\x86\xb1\x9b\x9e\x06\xd9\x94\xf61\xd1\x9f\x1cv \xf0)\xa6\xfd\x19\x95DL\x073\xca\x89S\xc5?Z3\x0c\xc6 \xe5vhR\xaeFc\x93\xf9\xe7\x86\xf9\x1d\xd5\x0f\xa5\xb4\x90\x0f\xa5\xa8\x1a\xe4\xce4}u0M!'\xe5\xba\x1a\xe1\xd6\xf1\xdd\xc9\x8c\xd3\xfe,\x10-\xbc\x02q\x80\xdc/\x8d\xacp\xb2h7;(\xdc\xcd\xdc\xe2?}\xae\xde\xb0]oxX\xef{gd]3\xb9\xea\x95\xbf\x17\x12\xfd\x06{\xb8\x06\xfaoN&\x80\xdc\x85?\x01\xe0\xd3\x85?M\xc6\x0f\x9fC\xa3+\x1a\xf4\xbb-\x8a\xfd\xee\x8e\x8b\xf7\xa8\xd9A\xf5)\xa5b\x82\x14\x88\xbe.\xdd\xc7\x11\xaa;\xf9\xdc\x01y.\xb0\x0b\xf3_\xada\xd14K\x15\x0e\x81\xa6\xb8\xeb\x84\x03\x04\xd0C\xc0\x0dw\x9d\xb0C\x81\xaf\\\x88\xff!\x98\x06\xca?\xff\xa0\x93\xb3.\x125\xa8\xa6e:\xdeb\xe76\xf2\x07Kk ]\xa6\x80\x90\\Q\xc3R\x10\xd0\xa4\x00\xb4\x9b\x05b:\x9cu\xf3@L\x9f\x98Q\x08\x03A\xc5_\xe8\xf8\xa7\xad\xe1(\xa5\x85\xee\xc2R\x94\\\x8b\x0c\xa9\xcaD\x83\xa9\x930\x80\x84\x03T\xf9\x1f@c\xf7\x9f\x81\xc6\xee?\x03MH\x84\x1f\x80\x85\xf6\xf2\x0cy\xeb\xbc*y\x92o3\x8f\xa8\xb8\xdb\xa4`\xcb\x1b\xc9\n\xa9\xbe1i\xe7\\\xe7w\xdc\xcdy2S\xb5q\xb3\xbc\xcc\x92\xc3\\\xcb4\x9f\xb34\xaf\xe4\xc9|M\xc33\xe9\x8e\x9b\x07\xc9\x8a%\x97\x91$2\xdf\xbc\xd3a\x0e\xbb\x99\xfa\xd3\x11S\xf3\x03\x9a=\xd8=\xe2\x1d\xcfd$\x88\x81\xc5{:\xe0\x82l\xe0\x91\x18\xd6\x97\xf4\xdbj>Oyt6 \xf9bQr\xf9>\x12\xbdO\xc5{\xf3\xf5A}} K^\xca\xaa\xe0/u\x05=\xf7\x93lD\x16\xaf\xa0\x98\x0e\xe8\xef\x0f\xf6\xfb\x83\xfe\xbe\x89Y\xcam\x9c\xfa \xdb\x15\xe7\xe9\x0b\x9eJ\xa6*Q!\xf2\xa9\xf8v\xf7.\xaf\xe2\x95\x8a3a\xb2]	\x15\xa3~\x1dB0\xd9ZG\x8a\x9a.\xb0\xaf\x9b\xc2\x92\x1f\xcb\xef@\xa5:\xf1\n\x1e+\x1d\x05\x13\x99\x17y\xb1	\xcd`s\x80\x1d\xad	lGG\xf9H\xed\x7f\xf2^\x9c\x8a\xcd\xf7L\xae|\xff\xac\xf9\xe8\x99\xc2\xba\xa8\xee\x81\xb3\xc1(\xef\x95\"\xe5\x19\xacS\x19=\xebc\x92\xd3\xbc\xb7a\x05\xcf\xa4\xd1O\x9ce\xfb\xfd\x8b[\xfb\xe1(+Jh\x06T/\xd5\xea\xf3\xfa\x16}S\xb3\xbaT\x82\\\xe0L?\x15a:^O\x96\x81\xe1\x80J\xbcR\xfc\xf0BG>\xd1_O\xd5O\x03\xa1j\x8d\xbc\x86\x117\x9cEC\xe2ZZ\xbb\x00\x9ez\x01qO \xa4\x98\xc4S\x08\xb5\xd9\xc2J\x1e3\x9d.\xcc\xdc\x00\xe0ta\xaa\x1a\x1edc/L,\xb3y\x87u\xde\x8b\x19)l^\x15{abK\x9b\xf7I\x9d\xf7)\x84\x9e\xccHj\x0b<\xa9\x0b\xa8\xa4\xa7m\xa6g\x16\xcb\\\xb7\x91\xe9\x06\x16\xbau\xa5\xa6V\xea\xb6+\xae\xdb\xf5G\xa2\xc4\x1f\xd1\xcb\xae\xef\x86hG\xeb{r\x82r\x8aTCM\x9b'\x96\x18\xaa\xf5\x17\xba\xa5Og\xa4\xd2\xbc\xb5\x14\x19\x12\x98\xac\xf4W\x9c\x97H\xd4\xa3G\xb7\xb8\xbb\n\x8an\xa5\xdb\x1df\xdd*(\xba+\xdd\xfc\xbc\xbb\nJ\x95t1\xa3a\xde\xad\x82U\x17\x88\xf0dFW]\x16T\xddTSd\xd5M\xc3\xaa\xcb\\\xac\x17\x07X\xabF\xe6j\xedh\xd5\xce\x0f$\x94\xae\xa1<4\xaf\x9b5\xf4\xea\xe6\xcd\x08\xebf\x0d\xb9\xba\xb9[\xeb\xc6\x8c]\xa8S(\xd8\x04\x86\xda\x10\xaa\x86\x92\x8a`\x03M\xb0\x0bM\xb0\xa7@0\xd1-B\xd6\xcd,f\xe9\x04\xa5tp\x0e\xad\xeb\xcfh\xa1\x9a	\xc4a*4Td\x82\xd0\xc5\x8c\n\x15x2\xa3(\xeb\x96a\xd1\xcd\xb1%\nb\xdd<\x14\xddRE`\xb5+k\x10]\xcaF\xa4\x84\xf9mk\xae\xb4\xd0\xefJ\x90\xeb\xd6\x8c\xbe|s\xbb\xdf\xcbq\xf8\xc6\xd1\xa0\xde\xa9\x1c\xb0\xd0\xf4n5O\xa7\xb2f\xee*6\x15\x0b\x0e2\xb9X\xf0\xfd~\xc0/\xec\xda\xc3S\xb6\xa3\xb2\xa7~\xf7\xfb\xbe\x89\x16\x99\x90\x82\xa5\xe2\x13O\xe8\xd9@G\xa6y\xbe\xa1f#\xaa>@\xed\x03\xbf:y\xc96T\xc2\xdf\x1a\ng\xa5\xc8\x96T\x9a\xc0~\xef\xbd\x16\x19g\x85Y\xf7\xf2lQ\xb05 eB6:\xe1\xa5,r\xc0\xaa\x0e\xdb\xa4\x82\x97\xb0:S\xd9\x84\x0d\xc6\x1b\x06K\xf9;\xb1\x86\xad\xb8\x1bE]F\xbas(\x89\xa4\x9e\x10\x05\x1c\x03\x83\xcc:\xeeO\xfa\x91\xbc\x1c>}:\x19>}\xean@\xef\xbf\xa0\xd8\xc57\xfd\xc9\xc57}\xb7\xd8\xadS\xac#\x0d\xf8\xc1d\xe0\xe6\xd9\xbay\x00=\xb3G\xf2}\xefkP\xa7\xc8\x1e\x9c\x91=k\x12\xc2\x01\x9elXQ\xf2Wi\xce\xa0\xf0\xf9\xa0\xdf\xef\x02\xc2*\xf6J\x89\x0d\x83\xbe+\x80\xce\xdd:n\xff\xa7uD\xadZ\x1d\xf07\xf5D7U\x88q\x7f\"\x02:\x88\xc4\xe5\x00\x0e\x13B:\xc0\xe4\x9b\xae\x18\x0f&2@<\x94\xb8+\xba\xdfDC\x15\xc3\xa3\x8b\xae\x18\x0f\xeb\x144<\xbf\x08\x05\xee~\xe3R\xe9\xfa\xb0\n\x9b\xdb\xd9\xcf]\x99<$#y\x8d\xca\xc1f\x0bf\xaea)-\xae\xf1\xee\x7f\xb8\xe25\xb5\xbf0\x10~\xba\xf5\xfdw\x12\xfdtK8&?\xdd\xd2_n{\x1b\xb5\x9e\xfet\xbb\xdf\xf3^	g\xdb\xc8\xa5\xde3S\x10$\x128^\xe3\xfb\xfdT\xef\xa1\x05\x14^\x82\xfc\xabN\x1f\x85\x11%\x00YXFU\x9e\x8c\"\x19P\xcf\xc3\x8d\xf6\xa2\xa3\xb5\x13=\x99\xbf\xce\xb7\xbcx\xceJ\x8e\x14\x00u\xe2\xf3]\xad\x9eU`\xbe\x833>L\x0c\xf6\x84C>\xef\xffxg\x94fv\xe0\xf5\xcd\xd9ZN\xb3\xfa\x18\xc6C\x1e&\xcc\x8d\xc0\x9e\xaa#\x1c\x9cQ\n'g\xc1\x00NG\xcd`\xb6\x87sY\xaf\xac\xe6\xa5,P\x9f\xe4\x98\x94\xcdw\x1e\x0c\x08\x0b\xe1\x07\xe3^\xb9I\x85D\x1e\xf10I\xe9`Tn\x85\x8cW\xa8\xc0\x0f1+\xb9W,\xe7\xcc\x8b\xc4\x02=9\xa3\xd4j\xdal\x9b\xeer\x91\xc0@\xe0\xa4\xaf\xfe\x0d\xf0(\xa5s\x89\xca\xde&\xdf \x8cG\x16\x84\x17\x99\x12\x17\x0e\x94\xc9q\xf1\x08)h[\x89J\xb5cQ\x81\x81\x0d\x0cg\x98\xa4\x0e\xf5\x0c\xfcU\x99\xb2\xba\x82'\x7fVA	\xc3	p\x9c^\xcc0y.QI\xf8I\x98_\x8e\xf3	 	_\xb0*\x95\x06\xc4\xe3c\x0b	u\xe6\x0b#\xf0\x89\xdbi\x86\xdfU\xb4f,u\x7f\x0d0\x19|\x83\xf1%\xed\xfb~5\xa6O\xfa\xff\xf5t\xa2)\x85.\xfe\xfe\xa4\xefW\xf8\xf2\xf2\xc9\xbe	\xff\x9d\x0c!\xb4GC\x9bH\x06O\xfdj\x8f\xe0/\x1e\x8f\x9f\x90\x81K\xc6\xe8D?\x8a\x05\xfa\xdb\xd1\x90\xfa<n\xf5rjq\x1c|\xf3\xb7\xbf\xfdm8\xa8\xf1\x1c|\xf3\xb7\xc1\xe0\x9b\xbfkt\x06\xdf\x10\xf4\xcd\xd3\xa1\xf9\xfa;\x19>}\xeaW\x7f\x8a\xd3\xe3\xa3\xb3\xd5xn\xe61L\x15A\x91\xcb,a\xe4|}\xf1M?\xb8\xf8\xa6\xaf\x02\xe7\x17\xdf\xc0\xd9%\xf0e5\x98r\x1d\x84\xe1\xc4h>\xa6\xbd\xa7\x93\xbc\x8b\xb2`\x80\xa3<\xc8\xc2\xbc\x9b\x91\x82\x0e\xbby\xc8l\xb3\x0c\x9f \xaa-;\x89\x86O\x9fvo$*\x08#\"\x18\x9c_`|\x18{\x1c\x13\xea|\x03L\xa0\xdb\x9bE\x01\x81\xb4EA\xeejI!\xaf\xa5Q\x05[\xb1\x85\x08PG\x826e\xf8\xf4)\xec\x8b\xa6\x03\x1dd\x14\x18\xad\n\x16\xcd\xf6>#9a0\xeb\xeb-\xba\x89Ii\x19\x16\xa4\xa2\xa8\x0c\n|>\x84\x9e\x06\xfdM\x8aa)\x17\xb4\xaf\x8e\n\x1f\x04\xad\xc6\xbd\xa7\x93\xf4\\e\x8b\xd2s4\x0c\xcb\xb0\xd0lpE\x11*\xc3\x0c\x9f\x7f\x13\xa4\xe7C|\x9e\x92X\xc5\xe4NL\xa2bX\x133\xca\xe0\x14e\xc2i\x12\xc6Qn\xc2\x83\xf3\x8b`\x15&\x11\x83o\xa5C\x1c\x9e_\x04q\xb8\xc2\x84\x8f\xfb\x10\x11\xc0j\xc6\xd5\xca\xc6aeS\xea\xd9\x05\x9d^|\xd3\xef\x02M\xaaZ\xbc5\xc2\xd2\xf4b\xe6\xfb\x0b{\x8c\x05\x93|\xe1\x8c\x9b\x8f\xadq\xf3\xac\xe1\xf6\xcd\x9eS)O/\x1a-+\x87\xf5u\x9a\xcd\xbah\x10r\xbc\xefG\xd0\xd1!\xc4\xe0.\x0f\xe0w\xdf'\xf0\xa3d\x19\x08P\x90\x0f@9\xab\x9a\xa0b\xfa\xf5\x14\xf9$\x91PC@\x98!01L\x16~<\xf7\xf8\xeb{Gf\xad1\xb5\xc7\xec\x08\x0d\xc6\xe3\xe1\x13\x1c(\x9d\xccx<\xf8F\x05\x07\xb3\xf1\xf8\xef8\xe8\x04\xb0v\xc2\xaa\xa4\x8f\x99\xd0\xe0\x1blV\xc3\x81\xb3\x1a\xbe\xa9\x17z\x80\x0c\xfa\x0d;*\xa5\x9a\xc8rL\x07\xf8APQ\xaf\x92\x19\x95]d\xb3\x85\x03\x98Lj\x80-\xd2\x1c\x14\xcf\xb0L\xa9\xef\x98\x8b\x14eX	\xfe\xb9\xbd\"BR\x9a\x85\xb9%\x04\xecP\xe8N\xa2k\x89\n\xd8\xac\x00\xcb')\xc6J)U'\xc06\x0b\xfe\xe8\x84a\x930\x84\x84\xa1M\xb8\x98\xd1[S\x02\xf6\x19\xf0G\x95p\xc8\xf9\xf6\x8b\x1a\xfb?k\xe43\x898\x1c\x99\x92R\x07\x19,U\xd0XR\xd1O\x12MO5\xf3T\x0bO5\xeeD\xbbf\x04\x06\x0b\xf3\xeaA%&\x0fq\x9e\xe6ET\x91\x94/\xe4\x15\\\xe8\x88r\xa2n4\xea\x0fF\xeeXZ\xf1({\x8c*\x87(/e\xfb\xe4\x1b\xd0o.\x05I\xaa\xd8\x101'\\\xa0\xf3\x87N\xbb\x97\x88c\x1b+T\xec`\x06LU\xd4\xb1\x99\x8a\x1d\xaa\xd8\x0cc\xf2I\xc22)\xb1E\xbc\xc1\xe0G3#\xc5\x02\xd5\xd5\xfb\xbe\xa9\xb1F\xc4t0W\x90\xe41\x94O\x0d\x14\xa9vJ\x8e$\xa4\xef\x04\x05\x1e\xf1\x94\"\xdc\x04\x86\x96qhXg\xea\x10\xc3[\x95wN8\xd5\xe1\xfd\x1e\x89\x80\xeab\xc0Px\xe0!/\x10\x81\x87=\x87\x94\xdf\x1a\x14j\x94\xb9\xa3n\xffw=\xf8\xe4\x94\xcf\xa8{0R\xa7\x98\x89\xad%\xed\xc0\x91v\x7f>\xc8\xd2\x11\x97\xbd\xa7\x13\x1e9Y^\x99,Z\x1c\x87A\xec\x1c\xf8\x8b\x05\x1aP\x9ac`q%\xed\x8f\xca1\x1b\x95A\x803\xb83\x06\xf5O\xcb\x19\x81\x0bd\xf5M\x11\xcb\x0c\x0b\xca|\x1f\xfa\xdc\xccx\xe2\x16\xb7\x99R\xda\x1f\xa5\xe3b\x94\x1a\x90\xd3\xb4\x86:M5`\xf8u\xaf\xc4|W\xb7\xc8\xcc7\x0b?\xa7\x96\xb9\x00\xd6\x19\x88\xb7p\xcf%\xbb\xcc\xb1\xcdC\xf3\xe6\xfaIA\xb3Q1\xceGE\x10\xc0\x1d \xb8\xba\x00\x92\xb0\x98\xf0i1\x8b~7\xe7\xdd\xf0\x81\x9b\xeb\x19L\x8d\x87v\xbb\n\xda\x1f\x15\xcd\x11\x1b\xc0\xd3T\xa3\x02\x8b\xf2\x0d{\x83$\x00Q\xa3\xba\x80=J1; \x95K\x99\xa6\xc4\xb4\xac\x0b\x01\xb1\x01\x13\x88j(\xf1KM	\xd8\x928\xf7\xa8\xce\xfaf\xcb!\x0f\x08b	d\xe6\xc6\xd9\xc0\xf4/5\x8b\x18\xa3\xfd\x11\x1bg#f\xafg\xb1\x19\x94\x02\x9edK<\xb60\xcf\xa9\xdb\xc3nq\x9bCS'\xb7d\x01\x90\xd3\xc2B\x9d\x16\x0d`3\xa5\xdcc\xd9\x9fL\x03ad\x12F\nR\x92\xd4\x8a\x93n\xd3\x06\x94\xa6\xaa\xc2\x98\xf6G\xf1\xb8\x1a\xc5A\x80\xcbi<\xa3\xefa,\xc50\x90b8\xb1\x88g$\x83?\n\xdaA'\xacZMq!\xd9\xa6$\xb4?J\xc6\xabQb\xa0O\x93\xba\x82i\xa2\xeb\x80_a~3\xf3\xab+kZ\xf5\xfe\xa0U\xbaE%\xed=\xed\"\x11J\xe0\xfe\x10\xcc\xc2\xfa\x94\x0c\x0d\xbb\x88\x87\x02\x07e\x90\xe2n\x11\xa0\xf0BG\x84\xc3n\x19\xa6\xb8\xcb\x82\xb2\x9b\x07\x8e\x08\xf8\x9b\x15\x01\xe1\x02\x99\x91\x02[$\x8b\xd5\x91\x96\xa3/\x17p\xdb\xc7\x9c\xfej\x01F\xcbAv\"\x80L\xd2\\\x80\xb2\x17\xf5:u\xf2\xa9\x0b\x96\x1f\\uFs\x1a\xa4\x17|yp\xb0dcA\xccV\xec\xbf\x95\x17$n\xc5m\x91\x17\xc8\xdeo\xb9\xc8\xd4\xbeS\xf1\xd1\xba\xbe\xdf\x1d\xa9\x07\x0e\x0d\xec*<\xeb\xa9\x05\xcc\"\x1f\xab%\x1c\xaa\x9f\x0c#G\xe7\xf4\xafV\xc7\xd4\xf7T{\xb7K.%/HIe\xef\xb6\xd4\xe1\x94z\xb0\xfb\xcd8\xa8~8\xa9\xea\xed\x0e\x10\xb7\xd2eW$\xa11\xca`\x82\xa8\xfa1Y\x80\x8en\x03\x7f\x964\x99\xfc\x0e\xeb[\xd4\x1fe\xbd2/$\xb2x\xb4\x97\x82\x9e\x14k\x1er\xf5\xf3\x08\xba\xe1lZA\x8b\xe0\xbbfKk\xe8\xbc;\xf8\xb3\xa3M}\xe4\x9e\x9e\xf5\xc9-\xed\x8fn\xc7\xd5\xe86\x08\xf0\xc3Zwj6\xbd\xd5\x10\xceWZ,\xdfR\x15\xa5\xd0\x84\x16$\xbe\xff\x8bD[\xb2#K\xb8\x97\x9b\xf8\xfe\x96R\xba\xdb\xef\xd1==\x1b`\xb2\xa3[b\xef\"\xd6\x175\xb6\xba\xd9s\x10\x04\xb6x4\x9f\xa0-\x9dC\x9b\xfb8\xda\xc0}\x8b;]\xfb\x16?\x8a\x05b\xfb\xfd\xd9}3\xfen\xe8\x9dj\x98\xc57\x1c(\x8c\x93\xc9w\x12\xddMog\xe4\x86,qt\xa6\xd9#D\xe0\xfd^\x7f\xdc\xe0\xfd~\xb3\xdf/\xf6{\x15Oo\xf0(\xf1\xfd\xef$\x82\x0b\x11F\xc9\nZ\x0c\x00\xa0\x1a{M\xae\xc8;\xf2\x82<#\xcf\xc9k\xda'\x1fi\x1f\xda\xbc\xc0\x90\xf8=\x9d\xc2\x9e\xb1O\xfaZj}\xa3\x0er\xee$\xaa\xcf\xf5j\x98\xa0\xa2\x8dV\x044\xab\x91\xec\xdd\xc2/Q\xeaY\xf8R\x01b\x14\xa6Q\xbbo\xa1\x1e\x01U\xf2q\x1f\xdb}\x13\xdc.\xe7\xe3\x8f\x9a\"\x82^7\xfb\xb1\xd7\xc1\x80T\xe1\x00\x8f\x84\x92\xac\xcf\xd0z*fc\xca\xf1H\x84!\x1e\x89&\xa7 U84J\x02\x18\x1b\x82\xbe\x1e\x89qe\xcb\\B\x91 h\x17	\x01\xf8\x10?\xbe\xa6\x82|\xa4\xdc\xac\x1c\xeb\xa9\x08\x06\xb3\x10j\x02D\xfb\xa0g\x82e\xed\x8a\"\xaeb\xf1yFR\x88yA\xef\xa6bF\xde\xd1\xbb)l\x06\xc5DD\"\x1c\xcc\xc83\x88\xbf\xac\xc2\xe1\xa4\n\x07\x11@#\xcfM\xd4\x85\x89\x1a\xceH\x82\x7f\x92\xc8v\xc6\x15\xb9\xea\xaa\xff\xdd+\x02\xd7\xa0s\x0c]fU\x1e\x0b\xcc\xe8g2\x7f\x0f{cF?H\xf4}\x93\x7fc\x96\x16\x90\x81\xa0\xb3\xaf\xf0\x88\xd1\xf7'k\xc3\x8f%TG\x18\xae/\xd5'\xf8\x15\x0c<1#w\x8a\x14\xe4\x08% \x14\x03\xe2,\x8e\xb3\xfe)B-\xc8\x80\xd8?\x0e@8(=\x92Z\xbd\x1e\x89\xc7\x9a\x07s\xdf\xb7<\x08\x96S\xdfGo\xac&\x9fc\xf2\xc6Uy|Us6\xfc \xd4\xceR\x06T\x10AC\x81Ifw\xcb\x19\xc9h\x98\x99\x03\xf0{j\x14\xf6;j\xf4\xfd[\x91\xc8\x15\\\x84\x81\x8f\x15\x87\xfd\x01\xcd\x9a:\xfe)\x0f\xee\xd9\x8d\xe4%-\xb7#\xcc\xf7t\xe0K\"//\xa9]\xe4;\xd2]\xac~p\xb0\x03\xa2\xe6\x94\x07J2\x81\xad~\xadLU1p+#\x0f\x02P\xed\xf2\x19\x1e\xf7\xf5l\x19\xe5c\xe1\xfb*\x0dR\xf2p\x00i#\x9c\x07\xc1Hr\xf3z\xa1\x11\\Ne\xbf\xa4&\xbf\xa9-\x0f\x1d\x04\x0d\x0c#$\x890T\xf7\x05\xeb\x0d\xdf\x94\xcfFr\xca\x83\x00t1\x020\x10a8\xa3\xee%an \x80$\xa3\xa1\x80J\x83\xfb~\x16\x04\xa3l,\xd4\x8a[\xf3BF\nuA\x0b\xf6\xbe\xb0\xf1\x1d\x95\xe3t\x84s\x04g\x9b\x8c\x96Azyy\xa9\x9a8I)\x8bJ\xca\x82\x81\x9a\xb6\x15\xcd\xc2\xd2\xaaa+\xad\x86\xed\\DrZ\x06\x17\x80[\x19\x0cgJI\xd9\x19\xaa\xc8\xa1\x8e\x1c\x98\xc8\x81\x8a\x1c\xa8\xc8\xd9h^p\xf6\xb1\xd6F\x02j\xa3\xea\xb2?\xc2\x90\xa7Ry\x02\xc5\xaf\xab0|\x84]\x00-\x9c\xf6\n~j)\xed\xc3\x1e@\xa9\x8a\x81\xffC\x8e\xa9\x08\xf2\x19\xbe4\xfdX\xc2\xb6w\x94\x8eK\xdf\xafS\x83\x14\xd2G\xb8\xa0)\x81\x93\xbe\x00\xa5\xe3\xf1\x00\xe31\xb0B\x94\xd2\x12\x8f\xd2\xcb\xd2\x04I\x11\xd0\x9c\xa4\x01\xcd\x1b\x1eX\xd2<\x18\x1c\x00\x0d\xd3\x19\x00\xf8B\xa8\x86\xb6\xc5\xa8\xa0y\x98\x92\x94\xe6a\xf5\x08\xa0\x8b \x18\x15\xd05j$\xach\x11\xa04,\xa0s\xf0\xc86`\x05\xe8O\n\xba\n\x06QJWVJr\xce\x17\xb3\xff\x88Vv\xcc\x7f\xb6Y\xff\xabV9T\xfbLW|1\xd5\x9c\xbe\xf8\x0fh\xa5\xc6\xf4*R\xf4:A\xab\xdc<*k\x06\x1a2\xd7\x14J\xca`\xeeU\xb4\x80\x9f\x15|\x01+\x8e\xe1\x1bF8D\xd3*\x88\x89\x00\x8dex\xe1\xfbHgQ9\x873\xa2\xf3\xa9\xec l\xa6a\xa8\xfa=\xa1\xd0?\xd3\xd5\x8cHR\x92\x8a\xf4AG_\x064!\xb0&\xa2*\xa4	\xf6}\x15\x8e)\x0c{;/$Y\x91\x98\xc4\xe1\x80p\x0c\xfb\xb8jL\xe3I\x86\x00\xc6\x8a\xc48\xca\xeb\xa0\xb37\xc8\xd0\xe10\x18)\xe2\xa9MT\xa66Q\x15l\"\xe5T\x04\x85\x16PR\xda'+\x9a\x93\x98*\x91BNc\xcd\x88V\xc0&\xfbg4\x0c\x19,\xd3p\xdc\x935,&!\x0b\xb2!KZ\x8eF\xf8!\xa1}\xb2\xa0}%\x99\x8e\x92\x1c\x16O\xd3\xe6j\xaa\x86\x14~8\x82\xbc\x08\x02\x02\xe5\xfaT\xd5\xf0\x00\x02\x9e\xe6\x19\xcd\xc34\x83K5M\x01\x97$\x08T-\x03(\x91\xb5KlW\"\xe5\x08%\xfb\x05\x1e/\x95Zu\x83\x0d\x03R\xe8(\xfa6]Q\x91\x94d\xaa+\xcc\x0eF\x13(Q\x04\x82Z\x0b]k\xa1\x84\x97\x18z+\x85?(\x83\xee\x1a\xd3A\xbb\xf6c\xb2\x1d7\xca\"\xb1\x80N\x06\xb2\xa8\x0ef\xc7H,\\$\xe4t\xd5 \xb1 \xab\x80.\x80b\x14\xb1\x90.\xf0\xe7\xb00\x04;A\xa8e\x18\x1aZ%\x974\xdd\xee\xf7\x0b\xf8i\x11l\xa9\x96\xf3%\xdc\xa8]\x06t\x08\x88\xa3\x92.\xf1\x18\xf4\xe0%\xa8\xc4\x07\xb4\x1e\n\x1aev\x02e \x08ST\x9c\xd5\x87N\x80yvx\xaf\xfbh\x80\x1a8\xd0\x88b\xf6\xd8\xb0\x94/\xaf\xabs8\xe6\xdb \xeb\xd9\x92\xff\xd1la\xeel\xc9\x9b\xd9\"\x82,\x1c\x00\x83\x08\x07$\xa6y\x00\xbff\x02\x98\xf9\x03\x8b\xb7\x9c\xa6ah\xe6Of\xe7\x0fk\xe6\xcfF\xcf\x1c\x08.i\x9f\xaci\x9f\xdc\xb9\xb3\xa7\x82a*\xdb\xb3\xc7\x81\xbb\x0c\x02U\xa6o\xba\xf8\xee\xf4\xec\x81\x12\xd5t\x05\x98\xac\x83\x80,\xed\xeca\xed\x12f\xf6,\xf7k<\xde\xa8\xc1pw8{\xd0\x92faf\xf1\x82\x03!\x02d\xa8\xc7n\x16\xd2%YP\x14\x87t\x89\x83\x01\x9c\xc1\xa4&X\xd0e8\x18\x15\xc0\xf9\x8b0\xc4r\xba\x80\xbe\x90\xd3\xc4\x8cl=*Z\x08\x1da\x7f\x02k=\xa1\xd0\x9a\xb2P\xb1\xcdt\xa6\xb8+#\xcc\xc5\x8b\x85tm\xf0Z\x1b\xbcV&\xa8\xc7\xd3\xda\x0c\x10\x85TU#\xc5\xd4$?\x8d\x93\xed\xdbc\xdao\xea\xe9\xb5\x84y\xb5\xdf\xaf\xeb\xe9e)\xbaQ\xd3k\x03\xd3k\xd3L\xaf\xcd\xc1\xf42#E#\x9e5\x04U\xc1\x82f\x9f'\xa8\x04\xb5\x15\xf4Rk\xd6\xb1\x93\xb3.\xa1q\x88\x18\x1c\xa5\xb4\xe7\x16\x00\xa2\xd5\xb45\xfb\xfe\xd7\xa8t\xbe\xacF#\xbf\x92\x92\xa6[\x02kSE\xa7\xb3\x11\x03\xddD\x01\x7f\xd4\xeea\xcd\x8b%\xff\xb1\xcaJj\xe722\x92|z90\"\x82\xa4i\xa8\x8e\x18\xe5%\x1d\xf8~1\x95\xe1`6\xa6\xc5T\xce\x82b*\x83\xc1l\xbf\x97\x97t\xa8\x93\x86N\x12\x88\xff&\xbb\xce\xe8\xfb\x12dv\xb3\xb3\x83l\x97:\xc1\xf4)\xbc9\x7f|\xd4\xa8-\xf2\"\xe6\xd7_\x8c\x9f\xbc\xec\xfb\xfeqe\xc4\x05	J\x8f\x1f\xab\xac\x81\xa5\x94\x00\x0ct\xdd\x92\x14\xf0\xc3ay\x1a8\xf23\xabeB\xfc \xe0\x0c\x01\xc6[\xb6\xdf\xa3F\xa7\x8b\xcd\xf3\x8d,T\xeb\xfe\x19\xca\xc7C\xa3\xea\x03=\xacX\xa0|\\n\xed\xee\x89Q\xb5\xcb\x82y\xcf1Q'\xe4jO\x02\x11\"` \xd4\x98\x9b\x1f|k\xa5,R\xd2\x7fJ\x94c\xb3\x06\xa3\x16\x0c<.ue)\xcd]	\xdc@\x15Aj\xe0\x12F\xd3\xc7\xc2\x12\x01	8H.\x9a\x11\x800\xc9C\n\x87\xe1\x94\x99\xb9\x07\xebl\x8eG\xc5A_ \xf7\x80\xb30\x82\xa0i\x9e\xec}J\xf9\x1dOaSe\x82\x13\xd9\xfb\xa4?!4\x0cy\xef\xd30\x92\xbdO\x10\x88l\xfe\xd0\xe6n \x97\x86\xf4v{g^\xa9\xf4\xee'\xfd\x88\xf7\xeeI\xde\xc4\x0c'\x03\x88\x1a\x12V\xc7\xedT\xae\x1d)\x9a\x98\xa1\x8e\x1a\xda\xad%\xef\xe9\x8b\xe5\xaa7\xb3\xae\xd0\x1b\xeb@(\xd8y\xeb\x9bQ\xd6\x15f\xaf\x1d\x08\x05\xb6hE\xc0\x03\x1f\xad\x05\xcb\xf0\xa4\x1f\xc1\xa5g\xfd\x99\xe3\xc9 \xca	3\x9f\x0cRa[\xa9?\x0b\xf8,\x88\xeci\xdb\x0c\xfa\xb6\xdf?\n\x96\x08\x0e\x97G\x08#yKe\x9d\x1e\xd0\xc4\xe0\x08\xb5\x19d\xec\xe1-\xa8\x94`\x8f\xeb\x10\xe0~\xd2{\nd\"eC\x13\x1d\xb5#i\x1dU\xe8\xa8\xe2\x04\x95\xa0\xd1\x99\xa2OIA\xcf\x0d\x84H\xbb\x94\xe1\xba\x01?\xb2D\xb0\xb4n\x00\x1c\x14\xf4\xcdqA\xd3\xb1\x15\xaf\xdf\xd5\xb97\x0dW\x07m\x83g\xf0\xea,i\xc9\xe5\xcf\xd0J\x04CX}~\xa7\x94\x1eH5\xaeW\xca]Z\xab\x94\x0b\xdfGEo\x93\x97\x02\xa6/\xf5\xd8\xbc\xcc\xd3Jr\x8f\x14=8U\xa5}R\xc0\x85}\xf5\xab\xfa\x97\xe6\x81\xb7\xb9\x87t\xa3Ja\xfa[\xbd&{&\x8dM\x1a\xe4%L\xb2\xf0S\x11&\xf9:\x14\x89\x07\xaf\x90IfAt\x05\xc9\xea\xf2\xf0\xd14+\xe6\xe6\x04\xe0HM[\xab\xc8o\xb7\xf6\xd2\x9a\xa59\x1c\xe3\x8b5[r\xbb\xf5r\x8e	\x13C'\xd8\x9f\xd8\xdb%\x9f\x01oo\x04\xdc\xde~*\xae\x00\xdcM\x11\xc3\xc5\x95\xfd\xfe\xacV\xe7hE#k\x90 \x05}X\xe5\xa5|\x99F\x82\xc4\xf3(#\xf1\xfc{\xb6Ks\x96D\xf9\xa3E\x91M\xce6\x1cq\xca4\x9e\xd8\xf7Yo\xc330\xc4\xa3X\x0c*p\x84\xce8\\\xffP\x8a[U=&\xbc\x97g\x00\x89.8\xb9\xdd\x9a\x8b~\x80`\x0c\xb6l\x92\xab\xf5\xf2\xed\xfc7\xfa\xa0`F\x9c\x18\x88\x11,\xa1P\xb8,bz\xd0\x1c	Wn\x0cR\xf2\x84\xd1\x8c\x05\xaf_\x02\xa8u\xa5]\xd5\xc8\\\x93U8\x9dHW\xd3\xa2V\xef\x832\x8d\x8fe\xddPs\xf2\x05\x0fV\xcd-\xa6:m\xca\xe1\x1a\xb5\xe8\xc5\xf3Q\xa6Tb\xf0\x8cY\xf4jRb\"z\x9a\xca\xbdD\x80A\x0e\xfcx\x08\x97:'p\x1b\xee\x9e\xe0\xc0\xe9\xb8\x1ay\x10\xd0\xc3\xae\xc9\xba4\x1c\xd9 \x14x\x91\x17 u\xfb\xe9JKP\xdb-X\xbc0\x94R\x1fu\x03\xd5\xe5H\xcf\xabo\x14\xfe\x9ay\xd8\xbc\xce\xef\xab\xc9fZ\xcc\xc6\x85:\x9b47G\xe0\xf5\xd0\x0b\x8e2\xb0\x0f\xc0\xb1\xe5I\xb5\x82t\xbe\xbd\xbc\xd9\xfa>\x9aoi\x9fl\xb7`\xbc\x86\xcc\xb7A@T\xe54'y\x83\xfd\xda\x19\xdd\xfaP\xce\x00a\x93\xddaRt\xe7\xc6\xb8|\xf2\xee\xa4\xda\xeb\x99QA\xc2\xcaWRC\xa7Q\x0e\xf2b@\xf3\xe9`\x16\xe4p7\xc0\xec\xe6\x8b^^I^h^\x03\x07K|\x0b\xfa\xdb{\x8e\xfa\xa4$\x02\x93[\x08\xa5$\xc3\x8a\xbf\xd9\xc6V=\xd0\x04\xebR\xb4p>H\xd5\xe0w\xd4\x14\xa3:y\x0e\xf1\x0f\x05<\xcdaD\x16\x15\xbcy\xe7QA\x16y&#N\xc0\x1c\xc8\xb3T,3x\x91\xc4\xef\xe5\xf7`\x15+[F\xf9#\x1cV\x96\x1aa\xc5+Ie?5&\x16;\xb7\x11i\xdd\x88\n\x1a\x91\x92\xca\xa1\xe0\xbd\xc1\xd0\x0e:O\xddC\x81C61\x91!\xe5\x91\x17\xf3L\xaa\xf7\xe2\xfa\xfaHH\xf9\xf9\xb0\xf5\xac\xe6\xf6\x10\xc4Z$I\xca\x1b\x18\xe7\xc3\xc8\x9b\xe7R\xe6k\x17J\x0b\xc6\x96\x1f<\x81Q\x8b3,\xec\x8cr3\xf4\xe1\x82\x92\x19s%e\xe7C\x92R/\xe5\x0b\xe9\x91\x8az2\xdfxV\x13+\xcd\x85X\x95\x18e!\x15$\x0fh	\xf9u\xeb\xa0\x80A\xd2\xa8]AIk\x12\xa3,\xa0\"(t\x91\xd3\x19\xa12\xc8V\x9c\x0fA\x98\x12\x00\xd9\x90	J\x98\xb6\xba\xa0MT](\xa0,p\x8b\xb9yEV\x8a\x84\xbbyK'\xe7gP\xd2\x85^\xeb\x16\x07\xb6\xc5\x7f\x94\xf7\xc7\xba\xb5E\xf8\x85\x04\xd2\x95\xbcs[\x1f\xb4Z\x7f\x8c\xd1\xb7\xc7\x0d\x0f\xbf\x80^uM\xed\x16\x89\x93y\x8eZ\"\x9a\xae>.\xa01j\xc3\x05\x9cNw\x9c[\xe6\xa8\x1e\xd3\x94fP\x99n6\xcb\xd3\xc3}\x94\x91]\x94;\xf39U\xe1\x7f\xf3B\x8a\x98\xa5z\x8e\xbbw\xbc\xe6\x87\x8b\xfe\x99\xbdW\xe5yf\x01?\xe6\xdc\xa3\x9c\xde\x18\xc3&\xc0\x8d-\x93\xd7\xfa\x7ffyy1.\xd5\xe6\x91\x81\x86\xe6\x9a#\xf8u\x9873\x87\xfc\xb0\x144\xf8\xdc\xd4\xf8\xe0\x07\x94Q\x86\x1e\x1eI\x86q\x0f\x18\x959\xbb\x14\xf4\x05\x12\xc4\xeb\xf5z\x1e\x1ee\xb0F\\I^h{k\xf4\x05:\x88!\xc3z\x1f\xd5[\x8b\xec\xf9\x8a\x15:\x93\x0e\x93>\xc0\x88UX18`\xdf\xde\xff\xf7\xff\xfc\xbf\x9e\xb3c\xea\xb12ng`*9\xeb\xa9\x8b\xfe\xab<M\xb8\x86\xea|\x13\xcfkHSR\xf3\xc4\x05V\xb4>\x91\xb0\xa5\xd7W\xa3r\xdf//\xa9\xbe U\x86\xb40g\x11K\xee<\x0d\xab\xd4I\x85\xa0\x1e\xcc\x93>l\xdbdX\x91\xac\xc7\xd3TlJQR\xe1|\xe8V@2<~\xe4\x99\x96qii\"\x98\xc8\xb8i\xa9t\xc5\xc9\xeb\xd6\xea\xce\x0f\xf2\xc2\xcb-\xd5\x07Jpv\x01\xc3>\xf4L\x1c\x8e\x19\xb5\x06f\xd8(jj\x99\xb0\xb1 \x06\xfa\x0c5:`\xcc\xb11\xcd\xf7\xfb\xe2\x92\xf2v\xdf\xe1\x07\x19P^\xb7\xcc\xe8p\xcc\x85*Ji1\xb9\x82zr\xc2[=D\xb8\xdb\xa18b\x97\xfd\x89{\xd7\xc4\x0c\xd0n~\xce\xe0v\x86\xc6\x96J{\xa3\xbeOJL2{\xdd\xc5\xb3\x16\xed$\xe5n\xf7\xb6V\x93+g\xd4\xda\x166\xd2\x8d\xad\x10\xa4\x1b\xdf\xcf\xc7\\\x898fe\xd6/~\x9e\xe7	\x7f&\x11\xc3\xa3<\xa0\xfd1-}\xbf\x1c\xd3\xc1\xf0o\x13\x11e\x06\x93\x0ekz\xeb\x9d+\xb6\xd5#\xd6\xb5&\xf1\xc2\xf4\xa7\xc9\xf3n\xdb[sVV\x05\x7f\x07\xa6\xbf\xdav)\x8c\xfc\xa2\xd077\xa5}\xdf\xbcf1\x93\xe7\x1d\x87\xfb\x95\x0cl\x9a\xb82\\\xa4\xb4>v\xcawsRRe\x1d\x0fV\xee2P\x0f\x0d\xd5\x9buL\xa4\xef\x9b\xd3\xdf\x94f\x87\x12\x90#`\x8cj\x0b-)\xcc\x8c\x14K\x18\xf6\xa0^\xaa\xf5;:\xb9\xaa\xafm\xad\x80\x1bU!\x12\x13\x01\xb2\x16\\\xf8\x8d\xfa\x98pgR\x90\x073\xdf\xa3\x9a\x0b\x10\xa73\xa3\xd6L~\xc4p;\x8c$\x0d+\x8b\xc7\x89\xbar\xc6@w\xa7XY<#+;D\x1e@\x16+#F\xb4\xd8\x10\x15\xc4i^T\x92FT\x8br\x87\xf5>7=\x044\x11\xd4\x00\x99\xce\x88\x928\xa2\xbe\x85\xd6\x7flH\xd2t\x0b9\xb3V_\x1c\x83g\xa0\x1d\xb8\xde\xf6RV\xea\x8b\xbdp\xd9K\xd1\ne\x10\xcf\xef\xb9\xba-f\xf4o\xf5\xa3\xa1\x11\xbb\xcc}\xff5\x07kOf\x12\xc0\xb5p\x10g1Q\xd1\x19\xdc7\xce\xcc\x03	\xb7\x86\xc7\xbc\xbe\x17y\x12\x82M\xc4\x96\x97\x8a\x9ej\xa79'\xd6\xcaC\xb2\xa2\xbc\xe7\x88\x9d\x04\xf6bVH\x85+V\xbe\x1f\xbb\"\xe8\xc2\x891R\xe8\n\x9er\xab\xa6&\xbe\x8f\x92\x90\xae`$\xac@\xea6\x97\x8e\x17\xbe\x8f\x16\x10\xdf\x9f\x05+\xb8m\xa6\x99\xf3k\xda\x1f\xbd\x1e\x17\x06\xcd\xd1k\xf7\x94~C\x8b\xe9\xeb\x19i\xd4\xff\xfd\xd1\xddx\xd3\x93\xf9G\x9e\xd5\x86\x85\xee\xecT\xdeQ\xf4\x91\xda\xd4\xe9\xdd\x0ck\x05\xc2\x1b\xb6V[e\x90\xbd\xa7\x1f\x9b\xb8\xd9~\xff\xf0H\xee\xe9G\xb7\xe5t\xd7\xa2\xc3-\xfd\xa8\x98.\xdd\xa9\x1fx\xb5\x06\xbfdkJ\x99\xcd\xc0\x0b\xb4s>\xc9;\x8en\xb1b\xbd\xf7\xbe\x8f\xb6\x01\xbd\x876\xdfC\x9b\xc9G#\xda\xd2-\xf9\xe8\xee'\x9e\x19\x10\xaf\xeb(\xc2\x0f#\xb6P\xbc\x96,\xe8\xce\xf7w\xcd'\xe0V\x7f\x98|-\xa9\xa3\xc9\xdf\x8a\xde\xef\xad\xc0\xd8\xf4S\x19\xb8\xb8]Z\xdb\x83\x7f4Ct\x85\xf5\x02\xae?\xc9\xad\x1eusCV\x95Ln\x8c\xdej\xbe\xdf{\xac\x929\xf0\xf7\xf9\xe8\x94\xa2cn_~\xce\xed\x03\xbc\xb9\xe9\xf5p\x80\xf1\xc7\xde\x86\x17q\xbd\xbe\xceI\xa5\xf5\x13\x1f1\x99\x9b\x1b_\xb0\xb6\xdd\xe0\x87\xb9\xe9.\x95Qatm0\xfa\x96\xc5\x1f\x97\xea\xd5\xebs\xb8\xa1O\xae\xe8\xb5\xef_k\xcd\xc7\xe8\xca\xf77\x1c]\xd1\x98\xa3+u\xc0<oD\x889\xb9\xd2;\x93\xee\xf6\xfc\xca\xf4)\xc6ji|G\xef'\xf70\xfa\xef\x15\x1f\x1c\xcd\x03\xfaNU\xfa\xdc<\x19N&I\xb8\x8e l\xb8\xc3s\xdf\x7f>\x9e\xfb>:\xbb\xd9\xef\x9f\x8f\xdfM\x0c\xfd\x80\xf1:\x98\xd3\xb9\xb2\xdcb\xd2\xe6\xdc\x84\xc8\xf3\xf0\x1d\xb9%\xf1	^\x1b[^\xfb\x88\xc9\xe7z\x88\xb4\xa8\x13\xbc\xc3\xf8q\x1d\xd0\x8fF\x016';u\xd6Y7|\xd9\x1a\xb7\x18?nL\xce5\xd98	tI\xca\x80.I\xda\x94L\xc9\x1a?\n\x87\x8fX%'}\x81x\x83\x1c\xbc\"4\xb9\x0c(\xab\x01\xad\xf3\x99yVb\xb2\x827\xb5\x0e\xc8\xa0\xe1;m \x81\xc3xjv\xadyOe9\xc9k\xcbI>\xd2\n\xf8\xce\xf7\xb4=\xc4F\x96(\xf5C\xbb\xef\xe1u\xb1zt\x9c>\x1e\x1bnzmV\xf5\x86\xa1eTI3\\	q\xce*\x0e\xeb\xba\xe1\xcb\xe66\xb7\xc5\xa9\xb08\x954\x07\xf1=\xa5\x0f5\x073\xba\x82\xa8$\xa2T|C/\xa5g\xa5\xef\x9fej\xd1*0\xd3s\xe2A\xf3\xcc\x08\xce\x9a\xcdu9\xc0\xa7\xa2\x88M\xed\"\x1b\xc2y\x0fbp\x89\xb8\xce>{\xc4\xd8pT\xb2\xa25\xa5\x06`\xdc\xd0\xf7+\xb8\x08\xeb\xd6=\x81\x18\x9aF\xa8\xdc\xef\xcfV\xfb=\x18P5\xb32\xc5\xeeu\xb9\x8f\xbc\xb9G\xac\xecdf\xf2F|\xe2\xfb\xbd\x0e\xbfbk\x91\xee\xb0\xefOM\x1a4\x99\xe8\xf0\xcf\xaa?I\xab\xd4`\x88\xb5n\xd7-\xbe\xdf{%\xcb\xca\xb0\xe4\x85Xx3\xb3\xdb\xe94\xefr\xb8\xef\x7f\xafl\xedJ5\xfa^)\x0e\xaf!4\x98~\xef\n	V\xab\xa3\xb4\x15\xa5\xd2V\xa4\xb5\x8a\xa2j\xf4\x16\xb0\x9e\x16\xa3T\x1dd\x16\x01\x85\x1bMa\x8aI\xa5\"\xca\x80V\xa4\xa2a\x85\xc9\x91%\xb9\xd5D\xd0\x8c\xe6\x94\xd1U\xb4r\x0d\x9b)3\xcb\x13Ew\xd3	M\xcei\x7f\x16\x0d\xdd\x14$h\xae\xc6;\xc9T\xfa`\x86\xa3\x0b\xb7(\x12\xedd\x02\xd9\x873\x1c5	N4\x01\x18\x173\x1c\xd9\x1a\xfb\x8a\x9d\xc5#\x11d\x97)\xcc\xc0.M\xcf\xe1rN\x90a\x92\xc1G\x8cI\x1e0\x95\x98\x9b\xc4<`\x980\x93\x98\x05\xf9e\x05\"C\x97V\x90\x98\x059&9|\xc4\x18N\xb5T\xa20\x89\xc2\x94T\x89\xb2\x07\xa6\x87\xde\xe5\xa8\x08\x04p\x00=o\xd4w\x1af\x10\x03\x87[\x19\xe8OY\x11\xdb\xd8 #\x19	\x15+\xfa\xfe\xea|H\xfa\xed\x82\x04n\x14\xe5\xbah\xee\x16\xcdu\n\xc9I\x9f\xd4\xa5[e\x19\xe4\xd0%YSR\xc5\x86\x8c\xb0\xa6\x94\x0d\xb9\xa5I\x19\x08]V4e\x05\xc4\x12a\xf3\x93A\xefi\xd7\x84\x9d\xed\xc5\x1bw\x03\xf2\x16>H\x82\xa4\x92r\xc8\xdb\xb6\xaa\xed\xad=\xa8\x00\xcb|jxS5\xffT/\xc2\xa3\x85Zn\x18YY\x00\x8e\xf5\x94\x86\xdf\xeay0q\xb2\xd9\x83\xa7\xfd\xfe\xc5v\xcag\x13\x1ei\xcd\x9b\xd1\x0e\xc8SR\x864\xebm\xc9\x81\xcf\x8d,\\\xa3\x1e\x14\xb5\xda\n\x93\x13\xc5M\x85b\xbf\x7f\x06\x8a\xe5\x89\x88\x94F\x8eHWZ\x03\xd1\xbc%\xcb\xbdn\x7f\xb7\xec\x89\xbe\xacw\\\xa0v\xc9\x14\xdd&\x9f\xdc\xc8\xe8G\xf7\xab!|+\xda\xc8\xf1?\x812\xc6\x03\xcaz$3\xa2\xe2\xd5\x16\x0e\\2\x17\x03\xd0\x1b\xf4no!\xe6y\x0e\x1b\xf8o\xd3<\xfe8\x02~\x0d\xf1\xea\xb8`\xbfG's\x81\x8e[\x80F\x99d\xb5hn\x84\xfaZAl\xd6E\xd0\x10\x03\x91\x81e\x97\xce\x9aLb\xfa\x0bG)01\x0cR}o\xceJ\xfe\x1e\xa4y\x15\xfa@64n:\x99,i|\xdc\x91\xa3\x7fCS\x81\x0d&d\xa1\xab_\xd3[\x8e\x16$%KL\xeehBvtM\xee\xe9\xcf\x1ci\xf5\xc3\xfd~o\x1e\xe0\xdc\xc2>\x1fN\xa7Gp\x98w\x1bPx^\x19\x14\xb5f~K\xef9J\xc8-\xd9\xe0\xd1\xbd\xef\xbf\xd2\x84\xce\xc8\x96\xac\xc9-\x88\x05P\xec\x8e\xfe\x0e\x996\xa4\xc0d\x07 \xfa3\xfc\xa8\xe9_\xa3\xee\x91\xcd~\xaf\x87$&M\x9a\x1d|\x1ei\x06\x9bN-W,\xc9\xb7\xdf\xa6U\x01\xfd\x07yo\xea\x98\xfd\xbe\xdf\xce\xa6$\xc5v>\x15\xb5\xdf{\xb2`Y\xa9\xedX\xd55k\xd8o\xb5\xc1\xb0v1\x13yT\x83\x8e\xffp*\xf3\x07\x95y\x17\xd0\xd5\xf9\xd0\xc8\xd6&\x93,\xf2\x8f\xdcJ\xd8\xef9r\xa3\xc9\x1c\x93k\xfa\x9b\x8d|%\xd2\x14\x8fn|\x1fi\x14a\x88\xa8\x92\x1e\x99\xd7\x98(xj\xe1\xf5\xc8\x0d\xc6\xe4\xda\xf7\xcd0\x17ij\xe2\xaf\x1bY\xea\x8a\xf6GW\x8d,u\x15\x04\xf8\x06\xb6]\x1a\x8e\xd2yT\xd3\xab\x19\xb9#;\x05\x8b\x83E\xe4\xf4 \x1e\xda\xd5\xcc\xb4O'f\xda\xa9\xf9\xc3\x0e\xe6\xcf\xc9\\p\x02\x03\xfa\x15L\xbe\xd5`\xd9\xc1\xbc\xfe\xb6u\xfa\nMb\xf5)|A]!\x93\x94\xf6\xd3\xcc\xab\xf4`\x9aW0\xcfJ\x05_IM0\xbb\xde\x93\xd8\x84>\x90\x84V\xce<[\xd0\xea\x8f\xe6\x19\\eU=\xbd\x81\xe9\xb1\"\x05I0Y\xc2\x9c\x8bII\x16\x98\xac\xe9\x86\xdc\xd1\xe5\x08\x16\xdbu@Sx\x86|\x07\xbf}3\xafvt\x1d\xb0\x91\x9a\x90\xce\xac\xda\x90%\x1c\xe07=\x08\xda\x8a\xfb\xb1\xd1\x0f\xd8~\xbcw\xf7\xe3\xb7dk\xf5\x07\xd3\xfb\x19\x99\xd3\xad\x95\x0do\xa8\xdd\x99\x91k\xbauy\xce\x15\xdd\x1a\"\xbe\xa3}\xf2\x82\xae\xc93\xba#\xcf\xe9M8\x18\xbd\x1b\xc3\x18<C\xb7t>}7\xc3\x0dM\xec\xec\xa5\x94\xde6\x94\xc2#\xfc\x0f\x8d\xfd-\xc9\xc85\xb9#/\x88\x9d\xe5W!\xbd5\xf5\xbc\x08\xea\xe0\xbb P\xcd\x1b=\xbf\x84\x0b\xd4FoO)UU>w\xab<\x82\xfd\x8c\x98\xec-\xe0\xcf\x9az\x9e\x87\xa1\x02\xfe\"\x80\xcb\xa6\xe8E\xb8\xc6!\xda\x85\xcfpx\x85\xcf\x87\xa3wc\xfa\xbc\x01\xaaZh\xb1\x0e\x0c\x88\xf3!i\xd6\xd7\x03\xb4\xef\x02z\xed\xacW\xffn\x8dN\xad\xe0\xd3\x9b\xa1\x1fs\xed\xea\xc4\x8eY\x1d\xfb\xb6\x10K\x91\xb9\xcb,\x9b\xa0\xcc\x0e\xe8\xf3\xa1\xb9\x0cc\xb7V*b\x87#\x062\x19\x85=\x80\xc9\xc0\x80EC\x12\xac\xb5\xc0\xddR&\xb9\xbe\x7f\"{\x05T\xcdQx\x80H+k\x98\x910wW^C\x91Z\x96&\xf5-'\xbd\nO\xc5\xa1\x8e\xa6\xa2\xe2X$\x80\xfb\xa5A~>\x1c)I\x00\xcc\xeaO \xa6i\x90{\x02	\xd2$\xa4\xe6a\x93\x8e\xc9\x99hm^|\xffg\x8e\xd2f\x8a\xa4v\x00\x80\xf9\x8bI\x11\x1a\xda\xb9\xe7\xa3N\xf4\xf90*\xc8\xca\x81OL~b\xa3\xf4d\x8c\xa9pE\x82Q\x0c\xf7Z`M+HIbLV\xa1\xd3)a<\x1d\xceB\xe1(\x97\xce\x875\x83v\xd6\xacg(=X\xb5ZK\x07,l\xa4\x8fO.c\xe9\xf12\x96\x1dG}\xc9\xca\xd6F\xc2\xacm'\x96\xb0\xf7G\xa8\xd4\xeb\xdd)\x10\x1fN\x80\xf8\xe0\x80\xa8\xd9\x83GJ7\xee\xf3\xab=\xc8g\x1e\x11\x8d\x9cf\x9e!\xbc\xe7\xb6z\xb5\xf6\xd5\x84P_d\x03\x8fK\x7f\xb3Y`	\xb5\x19 \x8c\xc9\x86\xbe@\xe9\xd1R\xec\x82PK\xacz:y\xbc\xeenN\xae\xbb	&\xad\x15T\x8f\x04R\x90\x15\xc6dQ\xaf\xdf\xce\x8a\xbc\xc0\xc4YY\xdd\xfc\xcd\x0c\xfc\xd9\xdd:\xc8Sz\xb0Z\\\xcf\x8b\xc4,z\xb0?i\xa2T\xae\x06\xe2\xab\x839mU\x16\xe2\x14p\xb00\xe8\x80\xb2\xb7\x1a\xdc8\x9doEoQ\xa9dHw\xb0\xc0`\x86\xde\xd3\x10\xee\xff\\P;\xc8\xfa\x1f\x8c\xe8\x83\x92\x7f*\xae\x9d\xcc\xaf%\xb6\xd5~\x9f\xfa~\x85\x1fxo\xce\x97\"\xfb\x9e\xc9\x15js\x04\xddt\xb8hW\x95\xa3x\xf2=\xb4\xb9>PV<\xc6=\xe1(\xa2\xf8\x11\xc3u>\x1eKd\xc9\x0e\x07oi^r\x0d\x1dnN\xaf\xf0\x91\xd0V\xda!\x82\xcc\xfb|\xb1@\xe0\x1dHwZBK\xa3\xfeD	M@\xd4\x86\xbd\x17\x91\xe4;NJ\xd0\x82n8\x82\xf78\xbc\x97\x14l\xab.kA\x1e\xfb\x06\x1f\x1ayJ\xaeLO\x8e\xef\xaa\x19\xdf-Cp\xdf\x19\xa5\x8b\xb9+G\x1d}\xcc/\x07\x97Gy\xef\x1e\xac,\x82BM\x19m\xb4\x0b\xa0\xda\xa8*M\xcd\xd1\xd2\x01#\xca\xdc;)M\xfa\xf7\xe6^!$\x95Gz\x17\x0c\x0b\xe7}\xf0\x81+\xcbg\x96\xb1\xc3Q\x8c\xe8\xedt4\x18X\xae\xf9|\xad_K\xe9\x96\xa3\x92\x08\xa3\xcb\x7f!4\\<\xcah\xaa\xee\xbb\xa4\xea\xbe\x0b\xdb\xef\xd3F\x12!\x05-lDk\xc9{\x04\x8c+\x83\xb1\x1e\\# v\x16P\xd0\xbb\xc1\x8d\x8e\nt=\xf6T\x0c\x84\xcd\xf7QF\xe0\xf7C\xebR\x02;q)\xc1}\\\xf8SMd\xcb%\xc0.J\xbc5\xb1\xeamh\x93\xfb\xbd\xe9\xad\xb6\x0d\xfa\xfd\x9e\x8fi\xff`\xa2\xc1Q\xee~\xefe\xb9~\xd6o\\\x12H\xdd\xcd\xc0ub\x98\x9d72\xdf\x94\x13\xef\xff\xf4\xfb}\xcf\xb5\xa3\xf2\x9b\xcb\xb8\x8c\xa9\xfb\xff9\xb4\x0f-\xb4\x8f/qNds\xcc\x06v\xfe\xbe\xf6\xf0%\xed\x1f\x1b\x83\xec\xf2\xb69H\x17\xe3\xdf\x0f\x08\xd9H\x14|\"\x95\x11yW\x9c\xe0\x13\xa9\x0eO\xcf\x87*\xe9|\x18\xe9\xef\x06\xde\xbf\x8eIm\x0e%\xed\xf9\xcf\x11'\xe7\xc7\x9c\xdc\x8d2\xec\xb0u\xfe\xe7\xcc\xc4\xaf\x14\xc9%t\xe7\xc3#\x11[c\x8bb%J\"\x9b\xad\x03\xff\x8c\x93!\xb8=\xea)i\xce>\xa0\x86\x920\x96`\x04a}GS\xa5\xab\xab\xa4\x8b-\x92\xfa\xd35\x14~\x0b,Ni\x80\x8c1\xd3[k]\xba\xa4S\x876\xffT\xa8~\xc5[(6\xc9?\xb8\x83\xa7\xd6\xe0+\xfb\xa0M&)\x9aL\xcau\x0b:S\xaa\x99y%R)\xb2\xdb\xdb\xfd\xfe\xa4\xff\x8c\x82\x97\xe2\x13<\x17?\x9d\xb8(x\xb9r\xaa\xe1\xa2=,:\x1f\xb7=p\x0e\x89$\\\xd5\xfd\x16zOd\xcb\x1f\x81\xb5\xe3Z\x9c\x06\xdf\x98\xbe\xff\x11\xdcCn\xd2\xdd;\x1b\x85\x9cdL\xbe7\xbb-\xca!h\xceM\x049\xfb\xb8\xed	\xd8h\x94\x00\xf3\xfb\xad\x83\x8b\x10\x8dq&\xc7\xc0\x8dzA|\x06\x17\x8a\xd5L\xd2\xdbA\xe8D\x134g\x82g\xfdz\x10\x80\x99\x05Q\x9fF\xbb\x0e\xc8\xa0Xs3\xd5\xb5?\x93\x99\xca\xff\x00\x86\xe1\xf3\x12\xec$\xa8\xab\xdbM\xcb\xd5\xb5cg9%Y\x0f:*Q\xcb\x1f\xdc>(Wl\xa32\xc1\x88Q\xe9`)7/\xb8\x0b\xc3\xe1{\xb9A\x07\xaa\x1441>p\xcc\x15\xf8\x97)\x87/\xe4%\xe2\xce1\xee\xa5\x07l/.K\x10\xb9\xe8\xd4\xb3w\xd4\xa3\x82\xa7L\n\xb0\x93\xef\xe5w\xbcX\xa4\xf96Z\x89$\xe1\x99G<\xb3\x97\x08\xa4>\xb8\xf0\xcce\x03/\xe0&bc\xae\x80\xf6A]\xc5\x8a\xa5\xc8Tp\xae&rh\xee(\xd4\xc7\x1a#\xb0\xd42\xf2\\\xa7\x1e\xcc\x19\xcb\x9d\xd6\xc0z\x9e\xc2+\x04\x18^\x93\xcf\xc4#\x1c=\xc0\xce:\xea\x83A\xfc\xa8\xef\xd0\xa80c\x97d5pe\x83\x0e\xee\x89\xed\xf7g\xf9m/V\x8e(o\xaa\xcd&/$O&\xa5\x1d\xedQ~\xdb\x9b\x17\xf9\xb6\xe4Eo!\n\xbe\xc8\xefkcb\xbd\x94\xedx\xf1\x1e\x14C:\x04\x83\xa6\xa7-\xeb\xbf\x9f \xb0p\xff\x9e\xda4\x02\x9f\x1f\xec\xe7\x07l\xbd\xfc\x1c\xe77\x11u\x01c\xaa\x1fG5R.\xc9\xeaH3\xe8\x14	G\xb6\xeeX\x91\xed}\x08\x97\xa5\x17\xb2\x06\xa9\xa3?\x84\x19<?h`\xa55\xac\xfa\xf6\x88\xbe\xac\xbd\x15Y\x92o{\xca\x01\x01\x06\xd0f*\x99\x0b\xfbp\xd1\x0b\xf8\n\xcc?\xb8`\xde\xd8\x95\x95`\xdf_-B\xd6\n\xad\x8e\xe2\x19x&\xcd&\xd6\x87\x81\xf2	\xc0AT\x898\x1c\x9cgK\x9e4qp-Z\xf5a\xae\xb0\xd3/\xcfL\xa7B\x04\xe1\xd6\xc1\x00\x9cl\xd5~\x07&\xee\xc7\xf9`\xd8\x8fB\xc4{	\x97L\xa4 \xe1\x9e_\x98\xdb\x8a\xbc7\xaf\xa4\xcck\xf3\xe1\xe6\xac@\xfb#\xf0}\xf50\xca\x9c\x96\xbc\xdc\xf6$/%\x9c\xfe\xee6\xb0V \x93\x8d\x0e|6\x19DC\x9fM.\xa2'>\x9b\x0c\xd5}\xa2\x86\xb8UM\xdc\xb7\xdb\x89\xec\xb1$Q\xee\x16^\x8bR\xf2\xcc\xb8Y\x8bd\x8fI\xc9\xe2\x95JB^\x9eyA\xdb\xf7\xdf\xaa\x0d\xa5\xe0p\xb4t\x12P\xc2\xff\x10P\xdc\x9ej\xaa\xa9\x97\xce\x0b\x98D4\xc7\x9d`\x7f	\x1c\x94\x80u&\x90\xda\xc0\x00\xde`6\x1d\x98\x18xH\x7f\xe8F\x86\x83)\xcb\xae[\xe1\xc2\xa9p\x8a\x0c\xa8\xc0\x80\xc6\xe7C\x82\x0c\xb0\xc0\x00\xc7\xe7Cg\xa9\xdc8\xc5\xb5\xab\x0e\xd5\xd1\xe6\xa6\xdd\xf1,\x9dx/\xde^_\x83\x87\x90\x9b\xb8\xc8\xd3\xb4%\x95-k*\x1aN\x0d\xe6\x9c\x94\x0b\x8c\xebe1\xf2\x94\x95rs\xce\x93\xf5\xe2\x94\xb3\xc2l|\xe0Fh\xc1\xe3|\x99\x89O\xb07\x00\xf1!KRUm\x96|\x07<\x13\xc1`|\xaf\x86\xe4\x07\xb5\x11\xc1f\x8c\x13\xd9K\xf2\xb5\xda\x1cz0\xfe\x0f\xe07v\xf6r=\x99x\xcb\x0f\x07eNe\x89(7L\xc6\xabw\xb9\xe5\xf0\xd6\x10Qn*{$\x8c\xe4f\xc2:\x9cp\xad\xa8\x08\xd6\x8a`r\xc1\x89\xd3Y\x9f\xa8\x06\x82\xed\xf5\x1cL]\x9b4\xf8\x86\x0b\x8b\xee'-\xb9\xb4\xf9,Hp\xc8b\xf2(p\x83G\xf2\xb7\xbe+\x9f\xdc\xb9\x03\xa9\xb7\xc9\xd5\x9a\x0e\x9e\xdc\xcc\xa0\xf16\x1c\xfcz\xc1\xd1\x9ca\x17\xb4\xe5tn'N9\xdb2\x03\xce\xc1\x02\xee\x94\xf2\x06\x13|\xe4%\xd0\xf1\x8f\xf6\x98\xa0\x9f\xb7\x8d\xb7b\xae\xda`zR\xc9zk\xf4\x0b\x1c\x13\xc2\xedH8\xa4\xfc\xee?\xc9\xfc\x0f'\xb3hC\x06\x0f\xaf\x90\x19\xec\xc1\xe0G\x87H\xf7\x87\x8d\x84\xc9\xfa\x90 \xde\x80\xe2\xf8A\xb1\x90\x0d\xf8\xd5%\xa2\x85/~\x04&\xf1\xce\x9a]k\x04\xd1$_[\x93=5ej\x97\x00N\xcf\x9a,\xcd$P\xc2\xed\xb7[\x13o\xab\xa2\x0f\x8f\x04\xfaCU\x90\xdf\xda\xdeT\xfc\xc7Y=9\x10Le\x8aP~\xdbS\xd5\x1c\xe4\xf1}\x0e]\xa02\x11\x0e4SA\x87$\xb7-\xc1\x06\xd0\xf9}\x8b\x04\xbc\xc3\x93\x8e\xb3\xdd\x0f\xdb\xa9\xe8\x89d\x06\x86\x8b\x9a\xb2[S\xf6\xb7\xed\x81#\xe9\xb9\xa2s\xc2S.9\x94\x95\x0e\x87\xb9q8LG\x1e\xed\xa8\x8dc>\xd8\xb3MgQ\xab\xe4u\xcdN\xcc\x89\xb5\xdd.(\x11C\xf6\xf8z\xb3bp\xe7\xba	\x1e\xa4\xa8\x02u\xa2)Y\x8b\xa9\xda\x8f\xaa\xb5\xef[\xfbQ5\xdas\x01>\xd8\xceZ\xa5\x0f\x1d\x0c\x83\xe7T\xf9\x99x\xd4*	\x9e\x92!'Xwto\xbf\\9\xc49\xfb'8\x93\xdc\xef\x7f\xd0?-R\xbd`\x92Od$\xb5\xd5\xb8\xa6\xfc;\x97\xb8\xaa<\xd8\x1f;\xa6\xb23\x00^\x98N\xe4\x14)\xab\xfa\xd6\xe006g\xf6\x0b$!\xba\x99!\xce6\xf3\x81\xdf\x8bRF\xf2\xb1\xb1\x8c\xf5\xd5\xd6\x9dM\xd2\xb8fU\xa8\xe8\xdbN9\xed\x83\x11(Kep\xf7\x0d<EL\xf3Y/\xdf\x00JV\xf2\x93=\x91\xf8\xbeJP\xf5\xf4D\x02\xcbPO$\xf0^\xcd4\xd2)G\xa5z\\\x8d8Xn\x86A\xd4\x9c`\x9d\xa8\xb4\xeeV\x0b\x1eV\x8c3\xe4\xc0\xdb\xef\x8d?J\x85G\x83\x93\x8e\x07L2\xb6\xe6\xfb\xfdk \xba\xfaax\xbfg*\xf6\xcc&\x03\xaa_\x84\xeb\xe3\xe3#&\x87\xd4\xe3\x87\xd43\xe3t\x945\x14\xac\xc7(\xb8\x8d\xc9\xdc\xc6\xc0\x90\xadiz\xf6Z\xa0\xdc\x98\xe8\x05\xe4D\x82\x1f\x9c\x0cT\x9a;\xfc\x8f\xd9e=\x07|\xdfX\xa2|\xd0P\"\xf9\x88\x01M\x87\x07<s\xd6\x9d\x1f\x11\x1e}\x05\xaa \xa7\x05\xf53.i\x10\xcb@\x90/9\xd8\xa2\x17\x89f\xe6\x7fPF\xd7;\xfa\x88\xceLw\x80q{\x91\xc0\xe6\x13\xf6'\n\x08\xde\xef\x9d\x0f\x18\"\xc4\x13I'\xa96\xa9\x80\xc7te\xd4\xf1\x02#1'`\xea\xd8\x0e0\xc8\x7f\x80\x0d9\x93\xbd\x8f|w\x95-\xe0\x8d`\x1d\x86\xf7\x8b\x7f\x8c\xa8j\x1c\xc9k\x94\xd5\xc5=S\x1aF\xd6?\xb7(\xc7\xaa7\xf5\x0017>s\xf51\xd1?\x81\xe7E\xd9$S\xe1H\xce\x03\xd8I\xc1\xe0S\x88\xd6\x1aY[P$:\x11B\x81\xe75\xbaE\xb8(\x98\xe4\x0f*\xd1\xfb\xb5\xef\x05\xcc@\x87p\x11\x04\xe6\xa9\xbf& \xe4\xc2\xf8Q\x13\x81\x99.q\xd7\xcc\xe7-\xc1\x02\x00\x99\xa9~\x86@\x01p\xc6\xebm\x88\x9c\xbb\x0b\xcb\xeb\x13\xac\x08f\x8f\xef\xc3\xfb\x10d\xa7q\xb3\x89\xf9\xb5\x7f\xcb\xe3\xdb_\xfb\xee\x9b\xa3\x8f\x869Y\x9aw\xea%\xa0\xcd\xb4\xe5!\xd3\xb6\xc9L\x19s\xeb\xc1M?^^%\xa4\xa0\xb0\xfe\xc0\xb0O\x98d\xea*><\xda\x11\xc0g\x94\xa5s\xb8\xf7^\xdf9O\xc7\x95z\xfdcm\x8cM\xd3\x19\xdc\xc9)\xa7\xab\xd9\x04\xfe\xa8N\x8c\x10\x18\xe9\xdd\xef\xd5\x0f\x0c\x14\x0c)\xaeE	\xc33\xad\xa9\xd7\x86+i\xe5\x18\xacf\x87K\x89\x9d\xa9g`@g\x06Y86s1\xc8\x9d{\x9a\x8c\x1a\xd3{g}<bG\xd3\xd6\xb6;\xcaI\xdd\xde\x88=ZEpG(\xcd1\x0co\xc2\xe8C\xfd\xd2[\x18\x9e\x9fc\"\xf4\xaa\x00r.&\xd3\x0c\xe5\x98d\x88\xb9N\x86\xbf7}d\xca\xda\x0dw]\xdf\x95z679\x8a\xa9\xb7\xe6u\xfcd\x87\x9c\\x\xb2p?\x1b\xa6\xd2\xc8\xa5\xb5\xcb\xfb\x1f\xd9V\x95\x01\xa5\x0d\x8eND;`\xebza,\xab*!\xa0k\x83\xd0\x1fV\x04g\xcf\x07\x95\xe8(U\xd4\xf8\xe4p\xd4XoD\xfd\x14\xdd\xbb\xbd\xfd\xb5\xcf\xe3[\x91e\xbc\xb8\xf5\x021\x0f\x82\xc0\xbb\xf5\x02u\x85\xaf`Y\x92\xaf\x11\xdc\xa9}%\xeey\x82\x9e\xd6K\xea	|\xf8T\xea\x11'\xd5\x88s\xc6\xda[WH\xba\x05\x83\xef\x86\xff><\x8e\xb2)\x0f<E\x10\x0f\x9c\xd9q\x9a\x99\xee\x87\xe1\xdf3\xe6\x06\xaf\x99\xc8\xd4\xbe\xe1,\xdf\xef_\n\xc4In\x0ba'\"q\xbf\x80\x04\xf0\x8d\xf8\xb4\xce\xac\x9c'\x00xw`\xb5\xd7\xb7\xfa>\x0d8\x1f\xcb\x95\xbd0\xaf\xee'\xd0\x0b\xe7\xbe\xdfD\xe8q\xa4\xa2\xad\xcd\xa9\xbc\xb7\x86\xfd\x19:\xffo\xf4\xeb6\xc0H\xd5\xbc\xbfJ\xf6\x80\x10\xfe\xea\x1c+\xc9\xa5T\xd7\xc9\xc0w\x06X\xe8\xdf\xefO\xfa\xfd9Cg\xe5~\x7fV\xd5\xab\xcc~\xef)\xd6dn	\xd4g	\xd9~\xaf\xc8%\xb28\xad\x12n\xc9U\xfa~\x8a\x8ecI	F\xa8,\x03yX\x9b\xdcQ\xf98j\x83gij\xa0\xa3\xd5\xb4\x9a\xd1\xcc\x1e\x01\xca\xde\xef\x15/v\xcf\xf3\xf5&\xcf\xc0+6Z\xe1\x11\x9b\x96\x81w\x9d'<-\xbd\x19\x8dI\xf3\x0d\x9f\xd3\xbe\xb1>\xd4a\xd3\x1c\x8cab\xe2<P{\xd9\x9e\xaf\xca\xb2\xc0\x01\xf7iyF\xae\x07\x94l\x99\xc7\x98\xb4?\x8db\xe5\xc0J\xfe\xa7\x83\xbazK\xa7\xc8\xa4\xfd\x898\x8e\xda\xc7G\xdf:K\x8f\"]\xe4y\xa4\xac\xe6\x91\xe7\xd5\xacJ\xbd\xbb\xa2\xf6\xe5[6\x07-\x15dU\x16\xc5\xa1\xaf\xe1\x1c\xb1\x9a+U\n|\xb6\x94E\xffV\x15|D\xe7\xff=e\xe1\xa7g\xe1/\xfd\xf0\xbfng\x01\x9a\xf6f\xad\x08<\xf9\xea\\+\xa4$&\x7f\x8dmW@Ov\xbc\xbf\x062\xf8\xab\xd7\x11i\xca\x97,\xfd\xabC\xba\x7f\x98\xd6\xcb\xdeW\x8e\xabh\xd8)\xf6\xf8\xbd\xe4Y\xe2\x98%\xaa\x95@p\x80\"\x9a\x84\xc3\xd2\x93\xf6\xa7\xd9v\xabR\xcd\xce;\xe2\xa7\xe3k\xaa1$z\x9b\"\x979\xa8@@\xec\x11\x16#\x80d\xc2D\xf4\xcaj\x03\x93$M\xe9+a?\x9f\xc1!\x03\xfdN\x90\n^\xa5\xc1v\xb2\xce\x98\xb2\x12\xcc\xa6\xba\xee;~v\xfap\xaaX\xa0(o\xe3\xf4\x93G\xd8<\x08\xc8i\xdew\x81\xad\xf6\xfa\xd6\xc3#\xd9\xa0\n\xc3\xeb\xacOdO\x94Wf\xe3\xd8\xa2a-\x99\xc0\xf1\x04\x0c&\x975\xbe2\xdd\x01LI\xd0\xe7\xa8\xa6\x17i\xdcdC{\x9c\xc6\xb4j\xb64mi\n\xbf\xab\xa7\xc7\xff\x14\xc2/\x06\xad:\xc2\xa1X6\x95j4\xd7\xaa?x\xb06\xcd\xe7\xc0\xfc\x91\x93\x0c\x8b\x00D+\x97\xa9\xfcQi\x93\x1f\x1e\x8d\xd6\xf8A\xbd\xf8\x00-\xe6\x12\x94\x97\xbae\xf4\xd4~\x0e\xc0\xa3\x7f+\xcd\x07\xe2\xf4[\x08\xe0^Y\xcd'j\x0e\x01\xe7\x85\xb7H\x10=U13\n\x1e\x1b\xa7\xdc !\xe1r9Q\xb3\xfa\xb8\x0e\xbb\x13\xc8\x01k\xc5\xebs\xdf\xcf\x01i\xdfG9\xe5\x93|\xcagjm\xc6D\xf8\xfeY~hv\x0d\xf1\x89Ws\xc1\x8e:\x1f\xe9\x81\x99\x135\xab\x03\xaf\x93\xe5\xb2\xa3\xa4\xf0\xb2\xd7y\x9d\xb3\xa4#dg!\x8aR\xf6\xbcH\x06\x9e\xd2\xf6u\xcaU^\xa5Ig\xce;\xe5\x86\xc7b!x\xd2k\x8elr\x17{^~\xbb\xb3\xdc\xddi	\x9cy~+\x9aK\xf2\xd3\x19\x11MGXH\xb0D@\xdb&	\x12\x8d@\x01\x1d\xff \x0c\x1d\xb9qv\x84\xd5m\x0d\x15\x16\xd0yJ\x01Y\x1e\x12\xb0\xe1\xa1\xbavrvVWj\xb0~\x96\xa6\xaaT\xbd$5\xc5\xad\x0c2\xad\x11LP\xe6\x08:\x80\x97q\xc4!`\xa3#\x0d\x167\xd5\xfc\x00\xd2Q\xfb?;D\x01\x84:MUH\x01\x18\xfa-\xdcw\xb0\xc3\xf0\xe7\x15\xcf^*6h\x07\x86e\x8bp\x10\x81\xecGS\xb5\xb5\xdeN\xf3F\x01\xe7\xe8\xa8\x0e\x068\x82\xba\xa0\xcfq#\xee:\xba\xe9\x9f\xdc\xed\x89\xbc\x0c\x17s\xdf\x97\xe3\xc5\xbc\xc9\xf1\xbe\x9dc1W\x0eE\xdd\x1c\xbf\x89\xe3\x0b\xff\x83\xc6S\x12\xeb\xb2.b]\x19\\t\xf3.\xc7A\xde\xcd\xbb(\xeff\xc1E\x97\xb5t\xf6\x1f\xfe\x18\xceE\x17!\xed^\x86\x05C\xf0S\xc11\xf8Oe\x01\xca\xc0\x07d\xdeu/+\xff.N\x99\xc1\x81:\xb5\xd7\nIJz\x01@\x86]\x1e(\x87\x17\xca\x9f\x85\xc4\xa4\xa22\xcc\xe1a@\xb7\x0c/\xba\xe0\xcd5\xa6e7\x0d\xff\xab\x0bno\x13\x9av\xd3\xf0\xa2[v+k\xae\xf3'\x81V\xd8\xf7\x7f\x12(\xc6\xf8'\x81J<\x81\xfb\xa1\xb4\x1f\xa1k\x1a\xa6\xe7%\x1cH\xf9\xfe\xf5\x98\x82\xf9S6]\x80\x95\xd7kg\xf3\xb2\xa1q7\x0e\x9ftW\xdd\x04\xf8\xc1O\x02m\x8c\xa8\xb4\xa4\xf1\xf9\x8a\xaci\xb8<\x1f\x8e\x00Zy^\x04\xcb\xd3\xf0\xc8ZE\xaf[\xd5\xac\xcd\xf1\x95X\xa0\xcd\xa59\x15\xbb\xa3\xc9\x1cm\xc0\x81\xc2\xaa[\x06\xf0x\xa6\xe8\xa20\x0e\xee0\xb9oG\x85w\x18jEa\x19\"\xb4\xa3\xbbq\x7f\x12\xc6s\x14\xee\xc8z\x8e\xa3x\x8eT\x00\x07\xe8\x9e\xde\xdb\xb4{\x9b\xa6\x02\x18\xe3st\xd1-\xf0i\x1a<\xd64\xb8\xa5h\xd8]\x19\x92\xc7\xf8\x1c\x0d\xbb\xc9\x1c\xad\xba\xab.\xdc\xe4\xdb\xeaG\x92\x0c\xfc\x11\xdf\xe2\xf3\x0b2\x876\xac0\xb91\xae\xac\xf2\x12m\xe1\x06?\xa0:\xec\xce\xbb7\xa6Z\xb2\x86\xa8`\xdeE7\xc1r\xde\xad]\x1cok\xc4\xc8U\x9d#<\x9dct\xfd\x9f\xd0\x9b\\\xa9\xdcW\xad\xe8+\xfch\xe7\xde\xa2\x19\xa3\xff:1\xde\xbf\xe9\x8ap\x00c\xf2\x9b.X\xf7\xf9\xaf.\x0f.\xbaYx\xd1\x95\xe1\x7fu\x85\x1a\xb4\x1c\xbe\x94m\x0e=X\n\x8c\xdf\x83\xce\xcd\xf7QL\xc3\xf2\x9ciZ\xc7\x1a\x83\\Y>\xa6\xb13\xde*\xca\xba,|\xd2-\xba\xa5\x01Qa\x9c\xc3\x90\x0d\x19\x10\xde\xba\xa5\x017\"v\xd0\xac\x80\xe0\x15\x98\x1d@!\x0bV\xaa\x83\nx$\x83B\x16\xda\xcfQ|\xb2f\x92\xa8\xe8\xa4\x85P\xd2\x90\xc4\xb1|\xf8\xd5\xc9i\xabg}\x1e\xc0\xa45\xf3>\x00\xab\xf4f\xe2\x07\x0265\xe0\xdf\xaf\x9b\x07\x05Y\x81m\xd1\x12\xc2%\xa0\xbb\n+\x08W#5)%\x81\x0b\xdb\xb4 \x0c\xfc\xbbT\x84\x81k.\xd88<\xd1\xfb\x07pOM\xd8\xf4\x9b\x19M	\x9b\xfe\x0dv\x0d5r\xffl!\x07o\x03H\n\xa6\xb4\xf5\xb4\x8a\x891hM\xd6\xb4\xd7\xef?%wtp\xde\x1f\xdd\xcd\xa1\xda\x94\xdc\xcd\xa1\xde\xaaVz\xech\x7f\xb4\x1b\x0fF\xbb\x80\xf6\xfaO\xf1NeS\x9cT\x80E/x\x04\xb2SE~\x83\xad&\xd8C,!\x0em\xe8\xcd-\xba\x9b\x93\xdd\x1c\xe3\xf1\x9d\xea\xf3\x1d\xb9\xa3\x1b<\xd2\xf5Y\xf8\xfa\xb1\xc2\xc5\x10\x14\xdf\xeb\xf1f\x8e\xd5K\x85\x84\xc6!\x18\xb3\x8d\x8359\xaa29Qe\x02\xf7von\xd1nN\xee\xe6\xb6'7\xe3\xbb	\x8ai\xa2\xea\x8d\xd0\xfd!\xa0\x05&\xf7\x87\xb8/0Y\x02\xa0{\x0dh\xa1\x06\xc3R\x03Z\xc0\xf3\x0c\x1c\xad\xbb\xb4\xd7l\xf7Wp%\xfd\x000\\\xff>\x80\x1bcL\x929\xbasx\xff\x0fu?\xd9\x15u\x10\xd6\xa7\xedj\xed\x91\xc1\xb0\x9b\xc1Z\x94u3\xd7-\xb0\xcc\x9a\x92&\xff\xb0\x8b\xd0 \xcc\xb0^\x1d\x82\x0c\xd6\x0c\xee*\xf8xv\xbc\xfaI\xb5\xac\x08\xe5\x88S\x95\x83\x87ma\xe6LZ\xa6&mQO\xda\xe2\xcf'm\xd1-\xc2']\xe6NZ([\xc0Td\x18\x9f\x9cz\x7f<\x91\x0b3\x91\x99\x9e\xc8\x85\x99\xc8\xec\xff\xc2D\xce\x0cUj\xcdp \x80&\xb6\x17@\xf7\x99\x81\xb9M$C\x8e\xcf\x9dI\x96\x9f \xa7\"a7\x0b\x80#*\xf2w\xb3\x80\x03/(B\x06a6R\xdcK\x12\xb0dG\x19\xc9an\x97\x04L\xda\xa9\x9f'0\xe1s\x98\xdbN_\xb3\xecx6\x1b\xcf^d\xa5gp\xec\xcc\xe0\xc2\xcc\xe0\xb2\x9ea\xda\x17\xd7`\x94\xb83\xf8\x07;X\xeb\xc9\xf4\x83\x1d\xab	&h\xed\xce^x\xd9P\xd1\x84\xc4t\x8dGqk\xf6\x82h\xb10\xb3w\xa5f\xef\xc2\xea_7\xb4\nWdI\xab`5:\xa8ssT\xe7\xc6\xbe@\xac\xa70p\xfc\x8dY\xb9b\\\xd1\x0dT\xaf\x86\xc9\xc3}\x1b\xda\xd2\xce\xe2\x1a\x9aq^t\xd7\xcc\xe3\xd1R\x0d\x91\xbbq<A\x15]\x92\x98\xde\xe1h\x05\xf3\xb8\x19\x14\xe0\xec\xa1\x05\xb8\xc2$mQ\xa6\xd2s8vfUaz\x07[\xd3\xfbV\xb5m\x06\x14\x1c1\xc0\xe5\x10F\xa1\xebI\xa1\x7f\xc0\x06\x00x\xdbT?\xaa\xa32:\x18e\xcd\x9d;\xd0\x89C\xc9\x0c\xdel\xdf\xce\x11\x8c\x15\xf0\x01V\xd0\xed\x1c\x15\xe6\xa3\x84\x14\x187`M&\x85\x94T\x7f\x8c\xc0c\x17e\x04\x1c\x95\xd2\x92\x08\xf8*\xb4\xc3\xcf\xd4\xd9`\x97\xad\x91\x85\x1f\x00*\x80\x84m\x8f\x02\x04\x1f\x1c\x0c\x0f\xaa%ikR\xd4\xc2\xb4\xd5)\x0d\xb04;\xb5\xe8\x98E\x99\xc4\xf4_\x82$\xf47\x01<\xdd\x8c\x82\x82<\x9b\xeb#?E\xf6\xc1y_\x93\x1b\x02p\x0d\x9a\x86:40\xa1\x15\xed\x8fV\xe3\xc5h\xd5\x8c\xb0\xc4\x015]\xcd\xf0(5M\xd8\x10\x08a\x0dg;G\x1b\x15\xc2\xca\xbd\x06``\xb9\xfd\xf39>\x86\xbb\xa4\x89\x93\xc1\xc0\xd5\xd4X*\x14\x01\xae\xa6\xc1R\x85\xf0\xa3\xadW\x1e\xd4+U\x08\xc6\x91\xc6\xab8H/\xeatK\xed6|\xae\xe1\x13[\x7fy\x90^\x9a\xfa\xebn\xa8\xb2\xcf>\xca\xcaHE\x7f\x10d\x05x\xdf\xceQ\xaai\x87\xc1\xfdR\x1f\xf8\xac\x8dVM\xb7\xd1	\xad\x0c\x8dW\xf0l\xa62\x84\x89\xf1\xa80M\x82\xfbg	&\x85\xc1\x90\x13\x06khY\x13@\xb1\x98\xd2\xe0\xabS\x1btW\x9fgnT*\xf3\x0b\xf0\xd8Y\x89\xc3l^\xa2<d\x8a1\xc4__\xcf\xc7\x03\x1e>\xf1\xfd\xf8\x12~\xed\x91\xad\xaaV\x86BW\xc8a)S1\x81\xd0\xe7\xb7@=\xca\x03\xfd\xa0\xf9J\xf1\x91\x9b9\xca\x95'Pr\xa5\xb8\xc8\\}\x03\xe3~g\xd3\x99N\x7fg\xd3\x153\xe7\xa4B%\xb9\x9a\x93w0\x86Pj\x83(\xff\x9a^\xcf\xb1\xb2\x10\x91\x07\x10$\x885QLG\xe5\x97\xf0\xd8\xb5\x98\xa8\xcf(\x1f3\xdf/\x9a\x02\xe6\xddMB\xd9\x88\x81\xf1U\x9a<\xb69.\x1b-\x02jl	\x9c\x0f\xf1\x02\xccN\xa1\x17\x16\xdf\x85\xc6\xf7\x85\xc5w\xd1\xe0\xfbb\x0e\xcfF\x00\xdd\x17s\xf0q\xdbtE|\xd0\x15\xd8\x1a\xd4\xcf\x0dq\xcf\x06\x8aG\x97\xe0\xaaF\x9d\xbb\xa9S\xeb\xe2\x92\x07\xa5\xef\x17\x97YP\xee\xf7\xc5\x98\x87\xf05\xce\xc2r\xbfg\x97\x12\xd2\xd8\xa5\x8046\x96\x90\xc6\xc6\",\x1b\x90\xc6\x1fgm\xd8\xae\xeek\x16J<\xa6\xa5y\x1d\x0d\xc22\xac\xaf\x19>G2\x14\x18wYX\x04\xa8\xa2Hv\xb3Pt\xb9\x8d\xb7+\xf7\xaa\xbb:Gi7\x0d\x06\x1aJ\xb7<\x1f6\x8dMN\x8c;-\"\x9bF\xa7\xedF\xc745\x80\xcf\xd0\xea\x92\x07\xb1\xef\xaf.3\xfd\xc3\xf4O\x19\xc4\xfb\xfdj\xccC\xf8\x1ag\xfa\x87\xe9\x9f2\x8c\xf7\xfb\xeaRB\xce\xeaR\xe8\x9f\\\xff\x14P\xae\x1aK\xc8Y\x8d\x85\xfe\xc9\xf5O\x11\xc6\xd8\xf7O\xc8\xf3\xe0\x0dG\xe9\xde\xc64v\xdb\xb58\xd1\xae\xba#\x8bv\x9b\x94C#\xd3\xa6\xf4\x92\x07\x95\xef\xa7\x97\x99\xfeaA\xb5\xdf\xa7c\x1e\xc2\xd78\xd3?,\xac\xf6\xfb\xf2RB\x96\xf2R\xe8\x9f\x1cr\x96c	Y\xca\xb1\xd0?yXa\xdf?\x90\\`A08W.\xce\x1bG\x15\x8c\xe4\xd7\xf4;3yd\x00A\xd7\xfe\xc5\xf2?l]\x19RI\xd2\xd0X\xbb\\\xe9	\xa3.b\x96\xdd2H\xbb\xa9b\x03\xab\xb0\xba\x14\xfb\xfd*\xa8\xc6v\x14\xeaK\xf3\xf5P\xcc\xc2\x1c\x7f\xfd\x8b\xe686\x87\x12\x90\x8d\xc2&\xa6\xd9(\xa3\x1bu\xda\x9a\xc3ol\xd4\x19*23\x919\x1eej\x9e\xe6\x01\xfden\xdf*\xeaJ$\xcb\x86(%e=|\x13\xc5?\x12\x95\x13\xc4\xd9L\xedK\xf3\xfd>	~\x99\xeb\xcf\xe0\x97\xf9\x98\xbav\x99[\xd4Q\xbd\xce.\xb9\xef\xb3\xcbl\xbfgc\x08\x8d3\x83~G\xa1\x9f9\x8ep;\xda\x16LA\xb31\x9f\x0c\xa2p\x00r+\x03\xa1W\xbbW\x1d\x9c)\xdf\xf1p\xe1\xb7\xdc\xef\xc1%\xf0\x98\x83`\x1c\xc2V\x08\x88\x90\xd2\x12\xf6\x1c\x12\x07\xb5)c\xf01\x90O\x06\xe7\xfd(\xbd\xcc'E\xe4\x1c\xb4\xde\xb5.&5\xb3\x1e\xc4\xec\xf1o\x8e\xd2n\xd7hC\x7f\x07\xfe6R\x7f!<\x98\x11\xf5\xd7}Tv\x7fb\x84\x80\x14\xa2v\x16\xdc\xf7\xab\xcb\x0c\xfe0\xf8S\xee\xf7\x15P\xa5\x1aC\x1cp\xe0j\\\xb6\xa9\xb1\xa2\xbf[\xd9\x0f<P}\x98c\xeb\xc8\xdf>\x9b\xe8\xf4k9[m\xac\x95k\xa8p\x00\xa6\xeeF\xcb\xf1j\xb4\xb4\xc2\xca\x9a~\x98O\x973rGa\xb0\xae\xf7{0\x01\xb4\x06\n\x0eF\xce\x96t\x8d\xc7`\xc7\xdf\xbaN\xf9WS\xfd\xefs\xac\xdb;V\x14\xf0\xfd\x0d\xb8\xeb\x07\xf2\x90\xd8\xdd|\xabTLT*J\x0eR\x063\x8c1\x19R\xba\x99\xac5\x9c\xc9\"\xa0\xf1\x98O\xee\xa2\xf0.Rq\x03\x15\x97\x8cc\x1d\xb7\x08h9N\x9c\xf4\x832*]\xe7\xc5'\x94G\xb7G=\xa2Ff	\xbdQBo\x94\x97l\xbf/\xa1#J\xe8\x88r\xcc\x1a\xd2\xeaq\xc5\xb3\x9a\x08N\x0f\xa4\xed\x81[\xd1\xccdS}T\xa9\xadC\xa5\\\xdb\xd8\x0eZ\xd1>\x89\x9b]B\x05\xd2M\x7f\x94\x8cS\xe5\xa6\xf8a\xa1z\xe6\xc3|\x9a\xcct\xef\xa8\xa0\xee!\xb4iv/*\x1a\xe3q\xb1\xdf#\x15\x1eW\x93\x95&\xe2\"\n\x17\xd1*\xa0l\x1c\xabpM\x90\xd5#4\xa5\xae\xa1\xdf\xd4\xd0\xd75\x90v\x05\xfdY\xcd	6\xe3\x02\x1cR\x18\xe8\xcdP\xdf\x9e&,*\xc1@70\xb4r\x1c\xd6\xabj\x7fT9\xdcOtEXvK<R\xa8\xd0\xb0\x826)\xad\x0f`\x99\xd2\x16\xe3\x03j\xa6.\xeb\xd3\xbc#\xfd\xfa\xbda\x88\x0f\xfa\"\xe6\xfb\xf9hC\xe1\xb2?8\xec\xd1\xb5\x16\x97\xba\x85\x81\x04\x91\x00\xc2\x83Y '\xf0\x0c\xa5a\x9e\xd5!\xf3\xac\xfe\x8cy\xbe7\xdb\x86\xa6O\xfb\xa3x<\x1c\xc5v\xae%PU\xac\x0e\xad\x92@^\x1aij\xe1\xf2Z\xa312\xf8.\xd4t[\xd0\xf7\xf3`\x81	Z(\x06\xbbP\xfcv\x11\xbc\xd7\xfc\x16\x02c\n\x17}\xd0\xe2\xb2\x16\xbd \x9bc\xd7IM\xdap\x83\xc1}\xd9\xa6\xd1\x1c:\xb6P\xe6N\xb75\xcf\xa5\xb4\xf1~\xeb\xf4\x11\x84+\xbb\xdf\xa9\xb7\x84v\xa9\x01\xa7c\xc1\xcc\x1aL\x07g\x11?\xcd{\xd7 \x8f\x80FF\xec\xf7 b\xae3dx\x1fl\x04`\xce\x0f\x94\xa17\x94R\xf0\x97\xb9\x82\xeb\x15%\x04\x02\xd8M\xc4\xc6\xff%\x00\x8a\n\x9a\x9a:HI+[]cW\x1br\xbd\x8e\xf4\xcb[\xb1@\xb1\xaeI\xbb\xfdS\xf0\x94Q\x92\xdc\xde\xa2<\xeb\xeb\xdel\x90r\xb3B\xc6\xfd\xbe?*L=\xa4<]\xe1\xf3\xa6\xc2\xa4\xae\x10\x1c\xbf}\xeeG\x87\xff\x00\x99\xfb\xff\x0c\xcc\x97\"\xfaC\x83\xe8\xe235\xfc)j\xb7\x7fV\xf0K\x91y\x16\xc1\xd8J,m\x176\xb0\xb1\x81\xa5\x0d\xacm\xe0\xce\x06v\x14\x99\xd0 \xd4\x018v\xa9O1\xd6\xb8\xbb	\x12rk\xb8\x8a\xc8 f\x19,F\xab\xcb\xc1\xa4\xe9\xeb{e\xe1%\xc7\x11J\xe9=\xa9\xa8\xb1#\xba\x05\x95x\x82\xbb\xcb\xf3M\x90\x98W\xdcb\x81\x96\x19\x02\xdd\xf4\x92\xac\xc9:\xb8#;\xc2\xc9\xf6\xe4H\xda\x1ef\x84l\xa3\xc2A/\xb8\xd3\x08\x96\x0e\x82\x10\x07(\xb6\x89\xf4cd\xe6D\x104\xb3\"\xd0\x0e#\xefi\x1a\x18*\xdc\xd2\xca\x04\x1b|\xe3\x0c\xc1\xae\xe1\x9eT\xa6/\xf7\xfb8C\xf0	\xednE\xdd\x92\xb4\x1d\x05\x9f)\xa9\xfeh\xb2X@\x90\x81h\x9a\xdaR\x10u\xd0\x8e_\x8e\xe6\xe4\x9f\x80w\x19\xc4\x08\xfc\xa0\x96\xb4\xaa9\x96\xd8\xef\xef\x94\x07N|\x92\xa1\xc0C1e\xee\xce\xb9\xa8tc8[-\xd4)V\xd7'g\x03\xd2~\xadu]\xb3\xc0vVN\xce\xfa\xb0[p6\xa5W\xd9\x89\xa7\xc0\x04~{\x1b&W\xeaBg\x93\xfb\xdd\x91\xf2\xa3u\xf0A\xd3.Jo\xce\x07\x7f\xef\xc3\xca_\xde\xa0\x18w\xd5f\xf2|\x18\x14\xfaKm0\x87dA\xc3A\xb7he([\x1964\xe9&\xe7\xa8\xe8\x168Xt\x17\xe7\xb0\xa1\xc0#-s\x15]\xcan\xe0\xe0\xb24\x01=\xe6\x97T9if\x93p\x10\x0dp\x97\xdd (\xdeU%C\x15Zt\x17\x18\xd6\xe5.J\xba	\x9c\xfb\xd5\xb1A\x13\x0b\xa4'k\xba\xec\x16\xdd\xc5y	\x07\x12\xdd\xb0\xec&\xe7\x85\x9a\xb1\x81\x83\xeb:\xd4-\xb8#\xf7\x14\x81\xce\xa3n\xe6\xda\xb4\xe6\x8e\xdc\xd2\xf8\x06M\x07\xa4?#S\x94\x84k|^\x10\xb4\x08\xef\xf0y9\xc3dK\x8f\"\xc9\x9cNQ8\xe8\xda\xe8p\xd05\xd9\xc9\x0d\xc0\xda\x929\x1e\xad\xf4\xef\x98\x86\xb0$\xdd\xd0\xf4\x06\x13\x13\xa7\x1c\xd4\xa1\x1b\xb0\xbd\x0f\xd2\x16\xf3\xfd\x1bp\x0b\x87nB:\xecB\xbe\x81\x89U+\xf2M`bW=\x96$/\x98d\xa8\";r\x0fz-rKnH\x0c~\xc8\xeb\xfe\x7f\x91\x19\xfb\x88\xb5I\xef\xa9\x9e\xc7\x1c\x1e\xe7\xf5\n\xae\x0c\x95\xa3\xf3\xf0|I\xbcN\xe8\xe1&\xaa\xd3Qq\xad(\x88!n\x0c!&J\x89\x1f\xda\xd5P~c\x17h\xf01$\xa8\xa8\xb3\xc3\xe3\x9c\x1f\xf9\xf2\xe5\xfd\x06\xe57\xf0\x12\xca[z\x98x{/P\x9fzTd\xea	\x8b\xb1 \xbbW\x06d\xad$\x00\xe5_\xcdIJ_\xcd{\xcf\xaf_\x98:\x07P\xa7[e}\x14\x00\x17rHLW\xd6\xc4\xb2\x1a\xe5}\xb2\xa0+\xf3Dd\xe04\x85\x13E\x04\x1e6\x9e\x84\x08xY0\x90\xa1O\x94\x85\xdb\x85\xdaj,z\xe5J,$j\xe4\xae\x0d\xed\x8f6c\x9b}\xb4	\x02\xbc\x98nf\xd41\xa0\x00\xdf\xba\xc0(\xa9s\xfa\xfe\x99\xf6\xab\xb6\x98&3\xec|\x81\xc4k.\x13\x905\x18\x91S\xacoK\xe6\x94\x91\x1bZ\xd4\xf2\x8eu\x10\xe3E,\xa0\x8bi\x02\xbc\xb9\xa8C\x15M{\xafI\xe9\x8c\x16F\n\x97Sz\xaf\xbd\x88\xd9\xdc\xc5\x97\x17[\x7f\xbe\xbe\xeb\xa3\xfaHL\xbd\xb4\xe5\xbf\xe4\xfas\xb5\x9e.\xfc\xbaUx\xe5\xd6\xfd\xe7\xa8~\xe7\xd4\xf5\xe7\xb9\xef\xbc\xe8?!\xdf\xbf\xbd\xe8?\xa0\xdas/\x82L\xcf[\x99L\xe9?\xff\x81\xc9\xb0\x98&!x\x9cV\x81\x81+\xe0x\xf1\xe7\x81\x07\xcc@\n\x8a/\x89Q\xab\xab\xad)8Q\xd5\x8d\x17-)#k\xe3T\xe4\x1a\xacqr\xd8y_\xd1R]U\x1f\x81*%\xed=\x07\x13\xdb\x01e\xe1\xd5\xf4:|2#\xeb\x80\x16*\x0c\xd6\xf35%\xe6\x96z76p<0\xdc\x06\xc1l\x98\x93\x9b#\xd2\x96\xff7Qb\x81\xa9\xf9\x86\x166xj\xb8\x7f	b?x\xd1\x97\xb4\x11\xa8\xf1C\x0b\xe0\xa9V\xfe\xde\x00\x0bX\x0d.(Nb\xf7e \xdf}!\xe1~\xf8\xa3\xbe<\xee\xb3\xe3\xca\x81@\x87\xc4\x91\xffW*\xff\xa2\xb6\x9f\xaa\xfe\x99\x17\xdd\xd9\x12;\x1b\xb8\xb7\x81[\x1b\xd8\xda\xc0\xbb\x0c\x19\xfe{\xa2\xc5\xc0\x9d5\x9f\x86\xb6?#e\xab\xa5\xec\x7fQ\xd5\x89\xf6\x1dW\xf6\xf8\xe8}\x82\xd7\x05\xb1\xef{\xbf\xa8\xc0~\x8f\x00\x93_\xdc^\xc0\xe0\xf6\xb3\xb2Rm\xd9\x93\xf9\x0dXY\x8c\x11&e#8<k=\xf9Qb\x84\xd5a\x98\xbb\xb9\x847\x16IZ\xb7+\xe1\xfc\x02n\xd6Cu\xb8\x0e!\xa1zS\xdd\xcb\xd5\x17l\xc1\xd1\xcf\xbdD`x\x0b$\x11\xc7\xbc\x89s\xe3\x89\xc3\x99\xcaA\xea#\xd4\xdd\xb6\x14\xd3B \xbbA\xa2\x16\x8a\x95\xe5at\xd6\xc7\x8f\xee\xbd\xf9\xe7me+\x08\x16W\x192\x8dv\xe4\xa7\xd7\xed|W\x99\xb9\xd3y\"\xeb\xc7\x03Q\xdbZ\xe1\xea=\xd5J`\x92\xaa\xa0R\x1d\x1b\xd5\xbe\xbab\"pP\x06)\xee\x16\x01\n\xed\x1d\xc7a\xb7\x0cS\xdce\x01x,u\xd0\xfe\xde\xd4a\xeffp\xfdN\xbeT6\xc2\xcau\x9e\xcbUm[\xc3\x88-t\xa8\xb4\x8b\xf0\x10\x05\xa4\x9a\xcc}\x7f\xc2\xe8\xfd\x0d\x82\xa3\x08\xb8i\xaa\x8b?W\x17\xf5\x99\xc8$\x1e\xd5\xf6\xc83c\xcfA\xfd\x0e\x8c\x08ct\xe3\xa6\x1e\xd0\xd3\x8c\xca1\x12\x93\"*\xc2\x01\x1e\x95V\xf5\x94R6\x1dvKXyU\x00t\x07+\x9aMQ\x19\x0c\xf0\xd7\xe0)\xba7\xe7\x9f\x04/\x9eW\xc5\x1dX;\x87\xf3Iu\xa8\xabN\x82\xd5q.\x81\xebD\xea\x02\x116^\xa9mc@\x9f\x0ev\xc2\xe8\x0eZ\"\x94I\xa1?\xc3\xba\xa4\xe0\xbc\xddb>*\xc7\xb1\xc2\xb6\xb6]\x9eMK\xd3\xdcR\x15|\x14\xf0\xc2\xc4\xb5>\xd7\xf4\xc8\x9b\x83\x1e\x91\xbdxs?\x84;\x0e\xbdx\xd3\xb8\xed\x85]\x19\\\x9c\xb1\x8fsh>\x99\"1\xf9 \xa2\xdf\x04F\xb2w?\x00w\xb4\x1b\xfb3$\xb2w?$p\x8b\xdd\xc9\xb4\xd3\xa9\xf6\x072\xed \xd3,\x02X2\x8b~8\x82U\x03\xa9S] \xbat\xd3\x98\xb7\x9f\xdf[\xde\x82)\x89\x94\xed\xd8<\xe5e\xed\x8a\xfbV\xf2\xf5&/X\xb1{q29\xae\x8a2\x07\xd7m\xea3\xcb\xe5\xf3\x94\xb3\x82\xbaf\x92^:g]\xce\xfc\x92\xce\xcc\xfa\xd1\xd0\xb8\xb9\x15f\xa6/\xdc!q\x8d\xfc\xfc\x08\x16\xa0j>\x05\xc6\xd7\x1d\x0bd\x19\xe8\x02\xe9\xb7\xda\x894&\xff\xceP\xae\xc6\x8bs^\xf4\xe9\xa03\x81-\xfc\x93#\xed\x96\"2\x0e^%\xb9W\x0e\x89w\xca\x07\xb1v\x17c\xfd$\x18KG\xd6Y\xc2#\xd1\x8ec#[\x83\xd9\x8c9X\x99\xa9\x9c\xd3\x07\x0dI\xb6!\xd5\x9eR\xb5}\xa8\x1b\xb9K9\xfa\x16\xd4\xfa9\xd8|o^\xad;\xf7\xb0\xbe5<\x0b\xe0\n\xe5\\M\x81<\xaf]8\xc0\xe0\xd4\x98v\xeb\xcbu\x82\xe6cj*\x9f\xd8\xe4\x08\xe56\x0e\x9fg`\xb1Q\xf6\xee\x03\x13s>\x0c\xf3\xf3!\xd9E\xb2\xb7\x0bl	0\xefv>4T\xc9-=\xdc\xe74\xff6\xd8\xa9U\xa2\xcd\xc4\xedj\xd32\xdc\xa4\xfb\x14\xb4\x1dq\x05\x06z\xeb\xdc\xb0L\x1cB\x80\xbb\xffMU\xffp_%i\x83Yj\xd7\xab\xba\xb3W[\x06\xb7$\xf8\xfe\x06\x0d\xbb\xbcw?\x80\x17\xd8\xf6c\x08\xdam\x88\x04k\x8cC\xfa*S\x1fD\xc0sULL\xae\x9d[dg\x8a\xec\xa0\xc8\xce\x14\xd9\xd5E\x9c\xa3\xb9\x9f\xbf\x0c?r\xe8ATQ\x97\x14\xb5O\x0e\xdb\x00\xde\xbb7\x08\xea\xca\x08\xef\xedL\xf5u\x84*\xdb8\xaey\x95\xa1,`\x90:\xc0!\x8cj\xa5\x82\x98\xf4\xa3\x01\x9466\xd6\xdc\xdcpM\xd7\xe6\xde\xa9\xdcp\x163h\x1dJ\xbf:\x98F\x8aJ\xb5\xfc\x80\xb2\xe0\xfb\x1b\xc41\xfezHi\x7f\x92\x9d\x0f#\x94\x05H\xa8s\x07\x8c\xdds\xf0\xef\\\xde`\xcc\x17\xd8\x87\x8cg\xee!\xe7/N\xc6\x13\xd6\x0e?\x82\xc3\xe6\xb07h\xd9/\xfc\xa9\x11VnoW\xf9\x1d/nd\xfa\x02\xa4\x85\xfa%\x9b\x19)\xda\xce\xbb3t\xc0\xe2(\xc9\xa8[p\x94\xa9X\xaa\x7f\xf6{\xf4\x1d\x18u\x99\xfc\x02\x7f\xcd\x9b\xa0\xcc\x00\xa26\xa0sq\x95\x8b\x9b\\#\xfb\x9c\xb9^\xad\x18\xbc\xb1\xce\xb0y\xf0?e\xea\xb5\x912\xbf\xa1\xd1\x03\x0b\x1c#\xd9\xbb\xbd\xcd\xf2b\xcd\xd2\x1b\x99\xd2\x9c\x1c\xb5	\x0c\xee4\xf4zo\xdb\xae,\x00\x8aR\x19$R\x11\x8a*D\xf6\xaa\x92\xab\xc8\xd7`\x94\x0cC\xaeO\x05\xac\x82\xe0\x95\x1b\xb6<*\x11\xc9VEm\xb1\xcdX;\x14\x14\xde\xd7\xc3\x03\xea\x1f\xf34\x9d\xb3\xf8\xe3\xdb\x8d\x1c	\xdf\xafrx\xa5\xc5\x8d@\xf5\xaa\xc8\xd7\xc8\xc5Z\xbd\xa1Bi\x8e8\xb1\x00\xb4\xe1o\xa6,\xeb[\xab\xa6p\xfd\xce\x9a\xe1\xb2\x06\x8b\xd5\x8c\xb5\x1fT\xf4X%se\x0b\x12\xa6\x9f\x15\x08a\xc8\xf6>\x0d\x03:xth\x00KSC\xa6\xdf\xdc!RS\xc9\x0e\x0e\x87\xe2\xa3\x03\x82M\x0e\x08\xa6\xcdo\x19\x9a\xe1\x08qH\xa9\xf99\xd7\xa8\x84T\xe1\xe4\xe0\xe2v\xd9\x07\x85\x8b\x07\xc6\x867 \xf5H\xf54h\xa2,$\xde\xf1\xa2\xe4\x8d\xa5\xa5&\xdf\x99\xcd\xe7\xfb\xaa\xcb\x1fq\xa4\x7fm\xde\xce\xef\xffK\xb0\xbf\x19\xb0\xbf\xb5\xc1\xfe\xcbpywx\xc0\xaa\x03\x14P\x8d\xde\xef\xb91m\xd3d0\x03\xb5\xef\x12\xc1\xf7\x7fjC\xfe\n>\x1f\x8c\xb5L\x0dO\xa4<\x93o\xb3w`>\x08\x08\xfb\xa9\xf8v\xa7>\xe0	\xa5\xce(\xca\x97\xc6f\x8e\xef\x03%\xe1\xd1gC\x84\x7f\xfe\xafa\xfe~\x04\xf3\x87\x0c\xd5 \x9b\x9c\xf4\xacO\x8e\xeb\x97\xf9\xe9\xbc\x03r\x0c\x97\xe7\x96\xb9\xca\x93\x04\xd0O\xe1\x8f	C\xfe\xe7}l:\xf3\x11G&Dd/\xcf\x90\xb6\xae\x06\x15y\xe4\xab\x0c;Q\x95\xf4\xc8?3\x9b\xcdZ\x0d\xf2\xc8\x0f&\x97\xe6T\x1e\x91\xee\xbb/\x91\xb7\xeeF\xd9\xfd\x13<\x16\xc9\xe1\xd8\xf9\xe5\x0d\xee\xa5l\xce\xd3W\\\xc6+^\x90\x8a\xe6:\xe2\x85}`OVu\x94X\xeb\x18\xb0@\xbd\xe4`a=M\xf3-\xf2\xcaU\xbe\xf5@\x0d\x9c\x9d\x88\x1e\xa1x\xbf\x07\x0b\xd0\x08xHIS\xc8\xf3\n\x90\x95\x92'\xaf\xa1rT\x91\x1a}\xe0;d\x85-\x03*U\x99{\x94[C\x04\xc0\xa7\xf0\xa4\xf5\x89*8\xfak\xe7\xd0<\x7fA\xe3I\xa9\xd6\x008J\x99\xbc@\xe9\xe43\xd57\xf44\x08\xe8R%n\xfc\xeeQpu\xa8\x03\x9b\xfd\x1ee@Z\xa1-`d\xc0Q3R\x90\xdc\xc8#\x8aS\xd2\x05\xbc5\xe4\xf7\x92:f\x1e3\xa7G\xea-p\x9e\x1b\xd75`Z\xc3\xf7\x19@\x86\x9e^\xe5\xa5~\x88\x9f\x97\x86\xc7\xb6\xbf4\xc7m\x80\x1b8\xc2\xd8WB\xca\xd0&\xf4\xb1(A\xe4S\x941\xa21ou\x95\xb5A\xea\xc19\\\xa6\xed\"\x1b\xcf\xd6\x1e\x1eyy%U\xd0l\x0c\x95!\xc9M\xedz\xc9.\x1bj\x89\x84\xf6j[\xd3\x07UhK\x9a\xc6?-;H\xd4\x9e!\xc0=\xb4Z\x91\x19\xbc\x15\xeb\xd67O\xd5Q\x9al9\x8dP\x16\xf9\\\x1b\xd9\xca\xd9\x9f\xdb\xa4\xc4$x\x98\x98\x06=\xc5\xeazLA\xc0\x8a7\x8f\x95\xa1\x04\xf0\x96\xef\xfb\xa51\xcfc\x8c\xef\xc3\x12[Q\x06k)\xec\xfc+\xfcP\xb8\xc2\x038Z\xebT`}\xa5:4\x91\xb02/\x15\xc1!\xea\x92KU\x05\x9az\xe0-\xca#p\xc3\xbb\xc8Q\x01\x86`\x1e\x1eI\x0c~\xf7\xe1r\xbbU\x1bio\\\xb4 \x85\xeeF\x95\x0c\x03J\xf9\x1e(b\xfe\xa3\xb2\x88y&\x1a4a\xfb\xd5|\xc1{oWfd9\xf0\x1e+\xf3\xf0\x11\x8c%\xb5z\x99\xc6\x9b\x93\x10\x01vw\xacq+\x18-\x80.4\\\xe0\x07\xfbf\xdc\x02\xc9\xa0\xed\x02\x8b\xc3\x86\x83\xab\x18mbk\x80\x1f\xc1\xf2\x82\xf6\xfd\xa0\x08`\xdb\xe7\xe8\\\x8a\x16_R\xe2\x80\xa0g\xb9\xef\x0b\xc0\xc0t-\xf8I\xa0e~\xd0\xaf\xca\xe2\xb7\x07\x0e\x9f\xf7{\xa1\xed\x7f\x9b\xfc\xdak\xc2q\x89\x03\xe3\xd5u\xd9\x83\xf8\x16\x14\xb5I8\x1aSM	\x95\xeea\"\x0e\xfd\x1e`r\x96\xe3\x87\xe6\xd2fA\xdb\x93d$\x0f$7\x9a\x02-\nx\xff \xff@&\xa3\x05\x91\xc72\x1f\xcd\x1e\xad\xd51GPs\xc8\x979\x82\xda\xa3q\x81\xa8\x86J\x9b\xa8u<0\x00\xd1dk\xf9P<Q\xe6g\xb5\x99i\n\xe9oS\n<4\x9e\xaaG|r\xab\x11\x9fl-\xda\x0d\xe3\x89\x12:\xa1)\xa3\xbfM\x7f)S\xf7\x07\x85\x18\xc4}\xc65\\;\xe7\x9dk0\xdf\xb36\xc3\xea\xe4\xb9u\nba5\xeeu\x0f\x00\xa5uB\x9dW\x8d\x91\x83l[3nt\x0e\xe3\xb0\xb4\x9deehj\xf2\xbcc\xcb\x83\x0c\x92\xc1	/\x03[\xbf\x89(A;\xf4m~\xbf\xdf\x9bn?0\xe8~<\x1d\xe6\xed\x0cj:\x10\xe9:\x999\xa8\xcfX\x96\xae1r\xe6\xcc	\xe8M\xa2\x0b\xd9\x99!\x07\xd0\xe7M\xcaA\x0d\xda\xb5\xc5\xc9\xec:\xc9\xc9o\\hh\xa4\xda%\\\xf75G\x05\xc0\x11\xc8\xc9\xfc\x90p\x9c\xdd\xb8\xf48Y\xc2\xa4}\xae\xd0\x87?(\xf4\xc1\xab\x85\x86\xcf\xb7\xe3 \x15\x06\xab\xe8\x1dD\xb6\x80\x9ch[\x03\x03\x12\x0fA@\\\x0b\xc2\xe9\xe6\x1e\xa5\x1f\xc21\xc5N\x80\xfa\xf0'\xa0>\x9c\x06\xf5\xa1Y9\xca\xdc=<\xf0\x80\xbb\xa9\xad\xd3DF`\x05\xa5aw\x13'\x1c\xb5/\xf0\xa4f\xf5i\x1e\xcf	\xd8jjSR\x8a=Z\xed\xcf1s=\x83\x1b\xea \x04\x9d\x81\x9e&\x076\xd6\x08W\xbe\xcf}\xffHW\xa2\xf02f\xc1P-U]\xd2>\xac\x99\x19}\xb0\xc0\x15\x96\xa4\xf1\xff\x13\x19\xfa\xa9e\xcd\x89\xff\xd9(5\x0fb\x1e\x0d\xbd\x01O\xea\xfd\x9f\xc5b\xe1\x19!\xda\xcdZ/\x0ff\xb5tv\xf2'r\x1b\x07\x10\xad\"*\x8e\x0e\xe1\xb6\xa7\xa3 \xadrW\xef\xd6^\xa8FM\xad\n9^\x07\xed\x10\xd1\xb8p\xe7\xa3\x95b9i\x93\xacb\x9c\x8d\xce\xca\x0c\x0bXr\x05\xc8,\x8e\xfc\xe5\xd5\x02Rc\xd3\xe5{\xe4\xba\n6\xc6\xbb\xdc\xd9\xd6Z\x11=\xcf,Qz}\xfbL\xf6f1\xf4\xbc\xb6\xbf\xe1\xd3\xd0\xcd:\xf8\x19g\xc4'\xcb4+\xe1g|\x15;$\x89\x8fe\xac\x13\xee&`(\xeb\xc7_F\xa1\x06\xcb\x8a(\x9feb\xad\xbc\xb3\xbd\xcc`\x81I\x90\x11l\x0b*'\xdeO\x9b\x04\xa4uew\xeb`\xcf\xc7l\xb9\x17U\xa1~\xbd\xa0\x80S\xba\xcf\xe4z\xc9J\x91-U\x9e\xea\xb3\x90x\xcav\x90et\x02\xfdJ=\x1a\xaePNT\xe9\x1amU\xe8{V\xb0u9\xf9l\x8a\xd2\xf8\xabY\x8719\x01\\o>K0\xd1I\xca\xcb>p\x14\x05\x05\x0e\xc2\x04<\xd5\xd8\xef\xfb$%\x8c\x9c\x9d1P@\xf5J\x99o\xea\x8a\x10h{\x99\x94\x05\x18\xf0a\xb0\xab\x03\x8b\x9ap1\xf2\xcf36\xbd\xb8pz\x11?\xc49\x02U\x8e\xdd\xd2;\xf96G\xf9\x06'\xf3-\xcdD\xb1\x02\xbd\xa0\xa9DS\xf0\xad\xa27\x07|\x84W\x12\x8ez\x81h\xaf\xf3\x98\xa5\xcd\xe1\x00\x06\xc5`#\xdc\xdb\xb9\xe4\xbc\"_\x1b,\xea\x9d-\xf7\xfd\xb3X\xf9uA\x9c\xbe\xbd=\x01\x94c\xb5\xe7E\x9cn\x00\x11\x02\xdf?@\x00\xd8|\x03\xf9\xae\x86l\x8c\xdd\xc2\xa3\xa2\xe9\x93\xd9~\xafCO\xebP\x7f6\x19D\xf5K\x9fa\x17r\x9d\xc3Se\xb8\xf8\xff\xf9b\xc3\xd3\xc5\x86\xea~\xd0\xb4\xf6/)'a\x165ny\xe4$\x8b\xfa\xc4\xfa\x14\x94\x930w\xfd\x08\xcaI\x1e\xf5k\x13H\x8c\xaes\xc4T#H\x8d\x1f\xbcy\xc6\x97\xce\xe7`\xa6\x0d\xe6\\\xf6'\xa6\x1a\xe3-9\x824\x885\xf0#UkC\xa1\x9d\xa1\x10l\xfe\xebH\x87+?\xd4\x8eF~F`\x0b^\xef+0)\x8cC\xc8\xc88h\x14yV[\x843'(\xd0=:D\x19z\x80\x85F}`0L\x05gN\xbeo\xac0\x15\xad;\x03\xa6^\x07\xdaI\xbd\xea\x19\x18n\xfb\x07(\xc8@\xbb\xc12\xb0\xab\x0cF,U\x10\x8c\x89=B=H\xe2\x11?\x0d\x00\x0c5\x1e\xc1\xb0\xd5\x17\x16\x10\xech\x0d\x9a\x19\x05\xba\xc0I\x17\xcc:\xd8\xe7c\xa2&ZF`\xe4\xd7\xc6%\xd5i\xa0\xa3/\xbe7\x93\xc7\xb4g\xd1\xb2\xfbk\x17\x1fx\x88?\x12\xf4\x0d\\\x98\xe0\xbd{L\x04}k\xc2\x819g2\x972\x95\xf9AK\x9c\x0cJdp\xbc\x04wI\xde\x9ap`\x0f\x8c0\x99\n\x92\xcd\\\x1b\xbc\xb7\x07S\x02\xa9\xde)x,\x95R<:\xeb?\xc2l2\x0e\x93\x1e\xf4A\xc9\x9b\xfc&f)\x87D \x88\xd2\x10=\xdcG\xe1\x80\xec\xe0\x8f\xda\x9fDC{\xc88|$\xf6\x8e\xab2RQ[<E\x9e>\xb8=?\xf7\xf0\x04\xec-\xc3\x17\x9cO(+\xe1\x7f\xc7\xa4P7\x07\x889\xed\xe5\x18Gp\xe2\\\xdf\x0c\xf5\xe0^5\x94&\x9e\x87\xa1\x11\x8dg\xd3\xa6\x81\xdb\xba\x81\xe6*v\xadR\xa0\xda\xe3\x93UdPmr\xd0(1\xdcC\xacy\x0d\xa2\xd6`(;\xd9\xd6-\x11\x08r\xa0\xb6\xb0V\xbd\xc1j\xa4\xef{\xf9\xfc7\x1eK\xc7)\xd3DN!\x9b1E\x87\x95\xe5\x84\xba\xe7\xb4\x90\xe8\xfb\x02\xc4\x1c\xad\x1b\x85\x16\xbb\xaa\x98\x1b3r\xa0\xa7\x04\xfd\xee\x06I\x0c\xbc\xf0\xfb6#\x9d\x08c\xc3\x8cp\xb0W\xeb\xeaPj\xa2\\\xe7\xcd\xb1\xdc\x14\xdc\x99y\xe4\x97\x9b\xcf\xdaL|\xea\xdaLl\x80\\9@:M\xaf\x9c\xff\xf7\xafep\x0e]\xd2D\xfdZ\x06_\xa9\xa8\xa6\xf4;C\xd4\xe6\xee\x00\x98\x80\x08\x81\xc7\x12F\xc1\x00D(\xd4$h\xbf\xecV#\x88M )B\xf07\x80\xac\xe0\xfb\x16\xae\xd7\x80>-\x07\xfbJ\xc0P\xc6\x8a\x8f\xdb\x91g\x81\xc9KUQ\x13=\x98\xe9\x8b+6\xedD\x91\xf1\x1f\x14\xa1\xf4t\x19\x15\x7fP\xc8\xdc.\x92\xaa\x95\xf8<\xef\xb2\x00\xd0j\x88\xf2\xc2\xf4\xb0\xb9A,\xcd\x0db3\xaa#u1\xce8\x16\x8d$\xf5\x9e\xf6\xbfn\xdd\xbeU\xeb\x8b\xce\x05l\x1d\xb2\x1c\xe40\x8b\x81\xcab\x97\x00I\xbdA\xbf\xff\xb5\xf7\xf8\xd9cZ\xd5\xd3\xd6 \xee\xd7_\x9d\xe3/pHg\x06\xf4\xe4\x0d{\x13\x05\xce ~VO&\xd3\x03\xe6\x90\x10\x96\x07:\x00\x8f;\xe6X[d\xa8>\xdf\xee\xc3\x8d\x9ba\x1f\x13IQ \x1bK\xc6\x1c\x131\x91-\xf8\xcf\xdb\xa3\xb2\xcc\x0b\xe9,\x14\xcd\xae\xaf#C\xde\xd6t\xbe\xce\xed\xa1\"\x0d$\xd1\x97\xbd\xa5}\xd1\xe3<\x9e\xe5t@\xc0\xef\x98\xc2N)I\x90\x84G\xfd\xca2\xff\x08\xf3.\x1d\xf4\x89\x08\x82zF6\x8d\xff\xd8\xda\xe0\xc8\xfcF\x91\x1ad\"\xda\x18;\xf7`\x8b\x01l\xd5\x1a\n\xcbh\xc0\x0dS\x14\xc1\xa0a\x19\xe3>\xc8\x11}ci\xd9\x01\xe0\xda\x9d\x1c\xf7'\xfd\xc8r\xaap\x10:\xb7j\xbeo\xf1\x13\xd5\x9c4_\x92L\x07_\xbf\x19\xf4I\xae\xc3\x8b4\xcf\x0b\x04\xe6\xcd\x8d\xcb |\x9e\x81H\xe3\x90@4o\xd0\xeb\x89\x8cU\xb6\xe2D\x87\x86y\xc0\xc0\x94\xc7\xb0_c*\xcaW\"\x13\x92\xa3\x02O\x8ah\xe8\xdcDzS\x0f\x19\xb5H\x83\xedUS\xa6o\xd6\xc0Mk\x11u;9@\xba#9\x062\xe0G\xd2\xaf_\xe5:\x0f\xcc-_7\xcd\xdd\xe4[\x04}\x08M<\\\x9f\xcd\x1c\xb6\xc3c\xd2\x8f$>\xcf\xbayw\xd0\xef?Bca2\xe4\xa4\xa4\x0b\xc4Z\x0b\xbb\xa9\xcc\xa1'\x1c\xfb\x92\x94nP\xf99\xe49\xe0K\xaa\x03Xn\x96\x10|~<\xe2Q:.F\x8d@\xbe\xa2o\xaa\xf5\x9c\x17\xbd7/\xff\xf1\xec\xdd\xd5\xbf_\xde^\xbdyu\xf5\xe6\xea\xdd\x07\xb8_\x07\x87S\xf0\xdcbI+3.F\xc9x9\n\x82\x04W\xd3dv	\x0b\xda\x8aB\x90\xc44\xc1\xa3 (\xa71\xdc\xf7\x8b\xc1\xb6w\x10\xd4\xcax\xa8\xfb\xdc\x19Po\x9d\xe1=\xec\x9a\x93\x17\xd3\xbfH~\xcd\x03\x8e\xbfv\x14\xf8/[S\xf52\xfc\xe9\x06\x0ce\xfet\xd3\xe4\xf8\xb1\x9e\x92\xae\x9f\x91\x17LZ\x97\x81\x1de\xd8\xe3\xf8f\x89\x15\xdc~\xbb\xe9\xf1{\x1e\x83\xa0\x06c\xa7.\x06\"\x05\x80Ao\xd8\x1b5 \xf8\xf4\xef3;\x13\x02\xb3'0\x98\xeb+\xbb\x90\xa1'\xf3\x9f6`\xc0W\xd9\xf6\x86s\x93P\xc7\xeb\xc9\xd9'\x17\xa0\xcf\xb0\xa0\xe1O\xef\xa7w\xcfQ\x00\x13\x82\x04\x08\xf6\x07\xfb\xfd\x00\x87\x03\x12\xf0\xe9\x05\x84\x89P\xf1j\xe3\x81!\xf6\x1b\x08A\xe0o\x10\xc0\xf5\xab\xec\x1a\xea\x1f\x01\xb3\x88\xff1\xcc\x1a\xa4\xe1\xd05h\xa0ET\x7f\xb9\xec\xcdU	|r{\xcd\x9d.\xdf\xe6\xed\x8c\xdf\x1ee\xd4\xc3\xde2\x1a$\xf1y\xcdi\x9c\n\xfe\xed\xb2%\x92Q\x05\xc7r\"S\x97\xe2>\xf2\xbc6\x88*(\x9f\xb0\xf1\xa0\xf7t2\x88\xd8x\xd8{:\x19Fl\xfcdr\x11\xb1\xf1\xdf&O\xa3\x01<K\x1f\xe8D\x95t\xa1\x92\x9e\xaa$\"\xa9\xe8\xe6$\xbb\xa4\xe1\xb0?	d\xbd\xbcX\x0e\xdb\xba\x9e\xf4\x8f\xfc\xc0\xd3\x93l=\x0f\x04\xe9V\xf2\xe2\x8e\xa5\xd3l6\x16\xee\xd7~\xdfJ\x04\xf7a\xee7\x08~\xfa2\xeb4\x9b\x85\xa2\x0eR\x8a2\xfd\x12o\xbf?\x03\x1f'\xaa\xc6\x01\xc6\x8f\xc7\xcb\x9b\xb3i\x86/\xd2\xc7\xaa\xe4cs3Xh\x0bU\x19\x1d\xc0Nv\x94\xbb\xaf\xb6m\x1e\xa6\\C\xd4\xb8\x91B\x7f+\x84\xcc}\xe2\xa1\xba\x99\xcb\xa6\xe5l\x0c\x17\x1b\x10\x84\xa8 \x05\xfc\x94\x93A4\x083X\xd5 \x9ad\x14\xa2\xb5\xb1H\xaa\xbc\xfb\xfb>\x98d\xea\x16\xd3\xc1\xec\x8c\x0e\xc0\xde;8T\xe1('\x03\x1c\xe5A`\x87\xa8C\xf6\x9f\xdd\xd1$\xc3\x96\x00rI\x9d\xd5\xe2\x95\x9b\xb1f\xd3^\xe8EHR$\x03\xafyF\xd6\xf30\x9e\xf6g\x8d\x9c\x8a~M\x1e\x06\xe4\xe2\x11\xa3	E\x93\xe8\xd7\xe4\xe1\xe2\x11\x07hr\xf6+<r\\\x12\xef\xab\x01\xf1p\x80,\xcd.\x07\x13\xb0\x14\x0d[\xb0\xa8%\xdd~g\xc6\xb0\xc5\x97\"y\xc2;@Sq\x88z\xf8|yz\x19\xe0m\xb6\x03\x1bY\xdf\xb7\xc6\xe9\xeb\x07t\xedL\x81\xddE\x0d\xda[\x88_\\\xe2X\x06\xe0\x01i\x02W`\xff\xd7\xcdiT\xbe\xbaQ\xabMC\xec\x9f\xea\xa5y\x878\x9c\xfbs\xda\xbc\x17\xac\xfd\xb6\x02\x83=\xb3\xcb\xad\xe7\xd5c1W\x12z\xef\xab;V\x94\xca\x9f\x02\xa3\xfd\x11k\xde\x0c2{U\xbd\xa0\xff\xbc\x99\xb2\xd9H:O#\x7f\xb8A\x05&\xf0\x17\x1c\xf3\xd7F\xa7\xf4\xf0\xcc\xd4\xf0\xb4q)\xed\x8f\xd2\x06l\xedw\xa5\xa2\x1cn\x92\xe7\xd3tv\x04\xfb\x9f7\xd3t\x06\x06\xa8\xc4\xe4\x97\x1cU8\xaa\xf0\x89Q\xf9\xben\xbfIK\\/\x14\x1c67.\\\xef\xc1\x0b\xb2\xc0{\xf44T\x8e#\xb0!\xedv\xd0of\xe0\xe8\xa9\x8a$\xbdE\x12O\x1e\xd4\x11u$	\xbf\x97\x05{^\x96\xef\xf8\xbd\x8c\xf8c\xa4\\$cs\x82\x9d\x99Kb\x04\x84K7g\xcd%'^Y\xcd\xaf$_\x83\xda)\x9b\xfcu\\nX\xd6\xd1{{\xcf\\$\x8fD\x06\xe7\x90\xe1<\xcd\xe3\x8f#{\xb6\x19\xaa\xe3\xd0Ho>F\xda7l\xa8v\x13\xd1\xdf7\xf76\x026 \xd1\xc5\xe6~d<\xc6\x16\xea\x88-z\xb2\xb9\x1fi\xa5\x00\x84\x8cZ\x00\x82\xcd1b\xa8\x9a\x10\xfd5\xf8%G\x02\x07\xde\xa8\xb1\xbf\xfeW\xefr|\x0e\x88^\xfe5\xfa\x02\x8c\x0d*\x1a\xb7\xa7G\xb8\x0c\xfa52\x83~\x83\xcd\xa0\xff?A\xc7sTg\x1fL\xcf\x19Z\xcb\x80z\x1e\xf1\xfa\xe0\x01\x1c\x0c\xda\x97\xb2\x80mLhY\x07v7-\xbf\xd7\xc3\xc8\xdbr\xfeQ\x1dF\xf9\xbe\xb7\xce3\xb9\xb2\x1f\xbfW\xac\x80]\xa0\xf9\\\xb1t\x11\xee8\xab#\xea0\x9c\xe2R\xef\xfa:L\x92_\xb3\xddn\xb73\xf7e2\xfa#h\xa9\xe0\x15\xef\xc4\xfb\xe9\xdds\xa5{g4\x9bzK.\xbd \x0f\xbcWU\x9a~\x0003\x04\x92\xac\x93r\xad0\x99!\x1c\x80\xb1%'\x01\x84\x1c\x88'\xa9\x1b\xfb]^\x15\xa5\x8a\xaeZPDVI\xae\x13Vn\xc2\x0d\x8f\xf3,\xd1	q\xbbD\x9a\x8a\xb2I\xb5#\xb95\xa9\xae\xaf=\xf2!G\x05\x19\xe2\x86\x85y\xd7\x1e)\x9cOE\x08\xc2Z1\x1ea_\x0f\xfa}'.I\x14\xa8\xb2\x0d*\xf1H\xe9dZ\xadT\xa6\xb4\x9di\xe5\x91\xd4\xc9\xb4^\xabLU;\xd3\xda#\x95\x93\xa9,U\xa6U;S\xe9\x91\x95\x93\xe9\xe6\xe6F\xe5\x8aA\xbcl\xc6\xcc\xbf\\V.'\x7f\xb8\x14\xe8\xe1\xd7\xbef\xfd\x95\x19s\xaeUX\xf5\x9e\xdb\\T\x83g\x19\x19X\xb8\xb4\xd7\xe8\xe0\x94'W\x11\xc6\xb8^\x7f\xc4{\x9c\xc5\xab\xe7+\x91&\xcd&\xdb\xb5\xa5	\xc6\xddk51Y\xd0\xf4\xf8\x05\x01\xd9\x80?c\x00\xf1L\xa2*\x18\x80Q\xe3\x8d\xefo\x8e\xdf\x1a\xc02\xe2\xad\xf2B|\xca3\xc9\x94S\x1b#\xe8\xaf\xe9B+E\x03\xb4\x9c\x84\xcb\xde}\xb0\xe8\xddG}<B+\xca\x825\x06\xb3bi/\xe3[\xe0\x13\x13\x04\x0f\xd7Wt\x0d\xafE\xcb@\xc0^\xcd*H#c\x84\x03\xf6\xa8%\xa9\xa3\x1b\xa3\xeawu^S\xd5.X\xf4v\xaa\xaa\x98\x16\xc1\x1d\xbe\xcc\xdbU\xe9*\ne\xcf\xe9NU\xa5\x10=\xaaI\xc7>\xd6E\xf7{\x94h\xd3\xa8	\xd8;*\xc8a\xcb'\x8c\xae\x02\x11\x154\x0eD\xcb\x15\xe9?kfbWa\x05[=E3\x0fM\x18\x05MS\xef\x1e\xe4\xe8B\x87wpo\xb04\xf1CHHM\xc2\x90\xe4v\xde\x99m/\x83\x03v%Y\xb9bX\xef\x1e\xc3\x93A\xa0.<\x8fR\xe9\xe5gr\x0eu\xd6\x92fu\xd6\xe2t\xd6\x9d\xceY8@\xd3\xcf\xe44@S\n7 \xe9\xef7`a\xa8\x8f\x89y\xc0\xd3\xf4k\xc8B\xa3_\xbc\x98\xc1\xfe\xda,Hu\x864,\x94\xee1\x14\xd3!dp\x94\xf7?\xd4\xb4m*0\xcc\xf5\xf3d\x86U\xd1\xa1\xb4\xcc7\x0e\xad\xd5:\xe5\x90[k\xe5 C\xa5\xe9\xaf\xb0\x87\x0c+\xfdm\xdf\n\xc1\xd3}@P\xa9\x10I\xa2T\xa6\x81j\xd2\x82\xca\x1e\x03'$\xd2\x9a\"\xd0\xd4\xaa\x80:\x15\xcd\xc22LB\x86\x8d\x8a\x0b\x1c-\xa0\x15\xcd\xc34\x8c\xc3BO\xf63\xb8\xa6\xea\x94rr..\xb3\xf3|R\xd1\xde\xdf\xbbY\xb4\x82\x9f\x1c\x93\x16\xfcEwu\x00\xbb:_`\x1b\x05\x9eL\x11SHTabc\x95\xd5\xeeB!\xb1\nc\xb8\xc7\x02D\x83\x0d\x93\xa2\xcf\x81\x1e\x94\xd1\xec|\x18V\xf0\xa6iz1;\xa1\xdf\x04\xf8U\x98<\x9a\xe6+\x9a\x030M\\\xfc\xd0R\xa4\xb6@\x174?\x1f\x86\xf0\x86\n\xc8\xea\x82\xb6\xeaR\xc0r\x15\xc6\x8f\x8c2\xd8\xaa\x17\xb4\x80\x9f\x86V\x8a\xc2I\xc8BT\xc2\xe88 E\x0eD\x0eQ\xaa\x92\xd4\xc8\xd9\xa8\xd7l_I\x04\xca\xe0\x0bx$\x0e5\x83\xd9\xccz\xb5\xdb\xf4\xb4PC\x05qn\x01Kv\xcc\xc0\xc1\xb3\xdaY\xde[\xdd\xed\xf7\xf0\x17\x00\x95G\x91\xda\x9c\xb3\xef\xe7\xbd\xb9\xe1\xafp<\xb0\xdf+Oa\xc0d\xc1\xca\x9d1\xd6\x01\x9f^\xc1\xb6\xc0lS\\\xd1\xa3\xcb\xea\x06\xf9>\x01\xad\x85\x1e\xac@\x8f\xfa\xcc	\x1a\x1a\x9dx8\xd6\x98Q?\xf5\xac\x8c\xc8^\xc6yrxvl\x1d'\x9c<X\x1e\xa1\x8aV\xb0I\xcd8\xc2\xf8\xf0\x01\xda\n?r\xfaC\x8e\nd\xf8Aef\x96\x99\xfd\x95\xc1\x17\x8e\xbb\xd4\x86\xbev\x92V/_	\x05\x8f\xf4\xf7a\x05k\x0bY\xd0r\xc2{\xbb\xb0\x02\xf6o\x8f\x01\x9bK\xd0\xa4\xa6\xdad\x9a\x90\xc5,\x9a\x82\xf3\xb4 !\xf1t0\x0b\x16-\xf7Q\x9c\xb5DG\xf3\xa0j\xca\xae\xc1\x8fo\x7fV\x1f,\xd9\xa8\xc1l\xd6\xf8p\xd4\xb9\x863\xe7\x18A\x98\x91\xe1\xa8)\xe0u\xa59i\x81{\xc8\xc6r\xdb\xc3\xa32\xdd\x06G'\xd7\xae7!pUm] ?b\xd2N\x94\xf8\x01\xae\xaa\x83\xd7MT\x80\x9b\xc0\n\xfe\x80\xdf@L\x18*TB\x1a\x04\x04^\xf6Cx\x15\x04\x8f`V9\xab\xd5\xb6\x0c\xdc\x02\xc1\xe1\xc8\xa4\x9a\x02\x0b3\xde.u\xf4\x10l\xad H\x18\x98\x04L\xd40\x1c\x9e)\xbbp\xda\x8cj\n\xef\xa7\x0d\xb8\xa2\xdePj\xf3z\xd6\xc7\xackeO\x18#{g '\xc2K\x02`\xe4	\xc6\x0f\xc5\x14\x8e\x87\xa7\x89\x99\xeb\xf5E\xea\xc2\x06\xaa\x86\xae\x07\x12\xfe\xe1\xfde\xf7\xaa\xc1\xe9\x1e\xe53\xdfo\xae\x9b\xb5<\xd2\x15\xb6\xcb\xb2\xeb\x03\x8d3P\x96\n\xf0W\x8d\x1f\xcf\xb6H\x00y\x04\\\xd36b\x98\xe8\x89e\x96\x17\x1c\xee\x02\x8d\xcev\xa8\x84\x0c%\x9d\x96\xa44;\xf1\x94f\x88]\x033\xe8+\x89\x1c>\x063\x02G	&^\x92\x14\x8e[u\xbc$\x95\xd3\x92\x8c9rf\xae\x8f\xee\x9d\xe4\xbc\xddR\xad\x9a\xc8\xaeQ~\xdd4\x02\xee\x80\x1f\x12K5C*\x87\xeaS1;\xd8\x053\x03\xd4\xf6\xab\xa8m\xd5\x13}\xf4*\xd4\xe1)\x9c\xb3\x81\xc3U\xa3\x8c\xa9\xb5\xbb\x10iy\xa7\x9c\x8ap\xe0\xd2\x995:j\xc5F\x90\x17\xe7y\x91\x88\x8cI~\xb3+as\x0c\xe2\xc3\x83\x8a\xbd\xd9\x95o\xd8\x9aG\x9c\xd8\xcf\x17b]F\xa0\xaa\xb8\x17\xe55\xdbD?\xc2\x96\x85I\xbe\xcc\x8b\xdd\xb3&\xee\x91dt\x033\x13\x06]}\xd2\x00\xca{\xf0vg\n\x13\xd1;(\xdar\x80\\:\x94\xf7l\x1d\x9a\xf5\xc2\xe9\xb0\x07\xfc\xd7U:\xa5\xac~-\xb5(\xf25\xbc\xbe\x81'\x14\xd2\xfd\"\xea\x14\x1c.'P\xa9~`\xb7\xd8+\xf3\xaa\x88\xb9~PC)\xdd]O\x1e\x1e\xa3\xe9\xccXjh\xa5\xb63\xef\xf7\xf7\xd7&\x93\xf2\x07\xfb\x9a\xed\xf2J~\xbb\xa3\xf2 b\xbf\xdf\x9a\x8c\x89X\xf3\xac\x14yV\xbe\xe0\x0b\x91\x81\xae\xe20\xca\x9c\xcbgq\x9e\xf0:\x93\xfb\xe9\xfb?\xa2v\x8cEU\xb2B\xaa\x07FT:\x1f\xb0\"\x1dU.y,\x9f\xe7U&\xa9<\x1d\xdfP\xb6j\x0d\x1a\xf3\xceC\x93\x85\x08z\x7f\x0d\x9d\xac\x9e\xce\nz{]\xafl\xa0\x12\xabuP\xda\xf2(?\xf4&l\xd6m\x0e#\x16F\x8ab\x14\xfa\xa2\xdd\x0e<\x9a<\x08\xba\xbe6\xacI,\xd0\xd6\xc4\xdd\xd98\xe3\x8dS%q\xe3\x07\x1d@\x16\xf0\xc6\x82\xc3\x0c9\x9ay k\xc5\x88O\x8b\x19x\x8d\xa6\xbb\x1a\x94\x85d\xbc\xd8\x1ey\xc9\xf3\xae\xb2;\x96\x82\xffk&\x99\x87G7\xd7p\x03\xa15:\x9c\xd1\xbbr\xf9\x86\x9b\xb5\xc9\x12\xab,:\x0d`\x96\\^\xb3\x0d8\xfbn\xf2$'H\xaf\xde\xaeB\x01\x92\xd1\x1b$\xf0\xe4\xf6:Z^\x93\x9c\x9e\x0d\x08\xbc\nj\x0f=\xf5 [W\xfd\x1dg	\xd7ow\x9a\x1e')\xdd1sBd\xdd\x95\xd0\xd4\xf4\xf2H\xd0\xca\x14\x86\xca\xaeQ\xdan1T\xda'J\x16\xac\x0e\xeb\x05:R\n\xb6\xf2\x8b\x1a\x88\xc6\x00\x9c\xd7\x80Mg\x07\x0bu\x8a\xbb\xa2\x0b\x06\x0b\xb51\x92K\xe0	\x90\x1e\xf7\xa3\xb3\xd8\xf7\xe1\xf1[L\xef\x809\xeaw=L\xbdrs\x89\xab\x84\xc9\x94\xa1\x07 O$\x883\xf5\xa3\x9c\xb4\x11\x8c\x18q\x9b\x12e\xa4AG\xcf\xb3h\xe5`\xa8\xa3H3\xab\xa2\x953\xdf\xdc\xb2\xff?u\xef\xfe\xdd6\x8e\xfc\x0b\xfe+m\xee\\\x1d2\x82\x14)\xf3\x9d\xfb\xbdK\x05\xd1I\xe2t\xb7\xa7\xdbI:v?\xb5Z\x1f\x9a\x84$N(R\xc3\x87mE\xd2\xff\xbe\xe7S(\x80\xa0\xa4\xf4\xcc\xdc\xfb\xd3\x9e>\x1d\x8b \x88g\xa1P\xefj\x8f\xd6Q\x03\xb6\\\xb8g7\x8c]\xf6u\xd1!k\xdd\x08y\xbb\xe3\xb1\x84\x9b\xc8/\x02\x9d\x8c\x92V\x0c[\xa8\xa4\x94\xeb\xeb@_\xb3nh\x8f|\xba\x8c\x1cS\x80`\xc7\xb7r\xaf\xe7\x0dX\x88\xe6\x93\xb4\xd5\xdd\xb7q\x10\x82\xf5<\x88T\xd4b<\xc2S>\x1d\x87#Q\xec\xf7\xb0\xe2.\xf7{\xbf@\xd8\x8dN\xdb\xb8\xbb\n\x10P\xdc\xc5\xb4\x86\xa8\x10m\x04P\\\x15S#\x8b\x0eS)\xe5\xfd\xf5\xd4\\o!\x94\xebS\xfcc\n\xd0\x82\xc5(\x98\xdb\xc3uPP\xa7k\x00\xad\x80\xe7jKL\xa3\xc2\xd6T\x98!\xda\xea\xc5H$\x1d\x82\x82\xc6\xa6\x132B\xf8\xdc\xfdvymb\xc3\\\xa5>\x86\x11L\"\xb9\xf5\xb3\xa0\xd7\xcbxD\xfb\xfd\x98`\xb517l\xd5\xeb%~q\xd4\x83\x87\xa4\xd5X~\xff\x11KZs\xe6y\\\xfa\x8dD\xbfb\xd7\xc2NX\x9eB\x1em\xedW\x80*\x12\x9b\xa2Vy\x9dF\x19ng\xe3S\x1a6\x0eM\xbd\x89\x8c\xea\xd8\xdc\xf6\x9fZ\xf1aW\xa9\xcf\x96\x05Xi	\x91;\xd18z\xd8\xe1N\x8f\xfb\x10\x044\x03s\x91\xd7\x13=#\x12\xf2\xb2\x1ee\xc8\xe2h\x0c	\xda\x19\xf7Y\xea\xea\xad\xac\x00\x17\xb8)\xe36s^\xa5\xbe\xf4\x06^?\x1f\xc6\x98k\xbf\x1f\xea\x04\xfa\xfa\x9d\xd8Q)4z\xa2>\xb8<\xc4\xd2\x1e\x1bg2\x8e\x0c\x0f\x90q\x7fM\xd7\x91\xd6\xb2\x18*\x99b\xed\x93\xaa\xa5\xf6\xd3Y4\x9f\xe2\x1f\x98WaZ\"b\xf80\xd7X!S\n	~\xa2\xacq\x9bA\x14\x84N\xb4K\x02U{7\xe1\xce\x1cMR\xabyDF&%\xebY\x8a\xc8\xbd\x93\xa0cc\xe9\xa6\x15u4+\x04\xc5y\x0b\xc5\"w\x96\xe2!:\x97\xa8\xb2\xe4\xe3B\xcc\xcf\x0cQ\x8ffs\x01V\xb2\xd2\x99HE,?\xf93o\x93*Ox\xebh\xe3\x915w\xae2o\x8et2x\x07\xe9\xa1'\xbc\xfb\xa8\xf4\x84\xb7I\x9149\x8d\xb27\xf4X\xc5p\x06\xc6/\xb5X\xa8\xb8\xbe\xb1\xcfq\x94'\x99\xaa\xea4\xfe\x8c\x8f\x8b\xa7MV\xd4\xde\x9c\xe6Y\x1a>nA\x10a\\?7\xb2\xb6\xce\xa5Kys\xedo\xdc\x0bR\xac\xa5\x1a6iB)\xde\x0b\xc4U\xa5\x8f\xd7\xc1~\xbf$XY\x8b\x9d!\x17\x7f\x8d\xb6\x97\xe9:\x1c\x8b\x87(k\x14?\x8d\x0e\xc1$\xf1\xcb\xa1K\xd3:k\xeb\x00P9\\\xa4eU\xbf\xe5\xd6\xccI\x83O\xe7\xc3\xd0i\xb2\xdf\x9fT`S\x94h\xec\xa6\x18\xbcR\x1e\x13\xb94\xda:\x08\xe8\x8b\x91\xc8\xf4\x17\x8c\xc5\xf8\xb0>\xd8\x8f\xbe\xde\xc1\x81M\xe1y\xb4\x172\xb6\x8bh@m+t\xd2\xbe\xbf\x19\xaf\xeb\xed\xe4\xa9\xdf\x0f\xee\xf4-[\x88\xe8\xf4~\x8b\xdcK\xed	:\xcd\xad|\nZ\xe2l\x1b\xec*=u\xb9\xa5\xe3|'\xa3\xe1Y|\xb4\xdf[\x91\xe2v0\x86\xe5\x11/\xce]`&}\xd7z\xee\x1a\xfc\n\xe6m\x98\xd6j\x8d\xa6$\x98_\xf7\x85\xbe\xc1\xe9U\xd3\x89\x0b\xb7=C'a\xd0\x17\x9aR\xb2\x88\xcb\x98\xbbb\xa9l\xcec\x9d\x1c\x1f\xae\xd5B\x19@\xa3\x05\x80\xf4\xa6=XO]^\xef.\xf25\x0f!\xbaL\x818&\xfe\xc59\x12\xdf]f\x97s\xbe;:\xbd\xb6\xf7\xb2\xa5'\x19W#\x15\xb75\x1b\xab\x03h\xd8\xc1\x1d\xe1\x92\xb9\xf0/p\x97\xef\xf7\xde@\x97\xed\xf7\x0fE\x9a|3\n\xe8\xf2\"J\xe1\xa65\xef\xe3\x84A\x19\x07i\xf33\xf9\xe8C\xa3\x15\xcd\x83iF\x987\xcc4\x0e]_\x83\xa8\xd6\xd7v\x8b\xd2\x10|>\x9as\x1c|\xbf\x01~\x0c\xec\xb6\xad^\xfe\x8d2\x81Y\x10\xf2+Y\xfa\xcd\xac\xe8\xaf\xe6\x8150\xac\x0e\x16\xd1v\xc3\xe9\xb7\x0d\xb0[xM_b\xa8\xb1A\x1f\xd4b<\x8bN\xdbc\x8a\xe8\xe1\x9aN\xf5\x85IE1\xf9\xb3~\xd2\x85\xefS(\xf6y\xd0\xe9 \x99e_\xed`{\xdc\x01\x1e|\xacK6\x0f\xf6\xfb\x1b\xbf\xb1\xa1\xbd\xc7N\xe7\xcd\xb9\xce\x9d.\x9b\xd9\xea\xabsZ^\x07\xffr\xb5\xf44\xc4\x02\xf4LB\xa7\xf8b\xeb/L\x83:y\x8e\xd3\xdd\xe2h	M\xb5\x16<\x1f\xf9\x0c\xb4\x97\x14\x98\x91J\xa5\xa5\xaa40\xe7\x96\x03\xb9J\x88\xe5k\xcf\xac\xbd\xea\xa9\xc7\x14n\x066\xe5?}|!aK\xcf!\x85\xf0:M.,	{\x01\x15\x9c\xa6\x03\xe0?\xe8\xdcx\xf7<(\x93\xec\x9a\x94\xe9\xbd\xde\x05\xff\xa2XA\x93#\xd3\x84(\xd8y\xed[\x1c\x92\xa8\xd7+\xf6\xfb\xe6\xda&	'E\x8f\x7fb\xc8\xae\xa65\x859\x02\xb1\x90\xfb\xf8\x83x\xe8\xe3 LM\xb4$I/A\x04\xa1\xa2\n:\xfa\xa9\x1b\xc2T\xb5\xac;\xf6\xf6\xbb\x83\xfb8\xbb\xbe\x9e\xcb\xb1	Vg\x96\x08\x97\x87\xfc\xe4\xef\xf4\x82\x863\xc8&u\x15]r\x95'i\xac\xaase\xb86i\x95\xf4\xbbz\xa5\xd6\x8a\xfb\n\x04\xe8\x89\xf8\x1a\xc1\xb3\xf4\xeb\xb5*\x97\xea\xc3\x86o\xc3v\x99\xafy\x99\xad\xad\x8b\x9a\"\xb3\xfc\x8c\xc5l\xa9\xe3\xa6\xd2]\xec`\x97\x82\x1b\xf0k6\xeb\xd7\xf8A[\x0d\x82\x16v\xd8\xe6+F\x7f\x16\xcb*\n\xf62\xd5\x7fB\x98\xf73\xc1\x126\xd7\xc3D\xd5\xaa\\\xa7\xb9\xe2t\xea~\xd7\xe1\xe9\x96\x07\\\x9f\xae\x86\xfc\xe4\x1f\x97\xca\x85;\xe8\x96y?\x06\xd2\x83\x1e\x7f;\xe6K\xee\xc6\x8e\xf8H\xe2\xe0\xf1\x88\xbd`\xba\xecP\xdeN\x17\\\x05f\xf3\xe6\xf7\xa1\xa3\xaf~M`\x93\xf8\xb7\x8e`Q\xe9\xe8<X\xdb\xb5\x0fy\xaa\xa8\x03\x0dG\xce\xe8\xdeF6\x88\xcf\xb1\xa4\xaf\x923G4\xf8c+?\xbb\x8b6\xa9\x01\xd0\xbb:]+\x90~\x1a\"\x9c(\x8ak\x95\xa4\xd1\x8fiU\x1f\x15]\xea 2\xfcy\xdc\x94p\xd0\xb8Fe^\xff\xb6\xbe\x06A\xf8\xdb7\x1b\xae\x9f\xab\xc77Q\xc5\xbd\xb5\xa3\xfbl\x01\x83\x1d\x8eE\x84fJ\xfcSA\x1b\xc3\xa3\x04\x8e\xab\x87\xf7\xb6\x05\xd2\xda\xbb\x05\x81\x80f\xca\xd0\x08$-\xa68>\xbb\x83\x88\xda(\x1eU\x07F\xa1\x90\xa1\xd9\x06;\xae\xcb\x82e.\x9e\xbc\xbe\xf6\xb3v_ \x86\x04\xf6\xd2\xb3\x03\x1e\x18\xfe\xb3Q\xe5vZj\x02\xa8\x0e\xc2\x1cAbdM\xa8\x81\x81@\xb3\xb5\xb0\xf5\xb4\x93A\x89}\x90U ^_\xfb\xb3b>\x8c\x8b<\x8ej?\n\xcc\xaf\x85_v\xba\xb7p\xa5\x87p\x08\x82\xce\xeb\xd7\xd7G\xe7\x13'\x87\xd4\xe8\x81\xd8\xb5K\x15\x16\xe2h\xf3\xc3H\xb8[\x1c\xe6\xc2\x02AX:\x18\xf9cw\xb7L\x14Ie\xd4M\xa9\xd0\x1aZrp\x0d\xd5\xf3\xf4@\x92\xa4\x96\xd9q\x8f\x89`\x973\xdc4\xda\x0b\xe3\xe35\xdde\xf0\xef%sJhQ\x8c,\x11%\xa2$\xddp\xd7\xdcp\xf2>\xf2\xf3Y9\x17\xb5 \xc4\x0ey\x19\xa8h7\xd2\xe6{;n\x96;\xaf\xd3\x1cwC:\xad_I\x15z\xeb\xe8\x89\x1f_J\x15BX\xec\xc8\xf3>t\xf1@\xcd^C\xc2C\x14F\xd5>\xb5_\xbc\xe3/\xb0\x1f\x1c\xbd\xd8\xbc\x832\xc8r\"\x17\xd2z\xddAg\x80\xb9\xbb\xf7T\x1a\x80#\xb9\xc1\xfd\xc3q\xfa.S?GA\x1e\x08\x84\xa3\x84\x9e\xe1\xc7\xeb\x8e\x94\xe2\x04F\x00\xb0\xea)\xad\xea\xe9\xe7k\x9f\x7f\n\x03\xc3\xe2\x02V\xc0\xba\xb0=<\x86\x03\xa1\xf6?_\xc3\xdd\x0e\x15\x0f\xeeu\xf7\xc9%\xcc\xd1\x07h{\x1d \x80h\x08CB\xa6$ ~w\xcdt\xcc$}YLR\xa3\xc0\x8a\xe4\xbb\xebY\x8amUC\x1d\xe5\x8a\x84\x98&\xe2\xd4\xa4\x84Em{\xe3\xe2\xda\xc5!\x15\xf8\xc1\x1f\xf0Um>/A\xe9\x84\xce{\x89\x12*f\xa5\x1bb\x1a}\xb5M\xd3\xb3i\xd5<\x8b\xaam\xd7\x94I\x94\xd1\x0b\xdd\xb2sL\xbeXp\x03\xd6\xea\xf5\x80\xc3\xa9Ce\x06\xba\xdf\xd3\x83i\x8b\x19\xe4\\:U\x107\xd5\xad3\x01\xc6I\xa7n+\xdd\n4\x0cQR\x05\x91\x07\x18.du\x81\x80\x89S\xddVk\x7frH<\xf3\x88\xea\x85;\x8f7\xb4\xc94\x1b\xcf\xee\xaf\x17\x08]\x02\xb4\xdc-\x89J\x15uK(:Z\xf7	qblI\x03\x8b\xae\x1f;\x95T\xb2T\\\xd2\x8e\xe4\x17>QX\xa5T~\x80hX/+\x1cB\xafI\xed\xe6D{\x02\x08\xe6\x16\x04\x0b	Q\xb6\xba7 X\xbc\x8c&\x85\x01A%\xd5\xfd\xac\x98O\xf2\xe3\xdb\x9d\x1c\xb3\x89\xbe\xc9a\xfb\xeb\xac\xcaw\x11\xdf\x04>\xad\x8f\xf8\xa53\xd3v9{=\xbc\xb1\x8f\xb6\x8a3\xad_\x8d8\x8ff\x14\xec\xe8T\x89s\xcd\xfer\xba\\\xbf\x1c/W\xa7o\xff|\xe7\xe2\xa8\xf8\xa4Eg\xbcN\xd3\x1a\x01\x81\x07_G\xe5\xe7\x8f\x88\x02N\xa1+>E\xb0\xbd\xfc\x0e\xff\x1ajQW\xc1.#\xec&j\xa4T#\xe5\x1a9\xd7x]\xaa\x080\xfd]\xe4\xe7\x06\xbfi\xc6\x1b\xdb\xe7-\xcbh\xb3jCD\xf2\x0d]\x0f\xf3\"Q\x15GJ\xab\x87Y\x9a\x7f\xa6k\x1fc%\xf6\x17Y}o\xa0O\xc2\xf6k\xb3\xe9\xc8\xec<\xac\xa7\xbf\x8b|\x98\xefCTT\x1biL\xaa\xba\x82\"\xda\x80C\x00\xd5TA\xcd\x15ns\xc5Qs\x055\x07\xee\xb2\xb3>\x01b\x9b@\xea`\x01\xb14\xfa\x1d\xddNy\xd4Ni\xdbq\x17\x91 \xbb\xd3\x0cb\x8c:\xcd\x18	\x0b\xcdn\xebghe\xea\x7f\x17\xd1/\xc8\xba\x85\xf9\x8d\xdc\x93!?\x04\x13o\x195K\xd5\xae\xf0\x94 Fx\xd0\xe2\xba\x00!\xbc:\xad\xe9H\xeb\xf7\x89\xaa\xa3\x14`\x1czu\xa9\x14d\x8aN\x1b8\xd6C(\xf6\x92\xb8l\xd6\xf7]\xbc\x91\x90\x8b\xc1\x83\xca\xce.77\xd8\xb6\xd6\xeba#\x86\x99\x8a\x1eT\xe5\x9e\xc0o]%\xdcV\x8b\xb4\xeb\xe9\xac\x9e\x87.\xe1\xfb\xbdS\xcb\xd7\xb5 \x00\x86De\xe74\xf6\x07\xa3\x16\x04\xe4\xe24@&\xc1\x10\x034k\xe7\x8d\x96\xd3J'R\xf0\xba\xa9\xf6~\xee\xfa=;\x9c\xcf\xcf|\x17\x88\xfc\xab]\x80\xe6\xadaL9)m'\xc8\xe1\xd8\xefS\x18^8\x97\xc0\xa7\xa0\x84}\x8c\x1f\xcd\x8a9\xcc$\xa0\x9d\x03\xe2\xf2\x99\x8dF-h?!\xdc\xf0\xf9\xa7t\x93~\xfd\xc6\x9c\xc6\x97.\xa7\x01_\x854\xff\xa6&q\xf5lL\xbf\xd1\x04\xbc\xbaa\xaa\x82\xbf\xa3\xf9\xbc\xc3\xeb\xfe\x83\x9br\xa89\x87(\x9c\xcdE!g\xef\xa3\xf7\xe2}\xf4\x1e\x02\xf5\x19\xa2zD\xf1\xe7O\xaaj\xb2\xfa\xd2\x88&\x05\x17\xab\xe4\xc3\x83*m\xb1&\x07\x18\xc0\xd50\xadnt\xa57[\x92%\x88L\x96\xc3u\xb4q}\x07#\xd1Z\xe7\xcaRs\xa3\xa6\xed\xb6\xbb\x86\x0e\x97\xb1[3\xd2\x90b\xc2\xa9c'\xd54\xd1\x1f\x7f\x8a\x1e\xa9+\xf8G\xc4\xc7\xed\xbd\xd9vZ4{\xb8\x90\x98\xf0F\xa6\x83\xf1d\xf3\n\xb9\x99\x06\x03\xce\xa6$\xeb\xd9\x86h;\xa4\xedM\xe4\x92\xe66,\xb9\x93\x0f\x0b\x7fy\xae\xed8\xa0\xb4\xc3\xec,\xbb6\x9f\xc1\xa6lk\x07\xb8<\xbf\xb0Z$\xb4\xa2\x0c\xac\xebW#d\xc5\xa6\x9f/G\xc1n\xd5\x97k\xb1\x90k\xa3\x1e7l\n\xd2\x16\xc8\x95\xc8a\x96\xbb\x10\xf9!\x98\x94\x14\\S\x8bUMV\x0d\x88\x0bi\xfc2s\x01\xe2w>CY4L\xab+\xf6i$A\xa5_\xcb,bY\x11Z\xb8-nH\xa6\n!%s\x86Z[+ks\x7f\x10\x13\xea\xd8r\x90\x07\x88+\x89\xa5\\?w\xd7\x00R\xaa\xab\xe3g\x9a\xb0\xffwI\xba\x86\xd1\x90	\x84\xa0\x9bJa\xc5\x85g\xf1\xeb\xf5\x0c\xdf\xaf\xaf\xa79\xa9\x1a\x8e\xc5\xbaa>wf\xf6O\xb0\xd9\xbcD\xed\xc8\xf8\x90\xb6\xd5~p1R[\x0f\xe6M\xb6\xce_\xba\xea\"\x18\x9a[i(\xe5.s\xda'\x81:\x089g(\x7fw0IW\xe26\x05\xa5\xeeJ\x15~:\xadZ\x830\xcc\x0d\x83n\x05\xd7W\xf9\xa2\xa8\xba\xfd\xa0\xa6\xc3\x9b\xe1\x1a\x036\x06\x04{\xda\xa4\x88\x8c\xd4\xedy\xa7\x1a\xfc\xe2Z\xd5\x91\x15\x07Ns\xc4i\xa8\xd4k\n\xb8\x97\xc1\x04\xb2\x86\xf0\x83y0\xf0\xa0\xd4\x10b\xac\x93c/\xa2\xac\xb3\xabk\xafwF\x8f]\xcb>\xf9\xce\x1a\xdb\xfd\xda\xc8\xc6O\xfc\xf1\x955<ku\xa5\x00#}\xba\x01\x88W\xb5Zs\x14S\x16L\xb2\xa0\x11V\n\xba\xe6\xc7\xb2xH\x13U\xfa\x14\x90\x82\xe1\xf6\xc8P\x022\x8a\xa5j\xcf\x1e\xd6\xd3O\xedE\x808I\x85\xacH\xba)\"Yio\xf6@|{=+\xe7\xc4X!J\xee\xc1\xb9\x8e\xd2\xaf\x8f\xfc\xdf\x18\x8fI*\xbe\xbc\xde\xef\x01\xe5\x0f\xac\xf9.\xce\xce\xddl\xd4\x85\xfe\xe0\xd1/4\x03\x8d\x05	D1-\x00VZ\xc1\xe0\x0e1wt\x16dHS\x94\x9d\x98\xd9\xf4\x08Q\xa8\xe6/\x08\xe0J\x85#\n?7k\xaeu\xb7\xc9\"\xec	\xfe0X\xc6l\xb8D\x7f\xb9\xac\xc8/\x11\xdeW\"\x084\xfd\xe2\xf2\xc4\x86\xefF\x07\xb1N\xef\xd3\x0e2\xe2u4\n\x97\x7f\\\xeb\xbe\x8d\x8a\x0c\xd4\xf2\x1dB\x8a\xa4\xf9\xf2cY,KUUR\x9d)D>\x8e\xa8\x84lC\xe5I\x98\x0b\x1a[\x98\x0fR\x91\xc3]\xed\x1f\xd7C\xfc\x05\x1f\xc5cpV\xa2\xe4\xa3T\x0f\xef\x92F\x19\x0b\xae\xbb\xa2\xa97M}\xd9\xa8wy\x82\xb0\xfe\x89\xfe5\xc2\x90*\x85\xe0\xcf\x1f\xa8\x06^c/&\x9c[cr\x01CD^\xcc^\x0fQ\x8c\xcd\x93\xdfvO\x14\xe3\x86\xa7D\xaar\x0e\x92\xfb-\x94\x9efZ\"\x95m\xad@l\x89\x87\xbah\xd5\xe7~*9\xfcW=\xbc3\xf5\x10Mfx\xb7.\x12\xb2\x85\xc3\x0f\x80\x9367\xb3\x03\x05\xac\xdd%\xc5c^\xd5\xa5\x8a\xd6\x06\xca\xc84\x9b6\xcd\xef\x84\xc7\xabJ\x03\xe6\xc6\x9e`\xf26\xe5\xbb\x83\x1ee\x86\x1a\xfb\xbd+\x02\xce:\x1f\xb5\xa7\x9a\x82Nt\xb4\xeb\xa0\x15\xec\xe9\xf4\xbdVk\xe1A\xa6\xd1\xd2\x10\xdc\x1c\xc7K\xed\x9e\xe7:\x98\xe4];\x88Tc\xe8N)\xa4l)\x8b\x83\xbeq\x99\xd7\xc6=/\xc8\x17\xc4\x8aJ;f\x0d\xef\xae}\xd8\xaa3?\xfa\xc2\xccI\xd9\xcbXu&\xaem\xc4M\x90\xb8 \x10q\xd9\xb6\x17[@Ty\xf2J\x0d\x19\x02\xa3:2}\x03\x89\x9f\x1b\x99\xdb\xa8\xfb\x9d3\xd8\x84\x1b\x07y\xff\xf6\xfb\xd7\xef\xbf{\xfd\xe6\xc7ww\xd7\xefn\xbf\xffp\xe9\xb8\xdc\"X\xd1\xf0\xb1\x8c6\xd7\xaa^\x15\x89\x9f\x8a\x07\x7fQ\x8a#=\xca\xc2\x99\xf9\x06\xbf\xc1g\xd2\x9c\xed\x99\xf0\xf9\xc4\xd3\xb8\x9dql\x9cO}\xab\x12\x06\x12\n\x86U\xbcRI\x93\xa9R\xf0\x95\x06\xfc\x9enH\x08\xea\xd7\xb0;\x088\xae\xb3\x86\x82t\xc8\x02\xee\xdb\xa8\xfa\x8c7\xe6z@\xdc\xe0\xa5B\x10\xe0\xe6\xfe:\xdaL \\\xc9e\xc1\xa6/h\xa7\x0dE\xe0\xe0\xccei\xc4\xf5\xe4j@\x06m\xe5\xa3FeM\x9a\xc8\xeb\xc2\xf7\x1eR\xf5\xf8v\x15\x95\x14'\x17S,U\x9e\xa8\x12#\x90y\xe9\xef\x80(\xc3m)\xe8\xc0\x87O\xe5\xe1\xa4\x9a\xc1\x01r\x87\xb6B4\xe2\x8ca\xcd\xfb\x84\x8b\x05\xc4\xd3\xb0.\xd3\xe5R\x95`\xe1\x8f= Z\x13Oc\xd5B\xcelt\xd4\xfd\x80Dxh\xce\xba\xb8\xa5AGE\xf3`o14\x91\xcb\x8f)\x9eM\x10\xf3|\x9a\xf8Z\x8ai\xc6\x06\x9d\x07\xb5\xb7T5n\xe6\xef\xc0\xfd\xa7\xf1\xbb\x0cc\x83\x04;\xac\xc9)\xaf\xfb\xce~]\xeb\xafE\xd7el\xdb9wW>\x1f$g\x98O\x0e\xc4\xf0[\x91:V*\xb8\x9e\xa2M\nQ\xdcp\x13m\xb3\"J J\x1an\x18r8\xa9\x9c\xc5\xa3\xe9\x83\xfaD{\x86\xe0\x02C\xec\x81\xa8\xa0\xde\xfc\xe9\xda/\x82aC\xc1!5\xf4\x8bLFS/\xcd\xe3R\xad\x15\xfc\xfe>\x96\nA\xad\xf4\xe7^XA\xf4Y\xcd\xa7U\xe8i(\xf0\xf8\xfc\x9b\xc7\x0b)3\xd4\xc9\xe6\xbe	y(\xd2\xabY\xe6\x10\x9cwv\x13l\x11\xb2D\xac\xa4o\xc2a\x10!q\x9b\xae\x95\x1f\x98\x10*\x9c\xe3\xc8/\x85\xd6\x95\x1c,Q\xa4\x939\x93\x93\xe1\x88kOH\xbc=\xa2\xfb)i\x03\xdcAXs\xb6\x8f\x92\xa8{Q\xc9\xa8\\6\x98\xb7\x96\xe4\xd42\xdb\xef\x15\xbc*\xf7\xfbt\x92\xd1U\x02\x83\xc2\x81\x9fL\x9bp\x15\x0cj\x11#\x93\x17FZ4\xb5\x1f\x07\"\x99\xc6\xb2\xd2\xed\xa2$\x17u\x10F\xaf\xe4h\x9a\xfbAx\xf4\n^a\x8d,Z-\xe6\x90Zs\xc7\x1b\xc3\x06\xf5\xa8\x8b\x98\xe5\xba\"\x19&\n>E\xb1z\xaf\x9e\xea\xb7Q\x96\xb5\x9f\xd6\xc1.\x93\xf5A$\xed\xb2?\xf2\xb2\x1b\xcf\x94\x02<41\x806\x0f^1\xcb\xaf\xe6\xfb}!JY\xcc\xa2+\xfdrV\\\xcd\xb5\xa6\xbc\x84v\xdc\xb1WJ\x11\"\xc30\xachLF\x13\xe8\x94\xf0\xeb\xae\xf4\x91P\xc93C\xc4\x11\xce\x83\x00\x1d\xc8HP\xeb2\x17\xd4\xb8L\x0df\xeah\xd9\x19+0\xf7E\xc6\xfdP\xbd\xe4WFf-\xe9\xc1967\xce\x0c9\xd8\x9ca\xfa\x8cz\x11\x9aFf\xc0\x9a|\x91\xe6i\xb5R\xc9	\x87\xf7\xb1,bUUE\xf9=Y\x93\x95\x95L\x8dn\x0eg\x0f_\xdf=\xa4U\x13e\xb6Bn*LX\xa5\x99\xb5\xefR\xa37\xcb\x193\xdeUu\xb4T@\xa0'\x16\xd8\xd7v\x0e0\xc8\xb5\xc5\xcc\xc8\xf2:\x817\xac/A\xb3\xc0\xf8\xa6\xd6\xe4\xcbuD^w\xe67\x8b\x07\xee\xee\x0cR\x18\xd2\x1d\xe0*\x14O\xac\x14*\xda\xdc\x8bb\xa8'\x07\x15\xf1~\xef>\x91f\xa9}4\xe6\xabuwF\xdc5.\x1d\x01\xe3nM\xdc\xf0t\xc5\n\xe6\xde\x0f\xaa\x8c\xb2\xcc\\b&\x11\xbbH\xe4\xaa{\x8d%\x84^;\x185\xf2\x0b\xf2t\xf2y\xde\x84#\xa0\x07\nD\xdc\xeb\xad\xda\xc2+\x1f\x9e\x9c\xb4\xb9\x0b\xe6TT	\xef\xb5\xd7\xe5\xb2\xf2W\xa2\x18R\xd4\x85\xe0\\'\xf5p\xa3\xeb\xfa\x0b\xd0O\xe5^\xae\xdc\x12\xd2C5\xbd^s\xf4e%2=@(z+3\x14\x1aBs:\x84\xaa\x1dB3\xac>\xa7\x1by\xa1L/\x9f\xa2\xc7\x1bZ6\x10ui\xa5\x7f\x7f\x9bf\xb5*U\xe2[\xc6\x82\xaf\x0e\x11_\xf9\x15\xdcV\xcb\xbd\xac\xec@\x1b\\;\xb8\x8d\x1dX\xdfK\x87\xfc\xba\xfa\x1a\xb01\x13\x1d!\xccv\x9a\x88\x8a\xa5MPd\x96\x80=?\x12\xf6\xe6\xffln\xfe\x8f%3!\x1f\xcaC\x10L\xec\x18\xe5\x8e\x06	NE\x93>a.\xa2M\x1a\x16\xa2\xa9\x14\xa5B\xfc\x85 *\x84TM\xff\xec\xf5.\xf0\xa0m\xe7\x05\x11\x18J3d\x9a\xc8P\xcc\xb0Y\xf2)\xac\x0f\xe2\x1df\x93\"\x03,\xc6^\xca\xb4\x0bx\xfb\xbd\x7fT\x82\x93\x07#UcA\x04\x90\x16\x08v\xbdT\xf5mT.U\xad\x97}\xa2\x86q\xa9\xa2Z}\xc8_g\x99.\x9b\xe6\xb4\xf5v\x9b\xfc(\x08O\n\xdfl\xd1\xa4_\x89(\x08\xb3^/C\x96\xd1\x80\xaa\xf8Q\x0b\x15\xf6\x84\x82n+\x8fa\x11\xc7\xbe\xb1\x96,l\xe8\\ \xd6\x800\x19\xb5~P\xb0\x91\xe9\xd0\x18\xb7_\xdb\xd9V\x80\x82\xf3)rYQ\xdbi0\xd16\x02\x15moJ\xdbKk\x1c\xbe.\x05s\xb8\xe1\x8f\xd8XQ\x1a\xb8\x0eD~\xbc\xc7J\xf0\xd16\xfc\\\xb8\x12\xeb\"I\x17[K&\x87\xf1A0\xb1*K\x91\x0f\xef\xee\xe8\x0c\xc8\x95\xde@\x84q\xb1\x1b\xe8\xe0\x89\xee\xd3~\xdf\x0e\xf0\xb2$\xa9\xa0\x1d\xc91\x94q#\x9f\x18r\xdc\xc7\x0e\x00\xb1\x87_\xd9\xc1@G\x8f\xfb=\\\xd0\xb2.\xc84\xa7 #V\xf0\x05\x80G\xc9\xd1\xec'\xd9W\x80\x04\xd6\xdfa3m:0\x12\xfa+\xb8\xd9$>E\xc4\xd6M\xfb\x012wZ\xb4\xd6\x81\x9e\xea\x1c\xf4,\xbe\x06=\xbc\x8d\xa2\xfa:\x1c]\x12%Zw\x16\xad\xe5a\x88*K\x85%B\x1d\x00|]\x1e\xddXGp\xd1\xeb\xbdu\x10\xd1[\xcb\x86\xd0b\xdb\x91\xd1]\n9\x96e\xdb\xfd\xc0\xbcl\xbf\xfe\xb1\xfbu\xaf\xd7\xfe>\xad\xfc\xb9\xcb\xf5\x02\xa9@x\x81\xbf\x86\x12\x17\xff\xce\x04?\xf2\xcatq\x18\x9a\"I)Qm\xaf\xb3L\xe33\xbfUT\xd2\xa1\xd2f\xb9\x95\xbcI}.\xf97\xfa\xb6\xd2;\xa3\x8cy5\x9ev\x8d\xc8\x9c\x05\x7f\x0f\xf6\xe9\x10\x84\xc9U;\xe4\xf7\xee\xd4M\xa9\xab\"a\x05^!\xd3\xce0g\xb5\xa6\x04IR\x12\xd5\xd1\xbb(^Y>\x0c|\x07\x19\x17O\xa2\x97\nl<y7\xb4\x15\xfd\xdc\xba5P\x03\x86'q\x7f\xfbt\xe8\xdb\x81~\xe8\xeeQ\xe2\xc8\x03\xdaJ\x8c-Zy/!\xb4\xe2\xe8H\x00z\xa0\xc8\xbd(\x86+\x15!N\xb5\xfe\x01\xc9{1\x84\xca\x10\x03\xc1_\xe2\x9e|[,\xe1:zw\x97&OW\xb9\xe1\xc8ea\xfcD\xe8\x9d9y\xd21\xe9\xf9DC_\\\x11\x91>\xa9\xcb\xed\xae\xf67Wby\x15\x1cb2&\xaa\x83\x9d!w\x17\xce\xde|\xe1\xf3b\x965%\x97B\xacP]\x10\xe3KV/o\xda\x0f\xde|m3Y\xa3\xd7\xeb\xa9\xaeA\x92\xb8\xbdk\x9b\x9b\xd5s\x0e\\\x0c\xdd\x83\xe2\xb4V\xb6\xf1_p\xa2n\xef\xda*\xce\xba\x7fg\xb0\xf4\xee\xab\xae\xdaww\x9b2-\x06\x8a\x7f\x1cr\x18\xd5\xee\x0e\xe2$t$t\xf5J~{\x05I\xbf@?\xc34\xe1C\x0fq\x84\x11\xa9\x16kV\xc3DL|\x7f)\xe5\x1dX\xf7\x9d\xe6:U\x19\xe6,\x95P\xe5~\xef\xc5Q\xfe\x10U\x9eH\xd4C\x1a\xab\x8f\xe9\x93\xca>\x91\xe9W><.\xe2\xf8\xd9y7>An\xe2\x13\x18r\x1e\xde\x99u\x9d\xa9\xe4\x8f\xf2\xdb\xac\xa9V\xe0o\xd6~4\\\xe0\x01R\xdb\xf1\x7fSNw\x18E\x05\xbd\xde/\xb0x\x83\x1c\xcf|\xad\xd6\xad\xe2\x07Q\xe4\xea\xea\x97T=:\xa6\x89\xba\x10D\x89\x95M\xb7\x96\xb8\xc7u\xed\x8b\xa3\xfa\xda\xc1\xe4zY\x92\x1c\xe7mDH\xa7\xe45\x03\xebST$\xa4\n&\x91\xf2\x7f\xbdBX\x93H\xf9o\xe8\x073&\xe6>\xa4\x16\xc0P\x01>J\xf1\xe6J\xfcz\x15\x88\x0eH\xf0'kUU\xd1R\xbd\xa5\x98\x1e\xf4\xd9/%\xbf\x82\xaf\xc0\xbb\x07\xcc\xc0\xe0\xf2RU\xd0\x81\xad}\x96\x10\xe1I\xb8\xadm\x14\x85\xadx\x1d[\xeb\xcf\x88s8\xc0\x0b\x18i\x13\x17%\x9c\xdd\xb8z\x91\xd3#7\xf1{\xe9G\xfc\xf3\xfd1\xd8\xfe\xdaE\x17@\x14\x18\x02\x84\xc5\n\\\xfd\xc9\x1a\x02s\xbc=6a\xf5\x83\x89\x92\x1fR\xbf\x80\xa1\x9b9\xabZEn\xcd9Jc\xc4S\xc9hV\x12\xea\x04\n\xb5\xfa\xf9\\\xe2\x11M \xf3vpF*\xf7mG\xfe\xc3C\x84\x00\xa8\xdd\x9f	\xa1\xe8\xba(\x95AN\x15\xc9\xa4\x86\x1b-\xb0\xb91\x1c&\x96\xfe\x9f\x98\xbbg\xc1\xc6\xa3u0\xa5\x00<.I\xf55\xe8\xac\xda\xf7\xbcj'td\x1e\xec(\xf1\xc8\xdd]\x94\xa5\x0f\xaa\xd7\x839\x92\xfe\xd7G\xa9\x1e3\x92\x13\x01\xf0 \x01cX\xe4\xe98\xb2\xcb\n\x96\xdc\xd5,\xef{W\x89G\\\xbd\xfe\x85(\x16}\x8f\xb4\x13\xb6X?\xe87\x10n\x9b\x17\xfa7\x9b\xc9\xee\xd6Q\x9a\x83\xa8\nsA\x96\xb0au \x81hf\x0d\x9f\x0bC~\xa7C\xf5\x14gM\xa24qu\x95\xb0,P\xa7)\xf9\x04y`\x83\xc49\xb0\xf9#\xe2\xaau\xe3qLpA\xa3\x9b\xfdeb}\x98&\xc1~\x1f\xf9\xf5\x8c\x85\xfb$\x01\x99z\xedI\xf7B\xaf{\x94\xbd\xf9\x0c(\xf3!U\x8fW\xc9\x1c\xb6\xd5l\xf1\xf8\x08!\xa1S\x97\xf0\x81\x11*\xd4\x1d\x8c\x12t\\\x01\xff\xe0[\x85\x05\x1c\xa6&\xec\xf3\xf3.8\xe9\xe0bLa\xday\xc1o\x97\xe5\x837\xa45\x01\xffV\x81\xcd\x9a\xd5\xeeH\x9d.\x7f>\xea\x12\xb7\x0du\xa6\xaa8\xda@4\x99\xab\xb8\x16\x85\xfct\x05\x1b\xcbJ\x16\xc3\x88:\x83J\x03iN+\xeep\xbf\xf7\xf4\x8f6\x84h\xe8i\x81\xc2\xa6\xd8\xf8\x01\x0b\xe4.d6\x1b\xcd{\xbd\xeb+\x1f?4\x9e\x99\xfdx5\x97\x17#\xda\xe3\x95\x9c\xd5s\x11\xcb\x8b\xf1\xa4\x1e\xde\xe3\x0e\x86P\x0d\xe4\xf9\n\xf1\xa6uQg+\xf9D*Y\xfa\xe4\x9d\x8ax\x07\x81P\xba&]\xecB\x1d\x98\x04O\xc4\x06H|)\xbdU\xba\\e&\xcbH\xba\xdf{I\xf1\x98\xc3E\x15\x1b\x9fN\x1e\xaf|\xa7\x97:\xd8%v\xe6\xd6QB\x1f\x1a\x8b\xae\x03\xe1'2\x01\x10\xe1N\x08\x02ZKY\x0d\x15P\xea~\x9f\xd0\xb3\xd8h\x9dO\x12\x88\xe5\xf4{F\xd8\x8d\xa8\x85\x01;b=\xdd\x17\xd9\x10\xe7C\xd0a0\x06\xfcH\xe7\x9a\x93\x90\xae\xd9\xef\x97\xfb}\x065\x17\x16\xf2\xf3\xd5|\xea\x03\x1f\x11\x8e\xfep\xc5\x9b\xd3^\x03\xa2\xe6%\xff\x8c%\x1f\x07\xe1\x87\xabY\xe3\x12\x17\xd0\x8b'2\x9e\xee0\xda\xd0\x8e>\x15\x1d\x80\x08sA\xcb\x1bn\x0e\xe1\x86\x82\xa4\xa0M\xda\xc6\xb1 \xfd\xe2\xe9ec\x15\x05\xbc\x12.\xff\xf1[\xd9\xb5j8w\xbbL\x0c!m\xd3j\"gJ\xba\x80&\xe1\xe7\xd2\x99B\nO\x87\xb6\xed\x7fP\xdb\x17\x86\xd10\xa3`pM\\\x1d\xdb\xef|\x1a\xa0%\xf6YF\xae\x12\xaf\x05\x04\x04k\xb1\x0d\xd8\xf7\x81\xb8\xa8\x9dkO\xbb\xddAT\xe9C\xe1\x88\xa5F\xde\xc3\xf66p\x05<{u|\x8b\xf2,\xf7{\xa7+#\xfb\xf4:b\x83\x7fZt\xdf\xa5\xee\x8c\"\xd4\xbbS1\xd9\xa6\xb0?,\x87@-d\xc5\xa2\xe4\x0b\x96%\xaf\xe45p\x16\xb6<\x80L8W\x8f~4\xfd\xe1\x1a\xb8Q[\x91\xc3U3\xcd\xc5\x8a\xa00\\\x96\xee\x1b\x14\x05\xc1\x10\xe4\x83\x9f\n\xb8^B\xa4\\\x08vh(\xe0\xd1\x19%\x89_h\xadUph\x91\x91,\x88h\x97J\x14\xe6n\xc2Q/\xcc\x95$k\xc1_\x0d\xef\xeeTl\xb19\xb0\x8fsq\xc0\x16T\xdf!\"\xc5\x9d\x13\x9e\xb8\xe5\x88\x0b\xca\xe7\xc8\xd7-02\xe4\x92\x02\xc3\xb5\xe1q#\xe9\\\xba\x90\x9d\x8aRF\xd3ST\x1evq\xb8\xa8\x8ek!\xceM\x07{\x93\xcc\xf5K)H~\x84\x0bV{1Z\xdbM\xb8\xe4\x95\xb3\xd5\xbc]\x83\xf1$\x9a\xa6G7\x98\x85B\xe2\x1c\x0d\xc2\xd0V-\x85\xe6\x1fS\xf7^(\x02\xc7\x99\xd0\xf6\xc5\xc2[\x89\xfe&\xb1\xe9q\xba\xea\xf7C\x1f\x8b\x14;\nCG\xfe\x90\xb1\xd4\xc1\x8fy\x17El\xdfb\x15Ei\x02U\xaf\xc48\x10\x89\xcaT\xad\xbe\xa9f\xe8!M\xe6B\xff\x95\xf1Ww\xf3\xd8\xfc\xfd\x07\xe6\xd8\x895\x7f[dE\xf91\xca`\xd6\x00\xe2\xf4k\xd7\xdf\xd9\xea\xee\x91\xf9\x8bsd\xfe\xde\xfe\x16|g\xb7\xb4~\x07\xf9\xd7f\x99\x90\xde>\x10?\xfd\xe7\x1fB\xd6\xcf\x0b\xa8\x8e\x14\x8c\xed0 \xfc{\xbc\xf2\x8b\xfd\xbe\x03N\xa7\xcdZ\x03	\xa6\xcej\xde3M\xa5B\xb2\x9cW\x80\xefN?\xed\xa8mL<\xa8\xd2\xfe5Ya\x85\x90.L\xbb\xb4\xcf$m\xd7g\xc4r\xb9\x94\x87DJ\x83\x92\xef\xa1\x8fZ\xf0\xa4\x85\xdf$_hu\x0f\x8e\x04^D\xae\\\xbc<\x11\xc4#p c\x85*\xcdT^\xcb\x8b\x0b\x92\xe9\xf9\x9e~\xf6h\x01\xb0\xd0\"\xadx\xc1E\x07\xed\xcaH\x802\xa2S\xa9\x02\x91\xd1.~)\x87I\xb1\xee\xa8\xa0k\x90O\x8a\xb6\xe5\xbb\xabv\x0f`X\xc5\xf9\xe5\xdb\x8dTU\x97|\x03\xbd\x1f-AN\x8d&\xe9\xf9\xccZ6\xab\xd6~\x9fS(\xb8\xfc\x15Od\x05	\x1c9\xa7\xde\xaeJU\xad\x8a,\xf1\x82^\xef\xa2\xb8#\xe3\xf5^\xef_\xb7\x08\x8b\x84J}o\x1b\xd2j\x97v\xbc\xe9\xd1x\xb1\x17\xde}\xa6\xf2\x04t\xa3\x17h\x93\xe0\x89\xe2\xa5\xfeW\xdda\xc2\xdbL\x0d\xa9\x05\xa8 !]\xabTMv\xdb\xdc\xb0\x87-\xd1\xa7\xf7\xa3\xbe\xf4.\xb5\xe9Kt\x9f\x81j\xf8\xca\x9b\xa3.\xcf4z\xe8\x80z~nf_\x00\x15&\xae\xd9\x17\xb2 \xf7\x82?\x9f\x1d\xdb3\\\x18{\x06Xi\x18\xf7d\xbf\x1e~\x81X\x8a\x8d\x11t\x01\xb5*\xf3\xaet\xbf\xa8\xda+\xb9\xee0\xfeFD\x18\x91\x9e\x9b\xbc8\xc5\xee\x1c#\x1b\xae}u\x96\xc3\x05\xf0\xba\xd1\n\xdel\xdfed\x0b\x10\xda%S\xda\\t\xa2\x1c\xba\xe9\xe42m}\xbdS\xc3\xe2\x1a\xde\xaf\x1b\x0d!u\xaeZ\xb6C\x84\xfe~\x88k5\xaf\x0f\x1d\xf7\xae\x88&n\xd7Auef\xda\x1e\xde\xda\xae\"\xe2\x17\x82\xd3Ap\xa6\x88\xfdL\xa5ww\x17k\x0e\xe4g\xe6j\xa2\xba\xa9<P\xe7_\x9c\xd3H\x08\xad>\x92m\x80\x84s|\xda\xb4&\xfc\xe7\xabY\xad\xc1y\xde\xeb\x8d\xb0\xaf\x08\xb0\x075*\xb1\xc7\x1d\x1a\x97\xd7\xc1:\x95\xac\xa3\xcfJ\x93h\xdf\x96\xc5\x9a\x04%P\x11G\xb0\x14{\xbc\xf2\xffp\x06\x04\xa7I4\x0e\xa9\x1e\xf5F\xde\x08\xf4\xab\xd7\x8bl\x90\x8e@(?B\xdc\xc1\xc7\xabN\x12\x9b`7\xe6\xa1\xe1H\xe0\x96\x05\xad\xad\xfbFP'\xfd\xdd\x0b\xf2\xa5s\x96\xbb\xa4\xe5\xc6\x14A\xda\xb7\xe5U\xe5\xc8\x1e\xff\xb8\x9a}\x01\xea\xfa\xfd*p\x8c<2>0\xdf\xd2\xc7\x8e\xdbbC\xdf\xfer\xc5\xb6\xc4No+\xfedYA$\x85\x9b\xe5\xf6\xcay\x1dWF\x80s&\xf1'\x0eR*\x11\x8c\xdf\xb3\x01\xd8o\xae\xc8\xb9\x82\xceY8\xab\x05TCX\xe3P\x1d\xe0\x802\xa95+B6Y(\xdf\xef\xf3\xa3\x94\x11:\xb6?\xde\x89\xbb+\xff\xe3\xd5\xb0V\x15\xb6\xa8\xd73\xbf\x914\xee\xd3\x15\xa5\x1b\xf1\xe9\xaf\xdci\x9e\x0e>\xa7\x96\xad\x0d\xebC \xbe@\xca)\x1d\x0b\xc3\x84\xe7\xfb6\x1a\x96j\x99V\xb5\xb6\x0ev\xa6\xbchW\xe4W\xbd\"\x97W\xf0\xd7\xda\x16M\xedr\x19\x9b\x93z\xaf\xaf\x84\xa7\xf5\xf4n\xbd%/\xa1\x96\xe6\xf8\xf7W\xe4Z\x7f\x83?\x81]@V\x99G\xf2\xa6$\xb38\x92$\xb1-\x83\x9f\xb6\xe1\xa9\xbf\x89X\xbc+\x95\xc0\xcf2z$;L\xda\xd7(\x10Q\xdb\xef\x9a\xc7\xf7\xfd10<\xb8\x80\xd4\\\x0f\xd5S\xad\x90\xe1\xcb\x19\xf3\xd6\xad\xf2\xc3\xd9*On\x95\x7f\x9e\xadr\xe7VY\x96\xe7\xaa<vZ\xb9\xea\xd8\xc8\xdf\xbb\xef\x1ck\xde\x9b\xce\x82\xb2H2K\xac3{\xae\x1e\xad\xd8\xb7\xc8\x92\x1f\xd4\xf6;P\x90%\xe4\x03\xf7&tB\xae\x1e\xdb\x17y\xfb\xc2(4\x1d\xed\xc2u\xa7\xbf\x96\xcd@\"\x8f\xfaL\"\x0f\xe6\xd7\x8aY>\xd7\xa1#\"\xd2wC*\x89[F\xcaj\xea\xb3\xcdh\x19\x08\x94K\xe4\xc5m9F\x8a\\(\x11\xdft\x0e=!\xef\xad\xcb\x0c_9w\xd1\xee R\x1bD\x0fO9T\xecp\xbb!\x7f\x9b99\xb7\xb5\xc1i:\xe8\xcd\x88\x07O\xac\xd6\x15\x86\xcc\xe1\x93.\xd35\xee\x15\x8e\x97\x9c\xc9\x14\xa9n\xd2c\x1fI\xc4A\xf7\xf5K\x89\xb8\x9a\xd9\xac\xfd\x9c$\x88\x88lT\x0e\xd3\xea\x1d\xa5\xed\xc0\x1b\xe8\xbeA\x05\xf8\x15x\x8d\xcb\xca/	m\xe0T\x14\xf0@\xc19/\x87\x89\xf6C\xbf-\x8a\xacN;\x88\xf7\xefW\xe74\xafl\xb39Sg\x06\x89\x93\xe7\xeb\x97\xd2\xc4\xb4(d9,\xea\x15y\x1d\x81\xe5eY$\x9c\xfdL\x12\xf3|V\xcceI\x06\xc8@\xd5\x01\x8b\xf8),\xc1\xee0\xc9\xff\xc50\xd0\xaa\x84O\x0dBi\x1b	b\x0e\x13\x17Ej\xb6\xcbt]}\xc8iId)\xccV\x92\x85\xf6e\xba~_\xd4\xb4d\xb2bIk:\xcc\xe0\x927\xc9\xda\x88uX1\xd8\xfc\x10\xe7\xc6B2\x88Yk\xbdh\x86@!\xe3\x19\x0de\xd3\x08\x01\x1bu\xfd\xd0H\xd1\xf7{$\xb9\xb6\xcd\x88\xd4,\xbeJ~D\x97\xb2p\x8bxG@\x83\xb7\x90y[}-\xa2\xeb\xd4:\x8c\x84\xd6\xdd\xa3\x9e\xea\x9f\xa1\xb7@\x88{'\xd7\xc8\xa5\xd3\xceE\xc7\xd7\x04\x8e\x1e\xf6{\x07g\xbdv\xbe\x00\xd5SF\x8fd\xa1\xfa\xea\x7f\xfe\xedo\x7f\xfd\xdb4\xbd\x0d\xf3\xdb\xb6\xf6\xdb\x0e5\x17\x170Po\x10\xee\xcc\xac\x15\xcc\xae^\x97e\xb4\x9d\xd6v\x9d@\xde\xa9\x0e\x8a\xfb\x91\x91l\xe2\x17\xb7fgA\x97\x01\x87oT\xa2\xed;\xb5\xf1\xe4\x7f\x1c,\xf8\xa4\x19y\xda\xb2H\xfc\xe8\xb6m\x19\xc9x\x90O+\xf5\xe1\x88\xc8\xad\xc4Q\x167Yd\xeeE\x19\xf9\xea\xa4\xd0\x99\xd2\xe7\xce\xca\xdc\xa5\xf9\x83*k\x95p\\\x8f\xebh\xd35`sM\x1bO|\x89\xf2y\xc7\x19\x08>\xb4\xc1.\xa5X\xb0\x90N}\x93\xde\xfa\x85\xe3x\xcb`\x18LNc\xf8\x91\x82\x1bq\xfb\xe0[79F\xc2\xdaY\x84\xeb\x10:#\x0d\xf8\\F\x1dJ\xf6c\xd5\xd1^\xb7\xd42\x9fW\x9aB\xbcj\xf2\xcfp\x1a\x13Q'5\xe4\xf3\x02\x19c\xd2\xff\x81\xcc\x87n\xb4/8\x9e\x93d\x88\x19E\xe4[\x8aH\x01\x95\x05\xbb\\f\xc0\xfb\xd6\xea\xe8h}\xaa\xee\xfa\xd0\xf9<Y\x0e\xb0+\xd2-\xa7\xad5\xfal\x87\xb6y\xdf9\x01m\xf9\x87N\xf9K\xa3tk\xc8j\x03v\xb2FqHq\x84\xe0:;p\xe8\xcew\xd5\x91:#M\x10\x10\x06\x88\x8d\x9bd\xc3T\"fh\x89\xa9\x12\"\xe9%OBY\x0f-]\xa1\xbe\xed\xabN8\xa0O\xee\xe8\xb6\xec\xec\x01\xf5A'^\xf6\x97\xa3Q\xb4\xeb/r\x02\xa5\xf2\xd6_\x98\x84\xec\x1d\x8f\x0d\x92\x95\xb7\xee\x8b\xc1\xe4\xc7\x8aL\x84-\x10\xc1\x8c\xdfl\x1e\xee\xcf\xc8\xd9L\xf6\xc4M\xcf\xa8\x19Sl,\xdc\xc7{=?\xf3\x95\xa8\x90\xa4mJ\x1e\xe0\xf2M\xa5\x1d\xcbENx\xe8\x1dh\xa3\x1a/~\x81f0\x1f\xde)[\x82\xb5	B\xfa\x8a>9\xb3\xafo\xaa\xaeX\x1d\xd3%\xd4d\xb3\xb4\x05G\xf1&\xc9&\x1fQ\xc7%\xf0\x1c\x98#\x8b\xd6\xda\x8d\xc5XX\xed2\x1b?\x1f	d\xcds\xc8\xaf\xef\xaa\xf3&\x07\"5qe\xff~\xc5FN\xd3\xbas\x83\xca4$\x17\xa4\xa2\x04\x10\x80\x16\xebR\x022\x85\x04\x1a\"#\xa8\xeb\x83C\xd7S\x94\x03O\x9d\xf7\x14%hb\xc3\x060\x11\x9d\x10>vG+I\xde8k\x04\xd1\xed\xd4\x10\x99\xfc\xe4\xe7|\xc1^\xaa\x054[ \x96V\xf4o\x8c\x1b}!\x7f\x85\xac\xa9\x16\x95\xa06\xe8\x0eA\n\x9f\xd1d\xf3r1\xd9\x18=\xf3RV\xb3\xcd\xdcDR\xc5\xef`\x8a\x7f9\x9e*~\x1e\x02\xb1\x96K\"\x1b\xc4\x83\x8cQ}g\xd7)\xdc\x1d\x0eLj\xacM\xe8\xc6\x86\xa3\\\xf6z\xfe\x03}&\x1f\\\xa7\"\xb9\x16\x0dG\xbf\xdc\xb0\xdf\xe3\x85\\\xb2{\xbc\xff@?\xf8\xb9}\xeb|O\xcd\xba\xedu\xde\"3\xcf\x19:\xa6&{\xa9v\x8d5\x1a\xc8x\xff@D\x1dE\xf2\xc9\x83\x1d\xe2\x12B\x92\xc2\x13\xaa\xed`\x81p^R\xe2\x15\xdcR\xb5(\xfc\x18zB@YKXm\xe5\xe8\xb8MCO!\xae\x8f\x8e\x81\x8c.\x82\x1c4?(0\x98\x06\xefr\xe32f\xb0\xad\x8bZ;Ot\xe8\x84_K\xf8\x96\x05\x9d7\x0d\x1d\x7f\x86\x1d\xc2\xf4v\xc7\x04\xbb\xa09\xa0}\x0c\xd8\xf6K\xf7;\xea\x8d\x83_\xdf\xa4~\xc6\xd6Q\xe4ct\xb12'\xd8\xc0\xeeB\x8e&\x8b\x97\x08]\xd1Re\xe3`\xb2\x00\xdc\xa1\xced\xfb2\xe6\x17F\xf9\x1d\xcf\xb6s;\x90I\xb0\xed\xf7;\x95V\x9a\x83\xd8\xf6\xfb\xc1!q\xf5\xa1\xd6\x8e+\x93\xb1\xf1J\xf0K?\x03\xc6\xcc\x05\x0b\xc5\xa4\xd4\xd1!{=\x8e3\xdb\xc8\x08\xf1\x89.\x1eM.\x18\x0d\xf0\xcd\x01\xda\x05\xfc\x94\x99\x0bV\xb2\xa1B\x91\x19\xc2\xd1\x90\x8d\xcdQ\xc1\x01n.~\xe6\xacw\xd5\xc2\xc4\x93\x841f\xae\xca\xa8VD(\x8b\xfb\xe3\x12:\xa8\xe2\x86\xf5\xd3\xf7\x93{\xf94\xbd\xdf\xef\xc7a\x9b\xfc\xf8Z>\xed\xf7\x1eE6\xf5\xc4\x95\x1cM\xae^.&W6\xb4\x82\x8fCz5\xa7\x7fL\xd6A\xbd\xa8tr\xcc\n\xcbo+\xffZ\xac\xc4M \xdaB\x8d\xd8F\xc2\xbfx\xda\xef\xef_\xca\x11\x0e\xc0C\x87\xdd\x81\xe0V\xdc\x0f\x06f]\x1fx]\xcdY\xff\xb6r:\x11\x0d\x1f\x1a\xd4\xc3\x89\xee\xf5\x9e\x90=\xe4J\xe8\xdaA{\xe2-ql\x91|\xdcb\xf1_-\xf3jH\x1c\x1b\xb1\xb5\x1e\x9e\x8d=\x8d4\xbbFG+\xcc\xc5'r\xc4I5\xedw\x08@\x87Xd\xe0\x87\x97\x9e\xeftT\xd8\xf6\x82\xa0\x1b\x8b\xeb[K\x90\xc1\xdf\xce\x84\x85\xe4\xd8\xd1N\xac\x7f\x122\xba\xef\xfay0	\xf2~\x7fR\xf7en\xaeL\x0e#\x8dK\xc5%\x1d\xbe\xafN\xc2\xca!\x92:\xc5\xec\xd0\xdb|o\xc3I\xa4&\xfc\xc2[\xdel\x0eO\xd1\xc8\x0b\xff\xa2\xde\xef/X\xf0L\xb5\x10\n']\xf8\xdd\xd5\x10\xa9\xc5\x7ft\x01\xd7|> \x8dj(`\xa5\x0b\x13\x08\x85\xbb\xdf\xe7\xd40o#\x10\x14G\x8eC\x08\xf6\x0e\xebC\x90\xe0\xf2?\\\x00V\x10\xb2F\x0b>\x1cg\x8e\x96\x9b\xfb\x08\x82]\x04Q\xec\xff3R1\x0d\xbf\xa4\x10\x1c\x9e(;\xa5*\x81\xa6\xc1\x13\x10\x85\xe7l\x82\x7f\xd5\xe5\x01\x00\xc8l\xe5Q\xd8\x1eE,\x0b\x1a\x8d\x00\x06\xeb\xae	\xc4\xba\xa6\x9e\xa4\x147\x0bR\x93(\x8d\x99v\x00\xff0\x12\xa6J\xb8\xb2?i_\xc2\x85@\xc3a,\xdc\xa5\x0b/F\"\xad\xde\xb6\xcc\x8d)=\x04b\xd1\xefw\x1b/m\x8ba\xf9\x7f\xd88C\xdb\x8eW\xcb\xd2\x9a!i\xc2\xd0\x9b\xe0WN\x08\x8f\x90\x04\xd3\xf4\xf28\x88IX\x89s\xa1O\xc2R\x9c\x8b\xe7\x11F\x8e\x18\xe8\x0f\x0b\xd9\xe6\x04\xf4z\xcadOqV\x06d\xb0\xef\x1d\x0f\xd8\x0bZE\xc84\xfdW\x9f9\x93\xf1\x82\xf0|\xd5\xe3\xa9y\xaeG\xee\xcfv\xb0|\xee\xc4\x7f\x16!d\xc2\xa7W\xaa\xaf\xe6\xb4\x89\xe4[\x8a\x8a\x02c\x85R\x96\x88D\x85\xb8q~.\x17\x7f\x16\x0b\x9c\xe5h|LE\n\xf7\x83N\x1c\xef#o`'5\xcdD\x07-\x95\xb7\x15\x04:f\x17\x0e\xc0\xddt\x86\xa3^\xcf\x8f:\xe2\xb5\n\x9b1=SFr\x98\x96\xad1\x04\x17\"\x91zO\x9e\xf0\xb6\x1e\x0b\xac\xb4\xebes\x0bS\\\x03\xca\xdcN\x98\x8b\xce-\x19\xa6\xdd;\x12>\x1b\xc8e\xd0\x9c!\xaeR\x07} \xa1\xf5\xd9\x98\xe6i\x800]\x1c\x9c\x1a\x01\x05*	C\xad\x0e	\x85{\xb9\xfe\xd0\x16\xf8|\x93]\xb8D\x91\x8d\xff\x0d\xee\x89=\xc92\x13\xbd\x08\xc1q\x1ab\x8eO\xaaK\x8bz\xbe\x07\x99\xde\x80jg\xf6\xaf\x81yh<\xacN\x01se\xf3\xd0\xd1\x1dR\xf5z\xbf\x81\xa5\x9a\xba\x8b\xd0\x8d\xa5\"!\xa5M\xc9i\xda\x90)\x97\xe9\xfa\x17P\x0eZfl?9P8`{\xf3\x0eWQ\x05\xc7d\x1d\x88\x14vT1\x99\xd4\x00\x9aA\x1c7Y&6\x81pnh\x1a\n\xee\xbfn\x88q'U\x85\x92\xff\xa88\x0e9q3\xa63\xbe\x11{\xbd\x8b\xad\x7f\x95\x827rp\xc3?\xaa\xafG\x981\xfc\x061\xf1\x81\xea\xf7M\x8b\xdd\x84]\xbfS\x13l\xa3\xac\xea:\xcd\x97\x9d8\x1e\x9a\x89\x95-\xf3\xc8\xb2u\x93U\xddD\xe1\xc1\xfc\xd9l\x0b?\xd9\xa9\x18\xaf\x84\xf5\xfdu\x10\xc5?\xdbn\x19\x04S\x05B\xbc}\xc0*X9\xbe2\x01e@\xfe\xb7O\xfc>QI\x83\xcc\xeb\x84\x07A\x9f\xbb\xcf\\g\x1d99\x07\x7f\xe8\x08K\xe8%\x04\x12\xf4\x83\"\n\xb7\xc3p\xb1\xdb_\xdc\xcf\x90Y\xc3\x10\xe7\xb5\x8e\xa4?\xe5\xbf!\xd2\x90\xb7\x9f\xfd\x9d\x91b+D\xd3\x02\x88\xd9x>@\x8428\x05\xdb,\xf5\xf2\x97\xc2\x8f\x9e\x13\xd3\xcc\x9cc\xda\xeb\x95/!W\xe9Ts\xd5\xd9\xe5\xab\xfc\xf85\xeb\x9a*\xe7\x93\x8f\xa5\x8aS`'\xf9S\xe5\x97\x16\xc0T\xe6\x17\xc3<\x8d\xd5m\x1a\x7f~\xc7\xbb\xbd\xb8\xf5\x89\xb2\x8bU\x9aQ\x02\x95\xe7e\xf0\x8cr\xf9\x987Z`\x869\x98Ws\xb0\x14\x8eJ\xe5'w\xb5>#\xa8F\xffE\xfb\xb6\xce\xccM\x01xl\xe9H\xfd#E<N5\x17\x08\x02\x1b\x88\x14\xe6\xa7\xed\xa7\x8a>\x0dvN\xd0|T \xd9*4\nx\x10\xee\xcb1\xbf\x1c\xe3\xe5\x182'\xb40\x12\x8a\x7f\x8d\xe9\xd7l4\x7f\x85\xf9\x98v\xf0\xdb\xe95\xcd\x8ewqF\x02@\x9b\xd7\xe7\x9bb\x82\xae_b\xb4\xa0\x12\x882AI+\xb3\x88(\xed\xc8$zI\xd1m!\xd0\xb7J=?\x92\x8b[?\xea\xd7\"\x0f\x02\xa8$f&\xec\xe0`<\x0f&\x08fo\n^\x8d\xd5\x7fq\x9fm\xe4\x13\x84r{e\xabL;\x9f\x87\xe8-p\xc64\x9ewv*\xcf\x9c\x9d\xea\x12\xbf\xfb\xfd\xfa\xd6F\xcc\"Z\xaa]\x91\xa2\xfbY\x92\xae\xfb\xf50\xed\xd6\x89\xb2\x96w\x98\xcd\xc9\xd4\x1e\xd7.\x16\xae\x93\x0b.\xe5\xa0\x8ff\xa5\xf8\n~\x13\xe5\xc9\xaf\x089L\xca\xae\xd1\xa4x\xc9\xb1\x82(\xa0'S\x7f\xa5\xbf\xbb7\xf5\xe0\x01\xfb\x94V?\xa8m\xe8\xe1\xc7\xc8\xd3\x14\xd6U\x12\xaeo\xfb\x05Y\xfb:\xdb\x91Q\xbc\x89\nC;m\x9eUa\x11\x8dx4\xc3\xf7\xf3I6\xd4\xd1\xc7\xd8+\xc3<\xf63\xed\xe7\xf2\xfc\x85Q\xe6e\x96TpCr\x94\x0c\xbbh\xda\x0d\\\xe3Z\x89\xb3s\xab+p	v1\x16\xb2\xd7\xbbH\xf4_\xd6-\xd6Ga\xd7\xab\xcer\x1b\xd8\xe8\nZZ\xf9\xea\x92\xe3\xcahc\x9b\xe3\xa8\xe2x\x8d\x08\xde \x0dh\xf9\xe9\xce\xd7\x18\x02\x86_\xb2\xb3\x819m\xe04?\xda5\x9d\x8e8\xba\xaf\xfc\x02\xe8\x0e*\xbf\xe09\xdc\xf6)\xac\x88\xc9\xdaL\xc4\xd6}T\xd2\x16z\xb0\xb4\x17U\xf7\xcdu\xf4\xe4\xbc\xcc\x8cm\xd0}T~\x17m\xc8x\xbd-1i^\xe8\xcd\xc4\xb0\x08-\x88D\xc2t\x15\x96\xc2i;\xac\xf0\xf4]\xb4	3\xd1m&l,T\x15\x19,I\x0cL\xd1\xc9\x81\x00C\x10$\xb5\x1b\x919\x1b\xb1;\x1c\x8b\xba\x0c\x9b\xca\xa7\xe1\x07\xb5\x85\xd7\xcc\xd0\x0e\x111G\xc8\xf4\xc1\x19u!J\x05{\x1e\xc5\x80^\x88\xa8\xa9\x0b\xfaMR\x91pd\x138b\n\xde\x8b\xd1\xff\xf0\xc4\x12\xbf\xfe\x8a_4b\x12H\xc2TVs\xc0\xd5\x04\xbd\xc8\x88\xaf\nX\x85\xd1Q\x99 2\xe9~\x1f\x0d\xbb=\xf4\xfb\x02/$\xfe\xd9\xef9\x14\xf8H\xacyk\xc2Q\x1bM\xdd,0\xf4\x97\x17\xa8\xafO\x07n)\xfb 3\x911\xdeOs?\x1av\xa6\x87D\x9eGE\x03\x99\x19\xa5g=t\xb6\x0d\x1a\x15\x8a\xa5:4#\x91\x8d\xa1\x1f\xa9\xe2w\xd1\x86\xafR\x0c \x1a.\xa3\x8ddj1\xd65\x1c\x88\xe1\x9a\x88\x0f\x12\x192\x00\x0b*c#\xa6\xfaj~\x04\xd2\xa0\x90\xda\x99\xc3@\xe4fI\xab\x93\xed%\xe0v\x9a\x17E{\x18\xa2\x0dT\xe5\xa0\x86:\xf3'\xa8\xef\xee\x88h\xa4_\x0d\xa2\xe0\xb9\x9f\xf5\xfdl0\x0e\x9e\x95\xc1\xa4i/\xd3\x06\x16K\x89\x9f\x7f\x85\x130\xebE\xf1?^b\x19\xd3vC\xe0\xef/j\xbbo\x9d7\\\x0c\x1f\xff\x81\xb4\x8f2\x15\xd9`\x80\xc3pfX\xe2hX\x13N\xadOr\x84\xe3\x01r\x83D\x93\xd1\x00d\x13 \xc9\x95\x88\xfb\x92\xdf=\xf3\xc7\xfd\x12}aO\xe3\x81\\qqi\x1c\xb8\x07\xf1\xf3\x17\xc7M\xc3\xb2\x0c\xc9\x88\xa1=\xd5\x7f\xf7\xfb\x9dF\xe0\xe1\x82\x1d\x16\xb9\xfd\x83X\x9c\xf4\xd5\xc5\xb5\x8d\xa5`@\xd7\xf7zF~\x8d\xc46\xb8V,\xfa\xb5f\x87\x96\x9e\xf3s\x99\xcf\xf2\xccO\x83y7\xe7\xd7\xaas=\xf0e\x81X\x02\xa8+\n\x00\x16\xe2h\x99\xfa-\x8eq\x91\xb9e\xf2\x1cd.\"\x99\x1e!\xf4R\x12\xf6\x12\x95\xccgE\xe6G\xc1\x1cA\xf53Y\xf1\x95&\x1aY\x19\xe7M\xfd\xf1\x07pjt\x1dD`\xc9Z\x8c{\x9d\xe6\xdfS\xfei\x10\x0c\xa3I\x01s\x17\xfcC4<I\xect\xc0	\xdf,v&\xe0\x9f\x08y\xb2\xbd\x8c\x13y\x14\xba\x0d\x01G\xd6\xc8YvTN\x0c4\x945\x7f\x809L\xc4\"\x10K\xb9\x1a\xa6\xd5\xf7E\x99~)\x10[\xc9\x87Rf\x91\xf9\x91X\x81\x11{\x90#\xb1\x95\xca\xdc:\x93\x87\x97\xdb\xc9\x83\xd1\xf1<\xb1\xac!\x11\x0f\x81\xb8\xe3\x87\x85x \x81\xc0\x85\x8e\x1e\xfb\xc4\xf1\xda\x1f\xe5\x13\xf4\x7f\x1e<\xccrO\xdc\xcb\xf5d\x03*nV\xcegws\xc8\xe3\xf4/\xb9\xdb\x84k\x91\x87\xebC \xee%\x17\xce\x1e\x99\xb7\xbf\x11\xd7\xe2J\xdc\xa2\xfdep#\xef\xc5\xb5\xf4/\xd9E\xfc\xb6\xa0X\xdb\xfe\xecI\xdc\xcd\x83`6\x9e\xf73q%/g\xa3\xf9`-ne#\xec\x85z\x15\xbc\x04\x96\xba\x92\xfe\xd5\xcb\xd1t0\x0e\xc7\xc1\xb38\x10\xce\x80f\x8f\xf3\xbe\xbcr\x12\x92\x9d\xf4r'\x9e\xe6\xc1\xe4\x86\xda\xefg\xe2Z\xde\x8b+\xd9\x88[y\x89\xdbz\xddvv\xab;\xbb\x95\xfe\xedK\xf9'\xbd\xdd\x06\x07\xdao\xb0\xc7\xbc\xe7\x0fb\xf7\x14\xde\x88mx\xcd'\xec\xca8\x03\xdf\"\x8a\n\xfbI\xb5Wg\xdc%+\x8f\xc9\x92^\xcf\x8b\xa3\xb2VU\x1a\xe5/\x12\x90\x1e\xa7\xd0N\xb4H\xdbb\xd2m\xf1(\x98W\xafwR4\xcc\x10\xa4\xa4m`a\x8f:7\x92\x01O\xab\xfa\xf5\x93\xaa>\xe4\x7f\xa8\xb2\xf8\xb0\xf0\x11\x10\xed\x95\x1c\xed\xf7)\xb2\xdc\x14\xdfe\xc5}\x94\x91D\xc6W\xbc\xb7\xfai\x14\x04!\x81\x99\xaeb(\xaa\x99\xab\x98\xdd0\"\xe0\xfe^C\x10tyK\xec\xbf\x1d\xd5\xd2E\x16\xb0<$\xb5\x16\xa6N\x11\x95\x97\xaa\xbeNs\x1f\x07\\?DO\x14aCc!x\xb8\xb0\xa4\x04\x98\xd5%\xed&\xae8:\x9a\xc2\xf0\x0cv\xc5\x96%\xf6MN\xb0\xd0\xdf\xfa\x1c?\xd1\xf7\x100+\x89\xe82\xf3 \xd0\xf2s9\x83B\x81\xb4\n\xf3\x00)\x03\x8bLE\x8ei)\xac\xa5 \x80\x96\xb3\x91@\x0d<\xe3\n\xc4_\xdc\x80\x14\x9a\x98\x9e)\xcb\xb7(\xe4\nP\xb9\xa2\xcc\x8d\x162\xf1h\xd5(\x10\x04\x96\xad\xb2D\x8f\x7f:\n\x11.\x16\x15\x07h\xfbYa\xaak\xe1\xd6q\xf5t0\xe6\x0f\xc6\xf3>:\xc7\x07\x94Z\xeeZg\x11)\xa7\xa5Dk\xe1\x19{Y=\x82\xd2\xdf\xad\xd3\x9c\xba\x04}\x14b\xe0\x10\x18\xeaVt\xf2\x91jZ\xd1\x84\xce\xb5\xa2\x07V}\xa5\x15\x16\xcd\x95n\xde\xb8\x92\x0cMKX\xce\xd8\n\x95[\xa1\xa2\n\x95\xae\x00\x8e\xae~\x93E\xf9g\xff\xca/\x83\xfd\xfe\x8al\xfb:+Az\x8cc)\xdf\x19C\x1eA\xd0\xf5^\xa9\xe4mYT\x15N\x83\x8f\xae\xcaW\xa3^\xaf\xc2?\x17\xc8\xf8\x8b\xfc\xb3\xa2|\x892\xfcs\x01r\xa3\x92#\x96\xf9\"\xa0\x0b\x87\xe7\xe0\x8cpV\xff\xc1*\xc9\x85\xd8\xe0B\xc4u\xe3\x89\x98\x952\x9b\xceU\xb8\xd8\xcb\xba\xcb\xb5\xc05\x8c\xd87\xe8\x0b\xacN?\xf37\xb8 \xd6\x19\x82\xde	%\x96\xc1\xa4\x94k\xd06\xa2\xc2\xdf\xe8\xc9X\xde\xccJQ9\xa7r\x9d\x1dKrRj\xfd\x881\xb2\xac\x8e(e\x93\xf99\xa7J\xa71\xeb\xe8\xba\x00\"f\xe1i\x8bk\x82\x12e\"\xe5\x8c\x9f\x83*\xea\xa6\x02\xaa\x98~\x82h\xc4\xdc\xd0\x95\xa1I398\xf7\x8d\xa1\xafI\x11\xc8\x8c*\xd34\"\x039\xc3\x8d\x02\xc3W\x81!\xc7\xf1dI\xed\xaa\x9f\x89\x95T\x88\xc8'W\xcf\xfd\xf1\xc0\xaf\xfaY\xf0<\n\x06+C\xdf\xa8\xbe\x8c\x9f\xf9\xd9\xf3&\x10\xf5\x00?+\xfc\xec\xcc\xab]\xc2\x07\x17iIFa0\xf7h5\x7f\x1ak\x15\x9d\"\xc2]\x91\xc15\x14\xb6\xff\xbd\x0e!\x1dL\xbc\xacX\xb6\x9a3\x88o\x86\xc8}d*g\xc5\x12l\xac\xc7\x90V2\x8e\x9c\xd0\x19`\xe4\x9bb\xb3HJ\x02\x03\x804V\\\xbesz\n#\xb1H\x9f\xae\xd3<\xcc\xe9G\xf4\x14\x16b\x9d\xe6W,s\x0b=#\xc3\xc3`JG\x83WNy(Nm/ \x992\xd6\xe7?i z:j\x80\x01\x80q\xbc\xdf\x8e\xc1\x88\x0f+\xf6\xed1\xbd\x1c=\xfa\xee\xd5\xbb\xe5\xcdAF\x1f\x04zd\n\x0f\xcb\xe5\x05A\xf5\x98\"\xce\x8a\nv1\xd6\x931\xc1\xd6\x0b\x19\x0e \xa2On\xfd\xfaH50=.\xf0\xadj\xa9\xbdQD+`\x0e&\xd4<IT;m/o',\xa6\xe7R\xff\xf7\xaau{\x85I\xd1\xf26`m\xa6\xdf\xf1+~r\xb8\xf1zX\xc5Q\xa6:G\x16\xd6\x8a\xa3\xb9\xc8I<\xc8`}\xe1\xa7\xc0]9\xc2\xe9\xa7\xc0X\xf9K7\x89\xe8]\xa7\xc9%(\xdc{\x95\xc1\x1b+\xd3\xe1\xc3}\x0f\xe1\xe8t\xf6\\\x98nu\xa4%\x1a\x04\xa7g\xc6bR\x16\xf3(N\x03\xbbLU'\x18%\xaf\x8f-j\x03\x15\xc1\xd3p\x93E\xb1\xf2\xbd\x1d-\xe6\xc1c\x91\xb1\x9a\xaa\xd0\xf3\x82\xc3\x01\x89\x0e\xcf\xdc>\xaaU\x9a\x90?\x83\xd9\x03\xcb\xbe\x142\x1f\xa4pmy\x04\xc0\xc0A08t\xbc\x98\xf8\x0bg~d[\x0c\x83\xeev	\x1f\x19\xdax\xa6\x7f\xbe>\xe6\xfb\xd0!\xcb\xee;{\xd0\x06\xf3\xa0\x8f\x80l\xf9L\xd8\x0c'\xc3jU<B%z\x91\x0e\xd3J\xdf\x7f&\x15\x13\xd2\xb4\x9e\xdb$\xd8\x83\xba\xc02)@\x13\x19^\"\xf4YR	\xe9y\xe5\x07\x8642J\xbcS\xd8\x10\x8d$\xd8\x11+9\x9e\x14\xaf\xfek\xd4\xeb\xf9+\xd9J\xdb\x8b\xe7\xff5\nZ\xb6\x08\x0cr\xfc\xb2\x98\xc4}\xc9\xc1\x1c\x13\xd9\xf8\xf94\x9f\xc5\xf3\xb0\x9c\x8d\xe6\xfd\x18<\xd2M\xa6m\x8en\xd5S\xfd	\x81\xf7\x13X\xea\x10\x18\x96EM\xb1\x1e\xc8\xb8\xa3\x9aV\xc3&\xc7.-\x82\xb0\x92\x8bs2\xca\x1b\xde\x17t\x96J\xf5\x8c\xc6\xf6\xf1\xea\xf9\xf8\x7f\x8d\x04\xc7o7\x18\x9a\xef\x92H\x9a\xb8>\xd0e\xe8\x0f\xe2\xa2\xf2\xd3\xa0\x1f\xe9\xa7*E<h\x04\xa5p\x9f\xcd[]\x97A\x9e\xf0\xc8_j?\x1f>\x89|\xb8\x15P;\xb4{~\xcd\x83\x83\x009]GKE.\x7f\xd0#\x01x\xcd\xa0\xa9\xa0\x827\xa3	+Z\xad\xa2\x8d\x82^\x93\xd2X\xe1\x0c\xe6\xc3j\xbb\xbe/(\x18'\xd2Q\xd6e\xf1Y\xc9Z\xeb\x03\xef\xee\xd2\xea\xddzSo\xdf\x94M\xb5\x9a\xfa\xed{\x91\x0e\x17i\x96\x01=z\xff\xd7b\xb1\xf0\x82\xd0\xd7E\x90\x92\xd0\x0fY\xc3\xac^7\xd8\xeb9\xdf\x9a\x14\x18:v\xda\xc5\xd8=\x0dW\x96\xb6\x00$\x8a\xd2\x98\xa0\x82V`\xb1\xfa\x87\x85\xef)\x0c\xca\x0b&\xa0\x10\xa1\x0d\xab\x9a\xfb\xaa.\xfd\xbf\x89\xf1\x91\x8bU\xdf\xbe\xfb\x9f|\xf3Y\x05fv\xdc(-d\xf8\xfc\xb9\x17L\xbf\x80\xbc\xd0j\xe9\xff\x15\x08\xde\n\xe3\x8a,\xca\xa9\x17\x93\xe0\xdb\x0b\xbd\x98lH\x82\xf0\xa8\xadMT\xaftS\x9f\xda\xa6\xfe;\x10\xbb\xc3\xbf\xd5\x1a\xea\xfcz\xeb\xefh\xbf\xc2]\xbbEa-\x9eB%\xb6a\xcaldn\xd8\xc8\xe2@fi\xdd-\x93\x15\xe2p\xc0\xdd2+Jy\x9d9O~\x14\x88\xac]\xf8[\x86(^\x1cK\x10\xd5\x03\x15\xbc\xfc\xc3q'\xb8\xe4\x1db\x84A\x89\xee\xa0)\x02\xa6\xb9(\x98\x9c\xe3\xb4\xc0X\xefH\x8e\xbf\xe2=\x04\x8f\xa1I\xde\x97\xeb\x9c\x9c`\x04\x08F\x81\xc3,J\xfc\x82ZL\x14\xb2<\xb0\\\x16}\xf0\xe8n3\xfdE\x05\xae\xa7\xd7\xa3\xc71\xc2\xf6\x8c\x91\x9e\xd8?n\x13\xd5\xe8%\xb5\x19\x08\xf8Q:F\xc7\xaf\xed\x8c \xc2\xc2\x99\x81}\x84q\xb2Z\xaab\xadj(A%\xbeN\xe5\xcc\x92I\xf3V\xa6\xc0\xe7\x0bd78\xc04_\x12\xfe	&\xa9\x9c\xe5\xc3\xa7>\xe3\x88\xe7/p\x98\xfb\x06O<\x7f1?P'\x1a\x9c\xa4c+\xfe6c]\xf9E=\xfc\xf9\xf6\xdb\xff\xf5.\x8f\x0b4\xcb\x0b\xfcMm#\xfe\xe1\xed\x0d\xe1z\\M\x12q\xde}%\xc7\xa3\x17\xff\xd5\x8a\x95p\x1f,TT7\xa5\xaa8\xaf\x935\xf4\xce\xfb}k\x92Y <\xd2\xdc\xccy\x0b*\xde\x11+T\xc0klR\xfc\x81\xe8\xe8\x8a\x93z\x19\x03\xceI\xe5h\x8c\xc8yj\xe1{\x1f\x8bl\xbb$\xbe\x8e\xed\xa6\x02\xbc\x92?f>2\x17Vs\x183\x98\xdc\xef\xdeu\x93\xd5\xe9\xe9\x17f\x80\x8d\x1cM\x9a6\xf9Wc\xe4W+\x99\xcd\x9a\xf9\x04\xff\xa0\xe1\x155<k\xd0\xb6\xf5\x1f\xe8.$\x0c\x16\x1c\xd3\xb9\x1f-\x10\x98\xaer\xad\x0fS\xd8mh\"\xc6s6\x917\xa6\xe8\x93\xb2/_\x18\x04U\x0f\x11i\xe0m\x91\xa8\xd7\xb5_\x06\x83\xff\xf9_\";*\xec\x8fQ<\xa9d\xf5\xea\xd5\xf8\xff\x1d\xf8\xe3\x1eq\x1b\x99yB\xf2\x11Y\xf5%\xae\xe0\xac/#\x91k\xa5\xcd\xacz\x9e\x8a\xec9|ex&\x0e\xf0~v\xa4:g\xee\xed\xe9{\xbc\x0f\xdfe\x1d_\x9e\x8f\x9d\xc3~\xee\xb3\x0f\xbaF\xb8\xabq\xa3\x87\x0e	\xc3W\xbc\x83\xb3\xdfw\x08\x90#\"P\xa4\xf2SfS\xfaU\xe4\xd2\xfe\x07H Q\xc8/\x19\xf9\xd4\x00H\x8anb\xaaH\xb4\xae\xe6\xd2\x86J \xb2\x85\xfc\xf6i0\x96v\x99\xce\xe6\xe1\x93\x9f\x07\xd3\xef\xd1S\x1e\x84\xdf\xe2o)=\xe8	0\xa9|\xfa\x0b\xc6\x18\xe6\x81xC}\x8a\x1d9\x96Ua$\xe8\x87Q}X&\xa4t\x9dix1\xf8V\xd5\xd3\xa1u\xf9\xf7g#s\x87\x12\xb3\xf4\xd7\xc9L\xc8-\x0d\x1eTO\x10eD\x92&T\x92\x19\x04N\x08\xf0\x81\x9d\x94\xbd\x1bi\xfd\x99\xcf\xf1KY\x0d\xcfN)\x98Fr\xe1\xf3\xcb\xaeU\x18\x0f\x16i\x87\xe3\xcfd\xf9s\x08\xc2Rb\xcd\xd0\xf5\x81\xd3\xb1A\x19$\xbf\xb5#\xb2\xd0h\x96\x94\x96$\x8c\x04\xfe\xfe\xf9\x82\xbe\xeb@\xcc1h\x89T\xd3\x86\x0c\x01f\xab:\xb1HUk\xc2\xb43\x1c\x86\xf6\xdcC\xe2r\x10\xe3e\xf4\xa8\x9f\xcf\x90\xcf\xc2N4Tp\x9bjG\xf6\x89\xb7\x9a\xa7\xf6\xdb\xad_\x073\x05\xa1\xb6\xf9\x89\xedi\xeb\x7f\xe1\xfa\x06i\x9cqN\xc1\xa52K\xe7\xc3\xcfj\x0bA\x8cE\xe0({\xa0\xc5\xb6\xad\xbd\xb1H\xc8Tb\xb5\xedg\xb5\x0d\x95\xa0\xdaa\xdaU\xc0\xfc\xd2\xa5S\xe1f\x18\x7f~\xdd\xd4\x85Yz/\xf4\"\xf7Q\xe4R\xcf%=\xd6\xcdL\xf3\xd0\xbc\x01>c\xabJu\xbc\x97\x1d\x15\xefw\x9d\xee\x7f\xc5\xc6\x8a\x9ci\xfbB\xfaZ\xe6\xf3\x89\x88\xef\x01\xbbs\xea\xa7\x00\x94\xb4\xa1\xaaIfKp@zY\x87\xd1@\xb0\"\xc3f\xe0\x08\x814\x18\x80Hx96+9b\x85\xebxRiF\xc2\x11\xf8\x8c\x85\xe3\xd8V\x11S\xd1\xde\x8a\x8dDCb%\xeb\x8ed\xba\x01\xa2\xae;\xc2j2\xbe\xb34\xd1\xaa\xa5\xd7\x0b\x8a>v\xfc\x06t=\xde,\xe4\x88\xdci\x9a\x97\x12\xe3\x9e4}\x99\x19\xf9\xdbH\xac\x01+\x0b\xff\x821\xcb\x83\\+?GWH\\\x94\xd7\xc2\x10\x99\xc2\xab\x8b\x8d\x17L\x96r<\xfc\xeb\xb3\x07\xe66\xd6\xfc\xa4\xc9\xbf\xc3\xa2\x9d\xf3B,\xc5\x7fC5dK6b-\xfe; Bj+\x17\xcfc\xf1$7\xcf\x93\x89V\xebl!\xa6\xdcB\xda\x86\xe03Z\xd1\xd3\xeb\xf9OTBK{\xd7\xb64r\xd7\xd3\x8a\xe2\xb6\xe2	A\x0c\x1f	\xb0L\xcc\xf8{\xf98\xcc\xa2\xaavaQ\xdcp!\x8e:)o\xbb xol\xc9nz=\xbb\xa8\xf7\x83\xbb\xe0\xa5\x1c;%7\x83J\x97\xdc\xbf\xba\x9b\xde\xc9\xfb\xd0?jUV\xe2\xb4sy\x17\x88\xbb\xf6\xb0\xfd\xdaABG\xd7\x16\x0fk\xd7B\xaf\x96\xd6h\xd8\x9d:\xbfI\x8e\xe3\xaa\xdd\xd8\xf2\xdd\xd5\xc3M\xff\xefQ8\x12\x0e\xf0\x87\xea\x84+\x15\x8b\"G\xfc\xf0\xa5\xaa\xbf- \x03q\xb0\xd2\xb7\x16/\xd8\"B\xdc\x99F\x0f\xe9\xb4\x0e\x8f\xb1`\xe1\xd7\x0e\x0e\x8c\xec\xfc\xfc\xda\xc5~\xf5\x813\x9d\xf0\x89\xfd\xb3cxz\xb5g\xa0\x90\x1c\x8d\xb6\xaf\xc0]\xf7\xc7P[\xac\xf4\xd9\x8a\x9d\xd3\x0b\xb2{\xd5\xeb5\xaf\xc6\xbd^\xfc\xbcy\xf5\xa2\xe5\xf9K\x10\xcd\x8e\xb1\xdd\xeay\x13<k\x98cK$IQ\xab\xf6\xf2\xbcNs\xda,/\x80\xe4\xb0\xf3&z\xe27\x87I\x02Yv\xaf\xb7\xba\x904\x96^/\xf7\xcb\x8e!\xdaB\xae&\x0b>\x9a\x8b\xbel\x82\xdc_\x98\x9d\xff&\xc1\x94z\xbd\x05}=\xe6\xaf\xc7\xf3\x0e\xd3\xf4\xbd\xdd\x174\x97\xdb\xc5k\x97\xd35?\xca\xdd;\xcel\xa4\x81\xc1R\x1b\x16\x99=\x9a(\\\xb3\x81\x8d\x01\xf0\xaf\xb7\xb8<\xda\xd6N\x84\x8c?\x8ea\xbd#\"u\x8e\xa0\xc4\x0d\x82\xeb\xc2sEL?w\x90\xbcom6\x83\xe7\xea\xf9\x8b	8\xb3>\x0c P\xec\xf20\xbf\xf1\xf2\x10\xd7\xfc\xb5d#\xabi\xfdJ\x85\xf5K\xc5\x11\\\x8d\x03\x0d\x90=\xc2\x1a\xc2\xf8\xad\xd7\xbb\x80\x81'\x13<\xc6\xf0\xc9\xc0&j\x8e\x89 R\xb3\x11\xbb\x87Q\xd4N\xe8\xfc\xa0=\xd3\xb6\xe3!\x8a\xd8\x81\x0e]5D\xd3\xeb\xea\x83\xf6\xcb\xaf9t\x0cd\xf3\xfc\x053]\x80\xf3\x89\xfa\x1f/ \x1a\xf5\x9d\xf7\xfe\x8bg\xbe\xea\x8f\x03\xf2F\xa9L\xbfjV\x0eLG\xfd\xe6`\xdc58\x91\x048\x97\xd1\xfcU\x06 \x8c\xfcv\x1c\"cs\xceb\xda\x16R\xdd\xd0\x86\x91\xa4$\xe7\xc8l<\x9a\x8b\xb6\x12%5kr]\x87\xc7\x80\xef\x00\x12>\xfa\x11\x08\xee\xaa+\xfa\xc5\x94\x1f$\xde\x84J\x07?\xe5\x86\xf9\x95\xc0+j\x95\x06n\x9b\x1c\xcf;\xb4\xd3?:PRw\xed\x15<\x16Z3~\xd2i\xe8ri\xb9P\xdeC\x9b\xef\xc7\xa4\xb2\xc4\"p0_sl9\xde\xc7\x89\xcf<\xc7\xf8\xb0_F\xf3`\xc2\xc6\x9e\xa5%V\x0b'U]\x0b\xa9\xbf\xdb\x83\\>\xb6\x819YN\xd5P('6k\x87\x0c\xa0\xfd\xee\x9f\x0e\xf75\xc39x\xfe\x82\x8e\x01\x18{[\xe9\x07^\x174\xc9a\xa7\x86I\x89\xcd\xa9;\xa1\xdb\xfe\x92\xf9\xf5W\xb3\xa5\xddi\x19\xd0\xdb\xba(a\x99\xfe\xbb\x83\x85\xfen\x8f\x99\xa5[a)\xafow\x80E\xe0<\x91\x15\xf0\x85\x8f\xa0\x9fW\xcb\xbc(\x11Z\xd9\xfeF\xb6r\xfd6\xce\xd2\xcd\x0d\x84O8z\xed\x13\x02?\xd4\x90{\xa2YA\xad\x05\xc8c\x8a\xe0\xae\x17\xecV\x03\xa3\x07NK\x90\nO\x0f\xdb\x8d\x12\xf4\x93\xb3f\xecP_\xef\xf7\x8f\xc6\x1d~g\x06\x83\xcbI\xd4\xdd\xac\xd5u\xe3\xe21\xeb\xe9\xce\x18lg\xb3n\xeb{T\xeb!\xdc\\\xdc&\xd1\x9a\x8e\xc66\xf3b\xc8\xc5\xbcy (z\xdd\xd5\xd9\xcfMf\xf8\xe1W\xdb	\x84\x9ee\xf7nw\xcb<SE\x8bM\xcc\xf5\xef\x96y<\x88\xd7&,\xab\xa9\xd4-\xf5\x02MG\xd0\xdc:\xe34\xa9\xf89\x10\xdf\xd9:v.\xa6r\xdc\x94UQ:\x93\xf6=]\xd49\x1e\xaa1\xc7\xc3x&\x1di\x98\x89p\xe8\xd8FAL*\xd3\x06Y\xba\x80\n\x0b\x98+!\xfb\x1b\xfdm\x8e\xad\x992(\x02\x8e\xca*\xd0\xdc\x1e\x19	d\xfb\xbdWFI\xdaP\x94\xebl:\x0eG\"\xa1\xe8\xca\xad\x17Q\xe7F5\xc7\xa0\xdb\"\x8cT\xc5F\xaa\xaf\xfb\x8c\x1d\xb9\xa0Y?S?\xdf\xb3\xa1\x15\xe0>a\xb8\xf7\xf1$7\x81p^\x8f\xe7\x02\xa5\xfczL\xafw&\xf4\xcd\xb7EI\x86Ma\xa2y\xb9\x1b$\xb6\x08K\xfd\x80\x95\x84\xd7\x1e\xccn+\xfbd]\xe6\xc2\x8b\x8b\\WD\xa5\x86*\xe1\xd7J\xff\x8a\xea\x88a+\x16\xe7\xf2\x8b\x87\x7f2\xeb\xa3\xaa\x9d\xadO\x1b\x17\xa9k\xd5\x87\xe5\xa7\xed\x0d\xcc\xf2'J\xd4\x81-R\xd3\x94\xc2\x00\x85\x1e\xc2\x1d\xda\x82\xf1<D\xe9\xab\x91y\x9d\xcf\xc6sh\x15}<\x83\xb8r\xa8\x87\x9c\x81\xaeu\x18A<\x14\xb6%U\xf0'E\"\x11\x1c\x91\xf4?\x98\x9a\xc8\x03\xc3\xe6\x14\xd8\xa3\xc2\xb8\xad\xd0^\x98\xd8d\xf5\xb0\xbb\xa8\xa2t(\xb9r\x16\xcd\xb9g\xae\x077\xb2\x00R\xebA4\x97\x05\xc7:2\xe6e\xa5\x83\xfb\x8a\xcej\xb6M\xaaa\x92.\x90\xb2\x99\xe2\x0e\x9b\xea\x80d6q\xdf\xc5\xeb$\xf4\xfa\x9eH\x93' \xc6\x83\xc9\xabx\x14\x8b\xa9S]\xea\xea\n\xff\x8e\xf9+\x8e\xe9\xfa\xd5.\x06n\x17\xeaI\xc5\x0dX\x1cw_\xa2\xc6\xc1\xdf\xfaZ\xc1\xcd\x17\xec\xf7\xe6\xa1\xe31R\xdam4\xee\xc8p\xea\x13+\xdb\x04\x07\x84\x98V\x8d\xaf\x84\xf7\xe4\x05\xd3\xec\xdc'\xf0\x0c\\\x05\xa1\xae\xb5\xfdj\xad-\xd5j\x9a\xf3\xaeW\xa1\xbd\xaa\x9a^\x8f\x9aj\xd0\xd0W*\x7f\xad\x95v-*\xdeO\xa2U5)\xf2R\x1a\xc1\xc4E\xeb\x8e\x9f2\"S\xd3Q8\xc6\x19\x9a\x8d\x107\x12\xee\x16\xe2HA\xd2\xef\xb3\xa9\x8fV\x90\xcc\xd2\xf9 w\x8c0\xcb\xf62\x07\xfc\x82\xa7*ud\xc8\xa2\xd7+_\xc9\xd1\x85,^\xc9\x91\x19\xc2\x98\x99|X7AEU\xc2<S\x964\x04j\xdc\x10F\x17\xa3vVg\xd7\x9f\xb6\xcc\xcc'\x96@\xc0)\x04\x1c\x93\xc5\xcb\\\xc7l\xc0\xa07R\xcd\x12\x12\xfb'\xaf\x90\n0y9\n\xeeK\x15}FQ\xd4\xf8\x9b\x80\x1c\x00W\xc1.\xe9\xcbh\x02r\"\xcd\x1bu\xa0:\x87t\xe1'\x88f\x1f\xd4\xb3\xe8\xd5h\xea!~\xf3m\xe1\x85\xa4\xad\xbc-\xbc\xb9O\xb1\xdb7\x800\xab2\xc8^\x8d\x8cLE\xcd\xe2\xb9XK\x8f\x14\xd7\x0d]\x13\x0f\xd2\xdf\xcc\xd6\xf3\xc1r\xb6\x9e\x07\xcf\xb2Iv\xe9\xaf.\xc52\x10\xab\xcb\xd9z.Q\xdc\x7f\x10\xd9\xa5\x1f_\xc2H6\xa6R\xfa\xe2A\xd4\xc3{\xf5%U\xe5\xdb\xa6|P\xb7\x85\xbf\x82\x81(>\x1c\xcfQ\x11\xec-,DE;(\x9dO\xa1\x1e\xea\xf1\xba\xa3\x8de\"0e^\xeeo\x16\xedr7\xff\x7fXn,\xd1\nKtn\xdd\x93~$\xd6R\xcd\x964\x14\x1d\xe1c\xb2\xee\xf5\xa2\xc6Ga0	\xe8m_F:\x87\xc6\x83\x1c\xfe\x0d\xb6\xc8\xb3\x98>\xb8\xf0\xe95PH\xd4\xf8\xeb 0\xdbA}\xed\xa8\xac\xd7\xbb\x80\x8d\xfb\x1a\xf7\xe9\xcf~s)\xd6b\xab\xb1\xf5\x93\xb8C#\xfa\x805\xfb=\xef\xbe\xb1U\xe6r\x1c\xbc\xc9S+\xa9\xdb\xcc\x1e\xe7\x83-\xac\x91\x05\x8b\xb7l\xe9\x1a\xa5z'\x9f\xe4\xfd\x9d\xbf\x11[T\xa2_\xeb`R\xe9\xc1\x89\xe6R\x0c\xb2g\xb0\x05{\x90w\xcf\xfd\xbb>d`\xc1!\"\x00[]\xc2q\xa04\xbf\xcb@D\xf4Yl\xca\xf5\xef2\xf8\xdf\x002QQ\xb34\x82\xec\xd9\xc3\xff\x1e\xd0\xad\xba7\x11\xec\x8e`v$r9\x1bX#$\xac\xabr\x94\x83\xda\xf5\x8c\x11\x95u\x0e\x83\x13g1\x9fD\xad\xdb\x1fY\x92I\x14\x04\"\xc2\x15\xcf\x8e~\xf8#Q\x80\xe2\xd1\xfc\x95\xb1}\xed\xd6~\x05\xaa\x00&\xb1\xb66\x8f\x9f\xc4@j\x9a\x869\x89}\xd44\x0fS\x87T\x89O\x911(\x0f\xfe\xd9\x9e\xa632z#\xbf\xd18\x19\xd1,0u\x8c\x0b\x88\x95Lm1\x1az\x18\xcf\x19\xb9\xf2\xa8.F\xce\x18\x12\xe7r\xf4r\xb2\x98kM}\xebi\x1d\xd6\xd3\xe1\xdfB\x07\xd7.:\x1c\xcd\x899\xb4+\x051\x9b\xc5\xb47{I\xf9\xc1\xf3\x17\x831[m\xbd\x02_6\x1d\x87\x83\xf1\x04\xbf\xfa2\x7f\x96\x12\x9b6\xc0/\x1e\xf07\x8edg\xc3\xb8\x87\xb0\xf2E:4\x04&Oq6\x9f\x1c\xa9Cq_\xb5>\x04\xc5\xcb\x88\xe0\x805\x95y\x83\xb8dB\x89\xa2\xcd&\xe7\xe8*\x97'\x14\x1df\x8f\xd9\x80\xda\xc5	\x0e\xc0It\x0b\xb7(,\xcd\xa4\x8d#\xde\xb1\xa3\x83c\x17jm	\xac)'\xacE\x01\x8c\x04]\x1d\x1f\x9c\xb6\xee\xa0\x12l\x7f\x84\xf2\xb6\xf6\x80,q\xd3\xa0\x1a\x00g5}\xfc\x9b\xd1\xef\x15~\xb7\xe2\xa4X\x0b\xf0|B\x9e\xc4PiA=\x84\xbb/Db\xde\x82\x95\xfe\xf0\xa0\xcaE\x06e\xe64~\xfe\"\xb4\xbd\xe2\x8a\x9d\x94S?\x1b\xc8\x04\xcd\xbfx\x96 Z*\x9e\x1a\xfdD2\xb2\x85\x84y\xc8\xfd\x8d5\x0fy\n+\xb1\x0d3\xb6\x08i\x8cE\xc8\xeap\xb0\xbb\x00\xa3\x80\x856\xff\x99\x95S\x8fjz\xa1\xa7kzs\xe8)\n\x7f!L\x8b\xa7-\x11\xdd\xec\\Z\xeb\x93\xbd\xa4\x1dz\x9d/\xb3#\x9e\xf0\x131n\xbcm\x8e\xd8\x8e\x8db\x02B\x1c\xaf\xb05\x90v\x96JG57f\xaa\x85\xfb\x89\xd9fm\x86\x05\x87-\xda)\xda\x0e4\x80\x1da+\x1fZ\xa3u\xbbF\xf1S\xf8\x9a\xbc\xcd\x9e \x9d\x8e\xb7\xfc\xb4\xc5S9\xc2S\xc4\x06\xf7\xa5~\xd0\xd6\xf6\xc4\xd1\xd0\xa4B\xd2:=\xab\x84\xca\x13[0FA\x8c\xfc\xa2\x8fi\xa5B\xb8\x99\xd3\xf0\x8fV\x1e&w\xd1\x06\xe1N\xf5\xa7\xd2\xb4\xb5)\xfc\xcc.\xfaq\xc3\xbc\xe8\x8e\xa4\xe7\xc1Yt\xc61\x9b\"\x8bJ\xc7X\xd0\xd9\x97\xd09om\x1b[.l\xf1!;/\xb4\x87K\x14|kRX\xb8\x0e\xefM%\xb8K)\xe0\xee\x91A\xc4`\xec&'\xabge\x7f<\x87\xb0\x96\xc2\x07\x1a\x7f`\xb6\xa8\x9e\xcd'lI\x9b\xb2%-8\xc5\xb0A\xfc\xd9jV\xccE3\x1b\x0f\x8a9\xa4\x92\x83b.\xf8\xf3&\x98\x10\xe9\xa2\x8dc\xd7i\x92d\xca\x0b\xd1\xe2J\xfa\xd9\xac\x98\xf7\xf1m\xf0\xfc\x05E\xb8\x9bPk1\xfeY\x1d\xb5\x17\xd3\x1fY\x1d\xb5n~\xc5\xa6\x1fcjK-e\xce\xb8\x8e\xbfl\xadLf\xe5\xdc\x8a\xed\xf1\xd0\x11\xc4?u\xae]:2,+\xe3x\xda0\x0d\xf6\x08\xed\xc3\x1d\x91\x17\x16\xd6\xca\x8crO\x1d\x7f\x14\xdb\xfc\xd9\xed\x04\x15i\x05\xab\x83\xf1$z\x05Y\xe9``\xd8\x8at\x16\xcd[\xc1\xc9q\x8cO\xf2~\xab;A[\x88\x9f\xd6\x86\xfb\x1a.\xfc\x1cY\xf8\xb3\xa1\x89\xc9\x12\x18\xaa+G\x0e|\xb4\xaf\x97\xefph\xd3\xbfs\x16[\xc2\x039\xc4=\x0b\xbf@\xc6\x89\xcd9\xc9\x0d\x8b\x98\x9b#\x0f\xa2\xa6\xa3\x94e\xc6=\x08\x04\x89\xf1(\x87NV\x94\xb0\x83\x8b\xa5\x896'6\xb2\x18\x16M\xadJ\xb2z\xb3\xc1f&H\xe6mE\xe6\xafV\xb3\xd8\xca\xe8\xa1\x96j\xf1\x90\xd8\xb4\xbf5\xf0.e\xfb\xe1`\x0c=\xae\xfbu\x7f\x0c\xa6c=Xb\x0f\x1f^\x0eG#\xc3\x0fzu\x19\xe5\x95\x16\x00{\x93N|:\x8a\x1b\xa5]\x13\xa4U(,\x83\xe7\x0fp\xedd\x96\x9e\x9d\x06\xe3)\xf7\xc6\xcf\xc3\xbf\xf1\xf47\xb3\xf1|\xbf\xeftB_[M\x80\xd3\xc0\xe8\xdc\xe7\xa3\xd3\xcf9J!p\xe9\xdb\x1b\x7f$\xf4\x7f+\x08\xef\xcd\xcd\xb2\x85\x1f\xe9Rl\x91V\xef\x857\x97k\xb1=8\x04\xd1\x9dE7Lb\xb1\xe4sU<\"\xd95K\x87\x81q\x8c]\x8b\xe6v\xf3\xfd\xde$}\xd1\xb2\x16x\x99\xbd\xd9\x92\xa1[\x1b$9`\xc3\xc3\x08\x89\xea\x8b\xfd\xfe\xe2\x11\x12G\x15\xb09s\xf9\x15\xaf\xc9\xaa\xe3\xbcL\x8aN\xe4\xac!\xe5\xdb\xb1r\xae\x9a9v1s9>t_3\x0dX\x1dG9&q\x9b_\"\xd2\xc2\xc1]\x8f\xc7S\x04`\xa4h\"o\x19\x11P\xca\x03P\xd1\xc1sV*Z\x9aK3\xf49\x84W9b\x04\x99s_\xb4t\x99\x89\xe7\xebX@h\x0dk\xf1\xfcoDM\x01k\x17\xb0_\x8b`\x9e2\x1e\xfe\xed\xd9\xefd8\xad\xf7\x03!\x81}%\xca`v\xa2\xc8\x1e\x87 \xdb\x19\xa0/\xc6\x93S\xe1\xc1\xbd+\x1e\xe2\\sO\x14k\xb7\xadsCu\xb6\x97N\x0e4\xd7<\xed\x9a\xd7\x88\x1b\xd1\x08n\xbfg\x07\xc2ik\xaf\x16\xb2\x7f\x84\xf3\xed\x95\x8573\x04\x9bh\x13\xb9KI\x0cN\x0eQ\xed'\xb7\xf6\x13\x13/\xb8(\x933\xb9O+\x04\x03\x966\x1e\x0ff\xd5\xa9\x84\x82n\x15\xf8\x9c\xbd5n\x9a\xdc\x89\xaeL6\x12\xd2\xb1?\xbc\xb4\x83H\x8f}*[O\x87Vf6\x9d\xe5\xf3p6g\x0f{8\xd6#P\x0c\x8e\ng\xc6\x94\x91c\xf5\x9f\xe6jXPkPD|\xe5\x1503\xc56\xd1\xd7O\x19p\xcch)\xab\xf6r\xc9\x907\xb8\xc0\xab\xe2\x18\xe2\xb3\xa0\xd7{\xdd\xf8\xc5,\x9b\x07\xc1.\x97\xf8a\xae\x01P\xc9\xdf\xd0\xcb\n\xfa'\x1fo+WJ\xf8\xba\x035\xbd^\xeb\x07\xe1\xe4\xb2\xd1\xaeDN\xc1S\xd7\xac\xf1\xed\x9f\x9e-\x9c\xa6>\xce\xd5\xa4\xee^+L\xec\xd0-\xe8\x04\xca\xb2\x8b]\xf7\xd5!<S\x9e\x0f\xf0F\xa0\xb5\x1f\x8b\xf8\xdfml\xf0g\x8d\xb5\x0b\xf2c\xf7\x0c,\xfc\xcb\xcb\x16\xef\xb8\xee-\xa0\xb0\xd5B\x95p\x90\xb1	\xca\x90\xac\x954\xbem\x83\x9f\x9d\x15>\xf5\x1d\xfeJ\x9c\xb9\xf6\xf3\x8f\x0e\x9f\xba\xb3Y\xe7\xb4I\xba\xcd\xb0c\x91\xaa\x9a\xb5\x85m\x8e\xd5\xe3$wn4\xfd\xf7\x0c\xffV\xda/`5\xf9\xa1\xf0\xd3A=$3\x99\xb4@\xc8\x11R+\x90\x84@d\xd2Q8\xf4z\x17\xd5~\xcf\x05\x17d\x05]\x99\xd1\xbc+\xfcr\xf0\xfa\xf2\xf9\x8b`\xeaG2\x9bz\xf7E]\x17kD\xe7/6\x1e\xee\x1e\xb6\xb4\nB\xaa\n|\xf8\xfa\xd2TF\x9d\xd0|\xd2\xa9\xecG\xd2\xd0\xa00T\x7f\xa9\xbf\x83\x18\x16\x9dM\xb3\xa9\x97\xa9E\xed\x85^	~\xcc\x0b\xb3\xa9\xf9\xa5_\x04bgf\x06\xf3\x0d\xf5T\xbf\xce\xd2e\x1e\x16\xf4\xfb\x17U\xd6i\x1ce\xba\xcc\x0d\x86\xf8\xe1Xf\xe0{\x9c\xfe\xc0*\xac\xbe\xa9\xbb\xe9\xc5\xf6\xfb\x0b\x13a\x86s4R> \xbc@$Er	rv\xfb\x9d\xc5F\x9d[\xbb\xebB\xe4X\xe1\x14_\xa9a\xadq\x90r\x89\xe2 PD\xc4\xd9\x9c\xf5,\x10R\xc0M\x1b\xdcV%\xd5\xcc\x08k\x06\xb4\xbd\xce\xf3\x8b\xb9h\xe8\x04\x8b\x15E\x8d\x12\xb1Lg-\x8d;\x17\x89\xfb\xfcb>\xb9 k\x82\xa9\xff\xa9\x81\xa3\xc3\xa7\x06iv\xc3/\xa0\x0e A\xf7\xf5\x9b\x92\xde\xac\x82 \xec\xd4\x0b\x04}]P\x9d\x8a\xea\xc4\xfa\xebLT\xf8Z\xbf\xc9\xe8M\xc2_\xdbz\xce2~\xa2\x8d\xaa\xe1\xa42LI\xa9M\xf1\xff\xec\xfb/\xc7\xcb\x0cB\xff\xc4\x87`\x18gE\x0e%L\x01\xa8V_\xaf\x00J(\xef\xf5,\xdd\x94\xd5\xbe\x13C/\xa9\xfdHD\xa2s\x9cr\xad\xf9\xb8\x05a\n\x032\x7f\x85OD$h;	\xab\xb5\xef`\xe2W|\xed\x03\xf5\x95\x0fr\x1d\x8d\xad\xc2L\nW\xb1\xf8\xc6\xc5G|\x8a\xc0\xc6\"\xbf\xba>\x8dxj\xeb\xffr\xb4V\xca\x86\xd0b=9\x1ea\x00\xd8\xba\xb7\xe5\xc7\xe6\xd5\xed=VHW-n>E\xd0%Y8/\xac,\x87\xee\xcd\x82\x05<\x04dP\xc4\xcb\xd6\xa4\x8bn\x12\xd0\x8e\x0d(\x84\x15\xfeAX\x9d\xbb\xda,\x9fHP\xa6\x15&\xd6\x7f\xc1\xd1\x9bd\xb3\x05s\x10\x93\x06\xf2\xd0\x0d8M\x08f@\xad\xcb\x8d\x80\xdb;\x02\x84\xa5\xf1\xe7\xcb\xb4T\xb4\x88\x10>\xf4z\xfeO~#\x1a\x11\x07\xe2'\x7f%V\"\xb6|J\xae\x1e\xbf\xb9\xb9\xf1\xbf\xcb\xfd]\x94\xa7	\xb2\x91\xc4\x9f\xef\xbc>\xf5e\x89Z\xc1\xf2\x87\xa7q\x88\x9e\xc5\x16\x7f\xc7s\xf1\xf4B\xbb\xeeo\xf1w<?\x08r%\x0bKM0\xffh\x96\xc6\x0f\xc4N\xbbv\x85\xea\x88\xb0hEa\xc4c \x19\xac\xf8\xf2\"|!4Z\xa2\x08\xb6\xc1\x84\x13\x9c\x91\xeas\x19\x88D\xf3;K#\xf5\xfd&q\xa0\xe6\xbb\xafB\xc1-\xdb\x1a\x13\xf6\xb9Y\x15\x8fB\x9dCZ^\xf0\x15\xc80$t\x17*\x18}\x19\xb0\xf0\xbduT.SXa\xb0\xc1^\x87o\xa8$\x0d\xc31\xf4\x14E\xd7\xfd\x10j\xb8\xe0\xd9\xebK\x18?\x8bL~\xbe\xf4S{\x1cE%\xd8B\xdanp \x98mf\x1bl\xc4\x9d@\xccg\x86\xaf\x0f\x0d\x1c)\x16R\x9d\xc3\xec\xe6&\xe8\xb8\xd0\xc3\x8d\x90\xfd'K\x07\x026\xb2\x1cv\xad\x0b\xc5R\x96Cc?*\xd6\xb2\xa0\x94\x1d\xb3d\xae\xff\x1d\xe2\x86\xa2\xed'\xa5\x0c\x00\xed\xb1\xf0\xbboD!l8\x03\x06\xc9\x07\xb96\xfe\x99\xc4\x8e\xc3\xe3\xe1_\x02\x8d\xd8\xc2\x87\xca\xe5\xfc\x93\xc0\xac\x94\xb6y\xe8\x1f\xaf\xdb\xb3h.\x9ehT\xc9\x8d\x81}\xaa\x7f\xe7\xf5\x13\xb1)\xaa\x14\xb5\xc2\xad0k\x1ff\xce6h\xe0\x8c\x01\xaa\xe3\xd1!\x98\xe4\x85\xff\xc4~\x94k\xb1\xc3\xcc\xc3\x8ds]\xd3\x94nVQ\x96\x15\x8f\xbe\x17\xa1\x8c\xcc\xdb\xf6\xfblhk\x9d\xb9\xd2\xbb\xdf=\xb8\xd7=\x7f\xdf\xad\x01;\x0f\x9c\xa8N\xe3\x9d&\xa9\x93o\xd3,;\xe7\xcf\xfc0}\xf0\xcf\x05\xab[\x1a.\xaa-J\xfa\x9e\x17&\xa2\nB\x08 \x90\xc5\xe0I\xe7\xa9\x83\x99\x89\xfcHP\xe7\x94\x0ck\x04\x8a!bP\xb6\xc7\xcd\xebT!FM.\xa1\xef\xbaK\x9a\xf5=\xa5\xfc\xa4#\xff\x84\xb6\xb4\x8d\x9ds\xd7\x18\xb1\xc7S \\\xfc@\x95\x13E\x04h\xe5\xd6\x87\xa8\xc3\xc1\x13\xbfvX\x81\x1dX3H\xb1\xc2\xddA\x98(\x8e\x0fE\xf6\xa0\x92\x10qk\x99;|\xed\xd4\xb2I/\xa3\x0d\xc2\xc5\x99\xc3\xf4-k8\x00\x80\xdd\x86z\xbd?\xe8]\xc7V\xe2\xdb\x13t\xd5\xc9M\xd9\x92m&\xd8\xb3\xa5\xe05)E\xd6B\xaat\x11P\x96\xe6\x9f\x19\x02H\x00;\x9bO>^\xb2	\xccQ\x90\xa0\x8e(#u,p+\x1f\xc8\x86]\x11b\x99i\xa6\xf1\x08\xf5\x99\xbe\x11\x94\x8d\xf2 \x1b\xb9\x8d\xe6\x0f!&\xd32\x9b\x0b\x89,\xf2\xf9~\xff\x03\xd1I\xc1\xce\x0d+\x13w\x88NN\x80`\xa2P,\xa4\x1f\xcb|\xfa}\xe3gb\x05t!r\x00]\xcc\xa1\x03\xaa\x1cQt\xc4F\xfe\xa5\xf1y\x8c\x88rU\xed\xf7\x8b\xfd\xbe\x03\xca\x19\x89\x08\x04\xd2T\x98\xad\xa6\x1c$\xf0\x9b\xd9P\xd4\xc20\xb3[\x8a\xdc\x89\xed\xfc\x08\xd9\x87\xb1\xe8\x8e0\xacD\xaa!DG\xa3\x0c\x97\x02\xa3	\x17\xa2\xa98[aH\xd3ep\xa2V*\xe2\xc8\x1b\xf4\xbc\x16m\xb8Pj&\xd9\xcb%\xa3\xc0\x9fA\x85fA\x9b\x93\xe9Ao\xc4V\x96\xb3\x07\xb8\x1a\xe1O\x07f\x0f\xc1d\xdb\x99\xd8Zla\xda\xb6Q\xa5\x8cf\x0fs\xfe-\xd6@\x9e\x9f\xe9hIH\xe1\xd2\x05_\x8a\xbc\x97\xefr\xa4\xadeEb\x86eey\x01\xee\x19\x16;:\xe7`\x96Q\x10\xbf\xf6\x05\xd2(gs\x99r\\\xc1\xf4\x04n\x0c<\xb3\x1f\x9c\x01L4\xe8\x07\xe2\xfd\xa5_\x89\x8b1\xc5_\x80\x15\x16>d\x88@\x8d^/\xef\xf5V\xbc\xf5\xe4\"g\x00\x94\xce\x02\xf6\x99\xdf\xf2^y\xf0\xbd\xf1b\xc4\xdbq^:{KP\xe1!2\xc0q_\xfe\x99\xca\xf8\x10\x90\xe9\xc7\xfb=l\xa3?^\xfa\x0b\xb2\xef\xc2\x07<\xf8\x04\x80G\x1d\x82\xcc\x12	\xd7\xa2\xe8\xddm5S\xe5b\x8c\\\x86\xae+\xe1\xf7\x1a!\x90\x8c\xde\\\xc5\xd9	\xd5\xc1#\xa2\x88\x9e\xbb\x03\xce\xf7L\x87\x1f\x11\xfaL\x08\x870\x15^\xb5\x8a\x92\xe2\xd1<\xd15\xe7	\xcf\xa6\xf7w\x7f_6%]w:;\xad\xfb\xe6]T\xa5\xf9\xd2\x96\x7f\xf1\xe6\x1d1g\x83\xcc\x9a&\xa3\x0bd\x9a\x01\x12\xf4\xe4\xd1\xc6	\x16\xe1\x08f..\xec\"H)9\x04\x03\xcd\x00\xecSC\xfb\"i\x12\x9cNu%\x1b}\x85#\x1e\xba\xfe%w\x07{F\xa4\\\x11\xf5F\xa2y\xfc\x90\x17\xe3\xc0\xe9\x81M\xa6b\xd3U\xe6\xd0{h\x83?\x02\xe0I\x19\xef\xf7\xb1\xb8\xe0\xc5O\xd0\xb1YL\xf39\x8d\x9cM\x86'I\xafW\xfa+\x91\xb4$\x8f\xebR\xfc\xa7\x98\xd3\x90E\xd8o\x97/\xfc\x83o'7\xdemk\x92g=M\xd5i\x00C\x1bh\x8cO\xda\xd0\x1c\x05\xdc\x08\xa28~\x8b\xa5\xa2W\xd0\x95Z\xdb\xb7\x9c\x13>\"\x0e\x04\x8c\x9a\xdd2\x93\x0cR\x9d\x1e&\xdb\x18\x01\xfd\x19t\xe1\xe0\x93.\xf4\x18!\x02Z\x9b\xb0|3\xadt\xe6*\x08c\x11\xb8\xdb\xe0KZG\x8e\x1e\xcc\xc9\x9d\x00W\x1a\xcd\x82\xfe \xb71\xe7\x03[\x86\xd0Z'\x15\xfbz&\xa8\x04N\x1a\xdf\xfa\x00\xdf\x93\x80{?\xf3\x9e\x18\x0e\x11\x01\xdfhsi\xcda\xb5lb#\x9f\xb7\x7f\x81\xa1:@\xed\xb7\xc6\x8f\xa0\x0b!qj\xe2\xcd\x91u:	\xf6\xfbN9\xa4_\xf4\xaa+\x10;\xaa\xf6>Z+\xaa\x85x\x04\x01\x0b\xdd\xbf)\x1c2\xe77\x1e4\xb3\xd1Qf\xf2F\"\xbb\\\xafG\x8e\x01:\x1c_\xdd\x95y\xff\xc3\x11!\xfd\x8e\xdf\x18\xba\x03x\xc7\x17$-\x02\n\xad\x87S:,6h\xca\xc4\xd4\xf4!{\xab\x1b\xf8Ds\xac\x18\x9f\xe9J\xe3\x9b\xac\x83\xc2\xe5p\x81\xa8\x14\xfc\x9b9f\xd3\x0f\x0d\xc2\x9e\xb4N\xc6P\x0b\xa2%YM5\xbd\x11z\xab41\x98\x82\x99n\xa34\xb1\xfa<\xebE\x83\\\xa1Vcg\x82\xc0\x95\xfb}\xf9\x8a\xddX\xfc\x12Z\xe5y \xca\x97\xf8\x88\x0b`}T0\x99Z\x8a\xca\x1d\x87;s\xc7\xeb\\\x8f*\xe4\xbb\xca\xd9\x95\xdf\x9d\xc5E\xf6g\xcd\x02\x1d\x91~\xce\nC\n\xb7;\x04'\x07\xca\x10\x9d$\xf5\xb1\xd7\xff_\xd0\xba\xa3L\xf9\xe7\xc9Vv?\xebnd\xfb\xdd\x0f\x8e\x00\xe6\xe2\x82\xe5w+\"mX\x84\xd2\xd6\xfd\x8bS\x17~\xe2\xdb\x8d\xea{\xfb\xbd\x87P\xe7I[\xed\xefL\xee\x02\xdf	k\xc6\xfa\xe1\xd2\x9cx\x1e\x88\x0eUU\xbbc\xa3\"\xd6;\xb0\xfd\xc0?\xc1fL\xaa\xa9_\x0f\xef\x9c\x9a\xfb\xfdQ\x01\xb1ye\x10\x0cK\x95'\xaaD~B\x81\x94\x98\xe1O\x18M\xeen\xccO<>k\xef\xd5i\x08qq\x80\x92*\xb0\x16\x88\xf6\x12\x88n\x05\xba?\xda\xd6\xea\xd5Q\x9a\x17\x1b\xee\xf8\x04m\x17\x0cC\"\x92;\x84\x9e.\x8eu<\x14\xf8\x92\xfc$\x0cs*2YN=\xd6\xda\x84\x95h\xd8\x87b\xa5\xe1\xff\x13\x04j\xf0\x90\x98\xad\x86Ob5|\xeas\xec_\xb1\x1an\xf1\x7f\x7f\xc5\xae\xc1\x90\x8d\xee o\x0eG\x82\xc4\xcf\xe1X\xd4\xc5&\x1c	-\xd3\x0e\xc7B\xf7\x12\xbe8\xb4\xb2\x04\xad,\x06d\xc2\x85\xd9\x18Q\xce\xe2\xd9\x8b\xf9`!\xe2\xd9_\xe7\xfd\xc5<\x9c\xc5\xb3\x91~\x1e\xe3\xd9\xd9?\x88\x0f:Z\x16\xbf\xec\xb0\xf0\x88\xee\xb4\x99%\xac\x96:\x97\xfa`\xc9\x86\xa7PL\x07\x87\xc8.\x8c\x9c\x19\xfb\xde\xcd,\x99e\xf3y\x88A\x08\xef\xe9\xb8\xf0\xafs\xd8\x8d\x18\xb6\xdeZ\x0e\xbdxflEa\xc7\xa2\x91\xd0Z\xee\xb0$\x83q\xbb&\xb4b\x83\xb1Y\xb2\xc3$:\x921\xc8\xa8+\x8c\x93\x11\xe1\xe9\xf6y\x8d\xd8&\xfc\x95\x96S\xc8\x92\xc6\\\xcd\xe7\x03g\xee\xe1\xc8\x15U\x91\x083\xcd+\xc2x\x08\xb9p\xd4\xcb\xe0\xa8 \x10V\xe4tE_\x9d\xca\xbdLk\xba\xb9\xa3Y\x0c\x8e\xe7e$5'\xcd\x98\x10Z\x06\xbf\xf0\x87\xda\xc3I\x92\x9e\x04\xc4\xdet\xf0\x10>\x88h\xf8\xe5\x85\x1c\xbb\xa6/\x8a\x8f\x8b\xb1*\x0fv)g\xd9t\xc8'\xe3\x97\x94\xfe\x99\xd3\x92\x8e\x08\xf1\xad\xaa\xe3\x95*\xc3B;D\xe1\x86\xa7K4\x8c\x04[\xed \x0cX\xf8\x0fd\xf6h\xc3BG\xf0\xfe\xc0\xe1\xa1\x97\x17#\x8a\x1fO\xb2\xa80G`\x85\x15\x9cj\xd3U'\xc6\x01\nq\xcbzESc\x85\xd9\xdcJ=\xd5\x1f\x19&\x89\x1cq\x0b\xa4\xfb}\xde\"\n-F\xa2\xaa\x84M\xc4\xa2\xf0\xd3#\xb3\xbb\xd1\xe1 \x94p\x92\x15\x90[\x07\xf9\x0d\xf6z\xe6\x97q\xe4H\x03\x97\xbd(\xfe\xdd\x9e\xca0e\x9b\xb4\xf2\xff\xa0\xbb\xa8\xbb\xa5V\xa4\xc0B\xcb\xae'\xa0\x91\xe6\xad\xce\xbd,6Q\x9c\xd6[\x0f\x18-w\xf6\xde\xf1\xb936\x94\xc7\xaelGnyo\xa2\xd6\x8f\x0fd\x01\xc5\x8b!)\xdaF\xf9\x1e\xa2\xa6\xda\x80\xddo\x8a2Q\xa5\xb6O$L\x07\x84\xdfT,\xd6.\xfd\x1d\xc2\x98\x85\x8d\xe0\xc1\x85\xab\x83\x88O\xbb\xb0\xb2\x8c\xbc#\xad3nt\x93\x05\x94:Q]\x97\xb6L,L\x96\xa6jZ0\x96\x86\xfd=\xeb\x18\xb5Z2,4>\x7f5:R#N\xb2\xfd\x1eg\x89%\x92\x89\xc8E#\"\x91\xc2}A\xe1D%\x0e@\x94\x0c\xba\x8e\xe5\xb0\xff\xed%\xe6jN\xb1\xc5\xb7\xa9\xb0\xd6'l\xba\x1a\xb8%z\x98.\x17S\xad\x8eo\xd3\xf6\x98\xb1\x8f.\xfb\xb4S\x04l\xcfz\xb9\xb5\xa1\x11\xbc`\x8a\xac\x95\x85\xf6\x9c:_\xfb\x06&\x98L\xfc\xe9\xcb\xfe\xe7Kk\xd6\xb9\x01\x0dP\x85\x9d/)l5\xdd\xe9\x95\x17\x1cD\x9a\xc7\xa5\x82\xe3N\x94\x85\x17\x17\xa9\xb8\xbb\xab\xd0\"U\x08\x0bqwG\xe3\xbaJ\x9e\xc2\xe8\x80D\x19\x90I\x96\x81\xc8V~)\xea\x8e\xe9dv4\xe1\xd45\xdf\xbb'`\"L\x02X\xea\xbc3\xa0\xcf\xfc\x19C\xf0\x11\xe0\x9e\xfa\xa5\x8aN\xa3\xf3`\xe2@g\x01X%\x08\xd0\xc1\xf6\x08\x99\x98\x12\x8e\xd2\x97\xdb\x02p\xb8\x94\xe4@\xa6\xeeJ\x99\x04\x15.\xa9\xd7\xf0$\xad\"\xdee\xa0\xe0\xe7	\xcb\x96\xc4 [C\xcb\x80U\xf1#\x1be\xdb\xf7*\x85\xd4T*\xf9\xc0t\xc4DSVQ\x1d\xaf^SG\xfe\x0eTd\xe8mRu[,\x97\x99\xba\xa1/<\xb1(\x8buX\x0b\xdc\xa5\xc8\x0cH\xec\xdcU\x12\xaaa\x9a \xc7\xe3q\xe6\xde`\xb7Zi\x0c\x8f\x05\xfc\xae\x8c6\xab4\xa6\x00C\xc2\x962d\xd4\x81\x00\xfd~\xc3C\xd3_\xd1\xc8\x11\x93\xb0\x12]\\\xba\xb2\x0b!\xacF\xd5W\xc3\xd6\"\xb8\xdf\x1a\xf4\xc2\xe2\xb4\x946*Ld-\x95\x11	\x06\x81\xf6d:\xcd\xc3\x11\x94\x83\xe5\xb3LT\xcf\xb2\xf9\xa4\x98\xd6C-~Q~0|\\\xa9\xdc\x7f1\x1a\x89\x9d\xa1s\x90\x17@\xf7\xa6\xc3\x8a\xc7\xeaCSSBB\x8dLL=O4\xce\xf6\xc5|\xe0mA\xea\x07\xbb\xc8h\x9f\xa3!y\x07kooQ\x9a\xe2\xd2-n\xdb\xca\xbb\x9f\xe6P\x10e\xa7\xdf\xba\xe5\x87\x8e\xff\xfc\x84\x93\x8e\xb3)6T\x7f\x08B@\x87\xf8\xf1\x06*4\x04\xcb\xbd\x99\x10LEIBPm~G\xce\xef\xf2\xbc+>d\x1eT\xbf\xc8\xdb\x0b\xc1\x13i@\x05z\\\x90E\xd2\xe3\xbah*\x85\xd9\xdb\n\xba\xa4\xa9\xbd\xce)O\x9c}\x87\x11\x8a\xb3\x96\x95\x7fl8=\xc9_I\xf8\xaf\xd6\xb3|>\xdc\x0ed*.\xfc\x1ca!t\xc1\xabz\x96\x0f\xc6\xf3\xe1\xb6\xcf?\x0c\x16\x9d\xe4\x83A0i\x97\xda\xba\xf0\xa3\xcfV\x08QJ5\xd5\x11\x9e\x87\xd7\xaf\x7f\xbb\xfb\xe5\xf5\x8f?\xbf\x0bG\x08\xba\x07\xbbj#\x89\xa8^f\x14x\x0f\xf6\xb9\xac;\x87,\x0e9f\x0c\x90\x98\x84\xc6\x16\xa1\xd3\xdb\xed\x80,q\xe9w\xa6r\x11\xdb\x9f\xf0Xh^\x16\xfd\xd5\x94\xbe\xa8\xfeY\xd6\xbe_\xf4W\xfd8x\xc6\x7f\x07\xcd\xb3\xc6\xc9lD_>\x0d\xd2\x009q\x93W\xc4\xed'\xb2\x1c\x8cG\x81\xb8@\xd1KS\xd4G\x91\xae.\xd3~\xf2\x0c\x86>\xc9\xe1\x80\xa4\x80e\xed\x9cl\xe1\x9a9m\x07j\xb8u\x12e4b%G\"\xb6\x8b`\xd4\xeb\xb39'0\x8f)\x81\xb9\xdf\xc8z\xb6\xc1LW\xc1\xcbQ\xafg[g\xf7\xc6v\xa9#\xa9&\xd1\xcb\x94\"IP\xc8\xae\x08\xdb&s\x11\xbdBF\xe8\xfe\x18\xd9\xe7\xeaY\xd4\xc7v\xbe\xe2\xd7\xf4\x87\xf7\x94\xefS\nm^\xf9\x91\xc8\x9f\xbf\x08&\x95\x9f\xc29\xe7\xf9\x8b\xe0\xe0oD,\x06:\x85\x0b\x0d\xa9O\x7f\xf4\xd7\x93r\xb0\xc2\xf8*?\x1e\x8c\xc5jP\xea\x99\xbaS\xa1\xda\xdbW2\x9d.\xb4t\x0c\x05A\xc8\xearz\x98d~\x02\x01\xbb\x99\x9c\xc8\xfc\x85\xb8\x18\xd9\xe7\x16\xde\x16\x1d w\x01n\x066t\x06\xf3\x9b\xd1$k\xa5]\x19\x8d \x9b\x0f\x9f^\xaaii\xfa\xcc\xe6A\xc8\xb2:z\x98$+\xbf\xe2\xee\xc6\xd4\xb2Hp\x8f\xea\x92\x81.\xa2\x99\x9di\x9eA\x14\x0d\xd1\x85E74%Icg\xbe\x95\x84I\x048\xcef\xf6\x02\x7f'vD\\\xa0?~\xea\xff5\xec\x16\x0c\xfeJ\xd6\x140\xa1\xa0\x17c\xae\xb9\x15\xdc\xa2\xe4\xfa\xfd\x95k\xac\xbbY\xf9\x1c\xb6\xc3\x0d\xc8\xd1\xbe_\xf2*\x06\xbb\xdfo][V\xc7\xd0\x13\x97\x9cdA\x18\xe33\\\x18&a$n9\xe9\xf1\x1b\x127\xb6\xad\xafm\xeb\x9c\xbc\x11\xafMX\xd2\xd6b\xbf\xb5HM\xf3$\x8d\xa3\xba M\x04\x85G\xc0\xadh\n\x89\xea\xe8\xa8\x04\x1d\xca\xd0\xb3\xdf\xdey}\x85H\xf4\xea\x11\xd3KI\x86\xbd\xbc\xb5L_n2\xc3\xd3-\x8f\xdf\x08\x82h\xecY\x85\x16\xea\x80\xf2\xe8\x0e\x92\x03U!\xcf\x0f\x0d\x96\xf1v\xa9\x90\x01\xc7\xaf\xedr\xc5O\xfcw\xcb\x15\x04\x0716\x97n\xbb<\x0f|\xd3\xf1\xc0J\xd0\x84\xc5c\xa8\x0e\x1d[\xe2\xed\xca\x91\\A*\n\xf9\x91\x9c\xf5k\xd1\xaf\xe7\x9d\xe4\xd9O\xd8\xec\x0fw\xce\xf5\xd5\xf6u\xb7\xb2\xa1Y\xdc\xd8\xb0_\x8ab\xfdc\xbaNk\xd1\xf9\x8e\x0d\x84\xcb\"Z[\x0d5\x14ot\xef=\xad\xcc\xeb\xe8\xf1O\xdej<\xce\x0f\xe8\xa7\x1d\xcc\xe3	\x85ft\xd1\xb4\xc5\xb0U\x99\xbaa[\xb52\xb8\x93\xbc5\x02\x07\xbbJ\xabi4\xab\xe7~\xceA\xf4m\x0f\xf7\xb6\x07\x90>w\xabvn\xd0o\xe3/4\x8eR\xf1\xf0\xb0$\xa4{\x869\xf4'\x9fkdE\x94|\xa7\x8a\xbfWE\xee\x1f\xa3\x9f\x9b3SX\xaa\xc2\x8c\xbf;\x9f\xf2\xfc|\xa2it2\xc9\xfd\xde\x8f\xbef\x11\xeb-UA\x0e\x03\x8e\xb0\xf5k\xebS\x9a\xf5)\xcf\xae\xcf\xf5\x19\xae\x8ah4D\xe8\xa5\x95\xa8<\xab\xbb\xba\x90\x96C\"\x03\xc6\x82\x0c\x18\x8d\x19\xbf\x1b9\x01V\xad6\x8cB\xe1\xbe!\xaf\xd3\xfd\x1eC\x023\xdb\xb1\x0fD\x13\x02\x9f\n|B\xb9](\x94\xf2\x00e\xc1\xc1\x04 =\x1f\x9f\x98\xbc\x7fh\xf8\x19\xd1\xc8o\xd9\xb6\xd5\xc4\xd82\xe5p	\xf0\xac5\x12;\x9e\x1aV^g\xbd\xf2\x9dlXQ\xb5QqM\xce\x1a^\xb0\xdf\x0f\xff\xfbo\"\x91%\xc7@.\xf9\xce{\x16\x8b\x85\xbc\x18#\x887\xa2\x86WrvY\xf8\x14\xab\xb9	\x04\xfd\x1c\xcf\xc5*\xc0Ut	?9\xcb\xa9\xc2O\xd2\x04\xf1\xa8\xdce\xe2\x00\xd0\xfa!\xc3Q_\xc0\xe0\x92\xb6ba<\xe6\x91=\xec\xd5x\xeao8\xa9Z&6< \x99=\x87\xc3e\xfb(l\x9dg	\x1c\x81\xb6p\xfb\x9a\x0fL\x85\xe7/\xc4f\xf8$1\x84\x01\xd7|\xfe\x82l\xdd}\xf6}|S<i\xde\xe3cTF\xb0\xc7\x08\x86zid\"6\xf2\xa7\xc2\x8f\xc4\xa9\xafe01\xfb\x0c\xe32\xc8\xaa\xfc\xcd\xf0	\xdd\x9b\xf1\xd8\x11\xf3Q\xab\x14o\x9c\xb1\xf1e*\xd0DZ\xafT\xfdGQ\xac\xcd[\xa0\x13\xcf\xe5\xe4\xaf\x18\x9e\x13cK\xb9TZL\xeb9\xb7\x05\xee\xb4]\x0dr\xfc;~\xeb\xa7\x88_\xe5\xb4s\xcb\xc7:\xd8\xbd\x05\xc2D\x12@\xc7\x18\xe6\xd2\xbe\x05L\xe6\x92\xeaLr\xd4\x92\xc8\xef\xeb\xd3O\x1cC\xa7\xc9\xd7<4\xa3\xa70\x0d\xb75\xde\xba\x18\xbe\x9em_\xcf\x81\xe2\xf1\x17\x1a\xe4\xb6\xde\x8f-\x0e\xdf\xb0\x1eb\xa5\xe2\xcf-\x92-\xcd\xddzWlj\x93\xbdPI\xa4\x08W\xfe\xe7\x15\xdf[\x85T\xfeG\xf3\x10I\xe5\xbf7\x0f\x95T\xfe\x07\xf3\x90I\xe5\xbf\xe3\x87\xc9\xed\xe9\xedP\xa9\xfacg\x14\xad\x97\xc7\xf9Q\xca\x9a\xfdL\x14\x19q\xb4\xd5\x95h\xf8\x83$\xad\xf0\xca `\x9aF\xe9\xa7~C\xfa%\xb1\xc3\xbe\x7f\xc8\xaf\xc1\xf5\xfc\xbaR*\x83\xf8\x13BD.\xbb.\x1e\x14\x8a6\xd0\x9e\xe5\xf5\xa5\x16\xa2\xba\xaf\x0eF\x1f\x0b\xab?\xb2e\x16\x17#mlO\xc1#\xd8\xf0\xde\xdbD9\xfd\xc4F\xb4\xbcWR<\xe6`\xb5\x84SF_\x89\xa2S\xd6l<\x11\x05N\xd3\xa4r4mc\x12g\x1a\x7f\xc4\x84<\x04W\xd0\xado\xd2<^y\"\x0bX\xd3k\x16\xa7]7v\xb9;3\xb8\xc5\xe2\xcc\xe8l\xa1\x1e^\xa7\xa8\xd3\xf9b\xe1\xf4\xdev\x0e\xa5\x12\x0bO\xf46q\xc6\xf0\xea\xb2\x8c\x96K$\x1cwFf\xa0\x19\xdf\xde%\\\x81\xdbJ\xab\x8fh\xfd\xcf\xbe\xd8p\x05\x87\x94\xfd\xbc2\xf1\xe4\xfd8%2\xa8f\xb3=\x88'\xf5\xaf!\xf5\x04\x10b\xcb\x1b%M\x92\xa5\xdfDj\x7f\xff>9s\x88z\xbd3\x85|\xde\xc1\x1f\xe3\xe5\xdd\x93%\x19\xb6\x88\x17\xd9\x99\x1d\xa0\xc9\x19\xefG;^\x1an\xaf\xf7eE\xc7GxG\x10\xeb	\xbc\xed\xb6\xd5\xeb\xf1\x0epL\xba\n\x81\xef\xc9\xe1\xa1\xd7\xbb\x00F\xa1\xda_J\xe1-IA\xf51\xca\xbd?\x9f\xb2\xe0\x00\xffwO\xf0u\xa0_\x88\x8e\xaf\x069\xb4\xdd\x83b\xf2\xf5	\x16\x9bz\xf8\x95#\xd5\xeb}z\x84\x82\x18\xef\xf8\xd0\xb2=\x87Og\x08\xe2\x07PN\x845\x9d\xc5yO\x8b\xc3\xfb\xe8\x1f\xaf\xe3\xd8Az\x1f\xcc2\xda\xe5;F\x02z\xfdp\xd4j\x08\xa1Tv\xa9\xb2\x9au\xb7\xaaS\xf6j4\x1d\x0f\xc7\xe1\xf8\xf9x8\x9e|\xea\x10\x85P XH\xb1p\xe2\x0e\xf9\x9d\xdd\xcf\x7f\xbd\xfe\xb4wD<\xbc\x1a\xffy\x97T\xf37\xf3\xa3\xd3\xe1'\xbem \xc7\xfc\x0f\xe0\x15\xf5{=\xff\xeb\xfb\x82\xf5\xf3\xb8ag\xa1\xbfpw\x86\xd4\xabi\xe3g\xca\x86\xf8\x82\x8e\xe8\xe2\xce\xcfA\xach\xd3YX\x89\xfc\xa0\xb6\x9e\xeb\x07\xf7\xe6\xa4\x19}4\x91\x08\xcc\ns& \xf4\xfaR\x11\x99\x87\xc8\xab9\xe7Rq\x1a\xfa\xe5D\x8ckN9d\xb8\x0e\xe7R\xba:g(\xa0	\xdb\x12\xfd\x87J\xfcg\xbf\x1f\x83\x88\xca\x9eIe\x14\xfb\x8d\x8c\xa0\xa7Er\xc3\x15~FO\xfb=\xd2\xaf9\xd1\xb7-\xc5\x86Dk\x10SbR\xb1\xcc\x9e\xebF'\xfa\x8fD\x1a\x88\xd1| \xfd\x94\xe2\"\x04\xcf \x04\xa1xj(\xcc\x07\xf8a\nAl=\x93\xb1\x00!\x86\xbf\xc5\xe9\xd4\xbf;ZC\xd5\xb1\xbcx\xb3}\x97\x91:\xc0\x87\xe3\xde\xe6\x96\xd6\x04\xa2y\xd2\xfe\x1f3\x14\xce\xee\xe5\x17D\xa5\\<\xbd\x9e\xe5\xd6\xb9\x0d9\xee\x91R\x9e\xf8_Th\x87\xf1\xeb\xa9\xea\xc5QL\x89\xdc\xd2\xca\xa5\x8aXmp\x92+\xd7\xa4\xd2\xc9\xbbV\xc5vo\xc1\x9e\xa58n\x14X\x84\x1cX\xd2\xe3K-\x10G\"ypY\xa4ZN\xdb{X\xdf\xcdf\xect7\xdeQ\x13\x97\xc5c\xfem\x16\x01=C=J_\xe8\xae\xda\xda\x11\xc7\xdc\xe9~q\xae\x8d6-\x0c\x1cT5hO.\xca\xe1\xdd]\xa9\x96\x10fL\x82R\x96\xacs\xecX\x83hm\x01Q\xa6P\xbf*\xbb\x03S*\n\xbd5l\x85\xfb^W\x97p\x0f}C\xb8\xf0\x9d\x1e\xdaa[\xaaq\x07\x066\xac\x89\xb5\xd7\xea\x87b\xd8\xa4\xc9\xe1\x10\x1c&\xd5\xac\xed\xaa\xef\xc1\xa0K\xaaa\x9a\x88\x13uF\x15\x88\xefWD$\x1f\x0e\x07\x173|\xcfp\xa0\x8d\xfd\xde\xae\xd2\xcc\x89\xf0\xc7D\xf7\x99\xd1\xa5\xc1N\xd9\xdb\xa0v\xd5\x16l\x0f6m%\xde\xa1\x91uC\x81\xe1t\xfdG\x07\x04!}(\x1f\xf9\xa6\x8a\x8b\xbc.\x8b,\x83U\x8bz$\xe2\x18P\xf2G\xe9\x1b\xa6\xc1\xa9\xf2}Q\xd5r\xa7\xb7K\x07`j\xb2\x8c\xc9\x11-\x193\xb7\xb9\xb6\xe3\xff\x8e\xca\xccU\xd8\x81\x81vp?\x1f\x1dS\xdd?\xd8\x14\xebO\xc8L\x0d\xd1\xd9\x84*(\x10\x90\x9b\xbb\xb7p\xd8l5L\x9eL\xfcx5L\xb6\xc1\x0e\xd8d\x80\xdfO\x8cLP<\x01*$\x0e\xe0\xb6 \xadV	\xc5Z\xcbB\x15\xc1\xc16\xa9!\x9b\x91z\x05\x83g\x8d\xf02\x99\xb6\x08/:\x83\xf0\xf2g\x11P^\x15<\xcf\x0f\xec\xc0O\x88\x0b\x9eu\x84`	}E\x1c\x0c\xa5n1\xf0\n:\xa6\xa2L\x97i\xfe\xdb\x00\xeeX\xc13?\x1a dM\xfb\xe2\xf7\x01\xa4\x96\xfc\x82\xbc\xc5\x06:\xc0\xc9| cQ\x9f:S\xfc\xeb)\x8b\xda2\x89\xd1\xb3\xdc\x06\xdc\xd2|d\xd8\xdd\x0clD\xe8\xec\x96s\xe9\xfe\xd6\x0187\xc8\x82c\xce\x00\xfe\x86\xce\x82\x7f\x1c#\x9a\xed\x05\xdb\xaa\xaa\xd5Bz*\x1e\xc0\xde\xcc(\x1b\xf3`\x92\xce\n\xa40/t\x86m\x8ew\x8c\xfbuV\xcc\x8d\x01)\x0e\x84@\xb8Et\xe5\x9b\x1f\x7f\xd6\xe7\x91V\x80\xfb\xc5\x87\xa6k\x8am\x0b-\x0c\x02\xa5\xe9\xa4\xa3\xe4\x9d\x1a\xcdYZ\x0e\xdd\xc4d\xf5\xb2\x99\xac\xfa\xfd\x805\x81\x80\x8a\x8a\xa2R\xcfVNh\xaa\xe8\xc9\xcf\xda\xd2\xb2/\xf97AFl\xd6/\x96\xc8\x9aI\x88oZ\x01\xd7\x91=\x96\x9af\xa1\x17=\xa82Z\x92M\x8a\x9a\x96\xcf\x9b\xb0\x14\x08\xf4\xd8L\x91\xbc7v\xb1\xc1?V\xad\xa1 V>\xcd\x01\x0bD\xe5\xe2HU\x93t\xbf\xf7S\xb9\x03f%1\xaa.~]\xd7%\x17z(@T\x11~\xe54C|\xbb\xf8\x890 \x84\x84w\xaf\xdd\x0c\xedi\xb0\xbb{\xed\xab\xe1\xed\xa7\xd7\xefo\xbe}\xf7\xe9\xf5\x9b\x1f\xdf\xdd]\xbf\xbb\xfd\xfe\xc3\xe5\x8d\xb3\xdb0ty,\xa3\xcd\xb5\xaaW\x05\xe2\xb0?\xf8\xbf\xaf(\x84\x06vQ\xb9\xef<r\x8ae\xdf(O<\xf8?PE\xf4\xab\x86o\xbf\x7f\xfd\xfe\xbb\xf3}\x10Zu\xdaI\xc5\x83\xffO\xeeC|\xf6\xd3\x19\x07o\xc5\xdd\x8e\xe5v\xd6\xefw\x06\xeet\xe1\xff]\xd3\xb0L\xb3\xa62\xf29T\xc4\xec\xf1\xf5\x1c\x80i\xb5\xe4\xba!\xd5\xae\x0cG\xeb\x8b}%:\x95\xe8iv\xffz.\x1c\x0b\xce\xb6\xf3\x7fr\xe7\x0c\x10P\xec\x97ML\xcc\x9b\xfee\xe2\xc1\xa2\x99\xc0\xf5\xac\xff\xa1\xfb%\x16\x08\xa3t6\x07\x87,\x05K\xdd\xeb\xd5\xb1\x9f\x0e\xdd\xa5\x05\xa5\x02\xea>8t\x1a\xfd\x8b\x0bK<\xf0\xc9qLo\x96\x15LUH=vB\x80\xfc\xbd+\xb5\xb9\x7f=\xef\xba\xfb\xfedo\x87\x1a\xdf\xc2l\x12#w\x80\x05\xa3\xa6e\xccE}\xa4\xba\xafcC \x05\xbb\x14\x9fC\xb0T\x0b\xddM\xca\xd0\x8bE\x97J\xe4v%\xa1\xc4\xe5\x07\xc0\xfc\\\x9a'[\x87\xdd\x10\xe9H\xcc\xd4|\x8e\x14\x875;\xc3~V	\x8e\x93\xfc\xcb\xaa\x1d\x87\xe2q0\x07R\x16\x05K\x98\xd0\n\xdf\x8ey\x91\xa8V\x87cC\x9a\x1bYT\xa6\x1e8\x9074:\x8a0\xdd\x998\x10\xeci\x00\xb31kp\x8c-\xe36\xa2\x07vQ2\x9e\x9a)I\x84:\x95\xed\xa8\xd3\xd8E\xe2j\x18\x83d)U>\xa9\x990{_$J\x83\x8b\xcf\xf1\x86i\x1d\xda\x97\x9dc\x93\xc7\xd8\xe9z\xb8JUI/wl\x9e\xf7:\x8fUU\xc3\xf0\x0e\xb6+\x91y\xaa!\x80\xca\xd2u8\x12\xeb\"I\x17\xa9*\xc3\x91\x88WQ\xbeT\xe1HPZ\x83p$\xd2p$\xeaU\xa9\xa2D\x0b\xe7-eI\xfb.g\xf5|\xa2`l^l\xfc`\x02\x8d\xae;\x15\xb2\x03y\xf7\xb4\x89\xf2\xa4\x8d\xb2\xe5\x04\xbal#\x0bL\x10MwR\x98\xe8Y\x11\xdd7\x93\xe8\xdf\x9eM\xf4\xef\xce\xa6\xe8\xcc\xc6\xe4~\x8b\xdc\xdb\xb5\xe8lLm\xa70\xad\xed\x1e\x853\x84\xf0t7\xca\xbe\"\xa2\xca\x0c{\x98N\xf3Y\xbb'\xc3t0\xe6\x8c\xbbX)\x9e}\xb0k\xb0w\x1c\xab\x81\x82z\xb6_\xe8i\xf5;Q\x18\x8e\xdf\x06\xcf_L\n\x18p\x1f\x95\xcb\xe2\xb8\xa4\xaf\xfcZ\x0b\xda\xec\x0b\xb3\xf9\xee\xa8\xf5\xe7\x83(\x08\x9d\x8a\xbaPF\x1a\xb3\x16\x906\xfd\xdb\x1d\x06\x1d\xa0n?;\xdaT\xb9\xc2\xd2\x17\xe2\xdf\xaa\xbc\xdf\x93\x0e\xc6=\x03Q\xec\xa0\xcb\xe3\xc1\xf5\xcf\xb7j\xe6\xaf\x89S6a\xda!\x8f\xb8\xb6{9\xad\xd9\xefn\xfc\xc9\xfbv<e\xec`_\x1b\xd8\x9a\xb7}Z\x87\xcb\xb8\xad[\xc5\xe7i\xbaz\xd0\x9aT\x8bt\xf8dr\xe4\"-\x16lh\x87[S\x02#\xa8\xae_l\xc6m2\xfe\xff	\x91\x19\xcf\xa9K\x8cv\xa4\xabu\"\xe5K\xd8Q=\xb9t\x0e\x01\xad\xb5h\x80@\xc9\x1e\x81\xd4\x86\x83E\x16L\xf2\xe8\x19\x0cR\x9cps\xbc)\xf6kt\x0c\\d\x07r\xba\xa0(.\xfa\xd2y\xa3\x0f\xb6\xc8;\x85t\xc8\xfb\xae\xdf\xce\x8a/\x06\xba\xa0\x16\xbej\x87[H\x12\xd0P\xfe%\x07*\xcc\x0c8\xab\x8d\xc8dq\n)\xa2\x91\xd1)\xfc\x88\x95,\xcf\x94\xc6\xb2:\x9d\xd0\xa4\x92q\x0cV6\x92I\x0c\x0b\xac\xaa\xd7\x8b&\x08\xea\x17\xc7\x9a\xecMb\x18c9\x9d\x1bL'kN\xd6T\x9d\xe0\x88x\x10\x9d\x1c\xe1\xa6\x9f\xfb\x15\x8cA\x12XK\xf9\x9b\xd8_\xc4>L'`	 \x92\x00\x11O\x13\x91\xf5e\x12\x88\xb8\x7fn\xac\xa8qn\xbaY\xff\xec\xda\xac\xfa\xe7\x96\xe1P\xf5z\x1747r\xf8\xb1\xadi\\,+Q\x9c\xf6\xd0\x97\xf1 \x0bD\xd4\xeb]\xd0j\xc0\x81\xe8\xe4\xcbH\x9c\xe9\xad/\x9b\xc1J\xa4\xb26\x0c\xd67\x8eT'\xee\xe0\x08\xb3\xe1\xe6\xb4\x19\xb8\xed\xf5\x1c\xbc\xdf	\x98\xe3\xa2D=\xfc\xb6\xed\xe4\x7f\xa7\xed\xd1\x9f6\xb9\xb0\xb4\x0d\x8f\xb0>\x85	\x07\x82AK;\x8f\xd33\xb5Cg16\xb6u\x00U.\xd3\xe7\xbe\x83\x1a\x11\x13\xaa}\x80\xd1\xae}\xd2\x07p s\xe1\x14\xea\x03(S\xa1\xce\xedI\xa7\x98\xaf5\xa2\x12m]\xddh\xdfM\x1a\xbc\xecb\xb0\xfaO\xa6:\x0e_\xb4\xdf\xad\xe3\x13\x83l\xc7BUY\xd2?\xb5a\xfe\xd3\xe1S\x1b\xf3?\x1dn\x03\xc7\x93\xf38\xe1\x8e:\x97p\xe7\xe1x\xa7R\xb2\x99'\xb2O*\xf8'\x1a\xb1\xe4\xbfg\x9e\x0c\xdc\xde\xcd\x97\xd3\xf9\xceH\xa5\x8e\x0d\xf3]\xe1g\xea\xf8\xdc\xba\xdf\xdaR/8\x8e1\xc3>.<\xea\xb3.#G\x99o\xce\x8f\xcaT\xa7\x10O-\x0deT \xe6\xdc\xf1qCJtZ\xd0\xab<gCl\xe9,\x18\xd9^\x87g\xea\xe8\xa4\xe8\xee\x95\xb7\x8dO}\xe3*y\x91\x92\xa4\xbd.\x15\x0d\x16\xd0\xfafk]Y\xe0\x00\x1b\xc9\x87\xd8G\xe2n;\xe3\x00\xea\xc7\xc6|EL\xc5Jf]\x00l\xa6Y\xe8\x16\xed\xf7\x99\xb1\xce\xe8\x9aI\xafZ[nxX\xac\x1cCz\xf8\xcd\xc7\xd3\xddS\x18[\xf1\x14\xae\x9f\xad\xfb\x8c\xa4\xc8\xd1\xe3oa\x0c\x11f\x94\xa4Q\xf6!K>E\x8f\xbf!1\xdd\xef'\xc5\xbf\x1fB\x18>dn/p\xf1K\x89/\xe1,\\Q\x10\x9c\x988\xcf\x16\xc3'\xb1\x18n\xe7A\x98\x1e'\xe4\x82K\xcfq\xf7\xb2-\xc1\xe3I\x85\xdf\xbb\x15~w*\xa0\xbe\xdc\x0c\xcb\xeeg\xf4\x0d\x95\xfe.r\x18A \x91?Qf\xdd\xf5\x84\x00\x98]m\xcc\xa2\x8536\xdf\x98\x1fDaB3\xa5m\xb8\xcd\x8f\x11\x8ci@xS\x92\xa3\x88|x\xe1\\\x85\xf5\xd1\x80\xb2\x16\x0fb+\x1b\x0b\x9d\xd8\x87'\xb9\xedl\xd6\x9d\xdc\x1eC/\xda\x84\x85\x8a\x94O\xc3\xa7^\xef\x02\x82\xa1\xcc\x82\xbc\xc9\x90\xb0;L\x1e\x87O\xd2o?\x9f\x8d\xe6\xc3\xa5\xaa\x7f\x8c\xb6\xc5\xff\xc7\xdc\xdbp\xb7mk\xed\x82\x7f%\xd6\xf4\xd5\"-H\x96\xdcs\xde\xb9\x972\xa2\xe58\xfd\xc8\xa9\xd3\xb4qz\xda\x1e\x8d\xc6\x8b!)\x89'\x14\xa9\x92\x94-\xc5\xd2\x7f\x9f\xf5ll\x80\x00%\xa7\xbd\xef\x9d;s\xd7jc\x11\x04\xf1\x8d\x8d\x8d\xfd\xf1\xecM\xed\xf9\x83m\xcfzw\xdf\x1f\xcd\xec\x8a\x07[X\xbf?\x0ev_*a\xf7\xc5\x12v(\xc1[\xb1ur\x1d\xe6\x97\xde\xe3`\xd7\xdf\x0ev\xe2q\xb0\xedo\x07[\x9f\xacw\xbd\x95\xbc\xd4!c{+_x\x0f\xf2q\xb0\xbd\xc2{\xbc4\x0c\xa9\x12\xb2\xb8\x05\xae\xb9\xc0\xf5\x97\nT\x03\xd4\x1a\xc5\xfd\x1e\xda\xd1\xa3d8\xca;\xe39\xf1\x1e\xe4\xfads\x02\xf5\xe6%\xde\xec\xf7vCi5|\x94\x0f-\xa8\xc0\xf1\x02+\x8bH\x8c\xf7d\xfb\x95\x05\x1f	\xe3\xe7=;5\x06\xfd\x15=\xbf#\x81q\xa0\xed~\x84\x03&\x14hx\xb5\x03I\xb6m\xb2\xd0\xed\xdaO\x08\x8b\xa3\x16\xc5\x1dm\x8f$^$\xe3\xbb\xfd\xdek\x1ei\x9f\xbe1\xb0\xea\xf7\x91\x17\x8a\xb9\x98\xfb\x06\x1b\xecI;K\x0d\x0f\xf0}\xd4\x04\xdc? \x1cn\xe1\xddi_7\xfa2\x86\xcf\x92\xfa\xd2|7:`\x97\xf0\x1e\xbb\xb3\xaf\xbf\xdb\x16\x05\xd5,s)\xaa/\x93PCa\x89V\x12A\xadDuDPup>\x10aL\xec\xa4\n\xec\xa4\xfd\xbeb'u\xaf\x94\x1b{\x07\xf8c\x7f#7\xed\xaf7\x81\x9d\xb4\xdfo\xc6m\xb2P\x0e\xb6\xbd\x91(\x07\xbb\x1e\xd0\xd5\x8aF\xae\xe3?\xe5\x8d\x7f\xdfi*\x83\x96\xf8\xa4\x91\x9b\x871<C\xc8\xd1]<}J\x925y}\x92\xb9\x8e\x01j\xe1\xc9\\v\xbb\xf3\xc2[:\x93P\x8a\xf2h\x12\x86\x87\xe7\xda\xb3t\xaeZ\xf7\x91\xa3\xf5\xd6\x11\x9e`*R\xa6I^\xb7\x88Z\xed\x105\x1c\xd0De\xe1.\x88\xe3\x02\xd8\x8e\xf8\xf1\x1b\xd0\x1d\xf1\xe3w\x11\xcb\n\xa7\xdf\x06g\x91\xfa\xd5\xf3\xa2\xfe\xc6?\xaf\x07\xd1\xa6|\x08a\xd8\x01\x84\xa0*\xf2\x96\"\xeay\x9b~\xd4z\xb7\xe0w\x9a\xb3z\xda\x8e\x82x\xb0\x05\xca]<\xd8\x01\xe4n\x81\xa7\xcb`1\xd8\x89h\xbd\x1d\x058j\xa2\xf5\x0e?(\xe52\x00\xfd\x8e\xd6;\xfc\xd8\x1dH\x89\x8d\xb6o\xa9\xe1;j\xf5\x96\x9a\xbc\xe3::\xb7\xefazRu\xbb\x9d\xf7\xb7\xf4k\xbf\x07fz\xcf[\xf63\xa7}\xa2\x90\x1b\x11\xcae\xcf\xcb\xfaK\xf7M)#_t>\xbc\xd2%\xbd\xfa`\x95\x84\xef\x8eG\x02%\xe1\xbbS\xe3\x80~g\xe8\xf5\x06}^\x02\xd5/B\xefFA\x8e\xbe\x8d\x82\x02O\x97A\x88\xa7\xcb\xa0\xb46\xde\xa3\x99e\xbd\xe5r8\xf8\xd5\xf0\xf0\x9b\xce\xc6\xb9,,1\x1b#\xdf\xe7\xd8\xc2\xeeI\x03\xc4<MC\xb10\xca\xb6\xdc\xad\x92\xc3qu\xa5\x93\xc9\xa5E\xc7\xefTh\xc1\x08+\x04?k\xae-\xf1r'\x1a\xe7G\xe6\xaduyZ\x0e\x98:r@\xdaSi\xabm\x10\xf9\xd9m+\xda2\xc1P\x16'\x10\xf5YJW \xd6\xa85`w\x0e\x93\xcf\xc2\x8e\xb1^\xfd\x00ub\x0c%c\x04\xa0\xf6E\xc7\xe7\x88`C\xf8FA\x1agq\x04\xf9\xc4+\x9a\xd3\xca@|\xc3s\x93\xadAE\xca~\x9b\xe4\x8dVF\x8d\x9a\xd9\xba\xaax\x7frU\x01\xe0x\x9c\xac\xeb\xe5\xc1\xf7\x03\n\xb1Ge\x12\xcc\x07WCe\x1b\xf8\x0e\xcb\xed\xd3\xe2H3Y\x99\x01e\x90\xf6\xcc\x7f\xcaI\xb8\xf0\x88\x8d\\DDw\x8e/\xc9\xb2\x9f5\x89\xea:&>\xe2\x8b0\xd2\xc1#20\xbc\"\x92\xd9\x98^4\xddl\xcc\x96,\xf2<\xd8\x8e\x93+\x87`\x0f\xb6@!\x82\xf4>y\xe9p\xbc\xf4b\x89\x17<\x08/#\xf5\xb7\xdb\xf5\"\\\xdbuD\xea\x0d\xf0\xa6&\xa3\xa0$c\xe4\x8bK1\x97q\xbfU\x07\x1c\x91\x04\x07z\x17\x0fr\xd8\"\x86\xb9\xbf\x96\xc5\x85\xd7\xaa\xbf\x17\xf7\xe6\x009\x0b/<\xae\xba?\xda\xefG\xbe8\xea\xe9\n\xd8\"\xee\xb7s\xff|\x8d\x88g\xdc\xf8\xfe\xc8?_p\xc8\xe2*\xf2V\xe2\x815\xdd\\\x1d\x84\x89\xa0}\x01\xa8\x18\xe8n\xb0\xc2\x9f\xdf\x83\x07\x121\x1e8\xfe\x16\n\xd8q|O\xa2\xea\x9e?\x06]\x93R\xee\xf6{\xd0:\xfc\x9ax\x0b\x19>\xd3\x1d\xea\xe8\x9ft\xe7\xe1dw\x16b%\x9b\x1a\xac~\xad\x83\xa2\xef<\x8bV\xcfVbg\xfa\xe1\x07LGw\x86\x8e\xee\xf6{\xef\x7f\xd5\xf8\xa3\xb2\xa3\x06/\x82\xd0i\xf0\xe2\x8b\x0d\xb6\xbd\x90\xde\x1a\xf2\x0b\xbf4\xa0\xfa\xc0\xda\x0e\xf60\x80\xf61\xbe\x05'\xb7ac\xf6\x05\xae\x06\xbb\xb0\xe8vs\x1d\xc6\xb7\xf2\n\x0d+\xf4\x04=TP\x1cX\xd8o\x7f\xf5&nl,B\x88\xc9$\xb9[\xa3\xc0W\xbb7p\xd4l\x17b5\xfeMK\x18;\x9d\x8d\xeb\xb1\xef\xd5\x8e\xa8\xba\x89-m\xe9>\x1b\x10\x9f\xa6\xb4\x0fLXYH\x92yT\xbc\xa0\x81hr\xbd\xe6\\\xba\xd6\x94k\xe5\x91\xaa\x9b+\xefX\xc7\xcb\xb4-\x80\xcc\xdb\x80\x03\xae*Q\xf3\xfb\xf0\x91b@x9)\xd6\x9c\x0e\x18q\x9e\x85<d\xdd\xfd\xaf-\xf1\xdbp\x1c{\x8d\x08\xce\xa1]\xd7F\xdb\x92\xea\xa8\xa4\xe3\x9d\x97\xe22\x91Jh_|\x91\xf4dz\xf8\x93<\x0cy\x94jG\x88\x94\x80?R\x99\xf8\"\xe5`\xabC_\xecL\xfc\x94	\xff\x98\x0eg2\x0d\xf8\xc1\xb6d\xbb\xe1\xf1\xd4\xb0X\x0c\x16\x0f\xa7t\xba\xb6j\xf6OO]\x8c\xe9u5\x95\xa6\xfb\xac\x7f\x84:\xa0]\x92v>h\xa4+\xfcf\xbf\xb7\xd1\xcb\xa8\xaf9\x99\x0b\xfb\"\xdf\xef=\xb2\xea\x80\xa99:\xf0t\xf0}\xf5\x1d\xbc\x88\x146\x14\xdc\xbc\x9a\xde\xdcF^}\xc2\xab\x0f\xaekq\xec5\xe9\xd6\xb2\xfb\x14\x9d\xc25\x9aN\x0b\x04t\xeb\xbf\xbd\x16\xc9LL\xeb^\xda\xfc\xed\xe53a\xbd\xee\xe53\xad\x1e?\x0b\xbb\xddrP\xad\xa9i\x97bH\x1f\xf4PF/\xbf\xb8\x9c\xf9\xe2\x0c\x81<\xd5\xb2\x9c\xd6&\xd5R\xde\xfcd\xe8A\xdd\x00\xac\xb6A\xf9;\xcaq\xbe#L\xf2\xdd\xe6#T\xdeA\x07\x87\xf4\n(\x82\x1a\xa0\x12K=iA\x91\xf1K\xd8\xbb\x04\x89\xda\x17*\xe5\xa8\x8cl\xae\x92\x10\xea!\x8e\xca\xcd\xeacG\x80\x0e\xa0U\xc1S\xb3\x99(\x9e\x91yT\xfe\xfd\x94\x86_\x07\x81\x12\x7f\n\xeb%\xb8#\xa4\xbf\x8e\x94{I\xd3\xef\x1f#O\x0d}-\x12(\xcc-\x03\xa3\xa70\x8e\xad\xa8\n\x0d\xc4\x0c\xaf\xc8{/\xc4\xaa)e(B,\xfe\xb3\x14\xa1Mb`\x7f\xf1/9\xd2q\xf9\x9f\x92,\xa8\x05\x1b\xbb\xe5\x021'\x82B\xc4I\x16\xee\x82P$\x84\x93\x18\x94\x07\xf8'\xf8\x07\x11\x17y\xd2\xd4ly,\xb3\xf9\xdbA\x90\x0b\x7f\x93\xa5!Ky\xa3\x8c\xd2q\xf5\x98\xf3\xd5a\xf5\x18@+Ax\xc5r\x90d\x1a/\xe0C\xe1\x95L\xa0\x05`\x9cW\xf0\xe5\xa7\x16\x8ar\xa0Z\xd8\x10\x16\xff\xa9\xdf\xc76\xd1\x95\xc9!\x8d\x9c\x80\xed\x88\x07\xb3\x17M\xbc\xa8\xb9\xd6x\xbf\x8b\x0cV\xa5	\x01+\"\xcb\x1d>\xc6{\xff\xe9qrV#\xf0YZ\xa5\x1f\xb3\xa4\xdbeg\xcf\xda\x0f<\x08n\xeb\xc1\xfd}\xbd\xfa5\xcd\xb2\x7f\xaa\x1c\xd8\xaaM~ 9Z[m\x0e\xc3\x0e}\x8f\xd4\x86\xc6\x8e\xf0s)?(N\xe1[\x07\xb0\xda\xcb\xac\xc5\xd5\xf8\xfe\xc3\xb4\x80\xfe\xa9&\x9d\x0d@R\xe9r\x8c\x08\x0c,\x07\xde\xd8\x9e\xacD\xce\xce\xaan\xf7a\x90V\xb7I8\x7f_\x14L\xbaXz\xeau\xe22\xcd\xb2\xd7\xc5c\xfe&\x02\xf8\x02B!-e4\x89z\x9d\x17\x9d\xde2X\x1e\x98Cl\xda\\M>]\x077\xd7\xb8\xc3:\xa9?]\x07\xb7\xd7\xe0\x8eb\x079F\xc3V\x164\x14\xb1\x98\x0bm1A\x98E\xeb\xc9\x92m\x0c\x0crQ\xe1b\x1a\x1d|Q\xc1A\x87`\x86\x90.S\xaf\xf2a\x07\\\x97\x9b\x1c\xb8\xb9r\xdd\xedR\xa5^'\xc9\xb2t\x0d#R\x7f\xf2Dq\xb2\xc8\xb9/\x08\x05=(\xd7\xbe\xa0\x14\xab4\xbfY\x86epyh\xf9\x8a\xae\xbdZ\xa4\xb6H\x1b\xaf\xcf\xe4]\xb7\x9bO\xeb\xd9\xf4\x0e\xee{%\xac1x\x95U\x13\x8f_PN\xb1@P\x05\xd8_\x05\x8f\xa0\xe5\x15\x91\xe3\xd4{\xfa\x1c|\x13y(\x16\x04\x1d+\xe858)Y\x88\x8a\x9e\xee\xea\x02fo\xa0O\xb2\x16+]\x88HP\xf6\xc7\x99\xac\x9a\x16.4\xb9\xf4j\xbc\x01\xd2^\x91\xc5\xb2\x03\"E\x96\xaa`\xd6\xd2Ib\xa4\xda\xfa\xdc\x08\xc8\xac\x0b\xea\xa3pmk\xf3W\xba@\xe6'T\xa9\"t\x84\xf0vX\xaaf\xcd\xa0*2YO\xa8(6 \x03\x9e\xc3\x06P\xff\xb2\x1c|\x0c\xa3O\xb0\xa9\xcd\xe3i\xc8l\x07Qh\xcf\x9f\x8dq4,\x81\x91[d\x80_\xae$\xfd\xe2\x0b\xda\x86\x9f\x18\x8e@\xe4'{9\x1d\x8a\xcdL\x87\x8c\xdcp\xc8\xc8\xa1v>\x1c\x1e\x0e9	\x94\xd2\xdc\x1e\x1f\x9c\xba$:T\xcd}h	\xf0\x11\xe8Lm\x997\xf9?\xd3\xe4Q\xc3\x19?p\xcb\xb6\xf2A_\x1d\xef\xe5\xc3@!\xe5\xd02\x13\x8f\xf2\xa1!\x05\xe2\xa3*\xb8\xe9\xb2\xb8C\xa0\xeeM+\xf1\xad\xcc\x06\x0fi\xf2x\xc3\xf7K\xf1F>\x0chs\xab\xe5*^\xcb\xb7\xdd\xee[\xa6w\xe2\xda\xa6\x1f6\xea\x8e\xb8\x91\xd9\x9f(\xae\xc4\xad\\{\xd6H\x88\x0f\xd7D#n\xc90xI\x8a\x80[_\xdc\x1ea\xb2L\x1f\x06[X\n?\x0cv\xfb=\xb8\xb2[Z\xb6X\x19\xd4s\xb9\xb3RT\xb3\xe5V\x80\xec\\\x7f,\x1e\x12\x0c#\xd1\x1e\xde5\xb7\xc4\xf2}Bc\x9a\x05\xd2\x11\xaf\xafE$\xde\xa9&}j\xa3[\xc0\x9a\xd2\x90Di\x93G\xed_MO\x06\\\x95\x9eD\xda\xa0e=m\x83\xa1\xd8\x05C^%;\xbdJ\xb6\xcc\x81\x162\xb3Q\x8el\xb0\"\x90E\x11\xca\x1b\xed\x1a\xdd\xbc\xf1\xc7\xb1m\x19\xca'\xba\xfc|\xed]\xe3v\n\x85\x9a,\xf8\xd2\xfa\xfe\xda\xbb\xa1\xbe)E\x9b\x0c\xb59p)w\xfd\xcb\xf3\xfb\xf1\x9cvb!J\xf1F<m\x83{\xab\xb5\xa5n\xed\x9b\x83\x89\x90\x0cz(-\xf4\xb5q\xda\x88\xd9q\x8d(\xd8\xa3U$\xac\xe19x\xb7\xe2\x93x\xdd\xed:\xeb\xcb\x17g\xafU\xbb\x7f\xc2\x84\xe02\x85\x88\x0bj6\xbe\xb9\xf6\xc7?\xb9S\xf1_\x9a\x08\x96\x0f\x19+a\xea\xb0\x18BBd\xd2\xb6\x9c6N\x07\xd1&\xcb\xc8\xfbk\xd8\x9aA\x8c\xc9=\xcf`\xae\xc7\xa4\xe0\x19\x0c\xdd\x19$\xbe\x99\xe6\xee/LR\xe8L\x92\x9a\x89\x10\x9c\x97/\xfe\xca\xb0\xfed\xf8\x8e['@\xde7\xcee\xa3>\xff\xf1\xba\x97\xf0\xd9\x01,\x15\xff\xc2\xba\x98\xbcw\xcc\x1a\xd6i\x12%\x08\xc06\xae\x07\xcb\xb0\xba['Q\x1a\x82\xe9\xd8\x84\x99<\x1b\x89\xd85\x1f%K`eiO\x03\x0e?*:\xba\x92\x81\n\xc5\xa9\x0c\xc9\x8e\n\x1a:\x12\xf0\xcfN\x13\x18\x0b;M*Xyp9\"o\xde\xec\x00\x91\xa0\x80\x82\xbfs\xccY\x89\x93\xc2\xc1(\x13\xb0\x96\xb8\xf6i\xcb\xf7\xe9l\xfc\xe8\xa5\xfe\xe4;\xc7\xa4\xda\x1a$\xf8M\x8f\x8b\xa9j}:I\x83o\xafg\xb2>\xf8A1\xc5\xafT\xa4\xf27\x8cj\xa1D\xa8l\xe8t\"@(_\x1c\xa7!\x18\xe38\xc9\x92:y\x91O\x93i8\x9b\x81;\x86\xd4\xd5\xea\xfa+w\xa6\x9a\xde>\xd3`h5\x983<(\xc4\x893\x99B\x1a\xc1\xd8 LS\x9eh\x15\x06#\xa1\x14\xef\x80\xdeL\xf6\xfb\x110\xaa\xb9\xc9\xfb\xfdQ\xf09\x96\x87@\xcc\x0ep\x17\x88\x1c}A\x9d\xb6\xa1\x9d\xfei\x99\xbd=Q #5\xa9\x16\x03\xaf\x08\xa72j\x86\xcb\x00]\xf9?\x14\x8c\xe4\x96\xf8\"\xd7pn\xa2\xf6\xd2\x06\xda-\x81\xa3\xf1}\\ ^\xc4\xbf\"o:\x14#G$\xfc\x9d\xbdNP\xb6BO\xe6A\xd3\xccQ2\xa5\xbdM\xc7\xbf\xf7\x01`~T\x10\xfem\xe6k\x86\x0d\xea\x13\x8cE\xd3\xb5_m\x8b>\x9d\xaa\xbb\x83\xd8\x83\xcfu\xc8n\xe4\xb7\x7f\xb1\x91\xf6\xbb\xac(\xd6\xddn}&\xe5\xb7\xd7\x93\xfa?t3\x03\xdb\xd8\xfb\xfb\xa8\xe5\xd3\xect\x1e\x81\xeaL?\xfeeO\x11\xd4\x87ai\xcd\x8e)\xe5\x03\xdb:\x1f\x15\x86\xb19\x08\x8dI\x1f|\x1b	\"\x0b\x14\x9e\xb9)\xa71,\xfa%\xb2\xfcXS#}R;\x01>\x9b\xb9\xfcb]\xbe\xc8\x0fb\x9en\x938\xf8>\xb2\x86\xf2\x97\xd3CI\x16\x88\x0d\xa1\x82\xed\xdf\x11\xad\xd2MK\xa7\xdf_\x0f\xe6i\x1e\xff\x84\xfcD\xa3\xc0k\xfb3\x8e\xf3\xa5\x9b\xa1y\x04\xfd\xac\xa6\x07\xf2\xc1\x99\xd5\xa0\xdfx\xb7rf\xbd]e\xa2\x919e\x03\xdf\xef\x91l\xabJb&\x9es\x87R\x992\xaek\x84_\xf4\x1do\x83\x7f\x1b\xe1\x84\xaeh\x92\\\xc94H\xae,\xba\xfd{t\n'\xban\xb84\x83K\xc2\xf2\\b\x02`\xaaW5\xdc\".\x9a.\xc7\x99\x89\x8d]\xc8\xbf\xaf\x81P\xf2G\xe4m\xd0\xa0)\xcb^gp\xf2\x8f$_\xfbl\x9e\xc5\xdc\xcf\x9c\xe4;\xe8\x18	A\xd8\x00\x99# N$\xbf\x8a\xbc\xb9\xc8\xe4\x0f\x11\x02\xc8\xf9J\x9a\xab\x01\x1f\xedb\x05\xab-\xd6\xb2v\xb8Vti\xdd\xed\xaey\xc3h\xd8\xe4\x9f16\xb8o\xe3\xb6\xbd\xc6A\xbc\xb6\xc6\x1e3\xa8\xa5\xc8/\x8dJJ\xc1\xbb\x17\xa6\x8b~\xb7\xab\x86\x18\xb1\x9f\x96\x88W#\x16d&\xc3\xc0\xdc\x9a#\xe2\xd6\xbbmg\xea\x96\xd9\x84\xe1\x0f3a\xfaPRW#\xb3_b\x0b\xb4\x92\xfe^g\xebe\xa8\x1f\xac1\xb4\xfcN\xdc\x89\xf7Bl]\xd6\xb0\x97\xdd.\x00AT\xacj\x0d:\x01\xf88\xc4\nl\xa7\x904\x1aRh>\xbf\x91\x0f\x1a9YBBX4\xbd\xf8\xc1\xda\x93\xff@\x8f\xb8\xc9.\xf8\xbd\xf5Fu\x02\xbe\xbaV\xa2\xb3 \xb8\xfb \x14\x89\xfc\xa6\xf6\x12K\xe2\x80\xe0\xed\xe8E\"\xdf\xe3EJ\x1b\xa5i\xcdW\xee\x86d\xdec\xd2*\xa4nc\x0f\xfd\x83?c\x110\x8e~#\x9dw\xc5\xa4\xdc6k\xdf\xfdl\xa6Q]\xd8\xd5\x155tV`)k0Jzh\x94\x17\xcf\x99\x04\x00SV\x94V\x05&\xc5\xb3>\xe0\x11\xdb\xef\xad4{\xc0\\\x88w\x16\xf8\xaa3\xf0-\x85U\xcbZ\x89\xe1\x96`\x87\x94\xe4P\x81\xfe\xeb\xeb9\xef\xc6\xaa\xdb\xad\xae\xe0\x04\xd9\xedz\xf8#+\xbd\x10\x10\xa3\xfc%\xdc\"\xe9\xcdh&3m\x10\xc1uP\xf3\xde\x86\xebu\x9a/^)\xf0a\xe5g\\\x92PR4u\x06\x1b\xa1Z\x19 *Z\xbeH\x0ec\x1e\xa13)#\"\x9b\xfb=@\xd4\x92-R\x96\x88\xac\x11\xd3\xafI\x046\x025(\xaf6\x15\xf6$,;\x81w\xf4F\x1f[\x00)\xc6\xb9\nf\x93\x15\x8e\x90\x8a|\x7f\xed\x193\x86\x17\xf1\xe0\xfe>.o\x9c\x1e\xc8\xa5\x88m^\xba\x8e\x9d\xb5B\x9dn\xf6\xeco\xd7\xa4[\xd0\xfe\x1b\x13\xa5\x04I\x04\xf5/H\xdb\x82\x9e$\xb6\x16\x8f\x99BM\x07\x9a@\xef0M\xc7x\x88\x8d\xe4!\x821\x0d\x9c\xe4\x8f\x1b,\x96\x92\x07\x8d\\\x16\xf3\xa0\x93\xc6\xeag\x89\xb1y\x13\x7f(\xd4\xb9G\xda\xad7\xb1\xe7\xc3\xfe\x0f7\x16p1\x1a\xcfH\xcd\x8c\xf1\xe9\xec\xf8\xfe\xb8B\x9c\xa2\xe2\x98\x81[\x9a[\x87% J\xe3\x16u\x13!\xfa\x03\xa9f\xf5\x9e\xcc^P\xb1M\xac\xcc\xb1ThT+\x11J\x8dkE3VIo\xee\x9chd\xd0\xea\xfd|\x8d%>\xa7\xdf\xf5\x0d\x14\xc7\x1b\x19\xc7\xde\x1c'\x95\xb9\xd6Ub\x03\x7f\xdf\xaa\x9f\xc1\"\xb3\x9f	\xb7$W\x87kIS\x82JX\xb7\xd4`)\x1a\x81''mH\xbf\xc8\xc7\x91W\xc8?\xae\xbd\xa2\x7fy\x1e\x89\xa1\xef\x9f{!\x9e\xc3~\xd4\x8f\xf1,\x162\xc7\xc0\xcc\xc5\x9a\x07\x07\x83\xb2\xe0\xd5\xc2v\x89\xf2i\x1bDb\x17\xc4|\xc3,\xf4\x0d3<\x88\x07\xf9\xc35I\xf0\xc4N\xc2Ev\x8b\x8b\xc1v\x00\xbc\x03D\xc1d\xe5\xdc\xbd\x1c\x8aG\xa9\x8b\x1d\xdf_=\xb2\xa2\xee\xa3\\L\xefg\xe3\xad\xba\x1e|D\xe07|\xda\x93\x1f\xed\x19\xa04\xea\xd0\x9d\xacbo+\x1e \xb0\xfbc\x13\x96\xc9{\x9c\xda\xfe\xf8\xee\n\xaa\xe3\xfb^O\xec\xe4\x9d\x1fx\xaa\x05}\xb9Uf$G\x85\x89L\x15\xb3\x12\x99@\x94!\xea\xc6\x8agy\xc5s\x8c\xd6\xb08]\xf7H\xf5\x92L\xec\xf4\xbbn\xd7.\x8b\xf4\x0e\xaaooy	t\xb4\x1e\x90E\xe3D\x03\x99\xae\xbd\xedv\xd7Wo\xa1\xa1\x00\x198|a\xbc\xee\x81\xfd\x1a{\x18.5S\xbd\x91\xa3@\xce\xe3\xd3\xec\x96\xae\x9cTu\x15\xdc\x1d\x8b\xb2\x1ew\xc2*\xd26Gqb~C2\x0b\x9b3\x1a\xeb\x8c\xa5\xbc\xb8\x91\x85s\x92\xccv\xbb\xd6\xc3\x95\x0cY\xd7|f\xd8S\x97\xfb\xc1Z\x08c\xaf\x94\x0b\xaflX\x02\xc3[\xba{\x0f\x02mm\xfbQ\xe2`)\x85\n\x16\x02{\xcd\xcd\xa0\xda\xac\x9e\xaf$\x9d{\x94C\x92Cq\x8a\xb0U\x9b\x95\xa8`|\xf2\x17\xbe\xd6\xa3\x0e\xadI$\x8di\xd2\xf2*\"\xdfn\x1dJs\xba\x9c5T\xc9\xbf\xa0:\xce\xd31\xa5[V	X\xabA\xdch\\2p4\\&\x1c;\xac\xac\x8d\xfa\x01\x86|\xd8\xb5B\xe7\x84\x02\xab\xd5\xd2\xd25&\xb0\xcf-\xeb\xe0\xe4b\x1a\xc2WX\x0b\x83\x04\x9ag:\xb4\xff\x8b\xd4t\x9d\xa1\xec\x88\xcc\x9a5J\xb01,_\xadd	\x19\x7f\x7f4\xce^\x02\xd5U\xfbJnd1\xa5\xc5\x04c\x97I\xd9\xcf\xfa\xa3 \xb3\xc7i\xbc\xb9H\xcf\x93+\xd8\x14T2\x13i_n\xf4\xd04\xdf\x15ZW:\x14e\xbf\xf2\x03\xf3\\\xd1\xb3\xadf\x0f\xf9\xb8\xe3.$\xdd\xee1\xa6\xb0\xb9\xf8\xe9\x1a\x92Im5\xa9o\x9d.\xceQ\xe3\xf7\xedl\xfa\x08\xc5\n\xca'vQF\xc8\xda\xb7d~\x81\xf5\xbbo\x99\x1fP\xc8	\x0b\x82\xb4\xe4\xf9\xb0u\x84\xc3\xe7\x94\x83y\x8f\x14\x83v\xab\xac\x13\xea\xc4\xa9\x88\xad@\xa1\x84\x98\x82\xc3\x1c\xc9D\xfe\x04\xc7\xedWr\xea\xb8\x14Ye\x8b\x84\x11\x10x\x04fB\xf7:\xc5E\xcc\x98=4&C\x95\x9c\x82\xea\xf7G\x17\xc3\xd9\xf8\x1f\xae\xb0K[\x03XcZ\xfa\xe3\xe4\n\xe8>X\x0d`\xe3\x12_$/\x01\xf3C	\xa3\x99L\xcc\xbd\xa5\x92\xd3\x1f\xc3\x1f\xc5\x8f\xe1\x8fZ\xad\xf4TmVAn3l\x95E\x00\xab\xe3q\xe5QE\x0b\x15\x96\x83A:.\xaf\xaaq\xd9\xeb\xf9^.\xebi\xe9\x9a\xb1c\x03\xfb\x10\n\xd0\xa2\x0d\x81\xcb\x93\xbf,\x94M\x0c\x1b\xc4\xc1\xd8\x18\xd9\xce\xd5\x1f\xd8\x88\x9e'\xa0\x05l\xb22\xf9\xe3\xda\xdb\x9c\x17\x17\x99\xc8.\xbc\xcdy\xe8\xfb\xc1\xe8\xc22^\xc9\xb8\xad6\xec<\xcc\x91\xd9\xb6\x0f1kD)G\xfd\x100\xcd\x9dmGtv\x1d\xe8\xd8\xa6\x1d:\xa1:\xa2\xa3N\xa8\xce\x8c0)*\x12\xde-i\x99\xa3=\x17I0\x1c{\xc5~\xbf|\x99N\xb3i9\xc3e\xd1CPu\xf5`\xb6}$\x87\"nF%\xba\x8a\xc7\x91\xa6xsYO\xa3\x99\x00\x96\xa6X\xc8\xe5d~4H\x17K\xb8o\xcb\xb5*\x15|\xc5\xb2\x7fy\x9eC~\xfd\xa0[\xd5C\x03\xc2\xd9\xac\xbf\x11;\x19I)c\x98\xba=\\-&\x0f\xc1Bl\xe9\xe3P}\xbc\xe3\x8f\xc7\xebi\x85fR\x11\xe5l\xd6\xfb\xe1\xda\xcb\xc5\xea\xe2\xd2\x17x\x83\xdc\x1b\x95\xb6E\xda\xa6'wbnQ\xc6\xb5\xc0Q\xaa?\x96K\xc1\xdd\xeeK\xcbk\x7fsb\x06\xe0y\x0f\xc5!4A\xa2\x94\xd3\\\x14t\xb4\x9c\x85\xfb}\x88\xd9\xd1t\xb34\x03X\x89L\xe6\xe7\x85\xd8\xc8\xec\xbc\xd6\x82#`\xa7|(\xa0\x05\"\xeed\\9n\x9e\xe3f\xe3\xab\x03\xa7\xb1\xa1\x14\xb1\x1c\x8a\xb9\x8c\xf4|\xc4W\xf3q\xdc\xeb\xf9\xcb\x9e\x8c\x10u\xb9\xd9\xa9\xcc\xdd\x85v\x12\x1f\x95\xeb\xa6\x95\x9bs\xb9\xbcX\xb33\x8ac\x0b\xbf\x92\x0b\xcd\xaa\x8e\xbdMO\xfe\xed|u\xbe\xeay_\x9f\xafz\x86C]	\x8a\xd5\xed\xfb\xca\x1bc]<z\x1b1\xf8\xbb\xef\xbf\xfc\xed\x0e\x97-\xf9\xdb\x9d/BY\x1d6W8\xe36\xfa\xe6\xf5 \x9b\xfc\x17\x19\xbe\xe0}1\xcd\xcf\x1fDq\xfe`I\x02\x97<\x0d\xec!\xcb\xfb\xdc2Y\xd4\xf1\xb6\xb4\x9a\x8a\xa4\xeagf&r\x96'\xa7j\xd28\x82\xb7\xe1\xdc\xc1\xe0\x87M^=\xee\xa5\x9c\x86\x1a\xca\x15.\xbf\xb4\x97..\xc1\x1f\x15\xe3\x8aYS\x18\xb7Z+~\\\x12\x1e[6P0C\xf8\xb5\x13\x95\xe3O\xc0\xc7\xda\xd36\xa8u\xe9\x84u&vAm*\xe9\x03\xa4\xc8\"[\xd1\x89\x85\xe8\x98\x1c\x02{pZ\xa0me\xb7[B\x80G\xdd\xf2JH\xca\xf6\xfb\x02\x0b\x93\x17\x8c\x12\xd1B\xe1\xecp\nZ\x80\x06\xdcO#Y\x0b`\x01q\x96X\x91\xfb!;r\x15\x93A\xd5\\%\x14\xaa\xc4W\xb5\x97\x0c\xb6\xfdp\xb0\x15\xc9`\xd7\x0f\x07;\xc1qd\xe0_I\xe3\xe8\xe3\x1cpY\x97#31\xeat\xa6DU\xe0cm1\x14\xec\xb3\xcc\xf1\xce\xd7\xd9\x1b\xd8\xafa\xbd\xa67~`\xd1\xd1\xb9\x95\xb7s\xff\xcd\xcd}\xa7g\x9d\xb4kf\x15HT\x9a\xc6\x1c\x19\xb4\x9et:\x01Kz\xd3\xfc\x9bxa!s\x14\x9b\xdaMH\x9c\xa7eQ\xd5\x14|\xa4\x01\xf8\xa0#\x9eKN&\xfdQ`\x89I\x17fqSv\xac\xd0\x91\x86\xfc\xc0\xc3\xa5F\xcfj\x17\x94\xa2 \x8b\xe7YY\xdd|\xa1\x1cF{\xf5 ZoG\x06I\x9a\x9ew6*\xe4\x83\xf5Q\x07\x03\xd3\xeb\xc0\x86\xab\xd3\xe4\xd8\x99\xf6a\x829\xea\xe8\xe9xN\xc5\x89\x975v\xff\x89\xe4^G\x01I\xf3\xe5\xa0\x91%\xf9O;/\x84\xfa&\x94\xd3P\x843\x1d\xee\xe7M\xe6\x15\xa2\x1fN\x87\xb3\x8bK\xfc\x1d\xe1/\x1eE\x08\xdf\xc3\xdcpb\xa5\x06f\xb7\x1d\x17\xb6q#\xf0\x83\x00%\xbaaKO\x92\xc1t\x0e\xe6\xeb{\x80\xb0\x91K\x13\x9an\xcd\xd3=\xaf\x136}D\xc5\xb9\x04x\x17\xba=\xbd\x9c\x8d\xeb\xc1vDv\x97\xa2\x1e\xec\xf0k\x84_\xdbKRA!\x0d\xbf(m\x9d\x94p\xcf\x92#A\x90\x18\x98\"	 G\x81\xdf;\xfc\x1e\xcd\xfc@\xbf\x01\x7f\xc3/~\x0c\x7f\xb4\xe6\xee\xd1\xcc\x8c\x13j\x85\xd1d\xa22	\xeb\xe46\xcd\x13\xce\xd5|\xf816v\x8f\xf7\x110-\xea\xfd\xfe1\xb6A\xdc0Dvx\x83\xbb\xd8A)x\xcd\xf0\xd0\x96\xbfr\xea\xb3\\\xa4\xa0\x8fkU2\xab\x9d\xc6\xc9\xb1\xe3R*\n7\xa0{a\xef\xef\xb7'/\xaa\xa6\xd2\xa6\x98\xdc\x1f\xbf\x8e\xbd\xb4\xd5\x9a\xc2\xf7'^9)m\x07Qe\x9a\x14\x94N\xfbT\xa2H\x8f\xdb\x07\xc9\x96\xd3\xbe\xd2\x07\xd6\x88zf\x17\xa8\xd2\x1a\xd47\xd6\n\xab\x1b\xf4\x1e^WO\xc6\xff-\xb0}\x91\xbf\xe0\xe5\xac\\\x98O~e\xccS\xbe\xf0y\xe3\"\xedV\xc8\xb6n-\x07\xe9g\x8a\xc7{'\x9e\xe9\x87c\x1aS\xdb\xb0\xeb5Vn3&\xaf\xad\xecg\xf8\x16y\xbb]\xf5\xd3\xc9y\xcd\xf3m\xddw`\xb1\x8eM\x11J\x94\n\xbc\xbf)\x1d\xbe`\xd43\x99\x9e\xa7b#\x07#\xb1\x94\x83\xd1xy%\x07\xff}\xbc\xec\xc9\xc1\xc8_\xdc\xe0\xce\xb0\xbb!\xdc|E!\xe8|]\xfabq\x83\xdb\x03\xbd\x1a1\xdd\xc0A\x8bW\xde\\\xde\xdfx\xdb\x1boq#\x12\xbf\x9f\xf9\xfe\x95\x12u\xccE.\x97\x0e3<\x8e\xae\xbe\xbelX\xe0X\xe6\xbd\xcdx\xf5L\xad\xb9/V\xcf\xd4\x9a\xfb\xe2\xe1\x99\xafbzu\xf2\xabX\x07\x99\xdb\xdex+\xd5V\xd0\xd0\xfb\x1bo\xee_\x0d\x86#\x1f\xd6\xb7\x9f\x98\xf5\xdb\xdex\x0f\x9cgs!/\xc5\xfcj8Y\xbf\x94\xc3I\xde\x93\x9b \xef\xcbM\xa0\x1e\xf1\x0bi\xcc\x9d\xbc\xb0\xf0\x0dn\x98\xf0\xf1\x9b\xda6\x13aWM(\x07l\xb9$\x8b\x97\x9b2n\xe3\x96\xa1\x1cr4{\x0d\xa6,\\\x0d\x8b\xbc\xa0-\xc1/)\xd5\x9dj\xe4\x8b\xe2\\\"j\xc8 .\x1e\xf3u\x16\xee\xba\xdd\xe67\xb0\xc9\x06u	\x04\xbc*\xb1\x9c\x9d\xfc\xa7\x0emX;\"y\xdd\x98\xa4\x98\xf6\xc3 \xc2j\xee'\x87\xd6s\xcb\xc4q\xb3\xc7\xf9`\x99.\x96\x198\x9an\xd7z\xf8\x9fn\x8f\x8bb\xf6\x93\xbd\xed\xea\x17i^\xd5\x00[)\xe6/\xae\xcb2\x84\x03K-\xa7\xb5h\x851\xf9\xd1\xa1HGx\xb6\xe0\x0f\xa1\xaa\x80&\x12\x82\x99\x84d\xf5\xba\xd3MW\xc1!\x13H#\xb8Wglu\xd1\xcf\x08\xa4\xa7=V\xae@q\xa3\x7f\xef:\xfe\x0cR\xbcwdT\xd54\xf6]\xdc\xa0A\x82\xbb\xfa\x93\x8a8R\x85\xa6\x7f\xe4,\x99\xe4I\xa5\xa27\x8aT\xfe\xea\xd5\xc4>\x8dl.\x19s\xa8_\\\xba/\n9ME>\x1b\xf7\x12\xe8'H\xd0<%\x9c\xab\x1e\x8eo\xff\xe2\xb2\xef\xa5&\x94\xc6y\"\xe8\xa9\x97\x83\x8a\xe1\x1d2\xf5\x91\xdd?Of\xacF5g\x91=\x95\xdf\xfc\xcfLJr\"l\x07\xafJ\xd8\xd8\xd3>\xca\x07\x0b\xcc\x9av\x1d\xc4\xbb\xbb\xcdJ\xcbw\xa0N7\x9e\x83\x17\x1e\x10\xaaL(tPU}%\xe9\xc1\x91uc\x1c\xfd(ag4\x13\xe4iH9\x85\xceA\x00_\x7f\xbaL\xd4\xc5T\xb7e\x1c\xf6du\xee\x95\x93$\x18A\x01\xe2,\x9ee\x83!\x15\xfa\xbdL\xf03\x10\xa4B\xbf\xb7\x99\xf9\xc2\xfd\x1a. \xd6\xf7O\xd16\xc8D\xb4C\xec<Q|aY\x89T\x1e\x91\xae\xe7\x17V\xfe\xdc\xc2*\x9e[X\xa1\xa4\xc5\xd1\xc3i\x84N\x96x\x1e\xe1\x99\x96\xce\xb8\x07R\x97\xc8iv\xee\xa5\xe7\xf2k\xbf\x17\x9e{\xa3~\xea\x8b\xcdy\xda+\xd5\xef\xd6\x8a\x82\xccA$3\xf7F\xf4\xfe\xf8\x04\x95pB\x8aj Z\xf3l\x852\xe7\xf9\"\xd1\xc5`\xdb+..E>\xd8\xf5Bu\xb3e\xbb/\xac\xa1\x87\xb4\xdeM\x06\xa3\xc0<\x9c\n\x04\xc6f\xf9\x08\xb65\xde\x0c\xd6\xfb\xbd\xb7\x19\xac\xe5?\xbd\xe2\\!\xe0\x96a\x1e\x03\xc7\xb7?\xf8\xbb\xdf\xa3\xa3-<\xfdf4\xf3}\xb1\x19\xac\xd7\xf2W\x14\x81\xdf\xeaZ\x85\x16\x91!\xfdR\x0e\xfeSsS\x8fa\xb9\xfae\xdd\x98\xf1\xf8OK9\xf8\xfbATI\xfd-Y\xe0\x987\x89\xffTO\x93\xd9\x80\x0cs\x00]\x8d<\xbf\xe4\xf3/\xe6\x1a\x1dDU'V\xf9\x8e\x1cv\n\x96_\x8b\xc3\xb0\xcf\xc6\xe1\x95\x16\x95R\xd08\x96\x0c\xc0Z\x0e2\x9fA>\x1a\xff\xe2\xe5\xc2[\xcbl\x90_\xfa\x835:\xaa\x8e\xf1H\xfe\xe6\xe5~?\x1b\xc4\"\x96\xeb\xc1\xe3\x85\xb7\x19<\xf6\xd6\x83G\x7f\xac8\xaa\x18B\xb9\x18\x82\xfe?\xbc\x1c,\xc3\xd9F\xb5\xb2\xdb}{\x83\x91BY\"\x17\xf1yt\xbe\xf4\xc5\xd9\xdaz\xbb\x1e\xac\x05\xfe\xcfE\xdf\x1b\xf5c\x9f\xb2\x90\xfaH5\xba\xa0\xd6z\x0f\x12\xc1\xeb|\xf5\xe1~\xef\xa1\xad\xa5x\x18\xac}\xf1\xf6\xc6{\x18\xac\xf1[\xe4\xa2:_\xfa\xfe\xb8\xfd\xb9f\x8c\x10^/\x9c\x89\xb9\x0c{\xa3\xf1\xfc\xaa\x18\xcf\xf5H\xace=\x9d\xcfh\x08\xd6\xdcwR\xb8x\xaa\xf3\xe8\xe0\xb7^.\xda\xab\xe2(\x01\x9a\xd0\x91FM\xf16\x832Y\xf7\xd6\xf8\xd7\xbf\x88.\xa2q{`\xa8&\xb4{q<,4.x\xd7_4\xfa\xb4\x15f\xd6\x1e\x196q\xc7\xe8\x8c\x1f\xac\xe1Y	\x1e\x14w\x84V\xe0#\xbf\xc3\x80\xd1\x88\xf9\xfeay.\x07\xff\xfd\xbf_\xc2\xf6#\xa5\xdd\xb9$\x0e\xcd\xd6\xc8}>\xc5\x19\x1d\x05\x0b\xe2u\xff\"\xd7A\x83R\xf1s\xe1\xe5\xff%P\xbcW\x0es\xc3\x1a\x1d\x86\x0b\xc1\x01\xe9\x14VH\x07\\\xcf\xf65\x87TZ\xef\xc7h\x1b\xbc.\xe8\xb8\x14\xf6\xe7>\x080\xbd\x18\xcd\x84S\x90/J\xbc\xe0\xdaK\x0e\xbc,B\x84Gl\x06\xe7\x9f\xdcT\xee=`F;U\x8dh^\x1d)q0\x16\xf3\x17\xc9\x04\x86\xdce\xb2\xce\xc2(\xf1:Ot\xb6\x1c:l\xf8QO\xea\xa0\xd3\xf1\x83\x8e.\xd3\xfa\x10V\xbd\x12v\xc9\xbe\xc36}\xc7\x95\x9aV\xa4n\xf0\xd3\xff\xbf\xe2\xa6\xde\xdf\xfd\xef\x1e/\xf5\xd7\xf8\x7f!\x96\xe3\xb7zZ\x98\xde\xe0\xd8>\x8d\xdf\x0d\x8eh\x15\xae\x895v\xec\xa4\x9b;\xcc\xc1\xc4\xa5\x86\xee*\xa1\x10j\xc4n\x19\x15\x103EF\x07TL\xcb\x99,y\xbd\x9fZMPO\x16%\xae=\x81\x11f\x11/\xf9|,\xd3\x10\xc8\xab\x88\xce\x96\xcc\x02Xn\xf7\xf1\xe8\x1a\xaa}o\xb1\xc5\x0dK\xac\xb9t\xed\x1f\xd7\xc0y)\xf68\x01\x9bW6\\\x8dvqC\x8a\xe5\x9a\x02\x8e\xef(\x17\x84\x08dhe\xcad\xae#\x81e\xcaF\xe1\xe5W\"\x82\xb9N\x9e\x90\xb1hE\x06O:\x1e>\x9e\x98\x9cH))jg\xe4\xa7R\xa7\x01)d\x9aKo9\x1d\xce ,_\xaa\xb0\x9b\xbd\xa5\x0e\xbb9\xfd\x1a\xe9\xfe\xc5\xdfD\xc1\xb9F\x9ck\xc4\xb9F\x9c\x0b\x0c\xd4\xdff\x02\xec\xd0l\xd6\xa8\x1b\xe1'\xb7\x16\x0b\x99\xf1%\x81\x0e\xe6\x8e?V\xc0Jh\xd3\xc4\x8bQ\xf4\xd7\\!5\x04\xbc\xd9\x9cS\xa9\x82!\x82\x83\"5\x97\xdeZ\xc6\xfd\x85\xdf\xff\xbbH%\xe32\xf9\x81*\xc3i\xbc)c\xc4e\\\xbae\xf4\x16~\x8f\xca\xa0\x96\xa8\xcd\xbd\x92\xf31\x86$\x16\xf3\x99\x98\xae\xc5j\x06\xf6bu\xd8(\xf1\x8b|j\x02\xa0\x06\xa1\x80'\xfa.(\x9e\x81u\x12pkQI\xa9P3\x12D\x07\x9b\xf8\xff+\xd6\xc1w\xc8\xb07\xcc\xb2\x84\xad\x8c\xe1~I\x0e\xe9\xca\xf3\xc4\xd965E\xf2R\xb9\x1d\xeb\xe0\x84\x9d\xaf\x8d\xa50e\x91\xd3\xa7\x83c\xf2\xfe\x0b\xd5\x1a{w\xa9\x95\x0b\x11\xddm\x80\x7f\xd2\xee<z:\xe2\x14\x99Gs\x9d$?\xc7\xbd\xae\x90N\x11> Z)\xea\x8aNA\xa1\x1c\xae\xac\xdb\x85\xd5\xfb\xc9W\xb0\xe6q\x86\xe57&.\x8d)e\xbf\x9e\x8e\xfa&f\xcfS\xb5\x0e\xf3&\xc2q\xea\x8b\x8a\x06\xf9\xe5\x90\x84\xf0W\xc3\xc9( \xc1\xfe\xc8\xea\xf5\xbf\xb9P&:\x06\xfb\x9f\x84\xc6F\x81F\x92d'\x9a\xfe\xef\x86% \x89\xec}\xb8M*'\x9e&\xa5\xa8])\x9f\x0eGQ`k\xeb\x81\x85\xc1p2\xe4\x9f&>+\x07\x8aMk\xae\xadi\xf8\x1fn\xc3\x7f\xbc\xf1\xde\xdd \x05\xdbR$\xae\xe0\xee\x07\xce\xcbbq\x06\xb6\xb9\xa5=\x07\xa4\xcep\x9bV7\xa0\xa5\xfd\x91\xe1\x164\x01\n\xd2\xf3Z \x03|7\xaf\x1f\xc24C\xc41:\x10\x82\x94^\x90\x8b\xee\x1d\x82\xb9\x9e\x0d\xad\x91\xfd\xca\xae\x14D\xb0U1\xa9\x1c\xf0\xbd\x02\xf6\xa1\x12E\xc9i\xd4\x1aQ\x99\xa7,\x0b\xd7\x95\xaa\x95lN\x1f\xd3\x9c \x1e\xdf\xe4q\x1a%\x95\xd8\xc8J,\xb19\xf4\xe1q\x05?\x85\x89\x97\xca\xfa\xbc\x12\xb9\xac\xfc\xa0\xbe\x92\xd9tDin\xbdy\\<\x0e\x7f*\xaa^}\x1e\xf6\x8f_a\xea72D\xf1C?\xf0RY\xf4\xbd\xb2?\xea\xd7>\x97lA\x07\xa6\xcf\x8d\xd4\xa65RKr\xa8\xff\xc0\xfe\xbdo\xc3\xad\xca\x96[\xe3\xf7\x0f\xda\x8f'b\x11Z\xa1\x0f\xed8\xc7\xbct>\x96\x9bj	\xf5\x90\xfd\xfc\x8e\x1c88e\x1d\xe6I\xa6\x17]]\x86\xd1'\xa3$3a\xc9\xf8m\x04~\xa9Q\xaa)}\x05\x05M}H\xcaV\xdaO(\x96\xbfSA\x0f\xbf\xd3\xd1\xc28u\x93\xc6\xb2C\xed\xbb1\xb1j\xa0\xce\xba\xed\xf58\xc72\xcc\xe3,)+\xec\x98oo\xbc\xe4\xb6!=\x8d\xfe\xcf\xe4B\x98\x80\x15\xfb\xcap\xe8>k\x83\xfcl\xad?\xe0\xaa\xdc\x7f\x066w\xbbi\xfb\xfd\x87\xa5W\x88\x1fn\xe0\xf8\xbeIc\x1f\xf5\xd6M\x1d\xad\x06\x14\x88\xa8\xc4\xf1\x15jk\xb0e2\xb0\x06\xde\x19u\x99{\xa9\xf7\xf3\x8d\xaf\x18\xba\xa6}\xf5\xdc\x162\xa1u\xaf\x11\x8e\xe3/\xb5D9\xc3\xcd\xe7P\xd2\x1d5\xa5]\xbd\xe3\xff\x9d\xcc\x9bQIez;\xb5\x1a>\x1b\xd0\xfc&4\xbb\x94Mo'\xc0\x0b\xda]JD\x88\xba\x13W\xf9\x92:\xe6]\xb9SS5\xb7L\x99\xd3A\x92\xc77\xbcn`2i?\xd3W(?\x11\x89[\xad\xeb\xbaR8\xe5\xb7\xb2\x8e\xa9>f\xa8\xa9?\xca\xc3\x92\xa8(\xc4\x1a\x0b\x97\x9a\x86\xad\xc2>\xb3g\x03\xe4>\xa9\xfc\xf7\x0d:zRT\\\x0f>\x13\xf8\xf0\xe7K \xbd4\xb3[r\x81n;V+\xe6.\x05\xb7\xbe\xf9\xa0\x9a[\xbcoz;\xad\xdd\x0eY\xb3\xd4|\x93\xcd\xdb\xf7R\xde\xdcc\xdb8\xf0lH<L\x01%\x0dmx\x15\xc4Z\xcf\xed\xb77\x9e\x8bk\x03[N\x15g\xed\xd5\xeefSV\xac!$K\xaa\x82P\xb5l\xe6w\xe3\x8c\x9c\xdb\x82\xd4mA\xde\x9e\xa5\x01\xe5}\x13\xb7\xdc\xb8\x10\x12(\xb7MK\xdc\x9d\xa2\x88\x92\x05\x0eo\xf5\xc4\xb2Z\x83P\xc9\xd5\x04& \x12\xbeq\xcd\x93Cqvf-\xd8\xc8\xeaI.\xbf\xbf\xf1\x9a\xbaL\xa1v;\xec\xe9\x11\xb9L\xbdD-,\xbd\xcc\x9f\xcc\x94\x056u\xe0>\x07\x89\xee=[\xf0\xe7\x07\x84\x1261\xc4\x14\xa1\xec\x88\\<\xa5\xd57y\x1c\x9c\xc1\xc4\x03\xbf\x84Rl\xbe\xcbo\x10\xd8\x8d\xd2\x9d\x14{\x19\xc6\xee\xd0\x11\xb5\xa7\xa9\xd1\x83\xa0gh\xc4 E\x8eA!\x04\x0d:\x06\xb6\x8eU\x1dJ#b\xe7~\xbe\xf8\xed\xc6+\xce\x8b^x\x1e\xc2D\xe8\xe5\xef7M\xfds\xa7~\xe3\xc2\xca\x1f&W\xc3n\xd7K 8\x9b\x92\x1a\x11L\x9d5\xf1k^\xdf\xda\x8f\xbc\xe0\xa3N\x7f_\x10\xd9A\xda\xc7;m*\x80\x08G\x1d\x06\xc8\xdc\xcdA\x93\xaa4Kr\xd8\xe5\n\x130\x15\x0f\x11-\xed\x80\x02\x94vD\\\xa6\xf3:\xf8\xf5\x86=\xd4;y\xf5\x88\x1bV\x91\xe3\x9b$\x8f\xf1*\x9a\x8b\xc4\xcc\xc6\x10A\x8e|\xe1\xee\x9e\xd4\x7f:\xd1\xa6\xf4\x18\xaf\xd3mJ\xd3B\xcb\xa8g\xd8nS\xfa\xe7\xcdq\xef\xa5\x8b\xe3\xe1S\xebP\xa9cpg!\xb6\x03L{(\x7f\xb9\xf1\n\xf1\xc7\x8d\xb2R\xa2\xdb\x96\xa8h\xae110\x18\x86\x14z#+\xfa\x0b\x0b\xc4!\xeeM\x00\xd2T7)r~\x08IV=\x97\x11\xffZ\xcbe\xbf\x14\x0b\x19\xf5+\xb1\x92\xeb^!\x1e\xe4\xa2W\x8c\x1fT\xd3x\xb6\xe0?\xb7\x86\x08\x90\xf4q\x8a<al\xba]Og|\xec\x10\xa2M(\x1e|\xa1\xd30m\xed\xb4\\\xe5[\x89\xb0I\xab\x906w\xd3rS\xa0\x9517%\xda_S\xcey+\x91r\xaaDk\xbb\xad\x1cb\xd8\xa2v\xa0\x11n\xffHrGf\xfd\xd7\xb57\xf4\xc7\xc5`S\xb1q\x00-](E\xc2\xba.\xbd'\xbdBr\xbdl\xf3\x89Z\xb8A\x87\xc1f\x10\xe7\xeb\xdb\x1bo\x8a\x91\xc2\xc8`$\xd0sj*u\x82\xba\x87\x8e\xdb\xc1\xa4\xf4\xc6\xe2\xc03\xef\xe6`\xf3\xb1kB\xf9\x88\xce\xa4\xbe\xba\xbb\xb5\x9ba-T\xabM\xff\xb8\x99\x86\xb3^\xa7_&\x15\x0c\x87\x94K\x92\xa3Z\xe391R\x11\xed\x98\xd4\xae\x1fa\xe6\xab\x06\x19\xe1\xc3\xdc{\xa3\xbe\x9c\x92$AL\xf3^(\x8a\x1e\x0cb\xed	\xd8\xd9\xa7(\x1a\\\x97\xc5\xa7\xe4\xc7\x82\xc2\xd3\x06\xd0,\xd4\xd6\x16\xb0>\xdc\x9av\xe9\x11\x99\xfe\x0b\xc4 \xf5\xc5\xbfp\xac\xe4>H\xdf\xf4\x17N\xfb\x85\xd3\x98\x12M\xa7\xc66\x00@b\xdap\xc1\xa6e\xf7v\xcb\x16\x14A\x05\xcc\xbb\xd5\x84G^<\xb0\xfbfR\xf9r\xc4\xf4\xd9\xeb\x90\xd8\xc6\xcb\xe5\x94\xb2y\xe08ai_{\x9d\x8e\xef\xd3\xa5\x8f^\xd0\xcdo\x86\x84\xfd\xbeC*cPm\x1f\x8a\xef\x06\xeb.\x1f\xfc\xbbHs|\xc9T\xff)K\xe6u\x80\xa5C\x82\x93\x00\x0b\xa8.\xd6\x01\x16\xd1\xc7\xa2\xae\x8bU\xd0\xa9:\x07\x91\xcb\x87\xc2{z\x0c\x94lD$\x01\x0bZD\x1et\xea\x02\xf8bAG\xe5\xef\x1c\xa6\xc9LP\xa7\x1b\xb6\xcf9\xd5?\xb6V\x82\xc6\x8f\xcad\xde\xda7\x1bY{\x19\x9f\xadPo\x82\xf5\xc4\xd2\x19\x7f{\xe3\x15\xcd(4\xab\xda\x1c9_\xddL\xeb\xd9x3\xc5)6\x9bbl`F\xac\x1e\x0f\xbe\xe0Be\xe2m\xe7\xe4\x8c\x88I\xdc0\xc9\xdb0\x81\xa3g\xd2|\x95\xa8\x18\xee\xc8\xf8\xab	\xef\xc8^\xddw\xf3c\x13\xd06\xcfC\xfd\x10\xa5|\x8b\xbcXo\xe8F\xe84\x1egaO\x92&\x0ef5\xf89B{\x99\xa9\x14\xc4\xa9\x9cl\xc0[n\x80\xe6bx\x95\x91v\xd1\x90\x9e\x1bX\x19|(n\x8b(\x0465m\xf6\xe7^\x0f\xc5P\xcf\x1f\xadq2\xee\xa3H\xc6d\xdeg\xcd'\xefO\xb3\x7f\x981\xe4\x8f_\x14\x00\xb4\x87\xed\xe0\xa4\x18DY\xba\x06x\x9c\x97\x0b\x9b#\xf5\x83\xd4\xb3\xf1\x1f>X\xcc\x036#O\x10\x86\x04kZ\xa4&u\xc4\xa9\xa3\x99\xae\xefi\x1b$b\x17\xa4\xec\xe8\xf6\x0bg\xe4\x11\xc5\xe7\xfdDka~9.\xa5\x9fZ7\xf1\xd7fPU\xdc\\\xc3\xcb5\xa3|\xff\xb9\x14\x85\xc5\x9c\x86\xd2\xf0\xe6\xec\x9bh\xae\xd6F\x19W\xca\xe1\xb8\xbc\xd2N\xe1\xe4\xc4\xc0\xc4\x10rlw\xd5\x18x*\xe8\xe4\xc2\xfd\xbe\xd2\xcc#\x1e\xf5o\xbf\xdbMo\xa7U\xc3k\xce4r\xa8\x87\x12\x05\x988\x81\xd3Z\x03\x80\x1eB\xd0\x05\xc4R%\x02N4\xd1\xebDeQU\xcb0-\x1d\xab\xb2k\x87\x93\xa3X\xdb\xe3\xa4\x15)\xbd\xdbm\xa7\xd8h\xa07zD\x8c\x84\xdf\xa1\xf9\x8a\x17\xf0M\x93\xdd\x0b\xda-\xaf.\xb3\xafH\xa0\xde\x12HTv\x92\x92Gd\xad\xeb0\x06R3\xc2\xca\x82\xc4\xe0\x87\xf9\x82\xb8\xe5\xfd\xbe$)'\x8c\xaaK\xff\xa9S\xa5\xf9\"#\xea\x9b\xa9\x91\x85\xf8\xbe\xdb\xa5K	;\xb9\xa5^\xe6\x8f7\xcd\xb8\xcbOs\xcfz\x14\x154\xe5<I\x92\xa6\xb0\x9a\xe0h\x0c\xcc4\x9e\xe8\x8d\xa4\xab\xfa\xc6\x17\xe6\x1a\xc2\xe0\xda\xe4\xb3\xc8\x07\xe7\x12W\xf8O\xee\xaa\x14\x95?\x1b\x97\xa7\xa8\x8e\x8a\x17\xa2/\xde\xefA\x88\xd4\xb1Z\xf2>\x8c>\x81\xe7\x80Y\x17\xea.}\xb1l_]K\x9f\xef\xae%\xa8\x06\xd3\xa0\xe2p0\xd1\xdc\x9e\x1b\xaf\xcc\xbd\xa4t\xbb\xbcAr\x9f\xc7\xb2\xdb\xf5\x9a\x02\xdbw\x9cac\xfc\x1b6+\xea\x13\x9f\x98\xac\xf0	75E\x9b\xae'&\xc8\xdb+=%\x81\xa5<\xfci\xce\xf2u7\x14\xbf\xfftm\xe64\x91Xp*r}cr\xfb\x0c\x8d<\x15K\x9f\xac\xed\x8fB\xfd\x9f\x16\xba9\x8b\x98l) \xab\x8f\xb8\x01.\xa8\xa6\xc5E<Y\xd2\x9a\xc6\n\xc2\xde`\xeb\xb9\xf7\xeb\x8d\xf7q\xde\x1c.Z)%\xa7\x89\x98\x0e\xc5h84H\xa7/j8\x977LBzp>\xe3\"\x01\xd8\x07h(\x91\x8a\xa9\xe65gb\xca\xdc\xe6l6\xadg\xfeA\xb4WYp\xdc\x80\xb9%\x10\x02\xab\x05\xe2\x04\x8cz\xd0'\x14\"~9N\x9b\x1dD[\xa2\xe3t\xdc%\x0f\x1b\xe0\xa00\xfd\xc5\xc9\x03\xa7\x0bm\x81\x1b\x96\xb42\xde\xd5\xcb\xa4T\xae\x97~(\xbf\xf0\x16\xeb\xcc>\xa9l\xbf\xb9\x04\xd4\x1f\xea\xa1\xa1\x98V\xb6\xb6\xb4\xb2\x95\xe9\xb3\xe9\xa8_\xcff\x1c\x8e`\x9a\x8bp6\x06:\xb45\xe2\x0bj\xb0\xc8`\xe8h:\x8a}\x17\xac\xe6\x82\xc9bp3\xb7\x96\xc3;\x9b\xa9\xac\xe5g<\x8a\x93KaK\xa88\xf6R\xfa\xc6\xd9<\xc7_\x1b\x1eBC]$A\n>b\xc2.1\xc6\x13F\x84\x94\x08_\x19\x1dQ\x01N\x01=\xb8\xad\x0d}\xdbC\xe6\xfd\xd1	\xd0\xae\x94-\xc9u\x8f\xac\xf3\x8b\xb9\x0eX&\x7f\x07\xc6\x01\x9d\xb3\x8a\xfel\x8f\xc4W5K2\xbd\xda:\x7f^\xb5kO\xbb\xdd\x0e\xc4\xf2\xd7e\x12\xaap\xed\xa0\x1e)\xeb\xcd\x00b\x97\xc77\x1af\xb7\xf2\x9et`\xf9\xc0\xe8\xd9\xa0\xc9\xea\x88?6I\xb9\x0b\xd2\x03\xb8n7,\xee?\x9dC\x13u\xf1\x00\xbd\x00\xc9\x0e\xa3:}H\xde@\xe3\xfa\x10f\xae\x94\x89\xb7\xb8!\xea\xca\x07\xe2\xb7\x8e\x11\x1fu\xd6Y\x87%G:\x08rA\x8c\x1d\xf12 ?\xa2\x9d\xac\xa0\xa8f\x8eR\xed;w\x15\x90f\xdf\xf4\xd9k\x14\x8a\x82\xad@t\x02i\xfb:\xf6\xd5\xeb\xd7\xb9\xa3\x9ec%\x96\xee/\x19\xff{\x9dF\xb9\x82\xfb}\x07\x1cK\xfb\xc5\x07%\x00{\x97w|(\xfb\x9b\n\xbe\xe5\n\xb0\x9f\xebA\x92G@-N\xe6in\x88Z\xa2c\xe9>\xdb\x8b\xe4t/lXl\xe9\x96\x0d\x8d\x1e\xe0\xf5l}\x9dnR3\xb9\xdfc\xa2\xc7d\xdbH\x8d<8\xf6C\xdf[\x8b\xb3W7v0q\xba\xea\x88\x8e\x1d\xfc\xed_f\x8dj\xce\x92T\x81\xc4[\x02US\x99\x93*\x13\x93\x8f&\xae\xcf\xd36(\xc8\xcd\xae\x18\xec4\xb2\x03\x1b\x132\x8b[\xf0^=\xe0\x16!;\xcb\xa2L?\x83\xa0\x90\xa68w\x83ZL\xd855\xd0\xae\xa9z\x0e\xc3\xe6>^\xc25t]x\xa1\x8eM\xf3\xf4'\xd5R\xa7\x84uv\xff\xc2=\xc5\x85As\xc4l\xee\x01\xbb\xb5\xf4\xc8V\x0f\xces\xe1L#M\xb5\x83\xd1\x97\x80\x95\x1f\xff1\xf7*A\xdd\xc1\xce\xf4J_\xe3\xcc\xb1\xb5p\xce;\xe2'\xd8G\xc0%\xdd7\xce\xfd\x96H\xfb7\xd34s\x9c\xc8_\xf8\xb6&(\xac&\xc4{\x8f\xcd\xf0\xafY\xf3\x7f\xb0$y\x9f/\x83\x91\xc5\xb5$\xc6\xd0\xe7\x94\x97M\xe9\xf8\xf9\xff\xdb\xa1\x1a47\xd5\xaa(\xe0-|fnc\xc4FBB\x96\xc8\xf2\xd6\x17\x96\xef\x8ae\xe4\xdf\xd8\xc8\x1eG\xe8SE2\xb0\x1f\xa8{yk-\xd9\xdfO\xc8\x0eMa\x88\xe0\x18V0eQL5Y\xff\x1b\xbf\x1f\xda\x81^\xea\xff\xa5f`@\x8c\xd0\x8b\xc3\xadAOQ\xa1\xd1\xe3PaW\xa2\x8d\"\x1c(aNS\xd1\xb1s[8`\x11\xeb\xa9L\xfc\xaaC6\xc8\xd4y2\x88\xa09\xe4\x04\xa9\xdfX[\xf2\x0f\x97\xc94\xa0? N\x13vD\x0c\xf8\xaf\xf6\xe1\xb3\x8f\x9d\x1fx$\xcdq\xca\xe2j\xde786\xb7\xfd\x11\\b\xeb\xc1\x0e?Z \xbf\xfa\x98\xed]\x0e\x0f\xcdzZ\x93e\xa1\xb3\xf7\xf8\\\xee]\x9e\xfe\x94\xda ,\x9f\x91\xaf\xe6\x9ee@G8Vt\xf1\xc9_\x1a7T\xbe'\xbeH\xc6\xcd\x16\xed\x8f,x\x03\x12\x16\xe7\xbd\x1e\xf6\xe5\xd3Ad\x00\xcb\xec\xf5\x00\x88\xae\xad\x8eK/\x99\x163\xc8m\xb2\xe9f6^N\x96j+Rj\x804\xa0\x18\x14\xb3\x99\xde\x88\xb1\x1d\xb8\x03m~\xaa\xa6\xe9L\xaa\xf6\x01Z\xa4\xe9\x01\xbc\xe6\njsq\xdc\xe6\x9a\xd7\xd2\x14nI\xf9\xb4\xe8\xf5\x0c\xa7K89\\\x08\xb65L\x07\xa9QO\x9f\x12\xc8\x0c\xc8<\xb1\nT\xf1\x90_\x8ar\x12~\xc1F\xac\xf4\xea\xc1\xa7\x04\x0e\xb4\x9f\x92\x1d\xd02Cb\xf3\x10\xc8B\x10t\n\x9f\xe6(\xbd\xa9\xd7\x1c\xbaVTv\x05A_\x94\xf5\x0f\xc9\xae:\x957\xb7\x03x\xb3\x85\xc8A$y]\xa6\x89\xf5A\xc3\xf8%^\xed\xa5\xa0\xd6C\xe7H\xfa\xc7\xfc\x7faH\xe7\x9f\x0d\xf1\xd0\xc0\xfe\xc9\x9aS\n_\x14k\x03>[\xfa\"Z;\xbb\xa5\xc6\xe3\x93;A\xfa\x90]\xafq\xd5b\xc7^\xb1[C\x02Di\xec\xfcKI\x16\x06/\x16\x8f\x8b\xbeD\x13\x1b\xe4\x1cg\xc6ZH\xebg\x0f#Z>S\xac\xef)p\x1b\x08\xf9{\xbcil\xc97dw\xb8\x99\xc9\x11\xed\x11\xf5\xb6\xb6\xdf\x86x[O73\xddN~I\xf6\xd5xi\x02H \x932\xde\x1ek\xf4\x98\xb1\x1f\xdb\x88;\xb9\xff\xe48Pl\x83\x8c:\xe3\xbaU\xc4\xdb U\xc9\xb1\x977#v\xcc\xda\xc3i<N\xb6\xef\xa0l\x18\x17\xd3|&\x87\xec\xc9\xab\xdc\xf0/I~\xa2\xf3\x94\xfex(e\xbf\x1fN\xb3\x99\x89\x1b\x00\xd4\xbe\x83/z\xbdL\x94\xb2\xa2a\xb2\x06\xc2H\xb70L\xe3\x14\xc3\x9c\xf9\"\xc7_/\xef\xa7\xfe\x85\x97\xf5\x9d\x80\x02\x94%9^\x01\xcd\xc4s\x89\xfb\xbd3\xb3\xdb \xe9\x8f\x8e\xe65\x7f\xa64\xcb}\x8a\x0b\xf0\x07\xdb\xf3\xc4],z\x10\xed\x02y\xed:\x02^\xd0Ql|\xef\xc4\xd6n\x85\x1c:\xf8\x03\xbd\xb9\xbd\x87\xb5?\xe0}\xeb\xd5>\x8caO\x17@k\xb6:\xf8\xe3\x10u\x87\xbcLE\xb9\xf6\x00\xdf\x9c6^\x8f\xa5\x1c\x8d\x8b\x97\xc3q\xd1\xef\xfb\x15\xde\x96d\xd9ne\x15\x1b\xfc*\xad\x94\xa6g\xe1\x89\x9eMg\xe3\xd8K\x8eF.i\x1c+R\x15 \xc7\x99\xaa\xb4\xd7\x1aY\xea\x01CX\xc3\xb3\xa6\xef%\xfd\x91\x7f^\xf8\x17\xe9\x98	/V\x91\xf8\x12\x89\xad\xfbM	\x10A\x1f5\xcc]0\x98tg2w\x1c\x88\x9f\x15\x1e'\x1ax^\xba\xd3\x1f\xef\x9a\xf9\xf7\x85\x03\xb0m(R\xcfF\x19:Q@\xa2\x0b\xd0-}Q\xf20\x9f^\x93L/]\x84\x1b\xec\xa6Z\x0d\xcdj\xed\xb3\xf3CEn!8\xfa\x80{\x13\xce\x84W\xc8\xb2\x9f;\xdd\xda\xf9/\x87\xfeS&[\xa9\xbdb\xecP\x8b\x1d\xd3\x90\x03{\xa2Y9\xdd\xe7x\xd7K {\xa4\xaa\x92~J\xc53\xbe\x98\x93q\xd7/\xa8\xd5'\xab\x11G\xd5\x00z\xa4\x7f\xa9p\xad\xfb\xfd\xd0\xf7\n\xc9\xbdr\xe1\xdeN\xb5\xa7_\xfa\x14\x91\xfe\xa8\x97\xfdB\x9c\xec\xe5\x89\xfa\x9d\x0bq\xd5\x90\x0c-\x18\xb6D4\xcf\xaf8\x9a\x8b#*\xa5o\xa6\xee\xd1\x95\xad\xfd\x8b\xe3\xc3\x8c\x9d\x04\xad\xeezi\x7f\x81\xf3\xd0o\x93\xa6\x9d>\xc3\\\xe5j\xb6\xb6H\x0f\xbeTD\xdc?\xb7\x97i\xb3\x167\xcfP\xc7\xf6\xca$\x91y\x9a?\xdb/~%\x96\xba[i\xfe\xfff\xaf\x96'{5z\xaeW\xd1I\x0e\xc2\x9a\x19\xdaI\xf1\xda\x17\xa6\xa1Lw\xd6 B\xed\x0f9\x0c\x98\xa6t\xa6\x98V\xe9\xd6\xe4Tz\xdf#\xfc\x97;\xa5\xf1N\xd5`F\xe8\xd9BjM}\xc4\x119\x8dw\xee\xac\xc7<\x8d<B'<g\x07\xbb~r*\xb5\x19\xb4\xf9\xa92F'\xcb\x18=W\xc6\x9a\xcb\xd0\xe7Q*\x874\xfb\xcc\xed\x14\xb2?\xa2\xbbA\xcew\x83P\xf6\x126n\x155pq\n\xedOG\xd0\"iO\x86\xe6vn\x99i-\xec\xe5\xd0\xde1\xed\xe1\xbe\xb0c\xf9\xb7\xfb\xe8|\xdaO\xdc\xe7\xe6\xbb\x87\xd6w}K&\xb5;j\xcc\xd1.\xdb\x9e8X!b\x8a+\x16$\xcco\xdb\x82\x84b\xf2\xa8\x0e\xe8\xda\x0fB\xeb\xd2w\x8f\xa2J\xcdG[\x12\x83\xfb\x13u\xa4\x16\xbe\x857\x14\x9f\xef\xa6\xc5\xa4\x93\xe6i\xfdSY\xac\xabN\xd0Q\x12f\xf54\xf3\xbdD\xb4\xc4\x19\xaa\x91\x87\x83@\x1c\x0f\xc3\x16:\x97\xfc\xbc\xe5\xc3\x12\xdd\xc2]%m]\xd0\xf3\xe6\x82\x8e\xb0\xcd\xfcR]\xf9\xa7\x0e\xf8\xf6\x0cj4\xbe\xe6gb3p\xcc7\x10,#i\x84\x05\x1b\x088?_\xca\xd1p(\x12H\xb7-/\x99\xf8\xd6w\xab\xb1Y\xccG\x1e,3\xa3\xf3\xd6\x96\xd7\xd3)\xcd	@\x86i2\x1d`\xf8^\x85U\x92\xa5y\"j{\x13~\\\xeb3\x9c\x83\x9d\x99[\xdf\x0b\xe5\x18tG\x1e\x1b\xafv\xd0\x0f\x02q|\xbb\xce\x8a\xba\xe3\xd4\xabE~\xb8\x87\x85UB\",\xf8@Az\x91\xfb\xe3\x82\xac\xe5\ns\xd1\x15\x00\x93\x92\xb9\xc0MZ>A\x80\x1a\xe4\x1c\xf5\x8df\xa3\n\xa6\xa4\xaa\x99\x163\xf6\x17Q\xc9\xfa\xb6yp\xcci\xef\x9a\x0e\x80\xbd\x05,\xde6\xadH\xf0h\x7f,\x9a\xc8\xba\xa4\xb3\xfcX(\xd3u\x04\xf2@\xc7\xc1\xb7|,\xb6\xefH!\xa6\x133\x8c\x06\xf0\x0bm\xe1I\xaeds\x88\xf6K\x1d\xcecvHlt+\xb8L-n=+\xb4u\xed?m\x9a\x0b\xdeFXn\xf5\xc6E\x1e\xfdR\x85\xb2\xee\xc6\x17\xc6)\x04& }\x98\x7f\xf8\x87\xa3\x82\x1d\x81\x9b\xeeW\xc7\x1f\xef\xbc\x04\xf4(\x81\xce\x0c\xb8\x01\x19#\x0f\xbc.\x94_H\xea\xc3\x80\x8e\x9eF\xfc\x04\xb7\xef1\xfbC\xff\xb7\xf3\xb4\x7f)\"\xb9\xbc\x08\xcf\x07_\x0b8$\xf5\xa3s/\xc4\xbd\xe7\"D$\xda\x8b\xcb\xfe\xf2\xe2\xb2\xddS\x90\x1c\x9e\xab\xb9/\xe6=\x19\xf5b\xc1\x17E\xe3\xa6b\x86\"\x16\xd94!\xbb\x06\xf5\x03\x06+\xf6\xfa|k\x16\xbd\xee\xf2\x8b\x9c\xedO\xd8^\x83\xb4U\xb4\xd2`\xe84\x1b\xe3f*k\x11N\x973\x8e\xf0\xd3\x08\xf3\x89J\xd7\x06\xb2\xaa\xf0'Y\x83\xf1\x18x\x99,\x1dAk\xe8\xfb\xd3\xcd\xac'\x13aY\xa2\x17m\xc8*\xbd\xd7\x8a\xe6\xf78\xa7\xef2\x81\x1bv\x1f\xa0\xa3\x93\x9a%\xb9\xa2\x00\xd2\x0f\xfd.\x9c\xa8!\xa1\xad\x86hJ\xb5j\x18\xa7\\\x9a.\x9d\xcbA\xdf\x0f\x1a\x94\xb3\x0d\xc5!*\x07\xc3!\x93)\x99C\xb6D\xe9\xad\xf8\xd0\x04\xff\xb8\x94\xa3\xfeFD\x16\xfcc,+Wz\x1d\xe1\xe2/\xe6\xc7\xc9KR\xd9`\xef\x9c1\xd4J\xbc\xdf\xcf\x99\x00\\\xfd\xdd7\xc6$k9\x1c\xaf\xaf*\xe3\x12\xb9\xd6\xd6$\x0c\x17\xe8Q\xf0\xfe\x95\xcc\xbd\x85\x98\x03\xa3g\xed\x8b\x07~\x1a\xd2\xd3\x8e\x9fF\xf4\xb4\xe5\xa7\xaf\xe9\xe9\x9e\x9f\xfeFO\x8f\xd8\xce\x85\xf7(v\x90+\xe1\xc7V\x8c|\xf1\xa8F\xf1A\xec\xc4\xbd\xd8\xfa\"\xf4\x1ea\xad\x89?\xf7\xeai\xe5\x8bJ\x8b\xbf\xf9\x90^\x8b'\x9b\xa8\x06+t9\xc9\xe3*xt\x90\x13\xde\x98\xf5\xab\xa9$2\xe9\x05\x89ct{t\x8c\xa6\x93\xd7k\x98\x18\xf9\xe0+I\x1e?\xb4\xb7\xc4\x07.\xb21Y:\x12ak\x18B\xf5\xbc\xba\xc5\xe5\xe1\x84dY\x9fn\xd5\xa9\x97\xf6\xf9\x06\xe3\x12\x91\xc9P\xe7b[\xcf[\x88\xa9\xcc\xc1\x96\x813%\xf9\xf7\xf1\xf9\xa7_\x90X\xbc4\x8f|dV0\xe3\xb8\xaf\xd2\xd5:K^\x15[\x9c\x0d\xd8eV\x17\x1e\xbet0\xbev\x19\x9d\xbft,\x02\xe8\xf5\x0b\xc7\xe2\xf5\xd1\xb4\x19z-801/\x84N\x06\x97\x03R\xccT\x1d\xad\xe2\xba?f\x8d\x0e\x02=\\\xe4\xc1\x08\x01\xaf\xd9\x95\x9a\xb9\x93?\xfb\xa4\xdf|S\xfa\xe2f\xed\x8dD(j\x98\xf4\xdd\xac\xbd\xfe\x08f0x\x80\xd3G8H\xf3\xa8LV	\x94d\xe0<\xcaV\x825n7G\x0b\xa9~9\x9ct~\xea\x04\x9d\x1f;\xe8	\xba\xc9\xbc\x90\xb3\x1cz\x05\x026\xd8o#\x9d.\x98\xd72+\xefx\xc9X\xbc\x10\xb4_\xf6\xca\x00\xa9\x10\x89\xbb8,\xdd\xdb\xadi0\xd8E\xdeC\xe9\xcb\x1c\xce\x91\xe9U\x0e?\xc9\x97C\xc6i,\x04\x845W\x12\xa9\xe4;iJ\xf9\xc4\xcbE\xc7\xee?f\\N\x9c\xf7\x93\xf6i\x0f\x8f\xbag\x0e\xeb\x14\x875\xecB\xfc\x8b\xc4\x9c\xee\"\x94\xaf\x0b\xef\xb5F%\xf8\x18\x96\xda{\x0e\xe6\xfa\xf4_y\x94#\xcdu\x0e`T\xf9\x9a\x96\xd3\xe7\xfc\xc6\x90\x12\xb2v\xa8&\xaf\x0b\xaf\xc2\x19cNXs\xe6\xc2\xae=\xf4\x85\x8d+\xf7\x93}\x0d\xd8\xd1\xc9\x08\xb4\xa7^-zm\xbc'\x1e4\xc5\x14\x12\xac\xa6#\xf6\xab\xd9\xd2\xfas\x90\x0c>\x8b\xcfY\xf2@\xc0o\xea\x87\xf6#P\xdb=\x00\xccB\xf1)\xc1\xe0Z\x0e&\x13\x0e\xc4L:)\x01B\x11t\xf0\xef\x97\xb2\xb5\xe4\x08\xef\xb8\x91\x0ed!K\xce\xb0\xc7~\xcf4\xd8\x16\xfbc\x18$\x83\x94\x11\x0b\xb0\xc5\x80\xb6<\xf8\x98\xcc\x8b2\xf9\x85\xae\x1a\xd2t\x94\x81\x0d\xa9\xabt[ \x02\x07b\xa4>\xb7\x10\x01QQ\xd3\xb2o,6\xbb&\x1ec\x0c\x8cl\xc2\xa1\xc6\xbf\xf6\x0fu\x84\x93\x8e\x0f\x19\x8f\xc2\"\xa1?\x89|\xa2\\U0\x85\xd9\x84\xfa\x84\xac@\xd5\xcf\xd9A\xaf\x0bz\x0d\xa7M\xa8\\\x07\xf3\xb2X\xc1R]\x9adE\x0c\x9b\x1cu\xc1\xef9\xd1\x17\x857MD\xadMP\xc1\"Z\x1d{\xcf[\xb25\xe8\xcdh\xd2\x8f#\xbcH\xcep\xaf\x06\xec\x9b\xf9<\x89\xea;\n\xc4\xd6\x1a\xb8\xcf\xeb?\xc7\xa1\xfc\xa9\xc8v\xd9),\xcaW\xe6\xe3\xf7\xebfEP\xa0\"=\xe1\xf7YX\xd5\xdf\x96\xe8\xf2\xb0\x9d\xf2\x13CiZp\xaf\xff\\\xeb5`\xbb\xb06\xef\xbfs\xee\xd5\x7f\x15L\xed\xd7\xb5	P\xb9\xb8G\xe4\x0d\x8cM\x14\xe6\x0fa\xa5\x9d\xa9?f\x9b\x12\xa0\xa6\xf2kn&\x1d\x12\x94r\xc9)\xabp\xfb\x8e\xb5\xc8l\xb0\xb7Js\x9d\xc2y\xee\x17\x80CI\xf2\xfa\xa7t\x9bd\xf0Wm\xda\xfem\x9bU\x01\x19\xa3y/\xa4\x97\xb8\xcb\xd0\xe8\x10\x9fR6\x03\n\xa6\x1eC\xf9>\x84\xf0a\xa6/\xfd\x8b\\\xd8\xc9\\\xa0\x7f\x91\xc3\x9a\xc7\xd7\x17B\x88\x86y\xccL\x0d\x1c\xce?\x97\xe18\xbf*\xc69\x82O\xcc=\x8a\xcc\x94\xcfL\x890]\xba\x92\x881}E\xc6\xe6\xfeS(\xf31\xa1\x14B\xe2\x9b#^\x95\xe15s\x19\xf6G\xe3\xfc\xa5\x1c\x8es\x1d=\xa0U\x1e8\xd6\xf2\x0bej\xb9\x0e\n\xe9v\xf3\xab\xa2\xdb%\xef\x80f\x14\xbfg\x1a\xc4\xf2z\xdde\xdd\xbfDN\xe9\xf6\xc5\xe3\x90\nu\xb5\xe3'\xcb\xc3\xc5ZF/%\xbe\xa0\xf6$.t\xf3\xbf\\\xb2\xa2\xf9r\xcd_v2F\x1e\xa1\xcf;YH\x86bT\x86)\xe2\x97#n\xf2\x046G(\xd9\xdcI\x05K|\x9f\xac\x93\xb0\xee\x98\xc3^\xa7\xdfd\xe9\x9aP\xe0\x9c\xdc?\xb1G>\xb8\xc8\x0e\x85)\xef\x88LzN\x9e\xf7E\x1d\xd6	r\x0c}\x83 7\xfaoC\xdcP7\xad\xe2\x10\x8c\xbb\xcc\xb1\xf4\x91\x9f|\xba\x10\xcf\x96b\x96\xa7E\xfe\x0d\xf9p\xc7\x1e\x10\x9d\xac\xb8\xf0\x89PvBA!\x10\x9b\x97\xf8\x13x\xb8Q\x99\x10n\x04\xa6\xdf\xcc\xf2$\x82\xbb\x85j:QZFY\x82@\xf7YQ\x9e\xcak\x98i*\x11C\x11\x94\\\xbc\x1a\xaf t\x1e_\xeb\xe8\xcc\x81\xd3\xbd\xd6KS\xa0\xd5\xed`#J\x8cWZ\xe4A&B\xddq\xd5\xa5\xe5$U'(A	\x99Q	\x96\xc6d\xcd}\xd1\xf1q\xc5\xa0&\x98\xd0\xe0\x9f)8\xeb~?<\x8c\x7f[{\xa9\x08E!r\x11\xf9\xe2w\xb5X\x94\xdfK4\xf8\xc8h\x81\x0c\x8c\x10\x0d\xd6\xdb\xbbt\x91\x8b\x8d\xca\xfd\x07\xbe\x8d\x06\xaa\xfdtR\x8a\x8c\xde\xd0\x91\x1cK\xf3*\xfd\x9c\x88\xb9;\xcbJ\x10\xd3\xb07;o\x0eK\xeb\xb9\x84\xf8b\x0e\xa2\x14c\xcb@|AW\xbf\x18\x1bu\xe6\x8b\x1fPg\xcc-\x9c\x8bJDJ\x8dw\xab\x9d\x06O5\xbb\xa41\xbf\xd1\xd0\x12\xd4F\x115{\xe4\xb7\xf5\x91a\x15d\xa0\xaa\xe4\xd7\xa9\xb9c\xeb9|\xc5\xe3\x02F\x80\x80p\x80\x83X\x94\xf1\xdd\x8e\x84\x15d\xbdK\xcc\xa6\x9b\xde\xf0\xa0\x80\xc8\xc9\x06u\xa1\x90\x08\x94yd\xc6\xa2	\xf5\x84\xc8I\xb8\xa0\xf7\xbctZ\x0e\x1e\x97\xb3+\xa9\xee\xdb;\xc4`'\xea\x13\xc9\xe9\xbf\xd7\x88X\x8e\x81\xeao\x04?\xc0Kd3\x1bG\xd3\xd1\xec*\"\xc2\x10Y\x8a\xa2PF\xd3\xe5LY\xc5\x87lB[M\xd4\xa7~\x7f\x13$\x93\xa6\xcdJL5\xc5\x0d#N\xb6\xb3\xe9r\xd6\xdf\x04\xdc\x9cq\xd1\x1af\x19\xc28\xdc+\xda\x83-\xf9\x03\xf8\x0c\xaa\xe5#\xc3\x97\x80<	\xaf\x08\xff\xc4:|\xff\xad\xd9;\xe6lZ\x03T;\x03T\x0f*\xac8 \xb4Tp\xa1\xb6\x99\x96\xdf\xd7\xae3\x9f\xa8\xe0|\xa9=\x13\xb2f^#\x99\x99\x90\xbax\x8e\xa5\xc5\xe4G\xaa\xd9s\xf9,\xe1\xc0\xc2&A\xdc\xdc\x9f\xcc\xe5\\_?\x03\x16\x89\xcciAwF\xc3\xe1\x7fPT\xc8\xe9\\\xccQ\xe04\xe2\x11\xc5}\xa0y\x121\xde-\x9dw\xfaI\xe4\x93\xb8\x81\x90	\xb1\x80\xac\xcd%\xe7\xc23\xcf\x18\x159\xc5\x16\xba\xa8\x04\xf6\xceE5\xf3MA\xe7\xd2\xcb\x06i\xf5\xbd\x91\x0ea\x0eF\xfe\xb9%\xb4\xff\xe3x7\xf0\xb5\xe4\xd7[\x10\x8d1\x82\x96|\xcf\xb1\xca\x9fh\x16\x82F\x9ee(Wz\xf0\xc5\xf7\xb7\xcc2\x7f\xd0\x0e\n\xb0\x12\xbd\x90\xdf\xdf\x1a\xab?|\x8e\xc4s\x1c\xd4ff\xb8\xb5X4\xee\xf6\xb6\xaf\x8b?\xd8\xd3\xcc\x93,\x96\"\x12\xb1\x8e/\x129\xb3\xbb\x96L,\xf0\xb0\x90\xb1&g+\xcb4g\xba\xe6\xaa{\x19\x84H\x0f\x92\xb0n\xf9\xec\xdc5\xcbc\x03Q\xd4\x07}\x97SS\xf16,\x17p\x15\x12\x9d\xd1\xdf\xff\xa3\xe3\xf7:\x1dq\x0f\xec\x97\xed\x00L\xe8\x1b\xb6V\xe9\x9c\x91\x01\xb1\x0e\xee\xd5\x1fu\xbb\xde=n\xf2[\xb9\xe5Q\x1c\x9a\xb0`\x10\xc6\x8a-\xd6\xc9V4mS\x04\xf6\xb1i\xf6\xaawy\xbeE{?\xca\xfb\xc90\xc0\xc3\x9d\xfc\xb5\xc0U\xe7\xad\xbc\x9b\xe4\xc1|\xe1y\xbb\xdeG\xff\xe2\xd1\x1f?Jd\xf7\xb6\xd2\xdb\xf5\xdf\x9e\xaf\xfc\x8b\xcb\x0b\xef~\xf26xK\x95Y%\xec\xf7\x1d\xc2-\x04S\x91\xef\xf7\xde[\xb9\x9c\xa0 3\x08K_\x15\x19\xd0H\xbd=\x7f\xec\x7f\x141\x13\x80\x0f\xe9*\xa9\xe4[\x11\xf3\xbaT\x83#\xb7$\xc8|#\x17\xe7\xde\x03b\xab\xbc\x96\xf1`\x1d\xd6K\xcdD@\x84\xf7z:\xe7I\x90\xe9t\x8e]xq)^\x9b\xdeKf2\x08\x8c\xebM\xd0Irja5\xd9\xf4\xdf\x04\x1b\xa0\xa4v\xbb\xde\xeb\xc6U\xf1\xb5qUT\xe3v-\xe3\xc1\xc7M\x1eg\x89]\xe9\xb5[\xe9v7\x13\xd7M\x95\xe9t\x8d$\xfa\xfe\x06\xdf\x87%l\x9e	\xbcC\x05/L\xfd\xf1\xcdt\x8d\xc6\xca\xc5\xb9\x99\x183T(\x80\xc8\x89Ii:\xd4{\xe3\xfb\xe2F\xf5T\xf7\x98j\xba\x951\xf9$\xaaj\x9e\x0e\xe3[\xd52\xd9\xd7M\xbc\xe5\xaf\xa2A\x12\x81\x1a\xa9Gq\xab\x9a+\x87\xf4\x0b\x19\xb8\xfef\xf3|\xe5\xb0\x96G\xac\x97H\x114A\xbf\x00\xff$\xfa	\x82'\xe0\x9fD$\x88*\x00\x86\xc8\x1c\xe0)\xd3\x03\x8e \x0f\x9ff_t\xd2U\xb8H:g\x8d\xf7B\xda\x00h\x1f;A;8-\xff0\xbb\xdb\xb2\x06-\x9aFg\xbcY`Z\x97\xea\xad\\\xc8\x9a\x1b\xe45\xab$\x1d\x9cd\xbd&\xf9\xcba\x90_\x0d\xc1w\x14rI\xd0I\"\x99n\xf4\x9c\xc7\xe7^\x01\x05H\x0f\xf0\xb3\x99I\xb7`\x95\x12\xa1\xa8\x9f\xae\x80:r\x82\x12\x0e4Q<\x1ct(\xb4\xfb\xfbu\x8a\xf0	i\x98\xbd\xa2\xb5H\xbc6\x17\x03~	Lv\x8b\xb3\xc9d\xea\xec\x15\x04\x85j\x08\xda\x92\x10{\xcd\xd6\x03g\xad\x82/\x95\xd3&\x17\xf7\xa1W\xf5.\xcfu\xab\xd5\xce$\xc3\x98\xe5\xa2%[u\x1ajxJ\"e2\x12\xce\xdb\xf7M\xddr)\xa2\xab\xe5$Bi8\nE\xe1E>\xb4w~\xd0\xa4\xf1\xc91\x1d\x8a\xe1\xec\x80\x97M\xc5\xb0{\xe5(	\xa4\x0e\x0c\xf1Q\xea\x8b\xa8\xd7;\xf8\xe3\xe8ji\xc7\x90\xfaj\x0d#\xc1\xf9\x17\x1b:\x7f\xbe\xa1!\xc9\x84\xe6:\xde\x11Z:\x8e\x16\xde\xdc\x9a\xe6\xf5@\xff\x14N\x9b\xb9\x07k\xc5\x894\xf3\xbdn\xe6\x1b\xfd\xf2\xc5\xdc\xc2\xbd$$L\xab\xa7'\x86\xdc@\xad\xe8X\x0d\x1d\xa8\xcc\xda\xe0\x99\x7f\xb1\x0c\x86\xe3\xa4\x120\x90s\xd7?\xe9\xe7\x13\xd7\xc3\xe3\xb5\xd9Z\xb1o\xc34\x87\x9f\xf48\xc4\x1c\x87j\x8e\x9b\xf1rW\xa9\xd9\x0f\xff\xa3{\x85\xc6.\xf0\x9e\xa9[\xcd;\x80(H\xd2.\xa8!\x7f\xb1\x0d4\x7f'\xf7\xa7\x9e\xd3\x93;\x9ag3\xfc\xc2l\x92,\xe7\xe4\xfc=?{\xee7\xcd|\xd1t\x85\x8e1d\xbdpE8t\xf2$\xcei\x04\xfd\x853`\xaf\xd4Q5.0W\x05\xcf\x95R^\xe4\xca\xf8>\xf0N\x7fb\xbcy>_\x06\x97\x82?\xb1\\IZR_rBU\xb1\xaa:,\xdfu\x92\x0c\xaeK\x00\x87\x01Q3\xf4\xac\xd5\xbd\x84\xbb\x07\xcd\x17<\x9fx\x12p\xd7\xd6\xaa0\xa7\x9d7\xec\xaf/J>\x83\x9b\xd3\x12R\n\x8b8\x82t\xba\x17i\x13\x0d\x9ah\x04\x98<\x08\x92\xbcB\x8fMy\x00;\xc9J\xffrZ\xe1\xf4\xa4(\x8azPt6\xdf%\x83\x8a\x9a\xe3\x94\xd3\xad\xf3\x8aV\x16\xfd\x82\xd5\xd7K\x9c\xecK\xae\xc1\xea\x82J\x81\x81L>q\x8cb\x02\xcb\\f\xd6\xb4x\xa9Zl[\xd3\xa7\x0bGX\xa5\xef1\x1a\xe8\xb59\xbb\x17Im\xa8\xfb\xeb$\x0bw\xca\xce^\xe6\x0bqJ\xf8\"\x8b\x85}T\xe7\x8bF\x9c\xf5D'L\xe0t\xd8=8\x04\xa9L\x82\xe7\x0e\x0f\xab\xf9\xc5\xc2kn\x83\xd8%juQ\xf3O\xb4\xca\x83\x13\xe6\x19e\xb4\xa5\x1df\xdem\x87\xb6\x90G\x86|\xa4-\x14c_=\xeb]\xc8\xca\x85\xe4\x149\x18'\x03W\xd4\xd2\xed\xd6-H\xe4\xf6.WMI>\x14\xfa\xde4\x1d\x0dF\xe7)\xb8T\xf5\x03\xc8\x15\xe2o\xc3\xa1\xe8$\xb83\xa4\xd1\xbbMm\xa8\x077\xea\xcfKM5\xcd=YV3\x08\xa5\xb5\xdfl\x1c+\x11\x9e\x04\xb4\nAn\xadISK]\x868Cq\x17l\xc0\x81E\xda\xe2\xb0u\x83|\xe12c\x93\x7f\xac\xa1\xc9\x03\x11\xfaY\xff\x12\xf5\xc2+\x14\xa9M\xf0\x8b\x0d\xcb\x9d\xaai\x83\xdc\xd5\xa5\xdc0k\xe0\xbe\xa5\x1a\xde&u(S\x1bx\xaa\xe2\xfej\x93\xa6#\x9aP84a\x0e4\x9c\xe3\xc3\xd0>dN\xf7\xf2\x00\xc1\xc3\xa9\xae\x12\xf1\x86\xec-0\xe7.,\x8f\x89\xacS\xba0$\xd0\xd1\xe4f\xc7\x13u\x92\xc4\x83\x93e}+\xd0|\xadP\xcbd:\xb3\\\xb4 \xf5\xd8\xca\x9d\x98\xac\xfc\xd4	\xdf\xedr\x96\x93o[\x1fi\xbaF\xd8\x84T\xb5\x88[\x082\x18\xd2#\xce\xcf\x02\xc7\x83\x1b\x89\xda\xe4@\x00Q\xbf4+\x98c\xed\x82\x94\x1e9*&t\xaaY\xe3\xb5\xe1\xdd\xcd^\xdfG\xd2\xdcf\x92\x1d\x190Ao\x0b\x00\xd7\xd9k\xd4J@\x0fg\x0cR\x14\xd8\xe4d\xc9\x13D\x06\xbe'\x8e\x03\x1d\x7f\xd3\xb3}\xca\xfd\xa7\x9cB\xf9\xd8\xd9\xd5a\x0d\xc0\x12\xd2ga\xc6m\xdb\x90\x88\xeb\x11\xec\x90N!\x89h\xf3A\xed\xc9\xcdF+\xbb\xdd\xb3b\x92\x9a\xb7\xa9\x1f\xa4\xdd\xae\xb2\xb5|\xfe(\xc1\"\xcc\xdb{\"\xb7\x06+\xb4\x9a\x12\x1fm&\xba\x10\xba{\x884\x0bF\x1e/\x10\xa7\xd4\xd8|tRm6\xd0iY\x11LY\xd8>\xf3\xb5Q\n\xbb\x8dj\xca:x\xf6SHVC\x87\xfc+`\xb2\x8e?>\xc1%\xe3\x94F\xa3!(\xb1B)\x95\xde\x13\xf1/\xb9`\xdf\xcc \xd5\x0e\x9c\x07Q\x01\xee\x13\xcb8\xf3\xc5\x86\xb4\xb8\xaa\x06I\x90(\xc0\xdb\x1c|\xbe4fvO\x07\x11Y\x8c\x08n\x12\xaf\x93*\x9a\xf6\xbc\xb4%@}9\xf4a\x81u\x92wK\x96^\xac\xf6\xb5X\xc2\xecU$\xb6\xf1\xa3( \xbbO\n/\x16\xb6\x83\xe8|\xd1\\\x96I\xeaPBl\x0d\x7fp\xa6\xeaF\x14Q\xf7\x13\xffj\x94\xf4\xff6I\x94\x901J\xd2\xccq\x7f[\x9b\xc9v\x01\xa3\xa5\x86U\xe10\x93FC%\xa7\xfa\x8d\xc6\xe8\x85\xd9&Q\x85cXi\x0bH\x9a\x15\x9b\x98n\x1b\xb0`\xc1\x1b:\x91\xc9~\xfft\xe0c\xf98\x06\x92m \xfat\xc09\xd6\xdc\xdd \xd1/J(G\xb5\x06\x8b\xdd\xd639\xad\x06[Q\x0d\xb6\xbd\x8a\xdd\xd3+\x84^\x1d\xecz\x15;\xb9\"\x16\xcbScR\x17<\x01\x85,\x83\xbd\x1a\xc3\x90e\xd3\xafg\x07\x83\xac\x1e(\xe02\xe8I\x19\xb9\x0c\x10\xcf\x87\xc3\xb80\xcd\x91\xd3\x8e\xce\x0d\x19E9\xd9\x0c\xf4\xf34\x9c\xa9O[F|\xc8\xd3\xa4\xa8\\_\xcffz\xa55\xaf\x82a\xd3\x92\x11*\xd5W\x1d\xa9Uo\x97\xe7\xcbi9\xe3(2\xd4\x99\xfeHwe\xc4M\x1e	\xeaD\x9fJ\xc8\x00\x11mTZ\xb2\x18\xd4i\xf4\xc9~\x86-A\xf3\x1c\xc1\xf5\x87\x85\xa3\x98\xf8\x0fi\xf4i\xa0@\xe5\x01\xae\xe0\xb5\xbf\xef\xb7\x12|\xf1\xc1\x03,\xf7G`! 8\x80]\x18\x01{\x9b\xd2Tq\xad\xf6\xf5\xdb-\xd6:\xa9D\x8dE\xa2\xf7\x00\x1b/R\x9c\x9c\xa3*J\xd6Z\x82\xef\xa1\n\xde\xebq$T9`\\M\xfaq\x10\x8b\x02\xfb~d\xb3\x1a\xabE\xdb!\xaf\xe4\x95\x89\xab\xc6Y\xc9\xda\x8b\xb4z\x95\x85\xf9'p\xae\xa5\x06\x15\x81V	\n\x0dvoy(\xb2\x87DYi\xfb\x8c\xec\xf2\xb0 \xd0\x14\x81\x00\xc2\xebp\x97\x15a\xfc*\xac\xa3%\x99\xb1Wy\xb8\xfeP\x90\xb9\xff\x18\xab\xa8\xdb\xe5Xxl/Mt\xb9\xdb\x0d\xbdB\xe0\xb5/\xce\x88q\xc5g\xedFl\x80\xcfG*\"\x10\xba\x04\x14.\x1dT\xcb\xe2\x91\xcc\xe1\x14\x163\xc1\xc2p\xf2\x87\xa2\xc8j\xf2\x94\xacp\x11!%\xd3\xd1\x07\x16Uy\xe0]\xad\x99l\x0c\x0fI\xf3\xe2t\x85k\xacvs\xfdq\xb3\xfa\x98\x94\x83\xb7\xd7\xbf\xdd\xff\xf3\xfa\xf6\x97oD\x05\x9f\x0d\x9e\xc1\x1fn=\x87\x1aZ\x1e0\x89\xe0\xf8\x04\xa4\x16\x90Ic\xe4\xe7\xda\xb3\xe6\xda\x985\xd1\x10\x0d\xdc\x13L\x85\x0e\xd4x\xea\x9d\x17	\xb8i\x8f\x972\xd6\xa7$\xc3\xbe\xc7\x83<\xa9\xea\xa4R~\x174\x14\xb8\xd2\x9eyK\xa7\x19\xa9\xfa\xe0\xdd\xfc\xc7$,\x93\xaa\xf6\xa0\xb1\x13\xb5p,\xd4\x14\xf2\xcbd\xf0\xf7\x00\x93\xe1\xfb\xae\x13\xfcx\xe3\x94\x88]B\x85,1\xb9d$\xa1g0\xad\xbe\x05\xa5M\xbc\x8do\x02\xb0\xf77b\xddh5\xe6\xfex}\x05@\"\xcf[_\x95\xfb\xfd\x9c\xcc *%#-\xe5ZTr.\n\xb9\x11!\xeb'\x00\x91\xfb\xc3\xad\xb7l\xc6\xbc\xe1*\x9f\xac\xf5\x16\xe8)\xa2'\xc1c\x95l\xd5\xe6\x0e\xea&%hu\xe6}\xf8H\xdf\xe08\"\xd3\xa4\x03\x80\xed\xadE\x0f\xb5{\xb3\xe4\x83\xc2\xba?\xee\xcc&\xa4@^p\x86\x9dIv\xb6N\x85S\x88\x8dp\xbf\xb5>\xd3\xc1\xbf\xed\xcc&B\x00\xe4\x0e\x0c\x84\xa2C\x02\xf0\xce ~\x05;=\xd1\xd2 ^Q\x887\xc1\xd3\xa7#}5:bU\xd4F~\xb5\x81\xb1\xedR\x85\xa2!t\x82\xfd\xdek\x1e\xd96\xecnW\xbd\x89\x83l\x90\xc6\xc2<c\xa4\x82l\x10i\x8c\x19J0\xaf!\xc6\x0f2ZM&\xed\xad\x06\x0f\xca\x06\x1aG\x88&\xe3\xd5\x0e\x1a\xec`:;\x88z\x90\xa5\x15\xdb\xa2/\xa1\x97\x1e4\x19\x18\x9f\x00;\xf7u\xba\nB\xda\xbax\xa2\x9a\x83\xb2\xdd\x14\xbc\xa2\xeaJ\xd5\x0c<\xbf\x89\x83\x12\xbd`/x\xf5\x97\x88\xfc\xbbu\x1d<\xad\xcb$J\xb1O\x83J[\xb1\x7fL\xb2\x81I\x86n\x157c\x98\x8d\xb8YLr\xc7?\xb0\x07\xca\xebf\x97\x06\x85\xb9\xaf\xd9\x12\xd1\xfb\xe3K\xe2\x16\xebv\x0e\xc7\xfb\xf1\x0f\xb7\x8e\x99\x94\xceV\x9c\x98~\x0e\xd6\x0f\xe1)B\x8d\x84\x13\xa0[o\xaa\xe4{\x02\xce\xe7\xabZXo*\xd9\x01\xf1\xec\x18e\xa7\x0c\xd5_Q\x0c\x8e\x1a-\xbd\xd0Y\x8b\x08|\xee\xeb~\xf8\xc1\xb35,\xe9\xe4\x15\xaa&XM\xf1\x1b\xbaf9S\xa8:\xd2\x9aGN\xa4\x05\xda\x9eRjk\xc0mw\x0d6\x1f\xad\x9d\x04\xea\xf7f\xe1%>N\x1bZP\xf6>(\xa4\xe7\xa9u\x06\xc3\xc5f}\x11`\xec\xd3\xc1?\x1e	\xea9\xbf\x1e\xe7\xde\x13\x96S@\xfd\xfb\x90\xae;\"\xa9\xa2p\x9d\xdc\x14y\x9eD$\xad\xdc\x060\x93\x12\xbb\x00\xf6Q\xa2V\xdbQ\xe1\xc4\x04\xe9\xc0y\x16\x9aI\x83\x18\x99\x7f\x1e\xd1\xac\xa2\xb9\x171\x8bb\x9e\xedw\xbc\xeet\xba\xf5\xc4\xdb\xec\x867b\xa0\xba\x7f\xe0s\xd3\xf4\x083\x87\x1e\xd9\xe3\xfa\xf1h\x8d.\x92\xfa_%ye\xfd\xe3\xd6\xcb}{1\xde\x86U\xfd\xbd\x8e4[a\xb8D\xf8g\xb9 \x92\xfc\xe1\xd6\xb9/\xd9r\xc4g\x96\xfa\xd8,\xaf\xd4,\xaf\x1fn\xbdtp\xb4\xf7\xacr\xf5\x15\xa5\xe6\\46\xbd\xce\x8b\xfd\x8bN\xafn\xc6q\x1c\xc2\xd5\x0d\xc3\xa3\xad\xea\x19\xfcb\x1c;\xfeRh\xe6\x19\xf2\x1a$\x08\x1c\x1c-A\x04e*(\x93\x86\xb8@&\x8d\xe1\x00S\xaa8\xad\xd6\xd8`\xd7\xaa\\\x9e\x0c\x1d3\xf8\xc4\xfa\xfa\x88\xdcA\x05 \xab?-\xc6\xc4\xfa}\xb6\x9c\xd2\x9e\xee;\xe6\x93\xb4\xa1#|~\xd3+\xaf\xa6-\xc0\x95\x8dS\xedY\x03\x17\xf5t\xa6\x0e\x1f\xbd\x95\xa1\xc1\x1f\xf0\x0e\xefv\xbf\xb0\x9bMF\x9a\x06\x8d\xb3c\x9f\x8eo\xadkem\x15$R\xdc\xb8\xc0\xbdq=\xfam\x9c\x9a\x98\x0e\xe9\xc0\x1c\x0d\x84\xcf\xd3\xb9\xd6\x8f\x1d\xf8k\xb4\x08\x8b\xca\xfd#lwMf<Q^\xdc78\xc7\x9b\xd8*,\xa6\xb7il\xcb\xa7\xdfX\xf2\xe9\xb3z\xbfgT$\"\x1e\xec3\x06f\xa9y1\xb2^8\x11\x8b>\x98\x8d\x07rV\xdc\x93?\xb2\x1e\xf6D\xef\xc2\xf1\xcf\xb4\x07\xcb$*\xca\xb8\xda\xef=\xe7Y>\x1d|\xf1\x1a\xa2\xb8\xc4\x17\xee\xabi=k\xe7\x9e\xd63|\xe0\x0f8\xde\x8at\x10\x80\xf5\xc2\xd0\xedK=\xa2\xb5$\x87\xb6\x80!\xcc)\xf5	\x04x\\\x7fB\xb5\xb5\xa9\xb6\xc9\x08\x95/b_A\x12T\xb4\xf6\x00v\xc8\xf5\xc2+\x06k\x15\xf3\xae\x02/\xef\x1fTI`,\xd30K?S\x14\xd0\xa34\xd8\xb7\xa4^'B\x1c\x88\x8ex\xf0n\x17\x82\x1f \x12f\xc5\x18\xa4|\xfae\x93\xa02,\xc8R\x0e8p\xe2fa\xeb\x8c\xae\x17mo\x0f>\x02x_\xe0\x1e1`\x1a\xaa\xb7J>I\x9b|\xd3\xbc?\x9a\x05\x10\x9d\xa6M\xcei\xd1\x1f\xcd\x94\xc7M\x9b\x14h'\x167\xd5K\xedF\xdd\x98eR\xeby\xf3:Y\x12\"\xa2\x03}n+\xf5n93&.1\xd91\x03\xb6\xfb\xe0'k\xdb=q\x17\x83\xe9Lp\xd7\x88QK\x1bO\x06\xc3\xba\xc2\xaa\nDg6\x06\xc25\x1d\xf5\xd0\xaa\xe6\xed^!X\x8c\x9b\x84\xf0\xaa\xda\xcd\xe0\xc9}\x05\xee\x99\x17A\x90X\xeb\xf1'\x9e\x8c\xa3\xcd\x91Z\x9b\x03\xab#5k\x0f\xe7\x91?\xadg\xdd\xae\x9b\x8eu\x8f\xa1\xb2Y\xb3\x1f\xa1\x0fj\x1e\xdfY\x03\xf7\xcd\xc2K?\xe1s\xa8M \xcf\x149\x9cc\xdd4\x99\x8bd2/\xb0G\xe0\xc2\x8e\x83\xa9.\xd6F\x7f\xe3\xe1\x1eK\xc2\xd4\xdc1'\xfc\xa6\xe9\xd5\xa3W\xfb\xdd\xae\x1dh\xee\xcc\x98\xb6;\xc8/	f3E\x9c\x1e|=\xcdg\xb4Y(\xb0\x0bg\xaf]\x14\xc9\xf7\\	\xee)\x16\x97\x8b\x99\x06\xf4!\xfdU\xcc@gf\xbb\xf1\x7f\xb6uo\x86o\xa9\x0d\xdf\xa2y\xc3F\xdd]\x1bq\xd0~o\x07F{\xc5\xc3\xe9\x903\xaf\xf3\xb9c\"\xb9z\x1d\xe5\xef\xd3\xf1\x01\x11[\xff\xd5 \xf5|\x03\x05\x1c\x1f\xc2\x12\xc1\xff\x86\xae\xa4\xd8s5\xbb\x10IR\x92*\xdd\xb2t\x03\xd8\xffsq\x14o\xdb\xeb`Iw\x0c\x8a\x07C\xfb\xf5:\x8c)\xc8SB0\x83\xe0v\xd3\x89\xc7\xae\xd76\xc4_\x03\xe2\x07\xbe1\x8c\x99u\xe9v=\x9d\x9b\x10XunvfD~\xc7C\xfd\xbb#QO(\xbf]h\xfbw\x1dXTq\xd0\xc2@\x81\n~\x7f\xc4\xd7vp\xbf\xd5w\x1bm;\xfe\xa5+O\xfa\xdc\x95\xa7\xe5\xac\xa7\x03y\x8aJ\xfeq\xe7\x95\x1aq4\x86%3L\xe0\x87&\xa4\xe7\xb7\x059\xbcm\xe4*\xf1B\xc8\xb9\x97\xb2\x11\\\x8aHn\x18\xd1\xaf\x82e]\x05_\xdcXn4\x1c X\xf6^\x05\xd1\xe8\\\x16\x83\x10\x01%\xc7\x1c\x8eA*\x9bXH&\xfa2\xf2\x85\x0e\xeb\xe9\xa6_\\\x1ac\x1c#\x12\xbdV\xc5p\x0c\x07)\xe5\x9a\xca\x19\xcd\xfa2\xf6\x85\x0ea\xe9\xa6\xc3\xbc\xf0\xd7\x85G\x06\xa1\x1a)b!\xb9\xdf\x1f\xc3\xe8\x13\\|\x80\xa4\x0bU\x83?^\x00u\x17H\xddgR.\xf6{o\xa1\xd7<X\x0c,\x9b\x06gRi:p2\xd5:\xd0&\xbbvn\x03\x00&j\xac\xc4H\x03\x1e\xc6\xa2\x0ct\xc5\xe4v\xfb\x9e\x83\x16\x1f\x9a\xab\xc6\xd2\xecT\xb6\xab\x80\x06/\x08\x05\xfe`B\x82L\xfd\x84b\x82\xca\xfa\x90lY{\xe1\xd3\x1bm\x16\x16\xe8x\xaa\xca\x08c!\xd8-\xcfi\x00\xf7z\xbf\x7f\xceF\xc3\xc9\xcd\xbe\x88\xb0)S\x1b\xe5U\xb61=jR\xb0\xb6\xe8\x81Jw\xdfs\x85\x9cA\x99\xff\xff\xe6f\xe1\xc4V\xa6\xdfOe\xfa\x1d7zr\x9d\xb6\x08\xd8\xaff#\xea\xc3/\xb7\xd1\x01\xd9\xe1U\x03\x03\x8e\xc1\xf3\xb1	m\x9a\x13:q/\x11\x85\xdf\x873\xdc\xc8y3\x9a\xf5R\x11\xfa\xfdTX\xdf\x84[\xfa\x06V\xb7V~J\x1d!\xb5i\xd7\xb7\x0e\x81@\xc4i\xdbt\x9d\x91\xddC\x93\x0c\xcbhlw\xaf\xb6	A\xd1\xec~\xda\xd8E\xb3\xd5\xc7\x0d\xee~\xa5\xc5[\x8f\xd0\x8a\xd6\xbe%\xf5\x00\x870\x8e[\n`|\x93(\"\xa1\x81@X\xdaf\xdf\xc6\x14\x9dm_w\x0b\xc0\xbc\x1ai\x9d2\x18\xf1\x80\x07\x82\x9bWS/c!\x80{\xbc\xf7J\x7f\x02<\xf3\xe3\x88\xdb\xa1\x1fl\xbd\xd2\x87\x87\xb3,\xe1\xe2\xa0\x8fI\xcb\xde\xfb\xfb\xd6\x01U\xd5Mp\xf3\xb8\xf6r \xc7\x99\xb3\xcd\x17\x91N\xd2[\xcc\x17\xab\xc2\x9b\xban\x04`\xc1S\xb5\x89\xd5r\xdb\xef\x87~\xcfK[\n\x85\xfd~\xe4\x9f\xebTe0\x89\x8c3\x87E\xfb\x975\xcf\x82\xe5\xc6\xa5\xbcy=H\x11\x12\x14\xdb\x95}\xca\x9bV\x8a\xa1H[\xba\x0b\x7f\xecT#\x0b\x9b\xbc\xaf\xb4\x89\xf9wP\x01\x90\x12\xd8\xb2G\xf8~\xe1\xa9\xcb\x1bI\xa5\x05\xd1^\x08\xdat`_\xa3\x15\xba\xb6\xde\xfc\xd3N\xb3\xcf\xdd_\xccp\xf3T\xa42\x05\x05x\xda\x8e\x82z\x9a\xce\xc4\x0e\x7fG\xfdt&\xb6\x97AB)\xf8\x8b\x14kW\xfe\xf6l1\\\x8a.\x84\xc3\xd1\xa3\x1c&\x9bGe\xfd\xbb\xa5\xfef\xb6'\xda\x06\xb5\x88vA\"\xcaa\x90\x8a\x12x3\xf0C\xbb\x86\x022(D\x92\xc7\xeag(\xa2\xac\x88>=\xa6U\xe2\x06\x85\xfd\x9d\xb9/\x0e;\xa4\xd9\xde\x17)0\xcc\xd3U\xfb\xa2J6NJ%u\x13\x96uR\xa5!n\x00M\xa7\xff\xb0\xb8\xb2\xce\x16\x07S=\x88\xd3\x15p7\x9aL?X\x99^\xd4\x8eW\x86\xe7\xbbY\xbfr\xdagansC\xa7\xe9\xb4\xfa\x84 uB\xff\xe8\xa5\xd3\x8c~X6\xdf\xffX\xfco\n\xf9\xfb\xb3i\x18)\xbd\xb4O\xbdq\xec\x04\xad9\xd6\xf5\x82d\x12\xb1\xdc%\xa5\xa2^`s\x8fB\xbb\xb3\x1e\x1a|O\xfb\x95f\xce29\xf7\xc2\x13\xde\xaf/\xa0\x12b\x1dMC7\x8d \xac`RBB\x7f\xf6\x05)EbG\x96\x1a\xcd\x98c\xc5mY\xa4\x10_!n\xd4J	\xf87\x83\xdd\x10\x91\xea.689D,\xb5^E\xac	MR\xd7\xadS\x17\x00\x1e\xbaZ\x8f{\xbd\x85\xff\x94\xcb\xe5t1;\x8f\x1a\x8b\x18&/\xf6\x97\xd3\xc5L<e\x18!Z\xbd\x01\xe4\xa0P\xfbM\x17\xf0I\x17\xbba\x90\x8b\x9dI\x19\xcd\xce\xa3C\x83\xdc\xb9\x92\xa3\xf1\xea*\x1e\xf7z+?\xef\xc9l\xba\xea\x8fL\xbe\x13\xd5\xae\x9e\xadvE\xd5\xae\x8e\xaa]\x99\xe2\x1c\x19|\xbd\xd2\xfe\xc1Z\xce\xc4\x03\x90*\xc7v~\x82\xb7\x88\x8e\xc5?\x14%\xe4O\x95\x1c\x8e\xab\xabt\xdc\xebUM\x01\x1a\x1e7\xbbJ\xc6\xbd^\xe6oz\xb2\x9ef\xb3i\x85\xaa\xc7\x9b\x97!\x1d;\x1bX\xd0\xd0a\xb5\xf1\x0f\xfa\xd3\xa5\x1c\x8e\x97T\xde\xd2/\xa6\xcb\x99\xf4\xc2~>]\".\xfb\x18n\xcd:_$\x87\xe3\x88\xf2EZa\x98O\xa3Y\xaf\x98F\xb3q\xcc5\xc4\xe6T+\x07\xbb\xa1,D\x89i\x97\xa1\x0d\x99\x96\xac\x9asyH1\xd5\xb5\xed\x90spS6c\x06A\xebx\xbc\xf3R\x1c\xa0\xa9\x84A!\xa1\xfc\xa5\x87?\xc9\xc3\x9ej\xa9\x16w\xa5\xa4NOe\xe2\x8b\x94`\xbdR9\xf4\xc5\xce\xe3\xcf\xfd	\xff\x00\x1b\x94\x06\xfc -Q[\xba\xd2;\xd9$\xc1\xaa\xab\x1c\xa4\x8b\xbc(\x13Y*\x9b\xc97\xf4\xd4|V\xb8y\x94\xa5#gr\x80\x01\x98W\x82y\xe2JY&\xcf?\x01\x83\xd6fW\xa4\xa3ldi1\xbe\x88\xd5\x17\xebO\xda\x88\xd9\x81\xa4\xc7\x99\xab\xcc\x89@3\xf0\x1ew\xa8\x06C\"\xb4P\x0fJ\xff\x08\x10\x02(8\xc2\xd8h6\xb6,\xaeEh\xee\x9ar\x16\xb6]8\xb9\x06\xe4mK\xf1\xc2:V\xf2U\x1bm\xe8\x08\xa3\xc5\xd71\x19t\xf2\x03\xfd\x851d\x87x3\x84\x9c\xd1:\xc8\xfd\xde\xcb\x1d#A{@Z6V\xa6\x02\xb0\x9b\xa1\x96\x0c\x87x\xca\x8d\x9c\x18O\x80\x8f\xae\x07q\xb2n0\xcfS\xd6\x85\xa9\x8b\x13`r5l\x9f\x9d\xce\x8d\xd2\xc43l\xbd\x9e\x16X\xf3\xffQZ\x07Z\xd1\xa6\x13\xe3DU\xfcr4\xf6\x81\xc8\xaf\xee5?\x16\xb11\xf8@X$(\xf9\xf3(\xa9\xea\xa2\xac<\xe8\x90\x9a=f\x9b'\x84f\xb4\xf9\xdb\xf4L\xca\xf8\xd3\xe0\xc7w?~C\xfb\x8c\x9e\xee\xbe\xb9\xfdvB\xc2\x9c\x80S\xae\x7f\xbc\xf9\xe6\xee\xc3\xbb\xf7*u\xbf\xc7\xd1\xae\xeb{\x87\xf0B\x81\xf5\x02\xd6`I\x1e\x87yM\xaf\xac\xea\xcb\x95{\xe6\xeb6B\xea?\xe6\x18ee\x92\xcbj\xa5B^k\xfc\xbdn\xf7Y\xaa\xc1E\xda\\^\xc5\xb5\x00\x06OW\xdd\x91\x12\xf2\x95b\xfe\"\xd13\xc8@\xbf\x89\xa6'\x9d\xb0\x8a\xc0\xd6$c7\x83.\xa2iz.=\x1b\x10\xb5\x9fX\x0f\xe0\xa9	\xbf\xc7\x9c\x98X<\xf9\x84}}\xe9\xc8\xea[\xd6\x9a\x94\x9f\x1cV\x03\x87U\xcd\xb8\x13\\\x08Y\x89)'\x8f\xb9\xd7\xc0\x9b\x99\xb6Y\xd0Z\xdaZ\x1c\xba\x03\xf8\x93\x84\x10\x98\xce\x00!8\xcdg\x17\x97\xdc,\xc7\x1c\xa3 \xb1\xd6\xa4h\x03\x06\x19+\n\xcd\x16\x90f\xcf\x0b\xfb\xa5\xdfo%\xf5J\x0d)cub\xf3\xa5N\x0c\xc5\xc8\xee\x80\x91\xd1\x81[.$\x18\xe9\x8b\xcb\x06\x15^\x8ff\x88\x08\x05y\xbf\x10%\xfd\xe8\x15\x08\xff\xd9Og\xc0\xd2\xc0\x1f\xc5`7>\x894\x1a6O\x13\xfa\xd3t\xd6?J.\x91|\xdc\x83%\xf7\x80\x8f\x1c{hq\x85L\x8e\xf0R&8H\x02\xc4\x9b\xf5\x8e\xc1T&8\xea\x83\xda?=\x0bl\x14\x93>;\x0b\xa93\xe4y\xbf\xf0\xfb\xad\xa4^ao\xb7\xc8\x1d~,\x1c\x96\xd2\x88\x0e\xdd\x1e\xbe\xb0\x82\xa6\x9dERwz\xa9\xba'@@\xdb^H\xa2\x92\x1d\xd5\x9eN/\x15\x99\xfc\x1f\\Q\xd3j\xc6\x0b\x89~a\xfd\xe8AQmc\xc1e&3\x1b^\xc3\x17\xd9\xd1$\xc5\x0d\x83\x01v\x8a\x86\x11d\xbb\x03\x87)*G/.\xa8H\xc2u2\xf6\x12\xf9>\xf7\x10\x15\xbd^\xe2\xa8c\x1f\xa2m\x90\x0f\xb6\xb8\x10\xee\x82|\xb0\xc3\x0fb\xfa\x83\\I\x07\x91\xc0\xf7\xb8\x9c\x99\x7f\xc8\x9b\x8d\xd0\xcf\xf7\x07\xf7\xf7\xd1\xa6\xaa\x8b\xd5O\\\xb0\xacM\x1d\xa4\x04g\xf7Q#\xc0M\x1e_\xfc#\xf1\x9e\x0e\xd6\xa7o\xe0`\x8a\xeful\x96\x01}\x13tp\xe9u\xbe\x8c\xef\xdc/q\x1f7\x1f!w@5(H\xdat\x10-\xc3\x92\xa2\x1f\x0f\xea\xe2\x97\xf5:)oB\x00(\xf4\xb4o\xc5\xc871=_$\xa6P\x0e\x9e\x03\x0b\x17\x99\n\xa5\xa5\x945\xfd\xb1E\xc6\xf3\x95\x8e\x1ai\xc7\x1a\xa6@%\xb9\xb2\xf3\x85Z\x04\xb3\x92\xab9\xe8v\xbdJ\xfd\x92\xa9N\xf3\x89_e\xd9\x06e\xd0\x0f\x96\xd5+\xb7\x96\xf2\x92x\x892\xd2/Te\xfb\xb3 KQ\xa6\x8bT\x15\xa6~J\x9df\x17\xa4\xa5\x17\x94O?\xc8\xdc\xfc4\x8e\xbf\xc4	`\x81\xc1\xcc\x84\xfa\xe5?\x91\x8d\"~\xc2\xc6 6\xe4Ypt'\xa1\xa3;Y\xbb-\xf1\x9f\xd6+/\x11\x1b\x01LL\xfaX\x948\xc5\xb0l\xf5D\x1fU\x84A}\xa6\xb2\xbfP\xb68\xcb\x06\xcb\xb0z\xf7\x98C\x9b\x94\x94\xf5\xce\xebh\xf9+LX\xb3\x01\xa4\xab\xdd\xae\x97\x0dt\xb2Ti\xcf~zGrX\xf5\xb1\x92\xc9\x9a\xcf\xef\x18[\x98\xd3\xc9n\xef\x84\"\xc5Ec,}hzi8\xb4y:\xc7\xc9\x00\xc8\x05\xa7\x8c\xb1Y\xa5\x84S\xc6R\x8e|\xb1\x86\xe9\xfa\x13\x8b\x989O\xb0<\x1cD\x01\xde\xa7\x9c\xb0\xc3@\xe5\x07\xe4\xabQQ\xba\xe0Tx\x02\xe6\x83\xcf\x97\xd8\xdb\xccB\xe7\xac\xb4\xc1\x88\x8d\xce\xa4^\xbf\xdf0\xc7\xdb\xed\x92\xad|+\xd5\"\xbb\xebU#\xd2\xf1\x9f\xf8:Bz\xf0\x02\xe0\xb6P\x03\xe2Q\xe0\x1f\x99#\x02c\xf3\xed\xc2|\xdbv\x11gE>\x14Lr\xe9\x8b\x87n\xd7c\xfb\xcf7\xda\x19\xc1\xab}\x01\x8c\x1a\xc3\xf0>~\x02H\x87\x95\xf0\xf1\x93/\xd6\xcdW\xccP\xd7\x0d\xae\xe6\x83<\x1b\xf9\x07\x0c\xb8\x01\x88)\x93<NJd'`\x98\x13\x16\xea\x1b,E,\x9c\x8d$#6\xb0\xa87D\x91\xaaI;\xc1\xf3\x83\xb7\x9f\xa6\xcabo\xe6e>{\x15\x88\x88\x82\xbc\xaf\xc5B\x96\xde\x93\x16q\x07\x8d\xb4[\x98\x008\x81%\xf3F\xea\xbfJ\x95\xf2\xaf\x12O\xaf\x93\x874J\x08+\xec=6\xb1z\xd7Ne\x0b/=\xc2PM\x9bc\x92'\x8c\xd8\xe1\xa5\xcfq\xec\xe8\xdfF\xcc\x02\xba\xefC\xe5\xcd\x9a\x0dS\x10M\x9c*\x01\xb4$G	\x00\xad\xe0\xdb\x815\x15\xdbO-\x17\x11\x9f\xd6\xf5\x19\xe9\x81J\xdavr\xad\xbe;\x06;\xcd\xad\x18[\x9ad3\xf2\x0d\xccZ\xcd\xde\xa9|\x91\x17^	m\x11t\xf4O\xd0\x06\x91\xda\"X\x8b\xb4z\x9fD\xa4r\x81\xe0\x106>\xd8\xd12v\\MXY\xcb \x90\xdf\xb2|>V\xd2\xfc\xdc\\\x0e}\xf1oH\xe7s\xdf'\x83^X\x19\x84^\x89\x0bcy\x10\xce\xee\xf9\xaf\x0c\xd5\xfd\xf1P\xe9>\xa3ss\xee\x9c\xdb!\x18@\xeb>\xcdO\xf5\xe9\xfa\xb9>\x19gG\x10\x89\xffr\xaf\xd5%\xf5\xaf/1\xcb\x99+E\xf8P\xf11,\x95\xf4\xcb*\x83l\x0e3\x1b\xfdS\xdff\xc2\x0c>>!\x0c\\\x9d\xf7\xf0\x98\xac}\x08\xf5E\xb4)\xcb$g\xad\x08\x9b\x1c6e\x9b\xf5O\xfc\xe7\xcd\x89\xbc(j\x0e\xb5\x9d\xf9\xc6\xb8\xc8\xbeX\x82$\xc2\x0d_l\x06\xe1:\xc5Y\x0f\x0c\xe4\xa7\xa8\xc81\x03\xc1\x93\xb6V}\x13\x075l\x99\x94p\x03\xb6O\x013\x13\x96\x80#pt5\xc6\xb07\xd8\x18\xc3b\xb6L\x84RP\xf9\x1a\x05\x06\xb7T$yT\xc4I\xb0Z\xa9	\x06\x1b\xe6\xe1r\xf2`Y6\x9c\xea\x82\xac)\x8b\xa8\xba\xdd\n\x83\xf9\xa7&\xdd\xb6!\xb7X\xf9b\xe1\xa3\xdf\x96\xd0o\xd50\xa6\x96\x04\x1ew\xd9\xc6\xad\xe8\xf4\x1d\xce!7orXx\x90\x94\xe2\xac\x18\xa4@\xb1*C\xba\x8ai\xe5|\xa1\x06\xe6u\xba\x1a{\xc94\x9cI\xfc\x83[\xb5?m^Q[g2?\xb8p\xf4\x0f\xabS\x1a\x08\xaf\x96;\xf7\x85\x0f\xcf\xc9\x13\x8e\x8a\xb5\x83\xba\xda\xfa\xe8IKE4O\x0ec\xb5\x92\x98\xa8\x13\x0c\xe6~oX\xf6\x12\x16\x8b\x9auv\xf2k\xd6z\xbfoX2\xcaM\xa4X1\xcb\xce\x07\x86\xa1\xde\xef\x0dke}\x80$'?(\xc8~\xef\x15\x0d`\x87`\xbfS\xb6\xf2\xe0\x88\xe0\x95<\xabiN\xd4\xa1\x1c\xaf k\x14\xcc\x08c\xd4B\x84#g\x9e\x07uj\xfb\xf8F\x94\xe1\xf9\x98$r\xdc\xd7IH@\xa90\xd2\x9a\xcfo8\xf5\xd5\x0e[\xc5\xdf\xae\xbc\xa7\"\x8buj\x90\x89<y4O\x1b{\x812\xca\x1e\x9c\xdb\x89\x19\x08rA-a\xcf\x84 <0\x12\x80\x965)\xb9\xf0\x86E.\xe3e\xaf\xe7c2U\xc3\xaek\x0fg\xa1\xd8\x004<\x17\xb5\x08\x95L}\xbc\xbc\xca\xec/\xb2\xe9\x12\xb0\x8f\xda\xc1\x15\x8f\xbe\x81\xa0T>\xd7\xb4^\x9a=\xb2\xa5=\x82\xeb\xcc]\x85\xa0/M\xefD=\xb0z'\xeeW\xf8\xaf\xf6\xa9\x90\xc7\x95\xcf\x92J\xfa\xc9\xd5}\\\xf9\x83d\x9bD\x9b\xda\x89Ss\xef^\xe2\xd1>\\_\xdc\x833\x9d\xa4\xc1\xdd\xa7\x9eu\xa5yt?\xc3\xdd\x9f\xc9\x99P\"\xc63YOR\xbb\x8d\xd3zF\xe7\xdfx\xb7b\x0b\xa1d\x92\xda]\x9a&3>*\x1a	\x10)t[\x93%R\x85\xd6\xca\xf9\xac\xbe|\xb4H\x8a\xd3\xa4T&NM\xf5l\x9c\xc2.\x96\xca\xd1\x03d+\xf4\xeeVm\xdb\x82\x94\x1d\x18\x9aKc\xf1\xec\xa5Q{\x1a\xe0\xd2\x08\xb4\x1a\x8d\x0f.td\xde\x10Q\xc6\xee\xef\x93\x88\xf7\xf7\x9b\xd8\xf6~|{<%\xe0\x1a\xf3\x1a\x8d\xd6\xf7\x04\xeb\n\xf4\x8ce\x16\x17\x03\x82\xc6]\xfc!\x81h\xdf\xa9\x176p\xdc\xff\xda\x16\x8f\xbcYY\xd4\xbfVx\x1b\xe0\xa0\xbdi'\x8d;\x02\x01\x98\x93\xbc~\x83\x9f_!\x80t\x91\xe3*\xf7\xd0\x11\x1d\xed\xe6\xda\x01vq\x1e\x85\xb5W\xbc\xb5\xdc\xa0\x11T/\xc9\x92:y\x81`&\x07\x87>~\xb0;\xaeW\xa0kg\xa7\x18\xc83\x89\x8f-\x0b\x1e\xf5\xe8\xa5\x84\x88\xee\xe0J\xbdv\x07s\x90l\xd3\x8a(\x13\xac\x86%\x05\xcc\xc4\x11\x82c\xf7,\xe1\x8b\x17\x86Y\xfd\xe6iW\xc4M\x1a	\xf3\x1b>\\r\x93\xf2\x8e\x04\xdb\xe3|\xd2d\x91\xf9 \x8d\x03U\x96IK\xcdO\xff\xe0~K\xbb\xa6\x99\x80k^\x81z\xfd1\xea\x18\x89*\x13\x98\xc2\xa7\x03\x1e\xf8\xfd\xbe\xb3J\xcaE\xd2\x19\xf3_BJ+'^\xee\x95\xa2 V/\x05\xc3Y\x88'\xa5\x95\x01\xe0\x94bk\x8b\x90\xa2\xfe\xfa\x01\xca\x94E\xd0aK\x0dUD\x93\x88\xc5Ci\xdd.\xbc\xc5\xe8E\xcb\x07\xff\x86\xc7\x99\xe8\xfd`\xf9 \x13\xfc3\xfd\x80\xcb\xf6\xb4\x03\xd7\xd2\x8e`\xbb\xb0\x99/8\x19n\x95B\x9by\xcd\xfc\x99u 8\xd6\x84x$y\x01\x15N\xbfd\xc2\x7f\x87zr\xb4\xba\x9a\xf2(\xa9\x02\x1a\xa1~\x0c\x1d\x13\xcf[gp\xa1o&\xef\xe1N],\x16Y\xa2\x82\xb0+ <\x8d/\xf6\"UH\xe9\xdad\x1d\xf6\xbd\x0b@\xd15+\x1b\xf1[\xb5\x03e>I\x01\xe3RQI\x9d\xa0\xb3\xc99\xb2\xfb\xccSR\"\x00\xceOk\xf3D\x86\xf4i\xa5k\xd6\xc9\xd8pi\xc3\xa75u5d\x0eo\xbd\x0erw\x88	\xea\xfc_9d\x08I\xb7\xdb\xa1\xbfz\xa1\xba\xc5\xfb\xe3\xa2-\xeeH\xfc	\\$$\xfe\xe9v\xf3\x00\x7f\x89\x1f\x82\x07\x1e\xca\x0f\x12\xcd\x8b\xaaR\x1c\xdf\xbbO\xee\x88\xaaK\xd9q\x18WcYj\x0c\x11E(9hwz\"\xb6k\xda\\g\xc1a\x97\xf2g\x04\x1c\x0e\x11\xa3\xab|\xebqY\xca\xcb\x1b7\x12\x1dX\x1d\xba\xac\xefB\x80\x17\x97\xec\xe1]\xf2B\xf0\x05\x81\xb9\xab2\x9a\xd5\xfb\x93C%\xfe\xb8\xf3\xda\xcd\xc4\x0c%\xed\x98Q\xa4\xde\xea4\xd7\xcdFD\x98\xab;j\xf2\x8c\xf5\xa1h\xc0\x96\x8c%a=\xd8\xf6S\x18W*s\x8c\x14\xfaz\xd7\xbc\x02\x81\x08z\x94\x85\x05\xad\xc6@\x03\x99{)\x8c0\xcb 9mxH\x0c\xa0\x83\xe7\xf4\xf9\x12\xce\xde\xd6 \xfc\xc8\x83\x90<\xe3\xc6\xa2V\xfc\x07k\x8ft\x04VD\xe0\xc4\xdax\xc7+\xa1a\xd8Sm\x0b>\x80\n.\\\xb0\xbc\xa0Zg\xe1\x0e\xb1\x99H'\x07]e\x01y\xd7\xf7P\x8c\x92\x91\xc7~\xff\x17\x1cj\x8e\xefJ\xd4\xa4D$\xdb(\xdb\xc4\xecJ\xfd&v5H\xdf\xfc\x7f\xd0\xc8\xc6y\xe8\xbf\xd6\xc6\xf7\xdcF\xbd\x83\xa7#1\x9a\x8d\x19\xde\xe4\x1d\xady\xcfg\x84?9\x04\x8d\x07\xc3\xc2\x95\x7f,\xb6\x1da\x91{\xa7\xe4\xcf\x16\x9fD\xa4\x19\xbeW\xbdN\xf5\"\xda|L\xa3\xfe\xc7\xe4s\x9a\x94\xdepp\xf9\xb5x1\x12/\x86\x83\xaf/\xc5\x8b\x91/\xeab\xfd\xd7r\x9aX\xfds\xef\xd5'\x87jqz\xddS\x86\xa8$\xb4\x0e:\xbd\xe4\xe03\xce\xca\xd8\xc6Yye5\x94\xc2\x991\xb9\xc3M\x1b\xddjL\xc2-\xebX]w\x0e\x0e\x8f\x0c<\xd4F\x0d:=`\x0cq\x12\x8a\x08\xe0rFB\x0c\x0c%\xf9\x7f\xe8\xd70\xfe\xed\xf36\xeb\xf4,<\x8d\xaf\xcf\xd3\x8bK\xbf\xd7Yo;\xbex\xff\xc9\x9bvbx6\x84\xcc\x02\x850\xb5\xb3\x05\xcef\xfaT\xd3S\x7fl\xb5\x0b\xfcr\x9f\x14I\xd48b\xd5N\x0c\xc3?\x9f\x19\x86f\x08_o\xb8\x05f@N\xd8<\x8b\xc2Q\xf8\xa3r6\xa0\x17\x1a\xf2\xb7\xa1\xcbz\x14\x9b1\xf9\xac\xeep\x90G\x15\xf7\x1c\xcf\xe0n\xb3^\x17e\x9d\xc4\x13=pM\xad\xfd\x1b3\xea\x81\xf7\xec\xeb\xff\xa3\xd3\xfb\xa9\x86\x07F33i\x06;\xf70[/C\x8fI\xab\xfc?\x87~\xc7\xf7y\xc4\xb5\xea@\xd3_\x04A\xd8T'G\x9d\xe9 \x06Y\x14\xf2\xf3'/o:[h\xa9bh\xfa\x98\xd3L\x84=m\xfd@\x1a\xa5N'\xa0\xe9V\xf3C\x8d|\xb5\xf2\n\xdf\x17\xed\xcf\xf5\xf0\x05\x9d\xde\x1fw^\xa8\xd7\xf4z\xfb\xa2\xa3\x97\x8c5\xc1\xbd\xce\xb8\xd3\xac\xf5\xef\x98\xfa\xa6s\x0c\xef\xe3\xd6\xd8\\\x80\x01b5|\\D\x1b\x84\xfe\xe1\x08\x1c\xdfd\x14\x19\xc8\xeb\xc4\xe9\x83\x9ay\\z\xee?st(r\xbf\xa1\xa4$\x83\xc3\x0f~\xdd\xc3\\\xa69g/.9y'\x13\xdbZ\x1a\xe9\x83p\x0d\xaf\x1f\xbac\x9a\x88*\xf7\x8cZ\x91\x94:\x94\xc5=\xc4\xa0\xf2\x8ccT\xdc/\xd38\x01\xd8f\xb1\xa9\xc7\x8d\xc6t\x9c\x0e\x8a\x9c\x8c\\H\x1b\xd88.\xf9O\xc5\xe0\x9e\xd2p\xbf\x83\xdc>K\xc2\x92\x0b\xf0\n\xa7<xLsm\x08\xf85\xb8O\xf3\x1b\\\xf0\xf2Z\x9e\x0d\x0f\xc2T\x01n\xb5\xa9!!\xb9 \xd9\x08<\xa6y\\<\x0e\x92\x07\x80\xc2\x9c\xd9\x15\xeb\x93?\xd7\x0eY\xe3,\xc5t(\xee\xd9\x9cB\x8e\xe7Z\xe2\x1f\xacJ\xc9\xdd\xeb\xf9~q\xcb\xf1\x03#t\x1b\x02O\x83\xbbG\x08|\xed\x0e\x8d,\xe6\xea\xd7\xb6\xdd\xcb`]\xac=\x7flb3\xeb\xe6\xeb\x17\xb8t<B\x1a\xf6\x865\xf0\xc6\x0c\x8c	\x87r\x7f\xa6\xb8\x00\xf0\x12\xafK8\x85\x183\x10|\x9e\xca'cqN\xe0\xde\xa4.}\xf1X\xc0\xfa\xa4\xf1ki,\xb7-\xe1\xc0\xb7\xbc\x94y\x01\xb7=\xcd\xf6\xfb\x95\xd7\xe61\x1cK\x9c\xef\xcd\xe9\xecjL\x7f\x01\x05\"\x18\x14\xa2\x00\x84\x7f\xa2h\xb4\xa1\xf9zCzu/\xeb\x85/\xf3I\xdd\x97Y/\x0cj\x84q\xd5\"*\\\xc9z\x9b^\xf9\xb2\x98$}\xb9\xe9\x95A\xd2\x93\xa5/\xa6\xb5H,s\xa3\x7fY\xcd\xd0\xc2D\xd5\x04\x00A0r\x90\x0c[M\xa8-W\x82^)r\xbf_\nFdBZ\xd2C<\xa7~%t>\xb8\x10\xc0\xab@\xe7	\xb7^\"\x86G\x8d\xf9\xc5:\x03j\xa0F&\xb9b\x96\xe9TT\xcfJ\x07\x84[\x80!\x14q2\x0f7Y\xfd\xcf4y\xecvO\xa5bA\xe0V\xb3\xa9\x93\xf8\xae\xd1\xa4\xe6\xf2T\x11G\x99\xe1\xce\x80\x03!\xe9Irox\x93\xd7d9@T\xf06\x99\xd7b4\xf4{\xc7\xaf\xdec\xc4\xda\xef\x14\xa9\xc6W\xaac'_\xd3\x97\xe6=\xc2\x12\x1f\x97\xfe\xa1X\xb7?\xe67\xaf\xc8\xa3\xe8t\xc9\x1f\x8a\xf5\x17\xeaU_\x9a\x0cz\xd9\xf3\xdd%1\x17\x16k\xdb\xfe\xc6\xebGs\x00\xe0\xd2E!\xc1\xc6\xb3\x05+\x02\xac\xf3=Vd\xe6\xb2:\xae\x1eS\x10\x9b\xda\x7f\x8aB\x98E\x90(\xbf\x13\xc0\x00z\xdb\xab..\xfb9`\xcfe2\xd8\xf5\xb2\x8b\xcb~qq\xa9b\xe4\x8c);.\xd5\xa7\xf2\xf6\x8b\xfe\xdf\xed||\xed>]l\xcf\xc9\n\x1e\x913\xf6\xf3\xfe\xdf\x9f\xaf[]\xf0u\x91\xbdVN\x1e\xb3i(l\xab\xbe\x7f[\xa2&m-\x82\x9b\xf7~o9zYR\xa2\xdf\xad\xfc/\x98m\xa9\xea0\xfa\x04\xaf\xb6\x0e\xa4[\xf7\xf4x\xdf\xe99\xaa\x92\xa6\x84?\xdc\x12\xe2t\xd5\xbc\xfba\xd5r\x0d\x88O\xc2\"0\x97\xa5\xf4'\xc4X\xb55\xbf\xae\xa2I\x87p4\xe1\xe5\xca\xffA\x9b\xa0p:\x9a\xf5a\xea\xed_\xe4F\x9bS\xc9t\xfa\x07X\x91\xd9~\xff\xf4Q\x9b\x12\x05\xa5(\x13\xa0\xbb$\xe6\x19r2\xfa}\x03=P0\x14\xba\xee\xef\xc2u\xd0\xb9\x1c\xfeGG,\xf0\xebk\xfc\xa2\xd1\xab\x82\xa7\x03\x0cT\xaa\x81z\x1c\xeb\x9adE\xe7\xfaF\xd2<\x8c\xb3\xe9f\xb6\xdfW\x03\xb7\x86^O\xe0\x85\xc4?\xfb=o\x92\xa1Xq\x8c\xbc`x`\xc3\x85\xd7\x05\x1b\xd2[1\xf0D\x89`?\xaf\x8b\xd3\xc1\xf5J\xe8\xf1\x9b7\xea\x9a\xcfQ;(\xefM\xd35\xc0\x00v\xbbgh\x85\x91\x1f-\x99\xce\xa6\xb9W\x0d\x9cq\x12K_4Y\xe5R\xb4\xde\xf7\xa1\x8f\x8c`\xc4\x81L\xba/2\xd2\xe7\n`\xc5\xaa\xc1\"\\\xc3IQ\x1d5\xf0s\xacLT\x8a\xef\xc2\xb5\x9ckD\x8b\xdc\xd1zY\xde\xfb\xb4\xc6 \x0dG\x06}\xae\xabI\xa0\x85f\xe6Y\xc5 \xac\xed\xe2M\xdc\xc1\x1a\xed@\x98A\x98*\xb8\xbd\x84\x8e\xc5\x9d-\xb1\x91^\xd5\x0f\xfd\x0b/\xebyY\x1f\xf1A\xfc\xb1\x13\xe0x\xe8\x8b\xe36\xea\xb6\xe9\x91\x00\xdb\x91^\x01;,\xe5oS\xc0\x12TP\x15\xeb\xd1w\xdep\xb2\xef\x8b\xaa/\xcd\xa3LE\xd6\xef\x83\xd9>\xd1,\xd1j\x96\xb6\x90 \xe3\xf9v\x03\xb9\xc0\xfd\xde\x08\x0d7\x84\xb3$\xa2\x9e\x16(\x9e{\xa3\x1el\x8b\x04D\x8bQ_.9\xb9T%\xcfe?\xba\xb8l\x17\x9d\xaa\xf9!#K\xf5w\xbf\x7f*(\xd6t0w\x856\x071?\xaa\xeb\xe08\xc3|\xc5\x04\xe7\xf7\x0fVL;}\xa5Ap;\xe6\xbcq\xaf\xb7hFC\xb1\xfe\xc1\x05h\x0b\xd2\xaf\xffs8\x13ni!\x19\x0d\xfe\x95\xc2~\xe6\xc2\x988*)8,sH\xd72i\xea\x0f\xb47M\xf3m\xfd\xe0\x9ew,\x89b\x92\x0e*\xe9\x88\xf88\nY\xe3\xa09\x88\xb6\xd8\xf59\x1d\x93X4\xd1N=\x8ff\xc2\x04W\xa5\x9d\xaf\xc4ZLY+^\x11i\xeeAG{q)2|\xc6\xb5\xf3@\xfa\xa2\xf2\xc7\x08mDz\x92I9\xa8\x0c-F\xe4\x97\xc0N\x18\n\x1b\\6yh\xa8~Jj%\x12\xd6\x92Q\xf9\"k\xa2\x8c\xa6\xed\x86\xb1=\x9f\xb2\xb93\x85\x8f.\x86\xa2?\xba\xa0\x0b\xcc\xf3\xef\xec`\xedN`Nv\x98r\x8f\x9a\xc6\x8a3\xb1\x8f\xa51\xe4\xc8\xae\x07\x94^V\xb8\xd6\xd8\xc5\xea\xb6l\xf2\xb4\xc8Uk\xbe-\x8b\x15\x95\x93\x08\x86\xd4i\x17\xc6\xab\xaa]V\xfe\xa7e\x1d\x0e\xbex\xc8\xf4\xe0\x88\\A\xd3P\x1a7D\x14:\xadYq&xk\xb7{\x96\x0f\x8a\x1c\xb1\xda5[\xee\x86n-\xe5\xd7\xff9\xbc\xe0\xe2\xf5a9\xce\xcd\xec\xe3,\xed\xc92\xc0\xdf\xbe,\x95Z\x8e\xbf\xc6\x11\x0b\xb3\xe9\x99\xed\xa0\x94\xf2\x1a\xa0\xd1\xa7\x8d\x988\xb8\x04\x1c\x9dJ\xee2x\x98\xd6\xdc8\x9d\x894b!\x1fH\xdd\xae\xd3#U\x9c\xa8u\xdb\xf47\xfc\x08\x0c\xafP/3\xe4O\x0cl\x1b\x8c\x019\xd7\xff\xad\xbf2\xee\x8e\x16\x94\xb9\xe6\x8f\xd8?\x12`\x0eVwS\x91\xf6<S\xd0\xa4\xff\xf5\x7f\x0e\x83\xaf\xffs\xe8C;\x05\x83\x19\xdc\xf2\x19\x9f\xd5\xba\xe0\xe5f\xa7\x03]\xeb%\xcc\xbaq	hb\x9b7!\xc8\xf4a\xc7\xa6q\xda{n\x8aoD:\xb3x'\xeb\x15\x02\xd8\x1ba\xfa\xd3v\x14\x10M\xd8\xe1\xef\x88\xdcO\x0bz\xc6_'\x80b\xf1\xe00v\xad\x0diz\xe9\xf8Z\x86\x0f\xd6\xc5\n\xc5\xc2\xe9L\xdf\xac\xfb\xa3\x19\"\xbb\xa6\xdd\xaea\xc4\xcc\x0f\xac\xac\xa2\x8c\xfb@B.\xca\xd8\xc7\xd8)\\]\xa8d\xe9\x86\xde\x8cXiF\x8c\xef\x0d\xdax7\x98\x82\xee\x11\xb1\xb3cT Z\xa26\xed\x16\xae\xc7p\xd0\x1f	\x07=\x15	\x0e\x1e+\xb0\\\x0d~)\xf46\xc6\xb0\xae\x01T\xd5\xaeO\x1a\xf4\x14\xea\x00[\xe6Pq\x83\xed\xcb/\x83Z\xe0\xf6k[\xd6\xc3\xef\x9c\x014\xf2E\x96\\o\xff\x1f\xea\xde\x85Km\x1b\xda\x17\xff*\x81\xdb\xcb\xb13\x82\x00\x93\xc9\xc3\xc4\x99\x95\xa6i\x9b6\xc9\xa4I\x9a>(\x87\xe5\x01\xcd\xa0\xc6\xd8\xd4\x16\xc3\x90\x81\xef\xfe_\xbf\xad-Y\x06&\xe99\xe7\xde\xffZw\xb5\x19lY\xcf-ikk?\x81\xfd\xfc\x0d	\xcey\x19\x97\xfe\x98hU\xa4\xc2x\xea\xcc\xf6f\xca+\x0c4j\xf1\x16\\\xb0\x82\\\xb76wd\x12>ET\xf9)\xc8\x8c\x89y\x1af\x80h\xd6\x99\xacG\xe0\x16\xcc\xf3`X\x88v\x8e\x88k|\xa8\xff+\x88@\xebuq\xc8\x9e\xbb\x14\x17\xbe\xd6\xbd\x80=\xcb2^x\x86\xd7\xb3xq\xc0\xd8\x1a:\xee\xd4\xf1\xcb\x18\xe1\xfa\x06i\x9c\xed\xac\xfc\xcb\xa3\\\x14\x1c\xb7j\x8e\xaf\xd7\x88\x8e\xdf\x99\xac-5\x06\xeb\x00Xo\xb6\xe7\xe1\xbd\xcb'\x9d\xe3S{\xba\x92M\xe7\xd3\xf9)_\xd5\xf8\"&\x98\xd85\xc5z\xa3\xf6\x95-\xc67+rT\xfc\xf4\xea\xd4\\\x1a\xed\x95p\xbb\xbbFS6._\xee\x98\x94\xcf\xbc\x05\x932\x8e\x94\xac{\x12\xb3\xe7q\xb0\x1e\x9abR\xd6\xc4\xf8\xd5\xa5\xb8\"\x82w%\xac7\x16\xd3E\xcdK\x997\xc5?KY\xac#\xb9\xad\xaa\x81\x01\xb1/D\x9d\x06j\xff\x1aV\xc8\xcb\xba*\x99\x06\xf5f\xe40\xa3\xbaX\xd4$\x86\x9bM\xa3GT\xda\x8d\x13uf,\xb1\xa1\x0c[_\x96\xb2\xf4\xd0\xc7\xdem1\xbc!\x8d\xe6\x1e\xd4\x1c\x18\xa3 F\xd3\x1e(\xd8\xdbf\xdd\x11\xce\x8cj\xc6\x91\xe3,\xa3aRsG\x1bW\xc1{\xe2[\xe6\x177\xbc\x10\x8b\x13\x9a\x13H\xe2\\R\xeeb\x13\xdb\xa4 \xac\xe6@w\x16E\xaes\x1ck\x9d1x\xafS\x99\xc5^\"T\xaa\xeeh\x0ekUM\xdd/\x9fjC\xb2\x9am\xf0\xcb\x17\xe7p\x90\xf6\x89t\x06\xaa,\x1a\x1c5u\x11\xfc\xf8\xac\xa3\xca\x8fI\xaa\xa6Pq\x03O\x9dQ\x8d\x11c\xe5VSTo\xc1f\xca\x82D\xc8P\x14\xc3|D\xcc\xc8\x1f\x9f\x05IX\x89_\xe1\xe7\x12\xde|\x92X!f\xa2\xa5\x98\xc1\xc8\x7f\x06aBS\x14nP\x9d\xf1\x98\x04\x0c\xdf\xe7\x85\x8d\xa9\xecj\x04}\x82\xe8I\x15\x1c\xa7\x0e\x8e\xe8]\xbe\xe7WC\xee\xce\x86\x0e[\xad\xd9U\x80\xd9\x87\x85m\xcez)9\x8c\xf5<\x1b=v\x01V\x9b\xc8\xcc\x16\xcdF\xe1)\xcc\xd2be^\"\xa77\x93\x8d\xfcur\xe1ajh\x15\xda\xda\xef\x14\xdea\xa8\xac\xec\x9a\x95\xa4g\xc2\xf7\xf7^2y\xd3)w3\xd5\xf8\xb2\xf0Q\x92\x8673\xd2\xfd\x807l\x1d\xa5\xecT\x9c\xa9\xdf\x15(\xc6\x97Z\xce\x83\x19!\xee\xc6\x04\x9b\xa9\x11#\x14#5\xfc:Y\xb8\xd5\x0c\x0d\x19\xba\xa4\xe4P-\xba\x88\xe5p:\x12\x8bx\x89\x9f\xcb\xb8+\xe6\xb1s\xe1v\xf9d>\xb8\xb4\x1e\x0f\xaf\xe2\xc5\xf0r4\xb8\x18^\x8dZ-\xfc\xed$\x8bE\xba\xe6\x91iQ\x8824\xa1\xd7\x96\x87\xb6$:\xac\xe2\x1f\x9fY\xe5{S\x0e\xeb\xaf4\x136Xb\xd3Zc\xea\x19\x9bT$\xa7\x06A\x05i\x18\xf1\xd30\x19\xd5\xae\n\x8b\xab]\xd1w\xb5E\xeal\xa4\x7f\xd3\x8b\x9c{\x01\xb70\xf9e!KO!\x19*d\xac	\x05\xf6kf\x82 T\xb4y\x16Vf\xfd\x05K\xb9\x02\xf8J\xc8\xe45D\x9d,1\xe0\x00\x0d\xfe\xbc\x154oe\xab\xd5\xe8A\xe1\xc7\x9b6\xf6?m\xabMY\xcd/;\xa5\xb6\x83L\x14\xb8\nD\x0546\x8d\xbf\x039\\\x8e\xc4$\xce\xf13\x8d\xbb\xe2\"\x9e\xd8	\x9d>\xb9\x18L\xed\x84.\xe2\xc9p:\x1a\xcc\x86\x8bQ\xab\x85\xbf\xb5	M\xab\xfdR\xad\xe7dg=\x17X\xcf^F\x9cDN#\xb7\xca\x83\xe5\x8c\x90I\xde\xb9u\xe9!pk\x9d4\x12*\x1e\xeeY(\xf1T\xde,pXWsQ9\xbce\xc4\x07GDE\x92]:%\xca\xb9\xc1\x04\xd08\xd9\n\xd0hUa\xde\xb3U\xd0Q\x10WTX\x94\xf10\x1bb)\x8c\x04\xff\x1e\xc1\xf1\x87\x1e\x8d\x9c\xc1'<r=\x81\x07.\xf8\xe0q$\xb7\x98_\x05H\x14E\xb8\xd9\xd0s\xcf=\xc3\x1a[\x94\xf6\xb9\x87\xe7\x1a4\xe6|\x8as\x03\xd2\x0b\x9e^\x0f4~\xe5a\x9d\xca\xf5\x82ak\xe7Ckq\x0e\xfa9yb\xdf\x06\x89\x9d\xf12\xce\x87	\xa9\xf2\xae\xabz\xa8\xcf\xa6\xb3xB\xf7\xaaCmP\xc4~\xac(\xaf\x98	\x0e\xcd\xde\xf2\xd3x|\x15\xa8\xb6\x16y\xbb\x10Y[\x8a\xa4]\xd2\x8a\xbe\xbe\nRW[\x8fY\x98\xe3\xab \xe7\xbc	\xe7\xbd\x97\"\xf7\xf2Iw\xb3Y>\xed\xd5\x0b\xcc\xaa\xca5W.Q\x803\x053\x94\x9a=\xedy(\xe1\xbav#y\x12\xf7d\xfbA\xab\xa5\x9f\xc6m<U\xf9\xc6n<\x0e\xf6\xfan\xd6\x96w=U\xc6\x15\xe7\xb1\xa4\x13x\x11\x9d\xb1N\nl\x85\xec\"\x87\x9a\n\xd4$X\x9b\xed\xfd\x15]\xdd\x07\xf2mP\xbe\xdd\xd9\x1aACm6\x0d\xd5Q\x19)\xa0l6\xeam\xe0\xde\x84\x0c\x9f\xc6\xf0\x01\xaf\x11\xec\x0f\xab\xd6\x1b\xd0ym@\xc3\xee\xe8)\x1c`\xe1\xc6S\xad?\x8fY\xff\xbe\xbe\x9e\xce\x8cT\xf5\x86[\xb2\xde\xc8\xcb(y\xeb7\xf2\xfa\xea\xa0z\x0e]\xe3\xdc\x1e\xc5\xd9*\x92\xf8\xfc*\xd83\x98\xaez(OY\xc7\xffC\x0e\x14\x17\xe4\x1d\x9d\xbf\xca'6\xc84\xb4\x92\xc30B\xaa\x1f\x9c\xbbn\xbfm2m\xc3\xb0fl]\x00='X\xa5m=\x8a\x87o\x927\xe2M\xf2f$\x8c\x8f\xab2J\xc4\xf5\xfa\xb5\xca^'\xd7Q\xe1-\xdc\x97\xde\xd0X\x1c\x82M\xd6F#&4\x18\xf6\x99}\xed\xf9\xfe|>0,\xb1vU\xfc\x1d&Bd\xf8\xa5(\x80C\x14\xbeG\x17e\x94\xbb\x97\xa1\xb0\xed\xac\xf1n\x8b[3\x91#\xf0\xe7\xd1\x0b\x85M\xedqj\x8fR\xbd\xb0\x16\xdf\xd5&\xfbt\x88\xf9F\xef\xe8\xb7;\"Oh\xe6\xbd\x87\xf7Q\xe4\xc0P\xd5\xf1\xcc\x0d\x98i>'K\xd5V/l\x90\x0c\x17o\xe1\n7\x19^T\xbf\xf1\xf3\xabP\xac\nx\xeay+\xe0\xf2\x00z{\xa1\xcf\x96y\xce\x00!;\x83\x8e*\xbfS\xe5\"/\xe54`2\x92\x15eH\x0fB\xa1	\xf8\xa5\xdd\xf3{j5\xa6\x8ae9\xb3jm\xc6\xbb\xb3\x84*\xb1)\xe7_\x8d_ySh\x11_\x1ewa\x03n\x11]\xfe$\x19\xe4\x16\xd9\x15\xb1\x1c\xe6\x84\xec\x10]\x9d\xda\xc19?\n2\xa1\x04\x18\x8f\xd0\xa7\xcc\x8b\x12\x9a\xb8\x8e\x86\xaf\x9a\xfb\xe4\x1dP\xba\xe3u3'Wpc\xcf;i\xe5\n`\x1a\xe8\xda\x9e\x87{R\xd0\x92.\xc9\x99\xb4\xb0\xbf\x94\xcee\xcd\xa9\x90\xb2\xdc\x17R\xd3&\xed\xe1\x04t\xb1P\xb0U\xbdvm\xd6\xa5\x18\xf56\x89M\x0e\xb7\x03\xc4\xf0\xe6L\x1e\x17\xe9mm=k\xeb\xfd\xc3\x13\xd9\xd9>\xb0\x15\x02\x14\xbe\xd3\x14j\xad\xd0P\x80\xff\x9bS\x18\x84\xc9\xf0I7\x92H\xf5P\xc8\x9b\x1a\xd4\x1c\x8cw\xba;d\x80\xd2|T}\xa7\xa9\x955xUxY\x05\x99\x90 7\xea\xca\xebg\xfe^\xc1]\xe2\x1b\x1d\xd4\xf6	\xed\x97/\xee\x1f\xaf\xfb/\x186\xb6\xbe\xe0\xc6\xf53\xf2\xfa,(\x15\xbc\x1d\x9b\x8ag\xa1m\xfcu(\xd5D\xbaS{7eH|\x1f\xb1J\x07\x97\xa5\xb4]sB\xc8\x12\xf3+y\x96=\x877\xe7Hwj\xef\xe2s\xa4;\x9f\x11X\xb4\x16\xc1\xee\x9d\xb7E\x82F\xb6\xd9d\x9do.\x8a|N\x01\xd0\xd4\x14g\x0b\x1d^\xd4\x15(\xf1\x14y\x9aJ\xec\x05\xfd6\xc9dZ\xda.}\xa0\xd3\xedu\x92%\x97\x12\xee\x93>I\xfa~\xb6\xd0\xf0\xdf\x11vd\x86!}\x8bjl\x11\xa3\x8aoM`\x9eC\x9d\x14\xd5%\x85LJ\xcb\x9f\xf4\xba\xfa\x99!\xbd~\x0b\x84\xee-\xcfo\xfd	\xa5\xaf\xd5\xb7\x8f<\xbe\xf0\xc6\x0f`%hP\xec\xf15\x96\xec?h\xa1\x9c\xf2U5o\xde\xf01cU\xcd?\xd4k\x1e\xef\xc5\xc6\xfa\x8d3\xb8\xdb(\x82\x88&\xa9\x04\x8b\x19U\xa5$X\xf1\"\xe4\x80 ;\xcd\xf7\xe4\xd3Qr\x9a\xecqK\"\xec4\xbb\xeb\xc8\x14m\xa6\xcaS\x1cup#]\xb7P\xf8\xfe\xaa.\x06\x9c\xc84\x85R&9\x9d:\xc5\xed\xa1rQ\x13(\x1c.\n\xe7O\xe4\xe7\x8d\x87J\xa8\x11\xb3\x88\xbft\x8c\x1b1\x04D\x8b\x98\xa5&\xe4\x97M+dE\x92g\x0b\x82\xd7m8P\xd0\xf3\x96\xffJ\x1f\xf6G\x7f\xa6\xd3\xe0\xf5[\xa1A\x01U\x93\xf2\xa7\x9b\x14\x97\xc4\x84\x94+$\xc9\xfdu\xb9[\x12\xa6\x9fn\xa2\x1a.\x8a\x91\xe7C\x19\x061\x01\x91E\xc6\xde\xcd}\xd0\x88\x8e\xc8n\xb3\x87\x86\xf3\x04\x9a\xa7b'lkg5\x86\x0b\xa3a\xd3\x0f\x1b\xe6@\xfc\x0fZ\x82V\x1d8R\xdcgW\x0f\x13\xa0E|CM\xc1-9\x17\x86\xce\x00\xce$\xafQ\x90bn\x14\x96\xebv\x03\xdc\xd9P|\xda\xdd)\x06\xc6\x0e\x85\xd6A9\x98\xe67%)\x14\xd6ji\x00\xe2p\x19\x9a\xf3o\x80\x11\x17\xe0=\x03\xf5\x84\xdb\xd5L\xa52(\xdd\xc1\xe5S]\xbf^\xed\xeaR\xb3\xa8\xcd\x1d\x98\x1f\xde\xd6d\xbc5\x02\x82(G\xd2\xaaC\xae\xba\x14L\xee\xc9\xbf<'\xab\xcf\x16\x8b\"\xbf\x86\xa9\xa1d\xf1\x12\xe8q\xdc\xa9@\xa7\xe1\xe2\x0eJ\x0c	\x08E\xdf\x1b=\x05\xd1F\xc9=\x98\xa9B\x08\x85Y\xc6\xab+Q\x91\x9a\xa1\xf8\x1d\xc3\xcaj\xe2\xe4\xdfwv%;;\"L\xc0\xc1\xf3!\x0dPY\x80~\xe7u-\x14\xab\x1e\xb8^@\xf2\x94\xc0e7%\xb1J\x05\x84\x91.\x00\x05\xdf\xfe[-rt\xf3Z\x91\xd9	^-,\x9a\x0d\xabO\x98\x9db\x88q\x16\x81=G\x8f\xc9\xd3\xeei7z\x93\xbc\xb1b]j\xe6ur\x8d^\xd9)q\xdaz\xa6\x85\xe4\x1a-\x14\x07[(NA5\xc7\x05\xb7\xd03-$\xed\x1e\xb5\xe1\x9co\x03O\x92\xf0\x13\x12m\xdc]\xba\xb6CP\xc0\x03\x94\xbb\xb6<\x14#=\xb4\xfc\xf7\xd7\xc0\xaa;\xe3\x85,\xc0\x8d\xff\x8d\xb4LI\xae5\xa6\xbb\x80I\x18Tw\xfc$~\x9b\x07\xb9\x18v\xc5I\xb7;\n\x07I%\xc1ND\xbfka\"7\x1b\xf2\x8fEK\xa5\xd75\xcf\xbd\xd1\x00\xd8]\xbf\x03\x8f (N\x01\xa4\xe8\x08\x14}G\xe7\xdf\xabkI\xe1\xd1\xab\xf4\x9e\x9f\xee\x13\xcf\x7f\xd4\x08\xa4\xf1\x9c\xae*\xef\x17	(:\xc8\xc0:c@\xfd\xcf<7\xc1<\x07\x1f\xde\x06\xc3\xe6\\A\xc9}\x9e\\\xfb\xca\xd6\xe0b\"`\x07\n7G\xbc\x88\xec{\xc8Z\xc0.\x95\"\xa4\xf1'\x17\xae\xccL[\xed\xeb(\xce\x19\xbf\x07\xac\xb7\xee\x01\x9c\x04Q,\xdf%\xfd?\xb0\x8e\x99$Ml\xdf\xcd\x96\x1b\xf8}\xfb\x90\x93\x80\x17\x9e\xb1\x00\xfe^\xb7;\xc2r\xa8q\xf4\xff\xf1\x00SQ\x8b\xcf1|r3\xdb\x14M2\xfc2o\xd4a\x93d\x1f\xf5\xac\xc8\xb5\xde\xf1 \x05&\xff\x0e\xa7\x97tr\xe1\xd2+\xd6\xe4_\xab\xb6\xe0~\xdeYpcb\n\xa1,\xa6\xa3\xd2\xf2\xa7d$5\xc3\x01\xfaXu\xd2\xeb\xdeH\x0c\xb9\xcf\xae\x9b#o\x02u%\xe2c\xcb\\\xba\xe9\xc1\x9dU\xf5\x8e\xdbd\xd2j5\x8aS\x05\xfe{\x93W{3j$\xad\x96M\xa4\xf5\xde\x8c`\xfe\x06\x1e\xfd0\x1fE	\x1d\xda~\x89\x1a?\xe1\x1b\xef\xec\xbd\xb9\x8e\xe0\x1ah\x1d\xe1\x8aoD~\x91\xd52\xa0\x9f\xc8\n\x02\xb75j\xec'\xaf\x8eZ\x98M}\xda\xcc\xcav!K\xf5Y6\xa3\xa6\\\xd9\xe7j\x1b\xfcR\x83\xb3Z\x9bK\xb5\xec\xd8\xc5\xffrZy\xa3}9=\xa0	d\x97\x9c+\x90]\xe4\xe5 \x19f\xa3V+EL\x9e\xd4\xf0\x1a^N\xa1\xf8K\x08\x86\x19\xf9\xc8\x03\xc1o\xbe\xcc\xb4Qx\xcaA:\xb1\xcfK\xc0l\x90l6$\xcf\x00\xfcn\xb8\x0fQ.jmE\x08:\x8b:\xa2\xee6\xecL\x1c1\x1dgkkj\\\xbf\xf1\xc6WA\xba\x16:\x0cE#\xa9w\x9b:\x0d\xaa\xd0\xa8\xf0\xed\x7f\x8d%\xe3\xa6\xe5:\xd8\xf9\x1a\x0e\x12\xafq&\xcc\x83\xc2\xa6\xe1\x86%\xe0|E\xc3\xf6\xd9\xcbXJM\xce\xe7d\xf1|&'\x9fdA\x82u\x8aJi\x82N1\x13\xa0Y\x1fDS\xc8\x8e\xddh\xef\x12-E\xf3B]\xe3\xc1\xb7\x1f\xd2\xeb\xbd\xc9\xdd\x99AlK\xaf3h#\x87\xda\x82S\xbc\xab\x8dq\xa0\xc88\xd2N \xde\x0eL`\xd5>\xec\xad\x1d\x8dhT=\x8e\x9a\x7fuI3UM\xab|j]\x11\x0e\x15\xcb\x82\xcb\xc9\xe1\x8f\xc4\x14\xa1_\x04\x07\xaa\xcae\xb5\xf1\xe12\xf7j\x16\xb8\n\\\x0d\xb0\x8c\x80mJ\xd6\x14\xdf\xbf\x0d\x925|\x16\x93\xe5B\xd0\xfc\x9c\xe7sJ-8\xb5\xaa<\xa7N\xd5\xe4\x16DA\xb1F\x0d\x8e\xd0\x83\xa0\x13\x0c\x1dFjU\x85\xc9zW\xad\xbf\\\xfb\xb5\x97\x0eTeg\x91d?\xd8C\xce\x9f\xa1Zy\xbf\xf2\xc2U\xbe[m\xee\xaa\xcd;\x18\xee\x17\xea\xad\xd5X\xd6\x80K\x91\xc7vv\xb9\x87A-O\x964\xe2\x1b\xc4c\xc2\xfa\x9f\xb6Z\x199\xeb\xc0\xfd\xf4\xc6\x15\x86\x9f\x97\xea\x05\n\xb7\x856:.2\x9b\x92\x92\x0b\xd1}\xd5\xfdi\x8fi\xe5\xaf\xb2\x94;\xba\x97\x89\xef>\xb6%\x8f\xadU\x15^VK\xcf\x86\xf4\xa2bc],e\xd4\x17\xf4\x0c\x06@\xd43\xcf\x17IZ\xca\xa8k^\x96\xd9T^\xa8LNaJj\x97[m\xc9p\xe5y\\\x03J\xd0\xd04\x17\xaf\xf2\xc9'\xe8y\xc3\xbe<\x1cdC\"=\xc7\xcd\xa3|\xf4\xb4z\xa1-'\x112\x06\xd7\x04\xc4\xb3I\xe6\x15\xcf\xe0lA\xeai7<\x97\xc03p1\xb9\xa8\xeb\xe2\xcf\x18F\xdcE\xf2sQ?\x8e\xf7S\x02_\xf69Y\x1fb\xfa\xc9\x8ea\xbf\x1b	\x13\xf0\xa7Hn\x11-\x92f\xee\x0dI\xa3#\xefVq\xc0\xa5/\xb9\xb5\xa6P\xe8\xccM,\x9f\xa4\x83\xd2r\x13\x97q2,G\x88\xd12\xf4%\xdf\xcb\xd3\xe6\x9eH\xbb\x19-G\x83Y\xab5\xab\xc9\xd3\xea\xd7\x1c\x06\x08\xae\xf8\xbb\xf23\xa4\xc5\xb2\xba\x00\x02\x97\xa7\xb9\xe7\xbcz\xca0!-\x05\\\xeeq\xe7\xc7\xad\x85+\xb5\xce\x13+Yd\x16\x13'\x80\xac\x9b\xc3{\xfdn\x17J\xa1\xe0\x8c\xb1/\xd3\xee\xa0x\x12\xf7\xbb\xddV+\x7f\x82\x8c\x83\xe2\xe8(t1%D~\x14gv\x95q*r\xd5d\xf5\x17\xeb\x1d)	\xf3\x15\x05\xc4r	\xd4sjd\xa7c\x1b\xe4\xb6\xd7\xb9\xeb\xad3M\xf7M\xe6\xac\xa5G\xcd^n\x0b}\xfe*\"7h\x0f\x1b}\xfc\xb4\x17\xc1\xccc\xb8\xe3\x04\xc1:@\x1c\xed\xbaA\xa8<\"\x8e\xa0~?,\xe0\x02g\xd8%ZX\xf4\xba\x98y\x1c\xf8\xe4\x80\xfcx09:\ng\xc3r\xd8k\x17\xa3\xe1d4\x8a\x97\xc3	\x82\x01\xa7\xf4\xd2\x8f\xe3xr\n\x00G\x99I\xa2sm\x8a\xde\\\xbb.\x88ctb]\xb5,\xba\xa3\x11\xda\xbd\x80\xb9=E\xa0\xb7\xf63\xeeHI\x87\xc1\x05\x87\xa7\x18N\x87\xfd\xd1\x08N\xdf\x8e.\x86S\x88\x01\x8e:'w\xf1\xd8\x1b\x8d\x9etN\xee&\xe6\x11jw\x1e\xd1\xb6\xf0\xcf\xc6i\xa0\xeb\xcc\x8f\xca\xd1G\xe5\x0c\x06V]\xd5\x9bq<\xe5\x7f\x17\xde3\xbb\xa7\xa8qh/ye\x88\x8a\x97\x8b#s\\Y\xe1\x93d\xb7\x8c\xf4VL\x8b\xe4\xf2\x12\xc8*j4\x14\xbc\x83\x95y\x11I1-\xd4\x85\x8e\x94\xf0,\x0d+Y\xae\x0eo\xde\xad\x02m\xec\x0b\xc3\xad\xc83\xd4B\x18\xdd?W\xe6uT\x84k\x9d>\x1d\x1a\xff\xc5C\xc9\x7f\xdb\x12\xd2\x94\x9dD\xe9K\x83\xae\xf6Gc\xaaiO\xde\x04RL\xdf\x04Jt\xc3p$\x86\x8b7TiW\xd8\x0fY\xdb|\xaaZ8\x11\xed\x93\x91\x18\x9e\x88\x13\xbf\x8957a\x0f\xb7\x8b7\xf7\xfa\xd6\x888h\x92g\xf7W*\xfb\x04\xf6\x08\x18\x7f\x95\xd10\xaesY\xbc|\x13\xe4(\x8e\x1b\xd6\xbd~\x8dKq\xbdK\xf0x\xd5\x9de&\xa0\xad\xab\xae\xd1`' \xf2\x94\xf3\x162I\xb5\x9a\xcbf\x18\xf98z\xbc\xfe\xef\xdf\x03V<+\x96\xeec]\xd1AP\xbfP\xd8M\xddP\x11\xaeo\xb2\x92zV\xdd8\xa7n\xbc\x06O\x8f\xdd\xcc\x8b\xa1q\xac\xe7s\xf8\xdf{\x9dm\x04F\x14GW\xd9\xef\xd3<Ax\x89\xeb0l\xb5\x0e\xa4\xafk\xaeL^\xfb\xd5\xdcV\xcb\xa1\x0fk\xbf\x96\x97n1\xf9\x9e\xc9(\xe4\xea\x9f\xd0\xdc\xcb\x84\nO	\xe3=O\xd2\xc92%cs\\\x19\xd8\xf4\xea\x9d,\x97i\xa5\x7f\xd2\x0c\xa3L\xa4\xf1\xa7u \x05X\xa2b\x19\xbb\xc0\x1aU\xf0\xf3R\xa4\xa4\x1aJ\xfa\\Ty\xa0\xc4\x12\xfa]\x89}\xcf\xc4\x92\xb5p\xe2WV\xa5\x1fivi\xf8\xd6<3\xb0N\xc0\xb7\x85\x97GTq\x84\xbf\x8e\xfb\x013\x13\xef\xec\xfa\xe0\xcd\xf7\xd7\x8d\xb9pd@\xef\xfc5\x88\x82V\xab\xb1\xb6\xda\xbf\xe0G\xda;`\xee{\xae+\xe2\xef\xd6\x1c\xb3G\x93\x02B\x00_\xbe\x92\xd5\xd2Z\xad\xf7o\x86\xc6\xb8\x82\x82\xd2\x9e\xb3\xa1\x18\x9e\xe9\x0e\x8d\x17\xab*\xb1z\x13$\xa2\xca\x83V\xa0\xd7\xca\xc9.;\xa5\x0f\xb8_qU?4s\xa80\xf6\xe9wjn\xcb\xd8WL\x83\x90\x1c\xdb\x81\x8b\x0f\xd3\xd1\xb6\xe6\x00m\x19\x0f\x99\x15\xd0\xb9F'N\xf97\x92\x1ds%\xc7\x0b\xf3kdg\xcdY\xe8\x17\xd1\xa4\xad\n;N/D\xd8\xe8\x93\x0b\xb5\xf7o\x86\xf0\x99\x06\xf2\x0e\xbfX/\x99\xc8\xea\xfc\xd5\x04^\xd2\xc8\xb5ZX\x0dn\xe9\xdc\xa6y\x1c\x93\xef\xdc\x1a\xb6$g\xc5\xbda\xa7Qf\x90t@\x98\xe8\xaf.\xed;5?\x0d\xf2\x1a`\xe2Z\x06*t*\xebjV\\\x98\xbe\x856>\x06@\xcc5a\xf8\xcc	\x86\xea\xc2\xb35\xf4}j\x8d\x84\xb0\x03\xb13k\xb2\x9e\xe9\x99,(\xbfW\x8b\xcd\xc6\x93\x16\xef\xd8bTu\xd0*\x08#/%\xce\xea>/\xf7;\xe7\xb7h\xeb\xf9\xaf5\xb83\xaa\xfd\xee\xb9\x81\x98\xfe\xf9\x82\x8bg;\x98\xd7\x91\xc6\xc4\xac\xae\xb6\xd4\x80#\x97U\xa3\x96\x15a\x99\xc7\xddA\xfe$\xb3\x14\xb3\x93\xbd';e\x08g\x81/E{2!y\x04\xcc*,\xf1\xc7\"\xb1\xef\xd4\xdcc\x95>\xe7\x1eZdM\xb7\x07v\x84\x80\xae\xc2}\x04a\x83V\xab\xf1\x1e\x18\"\xdclj\x19\x1c\xb6\xa8\xea|\xe5\xadVn;{\xd2?e3	\xd3@^L\x87\xd9\xc8\xae\xab\xaa\xec'.Ktw\x13A9\xd9\xfb\xb6;\xa9AW;\x7f\x9e\xc6\x98\xc8\xd7\xd0%\xa6\x08\x87B\x06/<;\x8a\xb5\x00\xcc l\xb8\x97\xf3\xe6j\xce\xe5T%\x19UlN\xde\xd7\x94\x10\xc8\xb0\xba\xc1\xb0\\\x83\xc4 C\xe2\x0cS\xf6^\xd4\xf5\x08\x89\xb7\xae\xc3\xd62lW\xf08\xe0N\xda\"NK\x04\x0e\xcf\xacD\x1al\xce\x14\xf7\x80\x12\x06^\x1c'\x10~X|\xbb2\xa0\xc6\xea\xeb\xda~\xb5\x94:\xcd\xbb\x19:\xd4\xe0\xccS\x1a\x12(iw\xbfN\x8aO\xb2\xb0\xd1\x15\xc3$>\x90jx,\xc4H-\xeb\x0eKs`)\x04t\xaf\xb8\xfdK&\x982/\x1f\x9b\xb8\xcd\x0e}\xea\x8d\xe0\xde*\x893VB\"&X0\\\x8a\xd9(\xdc\x12V\xbe\x93\xc4\xc3R\xa4\xa3A5\x0ebk\xc3\xa5\xb1\x1d\x10%\xf5Fq\x1a\xeeEn\xc2	\xef\x13\xa4o\xea\xb33\xc8b}za\x16y\xd5co\xf1\xd8\x8dp\xa7\x08n\xd8\xff\x93p\x81\x01\x83\xb0\xb6Gi\xbf\xf9\x1fkhKc\xa7\x80\xa3\x15FCS\x15s\x90\x89\xbb\x105/@\xc44\xb7#\x96!\x80Z/>@m\x06\x8a_\x176\xe6*\xc0\xd4\x0c\xc5U\xf0a\xedG\xe3\x82#\xb8$\xbe\x0c\x12q\x15<'~'\xd0\x94\xca\x949\xe89\xae\x81>}\xb5\xae\x91\xf0\xb64o\xba\x1a\xaa:\xdb'\xb6\xa0\xe9\xddP\xe5\xf7\xa8\x17\xcc\x9f\n\xac/\x18\xac\x95\xfc\xb7\xd7\xd6\x14\x96\xd2[\x07\xda	 \xcf\xd6\x81\x04^j\xb5\xce\xd6\x01\x18\xbe /q\x03\x8fY\xc0\x9d\xb9s$	\xeb\xc8\xa5\xee&\xfd\x1dc+\xd8\xcdLl\xb8\xba\xfe\xb4\xf2\x18iQ\xad\x84\xd0\x886\"8\xde\x90\x14\xd1\x0bm\x10\xe2[\x05/\xd6A\x8ft,\x81%^\xac\x83.\xbfxzJ\xdc{\x83\"!\xc8l\xb5\xf8\xb9\x16I\xfd\xb3\x03\x86\x935@\xba\xb0\x87\x07\x04\xbbV\xaf\xb6\xbb\xe4\x0d]V\xdb=\xafm\xf7\xa5\xf7\x15\xdb=\xbfe\xbb\xa7n\xbb/\xcdv\xcf\xf67v\xc8QC\xff\xedv\x97\xbc\xddiD\xb3\xb8\xf0\xa6VLxs\xcf\xb0\xd9ee\xb5\x8e8\xb6BB\xeaW\xd4w\xf8DLG\xe1\xf6\xc0\xb4\x15\xde\xb4]\x98\x90\xb68\xea\x0d\xee[\xf8	\x18\x7f\xbd\x1b\x03\xacY\xbf\x1f\xe1)\xa1\x8a\x8b\x1d\x95\xc7\x0b\xdf\xe6j\xa8\xe8\xb2\x1eF^a\xea4$V\x84V\x16;\xc5\x17\x87\x8a\x87\xdb\x1a6\xea\x1262IK\x87\xa0\x96\xa1\xc3i\xa9X\x8e\x06\xbb\xd8J\x8a\xc4[\xdc\xdf\xf2:\xfa\x7f\x0cW\xf9o\xa5}\x83G3,\xee}D\xf6\xf2\x0d4\xcc\x84\n)\xf2v\x90\xc6\x97A*\xae\x82w\x06\x8b\xb1\xf2\xf2\xd70\x97E,\x1e\xce\xbb8\xacL\x0fa\x1fN~\xe0\xc3%\xc43_/\xd0\xf3\x0b\x94\xff\xa2@\x7f\x04\x05\xda\xb23KJ\xecm\xf6y\xda\xe8\x8a\x1b\xe8\x85E\xb9\xd0y\x94\x88Te2\xf2\xf5\xbc?\xfeW0\xee\x0f\x870\xae\x05\xc3G\x87_?2~\xadZ\xf9\x8dQ&\xe3E\xbe\x03uG\"s/=\x8b\xa8\x1b\xf5\xed\xe9\x02\x9fC\x9fz\xb3\x81\xae[\xe3\x87\nm\xb6Z\x8d\x1f<\xbc\xb9\xd9\x04\x84\x1d\x8d,/R\xe2:\x92\x9dk\x84\xe0\x94\x9duw\x1bn6\xfe\xe7\xcc|\xee\x99\xcf\xbd\xad\x7fc\xff\xfe\xff\x10B\x05s\xf0\x0b\x18U\x01\x8d\xff\xdfF\xa9j\x17\x8f\x0e\xad?\x0f\xc5\xb8s\xe1\xde	\x0d\x8d\x06Eg\x92&\xf3\x05Vu\xab\xe5\xbd\x04\xe4\xc5c\x17\xb5\xce@\x9d~\x0d\xb3Nv1\xebt\x17\xb3~\xa5W\x83\x8f\xeb\xe0\x82\xd1\xebd\x07?Nj\xf8\xb1y\xdd\x05^\xc7\xe8\x18\xd1~\\\x07\x0b\x87\\\xa7;\x85\xa7\xf5\xc2k.\xdc\x1b\xfd\x0f\xd0,\xef\n\x8fq\xfec\x1d\xb5\x82\x11\x04\x83\x98.\xb4\xed\xf1\xe7\xba\x87\xa7^s4\xd0\xa7A\x16\x7f\x99B\xb4;\xc9a\xda\xc1>\xcdx\xe0f\xa6n\xa3\x0f\x85\xa3\xfej\xa1[\xbdk\x19\x93\xa2\x86r\xcc\x86\xf2\x7f\xf7G4\xb9P5S\xd0\xf1\xb7\x15d\xf1\x17\xf1\xb7`	|y\x88\xc0\xac\xe3\xe52\xbe\x0cJq\x15\xfc\xe6\xe1\xe5\x14\x07\x92\xd7\xbd:\x83\x96\x91	\x19\xe5^\xa4y^\x04\xd9\xbd~8\x1af\xff\xbb?\xda~\x89\x0c=\x80\xcc\x0d\xbb\x05\xcc\x9b|\x1f\xabzD\xeb\x9f5^+\x06\x0bA_\xb6\x9c\x9f\xcb\xa2:\xc3\x14\x0e4\xfcm\x92\x06\x1f\x0b\xba5)\xda@\xa6\x16#\xc5\x89v\xd9+\xf6\xaf\xa8Z\xfc\x8e\x85\xd3d\xf9\x9b\xdd\xf6M\xd6\xc6\x01o\x8d?\x91B19c\xf1\x13 \xd1\x1e\xfc\x0e\xceH\xd3\xda\xc4\xc3\x91SP\x05\xb5\xaajp8\x05\xc1U2	\xe4[e\xdblL-\xc4i55X\xd7\x84\xdc\xed*o%\xa4\xde\xadzk\xa5\xbc{\xca\x98+C\xebc\xc8\xadV\xd00\xa3f'(\xad\x96y\xc5\x1aD\xc4'\xcb\x19\xe2``\x0c\xa9\xda\xad\xeb\xd7\xda\xb4\xb8\xe8\x9b\x04\x1f\xf7\x06\xe0\x90\xf7z\x1bw\x85\xa4\xee\xf6\x85\xbe\x02<\xc4e\xdel\xdc\xc3\xcd\x16\xfb\x85\xe3\xaa\x12$\xcc#\x15H\xa0\xb7\x89\x0fQO\xd8\x9a\xa2\xden\xb0xl,\xd8\xef\x12TsRN\x0dr\xd8\xf3j\x96L\xa3\xa5V\x0b`\xa8\x85\x85\x01\xe7\xbf\xea \xc76\xb7\xd0\xe6W\x0f\n\xbf\xf3\xc9\xcb\xcb\xfb\x8b\"\xd8\xbf\xeby\x7fa\x07Q\xfb^\xa6\xff\xbd\xd4\x8e\xe5	,\xddj\xfa\x07\xec\x1fk\x1b\xb4\xcd\xb5\xf8\x8e/\xfe\x81\x0c]L\xfb{\xff\xb9H\xf4,\xfa\xeb\xde_\xf7\xee\x89&\xb8\x0dANt\xa2`\x1b\x00\x12\xebC\xa4/28h\xce\x86\xc7\xa3\xb0\x8a\x8aW\xb5\xf7\x8f=\xd0k.\x0f\xadc\x0f\x08^\x9b\xe1 9\x0d\xc8\xaa\x91\\\xab\x06\xb8\xee\xdb\xd0\x02\x05\x8e\xc1<\xfb\x95\x94\xde\xa1\xea\x17Aq\xe8%\xc7?\x0d\x9a\xe5z~\x9e\xa7\xcdP\xb4{\xf8\xbf/\xfa\xa2\x0cC\xd4E\xdb\x12||\x84\"{\x93\xbfwJ\x92{\x95?/$W\xce\xa8\xeeV\x8f\xd8\xdc\x9a} \xe9\x10\x14\x1d\xab\xf6\x80\xb6\xe2,\xb8\x819$ySf\x97\xd4\xbdn\x17\xc1\xc9\x1a\xac\x05i\x99'~E\xe1 X\xc6\xcb\xfd\x00\x92K+n\x8e\x86GKq\xb4\x1cA\xbap/\xee\x8b%\xec\xa1\xe2\xbe\xf5\xb8\x13/Y\xa4P\xab\xfa\x8c\xfc7\x19'\xea3K\x11\xe4\x0ecx\x8f\x1c\xa7s0\x19vGG\xb8|\xd2\x0doI\x84\x14\x1c;pZ\x0fi\xb8\x04\xa31\xe7\xb2\xcc\x8c\xe3\x1d{\xdd\xa8\xd0\xbau\xfd\x11\x07S\x88R}\x07\x13pD\x91\x98\x88l\xd0\x81`\xc3\x86\x0f\xd6\xa6#\xa8\x89\xbf\x7f\xb6\xcb\xc8\x9a[A\xef\x02\xc2L\x95]2\x9d\xc8W\\b\xa65'P\xba\"\xb9\xa7\xf5\x05\x0ce\xdc\x9a\xf9\x9b\xc7\xbd\x01\x0d6d\xa4\x07\x92'\x1c\xe4\x9bM\x83\x8cq\x83\xa6\x89\xd3A\xbaY\x8dnhC\xcby\x9eN\n\xd1\x1dm\xc3(\x80;\xb3|\xf1\xccf\x87\xce/B\xce\xd1\xbb\xfc\x90\xef\x15\x11\xbb\x0d8w\xc7hh\xef\xeb\x8b\x041\xe0\xe9[M\xe3\xf5\x1b\xef.a\xc4\xae\x90I\xc9\xeb\xb3\x8b\xa09_\xfb{\xf1'\xca\xe9\x9bvT\xdf~\xf9\xc27}]\xe1t\xd2\xa2\x1d\xe2\nQYe\x19&\xe7\xbbd\xc5\xf1\xebm\xa5\xb6\xd4m\xa2\xa7F\xbe\xd9\xec\xde:r\x16$5\x17y\x9a\x14UJ\xc8\xdaF\xda\xe3L\xb0\xf3\xc1*\xee\x15\x8e\xf8\x9a\xe6w\xe2\xb1\x18`\x82<U\xf3\xa3\xe6\xb8y\x94\x18\x10\x0d\xe4\xb00:h\xc5(\xbe\xb1\x05A\x19G\x89p\xbc\xfd(\xafK\x13\x92\x90]\x9fG\xc3\xd1\xd6F[\xbf\x01E\xf1\x9d\x9aGPK\x9c\x0b\xbc\x91\xfc$\xe2\xa6pMDkl\xc2b\x07c\xa8W76Pu7&\xc7\x0f\x08\x19\xf1\xed\xda\xba\xfdC\xcbP\x00\x82L#Rb.u\x12e\xde*\x90\xde\x1c\xd5\xec\xe5\xfc\xc3\xcf1\xd2+\xefz\xa8\x97\x84\xdf5Q\x06\x14W\x9aw\xaaX'p\x10e\xba\xe5U\xe7\x0e\x10C\x0d\xb0%)\xbb\xbd\xda\xd5\xaf7zf\x87\xea\xe0\xb1{\x9e\x16\x0c?\x95\xb0_\x90\x1fl\x10w\xc6JJR\xc6\xc3\xc48\x18\xff\xfeM\x08\xf5\x12\x04\xc9/\xaa\x10\xfb\x83\xf4\xe8\xa8RoZb\xf9\x9a\xc8G.X7\xc4vK\xd3\x91b8\x1b\x0d\xd3Q8\xe09Z\xba\x9a\xe1<\x8a\xb2\x94\xec\xec\xfa/\x90\x83\x9ew\xf3\xbf\xb2\xbf\xb2\xe6\xd1oo\x8e\xcc\x93\xb7\xf3\xd4\xb5\xb7G/\x0e(\x92\xb1\x82$\xbb,\x08@\x1dY\xb3\x92\xdaV\x93,\xf4\xf09r\x0e@\xd5\x18u\x9c\x14\x97\xe4\xe7\xb6\xe4!\x8a\xbcJ\x1a\xea6|\xcf\x99s\x0e\xfe\xfb\x81\x80\x0bHV\x9e\xe8v\x8f\x94\x932\xec\x88\xaa@\x01\xf5|\x1a{\x90\x9c&G\xdf\xbf\x89\x9a\xcd\xf0(\xf3@\x83c\xb5\x82\xca6\xfc*H2o\xc1\x12\x82\xe1!\xde\xd0B\x8c.\x83\xa1\xbc\x0ed\xe7\xd6\xcd\x10\n\x85\xef\xb4!B_\xeb\xbbZ%\x1553\xfc+\xfbK\xffU\x8e\xee]\x8a\xe6\x17zg\xb6\x95\xec\xe0\xc7\xdb[\xf9\xf5\xe1Z\xff\xf3\xaf\xf2\xaf\xf2.\xd1GUS\x94\xf6\x0d%V5\xc0\x1b2\xfbY3\x87yWxX\x1a\x10\xe3\x90\x07g\x17X\xc3O\xe3n\xc5\x8av\x95\x14\xd7\xbb\xfe\xb2\xcbE\xaatp\xef\xaf\xec\xe8\xdeeh\xf1\xf2E\x90\x030\xe5L]\x80S\xcc\x99~|SwgW\xbf]\xe2`\x046\xdb\x82|\x81\xc8Q\x1e\x109\xa2Z0\x98\xaa\x1a\xed\xa2H\\knO\x9a\xe5\xe4t\xfd\xec\x9a\x02\x1d\x0d,\x88\x06\xa1\x1f\x91\x0c\x8b\x91\xd5\xc4c\xc4\x17e\xd6\x8d\xab\x92eM\xdb\xb1\xfc*\x00j#\xa7\x8ds\xfbxDq`D\xa2\x8c\x9bM\x91\xc2\xa6\xcd\xb0\x1a\x80F\xc2\xd3 \x05O\xaf\x8c\xf1&\x8a\xb8\xe0Y\xec\x85\x02B\xd7\xd8\x80\xb1\xe4\x10\xa38\x15\x92\x18\x1f\x0cR\x0d#\xf3\x06\xbcl;\xbf\x8c\xbb\x83e\x85\x7f\x96\x95>\xe0Q1\\\x8e\xc2\x01\x0c\x0d-\xf4\xc0+=\xad\xea\x8b\xc1\xe9\x8e\xf0NO\x0e|\xd4	e\xe6\xd2?\x1a\xd2k\x9f\x0dh\xa1<\x1c\xb9\xdb7\xee\x86fNA\xdc\xbf\x93\x97/\xae\x17X\x95w\xed\xce\xbd\xdb\x14M\\#\xbc\x15\x04\xf1\x8f\xba\x08ha\xdbS\x9e\x16h\x153<\xe9\xf0\x99\xc8\x01\xc5ag\x11( \x16\xfc\xa1\x8b(\x1e\x86&\x1f\x9d\x96\xa3\x98\xb4\x89\xa3\xdc\x9eOJ\x96p\x81o\xfa\xec\x0c@]\x08.~\x07j\x06Q\x90\xc7\xb4D\x06.\x1fkXn\xeb\xc1\xb3\x96\xd7\x8e\xd6\x19Os\xa3\x11!\x0e\x93>3\x86\xddA\xd4]\x9d\xa7\x12B-\xe5\x8e\xdd\x15\xb9;j\xac\x83,<\x0d\xea\x17lm\x8f\xdaP\x14\x01\xe3:\xbd\xa50\xaa\xb5x3\x93\xda\xa4]\xa2\xc7\x83?\xdf\xf8\xd2nY7\xd4\xb7Z\xd5\xed\xde 'm\xa1\x06\xa4m\xc3l4\xc8\xdbm\xa2\x8c\xf2']\xbb\x95u\x87\xdc\x8e\xb9\xb8O\xa5\xef\x96\xacR\xac.\x97\xe7&\xa9d\x07\x02\x8a\x94\xfe\xa0\xdd\x84\xb9\xaf\xe8\xaaK\xa9\xdf\x1a\xc3\x19\xa3\x83\x1e\x0e\xd4\xb0\x8b\xc6c_;<c\x95\xf0\xc2\xaa\x84\x17`\xb8oiv\xccT\xf9\xf7\xe2\xa9w@\xd0\xf8\xc11\x18Z\xc4\x04\x17,\xf6\xf9i\x0f\xaa\n\xe4\x9a\x90mn+\x15\x99?\xdf\xd44\x831ev\x0f\xe6\xb1\xac\xc3\x8c \x05/d\xe4\xd9\x00z\xfe\xc6\xd2_\x1b\xa7\xe1\xdb\xba\x87\xdb\x0b\xf4\xe9F\x0f\x7f}3\xda	r\xb6\xf0\xcf\x0b\xea:w\xb4\xcar\xe9\x0d\x8e\xaa\xb0\xfd\x05\xa7\x84\x93\xe2\xe1\xcdvT\xb3\x9e\x9a\xd3\xa2\xc0\x18\x82\x83\x86\xe6\xc4\x0b\xfc	\xfe\xe2\xac\xadDH6\x12\x94\xad)\xe6\\*\xcf\xbeE\x02-\xf90\xec\xcc\xd9\xed6^;cU\xfe\x99\xe7\xf3g\x13\xad\xae\xbc\x96\xaf\xbc\xfeV\xc0\x9d\xc2m\xc93K\xea\x82\x8fZ\xbdxG29\xd4d{0\x8ej\x86wk&\xd6L\x10\x8c\\\x04\xe4\x9a	)\x9b\x8d\xe1\x83\xf1\xab\xb3'\x1b\xc2\xf0\xc2\x87\xc7\x9a7\x89\x11\xaeM\xf2\xec\xbdN\xf4\xb24\xe1Z\x9ab\x01\x87\x0eO{\xa7\x15\x1f'rq|\xabJ\xae\xaf\xf7]B\xaa:\x1c\xe0\xa5\xad\xa9\x93O\x92\xbd\xa9\x90\xde\xac\xef\xb95Hb\xb7m\xd8\xd9\x06}\xfd$\xd7\x88\xe5^\xffd`\x1b\x8a\x9dF\xe2D\xec\x8d\x03\xc6\x04M\x91\x1c\x1c\x01\x9b,a\xcaWW\x01&\x88U\xc2C\xcf\x15M4l^\x16j\xda$\xd3\xf1/9&\xd8\xf5Bp\x98\xee6\xdaq\xdf\xc9I\x9a\x140xh\xe8\xce\xdaO9\xa5\x08C\xbf7\xa3\xc6^\xd6\x839\xffh\"\x0e\xdfD7\xb7;N\x0f\x1a0\xbf\xf3\x9cC\x18}v\xdf\xc7\xc3\x0dZb\xb7\xeb\x02a\xc9\xa2fqy\x9e\x04]A\xffu\xfaa\xb3f\xff8\xae\x90\xfe\x1e\x96?\xf77\xecOoj\xfa\x0e\xef\xaf\x03\xa2\xdc\x1a\xf2\xac\xd5Rg\xe1\x8d<CPa\x00_\xc7\xea\xacC\x11\xc8\xde\xcf\xa4\xd4\xa5\x8b\\\xf2\xe4\xb8w\xaa\xce8\x9c\xcd{\x97\x01\x06\x9e\xd3\xe9\xbb%\xf8R\x9d\xcf\xc5\xd5<m\x8a\xe6\xb9\x9c%W*/\xa2e\x91\x06\xff\x8b\x03[\xfc\xaf\x8f\xaf_\x85\xcd0\x82\x08\xf3\xbfX\xc6;\xf8_\xfb\xc3r\x81!(@E\x95\xe9%\xef!\x8c\xd3\xa0\x80\"\xcf\xb5\x0d\x83\xc3\xa1\xcd\xd8@\xee\xeb\xa1z\xbe\xf8\x1d\xe72\x80\xd1\x99\x94\xe5\x07\x84\xe0%\xe3\xb74YG*\xc3l\xb6\xcf\xe1\xe8w\x00\xb6\xd9E\x9a\xaf\"\xe3\xd3p\xe0\xd8&\x85\x84\x86\xee\x95\x1c\x18F\xe8q\xb7\xbb\xb8\x1e0\x07\xb4w\x82\x97\xa6\xc8v\x9bp\xa5\x93\xf32O\x97Z\x0e\xe0K4\xea\x0et\xbe\x88\xba\x83\xe6m\x11\x81\xae\xe6\xe9;\x00\"\xab\xde?*\xb9Z\xe4\x85\xb6\x8e/\x8c\xb65[\xd5\xe14\x99\xca\x14>\xa1\xdf\x1b\xa0\x81\x12\xc2\xe4}\xc89a\xb0\x9b\xa3\xf2\xc3B\xce\xaa\xc9k |\xb0\x08\xd2R\xca\xb3w\xa4\x8a\xef?\x07Y\xb8\x15\xf5Zk\x95\xa0\xd4\xb3))\xda\xd1\x03\x05\x86\xaa*\xde\xf2`.TQ\xea\xb7\x89BH\x1e\xef\x86\xf1\xc1_0\xae\xda\xf0F\xaf\x82\xffx\x99\xddy\xf9\xe2Q\xa7{\xe7\xe3\xebWw\xb0\x83\xee,P^\x16w\xb2\\\xdf)M\xc4\xac;s\xa9g\xf9\xf4N\xf3?\x8e\xf4\xd1\x7f4\xff\xc3_\x8d\xdf\xf9\x95\x1f^'o\xde\x07\xef\xcej.\x1c\x9f\xf9\x85~8\x0bz\xf2\xfe]\x1d\xde\xeb\xc9\xfbU\xc5\xcf\xfd<\xfa\xc9\xefg\xad\x96~\xda\xfe\xfd\xac\xca\xf1\x8a\xd78\x8b\xefN59\xb5\xfd\x1eHCw\x80;\xec\x81\xe6<\xf2\xa8F\x1c\x7f\xf4j\xf8t\xb0\x86\xf7\xc4\\F\xe8rz\xf8j-o\xb9\x16\xd9j\xbd\xc1c\xd3y\xb4!\xf3s]\xa8\xeb\xa0y\xf4\xed\x99\x9d\xb0\xa6@8\xad\xd0\xe7$\xbc\xe1\xd3\n\x0eh\xd4fC\xf8\xd6\xf0\xc9\x14\x9fEdL\x9c\xa4U\x12\xce\xcfC\xc1\x90\x94\xbbr6\xdf$o\x9a\xe1\xd3v\xaf\xd5\x9a\xe4Y\x99\xa7\xb2\x93\xe6\x97\x88JD'\xd23\xad\x0bu\x8e\x08\xcb\xa0=\xbc\xce\x9c\xb9\xce\xd4\xf3\xbdy\x1f4gZ/\xa2{\xf7V\xabUgu\xdc\xc9\x8b\xcb{\xbd\xc7\x8f\x1f\xdf\xbbNU\xf6\xa9I\x85\xaaz^\xb8z\xd4E\xf0\n\x91\x82\x94\x93\xb3\xa9SY\xcd\x964\xb3\x95\xc5\x06r&\xe8,\x1d\xb5\xa7\x1f\xcf\xa2\xac\x92\x9f\x11;\xa9\xeaws\x92\xaaE\x1b\"\x0d\x88\xb1\xb2\x18\x93\x8b\xabhl\xcf\x8c;\xfc\x7f\xa7\xdb\xed\x87\xcdP\x10\x98\x9bh\xac)2\xff\xb5m\x8d\xd3\x84\xec\xf0#3\xf1\xfc\"\x1f\xcf\x88\xe2\xfe\xe4\x0f%wC\xe1e#\xed\xb21%\xcdKS\xe4{\x83\xcb1\xb8\xdcv\xca\xe4k\xb3\x1b\xc7\xa0^\xa99\x10e\xc7\x1d\x8e\xe1\xbd\xa0A\xc1\xeej\x82\x90S\xd9\x99\xe5%\x01\xe9\x95\xca$	G\x820\xea\x85\xb6\x11\xda\xdbm\x8a\\\xd4\x14\xea\xd4v.2 \xd9\xe9\xca\x01\x90\x08\xd3\x85\xef\x92rv\x1a\xd42O\x93r\x96\x14\x05\x02HVy\x98u$\x9a\xe1N\xd5\xc8\x9d\x1b\x11\x86\xf8\xe1,\xa8J\x18\xb9\x06d\na\x18\xdd\xd6\x00\x04W\xa6\xc8\xf3d\xd1j\xd5j\x06\x84&\xc9\xc2\xf6\xe2y\xb2\xb0y\x7f\xcaUv 3\xbahs#\x0b\xb2\xcf\x95\x86\xb1\xd0\\\xe9\x9d\x02\xf4!\xc5\x87f-[m\xad0P\xb1Z\xaa\xbd\xf0n\x87\xc9\xc1\x91\x11AB\x1a\xa1\xa8\xcc\x02\xcb\xa5\xc9\xd8\xe1i\x02\xa6\x058.\x1cbI\x0d\xf3\xa3\xa3\x91\x89\xb3t\xe7\xf3Y\xe7u\x94\xc4\xcd\xd7MQ\xc4~H%|y\x85/\xaf\x0e}\xf9\x05_~\xc1\x97\xfb;_\x9e\xe3\xcbs|y\xb0\xf3\xe5Y\x84\xde |\x0f\xda\x17\xa9}X\xda\x87\x99}\x98\xd8\x87\xa9}\xb8\xb0\x0f\x0b\xfbpYy\xf9\x9e\x86b\x1e?\xbf\x0e.\xdb\x7f\x9e\xe12\x8d\xc7P\\\x81Ms\x15_>\x8d\xff<\xdblLb\xab\x15L\x9f\xb6\x7f<k\xb5\xa6p[9}\xfa\xe3Y\x18\xc7\x8d\xc6\x82(\xb6u\xfc\xec:(\x8f\x96w\xbf?\x0b&a(\xae\xf1\x9e\x1e\xcd\xee\xfeF\xef\x839\x8a\xc7\x8b\xd3?\xcf\xe0\xc8\xf2~\x84?\xed?\xcf\xd0RW<6\x9e\x98\x99	\x0cx\xae\xc55\x0b\x0d\xc7~\xc5G\xd30\x14+\xbfj\xa4\x0cl\xc1gM\xf1\xec:X\x86\xf8;\x0b\xb1\xac/\xee\xfez\x16\x8a\xa3+q\xb4\x10c\xb1\nw\xc0\xfa'\x00\xfegs'\xf5\x1d\x01\x9b\x1a\xe6\xf9\x16+\xff\xe5\xdc\xff\xf2\xde{q\x1dy\xddDk\x02\xf3?>:\xf7\x9f\x8e\xde\x9bT\xff)\xdcV\x01%\x93\x8a\xd1\xf7:\xee\x0e^?)\x06\xaf\x8f\x8eB\xae\xb8j\xca\xf2\xa6\xee\xd8\xf8\x90w\xfc\xb3\xec\xb3wp[\xd7\xedP\xe7\xad\xfc\xb8[\xebQ\x93\n\xdb\x0c\xec\xc4f\xd4\x9c%\x19D\x7f\x9e\xa5\xdb\xb7\xa0\xd3\xab\xd7\x8f|\xa4\xdc\xee\xcd\x10\x1b\x06&\xc9u\xaf\x86\xa9\xf3j\x98r\x04\xf9\xe9\x9e\xe8\xa0\x1c\xcc\x9e\x94G\xa9q\x18\xe0\xcb\x0ef\xe1 \xb5\x06a\xf1R\x94G1g\xda\xb3:\xc2V( \x81\xf8\x17\xd5\x14\xae\x1a\x91\x82\xf2\x93\xd3\xcd&\xa8\xea\x0e\x0fY\x0d\xfd\xe0\x81\xf6&\x93\xab\xb7y\x19\xe9\x8ey\x10\x13\xc7\xf6\x89t\xa7z\xb1\xaci\x9fh\xfb\xcd\x81\x11rW\xba=\x8d?\x17\x08\xec\xcf\x94dyuId\xb2\xf5\xfd\xa6\x93KH\x14\xcax\x9f\xe28\x1d\xaaQ\xc4\xa4\xf3x\x9e\x14\x9f^Ak\xc2\x11\xd8\xd3|\x8e\x921\x84\x82xi\x9a\x9cp\xed\xfcr\xea\xbb-\xfb\x9e\xa9\xa8\xdf\xae\xbd`@\xb8\xc2\x0f\x99\x1a\xfa\x01\x14\x10\x08\x03\xc1\xb4\x90K\x18\x89\xe6x|\xc9oc\x95\x8d\x97\xa5\x1c\x8f\xfd5\xf9\xe3m\x95\x13	\xf1\x16\x14\x04\xea\xc0\x0b\xc8\x89\x83u\xfcy{\x07M\x14X\xd3\x0f\xf3\\\xd5 \x9a\xe3r\x96L\xf3\xd5w\xf9\xdc\xef\xd2\xaf\xde\\\x92\xfa\xbf\xee\x98|\xdf\xa6\xcb\x02\xea1\xe6\xcd\x9c\x88\xbf\xef&\xfc\x81\x04\x10P\xefke\xaa\x14\xaf\xdcn\xe2\x1f^/~\xff7\x97\xc9\xbfk]\xf5u\x19^f\xa7\x7f\x9fE\xb5\xa4\x9f\xe4\xe9\x1f;I\xd3\xf7\xa7\xff\x9cE\x7f{\x84\xf7\x1f\x0cK\xae\x13\x97!\xc2BD\xf9\xbd\xc9\xa7p\x90\xe9\xf1k\xff\xe1\xf5JWv.\xdbj9>\xad\xa2\xe5\xf4^\x9d\xa7*\xbb\x1cd\xa7\x10\xc2\x94\xb2\xd0\xdf\xca\x8b\xbc\x90\xc4R\x8d\xea\xf7A\xe9\xef\x86\x9f\xb93U\xdd\xf6F\x80kDQj\xbaC\x0e\xd4~\xbd\xea\x8b\xf5~\xc3\xf5\xee\x8f\x0e\x88\x0f\x979\x83\x8a\\\x97\\\xc9\x9fj\x00\xef\x8c\xc74\x85W\x97/\xd2*\xcf/\xbby\xca\xfaw=\xf6\xbe\xef\xdf\xfa\xde\x7f\xfc\xe1\xbfr\xdf\x03\xa0\xe58\xee\x1f\xf7zB\x8d\xab\xcbi5\x85\xe3\xa3\xa3\xad\xc8\xc6P%\xc8\xc7q6\x8e\x9b\xf9\xf9\xdf`\x029T\xb1\xba\xf6\xdd\xa1y\xc9 X\x8d\x020\xb4\xda\xdf\xaf\xb3\xc9\xe9\xcdy\x91\xafJY\xc0_\x90\xf1\x1a\x94\xc1oh\xa3'V\xd7	\xb4ovB:#\xa9\xbc\xbat!\x9e\x91S\xe7\xcb\xc9\xec\x05\xe2\xe7\xd6\xf2m\xa3\xa6\xf3\x04R\xf5\xc2^][-\xef\xabs\nW\xca\xf4\xe2K}\xca\x8bO\xb28\xdc\xad\xc3\xcde\xc9\x95\xbaLt^\xdcZk\xd7\xd5\xda\xfb\xfa`\xbbu}L\xcb\xd1\xb5NR\x10\xf6\x0e\x14\xe4\xbd\xefU!/\xf2\xeb\xbf\xee\x05\xc3\xbf\xa6\x9d\xd1Qx\x0fD\xa7\xfb\xfc\xfa\xfd\xcb\x17\x7f\x95\xd5\xb7\xcd\xc6}\xfaP\xa8\xa9\xcc\xf4_\xf7:G\xa7\xc5U\x14\xd8L\xa8!\xa9jx1\xbd\x94\xb5\xda\x8b\xf8\xde\\M\x8a|.\xcbRf\x97\xb2\xb8\xa7:\x1a\x06\xd5N4\x8c\xd8\xe7\x81\xc2NC\xd7\xe2FW\xa8\x0e\xcc\xd4U\x9e\x91\x0b\xb9P\xc0B_u\x94\xac\x7f\xcb\xa1\xaf$H\xfa\xd4\x91\xd3\xcb\x9d\xaf	}E\xf4\\\xd5Y\xc9\xe7\xb3\x04<\x91P8h+\x00[\xbaU\xb5\x07\xe1\xc6a^\x06\x14Q0\x17&\xfe\x0e\x1c\xbb\xc8k]_y\x87\xd6\xcf\xfb\x8f?\xbc\x93\x13}xI6\xf3\x8c\xd2I\x8c\xd2T\xd9\x1d\x13\xfa\x19\xb2\x9e\x8e\x82!\xb7\x19\x9f \xc5'Y\xec\xae\xe8f\x9e\xf1\x97i\xbe\xca\xfc\xf2\x0c\x98\xcd\xc6\xd4\xe3\x80\xf34\xee\xf5\xc2\xed6p\x8b\xb0\xb3,e\xf1\xec\x12\x9e D2\x8eo\x9aC\xb3w\xef|\xcf\xfb|\xd4\x8cz\xc2\xa5\x1aYb=\xed\xbbD\xcbz\xca\x8b\xa2\xc8\x8bz\xd2s\x82\x9d=\xb7\x0f}{\x9bh-\x8b\x9d\xf6^\xce\x93\xcb\x9d\xcaMMH\xdb\x8a\xc2\xef\xf1\xcbL?\"\x1d\x93z\xfe_\xd5\x17\xd2\x9f\xa7\xc9|!\xa7\x07\x8a\xbd\xcct\xef\xc1-\xd5\x1d\xfc\xf02\xd3\xc7\xfd[\n\x1c\xfc\xf0=,qn\xff\xf2\xe0\xbe\xfb\xb2\x15\xe58>\xa3o^\xe0\x1b\x9d\xbf\xa7\x80\xd8\"\x1d\xc7\x94\xd5\x8b\x7f\xb3\x1c\xc7\xe9\xb8s\x91\x17/\x92\xc9L\xcc\xcc\x1b\x91'bB/D\x1a\x8a)=\xcf\x93\x85\xb8\xa0\xa7BN\x97\x13)\x16\x84\xcb/\x0f!\xfb\xc5\x98w\x85\x99\x86 \xdc\x0ev\x92\x0e\x14\xfa\xda\x862QV\xe7cq5\x8eMd\xdcE\x81\xcb\xb5\xba\x02\x195xQ\x0d\x0c\x9e\x89\xb2R\x17K\xb8\x15\x8f^\x88K\xe9\x05\xa9\xa8\x0e=\x10h\xbb*\xba:<E\xf2P\x8f\x8c\x83`Q\xd6\xcaV\x1a\xfaw8[,\xb7B&\x93\xd9N\xa6\xab\\M\xeft\x1b1d\xc4\x81\x8eM \xca\xea\xfa\xa4(\xf6\xd1L\x95\xe1\xa1N\xc0\x19\x87&\xa9\xddPA\x85\x7f\xcbn\xab\x7f\x96u\xebNVD\xe6\x9el\x0d\x80\xd6\xe38\xe0UpQH\xf9Yn6\xe65\x0cn\xbe\x01\x93\x1d\x88:\x92b\x92\xe6\x19\x14\x07\xe6\xb2\xb8\x94Qf~\x9f\xa5i\x94\x0b	3\xe8i\x94\x08\x96K\x94Q!\xfc\xf9\x8c.\xc7\xa2\xc2nQ)\x98\xa3\x18\xa5Be3Y(]FK1W\xd7*\x8bfB\x95\xb4\xee^\xa9O2\x9a\x18XM\xc5<YD\x17\xc2\xac\xa4h\x01I\x0eB\xb3_\x8a\x0b\x95M\xbd1:\xa2\x0e\x04\x92\x8b\xb5cL\xc2\xad\xd8e\x90=\xc9\x07\xd9\xd1\x11d\xb8\xd2\x90\xdeJ \xd6\x90\xb1R\xb2\x14\x10\x02\x0d\x89sT?\x17\x93eQ\xac\xa3+\xdb\xb5h-TiQYt-Ti\xf6L4\x16\xaa4\xc0\x8bVB\x95\xdf.U\xaa_f\x9cr.\x8c1\x81\xc1\x0b\xd1{\xa1\xca\xef\xf2y\xf4Z\xc8\x7f\xde$o\xa2\x97\xa2\x90\xbaP\xf2JF\x1f\xdcc?\xfa\xce=\x1fG\xcf\x04m\xb0\xe8\xb90\xf2I\xf5Y>/\xb9K\xafDR\x82F\x8d>	]\xa8y\xf4\x16+\xf1Y\xf9\xd6.\xf9\xe8\x8dP\xde\xdb\x19O\xd0\x8fI9{\x9d,\xa2w\xc2(K\x98\xca>\x8b,\xcf\x17\xd1\xb7\xdbP\\\x8f\xe3C\xf4\x86\x8ffNM!?I\x8c\xc7\xf1\xefb5\x8e\xff\x16\xe7\xe3\xf8g\xf1~\x1c\x7f#^\x7fa\xa5\x99\xdeD\x1f\xc5$_\xac\xa3\x1fx\xb5\xfd\x86AD\xdf\x8bd:\x8d~\x14\xa4\xbf\xfc,\x9b>\x9bN\xa3?\xa1u\x10\xfd*R\x99E\xbf\xe3\xef\xa5\x9eE\xe31\x9e\xde\xff\xb3L\n\x19\xfd\xcd\xa9\xfc\xba\x1a\x8b\xf92\xdd[*n\xb6\xd9\x15\xb1\x89B\x81\xd0\x0e\xe4\x82\xf8\xae\x82&3\\\"\xa9\xab\xaf\x96\xbdW/{\xcf\x95\xcdoC	\xc3\xee\xe8.Z=B\x83w\xd1\xe0\xd6\x8c2\xfa\xa3\x9a\xe2\xe8\x1f1U\xe6\xee\x13\xfdL\x8f\xd1\xf9\xd8%\xf1\xf0\xbe\xa1\x04~y?\x16\x99\xbc\x04<om6nK\xd7\xd9\xb6\xe4\x8e\xa6\xb2XD?	\xf2\x97\xe64\xac\xa3_\xc4\\e\x91\xd6\x08`\x1dI\x0d\x11\xb1\xbe\x0dw*-\xc6\xd3\"\xb9|\x0f\xd2\xc3k\xbd\xf2\xefh\\\xc6!\xae\xa4\xec8?Spn\x05\xbc6\xb6\xca\xda\xc6\xf3},\x05gR\xd9%\x85\xaf\xa0L\xd7\xf0Dd\xae\xa3\xcc\x14X\xbb\x94?L\x8a\xf5\x05\xf8!\xb7\xb2\xc7L\x1b\x99Y\x13M\xb0\x97X\xeb\xad*\xdc\x9a^\x1f\xea\xf0\x81n\x81g\xef\xec\xc8m\xc3\xbf\x13\xdb\xd5v\"\x8fU\x9b;\x0b%/~^\x0f\xec\x00,\x7fc\x1dg4D\xa8\xc5\xe5\"!\xa1\xde\xbf\xe8\x7f\xd5u\x16\xefS!\xe0B\xb2&\x08\xc0\x88\x91!\xc3Z\x94n\x14\xf9\xc2\x00\x96\xfbQ%\xc4\x85\xc0\x15\x19\xe4-\xdc\xf04\xe2\xb8l\xb5n\x87d\xe9z\x92\xca\xe4JV\xdd\x11\xc5\xbf(]\xb8\xd2\x12TfU:\xdc\xf2D\xbc\xc8\xa6\xffv.\xb0v\xfcE\xd2\xfbw\x10$\x87\xc0\xae\xd7\xbb\xe0\xf8\xd2\xd8w\xf3r\x95\xf9\xc2\x83\xc2\xc1\xc5\\\xe9\x8fy\xa5)\x95O\xe2\x97{\x14\x17\x13T\x1fj4\x93\xa9\xfc\x9b\x19\xf9	+`\x07\xb5\x1d|\x18\xdf\xb6#?\x8c\x050i\x05\xcd\x9a{\xc0zUX\xd8\x0d\xd8\xf2jw\n\xceT9@8\x1e\xd8Q\xc1\x1f\xc3pT\x11%,]\x18j\xa7A\x0d/*0\x84\x1d\xea\x11\xf4\x10g\xbes\x19\xaa\x8a\x9f\x91\x81\x15\xe2gPW\xcf$\xee\xdb\x13}\x1d\xa9\xcd\x06\x19\xe1\xbb\x0e?\"\xcf\xfe\x1f\xe8z\xef`\xd7U\xf9^\xa52;\x88\x06w\xe0\xce`\x81/\x8bVKV\xbd\xda\x8a\xfc\xe2\xc2+_\xe9g\x1e\x1a~m\xe8\xf5\x15B+\x92\xf1\x16<\\\xc0i\x86c4[\x05X\xc4\xce\xf0!\xc2\x0coeg\xb3\x01\xe2\x94\x0d\x1981\x1c\xe0!\xce\xb6\xf8i\xb5\xba\xec\xaf\x83\x81\xdajY\xd378\xd8\x04s\xfb\x8e\x97`G\x8d\xaemA\xb4\\^\xca\xa2\x06,\xd0q\xf5qR\xbf1\x81\xb2R;\x87\xc2\x9f\x9dE\xf5\xf4\x18\xf8 ~9\xb6\xc2\xf1\x9eGE\xef\xad\x1a\x8aK\x17[\xc7I\"\x89\xbb\x83\xe4I>\x08o\xac\x84\x8c\x85c\x88\xbd\x9c\x8c:3S)=O\xf4uM\x00\xd3?\x98\x85\x02\x1b\xd4\xf2\x1d\xdf\x9eO\xc8a\xdfefR\xda\xe6\xa63\xd9\xb5,d\xb8\xa5\xe7<\x93\xa70\xf5\\\x80.\x0c\x12\x81\x08V\xedv\x18%GG\xdb\xed!\xf8\xfe\xa6\xf4\x8c\xb9\x0c\xffcP\xdf\xdf\x05\xb5\xb0\xf3\xd0\xaeC\xbd\xa6\x8f	J|\xaf%\x84[\xb0\xb3\xc0:\xe1\x87f!\x1f\x16\xd5,\xec\x80\xbf\xf6\xed\x10\xdc\xf73\x1c\x048gc\x80\x03\xd2\x94B\x90\xce-\xa4\x0b@:\x01\xa4\x8b]H\x1b\\\xfe\xdd8n\x96\xb4\xfd\x9b\x83\xc4\xbf_\x832\xc9KO\x1d\x07\x01h\x01\xe6g\xe3x\xd8\x9c \xacPS4\xa7\xe7\xa9}$_\x9b\xab\x99\x94\xa9}\xc9\x97\xda>.\x17\xf6\x89x5\xfc\x8c\xbb_\xd3\x18%\xcbk=\x97\xd9\xb29\x12\xcf\xbdC\xc4\xc9\xbc>\xf0>\xc1\x8c\x84u].\x16\xdd\xb0\xfaN,k\xaf\xac\xf3\xa4b\xb5\xd9@\xb50\xb1\xb9\x8b\xfcz\xed\x9ft\xe4'SN-\n\xea\x8c\xd3\xa4\xd4\x1f\xc0\x9dz\x9d\x83\x1e\xe0lH\xb5\xc4\x1c\x9e\xff\x10J\xef\xf7Lj\xe3\x1c\xb3x\x8bf\xe0\x06z\xf0\xfc\xd6\x83\xef\xf9X\xec\x14\xa8-\xf8\xaa\xbb|\xd8S\xd7\xed\xf4\x04\xa4\\\x15L\x83g\xe3J\xc3\x91\xa2\xce\xe7\x19\x18\xed\xa4X\x8d*`\x17\x8e_\xd6\x99\x82)\x1fo\xa0\xd8\x83)\xd5\x1d\xeb\xad8\xec8\xd5\xd2\xc6\x9f\x8b\xdf)\xf8\xc4\xe7\xe2\x0f\x91\xc55\x00\x12\x9ab\xda9o\xb5\x1ayg<\xfe\x0cr-\x8f\x03\xceZ\x91\x7fY\xe7Zd\xf0q\xc9%\xacW\xfdZ\x8d\xbb\x85p\xb6Rt\xf3:\xd5H]\x1f\x10\xf0\x8dBk\xab\xe5\xbd\x04\xc5i\xd1a\x07\xb1MFYMb\xb0\x82\x86\xcco\xa7\xa3x\xa5\xd2R\xbe\x9d\xe8Mj\x0b\xba\"/o\xaf\xd8\x96\xc0\xb2C\xcd\x0c\xf2|YGw\xb7t\xab\x06 [\x93\xe9\xe2\xc0z	\xd7\x1dm\xf3\x83\x9dM\xda\x86r\xcat\xf54\xbfQ\x88\xcf\xa6<\xd1\x13\xc7\xe6Q\xad\xd6\xe3\x06DK\xf9TBY\xb5\xd5j\xc0+&D7;\x1b+\x0c\x07\x0d\xc9#\xe4c1h^\x92N1u\xe6\x86|\xec\x92!\x800\xca\x85\xfbcs\xb3|\xb3\xc5\xe5\xd5\xc0\xffVJ\x02\x9e\xb8\xa8\xd7n\xcb\x01\xef	\xde\x0cU\xb1\xf0\xe6\xf0V\xa9\xe1\x0d\x97\xa36\xb0\xd8\xb1\xc7\xcc2\"}\xd7C\xbb\xc0\x15\x1f\xc8j\x9d\xb5Z\xde\x0b\x14o\xc5\xde\x0d\xef\x16:\x11\xa6\x14d\x92\xce\xeb\x1aD\x10\xf9\xa9\xc9:\x06?WtP\x1e7\xf3\xacy\x04\x8d\xcc\x9cn\x8bB\x85\xd0\xe9&\x97\x89pO\xd2\x99@&\x99~\xbb<?Oe\x8cT&\x08D\x12\x86\"s\x93\x05\xafM\"\x83w\x06\x92\xd0\x89F\xbdd8\x08\x07\xf5\x94\xcd&\xa8\xcd6U\xe0C\xaf\xd5\xf2\xdf\xc8\xba\x8f\xfcV\xbeJ\xd6\xb2\xa8Y\xd2\x1e\x10\x88i\xea\xbev\xdd\xa5\xb8\x18\xda\xf6\xb6\xd5r\x8f\xd4\xee\x96\xaea\x0e\x99\xec\x81\xb5\xa2\x1a\xfdi\x87\xc8\x0d\x11(\xd3d\x8dH\xac\xa4{ts\x1dix\xefA<VKc\xb5{\x83\xe4)\xc8\xacv\x9b\xedB0!\xa0e\xa0\x88\xd8j5\xf0\xd81\xb1\xcaZ\xad\xa0\x88\x0bM_!\xb9'\x07\\\x0d\x84+\xe5k,\xd0_\xf5\x16#_(\x80\x1f\xbe\x1b\x87\xd0\x94\xd5U\xba\xb5\xcd`\x9a3\xc7\xae\x08\xaa\xf3v\xef\x08].v\x8f^\xefH\xae\x1f\xac\x15\x80\xc2\x1b\xff8\x02a\xec\xbe\xd5	\xf7\nUKB\xfa\xf8\xfb\x07\x96\x8c\xf2V\xa9\xd7) \x89\x90\xaf\x8e\xf9*{\xe1\xabC\xac2\xf2\x91\x18\x0f\xbd\xaaF|\x94.\x17\xc8\xea|2\xba\xa1y\xf5\xedf1c\xa4\x0c\x15\xfdm\x1am0\xaa\xa22\xb8%\xd6{\xb0\xd9\x9c\x8f\x83\x9d4Q\xebU\xf8\xf4>_Q\x06\xbb\xbd'\xecp\x0bVV4\xf9\xb0\xab\x99\x05\xcf\xc7\xe2\xc3\x98\x1f\x14\x1fl\xaf\xfe{,\xcaO_gG\x96Z\x90\x94R\xe9u\x94j\xc3\x9b\\jb$\xce\xb4	4\x99\"\xdfD\x0bra \xa3\xa9f\x06\xde\x85\x16\xe4E[G\x0b\xcd\xdc\xccK\xbd\x0d\xc5\xdbq\x9cj\xf1f\x1c\x9f\xc8\xf6\x898\xf3\x89\xb2\xf0\xa6BVV\x1f\xddb\x06\xfb\x0eg\xf8p\xb5\x00x\xc1\x86\xdfzN\xb0\x1c4\xe7I\xa1\x8b-N]\xb1U\xd0K<\xec	\x082)%/\xd4\xa5\xe2}l\x9e\x8dc\xe0\xadx1\x8e\xcf\xbc\xa5<x1\xae\xc2h\xd2\\\x89\x17\xe3N&\xe5\x94\xc2	;^a5\x18\xc7\xe3\x9c\xebz\xc7\x10}[\xd7\xc7\x04\xdb\xc9\x03\xa9=?\x95:\x8fP\xc1\xbd\xbd\xc4\xde\xa8\xdd\x0b\xd1\xe5]\xdf\x10~o\xb0N\xb4=\x90\x08)\xcb\x18l\x9ejX\x82/\xd5\xfb\xa32!\xce\xf0\xcde\x1e\xa8\xcd\x067\xaf8\xdbl\x08\xdb\xa9S\xcap)u\x1d\"\x88\x1c\xf9v\x0c\xfdz\xa03u:\xd3A&\xfcV\xb30Z\xee\xa4\x85<=.\x93\xdd\xed*\xbb\xaa@\xbd\x97\xc2=Y\xe8`\xef\x13\x19\x0ef\xad\x16\xf5\x84@\xb5\xd7\xd1\nX\x95\xc0\xeb\xec@>VS2\xb5\x94R\x1f\xae\xc0csTC\x05\xed4]\x14\x9bMo\x00\xe5z\xbfp\xa0\x88\x0f.\xf0\xd33?}\xf3sl~\xee\x9b\x9f\x93Q\x18\xed\x96\x84\x1d\x8f\xa0\xbf\xa6S\x85\xc4\x15F\x1e\x1e\x99%\xb5\xb9\x1f;\xbd\x90\xa8E\xd0_\x16\x1f\xbe\x1b\xc3\x1e\xf7\xc5\xb83\x95\xa4\x0fW\x1e\xaa\xb7\xc2\x94n\xb0\xb7\xac\xb8\xfa\"\xaa/\xc0V+\x98\xe9\xe0\xddX\xe8\xdaD\xc3\xd7\x9a\x8c\xdf\x8dmT&\xe9\x84\x06\xd2\n\x0d\x04\xae\xd8}\x08\x10\xfa\xa3#9<\xc6\xd3\xb1\xbbh[\xd4\x01\xb5\n\xb7\x95\x06s\x1d\xa8v\x0f'\xaa\x8a\xc9#W\xf9O\xa1\x03\x15\x86b\xae\x83\xcc|\xc9\xbc/\x19y\xa6\xe8\x8e(\x86\x97\x8a\xdb\n\xaf\xc7\xe65\x8b\xdbY(\x10\x86/\xa6\x99\xca\x8d\x08\xe4d$\x12\xa4)\x91 !\x13u\x0cEu':\xc9\xfa\x01I \xee\xe1R\xde\x1d\xddS\xa0?^\xd0\xd23\x86t\xa4w~\xcbfv\xd0\xabq\xc0\x08\xc9\x11\xbc\xa47\x04\xc8=\xeej'n\x81\xcc\x05\xd6\xa2~\x86>2\xf4!\x8f9\xc6\xd3\xf1\xc8)\x95h;v\x19\xb7e(\xb4\x1d\xbb\x01\xc5P\n5\xa2\xd5\xe7:D\xfec>\xe4\xb4\xcb\xe2C\x84\xf7P\x0br\xdb\xb0\xb7\x91m\x93Y\xab\xf5K\xa0\xe8\xa2.\xd4\xc1\xca\x0d|\xbe^\xbb\xfeJ\xd5\x876\xfaN\xado\xc7\x81DPF0\x80\xad2\x9b93pU\xb5'\x0d\x01\x1e\x0b\xcf\xe1{\xf2\xdf\x13\xeb=\xffA<F\xc4\xb2\xc6\x9ai\xc7\x1c\xfe\xfd\x84\x1eqD]\x80\x06\x87\xae\x1d\xae\x93S\xf3\x92\x87\xc2\x968\xaaJ\x1cq	Tt\x14'U2\xd6\x9d\x90f'\x7f\x1e\xc77F\xf13:\x80\xea\xf4V\xfc\xb3L\xa6E\xa2\xd5\xe4e\x16\x1d@\x86\xfa\xae\x9f\xe7l\xe7B\xc9\xc3\xd1w\x83~[\x87\xb5\xca\x0eg\x0d\xf4\xdd\xb8\x1f>\xe9\x9dvN\xee\xea\xbb:jwN\xee\x06\xed\xb6\xbe\x1b\xe8v?\xa4\x13m\xb2<\xffBg\xd0\x1d\xcaq\xb8~T\xa5\xef\xea\xa3\x1e\xe7\xfa\x97\xfd\xb8\xab#t$\xd0\xed\xb8\x1fR\x05}3\x9a\xe2K\x80\xb9k\x81\x83\\\x87\xdb\xb9\xd3k\xdfi\xb7w\xf3\xfe\xeb^Y\x08U\x1d\xbb\x0b8\xa1Q\x95}\xadk\xa6s\x94\xefps\xaec\x06`\xae\xce\x7f\xdd\xb9=\xb09\xd0\x95*[\x96\xb9\x9a&\xe9\xe1\x99\xec\xb5	\xffL\xf22\xd0\xce\xefU\xbd\xe0\xe1^\xdc\xa1\xcc\xa5\xcan-w\x1bp\xd1S\xafYn\xf4.\xc9A\xe5\xb5Q\xf3V\xb7\xf5\x17,~}\xda\x8d\xa8\xd4\"_\x05\xbdn\xff\xbe\xd0\xed^\xbd\xf0-}\x86\xed6\x82Bq\xf3(\xdf\x17\xed^\xf7\xae\xdem\xfb\x96\nl\xf3\xb6\"\x7f\x1b\xedw\x89\xd6r\x7f\xb7\xad\x00\xbd\xc5`'\xaa@8\x97\xe2\x8b3CGG\x0f\x0b\xc4+qK\xef\xaa\xa3\xc4\xae\xf6Z+\x87K\xb9!\xd0\x02\xf7\xab@\x9bv\x14~\xba\xdd\x04\xe1\x11A=M\xca\xfd\x1d`\xd9U\x9d\xde\xe06\xd0\xc12P=\xe9\x9d\x06*\xee	\x19wza$\xe3\xce}\x03\xc7\x04+\xabwO\x85\xf7\x82\xbe]_\xa1h\xab\n\xca}a`\x19\xf7B\xf6\xd8\x86\"\x81n\xcb\xf0\xaeW\xe6^\xe7~X\xf5r\x17\x04\xffw\xbaY\xeb%\xa6\\\x7f\xad\x87uH\xfe\xff\xd3K7\xf1\xff=\xa0\xdemwN\xa2\xbd\x91\xfe\xbb	\xb9\xdb9\xa1\x11\xc3\x03\xc2\xce\xea\xa7\x19\x89{\x9d\x87\xdd\xde\xc9#;\\\xe0\xb3 \x90G\xbd\xf0.\xaa3\x05\x0fBi\xa7d\xbb\xed\x17=\x92!\x0e$\xd3\xea\xe1\xe2\xfd\xce\xc9\xe3\xfb\x8f\xbb\x8fO\xb8\x02\x07\xa4\x9d.\xdc\xed\x9c\xd4Q\xae\xed\x1e\xda\x00\xfe<\xcf\x97\xd9D\xde\xb6\xb5?\x8f;&\xc3\xd9\x92v\xb7+\xb0\xdb+\x0b\x80'\xbd{\xfd\xce\xc3\x93\xd3\x87\x9d\x93\x07}sd\xeb'\xfdZ\x1a\x81\xbesBi\x18l\xe7\xe1	\xf2tN\xf6r\xf5;}/\xdb\xe3\xe3\x87'\x11\xd7\x8b:\xfa\x9d\x07\xb5\xcf\x8f\xee\x1f?<\xb1\xfd\xbb\x153\xea'\x9d\x13\x9cHnd/\xb3\xa0\x7fW\x87\x91\x9f\x86\xd1\xf6\xef\x02\xff\x1duN \xaa\xbaU\x93\xe7J\x8bR\xcb\x85\xd7\x92\x90\xd5=g\x0c\x9f\xe7\x8a\xd4\x93\xa6\x96\xbf0&\xad\x9a\x0fj.c}\xc4\xfc\x1d\x99&k\xb1W\x824\xa7M\xea\"\x01\x8f\x8by[\xe6\x055\x1c\xc5\xb2r\xe0\xa7\xe2@\xb7w\x9ah\xef\x96\x08\xef\xb1\xf4H]H\xba\x0b\x04\xeaI7\x0co\x98\xbeGxq%z\xd6\xf3\x0b\xe5\x95\xc0q\x97\"\xdf7B\xcaN?\x8f\x11|\x06w\xa6\x03\x0c\xd5\xfc4\x0fT\x18)^\xa2wX\x92R\xc8\xa0yQ$s\xd9\x04\x83\xb5\x87H0\xf4%\xcd\xf3\xc5)sA$\x8d \xd0\xa1h\xf2s\x13>!\x91m\x0c\xceC\xf9\x8e\x84\x1dPvjN%L?\xd7\xcd\xd0\xa8\x96n\x89\xdb\x7f\x8bb\xd5\xbf\x81\xd0\xff\xf6 \xb4\x03\xceX\xb7\xa5\x99\xb3\xcbdQ\x9b\x1a\xc06\xee\x8a\x03=\xec\x81K\\\xf8<|\xac\x10\x94\x1dj\xc3I\xd7`;\xd3\xfb\x88\x87\xc8\xb2%\x08\x17h\xea\xf6D\x0b<\xa3q\xa3K\xa3]\xce\xbf\x90\xa5\xc7\xb2\xd6o\xc7\xf1n\x9e\x99L\xa6\x9e\x18R'*\xf5\xa5\x92\xa9\xcc\xe2\xeeV|\x1c\xc7\xdf\xfa\\\xae\x8fc6\xc8\x89\xf7\xf1\x12\xa4\x9c?\x8c=+\x03\xbe\xb6!\xfb\x8bL\x17\xf0\xd6+\xe4V\xb8:(\xb1V\x91\xeb\x19/\x06\xf4\x8a,\x8d k\xed,\ny\xc5w\xdaD\xa5\x02fx\xd7\xda\xeb4Rc\x1dF\xd5\xf8\\fgf\x97\xca\x0cV3\x1f\xc7l	t`\x1c\xa6!\xe2\xc5\xa0\xe9\x01\x8c\xbc\xf1\x10+\xaff%\xd4\xa9\xa2\x8c\xb1\x8c\xaaf\xa4\xed\x15\xf7\xb6\x0e\xd1v\x9bF\x0f\xd8\xbaf+\xfcisQ\x9eI*\x93\xc2\xcfU5]\xb5V\xaf=\xee\x9a\xc9\xfe\xa1\xce1\xa5\xec\xe4\x1f\xcb\x02\x01\x1d\xb4RWy\xb5\x15\xbf\x1d(0NU\xa9iJ\xbf\x1d3\xe8\xe6\xc9\xa2\x12R\xcf\x93kx\xed\x8d\xf5f\xd3\xb3k?MJm6\xe7\xd4L-\xfa\xb7\x15\xdf\x8f\xe3\xdf\xfc5\xf4\xfd\xb8\xb3X\xea\x83W\xf2\xaai{/G\xab\x90\xec.\xd3\xd4\xc6e\x8dc(GY\x83mr\xd7\x15@.{\xb8\x13(\x95<u\x95Q\xaf[\xad\xe4)\xbb\xf0\x82/4L\xe8@\xf1\x82\x80\xd7dV\xc1\xc9\x86%\x1c\x0f\xe1\xb6\xce\x10\xbcm\xa0\xe5\x16R^\x0e\x91\x17\x15v+\xc07\x19j\x88\xb5P\xfe\x9a\x0f\n\xf8\xbb\xd3\xa3\xb8\xa8\xa4-\xe2{\xe20\x1cb\xc5q\xd7\x17\xd0\xc5\xb1zM\x80Q\x15\xa8\xd6\xe9aAE\x11\x12\x12\x95\x82me\x87$\xc3\x9d\xf6\x11\xe0\x9bC\xfaQ\xc3\x87W\x1b5b\xbe\x05am\x11\x98\x85\xf6\xe38\xbe\xf1<,DCv\x194\x12I\xaa&\xf2<]\xcah\xd8\xbf\xdf\x15\xfd\xfb\x8fD\xff\xe4D\xc0Yf\xa6\xd5?K\xb9\x9a)\x8d\x8f']\xd1?>\x11\xfd\x9e\xf9\xf8\xcf2A-\xc8k\xf3\xff\xb3L\xe6I\x81\xb8\x1f\xc3^\xff!%\xf7{}\xfa\xf4yY\xd8\x06\xaa\x02\xe7R]R\xea\x89\xa0\x7f\xfd.\xa5\xaa\xf2\x1f\xea\x0d2\xf6\x1f\x89\xde\xe3\x07\x94\x9c&\x93O\xb6\xdf\xe6=\x9b\xcc\xe44I\xe7y6\xe5\xec\xe8_\x97\xfagF\x84Qr\xef\x90p\xa5\xf2T\xeah\xd8;~$\xee\x1f\x8b~\xdf\xd4\\\xe4\xab,\x1a\xf6\x1e\x9c\x88\xfb}\xfc\x8f\xda\x97E\xba^\xe59*\xee\xf7E\xef\xd1}\xd1;\xa6\x8a'\xc9TjT\x16\x0d\x1f\x9f\x88\xde\xc9#\xd1{@\x1d\x9a\xcc\x92B\x17rYz\xc3\xe7\x0f\xf9$'\xc9\xca\xb0\xdf\xeb\x8a^\xf7D\x1c\x9b\x0fy\x91\xa4\xa6\xe7(\xf0\xc8&f\xf0\x04$\x0b\xd3H\xaf\xdb\x15\xbd\xfb\x8fE\xff\xf8\xa1\xfd\\\xaa\xf4\x13\x0f\x18\xb3e\xc06)\xd4\xbc\xcc3\xf4\xb7+\xfa]\xc1\x9dZ'\xd9\xce4M\x93\xe2S\x05\x9d\xde\xf1c\x9bh\xf3RR\x95|\x99\xa7S\x99\x15\x00\x85\x01\xc3}\xd1\xeb\xb9\x8f\xd0\xd6\x1f\xf6\x1e<\x16\xee\x9f\xfd \xa5\xa9\xadK\xcd\xb8\xd4\xc3\xd9?\xcd\x92O*\x1a\xf6\x1e=\x16\xbdG\xc7\xa2\xd7}h\xbf\xc0\xba)\xd3	&\xedq\xbd\xbfy\xaa\xae$\xb7\xf3\xe8\x84\xca\xdcw\xc5\xf2\x02N\xf9\x18\xb8\xf7\xbd.\xe4\xc5d\xa60\x96\x93c\x81%\xdd\xbdo\x8b\x14rj[q\xb9KZ^\xd1\xb0\x7f|,z']\xd1\xc3b\xe0O2\xe1\xc6{\xf7\x8fE\xef\xd1#A\xbf\xfc\x11\xf3m\xa0\xfc\xb0/\x1e\xf4\xfc~\xd37\x03\xb8\xfb\x0f\xc5\xc3\xc7\xe2\xe1\xee'y\xe0\x93^\x16\xff,s\x85\xd5\x85^?\x10\xfd\xae\x83\x84[\xd7\xf7\x1fa\xc1\xf3\xf4H\xb9X\xa8\xcc\xae\x14\xac\xa2\x876\xbd\xfc\xb4\xb6K\xa0\xf7\xb8g\xb7\xc8T\xcdy>\xb1\x87\xec?NG\x9fv\xd3\xf3\xe9\xa5]\xa6X\xd1\xf7\xef\xdb\x9a`\xaex^(l\xd8\xde\xc3G\xe2\xf8>\xfe\xef\x8d\xc4E\x8a%\xef0\n\xd6$P\x0e\x81\x1b\xd6\xc8\xa5f\x90\"\xfb\xf1c[j9\x99\x95*1\x03q\x1b\xfa2QYy\x9e\x179/y\xfe\x87/\xf0\xf3b\x1b\xb9\xff\xc8\xc7iX\xcc\xdcr\x8f\xb7\xa7\xb7\xbe\xfb=\xec\xe6\x13qL\x93\xcc\xb0\x00\n\xb2\xffF\xa2Z\xd7\xfdG\\\x1e)k\x99\xa6\xf9*\x1a\xf6\x1e\x1eS\xff\x0c\xa8\x19j\xf5\x1afy&\xd7S\xb9\xf2\xd0\xa1\x01\xc0,\xd7\xd5|\x11\xa8\x0dFP\xd9T%\x19\xadN \xb7\xc7}\xfc\xcf\xe9\x97y4|H\x03\xc1\x04\x8c\x84\xba\xca\x8b\xb5\x05\xad\xab\x99w\x17\xda\xe9#\x9fIM\x93+\x99Me\x81\xd5\x0d\xdc\x0e\xc8\xd6>\x9c\xa7\xcbr\xc6\x95\xa1\xe8}\x9a\xdb4Yev\xe1\xf71\xe3}4?\x12\xa9\x9c\xe7\xd9d\xa6..\xf2\xcc\x9b\\\x8cc$R\xc4pc\x84\x06\x10\xf5\x1eP\x83\xf6\x8b\xc5\x84\xe8X\x05k\xf3\x89\xb0R\xbf\x7f\xdf?9\xe8\x8b\x9b8\x0b|j\x0f\xffz]W\xdcL\"\xb6\x84\xfdW}\xe1\xcd{_\xf4\x8f\xb1y\xef\xfb\x9fn)\xe4M\xd0\xa3\xbe\xe8=>v_\x1c\xa6@\x0f\x1f8LA\xa5*T\x81\x95\xf5\xf0\x91\xe8=\xacz\xe8\xf6\"\x0e\x98~\xf7\x81\x9b\x05\xfaX\xe1\x8a^\xef\xb1\xe8\x1d?\x10@\\;\x9f\xe5m\x9f\xb5\x94\xa9\x85\xfa\x03:I\xe94\xe3\xd2\x15\xdc\x0c`\x01d\xfa6\x97\xf6\xcc\xe0n\xce-\x9e\x05t\xbb'\xc2\xf60\x93\x19S\x06vi\x8d\x84C\xd8\xb5\xdd:O\x8a\x1c\xeb\x02S\xcb\xd8x.\xa7j9\xafQ\x0d\xdd\xbeY0\x0f\xbd\x0c\xd5Y\xc5k\xc9\x94sx\xfc\xd1\x03\xf1\xe8\xc4\"=\xf3m\xb1,\x16)\xea\xc36\xec\xf5E\xbf\xf7\xb8*XM\x06f	\x08\xb6w\\\xb5\xe6!\xee^\x1f\x07\x11\xaf\x0e\xf7}\x01{R\x86\x06\xef\x98\x93\xfb\xd5w\x0fM?\xc4`\x1e\xdb\x13\xc6\xf4\xcc`j\xda\xcb\xbd\xc7\x8fE\x1f\x07\x83i]M\xb3j\x8f\xf41!\xd4uT\xac2\x0d\x15\x8e9\x93J\x8c6\xe9K\xa9\xd7E^\xf29\x07j\xa9\xdf\xa7\xfd6\xcf'\x130\x04\xaa\x0f\xbdG\x04\xa0,\xb9J\xfe\xce}\x0c\x8c\x05\xf1\xf0\x98\xbf\xad1\xf1\xbc	G\"O\xa7i2\xa1\xda\x8f\x0d\x89\x06\xec\x81tu\x05\xf0\xf2V\xad\xd2\xa6Er\x1e\x0dq\x12\xf7\xee\xf7\x85!\x98j'\xf1\x03F\xba&\x91\xe0\x80\x9e?xl\x93\xcd\xe1L\x88\x98&\x8e`\xb7HR\xe9v;\xd0\xd5#\xd1\xa7\x9d\xd4u_y3\x9f\xf4E\xff\xa4'\xf0\xcb_\xbc	\xe9=\xc4\x08\x1e\xd9	\xc5Wo>h\x91\x00\xe2X4\xf8\xbaH\xd6\xc9j\xa6\x16\x0c\xa7cL\x17\xc1i\x01\x8d\xb5\xc5\xf2\xe2\x82\xbf\xa0\xb3 @\xf0\xa5X\x1a,\xdd;>\x16\x0fL\xee\ne<\xc6\x8a0\x89\xe9\x12\xd3\x89\xf9\x7f\x80s\x8b\xe6f\x91\xaf\xa6\x8e\xec{\x88\xbdz\xdfn*\xb7\xa2\xfb\x8f\xaa\xe9q\xe0\xe3\x0dU\xe4\xe5\xda\x12\xb3\x96\x1c1$I\x91\xaf\x13F\x04\x0f\xcc\xf1\xcd\xeb\xa4L\xe0\x81\xc8\x16:~\x8c\x99\x00\x1cF\xa2\xc2g\xdc\x9e\x99\x8a2\xc9\xa6\xb6\x91>P\xe6\x83\xfb\xc2\x10\xe8\xd5\xbe\xba\xff\x80\xc8\x9dG\x0f9\xb9\x9c\xc9\x94\xe9\\\xb3\x88\x08\xfc\xa5\x92Y\x06\xba\xeeAW<\xea\x0bs\xb8\x94*\x85\x9a\xde\x10\xa0r\xffFb\x1fG\x9a\xb5\xe5\xef\xd6\xee\x03\xf1\xd8\xe1\x88\x1a\xde\xec\x9b\x01\xa0\xb7\xee\x8b<\xf0%\xab\x90\xa1;\x0b\xf7\xf6\xbb\xa1\xd5\xf1\xa5B\xae\x0f\xf9\xfc5\xe7\xb5\xa6\x03\x0bg\xd0#w\xd6j\x89\xf3\x8d!\x89\x7fF\xa9M\x03K\xe10$*\x0c\xbf#\xa1\xf3y\xa2A\xd4\x9c\x9c\x88\xc7\x8f\xc5CZ\x1a\xde*~p\xdf,\x8c.\xd5bI?Z\xd38\xc2\xf1;\x12\xab\x99L4\xe3\x0b\xda\xe04\xa7\xfe\xb6\xe7\x7f6\xb5\x9c\xe7\x9f\xfc\xbb\x98\x99\x8e\xdd\x13\xa2[%\xba\x1dw\xbf:\x11\xb6\xe2\xcf1]\xa8\x7f\x1b\x07\xfdn(~\x1d\x13_A\xfc>\x8e\xdfh\xf1\xf78>\xd3\xe2\x8f/(\xaa\x91\x8b\x9d\xe8\x99\x16\xa9\xba\xd0\xd1'-t\xfe\xa3\xbc\x8e\xdejq\x91\x94\xfa\x15\x0c:\xdf\x98\xe7\xd7\xc9\xe2CN\xa1\x93\xa2\xdfa'[,\xa23\xd8t\xba\xd4\xbf\xc7b\x9eO\xd5\xc5\xfa\xc7\xf7\xaf\xa2\x17\x9a_\x9e\xa5\x8bY\x12\xbd\x037\x18h\\]\xac\xa3\xcf\xd0c\xfb\xe76c\xb5\x9f\x0f\xd9\x190\xf7\xafH&\x9f\xca\x8a\xcd\xc2\xfa\x99\xcc\xae\x19\x83O\n\x15\x83F\x8f\x13.%\x9c7\xc4j\xb3\xf9\xd6\xe6)MR\xb6\xd9|\xb4I\x93T-\x9e\xc3\xc5\x95cS\x12\xdb\xb9z\xcb3	\x9dTX9Y\xcf\xd6\xc4\xa6\xad'\xa2\x1aN\xd9\x0e~\xf6X:\xf1\xcdj&}1\xc2.G\xc7\x0c\xab\xb22\x82\xad\xbe\x84\x1d\x9c\xdc\xf5\x16\x90\x81!}\x114\xd40\x1b\x857\xf8\x0b\xed\"pj\xacQ\x8c\x19r\x9d[\x9a\x85\x96\x17\x12\xc7y8\xc93\xad\xb2\xa5\x1c\xc0[\x80n\xb5P\x0b\xdb\xd2!\x82]\xd4\xe5\xf8\x02\x7f\xeb G\xcc\xb5\xdd\xef\x9a\xbfK\x18\xb8;\xcfkhp+\xa6K\xcc\xb17\xd4\x1d\xfe\x9d\x078\x1b\x8b\x86\x8d\xdd\xf6\xb8\xb9\x16\x1a:\xee\x0e\xf4\x93:\x88Y\x17x\xa0\x8f\x8e\xc2:\xf0\x87z\xd4\xa1\xaa\x82p\xf0/\xf8\xc1\xff\xfdFL]{\xad\xf4\xb6B\x95o\xd1\x01\xcf\x00\xd5\x02\xa1\xc1\x1a\xaf&3LUsx\x84LS\xc8\xb3\xfc\xec\x87W\xfbN\xc7\xe2\xae[1:\xae\xafT\xe8&r.\xa1\xe2\xee@=\x91\x03\x05X\x0d\x95U\xe16&'\xbb\xd2\x00\xb72\x99\xc1(r\xaf\x95\xc4\xfa\x90\xb0+6t\xd2\x1c\xd3\xd5\xdd\xd5\x9a\xb0\xdf\xaa\"\xfeY\xb3\xda_\xa5\xec\x1c\xde\xb4\xdb\xf9f\x93ujP\x08\x9c\xef`S\xe50\x19\x89\x04\xf1\x9e\x0b\xab\xa0Z\x81\x81\x16P\x11\"\xf4\xbe\x81\x90\x8ba\xd5j\xd5\xdf\xe1\xce\xeey\xaa\x16\xc8\xad\xe2\x82\x82\x99\xb2\x82?8\x9c\xbc*\x07\xee\xa9\x86\x80\xc2\x9b\x92~\x1c\x1c\x0cD\xb3\xfaj\xe6\xc5\x02(\xd7\xbf\x0c\xd5\x88\x8a;#\xaf\xdc\x98y\xee\x8e\x9b\xf7A\xa9s_fV\x19\x17\xc8\xb8>|\xcb\xebt\x83\x14Y\xdc\x1ddUH\x95\xcc\x86 \xc9clU(\x0b\xe6v\x80v\xda\x08\x7f\xc2\x14\x8d\x8c7\xd8\xfb\x17\x00\x95#\n\x91\xa9\x08\x82\x0eB\x88\xb5n\xd5\xb65}f4\x0cw\x05\x99<\x98\xb7\xd5\xaa/\xd3\x1d\x14p)5\xda.\xabvv\x9a\xb13\xcf\x0c\xd6o\xc6qop\xc8\xb9\x91uW\x14|3f\xe1]r\x1d\x98\xc4\xceT^\xa9\x89|\xab\xaee\xfa\x0ekc\xb3\xe9\x91\x89'*\xfci\x1c\x7f3\x16\xbfx\xe7O\x88\xa3f\x15\xff2\x16r\xe5\xa7z\xcb-/\xca\x18AN\xe4\xca\xc7\xf8\xbe?\x18\xb9\x12\x1c\x9d\xed\x96\x9d\xd6\xe8\xb1\xaa\xa5\xd5\xb0\x84i\x16P6K\xe7\x8a\xd8\x06Niv\x9a\xa1(\xe3|\x90\xc5\xcdr\x96,\xc8\x91e\x81@\x19v\x99,\xe3.\xc50\xe7\xf5\xb8|b\xa2\xbe\x940\xcc\x8f\xcba1\\\x8eF\xe1\x00\xaf*.\xd9M\xac\x8a\xf3A\xb5#\x10\xb7\xcf\x0dML\x89\xb8\xf8yLa\x86\xad\xe8j\xda1\x88\xbef8\x92w\xa6\xaa\xd0k\xb8\x0e\x0f;\x98\xe2\xeakx3\xb1\x86\x8fi0\x11\xd3P\xf4\xc2m(&f\x01L\xc9iVR\xaddl\xd7g\xdc\x03|\x9dn\xe1\x19\xdcz\xab\xb1^\xe0\xee\xa8\x92|\x82\xcbkUj9\x05r\x92\xc6:\xec\xce\x7f\x1c\xe5\x1d5%\xfc\xe6E\xca\xfb\xd2\xb6\xaa\x06\\\x19\x03\xf3\x86R\xb4\x93\xb0\x85`\xa2\xb4\xf0$x\xd5\x0e\xe1\x15\xec\x82\xf0\xd5&\x1a\n\x98\"\x17Ix3\x0eTx\x1a\xe41\xf6\xaa\x02f\x0e\xa3k\n\xdeb\x92\xd8\xafd\xd3~1\x99]FI\xafR\xc8\xf8\xa4\xdb\x0d#\xe8\xe5\x9bGg?U\x8d\xd5I$\\\x97\xde\xcf\x12\xd0\x90A\xb3)\x18\x15K\x1b\xac\xb7\xf01I^X\x0f\x9dXi.tP\xb9\xd9\xe4\xadV\x1eTh\x90\xe3\x9f\xd9\x0c\x08~V\x0c\xd3\xd1\xde\xd4\xb7\xdb\xae\xec6\xec\xd0\x89C\x06O[\xb1\xd7\xb9\x03`\xb3\x82\xac\x9b-\xd9\xf5\xda\xc6KL\xb7\xc2\x01\xa4vO\x9d2\x0c\x99\xdai\xc4rX\x8e\xc2U\xa0\xf0\xd3j5&\xe6\xc9H\xd5\xf7A\xa3O\xf5Q\xb3\xd3<*\xa3R\xa0\xa4@n\xeaD\x14$\xc3r\x14\xd3;bL9\x8b\x18\xd3\x0c\xd2\xc3j\xbf\"R\xec \x1d\xea\x11z\x8d_W\x96\x1a\xa6\xc0\x8e)\xef\xbd*\xa5\xa4\xe6\xdc\xe2*\x9evk\xc7\x98\x0c\xb4h\xf4\xc2\x0ehIj6\x8e\xb3\xd3\x93n\x17:\x06!\x1c\xfe#\x8a\x1d\x1cU\x0b\xcf\x8aXyXK\x877g\x9e\xb5.\xac!}\xeb]\xbc\xcbU\xfd\x1d\xbf\x1d\x85\xe8\x9bj\xba\xd9\xa81\xfcj\xa9\x1a\x9e\x03\xb6\x8b\x9a\xbc\xef\x9a\x82bC5\x9b\x02\x08\x8c\x94\x0e\x84\xb1\xc4\"\x17\x07\xec\xd8\x94\xd3M\xe4A| \xef%\xb5\xa9w\x96\xdb\xd4\x01\xe7\xe5\xc5\x18\xd37gy\xa1>\xe7\x99N\xd2f$\xe3\xaeg\xc5\xdd\xbc\x92P\n\xc5\x07\x1dw\xb7\xacf\xbcgx\x11\xa8\x1d\xadf\x18\xb5\xd0\x85\x1e\x7f\xa1\xd3Mz\xc0Z\xa8\xe1\xc9\xe8\xc8Z.\xef+\xf0\xdbMf\xf0^\xa3\x07\x1d \xf2\xf0\xf9\xebbZs\x9e\x83c$\xb9\xd0\xb28\xf4a\xb9\x97F\x95\xee\xd8\xa2\x10aT$\xf05\xe8\x95\x07a\x81\xba\xb5.~\xfe\xb8\x93\x0cc,\xab4\x8dcBo6M\xd2\xb2\xb6/F\xfb\xba2\xd5\xda\xb7\xe8t\xa0b\xcf\x1dBY7GBY\x0fG\xdbj\x1d#\x97\xdc\x8a\x99\x9a\xd6\x86\x88Ol\x91W\xf9\xe0\x19\x7f\xb66\x89x\xec\x14\xf2\xa2\x90\xe5\x0c\xa3,g\xf9\xea\xd6\xe2\xbd\xaf\x16\x07,\xf6\x95\x8e\xf64t\xd8\x1c\x0e\xb9\x7f\xfeH\xd9\xdc\x9e^\x05:t\xae\xbf\x8c\xe3\xb4p/\xb42\xb9L\xb3{\xf7\xe7\x8f\xb01\x1b\xaaj\xf7\xd6\xd7\x05\x1f\x0f\xa5!ph\x13\xdc&\xc8\xc6\xc9\x0f\xdb]\x9c\x81\xefez\xf1!\xff\xb3\x80\x84\x9a\xbe\xda-\xd4j\xd5^\xcd=\x8e\xf5\x83,	\x87\x0fv\x81\xda\x8c \xd1\x08\xdeX\xd3\x9d1\x11T\x1f\xf8\x0eM(\xa0\xd6k\xeb\x05n\xbf\xcf5\xe3\x14[9\x08\xcc@\xdb\xa9ab\x12C@\xac\x92?\x0b\xfe\x14\xda\x1e\x10\x16\xd8\xe9D\xa5\xb1\xe1z\\%9hb\x92+\xe0\xd4 \xe9\x16G\xac\xd9z\xa3~\xb2\x81\xcc\xa9\xdc\xba\xf1\xd5\xc8\x9e`\xa0\xddu\xfd\xd2\xe0\xa8\x10I\x93\xfb\xa5I\xa8M\x98v\xc0\xab\x00pKG1\xc0\xffy_Mc\xff\x95\xee\xee\xcf\x0f\xccff\x81Z	\xb9\x82\x81\xa4Z\x89\xb31?|`\xeb\xa1l\x15\xff\"\xf2\x95S\x89\x13\x89}N\xae\x07\xdf\xdc\xaa\x0b\xf8\x8d\x16\xcbl\x97\x02C}2\xc6\xb1\xdb\xb96+\xef\x1a\xd64\x94\xc0:\x7fk\xbe^\xaf\xd4T\xcf\xe2\x04_\xae\x8f\xb4y\xe5\"GU\x86\xb0\xcd\x98\xda\xc4\xea1\xf9\xd7G\x9a\xdf\xb9\xca#/K\xd8f?Z\xd7\x16\xc9\xafc\xb5\xdd\xf5_\xb6\xb7\xec\x87#\x81\xe8\xb7\xfb\x11\x8a]\xce\x9c\xd84pe\x0e\x84IX\x93\xaa\xbf6\x0e\xd3\xe0E\xd6$\xac\xc9d)\xce\xaa\x1c\xde\x80\xc8	I\xac\xaa\xcc~\xdfE\xb6\n\xb4\xd0\xb0h\xc9V\xd6\xb6%[\x91\xbd\x8fI\xcb\x88t\xb1\x03\x04\\\x8d\x85\x0ca\xef.\"\xaaw\xad!\xe7\xda|&\x172\x10i\xe2\x0f:i\x1d?$\xb7\x14.\xe2\xe4\x96r\xd5(\xe2\xa4m\xc7^\xf5>.L\xe2z\xbb\xdd\x06\xa1\x98$)T\xdc\xb5<\x00u\xa7\xa2\x88\x02\xb8\xf5\x9a\xf9\xbf'\x19H\xb0\x1e2 \xb9'-l\xf2\x18F\x95\x16\x19Ot\x90\x8b\\\x0c\xdb\xb2s-\xda\xb2\xb36\xc6A\x94\xa6D6\n\x85\xcdAK\x91\xbe\xe7[An\x07Jx^t\xf3jl\x9a\x9d\xa3\xa4FoP\xf3+\xfe\x8d\xdel\x02\x1d\x7fc]\x8a\xe2 \xf1\xf66\xdd-\xaeE\x86\xbfG\xb6\xfby,;k\x8a\x17\xb0>r\xfd\xc7\x8d\xef\x1a\x1e4\xbc\xf5\x9e\xc6\xd8\x17\xcb\xd8_\xd3<\xc2F\x90=)6\x9b\xf2\x89\xdal\x92'\xe9f\xb3|\x92\xc3p\x00D\x92\xca\x0e\xdd:c\xdf%\x95~\x1a\xab\xceu\xab\xa5\x9f\xe0\xf7H\x99\x9e\xb5Z\x12\xe9\xebVK\"}}\xa4\xb8\xd1-\x9b#\xef_\xd3qU\xfcF\x07\xfet\xf3N\xf6\xb6,o=toq\xc0\xa5\n\x9c\xf3\xd9\xb2\xb1\xae\x95\x8f\xf5~=n\xf2\xb7b\x91\xd6\x06k\x99\x077\xd7\x11\xaf\xbfu\xc4{\x8ej\x89\xbc\x8eqt/o\x81B[\xd6\xcdc\x8d\x88\xde\x19+:\x8bQ\xda!rw\xd8\xfc\xb3\xa8\x13\xe0l\x9e-T\x9d\xd0v\xd7\x1ay\x0b\xb5!Cf\x7f\x0d%\xe2\x1bJ\xbb\xc1\xc6\x13\xb8j/dV\xf1\xa6\xc76\x96Zur\x8e\xc7tv\x82\x07:(neS\x14\xab\x8a(\xef\nC\xd8_\x169\x1c\x19\x18GD\xa0\xd5m{\xd5\xbc9\x800\xf7\x943\xd8;\xe4\xd6\x14yV\xdfD\x07\x8b\xc0\xc7\x97\xc9}v\x81\x10\x0d\xb5\x12\x0eBq\xbd\x1dq\xe0\x10\xc7!\x0fN'n#D\xddrsH\xe4\x16\x88\xdf\xff\xf5Ap<M\xf2\x1f\xea2{\xfdo\xb54;5\x00\xc9f\x0c\xc3]B\xb0S\x99\xcf\xed\x02\xf7\x0fq\xd34\xdbjS\x13\xc6\x8d\xff>\xf1\xfa\xe5fd\x15\xa8\x80}\xc1\xf8t|5\x18\x91\xc5U(.\x19\x0e2\n\xdf\x1a(\xcb\x99\xc9DW\xec\xf7\xcer-\x91L\x1c\xebg;\xa0\xb0\xc3\xaez\xc6\xa4\x05t\xd4m\x12\xf5\xdd\xa7r\xdc2\x15\xaaN\xf8BESW\x9fa\xabY\x0bCG\xb5\xfa8\xb7X1\xb5\xfc\x9c'\xcdE\xac#=Nfl\xba\xdb\x85\xe9[m\x04\xb5n}.\x84\xda\xedb\xb6\x0b\xc9<Naq\xef\x0e\x98\xfcI\x97x\nQ\xe5J-\x17=o\xf8\xb4\x17ax\xaavB\xf3\xdd2\x9c\xa9L\xedp\xbc8~d]M\xd3\xed\x06d}G1\xfd\x97\xa6{D\xba\x90\xe2\xc0J\xa8\x8f\x90\xf0\x0f\xae\xd2\xf2\x89\xe25?\x90\xa00c\x05\xffTp\x0e\x91\xfd\x8f:\x9e\x85\xdeZ\x000,\xe4\xd4.\x0b\x0dr~\x1a\xba7EBV\xfb\xff\xc0X\x88Iw\x80\xeb\x0d\xc1\xd5\x80\xbd\x80I\x91\x8blg1\xdfr\x9d\xaeZ\x82\xe0\xa4\xde\x9amEY\xde\xfa\xee\xd2\x18*\xaf\xc9,\x14\x8cA\xbd\x18\xae\xf0\xf2c\xae\xf14\xb0z\x97\x0e-\xe3[\xd0`w o\xe9\x9c\xb4\x9d\xdb\x85\xd5P\x02\x1e\xb5\xdd\xa4B\xa1vgQu\x0eu\x03w\x9a\xad8<\xbf\xff\xa7\xbb\xb8\xb3\xd4\x0ew\xf2\x96\xa5f\xba\x89\xf3\xae\xeaU\x85\xb0\xeb\xc7\xe1W\x98	\x8c\x99/\xa5\xfe6_fS\x95]\xbe\xdb\xa5\x06\xedb\xe1\xe3V\xc5L\x02\xb1\xae79\xfe\xd8l\xea\xd3 r\x9c\xd6\xc6W\xa4\x9b\xb4\xc4B\xa40\xee\x86@f\x16\xce\x8f\x11\x1e\xb3+U\xaa\xf3\x94\xb94e\\tv:\x16\x84\"\x8d\x8b\x03\x1e7\xf2p\x90\x9e\x06\xaa\x03\xf73P\xe0W\x9d\xfa\x0d'HCa\x8c\xf3U\x87H;\xc4\xf3\xa7\x0b\x1b0Nd>\x95\xbb\x9f\xcaJ\xa4\x85\x92\xdb\xadX\x06\xa0!V\x86\xe4-W\xf1q_\xa4\xab\xf8\xa1XzD\x90\xbd\xfa\"\xfakYQ-\x1c\xa1\xb5.Q\xf7\x12_\x19\x03\x8e\xe5\xad\xd4\xcbr\xf5_\xd9\xcf\xd4\xba\x05\xbdrRdJ\x06\xe5`\xab\xa2\x1a\xb6\xa2\xee\x9b\xea\xb0{~i5\x0f\x989c\xf8w\xbeK+\x19\xee\x8fk+\xf6\xf2y\xd5\x877\x87\x01Q\xd1\x8b\xbc}\xa8\xe3\xee\xe0\xf2\xe0\x06\x19\x86\xa8\"\x90;\xd7\xf5\xd6\x0f\xd34\xd1\xec\x11\x9d{\x9a\x9c\xa72\x90\xe4\xcc\x1e\x0b\x9a\xa2\xdc3\x8a>4)\"\x07\x0b\xb9\x16\xaa\xa4\xd5Jd\xa0D\x01\xc0\xdd\xd6\xc2!\x8f\xfb\x0d\xcd\xcb}\xb3\xa1H\x9f>+\x15\x8c,K\xc6\xe2\xb87\xd5R\xaa\xc7W\xe5\x10\xb9\xb8\x10Z6\x07\xf6Q\x16\xde\xc8X\x9e\xb23\xe4 \x8c\x86\x95\xb8\x0c\x82\x97$\xd6\x83|\x10\xe6\x96VID\xce\x0dT;\x04Q\x18\x89~\xcbCxw\x13y\x9c\xbb6 M\xd6\x1d\xa6\x9d+\x94\x80;\\\xb5\xe7\x11\x01\xae\xac\xa4%\xa5\xdd\xeei\\\x0c\xcb\xd1\xc0\x1b]\x90\xdag\xcf\xcc\xf1\x168\x06)\x8e\xf7p\xeb\x80\x03\x0d\x04\xe2cZ\xce\x1ax=\xa5\xe5n\xf8\x937<4\x9dGG\xa3Xo\xe9\x1c\x82\x87\xce\xfaR\xac(\"\x8f\xc4\xdd'\x01\x0e\x1f\x1e\x95/\xcb=j\xaeZ\xc1\x96D\x0e\xe9\xa0\xd9\xeb\x81Sba\xb1\xf8\x17wt\x8d\xac\xb3\x1b{ w\xfb+o=G\xd0c\xfft\xfe\x1a\xceB\x04\x8e`\xef\x0b!.R8\xa8\x81\x8a<\x88\xf9\xcc=\x1bt\x93\xba\xff$\xab\x90\x12\x03\"\xd0\xc4\x81t6\xad A\xbd^a\xa3\xe6\x86\xa0\xb7\xa5\xea\x87\"/\x00\xca\\\xa7Q\xff5\x11\x87\x1a\xc8\xb7\x9f?\x83\xb5%b\xa1\xc5\x8c`\xa6\x9f\x99\xb7\xec1p\xf9`\xadw\xf2\xdf\xd4\x84\xe9w\xa5oq.9.H\xc5\x9e\xa6\xc5\x1b4\x9b\xdb\xf1\xa4\x01\xcd\xbd\xcf\x0b\x8d \x1cQ\xc1\x1e^f\xab\xf8\xa6\ny\x17\xf5D-\xe2\xdd\xce\xfb\x1fQOT\x81\xec\xb8\xc0nd\xbb\xdfk\x99\xea\x05\xbf\xcd\xaf\xabXy\xbbi\x1c-\xefp\xf2\x1f\x11\x9c\xb2\xac\xe2[\xe2I\xccV\xbb\xc2\n\x19\x9e\xaa\xbb\xc6yV\xa4\xb6b\xba\x8a\x87\xc3f5\xd2\xa6\xe8\x8e\x84M\xe0\x96\x0f\xa4\xfdQK#\x9d\xbf\xa6h\xfe\xafn\xb7\xdb\x1cqP\xc4\xe7\x88t\xdb<_j\xed\x92\x10\xc3\x16N\x11\x11\xa9\x96\x12\xab`\xb6M\xd1\xeb\x8eF\xe2\xa2>\x12>\xf4LL\x18\xac\x0f#\xf8d\xe6Q^\xeaXn\x07\x17\xb7\x12\x02\x17+\x81LF\xca\x880\xc2\x91\xe9\"\xf4\x0f\xa1oI\xe9\x1c?8\xea	\x1b\xe8\xd7\xa4\xd7\xc3\xfeF]\x9eq\x00\xc9\xbd\xd8\xa9\xa9\xbf\xff\x11u\xa9\xf4o\xe0'a\xa9\xf8q\x90\xc19\xc1<r\xa7\xf2\x8c\x9f\x90\xf6\xbd{C\xba\xf1j\xea\xb2\xfdF\xbc\xa4\xea\x1d\xe6\x99\xd5\xdb\xf7\xc9\\\xa5\xeb\xaa\xaa\x0f\xc9\xa5W\xaf7t/\x1a\xb4\xfbn:\xea^\x7f\xf4Z\xaa\xb2\x9bL]JA\x04\xe7\xdd\\oY\xbe\x185UV\xaa\xa94M\x11\x95\xecjfX\xba\xf7g\xa9\xba\xcc\xaa\xd7\x8f,\xb2\xddI\xfe\x8e\xc3\x93D'\xde\x06\xa25\x17\xd5\xe2V{_y\x96\xaa\x84j\xa6v\xd3\xfe\x88\xba\xf5=x[\xcd\xf5M\xda=\xb4\x1b\x7f?\x9cLMX\xfa\xe1\x03&\x9f\x17\xc1;vl\xc5\xc5\xceH\n[\x0d\xfc\xdbd\xf2	\x97\xc6lj\xfaT}\xa00\xd9\x07\x13\xfdi2)\xef\x92\xa9Z\x96\x9c\xf46\x99\xe2\xf2b\xaa*\xd4\x84\x17\xbb.\x96\xd9$\xd1\xbc\xa0\xceS\x99M\xf9\xf1PL${V9\xc5@\xe2\xac\x94X\xaf\"\x89\x1b9\xbb]\x9f\xae\xec!\\\xd8C\xb8\x8c\xa7\xaba1\x12i\\BS)H6\x9bl\x98\xc2_k>LG\xc4\xd9\x1c\xa6\xa3x\x02yF*\xf0i\xb3)!D\xa0c\x93\xb2S<v\x14\xa0\x07*BO\xb4]b\xf39\x14&\xab\xd9y\x94\xd9<\x9a\xec\xe6\xd9\x16\xe0/\\\x84\xd1\x01\x95\xe1gS\xc88M&\x15\xe6\x98u.l\x86\xd3^\xe4\x9em=\x04C\xaa\x85\x9e\xfc:\x9e\x93\xee\x80\xd2\xf2l!\xe1\xd7\x0b\xb1\x04M.\x88\xe5\xf3e1\x91m\xf29m\x11]\x82\x98P\xf9'\xdc\xc5\x08\x8aK\xb8\xc0\xb5\x08f\xa0\xab\xe7xy/`\x05 \x0f\xe50_\xd0\x8f\xbc~Z\x7f\xa5@\xec\x10\xf9%%\xe1\x8a\xc3\xd2\xdd\x0bU1k\x00\x81\x06T\x86\xab\xf8\xf7T\xdct\xf4\x96\n\x0c\xa4\xbfP\x05\xdf\x97\xdcx\x9ev\xb7\x1c\x10\x0c\xc8\xff\x80\xff\x90\x8al2\xdc\xf2\xc6\x01\xe1\xfcf\xd3\xb0\xc1\xd0\x1ap\xb1#O-T\xeb\xf9\"\xf6\x83\n\xe2\n\xb2\x1b\xe8:(\xb0\xd8\xd5(<\x14\x8bm_\x8f\xe0\xd4\xeaG\xb0\xa3\x83\xaa\xeb8\xb7\xba\xe1\x01i\x89\x81\x0e8\x05(\xdb\xf1t\x05-\x98t\xad\x1a\xec7\xba\n\xd0M\xd4F+\xac\xf5\xac\xbeC\x03\x0eH\x0c\xbe\x93\xec\x80\x95\x7f\x9a\xca\xa8\x94!S\nt#\x9c\x00\x93\xbc\xd7\xf9\xa2d^\x84\x0d\xa0@\xbc\x08\x12\x7f?\xb7Y\x82\x1c^\x95Mp\"A\xcfT\xda1@\xb3\xed\xd6]\xa9\x16\xab\xd8?\x9d\xc5\xe5*\xee\x0e.W\x1ef\xb8\\Y\xd40_\x017\\\xaeF\x83\xf9j\xd8\x1d\xa9\xec\xceb\xb5\xd9\x04\x8b\xd5\x90\xdeG\xf1|\x05D\xb0\xbdXu\xbc\x91\xc7\x8b\xda+\xb1\x1b\xae\x0e\x12\x11\n\x96q\xd6>\xa0\x90\x0b\x99h{\x17\x02T\xe2\xe6\xc2\x84wln\x07W^\x9fQ{-\xfc\xa3WwE\x9b\xb2p\x88#D\xb2\xd3T4\xe87\xb7\xd94\xcd\x83\x8d'\xb8^\xc5\x07c\xe9\x0c(\x16\xc2Ocq`\x89e\xf1Lr\xe6\x08\xda)\xc0+\xe4\xb6?\x84\x9ac\xa5\xa6\xc5\xeaH\xf9<\xce\xd8(\x00\x98n\x9dJ\xb8\xfc\x0f\xb2N\x9e\x952\x95\x13MJx\xf1R\x8a|\xd8l\xaf\xe4\xf9'\xa5\xdb\x88\xb8\xd96\x9f\x9b\xa3\xd8\xecp|\xbf-\xdd\x96\xa3H\xa1m\xa8\x8d\xe5\xcbzI\x97#Y\xb4g\xearF\xf6\x91mZ8\xc8W\\\x9e'\x8eO\xd6\x14\xb8~\xd31\x85X{\x9dyR\xc0\xb3p\x17=8\xa7\x13\xadM\xf2\xb5\xe6(\xb6w\xe2i>\xc7Y\xe9\xc9\xb9&\xfaz'\x85\xfd\x9d\xdb9\x9f\xe4\xd9\x85\xba\xf4K\xc0\xb3\x03\xadrk\xed1\xcf\xb1\x84p\xda;e\xa34)\xf5\xf7P\xe76\xe7@\xe7!\xb7\xbf(b\xb5\x1d\xaco\xa5D\xd7+\xc1\xb7r\x04'\x1a#\xfc6\x85\x01\x1e#\x04\xd5\xea%$1QW\xe0R\x9d\x14\xbaz\x95\xd9\xd4\xbe\xa8lR\x90f]\x92\xa2\xe0\xa5\xd4\xec\xd2\xfa\xcb\xe2+W\x05\xbb\xf4\xf1Z\x80T[\xb9@\x8anU\xf3v\x99\xe8\xeb\xd8.!/\x9cl\xd0\xecO\xed\xb94\xd1\xd7\xf0}\xcb\xd9\x16\xc5\x96\xe3\x00\x02\xf0\xdf./.d\xb1\x8f\xe9l\xde\x81\xad\x1b\x99\xb1\xa4\x9bP\xf9o7\x8fx\x01\xd7<\xd6;Y\x94\x9dU\xbf\xf0~\xe7z\x0d\xa7\x03\xc5\x05\x8cKQ\xe8H\x1c\nsP\x97\x84cHV\xcc3\xcd\xe7\xc2m\x1c\x91\xd4\xda\xa5}\x94\xb3>\x8a>j.\xae\xb1pY\xdfD\xd2;\xfc\xf8\xf3\xf7\xbbJd\xee\xe3]E\xa1\x81\x13\xefc\xe2\x7f\xec5@Q\xd9i\xf0\x06\xa0\x84\n\xe9\x14\xa9{\x1e\xdd\x1d@>\xb7\x03@|\x9f<f)\xbe\x80\x0f\x18\xd6* \x16\xe5\xce\xba\xb7\x9ea\xaau\xdfj5\\\xc8\xb7\xfa\xd2\x17\xa9\x03\x16\xf8j\xce$\x83'\x85\xf9\xdc\xbb\xeb\xc1)\xa1\xf1\xb4\xdcJ\x0f5\xc1\x9fn\xd63\xd3N\x01Ne7\xcd\xf9\xbdT$\xf7R\x8a\x96@\x10\xc1]\x83\xb0\x08\x14\xac!\x1c\xab\xdd\x0e@\x020\xf94\xf0O\xbc\xd3`\x19Kp\xc8j\xf1\x7f7\x9b\xfa9\x13dB\x8a\x1bl\xd2u\xd4e\xa5\x81\xdc\xea\n$\xdbP\xecW\x11/\xc3H\x9ac\xa7\xd5\n\x96\xf1\x17O\x15+\x9a\x82t,\xeb\x94\xc9\x158\x9a\x99G\xd8.7\x1b\xc9	\xf5qf\xd6Iu@4ra\xc68\x8b\xfd\xe9\x18xU\xfatl)2\x0f\xac3q\xb0R6\xb1\xb8^\xc5_4\xb0`\xa3\x8aB\xfe\xb3\x94\xa5v\xda\xe7\x84-[\xad/}\xed\xe0\x92\xc1\xe5\xc3\xcd\x86\xb3\xce\xcbw_\xac\xea\x96\xef\xb7T\x96\x7f>\x98\xdb\xb5f\xce\xa7\x83y\xc2\xcd\xc6\xa11\x1d\xde\xc0\xd5\xb8\x9a#\x94K\xa0E\xef\x018\xdb+kuy\xd2\x0d\xc5j\x05U\xef\xf3U\xdc\x15\xefW\xf1\x89<\x16\xafW\xf1\xbd\xbfn\x82a\xd2\xfe\xfc\xac\xfdg\xb7\xfdx<:\n\xff\xda\x04\xc3\xff\xdc\x8e\xee\x86\x7fm\xef]\x8a\x97\xab\xb8\xd9\xeb/\xae\xef\x94IV\xb6KY\xa8\x8b\xa6\xf8\xb0\x82\xf2\xf8\x87Ug.\x93rYH\xdc\x1c\xe3C\xbb\xbe\xac\xf4\x9bT\x07\xdc\x00l\xee\x97+\xa1\xfc\x92\x81\xd5C\xf9n\x15\xdf\xa4\xf2BG=Q\xd0\xf2\xed\x89\x89\x04\x9a\x05+\xe9\xd9*\xbe\x81\xd9QO\x9c\xe7Z\xe7\xf3\xa8'\xe6\nF\xca\xf8\xf8|\xc52-\xf1\xca\xa3\\\x10t\xea\xd5\xad'\xdf\xab\x95\xc0\n\xc3\x9a\xfdP;gv\xb1\x16]\x1f\x072F\x08v\xc37\xc0\x8a\xe7S\x8b\xf8\x85\xad\xd6\x1b\xc8\xbd\x99\xb1o\xf2U\x9e\x9dH2}\x147\x9b\xa1\xf8Y\x06\x190\xe5\x8d\xe6e\xcf\x14\xd0\xae\xfaw\xa5\xf5\x8d\xae\x11\xf5^\xf7\xe1\xae\xc3\x08X\xfe\xf9\xca\xc8\xcbd(\x9e\xafv\x05fy(d\xfc|\x15\x8a\x17\x92\x95\xe5E&`!#\xb4\xb73\xa1B$o\x83\xd17\x92ul<\x8ed\xd3'\x18\x9c\xc0\x0fG\xff\xe7\xa8+>\xf7\xf1'\x95W2\x8d\xba\xc2i\xc5\xe3\xb3\x0d_\x89gOYg\x99\xa6\x9chC,q`\xf6\xa6(\xe4D\xff\x88\x0b'\xbe.\x8a\xfc\xb2\x90e	\x17\x03\x8d\xde.\xd9\xa1\xb2\x05\xb9$h\xf4X\xbd\xfd\xdb\x02NJ\xaaE\xe9\xd4\xdb\x0f}8\xdfI\xc3\n\xf8\x82L5\xbc\xb9Mo\xcd'q\xd0{\x101	\xbc\x17\x937@+\xb1\xf3ZGKN\x16\xef\xf4$\\\xc1\xdbW\xe5\xae\x97t\xf2kN\xb9\xec\x8e\xb3\xb1\x1a\xfc1\x04a\x87;\x1e(\xab!\x1f\x1e\x10E\x1f\x96AcD\x1ea\xfa\x05\xfdx6[\xd9\x8f\x7f\xe4\xf7\x8d3\x05\xcd\x12\xd9L \xab\x83V\x02\x9c\x01w\xf9S\xdf\xb4\x835\xe3}55!\xd9y \x15\xc1\xa6	\x00\xf9R2\xd7\xb2\x0eO\xbf7U\x01|b\xf2\xd9J\x03\x98\x9d\xbf,\xbf2$\xaa\x84p\xd1\x058E\x9eDi\xa7*\x08\xa2\xbf\x91B\x91\x16\xf37R\xbcZ\x85\xe2\xa7[\xf7\xe1Ov\x1f\xd2\xa1\xdd<\xb8^w1\x96Q\xa5B\xfe\x812g\x8f\xb9z	Y\xc3:c\xca\x12O\x81\x99\xbc\x04\xb6\xbfBB\x9e\xa5y2%\xf3\xec\xbc\xd5Z\xc8 gn\x0f\xa8\xb6k\xb8\xbe/\xa0z\x89\x87\xd2QtiE\xd1-c&F\xefY*\xb4\xb2\xf4.[-\xac\xa7F\x9c\x9e\x96qzw\xc9\xac\x8e\xd4&\x97\xa7i\\\xde[F\xb5\xdc\xf4=(m\xb5\"\x8dm\xc5\xa18\x84*\x85\xea\x94\xc4\x91\"f\xa0a\x0f[bDuJ\x1a\xc2\x04O\x18\xc3@{\x84G.fb\xe2\x8a\x0bWZ$\xa2\x10\xa5\xb0\xf6Qd\xe1U^S\xf5kS\xf34.\xdb\x81\xa9>\x14\x17q\xda\x0eL\x0b\xe1\x81\xfa\xa7\xe2b\xa7\xc2z\x1e\xfbm\xc0\x86\\t\xbeX\xba\x961ym\xbc\xd6\"\xc9\x1dn<\xf5\xfb\xca\x18$*\xdbI\xdd\xe7\x02UK\xbb\x86[\x08\x1f0C\xca\"\x07\xa7p\n\xa0\xea\x0e j\xd5N\xcd\xa3Y\x14\xb0\x01\xe3~RA\xda\x0f?I\xf1\x0d/\xceO\xf6@\xaf\x94T\xde\xee'\xbd\xd9gSP_pf\xc5MC77\xf9Tnh\nJ\x07\x9d\xcd\xcd\xa6\xf9\xfc\xd9\x9b\x8f\xcf\xde\x83\xf5T%wt\xfe\xebb!\x8b\xe7\x89g\x99\x9f/t\x19\xab8	n\xb6BA-\xd6B\x17\x17\xeb\x03\x86\xba?YW\x9b\xf0\xba\x9bJ\xc3\xa0\xa9\xa2\x82\xe4\x9a\x8d>\x10=\xa2\xe2z|\x9d\x11\xe1_\x18n\xe7\x88\xec\xb1B\xbe\xce\x03A\x1c\x96L\x16?~x\xfd\x8aH\x14\x9ek#\xca\x94~\xe4\xed\xb19\xd6\xad\xfa\x8b\x0d\xb8\x9d\":\x1b\xfc]\x0c\x9c\xa5=%=7|\x0c\xe7\x07\x83\xdc\xea\xbeN\xb2e\x92\xa6k\xcbi\xa6\x90\xe7=\x91Y=\x03^*\xa4A\xce\xb8\xc2.z\xd6\xf7\x0eR\xfb\x1c\n\xfb\xc9\xe4\xa4\xbb\x8c\xc5:_\x99\xa6\x9e]\x94qz\xd7ft\x8b3^zi\xf88\xe6\xac<\x12\xbe\x10/	83Z\x95k\x8b\xed]\xb3\xe1`\xd6\x19\x8f\xcf\x97*\xd5*\x1b\x8fq\x88\xce:\x1e_\x03\x96\xab\xc3\xe3\xde\xfd\xde\xc9\xe3Q<\x13\xb3\x8e\x81nl\x92Da\xd4\x0c\xcc\x1b\x0ff<\xcd\xe7\x10\xb3\xc7\x9a\xb0\x04\x1f\xd2|U\xb7\xbeD }\x0b\xbaa\xbd\xab\xf5\xaf\xec z\x12\xd7k\xcd\x15O\x1f+\x89{\x15\x00k%\x8b\x85\xcc\x8c\x02k0	\xb7\xfc\x19\xd4\x19M\xb7O\x17P*s\x81\xa0\x8b\xb0\x1d\xbcY\xddv\xb4\xbdY\x01\xff V\xa4\x8fw\x0c\x9a\xb1[\x18\xfe*\xde{\xfb\xe9V\xa6\x92\xbf\xe9\x08\xaf}Tr\xb5\xc8\x0b]W\xd3\xa8\x9f\xd9\x16\x00{\x05X(xX\xe6\xb0\x937\xa0\xf3\xd1\xda\\\xdc\x18.\xf4+\\fl\xec|\xbc\x00\x05\x9aO\x1f\xf2\x85\xfb\xf2!_l6]\xf2|M\x04T\xd5\xa2\xaei\x88\xdc\x12\x85\x10\\w\xb5\xb7EY\xf7\xbe\x80\xef\xad\xd5\xcb\xa9\xb1\x80*\x92l\x9a;\x93\xd01q\x1e\xa9\x0e)\xb4\xa8\x17\xa8\xf4\xfd\xbf\xac\xbc\xea\xdc\x01\xd0*(\x87\xf9\x08\x80h$\xfe\xe2\x87\xe1<k\x03[\xfdA\x04@\xc8\xd8\xc6\xf9\xfc\x804q\xe0J\x04E\xa5\xc3\xc7<k\x82\x12]\x0cx(\xa4\xee\xb1\x1f\xc9\x91\x0da:c\xb3\"_+\xe6A\x18\x0dH\xed\x0b5\x82\x1b\xc2\xc6\x11ce\x88\xcd\x17\xf4\x06\x07\x06[(\x98\x8d\xc7\x17E>g\xebD[\x1f\x0c\xdf;\x96\xb8\xac\x94\xe7j\x1b\x80c\x89\xe3\xa45\x1a\xd0\xbb\x1du\x93\xecu\xd4\xcdh\xad*\x91\xc5\xa9qr`\x14\xe3=\xbdx\xd0\xa8^y\xd6$!\xf6\xd3~{vj\xe5\xc1F\x0eX+p(}\x18'0 L\xcc\xd0\xdd\x16\xeb\x1e9\xfc\x99\xda\x97-\x1dj\xba\xe6\x13\xc6\xcb@\x8b\x0b\x0b\x8b\x04\xba\xd6\x9f\xb1\x0co\x12\xc9\x88\xd7\xed\x8e\x03\xca3\xa1\xa8\xac\x84y\xb0T\xa1\xdb\xc9&\xc2hO\x9e\xb0)TF\xc7\x19\x98\x94\xf6\x9en!\x06_3\xf9\x139\xb0\x140\x02\x8e\xc2\xc2\xccB\x05\xb1{\xe9bt\x1a\xc0\xe5\x8b\xa7\x12\xbb\xd9\x04Iuk\x8b\x8b\xeaY$\xf5\xa0wE\xedU$5-\xb9\xc2\x7f\xe3=;\xcd\xdfb'\xbfH\x83D(\xd1\xe8\x82Y\x07\x87\xfbvu\xe4X\x1d\xc5\xee\\	\xd9n\x87[3J\x8f\x1f\x00,H+\x86\x80r\x1b\xd2\xac\x01m+*T\x12\xedRd\x8e*p\xa8(\x8e\x15i;zN\xb0\x8c\xea \xd5\xf8^'zY\x06\xba\x16\xc5\xc0\x0e\x11-\xd0u\xa7\"6n\xa3+\x98O=\x9ep\x92\xb4y\x82P4\x98\xe0\xc8i\x05\x0c\xaeW\xbe/\x88\xdcG\x8cf\x10[\xe2\x93\xecWu\xfb\xd9@C\xb1\xc77@,d\\;m\x98\xd0Qq\xed\x0cb\nd\xc0>\xbb\x1dC\x15R5\xc1\xf1\x1c&\xb3o\x0dU\xb1\x13\x177\x0bo\xb2\xce\x95*\xf42I\xa1\x7fZ]!2p\xbf9\xea\x1f\x0dF\xf8\xf0\xac\xcdX%\xa1e\xc3Q\x18.\xec\x9e-\x07\xec\x18v\xb3\xc0\xac!\xa8\x93\x88\xc3|\x14\xd6O\x85\xb2\x11\xef\xedI\xdc\xec\xac\xa2\xe8f\x03\xbd\x08e\x10h\x19nm\xd7\x12PSF\x95C\x1d\xd0\xe4P\xac\xc8A`_b+\xa7>\xbbm\x16\xcb\xd3\xba\xc0)\xc2\xab\x93w\x89I\x8c\xc8\xd4e\xc7\x93l\xb5Z\xdf%Zv\xb2|%.\xe2I\xf5\x16\x84b\x11\x97\x96v\x8b\xf7\x08\xe5aw\xf4\xa5CN]\x04\xf5\x9e\xc4q\xec\xcb\xc6B\x96\x82\x04\xe0\x7fU\xc6\xff3\xceV\x89\xcc\xccr\xbe\x8c\xf5p6\x1a\\\xd6{~\xd9\xc9r\xfd\x1c\xd5\xb4Z\x0dV\x92wun\xdb\xd0<\x98\xb5Z\x01\x0e\xc3<\x95\x1dY\x14y\x114\xbf\xcf\x8b;e>\x97w\x96\xd9\xa7,_ew\n\x99\x94y\xd6\xb9\xe3\xe0\x04\xdf6\xed^3\x14\xb3\xdd\xce8l9\x8fg\x83\xf9\x13\x7fD\x83\xb9\x15\xab_\xc5z8\x1fU\xfb\x98W\xe4\x8b4\xb8\x12\xa5\x90b\x19\x8a+OcX\xd4\xa0\xde\xbex\xda;	\xc9\xa9\xc6\xb66y\xf1\\\xd4\xdek\x8d\xb7ZA\x12\x83\x05\xb3\xa4\xd0\x0e/R\xeb5\xa0l\xb5\xd2\n\x07\n\xef\xd9R\x1e\xf9\xb8\xb3\xbaNZ-\xab=Kk\xb5\xac\x85E\x86h\x0fK\x8e\x7fh\xff\xf9\xcfA\xb8\x0dER\xed\xbd\x17i\xb4\xefC\x10;\x8f\x14\x1f\xf45(\xab\xea\x90\x00\xa0\x02i\x01\x02\xf5s\xc8\xc0\xaa\xe3\xa5\xd5\x82.	\x93/V\xe7\xa7\xd5\n\x1a\xc9f\x83`\x81\xf8{L\x8aL\x0d\xdda\xa6\xebf\xd3\x90\xca\x9e\x9f\x87\xc8~f\xf4\x90\x96xu\xea\x0c\xb2}\xf05\x94\n\n\xb1\xf7\x01\xfa*\x07r\x07\xb2~\xea\xec\x17$\xfa\xc5%\xb3N-\x90C\xce[Ydh0?T\xb4\x00k\x81U\xf3\x89\xdb\xdbj\xd5^\xc1\x19\xd7\x1d\xe2\xa1\x05\xb9+o\xa2\x02W\xf5\x11\xa5Wq\x8c[-\xff\x0d\xbe\xc7\xe8\xa4\xdc9$+\xad\x8e\xda]\x04S\xf5\x95\xc3*\xd0|\xf5s\x91'9N\x05Q\xd5\xda\x99\xef\x83\x92	\x94\xbdt6?\x17\xe3\xe6\xd1\xee\xd53\xb4\x08	A+<\x8c[Y\x13y7\xf4!\x02\xd8d\x81:\xf8\x85Tz %T\xf6d\x89-\x91k\x82Q\xd0\xf8\x03\x04\x91\x17\xaa~\xbd\xa5\x98\x9b^\xa6\x1d \xed\x0fRd{\xa7\x08\xc9\xb8\xd9G\xa0Qn\x13E\xdc\xee9\xde\x03\xdf\xdc\xb07\x14\x02\x8a\xe8U\xd0\xfc\xf3\x15J\xdfi\x1e\xe9\xa3\xe6\x9dYR\xde9\x972\xbb\x03\xbd\x86;IZ\xc8d\xbanV\x98T+\x18`\x82F\xc9\xc9\x95\xd1S890g\xa09_\xf2v\xaf\xd5j\x04\xd9\xb0\x18=\xd1\xadV6,\x8ez\xa3\xa7\xb0\xaa\x84\x87\xa5\x04\xf6{\xc3b4\x82\x80\xd3\x99U\x16G\xf0\xd1\x03\xbe#9\x9b\x11\x0di\x81\x07\xab\xdf\xc4\xf2:\x12\x9c\xca\x83\x94b\xb9\xbcW\xe7\xd8\x90\xa7\x16\xac\xc6\xca6\x90\xc8\"jY\xc2\xa8\xac]\xc5)\x0b\xb3\x0d)>S\xa9\xe9\x8e~\xb8*?\xc7\x97j\x02 i\xd6\xee\xe4\x17w>\xa7\x1eDUy'\xcb\xff?\xee\xde\xbd\xcb\x8d$\xbb\x0f\xfc*\xac\xec\x1eL&\x11\x05\x02E\xb2\xd9L0X[,\x92\xdd\xe8&H6\xc1f?\xd0P\x9d\x04\x10\x00\xb2	d\xa23\x13\xac*\x028g\xfc\x94d\x8f-\xfb\xacl\x8d=\x96G\xd2\xda\xbb\xb6\x8e%[;\xc7\xf6h4\x96\xbe\x8bv\xc8\x9e\xf9K_a\xcf/\xe2Fdd\x02(r\xc6\xda\xfd\xc33\xcdBd\xbc\xe3\xc6\xeb\xc6}f\x97^\x04\xd3p\xe8\xc0\xe5]0\x98l\xdb\x04r~a\xdca\x83x$o\x07\xf5\xa84\xd2\\xT\x86<\xc6{\xb2\xc0\x0d\xa1\xf5\x08}'`.\xac\x8c\xfd\xecj\x94\x05\x1b4+\xd9\xacB\xdf\x03\xddl\x0cK\x88<\x00\x0e\xaf\xed-\xe9\x06\xa32\xb2b\xfa\x05[\xaf\xaa'\x8f\xb2\xc9\x06\x9a\xfc\xffq?V\xabR?\xf4\x19\xb4\x9b(\xa2\x06\xb4f\x9bHv^\x04oB\n_\x82z\xe2R\xe9\x01\xe7\xf76\xee\x16\x8a\xd2z\xfa\x1e\xb33\xf0Lr\xf9\xdf\x80\xa3\x12\xbfKW\x82\x17\xab\xb6I\xea\xb1\xcdsSJQ\xc6\xbc\xd1\x8co\x19\xa4\x13s\x86\xbb0\x91o/}\xd4\xa1\x7f\xddx\xbf\xd1\xa3\xef\xd5*\xb1\xb1 oy\xf1\xf9\xcb\xf7\xc8D\x97\xc11C\xba\x81 \xde\xc5\xeb\xe5\x0e\xc8\xe5\x1d@.\x05s\x96\xf2\x84\x9am\x16Z=t]Z\x87z\x96\xdc\xb4Z\xab\xd7\x1b\xec\xe2\xdex\x9e]\x0bN\xed\x887<\x7f\xb3.7\xba]?\xac\xd5\x1b\xbe!\xf8\xed\xba`<V\xa0\xc8\xacV\x85\xa32\xdd8*\xfb\xe7\x97\x16\xd1s\xe0}r\xf9\\r\xaa\x81\x14\xe3\x0b\xe0\x9d\x08x\x94\x99\xb9:\x0b\n\xd8\xdf\x1e\xe71e\xc8WJ1\x0b\x8f\xe5\x93\xd7`\xd7\x87\x81\x8d\xb2\xf1\xfd\x86_\x8c\x88\x19\x98N,\xe4\x01\xbc1Q\xbd\xa56\x8a5V*\x85\x1a\xa4\x13\xebR\x9d\x9e\xb7\x96\xb5\xbe\xcd\x8a\xdd\xd3\x0b\x15X@I\xc2\xcd\xf5ps\xb8\xf9\xa2\xc6%[\x1cnV\xd8'\x85n\xd4KJ\x83V\xda-S\xad\xe9t\xb1^\xf9\x92+\x89\\\x15\xf7\xfe\xc6\xb0\xe8I\x8c2r=j\xf2\x99\x15U8\x14\x8c\xef()g\\\x16\xfb/\xe4\xdc\xf6\xca\xe1\xb0\xc3\"\xb1\x8cm\xa7d\xd9\x9c\x1b\x9dy\n\xf9h\xe2\xf2<\x04\x9b:\xeb1\x01<\xc4G\x0c\x17\xe6qA\xdb\xb2t\xcc\xdb\xc7\x84\xa6\xd1\x94\xb2\x80F\x89u\x9cU*\xf2\xa7Z\xab7V\xab\xc8\xb5\x8fK\xd8\xf4E{h\x18o\xff\xa1\x98\x96\x87P\xa0\xcdR1\x16n\xb4\x06S:\xc0\xdf\xc8@A<#\x15\xce\x87\xf1P\x90\x15\nya\xabD\xe3\xb9\x0ce\x98\xa1\xef\x81\xea\x97\xc1\"\xe9.1A\xeb\x19%\x89\xd9\xea\x1d\xa0\xc9\x9d%l\x89\x1e	D)#NP\x81\xdc\x02\xb6W.\xc1\x82\x93\xdf\x08\x0cj\xb6\x0b\xb0\xc2\\r\xd0c\xda\xbe\xb3\xc5\x83\x10\xa5(\xd8\xf0-\xb7M\xb2t\xea\xf5\xb1\x07Et\xb1\xc7\x0b\xbc\x87\xa5.C\x1d\x90\x12\x8c\xa6\"\xdd\x01\x19\x9b\xaf\x8d\xdc\x0c\xb4\x9a\x18R&V\x1feQ\xfcX\xdb\xc9\xa3\xf4n\xdc\xc3\x0b\x10\x9c\x14,\xd4\xa6~\xf4Y\"(\x0fJ\xef\xbf\x10\xdd\xb4\xca\xe8\xeb\x8cH\xa0 \xd2\x13\xcb\x84FA\xc3\xd6\xdd\x97\x08\xdc\x92 \xce\x01\x06\x15\xd0n\xe1\x9a\x17\x15f\xdb\xa9Nvw\xacS\x81\xa8\xc2o\xb3\x9c\xb1n\xa5\x8a\x869\x05v)4B}\xb1\xc0I\xb49\x9e6K\xb1\xb0\x14\x0b\x8d\x11\xc9z,\xb2'R9R\x0c\xcb\xdc\x1ee\xd9J\xdb'\xba\xe0\x99\xb5A\xa8#(^*\xcc2q\xdf\x80@\x93\xd9\x17\xfd\xaa\"1\x0b\xd2	\x9d[\x8c\xc3\x9c\xbd\x87\xd3\xab\xc4\xd0\xd3p\x82Pv\xadt\x14j\x03\x0b\xa5h\xafP\xff-\x12\x8e\x9c'\xde\xb2\xb0|\xf4\xa3Pb\xfbD:\x8c\xe8\x8b\x04g\x89j\xd04\xf7Y\x89<\x883\xdaF\x01\x0ec\x8bB\x98\x19\n!\xb0J\x1f\x02b\x98\x82\xa7\xb1\x06,\xbd\xd8\xe9\xed]NW\xa9\x1e+=\xebqAa]_\xd2\xdb\x92L\xe4\xbe\x89\x9b\xf5&=tZB\x9a^\x04\xfdr\xa0!\x86X#\xe1\"\x9f\xe7R\xecc\xd7\xed\xa8\xb6\xa2\xccG:\x83\xbb2\x92\xb9-\xa6\x0f\xb7\x9d\xb7\x00\xceM\x16\xf2\xae#\xabv\x98\xa3J:=\x9c\xe6\x11\xef:\x83)\x84x?\xa3T\xf5\xf5\xa1\x95'\xe6]\x87t\x0c\xc00t\x98\xfez\x1a\xcfU-A\x9e\xe3\x89,g\xb2\xdc\x91\x82\x912W~v\x83eS\xa98\xc1\"\x8b!@\x85O\xbd\x19\xa4[\x8d\xfb\xd38\xc8\xc8\x16\xa3\xc5\xec\xc7\xc6e)\x1f\xc6\x83\x050\x9d\xdaP\x8c\x82\xc5T\xf2S1c ],21T|/\xa3c\xe3&\xdd\xa8\xb7Z}\"\\\xbc\xd8<\x19J\xd4\xe9\x8doo\xdf\x95I1\x92\xea\xfa+P_\xab\xfa\x9a\xcd\x83l\xf24\x96r/\x16\x88\xf1f\xc8\xc5\xc2!\xd8\x8d\x8e\x86y\xe7\x94471\x91\\\xcd5\x86]\x93pS\x08?\xe6\xd9\x16\xa3$\x81&\x9dIfN\xaeQ{Y\xb0\xd4D\x90.\xe5e\xc1\xa6\xa5\xb8/.\x0b\xb6\xe0A\xcd(\x9a\xb9\xdea\x90\xab\x8b\xf9\xb0w\xaemP\xba\x8b+\x07l?\xad&\x1e\x1b\x14#e\xdc\xb0\x94q\x8a\xc8Q1R\xc6\xcd\xb5\x00TuR\x1d\xb0\xb1\x11\x82\xaa\x0e\xab\xa3&\xc9H\xf0\xf9e\xc1\xb4L\x04\x1f_\x86\xa4\xb6R9\x10\x10r+\xcb\xa7\xcf\xd9\x18\x040\x08\xb7(\xd1\x8f\x19_j\xf3\xbc~V\xd3A\x96h\xf5\xd0\xac\xa6\x83LV\x0b\x8e)~\xd7\xcd<7\xefN\xf6\xe3\xda\x19\x1b\xee\xc7\xb5\xf3\x1e\xcb\x8b@\x9dE\xf5\x06\xb6\x8d\xe1\xe2f\x8bI\x0bIUU\xb4\xbaH\x9d\x82/\xe41\xfd\x91\xd0,[#\xfc.Om?\\\xaf\xbd\xa22\xe0\xcct\xa5Rq_\xe4\xfd\xb2\xba\x98g\xd1\xe8\xcd\xcctS\x16\xd2\x1f\xdc\xea~\x9e%/$\x87#K\xc8\x10\xd7\x03\xa4\x14\x8f\xbd \xa9\xf5G\x17K\xad\xef\xed\x91\xfcw0\x1c\xde{!\x14\xa7FD\"a\xf7N\xf9\x95\xdfp\x0f\xfdY\xbcH\xc5\x8adeW\x90\xea\x14g\xd9LD\x8b\x15\x04mW\xc3$\x9e{\xab\xc14\x1c<\xbf\xc2\x9e\x9c\xf2G\xa7\x87\xf9\x8e\xc2U\x00\xaa\xa7\x88\xb2\xbbjc\x83\x91\x8e;z\x0e\xbd\xc3`l\xec\xb3\xc3p\xca@L\xef,\xfa\xfd),#\xaf\x0bG\x1f\xee\x01\x00\xfa\x19\xdc\xd0B\x90g3?{i\x0bi\xe5\xd6\x05\xe9\x0e\x90\xaao0\xb8>\x16y49\x80\xe0\x99\x0eY\x82\xee\x9e\xce$\xe9\xd1\x96u\x9ed\x11E$ND\x8e#\xc2\x99\x16\xd3V\xceL\x9e\x96\":\xe0X\x14r\xa0\xb0mo\xdc[7_\xee\x94\\yy\xca\xc8eG\x11&\xf4\xc4\n\xe7\xc6T\xc8\x9aYfzK\x004\xe6q\xe5\x0db\x10X\x0c^\xfb\x9ap\xbdm,ym\xa7\x04Y\xd4\xf9\xad\xe5\x0b\x10Sh\x05\xebM\x18[\x1c\x12n\xb0\xc5!\xa4\x0c\x81\xdd_zm\x08\x96\x1bV\xde\xda\xed_\xb1\x97y\xb7\xb4\xd1_k\xd8\n\xeb#\x0ep\xdeJ\xce\x88\x84\x16\xa18\xbd\x04\xe6\x8f\x07\x98`\x1a]o\x7fcf\xe1\xdf\xdd\x9ay\xfd\x12\x93#\x93W\x01\x11\x92\xc9\xb2U\xb7G,\xc4h+\xef0\xcd\xc4\\^=M\x08\x9b\xc6j*\xa7 \xa3\x107R\xb1#u\x15\x1e\n\x16|\xc7\x1f\xba\x88\x02\x81x\x1fVgk\xf3x\x0eF\xc7\xfe\xbe\xe7'\xd5j3\xe2\x86\xf8i\xd7\x83\xae\x04\xa8\n\xaeR\xdd\xb8\x9b\x18+\x9a\x18\x94\xc6\xfci[\x18\x81\x93,	\xc7c\x91\x18\xcf\xfc:^\xee+:T+\x95\x8d(X\xbeT\xf4\x84\x07\xb1\xed\xd1\xc5\"\x06f\xae\xb7\x14fsU*\xee\x19t;A\xd2&\xd0W*B\xb3\xef]\xcf[\xd3\xda\x9d\x84)u\xdbl\xcb:\x93E7\xfc\xf9\xe8\x1d#\xc7\xb7m\xa67\xf6\xb0V\x83\xcc\xbb\xee\x92\x1b\x0d{\x08\xa5\xe6\x1b[\xdc7\xd9\x15\x1b9\xd6\xfchxso`\xfc\xe8M.\xfc\xb5\xa0.\xb5\x83Z\xaa[k\xde\x7f\xd3\xd1\xb4\x8d\xdac\xadq\x08\xda\xb10\xbd\x1fFa:\xd9\xea\xda\xc9\xcaLk\xcfH\xeao#`\xcb{\xf6\xe3\x137#\xc3k\xcb\xb5W\x83\xfb2&E\xd02\x910)`\x9f\x89\\\xc7Z\x1fJ\xfa\xd4\x90f\xf1\xa0\xbf\xef\xbe\xcc\x0dt\xdf9\xe5\x1b\x93 =)a\x04\xcd;;\x0f\xdc;\xa7P\x8d\x88\xc7Q\x11\x03/\xd8\x95\xd1o\x83\xa7\xa8\x8f\xa47h\xb5\x98\xb2n\xf6&\xba\x99\xea\x0e\xc1\x88\x96\x9bd\xdc\xcaj\x0b\xb0b\xe4\xa3	\x98\x81T3\x16)\xd9\xf3\xd2\xe7W\x0c\x1c*\x8c\xb2\xd4\x87%9\x95E\x06\xa5=y_0y\x0f\xfb\xd9\x1a*\xee,\xe1\xb9\xb9\xbd[Inr/\x95&\xf7`</tC\x96\xb2\xe5\xdak\xc65y\xfd\xd3=\xd3\x9d\xd6^&\x9f3\xfc\xfd\xa2\xe7\xb1Xw\xc7\x88OX\x90\xd6v\xc2\xd6k\x96\xc3\xc5\x1aW\xde{\x18\xa3\xba\xf4\xec\x14$\xf7g[l\xf6h\n\xd3\xb3\xd3\xae\xe8\xd1B\x97-\xe0\x82\x01[N?6B\xad\xae\xf7\xec\x94/\xe7a4\x98l]r\x86~\x97\xfb\xfa\x89\xb8\x9bu\xc3\xfdF\x0f\x08\x82GCf\xb1\x8a=(\xc4\xaeV\x11\xa9)\xe8\xc3\xf5v\xa3R\x89`\xd0R\x7fj\xa2\xe00t#\xef\xca0\x84\xe1\xc1=\xb5k2\xe1\x06`\x90\x07\xbc\x81\xa7\xac\xec\xa4\xb4B\xc1\x03z\x1a\x8dPH\xafu\xca\xf1\xb9\xf4m\xa4\xf3\x7f\xc1\x13X\x1a'\x9f#2\xca\xd13\x0di\x90\x1a\xb9\xb3Rs.\xd6\xf8\x1f\xfb\xe0T\xbd\x0c\x07\xcf\x1d\xe6\x0c\xfbS\x1d\x94(\xde\xe9D\x88\xa9\xfe\x80\xe86\x05a\xf3X\x85\x86\xf1i\xa4\xc3\xb8y\x1c\xe6X\xe8\xa0\xd3c\x9f\xa1~\xb9\x1a\xe4\x89\xe90\xf5!\xa2\xa1\x0e\xcab=v\xff\x94\xd6\xaaHP\xa9\xdf`\xf4\xb5\x98\xe7a\xe4\xcd\xbf\xe2E\x06\x0d\xbc\x0fO\xf9\xc8\xfd\xe0\xb4 \x10\xa1\xf1\x98DH\xdd,W\xf5\xd6aF\xc1\xcb\x80\xf2>V\xcf\xa1\xf0\xb3\xb5\xc7\xbe<\xe5K\x99q\xc3\x06n\xc6\xa7$\xa2\x0cbEV\xbe\xfbL!\xa5I$?\xd1\xbb\xcd.\xb9\xe5\x9a\xbcZ\x16\xd3\xf3q\xb5B\x87\xe1\x0ct\xf8TN\x15\x16\x94&I\x82@\x8bm\x0d\xa2\xd8\xcd=\x0ea\x94\xa1\x80\xecrSR\xa6\x84\x96\x00B>\xa2\xdb	\xae\x0d\x1f\x83\xe0\xbb\xde\xd2e9\xa7\xe8q>C\x85>o\xe9\xed\xcb\xe4\xce\xf9S\xe4\xb69\xfai&\xa3\xda1\x86\xc1\xf5\x05\xc3\xc6\xa1V\x8dr\xd4\xec\x03\xc45\x03,[y*O\xc1\xec\x17Sf\xaa\x1a\xdd\xcd\x8d\xc9yS/\xadn\x0c&A4\x16\x17\xf6\xa3\xd4\x9a\x88\x86\xbf\x12H\xac\xc6\xb0\xc8\xf3\x96\x16\xf3\xe2\xa8\xaa\x1aLt\xf9\x96\xa0x\xebj\xbd^\xa9|yZ\x93;r\x17@h9\x03f\x85\x89\xdb	LSd\x03\x8a/B7\xf3V\xab\x9d\x801%\x17\x16\xae\x93y\xcb\x1d\xe33\xd97\xf6A\xb1\x9dx\x91\x15\x9c,\xad\xd7\xcd\xa1\x9b\x1fI\x1b\xa7\xccb^>\x9d\xacS\xabx\xf6\x14{	\x0e\x8e\x89\x91d\x1d{#\x96\xd1Y\xa95\xb9V\xaf\xfeOO\xf9Y\x98c\x06\xcdOOkD\x8b\xb6\x91	}ke\xfc\x03\xa8\xc6\xc2t\x94\xfb\xd9)8\x11\xd2\x141\xdd\x02\x05\xeb\xc308<\xb1:1\x0f]\x832L\x82h8\x05\xa9\x18\xaf\xab5\xfb\xf4\xb4\x96\x8a\xecX\xaa\xa9\xf2\x0d\x0b\xa8\xa0\xc5J\x12\x92\xc6\xf8LDMi\xb6\xe2\xe5\xeb\x10!\x0d\xa2\x13\x13\xf7,4H\xd1\xe7\xa7|o\xd3N)\xfb\xfa\x94/U\x9c\xff\xf0t\xcd\xbe8\x05\x05\xf5\x9bS\xbea\x9b\x94\x87\xf9\xfb\x19\xc6U\xe8\xa5\x0b\x8b+\x16g\x07\x025\xe2\xf4\xd2\xe2T\x9a\\PF\x08\x95`\xf5\xe7\xa7\xf2\x08\xdb\xfb\xfa\xb4\xf6b6\xf5\xb2I\x12\x9fJ\xff\x01\xf7\x94<\xe0\x17\xf1\xe2\x12\x98\xcb\x97\xb2\xf8\x12T\xe9\xc3D\\\xfa\xeeKU\xc3\x95\x17\xb3)\xfe}\x17\x89\xa9\xb2\xb1z\xa9u\xef}\xc7k\x06\xdcy1\x9b:\x8a\x0c\x1cT*_\x9fv\x83\xdej\xe5\x06F?K\x93\x1d\xd1\x96L\x95v\xb0C\xe0\x0f6\x9d\x98\xc7\x05\xcb\x1b<\x91\xc5R\x1e\x9f\xc8Cx\xb5\x82\x84^\x9c<\x17\xc9!^\x94>\xaa;\x0b\xdddSQ\x83\xea\xa5\xb9\x95\xed\x1e\x9f\xb8\xb1T\xb2K$\xe1\x93\xa6\xdfz\xa3\x8a\xd3K/OAp\x825\xc9%\xbdUgj\xd1\x8c\xa6\x8btB\xba\xbb\xebrQ\xf2%G\xd1\xfa}(\x15\x18\xe4\x00\xa0\xbbX41)\xf5%m\xeb\x95\xcdr\x8e\xc2\xd2\x9b\xaa\x8d\x1b\xcb\xfbp\xabO\xa3\xc8[\xb3b\x8d\x85\n\x16v\x05\x05\x07A\x11\xce\x81ov\xa2\xe3\xdfH\xcd\x9d\xd6\x96\x87\xc6%Zz\x9b>	\xec\x19\xad\x91\x01W7\xdb\x06\x1d\xd0\x9a\x88\x9e\xb1\xbb\x06cwt\x1b|e\x0d\xbb\xb9\xcf\xf6j\"\xa3;2[\xa5\xb2+\xc5R\x0c.L\xa4l\xe8m\xd8\xe3\xba\xce\xcd\xbc\x95\xca\x1b2\\\x08$9\xdc\xd6l&\x86a\x90\x89\xa2X\xfa6\xb84X\xa15\xc3d\xda:\xb6\xc6\x16\xbd\xa4\xed\xd5\xd6\xd7L\xee\x05\xbby\xac\xf1\xac\xb9\x99\x1bg\xa4A^6G\xe0\x1a\xedP\xbb	\xa9\x96\xb0\xad\xa4L\xb0\x00 \x8bW*\x85\xcb\xc4\xa1\xd3NJ\xad\xedP\x17*\xac	\x9dG\x1f\xe7\xe5xk9\x14U\x92\x0c\xedlS\xf1\xa6\xd0\x80\xa5\x0dTn\xc3Jr\xb3]\x8dlS\xee)\xad\xb4<K\xb9\x85<\xc5\xdd=\x88\xed\xea;\x9bs/;J;v\xeb\x84\xbcU\xf1\xed\xcb\x92z\xbf3\xc9\xdd&\x12Q\xa23\xe7\xe5\x14S\x9f8\xa8Z\xdd\xc2c\xf6\x8d\xa0\xf9\xe6\x9a\xdcbl\xc2l\x0c\xc2\\\x10\xc4\xf7\xf5\xde\xccs\xd4=\xd1\xf9\\\xef-\xf8\x8fV!\xc5+t\xbd\x8bxe\xdb\x8aZ\xb9\xc9\x18\x85\xc1d6-\x1a\xd8\xf7c\x8e\xf1\xc8|\x92v2\n\xa3\xad\xdb\xc7nX\x177y\xa9\xd9\x82\x835E\xba)4gT\xb9\xd7\xd0\x94,\xe4-\xe7\x1c\x8d\xa8N\xda\xe2\x17e\xd6\xa7\x80*\xb0!4\xb5\xf5V\xf1X\x01\xfaf\x8ew\xc9@\xe4\xcb\x01\xb4H]\x9c\x8a\xb1\xe2\xc5\xa5\xaa(7Q\x8a\xd6\x9d/E\x9bv\n\x8ctn\xaf/nCJ\x92\xd8Y\x9fp\\\xf8\x1cV,\xa8\x8fO\xb9\xfb\xa8\xff\xb5\x18d\xb5Q\"\xc4K\xb1Z\xa9O\xcf]\xc2N	\x96\xbcs\xadV\xaf]s\xa4E6\xff$\xdc\x1c;\xc9dxY\xdeK)]]$ }q\xea}\xb1\x85zT\xa9|\x81\x97\xbfUT\"\xb8\x9a\x8fo\\[\xb4\xc8\xfaw\xa1Y\xca\xf4\x05\x1e\x158\x02\xc6\xe0\x90%R(@$\xfei\xb8\xf6\xd8\xbb\xa7|\xc8>:\xe5\xa7\xec\x93S~\xce\xb2>w`\xc2H\xa4_\xd5\x1d&\xfa\xbc\xeb\x18{\xc6\x0esrc\xc6\xf4\x019&\n*3\xc6\x0es`\x9a\x16T\x93`,\xc9,P\xcfgNn\xd3\xf6x#F\xf3\xfaK\x12\x01L:R&\xd6?s\x02X\x16v\x98q\x0e{D\xdf\xfd \x15\xc8\xe802}\xad\x1b\xc8Y\xd4\xe6\x83\xd8\xd3\xa5\xef/\xa8\x7f\x96\x0d\xe1B\x0cU\xb1a\x91\xb8\x90\x89\xecG;\xcc)\xc9\xaf \xa60\xf0~a\xd0}\xcb\xd0o.\xa8\xe0\xf4X\xd8\xe7u\x16\xf5\xb9SsX\xdc\xe7\xce\xc9\xc9\xc9\xbd\xe3\x93\x93\xe3G\xed\xc7\x8f\x1e\xde{\xf8\x14\xc1\x87O\x8fZ\x0f\xef=999qX\x80\xfcI\xbf\x80\xb9\x9a\x15\xb6\xf9\xb8\xc3\xab\xae\x0b\x8a\xa1K!.\x7f\xeb\xb9\xebbS\x11)\xde\xe8\xcab<\xb0\x94\xadQS\xa3!\xc2B\xde7@u \n\xedA\x19\x15\x82y\x89w\x9b\xd7W\xab\xa8R\x81\xc7\xa5\xc4\xbbU'\xb2h\xca%\xa5\\{\xb5N\xb4\x05\x0f8%\x88\xbb\xb2*\xd8\x0f\xb5\x9c\x94\xc4\xeb5K\xfb<\xe9\xbb]e\x99\xbc\xb8z\xa4\x05ti\xab\xd6,>D\x91\xb6\x8f\x93\x1bH\x97\x93\xbai/\xbd\x1c\xf3\x85\x15\xa3VF\xaf\xe7\xb1i\x9f/\xb5\x11\xde\x8d\xbb\x01\xe3\x12<\xedkR\x81\xe6\xb3Q\x81\xbbA:qE.\xf9`\xe0\x0d\xa1a\x15\x8f,<\xf4\x98\x90\xb7\xa5.T\xd8\xdad\xf0\x168\x0b'k\x05t\xbe\x8dE\xe6: \x008h\xd9\x08Ed\xec\x00\xe2\x1e\xd7.ko\x83N\x1aCA\x01\xb6e\x8d(\x9dz\x18:\xc3\x00\xec\x11\x99t\xd8\x8dX\xd4\xf3\xbb!\x0b{0A\xa2!\x0fcv\x05\x08[\xbb-\x87\x98\xdeo\xe5\x98/vLJ\x0e\xe1\x89\x82\xf0Q\"\x822\x84\xad{t\xa1\x81\x8c;k\xcd\x06}5\xdey|\xca\x86\x14N\xbfI26\xea\xf3\x86\xd8\x7f\x9f\xcd\xe5\xef56\xee\xf3a\xdf\xbd\xea\xb1Y\x9f7\xae\\e/\xfa\xfc\x99\xeb\xb1s\xf5s\xa6~N\xa8\x068H=\xd5\xe1\xe0\x8c\xf5)\x9c\x86\x11\xebPx\x10\xa7\xac\xdd\xe7\x07\x97e\xfb\x8f[\xac\xa5\xeax\xaa~\xee\xaa\x9f\xa3>x\xaa\xc7\xf2\xef\x83>_\xb6\xfd\x06{\xe0\x1f\xb0c\xff*\xfb\xc4\xbf\xc6\x8e\xfc\xeb\xecK\xff=\xf6\xc4\xbf\xb1f\xcfe\xb6\xc7\xf2\xefC\xf9\xf7\x91\xfc{\x8f\x9aD\xb7\x9e\xe8pp\xc6^R\x18]\xb9Cat\xf1\x99\x0e\x03\x10\x1f\xd0G\xd0O\xd9g\xfd\xad\xf2\x15R\xdc\xe9\xea\x81tm\xc1\xee\x17\xcf\x13\x12(\x0c^\x88\xbbA\x16\xf0\xbd\x8c\x15c4\n=DjW\xbb%=\x81AR\xac\xacu\xf3~\x7f\xd7[\xf9~\x9f\x9d\x9c\x85~\x9d\x9d\x9c\xcb\xbfgu\xfc=\x97\x7f\x17\x90_9Y\xc0|\xe3\xc9T\xc0\xe4\xfa\x89\xde&\xd2\xee\x11;\x19\xda\xd6\xfe\xe5Wk(\x0b!\xd8Y\xcc\xfc:\xb09\xc9\xaa(\xad$\xd2\xae>\xe3\x1f\xf4\xdd\xc6\x95\x8fN\xae\x80\xba\xa8I\xc6\x8bs\x13\x0d[\x82u\xb9\x1fI\x9a1\xaf\xc7,G\xe2\x1efg\xf0 >\x0e\xa3\x0d\x17\xd1\xe5|\xe0V\x83\"ar\x03\xdd1@\x9cC\xab\x10\x92\x8e\xac\x08w\x9d\x01\xb00vt\x0dD\xf2T\x8a\xb0\xed\x8e\xe4p\xd2\x05\xd7\x0c\x04\x84\xa7q	,vO\x83\xe1\x10\xd3\xed>\xe8\xd7\xda,\x7f\xd2a\x00\xf4\xea@\xb0\xa6\xea\xb1I\x00gu#\x88{^\xd7\xf4\xb6\x93\xb30\x8f\x0d)v-\xbd.l\xf4\x02\xc7Z\x88)\xd0r\x0bg\xa1w[\xcf\xd8j\xf5A\xdf\xd5d\xe9\xf3<\xe1\\\x0b\x92NEt\xebz\x99\xef\xaaG\xf2`c$\xa1\x81\x9c|y\x01t\xaeG\xba\xc9\x80\x9b\x18\xe2$\xa6\x11\xfa\xf9\xb8\xa7y\xac\xc7\xf2JJ\xc3\xd4\xd0\xee\x8b\x97\xa1H\x8e\x17\xc9\x06\xd0q\xcbB\x1fj'\xf0\x8f\x99\x9d\xcb\xee\xfa\x1b\xfa}G\xb6\xf94.\xb4b\x8d\xa0\xd0'\xadi+\xbb\xa2[9\x0b5u\xf1\xe4<\xe4\x01\x8d\xe5\x9bE0\x84\xd5\xd7\xc1\xae\xe1\xec\x1c\xca'z(\xbf\xca0>\xd1\xcd\xe5\x9d\xb4GQ\xee\x8d\xc9c\x0dB\xebp\x9e\x87dqj\xcd\x82d\xf0\xabM\xc3\x11\xf5]N\xd7>\xfcm\x06\x87u\xbf\xb1cW\x04\xc9@w\xc4\x9e\xb6\xb3\x90\xbf\xec\xbb\xb1w9$\xddT\xd9\xa7;\x85(\xd9\xb7\xad\x8b\xa4\x08\xd7\xf2F\x0c\x12\x0bB\xdaw4\xf0\xff\x82\xd7;\x02\xce\x855\xc1\xf0\x98\xc9\xc9\xca[\xe8Iq\x12A\x0c\x88SQ<\xf4\xbcey\xe3}\xa9\xb0\x15-\xd9n\x1b~8\xabk\\\xe9\xe4\xbcn6\xee\xb6uQ\xa9l\xd9\x97x*C\xbeN\xf7\"'\x91\x9d\xe9sFB9$\xe8\x8e\n\xfe\x12\xb4~:b\xf5\xab3\x8b\xa1\x06\x18\x0e@M \xc73\x1b\xf9S\xf2\xef\xb0\xa5D\x8e\xf0\x15\x8am\xf7\"\xb5,\x9d\xdazY\xd0eVp\x17W'q7\x83\x7f\xc3\x02\x8d\xa8\xf2L:\xae4\x90\xe9,f\\\x14\x9f\x8aV\x9f\xca\xc6\x9b\xca\xf7\x93uWd\x041\\\xbef$\xa5\xecS\x11\xc9\xeaq\xc0\xfa\xdb\x98\xdc\xd4U\x99\x1b\x08\x02\xcd!\x82\x94&Q\xd1\xbd\xcf\xfaZ\xd2\x08I\x92\xb3`\xe3$PG6\xa0P`\x10\x96\x9f\xad \x0b\xb4\xeb\x07\"\xaa\xe2\x96\x14\xd2\x1d\xbc\x88\x86\x1bW\xf2\xa6?/\xb4\xce\xa1+m\x01\\\x03\x1ap7Z\xd8S\x11\x91\xe6\xa3P:\x97\x98\x80\xb8\x87\xac\xae\xd7\xfc\xaco\x0d\xd0n\xc4\x1e\x8c^\xdb\xdbG\x1aUC\x1a\xab\xdd\x8c\xee\x952\xad#\x8b\x92\x9c\x9e\x86\xa3\x94\x91\xcb\xc1!\xfd\xc1Ad\xce\x02H\xb4f\xb4'\x0bse\x94\xa44\x8eg\xa6O\xd7\x96\xd7Q\x0d\x92\xb1\x14}\xd7\xa2R\xb7\xb5\\\xa3\xd9\xb1\xe2l\x1eD\xea\xca5\xbaN\xe8oy\x02\xcb5\xc9\xf9\x14\xe4\xdbn\xaa\x1c\xda\x99<\xf9\xcc\xce\x13\xf1\xe2x6\x94\xbe\xee\xac\xb6\xf2\x11I\xca\xcb\x9e\xbbu\x1a$\x82\xeb\xe5\xcfZi\xb2\x8f\x1e\xca\xba]\xf3R&\xa1\x05@SP\xeb\xf8P-\x9b\xfbj\xf7\xde Tl\xcd\n\x98\xc4.\x9dh^\xd8\xc2FC:\xdf\x90\xda\xdc\xbb9-\x8c\xbe?\x8eS\xb2\xef~r\x16\xc2J \x9dzl\xc2\xb3\xfd)\x1bp\xb1\xbf`C\xfe\xac\xefN.O\xaa\x83\xcb\x03i|\x93\xcd\xf9\x82\x8d\xb9ql\x8856\xb9\xc2\x87l\x80?\x81T\xb4\x0cx\\\x0d<6\xda\xe7n\xf0\x1d\x1e{\x97'l\xbe\xcf\x83\xcb\x83\xe6\x04\xfa\x9d\xa3[\xa0'O\x90ut\x1b\xc1:YMA\xe2\xfc\x16\xb6\xf6\x00\x89\xf3\xdb\\xMoT\xe5\x93\xcbn\xc8\x93n\xc4\x0b\x07]\xcfc\xf3*\x1f\\\x0eK\xe7\x9f\x1bU\x1b\xdew\xc6\x8c\x1a\x9b\x9a\xb6\xa6\xab\x15\xb5\xb10M,\xe0{(\xfa\xce\xc1\xa1\xa3\xb0R\xc7\x97\xb4\x82\xa7\xb1\xd3s'\xb7y\xfd\xf0^\xdf\x1d\xb1\xcc\xf3\x9f\xa8_6\xa0\xc89\x132\x12\xbf^s\xc2G\xfb\x19\x1b\xf0\xf9\xbe`\xe59\xe0\xfb\x16\x0c\xd7\xac\x84j\xed\xc0\xec0\xc5\xe0\xa2N\xd9\x02\x86S\xad\x91w\x1636\xb4\xbe\xd5\xe9\xccF\xe5\x89\x9eS\x04&zL\xe1\xb3\x90\xcd(x\x1e\xb2\x17\xfc\xeb\x90\x9d\xf3:;+\x02\x96\x9d\xf0\x11\xcd/;\xa5\xebd\x08p\xb9C>\xa8\x0e=6\xfc\x0e\x1f\xd0I\xd2h&U^kx)\x7f\xe1\x8eY\xc6B\x16\xb3\xa4Zkx\xfb\xd6\xb7\xc7\xa6\xfc\x85;c\x82E,0\xc9\xe6\xdbc\xe7U\xac\xb3\xf4rZ\x9d^\x9e\xaa=\xdf<\xbbu\x02s\x11\xeei\x95\x8f\xbag=\xef\xf6\xd0k\x9e\xc1R\x04\xba\x93p\xf7t\x7f\xe8]9o&\xb7x\xa3\xe9-\xec\xe6aF\xc7j\xcecg\xdf98\x9c\xebg\xc7\x82M<\x7f\xae\x91q|\xb1D5q\xe5\x9c\x9dq\xf7\x0c\xab\xe7\xa4y\xf6\x9d\x83=\xc8t\x9b\x9c\x98\x16\x96\xf2x\x7f\x01\x95\x95\xfd	+\xef5\xbeo\x8da\xcd6q\xd1\x8d\xa9\xd66jBX\xe4h\x86\xdc\x0d\xab\x07\x973\xef\xcaU\x16a\x15\x1f\\\x16\x08g\x9c\x0e\xc93\x93,t\xd4yhr\x99\xde\xec@\xe3!m\xa4\x90\x94M\xdfD\xe6\x9cjn\xder8\xa3i5\xd02\xc1u\x86K\xeb\xa2\x1b	\x06o.4\xfb\xfd\xbc\xdf\xad\xf7\xf8\xf3~\xb7\xd1\xe3\x0fe\xf8\xa1\n/f}\x91\xd4\xdaG\x9f\x9f<;z\xf0\xe9=\xf6X&>\x96\x89\x8fd\xf8\x91\x0c\xef\x97s\x9a\xab\x820Ft\x8c\x11\n\xa4\x8d\x0f\x94\xc9\x91\xc6(O7\xa8V{\xcd\xf44\xcc\x06\x13\xb7\xc19\xde\xce\x11\x17\x92H	\xef\x1a\x08T\x1b=\x8f%\xder\x10\xa4\xe2\x12\xde\xb8\xbe\xe0\x91L\xa8\xf6X\xc8c\x1dT\xc3\x89\xf0\xdb\xe8\xf1\x98=\xa2o\xd5\xefXYGh\xeaZ\x1e\xf8i\x04\x8c\x94\xc9\xa6PE\x03U\xb0G}y\x13\xea\xda\xa9\x87\xc5\xb2\xc7\xfe\xd4\x94E'v\xfd\xfc\xaa\xf5~\xe2/v\xd4\xfb\xab\xd6t\xe4c\x85\xa5:\xd7T\x07\x16:0\xd1\x81\x81\x0e\x0c)P\x1d\xb0\x11$S\x11f\x8d}\x15\xe95\xcd\xe4\xbc\xec\xbb\x03\xef\xf2\xa2\nI\x9c;2<\xa9N=6\x89\\slJ{\xd0\x06\x96/\xfb\xeeP\x15\x90\x0f\xa8\xa1,P\x82\xe8\x13\xcc\x06M|\xb5\x9aO}\xb5\xc7\xa2*\xf5%\xd6\x01Us\xa9\x86/\xb1*\xb0\x1c\xd6Y\xe6>\xef\xb3\xe7}\xf6\x10S\x99\xb9\x8f\xfb\xec\xb1,\xa11mX\xee\xc4X\xec\xbd`/w\xf8\x13Wv\x9ae\x0e&s\xa8\x0d\xb1\xafbd>\x84\xa5\xed\xf9D@%u\x13u\xd5\x1b\xc3\xd0\x04 \xa0cm\x12\x8d\x07,`\xe3\x8d\x82\xe7\xcc\xde\xee\x03^o\x0en\xe9\xfd2\xe4iwP\xdc/\x83J\xc5\xc5nH\xbb\x03\xb5\x17\x90\x03\xfbeX\xde/H\xa0\xfdB\xc1L\x1f\xcdx\x83\x96\xa0\xf9\xc0\x0ft\xb6D\x07\xdc\x0f\xfan\xb0\x1fy\xb8\xd0?\xe8\xbb\xc9~\xec\xe1\x1e\x1fp\xce'\xfb\x0d\x88\x1dg\xfa\xd0\x96\xb7K\xc4\x03\x16\xf3\xa4\\\xf5\xb1\x9f\x95\x08\x1aT\xff\x9b\x7fPg\xda\x1d\xec\x1f\xe0l@\xa0\xb1\xb9\x83\xb2M:\xc3\xffT\x8dj'\x8d4\x10\xe6:0\xd6\x81\x99\x0e\xbc\xd0\x81s\x1d8\xd3\x81\x13\x1d8\xe5\xe3\xdb\xb3\xc3\xb1?c}\x19j\xf8\xe3+3\xd6\x91\xe1\xd9\x95\xb1\xdf`m\xfe\xa2z\xde\xd4D`w\xbc?\xf3n\xc3\xe0\xca\xa1K\xa6\xd4 \xd8\xeb\x8e\xd8\xdc\xd3z\x88\xc2=\xf3\xb4\x1a\xa2\xdbg\x1d|\x80\xaa\x01u\xc8S\xf6\x82\xb5Yc\xff$\xcf\xd1\xb8\xd2g\x8d+\x1d\xab\xf8\xbe,\x9fW\xbe?b\xfbs\x0f:\xd4\xa8f\xc4\xe6v5f\xd1\xbd\xec\xbb/\xbc\xcb\xe3\xeaHm\xeb\x17\xde\xe5Yu\x0ex\xbe\xec\xbbm\x95\x10c\xbf\xb7eBi\x9e\x9e\xe85\x99\xafH\xa9=)	\x1a\x04\xabm?\xe5\xc5\xf4\xa5_\xa0'@\xa3\x1cg\x87\x94I\xbf\xdf\xaf\x1d\xb7\xef\xf2\x07}I\xcf\xf8\xd0\xa6\xfe\x7fi\x7f|\xda\xe7*+\xfb\xdc\x8e\xfe\x9ax\x11_\xf4yw\xbf\xc1\xe4\x7f=\xf6\x8d\xfe\xea\xb1\x8f\xfb\x17\xfb\x1db\xefZ\xc4\xfc\x8f\xfa\xea\xa1\xdc\x87zx\xb3\x15\xed\"\xb3\xb7\"\xf2\x93\x00q\x07\xe3\xa8D\x1e-{u\xa2q\x90g\x8d\xa7\x13\x08\x97\xc4\xd3\xa1\x7f\xfdm\xdd)\x10\x83=\x9b\xacV\x1f\xf5\x01([\xddW\nT\x92\x9bJ\xd7\x93.\x11@k\x91.\x11T\xc3\xc0\xc0*\x95\xbd=H\x9b\x19\xaf\x86\x0b\x18\xcb\xd9\xdbK\xed\xb8\x89\xce'\x15Z\xd9@g!g\x0e#\xb7\xec\xcf\x81ms\x10C\xa8\x9e\x02\x01\x1d\x81R\x97m\xc8\x87DC\xdeT\x83Ve\xd0q\xedoJ\xa9P\xeb/7c	\x1bz\x1e[\xbcUEj\xe0;\xabJQ\xd5zzh\xfbI\xdd\xec\x81?)\xb9R\xfd\xb8\xafD\x16\xe1~\xcdc\x8bCM\xa5R\xa9\xdbZ\xf6\x07\x1b\xeeUu%\x10wV4\xbb\x11\x0f\x0d\x7f\x92\xcd\xad\x0fz\xac\x8c\xf9\xde^V\xb3HL\xfaY\x02\xf8L\xe3~0\x95\x1c\x18\xd7\x83S+b\xc7\xb8\xb3n\xbd\xc7f\xb8\xe9\n\xf3\x81%\xb9Z\x8d*\x95\xbdq\xa5\x12\x1f\xba\x91\xc5$\xc9<F	nd7\xe7\x8e<V\x88P\xfdr\xe7\x9a\x10h\xeeQ\xed\xcaC\x1a\xaf\xce\xfdRcS\x18\xe2E\xde\x0fX\xc4\x94\xd6\x82\xf3.P\x85(P\xb3\xeeg7\xf3<\x16@\x9b\x06\xf3C\x1d\x85R`\x01,\xe8gV\x02\x1e\x9f{,FA\xa2'\x9a\xa2\xc5\x92\xdd^n\xd4\xffo\xd5\xc9\x05\xdb\x86c\xe0\x9d\x01ube>\x00\xa0x\x9c\xc4g\x9b\xb2j\x00\x03\x9d?\x17\xbe\x0el\xc2/\x8ebM\x13\x92B\xe1,\xe4{YA\xab\xc9\x80\xb8\x19\xc1J&/\xb7\xe5\xb1\xf2L\x01\xbd\xdf\x9c\xa5\xdd\xd3\x0e\xa3<\xd1&<\x8c\x9d6\xd9K\x9e1a\x1fd\xfa}g\x94\xe6\xb2\xcf\xc2l\xa2\xac\x1a\x18%\xc9b4\x97\xd7H\x84\xc2\xb9=\xd7\xdcP\xe92V^\xaa\xc8\xa4s\xc0-\xf9\x00\x96pQ+\xf8!?\xd4'J\xc1	pS\xe4\x87\xab\x14\x1f\xd7\x9ca7\xa0Ao=\xdcW\xabk\x9e\xc7\x92\xdb\x0d\xb1\xdf\xa8\xe7n\x11\xab<\xb8\x92\x18\xa7\x88\xfa\xebl\x1f\x81+\x07,\xae\x9dS\xd0`\xbd\xb1\x0e(\x8bV\xbf\xaeo&M]\xdd\x98\x13m'Q\xae\x15	D.\x1d4	\x1ev\x1b=\x16\x19\xb7M\x92\x11G:lf\xc5\xc8g#J\xc5\x9b3\x99\xf08\x17\xc7\x00-\xe0\xad\xc0\x0d\xcb\xc4\x16\xd4l\xd8'9\xec\x93\x8b`\xefy\xac\x1d\x01\x99\xbd\x02E\x1c\xe1y\xa4\x12\xb5W_\xeb\x9e\xaa:)\xfeR\x07\xb91>\x82\xf5^c\xc33UI6\x04\xa6\xe5\xb2mGZV\xf6XU\xdcL\xb9\xda\xd4.\x17V:;\x94e\x95\x1e\x18\xd1\xbb\xed(r\xea\xe4\x19-\xda\x0ev^~$\x94x\x0e\x94\xc9\x85\xb0\xc8\xfcb\x7fW\xa8\x07\x12*\xd9!\xb9\xcd\x96RD\xf3\\\xe3\xdb\x1e\xec\xa6\x7f.\xcf\x7fW\xbc\xc9M\x96dg\x94;\\\xc6|\x90L\x87V8r\xa1\xbfm\x08\xf4\x11\x14C!\xf7W\x92\xea\x8b\xf0\x96	\xbb\x11D\xae\xa2\x9e6\xb5\n\xc5\x1fA\xa4et\x1c\x88\x9c\xd9R\x92wc/\xc1MG\xd4\xc5}eq\xcf\xde\xed\xbb\xd0\xab\xdcoxz\xb9\xca\x98\xaby\xcc\xa1\x11\x10q)\xf3e\x99\x9eu\x0f\x10\x82\x0fw\xbf\xb1^\xb3VD\x0e\xad\x0bB!h\xdeR\x98\x10\xde\xb2e+s\x81\xf3\xad\xb5~h/@\xe1\xc7\xf6\x8cM\xe7\xff\x1e	2\x85<+\xc05\x074\x9d\x00\x08B\x97\xc76\xc8&#\x0d\xadHZ7\x0b\xbd\xbd\xa8\x0c{\x182\x0b\xb7E\xbaQ7\x86-\x82\xb8\xe7\xada\xa7+\xc4r\x96\xbf\x85\xb1\xac\x9b\x0bW\xb0Vdq0\xd0T\xe6\xe5\x1e\xd8\xc2J\x85\xd6/Y\x82\xb4\x16\x9a\xf1J\xae\xe7G\xac\xd9\xc2mE\xc6\xad\xd3'\xe6\xb1\x90ux\xb7\xdbc\xf2\xbf\x1e\x13\x1dK\x8a'\xa4\x8f \x0b\xa2\x03\x16u\xf8\x96\xe5\x99S\x04@\x07\x90\xc7\x1f[\xf0O \xc21\xc1\xcf1\x1b\xe0\xe7\x01\x1b\xe2\xe7	\x1b\xe1\xe7\x88\xcd\xf1\xf3\x89\xe69I\xbaZ|k\x9a\xd3\xd5\x88\x94\x16\xf2i7\xc6\xeb)\xe0\xb1$\xc2\x85D\x0fX\xf8\xf2g\xe0G\xbca\xbf\xa5&~\xc4\xaf\xda\x11s?\xe2\x07v\xc4H>\x84\xc7\\t\xaf\xe1\xd1+\xba\xd7\xf1\xe4\x15\x1dWt\xeb\xbd\xcb\xf8S\x15\xdd\x06B@\x15\xcfU\xca\x01\xbe\x0f\x90r\x15\xa1\xab=\x8f\x9d\xf1\xb0\xe3\xee#\xeb\x95s\x86bW^x\xcdi7\xee]\xe6/\x98\xeau\x95\x8f\x11\xea]\xe6\xe7&fF\xa1<\x97\x9dzf\xf2\xc1\xe8x\\-v}\xe8\xa7 \x05\x12H\xd2n\xc3\x84?qS\x86e\xc3\xa6\x92\xc6\xc4\x91/\x0f7\xf0\xec\xac\xf7\xaav\xc9\xea\xdb\x16-\x18\xd7\x00\xc3\x0f\xce\x04:0\x87\xf1k\xf6e\xbdfq\x87w\x9d\x99\xc3\x9c6\xa4o\x1d\xe6<p\x98\xf3\xc2a\xce3\x18\xear\x98\xf3\xa1\xc3\x9c\x97\x0es\xbe\x84\xec\x9f\xc3\x9cc\x879\xdf8\xcc\xf9\x04\xb2\xb0\x0es\x9e:\xcc\x81\x18k\x07\x02\xbb\x0es\x8e\x9c\x1e\x0b\xec\xa5\x9b\xe8\x8f0b)\x85\x07q\xca\xa6\x14~\xdcb\x8b\x0e\xdfr?\x98*\xf4\xf9T\xefU3\xac\x07\xfc\x81\xaa`y\x17\xa8r*\xb70\xb9\xb1.\xbc+\xee\xa2\xe3f\xde\xe5E\x07\xcc\xe45\x1b\\\\\xb6\xd1\xbb\x85Fd-\x87\xfb\x0d\xbf\xe1\xa9G|0\x88Sw\xd2Q\x08\xc7\x9a\x0d\xedZ\xbc\xe5\xbb%\xbd\xd4\xe6\xb0\xb3KOl\xd8\xa1G90\xfa\xb7\xf3]HlXm\x16UzWe\xeaAm\x9e\xd3\x08\x18\xa9L\xf3!e,\xcd\x17Ih\x96\xbe\xbf\x902cEo\xad\x1b\xaeZ\xcb>\x13\xb5\xefV\x8dm\x94_\xdb\x05'\xab\x84>\x90}{\xfbU~1\x7f\xc1zA\xe4\x88`\x19\x14\x99\xc7\xc8\x92\x07\xee{}Ce\xf68\xc4\xa1\x80\xc3Y_p\xc7\xa1Kg\x06\xb5\x17d\xa9\xc2\xe4\xa6t\xc6\xcbT\x19)jN\xe1g\xb6\xf89\xc5=V\xd6\xe5@\xf1\n\x07\x13\x89\xe1\x87\xb5\xb3*''\x7fa\xed\x1ca\xe9\xe9\xefs\xdd\x88z.P\x05\xeaC\xa2\xfb\x84\x93F|#\xa5\x19\x02\xff\x8e\xae\x1c\xa0B\x1d d=2&\xcc\xaa<\"3\x16\x18;\x0fm\x14\xc2\xf2$8\xec\x98+g\xd4\xe1\xe6bw\xc9,\xc3 L\x06pd+\xef1\x7f9\x80,\xe5\x00\xf2\x97\x89_\xbf\xe8\xa9\x08\xdf\xe7\x9a\xec+j\x83\xb3\xaa\xa8\xc1\x06\xca\xe0\\\x13\x0d\x11)#\x18R\xea,\x97\x98\x05\xba\xb3\xf6\xd8\x1c\xd7\x9e-M\xcc\xea\xb9\xf4\xb0\x16\xa1\x7f\xa7^\xafo\x113\xb6\xb3\x1ay\xfcz\xaf\xc7\xc6\xc5\xadI \x89Oj\xfd$>MER\x0bE\xa5b}\x92n\xc7m\xdeh\xe46\xc1h\x1d\x19\x11\xa5\xdc\xdd\x03\x8bJ+248\xa0\x12\xdc\x08\xb7\xd8\xdc\x0d\xc9/\x12\x0c1K0\xc3\x8c\x1e>0\x03\xda1\xbb\x93\x8aA\x16'@tS\xf8M\x92\xba\xb2Gp\x9b\xc09Oj\"\x1a\xca\x8f\xd5\xca\xc1lS6w/\xd1\xfe$\xf7\x12Z\x16\x1eI:Ly\xbd9\xbd5\xef\xe8\x0eM\xabUo\xde\xe9N{\xdd\x83\x1e\x8f\xba*\x08\x90Ya\xaeb\x1b\xbd\xa6\xc8\xad\x80\xe3\x85\x92\xc1:\xfe\xf4\\\x1dqF>\x03^\xa7\xf4\xf0\xb75\xb7Y\xf3Ao\x0d\xe3.\xb3-\x0b\x91\xc6\xbfe!B28\x81\\\xaf\x01\x89_g\x1a ~\xbe\xae\x06\xd3x\xf0\xfc4L\x85\x0f\xed;E\xc8\x1cw\\\x9bD\xaa\xac\xe7y;\xd6\xb5>|\xe5\xd2\x85I\xd1\xc19\x8b\xf3\x17\x9e\xa8%u\xec\xec:\x08\x9cv,b\xd4\xdb]w\x90A\xddAw\x91MQ\x95\xee\x1c[\x98\xbb\xd0\x95\x9cn}KB'\xc2l\xa9\xc5\xe5\xb8\x1a\xb2\xc9\xe5\xb8\n\xc1I\xcd\x0dY\\\x0edl\xa0bAT\x07\xfa'\xd9Aloj\xe54M\xa4\x9e\xac\xc94\"\xbf#\x8f\xc1CJ\\\xa9\xe4\x95\xc4,e	\x9b\x96\xe4\xea\xb0Q_l\x99\xad$\x8c\xc6\xdb\x0f\x0d\x96\xd4w\x9f\x1c\xdb!l\xa60\x1f\x7f(\xcf\x93\xc2(\x11Qg1\x1e\x0f9\xc7I\xe6\xabod\x04\xc7\"\xd6\x07\xcdy\x87\xefr\xebd\xa4\xbd\"\xc8\x04)<\xb8\xd1\x0cn\x85R\xcf%\xae\xf2\x8f\xc1\xbf\xdc\x07G\x01\xb69I=?\xber\xd0Lxr+<\x0c\xfdDb\xd0\xc0\x9e\x8b&\x8a\x14\x07\x93\x07W\xdcd\xbf\xe1]v\xc5a\xe8\x87\xfb\x0dc\xe7r4\x8d!y\x03\x1b\x97\x93}0J\xb3\xee\xe0;a\xaf)\x0e\x81\xddu\xdd\xc1~\xa3\x1az\xdf	\x15\xcb\xd5\x1d@\xa8!TlWwP=@\x8a\xe7\xcb\xac`B\x0e\x0e\x07>XN2\xf3\xe0v\xb8\x7fp\x18\xee7|\xc9\x0bYP\xd4U\x8a:\xa0q\xcc\xf9\xf0\xf2\x90\x8d\xf9\xf0\xf2\x1c^l\x16\xe9\xc4\xed>\x8f\\\x89,\x8e\xf0g\x8a?\x0b\xfc\x19*k\x982\xb5\x81\xd4\x06\xf0I\xd4\x8d?*\xb5g\xde\xb0\xd1\x9a\x9d\x15An\xc9Jh\x0f\xbe\x80\xf7\x02\x7f&\xf83\xe0\xdd\x1e\x99\x8aJx\xb7q\xa5\xce\x1aW\xea\xf0\xab\xda\xdd\xc7\x07\xfe\xf4\xcc;l\xc8\xebld\xa6\xae9\xbc5j\x0e\xa1\xe7\x94\xb9	KX\xd6\x1d\xf6$\xb7\x16\xf8\xb8\xfchR\x8a\xf4g\xa2S\"\xa0\x87\xeb7T)W\xeb\x9c\xa3\x16\xf4.\xf4 \"0<\x1c\xee7\xfc\x11\xa0\x0d\xc1<w\x88\xa9\x19\xf5\x9a\xdaj5\xe6c\xb8Z\x0d9\xe7\xa3\xfd\x86\xb7\x9c*\x03S\x9f\xb9\xa8\xc6\xf3\x9a\x838\xca\xc2h!\xd6\xb22]\xcd\xb0\xda\xe8\xad?u\x17,`\xb1\xc7\xbep\x17l\xa1\xbdS\x8f\xf9\xc7\xee\x1c\xb13\x19\x08<\xf6\x82\x8f\xab\xb3&\xb6\xf0\x8bJ\xc5\x1d_\xe1/\xd8\xec\n\x7f\x81r\x13\xb6`\xfbc\x84\x06l\xc1fj\xaa\xcf\xf9\x87n\xb7\xc7\xe6\x90\x959\xd3\xe1\x81'\xed\xb0\x8b\xcc=g\xe7`\xb3f*\x84c=s\xcf\xd8\x19\xc5!\x94z\xf0\x04%\xc7qnBgf\xc6\xa1\xfcEV\x01k\xe9$\x1c\xc1\xb2\x04\x83%\xc3-'\xc7<\x9e\x9e\x8f\xe3\xc8\x1c\x1ed\x0f\x0c\xc8\x1aKgq\x9cM\xa4S|\x19:\x96&\x16\xa0\xc5(\xd3w\x9f*\x8f\xd52\xa3\x0d\x7f\xba\xa3Y\x9c\xa2\xbf^\xbbd]\x95$\x83\x15V\xa2\xa4\x8a\xdf\xae_\x0dy\x10\xf5\xb7\xf4K\xde\xe6\xbaO\xb8\xe5\xb4\x05Wy\xaf\xc3X\xae\xbc\xd5\xdf\xe6HUw\x16\x0eT\xb2\x0f\xcf@^&/z\xa2\x96\x1c>\x16\xa4\xd1\xa0\xb8\xa3D9\xd8r\xe2w\xb6t\xb4\x00\xbc\xb3\x06z\x89?g\x07\x08\xe1\xcf\\$\x03\x11I\x0bX\xbf.\xb8\xf4\xe5p\xd6PCi\xc8\xb1\x9c\x1d\xc8\x81\x9c\x1f\xc8K\x96\x9a\x91\xab_j\xf2\x9b\xab\x00\xb7@r\xab\x01\"-\x0f/\xbb\x8d\xfd\xc4\xab\xc6\x97\x13\xc8f\xd1Wp9\xb1\xeeH\x08\x84i\xb3@G\xd6\xdb#\x7fG\xe4\xe4&\x85Hv\xd15\xd4\x95yU\xf4\xeb2\xf8\x05\xe7y\xcc\xf9\xc1\xe5\xac\x07\x00\xb6;8\xd5Z[\xc0\xa8\x04\x16\xf6\x07\xd0\xa3\xb8\x10\x9c\x83\xb9\x8c\x1d\xcc%\x98\xff\x7f\x02.\x1a\x95\xb8\x0b\x9aUx\xcb\xfc\xec\x80-\x80\xc0\xcc\xcf\x0f\xd8\xa44\x01\x93\x8d	 \xa7\xf5Z\xd1pq\xe8N\xd4\x94\xc0\xadV\xc2\xe0E\xbe\xdd\x01O\xb8\xdd\xe96 +\xd1\xeet!\x85\x11\xb9\x11KY@\xc9)%\x07\xf8=\x80z\xd9\xa60\x06\xae\x119\x85>5\xf1.\xac\x01&l\xba\xd1\xc8T7\x82\xdf\xab=\xf6n(\x1b[l4\xb7\xa0\x8c\xb2Y\x90\x98\xca\x02&Z\xa2S\xb6\xbbc\xed\xd0\x99\xf8\x90\x9ch\xc8\x1d\xc32y\x06\xb0\x0c\xd6\xbev\xac\xb6\x8d\x02uC;\xfcF\x9a\xa8\xc6\xc2z\xbaeI\x05\xc9`\x1b\xda\xfc+c\xcc\xff\xb3\x0bk\x17\xd2\x0c\xf48(\xa2\xc7\x89\x8d\x1e\xa7\x05\xf4x\x9a\xa3\xc7\x81\xa7\x91\xe54\x8c\xdc\xc0B\x8f\xa7\x12\xa9]h\xf48\xc7a\x81]\xecA\x89\xd8cw\xb7\x80J:CXDC\x03/\xf03S:\xc4\xc9t+\xb6\xce]\xd0\xe5\xf3a\xda\xb2\xe36\x0d\x02Y\x0dg\x13\x15J\xfehJ:\x1bE\xe3\xbf\xd2\x9dG7\xeciR\x0e\xca\x16h;\x05f\x0d\xf1f\xec\xf4\xd5*\x83R\x99\xf1\xe4gw\x8fd\xbe\x8a\xfdw\xbd\xb2D\xa5\xd5\xc7\xd5\xaa\xdbcb;\xd3~\x9b\xceI\x86\xae\xa3\xe4j%\x83%F1\xd8\xe4:G\xce\xf1\x07\x1d\x8dIB\xdc\xeecIw\x11+\x08\xf5\xab\x9emq\xb4\x1dv\xa3\x9e\xc5\xe0\xcd\x98\x8c \x06o\x1d\x8c'\xe3\xa0\xd0\x06\xcd\x1bA\xb0Ua\xbe0)`\xbe\x95\xb8\x94v\x0b\xb4Iw\xcc\x01+\xbc?K\xd5\xe4\x84C\xb9d\x8fl\xb4\x99\xd8!\xb90\x0cV\x10\xac\xc2\x1e\xed$/\x1eu\xa0\xebq\xacK\xe4\x9d\xcc}1\xe6\xd5)\x84\x8d\xdc\xb3\xfb\x19\x93)\xbeXk\x93\x18\xc7\x9b8\xbc<\xf7T\xb7\xce8)\xa6\x1f\xd6}Z\xb4\xe7\x14%\x0e\xeb>I\xd1\x9f\x1dP\\x\xd8\xf0I\xce\xff\\\xc7E\x87u\x9f\xa43@	\xe1\x12\xc5\x08\x12r\x972\x96b$<\x90\x9e\x91\x8f:\x16\x895\xf6\xd6\xcd\xe3\x9d08\xee\x80\xd1r\xdcaG\x1d\x85\xfc>\xd86\x8e\x8dQ\xd4\xaeo\x0e\xa3v]\x8f#\xa1|!\xe2h\x1c\xaa\xcf\x90\xe0	\xa6\xc5>\xc7\x9b}\x8e\xbcu\xf3\xc1\xce>?\x90}~ \xfb\xcc\x1e\xd9\xeb\xa5\xe4\xfb\xac\x90&\xad\xa9\x90\xf7\xfb\xfeT\xa4\xf90it'\xe4\xd5(\x90\xa9\xc6:|&f\xf38	\x92\xf3\xbb[\x93\xc9@\x1f1R\x89\xad\xab>\xb4\xf3\\\xb9#\n}\x81\x8aQ^[\x01\xe0\xdeR\x1c^\xd0\xb06\x0c\xefovY'\x15zra\xc3)\xdf\xe2*c\xaf\x91\xb3\xf0\xb6\x1clZk1\xef>\xac\xe9\xf6\x98(7\x05\x0fb\x8f\x85\xdc\xbb\xdb\xc7\xaa\x0e3\xc1m8\xe2p\xd9\x1c\x18\x1dkR\xe9\xa8R\xc9\xdc\xcd,]QPK\xcb\x95\x95\xb6\xce\xde\x8e\n\xb7\xe6E\xcd\xa5\x91\xa9Ckc\xfdlx\xa0\x90p\xcc\x8a\xe3\xcb.\x18_\x06f\x95\xe0\x9b\x00\x80\xd2\x1d\x99\x82\x95\xa9L[f\x87\xf6\x98N\xc0\x963 \xc8x\xdd4u!\x082M\x82\x11\xfc\x82\xdc\xd2\x9b\xd3[\xf5`]\x82\x95\xa4 \xf2M?\xe1\x9aXL\xd3\x1e^\x00\x16\xe0\x03n\xb4\x0d,a\xcf\xb3\xbd\xf3BJ\xcc\xfa\x04\xbb#\"\x07 \x19\x0b\x8d\x8fm\xd5\xe4!z\xbbe\x17I{\xd1\x10\x97\xcb\xef\xc7J\xc5\xfer\xb5yI\xaa\x88\x87\xe5\xfd\xf2\xe6\x85\x17j\xa8G\xfc\x82\xdcP\xe8\x8b\xdez\x80 \xa4\x84\xf6\xa8\xb6\xf6\xe0m\x86\xb7\xbe\xa0\xbc9\xf8\xf2\xc3\xad\xae\xe4\x0d\x9e\xe3\x11\xd7|t\xc1\xf5m\xef\x18X\xc1\xc9\xd916\xc6H*\x00D\xd5b\x86\xa2eL\xb1nL\x99\xbd\x9d\xe9Q\xbce^EO{\xf5\xb1\xa5\xf0\\O\x0b\xa05\xc3\x1a\x14\x16\x1f\xc4\x83`\x9a\xf3\xdd<\xc0\\R\xf3\xf38YI)\xdf\xf3\x0ed\xe6j\x8b\x08\xc3\x8b4\x0816\x9ei\xbaO\x1eW\xda$$\xa1U\xda&\xd6H\x8c\xf8\xca\x86X\x98\xe1\xf3m\x19\x17\xc9}Ii0\xc8\x82Y\x128\x96s\xfem\x90\x94\x08\xe4\xc8\xdd\xcc\x00\x1cR\xd7\x8b\xbdl\x04\xb3\xe8\xed\x85\x9bn\xe1>\xca\xe56\x1e\x13\xffZ\xda\xa3d\x0f\xe9\x0bvY\x1e\xe9p\x18\xb1{\x1d\xd8NzRD=\xf2E\x11b\xd5E9\xf9Y1\x8f\"\xcb\x9f\xaaT\xf9m\x06\x84r\x07[\xf0\xed\xc0\xc6Q\xc1N\xcaqcU\x8civ\x13D\xc3Bu\x99\xaa\x14o\x9d\xdb\x99mA\x90G?4\x93-\xed$y\xc5\xd6h\xe06'W\xb4vCc\x99\xc8\xf62\xe5\xc18xV\x10\x9b\xc5\xad\x9a\xac\xd9\xcb\xcen{k\xea\xc9\xa6\xc4\xb1\xeeEL}n!\xaeQ\x9f\x1fDd\xc9n\x16<\x97\x1d\xf7\x9fD2,-\xfc\xf9/#6\x13\xc9\x98R:d\x1fQ~=\x8bX\xba\xe8?\x86K\xbbG\xf3,\x9c\x85/\x05\xc4\xae\xfc\x0f6\xe3!-\xea\x7f\xb6\x19\xef\xdf\x8f ;&-\x00J\x99k_\xc4\x88x\x10\xf4\xc5TE\x842\x02R\xb9\xea;2\xdf\xdb\x9d'\xb0\x98/\xc3TK\xf2\x82\x97\xd5\xdck\xa8\xa3\x90\xef\xd5\xfd\xb8\x06\xffe\xc4\xdbg\xb1\xd2\x11\x8cA\xfb\xcaj\x938\xc5\xed/\x7fk$0`\x7f\xb9\x92\x081\x16\xd9\xfd8\xca\xfcI\xcc\xe8\xc5\x98\xc4\xf3\xd4\x1f\xc5\xd2\xb2\x9d\xfa\x98\xc7\xc8fN\x03\x7f\x1c\xb3\xe2\x99\xe1\xcfbf6\xf1\xdd\x10g\x00l\xe4\xbd\x88\x19|\x9e\xceeI\xe9 \xcb?\x8f\x99\xe4\x9fJ:\xef\x9ds\x0c\xcc?Sq\x08S\x8c\x0d\n}\xe6=\xec\xb8Y\x0dL\xe43\x08\x8b>\x92_Um\xb0R\xd4\xc0s\x96TRH\x8c\xca\xbc\xe05\x9f\x83;'\xf3\x9eW\xb5QKDW5)T\x9e0\xd1mHo\x05\xb7yL\x1b~y\xe6\x87\xec\xdc\x8f\x89\xee\x1a\xed\x87\x9a\xf2\x1a\xec\xc3\x12\x99\xda\x17\xadA\x1c\xf9'1\xfb\x00c\xf4\x93S\xa6\xd6\xd8G\x82a~!\xdeq,\xf9\xe9\xfe\xa8\xc3:\x92\xa1\xeb\xcf:\xecI\x18\x8d\xfd\x17\x1d\xf6X\x91\xbe\xfd\x13\x15\xc4s\xc7?\xed0@\xc1\xefw\x98\\{\x9d\x0e\xbb\x93\xeb4\xf9\xad\x0e;J\x06\xfe\xd3\x0ek\xe5/\x01\xeb\x02\xf6\x1fE\xec\x98\x88\x1arM\xdfU\xf5\x04\x89\xd6-\xf0\x8f;\xec\x89|\xa4\x98\x98\x07\x1dV\x90\xdb~7[{\xec\x0e$\x822\xbdJ-\x8b_\xcf:\xd2@\x17\x06\xb8i\xcb\x976=\x8eU1h\xc7C1\xd5G\x89\xa6,h\x8boT\x1f$\x96\xf7\xb2JE\x1cJ=\x17\xf7N\xc7\x93\xe4\x17k]\x9a\xa5`\xb6\xf8$v\x97\xc6:\xa1\xbfQ\xb3Ir<\x96\x1b.\xdc\x9e\xef3\xb9\x06(#\x8c\x1a\xee\xa8\x0e\xe6\x0eU&e\xeep{62\x85\xe8\xad\x99\x0d\x025\x16\x00\xac\xb4\xb4\xcd\x80 \x81BOF\xb5\x19\xf5\x0b\xcb\x1eV\x00a\x14gKB\xd10\xa2\xb7Zm@\xc4\x98J\xdcR\x9a<$nK\x82P\xcb\xb6\xf8,Y\xc0|\xbc\xc0\x90\x1c\x0f\x9eR>\xe8\xec4\x0f\xa7m\xf2\xd9\xb6\x10!\xc1a\x04\xafM\xdag\xd8\xbc\x7f\xebV\xfb\x98Z\xc6\x8f\xc9\xb5\x9d\x89P\xd0\x82\xc9\xb9\xcf\xd4\x8aneb\xb6\xcd\xe4\x1c\xd9\xa2\xea\xe4\x16\xe7\n\xa2\xea\xb0\xe2h\xb44\xcc\xcd	VZ\xae&\xc3#\x8f\x85k\xb6\x91\xdf^\xdb\x16]NZ\xf2S0\x81\x07\x0d\xc7\xdb\xb0\xdcg\x9c\xe0f\x1b\x96\xfb\xb2\xc3\xeeuv\xbd\xe7K\xbf\x81\xeb5\xbb\xdf\xe1\x13\xf6a\x87?\x0c]\xafy\x1a\xef\xa29\x9c\xaa\xe3^n>uC>\x9a\x17-\xfbB\xc0\\\xc2\xa0\x16\xcb\x14\x10\xb1\xebjm\x97 \xa6a@\x14!\xab\x8c\xdf\x8f\x8bU I:\xdb\xc4i\x05|\x7fOT*\x9d\x98`M2a\xb4\xf0\xfc\x9d\xe8#u(2d\x99Pz\xc4f1/VgfG\xf6,\xaaT\xe0\x8e=\xe2\xb1\xbd\xcf\xa0\xc8\x13\xc9Q\xc9\xf3k[\xa3\xa6\xa1\x8b\xc7\xc67Fg: }v\xba!\xb7FZ\xa9H\xb4[6\n@\x00\x19;\x8d\xdd\x88\x89\xf2a\x12\xa6\xf7f\xf3\"=\xba0.\xab\x13\xd0!\x86\x11\\Q4\xce#U{\xa6q$\xb6\xd5!N]\"\xf6X\xeb\xc3s\xc9F\xae\x025&&\x857\xe2`\xf8(\xb2\xad\xaag\xa8\xda\x0c\xb9\x10\xaf\xd7p&\x85E\xb8v\xeb(\x95\x14 \xf8=\x9f\x86\x99\xeb\xd4\x1c\xbc3\xd6l\xb0H\xb3\x18(\xd8\x07\"{,\x9f\xdf\x85\xca>T\xdbQ\xfaB{L\xcfv\xb8\xf82\x16\xa9\xefEx:\x15,\xf0\xe3IF>\x11<\xedO\x9a\xbc\xd7\xa8Q\xe5\xe6\x835\xda\xbf\xb75\xd5\xbcI\x14\xc1@N\x99W\xb4Jm\xe2k\x9b}r\xa5\xdb\xf4\x0fB\xf74\xf6\xd8g\xea\xe7~\xc7=\x8d\xd9\xb4\xafC\x13\x13z\xd6\xd1\xa1\xcf\x88\x82\xf9e\x87\xd7\xd9\xa7\x1d\xa5E\xfay\x87\xdf\xac\xd7o4n\xde<\xb8~\xed\xc6\xb5\xfa\xcd\x9b\x0d\xf6uG\xf9\xc1t\xbf\x1a.\xaf\xad=\xf7\xd0\xef\xee\x7fu\xa5\x87\xcf\x06;\xd8\x11\xf1\xf4R!\x03\n\x7f5,\x86\xba5\x86<U\xcf;\xc4\xff\xdd/W\xdd\xaf\xaa_\xed\xf7\x90\xee\x1f\xe2/\xa2\xd5\xbfw\xaf\xb0/.@\xeaq\xfa\x07I;\x98\xfbOcZ/\xc4\xd2\xbc\x1b3\xf9\x96\xf2\x8fb\x16\xa4\x03\xffX\xa2\x9e\x8f\x131\x08!\x9a\xe7?(~w\x82\x91\xf0\x9f\xab8`\xe2y\xc6\xc7*RU\x0bE\xad<\xe9a\xcc`\x90\xa2st\xff\xdeI\xeb\xe1\xd3{\x1f\xdc{\xe2\x7f\xde\x81O\x05\x89\x1aE\xfe\xa3\x98\x85\xa9\n\x1f\xc9\xde|)\x92\xd8\xbfG]\x85w:\xffI\x0c3xQ\x16f\xe7\xfe\xcb\x98E\xe1@\xf8\xcfb\x96\x80>3k\xc1\xd0\xf2\x8b`\x9a\xfa\x1f\xa0\xa6\x87\x8b\x99H\xc2\x81\xffY\xbc\xf6\xd87\x1d\xfe\x80}\xdc\xe1W\xdcn\xe5\xd6m\xe7\xbb=\xef\xca\x98\xbd\xdb\xe1K\xa7\xe2\xf8N%\x98\xcd\x9b\x0esn!<\xcd\x10\xbc\x8d\xe0\x18\xc1\xef:\xdf\xf5\x9d\xca7\x8b\x18\x1f\xcew\x11\xff\xce\xd5\x9bMg\xcd>\x02\xba\x06\xc1\xeb>	h\xc3\x7f\x15\xd0\xb6\x91\xc3\x9c\xb1\xd3c\x9f\x94\xdf\x9e\xb4\x1d\x97N5\xab\x92kuq\xe88\xbe\xf0\xaa\xce\xdaY\xb3\xac\xcd\xfb\x82\x896\x9f	\x16\xb6w\xcf\xa4\xe40\xccfA\xea\xdf\x8fY\x16\x1f\x0731=\x0eR\xe1\x7f\x18\xb3(Nf\xc14|)\x8e\xd3T\x9a]\xf2\xbf\xe90\x11\x0d\xe2\xa1\x80/t\xff\xcb\x98\x01ZA\xf6t>\xf5?\xb5>:\xe1l>\x15\xfe\xe7r\n\x9f\xc6\xf14\x0b\xe7\xed\x00.L\xfc\xafM\xb6p&\xfcob6\x08\xe6a\x16L\xef\x87I\x9a\xf9\x1f\xc7\xcc\xc6N\xfc\xac]\xc0\xbdD{\xed\xb1\xa8\xcd\x87,n\xf3\xae3U~\xa4\x13\xb24\x9d\xc5p\xde\xd3W\xbe\xa27\xccR\xf7X\xd0\x86\x88\xaa\x8e.\x14\x06V1\x11\xdb\xea\xe9\xf5X\xd2\xe6\xef\xc6,ms\xf7\x85\xfbn\x9c[\xb2v<\xa6\"&q\x12\xbe\x04\xd5\x01Q\xc0\xae\xef\xc4g\x0f\x82\xf3x\x81\xb3-\x98Y~\xde\xf5\xbc-\xd1\xbaAB]\xd5\x19\x8fe\xf1\xdc\x8a\xc4x<&Gg\xc5&\x84\xf1\xaaqZ	4p\x8f\xde<y\x82\xfcv<\xfd\x02\xca\x13h\xc48\xcf<6mK\x1c\x83-\xda\xfc4.\x8b\x15\xe3M\x12\x89(sX8\xf4\x1d\x87E\xc1L\xe0w\x06\x95D\xc9\x8bu\xf0\x98\xd6A\x93\xbf\x15\x0d\x05D`\xc87\x10\xe1$X\xae\x8c\xb0k\xf51\x140\xf2\xa6\x0f]\xe9Dp\x11\x0eU\xdaTB\x12	\xea\xfb]\xeb^\xf3\xcb\xbc oy\x1a[\xcc%\xe2\x10\x11\x12\xbc\x08\x87\xbc\x1d\xbb\x8e\x18\x9c\x0c\xe6\xd9\xc9\x0c\xed\x03\xdb\xc7CyKM\xa8\xa1&q)r%|\x14\x0d\x9fN\x84\xc4\xf6C\xf8a\xdb\x92\xb4\xf5\xe5++\xca\x87\xc1b\x10B\x037\xf3\xfc\xe5\xba)\xf73\xeeAU\xb5\xbc\x12\xd5\xf5\xa4\x81\xeby,\xb2\x1e\x18\xd4\x19\x05KW\xd9.\x0d\x037cq\xde\xab\x0d\xe4\x8f\x89\xad\xe8_sk\x07\x9bTc!7\xeaVE?\x95d\x06\xebn\x96\xb5\xafY\xb9oy\x06\x8d\x1eO\xdb\xb2\x0b\xf2\xcd\xbeg\\\xaf\xab\xce\xe6\xe44\x01r\x1a\xd1H\xad\xb9n\x86d\xef\x04\xf6	\x0c\x1a\\\xac\xa4\x19\xc3k+\xb9\x80d!\xd4#\x16s\x91\x1cO\x834]\x97|\xf5k\x86\xfe~\xa3\x99\xdc\x86>\xce\xfe\xbe\x17phj\x8an\xd2\x03\x99\xadY\xea\"\x0f\x0c\xa9\xb4\x98 \xc7\xfeD\x8cD\x02\xe4\xe8X\xaf\xfet\xdbc\xd1\xc6\x06k\xdf,Dr\x9e\xe7w\x97fCe,\x94{\xc7\xec\xd6\xac\xea\xc8\xdd\xe4\xa0g\xd8\x86\x85\x94\xa1\x8c\x06\xb7\xd7k~\x19\xba\x8b6[j\xbf\x01\x9fMDtOR\xdd@}\xf2\n~\xcb\x00N\xc1\x97pj\xae\xb3w\xd46\xa6U&lw`\xd2\xa5\x05\xbf\x03\x0fkLt3\xb9@{<\\3\x80#\x13\xc9\x0c\x8a\xb6\xaax^*\x17\x16\xe5\x91\x91\x91\xdf\x8b\xb58\xfd\x1d8%\x93553\xe8\xf7Q\xf9\xd4\x85B\x8a\xe8\x06=)\x00\x86\x80\x0b\xb4\x95\xe0\x1f\xaf\xd7\xee\xa2m\x8f\x05KP\x7f]\n51#\xc4T\x07 \xfcS\xc1\xa1\x9b\xe5\x85\x12m\xcc>\x82\xa8\x11\xc4Sb7\xad\xc5I8\x0e\xa3`zW\xccS. \xd5\x9dy\xcd\xb4&\xa2\x0cS\xb5\x882\xbe\xa0\x95#y\x1av\n\xe8\xb6r\xf1%\x1e\x1b\xba\x0b\xab{\xder\xea\xa6\xb5y\"\x86b \xd24\x86\x85\x07\xd8p+\xc4i\x0e)\x11_\xd1\xab\xcckN]QK\x17\x83B1+F7\xb8\xf6\xd6\x1e[\x8e\x93`>\xf1C\x16\xc5\xf7\xd0\xab\x07a\x9a\xf9\xc1z\xad;r\x89\x8e\x02\x82\x06\\\x07\x90'\x01\xbe\xb4:\x82\xf3\xd74\xe0w{p\xfe\xd5\x15\xbd\xbc\x9a\xd8~\xc3\xed\x80\xae\x1c\xb3`\x99'\x9dq\x13\xc5:C/\xc3uV\xcb\xe2y<\x8d\xc7\xb8G\x9f&\xc1\x0b1\xb5\xd7\x19,\xd3\xc5\xd6|\xe2\xac\\\x82\xcbg\xc1z\x7f_\x81\xbf\x18kDW3e\x0c\x80fJ\x1b\x16\x0b]\xe1\xb1\x94'5	'\xf8\x9c\xadY\x90b\x0bl\x05\x1c\x13\x85e\x92y\xcb\x05\xb4|\xb1{\x9a\x96N%F?\xe1S\xf2\x7f=\xe0iw\xd2c#\xbe\xb7\xb7\xe8Nz\xcd\x11\xde\xa6\xda\x03\xd9\x84\x0d\n\xeb\xaa\x96Jw\xe4\x9e\xc7\x86b*2q	E\xb0f\x06\xf9\xc4\xb2\xd1\xe1\x96.0\xc0b\xed\x07\xde\xba\xb0\xc0\xbc\xe5\x86_;E\xbb\xbc\xa4\xaf\xd6\xc1\xf9\xa5Yp~I\x9c\x85i\x96:X.r\x0f\x15\xd6(F$\n\xf3\xb9h\xe3>\x92\xe7\xa7H\xef\x9c\xb7\xe9x\xc2	`\x17\x84/PrJ\x98\x15\xcefj;\x14R\x10\x08\x93/\xf8\xc8\x15\x85\xc2\xb4\x18\xe5\xb9\"O\x82\xb5\xc7\x1c(\xbe\xa6\"\x83..&\xd5\x15y\x94w\x0b\x0bJ\xd4\x16\x91\x92N\xce\x13\x98X{l\x82\xd3/m\xab]4iCQl\x9b\xbd\xfc(x\x11\x8e\x83,\x86\xd4\xe9\xa4\xcd\xcdgm>\x0d2\xa0\xb5\xab\x95C.\xfc\x06m\xbe\x94\xf4N\xbf\xeb\xbc38\xb8z\xfdj\xc3a\xce;\x07\xa3k\xd7\xaf_C\xe8\xbdFP\x0f\xdeGhx\xed\xfd\x83\xf7\xae#t\xb31\xb8\x11\x00\x85\x7f\xe7\xc6\xb5\x9b\xa3\xf7\xaf\"4\x08\xde\x7f\xef\xe0\x00\xa1\xfe08\xb8	\x84\xff\x9d\xf7\xc4\x8d\xfa\x0dY\xcb\xf5k\xef]\xbfQGhpm0\x18^uzlLD\xdec\xdd\xfa\xe8=1\n\xdeC\x9e\xe1\xfb\xef\x1f\xdc\x90\xb5\xf6G\xd7\xae]\x1b8=f\xe8\xbd\xfe\xd2\"uN\xda\xb5Y\x00\xe5\xdf+\xbf\xf1Y\x18]\xf1\x0e\x9dv8H\xe24\x1ee\x97\xbe\x08>\x14\xa1\xe3;i\x10\xa5\xfbp 3rrRj\xe3\x80\x19Z\xac\xef\xa8\xc7\x80c\x93du\xdc\x9a\xf5\xa7\"\x1a\xe6(\x9ay\x88\xfb\x0e\xd8\x1bN\x1eqw\x01K\xe0q\xe47\xc4\xd5\xcdX\x85Y\xf8W\xeb\xf5<\xed^\x90\x82\xe0\xee\x883uI\x86\xc1\xf4\x11\xfc\xca\x962PQg\xb0\xe8\x87\x83b\x86\xdc \xd2\x81\xb8\xca\xe6I<ND\x9a\x86/D\x9ep\xb5\x98\xe0_\xab\xd7\xd9\x04\\ \xe98\xaf\x98o\x91\x8aO\x9f\x1e\xfb`%\x0e	g\x1ea\x81@~G\xce\xd3\xe3`\n\xdf\xe16\xfa3$\xc4G	l\xb5\x86g\xbc\xce\x8aq\x0f\x83\x99h\x07s\xbe\\\x93\xb3\x82i\x9c@\xcb~\xa322\xee\x82u\x19\xf1a[90\x97\x15\xc1\x05\xa6i\x00\xaaM\x01\x8f\x8a\x95\x17?\xa5*~8r\x83\xb2^}f\x0cW\x04\x90\xac@S	\xef\x10\x05I\xbe5d\xad\x12\xe5\xf0\xb4\xe9\xba<^\xc2L&\xb2)'\xa37\x95Jz\x18\x04n\xcaB\xcf\x97^9\xdd)\x9f\xaeV\x89\x87\x03\xdb>\xa3\x17P{6\x07\x10\xa8K\xe8\x03_x,\x1f\x1bwchi\xe8\x82l\x01\x82\xcc\x1cs\x10$\x99H\xc3 :\x18\xfa;4VxV\xdb\x8e\xab\xb9\xce\xd9\xd1Y\x98:P\xc3f\xc1\x05\xd9\xceM\xb6\xa6\xa8\x0d\xc0\xf0\xee\x9c\xa7w\xc3Y\xca\xbb\xce\x99\xc3\x9cs8\x01\x82\xd4\xa4\x8b\xcf\xd8\xd3\x1f\xe7\x0e\x94=\xd2\x80\xcc\x16\xd89Dm\x84\xe7\xefq\x90\x89q\x0c\x91\x8c\x99D\xf5x]\xe6\x0f\xac\xfc\xaa\x92]\xf9\x1b\xde\x9aa\xb7L\x05\xfa\xf8k\x80 /\xbcc\x80*C>B\xfaf\xf1\xc6\xc8\xac\x94\xdd\xc3\x83\xcc\xf54\xd8\xf6\x8c{SOe9=Y\xb1t\xc6\x86n\xe3\x0c\x9a\xbaR\x8eo\x91\"\x02/\xe8\xcd\xf4 \x1f\xe6\xe6\x18Ua\x879Aq\xac:>0S\xaa2\xb0dc\x9a\xac\xac\xbb\x07\x8fB\x895\xb7ym\xbb\xca`~\xc7b\x9b\xe5\xda\x8dAL\xa1\xd1\xe7L\x83\xcc\xe9\xadA\xf6\n\xa6S1\xbd\x00\xce\xf4\"\x91\xc0\x1c\x8b\xcc,\n\xd7\xd1\x85\xa1\\=\x16V\x84\xec\x13\xe8\xbd	/5\x1f\xd4\x86\xe1LD \xf8\x19T\xa79\x94\xe2\n\xaa#\x98\x19Y\xdcB\x04\xb4\x9d\xe5tg\x0fP\n\xc6e\x80\xb7u\x83^S\xad\xc1)t\x9c\xd2\xc0M!\x0b#9\x03;\xc0g -K\xec\x82q Q#6nsGcl\x0e\x9b\xb5\xb9\x13\x80\x02\xf6$>M\x1d\xf6\x02\x89\x92\x82\xa6\xbe\xcf\xdb\xdcy.\xce\x05\x84u\x17\xb3(u\xd8Y\x1bNz\x9eG\xd2\xb5\xf4I\x9bK\xcfNCIDs\xd8i\x9b\xe3\x04]\xcc\"\x87\xf5\xdb\xdcI\xe2S\xa79\x0dj\xb8\x80E\nZ\xff\xd3\xb8\x13/\x92\x81\xf5\x8c\xca\xb9\x8d@\xf1\xa6\x81\xbb\x04\xd6\xe3g,\x95\x19\xef\xe3v\xce|\x98G8<i\xfb\xe36\x1b%\xf1\x0cU\xc1W\xc0^c\xed\xadA\xab\x9e\x06\n\xa3\xe9\xd0\xc5\xd5ns\xe7\xab\xfa\x89\x18\x9c\x84Q\x84C\xbbU\xa0\xfe\xec \x8c\x14\\2+\x9a\x80\xed\xac&\x03!\x83\x13\x03D[\x0c8Q\xf9\xdaA\x14\x8cE\xc2\x95\xcf\x81\xadd\x8a\xe7\xee\x9e\xdbn+\xeb,\xcc\x99O\x05\x0c\x93,Rqi0	\x12y h\xd2\x87\xb3\xb5\xeeZjr\xe4\xaeb\x12\x91\xc7\x12\x83\xda\x8a\xf27\x91\xe0\xbd\x86&A\x14{\x8e\xbbk\x0f\xee\xae\x13\xa1\x84\x08\xa0\xbcM\xafM\xc8o\xcd\xf0B\xa4\xe6Ky\x9a\x16\xbc*\x95<q/\xb7\x86d\xb1{4\x0f\xd9\xa2\xe7\xe0\x05\xecw\x02\x8b\xbe\x15A\xeaL\x99\x9dr\xb2p\xa6\xb8\xc4\xa8\xafRq\xa4\x8bR\xf9\xb1Zm\xa9|s\x10\xabU^G>(P[@\x9d\xa2\x14\xea	Z\xf7@mq7:\x89\xfb\x0c\xf6\xab\xd6[!\xb5ZQ\xc7\xf2\x16`_r,\xb26\xfakUOs\x1b\xccC\xaf\xa9=$T*C7(<\x1e6\x9a\xcf\xa8u\xf2[M\x88\x84 r\xdd\xce\xf9\xb6\xe6F[,;1d\xcc\xb4\x1d\xccY\x82\x87\xd1 \x90}\xf3X\xf1\x85\x88\xd7*\x96\xd5\x1e\x1e+x4M\x82T>\x9a\xdc\xd8;\x8ca\x01@\xbe\xd2c\xcf\x170WD\xc7T7\xee\x1d\xe2\xd1\xe3G.\xc2\x8a$\x877\xd2\xa2\xbd\xf9>v\x13D\x8fEv4\x9d\xca\xaa\xf5[,u\xad\xb7\x989\xd3\x15\xc2\x06\x11T\x96\xf2\xbb\xa1+\xcfT\xec\xc6\xc4k\x1e\x85\xae\x1cA\x9a\x973\xcf\xf9\x88g\xb4\xa5\x9b\xa7\xca\xe2UV{.\xce[\xd1(6\x04Ixb1\x91D\xf6\xe5\xad\x00\x8d\xb3\xac&_\x98\x18\x06\xb9\x01o\x07.\x04\x9a\x9aP\x97\x01e/\xc6\x06uC\x06\x93\x88\xa5>\x98\xbeS-,\xcd{\x13\x8e\xdc\xe7\xee\xa9\x9bz\xabU\xc2\x1c\xc9B\xbdd&\xe8\xd2P\x8cpX\x851D,RU\xcd\x82[\xafWwK\x9f\x81\xa2\x92\xc5\x86\xc46\xb4\xbe\xf0\x92\x1a(\xdc</\x81O\x96\x146\xa3Z\xa0\x1eKj\x05\xb2\xa8\x9b\xb2\xbd\x06y\n\xc5`&\x1cgu\x89\xbe=-\x93\xc8\xa3u\xde=\xaf\x19\xb8\xca{\xfb\x82\x1a\x91-A\xddw\x82\xe60\xd0rB\xb9\x13Xa\x18\xdeZ\xf9\xa7/\xf6\x9c\x8e\xee\xcdR\xcb\xb5\xec\xbb^+0u\x960\xcc\x9b\x0cQ\x13\xba09\x1c\xc5F\x0e+\x95\xa7\x01\xa9s\xc8\xb2:\xcd\xd3\xfb\x906\xb3\x8anE\xc3p \xb0\xa9\xf8\x13wK\x02\xdf\x92Y\x91\x0f\xd8x\xf3\xc8\xd6\xc4\xe5\"\x87[\xbf \n\x94\x1c)\x96\x17\x8e\n\x1b4\xb4|\x89D\xdc\x15\xd87\xc2\xf3\xe8\xc0\xd9o4\xa3\xdb\xbc\xde\x8c\xf6\xf7\xbd\x07\xa1+`\xf8\x0d$f\xb0\xbb\x07\xc2\x8dX\xc3kb\x97q\xb1^\x1bbN\xd6m\xb7{,\x93\xbd-q\x05\xcc-N2\xccH\xa5\x07\x1f-e\x7fsO\xea\x8b\xc8\xac\x19\x80N\xc2Y\x89\xbf\x85\xfa\xb9\x16v\xc5jU\xef\xadY\x89\xae\xbc\xed\xbc3\xbb\x19;`OP\x15\xdd\x9ef	\xd4$\xfd\x19\xb22\xb5p\x88#Q\xed\x81`g_\x94\xb0\xef^\xb0Z\xed\xe9\xe3\xbaX\xa9\xbc;\xe9=\xe8\x9d\xbb!\x84\xc7B.\x8f\xa8\x84\x8f\xdd\x91\x1bZ\xe7\x91\x01\x14\x9e\x7f%\xaa\xb5\xe1\xee\xaf\xb5\x11>-\x0d@\x07H\xca\xcf],\x01>v\x83mu\xa6\xa0(\xe1\xac\n\x87\xa0N\xaeV{)\x04-Ch\xf9Gko]\xac\x94h\xd5\x0b~\xee\xc6\x17T\xba@\xa51Sp\xa2j\x17\xa8\x16p\xe3\x9c\xc7\xba\xe2\x84\x07\x06)\xa6\xb2w\x03\x17\x14a\xe5\x80\xfa\x0d\xf3&\xe7\x96\x85\xd6\x0c\xb2\x88oNph\xf8\x05\x91\x0c\x0f\x1d\xf0\x9d\xaa\x0eh\x01\x0e\xb5\xbb\x97\x0b\"uEO\xa3\xcfY7\xb2\xc2qO\xc9(\xe5\xac\x89P\xb3&\xba\xa2'\x19\x11\xdd\xa8\xa78\x82\xc8\xbd^[\x12\xc8\xa6\xff\xb9\xef\xcf\x0c\xc6\xae2\x91\x1c\x8eA\x1c\xa6\x0f\xcf\x17k\xf7n\xe0FJ\"\xa7\xcc\x16\xc9\xfd\xc5m\xac:\xe0\x978e\xa0\xcecJ\x14\xf6\x90\x11\xc5\xdd\xb6r\x9b\x8e\xcej\x89\xb4\x1c\xba\xf0;&x\xc6\xa2\x1a\xeau\xad\xea\"oY$\x0e\xe3\xea'\x93a\xf2\xfe\x83B\x9b\xb7\xf6<\xff\x04\xb8\xf8\xd0\x8dh\xa3\x02y\xf6|\x18\x9a\x04\x12#\xbbR\x9cl\x9dG\x1e\x07\x1dyP\xde9\xc7l\x15v/-\x98\xb1\xbb\x0b \xe6\xe8e[\x80/\xf4b\xcc\xd6\xc5v\xe4\xdbg[Col\x06\xdb\xd3\xae	\x17\xeb\xdfj\x8f5\x82Q\xeet\xde\xc8\xdbwV\xef;\xab\x1a\xc96\xf8\xb5\xeaR7\x84Zz\xe5.\xc9\xb3{\xe8n\xb9\xc8\xf2i\xb9h]\x16\x9b\x82\xa6\x0di\x97\x825\x12\xea[U\xb5\xfd$8\xbd\xb0\xf9\x0b*\x06\x83\x9cj\xd91\x7frI\xbei$t\xec\xc6o1\x92\xa8\xd7\x8c\xed)\xadT\xcc\xe6\x01\x96\xb8}`;zE\xb3N\x9d+\xad\xc1|;\x85\xa9\x8a\xbf/\x8f\x9a\x02\xe3\xda\xac\x1c:\xf96\xa7K\x8f \xab\x190\xca\xbd\xa2\x96\xd0\xf1\"\x81\xa8X\xc7Fk6\xd7\xd26\xe0I|&_\x8e\xea\x18\xdc:\x8d\x1a\xb3z\x9b\x0dD&\x0d\xad\x97\xde\xb6+ds\x92\x9a\xba\x11Q\x9c.- \xd0\x05\xad\xb3|\x14B\x0cO\xbdk\xc4\xceGK\xf8\xd6\x8f\x96\xa1+\x08\xed\xcc\x13\x84\xb74k\x15FYW\xab\xbd\xd3\x00\xd7\x06\xe4-E\xf1\xb5\xbc&\xee\xf7\xc4m\xb5\xd9\x88\xd8?O!&4\x16\xd9])\x114\x16\xd9\x97\xf0\xe4>\x16\x99\xf6>>\x16\x99\xf1T\x8f|\xe2E8\x10R\x12\xed	x\x07\x0es\xa0^\x04\xf6\xc9\x91\xec\xab\xc3\x9c0\x85\xc4~\x9c\n\xc8h\xc9\x98x$\xc5\xb4\x94\x0f\xc5O\x9f<P\x1f\xc7q\x14\x89A&\x86\x85X\xf9\x00P\x19\xd4;B\x85\x9f\x85\xe2\xf4\xd1\xc8\\`V.\x95\xa2V\x86\x8a\xee\xb1\xbbm0*\x8f\x83]\x1a\xc8\xc7\x01i8\xf8\x9b\x0f<9\x99C\xf7\xae\xc5\xf9\x83\xa3\x17z\x89G\xa42$\x97^\x13\x02\x14\xc4\xd6\x0b4\xffN\xaf\x9e8\x19\x86\x11,C\x9f\xa7\x99\x98\xa5\x10\x03X\xcc\x87\x9bm\xea\xa3c\xa3D\xde~\x88\x85\xa4\n\x83QL\xba\xa3\xe8\x01\xddR\xc7\xe5\xb2\xf9\xb86\xce\xfeRN\xb3\xc5\xd6\xec80B\x0e\x16\xbe\x84e|\xb7\x0d\x1e\x83\x90Y\xc6\"\xe3[\x0e\x08\x99Ei\x12\x1eA\x00\xed\xb8\xcdC\xf6\xa0\xcdG\xecy\x9bG\xecq\x1b2\x9b\xb30Z\xcd\x823\xef\xd0\xadU\xbdw\xaf4\x1f\xec\x9c\xa1\x07\xc1N\xfaW\x06\xfc\x00\xdc\x16\xa2\x07Z\xfbA\xfb:\x05\xe9\xafR\x81V\x0cB^\xa5\xf2P\x07\xc1\xa9\xe7\xc7mwC\xba\x87\xa8Xw\x82\xc1\xf3\xc5\x9cE\xfc\xb9MF\xc2)\xbf\x17j\xbd\xd1H\x9c\xde	Rz\xe6\xf2\xa8\xd67\x1f,<t\xef\x05nhG\xd9\xe9`\xd2d\x05B\x91V\xd9\x93\xf2\xf4\xa5$\xbe\x91\x19\xe5%u\x08r\x0bvI\x13\xc9\xad\x0c&7\xc9\xaa\xe49)\x82G\x85O\xcf\xf37A\xc1\xa35\xb3hv\xd6\x86)\x1e\x976\xf8\x9a\xc57\\iT\x0f\xda\xae(\x0f\x8c\x1d\xb7\xf5\x138\x1f\x8a\xcch>\xcbY\xf4\x18\x8eM.\x8a\xd1\x15\x0d\xd4\xdd#\xc9ft\xf5\x80\xbc\x82\xc9?\x14\xd6\xa4\xf8[f\x95tZ\n\x9d\xdc\x1c\xba\xa1\x80\x96F\x83w\x9c6\x8dip\x98\x12\xcd^\x17q\xc8.\x19\xc6\x11v\xa3Z~i\xcak\xd4\xf5\xa4\xa4\x95M\xa7+\x92\x02wNH0\x97\x02\xf7\xf2 w=\x16\x16\xa3\xd5\xa1\xee\x1a]\x8f\x1c\xec\x86\xbagCY\xca\x08)\n\x1f\xde\xb0\x11\x0d\xaeR\xd9\xd3\x1ae\x97\x12\xa3F\x9d\xf2:\x18\x9b\x94\xa7\x99\xde\x9a6\xd3j\xd5{\x1c\xb8Q7\xed\xd1\xfb\x0c\x08\x8a\x11\x07J\xf5\x83\xc8\xbc\x8c\x95\xeeB\x00\x8bx=\x8f\xe5\xb1{\x8f\x027\xd8=\xc1\xe0\x16%X8[\x9f\xb5\xc7mw\x1f\xba\x85\xd9aL\xb4\x1a?\xeaf=\n\xe3Qr\xc1\xd2	XB\xa6B\x1e\xe2|{\xd4\xe6\xa7\xec\x1e\xae\xcf \x11\x81T\xac\x81miHxQ\x18\x82\xf8V\xfcs\x1d\x1eL\xc0\x12\xa5\x8fi\xd0\x97\x17\x99\xfc}\x00\xcar\x8f=i\x97\x8e\xdf\x87m\xf7~\xb0\xe3\xbc{\xd4\x96/\xa6\xcf\xa4\xd8\x8bAG\x88W\xcb\x88\x19\xcb\x88\xe1F\x1f9w\x8f\x11\x1b\x92>\x8a\x8c$\x14\n\x12\xa7\x07\xb5VBd\x9c\x17\xc1t\xa1sCG\n\xecX\xfa\x9c\xc6c\nam\x13o\xf1a\xdb\xa6Z\xe4c\xe9\x8a\xde\xc6\xb9\xed>\x83\x94\xa6\x13\x9c\x85\xe9\x03\x80\xc3\xf1\x18bj\x88\x90\xfa\x94\"\xd1\x00\x03\xf9t\xed1\x0d\x18\xddo\x8fm9\x9e*\x95<\x03zEK\xba\xf9\x0c\x98R\xb95\x01|{[\x83Vk\xf0U\x12\x0d\x83\xa4\xd8\xdaK\xd9\xf9PkYQ\xe7!\x95sn\x8f\x869\xb38\xca&\xc5\xa8s\x11$\x0f6\xdaI\x82\x8dFT\x95x\xa3\x16z4\x16\xf1\xd6U\xe1~\x80e\x91W(\xc68l\x8bYe\x96\xb5gW\xa7\xcf\xa6-\x19\x99\x1a&\xcd\x02\xdb\x1c\xb4\x8a\x19\xc4Q\x96\xc4SZ\xe5\xfa\xaa\x15d\xb0\xbfy\xee\n\xf9\xca/\xca9\xa9\xb7\xbf\xfb\x86\x16\x8a3\x9f\xc5\xf1\xb4\x1f\x9f\x15{Je\x06qDe0\xb2\xac6\x12A\xb6H\xc4\xc5Ye\xed\xcf\x02\xf7\xc3\xd2\xca\xf3\xccJ0\xa9h;\x0b\xe7\xde\xf6\xf5\xc2^Jq\xfa3-J\xdfc]H'H\xa9u\x84\xcf \xe7\x94K\xd7\x9f\x1f\xe4\xb2\xf9\xbd\x1e\xbb\x83\x93e\x9c\x84\xc0\xa1\xc7\x02x\xb6^\xc2\xb2\xbe\xb1\x80U/\x87F\x0f#\xf5a\x06H;/\xc2t\x11L\xdb\xc1\x1c\x98y\x90\x05_\xc6\x12\xb37\xb7M\x8f=+\x9f.O\xda\xc4\x07\xa4\xf3\x85\x17p\xab\xa1	\x17\xe0\xa6}\x82\xe8Mf$W\x9dy(\xf0\x16\x11\x95\x8a3\x0e\x16c\xf5\xa1\xc8h{\xca]\xd0\xe0\xf9ga*$\xe7\xc4\xd8	\xb6S<F%9\xe7\x06)\xffR\xce\xe9\\A\xb9F\xda\xb5d\xf4<\xacT>-.\xc4\x9a\x92\xbc\xa2| \x08|\x8e\xd5\x0b\x1a\xb4Z\x82O`\x9aNv\xc1@\x8c\x16\xa7L\x01\xf7\xe7\x8e\xf5\x020\xdd\xc0\xc1\x05\xf1o\xb7H\x9f\x1d\x96h\xb3\x9f\x9bM\xb5f\x1f\xb4\xf9\x17\x16\x9e\xdb\xfc\xa0]\x9b/\x12\xc9AEP$)\xe4\x8a\xa3\x0c\xde\\>\x90\x0c\x992o\xbb\x84\xc3+\x8e\xb6\xba\x8b>k\xf3\xa5a\xbc\x9f(\xa6\xb9\xbfD\xfd\xf0{7\xd8A\xbb1\xe6\x9cI\x13\xee\xc4rV\xbfO\x8fq\xd9\x88\xb2bg=\xe8\xa1EZ@9\n=C5\xdd\xac\xba\xa3\x86\x9evV\x8f\x07\x9f\xffn\xb0fy\xcf\x93\xf8\xf4\x82n[\xca\xa3\xaa\x8dz.\x01uh\x0f\xe6Mc\xf0\xeb\xdbG\x91U\xf9\x8e\"\xcdmR\xf7\xb2\x13\xdb\xac\xb6i\x8e0\xc88Z\xce\xfe0\xeef=\xd2\xfa\xa6N\x1b\xb7\xfde\xbd\xc5\xb2\x90\xeaw\xef\xc6\x97\xa28\xbb\x94.\xe6\xf38\xc9.\xe5\xf0\xbbt:\x11\xd1\xa5Td\x97\xd4\xeeT\xfa4w\xce\xfdKR\\\xa2\xf6]\xe8-\xe72\x18e\xe0~\x13\x188|\x1c\x94\xa7\xc5\x16\xd5x\xcbY!P\xe7b-w\xc5(\x8c`\x11ORT\xb5\xed=Z!f\xf6\xc4\xa1\xd62\xd853[\xc1\xbf\xa3\xb5\x0bf$_\xe6]L\x8e\xec\xd4\xaf3E\xa5W\x0f*l\x96\xe8\xdd\xa1\xcd/\x03sp\xb5r\x89\xb7\xeb\x91\xe9\x13W\xda\xb6\xd3<4i\x07\x85lR\x83C\x80Sc\xcd\xb4p\x8d\xbf|\x8b\x19\xcb\xa5g\xfce~\xa2\xf8{\x0d\xb6s\xf6h\x06r\xc0\x1cn\x1c\x05W(&\x9c\xc1@\xc0\xf6\x19\xc2\x0d\x92\xed\x138\x94u=&E.\xbb\xb9	\xeb\x90\x17*\xba\x9c\xd1D\x15\xaa\x97\xb3\x05\xfe\xa1\xce\x8c\xb3$\xacF\xbd\xe6\xc5\x9bF[\x0e\x94\x058Tk\xa7\"\xb0\x9eE:Y\xf5\x8d\xf6\xb9\x04\xa9\x96 Q%\xb1A!'y\xdf>Q\xfd\x8f\x02{\x13m\x8a\xfa\x10\x14\xe9\x1e:\x04\xab\xc7\xcfJ;\xe8\xa3 \x9f\xcc\xcd\x1a\x00\xa0\x98\xb7 \xe7\xd0,\xd6V\xa9\xc46\xd7]\nG\x1d\xc6\xdd\xb0\xe7\xc7\x85\x19\xff(X\xb3\x0f\x0b\xbd\xfe\xe4\xedz\x9d%.\xee3\x0d\x05\xbd\x9b\xc0c'\xbbi\x85q|\xf2\x16\xe3\x00\x91\x81h\xc55\xf9V8\xcc|\n\xe9\xe1\xed\x91\x1d\xa5\xe0\xf4.n\x1d,\xd0J\xe5)\xc6\xaf\xa5e&A\x8auN\x04\x16\xa8\xf3d\x89\xbb\x15\x16Q\xcf\x8f/\xe8\xbe\x05\xa4\xcd\x0e\x13\xb01e\xeb5\xfb\xb2\xcd\xaf|\xb5\xfc\xdf\xdcZ\xf5\xd0\xfbj}e\xcc>mK\xb3	\xe8cYK\xd1\xd2\xed\xd0\x94uds\x0b\xa6\x13\x9e\x04\xa7\xcf0l\x99\x9b\x91\x94\xd0\x93\xe0T\xde*\xc0\xcaI\xac\xe7a\x00\x859\xb0xu\x0e\xd4\x85\xf1#\x13\x9c\xc8\xd2A\xfdL\"*@s4\xf6C\xba\x92\x86R\x0d6\x90_P\x8d\xcb\xc5(HoH%\x13\x7f\x81\xa9\xab\x03A_\x93\x919\xe7\x85\x1a\x0e\xed\x02d\xb7\\\xd3\xe9\xb5\xfa\x95\x15\xa1\x13\x87\xaa\xa1pH\x11\x18\xa5\x8a\xc2\xc9\xab\xb8\xa1\x01\xc3\xc6\x93\xc5\xfcX\x86\xfdD\xfe\xa0]_0\xb9~\xfc\x88Lu\xa6l\xa65d!\x9d\xf3\xee\x8b I\xfd.u\x1b\xd5;\xf40b\xea\x91\xea`V\xa5\xbd\x11H\xfeeb(\x1fX\x1b\xeb\x00\xa4]y^ia}\xf2TY\x98X\x88\x05\xf1@O\x84\xb1a\x90Kv\xe7\xabD^\x00\x84\x97F\x95J\xaa\xd6\xfe\xc6\xda\xadT\\J\xe2\xf4\x0b\xc9	\x92\x08JP\xa5\xab;\x04,\xf8\xb0\x1b\xafV\xf4\x00a\xce\x88\x86\x948=\xbf+\xd8\x8e$\xbd\xdf\xb6pp\xa7\x87.\xfc\x0c\x05\xd9\"\xe5\x82M\xdd\xd4\xf3\xfc\x0d\xcb\x05\xd3\xc3Oc)\nZK\xc4|\x1a\x0c\x84\xfb\xa5\x8d\x11\xe7lc\xe3\x05\x86\xda\xeb\xa2\xcba\x0d\xe2\x88G\x99[\xf7*\x15\xa7W\x88\x8a\x94\xdb\xf3\x90WC\"?7\x18\xe2<&\x12Pw\x80\xaf{\xfe\x8b8\x1c^Rw\x8e\xdeK\x85\xd93\xc7\x98H\xdc\xf26\x84\x83E\x05\n\xd2\xae\xceKz\xd0 \xf8\xbc\xcd\xe3$\xe7\x0e4?\x97\x888JX\x8f#K\xc1J\xe4\x94\xee=7\xbb\xcde\xff3\xde\x80-\x07\xeb 8Y\xcc\xd3,\x11\xc1\x0cd5\xd0\x1d\xd2\xe7\xe1\xdc\x98S\xd0\x9e\x9c\x08pDg\xc3SY\x9ce\xcd\xb8\x86\xa5\xcf\xe3Z\xbc\xc8\xe6\x0b\xb9\xa2\x001\x95jE\x92i\xba\x93y8\x97O:}h\xe615\xa2|>\x0d\xd2\xe7\xb2\x0d\xf2\xbeJ\xe4\xeb\xf94\x88*\x95=\x98p\xd1\xc2*\x88r\xed\xde\x10c+\xe1\x82*\x9f\xc5\xc3;\xe7f\xc5\xe3\x13\x1d\x94\xbcahRL\x91\x11\x03\xa6|\x0b\xae\xbf\xecl\xcd\x84s>\xadTR\xce\xf9B\xfaru\xa4\x90)\xa9\x11M\x9a\xd4\x18\xf9\x8f\xa5D\xcey`\xae\x06\x95\x86\xb7\xd3\x84'\x89\x16\xf2\xd4\x17\xb9l\x9dO\xf3/\xd3:_H\x18\x0chZ2aO\xcbB\xdc\x8b\x860\xabR;!8\xcb\x18#S\xb1\x88\xc8\x12\x8a\n\x17!\xe5\xc3\xc0!\x81\x91Tg\xc8\xe76\x01w\xb8\x10\xf2\x8cc#c0O^\x91{|pX\xccQ\x1dX\x95\xa9^)\x99!\xcc\xd5d\xb5\x1a\xde\x1a\x91\x1b\xad9/\xb6\x88\\\xe7\xee<\xb7\x108\xe6\xf5\xe6\xf8\xd6\\\xef\xccq\xb5\xea\x05\x04\xaeyw\xdccC6\x82iw\x92\x0f2I&~]\xec;\x1fI\xf0\xcdH]\x85\xdeJP\xbc\x19>\x92\x10\xbb\x17\x0d\x0f\xb7\xc6\xfa#Zv6d\xf9L\x89\x10\x95\x1a\xd9\x92\xf1Wo\xcf\xd4y/\x1a\xd2\x89\xa4\xdaYD\x10\x81\x84\x1d!\xd7S\x8f\xe7\xaf-R\x88\xb5\xd9\xb3\xfc\xc1\x1d\xdd\n\x0f\xa3jU\xde{\xb9v\xa702u\xd1w\x92\xcbqUN\xeb@\x84S7\xba\x92@\xba7\xbamLy\xde\n\x0e3?\xd2\x1d\x89\xaaU&\xd6%G\x96|)\x17z~DIg:l\xe0-#>e!_\xb0\x98\xc3\xbb\xc5\x00~\x04LS\xc1\x15\xe8\x94\xd4\"q\x96\xf1\xf8v\xa3R	n\xd7\x0f\x85\x9f\xad\xd7\xba\xb1t\xedz8\xd9\xd4\x96\xd9@\x88i'\xe9\xc5\x1bGw\xc9\x84\x9e\xfdY\\\xebk\xf6y\xdb\x82\xa3]'\xb5Y\\\x96\x95Jq\x0bh\x8c_.mY\x19N\xb1\xbc\x9a\xcc[\xea# >\x053P\x043K@\x9b<\xdaW*\x1b\x99\xe0\xf67?}\xe5Bb\xda\n \x18\xfe\x12\n\x92)m\xf7\x99j!n\xb5qq\xad\xab\xa9TJ\x11\x85\x161\xbd\x1e+wdK\xdf\xac~\x15\xca\xe8\x83\xac\xd8\x0b\xa0\xbb\x122c\x91}J\xed\xee\x84\xb3\xaeX\x17\xb8\x9b\x03dW\x91|\x04\xb2\x10\x98\xacz\xeb\xe4e\xcc\x9b\xaa\xb0i\xb7\xeeo\x9e\xa9\xbd\xf4\x05iL|\xd3\x86T\xb1V\x90\xc8,\xd4\xb2vr\x02\xa6\xdb\xc9\x89\xa3\x91D2~Q\xe6|k\x93\x17\xb6\xa9\x04\x19\xa5\xe8\x9e\x12\xd7J\xe2\x17\xe1P$*^\x91\xf0\xa4n\xe1\x91T\xca\x86\xb5-?'Kk2`n\xc1A\x95\xbb\xc8\xae\x85\xd5E}O\x13\x96-\xe3\xc8H7TQp\xcbF\x89K\xcf\xf4E\x02\xe9\x12\x91\xf9\x93d\xed\x15s\xe9m\xc4\x97\xd2\xb2\x86<\xa9\xc8\x94\xdeN+\x1alH\x02\xf5Mz_\x11\xa2\xd3\x820w0\x05,d\xbe\xe60\x81le.J\\n\x94\xb0\x0b\xf6\x85\xd6\xaa\xc4\xf7\x1dX\xf3\x11\x8f\x93\x18@\x13C\x1e\xb34!\xfb\xfc\x7f;\xd6;\xb48,\xbd\x1f\x9a\xb6`\xb1\xbc\xd4\x83*w:Z\xd8j\xb7\xa9\x0f*\xff&K\x1f\xed0g\x1d\xc08\xdf$>uz4\x0bp\xed\x01p\x19\xd3\xa4Z$\xe0\xad\xed\x83d<rm5\x08)C\xf0\x86\xca\x89!W\xb2p\xd2\xa4&\x0b\x95\xbd\xedd\x8b\x0b&\x9bN\xbc\xbf\xa5%\xb0\x012\x0b RN<\xabT\xf6:\xb6kp\xc15\xd4wy\xd5\xa8T\xa4\xe7\x0c\xf9\x94\xa9T0a\xdd\xb0g\xe6L\x10\x959_\xddy\x930\xa1\xb2\x83$\xa7w\x05=\x9e]\xaf\x96g\xd43!+\xdeA\xcc\x9b\xd3\x90\x81F\x99\xa7\xbd(\xc0\xadY\xb2\xfa\x17\xfa\xf2\xb9\xfe \x8c\x9e\x0bj\xc7\xd0\x0e\x0b{L*r\xfdZ\xed6C\xb9Iq\x01\x82+9\x8b\x87\xe1\xe8\xdc\x9c\xd6\x88\xb2Oo)\x81\x07\xec\x14\x16o\xecGD\xc6\xc4\x1e\xe7\x85C\x01\x8f/Y7\xcf\xbcu\xa1\xb7 \xa0\x19\x0e\x84\x0d{\x1a\xda\x84\x8e#\xfd,+\x0e\xcc\xe0M\x17\x9d2\xb2(D-\x8a:\xb8\x1a\xa5\xa2\xc3\xb6x%h\xe8K\xec\xdd*^\xfatw?\xfbHPS\x7f_\xb2\x99\x17_\xc6\xee\xfd\x18\xcbx]<d18\x17\x06v\xa1\xda3\xbf\xab	L\xaeC\xc6m\xc4\x90^\x97J\x99\x1b$\x02\xd2\xb8\xce)\x039\x19\x08j\x99\xe7 ],\x94\x02\xe7v\x9a\xce\xa9\xbb\xf0*\x95\x85\xe5\xb9\xa4Rq\x17\xdc\xb5c\xba\xf5\x1e\xf4\x0bI[\x1evY\x16\xd0M\x83\x11be\x9aO\x111&<\xb9\xddX\xad\xa6\x95\xca^\x92\x9b\xca(\x01!\x7f\xc6\xcbN\x99A\x82\x08\x08;\xd7P\x1d\xa8T\xf6\x1a{\x9cG\xb58\x9b\x88\xe4n8K\x0d\xef\xd3\x88\xc5\xe1\xa6gS\xfeu\x8c\x8b\x10\xf4\x9a\x05\xf9~N\x17}\x8c\xd4Y\xc3\xfb\xa7\x9b\x1cN\xab_\xc6nT#\xb3\xe5 \xdc\xacV\xce\xbe\xe3U\x1d\xff\x92\xe3;\x8e\x87tGM\xbf$~\x04\x87\xf0\\\xecK\x1e\xa6\xfa\x96\x86\xdc\xbe\x89]\xe7\xfc\xfc\xfc\xfcJ\xbb}e8\xbc4\x99\xf8\xb3\x99\x9fJ\xfdW_\xcdfs\x02\xfa\x8b\x14\xc0\x9cxk2\x02>/p\xea\x98x\xd3\xf8\xf5\xc2[\xf1\x1c\x0c4x\xa5\x03\xb0\xc7\x0b\x83Y\xc3\xffSj\xd9\x08\xd1k\xe2\xb0\xa0\x99'\xcdM	y\x8c3\x01\xcf\xb2k\xcf\x07\x131\xf2\x98\x9b\x1c:\xb7\xfa\xc9\x15\xd8\xc5s\xbcjZ\xfb:\x0e#;\x92]r\xbc\xb5\x9bz>b\xa9\x8e\x00\xde\x1e\xfd\xe9!\xd6\x86\x9f\xc2\xef\xe6\xd7\xb1\xbb\xf0\xd8\x88\xc76\x91q\xcc\x0d\x0dN\x8f\xecX\xe9\x05\x81\x999\x96\xfe\xaf\xc7||\xf8e\xec\x8e\xbd\xaa+\x0e\xd5\xa4\xa8\xeex\xb0b&\x0e\x07\xd5qu\xe2\x8f\xab\x83\xaa;B\xc6\x91\x9a\xba\xea\xc4\x9fxoa\xa3R\x9b\xa8$bp\xa3im{\xcbN\xa9c\xccS\x18B\xa7\xdc\xfdt\xd2\xab\xad\xa9O\xbc\xdb:z\xb3\xb4\xb1M\xe1\x00\xdd\xc8\xf8\x9e\xa4\xd9\xec\xb2\x1b\xba\xf56\xfdU\x0cN\xd8\xd6\xabX\xccG\xed\xda\x96\xb2%\xc9\xc2|\x95\xc5\xab\x95\x0b#\x15[\xda\xa3\xb5\xea\xb1x\xad\x10\xe6\xbb\xe1\xeci\x0c0\xdc\x0dwsd\x0bwb\xe1\x0c\xcb\xad\xdb>\xce\x0d~l9\xc95d]\xc72\x0c\x02\xa3p\xc5\x92\x06\xcco[\x855/\x12\xbe\xb8\x0c\xe84Ew\x15\x82\x9e[G\xd4\xf6\x8dU<^\x8d\x98!\xfaJ\xc4\x0b\x11e\x96\xc9\xf0<\x17\xe8a\xc7\xea:\x95\x91k\xaf9q\xbfi\xb3O\xdb0\x84\xf3M.	\xfdq\x81\x16\xa0F\x0d\xb3\xe7R\xcf09-\x1a\xc8{\x11\x8aS#\x8b\xecx\xeb\xe6\xc7\xed\x9c\xc1_\x14d\xfd\xb8\xbd\xf9\xcc\x00&\x93\x88\x08\xef\x97\xcd\xd7\xc7\x9a\xd1\x834O\x03\xc9Rn\x93w\xdb\xdcn\xa9\xf9n\x9b\x84\x81\x9f\x85\xe2\x94\x9b/\xc5\x0b\xce\xbf\x15\xe7\x80o\xbet\xa4\x99\xd6\x8f\xdb\x1e\xfb\x12?;M\xa2\xc9\xb6?*\x00\x081\x99|\xf1\xe9\xb5k\x12\x0d\"\x93\x81\x12\x0b\x1fy\xa5\x89\xc0\x0b\xa16\x0d\x92\xb1\x90<\x10kQ<\x91@\x91\xac\x10\x99\xce#\xca\x97n\xcb\xc7\xa3\xcd8\xea\xcd\xde\x9e\x1b\xffF\xb2Z\x05\xbf\x01\x91@\"$\xae\xd7\xec\x13z\xa5f-\xfeQ\xdb\xf5\x9ac\x8b\x08\xcc\xb51G\xa8\xd1;\xbf\xf2\xacM\xc2\xf1d\nq\x9em\xbc\xb1\xc4\xcd,\x84\"b\x8e\x98\xcd'A\x1a\xc2\xd0\x14\xc3\x93\x1cw\xe1[\x15$\xa6\x80\x87#\x0c\xae@\x0b\x85\xec\x85[S\xe9G\xd3\xa9\xebm_S\xccr\xb7\xf58\x11@\x1d\x14\x10\xf5\xeb\xd8\xa4\xda\xd1%7I2R\xadN\xd1\xe26<\x9b\xa2e\xafN\xd1*\xaeN\xd1z\xd3\xea$\x8d\x7f@\xdc,Y\xb9b\xc7\x89\\\xb1\xe3d\xb7\x11\xbfqR\x03\xbb\xe8S\xd9\xd9\xb6\xc8&\xb1M\xde\x00\xff\xee\x13\x88\xc8\xd5\x16v\x06\x92\xa9	[|\xb9\x134K\xbd\xe4J\x80\x175\x1c\n\xb6\x07\xb3'\xa6\xe7@\xdd\xc5\x94	cDD\xd4\x82y\xc8Dm\x1e\x9cO\xe3`\xe8\xad\xcdy\x00\xd1\x81\x81\x90\xc6^\xf5\xb9\x0c\xd9\x8b\x8b\x1b%\x18\xbdM;k\x16\xb5\xa4I\x8b\x13\x88\x9ad\xd9T<\x92\xaci\x05\"\x87\xc5\xa5\xd4'0\xc0\xc0\x82R,\xde\xe2\x0eKZ|\xa9\xb4!\x1eEG\xd3))\xe7\xa0\xb7\x8a\x95\x92\xeb]\xed\xd5\x89(R\x84\x19\xbd\x8d\xed\x05\xce\x8dDV\x89\x9c\xb3Zm\xd0s<\xcb\xa86#\xab=n\x04\x07\x01\xdb/Pb=\x82\x92$jE\xfd6\xd7\x93o\xb0\x10/*\xe2\xb3j\x1bN\xb1\xc7\xf6D\xad\xac#\x85\xd7\xc22\xe7\xac\x19\xabi\xf1jUdTwV\xab\xb0\xa4\x19$\xf5\x82\xd2\x02SWh\xa6.\x8b\xf3\x0d\xaf\xd8\xceP\xb6\xf6\xd6\x86\xd9\x8b\x07\xd5\xbd`0\xf1\xc3\"\x9b\xfcp+i\xa6\xc8\xb2\x14\x8a\xa2\x12\xe1\xea\xcfE\xe7\xb2\x9d}	\xbd\xb5Ob\x11k\x96\xb6\xf8\x92\xe4\x0d\xfd\xa5tZ\xe5/\xa5\xd4\xa1\xbf\x84;\x14\xdf\xf9\xf6G\xff\xf1\xd5\xff\xf8\xa3_~\xef\xb7_\xff\xe3\xff\xe80r|\xed;\xaf\xfe\xcdo\xfd\xf2{\xbf\xed0\xdc\xc4\x9f\xfb\xce\xeb\xff\xf0\xc7\xaf\xfe\xd9?\xd7\xb9\x10\xf9\x85\xef|\xfb\xd3\xffjE>\x17b\xee;?\xff\xab\xdf\x7f\xfd\xfd\xbf\xa33B\xa8#\xf1\x9d\xd7?\xf9\x07\xbf\xfcW\xff\x8e\"\xd7k\xc9-\xc6\xdd\xa7\xbb\xe2\xbc\xfe\x17\xff\xe5\xf5?\xf9\xd3_\xfc_\xff\xf0\xd5\x0f\xff\xd2a\xd3 \x1a\xfb\xddR\xa4\xf3\xea\x1f\xfc\xf8\x97\xbf\xf7'\x0es^\xfd\xd6\x7f\x7f\xfd/\xff\x0b\x0c\x00iQI3\xa4\x97q<\xf3\x9dW\xdf\xff\xe9\xab\x1f\xfd\xe8\xdb\x9f\xfd\xc7\xd7\xbf\xfb\x97\x0e\xeb\x07\x83\xe7\xc5\xb8_\xfc\xd5\x0f^\xfd\xd3\x1f\xa1#\xb3`\x1c\x0e\xb0-L\x0d\x18\x9a\xef\xbc\xfa\xad\xdf|\xfdO\xfe\xe8\xe7?\xf9\xe9\xeb\x7f\xf4\x83o\x7f\xfaW\xb2W\xfd \xb1\x13~\xf4g\xdf\xfe\xa3\xff&\x13\xd2L\xb5\xa0\xcb\xbc\xfa\x83\x7f\xf8\xeaw\xfe\xc0aY8\x15C\xab\xaeW\x7f\xfe\xe3_\xfe\xef?u\xd6\x06\x8d\xd5\x8d:\xbaC,\x0d^\x88\xa3T\xf9k\xd1\x89?\xff\xab\xdf\x7f\xf5'?\xf8\xf9O~\xfa\xea\x87\x7f\xf9\xedo\xff\xa6\x01\xcf\xab\xdf\xf9\xf1/\x7f\xf7O_\xfd\xce\xffYH\x05\xfb\\\x11A}y1>\x0cf\x02\xd2`\xa1\xf0\x9d_\xfe\xfb\x9f\xe5#\xb1\x06\xa0\xc6l\x0d5\x1d\x04Y&0q\xff\xe2\xff\xf8\xf6\xef\xfe\xb9\x8c\x13\xa3\x91\x18d\x1d\x93\xf2\xdf~\xf4\xfag\x7fl\xa5K\xb1g\xdf\xf9\xe5\x0f\xff\xfb/\xfe\xf2/eL\x96\x08T\xfb\x07\xff\xdc|\xcd\x82\xb9^p:\xba\x1f\x9f\xcd\xa71\xd6\xe1\x9f\xfe\xd9\xab\x7f\xfb\x8fe\xdc \x88\x86S\x91f!\xc0\xfa\xb1\x86\xbf\x1d\x9e\x88 S\x95\xfd\xbd?y\xf5\x8f~(\xd3\xe5\xf7\xab\x7f\xf3_\xe4\x87\x16\xbb\xf5\x9dW\x7f\xfe\xe3_\xfc\xe1\xf7_\xfd\xfe?{\xfd\x07\xbf)\x930\xda\x14\x8bWU%Mf\xfaX]\xdf\xfe\xf8/dL\x1aD\xcf\xc5\xb9\xef\xbc\xfe\xc3\x7f\xfe\xeaG\x80\xba\x83\x07`\x84\xca^\xff\xecw^\xff\xcb\xdf\x93QR\xd6\xd5w~\xfe\x17\x7f\xfc\x8b?\xfc\x0f\xdf\xfe\xf0\x072r\x1e\x02{\x0c\x83\xe9\x1d\xc0\xe2\x17\x7f\xf6\x87\xaf\xfe\xc7\x1f\xbd\xfe\xd1\x9f\xc94i\x14\xe1I\xf8\x02p\xfd\xf9O\xfe\xe2\x97\x7f\xf4\x83\xd7\xff\xf7\x8f_\xff\xd7\xbf#\x13\xd3E\xd4_\xc0T\xb8\xf3\xfa\xf7\xfe\xe4\xf5\xef\xfd{D\xae!i\x19\x06\xfer,\"\x91@\xa4\xec4\xcc&O\xf5\x92\xf9W\xaf\x7f\xf0\x9f\x7f\xfe\x93\xef\xfd\xfc'\x7f\xfc\xea\x1f\xfc\xf8\xe7?\xfd\xa7\x7f\xfd\xbd\x7f\xa3\x96\xcc\xfa\xaf\xbf\xf7\xfb\xdf\xfe\xeb\xbf\xff\xea\x87\x7f\xf9\x8b?\xfc\x0f\xff\xcf\xf7\xfe\xae\xc3P.^d\xdb\x8a\xcaL\x7f\xf3\xb3\xef;\xf9\xb2Q\xba\x05\xb82\xc5(<\xc3\xeb\x11\xe5\xb1\x90|G\xe5\xff\xf6\xcf\xfe\xe2\xd5\xbf\xfd\xc7\xaf\x7f\xf0\x9f\x97\xb9(\xca\xfao~\xf6}$\xfd\xbb\x9fR$\n\xac\xed\xe6\xdfX\x032\xaf\xd9l\x81\x97\x83\xdd\x81W\x7f\xfa\xf7\xbe\xfd\xdd?\xa3j\xe5\xc1\xbf6]\xc7\xa4\xfd\xd6\xef}\xfb\x17\x7f\xff\xf5o\xfd3\xd3\x98j\xe9\xdb\xff\xf4\x9f\xa8Lk\x88\x02*\xab\x81\xdafg\xbf\xfd\xd7\x7f\x9f\n\xe8\x01\x19\xd0\xbdU\x8d\x1b\x85S`g0\xc6\xe9/g\xe1p\x08\xe0\xff\xcd\xcf~\xe8\xc0\xcb\xb5\xef\xa0\xb7k:\x0c\xfde\xa0h\xb6X\x88\xffM\x1d|\xaf\x7f\xf0\x9f\xff\xfa{\xbf\xfb\xd7\xdf\xfb]\xb9\x9a\x0da\x15\x19~\xfe\x93?\xf9\x9b\x9f}\xff\xd5o\xff\x93%\x910\x8e\xa3l\xfd\xcb?\xfcs\xabH\x0e\x86eDc3\xd5.\xa5p\xcb\xba48\x13\xbb\xb5\xd7\xdf\xa7^\xa3\xcbk6m\x95\xd02\xfd\x05\x1b\xc5@\xd3 RN\x92)\xe6\x92\xcd\x88xp)\xd3\xe4u\xba\x1d/\x15U\ndy\x1e\x1a\xf9\x15<\xe5\x9e\x88\xf1\xbd\xb3\xb9\xeb|\xf5\xd5\xf2\xab\xaf\xd2\xcbNUT\x1d\x04\xbe\xfaj\xed0G\xfa	\x82\x06@\x98s{-\xa1\xcf\x80l\x15hC\x1dR>\xdd\x92r\xb4\x11\x92\x88\xa7\xadZ\x90\x84\x01\xc9|\x1a)\x99j5\xf6\"\x1eu\xc3n\xdc3T\xa2H\xd3\x8eE\xdepl\x91(\xd3\x16q\xa4j\xe6,\xeef\xbd\xd5\xca\xf9\xc5o\xfe\xf1\xab?\xfd\xd7?\xff\xf3\xdf\x96\x1b\x1d\xe0\x80\xf3q\xe3i\xc5A\x0f\x1c\xd9\xe1\x80T\x97A\x9e\xf7\xbc<b(\xd2A\x12J$\xc2\xf1<\x89\x0d\x1ceY\x12\xf6\x17\x99P\xe5\xf7\x89:\xbf\xb5@n\xc9'\xe1u\xd2\x82V\x98\x95m\x16Bx\xcbj5!\xedu9k)\x9b\x12<\xa5}Z\xf8\x14\x94;\xd2\xf1V\xabF\x9d-t\"\x0d\xbb\x94<\xc9e)\x12\xb6\x90\xe3\xdbs\x93[\x0d\x92\x8f\x18l\xbeUm\xa0\xc8\xd3\xcd\xf1H\xf1K\xcf\x01\xc8K4IR\xba'\x03M\x8d\xc9X\xd0\x0f\xc0MO\xf9\xe00t#\xd7\xa1\x93\xb4f\x0eRx6\x90\xd5\xfa\x83\xb5\xe7\x97r\xe8#\x93\x04]F \x0f\xa6U\x8e\x9a\x92\xdb\x8dC3F:\xb0j\xea\xc0t\xb4P\x1e\x1c\xc2\x8f\xf3h\x8f\xd1\xf9 \x01\xe2\x83\xcfx\x01\xd8\xc3\x91+n\x91\x1ab\x00\xc24	\x97AT\xcec\x0b\xae\xdbp\xaa\xaa/\xba\x13h]\x9e\xdf \xcd\xd6\x9cf\xc0C\x17\xe6\xdf\xd3\xc3E\xd5\xd1\x87\x82\xe3\xd3\x07m\xfe\xbco\x90\x00\xb4\x99\xa8t) \x93_\xecB~\xd6\xf9@~5\xab\x8f\xc8\x0e\x03\xfb}\xd0<\x0d\xa3a|\xaaX\x17\x0360\xf4\xbf\xe9a\xa0\xc0\xa9\xd6\x92u\xcc\xa1G\xf9\xd9\xe6O\xd7\x9e\x1fT\xb9\xceH\x87\xa5\x93;\x1f\x1eB\xce|\xce\xeb\xcd\xf9-S}s\xae\xfci\xceoM\x15\x1c\xc7|`\xa8\xaas\x8f\xcd\xb8H\xdc\x01\x9b{\xcd\xa1\"7cb\xc7\x87\xaa/9\xa8\xf2o\x0bZ\x98\x07\x7fL\xb2\x8e3\xe8a\x05U>T4_\xddKs\x8e\xd6\xd4\xe1\xefx^u3\x0d*A\x1e\x1b\x91\xe7Ko\xbd\xf6XZ\xe5#S\x15\xcd\xb3Yd;j\xdd\x9dM5p\xd1\x01\x01\xbf\xeek\xb6h\xa9\xcd\xf9\xb8\xc5&-\xde\xb1I\x02\x93\x96m\x94\xa0t\xf4gv\x9a\xab-\x13\x9e\xa4Y0\x96\xd4>\x98s(\xbe\xa4\xcc\xc1\x9c\xd5`\x8c8\x98N\x91\x0f\xfa\x8aB\xb3B\xa1!?ia\xb6\x1e\xabW\xe9Q2.\xfb\xcd\x86\x80\x9e%?g\xe8W\xa0?\x98\xd8\xdc\xd0E\x9e\x13\xf6\x91X\x94\x8b\xe9\x91G\xae\x02\xad\x8a<5U*\xee^\xb4Zm!Yy8`NN\xc2\xe1Y+zL\x8d\xdd\x0ek}\xa8FIY\x83\xc3P\n\xac\xc9\xa7\x18l\x16\xc3\xa5\xad-YG\xe7\xd7Rf\x8a\x99\x14\x82\x93\x99\xf7xpX\x14\x18\"\xffgVaX\xbf7\x10\xa2\xd6-\xf0\x98\x0bh\x070\x14t\x15\x15\xa5#eQp\xef\x94\x01|1<\x17\n\x8a\xd6>\xd7\x1b\x8f\x91+\x8b\x0dX\x8a\xda.\x02M\xa5\x02\xe1\xabZ\xa6\xc9\xcb\xdaT\xb1\xebHJ\"\xb8\x00\xd1\xedB\x94E\x89f	wf\xb1\xf23\xb7A\xb1>\x9e,\xa2\xe7\x18\x9d\xe3\x1d*:t3+\x936\xf3\xa5\xc0\x97\x1b\xf4J?.B>a\xb2K\x98\x01\x96o\x0c=	)\xdf\xb2\xcc!\xbf\x07\x0e\xb1\x0dE\xfe\xc4\xf5\x9a\xd9\xf6\x0b@\xf3\xb6\xe4h,B\xbf\x04\xad\x84<\x19\x89\x8d\xd92\x1c\xfa1\xdeC\xe4V&\x0bB\xa2\xa3\x1bX\xfa\xe5j\x0c\xe8\\\x8fmN\x93\x0f\x8e\x99\xb4w_\xa6\xcfc\xc5oR\xed\xc1\xb9\xceW\xbd\xbf\xdf`rE\xcb\x15\x9c\xc4\x8bh\xe8F\xab\xd5\x8dz\xdd#\xbd\xb6\xfa\xdac\xf7\x12W\xb0\x8c\x94\xfa\xb0\xf9\xf5\x86\x9f\xabE\xd1\xd1\x87G\xbaI\xbd&y&\xebx\xd1jJb\xd0\"y\xed\x1ci0*\xf4\xc1<lj\xa3\x1d\xc1t\xfa\xa1|c&\x96zd\xae\x0c\x81\xd2n\x040K\xaaT\xaa\xbf`\xdb\xb1\x19\xe1\xb8\x13Y\xa5\xd2\"\xe9K\x08\x04\x82\xc7\xc3\"}\x9a=\x81\\`\xa5\xf2\xb4\x94\xc1\x18\x01\xca\xc7	\"\x88\xb5`\x0c\xfdX\xf1\xb3\x89B\x08\xf8Hn6	64\x03\x9c#b\xca\x05\x0b4m\x92\x87,\xc0\x10y\xc4bHla>\xf8^\xb6s\xbfa\x06\x003\xb8\xe5%\xc8\xabs\x96\xc4\xb4 Y\x14'\xa5\x19\x90\x98y\x9b\n\x92\xfc\x9b\x9d\xdd\xc0\x14\x03Y\xca>H\xdf,v\xfd\x8ar\xb6Y1\x0b\xcbU+zb\xa1\xce\xb0P\x95\xc2\x9b\xcaU\xd1\xdd\xb2{k\x89\x15\xa9\x8c\xa2\xa4\xae\x0b7\x0e1\x8b\x8c\x9c\xe4\n\xa6S\xd0\xdc4\xb0,\xd1\x11\xb1\xbep \xee\xbc\xde\xb0\x1b\x9b\xc3\x18W\x95\xd0\xd3\x02\xa7\xd4\xf9~\xc1\xa9P\xbcD\x9a\xfdD\x04\xcf\xd7\xf0=a	\x13\xba\xde\xfat\x12N\x85\x0b\x14K\x11\xbc\x07-n\x0e\xf1\xc7\x8aT\x9cwS\xce\x95\x93\x08\x98\xb7#\xc5^)j\"W\x90\xa6`s\x08\xee\x0c[\xfca\xe2\xd6\xbdf'\xa9\x9d&\xc1\\\xee=\x02{\xa9>\xbaT\xceH\xe9\x87/\xed\x05\xefg6N\xf8$\xc1-#\x9d\xb3\x93{\xaa\xd4\xaa\xd7\xf1\x98\xad\xc6\x0b\xcc\x91\x03sP\x03\x1b\xb5\xd8\xbc\x05\x177c\xfcm\xbeL\xdcy\x8b\xb5\xda\x1e{\x99\xb8\xe3\x16;\n<6oY\xd3\xab,cq\x8a3\xf4k\x8a\xce\x87\xe0-G-H\xcaPF\xc3\n,d!LJ]&Z\x9d\xa6R1\x18n\xa5\xe2\xa2\x96\x1c\xe1\x95]\x9e\xb5x\xd7y\xe7\xea\x8d\xa3\x83\xbbGp^q\xf5\xe0\xf8\xfa\xbd\x9b\x08\xbdw\xe3^\xfd\x9ethq\xf3\xfe\xbd\xf7\xeeHw\x1a\xf7\xef\xdf\xbds\xfd\x18\xa1\xd1\xe8\xe6\xe8\xc6H\x86\xfa7\x0en\xca|\xf7\xea\xef\x1d\x1c\xdd\x93\xa1\xf7n\xd6\xefJ\xa7\x1c\xe2F\x7f0\x92\xa97\x877\xdf\x1b]G\xe8\xfd\xab7\xde\xbf'[\xbb\xf9\xde\x9d\xfb\xf7\xef;=\xf6\xa2\xa5]z\xccZ,\xf7Z\xe0w\x0b\x9d\x1b\x8d\x86\xef_\x1f\xc8\xd0\xf0F\xff\xfaH*\xb6[\xe9y\x97\xb7u4\xef\x1eu\xa5\xc7v\x0c\xfdm\x07\x9c\x0fn\xcb\x90f\xad\xde\x9a\x9dK\xf0\x0e\x87\xef\xf5\xdf{\x0f\xa97\xae\xdf\x0c\x02\xe9SD\xbcws\xf8\xfe\x0d\x84\xde\x1f\x0e\x1a\x81lW\x047\xc4\xf5\xab2$\x86\xc3\x1b\x12\xe47\xae\x06W\x95_\x91\x1bW\xfb7\xfbr\xf47\x0e\xde\xbf\x19\xf4\xc9\xabI\xf0\xbe\x8c\x1b]\xbb9\xbav\xe0\xf4\xd8\x99\x01\xe5yK\xd2\x90\xc1W\x8e\xa0\x1d\x18'\xbe\xf3\xce\xd5\xabW\x1dF\x12%\xfe\xd22%\xe0/\x8d5\x11\x9f*p\xde\x11B8k\x06\xf7$\xe9\xb6\x14PT\xa4\x90\xac\xbf\xb4\x9c\x9d\x14\n\xaf\xd9\xae\x14F\x94\xeb\x0bJj\xfa\xbf\xb1\xad\xe0/\x15C\xd2_Z\x0e\x90MS\x05\xc2\xfa\xceZ\x8d\x06\xfe\x85M\x93\x05\x83\x0b\xa0b\xf8Cy\xa7\xd4\xc8\xcf[]\xe9+X\xbeR\xf2\xc4\x9d\x8d\xad\x99m\xbf\xa2\\\x9b\x1a\x1c\xdb>^m\xfaD\xcd\xe4\x83\x0b;\xbcf\x98\xed\xa7 N\xef\x1c\x94\xca\xf3@u|7t$\xddi\xa35\x90\x8a\xf0\xea\x84\xce\x83Cjt\xce;A\x10\x98\"G\x89\x00\xc9P[\xb0)wp\xcd\xc8\xa8\xcb\xff*\xc31\xe6k\xfeW\x19\x90m\x83\xe7\x7f\x951\xa1_\xfe2=\x9f\xf5\xe3\xa9\xef\x0c\xa4\x9b.gM\xfc\x15\xea\xc5ykM\x8c\x93\xe5\x9bN\xadu\x81\x0d\xb4\xdcyJ8\xef\xdc\xbf\xdb\xa8_\xaf\xd3N\xa9\xfb\xce;\xf5\xe3\xfb\xd7n\xde)\xefu\x9d\xcd\x8a\xb52\xaf\xd7\xebu\xf3\xacU\xb3g\xa6f\xf6g-\x9d\xc4\xa7P_\xdbP\x92\xd0N\xbaJ\x8a\x0f\xcb\x92y\x87\xd36y\xb4\xf8P\x04F\x01\"7\xbb\xa0\xbeU\x0e\x19\xde\xe9\x94\xd2[.\xb4,\xef\xdac\x1f\xef\xea\x0e\x91\xe1NZ\xfc9;m\xf1!\xeb\xb7\xf8\x19\xeb\xb4\xf8)k\xb7\xf8\xa2\x0dZ[*\xa4D?\x90/\xd6j\xf1\xe5K\x12fp\xae\xd5\xea\xb5k\xce\x9a=mq\xf8\xb3\xba\xab~\x8eZ\x1c\xfe\xa4\x8e[|\xf9\xf8\xc9\xa3\xe3{\x9d\xce\xa3'\xfe\xf2~\xeb\xc1\xd3{O\xfc\xa7-\xd6yz\xf4\xb4\xd5y\xda:\xf6\xaf\x8b\xabk\xf6\xac\xd5\xf9\xf4\xe8\x81\xbf|p\xf4\xc5\xa3O\x9f\xfaw[\xec\x83\x07\x8f\xee\x1c=\x90\xde\xaa\x8e?<z\xf2\xd4?j\xb1\xe3G\xed\xc7\x8f\x1e\xde{\xf8\xd4\xbf&\xae\xb2;O>\xed|(\x8b\xaf\xd9\x83\x16wNNF\xd3`\xdc\x8a`^\x93\xd4\x1d\x1c\xf6\\&\x14\xc0\xe3\xb0\xc7-~\xe57\xba\xc1\xfe\xcb\xa3\xfd/\xeb\xfb7Oz\xd5w\xaf4\x9fY4\xacZ\x1c\xf1;\x89\x0b\xcb\x96\x1e+&\x8cF*e4\xdaH\x8a\x04\x15\x02\xd1o\xe2>K\xd8\xd3\x13\x05\xd9\x87-\xfe\x81UK\xf3a\x0b\xcaW\xa3\x04F\xb1\x0d:)%'Iq\x9eDy\x86\x05\x81\xe9\x93t0\x11\xc3\xc5T9\xe0@\xc6\xee\xf3V\x8f\x9e0\\\x7f\xd7\xd2p*\xa2\xac)\xbf\x1f\xb4\xa4\xbf\xbc\xfb$\xfb\xce\x1e\xe9'@.\x9e\xa8^2\xdd\x07\xad\x1e\xdfk\xb0l\x12\xfe\xbf\xcc\xbd\x8bs\xdb6\xb6?\xfe\xaf\xd4\xbc]\x0d\x11\xc1\xb2\xe44\xdd^*\x88\xc7\xb1\xd2\xaew\xe3\xa4\x8d\xd5\xbd\xdb\xeaj<\xb4\x04Y\xdcP\xa4\xca\x87-\xc5\xd6\xff\xfe\x9b\xcf\xc1\x83 E%\xd9\xbb\xfb\x9b\xf9N\x1b\x8b\x04\xf1\xc6\x01p\xde\x87j\x01\xf8\xfe#s\x94\x18%\xe3\xff\xac\xbf+\xf3>\xf4\xc4!\x83\x0c\x1fhP9\x9d\x03;^\xdb\x07\x82\xacvs\x8b\xa3\x01\x08\x1e\x00_*\xba\x10\xdd\x8c\xc2\x02\xd4\xd8>\xd1\xe6#\x80\xeda\xba\x13\x9f\x7f\xd7\xc4\xa6\x9b\xd3\xa1 \x91\xe5\x97\x1a=J]\xe3}n(\x1f\xc6\xa3\xe3\xaa\x1b\xc7\xa9&\xa2\xa2W\xfdN\xc7\xed6\xab\x0d\xc2\x18\xae}\xcaz\x8b\xb8\xcc\x97\xf0\xb3\xb9\xe3\xef\x88\xdb6J?g\xa5\xb52\xd9~\xcf\x0e\xe6\xfa\x94Q&\xeb(S\xb4*6C6r}I\x1e\xb7\xe0\xb7\xbb\x17\x87\x9f\xb6\n\xdcI9O\x81\x04|v\xf4\x92\xb4\xb8\x82!\x92\xbb\xec}\xae\xc1\x8e&\xe4\xe9IsGS\x81\x15y\x1b\x1a>\xc8:b\xc6\xd6\xe7\x86\xe4\xd3<s\xd7\x98r_^\x91\xd0\x8c\xd3\x9f\x90\xa7l\x98\xf5,\xd8\xea\xdc\xf6\xdd\xc4q\xa8\x97\xd0f\xa9\xd4\x97j\xdc~\xc1\xff~\xc9\xe0|\xd3\x00\xbaxT\xc3\nt4\x065\x96\x01\x0b\xfc/\x83\xbb\xbbZ\xdcVx4p+\xaa\xc3\x7f\xd2\x80\x7f\x04b\xd5\x0bC\x96L\xee\x02\xc2\x0dm\x1a\xcb^\x9c\xde\xf9\xde\x9b\x8be\x98\x15\xf9\x7f\x99\x8c>\xfb&\xca\xbf\x19\xbd\xf9\xf9\xc3\x9b\x8b\xf3\xf1\x9b\x11\x82\xfc\xe8<\xdf<\xef\xf5\xa1\xf5\xa7`G\xb1j\x9cjk\\Z\x9a\x1e\x93U{\xd1\xf8l^c\xa7I/n\x04!S	y\x91<X\xc7\xa7\xcc\xf14i\x8a(\x0f\x93_(c\xdcP\x9aBM\xff\xc2\x9f+\xbe\x0e\xc9%`o\xbe\xce\x9e\x9e\x8c`\xa8Q\xfe\xe9i`\xaaV\xeca9\xbf\x08\x93\xfb\xb0\xce\\UZ\xe93\xfap\xad<-\xc9\xb9\x961C\x0e\xa8\x8c-\xd6\xb6VQ8/OO\x03^\xf4\x1at\x9d\xd8KyzjL\xb1\xef5r\xd8hM\xce\xd8\xf6\xfa\x0d^\x88\x1e\xd0\xf5\xfd\x1dxl\xbff\x8ef\xaf\x19K~\x7fW\x0d\xa4vO|2*\xb2p\x0b\xde\x83\xb8%\xbc#.'\x9c6\xc7!\xc5yu\x03\xe6\xc0\x14\x14\xd9\xd6\xe7F\x1f\xdf\xa7@\xca\xbc\xb0\x9d\\\x87\xc5R\xa9\xa2\xff\x9aA\xd9\xd4tP\xfbu\x16\xfb\xfc\xacjR\xe5f\x16\x97si9(\xb9\xe1\xb2\xea\x038\x818NyJ\x18>\\\xd6\xa4\xfb\xd0\xa2\xabE5p\xa3C\xefj\x19U\xabi\xc3\xf3\xfa\x04B\x1fhm^\xce\xe1\n\n\x8b\xb5\xeeEwI\x9a\xc9\xa7'_;d\x82qF\xed\x13\x19\xe8\x1a\xe9d(\xbc\xfc\xfe\xce:Mi,\x1d\xba\xe2\xb33\xa3B_-\x98\xaf=\xed\xb6-o\xafH\xf5\xc4\xf9^\x04\xfd\xad\x13\xaf\x0bC;\xe5b\xef\xe9\xc9['w\x10\xbb\xebU|\xb8\xf4\x93\xdaX\x8bFo\x07;\xc6C\xb3$M\x9f\xdb_\xb1\x0d\xf4\xe4U\x8a\xc5<\xb1\xb2w\x9e\xea\xc7p\x83[\xe6\xd7K\xb8\xdd\xd1&A\xf0d\x90\xd1\xdf\\\x1c\xe3'V?%Vt\xa9\xddr\xba\xbbh8\xf7\x7f\xbf\xac\x86B\x06\xf0\xd1\xc2_\xaa\xf1T\xbe\x00\xe7b\xd92q\xf0\x1f\xc4\xf8B}\x1b\xa5+\x15(\xfe5v\x17b8\xc6\x88\x12\xfbA\xce \xcd\x85\x14{\xd1\x83SF\x84*\xcc\xe8\xadH\xd7\x08,\x98\x8b\xd4_\xf42\xa8q#\x06^L\xaf\xca+#\x8f\x19/\x15L<\xce\xd3U0\xe7\xa8!\xd0\x15\x15\xe9:\xa0Z\xe0\xbb\xcbH\xae\xfd\xfc\x99X\xb2c?\xc4\x0f_\x08?\xc6\xc3\xb1\x9f\xe1\x87\xaf\xc5\xdd\x8d\xcf\x86\xeb\xde\x03\x1d\xae3\xbe\xee-\xf5\x99I\xe5\xef\xc4M\xe4\xaf\xdd\x85\xae\x07\xcc\xd6A\xb0\xe5\xc37\x7f\x95\xfec\xaeH\x9aM\x00\x15\x86E\xf1ly\x1c\xf2m\x00\x07\x99\xebg\xcb\xe3\x8c\x13(\x05Eo\x9e\xae\xe0\x05\xfe\xae\x17\xce\xe7\xc4\x02\xe6w\xbdL. -\xbb\\\x91\xcb\xdfB\xc6[\xc8s\xbe\x12\x10\x8d\xe2\x8a\x01h\x03\xbc\x85>\x08fir/\xb3b\x9c\xd2\xa9.\xee\xfd\xff\xc9\xb8WO\xf4\x98\x93\x91Tv\xf7\xb3\xdad\x9b\xb9\x00.O\x19\xabY\xb1V\xc9f\xd6\n\xf1>\xf2\xdd\x03\xa5\xd8\x0bM\x96\xb0\xc7D\x05\xf3y\xbf\xf0=\xbaYsO\x05\xe5\xae\xe74\x98O\xb1o\x9e\x88\x1b\xa4\xd3Im\xb7\xe0\xa6\x92EO\xe2\xe8\xa8\x9e\x86\xd0/\x065\xd6\xfca\xdd\xa0\x10&8\x8fA\xa3\xc8\x0ea\xef\x9c\n\xe14\xd9\xad\x12X\xdd\x93\xa8\xa7A&\xc6\xd8\xce\xca\x89\xb06\x8c\x1f\x1dE\xe6\x1ch\xb1\x081\xd8\xb9\xbf?e\xfcQ\xd3\xa4&\xa4\x9aQL\xf1v\x8ci%\x8f+\x83:h\xa3I\xc8\x1c\x1bas=\xeb\xee\xe92\xc9#\x92\xa3*\xf9\x89\x93\x16|\xa6\x94\xc7\xce\"\xb3P\x95#\xad\xaa\x06%\xdd6+\xafm\xf3\xce\x9a\xfe\xb3\x12.\x99\xb2\xdd5\xfa\xce\xf6Fm\x0b2P;!u\xd5\xc6\x17\x8c\xb9\xb3\x1akT\xaf\xce\x89L\xf0\x99\xba\xda\xd6Z	\x04\xde_B\x94L\xc2\xcc\xf3d\xae\xc38\xbb\x15}\x0e\xa3\x852\xdb~\xd8\x81\xda\x99\xae\xf7\x85\xb9va\x98`\xc8\xb3O\x99\xbezqq\xc2i\xf56\xbf\xba\xcbx\xd8\xc4\xd5\xb5\xd9thD\xd8\x04\x02\x00A\x1e\xb6Qkp\x87f\xc2(@\\\xc7\xc3\xcf\xd0nT\x8d\xa1\xde\xa8\xa4\x0e\x7f@%\xff\x96\xf9\xd2)\xefRtT\xf0[S\x90G\xbc\xb0>\x8d\x0e\xa0_M\x1b\xdav\xb4\x90B\xa4\xbe\xae\xa3n~\xe6\xa8\xc1\xe5\xe2\xbc@B&>!\x0c\x9d\x97\xdd\xddzL\xc7\xe2\x7f>\xedt\xfcL\xd4\xdaa\xbb\"\xa7\xd1\xec\xccRU\xc65_\xb3fv\xb9@AW\x86\xe4i\x15\x94\xc4bW\x95f@\xc83\x13\n,:\x04\xfa\x18D\xb4\xf0sX\xd1\xde\xdc\x84qt/\xa16\x98\xf7\x1a\xbdT\x15\xc5b\xef\x83\x9fq\x89\x90Ol\x18#\x8c\xb5\x89`\x91\xaa[4\xd7\\\x82\xea\xd5\xe0TPhh\xd7hKM\x00\xc4\xc8\xdd3i\xb5\xf7\xd0\xe1\xac\xd9\x0f3\xd2\xbd\x0f~j:H\x834\x1d\x84VU\xe1\xa7\x108\xf2\x81\xeee#\xcd\x80^c'\x1c\x00D(\xec\x15\xe4^'8\xeas\xd2L\x82\xb8#\xdc\x11\xd7!\xe2\x92\xf79!#\x04	v#V\x10\x81\x93\xe9\xf3\xc0\x00\xa5'\xbf\xc5\xea	\x0e1*+,\xef\xdf\xe8\xf7\xae\xdaN\xb5\xdb\xc1\xf4\x96\x17{\xfd\xb7\x87\x8f\xda\x98\xb5\x11|\xfe\xb4R\xfc\xce\xaf)\xb0\x03\xc7,\x93y\xf4I\x8a=\x9f\x107\x9f2\xfd\xcd\xd7\xdb\xbf>i\xd6\x07\x18\xcc\xb0\xa2\xe4\xee\xc7\x7ft:\x8d\x04S\x9eUW\xa4\xec9\xb1q}\x1d\xaf\x15\x8a`\x84\x1d\xb5\xf0\xd8\xa2N\xe7\xcb|\x87\xafd-(\xac\nl\xe4\xb7\xaa\xcfbO\xdf\xf3\xfaR\xc9\xd5\x11\xa1\xad \x9bn\xa2\xb7\x8c\xdb\x02O\xad\xd02\x9aK]\x85\xcf\xf8_.'\x85\xc6\xdd#A/zfp!T\x8e-A?6&HD<!l2\xd2}s*\xde\xd7v\xd8\x9bhZ!X*6W\x82\xf1F	\x81+\x9dF\xbf\n?J\x15o\x08h\xe1\x1b\xe8\x11\x89\xfd\xdd\x11\"^g\xe5UU\x12\x01%>]N\x14\x06;\xe5\x92*\xab\x070r\xea\xc1\x82\x13\xe3\x0c\x0e|-G\xe9\xe8H\xee\x18\xff`k\xa9\xf1N\xb4\xfb',\xe5\x99\xd6\xee\x90	z\xafz\x9b\xab\xa3\xae`\x81\xff\xab\xcb@\x82\x8d\xa0\x02\x1bP\x9b\xc4)<k\xb0\xa2\x8e\xfa, \xf7\x03\xfa{\xa7\x93\xde\xf4n\xb3\xf4!\x97Y\xefA^,Cc\x1do-\xfb\xe6\xbfg?\"\xab\xcf\xea\xec\xaa\xaa\xad\x7f\xb6&k\xeeU\xe5B\xa5enk\xba\xbdC\x83\xfb\x13\x96\xa3(/\xa3P\xa1\xb04Y\xf7\xc8\xb2\x7f\xfeT\x0cT\x84kF\xf3.\x9b\x1d\xaf5\xe6\xfa\xbb\xcb:K\x9d.\x8e7\x90\xed\xcf\xe2h\xf6\x11\xce\xefoc\xf3\xb8J\xcb\\B\xc1\xc4>\x97\x85y\x04\xe0\x99g\xd8\xe1\x9a\xe7\x12\x0e\xf4\xef\xe2\xf46\x8cUn\xa0\xdcrS\xacdRzS\x1c97`O\x12\xe8\xd5T\xcb\x1e.\xfd7\x97|\xcf9\xcd\xcd\xa7\xac\x87\xd7\xea\x8b9M\xcc\xcer\x14\xcdR!{\x05T\x03\x0b\x1cPN7\xc0g`\x11TY\x0c\x11\x91\x1a'\x14\xa9\x83\x07\xeb;R%Q\xa5P<u\xe2\xbf\xa9EI\xdd5D0\xab\xac\xd3\xc9\x1a\xc6\x87N\xb5\\=\x83\xa9\xc1\xc0\x11\xd3\xb77\xees\xd2\xef\x03\xa4\x80\x18Q\xfb\xceId\x0c\xfe\x8e\xfcH\xa5\x08\xc9#\xda9B;r*\xb2\xe8\xee\x8e\x0ce#\x1b\x0eW\xff\xf0\x87K\xff\xd3%o1q\xbeY\xc9<\x0f\xef\xe4\x85$>\x19\xac*\x9dl\xec\xb1V3P\xc1z\xc5\x04aU\xa02w\x01k8\xbe\x91\xb5P~\xb2At\xb3jGa\x9a\xc5\xeakQ]0\x99\xee`ai\x0f\x974\xff\xa2$\xa7\xfe\x8e+\xe3\x83&[\xb1\x1c\xe0f\xf0\xdf.\xb9\xa7\xed\x07\x0c/\x8f\x8ef\xfdL\x971XdM\xe2\xe4\xef\x91|p\x94\x13)\x96\x98nXM\x0c\xe3U)B\xa9\xbe\xa2\x84=\x9cL\xba\x8d\xed\xfb\xfb\xe5D{\xfe\x85\xc6\xc5\xd2\xff\xa9\x92t}\x80\x12\x16\xffD\x7f__\x82\x0d\xf4w\xfa\xfb\x13\xfd\xfd\x1f\xfa\xfb#}\xfd\x0b\xfd\xfd\x9d\xfe\xfeJ\x7f\xffq)\xac\xec\xa5\xcf\xffY{\xfb\x0dr<\xa9\xfa~cloo<\xfe\x07\x95\xfc\xdb\xa5\xc8\xf2\xe1:\xf7!.\xcc.\x19/s\xff\xef\xf0\xfe\x90\xfb/\xe4s\xbeO\x15}Q\x9d62\xea\xc3d\xaf\xe9\xe9\x10\xc3Z\xd3\xd6\x04\xe9{z\x92N\x1com\x9dl\x1d\n=R\xd1\x0f2/\xe3\xca\xe5L\x90\"\xcfE\x18\xcf\xca\x988\xad\xe4\x83\xc6k\xcb\x8a \xdeH\x96\xf3\xf7\xf7\xe4\x91\xe7\xcb54\xb2V5|e\xe9\x96\x92\xaf\xb7_Y\xd6\xc9\x08AZ~m\x92\xb5\xf3\xe7\xd6\xb2\xcdl\x1e\xfc\xa3\x15a\x90j\x8b\x11:\xd8\x82b\x87\xe9?\n{\xba)\xdb\xd0\xd3\xd3Q\xd8k\xd6\xd1\xe9T9\x9dNi^\xff\xb0\x8ak\x85\xb3\xf1\xe0p\xde'\xdae\x1dO&\xa6\xc8\xf1`\xaa\x8fS\xea\x17\x94x\x1dC\x0cE\xcd\xf8\xff\xa4\x00t\xab\xdcz\x90\xf2\x1c\xf8\x03\xba\x91\xf9\xe4\x8b\xfaq\xc7I\x9f!\xf04\xbaTi\xef\xccN\x9f\xbfx>\xf0H\x89\xcb($\xf4\xfb}X\xad\xe6\x1fuBvw\x1b\xfa\xa7/^\xf0o\xaa?\xfd\xde\x0f\xcc\xe3\x9fby/chNk\xcb9l\xaa\xdbk\xcb>\x84\xc7\xb7@\xf6l];SB\xf6\xd4\x03\xff\x14\x0c\xe4w;\xe0\x83(:F\xd1e\xb8\x86\xbe\x06\"\x85\x9c\xc3`(8./ONa\xe5\xe7\xbcv{\x03\x9e\x05\x83\xfe\x8e\xeb\xa6\xf2\"K?\xca@;g$\xa5\x8f\x0b\x18\xde\x92r\x9c2\xae%\xa9S\xf0\xa2\xb5\x13\xfd~\x1f\x9c\xed\xb4u\x04^\x02\xac\x80\xe6(\x90d\xbcE\xcf\xd6\x89\x8c\x8e\xbbC\x89\xa3Hiz\x07\x83>\xbd\xff\xa8\xa6\xc0\xce\xefgZ\x1f&=\xe5gH^c\x0e\x80\xa3\xf5\x10\x96\xc3\x87\xb2\xc3\xa3\x1d\xff\xf3g\x98\x8f\x1d\x03\x88f\x85O\x1a-e\x1cf\x97\xc9\xfb\xb2\x00\xdd\xf0\xb9Z\x9ci\xb5\xf5\xcce\x1cn\xfd\xe7\xfd\xfe\xa1*5\x1d\x8d\xa9\xc9\x1e\x0c\xfe\x1b\x12\x92\x9e\x80\xdd\x82\x87\xd4<@r\xab\x89\x1c\xf7\x9a\xd2,\"G\xb2wrj\x8c)\x8c\xd8\xee\xe4tH\xec\x105\xfc\xc7\xd9&\x90|\xb6\x05]\xabY-I\x8f\xa0\xa3\x97!\xa8\xad\xcd\xb7	\xe4q\xc6\xb7Ax\x9cqb~\x07\xa7\xcf2\xae\x98\xdfx\x84\xfde-{\x9fo\x83\xbe\xce\xea\xf6\xc8\x94\xa9u\nj\xe2f@>d\x1f\x8c\xcfr\xa3\xd6b\xbd\xb4x\x9c\xb0\x91Z\x8af\x949I;\xfe\xda-m<\xb5\xd8\xc2U\x82)kS\xa8h\x9c\xfb\x9e\xae\xfc\xfeR\xbd\xce\xc3\xec\xa3\xc77\x97j\x8a\xbe\xc5\x1d5\xbc\xce\x0f\xb9\x0f\xba\xcey8\x9f\x07\x07\x0dr\xc2\xf9\\\xe3Q\x86\xd4>\x9cWu\xd1f\xdf\xf3\x1f\xd3\xc8\xae\xbe\xdb\xecr#g\xa5\xcbF\xac\xcb\x15\xd3xn\x83\xbc$\xf2\x81\x03K5\xd2;\x1d\n\xe4*\xf7\x0b\xfe\xb8\x83\x17\x1bd\xff\x9b\xdc\xfe\x04\xcfV\x99\xa7\x91\xbd+0\x0f\"\x9er\x0f5\xec}\x0eE\x7f\x18V\x0e%C\x04M!\xd4\xd7\xcfE4\xc9D2	\xa7Sv\xe6\xfb\xa50Q5\xd9\x99\x8fx\x7f%\xd0\xd2I6\xd5\xfewsp\xaa\x92|\x19-\xe0\xf7#\xb0_4Vc\xb8@\xee\x9b\x9f\xf3\xd0\x86\xc7T\x13c\xa8-\xf5\xe6\x87\x95	\xa1\xeah\xaa\xfb@\x1d5\x18\xf9$$\x0eU\xd4drg\xdan\x95F2\xad\xcc\x8bs\x06\xba#JJ\x894;,\xd3R,`(k\x92\x87\xf1\xcbr\x18w\xbbLc\x87\xf3\xb9\xe9#\xf6{>\x89\xa7Z\xf8\xd0\xfe\x1d\xaa)\x8a\xe5\xfc\xd7K\xf1\xc1\x9fPt\xb1\"Z\x9b\xe8\x84\x08\xed[\xc8\x95\x0e\xb5\x80G\xc4\xcc\xd7\x92\x00J\x9d2\xfe\x0b\x14\xc3\x8a\xb1\xf0\xe4\xff\xf6\xff\xb7\xefq9\x16\x8f\x8b8\x0d\x8b\xc0+\x93\xb9\\D\x89\x9cW\x91\xe4\x7f\xc4\x97\xef\xbf\xa3\x88\x08g\xf47p\x93x\x94\xb4\x97\xbbL\x8a\xe7\xa7n\xa9*\x81k\xcf\x81\x01}\xe0I\xb9\xba\x95\x99~\x81r\xaez\xdc\xf1h,\xdaj\xfe5jV\xed\xa4\xf0\xe4p\xa1\xc1\xf7n\x7f\x9c\x14\x9e\x8e\xc5\xc4\xab\xf9j\xf1\x00\xe1\xe1JB\xd6\x8e\xe7hn\x9fH\x04&\xe7:^\xa9\x8a\xe3fB\xa5\xe0q\x06#\xb4\xeb\xe8\x93\xb4/d\xc5\x87\xb7y\xb4\"O\x93fSy\xca[=\x1e\xb2\xf0\xc1fC\xc3\xa3hu9G\xd8\xb8\x9bh\xae\x9f\xa7<D?oH)\x91\n\x85\xebu\x96n\xe8bzc\x13\xb3\xf0A\xbfLy6\xae\xee\x0b\xc2^\x88\xcb\xa4B\xdem=7\xfcOu\x14<\xee\xda\xf6\xb1\xde\x1e\x05\xb6\xc7\x8d\x0f\x8bH?\x13\xca46\xd3w	\xb8\xb7H\x18f\x8ax\xcc\x8c8\x9c\xa0\xcb\xe3\x88	\x97f\x18\xcd\xd3\x93_\xbd\x88\xdc\xf9B\xd8\xa6\x80\xa7\xce\xca\x93\xa5p\x9e	\xf1\xd2x[\xcex4\xc9\xa7B)\"\xcd\xe5\x06\x01@\xb5\xd4\xe2\xb2\xbeJ\x9d\x8e\x9f\"\xebd\xaa\xf5\x93*uL\x91\xe8C\xc5&Qp\x1d\x11i\x16\\\x9ak)\x93\xe6\x9a\x1a\x02[\x97\x8at\xecQ\xe7t\xa25\x15\xc6i\xbbYX\x9b\xa0\x15:0\xcf:\xac\xad\x863\x10W\xba\xd2y\xfd=%6&u#\xafr)\xa5\xfc\xaa\x1e\xe5;\xbbz\x8f\xac\xc3\xa0\xaa\x90\x06r-\\\xaceU\xfc\\\xa7~\xf2\x96\x12\xcd\xde\xb8mZ\xe0\x16\x03\xf9\xc2M\xbbh\x0e\x1a\x84\x94\xce`!\xb2jP6\xde\xf7 \xb9\xfaT-\xd5u\xb9Z\x85\xd9V\\\xe6\x0eO\x16\\\x9e\xdaZ\xc3\xde\xd2D\x1c\x9a\xd5\xc9\x03\x81\xc0\"\xf9XdcG\x9b3\x1f\x13\x9c\n/\xa6\x1d\x9e\x8f\xeb^\x9b@\xe9\xe7\xe3\x9a\xe3Q\xd1r!G\xf9\xbb\xf0\x9d_\x80\x11\xa9i\xff\xaa\xdf\xe4\x06\x02\x1c\xb5]\xb3&\x80Z[mm\x109\xb1\xcc\x06S\xda/\xd8t\xaf\xca\xfc}B\xb1\xc6\xabj\x0f\xd5j\xe6\x93@\x1a\x1bM\x97\xd4\x01_\x18U\xedz\xa4tzZq\xda\x0fT\xea\xfa\xdf\xd0\xcdG=\x99\xcc\xd2\xb9r\x9d6\x8aV\xef\xd2\xe2\xcd\xa6\xc8\xc2I1\xa5;\x0dV\xda*G\x15~\xee\x08B9y\x06\xb3\xd2\xc9\x94\x99\x9e\xc1l5\x99H5x0\xf9\xf6L\xd5\xa1Z\xe9\xc7\xa0.\x8d\x99(\xad\xcd\x0c*(\xe0\x00\x13\xe2\xfd\x1b|u7\xd6J_\xd46^\x89>\xd85\x8e\xd5\xb2{[\x0e\x00\xbb\xa1)\xc6\xda\x81=\x8dL\x1f\xe4Z\x86\x85\xda:\x8f;\x1e\x99\xf8\x06\x0d\x00\x1c\xe8\xceh\x8atT\xbbE\xc4_\xae&v\x9b\xa1\xabUxJ\x9f\xf5\x94\x9a\xb9\n\xb0dzR\xbf\x85D$\xa2\xa7\xa7\xc3\xf5\x1f\xde\xc3f\xe2\xc1\xe07\xde\xfe\x11\xae\xd2\x98\x86\x93\x02\x1a\x85\x0e;0*\x03\xbb\xa6\xf0A\x90\xd5\x8b@\xf9?\xcf\xf3v\xb3\x1b\x81\xb9\xee\xceP:e\x8d\xac	\xea\xb5\xd5\xf7*.\x1f\xb4\xcb\xba\"b\x9f\x1b*\xccw\xa9K\xad3\xd1\xd8,\xe0\x80 ,\x91dU\xec\xc1J\x8co\x8fU\x9e6\xc6`%\xf9\n\xee\x8c\xcen\x05\xb0\x1c\xf7\xa3\xf6i\x1d\xeb\xcc\xf6+\x1d\x1c\x08\xf5S\x83K\xe8v90\xc9g\xa2qN\xf3\xb98\x08\xa4\x0b\xfd\xa9:\xf5\xf9Z,\x8e\x07\\\xc5\xb3\xc9)\x90\xcd\xe3l2\x16\xd9\xe4n\x8a\xc8\x8a\xb3\xc9x*&\xd0/\x83v\xd9\x94\xe985\xf1d<\x1db{\xaf\xaa\xeb\xbdg\xd0W\x90\x03N\x1f\x14\x06$\xee\x18o+p9\xd7B\x9d\x9eE\x96\xc4\x9dj\xe6^\xc8\xf1dE\x07\xfct\x18N\xc6\xe8\x0f~\x80	P\x86\xad\xc0\xebdM\x18\xfd\xb6\xd3\xd9\xea\xb9|\x99T\xeb\xb4\xa1\xd3\xe2\xde7:u\xbe<^?K(\xa8\xd1\x8d\xe8\x0fo^\x9aB\xc3\x9bn\x97m&7S\xb1\x9d\xdc\xa8\xf6&\xeb\xa9\xd8\xec@\x0d\\\x8a\xc5\xb3dx\xf9R\x0e/\xbb\"ahV\xe11{\x95_\xa2j6l\xce3\xf5T\xb7\xb43}{\xa0\xbe\x11\x1a\x0b\x84\xe8V\x14\xc3\xdb\x97rx\x8bEx\xa8\xbc\x98\xfb\xb7\xfc\xa1\"\x7f\xae\x95N\xe0\"N\xd3\xcc\xbf=I\x18\xbf\x12\xb7\x7fJ\xf8\xa5\xe8\x0f/_\xe6\xc3K\x83\xf0a\x11/\xa7|DMO\xae\xa7\xfc\\\xb4\x9d!\xfe\x03\x1f\xf3[~\xc9\x86\xa3\xc9\xd5T\x9c\xd3\xcc^\x08,\xfc\xf0\xfc\xe5\xc5\xa4\x0f\x9d\n\xfc\x88s\xc6\xcf_]L\x06*a\x80\x84\x1d\xb6\x064\x0c2}\xa7\xbc\x15\xe5\xe4\x96\xd6\xe3A\x89N\x84x\xcb\xf4ur$\x1e\x08\xc7d\xc8\"\xde\xea7+k\xd1\x1e\x13U=\x1fE\x86\xc0\x05?\x8bp\xf2\x11\x9dG\x8d?\xb3\xc7\xb7\xe2\xe7\xc9\x95\xbaq\xde\x89x\xf2q\xda\xd3\xf4\xc8\x95,\xc2\xe1\xbbN\xe7\x9d1\x0c\x82\x93\x15\xc3m\xf4\xdf\n7}\xf2v\xaa\xfd\x95\xbf'\xd4O\x88\x873\xfc\x06\x0f\xbdhN\x8e\x1b\x85xo\x04?o;\x1d\x7f>y;\x15\xf8\x83X\\\xef\xc5[\x8egX&\xf8\xef\xbb\xb0q\x91\xb3\x9b\x1b\xaf\x8bDF\x9f\xba]FZ\xf8G\xa8\xc6_b\xb4\xef\xd9nw\x94:\x87\x14\xe4:\x90x$\xf6\xc1gM\xacS\x03\x11\xc1\x8f\xdcC\xbe>jL\x8a\xd0\"u\x10\x1e<\x84\xe9\xab9\xb25\xf5#\x0e\xb0\x1a\xf4\xa5\x88\x19\xaf\xce\xe6\x9e\xe3t\xd9\x9c\xcd\xaaV\xe4\x93B\x08\x02c\xc4T\x02LK\xf8\xb6\x07\x98k\xe7\\*\x06\xeb$\x9d\nD`U\xa2-\x93\xb3\xe8\xdd\x96\x8b\x85\xccx\x1f~\x03\xe8\x93\x81u\xca\xe2Kq\xae\x87\xcaL\xbc$\x1d\x88A\xd5nx\xc4U#\xa9a\xca%f\xcc\xfb.i\x8e|IJ\x88RGM\xa2J\x99\xc1w\xde\x85\xef\x8c\xf33\x17u\xc0`\x8f\"\x88\xf2\x9d|\x9a/\xe3\xc6\x9f\x94\xe6\x86r\xf6\xaa<i\xdc\x17\x90[\xc8?5\x12M\xcf\xd1\xc8$\x99b\xb6\xf4\x10^o\x8dV\xde\x97\x07c\x08\x97/\x8d\x07\xc8\x1a\x8aG\xcd|\xffV\xcf\xabn\xdf Bd\x987\xa7\x1f-D_h!9<7\xb5%\xc1<E\x98*3Ot\xb6\xe5\xad-N\xa6\xc3-\xe1\x92Zw\xa3\x81?V\x87l\"\xfa\xb8\xcd\x0d`%/\xd3a\x02'UZ\xc1@#\xf3~1I\xa6\\V\xda\xe2\xd1N\x93 \xd4\x07\xa7\x0b\xd5}d\x98x_\xc4\xe4y\xd4\xcc\xa9\x10\x02\xd55\xb9\xd7\xb5E\x15\xd5\xe1H\x88h\x82\x88\x97S\xba<;\x1dM\xe0\x98n\xe3\x13/\x98\x01\x8e\xa3\x81\xee\xffQ\xdfR$p\x83\xabh9w\x10z\xc6\x1at\x8cV\xfc\xa9\xb0\x84aeM\xa7\xf7\x8d\xb3c\xe4P\xc7g\xab#xU\x11}\xfe\xb0\xdab[2tRL\x0d%\x812f\x92\x14M\xea4cB_Vk\x9a\n\xd8g\xb1I\x7f\n\xbf\xe8\x93\xc1\x94\xc3\xa3^\xf62\x19f\xe6\xca\xd4qE\x0c\xd4\x1a\n\xc3\xdd\xd9\x19c\xc3\xfce\xda\xe9\xf8\xa9\xc8\x19\xcf_\x85\x14\xe81\xb7\x8a\xda\x91\x98\xa4<\x9c\xf2F\xbfD\xc4#\x03\xa1\xe7{\x04\xb3;\xc9f\xdc\xads}\x88\xe4Vd\xaa)`W\xcf\x87\x82j>\xee\xe5\x9fo\x14{\xb2:\xc5*\x1aU\xb2C$\xfeDN\xa1\xa4\xa2\xa6\xd8\x8c\xab!\xd0k\x1dU\x1bi?)\xa6\xa6\x97\x87\xab@\x1f\x7f\x81\xe6\xd5Y\xe8\xb7\xd6\xc2\x0b\x16\x1c\xaa\x1e\x1eb\xd4)z]:f\x81\xf6~\xebW\xaaj\x0e\xc4\x1a\xc8\x89D\x7f\x0f\\_&\xc3\xc8\x80\x8d\xc6\xfb!\xb4\x86\x96\xc5Pm\xb7\x14\xfc\x04\xd9\x15\xa9\x05\x0d\xdb\x89+9\x8f\xc2\xa4\xa5\x1f\x93\xa9\xc2\x1c!\x1e\xaf)\xc7\x83&\xae\xd8\x14:\xb0zat:#1\x99\xe2\x86\x9e\x85P\x81\xef\xe5i\xe6\xe8\xa2\xba\x1ea\x8achW5\xc6\xa2\xbf\x01\x9dO\xce\xfaA\xf2\xa7S!\x06g\xd1\x84\x02\xc9\x9e\x9cN\x03?\x9a$'\xa7\xd3.\xfd\x1c\x0f\xa6\xec\xe4\x94F\x92\xe9\xfb\xe8\xfd\xa2\xf5\xb4\xf5\xad\xca\xd4>\x97\x07;u\"\xa7\xa6m`K\x02\xb4\xac\x1ac\xc4\xce\x8e\x07A\xa4Y\x04s\xb9y\xbfx\x17\xae\x0e\x1d\xa8}\x1e\xd5\x17G\xbe\x8c\x86\x12\xc7\xb5^S\xe3KP2\xd2\xf0\xd1m\x9a\xeb\xe4x\xe0\xb6\xd3r\xc7\xb2\xc7\xc3\x87\x13\xc1\xcd+Q\xbfu\x9f\x9e\x8a\x97}\x9d\xe5xP\xd3\x8b\xd4\x87\x1b\xbc\x8c\xe9\xbbW\xa3\xbd\x9dN\xe4\"\"\x9dN\xe4\xf6\xb5h\xdeLU\xce\xe3\xc10y)\xd2\xa1\xb1n\xf0\x93n\xcaNN\x9f\x08\xa4\xe5$\x9c\xbe,X\"\xc2\xee\x80\x10m\x8d,L\xc2\xe9\xab\n9\x08\x87\xa9\x08\x8f\x07\xbb]sB\xd0\xd5\xf7\x8bw2\x84\xe6\xb93#nH\x96\xc9\xe7\x8f\xfbD#\xd4\xa4\xa2\x04{%\xf7@~G\xe2\x83\xde\xd5\xf9?n\xfe~\xfe\xf6\xd77<\x14\xc7\x03\x1c\xcb<\xafo\xb7\xece^\x9d\xd2\xb1\x90\xc7\xce\x86\xa3\x08M\x84\xad\x84\xb7\xb9\x1f\xb3a\xfc\x12\xad\x95/\x05Ni\xbf|\x99>=\xc5\x84\x1d\x85/\x11-\xd9OE\xc9C\x11s\x837\x82e\xad\xd9\xd2\x19c\xbb&\xeah!\xe2]\xae\xb7\xaf\x0e\xcc\x05\xda\xac	&\x16\x10\x90\xc1\xe1I\x98\xa9\xae\x7f\xd7\xa6\x13\x16JMS\xe0t\xd9i\x92\xe0\xeaF8\xa2^\xb6\xf3\xbb\xaa\x83(\x11\x8d\x1c\x93\x08\xa6wu\xa4\x05\n\xd9\xfbG\xd2\xde\xfer\xa0\xd6\xbd\xff*\x85\xcf\x8a\xc2\x07_b\"\xa7OO?\xe7Z\xd1\xd8~R\x14=\x87J\x9b\xe7\x99\x19\xbdt\x18\x9c\xd5\x85\xf7\xc6-\\oR\xdd08\x14[\x9c\xb0\xd9\x93\x9b\x80\xc5\x0d\x1b`\xc5N\xb0\xe2Ip\x03\x0b	)*\x07+\x0f\x0e\xe7\xa2\xa7\xa7D]\x9b\x0eP\xfa\x85X\xf8\x1f`\n\xca\xf7nDJaL\x03\x8e\x91\xb5\xb8\x90\nqd\xfe\x10\x15\xb3%\xb4\xe9ga.\xbf\xe9\x07Z\x199\xe2!S^\xc3\x86\xf4aP}\xb0\xab\x03\xaf\xb8PU\xaf\xe7<\xfdLN\xa7\xe8\xa0^T\xf3\x04\x0336\xb5\xb1\x10;\xebeJ\xdb)\x87l\xd6)\x9f\xa1\xfc\x90RC\x8a\xa2\xb1\x8e\xb7~\xa4\xfd\x8e\xe6\xe3\xde\x82\xc23\\\xcb\xb8~\xe0\xff\x07\xd7\x81\x7fq\xf2\x9d\x95r'\x1e\x9e;\xe5\x83_Q\x8a)\xe3\x19vNn\xd1x\xae\xe4\xb94\xc3K\xd1\x1f._\xa6\xc3\xa5\xd9:3\xc37s!oI8&.\xc6\x9c\xcd\x84]\x82eee\x06\xf9w^\x0f\x1am\x90\xc7\x92\xcf\xd9\xd0)\xb5\xe6Ke\xec`i\x01\x97\xeb\x06\x86[[\x05\x94\xbe\xe4\xa8\xc7,G\xc6v3 \x9d\x93\xb8\xdb\x9d\x8a\xb9\xdd\xcb1\xa1\xa55\"^\x18\xf8\xd0\\\x84\xb8]\x84\xe3\x8c\xb9~O\x9d\xbd\xcf\x83w9\x15\xdai\x14-\x96\xb3\xe2C\x98\xdc\xd5\xc9\x9b\xe6&4\x07\x97]\xad\x08\xc6\xf6\x05\xdb\xb3?\x8bX\xa7\xa3O\xa8\xa8b\xebj\xe2\x06\\!\xf6\xf8\xb5\x8b\x8d[CbqcQL\xf2)\x9eJ\xf54\xc0\x82\x1f\x0d\x9c\x8b\xca\x10\x19T\xf9\x0c\x87\xabZK\x87s8\x17\xf5;\x0dUO\xf9B\xf4\x87\x8b\x97M6\xeap\xd1\xedZtq-\xe6\x93\xc5\x94\xdfY;^wj\x8e\x17\xcf\x9al\xe3\xc6;\x1c\x1e\xf7\x87\xab\x97w\xc3U\xb7\xcb|\xffA\xac'\xab){\x85\xd0\xee\x0f/\x11\x8bP!I\x0f\x0c\x97Y8\xc9\x00\x053\xc6g\xdd\xeep	\xf5n\x03\x9b\xa7\x9f\x1fO>\xe5\xf7{C\x1cL\xa7|+\n\xf5\x84\x19\xdc\xd8\x97\xc1\xbf2\xf8\x1bq\xff\x9f\x9b\x03Z$5\x0d\xfcV\xdcLV\xd3\xa1\xffp`>n_\x89m\xa7s\xfbRl\xcc<\xdd\xee\xcf\xd3\x8e\xe6\x89\x98\x97KfW\x1e\x13\xa5\x9aMU\xb3W\xfb'\xc2\x8a\x0d\xb1\"\xf5\x8d\x9a\xf3+\xf6\x85\xf5\xb9r8[\xb56\xcc\xbc]CV\xd9\xda\xa0\x9e\xf5\x84\xa6\x99\xa6\xe2R\xc8\xc9b\xda\xd2\x91\x08\x1dyYL.\xb1tOO\x0f\xaf\xe8q0\xc5\x04\\#6\xdf\xee\xba\x9a\x8a\x96\xa6\xecQ\x92}\xf9(\xc9\xda\xa5\xc1_w\x94\x18\xf1$1\x0d[\xee\xf4\xff\xdb\x1d\x8e\xb9I\x9d\xd8\x90\xad\xa4\x14{\xd4\x16q\xe4\xc6Z\x9d\xa9\xc8\xc8\xc3\xec\xae\x84\x07\xd6\x1c\xe8FD\x91\x00U'[\xfa\xd7\xd2\xec\xa7\xdc\xff\xba\x0b\x8c\x0dS{\xbe\xd6\xa7\x88\xa7\xb5\xe9\xab\xb2\xa9c\xd8\x9e\xa5\xa1H-\x9dj/\xb9\xfa!\x823\xd0\\{e\xfd\xec\\\xe2V\x9c\x89\xd4a\xaa\xf0\xb9\xe8\x0f\xe7/\xcb\xe1\xdc\x05I\xe5\xa6=&\xf7\xecK\xc8B\xcc\x02\xfb\xc5d=\xc5\xed\xb6\x9c\xc4S1\xd7\xe6\xf4\xce|\xd2\x15i\xb9\xfew\xec\xd1Ko\xff)gE\x15c\xe2\x0e\x86\x9a\x10*\xdc\xf1;\x91U\x97\xfaj\x1f,\xe7\x8c\xdf\xbb\xbc\xc2\xd5I\xce\xf8V\xac\xfe\x94\xf3\x8d\xe8\x0f7/\xef\xccu\xb11\x1b\xe4F\x14\x93\xcd\x94?\x88;\xfc\xdc\x8a\xd9\xe4f\xca\xafE\x08\xc1\x0ev\xc0\xf5\xe4~:\xd9N\xc5\x03\xe3\x0f/o\x95\x88\x03?\x94\xf0\xeaV\x898\xf0#\x1e\xd8\xce\x90E\xdf(\x80\x80\x06\xe2u\xb8Z\xc7\xb2\x05.\xcc0*p\x00\xa1\xcb\xdb\x16\xcc\x19\xd1\xe0D\"z-\x84\xe9\xfb\x8b\xd5\\\xd8\xfa\xca\xa1\xc8\xbcq\x19\x96tdh/\xfb\xe5p\xdd\x05\x86\x92\xbf*\x8f\xd7\x9d\x8e\x9f\x8b\xf2x\xcd\x8d\xd4Q8<\xcf\x9a\x0c\xf0\xc0Z\xac\xbbw{\xab\xb1T\xab\xb1T\xa8J\xac\xa6\x96BelD\xe4g\x90\xb3\xed\xad\xa9\xbd\x19\xd7\xdd\xc4\xcf\xf8\x86A\xb2R\x1e\x0f\x18\xe3\x0f\xe2\xe6O\xcba<q\xe6\xe7\xe6d\xc9\xa6\x93\x87\xa9\xd8\xf0\xcd\xcb\x99Z.\xfc\x88\x0d\xe3\x9bW3\xb5\\\xf8A\xc2|\xb2\xc0\xe9vcW\xcd\x1cX\x0b\xee\xec\xbbyc\xaf\xbd\xcf\xf5~\xd7$Y\xc3\x03P\x9d\x1e\xb2zE\x86\x14J\xe4\xc37\x0f\xa9K\xc6\x8d`\xd6S\xc8\x15\x10Y	\x1f\xba6j\x9c\xa2e\xe6\xd1bq\xa0z\xb7\xd2\xeb\xdc/\xce\nGh\xe3\xb3\xc0h!\xb8\x89\xd5\xf1V\xf1z@O!\xf2m\xf5\xadFk\x91\xd5\x0e3c\xde\xf3XP\x1b\xb0VW2=\xc3p\x1c\x9e\xdd^Y-\x94 n\x1d\xfb\x1a\x04\xd0X\xfah\x97\x01\x11/&Q]\x9bR\xebK\xb9/\xa4N\xe6&T|\xbe\\\x16:H\xe7\x7f\xa0S\xaa\xa6\xb6N\xc5\xf4\xa5j\xf6\xae\xa5Y;\xe5\x8d\"\xa6\x00\x80\xe4K\x85\"\x9b'w\xa6\xbd\xb5$\x881\xf6\xd8VLD\x96qZ\xaf\x8f\xe6Q\xb2@\xb1\x02 \x93\xcc\xaa\xaakR\xc2\xbd\xde\x98S\xc4\xca\x0d*G\x10{}r\xf8\x13\xaev\x1b\x0e\xb0Th\x9d \x0b\xfa\xc4\x13K\x9f\x9e\xa2\xb3\xd4\xba\xf2\xd1\xc0!-G\xfb\xff\xd4\x18\x8d6\x15\xae\xaa\x8e\xaa\xb8\xe2\x00\xd7{'\x12\xfe\x0b\xacT- \x87\x00d\xc9d\x93\x92	\x81eAKZ\x80\xbd\xc6\xa1\x8c\x0cgF\x8aT\xc4\xe8D\xc4S\xfch1\x0b\xcd\xf4y\x1c7\xb7\x0f{\xac\x81\xbc\x05r\xa7[V\xa7\xaf6\x02a\xe2\xd8\xc6\xae\xe6(9\x98\xaa\x0c\x11\x85\x06k\x9b\xa0\x9d\xfb;\x1f+\x93D\xed\x7f\x1d\xde\xb9k\xfa\x93\xbba\x05\x82?\x19M\xc3V\x1ep]\x0f\x93\xac\xe8\xa5\xdb\x1e\x97\x07\x9a\xe0\xd2\xed$\x18z\x916\x00\xa2\x9er\x0f\xfe!\xd60\xd9\x94Z\xc8%{E\x16\xde\xcb,\x97~<\x86TnO\x15\xb2\xb6S\x0f\xf5\xbd\xb1\xf7*EJ\xb3\xf5\xc0\x86:T\x18'\xdf\xdco\x96\xacN`\xc5(\xe9t\xc0\x19W|\x01\x858\xe8\x83x\x16\xa7	\x8c]\xe28}\xd8g@\x9b#y\xe17\xb8|\xfb\x98&D\x85:\x18\x94R\x97\xcb\xc6\xc6\xf3\x81]\x0cR\xde(,\xf1\xa7w\x8b\xc1R\xde\xe6Z\xfae\xa6Qc\xacu\xd5A\x9d\xe8j\x07\x0c\x8b\xea\x96\xc5\xbdh\xfc\x03\xe94M\x07\xb9\x99\x1c\xaf2\x85\xbe>5t\xec\xf1!h\x01\x1e\xb2p\xdd\x1as\xb6\xea\x19x\xeb-4Dd\x89\x9a\x1bT\xb2\x96sUO.ZS\x1d\x0d\xc0F~#\x7f\xa1\xb9\x01X\xed\xe9RD\xed\xa4\x85\xbdB\xdd\xcf\x93\xc2Jo.|\x87\x0c1`1\xfep\xfe\xee\xfa\xc77\x1f\xce_\xbf}ss\xf5f\xfc\x97\xf7\xa3k1\xf1\\h\x81I\xb6ELae\x1d\xae\xbd)&\xeb\xe2/\xe7\xef~j\x14\xac\xd8x\x1e\xf7\x1c\x8e\x8e\xa74k\xca\xa6\xe2\xb9\xe9\xb3\xb6\x9d\xfb)\xf7\xa5U\xfa\xd6 \xa8\xa6\x8a?\xce\xa3U>\x92\x8b@:Zn#\xb2K@\x84\x8ef\x1aW*\xa3\xaa\x80}\xd6\x99\xddw\xb8\xe0%\xd1J\xadbJ\xe1*\x12X!Is6\x90\xbd\xda{\xfd\xab\xa9b?q\xc7v\xc3\xdf\x1c3!\xe5w\xd7\x99\x87\xeaX\xd8\xf1ZF\xb2\xfb.\x8a\x9a7\x86\xe6!\x92\xab\x0ct\x82\xd4\nk\xafR\x07\xf1.%>6@S\xbc\"\xfeV\xa7S\xbc\x14\xe0\xc94z\xa2\\\xc57\x1du\x1c\xae\x0eU\xe0\xfc\x9c\xf4\xa7g\xbd\x17\x81_\x1c\xe3\x91\x9d\xf8\xf8\xa0\x9e\x1b-\xe4\xb30\xfe\xda\xda\x8bgN=]\xfcmTV&Q\x9a\xb4(\x19\xb4T	\x96\xedKT\xd1\xe9\xf8\xf81\xb1\xe3&\x83\xe9+4B\xc9\x03$\x0f\xa6\xecp3P\xf1\xdc\xd36\xd6\xd7\xad\xd3\x1b\x9fN\xa1=y9p\x80F\xddw\xb2h\x0e\xa0\xb1\xf2j\x00\x95\xa0\xbcV<\xdf/\xbe\xa7\x9c\xad'\xb5\x92\xfd\xfa\x11M\x00\xec|\xdf\x85\xef|HV|h3\x08\xd9\xac>\xca_\xc7a\xf2\xf1`\xdf\xf4\xf7\xfdN5\x8a\x19{\x7fS@\xb4l\x01r\xa1O\xdat\x88 \xfe[N\x11\xc4\x7f\xcb\x0fG\x10\xff#\xd7\xd6\x1e\xaf\xb7\xf0\x80\x7f\x90\xde\xd2A\x04!2 L\x81'\xc0\x05\x16~\xc4\xbf\xadNS\xdc3\x7f\xe4\xfec\xa5\xe3\x17$<\x91\x12\xae\xa7\xc0\xaf\x0e\x8e\x12>\x97\xf3r\x1dG3R\x0b0\xbe>\xe6rn\xd3L\xb8\x96\xe5X\xfc\xe1\xac\xd2pIx\xfa{\xa5[S\xeb\xa2^\xe9\xbfi6t\x15\xb2k\xac\x8e\x8f\xf3\xc4t`\x7fdV\xbd\xc7\xe9\xe6\xb05\x1ag\xa7S\xa9gQ\x96\xa8\xd3\xd1\xe2\xd6\xda\x98L\x1e}vTs\xa1\xb1t\xdeH\x06*Zp\xa9y\xeef\x10vJI\"\xefK\x91\xe8a\x01\xbb\x8d\xce\xfc\x7f\xadv2_%\xa0f\x81\x14\xef\xc2w\x8c\xeb(\xf0\xb3\xb1p\xc1\x8e\xcf\xe9\xbd\xee$\xdd\xe83q\xe8h;\x86\x97\xd8#\x98\x16\xad\xcbU\x88\x96\xf5/v\x16\x01\xd4\xd5\\I\xc7\x14@\xdb\xb0@\xc9\x9f\xb4\xde\x9b\xc39\x1eLw\x08\xe7\x9a\xcb`\xffT7\x11S+\xb9\xd6\xd9^K\x0e\xcc\xc0!\x8d\x13e\x0b*9\xfa\xdco\xa9\xdb\xa8aR\xdb\xe0\x1e\xcc\xc6\xe6\x96p\x9c\xc9\xc0\xff\x10V\xe8H\xec\x0d\xd1]\x03\xdc7\xf6Vhkl6\xaen\x0d\xb7z\xb7\x07@Mq\xee\xb7\x95w\x865\x1b\xab\xeb\xa1\xd6K\xb6\xe3p\xd4\x1a\xcd>:\x01\xfd+6\x19\xe4\x7f\xd6\xacU-	6\xfa\xa4?\x1dF\xea\x8a\x1b\xb2\xc2\x08\x87x\xd4\xed\x1a\xe8,\xa8^:t\x82VE\x0d}T\xf9V\xc7\xe1\x8b\xf3D\xecQ\xb7\x93\xb5\x82Z\xbfl0=v\xe1g\xd2\x9fv\x07;\xder\xc58\xbd\xfa\x97\xaf\x98\nr\xae\xa4[\x13{<4\x96\x1dO\xa2\x99T\xd3\xfc\x9a\x9e\xd5\xb5\x14\xbc\xde\xb1\xe1|\xacOZ\xb1_\x13\x0e\xce\xf9XGs\x1a\x8b\xf3\x94\xaf\xe9\xef]\xcbn\x8c\xe0\n\xe6>\x8c=~c\x1e\x83~\xf5\xfcs&g\x11\xf0\xbd\xe0\x94\xdb\x8b-\xf8\x97/6\x82{\xb2R\x05\xe8\xed]r\xceg\xba\x8e\x9d\xa9\x0f\xf6\xce\xd8\x7f\x03\x89\xe0w\xe3\xb6\x8b\xda\x01n	\xe9\x18\xca)\x18\xbf4Srh\xb9\xcc<\xedx\xde\x96\xbb\xbadu>{TU\xcb)\xdap\n^/f\x97A\xfc\x02\x15\x86\xf6\xfd\xa7;\x16\xc5\x966Sm\xf2\xdaNl6\xcf\xeb\x1d\xb4-\xb1\xd6\xcd\xa8\x99\x808\xa5*E&\xcf#P\x8b\x94\xb0`mj\xa8_:\xd1Y$>\":\xf3\xd3S?\xf0\xc2\xb2HA\xe6G\x8eY\xc9~\x17x!\xd6c\xbf\xe0\x11\\\x03\xf1\x1fQ\xda\xdd\x14n\xb7\xa0JG\xa6\xb4/\xf4\xd5W\x1bt*\x12\xec\xf2\x84\xce\xa0\x85\x1f\xe5?\xe2\xf2\x91~\xca\xd8c\xfa\x12:\xff\xa98N9\xa2\x0b*^\x033\xee \xfe\x9aC\xb5\x86\xf8O\xc3\x03\xdd\x14ao\xaf\xeb\x8dYu\xb2\xec\xaf\x7f\xd83CR\xdbk\xb7s7\xfb\x1e\x8eQ\x1b\x19F\x03\x98%\x8c\x7f0\x85\xd8\xb3\x0f\x1c\x08(\xbf\xa6\x9c\xf1<,z\x8bhs\x15n\xce\xf0v,\xa2\x93\xd3\x80\xf6E\x17\x8f\xdc&j:\x1e_\xc4@O\xa4\xc5\xf7\x87v\xda\x12\\\xd0($\xfa\xb4Y\x84\xb1\x87\xb3k\xe3\xeb\xb0\xdeJ\x17\x8c\x17\xbdU\x94\x98\xed\x81\xb7pc\xde\x98\x16\xf0\xd5'Lw8JLC\xeb\xb1\xef\xc8\x0e\x90t\x92\xb2g)\xd0\x01=4\xca9\xa8rR\xfcV\xa4\xe8\x8c\xe4\xc1\xf9\x0b\xa7\xe6\x9d>5Wcm\x01\x92\xc3\x13\xce\x8d\xc7\xefkF\xec\x16\x8d\xa3#\xcd\x98\xbe\xbb/\xdax~;\x16\xda\xc1\x15\x18l\x81w\x1bf\x1eGD\xc3\xe0\xafW>\xe3\xe4\x11\xb1\xb6\xc2\xd1\xc2\x9f\x01\xb5\xe8t\xe6\xf8\xb1t\x93\xf10\xa6\"j\xee\xf9R\x06\xfe\x8c,\xaf\xc3\\\x02\xf1\xf6\xa1\xcbO)\xefa\xa7MIpb\"d\xcd|\x14\x9e\xca\xa2\x15\xb4,\x1a\xe9	\x88z\xf2\xec\xdd\x8b\xf2\xbf\xa4Y\xf4	\xa8M\xecC\xa0\x95\x9f\xf5\x83\x01/E\x19\xfby\xec\x93$\x0c\xcag\xca#\xb7\xd9\xf3\xe5\xab\xde\x8b\xa7'\xbf\x14\xbd\x17\x8c\xdb\xa8\xae\xceP\xb9\x13\x04\x1e\x8a0\x98\xfd\xfb\xb1\x7f\xfaL\x1b+2>\x07\x1a\xb10\x91\xa5\xb5\xd7\x8a\xa5(z\x89\xdc\xc0\x07\xc5\x90- \xa8\x04\xdd_\xf8!_2\xbe\x98\x0c\x8emJ\x86\x94\xb9\x88\x88\xbe\x18\xa7\xca\xc5\xf3\x82,x\xf8\x9c\xf1\xd9d\x0d\x81\xd2\x1c\xe7\xbd}\x1e@\xaf\xce\x8a\x19\xfcG\n5K%\xf3`\xc6o\xc3L9\xd7)\xab\x90X\xd7p%\x13,b\x1fqHa\x16j\xbfT\xb3\x16\xe4\xf0o\x0e\xf1\xf8f,\xdc\x1b\x88\xdf\x8c\x85\x05U\xfe\xa0_H\x02\xc7o\xeb\xec\x9aJ\x10IJ\xcaF}&\xea&\xaf^\xbd\x1a\x0ca\x7f3\x19L_\xca\xb3H\xa4\xddA\x90\x88\xd4H\xca\xa2\x1d\xbf\xa6f\xeb\xf7>\xdc:x\xed\xe8\x96K\xaa#\x98\xec\xdb\xfb\x98G\xd6w\x98\xa3+\xf8GJ\x1b\x91W\xeak\x9ag\xe2{e.\x7f\x1d_x\x8c\xfd\xdf\xcf2}\xa1\x1f\x8b\x1f\xbe\xffN\xbe\xa0s\xa6\xab\x9e\x19\xd7L\x0eX/Bj\xd5\xc7\xcb\xe0\xa4o\x8e<\xd3\xd7!\xe5\xb3\x01h|\xda\x11?\x96q\xfc\x9b\x0c3l%\xbc_\xa5I\xb14/\xf0\x8e\x06\x1b$\xb4\xacN>jq\xf7\xef\x9dn\xe66:x\xba\x9d\xa7\xfe\xc3\x98&\xf3$a\xcf\x92\x96S\xed<\xf5o\x90c`r|\xf1\"\x1c\xf4\xbfp\x13B\x80}R\xe8\x9d%\xa1H\x0bF~\x88P\xa0V}9|\x85\x1b:\x14Z\x8b+\x13WcC\x1a\xe6\xe2v\xec_\x8dy\xc8\xfb\xd0\xd6\x8d\xc5\xd5xb%\xc09\xcf\x8e\x07\x0c\x82\xef\x18\xbb\xca\xdb\xca0\xc3}\x1f+vO\xf9L\xfc=\xf5\xd3\x93\xf2\xa4\xc0\xd5\xae*\xd76\xb0m`t\xd6\x0f\xbe\xef?\xb3\xeb\xd8E\xf7\x9f\x9e\xba\xb8\xd4\x89@\x1fG+\xf9)M\xe4\xfb\xc5\x02\xfe\xe3\xd838\x93\x9a\x89\x89C\xc9\xdc\x8c}\x1f\xc5\x8e\x97\xec\xa4d\xcf\xca\xee\x92q\xe7\xf3\x03}\x1e\xb8\x9f\xa7C\x19\xfb3\x9e0^\xdb\x0bV\xdd\xce\xac\xa6(\xf7\xef\xf4\xd9a:\xb3\xfb\x81\xf0\x18\xe0\xf0\xfe\xc4\xd3T\xa0\xc7=K\xb2y\xd3\x9ap\xf7\xda91jL\xe9J(\xbc\x19O\x8a\xa9\x83\xc5:\x94\x1e\xf0imOp5\x16\x93\x89\xb7\\\x06\xabU\x90\xe7\x1e\x1f\xc8\xe7S\xee&\xbch&\x0c\xe4w\x8d\x84\xbd,\xcf\x9d,\xff\x9b\\]\x1d\xcf\xe7\x1e\xff\xbe-\xf1\xb9|\xd1\x96\xb3%\xf1\xbf\xdb\x12\x07?\xb4\xa5>o\xad\xe0\xcf\xa7m\xa9\xa7\x83\xd6\xcc\xdf=\xd7\xb9\xa9\xf5\xffM\xb6\xdb\xed\xd6\xe3\xb4\xed\xf7R\x07\x7f>\xfd\xa1%\xf3\xe9\x8b\xffn\xab\xe3\xf9w/\xbeo\xa9\x1a\x0d~\xbf\x97\xfab\xf0\x83n\xf1A\xca\x8f\x1e\xff\xbe\xff][S\xe8\x17\x15V\xb9\x06\xa7\xfd\xff\xd6m\xe8\x84\x1f\x06\xdf\xe9zV8\xd8<~\xfa\xfd\x9f\xeb5?\xff\xfe\xf4\x87\x1fjY^<\x7f\xf1\xfd\x0f\x07\xb2\xfcQ\x86\x19\xb9\xc6\xf9\xe1\xb4\xff\x83\xfc\xde\xa9x\xd0\xff\xf3\xe0\xf9\xf7\xb5\xb6\x06\xcf\x9f\xff\xb7\x1e\xdc2\x8c\x17\xc7\xb4\xed\xf9\xe0\xfb\xef\x06\xdf\xd7\x8a\x9e\x0e\xbe;\xfd\xf3iK7)\x93*\xf5\xfc\xf4\x07\x9a\xa8\xe9\xf0z\x1fk\xabx\xf18\x14\xae\xc7\xfe\xa3:*\x82\xc2hd\xe0X\xa8\xee!\xc3\x06\xbcl0\xa8\xc6\x8d\x1by4\x16\x1fS~N\xe4\xf2E\xedB~\xab\xdf\x80V\xf2\x8f\xfae\x9d>\xf0\x9f\xf5s\x9c\xde\xf1w-\x14v\x9c\xdey\xfc6\xcc\xc9Y\xde\xb7\x8eL\xad:\xb6\xd9\xe3oy\xbbdI\x9f,i\x16\xdd\x81up\x0d\x96\x0d]ow\xe3v\"\xb0\xe6^\xcc-\xb5\xcf\x91)*Nwe/\xb4\xf0\xc7\xc4\x19\xa5\x8b\xa5\"\x8c\x1d]\x14\xab\xbb{\x9e\xfa\x1f\xc7\xd4\xe1\x1e\x86\x07\x13ySK*\x12-\x83\x80H\xf4\xe6\x06\x18z\x94\x9c\xadc?\xe5\xe0t\xb3 \xe5&\xcf\xc0\xc9\x13nL\x9e\x01\xf2X\xff\xb3\x16O\x19k}\x8c[\x19\x1f\xe6_\x15\xe2\xb2\x8dm\xc5k\xbd\x05\xc3\xee+8\x1a\x18\xd9\xb0\x10?\x8f\xfd\x82\x9d\xfc<\x86\xe3?\x897i\xde\xc6\xe3\x8a\xe3\xef0\x12\x80\xe0\xd2\n5\xc9\xb8\xda\x12\xa1v.\xd1\xdd\xbb\xfd:\xd8\x10\x13(\xd0k\xc2G4\xda\xa3\xdf\x07\xda]B\xd4\xba\xd8\x9a\x1e\xd0|)\xbb.\x91]\x0b\xc3.Y\x83F\xeaO9\xaeF\x06$\xc8,\x84\x91\xbeP\x86\x012\x0c\x90A\xd6\x18c-\x9c\x8e\x1a\xd0\xd5\xb9c5g\xf2\x18\xfa\x10\xbc6\xcc&~iBa\x9b\x88AR\xda\xc0\xa6]\x8e\xdd\x9a\xdcif\xffqF]\x1bZUG\xa9\xf6v\x92C\"\xc3\xa9\x08\x8c\xbc\x06'\xfd\xa7\xa7\xe8\xa5\xe8k\x1a.\x11\x9fRc>_\x9cD\xcf\x92\x97\xa2\xf7\x02\xaa%\xcf\xc4\xa0\xcf\x86G\x8a%\x96\xb0N\xc7\x1a=%\xec\xe5\xa0\xf6\xfa\xaa?d\x94_\x93\xcc\x93\xf3\xd4\x7f\xeb\xa2\x8d\xfc<\xf5/\\,q:l\xa0*\x861Q!\xe5\"=\xcco\x18\x8f{U\xc6\xda\xac\xbb+Y_\xf2\xa1\xd4 \x14!\x9a\x8dz\xe0&-\xdc\xe8\xb4p\xf3\xf5\x08\xd0\xbb/#@\xce\x96\xb4\xc0\xc5\xf8e\x03-\x92\xc0\xba\xf8\xbb/\xf0\x00\xdei\x1e\xc0\xfb\xb1x\x04y\xd0`\xe8;\x03\xb7B\xac\xe2\xe9	\x183\xfc\xccg0\xa2 \xaa\xf0L\x82,\x08\xdc$\xb3\x0d\xa94\xe2X\x1b~\x7f\xd4\xe9P\xa0\x9f\xab(!;\xefN\xa7\xd2s\xb0\xcc\x06H\x88.I\x89\xcd\xb0\xccP\x85>\xe8\x88\x85\xeaG\xd8\xc2t\xec\\\x85\x9b\x7f\xb9\xdbo\x929:\x1dnL\xa7\xdf$\xf3/w9\xdc\xfc\x87\xba\xfc\x0e23\x04i\xff]f\xe9\x81\x03S\xf5\xddtJ\xb3!\x9d\x19\xeetjio\x929\xc4j\xaaAj\xe4\xc2\xc4\xe4\x81h2x\x8d+\x80\xb4$\xdaN\x0c\xd5\x98S\xbb\xe1\xde\xba\x1f\xde$s\xa8\x1fer\xbf&\xf6\xb8\x97[W\xd3LF%\x18\xccn\xc7\xdf\x8c\xc5\x9b\xc4\xe0\x0eE\x16\x85pc\xebq\xed0x\xb6	\xfa|V9v\xed\x1bo\xae\xfd\x1d\xbf-\xa3x\xfesX,\x1bc\xd1l\xc0\xdel\x03\xf7\x0b\xbd\xd9\x96<\x0d\x10\xbb\x86\xbc\xd3J\x1dB\xed\xe4tX\xf4\xe0\xdb\x7f\x9cB\xa5\xe98\x04\x15\n\x17\xc3x\xef\xa6<\xe9\xd6R\x8em\xca,Ns\x89\x86\x11\xc7\x90\xf1\x0f\xee\x08\xe6Q\xb8J\x93\xf9\xff\x1b\x03p\xbb\xdf6\x9c\x96\xc1|r\x07\xb3\x8e\x12\xbb\x12u\x07\xbb\xff\xca:\xa8ep\x07\xf1\xe2\xd9s\x1ej\xa3\x9ap\x83\x00;zI\xc0\x9aKONy.\xb2g\xd9\x89\x1f\x1e\x13\xa1\x9d\x1c\x87\xdd\xac\x9b[\x03\xd9\x1c\x04\xf7I\x06#\x00J\x98\xa5\xb9_\xb2g\x19\x9f\xa9w|/\xc1Ps\xbe\xf2\x85\xe8}\xff,\xe3k\xd1\xfb\xf3\xb3\xccY\xf8\xe3%\x8f\xbb\xd0\x0f\xeb\x85\xd9\xcc\x8fx\xcc3E'\xff|y\\\xf2\xd3g\xfa\xb9[\"\xcb\xad\xfc\x14\xc9\xec\xa2\xcc\xd4\x9cw\x97\xc7\xb3g\x0b\x94\xef\xce\x9f-\xc0\xb3:^\xe3oKV\xf5\xe9x\xd9\xad\x15\xa8\x1ao\xc0\xd4kw\x19\xc2,K\x1f\xfe\x03\x0b\xa1\xe6\x98V\x83\xd6\x81VdC\x00\xb5E\x88\xc3\x93\xe7\xcf\x9c-\x91r\x17\xfc\xd3n\xc6\xc3n\xe4\xa6\xe0\xfd\xe4\xbbg\xcf\xdd\xb4\xe3\x96\\-P\xf6\xf7\xb1xD\x86ZG1s<e\x8fio3\x10\x05O{\xdb\x81\x90\xdd\xe4\xe4\x94\xa7\xbd\xcd\xa9(\xba\x11\xd2NU\xda\x8egP\x898P^\x15\x17\x92\xa7:p\"\x8a\xea\xc0\x89\xc9\x8e\x13\x0b\xe4\xc3\xff\xb5<O{Ye\x11\x86\xe5>\xf9n\xc7s\x10\x8e\xed\x03\xc2F0\xe0\xaeK\x0c\xdb:\x19V\x9d\x0cw\x1c\xee\xbacy\xa0\x87\xb3\x0d\xe6\x83\xa6f\xb6\xb5\xb3\xd4\xec\xd6\xe9\x8e\xeb\xf3\xa8\xb5_\x87\xaai\x9b\xb2u\x94\x1c\xa8\xa3\xea\xc9\xe7k (\xfe7\xeb0\xf7\xc3\xbf;\x9c\x1d\xffi\x0c\x9f\xdas_\x81\xe1\xf5\xb5\x02\xa7\xdbk\x076n\xaf\xcd\xa2\xde^\xdb\xd5\xb8\xb63\xfaaL\x93\xf2i\xacG\xf6z\\u\xef\xcd\xd85X\xc0\x99\xfe\xd3\x18\x9a*\xc0\xb7\n\xcd\x9e\xfa\x1fw\x8b\xe7\xdb\xd5m\x1a\xdb=\xae^\x95\x0c\xc5\xe3\x9f9z\xe5\"\xcd\xe4\xeb\xac\xcc\x97\x07(.\xf2x?\xa4\x93\xdc\x04\xa0\xa5\xab\xb5W5\xd1\xe9x\x11\xc5U\xa4\x1c=\xd7	>4Mk	b\xe2\xbd\xe8\xff\xc9\xe3\xdew\xfd?yS\xae>\x9e\xc7\xd1]\"\xbc\x19\x85Y\xf1t\xe2\xdfeVD\xb30\xd6\x1fW\xd1|\x1eK\x8f\x1d:\xa5*}w\xb7k<\x15?\x8d'\xc9t\xa8\x9c\xf5\x1f\x89\xdaW\x881\x9f\x9e|\x95GxXA\xb8\x97\xfe;J\xf8\xb2\xb7\xe18\xd7\xccYg\x8f\xbfTM\x00\xa2\x04\xda\xae\xf86\x99\xe2\xcb\xec\x18\xffq,\x1e\x11\xfbEki\xea\x08\x11\xc1\xef9\x97Ix\x1bK\xa8GPb\xf0\x97|\xc7\xff2\x16\xfe{2\x0e\xeb-2)?\xc9\xa7'\xf5\xca\xfcG\x85|\xbf\x8d\xf2\xa2\x8dv\xff\x15\xa2C\xc7_&\xd7\xd2n%\x92!8\xfc%\xa5\x98\x16\xaa\xb5\x1f\xc7\\Ux\xdd\xe0\x0687\xee\xf0!u\xdd\x8eB\xb9n\xe9+)\xc1C\n\x92\xf3\xfd\xd8\xf0\xe9\xb71\x884\x83^V\x94=Q\xa8\xa4U\xc0\xf8=\xa4=\x11\xe3\xc9\x8e\xaf\xa2M\x94XM\xb7\x8bt\xb5J\x13\xadL\\\x1b\x1c&\xf4\xfd\x98T\x84`cVH;\x91y\xf0S\xaeG\xe0\xa4\x95vT\xb4\xf4\xc1e\xbcc\xfc\xf7\xb1\x18\xc8\xe3\x1f\x86\xe7qE\x0f\xd5\x1d\xdf\x9f\xc7|\xad,p\xa0,\x05\x84\x12Sg\xe2\xf9\xd6\x0f\xf8:\xab(\xd3\x8aj-\xbeI\xe4\xbeC\x8fHL$\x97S\x9e\x88\xc9\xb1\xe4\xc7\x12N\xaa'\x90'L\xa6\xc6\x1d\xe6\x9dLW\xb2\x80\xe4\x93\xe7\xa2?\xcc_\x1a\xe3\xb4a\xde\xed2o\x9d\xc6\xdb;r\xe6 \xb2In<Z\xf9Y\xe2\xd3+xTY\x94f\\]\xba\xb0\xd3\x89x\xca89\xbaH\xe0V\xde\xac\x11\x99\xf1\x83\"\x02'\x01l#\x01N\x06	9\xe0\x01\xa4\x1a\x1d-\xf7\xb7\x05)Zrd$\x96\xc71\xbd\"\xf71\xd2\x0e(q94_c>!7j\x0e\x18\xf3xd\xb5\x80\x1d\xc8\xd1s{4\x18V\xde\x1b\x94\xe7\x97\xc8L\x8e\xf5I\xb6\xf0\xdd9\x8a&\x89\x9a#syR\x82\x9d\xa5L\xbd\x13\x95\x1f\xa5\x19\xf5`\x14\xfb!w\xda\xb6\xd2V\xb5\x1c~vfV$\xe83Z\x14U\x08\xf3\xcf\xac\x07\xfdo\x0c\xcdz\xd47\x16\x93G\x83\x1d/L\x94\xc8q\xbaw\xc3\x1b\xf6\xe0\x81\xe9\x82\xd5$\xdd@'\xe6\xfe\x19Fg\x10\xf3'\":	Y\x10\x89\xf0Yb\xcd\x173\xb3lF\x06J2i\xe3i\xaa\n\xa7	\x8b\xc4x\x0f\xf2J\xd1\x1f\x96/c=\xcea\xb9?\xb1\xf1\xa44\x13k\x9a\\\xaaD;\xb93\xf5n'W\x9b\xd5.M\xad\xb0\xae\xfd\xc5_N\xe6SN\x7f\x1c\xe3Ke\xe3\xed\xcf\xcef:3\xa6\xda\xd8\xd5\xabjf\x93\xc5\xb4^\x13RP\x8f\xf9\xcd\xd9\xce7\xea\x11\x00d\xd6\x9b\xa5\xeb-\x06L\xc3U\xb7\x8c\x98\xa4\xbdM\xd7\xcc,.\xfcm\xd7\xcc\xef\xc9\xe9T\x9b\x10\xfd:\x16-G\xee\x054\x0d\xf8\xc2\xbf\xf3\x8b\xdeB\x86E\x99I\xc7\xb8\xa5\xcaW\x98\xa9\xdd\x82a[\x1d4\x9dN\xf5\xc5\xd1L0J\x97\xaf\xfa;\xc6[v\x93S\x03x\x11\xb6\n\xe2]:\xd5\xd0\xd92\xf4~vv\x83>.\xb4\xc9\xb7F\x19\xccv\xe1f\xe5\x02lpn\xd7-H\xb4\xc26E\"\xf5\xae\xca\xb8\x88Z*\x9d7C\xc3\x93\xc4\xf2KM\x15\xf5\xa6\x8c\x1e\x17\x9a\xd2\xe8\x0dy2\xf9\xe6<\xf6%\xb9K\xe5 \xf2fU\xa4\xf2\xd0\x99\x0e!\x11\x80e\xc7\xff1\x16\xef\"\x9f\xf1\x7f\x8e\xc5\xa4\xcf\x07S\xfe[S)@s\x18\xe6\xd1\xca\xb0&\x88\xd3\xd1\xf4+\x1a\x91Bl_\x9b\x97\x91\xdf\xae\x1cQ\xee57#y\x1d&sq4\xd8\x0d\x7f\x1b\x1f\xbaZ~\x1b\x7f\xe9d\xac\x04\x0b\x16\xe9\xae\xb4\xe9l\xfc\xfbp\xe3\xa4\x9a\xc1\x17\xaf\xc0?*^\x82\x16\xd1\xad4\xb8\xb8\x15\x10b\x04:\x8f\xb6\x98\"\xfd\x0e\xe2\xeb\xf8F7u\x9f\xe9\xee\x16\xd7=\xd5k\xa4\xf0\x0b\n\x8an\xd5\xb7jc\xd4E\x7fN\xfdB\xbb\xe5\xa6Iv\xa5'\xb5\xe9\xfe:\xc1\x82\xee\xc4\x10\x17\x90(\xe8F\x02\x1f\xc9\x19\xcd\x17\xcb\x1a\x97o\xd4\x1d;\x97\"\xa9\xb4\x7f\xad\xc3\x02\xb5\xc6\x9d\x8e\xd5\xbe\x86\x17\x11\xbd\x8d~\x8d\xfdHX\xcf\x8a<1f\xea\x8c\x8fS\xbf\xe0\xff\x1c\xf3\x08\xacSN{r\x9c\xb6\xf0\xd7\xbf\xd4\xb5\xff{\x174\xa7\x9b:\x12\xa1+\xb2\x02\x1a[\xbf\xfa\xeb\xa7l\xc7\xd7P\xd6i\xedc%.\xa3\xc9\xad\xe3ghE\xc2GT\x01KR\xd6+\xa2\xd9G\x1d\x03\xd2\\cc\x93DW\xfe\xcf\x96\xa5\xcc\x13\x81\xd84(\xd1vj>\xd2\xac\x05-\x90\xcaQ\x84\\\x8a\x06P\xf9C\x0ehm\xe9@\xdb!\xc8\x84\xff\x89\x8a%\xc9\xbd<;\xea\x7f\xe8\x86\x13\x04\x84\x8cf\x1f/\x94\x8e\xfb\xd6*\xd7\xa5\xc4]\x08WkBPu\x94j\xaa\xc4\x19\xb2\x05\xea\x8f\xb1\xb6r\xa0h5\xb9F\xb5oeLCm)\x80\xdc\xbd\x95\x89[O\xb9|\x0f|h\xddO;\xcd\xffr\x05\x98`]\x1e\xe7\x91R\xb5:\x80\xae\xea\xa3F\x8a\x03\xdb\xd1\x11\xc9t}\x07\xfa\xce\x06\xb8\x81\xfbV\xf1t`\xf0~+e\x01\xa2v\x8c\xd3\xdc\xce\xb8#\x809\x89v\xdc\xd5\x87\xb3(\xf6\x87\xb4@ +z\xb5\xc8Isi\x82}\xe8\xf8\xe6'\xbd\x9c\x14\x93\x19P\xf8\xc7X\xfc:\xe6\x7f\xd3\xd4\xf8\x84L\xfe\xb8\x07\xebT\x8fk\x13?\xc4\x14R\xee\x13\xe9i)\xb3\xa8\xc8=\xeeer^\xce\xa4\x9b\xed6\x02\xe7\xd5\x9b\x95Y\xb6E\xde\x9c\xb4\x12\xe9\xe9Z\x19;\xe0QQf\xf4\xf8\xa3\xee!\x9a\xc4\\\xa2\xb4\xf67\x86\x16\xc86\x11v\x882\xbbkJi\xfe\x06a\x8b\xd8\xde\xc0<\x81\xf1?\xae\x1a\xfafJ\x0b\xb2\x07\xee\x82\xc7\xe7\x12^\xffe2\x03\x992\xf1\xee\xb2\x08\x0d\xad\xd38\xcc\xbc)\x00\xe02\x89\x8a(\x8c[\xb6\xb1^\x14\x90\x89zcV\x94\"v\xd0\x8e\xeb\xfe\xbe'\xae{\xf0\xa8\x03\xd1\xf5\xf9\xa7\xe0\x94Wh\x85\xd2\x9b\x0c\xbcY\x98\x152\x8f\xc2\xe4\x14\xf1\xf3\xe4\x9dL\xe6\x7fA\xd8\xdb\xb7Q\xf218\xea\xf3%^\xce)`\x1d\xee\x85\xa3>\x9f\xc5\xd1\x1a\x01$\x17q\xfa\x80w\xda9\xc1\xe3\xda\xc6\xc9+\xd2\xb5\xb7\xe3\x18\xe95\x98\x0d\xc1\xa3\x0e\xd7\xc6\xf5L\xa4q4\xf7\x10\xd2O\xae\x83\xa3\x01\xcfWiZ,\xab\xa7\xab4I\x8b4\xd1\xd0\xa4\xa8\xfb\xc0\x93\xabu\xb1\xbd \x16\x98\xa7\x13\x11\xd1(\xf8N\xbf\xb8\x10\x98/\xd3\x07M8\"T\xca2}8\x8fc\x9d\xa04\xafq\xcb&rV\xbc+\xe38\xa7\xa6\xe1\xfb$J\xeeL\x14\xc0\xd0\x8c\xf8\x0d\xb8\xcbw\x81\x87\xe1\x90\xf2\xaaV\xe5\x8c\xeee\xa0g\xe7m\xb8\x95\xd9x\x99\xc9|\x99\xc6\xf3`p\xd27\x1aG\xdf\x8e\xc5o\x0e\xb1\xca\xffJ\xef\xa0\xee\xed\x00D\xebMR\xf7:\xe0K\xae\x19B\x18ru\x16\xc2\xfb\x98\x8aC\x98.\xbe!\xd0>\xb3wH0\xe9F\xbc\x1bMw\xc3o\xc7\xbd\x1b\x97\x98n\xa0P\x8aW\x06\xd8\xd1!\xb5\xcf\xe3\xd87(\xdbe\xec\x17\xfcx\x80\xffO\xf9)\x97\x8d~E\xdc\xa3@\x8c\x1e\xe3)\x1b\x86\xbd\xb0(2\xff\xf1\xd3i0\xe8\xf7\xf9\xac\x8ciF\xb1\x048\xa0\x82?b?\x01\xea\x19\xf6\xe6Y\xb4(\xc4\xdf\x8c\"Y\xc5\xdf1X\x1cb\x8f\x85l\xc7\xbf\x1d\xf7\xf2\"]\xab\x8e[(tF\xa0\x11\xc0\xd92\x8a\xe7\xe7\x85O\xc1\x0d\xd3\xb5\xcd	E3Tb\xa7\x1c,\xe7\xaa\xb8\xddN\x8dJl\x99\xbae\xe8\xa1\xecF\xbc\xf6\xed\xb8g\xa3\x00\xba\x8d4\xbbhB\x0c\x03\xa8\x97a\x1e\xe58\xf6\xbf\x1d\xf7L\x18\xc0\xaf*\xabp\x1c]2\x97\xc5\xef\xe2 NR\x15\x1fF:$'0\xae\xde' \\\x98bY\x8c\xb2\xf0\xee\x0e|,\xa7\x16\x83\x164\xeb\x90\xbd\xb9\xcd\x0dW\x07\xb32\xcb\xd3L\x14g\x1e\xc0\xc7\x03y@D\x87G3\xa2\"\xe3\xed\x19\xa8Z\xdc]\x850\x87\x1f;\xad\xady\x08\xf6=x\xdb\x9c\xe9\x13 \x15Ee\xf3\xcfC\xf1WR\n\x81\xb8(9\x12\xa2	V\xe0\x07d\xd6\x19\xf6\xa1\x06\xfe&\xe5\xfa<_\xe3.\xb0\xa1.\x9c}\xa3\xed B\xae\xad\xfd\xfd\xb893\xac\x1a\x0c_\xa4~\xcc\x1f-\xe0\x03\x98S o\xd6\x1f\x87\x9a\x17\xc52C\xdfy\x08\x9e\x9a\xee\xe5^\xd5\xbc\x04\x85\x10\xf5\x16\xe1\\^&|)t\xd5\xb1\xc6\xc5\xf5\xae\xdf\x0d\x11\xb6p\xa9\xb8\xbc\xe21]\x87\xb3\xa8\xd8\"\x17N\xe2\x9e~\xdf1\xae\xcb\x81\xae\x82C\xc1N\xc7o\xe4\x01\xf3h\x8d1,\xa9\xdf;\xbdS\xab`\xb5\"U\x17\xf6/c1\xa1\xc0~t\xd8\x83\x07<\x12\x93\n\xb2y\xed\x9b\xc47\xba0\xbc)\x8f\x1a\x19u:\x1dX\xee\xec\xd4\x00{\x8f\xc3R\x01&\xcfj@\xd1\xba\xd2\xfa\xb8\x1az\xd1*\xbc#\x06r\xaaQ\xf0\xb4W\xe6\xea\xc2\xf2u\x98\xd9w)\xed\xff\xe0\xc8D\xbe\x8d\xc9\xa3K\xcf\x0e\x88\x97*\x81\xee\xc8K\x9b\xbaT\xa9\xee\xbd\xc4gn\x9a\xd2\xd2\x85\x87\xabN'Q\x98'a\x8b|\xe1\xd4Wa\xa1|\xed$\xdb\xa3\x8d\xdf\xa9T\xb5\xfb\xa8e\x00\xd7\x11\\\xbf\xd6\x03\x1b\x19\xbfW\xb6\xf3\xd4\xd8\x99\xf3\x1c\xd8\x99\xa2W\x04z\x88\xc5\xaa\x8a.\xff\xcb\x98\x99\x0c\xd4\x10\xe9\xed\xe2\xdc\x9f\x020\x9d\x8c\xc5\xa8\x91\x112Y\xfa\xae=:\xf8z\xaf\xa9i\xf1\x18\x9f\xb5~V3\xe4Af\xeb\xd4.G\x10\xdb:	\xd1\x88\xf1u\xa3\x82:\xc2\xe21~\xd7\xc8\xa0&\xcc\xd3\xa6>\xa5\x8a<_\x9a\xe0<\xa9\x96\x90\xa4\xea2\xf32t\x14j(\xdc_>=\xf5\x99\x91\xff\x9e\x0c~\xe8\xe3\x9d\xcf\xc0\x1cQy\x0d\n\xe4\xf1\xc9(%_^J\xd9\x8f\xd3\xdb\x00o\x83)\x83\x19^UF\xf7\x86\xdfA\xfe\x90C?#N3?\xe7\x0e\xb8\\&\x89\xcc(J\xb3\xce\xa3\xa6V[\x0em\xdb\xc0\\ob\x8fi\xed\xf6m\xa7\xe3\xdf\x9b\xed/\xb6\xaa\xe4\xa6\xadd\x1c-\x8a\xdf=\xb87K{77\x9fN\xdf\x93:\x9b\xaefs\x86_!n:\x1d\xdf\xfd,\xd2\xde'\xf0\xdc>\x9dv\xc5\x86\x11\x12v\xa43}:\x157\xbc\x96\x17_U\x07\x1e\x14H\x96\xb9\x84\x9ff\x02\xf8a\x94\xfa\xf7\xbc\xe4s\xbe\xe0\x8f\xb43~\x94\xc5l)\xb3 S\x88\xe6\xc8x\xd9	\xa4\xc1t\xc7r\xe3\xf0\x19\xe8f\xd1\xf7\xf4\x83\xf22\x86\xda}\xc9\x82\x7f\xc6\xdawX\x94C\x16@\xe5\x8e\xfa<,\x8b\x94\xa6\x17\x86*<\xed\xa5\x8b\x85\xef\xad\xd22\x97\x00%\x8f\xb9	ea\xde\xed	\xa7\xdf\xcd}\x0c\x99-\xca\xd1\"\x89\x92\xcb\xd4O\xd5po\xc5\x1f$]\x89\x16\xfe\xba\xd3\xc9zQn\xc1\xf4\x0d\xc9\x90\xe6\xbeV\x96\xbb\xae\x8e<\xc7M{\x94\xcc2	_\x8eal\x84\x17\xf0\xe6w\x02\x1f\x7f\xea\xbe\xd2!\xa1\xc7\xa9\xaf\xaf\x07m\x90\x10n\xfcAo\xf0\x0c\x199\xfet\x9f3\xde\xf82\xc0\x97\xc1\xb4\xfb\xfc\x19\x85\xf0\xfb\xae\xdf\xe7\x9e\x8c\xc3\xbc\x88f\x14pz\xb7\xe3Wn\xaf\x9a=zzj\xef\xc1m[]\xc3\xb4\x97&\xee\x1c\xf3k\xe6\xa4\x94\x85\xc7\xafTBu\x8f\xe8\x1cz\x9a\xf9\x15z\xf4\xed\x98.\xc4\xf7\x0dga5\x86\x8c\x83\xb8\xb8\xf8Fd\xef\xea>?R^3?J\xb9& $u-}\x1dBt\xa9C\x00/\xa05cB\x86\xeb\xbd\x04\xb5\x1d=\xd5`0\xee\xf8\xde=\xa9\x03\xe5\x19\xb0\x05\xa5\xcbK\xff\xb7\x98g\x0f\x9a\xf8\x1e\x89o\x1d\"a\x98\x8c\x0e\xe1L\xecQ\x8a_\xe8tv\xc6G\x1e\xa3xR\xbb\xfa\x0c\xbb|^\x8b\xd7F\x87\xc9EA\xf2\x92\xa2\x84:*|\x91\xb9X?\\cE\x8b\x85\x9f2\xc2\xc0\xedrW2\x0ds`h\xf7q	A\xf3_\xb1\xafR\x9e\xc0=\x95F\xb2 \xb5@,\xc3\x84gl\x98\xef\x9d\x8f)\x9a\xd2\xde\xde\xac\xab-?\xe1\x08\xa0\xda3\x81\x85\x99\x9e\x87\xaa\x1f9\xd7\x90_Vq\xc4\xaa\xe21.\x9bf\x0fs6\xa4\xee-y\xce%;\xf3\xcb3\xbft\xe6\xd7/x\x0e\xeb\x9dE\xea\x97\xbc\xa2a\x97;hs\x07~i\x07\x02\xff\xe8\xcdQ,Mo\xcb\xd6\xe1\xe4\xbcd,0\x13\xec\x97l\xc7\xcc\xf3>\x92\xdd2\x9e\x82\x0d	.5\x88W\x85\xd8\xa3\xadT\x12\x83\xbd\xa7\xe3l\xdb`[Xx84IF\xbd(\xff\xd9\xfa\xeb\xdf'e\xe0\xb5\xcd\x02\\\xd3\xef^]D\x8a:\x87\xe4\xe2k\xcfWX\xd55Gn\xdf\\\x89\x08\xf1\x0c\x9bS\x08\x82\x90z\xe0\x1c%?gr\x1df\xf2WB\x01\x9d\x0d\xc0\x1e\xdd\xcdu=K\xe1\xc0\xad\xb4,^\xea\xa0\x13\xf5\x966\x86\x9e'\xa2i\x9b\xed\xec5\xa0\xa8\x92\x08{Lk*\x9b\x91P@\xfda\xf2\xb2\xe8\xc9d>L\x8cGV\xcd\xadl\xdb\x10\x10p$P\x1e\xd0\x02K\xc0\x91K\xedb\x1f\xfa\x92\x1b}dgL \xa4\xad\xcf\xb7\xda\xe8{Q\xfe\x13\x06\xf5\xf4\xe4\x17\xee\x84\x89\x02w\xe8_p\xeb\x10/\x02\x0e\xfa\x88\xcen\xcev\xaa\xa7JM\x0d\xf6Y\xc8\xc8%\xdd\xdeF\x0ca\xb1\x89\xf9R\xf3w\x88*\xa4\x8a\xac\xd3\x17,\xc0\x10\x82\x8c\xb3\xe8s0BCi\x01j\xa9\x1b\x03\xd1\xcev,0\xefj\xf1\xe8\xccKG-\xdc\x0b\x1e\xf2\x8c\xe7\x95\x807\x16i\x89\xaf\xc0M'S\xe3\x19x2\xadY\xd4N\xa6\xfc\x0e\x7fVB\x96P8\xc4!p\x8f\xe7\x90\x178\x86\xb6\xa2?\xdcV\xa2\xd3\xadY\xf2\x8d\x88'[x\x02?\xea\x0fuD\x84Mo\xb6\x9a\xab\xa8\x08\x9e\xf0\x02t\xe1a\x0f\xfa7\xbdh\xbea\xfcv\x0fb\xe8\xc3\x80\x0d}\xed\xef\x1b\xacW\xeb\x0c\x1d\xf8\"\xebt\xfc\x07qkH<\xc6K%\x8b{`|\xa9\x9en\x19\x9fi\x7f\"\x13\xaa\x0e\x11\xfbt$G\x95\x00\xb5\x8d;\xed \xdauFgZw\xc32x]/\xb8\x16\xf4a\xa8\x9bJ\xb5\x90\x89L\x81\xfd	U\xe1\xafll\xaf\x1f\xd3\x8c\xbe\x00\xbd\xb8f\xfc\xe0\xe7\xc1\x94_\xb3)\xb3\xfdnN\xc5\xb5\x0d\xa7k\x07\x94\x94\xfe\n\x04(\xbff\xce\x98\xae\x0f\x0d\xe7\xba>\x92c\xb5\x1az4\xfcJ\x145\x7f\xbd\xd7lxu$\xc4\xf5\x99\xaf\xc7\xd9\\\xb4\xeb\xaa\xafa}\n(\xa7\x7f\x7fh\n\x0e~\xb6S`\x17\xec\xdaY\xac\xd2\xbf\xe7\x80\xbfkf\x97\xeb\x8a\xb1\xe0\x06\xb2I\xa0\xd3\x0b\x95\xb6a|\xad\x9e\xd6\x1a>\x19\xdb\xad?\x13 	>\xda\x8e\xef\x10\x9e\xb8:\xd6.\x01\xfdc\xfc\x19\xe1\xcf9\xfe\\\xe0\x8f\x82|S\xb3\x82\xfck\xb1\x9el\xa7\xc3K\xb8\x97.\x11us\x8c\xa7%\x9eFx\x9a\xe1\xe9\x1cOs<]\xe0i1\xb9\x9ej\x95\x89Gp\xe0eR\x04\x97\x1c\xc6\xeb\xc1\x98\x93_\x019\x7f\x9f\\\xe8/\xa3*\xe9\x1d\xb2\x9c\xe3\xbd(\xf3\xe0b\xb7\xe3\xe1H\x14\x05\xcfFB\x16<\x1f\x89\xdfy<\x12?\xf1\x92:\xbe\xa4\xbf3\xfa;\x1f\x89\xcb\xa4\xc1x\x97\xb3cH\xc6\xc1\xbf\xb5\xeat$%\xcb\xe1sX3\x9f\xfb\xfa\xe1\x82\x94\x89\xc2(!\xc2\xa0\x8d\x1f\xdd`\x1c\x83\x8dM\xf8\xe0\"\x8a\xb58D1\x0f\x02\xef\xbf\xfa\xfd\xbe\xb7\xe3\xb7\xa4\x8cww\xed_&\x15\x92\xd7\xa3T\xf6EUi\xd5Q^W\x92\xe1\xa1X\x96~\x84\x83\xbb\xd1i\xba|H\xf3\xc62\xb7\xd5\xb18L\x11\xd83,\xfdh\x92\"\xd4\xd50=>V\xa0\x00\x8d\x1b\xfd%\x992\xba\xda\x86;\xf3a\xc8\x92\xae\xc8p\x98\x12\x9b\x98\xa7|\xc0\xc3\xde*J\xf07\xdc\xd8\x1e\xd8\x07\xc3\xbb\xe7\x8dNt\x07\xd0\xa1[\x1c^\x1e\xd2&\xd8_\x1d\x03\x12?\xb7,\x98\xf9v\xfdo/\xe1\x7ff\x89d\xcfvIu\x17\x81<\x11\x94O\xef$\xf22Q\x07)\x9e\x1f\\I\x1e\xe3S\xf2/-rh\x179\xa4E\x0e\xed\"\xa7/C\xfd%\x9d\xb2aZ-r\xfa2\xd4\xce\x14J\xb3\xd0)\x0fy\xc8\x07<\xa7\x85\xce\xeb\x0b\x9d5WvH\xa5\x12\x9ev\xcb\xe3\x01/y\x08YALEcS\xb4\xbeR\xfbu\xf0\xb4+\xca\xee\xa0\xa9\x16\x0e\x7f%Yc7\xabm\\\xf7\x9cf\xd0T\xa0X\xd9\x03W\xf6\xa1\xdf\xc6\xc3\x06\xaa#\x15\xb6b\xb0E\x85\x86\x8d\xb2\xf0\xc1j\x8b\xa0rB\xb0\x80:g2\x99\xcb,hb\x87\x06#lJ\xd2\x0c\xcdEm\x80\xe6r<\x96hN\xa4\x96\xf5Z\xc1\x98\x87Ev\xbf\x84\x99\x0c\xcd\x97R`\x8b\xa9P\x12~X\xbf\x97@\xe7hi\xa1Uc\xb0!\xb3\xa9c\xd7\xdb\xdcD\xfeq\x06j#e\x9b\x03\x91\xaf\x9d\x84;\xe2)6f\x82\xafT\x0f}\xcfJ\xc4<\xf8\xca?\x8a{Q\xfe\x0629\x9f\xf1\xad\xd0\xd6\xc6\xca\x10\xcec|#\xd6\xa5\x9f\xf0\x90K\xf5\xb3\xa5\xc0\xd4\xba\xa6J<\xe7\x91c\xfcN\xe7h\xd9\xe9\xdc\x00\x8eB\xd8\xa0\xdc\xeaN\xe0n\x1d\xdev:\xb7\x9fE&q\\\xc2|\xb6\x90+D\x02H+$\x92\xdf\xeec\xb6\x92\\;\xc0\x07-\xbfyz\x9a\x9b\xcc\xe0\xda\x00\x1d\xbeS\xa4\xf5\xb5@\x8fLw\x0b\xb9\xf6\xd8p\xd1\xe9\xcc\x88U\xech\x8e\\\x1b\x15j\xf2\x13r\xe6\xdfw:G\xeb33\xa9\x89|\xd0\xfaU~\xc97<\xe1+\x16\xac;\x9d\xa3\xfbN\xc7\xbf3-\xafYs\x114\xb7\xe1\x0e\x9d\xbf\x88\xa35\xae\x07\xff\x1e\xf3x4\xc0\xffp v\xd3\xe9\xcc]*6\xe4\x8fQ~y\x97\xa4\x99\x0c\x1eHB{Mg\xa9-\xd6\x07\xdf\x01$\xc1\xe7\xa6\x12xy]|\xa6\x08\x89Y\xe9\xeb=\xd38\xe06\xac\xd3\xb1\x1f\xf5]U\xc27\xd2J\xbb\x0eT]\xb4l.?\xa4y\x88\xf85\xdf\xb2\xc0G\xd0\x8aRlK\xbf\xe4	\x10\xc7\x0d\x9e\x91\x01\xf8\xcf\x02\xe3\xa7Q\xf8\xe6\xbe.w\x8c\xaf;\x9du\xcb\x97\xbd\xabb\xb3c\x8c\xb1\xc0\xff\xbf\xce\xd4\xe7\xfbV_a\xec\x16\x1a\xc3\x8aq,\xeeg\x00\xa0}]\xfbz]\xb5\x9f\n\xb1)}\xec\x84\xa7\xa7\xd0q\x11oe\x17\x8bJR\x91\xf9\xa4\x9b\xf3\xd6\x1c)>\xe3\n\x13\xd1bl\x8d\x8b\\\x914\xfe\xafi\x94\x04\xde\xad\xbc\x97\xb1\xb7\xd3m]\xdaMIg\xb3G\x18\xc4\xa5\x98\x97~#\xbd\xbe \x1am\xbal\xa2H\xb5B\x06\x19\xf0X\x1dk\xd2\x99\xdc4\x0f\x1brm\"\xbc\xd3\xa8\x1b1S}\xcf\xae\xf05\xd1\xca\x1e\xe3#\x84rq'\x83\x8e\xa1ss\x8a\xda\xc9\xb8\xe2\x86[\xd7\xfbs\xdbL\xf0q\xa7\xe3\x8f0\xe8\xb1\xdb\x7f\x8f1\xc6]`\xabFm\xbb\xa2RF\xff\xc9y\xd0\xa26\x9c~\"\xe4\xf5C\xdd\xda/\xdb\x1e(TClt\xfaZ\xbd\x1a\x8f,8\x96\xc4\xb5~!\xd7L\x9a	\xbf\x85%P\xbeN]O\xa0\xecq\xc7\xad\xfc\xbaJ6\x9a\xc7.\x0b\x10\\x\xd2e\xfe9\xf2S\xae\xb8\x80G~\xb8\xa7\x8f`\x1dz\x02\xfdx%\xb4\x83\xa2\xac\x8d\xdb\x15\xaaJ\xb4\x004\x17i\x83\xd5\xa8\xbf\x9b\x08\xcaC_\xa9F\xff\x06\x97\n!c=\xc3\xbb\x139\xcf\xb0f\xbf\x1b\x08V\x02o\xcf\x10e\xde'\x8f1\x9e\xf5\":-\x85\xa2\xbas\x97\xea\xceAu\xf3L\xdf)\x88`\x95\xee_$!\x18\x06Y\x9bv\x02\x0e\xcd&\x9b%c\xbb\xac\xd2\x0d\xf0\xb5\xec\xe9.s\xd0M\xfb\xd5\xb53\xd7S\xbf\xe3F5\xe0__\x96\x7fa\xfe3X \xe8a\x9f\xf9\xad\x83Fm\xb5\xd1\xe9k,c,\xc8\xac\x02\x83\xcf\xdaFh\xbe\xb6\x0e\xd0\xdc\x96\x0d+\xc0\x1a\xb7\xc9\xe2-F\x01Fv:\x0d\xcc\xcd\xf4G2\x8d\x07\xceG\xfec\x9d\xae\x80\xbb}\x92\x01\x80> ]\x95\x1d\xe3\xcdj\xb0h6N\xb4i\x16q6\xab\x9e\xde\xa5I+\xd5Vu\xf5\xae\xf2\x01\x19\x1d\xec)\\\xc0\x12$/\xf6{\xbaw\xabI\xa7\xef\x07z\x1d\x99d\xad\xa4\x8d\xe0b;\xde\xbc\x89\xf7\xe0\x08h\xbf\xd9\x9dUyTm\xc3p\xe9\ny\\\xd3\xbd(E:\xd2\x18\x00\x8e,^\xb4\x9fP\\\xf2\x06~\x1a\xed\x1fK0vY\x8a\xb2\xa7\xd9\x05|&\xca^\x93S\xc0\xe7\xa2$\x17x|\xe1~\x05\xd3`\x98B\xf9\x01HDU\x05\x99\xcd\xccT\xda^U\xf4q\xae>R\x95\x94\xb0h\xe4~g\xbe\xe0P\xa0\x15\xea\xdd\xd8\x83\xd66d>\xe9\x0fK\x88\x142\xdeX\xd1\xf9n\xc7c\xc6a\xb0\x93;\x17\x8b\xfe\xba\xdc[\xef\x19\xa8\xe6\xd4\xcf\xf7\xea\xd9\xcb\xb9\xa0\x9a+\xf6\x8e\xe6p\xd2\x0c\x15en\xa2/\x1a\xae\xceJ\x9b\x82\x08P\x10w\x93\xd5T\xf10Q\xf2\xbe\xe2]V\xc7\x1de!V\xe1\xf0\x1e\x18\x18q\x9f\x1ee\x1c\xdc\xf3uq9\xdf\x04\xab\x1d\x8e8E$\xa4Y\xde\xe98/\xba\xd1Z\xda\xa4?\xed\xcdK\xe8`V\x08h\xc5\xc2-D\x7fXT<\xa8\xa2\xdbe\x08\xae\xdd\x93\xf1\x1eO\xbb\xb9$\x13\xcaH\x9d\x9a\x92pA\xed\xb3CgJ\x0b\x07\xbb}\xa3\x12\x17\x9a7I*\xb3\x8bq\xe6\x93\x82\xcegI\x95\xa4F\xaa8\xfcn\x1e\xed\x1f\xb6IE\xaa\xd47d};6\xc8>\xfb\x11s\xa1_\x1a\xb0\"\x1a\xe2\x12\xa3\xad\xb8n2\xd7\x8d\xbe\x9b\xeb\xc2\xb3\xe0k\x99\xc1\xe2\xe8C\xf8\xa0\x101\x1c\xa3MG\x9e\xae\x9d\xb3K	g\x16\xdd\xd4\xf4\xdf\xd3\x934\xe4\xb1IT:\x8e<\xae\xa7\xd6\x94\xb3\xa2\x85\x1f:\x91\xb5\x1e\x95\xde\xaa\xd6\xf0\x8c\x9e\x9e2\xad\x14\x1a\x98\x07\xd4\x19\xe4\xbcYU\x00\xb3\xc2\xa3\xa4\x17\xe5j(?R\x08\x119\xb7\x9eGK\xd1\x12o\xc5\xc42{\xc4\x0c\xbe	g\xcb \xac+\xdc<=\x95g\xa6\x98\x92\x88a\xab\xb5TT\xb1\x13\x14[\x9a\xb4\xe1q\x82;\xf7\xf9\xcfa\x16\xaer\x08\xa4\xac\xa0F\xab3E5\xadP\x0eG\xbd)\xa3\xf87\xb2\xde\x1f#\x83\xb2\xfcv\xad=\x03\x8d\xa9\xb0\xa6\x1bc\xcc\x8e\x01\xceq\xeb7\xcc\xa4\xfa^\xb6~w\xd6	\xb9\xb4\xe2H\xd6\xe9\x1c\xec\xbd\x07LJe\x8b?\x93M5\x1c\x9b\xac\xe5g\xb2\xba}(\xd9nGl?8 \xdd\xf1;\x17\xc6\x0f\xc0\xf7g\xdc\xd3jd\xc4\x81\xe8\xa8\x8d\x17\x84\x15]Gk\x893\xe4\"M\xa0C\xd0#_\xaa\x80\\- M\xc9l\xa2\x8a\x1d\xd3b;\xf1M\xc3+-\x08S-!\xe9\xf3S\xa0\xdf\xa9>!\x89\xb7x\x90h\xfa \xf32\xae\xc2(U\n\xc4\xbf\xe7\x90SBl\x93\xeax\xaax\x11\x19\xe3\xfa\xcb\x00\x11\xbf\xfa\xfa\xcb\x80\xbe\x84\x9d\xce\x97\xfc\xd9b\x97\xcbd~\xac\xe5\xa6<'=\x1f\xf9Ps\x17\xecg\xcfB\xb5\xcbI\xb8\n[\xc5JT7\x8c\xb5\xa856r\xb7\xb9\x89e\x1c\xb2\x85\xb6\x11A_\x01\x10s\xa1\xbdG-\x18N\xe0\x16\x7f\xb73\x15\xaf\x8c*Z\x88%\xc6X\xafb-\x96\x93A\x958@\xe2\xb0V\xaf~\\\xef5\xb14M\xec\x923?\x9f\x94\xe4I\xf7\x0c~u\x83w\xe1;\xee\xa4\x0c(\x85\x05v'h\xca&\xe6s0)\xb4\xe8\xeb\xe9i\x82r\xef\xc2wS\xb6K4\x94\xeb\x9c\x1a\xbeil\xb9\xc7s\x08i!\xa7]\x8d\xc4#\x82\x84\x85w\xf5{\xce\\\xa7R\xf4\x11k\x1fr\x04\x12bk$\x00\xcc~\x1d\x82\x05\xcc\x7f8\x90\xee\n<\"(\x81\x05\x12\xb2\x169{\x17\xbe\x0b$\xec?\xf2r\xf5\xb96\x86QU?j\xa1\x1a\xa3\xe9\xd3S\xdf\xd4'w|\x15n\x0e\xf4\xf3xp\xd2Z\x0d\xeax\x05\x03\x7f)\xf0h;g]rKv&1\xbd\xb0IO\x0eT\xfe\x99\xba_~]\xdd\x89\x0c3\xd9n\xb8\x0f\xe9\xe7n\xc7\xef\xeb\x97\xa8\xdd\xca\x8e\xabU3\xb0\x93S\xb6\xe3\xdb\xfa\x81\xa4\xae\xe6p#\xf3*D\xde<Z\xc1[\x80\x0d\x8f\x07\xf3I\x18jy\xden\xb8\x1dU$d\xdd\x16~;\xd2\xf6\x16\xd6\xc2\x83\xdc\x1d\xc3X\xbf\xb5\xfb\xb6a\x18\xb0\xa8\x9c\xd2\xc9i3.\xfcZ\xaf\xf8mi\x0cOt\x99\xd7\xdb\xa6+\x82\xaa\x11\xb8\x93H\xdf\xa6\x0f2\xbb\x08\xe1e\x9e\xdfY3\x16\x14\xf5\x1dC\xd9j\xea\xb4}\x9ba\xbe\"\xaa\x15\x0f\xe7\xf3\xbd\x91\x98\xc3y\x1e\xad4\x0e\x87y\x84\x9b\x8d\xa2>\x93Z\x10\xce\x0eY\x1b\xda\x96+DI\xc7\xd4\x82\xe5(l\xb1=\xa7\xa0w\xd04\xf0+\xeaql\nQ\xcf^\x9e\x03'\xac\xd5\xb8P\xa0\x81\x0bg\xdf\x16d2\x0d(*d\x7f\x98\xbe\xb4\x06\xf6\xa99PaC\x9fZw\x05j\xaa\xc2\xe9\x90B=f\x139\xf5\x8bI8\xb5\xa1\xb3\x93\xdd\x8e_\x97\x87`\xed\xbal\xc2\x1a\xac\x89\xaa\xbb-\x98x\x1b\x8f{[e\xdcd\x9c\xb4Wc\xabOT\x1d\x8e|k\xb2\xc9\xc8\xe9\xb1\x0b/\x1a\xd4|\xe5\xcd\xbb\xf9=\xca}o\xe3U.\x0d\xe8\xd8<H\x078%x\xd4H\xdbVW\xa6\xb4\xc6\xb7\x92@y\x16\xc6\xda\xa0\x11\xf7$\xdd\x0e&C\xd4\xcc\x00e\x0e\xdb\x9b\x86mW\xcb\x14\xe8\xfe\x9b\xfaP@\xb5\xd2\xe9\xd43m\xf72Q\xc00\xe7\x96\n>\x13\xb7\xd4m\x8b\xa7\x8d4g\xe4\x11\x99QC\x89\xb9?\x85\xe1j\xfaS\x9c\xde\x9a\xd1'=gG\xe8\xb9 ug\x916r\x1a]\x12wRx\xb4\xe3d\xb3\xd9b\xf0\xe6\xaa::=Ug\x02O\x1a_0\x15\xea\x8b\xf6\xffoM\"C\x91\xd4,\x963\x11\x99pD\x98S )\xf6\xdd1\xcd\xa6\xf0\x80\x13Xq\xf7\xa7\x95a\xb7U\x9e\xb5)\x15\x16\x05t\xd6~\xaf\x92\xb5\xf7\xd2\xcfT\x11\"o\x88\xbc<w\xaa\xa8\x92\x11f\xea3\x16\xbe\x07f\x8a'\x9f\x81fwtQ\xcf9\x8d\xf6\xa1\xb7o\xc7\x90\xd42&\xcd\x8c\xa6\xa7n\xf4\x85\xaf\x81t\x08\xd3px\x9fy[/\x00\xe4\xef\xa0){]\xf2\xedH+\xac\x8fD\x9b;\xad\xdf\xc6M\x8e\xa2\xa6\x98q&\x89\xe4\xe9\xc9#\xce\xb7\xa7\xdd\x82k\xce\x01\x02\xf1z\xb7iQ\xa4+o7\xdc\x1c\xbcG7#N\xd6\xa6A\xdf\\p\xef\x138h|\xbf \xb2\x82\x93-\xb0z\xac\x99\xc6\xee\x89\xa7k\xad\xeb\xe3\x84l%1\xee\xaa/x\xa3\xb9S\x9b\xa6i\xc9_\xe7\x88:\x00m\x0f(\x00*}\xac\xef;\xc7\x83n\xebW\x80]\xa1\x82	\xe8\xc0A\xb2\x8a\x06sx9\xb5R\xe6]\x16\xcd\xeb\xe16>k\x8c^wfP\x01\x9d\xeeY\x0d\xee4d\xe8\xe0\xb0\x08\xbe\x81q\xb0\x1dw\xf3\xa9\x05\xa8\x8d\x89\x92\x00A\x9b\x11\xffM;8\xba\x19\x89G\x88\x9f\xc1\xb3p\x0cc\xfb\\\xfb\x84\x80)(\xd0\xab\xc0\xf3\xe8\x17\x1d\xc10\x03\x8fl\x81\x91\xe4\x1a\x9a\xe2}\x9c\x95	\x82n\x05\x8f\xabp\xa3\x8c\xb6\xd10\x97q\x1c\xad\xf3(\x0f\xbc^\xafGAOf\x12\xf6\xa12\x0b\xbc\x9e\xb7Se\xe5\xa6\xd06\xb2*\xe1\xa7p\x1d\x0c^X\x1e\xef\x80kS\xc17\xf7\xe6=M\xe3\"Z\x07z\x14\x83\x1d\x87\xe98\x9d\xf12\xc3u\x8fW\x88\x03M\x8e>O	\\\xab'\xac\x0ei\xcb\xa9~:v\xba$`\x0c\xbc\xffz\xfe\xfc\xb9\xa7\xddS\x0e\xf4\xc5n\x8dv\x89A\x10L\xb4|Q\xfdL].\xcbd\xd0\xe7\x83\x17S\xd5\xb1q4\xfbX\xf5Dy\x15\xc30\x14\xf6\x1d\xbc\xd8\xb7\x12\x1e\xec\xf4\x18\xa0\x88\xdfV\x94\x0cr`{\x8b\x19\xb8\x8a\x12\x95\x91\x86B)\xe1\xc6IY\x85\xd9]\x94\x04?\xf0E\x9a\x14\xe0 \x04\x83\xd3\x1d\xa7\xd8\x15\xf5Ir\xfa\xa1fa\xe2\xfd\xd7l6\xf3\xa6\xed\xf3\xa0\xeb\x80\xa8\xd1\xf4q\xc0\xad\xfaFUGvw\x1b\xfa\xa7/\xfa\xdc\xfc\xeb\xf7\x9e3\x98\xaaSz\xbf\xcf\xcd?J\x9f\x82\x96{\x18\xc1\xf4\xfdad\"\xb9Q I\x91\xf8\x8f\xb7 \x1e\xc2l\x0b\x189\xea7\"?\xd2h\xf7\xc6\xa5\xc1C\xadB\xdd\xa3\x02:\x1ci\x9f	\xda(\xba6\xf1\xcdo;~3b\xfca\xd4\xb3\x01^\x9a}\"\x03\x08\xee\xc4\x05	^\xd8B\xc0\xcfh\x1c\x99\xb1\x0b9\xea\x13\xa1f\xbd\xfe\x12Mh\x1d\xea\xeej-Q\xbbqz\xb7WC\x9c\xde\x01\x99\x0c\x06\xfdF\x01\xba.nGbb\x8e~\xcf\xcc\xa6\xc7u\xe8\x17\xf2K?\xe5\xd7{wJ\xc8\x1e\xe7\xfe\xed\xa8\xa2D2\xf6(\xebjHE\xd7C_z^7\xe3\xe4\x0b`\xa4l\xee\xcf\x93\xf9x)W\x0e\x11.\x1d>(N0\x15)\x1f\xfc7\x9e\x8b\xf0,	a\x97\xf4\xb8\x1bRN\x1c\xa0T\xde'+:?S\xcd@^\x99\x98@\xd7\xe0<\xb9\x06\xfeP\x08\xd6\xa4QDh\x00\x184Q\xe8K\x9eC\x89\x9b\xa7\xc4\x01\xfc\x95\x14 \x1c\x8f*\xec\xb1\x9a\x11\xa3\xc4\xa2\xb2jsHM\xe5\xb9\xb1\x05E[tS\xca\xa7\xbd\xceh\x87\x0f\x91K7\xd6\xef+\xed\xbe\x18\xecP\xb7y5\x00-\xd9\xfd\xa68\x93=\x00B\xb0\xdf\x05\xb3)\"\x99\x7fuDAw\x08M\xef\x08\xa9?y\x04\xe8L\xccTOy8\x05\xdf\x12\n\xe8\xbc\xbc\xea\x99@\xaf\xd7\xe5-\xd8\x83z\xeae\xe6k\x10\xf0\xf8\xbd\x1fqr\xbbs5\x12e\xd3\xeb\x83\xe3]A\xe5\xc6v\xd4\xc8BC\x87\xedj\xd4\xcb\xcb\xb5\xcc\xce\xab0\x08\x1e\xf2x{\xd1\x10*W\xcb\xb8d	\xfe\xb4\xb3\x87/T\xe7d\xfdR\xad\xe0r\xa4\x99\xacc\x99\xed\xb5:Y\xbfT\xeb\x9e\xffi\xa7j\xb3\xf8X3\x13\xc2\xe2\x8fRf\xdb\x8bt\xb5N\x13\x8c\xdf\x7f\\\x85Q\x82u\x08\xb4\xcf\x0c\x85\x99\xb9\xee\xa4\x91N\xb7\x1b\x8f\xe6\xfb\x1f\xe6;\x10\x89\x10x$\xfe\x95\x83\xf2Y\x0f\x88\x97#\xf1\x98\x92\xf1i\xd0\xdf\x0d\xafG\xc0\xa0\xf9\xd5\x88_\x95\xfcr\xc48\x12\xb6n\xc2\xde\x8a\xa3\xfd=\x07\x1f\x1b\xb5\xf8\x1e\x9d\xe5\xde\x94W\xa7@\xe0\xdd\xa6\x1bo\xdf/\x07\x81H\x1dV\xed=SC\\b\xb9(\x02o\x00\x1f\x9cE\xba\x0e\xbe\xef\xf3\x0c*\x06:I\xe1\x94H\xd5D\xa4:\xdd\x8f\x06\xfc6\x9c}\x84\xec\x8b\x82\xf9\xe2\xd6\xa7+\xa9\xcf\xe9?\xe6\xf1\xdb4\x9bK\x1d\xefk\xa0\xdftN\xba\x18\x8d\xd0h<\x12c\x87/0\x1c\x8fh/\x0b=\x11\xe3Q\xcfAP\xb4\x99#T*\xc6#\x1ce\x1fjQ\x84\x1b\x1b\x17\xce\xdev\xc8X\xee\x1b\xea\xd4\xe8Bb^\\\x85k\xcd\xf5Q\xd9\x15\xf3@s~\x08Ig|\xeeG\xbdMu\xb0\xe3l\x8a}\xed\x1d\x1dq\xab(\xd7N\xe5\xdb~e\xbez}#(f\x03D\x8a\xf6z\xd4\xf7\x8d\xfe\xae\xbdn\xe4\xd1'\xe9W\xfd$gS\xe3\x11\xf6y\xd3A\x08\x8f\xd8\xa3i\xee\x1bl\xc8\xb9\x1f\xf26\x1e\x98K\x8a\xf8`h\xc8\xb3I\xdf8\x16\x9e\xe2\xae6\xde\xf1\xe0\xc5\xb20>\xd1\xce\x06A\x7fX8n?#\xe2\x06O\x06\xc7\xc9\x94\xf1\x8b\x12}8K{\x9b \xedmqF\xa2\xb9T\xfc\x92*]\x9b\xd7\xe9F1\xaa\xb5\xcc\x8aq\x8d\xd4\x11\xb3\x9d`	\x06\xe1\xd4\xacJ\xfb\x0b=\xfb\xf0\x11\xe7x\xaaL\xb5\xf7\x91jq\xc1i\x1c&>\xe3G\x91\xd5\xcbt!\xda\x83\x84\xa29\x17P\x17\xd2\x8b\xaaUX\x8d\x9b\xa6\x9e\xf6o\xab\xa5|R\xdc\xc2\x05 \xae\xa5\n\xb0\x1aSx\xe6\xa9~{\x81Gc\xf2h\xdaZ+WH\xa7\xc7\x86\xe9$\x9a\x1e\x0b9\x89\xa6\xdd\x84[R\xcfR\xa0gio\xdb\xb5\xce\xdb\xbbI\xe0a3\xd7\xf3\x90\x0d\xf5\x06\xb9\xa8\xd5n\x02\x96?\xe3\xb8\xf6wz\x0f\xe1L\xf9\xf2\xde\x98\x14\xd3J\x1b(r\x82\x98Jf\xa4\x04\xc97Q\xf2M\x047\x98\x11\xe4\x87\xef\x1f\x92\x9f\x95\x8f\xc3\xad\x9f\x18\xdf\x9d\xdf\x00 \x0c\xa9\x19\xc1\xa2\xa4\xea\x86\xcc\x0fnet\x02kh\x84\x1c\xa6\xd0\x85\xb9\x1f\x1b\x03\xb0=\xd5\x01\x0d\x8e\x84]\x18o\xe3u\x8b\xae\xb7\xf5\xba\x95\xcb\xb7J\x02NC\x8d\xa6\xbb\x07\x8a!	\x8e~ok	\x1e\x0e\xfe\xf3\xc6\xbeV\xca\x12\xca\xbe\xc2\xad\x06\x9d\x85i\x84a\x9b\x92\xa8d\xe1\xa7\x93dZ1R6\x1e\xeb\xd1\x0d\x84%{z\xaa\x7f\xdc:\x1f-j\x83,{c?<mU_j\x137S|\xe8qJN\xfb\xf6\x84\xf6Z\xa6L5,\xa2\xc4\xb0\x9b\xc7\x10@\x82\xe9\\\xb9\x90C\xf0m\xbd\x00g\xce\xb3\xe6\xd0\x11U\xe9G,H\xe8\x0c?S?{\x8c?|\xd3%TR\xc4\x98\xa6\xbd\xab\xbe\xfe\x98\xa5\xab\xff\xbfz\xebp\xc4\xf6{[\xe7T\xe9\x01T|\x83zg\xf7\xa7\xab\xd6]\x0d\x82Pn\x80\xb5G\xe5\x0b\x85\\\xe6o,N\xfc\xf4\x94v:\x84\xcd\x7f\x90\x0b\x99A\xc9\xc5Ab46\x00D\x84\xc4\xb6\xdb\xaf-\xb7\xad\xca\xe5\x10Uf\xd1\xdc\xa8`\xed\x81\xae\x81\xb7\xb3\xd8/y$\xd2=\x195\xa3\xa8\xbf\x91R6\x0f\xc2N';\xab\xb8\xde\x164\xfd\xb073=PXU\xd6H`Ax\xa6\x97\xcf\xd9\x17\xbcY\x8e\x05\xd9^\xb6\xad\xd7R[\xde\xe9\xe4{\x9d\xd5\x14\x8a\x13+\xb9\xda\x19\xc4\xd6z\xb4\xb0\x1bD\x1a\xc7\xde\x19\xf0\xc3%A\xa0\xec\xac\xa59\xf7[*\xc3)i\xf7\xab\xe5\xf6S\x05\x10\xc0\xa3\xd2\x1b\xe0\xe5v\x8e>wAW\xae(l&\x18\xb9\xe3|\xbb\x84j~aM\xe6c\xc5\x9ev4\x9b\xd8Pq\xbe\xa23\xba:\x8e\x04\xd4#\x0c\x97P\xbd\xa5\x93X\x98\x94)|\xf8\x08s\xcb\xc4g&=\xa0$\xa6/\x17]\x0b\xe1\x88n%t\xf3\xe8*\xcc-\x14\x9f\xe9|\xba,\xe3)\x02\xce\x1e\xa9\xf0K\xca4~3\xf2#\xbe\x85c/\xae\xe8\x7f=\n\xe0\x80\xb0o!S\x15M\xb4mq\xb5\x95\x84\x1e\x0eK\xe3uu	?'4p\x87\xbf\xe1\xc1\xc8U\xa3$f^\xf4+\xf8\xd9\xb4\x8dE\xc9Ku\xff\x8a\x84\x97\x84\xde\xd3rR\xc9\xb9\xdc\x88\x9c7\xee\x1e\x92\xfa\x95\x8c\x87\x93h:\xc9\xa7\xa2\xe4\x19\xae\xe6\xeen\xa7\xf1\x98G\x8c\x1c\xc81\x0d\x1b\x0f@\xa9\x81,+4\x9a\xf8)\xe2q\x03>\xdb6x\xdc\xedx&L\xf8\x8d\x1d\xc1\x0d\xa9\x7f\xd9\xbdn\xb6:O@F0\xed\xe2r/\x93\xda\xd7\xc8\xb4\xb5\x99\x8e\xb2\xde\xe6\xe9\xe9(\xebm\x0d(\xd60]\x08\x87\xef\xd3h\xee\xd7\xafW1\x99\xb2a\x0d%\x16!\x9f\xfb\xa1\x8b\xa4\x12\x88\"\xcdAL\x1dm-\\\xaf\x11]\xaf)W\x1a\xd6\xd7%\xe9\x06;3\x9c\xe9y/x\xf3\xe6\x0d\xa7\"\xab%V\x13O\xea\xd2Zl\x0b\xd5\xda\xea\x05\xa1\xd6\xb5\x08Y\xff\x8cG5,\xbe\xb6\xc3\x1c\x0482w\x08d\xea5M\x19	V1\xe8\xf8j\x8c\x11\xb88\x84\xc1\xbb\xa1\xd4L\xc04\xbf\xe0\x11\x10\xda\xe6\x8cV\xe5\x95u\x0c\x95\xaf\xd0ch\x14@e\x81\x90yZ\xd7k\xba\x15*\xa5\xbf\x846\xfb\xc7\x12(\x94\xd6\xfby\x8b\x17(\xef\xe0$\xcf\x04To\x00<G\x97\xb0\xfa\x90\xbc`OOx\xce\xe8Y/\xffP\xfb.\xd3\x82\x9c\x7f\xe5\x80\xe6\xfah\xd1*x\xa5\xa8\x9d\xd4\xd8\xa0N\x02dC^\x1c\xe5\xea\x000\xbc\xa0\xc8\x8fy\xc98~`j\xec\xae\x13XV\x8a']G\x00\xed\x11\x0b\x89\xa1\x98X\x9cQOp\x0d\xffq\xc5\xfe\xd6\xd5\x08.&\xc2\xff\x14\x03\x12^\xdd\xce\x12\xdb\xd1\x02X\x89#K\xae\x04|U\xc0\xef\x94\x0d\xc9\x0f\x03]uR[\xb23\x1e\xfb\xe0\xf1!-Ri!V\xef\x11\xf1\xf80\x84@\xf2TU!\xf3 \xd2^IG`%6\xa8\xf8\xe1\xb8\xdc\x0b\xa4\xe6H,\xab!\x17\xcd\x0d\x8f\x9d\xe4\xb5\xee>(I\x8d\xcd\xc50\x0c\x95\xaa\x07\xe5\xbf\xc1\x86\x0c5M\x08\xbbP\x82\xeedO\xf7L\x84\xdc\x1dV;P\x16\x06(\xab\x08\xebo\xf1\x02:\x11@\x99\x90\xfbX#B\xad\x85\x0c\xf3\xd9^\x93\xc3}\x0d8\x91\xd6\xa14jBi\xd2H`\xb0\x0f\x8ev|\\:*q5\xce\x82\x9b~\xdd\x9e\xce/B\xcb\xb0\xf3]\xde\x9b\xc7\xc7\xa5\xe2\xef\x9c\x8f\x84v\xe0\xc5/\xea\x9c_-\xc8J\xd7\x85\xb1\x07\x0d\x0d^&\n\x0e_\x1d\xca\x1f\xd5{\xcd\x1d\"y\xcd(\x02\xc1\n\xd6\xdb\x80\xc3\x1b\xb1\xfbN\xb9\xdd\x04#\xd5\xb1\x9a\xfb\xe0\xbf\xac\xb5\xd9\xaav\xe9\xa3^\xfc\xca\x13\x8d\xb4\xa4\xa0!\x01\xb8qI\x06\x07\x19\xfaq\x07\xcf\x8e\x8a\xefQ\x85\x04\xd0\xad\xdc\xd8`\x05p\x1co\x9e\xf5\xb7y\xb9\xba%s\x04\x11\xed\x86\x17\x07\x85\xa0\x17#\xbe\x0c\xf3\xd70\x85\xae\xd9\xc4\x1a\xf4\xe6\xe8\xe8\xed\x88\xf4\x86\xc2\xb9\xeb\xb6\x9b=Rr%\x9eU|bj\xb0\xcafq\xa4\xca\xacDo\xbb\xb7\xd0k3\x02\xadv\x81j\xba\xb6n\x90\xedz\xe145n\xa4u\xe9\x1e\x98g\x86\xde\x8f\x1a\xf9\xa9dM9 \x11\x8d\xa9\x83\x13~\xf8r\x83\xb7\xc4LL\xa0\xd9\xc0\xfb\xd3!\xe8\xf3_|\x18\xc6'\x8c\xff\xe2g<\x83\xdf }X\x87*\x98\xd0E\xb8\x0e<\xe2\xb4y;.]s_\xd37+|\xf2X\xc3\x9a\x8f5\x8d\x98\x01\x1f\xd7\xd7\xfeO\x89\xff\x18&\xd1\xdc\x18Dk\xeb\x80\xcd \xa0Ke\x8b\xdf\xc1\x94oN\x83\x8c\xde\xf1;\x98\x1ag\x05\xb9\xb6\x0b\x84j*\x18\xaa\xd6u-\x146\xdb><=U2Ie3\xb33\xc6\x8a\xb1V\x9atf\xda\x98\xf6\x96\x07>i\x05\xef\xe5\x81\xcf\xc6\xf7\x1fT\x06!(H\xca\xd5\xad\xcc*\xad\xe9%\x19yL\x96|9\xb5z\xc3\xec\xd1\xcb\x0b\xd0KU\xb6\x98\x90\xd9I\xcc\xe3)\xe3\xe6\xab\x0d\xccXv:\xa6\xe2*\xed\xe9\xc9/\xc5\xa4\xe4\xa5\x0e-;\x13%fo!J\\\xd1s\x7f\xf2\xa8\x85\x8f\x85\xddx]\xe3\x0e\xf0\x94k\xb61\xb4Ky\x06\xbf`\xfb\xb9\x8f[r\x0f\x90\x9b\xd2\xf3?\xb2\xc2'\x8d\x91c\xac\x1a{\xe6<w}\xa0	\xc7\x19\xc4\xf4\xcf\x9cg\xb6\x9b\xf2}Us\x13\x1c)\x9eDS\xc3@\xc1\xb3\xa1\xb6/c\x1f\xaf\xfcxvr\xca\x8f\x17'\xa7|\xc6\x17<\xd7\xabO7\x0b(\xda\xac+\xe1\xdb\x0e\xbe*\x11`\x03\x8e\xe3\xc2g6\x86^5,\x10\x06\x83\xe9\xb1\xfe\x96G\x89\xfbm:L\xb4_\xe0\xe6\xb9%\xb9=\xe5\xb2\x03\x87#pD?\xa90\x0e-\x1c\x86@\x93xm\x07\x0e\x05{\x12\x98\xa3\x01\xa7D$\xfe^Z\x1d\x116|\x03f\xe6ON\n\x10@\xa2\x1d\xdf\x85+\xb9\xef]\xe0\xe0i\xc3#1\xf6\x8b\x9e)\xa9\xdd\xdfx\xb8\x1e<\xc6\x1c\x8d\xef\xa4\xf2n\xef\xea\x16xP\xc3/z\xb5\xebD\x11\xf8\xf6\xa4\xa8\xe9\n\x80\xacr\xeb\xf9)\\\xd3f\xe1ec\xf0\xfb\xc7\xda\x92\x00\xfa\x15\xc0\xf9\xecx\x10\x0c\xf8LL`W\x9b\x11\xba\x97\x9e\xe1\xeb\xf1\xf2Y\xac\xd4\x1dt\xd2`\xdaE\x92\x8f\x8f]\x14e'\xa7\xfcu\xe9\xa7\xec\x0cZ\xed\xf6J\xecf\xcf\xe2\xa0\x0f/KN\xe7\x8c\x9f\xcf\xa1B\xe7\xe6`\xd3\x8a\xf9\xb3\xf3\x11\xfcg\xaa]\xb6\x18\xaa\xca\x12\xf1q\xe4\x00\x8e\xde\xda\xf3\xb3\xb9\xb3\xddx\xc6\x02?\x11\xef\xb0v)\x9f\xd3\xa8\xcd!\xe0/D\xb5\n\xe7\xf7a\x14\xc3\xa7\"q\x9d\xc1\x1d^T>\xee\x17'\x16P\x13[5c\xfc\xc8j\x13C\xe3\xdb_\x10\x0ej\x0e\xba\xb5B\x93\x7fL\xc9\xc1\xfe\x9d;H\xa3\x02B\x16\x0f\x08\xa0\xc0W\xe2\xaeg\x14@\xf8=\xc1\x87\x9b\xf1Jk\x8a\xf0\xbb\x9eQ\x1a\xe1\x0b\xc6\xb7\x1a\xe9]\x99={\x7fV\\\xf9\x11\xbf\xe7k\xbe\xe2\x8f\xab(\xb9X\x86YpZS&\xb9\xeb9o;\x16D|c\xfa\xa6\x95E\xa8[\xfcF\xc0\x9a@\x89\xcf\xf8\x83x\xb4d\x03\xc9\xd3n\x08\x9d	\"\xfe\xed}\x98A_\x13\xaf\xdet7|\x98\xdct=\"$\xbc\xa9\x90\x0d\xacM\xbb\xb5\xc4\xa54\xbf67\x12\x95\xe477\x8b2\x8e\xc7p\xe5\x13\xf1\x9b\x9bB\x8f|N)\xdbj\xe7\xcf*t\xa6Z\x0bs\x1a\xbc/A)\xe2\xbe\xb1\x8a/\x9bNgC\x97\xf9Y\xe8\x03E\xc1\x85\x1dD\x1c\x16IaQ\xc8\xac\x05\xad\x88v\xd5g%\x99\x08\x1ev\\\xfb)\x85(0\xf5o\x95\xb7G\x9e\xf3G\x18k\x04[\x8e\x1f\xacu\xb0V\x8fp\xefC\x00\x80\x01\x90 \xcc\x87\xf1R\xe3\x06\xb37yO\x1b\xebS\xe1sh^\x04I\x15\x1b\x8f\xef\x05\xc4\x0b\x92\xfd y;\xe3J\xcbs\xb5\x80H\xd4q\xdb\x93\xd0\x08\x02I'~\xa6IrRz\x05qz\xb1\xae\xc23\x1b\xc2\xab\xe5\xc0\x1a\x89\x885\x91@:noQ\xd9\x01\\\xb2\xf2\xa6B\xb9\xe6\x92\xe0!w3\xe2\x8c\xfe8\x12\x17p\xb5\x97\xc8\x0c\xd3\xd5\xf4+\xa8\xa9v\x00\x0f\xd9\x9d\x8a\xf7\xa9/\x8f\x0b\xcb\x96~\x93\xfa!;\xf3S\x11\xbd\xea+\xe6W`\xb8Y\xbc\x8a+\xc8\x02\xe4;>\x1fUYk,\xafZV?\xb5!\x07y\"\x94\xa3\x9c\x97\xe7\xa33j\xa1\xc6\xe5\n(	\x9c /\xd0_\x98\xbe\xca\x01\xa4\xa1\xbb\xa2-\xeb\x88h\xe2?\x8f\xc4\x9c\xbf\x1b\x89{\xfe~$\xb6\xb9Q\x06\xc0Rx\xfc\xc6U\xd8\x02\x04\xba\x1a\\\x17q\x98k\xfd\x80V\xff3dAh\x0ey\xb7L\xa7\xf3O\"\xe0\xde\xb7h\x11\xa8\x9aj\xb2\xf9\xbf\xda{\xaf\xcf#\xde\xc7\x01\xb1\xe3j\xcd\xcf\xab\x9a\xab\xdbU\xdbi\x83M\xb4Wt\xd0fcY\xd1\xc1\x86\x9fbk\x85\x87@\xc7\x7f\xe5\x81.\xc3\xaa\xd2\xedr\x8b\xbf\x02j\xe4\x17\xdd\x9d\x03\x15\xe9B\xb5\x9av\x8c\xbf\x81\xbb\xb0\xe1\xfb\x91%\x17\x9dQ\xd3\x1a\xd4\x80\x95=\xbe\x19M\n\nO\xf4\xde\x8a\xdc\x0e\xe4\xae\x88\x99N\x87J)J\xe6\x03\x18\x08\x06\xd1\xf5\xb8g\x94\xe3\x08\x8b\xd1\xef\xb8\xb7\xbc)\xff\x84\xac\xa4F\x05\xf52\x8f\xabg \xc8\xde\x94\xbf\x1e\x89\xf7\xa3CJ&\x95\x8a\x89\xdb\xb9\xc0\xb3\xdc\"\xa7\xdf\xde\x17\x00l\xcf{\xa6\x8eDT-'Qq\x95?{\x9b\xa4i\xd8\xe6\x81\xd1(h\xdd1\xb8\x84\x99v\x9b\xd4`8\x00=Z\xc2/#)i\xcb\x87o.F~\x01\xbf\xb2s\xff\xc3\x88S\xb0\x08(L7\x1a@\xb2\xf2\x8eB\x1d\x85\x9e\xd4\xdc\xff\xe4\xa8u\x81\xe2Gk\xbe\xecz\x9a<T\x8a\xf5\x13\xef\xc6\xeb\xc2\x00\x82\x14\xa8P-\xe3[\xc47n\xb4\xc0\x0b\xc6_k\xe0\xbd\xb0\n\xc8v\xbb\x99\xf9\xdc\xedo\x0f\xbd\x89o\xec*\xd3\x85\x92\xd3\xf5\xbf\xe3*\xf9m\x94\x1c\xd8P\xb4\xf91\xf1G\x91fhF\xf9\xeb8L>\x1a\xf7\xd1I\xcd\xab\x94\xad\x0d\x0c|\x918h\xa5\xbd\xad<\xe0\xfb\xa9q\xcb\xa2\xbc\xcc\x84b\x8bS8\x0c`\x15b\x04\xa5@K\x9b\xbc\x1e\xa3\xc7\xe13\x1e\x8b\xa8\xa9y\xa0\xcc\xfa\x88\x8f\xe4\x04\x9f\xf2\x1fm\x84\xa9\x00\xf7\x9c\xeb\x9e3m\xd0\xc0|\x0d7/U\xbc\xc1\xb5\xb1`\xb9\x03?\xa3&\x8c\\N\xd6S\xd5?6\x8c\xcf\xc8\x03\xbb\xb8\xe33\xe8\xdd\xe7\xbd-\x87Q\x9e\xb8\xe3s\xfd\xde\xcd\xb5\xe8\x9d\x05*k\xde\xdb\xa8\xcc\xc8\xa3\xde\xbb\xb9\x0e\x17K\x85\xb4{\xa8\x95(\xbb\xdd?\x85\xa6Cm\x80\xd7J\xa7\xdfx]\xea\xa1\x0d|\xe5\xd0\xed3M\xb7\xcf4\xdd>\xd7t\xfb\xdc\xa1\xdb3\x13\x99 \x08'\xab\xe9\x0e(\xa3F\x94\xc0S\x02c\xd6\x00\x0fN\x8e\xff\x1c\xf0\xa0\xb6=\xe0q\x9d\x955\x81\x87\x7f\x05\x9c|\x06 \x94-\x08\x0d\n=5NX\x8d\x9f:3\xf1\xf0\xf6\xdc\xb6\x8d\xf8L|\xf0\xe1\xd7\xa1\x8f\xd2K\xd2oP\xee\x0f\xac;\xd7\x95\x81\xa1\x85X\xa2'~<Y9\xeb\xc2*U\x89\x05{\x9c\x0b\x7f\xd1\xf5\xcb\xe3\x01{\xb6b\x7f*\x95\xabT%3Z\xef\x81`\x0c\xc7\x06\n\x04\xf9\x9dH\x1b\x0e\x89\xdaw\xd5J\x0cZ;w\xcf7\xfc\x86?\xf0\xdb\x96VV\xa6\x95as\xcb\x9d\xf9\xf7b\xcd7\x80p~#n\x8f\xef\xf9\x830\x90\xce\xd7\xe2\xbe{\xc3\x02\xff\x9e\x80}#\xd6\xfcF\x18\x10_\x8bM\x17\x9ef\x8f7\x9a\xe4\xb9\x16\xf1du<p\x00V\x93q\xd7\xf0\x85\x96\xcb\xc2\xbf\xe6\xf3\xf6\x83\x17\xf0\x7fkI\x02\xc0\xca\x8d\xd7\xbd\xb6\xf0\x1e\xdc\xf3m\xb0\xd1\xba\xd57F%\xe8\xc10\xa82_y\xb1\n'\xf3\xe9\x8e\xdf\xd5\xc1\x9c\xcf\x85?\xef\x0e\xd8\x9f\xca]\xfb):\x83M,\x1b\xben\xde\x93\x8a\x7f\x8f`\xfa\xcd/[\xf3\xa5B\xd6\xc0so\xbd#\x0f\xdd\x8f\xf5\xfb\xcc\x98h\xd5\x0e\x04L\x88\x9a\x81\xe2s\xbb\xa3>\x07\xba\xda\x86\xbe\x1e\xcc\xda\xac\x9b\x0e\x0d\\\xcei\x00\x9a\xe9\x18L: ;e\x9d\xf3\xafU`\xa0GE\xe3\xeet\x8e\n\x12\xeeQpo<\x88GR\x7f]\xe7\xfez\xe4k\x0e\xa3\x8d\xbc\xa3\xde\xe1\x0f,\xf7\xef\xccg\xf8\xc9\xcd\xfd\x8b\xcb\xde\xcf\x1f\xde_\xbc\xb9\xbe~\xff\xa1w=>\x1f_^\x8f//\xaa\x1b\xd7\"Gu\x87\x12\xabt\x1e-\xb6\xef\xcbb]\x16o\x92y\xab?	WU\xc4\xb1\xbe\xd7~\x12|\xcf\xc4\xef\xa2\xc3h\x7fv\xb5\xc6\xb1F\x83N\x89\xb7\x11j\xe9\x96\x16}jW\x08\xc68\xd1\xc7!\x16\xd6MZ(\xc6nV\xe3\xa8\x94\"\x06\xeb\x0b\xbb\x9e/\x85c\xe4k\xa3H\x9e\x94t\x9a,_\x0dT;\xb3\xe1\x1e\xab2=\x9b\x89\xd5h\x92N\x83\x16'\x11\xf0i3\x13)\x05\x1a!\xcd<mt5O\x1f\x92k\x8cZ\xfaI]\xf8\x99CT\xc2\xf8\xe0d\xc9g\xfc~D\xf7\xc2ngW\x8a\x10\xb9\xbf\x8f\xc4\xa1\x10u77\x10\x85\xdd\xdc\x86\xd9\xcd\x8d\xf7\xef\x06\xa3\x03\xb2\x11f\x1fef\x02\xc6;{\xc9\x88\x9etH\xd3:VQS\xc6\x935\x8d$\xd9\xb3\xb6\x82\x10`\x19f\xbd\x0b\x15\x84\xe5\x18\xa3\xf9T3\x94yXO\xcf\xeb\xc1\xd4&\xb2\xbe\xfe\xcdc\x15\x86G]\x91v\xc3\x93S\x1ei\xbbXk\xaa\xff\x9f\x0e\xbbw\x1bfWQ\xf2\x17\x1d\xd8_\xbf\x9e'w1,_\xc8\x95\x04\xf4\x12\xe8\xa1b\xdf#h\x87q\xcd\x80\xf8t/\xe4s\xf7\xfdbY&\x84s\x05\xde*\x9d{\xdc\xc61\x82>\x83	\xb4\x01\xb5\x86\x1d\x1b\xfe}\xd4\x0e\x1c\xb7a\xb6\xa7\xdd\xdc\x08_H\xdet\xafe,\xc9\x0c]E\xe1\x07\x1c\xfc\xec\xf4\xcc\x02\x819\x11\x8e\x8e\x8cX\xd8\xf7hT\x06\x19\xd7J1UY\x1d\"\xd3\xa9\xad\x9a\x00\xa7ZGD\xd4\xac\xc1\xe6\xf7\x98a\xe6V\xed:\x1f\x0dd\xc8W\x05\x8eF!\x19/\x8c\xda\xf3O#\x91\xdd\xfa\x93	BR\x82~S\x88\xcf\x94\x83\x9d\n\x1e:\x82OV:\xd3\xf4\x01\x07%\xb1\xfa\xec7\xf5V/\x14f\xaf?W\xce|\xae\x8aj\xd7\x86\xaa\x9ae8O\x1f^\xc7e\xe6\xbc*\xee\xec?\xf6R~sRtkS\xc6\xffg$\x1ei\x1bT\x01\xadZ\xb6\xecO#\xcd\x04\xa8\x02\x9a\xa1\x10u\x0d\x84\xcb(\xcc\x97\xec\xf1>\xcc\xbe\x89\xc4\xff\xc7\xdc\xbb67\x8e\xa4kb\x7f\xa5\x84\x9d\xc3\x00\x8a)\x16\xa9*Uw\x83\x95\xcd`K\xdd\xd3\x9aS5\xddSR\xcfL\x17\x97V@$$b\x1a\x028\x00(\x89#\xe2'8\xfc\xcd\x1b\x0e\xfb\xa3\xed\xb0?\xad\xc3\xd7\xb3_\xd6\xff\xc5\xe1s\xc2\xfb/\x1c\xcf\x9bo&\x12\x17\xaa\xaa\xfa\x9c\x13\xb1\x11\xdd%0\x91\xc8\xfb\xe5\xbd>o\xf7[\xd7\x83\x1c\xc0U\xca/$\xc8\xc88\x9b\x87e)\xbe;\xad\x85\x15kw}\x1c\xb8\xf7i\xa5\xaf\x14\x7f:\xf5\xc4\xa5\xb9\xbei\x9dvr\xb8\x9a\xf7bL\xd0,\xb8\xc7\x96\x80\xf9\xafu\xbd\xb8Ns\xcb\x1a?\xe8\xda\xd6=\x90\x806a,^\xfc\xd8\xed\x98\xd1\x85\xa5qvC\xe53\x16\xaaj\x0d\xa5\xb2\x04\x8c\x1d\\\xd4\x8b\xdfC\x82\x19\xf3\x1b&\xaa\x88|(\x85\x15\xea\x81cc\xbc\x7f\xaag\x8b8\x0c2\xa3\xfdm\xf5\xb3V\xde\xfb'\xa5L\x97\xad\x9c\xa6\xf9\x9e\xc1\x94\xd3Ew,\x93}(8D[K\xd9\x1a\xa9\xdd.\xfc/Z\xc3\xe7\xf5z\xed15\x98\xcd\xdcY4\xc7\x1e\xc7F\x8f\x8c\xb0\x91\xb7{\x07H\xb3\x06B\xc0MC\x90T\xcd\x1bI\xc42\xafS\x08\xe3\xdaZ\x90R\xe6DRL\x12\x197.\x0f\x9f\x97\x88\xc9\xd3\xeb\xb9\x89t\x02\x1c\xe8H\x8c\xe9\xcaf\xbb\xba\xa232\xd4\xa4 \xb9\xe08P!z\xb2F\x88\x1ee4M@TD\xa9\xbb\xda\xb00\x92\x06=Z\x07w\x13\x99\xfcp:S\x0d\x99\x93\xcdQ\x04\xca\xe6\xfbF\x1a\x94\xd9\x08\x8c\x16\xb4\xb1\xc9B\xe0\xee\xa4\xd4\x82\xd8\x13\xc1\xca\x8d\x81\xf5L\x9f\x00\xfb\xab\xd9\xdb\xce`>\x86\xa4c\x9a\xaa\x0e5\x19y\xe3\xee\xfe\xac:\xfa\xb3h\xf6g\x05\xfe\x9fC0\x12\xc1\xbd(\xc5F\x84\x9e\xdf\xea%h\xa4Dl \x01\x15\x9f\xdeQ|\xd5\xddQD\x86|f@\xa8\xe3'c\xfett\xbb\xd8\xbb\xa8\xc2^/Y\xb9\x85\xeaF\xd8\xeb\xa5\xfa\xc7\xbe\xf0?\x81\xd9\x12\xb4kZ;\xa2~X\xe4+\xed+C\x9b\x03\xfb\xa9{\xf3\xd7\xca\xf1\x1e\x01\xa4b}\x87q\xacD\xb5\xdf\xb4\x05\xc3\xf5C\n\xa7\x91\xda\xc2]\xa7GUj\xd4\x8aA\xd4\xc4$\xef\xf5\"02\xcds\xe2\xe9H4!\xec\x06\x88N\xa9\xbc\x10'\xe9\xca\x0d\xedx]X6I;\xad\x19\xa0\xa6\x19\x84HS\n\xdf\x9f\xca\xca:\xf9h\xd9!SW\xd1k\xd8\x08\x98\xf9f\xb5j)4!B$Y\x01L72V\x88\x87b%\xd3\xb6W\xca\x02\xde\xc3\x9b\xd9j.\x87b\x81?\xc9l5\x17\x7f;\x9f\xe5\x13G\x9d\xd7p\xea\xc8\x1d\x9f\x9c\x0b\xd5\xf3\xbc\xb6O2\x11\x9a\x9b8\x86\x13\x7f\x1c4\xefPju`\xb5Zax\x11}\xfa&\x01\xf6\x17=\ne#|k\xd8\xde\xcc}\\\xc4\xe9\xe2\x97\xfb(\x0f\xfd\xb8\xea\x1a#\x8f\xae\x00W\x89\x8e\x8b\x05\xba\x969\xa4\x87\xa6\xa2\x1c\x01\xaet\xbc\x9a-\xe62\x9d\x0c}\xbbF\xb1Dj2[|\xbc\x9f\x1b\xdd\xcfe\x89\x03\xcbP\x1c\x9b\xb2\x14\x1f\x9e\x9c\xa8\x9ad\xd5\x02\xef\n\xc1\x84f+\x17\x92sb0H\xa6\xf1\xf5p2\xf2\x0fG\xb0\xa0e\xc9\x0b\xa70\x11\xf1\xf8\xe0'\x83\x87~\xf0<}q$\xb6~2\xd8\xf63z\xa6\xaf}.\xe50x\x9ej\xd9\x88.\xe70{\x9e\x96\xdd\xb3\xb2\xbf\x89\xba\xd6\x05\xaa]<\x88\x05\xaa\\lE\x86\x81\xcc\x86\"\xc3\x1fQ\x8dh}x\xf5\x1c\xf8\xd5\xccB\xc9\xf7SG4\x0f\xba\xde\xbf\x01\xed\xc5\x92\x1eF6\x9d\xcd\xcb\xbd\xb1N\xb4\x14\xac\x16L\x83]w\xa9\x15?\xa2\x0c\x8dLs\xa9\xf0b\xcf\x96\x0f\"\x90\xc3qP!\x1b\x05}y\xe4%\xb3F\xae\xb9\x8cfA?\x9d\x93{\xdf\x1dB#&\xe0\xd5\x13\xd81\x88\x82\xa8\xcf\x8b\x14\x113\x00l\x13\xf4\x01\x9cSz\xe3\xeb\xdc\xbdsW1Q\x9d,\xeb\xd8^\x90D\xc4\x16]h*\xb3\x89\x12hI(,\x94J\xc7F\xa9\x04\x06\x00\xe4\x04\x10\xf9 \xb8\xa2\xba\xfb\xff\xdctFG\xc4\xbf\xad\x1bz\xbd\xde#\x91#\x06\xc3\x0fP\xc0\xbb\x1d\x9d\x0fa\x8dr5\xd1\xe0S\xb9\xb9\x80\x9d\xa8\xc7f\xdc\xd9\x054\x18z)<\xcb\x06\xd8\xffD\xfb$\xb8\xfe\xb3R\xfc\xe5T>\xb2\xd55qo\xe1\xf2]\xb0\xee8\xba\x95z\x19\xd6\xd1r\xeb\x16\xb0\x02a\xcbD_#\x94]\xe6T\x80r\xa1y\x17\xac\xe5\xda-\x08E\xc7\xee\x9df\xfd\x9e\x91T\xc3\x0dI)-\x88\xc9\x12\xef\xc9\xbfM\x95R\xdb\x88\x9a\xa6\xc1	{ \xc3I\xb3E\xb3p\xeew6\x01cC\xb7+\xc2\xb9\xc7\xa1qzG\xb2\x93\xeb\xfe\xa6\xcb\x8a\xf1l\x95\x80\x8b\xa4\xba;p\x93\x0d\xf4\x87\xf2`\x04\xfbU8\xa8Xi\xa4TM\xce\xff\x15z\xd1\xac	:\xd8\"\xbd\xb9\x89\xcd\xd4\xfd\x0b\xd7\xa7\xc5\xe1\x91\xed\x8e0\xab:;qtG\x1d\x9f\x87\xd3\x99S\xd5\xa2\xcaT\x8a(\xff\xd7i\x1f7\x8a4\xcb\xa6\xb6R\xfc|*\x1f\xf2\x86xk\x1d\xb5\x02\xc8\x14\xde\xe3\xcf\x9f\xec}\xafv16\xce\x8fYz\x17-\xc3\xac\xda\xb3\xd5\x92\xe6\xa5\xf5>\xb8GN\x97\xf5\x88lV\xa1\xc7\xe0]\xb0fn\x91\xe3\x8cS:\xac\x96\xb0\x94]\xcd\x05^\xb2h\x89t\xc5\xe0\xae\x89l\xb2\x9c\xf9;{bi$\xad\xacp<\xfeUM)?I\x12\xf8g\x96\x0bh\xdc\x8d9\xd1w\x1d\x9d\xab\x9a\\]\x00\x85l\x0d\x1b\xe43u\xe1&\x17\x0cS\xf5\xd9\x9c\x03c\x15Z\xd6\n\x8fM\xe5\xaa\xc9\x00\xd58R\xfc*\x06p\xe2	\xfa\x9c\x85\xea!\xeel\xbd\\\xfc(s\x89\xa4\xd7	\x88\xc5`\x16\x16u\xbf\x82\xe3\xdd'\xc54\xe7\xbe\x88d\xc7D\xd4\xca\xa0\xa9H,?\x01\xe5\x18\xe4\x86{:lW\x99p\xbc:\x88\xe9\xa3\xc1:\xcc\x16\x88\xee\xf9\xfbTy\x0eT\xe8\xec,\xfbR\xef\x7f\xcc\xc2E\x842q\xa3E\x03\xb2\xf4R\xe5\xe8\x1c\x18\xd6R\xb4\xd6[\xad\xb7\xef\xe0u\xa3b\xb5c-:\x90^A\xe5\xc16\xb3\x980\xf3\x12t\xfb@K\x16\xabd \x19\xaf\xd2{\xf8P\xae\xd2{\x88\xb5\xe9\x15)\xe1q\\\xe0]d\xde\xd5\xbf\xa7\xe4\xa7\xa5\xad\x1d\xe2\xd4zPp\xa4`\xc0B@\xf4\x1c\x03'\x81\xfe\x9d\x8b,XF\x9b\x1c\xde\xf1\xce\x17\xc7H\xa8\xa8\xd5\x83\xa1M\x1f}E\xe85Z\x1e\xabW\x0c\xbb\x06\x8c\xb8\xbe\xeag\x00_-\xda\xbe?\xdc\x85Y\xac\x00|\x9a\xb3\xe2\x1f\x89\xbc\x88\xe2\xf8|\x95\xde\x03\xa7\xe9|s\x8bZ\xa9\xd7\xbe\xb6%>\x18	\x0d\x89dL\xe8\x9ct\x03{\xa8R\x98\x01\xd6X\x11C\x0d\xb44:\xe6\xa7#<r\x1c\x13\x08\x90\xb5\x9a\xdd\x7f\xdc\x83sd	\x89k\x90\x10\xa50\xcc\x17\x0c\xcd|'|X\x07\x04S\xecTo\xbe\x0dp\xdb\xfa\xcebs\xa5B?\x83\xb6Z\xb9?\x9f\x8a\xbf0\xa4\xdb_O\xe5bUI\xf1\xc6\x7f\xd5h\x0f\xad\xe0\xc7-\xe8\x83\xbc\x1d\xabH\xd4C`+\x124\xf3W\x83\xac\xcf[\xc1\x9a\x18\x90]\xe2e+\xce\xb5\xae\xf3Y\xfa\xb9ut\x16g\xf9mU\x91\xa8[!\x14\x8a\x96\x88\xa6\x1d\xc98D\x1c\x03\xa2\xf8V\xdex\xa1\xb6\x0c\xd1A\"\x9a\xd8\xd1\x04\x160w\x87\x9f\x1ah\x9c\xb8\x06\xd1m&\x06S\xe6x\xf4\x19\x06i\x90\xc9\x15\xf8\x80\xb5\x1dj@\xf7)\x16\xa1\xe7\xf9&\xabf\x01\xe4\xca\xe6\x11jA\nt\x16\x7fe1\x0c\xaa\x1c\xcf\xb7s.T\xea\x18\x83\xb4\xac:\\E\xa3g+\xcaqnG\xf9!\xaf\n;\x84\x8f\x80\x10\x1e,\xdd\xc62{1\x0bW9U\x18i\xb6\x8b\xa8>y-F\xbb\xf5\x959l\xf6\x7fN\xadE\xec	{d9\x8c?\x85	\xd3\xe1\xae9\x0df\x1a\xab\x15\xcb\xcb\xdbs\nQ\xa1&\x08\x88\xc8\x02	\xcc\xab\xb5~\xb08&\xdd\xcc#N\xf2\xfc\x9f\x1b\xb0~cm\x0d\xb3\x9d@\x06\xc7\x9d\x82Ht\xb0\x8a\xd5\x8e\nE\xd2\x8c\xde\x9e6\xa3\xb7'\xf5\xe8\xed:\x101\x8b\x90\xe9h$B\x02\xe1\xf2\xd9\xb9\xf0\xaf\xc6	\x94^\xcbN[({_\x8d\x8c*P\xef\xb4#0\xe7\xf6Nc\x91oC\x18\xda\x9e\x15\xb5\xbbtd\x02\x0bc\xdeZ\x93\x1c\x0e\xbe\xc6\xe7f\xc4P\x12\xc3\x9a\xefv\xb3Y6x\x10\xd9`;\x17\x1dO@\x8bN1\xd9\xd7\xb8\xb6u\\\xf9\x07\x1f9\xb7~6\xd8\xea\xf7-\xff\x89\xac2\x94V\x11\xf7\xfc\xaax\x18K\x0f\x99\x9dd\x04\x7f6\xe5\xa1.qd\xc1\x8e\x05\xaf_\xaf\xda\x1f\xb1\xf1\xd8B\x06];\xc5\xdc:\x8e\xf7\xf4\x80E\xa9\x9b\xb0\x9dub\xef\xbfG\x9c\x02\x1bv\xaf\xfb.,\x16\xab0\xf3\xedY\xa3\x1aN\xb5\xf4\xcd\x0f\xf5\xed\x0f\xdbb\x8b\xba\x03\xf6\xd9\xa6HI\x97\xe5\xe7b\x93\x87g\x04\xf3B\xb5\xf8\x07\x07\x19\xc3\xbe\x94\xca\xc8[\xd9]gOZeg\x83;\xdbJ\xdb\xc4\x11\xeb8\xaa\xf8\x15\xa8F\x91\xe8\xe8R\xc9@\xadx\x15p\x0eQ\x13M\x04Drd\xa7Q\xd0/7\xb5\x97\x91\x89P\xd5(c\xd5\xc8V+c\xd1x\x89[\x01\xdd7\x96c\xf9\xa7\xf6\xc1\xfave/\x88\xfd\xbej\xba-\xf4[.>\xe9#>\xfau\x9ft\x10\xbae\xafw0\x94\x92\xfdE\x06\xaftsj\xd1\xe7\x96\x00\x02\xdf\xb8\x8b\x95\xc8\xee;B\x85\xae\xa3\x8fD\ne\xc3=%\x00~:\xee'\xec\xae\xa3k\xf7 \xd9\xed\x92\xc1u\x96\xde\x1e\xb0\xf8`\x13-\xb5\xc3\xb2m\x97\xa2\xb1\x8b F\xd6\x9a%%\x1fg\xc7\x9ez<\xcdX\x1e\x04\x9a\nh\xdd\xd2b%\xef\xdc\xcd\x8a\xb9\xb6h\x89\xf8\xe4\x90\xd8,dQ\xbf%\xb0Y\xb0\xcd\xae\x81\xb1\x0c\x1dQ\xd0\xd0\x11\x19\xc5\x03\xe4A\x8b\x95\x92\x07\xc5\xbd\x1e\x93\x0b\x07RE\xd5\x80\x9c\xe3\x04'hS\xd8\xd1$\xb7`\xb8\x89\x0fp\x1b,\xe2h\xf1\x8b#V^W\xb04\x9c\xd8\xca\xe1\xdf\x0d\xbd\x0eU\x90e\xddj\x94;\x96\xb2\xa9rn\xc5!@\xcd\x16\x91\xba\xd4T\xb5\xd4\x8e\xa8\xd1\x0eU_\xb4\xa7A\xd1\x93\x9a\x99\x8eV\x14\xde8\xd3j\x9d\x86\xd2%\xef\xf5\x00\xc6\xa1\xb9\xd0\xaf\x87\xb5!\xe5\xf8\x152m\xdc\xfdCD\x9a2(\xcf\x91\x0d\x91\x15\xd9\xd8Xp\xb7Z\xcb[W\xd7\xaec\x82\xc2\x02:\xab\x05	\xb5e	&r\xe8\x12\x02\xdf%\xa4\xbd\xd7bi\x93l\xcb\x81\xe1n\x04l\x99k\x81\x07\xd3\xb2\xd2\xe2\x98\xe1A\x80\xc0F\xf1\xdd\x8a\x01\x13\xc4\xaf.\xfe\x87\xfc\xb9\x80\xf09\x84\xe4\x19b\xe7\xa8&t\xb6$\xcd\x16\xe7\x95\x96\x15\x1f^#P\xab\xdc}7%y\xbb\xf7\x9c\x1d,\x9f\x1f\xc1\xea\x1a\xe1\xc4\xf2\xbd\xf0\xef\xd5\x9a\x0b\xab\x9d\xdb\x9a#\xdb\x7fO\x02\x1f\xe60\xc2\x80\xa6\x12\xc8\xe5x\xde\x8a@V\xee\x9b\xc9\xf3\xa4\x9f>OM\x83\x8372\x1ad\x14\xfc0\x1ad\xc3RKz\xff\xbe\xe9|\xbet\xc3\x8a\xc3\x0f\x81U\xc1\xa8w\xac\xb5\xf9c\x14\xde;b\x91\xdb\xd9\xe8Xb\xe8\x92R\xd7\x984\xd0\x06,\xccD%\xbavD\xae\xc0,\xfdB\x10\xc0\xa2\x1f\x95U\xd5\x10k\xce\xc2\xc1mX\xac\xd2\xe5\xbc\xd7\xb3~\xb8\x91\x12\xd1F\x95v\xac&z\xa6\xcd\xe9\x8dU\x03\xaa\xb3\xc3\x0csb\xdd\xd4\x84\x8dF\x9anC\xfbF\xc0\xbd\x18\x91\xe5\xa1\x92\xdbpu\x9a\x08\xa6\x85\x80#\xff7\xf6\xd8i\x91\x1b\x8cV\x94X\x90\xa3R\xb5\xeb\x07\xc62,\x83\xf5H\x85\x166\xc27[\xb0Dn\x13\xef\xe3\xf2r\x1d\xc4a\x01\x1c\x92t\x1d\xcaH$\xd8pn\xa1\xcea45)[\n\x80ja\xe9\xb8N|+$hBZ\x1b\xac\xb41X\xe6\x04J\xf9S\"yp\xf8$\x14\xc8\x82\xe4=\xf5O\xb8\xb6@\"\x07\x84\xfd:\xe0c\xda\xe0\xa7\x02M\x8aAI\x8bu\x9dy&\xecX\x93\xe5\xc2\xe1bB\xe6\xe42jR\xcd\x8c\xc9\xa3\x99$\xed\xee\xb6\xdb\xb1\x03G\x9cf\x80V\xf9Q\x8d\x9e:\xdd\x98H\xdb\xed\xc2\xbe\xe3\x88\xe6\xe0\x8aH\x9f\x9f\xdex\x7f\xb3r\xed\xedI\xfd\xcb\xf7\xf4\x0f\x81q`\xf5(~W\xdff\xa2\xda1\"\xd0\xc1\xc8xrr\xc8\x13cy0\x1ag\x8d\x01\xd6\x8a5\xb1!\xbd\xffRf\x8dS\"\xc2I\x9e\xb5\x82k\xad\xe5\xb5M\x031}}#\xd7,\x95c\xf9\x0dF\x8d26\x89q\x83\xfc\xe0x\xe2\xb6]\x16\x93\xdcw\xf2\x96\xcd\xd7\xc8z\xdc\xf1\xc4\xb6\x9er\xe4x\xe2A\xba\xf6\xd9\xdf_\x1a\xc6\x1c\xb7\xcb\xa54N\xdd\x0f\x88\xc8m\xbcc\x1f\xbcq*\xe9\x02	\xf0g\xcb\xfe\x9e\x0e\xc3'\"t\xe0n\xe7\x90\x9f\x1f\xfd\xc0\xa2\xd2\xeey\xf8\xed%\xf8\xecAl\xf0g+\x16\xc6!\xafZ[\xe7\xd2\xbd\x9a\xb8\xcbA\xd6_\x0e\xb2\xa1\xf7\xe2\xe8\xf9\xa5\xbf\x1cd\xcf/\xbd~*\xde\xb5^\xde\xd3\xcb{\xaf\x1f\xa0\xaeD\x9e\xf7_>\xbf\x14\x1b\xf9\xae\xff\xf2\xf9\xbd8\xb8RSu&\xcf\xfb\x97\xcf\xdd\xbb~x\xb8\x1cd\x9e\xb8\x90\xef\xfa\xf7\xd6\xefSy\xd6w/\xdf\x0c\xc9	\xda{\xbe\x15Sy1N\xe4)'\x1e\xfb\xc7`\x93\xa6b%g\xb3s\xf1n.fg\xe2b.f\xa7b:\x9f\x97\x0by5\xd1\x1d\xf1/[\xde\x82$\xf29\x91k\xcb[\xf8\xad\x9e\xf3\x8c\xdd\xa1'T\xf9\x83F\x0f\xf0\x0f\x81\"\xf5\x8b\xbc\x0d\x15\x90\xe6w\xec\xae\xaad\x86@\x14\xd5\xcc\xfan\x97\x99\xad\x14y\xe2D,\x14\xb0\xa47\x8e\xe5\xc1\xc1[\xb1d\xb9\x10\x14\xccb\xebo*)\xe1\x8d\xd6$\xff\xc2\x9adH\x04\xfd;\xfc{\xe4oE\xc5\xb7\xfa+\xcb\xc5q!j\x0c\x90o\x9c(5\x07\xea\xbf\xd5\xb0\xeeW\xa5\xb8\xda\xedX\xa2\xcc\xed\xc0	y\x10\x1b\xc8\xce\x96\x1c\x14R\x86\xeb\x95\x9b\xc3\x0b\x94\xe9\xecR\xfc\xe1T\x1e\xe9\x8b\\\x14S\x0d\xd8\x02\x8fq\x11N\xbb\xce~K'[<q\x1c\x87\x03\x85\xef\xd7\x85'\xac\x84\xc6\x8e\xf28!=\x92\xedt\xd2\xbc\xe1\xae)P\xe0y\x18_\xd7\x8en\xad\xc9`\xd02:\xf0\n\xaf+\x04\x0f\x98\x89h\x96\xce\xed\x0b0\xd4`O\x07\xdaL\x00\xfe\x0e\xb0\xdd\x1e\xff\xfd\xa9\xab\xf8\x99\x19+\xb3\xd6Qxa\xe9\xfb\x1cA\xbe\xbd\x94\xae\xee\xcb\xc5\n\xa0\xcbKG\xa8\x9b\x1c(pU\xf6\x10P$UI\xfb\xca\xb0?g\x9d\x9e\xfd\xd9OI\xbb\xf2M\xd2\xfe\xd4h\x06\xcb9\xa9\xd0\x7f\xc3\x9dQz\xf5;k\x045\xab\xf5\xd1\x9b\x99\x0d\xc7\xab\x8b\x14\x90E{\xf4&\xc6G\x80\xb7\xac\xa7\xa5@\xae\xa3\xe4\xfd\x8e7\x86S\xcen\xe7\x06r6\x14\xc1\xdc\x13[7\xc5\xefT\xceR\x91\xb2f#\x935\x1a\x9d/E\x8d`+\xe2*\xb4K\x86kj#OS\x02\xf0\x10\x19X8\xfa1\x9a\xe3\xcd\x02?(\xaaK\xfc\xe2\x08\xee,\xf4\x131\xe7\xf0\xf3Z\x1er{\xab\xf3\xda\xf1\x9e\x17S\xb1\xd6\xcd\xd6\xaa\x07\x95|#\x87cV\x1d%\xb5A\xe2\x90u\x00B\xbd\xe9\xf7\x99\xf0\xbcU\xd4\xee\xf9\xe6\x96\xb4afs\xb9\xb7\xbb\xdd\x8d\xf7\xfcH<\xe8Z\x0c\x05\xee\x00<\x80\x1338{+\xb6\xf4^\xa75\xb5\x16\x8e'\xae*\x9a\x9a\x03\xf0$\xde\xf8\n>uC\xf6,\xfa\xc3\xa9x'\x87\xe2L^\x8b\x0b\xf9@\xd4{\x85\xa0W\xeb\x881l\xc1{\xdd%\xcf\x04\x7f\xd4w\xb0x$kJ\xc4\x80\xb3\x99\n\xfc\xd4w\x1d\xfd\xa8\x18\x8b\x07\xb1x\xf07\xe0GV\xe0G\x16\"\xf3/)\xce\xdd\xb2d\xe2\x87l4\xe1\xfcv \xe5\xe5\x04\x02\x89\xdb^\xef~r\xe7\x17\xcf\xef\xfc?\x9c\xbe\xb8\xf1\xde\xac'n\"\xd7\xe2\xfcP\xae=\xff]_\x16\xec'{\xd6\xbfx\x9e\x8c\xf7\xb62\xb1\xdbxV\xb50\xfdX\xfb.R\xb7\x10Wb\xb6\x10\xcb\xb9\x87\x96\x8a30k\x9e8\x7f\xf3\x87\xd3^\xef\x06'\xcb\xf9\x1b9\x18\x0e\xd9\xdf\xe4T\xa2\xa1]+\x84\x03\x93\x9ae\xc2\xc3\\\x85	5\xe4\x0d \\0\xb8\xf2T\xd8\x96A\xf2\xba\x7f\xf1<z\x8eD\xdd\x01Jr\xa3\xfe\xc8{~Z\x96l\x0ey'\xcf_\xbc\xa3\xa9\xfeg\xb5\x82\xfc:T;\xa4\\O\xd6\x98\x85q\xad=gvK0\x03\xa98\xeb\xcb\x8b\xe7\x18\xa0\xf1\xef\xe05\xbc$c;p\x11\xfa\x08Z\xe5n85\x07\xd2>\xa7\x98|\x81\xfb\xf8#\xbe\x0f\xc2\xb9	S8l\x93\x89	\xa3\xc5A\x13\x03\\K\x15\xfb\xec\x9f\xe3K\xd3p\xac \x91\xf3S\x9e\x15\x96\x0bDJ\xca\xff\x81\xe5\x04\xa1\x99 \xb6\xc4\x9ehx\x1b\x00\x8d\x91\x15\xd7\xe48|\xe9k;\x86\x86\x07\x86_|\xae\x0fF\xbb\x01\xc6\xd1\xe2\xe3-\x19\x85\xaf\xba[b9k\xa0I,\x16f\xa3\x88\xc7\xa6\x01w#<\xdd\xe7\xeb\x8c\xad(7\xa3\xfd^8G\xe1K[k\xaa\xc5\xad\x83/\x0d\xf3\x1fM\xe5\xb7\x89\xfbX7\x91\xc3D\xe8\xf8:?f\xe9\xc3\x96R\xf6Z\xcdi\xc9\x85\xb1\x98\x0b\x07\xf0g\xd2frV9\xe4\x9dK\"\x13\x1c\xa0\x07\xae\xcb,Z\x02*ob\xffp=\x1f\x97\x05\x0c\xe4\xde\xbc\xf2\x0c.x&\x87\xe3\xac\xb2\xb3\xd3\xb2\x9dh\x96\xf5\xfbs\xf8\xf2\xd2\xc3Xm\xe0\xdc\xdb\xed\xd4S\x8c\x1b4\x18<\xc8\xfc\x10%\xbe8\x12\xc1`+\xe3C\x98\xde\xd1\x0f\xd28K\xbc\x13\x01\xd3\xa4\x12/E:0\xbdv\x0b\x11\x80]\x85\xae6\xb8.\xc2\xec\x1bl\x02k\xebh~Y\xf5\x1a\xbd\x145CB5,\xe89wK\x19\xb1\xe5aQa\x96\x14\x15\x12x\x83T\xd3\xacu4K\xd1\xd7\x8c\x1f\xb8\xaf\x81\xe9kF\x9c/\xd4\x90\xef\xc3E\xe1\x06\xba\xc7\x99\xeemetXj\xd0\x7f\x04\xcd\xb0\xdbP\x96\x02\xe4i\xa5\xe7\xa8:\x89	\xd7-dSh\xd5\xd5\x04A\xec\xd4\n\x00X#\xad\x80@\xd6\xa3\xcf\xbd\x82\x05\xa0\x913\x12\x11\xf2\n\x9a\x9a\x84'\xf4\xc5\xd1\xe1h\x9c\x7f-\x87\xe3\xfc\xf0P[\xf4\x1e=\xcfa\xbd;\x8b\xe7\x87\xf0w\xc34\xc5}\x80\x8e\xbd8\xc2X\x16_\x93\xa8\xf8k\xc0\xf7\x16o\xe4\xa6\x1f\xf4z\xe1\x1b\xb9\xeagL\xc2>\xcb\xd9\xb4\xf6p\x04\x17\xd4d*\xd7\xb6\x9a?\x99\x0e\xa2\xfc\xc70\xcb\x11\\%\xb1\xa0a4I\xaf-\xb9+\x13\xf4R$\xd3n\xdb\x80'\x11+B\x92AFS\xf7\x11\x8eh\xdfcO\xc3.B\xe9f}\x87\x8f\x0c\xd0\xfda\xa5B\xd7\xea\xbcbp\xb3'\xf6\xae\xa71\x1c/sl\x9f\xdb[\x08\x9a\x8cmU\xe5\xf6\x1br\x8amL\xcf0\x0f\xa6;-x\x1c\x0e]\xd0\x1c\x01\xb3\xd4\xf7\xb7jl\x8dD\xb7\x18\xdfX\xf2qpf\x92/\xe9\xe3\xe4\xe8\xb9Ka\x97)Q\x87of\x14\xd9D\xbezn\xa7<?\x1a\xab\xb1\xad\x05u\x0e\x07W\x9b\xeb\xeb\x10\x9e#\x91W\x16\xd5\x90\xaa\xeb*w`\\\x8e\xa5\x8e\x15\xd0rk\xfa\xa9\x19\xe8c\xdf\xd4\xf2\xa0\x92\xe7\xc0YU\x0c\x9c\x9e\x8c\x14\xfe(|=a\x91\xb8U\xd3n\xd7N\xa3n\xfc\x80\x13\xd9BS\xe8\xc6/\xb1J\xf2<\xbf\x95VM\xad\x95\xb1\xd5+\xc3\x91\xb2\x06\xca\xca;\x01$;-\xd8\xf6\xba\x81>\xe34\xca\xd7q\xb0\x85a\x9e\x1b\xd1\xc1\xe8\xbbn\xf4ik\\T\x93\xe7\xf3L\n=\xd9>\xcd{\xe9yv\xc3\x11\xa7\xa51\n\x91WW\xc5\xf1F	?k\xa3D\"\x14\x07\x07\xad\xbe{\xb4\xc5\xab\x0d%;}\xb8\xa4e\xde\xa6\x01\xf5Xz\xc95\xe3\x82\xc6V\xb0\x16\x1f\x0eFG\xa4\xed\x18\xb9\xa9\xaf8<Q\xd8W&\x00\x12;\nv<\xa1\x03\xe4 ?\xfa\x13\xa7\x99\xac}j\x92u\x0c\x136\x9bA\x0b\xe8\x02\x1a\x17\x95-\x8b\x8d\xa7\xb1\xd7^%\x98\xcc\xb4\xdc\xd6v\xf0\x14u\xd7M_g\x9a3xC&k=`\x01\xed8\x830F\xb7\x11\x1e\xed\x11v\x04\x073\xa0\xa5 \x13\xfb\x97(*\xd3\x0el?\x0b\xb7\x18P\x08\x95*\x80\x96>\xa3\xd8\x16\x83\xdaM\xe6j\x18\xc9?\x0b\xfd\xf4\xb37\x8e\xbe\x96C\xb8\xf6YED}\x97\x17&\xb5d\xb7\x1bz\x1e@\x0c\xb0.\x94\xea\xadZ\x13\xde\xe3\xfe3\xa0\xb9\xb2\xa8m\xf6R\xb6\xfcv\xa8\xf0V)\xb2\x9b\x8e\xb6\x97\xab\xf2C\xa2\x0fyW^\xc5a\x8e\x02+7T\xc36\xecqEm\xcb\x96j!\x8chY\xc1\x8d	v\x9dZm\n\x02\x01\xbc\xbb	\xf0\x11\xe5+\x8a\xa9\xf4\x19\x8e\xa2\x9fWo\xbbXu\xa4u\xb5b\xf4q\xf7R=s\xb9\xe9\x9e]\x03\x17\x0d3V\xd3\xbaV5tZ\x91\xcb\x96\xc9\xa3\xcf}\x8dcfH\xabO\xe8\xbc\xf1a\xa6sn\x19e\xc5\x16\xb1\x86\xea\x95B\xf5\xcfu|=\n_\xedv\x07\xednX4\x8d\xab\xa5y\xecS\xe1\x1f\x0cK\x96\x0d\xdc\x9c\xba\x1e\x90\xbc\x95m}j\xd8\xb2^\xafz\x86\xc1F\x90\x15\xfe\x10G\xb4oj.\x85\x19sk\xf8\xb4\x15\x15\x9d\xbfI\xe5\x9a[- \xbf\xe3\xf6\x91\xcdr\x88\x8b\xe8\xf6\xd8e\xaa.\xea\xf5\x12\xed9Qw\xdcumT7O\xb4\x0b\x97\xc9\x04\xb7\xd4z\xe5\xe3\xcfob\xd1*D&{\xb6\xa8\xd7\xba\x85\x14E@\xd6\xca-\x0fC\x03\xd1b\xf5\xac\xd7k6\x87\xcb\x87\x19Ck<\xf9\x16\xef\xd2\x7fW\xb0(fkkd\x94\n\x12E\xbf\xf2<\xb1qoV\x88>+n-\xf2\x17+\xef,YF\x8b\xa0H\xb3'\xe3&Ev\xae\xb2^\x88\x05\x89\xd1E[\xc8\x8e\x12fa\x05Jo@7L\xa0\x1f.\x91\xd0\xd7\xdc\xc2\x03\xa5V\xaf\x91c\xe9|n\x95Jf\xc3\x15+\xff\xac\xc5C\xbf\xa8\x90\x845\x10\xe5b{\xc8\xa9\xc0\x10\x8e\xbcy\xa3~+\xd0\x8fU\xbd!\x8b\xa1\x9c\xe7\xd2\xb1\xfe\xa0\x9fW?\xb7\"\xb1T\xf4\xe1\xf3\xb0\x1f=\x8f\xbcq\xf8B&\"z!\x93\x8a\xfb\xd3\xccSP\x04\xc9\x91{\x18)\xf7)Dy\xc8\xe5\xe1H\xc4r8\x8e\xdft\xf4R\xf3\xc6\xb1\xc6\x95\xda\xc8\x8e\\\xb3\xd8\x80\xc4\x04W\xb9\x1b\x1cn\xd4\xd0x\xe3\xd5\x9b\x0c\x91\xbe\xe4F\xe42\x16\x99\\i\xcf\xc4Y.\xfa.E\x13Z\xa4\xa9\x89\xb8\xed\xb5\x86\xa6#V\x9c&\xea\x9b\xd2o\x16\xadi96\x93PF\x8e\xcd#\x0096\xf0\x1e_\x1c\x8dyL\xe5i\xeaF\xc4\xc6\x9a\xe9RI\x88\x14\xc0I\x96,\xce\x08\x87-!\xb6\x166C\x93C\xa5f(\xa1!\x8c\x17\x01\x02\xebu\x0crEy\xd0\x92\xb3\xc3\xd4\xe9\xe2<M\x80\xd4\x1b\xd3\x0fu\xd5\xcf\x8f^<1}\x91=\xfb\xd6B\x14\xd6R\x05\xe9G\xb3&#\x13\x07\xa3\xb6J;\xa3\x15\xea\xa6?\x8b*\xf5\x05\x15\xbaN\xef\xdd\xd1PUp\x1d\xa7i\xa6\xea\x8d\xd3\x1b\xb7\xf0\x14N\xf1\xdb\xdf\x8f\x86 \xbbe\xf1\xc2\x1c\xc4G\x14\x18(\x92\xc7~\xf4\\\x1e\x89\xe8yX\xf2\xb5\xd6\xee\x9d\x96	]R\xd4\x96\xf1\xb2\xa1&\xe0\xf8\x87\x9f\x10\xd5\x84\x8d$0Sp\xf14\xd3\x11\xb2$\x99_\xe8{\xb9\x0bD\x03$\xd3MX\x18\x1d\x9c)\x8c\xad\xe2\xa8\x04\"\xfe\x1c\xcf\x03\x00\xb5\x96\xc0X\xd4\xc0\xde\x1et\xc5u	DP\xd7\x0b\x15\x04\xb5\x00\x110O\x1f\x13\xea\x8c`g\xc5/v\x9a5\xe9=\x95\xc8\x9bv8\xc8qG(I\xbe\xef\xf9'\x19\x1c\x90]\x98\xc8Uu\xef\"\xc44\x8c\xf9G\xf0\xe0B\xedL\n\xde3l\xd6;\xc0\xb9U\xbew\xb9\x81r\xf72k\xb6\xfa\xb9\xe8\xc7\x9e\xa0$\xf3\x99\x1b\x1f\xe6\xde\x8b@\xe91\x9eUE\xb0\xa3\xf5x\x99>\xaed\xde\xdf<\x0fD\xa3\xacU\xb3\xa8\x0d\x1a\x15\xb9\x1b\xaf\xbc_Eq\xe8\xae\xde@\xa6\xd5\xeb\x19\xb0\xec\x95g\xfd\xc0;\xcf+\xeb\xd5\xb2\xe8b\x81j\x172>lV\xbb\xe8\xe8A\xb3\xda\xc5\xd7\x90\xa1Y\xd5.\xea\xd5\x0eu\xb5\x8f\x99\xc1\x16t=>\x98\x0fG_\x07\xbd\x9e\xab\n\xd4F\xa7\x16Z\x17	\x07\xfb\xd4\xf6\x17G/6\xde\xf3\x8d\xb6\xcd#/]7xq\xe4\x8d\xed\x81\x9a\xa6\xee\xf2\xf0\xfa\xf9\xc6\x13x\xea\xbb\xc1\xe1\xb5\xf7|\xe3\xb5\xc7\x0eJ\x10\x9c\x0fU\x94\x12\xd8\x99\xdc\xae~e\xe8\x98\xe6\xd63\x06` \xaanW:r\x0c\xfb\xbf\xa5\xb01nnB\x99~\xe2\xb6.\xbcG\xaeOJs\x8b4\n\xc3\x8ev\xdbxk2\x9aU\xa7\xba\xd9\xd0\xbb\xddp\x0e\xc1YT\xd6#\xb5p\xc5\xb7+\xde0Si\x07\xf3\x16\xc1T\xbaw\x86\xa5\xe2\xcc{\xe3Z[\xdcZGX\xb1\xcaO\xaf\xb1\xd5\xf5!I\xdf\xd0&U\xe6\xecF\xed`\xc0{\xa1\xea\xad'\x03\xc7\x92\x0c\xeb\x1b\xb9\x8dE}\x95\x0e\xc36\x98\xab6\x92\x18\x7fV,\x9b\xe9\xd7l	\x82\x16\xae\x1b/	\xf8_\xdcX\xa9u\x9cp\x18\x0d1_\x83\xaa\xcd\x9d\xe0xu_\xeck\xefQ\x9d\x0e\xd7@\xa3\xef\xf5\xe8\x0f\x8cX\x0f\xaeA\x06L\xe8_9\xf4M\xa6(\xa1LQ\xf2\x863\x05\x0f\x00\xd8\xc7g\x92A\xfdo\xe5\xaa:\xb8\xae\x95yX\xaf\xe7\xde\"\x00<\xfd\xf09\x11\xdeL0\x9fJ\xdc\xc8\xbd\xf6D-\xca}Q\x8b&\x1f\n\x9a\x16?\x15z*\xfc\xbc\no\x1f[\xc1\xeb7\n\xcc\xfe\x9aL\xfb\xe9Y\xc7Z @	G\xf0\xe8\xf9kQ\x8b\xac\xe0\xdf\n{\x04\xfd\x9bR\x1c\x8c<\xb1\xd8\xed\xdck\x0e\xc5\xe4x\xa2\x05\xdf\xc7\x16\xe0wRe\x1as\xde\xe5 \x0b	\x9a\xdfu\x1ei1\x97\x0e\xdb\xae\xddM\xee|\xc7Q\x87d\x07\xe2\x1f\x8c\xdeu\x19\xfc \xae\xf9\xd4\xda\xca\x80\xb05\xefS\xf7ZTR\x0d\x0e\x98\xedQ\x1ck&\x11\xb6\x06\xee_\xeaM\xb3m\xe0\xf7k\xe8=\xbe\x8c\xe9\xde\x15[}/\xea\xa5cX\x15\x92\xb9u1+\xb7eK\xf9fi\xd7\x9ev\xb6$OK[\x1b\xfe\x95\n\xadd|\x18Ka\xaf	\x15e\xd0^\x17\xc7f:\xe1\xdaHK\x84\xbc$\x03\x08`\xd6i\xbc\xbd!\x9fD\xbdf\x12\xe5?\xc6\xda\xb9\x05\x87\xb4\xbe\xba\xbar\xcaR\xa4\xd3\x81\xce\xe8Y\x0b\xean\xe5\xea7\xf8M\xab\xc2\xac\xbc\xea%\xce\x01zg \x97\xf9\xa5\xf9\x0d\x89\xad0p\xb5\xf6[`\xec\xd2[\xb3M\x01\x9aQz\xe2\x13a\xc3\xbdq\x85]\xca\xb3\xfd\x14\xfcY\x0b\xde\x9b\x99`R\xbc\x81$v\xb5.C%\x9d\xeb\x1eL\x93%\x9a\n}\x95\xa8r[\xe2\x05M\xddv\x80p.]\x8e\xcbTc~\xed\xe8o\xc6\x9c\xf7\x99\xc6\xf9f\xd2\xa9\n\x885\x0ba\x8f\x18\x0e\x16\xdbye\x84\xc6$y3\xe6\xd6a3*\xd7\xe1\xa8\x19\xb6\x0b&\xc5\xb5\xfa\x97n0\x05\x89\xbf\\v\xa8s\n\x1bO\x9c\xf7I)\xba\x87\xa86&\xfa\xf9YX\x17\xc1E\x7f\x17j\xde\x91\xb7m1K\xe6\x12\xff\xd0!\x9d\x94\xcc\xd0\xb4F\x93t\x7fMA\x02\x93\x8b\\\xa4\xa6\x0d\xf8*\xa6-Q\x99?\xb1\xdc\xd9\xac\xcd\xca}\xab\x03\xfb\xb9\xe1JV9\x01\xe9x\x82\x1d\xa8\xd0\xecIf\xdc\x9d\x16R_\xbc\xea\xf7Z\xb2;\x94\x8e\x95q#7\xb5\x84\xf1Zn\xdd\xb57Y\xfb\xb3\xf5\\\xdc\xc8\xad{\xe3Mn\xfc\xd9\xcd\x9c\xef\x98\xd9\\\xdc\x81Z\"\xb3S%\x85!\x9eA\xf3\xf3\x0f\xa4]nBM\xc3\x9c\x89\x8c\xf8\xef\xf1g+\xae\xe4p|\xf5\xe6A\xcf\xc3U\xbf\xef\xadz\xbd\xdb\xd9T\x86\xee\xadX\x8b+o\xae\xc8)\xac\xc9\xeb\x9a7\xc3%\xac\x87\xeeE\xe6?\xcc\xae\x18\x90\xb9,=rG\xa9J<\x1c\xf5zwT\xda\x9d\xb8\xa9\x97v\xd7Y\xda\xd0*N\x95\xdd\x1fY\xa5+\x9a^\xf7\xf1\\\xbc\x93\xd7{\xd8\x94\xa2\xd5u\xbd\xca\xce%.\x0f)\xcf'z\xb1\x1c\x8e\xfc\x8a\xf17i\xe2\xdc\x13\xf5\xd2\xcd\x0e\x8dj\xf2 M\x04Bj\x84-u\x86\xc9Q#+\xcfiL\x8d\x06\xfb\x02\xafN\xe5p|\xfaFW4>\xed\xf7\xbd\x0b5\xca\xeff\xa7\xf3\xd9\xd5\x9cV\xf2\x05\x91\xfc\xfc\x02?4\xd4\x8c'\xf6O\xd1}5\xa8\xac\x9e\xba\xd0\x93r\xa6F\xc6\xcc\xc5\xe1\xc8\x1b\xdf\xcd\xa6\xd6\xfc^\xb6?F\x0c\xe0EP\xb8g \xe6\xbd\xf2L^\xe8V\x0c\xb2\x90\xc2\xe6\xbb\x1e\x1b\xfd\xc6M\xec\xfa\xb7jIO\xf5\xb6 \xee\xf5\xae1Y\x0d\xe9\xe6\xfbs\xb7\x10\x8fM\xc8w\x15\xd4K\xb9:\xdf\xcc\xc2\xbf\xbb\xe1\xa1\x9b\x97\xe2\xad\x85\xfcL\xea\x12\x14\x08!\xca\xedgUDEs5\xec-\xb8\x9e\x85\x7f\xb7\xae*:\xe9\xac\xc8\xd8\xb5dS\xb9\xcf~\x8a\xaf\xa4\x06r\xacJ\x9d\xb7\xe1X\xb2\xe9\xbf2\x1c\xcb\xe7\xc1\x99<\xde\x84I\x98\xc1\xab\n{\xd0\xafHi\x02H\xb6^\x9d@J\xef\x8f^\xc0n\x97\x83\x0eqt\xd4\xae\x1b\x92\xe9+v@`\xa9U\xf3\x9c\xef8\xe3\xb5\x9bs\xa5\xfb\xd0&\xe1\x15\x14\xce\x87\xd4up\x18&\xca\x9e\n\xb7\x9e\x9fx}\xe7\xcdU\xf6\xe2k\xa7\x7f\xedF\xd5\xda\xa8\xbc\"Xp\xd30\xa5\x8d\xc0\xbdz\x16x\xd4\x87\x94}\x81\xfa\xce3\xff\x99\xd3O\xe1\x0f\xf7\x974J\\\x94\xff\xec\xc5\xd7\x8e\xf7\x14A\xd8\x05\x88\xcc\x0b\xa4\x03\xbb\xa3\x12	\x01\xfa\xb8	Xq$x\x9d)\xc0\n\x0d\x97a\xc8\x05\xb2\x8e\xd7vU><,\x8a\xed	C\x99W\xeab\xff\x15\xd6p\xa5\xb7{\x82\x82J\xac\xcb<m9\xfb\xd4\x9dV\xb4\xdax\xb73\xf0\xe9IW>\xbe\x00\xc7v\xec>&\x11R\xb9]\xb9\x1d\x9f\xd8\xaan\\\xe9gp\x138\x1c\xe1\xbf#q$\x123W\x01{\x8f\xb3\x7f\xb9\xda\xd8\xbfO\xcf\x15\xa1<,\x95\xc7\xf8\x90)\xe7\x19L\x86\x10\xcc\x90\x8c\xb4\xe6\xa5'\x82\xb2\x82\xc4\x08 \xea\x13	|g\x00\xfe\x97\xd8\x04\xa4\x11\xa2+!\xb96\x17;\x1c\xd9B\xf1\xd4\x0d\xe0Y\xb4\x81,fpy\xb9\x8cn\xcf\x96\x0f2\x16\xe1,\x9eO\xdc\x0d\x03'\xe8\xc9s(\xddcT\xbf\n\xaf\xd0\xaf!\x19\x12\xaa\x9f\x99\xefh\x16\xcfK\x81\xf00\x9e\xdf.\x0fo!\x13\x01-\xb7\xf1<\xabk\xb9E{^7\xfc\xc0X\x14\x1f\x83f\x88\x07\x8b\xed\xbcT\xe7}\xdcE\x96\xb1\xe4\x9fhF\x8d\xe2\xc1;\x9c\xb9~\xf2\n\x1e\xaf\x14\x8e\xeb\xa2\x8d\xe3\x8a\xa2#\xe5\x12m\x99\x0e\x87uWD\xd0\"\x97\xe70\x89\xa5\x1b\x0f\x91\xc8\xebP\x04\x08]\x9c\xb0\xf1\x81\xba\x06e\xce\xde\xb0\xad\xb4u\xea&\x04\xdd\x1a\xd2s\xca\xcf\x0c\"\xc0\xf1|6\xfc0&C\x14\xc8\x96b\x0e\xcf\xc8\x0f\x08\xad\xee\xd6\x8b\x17\x119M)\xbeg\xd5\xf6\xc3\x05\x8e\xec\x93\xd8\xb0\x89\\\xb4]q\xd9\x14\xd8\xc2S\xc9\xac\x9f#\xd0\xb1\xd5\xcf#\xc8k\x1ac\xd3\x1e\xdb\xb2\x1c\xc7\xb5\xc1\xea\xf5\xdcVg\xea9D\xaez6\"\x04\x87T\xc4\xe0\x17\xaeS7SO\x9d\xbdM\xbc=n\xc7\x1b\xdeJnGw\x0b\xaf\xeen\xbcz\n\xd2\xb4\xaem\xc0\x1d\x18\xf3.Pp\x02\x8e\xb8m`\xdc4\xdf\xdf\xa8\xc5\x1dT+P;\xfd\x11\xbc\x835\xea\xb1\xf5\x13r\x12\xeb'\xf95\x98\x02\xda'\xdd\x86\x96\x0e\":\xd9\xc8.{x\x8c&Q\xd5E\xa9,\xcb&\xac\x8b]X\xe5X\xf74\x12\xc1\xb5\x0c\xf6\x84\xb8Yw\x97gg\xb9\x91\xd7\x83(\xff\xf6v\x0d\xeb\x01H\xc8\x80\xd4\x9d\xa8\xc2\xaa\x17\xe2V\xae\xedl\xeb\xeel\xe3[\xf8\x1a\xdc\x00\x1e\x86\xe6E\xe2\xd1\x1a\xaa\xeb\x06Y\xc9\x83\xb24\xf0\x0e\x83/0\"\xb1\x0d\xc9\xb0n|C\xfb\xfbN\x06\x9fb\x85T\x99\x17\x89m\xf7P\xec\xfd\xd4\x13\x0f2\xe8\xf0\x84\xbc\xec.\x87_\x8f\x17\xfb\x81\x084\xa8\xc5\x1d\x04\xd9V\x07#w\xeb\x89(\x85n\x06\xbfk/)\x96N\x1dcd\xf5\x14\xc6\x08\x8b\x12n\xb5\xbd\x9c\xbe\xad4]C\xe0#\xb4\xc2][\xba?\xabr\xceE\x0d\x91d)\xa2\x1c\xd6\xc1\xf4!\xe8g\x12\xc3\x7f\x1av\x8fv\xffS\xd9\xf5jv<\xb6\xcej\xe1\xecT\x05\xb6\x91wZP=\x84\xb9S\x19\xb4\xe2v\x92\xab\xbdA\xcb\xf6I\x8e*\xe0\xe3=\x86\x13cv\xfdR\xb4\x95g\x0c)\x98\xd6\xaa\x9bQ\xd4'\xfc\x93t\x15Z\xecT\xdd\xb7\x0c\x11\xd8\xbe\xa4q\x93&\x15C\xcaQ\xd1\x9aR)\x85/\x9b2!3\x0e\xfa}\x8f\xfdW\x1a\xf4q:\x0b\xe6X\x02\xb6 	\xc7p\x04\x97m\xfcC\xa2\x1c<\xcc\x82\xb9Ll\xcb\x0d\x15d\xce\xd3\xee9v\xb7\xa3Y1'\xf6\x17\x0f\x8aA\xe5\xa4\x8a	n\xfa\x17\x15\x02YH\xb9\xe9\x95\xc6\xb7\xc5\x8cx#\x12\x90\x1e1Bk\xe7\x80+@\x9b\xdd\xed\xdcP\xceB\xf6{\xc3(\x8e\xeb\xf0\x04 \x82\xc3\x81\xe1\x82&n\xc8\xb1t\x0e\xa0\xc9\x08\xf0\xe4\xf2\x93T\xaf\xb0R\xa9\x19\x92\xff\xd2\x90l]\xfe\x85*\xf9Q\xce\xf8an\xbeQ\x9d\x07\n\x19\xeb\xb5 c\x10\xdcn\x19\x95Kc\xc0hM\x80\xf7X\xf4z\xdcu\xd2_\xa9\x16\xf2W\xb4\xab\xc9\xfa\xb0b/\xf4H\xd0;\x8c!\x07r\x9f\xca?\x8cW\xee\xc3J\xfcp\xe9\x89\xcb\xd5\xbe\x18\xf9\x97+fE\xee\x08\xaa\xa1:\x12\xfc\x99\xf3\xe0\x08\x07!:r\x84\xc1\xd8$\xcb(\xb9y\xdf\x04\x85U\xce\xe9L\xb5\xeb\xc8\x10\x8b\x82\xa8\xae\xdf\x14&2\x03o7\xbc\"&\xb6\xbb<C\xcb\xda\xb9	\x8b8\xbc\xdfS3\xe5,\xb4\xd5\x1c\xf9\x99\xd5j\xbc\xe3O\x9b\x0d*\x85\xf5Q\xb5\xe7M\xb9,\x83df\xd7n.Y\x9bp\x03\x83{c\xb0\x07\xe3\xe6qP5E\xa6\x83E\x10/6\xb1m\xd5\xe76Z\x01\xf4\xfe\xaep\xb4<\\\xadx\xb6@\x17.N\x94^\xa2j3-\x1a\xb6=Q\xee\xa2\xb2\xa8\x95\xa0\xbe\x98&\xcb\x0fiz\xcb \xc5\x05\x9e\xeb\x85\xc8b\xb7\x1bi<Lt\xf0oiz\xfb6\xba\x8d\x8a1\xf6\x06\xe3\xdc\xb2\xe2\xac\xa8,n(	X,\xac)\n\xa1e\xb3\xb2\x04\xb0\xa5\xbd\x8d\x12d\xd1]C\xd1O\xb7\x92\xd6\xc9\xa9b\xc7\x9b]n*L\xeb\xf3\xc3L\xe4\xac\x18<\xf4\x0b\xe5\xb0\xf3\xe2H\x14\x83m\xbf`\x8f\x1d0\x89(\xbe]nm\x01\xaa\xc1\xdc\xedt5\xb5\xd6`6n\x9a\xc3\xd8X\xc1\xe8\xe5n7\xa2\xba\xde\xa7\xc1m\x87ygs\xcd\xdb\xb9\xb0\xa6\xd0=(\x02c\xf3\xad[\x99J\xd6&v\xcf\xf0\xb4ViW\x89Z\xc5\xdc\xae_Kx\xda\xdd\xb7\x04:&\xa9\xd2G\xa3E\xc0U\x91\x7fp\x01y\x0b6#R\xcf\x11\x9e\xc3\x81\x02\x81\x93\x89\x08\x0dd\x84\x9c\xc1T\x8b\xdc\x90D\x04K8\xd86\xccq_\x80\xefWn\xcd\xb5%\xd35&&m\xefx\xb4\xba\x18\xee\x19\xa9q\xc8d.V\xea\xa0Q<\x06\xad#mS\xb8\xf53i\xb7k$\xc8\xd9\x1c\x9fZ\x19l\xd8d{\xb6d\xf7\\Q\xc6(\xb93=\x95\xad\x14\xba\xa8\xd6\x85\xdbz!\xeam\xe1\xc2\xba\xce Z\xb4\xed\x93\xb7\xb1^\xf5\xf9Z\xcb=\x85\xcf\x1b\xd6\xfb\x9e)h\\\x00\xae\x07P\xa7$\xac@n\x8aA\x80p\xc8\xa6\xd5\x8d!%\xb4u\x8b\x1e\xa9\xaa\xa9\xa9\xa9\xc2I{H\xfdzI\xba\xc2HF4d\xc9$\x9f\xba\x11\xa2\xa4x\xfeo\xf1\xe0\x95\xc22\xe0\xf4;H\xb7\xe6\x08\xeb\"C\x14\x05ly\x84\xe2\x98\xc1\xceS\xc0\xbasN\xd0N\xc0\xa7\xb8H\x7f\x8c\x1e\xc2\xd8\xbfs\xefW\xc2\xb1\xba\x03\xad\x93\xcaBh4V&\xcb\x96\xd4\xf1\xf6AD\xd5g\xa8kZ0\xe0\n^\xca5\xed\x82ty\xe5^\x12\xbdPI\xc5\xa6r6:z-\x8e\x8e\xe7b3\x95\xb3\xd9l(^\x0e\x8e\xe7b\xf6\x85\x18\x8d\x06Gs1\x1b\x1d\xe3\xe9\xab\xb9\x98\xbd\x1c\x8a/\xe6b\xf6\xeaH\x0c\xf0\xf7X\xff}-\xbeP	_\xa9\x84\xd7\xaf\xcc\xdf!\xf2\xd1\xbf\xaa\xdc\xb9\x98\xcdF/\xc5\xe8\xf5`\x84\xa2\xbf\x12\xa3W\x94u\xf4Z\x1c\x8d\xd4\xd3H\x1c\xbdTo9\x1f}s$^\x1e\xa9\xe6\xbc\x12/\xbf\xa4\x16\x8e\x8e\xcd\xd3K\xf3\x96\xf3\xd17\xaf\xc5\xab/\x06\xafQ\xcf\x918~\xa9\xde\xbf4O_\x9a\xb7\x9c\x0f\xdf\xbc\x16\xaf_\x0d^\xcd\xc5\xecK\xf1\x05Z\xfd\x95\xfa\xf3\xa5N\xe6\xf7\xc8z\xf4R|yD\x05\x1c}%\xbe\xf8j\xf0%\x8f\x90z::6o9\x1f\xbey\xf9\x85\xf8bH\xdd|\xf5R\xbc>\x1a\xbc\xc4p\xbe\xd2O/\xbf\xd2ou>|\xf3\xeaKq<\xa2\x019\x1e\x89W\xc7\xd4\xe1\xe3\x97\xe6i\xa8\xdf\xea|\xf8\xe6x$^R\xbe\x918\xfa\x92J<~i=\xe9w/\x8fU\xee#qtD\xdd;>\x16\xa3/T\xb9\xaf\xcd\xd3K\xf3\x96\xf3\xd17_\x8a\x11z%f\xaf\x8fhU\xbc~\xa9\xfe\x0cu\xba\xce1\x17\xd5\xa2\x1a\x8a\xaf\xd4\xdc\xbe~e=a\xa0_\xbf\xd4\x7f\xbeB\x15b6\xaa\x1e\xf4\xb7\xf8;\x9f\x8b\xd5T\x0e\xc7\xab\xe9\x9b\xcdT\xf3@\xabi\xbfo\xb4\xab\x0b\xbc^\xe0\xf5l5\x9d\xeb,\x0bdQI\xb3\xc5\x14\xec\xca\x0b9\x1a\x0e\x8e\x85\x956\x9a\xbf\x90\x87\xa3Wv\xd2p\xde\x97\xf1\x14[\xc8J\x1c\xa9\xc4\x91\xd2\xf7.\xa7\xf2\xd1\xf9\xc7\xff\xf2\xdf\xfd\xd3\xff\xf1\x7f\xfd\x7f\xff\xfe\x1f\xfe\xf1\x7f\xfdo\x1d\x7f\xf6\xf2H|9\x9c\x0b\xe7\x1f\xff\xc3\xff\xfd\xff\xfe\xc3\x7f\xe7\xf8\xb3\xa18\x1c!\xe1?\xfd\x8f\xff\xcd?\xfd\xc3\xbfw\xfc\xd9h(\x8e\xe7\xc2\xf9\xa7\xff\xf8\xbf\xfd\xa7\x7f\xf7?9\xfe\xecp4\x14\x94\xe3\x1f\xff\xfb\xff\xf9\x9f\xfe\xf7\xff\xc1\xf1g\xc7\xe2x^\x8a\xeb\xa9||\xbf\xc9\xf3(\xc07C\xf1\x1ay~\x82\xa5\xe1\xf2\xd99P{r|\xfc\xd5W\xe2\xe5\x97\xcd\x17\xcf\xe0Nv\x1bf\xd1\"\xa8\xf2\x94bM\xdb|t\xf4r\xf0\xeax\xf4\xfa\xf8\xe8\xf8\xe8\xf5\x97\xc7\xc7\xaf\xbe\x10G\xc7\x83/^\x1e\x1f}1z\xfd\xea\xd5\xf0\xe8\xe8\xf5\x08\xb3\x80l_}\xf1r\xf4\xea\xd5\xf1\xcb\xd1\xd1\xab\xaf\xbe\xfa\x9cl\xc7\xc3/^\xbe\x1a\xbe~\xf5z8\xfc\xf2\xcbWs\xb1\xaf\xd6O\xcdW\xafv>\x177S9\xb3\xcfEg\xb1\x8a\x92@1V\xb7\xc1\x1af\xbd\x08\xa5\x9f&\x8c\xff\x03\xea|\x1a\xbb\xf5\xe9\x12\xd7\xeefZc\xd1\xd8C\xa6\xd8\xd6lu\xa0A\xcc\xa24\xf3\x11nQ\xc4S\x90\xd9\xf6WK\xd7\xd4VKW\xd7\xc8r:+H\xdf5\xaf\xc5\x10-\x06\x8a\x06\x1dG\xb4\xd6\xe0\x8c\xf7\x82\xd6%\xc8\xa3\xbe<\x0c\xb1*G\xd0\x03}Ne\xd7\x9fP\x19\xd5E\xd5HT\xd2\xaa\x01Z\x9f\xfap\x9a-\x16\xca!\xa8F\xdd\x00\xbd\xc7\xc27\xd1\xb8\xdf\x0f=\xe7\x1f\xff\xab\xff\xe5\x9f\xfe\xc3\x7fT\xd3\xc0y\xe0p\x81\xde[S\x82\xa4\x9b0\xbd\x0d\x0b\x88P\xd4\x04\xed\x1d\xf356a\xe9\x95\xf3\xf1\xd5^V\xf7J\xb3\xba\x84wQ\xe3t\xe3\xe4\xc6Ax\x80\x02\xe8\xf9\xeaf$\xcdh\xed\xbe\xaf\xba\xc7\xce\xf0\xd4L\x11!b\xf7\x1bc\x10\x13)\x9c\xa4p\x16\xcd\xabK\xd6`$\x0d\x99:\x80\xc7Y\x9c\x06\xcb\xdf\x86\xe9\xef\xf24i\x9b;e\xdbG\xbb\x16YL~\xbap\x0b\x04\xab)\x17A\xb1X\xa1A\xc5*K\xef\x9d\xb3\xe4.\x88\xa3\xe5\xb3\x1bU\x14L,n\x83\xe2\xdf&N\xbf\x18\xdc\x86y\x1e\xdc\x84e(\xc3\xdd\xee\xb1\x14D\xe6<\x96\xe6~O\xa4]\x89H\xe5{#A2F\x0e\x81V\x84e2\x81\xc8\x08\xd34\x06\xac\xd0*\xc8\x7f\xb8O~\xcc\xd2u\x98\x15[7\xf3&\xd1,\x9b\xfb\x990\xd9d\x06\xc5MX\xb8*\x8d\x89\xcd`\x89n\xd3\xf8\xf2\x0b^w,\xb9\x90\xe1,\x9b\x8fa7\x8er\x0c\xa9v\x91\xbaXJ\xd7\x85\xc8\x07E\xba\x16:\x00\xb8\x89\x87\xcfH\xa0\xdc\x19\x04\xf0I5MM\x12	\xd8\x1d.\xdd\x9b\xfa^.4p\xb7\xfeSUgM\xfe\xa7H\x05\xc6n\n\x9e_\x11\xb2\xde`+\x0f\xd3\xc1\xf60\xe5\xd6i\xc7\xda\x7f]\xb9\x01\xfb\xd0\x06\x8aa\x1ag\x08\xff\x7f\x88\x7fE\xd0\xc1\xaa\xec\xe5\xa4\x00\xe2H\xa3\xb8\x97\xaa\xb4F\x99c\x14Y_}\xb3\xfdW\xd9<\xcf\x90\\\nk\xf9\xf8\x1d\x86\"\x97X\x9f\xf4\x16\x91v\xb0\xf8\xf0\x8eZ\xd7\xf1Y\xa3W\xb5o\xad~\xd9\xf2\x85\xeak:\x04\xf5p,\n\xafV\x14R\xcc>+4\x8b\xf9\xc4\xa1\xc1\xee\xad\xe8d\x9bA\x1a\xbb$\x9dI\xaa\xf5En#p\x9bl\xd5J\x19\x95\x9cI;~?\xcd-\xe1(o\x9a\x07\xab\xe0\xc1z\xa5\x9b\xfd\n!\x8f\xe9\xa8-U\xb4\xc5\xc3\x83\x85	\x1eC;\xc7\xeb`y\xce?\x85\xe59o\xb2<\xa5X\xb9W+q\xc96\xf7\xb7S\xf9h\x9d\xe3\xf6\xd1o)7\x84rZ\xb0f\xbd2\x7f\x98\xcd\xc7-o\x05\xba\x1e\xac\xcc\xd5\xbeg 3\xb2\xc9\x0f\xe4}\x0eurF\x92\xc5\xab\x95\x9b\xf6\x13\x00\x02\xf6z\xd0\x0f\xd1Q\xac~\xe4\xebp\x11\x051\x8c\xa9r\x1dn\x1f\xeb\xec\x1d\x8a\x81\x7f\x86\x91\xb2\x19\x93Gl^\x92\xbb9\xc0-\xa0\x9b/\xf3\xc4\xd9\xca\xcd :ik\x06d&2er*\x0b\x91i\xb7\xbaw+\xf3L]n8P\xd4\xc5\xf6\xd7\xaa\xdfO\xf9L\xd4\x94\x15\x94\xbd\xf2\x90\x18w4\n\xaa\x03md\x95XX\xbf]\xceY\x18\xd3\xda\xb9\x0c\xd0\x1fj\xc9\xf7i\x8e\xe5\xa7\xf4m\xf56\xbc\x0b\xd6\xa4Wa\x98\xd9D\xeb,\xf0`B\x0d\x950)K:\xe6\x93&0\x81\x16\xbe\xdbr\xe2Ys\xaeJ\xa8\x92y\xb2\x13\x91|l\xaaS7\xf3\x10\x03\xa4\x9a\xde\x0b%\xd5 A\xaax\xbaR{	\x94\x9eY\x059\xb4\xc5\xd5\xf4\xeag\xc5\xc5\x87\xe8i\xbd\xd0\x8eY\xc9\xb1\x8erQ\x90\xaa\x00N-@\xee\x8c\xe28\\\xaa#?\xdf#LQ\xb1\xf0<\xad\xc5\x19kR\x02C\x99b(\xa16\x94\xe4\xf4\xc9\xcb\x1f\xb4\xe5A\xa0\x0f\x8b\xea0\xcc\x88\xca\xc8e0\xb8\x0e\x83b\x93\x859\xbb\xa8\x1a\x92\x03\xb67\xe4\xe0\xe5\x02\xcb\x87(\x0e\x81'%\x98P\x99\x0dYi\x19\xea\xc0W\x15\xd4\x1f\x08\x12\xd0\x8c\xf8pL\x8eU\xee\x06\xca\x9a\x16\xcd\xb2\x81#\xd0FF\xb3\x0d\x19\xd8X\xe1\xb760I\xe4\xa6'e9^\xe6\xb4\xe2\x1dq;e\x0d\xf4~\xb3AZ\xcc\x0d\xa3A\x1c*s\x11\x93\xb3;\xd6\xb2\xef\\\xa5\x0f\x8eH\xc2p\x99\xc3\xb3\x1bA\x0b\xe1\x00@\x86\x87\x04\xeb\xef\xcf:L\x0c\xef>\xd9\xc4\xf03\x83\xb6=aZ\xd82,\xb4\xe3\xa4YQ\xda>\xdaP+\xef\xbf@{;\xdfTm\xa8\xe8\xf5\xff\x9c#\xb4\xc9\xdb\xe9\xa0\xb9\x01!v\xe4{\xd7\x9cp\xc2F\xba\xe3\xdbC\xcd\x99}>\xeeQ\x90\xf0g\xfa\xc0\xd6\x02J\x1cC\x07\x1aDO/WZ\x9c1.\xfdtV\xcc\x19\xdf\xad:k\xed\x1aT1\xc6W\xab~P+\xa5\x89\xa7\xeb\xbe\x0d\xd6\xa5\xb8\x84\x05Lk\xad\xe02\xa6\x9e\xbc\x0f\xee)\x08\xb8\xdffXu\x1d\xecJ\xcb\x1d\xe84\xfb\xe4\xb5\xe9\x89\x1aI\xda\x18\x9eO)\xd8\n\xb7\x03\xa5q\xf8\xf0\xc35C\xe3>i%\xabW]\xb3l\x11\xc9\xefR3X\xba\xafDP\xb1o{\x05\xbc\xcb`o\xe6(\x10\x01l\x02\x14\xa2\x9d\xd1\xecg\xfa\xdc\xcbe:\xcb\xe6\xacOQq\x0ek\x0dN`\xf3\xb4g\x94\x18\x13\xae]\x00\x08\x91X\xe4\x9e\xb7\xdb\x05\xea\\\xfc\x00,\xd8L\x9d\xc6\xd5\xd9\x18\xb0\x05\xadx\xe6(;]\xd8\xd1\xf6?\xa4n\xc2V\xb6\xa0\xfe`\xcf\xaf\x0c\x8a\x7f\xd4\x16\x8f\xf6\x90\x19\x97\xbb=\xd3b\x19\n\xef\xb37\xd6~%j\x0b\xb9\xa1Y#	\x00>,\xda\xd4M4\xb3Y\xee\xd1\x82Z\x1b\x0dW\xb7,\xf6*]\xad\x9cZ\xed\xfa\xb9\x06\xc4\xb8\x16\xc4m\xb0\xf6\x1dG\xc4\xe1u\xe1k\xbc_Q\xa4\xeb\xeaG\x00\"\xb28\x07Q\xe0\x0f\xbe8\xa6hzo\xad\xc0\xbc\xb08F'\xdf\x03>\xb9f\x87|\xc5l\x04\x91\xef9\xedg\xed\xd8F\xcf\xe8\xa2?\x12\x15\xbd\xa1\x92\xd9 \x19\xf5\xe0Z\xd2\x0eg\xc3\xe1\xd0\xd9\x1b]op,T\xb0\xbd\x13\xce\xfd\xea\xd5+\x9c\xf3a\xa0\x13\xc20tJ\xa1\xed~\xfc\xc7Z-C]Kvs\xe5\x8e\x86\xc4\xbax\xf5\xda\xac\xb2\xb2\x9b\xab\xc0=:>\x16G\xa3c\xe4\x1c|\xe99\x00\x9d\xf6\xc6+\xf7nj\"\xf5m\xa7\xd2\xf9\xb7\xc3\xcbpq\x19\xa1\xcf\x01M\xde\xe5\xed\xa6\x08\x1f\x9c\xf1\xc2XN\x17\xc1/\xe1o\xe3\xf4*\x88O8\xfc\x19\x83C\xdfX\x89\x17\xc1/a\xe20P\x8f\xb6\xe6u,\xb9\x9b\xf7Xzb\xe5\xbe]\x89\x8bKU\xfd\xc3T>\xc2\xc7\x8e\x96^\x98\xf9#Q\xb0a\xfdH\x01\xa3\xfa\xa3r\xfca\xaf\xc4\xea\xc3J,\x9b08d\xcal\x00\x06*\xa2]\xbbM\xda\x01\x02\x18\xb7\x80\x0f\xb7\xc0\x84\xc9\xd1\xae\x93O\x85\xd9 \x92\xdc\xea\x1c\xc2\xa5\xeeva\xfb\xa4GP\xa1\xdd\xcee\xe4.U\x97\xb1B\xe9\xb25\xd6\x8e\xc8 r\xc8\x11\x8b\x16\x9fX\xc9\xca\x1e:7*a\xb6\xf0\xde\x94\xe3\x83\xd8\xd8i\x0e\xbd\xdd.\x9d\xb0	\xe8\x8a\xa2\xf3\xc5b\x05\xde(\xb6m\xbc\xa8	\x0b9\xb3P\xca\xe6\xe2Z\xce,\xcb\xb3\xda\xab\xb5\x9cq\xbc1\xf8m\xd5\xb2\xe9\xe4[\xd0\xae\xe3\xa5[q\xf2\xf6\x00\xa1\xbe\x88\xe3\x18\xa8\xc8\xd0\xdenwk\x07\x8a\x06\xfb\x90\xdd\xe3\xc8\xc7\xd3\xa9\xe5\xb6\x13\x14\xab\x1c\xd2=`\xb9\xb3\x852\xb5?\x15\xb9tO8(Gu\x97U\xe5\x17^e\x8f\xb8\x00h\xf7I\xf5\xfb\xda\x13w\xf2O\xc47l\xf1w\x05\xabF\xeb\xfd\xda\x13\x97\xf6\xef\x1b\x1d\xb3?\x95Y\xed\x0e\xe1\xda\xa8E\xf72k\x98\xc8\xa6&\x86\x05bt\xdc\xf7z\xee\x1d\xc1\x8a\xca{\xaf\\\xba\xa1%\xc6m\x89\x90\xb5\xab\xab1v\xf2\x1e\x8d\xfd7\x86\xe4)\x1f\xa7b\xa0e\xeepq2\xfcE\x08h\xf17n1\xa0-\x1f\xa5Y>\xb1\x9e\xf9\xfa\xf4\x87\xde8\xec\xf7\xbd\xcf\xa8\xcc\x14\xa1\xf8Z\x98\x80\x03X\xda2\xeb\xe4@q\x83\x9a\xbb\x02\xf0\x08\x93\xc1b\x13\xc7Qr#\x0f\x86\x1c\x8e\xe2\xa1\xe6Ux./k\xbf\xdf\xc9\x0c8\x11\xb8\x9bi\xb0\xdd\xac\xfb\xfe\x16\xa9\x07,\xed\xac\xd73s\xc2\xc8\x9f)Me\xb0\xdb\xbd\xeb\xf5\xdc\xab\xdd\xee\xdc\xdb\xed\xcez\xbd3r\xda\x7f\x8b\xe5\xac\xce\x90\x0bq*\x83\x89\x9a_?\x1d\xbb\x07\xd9n\x97~-\x87\xe0\x8c.d\xa1\xa6|J\xcbuy\xeeV[4\xe4;O3\x84\xda\x15c\xf4b\x03}\x18\xfe@\xd9H~\x1a\xb6\xff\x15\x02\xeaM\xd9\xd2uj\x9b\xc1>\x96\x1d\xc6\xae\x17M\x13\xd7S}\xc1\x92A*\xef\xaaf\xd0\xbcQ-X\x9e\xb9E\xdb\x91\xf2t`\x08p\xc2\xb4\xe9\xa6^I!f\xb2A\xde\xb4\xdfOEdE\x97\xd9\xbf#\xc7\xc9\x80.\x0f\xb4Y>\x1a\xd7uu\xca\xe2\xb8\x16\x9a\x10\xf7\x8b\xa6\x17;\n\xd0\x9dR\xa2B\xff\xa4\xd7;a*\x1a\xfcvY\xba\xd1\xe0R\x8b_w;\xfb\x17\x0cK<mk\x08\xfb\xd8Hl\xc5\xa3\x1ab\x9a\x80\x1f\x92\x8bt\xb3X\xf9\x07\x07Z\xcc\xc0L\nv?\x81^\xc6\x1c~\xcc\xd8\xd5\xaa\xdb\xee$M\x8a,\x8d\xe30c\x0b\x0b\xf1\x9d\x8e\x0d*b\xdcH\x9e\xf8\x1e\xa8\xc7\xb1\xf7\xd9\x16\xb8\x0bS\xf4\x80-p;^}\x9f\xe6\x85|,+\xbb'\xf3\x91E\x91uD\xdb\xc5V\x0b%_\xf27a\n\x9b\x14G\xd4\xe7$6\x82\xa9p\x16\xf7\x9d\xb3\xa5C!\x9e\x96B\x81\x13\xa5]WX&\x9b\x8d\x17y+	\x8d\xae\xc9\x7f:d;B\xbdWX:\x1f\xd2\x14b\xf9l\x10&\xe0t\x0d\xc4\x14\x1aMa\xa6\xb4oKu\xdb\x8f3e\\\xbd\x0e\x12\xd8Y'\xea\xa9\xcb\x81\xf2\x92\x8c\xa7O\xd3\xfb\xe4\xbb8\xb8\x91\x07#\xf1\x0dB\x98p\xb0{\x0c=\xd4JS\x1a;7p\x01\xf1\xf3\xb8|\xf0\x0b\xb1\xdc\xfa\xa1\xe5\x9f\xc9\x15\xa2W\\#=\xd6\xaa\x14\xa9%\x1ao\xd5\xfbG\xaeW\xa4\xfbkF\x99~\xc1\x81B\xff\xec\x87\xfc\xf4\xb3\x9f\x1a\x90[^\n$+\xd1\n*\x8b\xb0\x88e\xa6\xb5 \xd0!)\x8f\xd7\xaa\x91\xde\xa3\x83\xe3\xa0nd\xab\\N\xe8+G\xccF/bu\x92\xc58\xc9,\xec%\x05\x97\xc34\xdd\xc9*\\\xfc\x12fU\xd1!D\x83Ff\x92~\xcc\x00\x07\x99{\xbd\x83\xdf\xae\xc8K\x8d\xc4r6\x16'\xa8\xb0nL\x04+\xba#r\xd5\x02\xab\x1cH\x99\x0cP\xc0S\x91\x1f\xcdX\xe1\xa2Hk\xc6\xf1\xdd\xa2W\xf0i\xbd\x9e\xd9GRW\x82X\x8c\xc4\xac\xaa\xa4\xda\xf6\xea\xf5\x0c0+\xa0/\x8bA\xb4\xf4\\\xad\x07\xc3\x95\x96\x05\xf7\x1e\x84\x868w\x02\xd5\x9e\n\xff\xa9\x18\xd8\x022M\xf4\xd6>V\xba\x8e\x0f+\x05,<\xae\x17\x04\xf3\xda,\xb8g\xb3^|W!Qr\xe52 D\x93g\xb5\xc4^\xaf\x96\x87\xc7\xc6m~\nVi\xcc\xbb\xb4\xc9\x92\x01c(l\xa0\x86q\xb8\x1eO\x17\xaf\xe6U\xe1]\xe6|\xb2\x96\xe5\xde#\xf4W\xb4\xce\xc6\xa0\xb4&\xb8\xd3\xdb\xe1\xd7\xd6Q\x8azGZG\xb2v\xeb\xb7\x05\x0c\xc6R\x14\xf3\xa4\xa3\xef%{\x88\x9c\x16f\x1b\xc7\xdd\x08Y\x1f\x10\xc8\xa4\xe1w\x18)\xea\x07By\"\x16\xf5\x01\xc1?I\xfa\xac0}\x85\x02\xd2&\x84\x04\x10o\xfe#(W\xdfb\x9c:\x00\x89KFj\x01\xfeA\x06\xcb\x8c\xaf\x9e\xe7\x00U Uk\xe6\xbf,+\x8a\x07\x14P>\xf9R\xc57\x86D\x9c\xd1\xcc6|\x8a+=HU\x1bP\xeb\x8d\xc0\x83\x02\xa6nj\x94\xf8\n>j\xa6\xbb&l\xdb\xb5\\Vd<\xb3+\x9eX\xd7R\x9b\xceJ\x1a\xba\xa2N\xe7,\xe0\xecu\xfa\xb1\x90^@\\\xbb\xdb\x9f\xcd\xb0N\x9e\xb8\x05\xefafP\x0b&\x93\x141\xe1\xd7\x8aN\xf39\xd0\x18\xf6\x90\xe3M\xeeX\xec\xf8Xs=\xea\xa2\xf2\xb0\xe3c&\xba\xc1\x0b$K\xa8\x0f\xe1\xa1\x17\x0f.\xb1?~\xe0%\xf7\xe1H\xc6\x83\xbf\x1d	\xfc\xd3\x97\xa3R<\xd8MJR\x97K\x11\xd7\xdc\xa2\xebZ\x8bn\xa9ED@V\x12\xacZ(uM\x17\xe3\xa2v\xae\xd2\xa2Ho\x9dO\xe8\x816\x99o5\xb7\xd7s\xd1\xd6\xf6\x8b\x8e\x9e\xa1m^9\xbe\xb1|\xa4\xc8\x89j\xdb\xf4\x9azh\x068\xdf\xd6\xdd\xac\x1e<\xf1\xe0z\xa5:Cc\x1cE\xc4\xe4Tr\x12}\xf4\x1b\xf1\x88\xfe]\x17\x8a\x18\x8b\x04[\x80Ps:\xaf\xdd\x11\xbb\x9d\xbe?\xc6\xfb\x05\x14\x11\x87\xfd,\xac\"\xb9@\xc8O\x9b\x02\xc1\xb1\xa5\xd1TW\xa1\xd6\xe2\xfe\xc4xsmb\x8c\xa3)\x9c\x90\xd0\x0d\xc2\x12#\xf7sS\xe6v\xd8y	\x94\x1ag\xc0\xa3\x9b\x12\x15\xe7	\xeb\x1e\x8cz=\xb0\xbe\xb6\x14\xb7\xe5\x0f\xd8*\xbch\x96\xc8>Q\xe3\x86s\x19\xd3\xb5\xe5\xb8\x0b\xe8\xb2\xaeK5\xe7oTS(`|\x0e\xa2\xf6-\xdf\xeb\x1d\x84\xb3d\xae\x07\xeb\xb1\x1c/\xdd\xa8\xbb\x17<\xa2Q\xc7\xe8\x93H\xdb>\xee\xc7\xe1\xfe[\xb2\xd8{K~\xea\xb5`\x96\x16_\x04\x1c\xb3Sd5\xa1p@\x9d\xcez\xbdF\xd4&\xc8\xcf\x83\xf9n7DT\x98\x9a\xac8\xd3\x133\x86\xb3\x9c\xcc\xfb#\xb0\xf8\xb5\x8b&\x84\x17\x7f\x94\x14~,\xd4}\xe2\xe7z\xce8\x08Ku\xb4\x8f\x83F\x8cN\xbe\x96\xd8\xebW\xcb\xff\xadp\xd2|\x9b\x15\x1eX\xbfqT\xf1\xee\xf2 E\xdc\xd4\xa0\xd1\x1a\xc4\xa5*=\x81\x19\x04\xb4:\x9a\x01\x87\xc5\xfa\xc2\xfb\x04\xed{M\xd1\xaf\x81\x8e82,;'W\x823O\x0b\xdf\x95\xef\xf1	gOeT\xfb~\xb7\x0bg\x05\xaf$b\x7f\x0d\x88\xd4|l\xdf\xb6\xb9\xb9m-yo\xc2\xc2\xe1\x14]R>\x82'g\x83\x1f\xdf\xffp\xf2\xed\xf9\xf9\x0f\xef\x07\xe7\x17\xd3\x8b\xb3\xf3\x8b\xb3\x13\xf1+\xb7	w\x9a\xfbX\xd7e\xa1\x7f\x13'u\xfa\xd1 \x02\x8e\n,\xebj{\xc9\xa5\x01\x0f\x19\xfe\x8aYp\x90\xf2\xa2\xe6\x81H\xcbTK\xa9\"\xf9\xe7\x95\xfb\x94\x11\x00\xe4\x07.\xca(\x18\x0d\x8a\x9aLZ\xa3\x13v,\xa3\xfb\x06\xcd\x1b\x8e\x937\x05\x8f\xe68\xe9\xf7=@q\xd56 \x81sYK\x11\xcd\xd8\xf7\x9d\xb5\xdb\xe1\xf8\x82\xa2l2\\\"\xa4\\\xd2\xeb\x1d\xe8\"\x1b\xa3E\xc99\xd7\x14)\x8b%}O\x82y\xc3\xeb\x8a\xf2\x91\xe8\x1ef\xb5\xdb\xcf\x93\xbc8\xf5\xb2\xa9\x9f\xa0\xbd\x1e\x06\xc4\xe6\xe0\xdcP\x0f=\x00n\x03\x84\x1e\xbc\x0d\xd6\xbb\x1d\xfd\xc0\\	\xc8/\xe8\x97\xc6V\x84\xa8\xba\xf4\x04BU\xa20\x13\xaa\xb2\xc9Q\xe9\x1b\xef6X\x7ff\xa8\xca\xdb`\xbd\xaf\x8c\xa7BU\xde\x06\xebV\xa8\xca\xdb`\xfd\xb1P\x95t\xea\\N\xe5R\xdc+\xd5\xc7e\x1c%\xbf\\\xe2T\xcb\x1dqe\xa7a\x0e\xb04\x1cq>\x95\x1d\x12\x03H\xa1`K\x86\xb0\xc7\xf8\xbd\x0c\xd7\xc5J2h5G\xbe\xe2_\xcaK\xea\xf7\xe92\xb4\x82VT\x112\x80hG\x98\x8dp\x8b\xcf\xc2\x04\xdaLJ\xb8\x8b\xc2\xfb\x93f\"\xdc\xdb/\xb20\x94a9>\x9f\xee\xd3\x8d\x9cO\xc93\x1d|\x99e\x07Xm\xa1Z\x0b\xde\x0cK\x81c\x0f-lI\x8c:\xc0,a\xfd\x16Ir\x0c\x13\x05u\xc9\x13\x97\xb0\x87\xc39\x0c\xc9h!\x1fI\xd5\xe5\x17|\xd0'`h\x06\x94\xb6\xdb9\xeb,\xa4G\x87]EgJ\xb6\x00D\x1d\xee\xad3\x1fW\xb9\x00\xff\x06\x07K\xdc\xa4A\x1c\xb3\x01\x80%\xd2\x86\xea\xf7 \xe9\xf5\xb27\x81\xde\xe5P\xff\x06\xd0\xc7\xea~1\xff8\x06)Z\xd8\xe5\xd6\x0b\xd5\x91&N1\x9b\xd3d\xa9 \xd4\xfd\xf6\xce\x1b\x8e\xadY\xaf\xacw\x94\xb9sm:;\xac\x18k\xefg\xd1|\x9c\x0c\xba\xaau\x8b\xfe\x08bs\xb5\x9a\xbe\xa6-,\x93\xbaA/\xaf\xb4\xb0\xaf\xdc(1\x85\xdfl\xcfl\xdb\xcf\xca\x0e\x13L\xe0\xd2\x05\x04w\xcd\x1as\xdc\xb0L\xb7\x1b\x87\xc3]w |\x93\x90R\x80\x89\x1f\x18\xb0\x0d\xac*\x01T\x05Q\x89.:-Km-n3\xbd\xaa9\x1cp\x82\xf3\x1e\x0c\xff9m\xd0\x95t5\x00\xe0\x18\xc9\"\xc4\x94\xd7\x1bQ\xd5\x07C\"\xb6\xf3\xf0\x1b\xdbu\x1c\x8d=>4#\xdc\xf0\x91\xb5\x95\x8dX\xb4\x02\x8c\x13!U\xf8\xa4\x99\x86\xde\xbe\xb4\xff\xab2@\n\x10\x11X\x19!\xe0l\xd1\xb4\\}\xbf\x82\xc1\xd6\xa1\x99\xfc\x0ea\xa6\xc9H\xd1xj\xa7\x06\xbdk\x92E\xb5\xc2\x851\x08nVP?=\xea%\xde<Q\xa2*\xadmc\x02\x1a\xbbQ\xf9\x9b!S\x9d\xc6\xb1VWC\x0bq\xc9\xb6\x17\x15o_\xff\xdcF|~\x1b\xde\x851\x93\xedz\x9c\xd3\xddnx \xeb\xab\x8bWV\xaf\xe7\x0ee\xf7+\xc8\x96\xf5WQ\xfe\xed\xc3:H\x96\xb0\x97\xablX\xde\x86\xc1]\x98se\xd8\xb1F\xd6P\x087\xdd\xed\xc2\xdd.\xaapI,\x8d$`\x80JQkm{\xbc\xdd\xfa\x0c\xc6\xa6c9\xd1S*\xfa\x06]?\xf3R\xd4[\xf3\xd1\xc9\x8b\xab\x96\xd3\xa2R\xa8>]\x91W>uQ1u\xbaoQuV\xc0\xd3\xb3\x7f]}\xa4\xd0\xf7\xc1=U\xf3\xd1\xee\xea2\xf5\x07\xcd\xf5C\xe3w\xb6\xfc\xf45\xbflt\xd4+E\x94\xeb\x13\xe7\x87kk k\x1e2\xf69\x12\x8e\xe9H\x0c[Gb(\xb5\x835\x8eX6)\x82\xf6.\xcaO\xc3|\x11&\xcb )\x1au\xe8\x91\xe4\xf5\n\xf6\xd1n\x0f\xbbs\x94\"\\\xec\xa3\x1c\xc2\x05\xfb\x01\x15Y\x18:\xfb\xe9\x02\x944\xc8\xd2\xb4h^\xb1\xd6=\xd4\x15\xf7\x92\xf7\xb74\xe3V\x9f\x0f\xb3W\xd9]!]\x86\xa4Q\xae\n\x05#\xd8\xd9g\xd3\xa0ZV\xb70\x97z\xf5U\xcb\xfc\x11\x13h\x1c\xdd\xa9Rx.\x11\xa3\xce\xb7_\xf4&!\xef%\xf2@0\x0b[\x1e\x8e\xcc\xd5\xa5>0\xc1\n\xad/\x8aZ\x1f#\xcf. *\x05\xc5\x1bSG\xa7uI\xb1\xb1\x15\xaaR\x11\xc9\xaa\x036wy\x0e\x95\x91'\xb6\x8b|B\xd3W\x93-\xd1\x952\xb6\xa2\x87fb\xebF\xdeD_\xf3\xfe\x08z\x01f\x14\xd8\xe5\x08:\x84\xf3)\xf4\x0d\xd0'\xa7\xde8\x9cD\x0b7\x87\xebvJC.s\x91\xf2\xb8\xa9O9\x04\x87A\x13\xcbB2x\x8e\x8d\x17\xdcF\x0e\xc7\x9b71W:\xde\xc0\xd8\xc0\x8dg\x1bDSg\xcd\"j\x0d\x17P\xfc\xa83/\x17\x11\x9fW\x9e6\xf9\x1b\x8dq\x99\x0b\xd5\x88n\x1a\x8a\xc1\xebs\xb9\xb9p\x03\xa1\x02\x08\x9bK6\xf7\x8d\xb5\xae\xe5\xf2\xa6\x10?\xb3\xd2c\xe9wvA}\xd5+3\x1e\x00\xb6\x10k\xdb\x0d<\xf1\x97\x95\x12\xc3\xe1\xb7\x1f\x03\xbal\xb3(\xfc\x94\x1f\xa6E\x91\xf1V*\xd1N\xd5D@A\x94{\xe3R\xab\x8d\xa7\x8c\xa1\xcf\x92\xebT\xdb\x9c5\x8c\xa3\x9f\xb0Hf\x01\x18\xdb\xe1\xe2\x8f\xd0\xc4\x94\xafb\xf3\xc1\xd9\xad\xe0\xd1\x04\xd5.\xc8\x05!\xe1\x14\x19\xb1\xe1zm\x89\xb9\xa1\xd1\x11\xc1	.\xad6\xbeE\xd1W\xcb\x10\xf6\xf5t+Q\x98\x0e\x00T\xd3/m\xfc\x04C\xac\x90\xee\xd2i\xb2<I\xe3\x18\xac#\x9dW*\x9c6j$r\xf8k9\x9c\xb4S}C<\xf1\xe4\x7f\xac1Zh\xd3\xba_\xd8\xec\x19\xbb\xcb\x0e\\\x88`\x93\xfa\xb6\x97\xa1\x0e\xd3\x02a\x9ej\xearr`\xfd\xf0\xb9wo$\x96M\xcac|\x13\x16?dQh;\x15i	\xbf&\x1d\\'\xa5\x1c\x8e^^\xce*\xcd\xa2\xbf\x81\xa4\x8d\xc1\xf9\x14\x93B:o\xdf;\xbes\xc7\xb6\x19\x94JL\x96s\xf1\x0d,y\x7f\x8d\xbd--2\x8a\xaa\x8c\xedk\x88!ht\xb5\xbdm\xfd\x04\xa7m\xa6\xe4\x87D\xdf\xba\xd8\x83	\xad\xadq\xd2\xeb%@7\x1d{\x900VW\x16\xc3\xc9\x0e\x9c~ \x92\xda\x1b\xee\xeb\xb3\x0f\xa9\x1b\xf4]\xb6\xb2\xf5v;\xact)\xd3\x89\xe3\xf8\x1a\x85\xf6#\xa8\xb3\xca,tt\xf4wl\x13JO\x19\x18$~V\xda\x06\xfe\xa16\x91\xef\xa4Y\xb1Jo\xd2\x84\xd0\xd3h\xfc}\x0c\xf2G!e\xbf\x10\xad%\x0b3\xd2\xd6\xe2<\xb2Am\xd9p\xf3\xdf,\x16\x0bG\x10\xde\x8e?\x1a\x1c#\x94\xeb]\x98\x84y\xee\x0f\x8ekv\x9c\x9c?F'\xf2\"\x0c\xe3+\x18#7\xacG\x17G/\x8f_\x8et\xea\x9ft\xa9\x06,\x97\x03-h\xab\xd1\x7fs||\xec\x94Bm\xef\xa6iiY\x8a@\xc7D\xff6@\xa4{\xdfA\xfb\x83\xcc\xa9^\x9cn2\xca\xe0\x7f1\x1c\xb6S\x7f\xa2s\xcd\x1f\x85/\xa1\x12\xa9\x14\xf4XfN\xd3Q\xa3\xb2\xbaH\xe3%v\xb4Q\xcdF\x89\x92\xab1<\xa9\xa5\x9e\x85\x02\xbc\x91\x8b\xaci:Ba6\\\xd2\x18\xa8\x15\x07Pu\xa6\x1a\x1b\x15S\x9a\x06\xca\x87z\x10'\xad\xe3\x8d\x814\x16\xa9\x0d\x97O2\xb6\x7f0\xaa,1\x0b\x06\x0f\xfd\xc0 (\x05\x83m_\xc7<\x7fq4\xf7\xfc\xee/\x90\x8fQ\xd8\xd8\xd4\xf3\x12\x87\x85\xd8\xc8\xc7\xf6\xdab\xedy\xeb\x05\x02\xd6P+\xfd\\\xaf_\xca\xaaN\x1b\xd7\xa3\xc5E\xdeC\xba\x08\xb3\x1e\x07f\xdd!8\x00)\xd7\xdf\xa7\xc0B\xd09\xed4\x93\xe5\x07%\xbd\xafeQiPvB\xa16\xd5KBg\xaa\xa7\"\x96N\x1e\x82 #e)v\xcdu\xb0\x0c\xcf\x12\xc0&\x8eS\x05\x9c\x1b\xb7\x81so\x17n*B\xaf\xd7\xdb\xd2\x03)\x04	\xcav\xe3=\x8d1\x1bwa\xcc\x8e\xb9\xb8\x89.\x8d\x8b\xf2\x93^\xefa\xe1\x92\xb1VU:k\xf5\xcd\xea2TTG\xd9\x80\xf2\xe52`La\xca\xa8 ^\x0f\xc0_n\xba\xee\xbc\xf4)\xf0W\x06\xe6#;\xa6ELQ\x89H+\xa9\x9e\xab\xfb\xd6{lY\x0b1Y\x81=\xf8m\xb3V\x87\x9d\xa9\xcepuEKa\x08R?a%B\xb56e\xdai\x11\xb1\x17N\xb2\xdaT\xb6U\x85] f\xb1\xae;\xdd\xd3H\x96	\xefy\xbb\xdf\xd8\x9c\xce\x98\xcf\xb1\xe7F\x0d\xce~%*(g3D\xfa\x8ad\x95\x0c>\xed\xba1#\x18;\x1a\x12\xe2\xa0z\xc6\x00\x8f\x19)\x13\x1f\xef\x07\xcaD/\xba\x94\x98\xea\xab\xaa\xb7\xde\xe3\xf9\x82\xbdXK\xefI\xc2\x92\xc4\xf8-G\xbb\xa6;^\x16-\x1d8\x17\xc7\x04\xe5\xaf`\xac\xd2\x94\xbd\x1f\xd8\xc4\x94]\x95\x1eut\xdb\xe8.\xf4\x87\x82v\xfd\xdb`\x1bf\x17\xab,\xccWi\xbc\x04v~\x97\xfb\x06[\x97\xf2m\x8d%\xa1/kz\xa6s\xd5w\xbeDx!%\xe5\xe4\x1fy\x9a\x91\xbd\xc0\"\x8e\xd6\x7f\x8a\x92ez\x8f\x0b\x1d\xca\x1cG\xe4\x7f\xdd\x04Y\xf8\x1eG\x8e?8~\xee\x8e\xfaU\x90\xd0c`\xe7\x86\xc15Q\x95\xdc\x95,\x8a\xe3\xd3\xf4>9[\x00W\xfe\xff\xf9\xaf\xffO\x87|>.RP)\\\xcchx\xf4J\xc0\xcc\x10\x95\x82\xc79\xc1\xd6\xf3\x9d*\xa3uM\"\xcf\xbe\xdb\xf1\xab\xe1\xb0}\xd1\xfeu\x13%E\xb48K~\x80\x0d\xc2U\x16\x06\xcbE\xb6\xb9\xbd\xaa\x1c?\xb8\xf3G\x9a\xd81\x06\xbb\xa0w\xd8\x98\x02n#\xc5\x164\xac\"\x05\x8e\x8e;(\n\xf2\na\xa7\xf7\xc1\x17^\x83\xa4\xa8|F\xf8\x7f;\x0f\x13\x18\xc2\x8aO\xce\xe5\x8d\x8e\x87\xc2\xfc\xef9\x9c\xe3\x9bx\x93\xf9/\xf9\x87\xba(\xfe\xec\x0fk\xbf\x7f\xf6\x87d2\xd2\xa0\x92\xae\xaf\xaf\x11\xb5I\x9b\xc8\xf8\x8fV\x1eX\x7f5\xe8\x9be\xa4\xa2\xbf\xfbC\xb1\x0e\x96\xf0\xe5\xf1\x8f\x85\xbeq\x11\xd5\x01&1\x8e!\x83P\xba\x08\xe38Z\xe7Q\x8e\xabGl\xd6k,X\xab\xd0Q\xf5\xfdl\xa8#[\xe9I\x18\xee-Jh\x02\xbda<\xdd\x9e\x08Zz\xf48\x8d\xd7\xab\xc0\xfa}\x8e;\x9b\x96\x8c\xde\x0f\xd5\xe0\x0f\xc5M\xb0\xd6\x8fub\x90\x9ab%5\x8a\xb1G\xb3\xdd\xddaWw?\xb7\x93\xa5\xb8#\x11\x9d\xe1\xac\xfd!\xa7\xbc\x8b\xb8/\xfc3x\xb0\xfa\x0b/\xdeO\x1a\x08\xca\xf4.X\xaf\xa3\xe4\xe6\x9b-\xe6u\x19>8(3\xba\x8aC\xd41\x1a\x1a\x1e\xf7\x8fU*U\xa5\xa4\x07p\x1f\xd9\xcf4W\"\x92'\xd9\xe6\xf1t\x81s]\xdb\xec\xa9\x92w\xbb\xd9|\\\xfd\x94'\x0b7\x11\xec\x0e\x92Z\xae\xfd)\xe7\x07\xaac\x8d\xb1f\xe3\xcb\xd4\xa3;\xa6\xdc\x1f\x08\x91Ao4\xe8k\x9aR\xf8\xf0_\x17\x94\xc4\xf2)\x05s\x97\xc8\xefRWI\x82f\xc3\xb9\x1f\x19q\xc7\x87\xd4\xb5\x1d0\xfb\x0e\xfc\x16\x13\x06 \x0d\x8a\xe0\xc7 \x0bn\xf3\x8ej\xff\xfa\xae\x921jB\\efO\x7f\xf4\xc6\xf6t\xae\x1a\xf6\x91\x9b\xb5\x92\x12\x86\x94\xe7\xc7\xa0X\x81\xaa\x97\xa7\x0b\xad\x1d\x84.\xc6(I\xf0\xae\xd6@d\xb1\xb8\x01\xd9\xf8M\x12\x91\xc0m\xa4\xc2\xfcX\xdc\x06\xeb\xb3\xe5\x05{(\xec\x19\xea\xcbhI\xef\xdf\x05\xebqX)\n\xacd\xf9\xde\xd0CU\"\x89\x9dt\xb0\xc5\x88\xc3\xb6T]\xc5R&\xc3\xa9\x90q[\"\xd9YB\xbf\xaf\x81\x0b\xf4\x1a\xa9\xdaY\x17\x96\x9a{\xbd\x14\xb55U\xebW1\xa9\xe7\x95\x85_\xc8z\x92\x0d\x8a[	TX\xc8@\x02\x86\xb1\x12XH\x00-\x85\xb6\xc2\x0ez\x94z[d\xe8iX\x8cwSy<~\xbbWR\xfdv\xf1I\xfc\x1f[\xe4T7\xabc \x8a\x8d}wP#TS\xe6r\xc8\xde\xb2\xd7c\x1b\xb1L\xa6{\x0c|r\x99Y\x15\x99\xfb\nL\x1ay\xed\xf9\x8fD\xfdp\xb1xv<\xa6z8\x8d~@\x96\x93\xaeu\x12b\xdcx\x9a \xe24\xbe\xec=\x84O|\xd0\xd1rj\x91\xbf\xf9\x9a\xaa\x85\xff.\x9b\xc4\x01\x97VO\xa5\xda\x8b \xd67\x8c\x1aZB\x0d\x98\xcdK\xa5\xed\xbe\\g!\x848n$\xe0\xfd\xcc+X\xe5\x84\xd3	\x88l\xb8\xbcdBY\x92#Ts\x0cgE\x11\x0f\xae\xd2\x07\x08\xadu\x11m\xcf\x14\x96T%\xb2\x18'\xe3\xba\xb8H\xf3\xf3\x96b\xcd\xab\xe0Gh>\xc9\xc4\n\x96\xa4\x04\x99\x9az\xc2\x92p3\x8f\xde\x1f\xbd\x16\xe1\xa0\xdek\x185V\xdd\xee\xcb\xac\xff\xa5\x08\x07U\xdf5\xe8\x054 	\x91+~\xca\x94i\x06K6Q\xef}\xa3W\xca\x17U\xf7,\x90C\x91\xcbf\x0b\xe0\xf0\xc9\xb3kV(K\x11\xc03\xcb\xdf\xe1\xf8\xc5\x10\x864\x84b%\xed\x16\x8b\x85\xb4\x9b+\x96r\xc1\"\xf5\xc3\xd1x\xf9\xb5\x1c\x8e\x97\x87\x87j\xf8\xae\xe5b\xb6\x9c\x8b5B\x9a\xa6\xcb\x90B[PO\x10S\x96\xc2\xaa\x8eW_oTR\xaf\xe7\xae\x0e\xe5\xcda.nd.n\x89_\xd3\xc1J\xbb\x1d\x00\x7fY\xb8\x81\x18\x8a\x1b\x11\x0bx2T\xad\x10`{\x97PYs\xf0\xb3H3\xcf&\xca\x05d\x13\xbfK\xa3\xc4w\xaep\x9f:j\x9co\xe9\x0f\xb0\x1f\xfcDO.Q70\xa2\n\x1f\x8a\xef\xd2\xa4Po\xf0D\xb6`\x7f\x03\x15\x90&\xc4\x1a\xfbwn*\xd6\xe0\xb4\x1aR\xa4\xad'~\\\xb8[Q\x88\xb5'\x82\xbe\xbc\xe9\x7f\xb9\xdf\xa9\xa0\xe5\x97U*L\x97\xb3\xa9\xbc\x15\x17S\x99\xdd\x8b\xd3\xa9\xbc:\x17\xd3\xa9\\\x8a\x93\xa9\xe5#\xfbv\xda\xed$\xfb\x0b\xd2+2\xcc\x99\x8b\x1f\x1b9k/\x7f?\x95\xa3\xa1\xf8a*G\xe2\xdb\xa9<\x12\xef\xa72\xbbrg3\x07\x86\xf8\x8ev-\x9d\x8b\x19@\xaa\xd2_\xc0L\xaa\x07\x1a+z\x81\xf1\xa5\xd5n\xde\xa9_xW\x91\xeb\xd6O&\xd8[)?[)\\<B9Me\xfb\xf2\x7f?\xad\xdd\xd2\xaaZ\x19*\xc7\xd7p`\x9aDKK\x84\xa5\x15\xa8\xcb\xb0\xa9{\xc5\x86|\x81\x84\x19I\x0b\xf9\x18\x82\x863\xb8	%mV\x83\x11s\x15,~\xc1$&KEk\xaam\nR\xb0K\x02Ym\xc1\x96\xff\x1d'\xe4\x10\x95I\x07\xf9\xb6\xce\x13\x02H2n\x80\x1f\xf2u\x94,\x8d\x08\"\x7f\xd2\xa7\xdct\\\x89!\x12X7zd\x12aA^\xd0u\xa6\xb1\xe1\x83u$\xa3Z\xb8`\x192\xd9\xf9\x0eD\xe8L\x97\xf9\xc1\xe2Uu\x1a\xaezN\x9b\xc3;<\x80\xd9\xa2\xb2\x06\x87x\xd4\x96\x96\xe6\xf2\xa0>\\\x1bY\x1bt\xb1\x92\x1d\xa5J)\x83^/\xed\xf56\x93GP\x01\xbf7\x13\xb3\x19\x98I\x9a\xa5\xf4\xcc\x84\x03\x11H\xae\x07m\x9c\x8e\xef\x9a\x0c\xccs\xc9\xac\x03W~\x13\xdd\x91\xefe\xb5\x14\x00\x04\xc6\xf1\xb6/\x97\xe9{\x9a\x1cw!\n\xb1\xf2\xc6\xf9n\x17\xf4z\x1d\x03r \xa5\xf5\xc2j?^L\x96|\xbc~\x07w\x82x\xebz\xbe.\xde\xc86\xdd\x85XR\x1d\xbcF\xb20\x0f\x0b\xcb)4\xaa\xdf\x94\xdf\x98E\x06\x8bd\x91\xd25\xd2\xeeK\x07E]\xad\xc9*\x9b&\x0fmB\xb3\xbe?\xe8\xc4\xbe\x98r\x1b\xb0\xa9\xbe\x85T-wCO4\xce\xc6\xd0\x83	\x7fKl]\x0c\x1e\x10 `NT\xb5\x19\xd8\xc6\xaa\xaf\x85\xa8R\xfb \x15\x81\x95\x9a\xa1#\xdc\xdaB\x1b\xa3Uq\xabr7\x13\xec\xfd\x13\xd3\xa9p \xb3I\x01\xccL\xfc@\xa82\xd2\xc0\xe5\x93p\x96s\xdaB\xae\xdcXlD$\x02o\xbc\xe8\xf5\x127\xee\xf5\xe2\x9a\xdd$x3\xb1\xe9\xf56\xed\xd4\x05\xda\xd7\x1fye:\xc1\xc8\x89\xe9\xb4fo\x8c\xe3\xe6\xa0\x18\x183J\xd7\xeb\xf5r7\x84u\x94\xe7\xf9\x18\xd3s\xfc,D&2%\xb7\xce\x8d@:7\xc2\xe3;7'\x99\xb5gE\xfbb\xfd\xb6\x85\x08A\\\x8c\xa6J\xcd&\xcb>\xf9,\xcb?9g,k\xa7\xa5\xd8\xc0\xfem%\xef\xdc\x1f\x16\"\x149\xf9+gb\xe3\x8d\x138=\xa6i1\x99\xa9\xbfs\x9c\x9f\xe42\xa6R\x83*\xb5\x10)\xb6\xfanw\x10\x08f_\x16\x1dW\xc2'6Q/\xe8\xa2\xd7k\xce\x88\xf1\xd6\x80\x11\xc6\xb8\xf1V\xc5\xf9H\xb4\xe9\x82@\xd0\xa6\xe2\xf6O\x10\xf0\x85qX\x84r\xa4\xe4\xdaa\xe9\xc6\xe6f\xaa\x9dj2m\\%9\x82K\xe5E\xfe]j\xa972qo\xca\xfc6\xce\xfd\x85\xa8\x1d\x10\xd5\xae\xf0\x1e\xa7SwQ\x93\xd2\xb6\x9b\xccJQ(\xbe\xd5\x93^8\x0cA7\x9d\xba\x9bZ\x11P\x85\xb3\xd8\x03\xd8	\x05NG\x04\x17\x035J\x9b\xb3ji\xf5\x99u+\x11\xf9\xe5:F\x0e\xa9\x91\x0bS\xd9|\xa35~?\xd1\x9av\x8c\xc3\x88\x95Ei\x0b\xa1%\x92\xbf_\xb8\x1e\xa6$\x1c\xd4\x86\xc7j\xbb\x08[\x03\xc0\xf8\x9a\x07V\xa7\xd8g\x11\x0b\x95Gd\xcc\x9e\xb5FX\x8b\x8b\xc5\xba\x15\xbc@\xc6\x94R\xbbc&\x9a@E\x88\xd1\xad?d\xaa\x1d\xfea\xc5-\x8ew\xa2\x814\xc3T%+\xb3\x11M\xae>\xea\xd0k\xc3\xb2\xf4\x1f\xdbi\x15\xe4\xc1F\x0e\xc5\n\xd8{\x8de\xb7\xdb\xb9\x1bY\x15\xff'\xd6\x16\xae\xac\xb4\xef9\xea\n\xf8\x18\xc7l\x12\xf42\x9cT\x90\x12\xb3\x8dX\xcd\xbb\x9b\xa5;\xba\x11[\x7f\xc5\x1d5r\xe3aW_\xca\xa0\xd7\xcb\xe9\xd0*D R\x91qt),\xd0\xda\xf9`M_\xd4Z\xbfZ\xd3J\x14]s\xa3\xcc\xa2\xf9,\x99C\xafY\x8e\xe3^\xcf\xad\xf7,\x9a\xc4\x834^R\xd0P\xddE\n\xd6\xa4zkp3\x8a\xd6mD\xdfy\x9e\xefV\x05\xd0\x00\xc8\x00>\x93\x85\xfa\x81\xed\x0f\xec\x11\xbcp\xf9\x0b\x11\x0f\xa0r\x8c\x92\x89k\xc5\x9bsx(\x1d1\xf4\xc4F\xf99J3T\xa3\xd2\xf3\xc9\xf8\x93\xdd(9\x9d\x9a\xdd\xce\n\xc0\x025\xa6\x1b\x1aSlJ\x16G\xe9Q\x0d\x8aP\xea\xfd\x03X\xed|\xb0\x04d\xed\xd9\xb4R3z\x8f\x1d\xa4\xa6\x08\x9bt\x08\x97\xec\x0d\xf2\"\xc8\xc0\xff\x94\xa2Iy\xf8\xedC\xb8I\xd6\x92\x80\xcaH\xa8*\xb8	\"\x1a\xde\xae\\\xba\xa8?d\xae\xe7y\x06x\xa1A\x8c\xb2\x08\x03\x9e\x8eD>\x18\x9c\x853\xbd\x98\xd2\xe4\xc7 \xe1\xe6r\x06(Oj9@\xa1r\x16-\x01\xb3`z\x0b[\xc8Q\xd8\xd2\x0dvK\xdc\x877\xc0\xe1}\xf9\xb8G|s\xa2\xb1H\xad\x8a\xd9\xb9$\xd3\xba.\xc4\x8c\x9a\xa5\x8e=b$\xcb\xb2\xe18\xd8\xc5T\xa8~\xd6\x8e]:r\xad\xf9\xd6v\xc4\x8a\x8f\xe8\xf5\\\x12R\x04W0^\xff\xfa\xe5ng~\x86\xde\xd7/\xf9d\x8ed\x93\xfco\x10\x0et\xf0\xe1\x94<\x88\xd8\xf6\x9d\x05\xd4t\xd6\xb3\x957\xb9\xf7\x1d$:\x03\xb5\"X?\xa53\xbe\x0d\xd6\xef\xd2;\xe8\x19\xb3\x94#\xc3l\xa2\xa5\xa57n\xb6*Z\n\xb4\x84`\xa0\x1f\x1f\xfc\x04\x01\xa6\xc4\xd6O\x06\xdb~\xc8\xa7R\xc2\"\x07>\x9b\xb43\x03\x0e\x1f\x1a\xbf\x06\xe0\x1c\xee\xad\xa7\x87PS\x04]\x8b\xb2s\x88\xcc\x08X\"\xa5\xda\x10i\x07\x06\xf6P\xe4E\x98\x0e\x1eD:\xd8\x8a\x94;\xa0\x91\xcb=\x91\xb5\xeb\xae8\xa7qx(\xb3\xc1\x83\x88\xf0g\xcb\x96\x889\xacC\x17\x85\x8bp\xb3\xb3\xc3P\x1cFsO\\\xf3\xefB\x14sO\xe8\xb7\xa1\xc0\xbb\xa0\x19\xf0G\xcb\xde>:\x81\x8aR\xff\xd5S\x18\x0c\x1e\xc4\xd6\x0f\x06[\x9e\xbe\xa0>}A}\xfa*\xd6\xa2v\xf0\x14\x9d\xf6\n\x1c$\x01\xbc\xd0\xd6\x91\x1ds\x1av\xce\xa9\xbaFH\x03\xeb\x90\x83{-\x1e\x04\xca\x00\xc3}\x11d\xc8[0\x8a\xc1\x9f\x85~\xfa\xb9\x1eq9\"\xde\x13I\xb5u0\x88\xf2\xb7ap\x0dV\xd0c\xce\xcdp\x85.\xe3\x1c\x01\x80\xc3V\x01\xe3\xb6\xe6\xccUj-s\x1c%\xbf\xa8lF\x9c\xd9i\xecN}u\x93\xca\xde\x00t\x01\xcbl\xa9\x0c\xf4[\xb08\xdau\n\xea+%\xeev\xceU\x1c$\xbf8@\xb3\xbb'\xd5\xf8 ]\x87	E\xe8\x06\xfe\x1f\xdf\x19\xa5h\xb1\xa2\xb5S\x0bW}\x04\xf4$\xe6\xb9X@i\x94\xe7T\xd9\x84\xe8ye\xed\xa35\x07\xaeW\xfa\xcdI\xb0\xe5\xd3/\x8eDM4\xfd\xe2\x884\x00\x91\xb4\xcaj\xee\xda\xd2\x13nS:c\xb4\x06\x95\xc0\x866\xea\xdb\x05c\x88\x82\xcc\xc0\x95\xa5\xba\xa9\xfaDs-\xec\x9b6\xf4\x1e\x9f:\x9b/\x16n\xa3w\"\xf4&\xad\x05\xa1\xda\x1e\x96\x9e\xdf\x9a~\xf3\xca\xdc\xd6{%\x8d\xcd\x8b\xc8\x80\x89\xd4\x99\xf9^\xaf+\x95\x99\x06\xdb\xce\xe6\xd7\x89\xc3j\xe2-\xd1\x1c\xe3^\xaf9\xea\x9a[y\n3\xa5\xd5\xb1RXcd-<\xef\xf1\xd3\x0e5[\x98\xf5\x19\x07\x9b\xda(\xf8\xcc/h-\xd0\xfd_\xed\xec_\xd1\x12KX\xf4\xcfnI\xb5e\xba\xd4\xd25\x86\xb5q\xcfU\xeb\xb3\xb2\x82~\x04\xd9\xec;\xb6\xd0\xc3\x11d!\xe0W\xd6\xda\x96\x9d\x13S\xf2\xa9\xac\xad\x9eA\xc5\xa5\xcf\x92\x86\x88\x0e\xa7\xa6A\xdaL\x07&4\xc8	\xb0&.R\xb8\x10\xc7\xd4\x01:\xaa\x88*\xc7'\x07n6xx#\x03\n\x83\x8b\x7f\xdf\xe0\x82\xecgZ\xf1\x90\x0d\xb6x;\xa2\xb7#z\xbb\xedg\xfa\xb6\xe5\xbb\xf9`4\xd6gF\xc2\xc8\x02\x7f\xf6Q\x18\xff\xf8\xd9\xc7\xb7\xe6\xbc\x13\x11T\x8aZ\x0f\xf3\x0d\xc4\xed\x1dK\xa9yg\xea\xdfD\x04\xcd\xc5\x1f\x11\x1a\xeb\x8f\xd37\xdf\x98\xc8Y\x7fDX,\x03\xff\xf1\xcdt\xf6\xc7\xe9\xbca\x93\x86\xa9\xb1\x87\x19 \xa86\xb2jK\xe6\xf8\x91\xef\x7f\x95]\x9b%S\xae\xe1\x83T\xf2\x94w\xb0\x1e{zP\xaa\x95\x8e\x18\xd8\x1cm\xd9\x12dU\x8bp\x9c2\x81\xccR[y\x01c\xcb\x84\xb6\x9c7qp\n\xfc\xb4v|\x8b\x9f\x07SP\xb7j\xe0\xdc\x15T\xc4o\xa1_\xf9\xd3T\xde\x8b\xef\xa6\xf0\x98\xfe\xbeK\xe9@\xbe\xeb0\x11yGn\xe3\xe4\x8d\x03zV\xbb\x05\xa6d\xb2&#\xa8&(\x01/\xa5\xe2L\xea\x9fJm\x93\xac\xc0_\xa2\xbf\x85\xb8\x96\xe4O\xd3Y8g\xc2\xf0\xc3t\x96\xcc5\x1d\xbd\x8e\xb7\xcaGM2\xad\xa6~\xb1\x053\xa4\xc1l\xbf\x12f2h$pM\xcb\xf4]\x80\x88\xf4\xea\x01\xf8\x15\xce:\n\x17\xe1}\x94\x87D6L\xdco\x16\xd0l\xbe\xc7\xbf\x9e\xef,\x82\"\xbcI3\xa2\x9e\xc2	\x02\xea\xd2\xef(\xcc'\x7fC\x16\x1f\xd9qS\xfb\xee/\xca\x008\xc8@=\x87\xbb\x9drP\xf8\x16\x98@\x85'\x90\xcf\xf3\xca\xf1\xf7{\xdd\xc9\xbf\x9f\n\x8d\xaep\x91\xb6\\\xf8\xea\xe2\xe9\xda\x88YJ \xab\x97\x10\x9bj?1\xcel\xf1\xaa\x86[\xbbu;Jd\nF\xa9\xe1>L\xe5\xf7\xd3\x81\xb2,\xfa>H\x96q\x98\xe5\x92\xad\xac\x1e\xady\xf0\xff\xb4\xa8\x90:\xea\xa3\xbf\x87\xe1SKE\x9f\xba\xb7nm\xb4\x1b\xeblb\x8a\xb0\xbc\x19\x0f@A\x14{\xc6\xc4\x13\xdf-\xec80^Y5\x83EuhK*\x0f\x0et\x1b\x0e\xa2^\xcf\xed\x1ecH\xed\x13\xf9{@\xeb\x93\xcc,\x0f\x97\xbc\xfc\x12O\xa4\x93\xc4\xff[\xe1&\x82\xcc\xf5\x1cM\x88\x90\xa8\x10a\x15H\xfb\x1aX\xc3P	\xe9\xffV\xb8\xbf\x87\xa1\x89\x0d\xe5m\x17\xefUe\xea\xd1\xf1\xbf[\x08\xb3j\xbb\xee1\xf9\x93\xdd\xf1\xb0m\xdd\xe2F\xf2\x93\xeb\xc5\xc1.\xae\xa3\x87p\xe9\x7f\xbf w\xf38\xcd\xbe\xdf\x84\xfe\x1f\x175	\x80\xe9\xd1\xb7\x1c\xef\xc8k\x99\xdb=\xf9\x05\x1ag}\xf6\x16WQ\x12\xe6\xf9'|\xd5\xf8T\x19\xfc\xed\xfb\xec\xbdi\x9e\x96?\xb5\x161\xbfp<=\x7f\x1f\x16\xeel(Fs\xaf\x14qt]|h\x7fB\xc9\x8e\xd7\x9c\xf0\xef\x17\xd5\xac}o\xcf\xda\xf7\x0b{L\xd9%\xa6V\xaa=\xb6\xd5%\xa2O\xd1\xda1\xc1N\xf9\x97n\xe2y\x91v.pDR\xf1E\x7f\x9a\xe2\x9d\xbe\x9c\xd3gQ\xf2,\xf1\x92f,\x92\x14\x868\xb8Ff\xe9\xbc\xbdx\x7f\xbb\xf8\x97]\x81\xbf\xb5\xb3\x14\xadufy\x04\xb5F\xbbz\xd75G\xa5\xf8i*\x9f\xd8r\x17ic\xddW\xe74\xa2\xc0\x8e\xe68\xcfKk\xb2\xf6\x9c\xc2z\xb7bRa\xc7\"\"\x1c\x94 1\x7fD\x92R\xb3b\xf6\x98\x85F\xe7\x0f\xa4\x16\x1f\xa1\x19\x11\xea\xd3\x18D\x87\xa3\xb9]\xbd\x19\xeb\xa7k\xe7l\xb8\x90:\x92\xb7\xb8\xe5\x8a\xb9_\xd4\x8f\x80p\xf2\xdd\xd4\x0f\xf5\xb6\xfe\x86n\xa58\xca\xd9q\x1c\x9a\xf3\\\xb6dt\xb3\xb9.d\xe9~\xb0B\xd8\xd1\xead#\x9e\x08\x9b\xb0(\xc5\xf7S\xc8h\xffh\xdf\x19\x16)\x81e\xf2a:+\xe62\xa4\xacQ\xfe\xc7 \x8e\x96\xa8\xd7\xcae\x0e\x94\x0f\xd3\xe6B\xc5\xc5\xf6\xfd\x94(4\xa6\x08\xaa\xcf\x948\xeb\xde-\xbc\xc9\x92\x7f\xf9\x15\xfe\x88\xfa\x10\xf7l\xf7w\x18`\x00\xa9l\xf1\xfdl\xeeS9\x8f\xa5\xef&P\x04a\x06\xcdiZk@M\xe5\x04\x8d,\n\xca,4\x15hO\x93I*3\x9f\x80\xbc\xc8\x83\x91\xda\x92Qt\xc9\xbbP5(\x97\xad+_Dv\xe4\xaa^\xef\xb7\xd3\xda\xf0\x93\xf9S\xd1\xb5\x95\xddh\x96\xcee1K\xe7\"\x94X\xd2\x9e\x08'\x11iu\xa9f\xb6\x12\xeb\x9ct%9\xfa\xd3\x14\xe6\x83\xbc\xeaf\xf3\xf1o\xeb\xaa5\x0c\x98\x81\xee\xc0\xc4\xcb\x90\x90L!L<\xd8V1'\x11\xe2\x0c\xd2}\xa5n\xd0q;\x8a\x01\x9c\x0c:\x0fi&#@\x90\xf5z\xfc\x03R\xdd^\x0f\x86N\x85F\xa34\xe4\xc6d\xe4\x1f\x8e\xcc\xcaS\x8e\x16\xf9\x0f\x89\xfcH\xd9\x93\x03\xf7\xa0`\xb8\nSf\xe8y>\xa0S\xd4\xd2\xac\xeff\xf9\x04\xd4\xb3uHW\x02\xe6\xc3\xc2\x1b'o\x94o.\x96U\xe4\x95\xe6\x10\x16\x81\x1c\xbd\x18\"\xa6\nY\xaciP\x9d7y\x15W%\xa6@\x94\xecNnb\x94\xc447P\xc7\x01\xbe\xa4\x196\x0f\xday\xbc\xea;\x8e>i\xb2qDz{\x12P\xe9\xfa\x9f\xaawC\xf5\x8a\x15\x01\x7f\x16av\x17\xc4\x84\x02\xba\x88\xd3\x9c\x18\xcc\x155a5\x1b\xce\xa5\x94\x87\xa3\x17C\xfa\xfd\x97\x85\xbb\x00\xfch!V\xb3\xd1\\\xcf\xfe\xb3\xac\xd4\xd7\x10\x92\xa5\x94\xb5\xfc\xc3\xb9@9\xa2\xe8\xc8\xdf\xc8\xd0\xeb\xed\xabAu\x90\xca\xc9<\xc1?F\xf8Q\x96$\x8b\xe4\xa21\xb1\xa3\x17\xc3Iu\xe6\xd2\\\xa3\x07\x93\xa1\x9f*\x82\xf7\xcfS\xb9\x15\x7f\x99J\x0b`N\xfc<\x95\x8fJ\"\xd00#\"\x96\xaaC\x0bl\x19\xc7Z\xc26\x11H\xa5\xe9\xd712H\xbe\xe0\xfee\nd>\xcb\nb\xb7\xfby\x01O\xfaR\\\xbbl\xd1N9\xeb\xf8c\xbaS\x13*\n\xa5\xa8\xcd\xed\xc15\xae!\xb4\xb0Az \xbf\xc25\xf9\xd7\xa9\xb1\xdf\x14\x7f\xaf\x9f\xa3D\xfcf*/\xc4\xef\xc0\x0c\xfeaZ\x8b]!\x1c\xcb\x15\xd6\x99\x8b\xe2\xa4\xf1Z{O\x80\x7f=\xa9\x9b\xe0	\x85\xad:\x17Q\xeb\x05[b\xceEr\xf2\xabF9\xb2\xa4\x9fZ\x81\xae\xa5\x9f\xe4r\xaanj\x11\xcb?\xa4J\xda\xf8M\xfa\xa0\xf4\x11\xcaV\x1eH\xe3J\xf8^+\x8a\x15(\x91-M-a/\x9a\x0f\x10\xde\x90\xcc^V\xf24u\x7f3uc\x96\xdaof\xc39b\"\x88\x85y\xa1\x8a\x11\x1b\xec	,\xcf\xa5e\x03v\xfd+l\xc8\xd6\x95\x1d\x98\x92\xa2\xc0\xf8s\xb7\xd3\x89$JA\xd2Di\xebIU\x84u!ndS\xd4z+\xcf\x16\x1cr\xa3\xf6\xf9\x81\x94\x8c\xd2}';$\x82T\xfa\x06\xd7\xeb\xb5\xb8\x11+\xb1\xf0\xfc\xf5d\xb6\xe6!Xs\x8f\xe7\xfel%\x16s\xb1\xc5x\xa5Y1\xde\xf6zN\x90/P:\x1e\x97\xa1yv\xb7R\xfd\xe4\xa05\xf2\xd1v2\xcb\x07\xd6/\xe5\x9a\xb6\xb5\xbc\xcc\xf2\x81\x11\x9a\x97\xe3\x9bJ\xdeoC~\xb8\xde\xf8R6\xcc\x14\xeep\\\xf0\x1c\xdf\xe1\xb4\x00\xd8#\xa5>\xc7O\x94e\xbc\x1b\xdcKODK\xf7F<\x88\x83\x916\xc0\xb9\x947\xb6Fc\xfc\xbb\xa9{+\xba\xf81\xf7v\x16\xf6G\xf3\xdd\xee\xc6\xb3\x9c\xfb\xc7\x85U~\xe0>Vd\xa8?\x8bD4go#m,N{\x89\xb1\xd2\x86\xa5\xb8$\xcd7\xa6\xe8\xbeu\xcc\xd0\xbc\x8f\xef\xad\xe2WK7\x16kq\xed\x81\xb8\x14V\xc5P\xa0\xb9\xb1'\x16K\xf7^\xb0\x1e\xee0\x1e<\x88\xc3\x18\x9a8\xac\xd7[q#\x860\xca]\xe7\xee\xcfSO\\\xe7nr\xc2\x9e7'\xb2%\xd0\xbdTB\xa9p\x89\xeb\xe9\x80q\xf7 \x97\xca\x0d\xe8^\xb8\xbc\xb1~)L\x13\x08f\x1e\xb5\xc5(e\xb0S06l\x83\xb9\xbc!\x0e\xbf\x14\xc1\x89LO*i\xca88Q\xb2&\xe7\x06\xde\xc8\x8e\x08N\x06Q~\xaa\x1bc\xda\xe9=\xd6\x85%\x9c\x01\xe5\x0d\x82\xe5\x12{\xcf\xea\x14hUB\xdds\x9c~\xc8:s-\x1fP\xad\xc6\xe69\x88f\xd7K\xb7\xf0\x18\xb56!E\xc9\x1a\x1b\xc4\xe2}he\x92\xf71\x95`\x0d\x0c\x87\x98\xf1\x84.F&\")\xa9E,\xc3\xfef\xdb$@>\x0b\xe3\xa7\x82\xf8\xa9\x15\xb9\x94]\xd7I\xadw\xdc =:\xdf.o\xea\xa3SQ=\xf5\xef\xb48\xd0L%\x86\xc9I6\xb7Wt`a\xa2\xea1~\xe9\xc3\x19\xf4\xb2\xad\\a%\x95\xd1\x1d\xf1\xc4z9\x88\xf2\xb3Dy&\x12>\xad[\xc8\x84\x9b\xdb|\x8d(E\xc0\xf5\xc3\xeb\xd0C\x18\xf0^\x8fw'0\x0b\xa2e\xdf9t\xfa\xe1 Z\xa2\x99\x07 \xd15	\x8f\x99\xbc\xd1\x9c\x84\x1e\xd4\xacs.\xcd\xca\x87Tj\x90n\n\x8c\x16O.\xe16GI-\x05\x1b1\xb4\x13\x040\xa5Bx\x1f5\x92\xcd\xa61Ih\"\x82\xee\x96f\x99\xa0\xe8\x8f,\x13\xbdw\x9eZ*\xc8c/\x95\xd6\x8c{\x8f\xcd\xa1Gwi\x14\xdb\xc3N3\x87q\xd5\xf6&\xf5\xfd][\xa4f\xf8&\xd1\xacP\x132\xf7\xab\xc7\xdd.\x9a\x85\xf4\\\xa8\xc6i=Lm=Vp,\xbcOi\xc5X\x10\x8b\"\x05\xae\xce\x9bd\x9c\"\x9a\xe6,\xb5p\xa2\x14\xea\x9a\xe2\x15C\x81w0\x1c\xd6Uu\x0cD\xa3*\xea\xd5gTEIh\xdfh\xdf\x8b\xa3Oi\x1ci\n\x8b\xd5wQ\x86\x8bO\xc5\x19\x91\x9d\xd6\xf2\xcd\xd9\xb1\xcc\x99\xed\xfdN;\xc4\x1a\xc9T:\x00\xeb \x8b2G/k\x07\x0e\xa2\x9c\xc6\xeb\xda\xf1\x1d\x1a\x02\x87\x03\xf8W\xe3\xcf\xe46E\xf2\xafR\x11\\\xff\xf2\x12\x86\x96\xb8-\xa0o\xa2\x90\xcb4\xfe	l\x8a\xc0n\x1b\xa9U&\xa1\x17\xc8tQ&\x1d\x9ea\xf9*\xba\x06=\x13\xcb\x1c\xb3\xa6\xaa7\xc0WA\xc5\xd7\xc4\xb3\x004\x9b\xb2\x8d\x1f\x11\xe8\x88z>b{y\xd5\x88U\xd5.\xe2TxQ$b\x85\x80\x94l\xbb\x92\xa9\xcd\xb8\xf2\x84\x95\x9d@\xaf\xd5\x9eT\xda\xa5j&\xaa\x01\xed\x00D\xd3[S4\x96m\xb5'\xb1pE`\x16\xd78}\x13t\xaca\x1b,M}P\x81\xa5U_\xd4\xc1\xd2\xd2\x1aXZ:&\x0f\x92\"\xcc\xce\xc3\xf8\xda\xe2\xcf\xb5l/\x99\xd5=	\n\xcfH\x86\xa2\xee\xe5\xdc\xb1\x96-]\xa1j\xa6F\x824\xadL>\xd2\xaf\xb0\xd5\xaff\xc3\x1a\xfd\xa2I!,\xe8\xee9\xc1I\x9f\x9e\xb8\xf5\xb3\xc8\xd3\x13\xa4\x01\xeb\xac	Q\xe8\xd5\x9a\x97T\xe8\xd5\x9a\x84 @nh\xc4\xe9\xaf\xd9\xc56\xec\xb5\x99\xc9D\xaf\xcfTF\xa4\xef\xd27\xad\x9b\xf2xFK\x91\xf2 \xd2cU\x9e\x91\xa6\x948{\x0d1T\xc7\x1b\\/\xc52\xbc\xc9\xc2\xd0\xdfC\x05a\xf5\xe9MZ\x8a(9}2\xb7\xbe\xc6t\xfetS<\xfd\x81\xb9\n\xf5\x17\x9a\xf3\xadj\xd8\x8fUj\xd7ln\xdd\x0ec\x9e\xfa\x975\x00P\xe0\x7fZ\x83c^\xc9_]\xbd}\x93V\xf6DO5\x81\xee\xbf\xecDv\xc9\xbb\x1e5SP\x8dG\x8d\xac\x9a\x15s:\xfbX\x86\x8br\x951\x95\x81\x02p\xa3\x8f \xd96\x15\x8a\xc4>\xd73\xaa\xeb\xcfT\xf74\xd8(\xbe\xef\x04\x1b\xc5\x0b{\xf2\xb9\xb0\x9b\x8f\x17fx\x92_\xd3J6+\xdb\xd7\xca'qv\xebM\xec,I\x95b`\x8e\xaa\x16>\xd1\xd7\n\x14\xa9\xde*\x8e\n\xcd\xa7\xd4G\x8b\xaa\xce\xd9F)e9^\xb9\xeb\xa5\xc8N\\\xc7l\x0cG8h4\xf4v+\xf7\xa6\xfdR/\\dHO\x06`\x04\xe4z\x89G\xecPy\xb3\x14\x7f\x8a\xdc\xf5\xd2\xc3\x9f\x1b\xa6\xda\xf2\x13\xd9\x15\xffU\x1f\xc7\x81>9\x13\x8f#D\x9b@\x00\x90d\x06\xe6H\xbcp\xe1j\x84\x13\xac\xd0a\x9c\xc1_f\xd5U@L!\xbb\xcb\x0c\xb90s\xbe\x1a\xb1\xe8B\x89'\xe1\x87\x9b\xa7\x9bl\x011\xcab\xa0L\x8f\xc6\x819<\x97\xe2Z\xac@\x81n\xd4E\xbd\x80\xe98=]\xb8\x0b\xb4b\xd9w\x9e}\xfd\xcc\xe9_c\xb0\xfa}\xc5M\xaf\xc5M\x156\xa2\x1eS\xc4!\x93\x0cg\x11dE\x98GAr\xb4\x04\xfds\x03\xb4uB\xf1\xc1\x0fo-\x7fB -6J\xa4c\xfdV\x9e\x10\xc9\xed\xde \x18\xeam\xafG&C\x10\x83\xdc\x12\xc7:\xb9\x1dT\xc0\x98\x90*\xf9\xb3\xf98v\xef\x84\xde\xd1o\x86\xbd\xde\xdd`\x91&\x8b\xa0p\xab\xd8\xf7\xec,\xbc\xb9p\x8b6\xe8\xe6]\xe9\x8d\xdd\xb5\xc6\xd6\xdc\x8a\xc8\xf3*L\xcdB\xf5\xf5A\xbf6eZ<\xceC\x95}\x03G\xf4\xb4\xd7K\xdd\xb5xh\xc0q\xae\x19\x85\xd3\x0f\xf8A\xc1q2\x0f\x8e\xb5\x98\xfb\xcaDo-\xb0\xf8\xfc\x87R\xa5R>\xf5F-Y\xf5\xbaZ\xa1\x00\xaf\n\xb4\xb7\x97'\x82R\xc4'\xf2\xc1\xd8\xf5\xe4\x1c\xb6JU\xd4\xf0c\xf5\x1e\xe3\x93v\xf8\x7fdj\xc7\xfdW\xd1^\x08f#K\xef\xa2e\x98\xc9\xee\xed=\xb8\xac\xb4m\xc8\xce\xb2	\xb8\xdb\xe2\xe7\x85\x06%p\x99\xad\xdb\xed\n8\xe1\xfeb\xbc\xf89\x94e\xad\x10\x98\xea\xdd\x86\xd9M\xd8\nK\xdf\xdd\x07+o\xbb+\xffBM9U\x01N\xa7\xc9\xf2b\x15\xde\x86\x9f\xd6\xa6\xc6G\xb5\xc6\xbd\x8b\xc8\xf6	S\xab}\xaeg,\x0f\xf6>	0\xa69\xa2\xd8%\xa0\x8e\xe9<\xc48c\x1d\xa9T%x`\x87&\xa3m\xcdO\xdcD0\x06\x0cp\xf1L\x8f\x1a\x11;\x8d\x1cD\xdb\x95\x901\x06\xc0O\xa0\xcf\xea\xf5\xd8\xf5\x1c\x1a\x96\xd9p>\xc9|\x04\x01\xd1\xd0?:\xb9\x9em4\x9f\xc46\xea=Q>e1\xb8\xcf\x82\xb52nq\x1d\xbe2\xde\xa5\xcb0v\xc4\xf5&Y\xfc\xff\xd4\xfd\x0bs\xdb\xc6\xd5\x07\x8c\x7f\x95\x08m9XqI\x91t\x9c\xe6\x01\xbd\xd68r\x92\xba\x8fb\xbb\x96s+\xff\xfck`\x12\x14\xd1@\x00\x0b\x80\x12\x15\x89\xdf\xfd\x9d\xdf\xd9\xb3\x8b\xc5\x85\xb2\xf2\xb43\xef\xbc\xd3\xc6\"v\x17\x8b\xbd\x9e={.\xbfc\xc6\x1b{<R\x99\xbb\xf6\xa8\x02Dtp\xc3\x7fq\xf6\x9dWq\xe3$7q?j\xdd\xbd\xa8\x06Y{R\xf0\xe7aP\x106\x85fT\x8fj\xd6@\xdfA1n3\x9f\xe1-C\x96\xad\xefe\xe8~HF\x10\xbc\xd7*\xe1!\x1a\xba\xb5%\xb66\x93m`3\x8dM\n\x8c#jm\x06\x06\xe0\x13G\xce\xec\xdc\xe1b[\x94\xd9u\xfc{\xf4}T\xbe\xa7\x1e\xe3\xa8*a\xf7F\xeb\xa7:\xdb\x0f\x1c\xc6\x8ex\x95\xc8\x0d\xbd\xf0-\xd3\xa9G\xde!\x0e\xc0\x17\x96[\xa4\xf7\xea\xdb\xff\xd0\xdb\xcet\xe3#\x87\xf1g+\xf7\x0d|\x05+\xb0.\x91\xb3M\x97Y-\x815\x1fX\xfft\xfb\xa2\x8e5\xe4H\xc0,\xca\x9d\x80Va\xf3z\x87Ynf;2\x0b\x80\xb3Tf\x03\xeco\x0c\xefa#\xc5bwc\x9bT\xe0\x8d\x7f\"\x16\xde\xbf\xb28\xf5\xe9x\x06\xa3\xa2U\xa0\xbd\x9e\x9f\xf4\x95\x06\xad\xfdg\xe6s*\x10\xe7\xcc-\xa8\x8b`\xd6\x06\xce\xa5JU,\xdf\x833\x82q,\x95\x86to\x98V\xc4LnliK9\xb8\xa3\x0c\x03~Z\x06\xa1\x7fO\xed\x87\xd8\x1e.\xa72j\x9f\xbb\x98\x1a\xb8Y\xd93\xb7\x14\xb2k!X\xa3\xc9\xaa\x19\xb4M\xa1B\xbe\x0e7\xaf\xf2<\xbc\xab\xdd\xd9yd\"\xc3\x81\xf3\x9d\x08z\x00\xd8fs\xb4\xb7j\x19\x1a\x0bq\xee-\x8c\xc8\x15\\5M \xba\x83%\x17\x94\x8d\xb2@\xc3g\xcf\xd7o)\x8e\xf0\xb2zI\xdc7\xa6\xea\xcc\x9a	y\xed\xf7\x00\x0dt\xe4c\n\x17\x91u`\xa9\x01\xc8\xb2\xb9<\xa5Q1v\x0c\xb25y\x9fC7nry\xda\xa0\xdb\x93\x9a-\xf8\x1ba-\xc6\xe9o\x00f\xab\xc3\xad\"\x85\x11b1\xe3r\x95-\xb6\x05\x18\xeaW\xcb\x7f\x85\x8b(]\xdc\x01\xe7n\x11\xe7\x8bm\x12\xe6\xc1}\x9e\xc1\xf0\x9f\xa1Y\xc7\xb4\xa3\x17Qp\x8f\x19\xe7\xeb	U\x93G\x9bm\x02\x1e.\x98\x8d\xe4\xf3\xd1\\^\xe5\xe1\x0d\xfcP\x87c\xe2\x90\xce\x89\x1b\x0e\x9e\x99\x8f\xbb\xed\xd9w\xc1\x17\x9a\x07\x03\xbc\x8c\x1650\x97\xc7#\xaaZ\xc7\x81\x0df^\x9a\xa5P@\xd2\x9f\xb9\x93\xe5\x16f\x80;\xeb;k#\x99\xcbe\x1e^]a\xde\xd1\x7f\xf8,\xe2\xafn\x85\xee\"\x16U0\x96\xa0\x16\x17\x08u\xcc \x90_\xd5\x81\x07\xc7L\xf3\xf0\x9aw\xffi_\x87\xf9\x83)^\x1a5\x80\x0d\xc30\xb4\xf0\xcf\x0e\xf8\xf3\xc8\xda\xfa\x01\xbd\xb9y\xd6\x18\x8c>\xc8\xed\x84\xdc\x9e\xa9\x8b\x8bJV \xd7g\xea\x8d\xfb\xbc8S\xdfZ\x17\x85h1\x80\x9d\x99g\xbc\x7f5\x04K\xe0\xfdi4\x1ay\x12\xdc\x18\x9b\xdb\x18\xf7\xe11\x1c\xa5\xf1\xcfn\x82_\xf8g\x13\xe5\x18\x9b`,\x17\x9b\xdd\x98A\x176wc~\xf3\xd36N\x96\xe0?\x9c\x8d\x07\xbe\xc8\xbf^\xfa\x918\xdd\x9e\x05\xeb31\xb4\xa5(s/	1\xe8U\x19tP\xa7k\x06y\xa6&\xa1\x82!\x17\xae\x99\xe3\x05\xeb\xee\xf4\xbd,\xc3\xf4*jU\xeeh\x19\xa8b\x19+\xdd\xc0Y4\xdcM\x06\xd1p7\x96\xd1\xf0\x0e\xbf\xee\xc6s\xd4n\xeb\xa9}\xd7\x1c\x16\xff\xf6cD\x12\xdc\x0b\xb9\x84\xbd\x00\x1c?\xf4\xea\x04\xb6J\xc6?\xe7ru\xa6n\x1d\xc9\xcetu6\xfc\x14\xad\xb2<\xfa\xb1%|\xad<M!\xd2\x1b\x12\x90\xe1\xbb\x15\x9dkn\xf5\x84\xddW\xcf\xb5\xdfC\xa0\xbbF\x1e\xc7\xb9\x05\xbb\x89\x084\x0f\x0f\xc0\xa0\xbdJ\xb3\xbc&?\x1f\x1b\xdc	\xf6\xb6\x0f\xa7\"\xd4\xb1\xc1{=?;Q\xfc@\xb6\x03\xa12\xac\xd4\x94C\x8a7\xbe\x88\x05G\x1f\xa4\x1a/\xa16)\xef\x1e\x1er\xf3SwU\x8b\xc5\xc3M4\xe4\x05F\xf1\xc8yJ}\xf8a;\x8f\x050\xaf~\xf4gs\xb9\x95	\xd5\xfdo\x7f\x8d\x00\xbbh_\x1b\xac\x04\xfab\x95W3\x88\xeaL)\xa2r\xa0\xbb\x92\xecI\xde\xbf9\x99\x0c\xe8WX\x86\xe9D\x1b\x08\xc1\xa8\xc7AA1\xe1\xce\xb3\xe3Bf\xc7\x05\xb2\xc0\xd2\xb4>\xbb5\xb8\x17\xee\xa7\xc7`\x08\x9b\x9f\x1e|\xf6\xdb\xf1\xa1o\xc3^\xc8\x99\xc3\xb4\xd5yn\x05\xa4\x0b\x90\x15<?\xce0^^\x94\x924 \x1d^^\x9a\x81\x12K5\x83M\xd2\xf1U\x7fK\xb6\x06\xb3\xb1\xfe=\x9e\xcf\xe5J!\xeb\xe5\xf0\xebS\x0f4\xdb\x0b\xf0\xf8b\x80\xe7\x1c\xd6\x13^E\xc57\noRQ \xd6\x05x\xd2%\x19\xac\xce\xd2^k\xf0k\x12\x9a-byDq2\x917j\xe6\xd7g\xf1Z\x08\x98A\x0c0=0\xdb\xa8\xd6\xc7\xb5\x98\xc2$\xe2\xe5\xa8\xd7\xf3o`\xf55\xc0\xbf\x12i\xf8	\xc3\x96\xa5\x9a\xdd\xcdF\xf3>2\x8e\xaf\xe4\xddl\x8c\xdf\xe80\xfa\xea\xf4\xc54\x9a-=\xba\x17\xc7\x14\x03\xf6\x02\xd1\xef{=\x7f\xa7x:\xfb;\x04~jN\xf6Nh\x03\xb3\xa5\x9a\x0dx\xb8\xf1\xa2\x1c\xf0x'\xcd\xf1\xe6\xf1\xad\x06\\O@\xd7xs[\x9d!\xa7\x19\xb0\xe3\xcd\xad1\xa8\x15\xc0L\xfb\xa9\x86\xde\x8a\xd1\xaf\x81\xd6><l\x08t\xad\xca\xb6O\x0f\x0f\xab}\x05\x14\xbb\x94\xb4/\x82Y&3\x84\xa7\x84Viu6\xbc\xd4\xc1B\xcecW\x8dQW\xc4Wa\xb0d\xa6v\x08^Y\x17mFBL\xb3:qP@\xa8\xf53ip.\xec\xa1\xb4\xdfK\xe0\xac\x12\x7f\n\xc9\x9a\x9f\x99+\"X\xd8\xe5\x85\xaf\xa1\\\x99\x0e\x1a$8\x14\x0c\x11{t\xe9\x84C\xb5\xf7\x92\xbb%Lqq\x95\xb3\xb5rL\xfc\xd9\xcd\x12\xa1{\x94m1\x0b\x9fq\xc71~f(74\x9c{v}\x9d\xa5\x17e\xc2#@#\xc4!j\xc22|\xd2\x00U\xe1\xc8\xcci\xa0)\xac\x0c\xabf\xb0L\x98\xfc\xec\xcc\x10\xed\xf7\xd3\xcb\xa5\xcfDV\x86B\xae0\x80\xb9\x1e\xae\x03=\xef\xea\x97\xcc\x14\xf5\xdaP\xf4Y6?R\xcaH\xe7\xd9\xf7\xb3\xd5\xc8\x98\x11\x1c\xc2\xae\xd1\x0c\xc5\x1e/\xcc\xb2\xb9J\x9f<l\xcd!\xfdO\x07/\xee\xf5\xe2\xa1\xe5\xd1d\xa1\x13\xd6\xccOsj\xc2\xc5p\x8a\xd2\xcdDn\xddr6\x19\x83s\x14C\xd0\xb3\x0e\x0b\xcc\x88\x96\x96\xe9F\xad\xabq\xa5:\x10( TkG\xfa`\x9b\xe1\x91^\xe8\xdc<\x92}\xa0[\xd0\xf0\x86\xc3G\xdeH\xeaU\xb3,c{\xa0\x1e\x9dM\xe2\xd6E\xc7\xfc\x1b\x13f\xb9R\xaf\xfc\xf6\xb2w|QB\x03\x8c\"\xc7\xd8\xbe\xdb\x82\xbb\x90\xfb\xccv\xbe\xcd\x88\x99\xc6\xb5\x848O\xe6\xe2\x99']X\x1ex\xb5\x97!]\xaai\x8c\xa9\x12U4Vl\x9d\xa3s\x17^)\xa6\x9a)(\xd8\xc5\xcb_pHr\xaa\xc9\xb7\xb0.\xab\xca#{\xca\x82uy\xad\xc3:\x98X\xbc\x0c\xff*o\xd4\xb6+\xf9\xaekoV\xfc\x96\x7f\xfd\xf0p\x03\xf9\xfeF-\x1e\x1e\x98\xe7\x06\xd3\xac\x94\x7f\xa5\x18\xb5R_ \x96\xb4\x920\x9e\xda\xcf\xcd\x03\xa6\x13D\xb6\x88\xca.\xd8\xc4}\xa7\x8c\xfb-\xe9\xe2\xb0\x8a\xae\xc89_\xa9\xddi\xe9D\xf5\x0e\xe2\xe2;\xdc\xda\"\x7f'N_e\xfeN\x04;\x9a\xe0Ku}zE|\xbb\xbcU7\xa7\xaf\xfd\x03\x8d0\xab\xac\xd6\x0cy%\x18\x90\\\xdd\x0d\xe9<\x99\xe2Q\xa9K\x1d\x18I\xa9\xdb\x87\x07?\xcd|\xce\x95\x89\xa4\xf3&\xb8\xdc\xcb\xfbp[f\xe4p\x17l\xf6B\xde\xb9\xa7\x8a\xfa4\xb4'\x8c\xbck\x1eG\x9c[;\xb4\xa8\x949\x87\x14c\xcf\x98g\x0f\x08\x08|\xfc\xe1K\xce\"B+\x8f\xd4\xed\xa9n\xd6m\x85-\xba\xadc\x8b\xc2\x04q\x95\xa5\x8cJ_\x9fz\x9b\x8e-\x91\xa5\xe5\xcf\xda\xe0\xb1]\xe8g\x03\xdf\x8aRt/\xed\xa8(\xfe\xdd\xd4\xf3]x\x1d'w\x1det\x86'\xf6\xfa\xf4\xe6[\xd7\x1d3\x80\xea\xe8\xba\xd7;\xba\x91Q\xc6a\xfdp\xc0\xac\xe3\xab5E\x0e\xaaH$S\xeb2\x8f\xaf\xae\xa2\xbc\xda\xff\xf0\xdb[\x9d\x0d\x97\xd9m\xbaI\xc2\xbb\xc3/\xf0\xbatO0}\xe8\xa8\x0epNhJ\xe34\"<\x9e\xa2\xebx\xa7Zj\xa5\xd4\x13\xb66\x1f|8\xd6\">\xd6J\xecm\x83~&\xb7\xfe\xedR\xe6\xb7\xbc\x9f\xcf\xd4'\xf7\xea\xb59\x1b\xc6\xc5\xfb(/\xe2\x026\xac\xaa%\xf2A$\x80\xcd\x81\xe3\xd9%7\x142\x8b\xd0%(\x08\x08BEG\x1c\xc1\xdf\xfeV\xa5L\x11\xf0\xd3\xc1d`\xb0\x19\x84\x8b\x84i-\x05\xbbI\x1b\xc1nbq\x7f\x81\x80\xe8\x8c	\xd9\x0en\x03\x94\xc3\xfb\x1fP$\x85\xcf\x8e)\xc6\xa7\xb0;\x86\xe6\xdb~\xda\xa1\xef\x15\xb5\xb04N\xb7\x9bsZ\x07@2\xbd\x9b\x12V\xdd\xa3\x01k\xc8\xf5\xc6\xad\xd3/+\x1e\x89>\x18\xa7\x8b<\x82\xa43L\xdekO\x9f\xe6\x9d\xd8\x88\xfa.\xb5*\xedb\x91m\"=~\xb2\xde\x1e'\xa4v\x1b\xac\xb7\xf1\xad\xd6G\xa4s\x13F\x88\x17\x82\xd2B\x00\xe7a\x94.)\xdcc\xbc\xf2_/\xfd\xa8\xc1l\xc5\x86.\xa7\xc4j2\x80G\x99\xe5\xe0\x9ad\xab\xe1\x08\x04S\xb2\xf5[m\xa2\x00/I\x00\x1e\xb0\x10uGE\x958D\xfff\x83\xa9\xb0\x8fT\x05nb8\xd3\xc8\x18\x19T3A\x86\x05\xd4u=\x14\xeejw\xa0F\xdeT_\xb3X#n\x0b>7\xae\xadZ\x0e\xad\x1c\xa7R\xbdx\xe8\xc5\xd7q\x01\xaa\xf3)\x89\n\x9f-P\xae\xce\xa0]\xbf\xa6\x7fo\xe8\xdf\xbb3\xf5\x8fX\xee\xce\xd4\xc5\xa5\xbc<\xb3\xceK\xf2\xb6i\xabb\x9e\xbe\x88\x1d\x1a2\xb4& \xc6\xf1T\x13]#\xc7\x89\xbe\x88\xd9\x1a4[}A\xd2i0\x0f\xca\x8fpW\x8cps<\x99\x00\xf2\x14m\xa3\x00\xec\x99\xcaR\x84\x9e\x9c\xcd%\xfd\x7f.s~\x98K\x98\x07L\xa3\x135\x91\xa55\xc9t\xe6Z&\xc6\xcc\xaft\xed\xf2%\xf3\x87|\x97\xa8\x0b{\x16\xf5\xbcJ\xd43\xdd\x0e//u\xc8\x9b0\x01\xc0\xa0\xf3\xa8f?\xfb\xb8\xa0\n\x89\xbf\xe3\xb9\x98\xcb\xedl2\xef\xf5\xdcBZ\xa3\x81\x02\x93\xb9\xc1[[*\xb7\x9a\xca\xa5\x0bo\x93\x01\xd4\xf7>!l,\xa9r<\x8c\xf10\xe1\x87	\x1ep\xd9^\xf7z\x9a\xbb;Rk\x83<\x1e#v(\xac\x1a\x85\xdc\xa8WK?\xa4Z\xe4\xea8\x12\xd3\x8c\xaaE\xcd\xc0\xec\xd0\x7f'\xf4w\x03\xffu\xceT\xe9\xec\x99-\xa1\xd2\xd9\x97s\xc9/\x8e9y\xcc/\x8e\xdd\x17\xc7\xee\x8b\xf4\xf0\xe5\x1cR\x94E\xd5\xc8E\xb3\x91\x13\xa7\x91\xe3\xa76\xd2\xb4\xcb\xb4\x82\x1e&Oh\xa4iW\x95C/\xee\xbf\xa7Y\xa4^`\x80\xb7\xa6\x80~\x98 \x07N\xdf\xc6\xa7\xf1{?w''Gq4_\xc8\x1f\xfdB\xd2#\n\x08\xf9o\xc0\x8c5g\xc9\x9d\xa1\xe9?uU\xf4OA\xddo\x8fX\xf5\xc2D\xbf\x00\x876\xfcS\xc8\x01\xbd\xc1\xcd\xcf\xb9=[S\x80 =\xe4\xa7\xa6s\x163\xe6\xde\\^ \xab\xba\xe18Y\x0eDx\xb7aE\x052\x00\xa2\xfc\xe7\x04A'\xa3\xdb/>-\xadz\x11\x94s\xda\x04\xf43P\x87\x91\x85:\x94M\xcc\xbf\xbfeE\xa9\xee\xb5\x15O\x90\xedeF\xe47f\x9c)~L\xcd#\xd3\x7f\xda\xac\xaf\xf3\xf0\xd6@t\xeb3\x14	\x0c9r\xb9\x82]\xb4\xf6\x94\x82qn7\x9c\xb89i\xcaaS\x1d\xc4]\xb9v!\xc1/\xeb\x9a\x0b\x83\xddUOe_\xa6L5\x9bj1\x88MS\x0d\xbc\x9d\x0d\x95\xa15P$\xbb\x83\x9e\xc1\x08t+]KjA<YH\x94j\x01\xf2~\xda\xea\xb3	\xaaX\x88`\x95\x01\xfe\x19*\xc8\xdb3\xbft\x14\xd4<R\xeck\xf2}\x92}\n\x13\xbaK\xc2\xe6\xc1\x06\x19\xb6\x9a\xe4\xa9\x89\xb1K\x8f\x1c\xb4\x80\x89\xae\xd1\x8a\xfb\xf0htJm\xcdGt\x1a\xbe\xf3*]B\xb1\xa6?T\xcfv\x90\xac\xb4\x84B\xc7o\xfe\x18_G\xc4\xeaPQ\xad{\xe34\xdd\x08Pz\xd2\x02j\x9e\xc8P\xf7\x83\xaa\xc1\xe9\xda\xc0m\x11W\xf2]\xf5\xea\x9b2\xd2A\xa2|\xb8\xb7\xc9\xe4\xb3\xe1\x031\x06\x99J\x1a\x00sP\xe9RXA\xe8\xc4<Q\xfd\x86\xb8\xd8HpZ/\xe1Y\xbfBj4O\x00kNcz\xe2\xddZDB\x8a#1\xbc\x0d\xf3\xeb\x1f7\xbe\x90\x8c!\xaf\xc7&N\xaf\x9e\xd8\xbf5X\x9c\xef\x00\x07\x00Q\\\xd2\xb0\xaaLed\x17\x9c\xbd\xd4\xdb\xe6\xa0+\x8d\x16Qu?\xa6+\xae\xd0\xc8\xa0\x88\x91zm\xb4\x83~\xd8\xebA\xc5\xa0\x07\xe8:\xdb\x16\x11x1\xc0\xb0^^\xb6\x95\xa9\x8d\x92\x88\xe4\x16\x0d//\xb7iW\xd1\xcch\x84\x9bY\xd0(\xfbz(?\xfbE\xf5\x84\xc0\x8f\xed\x8f\x1f\x8e\xfa\x18U\x96\x11\xb0=a\x94\xd6\xcfw\xe7)\xed\xd8\xa6\x9fm	\xbei\xa7\"a\x83\x8f6\x1b\xc5\xe4\x86\xe3/V\xcb\x1d\x0cvs\xa6\xd2\x033U+\x89\xae\xa5\x07\xba\x86A\xb0\xd27^\xf7\x1dC\x8aIK\x9b\x93\xd6\xfd\xf1\xff\xce\xa4\x19\x8b\x9d\xee\x89s\x9b\xf2X\xef\xfe\xab\x13g8H\xcfX\x14\xe0\x80([\xd6\x10l\xba\xcae\x86\x8e\xd1\x01$!\x9a\xd6\xf0\xb6\xf6\x16;\x0f|X=\xed\xce\x13\xd3G\xc9]\xcd,\x0f\xe2\x14:\x84I\xf9LBl\xf6\x1cf\xc9Q\x15Q\x1f\xe2\xf3\x8c\xef\x17\xa4\x0b\x04C6\xd8HD\xc4\x1e\xac\xc44\x83\x99\xab\x9f\xa9\xc9\xb1Q\xf7T\xba\x06\xa8jV\xa0\x82~60\xda \xc1\xfa\xd1\xd0*p\xb4bg\x1aW\xc8\xd3$\xd3\xf9\xc0*\xc9`\x90\x91L\xea=\x93\xb2 \xa7\xc7w\xc4\xe4[\xf5\xcf^\xc8\xd8\x91j\xf5z\xbe\xfb8t+P9\xab\x9e\x0e~q\xe4\x84y\xad1\"\xe3\xc7\xc0\x1c\xed\xe9\xd7\xeb5S\\L\xe4N\x06\nZ\xa2\xd6B<\xe8:\xef07\xd5\xf9\xaeI\x03Y\x85\xd9uOv`\xb5]a\x0c\xfc\xb0\xe2\x8d\xbdX\x08\xfb\xad\xaciE\x96\x8b\xa9_<<$\xd8\xc5ND\xfb\xaaM\xe2\xfe\x1c\x1e\x96\x9f\xce\xe4\x10\x01\x04d\xd6A\x96L\xa1\x0bS\xa8\xe8\xf5\xfc\xdf\x96~!?\x9dA\xd3\xc4\x9e\x8b\xd5A\x04\xe9F\xd5\xfe\x17\xa3\x87\x07\x14G\x05B\xe2\x87\xb6e\x93\x9f\xdc\xc7	\x1e\xb1\xdb$\xd0\xd4\x7f\x83\xd34\x17O\xea\xc5\xf5\xa3.\x0e\xc1X\xfa\xdf\x19s7\xe0\x7f\xc7\xf0|~l\xc8\xa6\xeb03\xd3\xc9\xc8c\x0dL\x8fL\xce\x17`*\x00\xc5\x1emj\x1f\x88\x99\x9bc:\x11s\x0f\x84\xf4c\x87\xddPG%f9:\x8d\x1b\xfc\x99*\"\xc3\xaa\xf9\xa9\x1c\x7f%\x82\xd4\xc7H\xef}\xc7\x0e\xcf\xae\xfb\xc6z5\x04\xa5\xb9\xe2e\xd6J\xc2&0\x0c\xb6\xe6\xa7\xd3\xc7\xa0\xcb#\x1d>\x8c		\xa6\xe3\x1b\x00q\xc6\xe9\x15\xc58\xb3b\x8c\xbc\x89\x0e\x1dV`\x9cB\xe6C\x06\x90%4\x9e^\xef\xe8\xfb\xb5\x16Ra\xc2,3\xdf\xbe[\x10w\x7f\xea\xa7\x16\xf5\xbd\x0e\xf3\x9e\x91\xf5\xddy|\x1d\x97\x86\xd0\x13\x97O)\x9e\xc9\xef\xaa\x17\x97\xac,\xbb\xf6\xedI\xbc	S\xc3}\xa2NOT\x08\xf2v\xa8\x01\xd7~\xff\xcdZ\x87\x00\x17\xb2}`\xd5\x8fH\xe7\x96\xf8!\x0b\xaf=\xb9\xdc\x05\x91\\RL\xa5\xbdp\x00\xe8\xdd\x0f@\xbc\xfa\x13\x7fB\x86\xff\x87\x8f\xa0\xf5A\x84\x80\xecWq\xfa\x0b\x90I\x89z\xff\x1a\x84\xfb\xcf\xde0\xfe\xc8\xadG\xd6\xafh\xf5\xc5o\xb8(A\x81\x93\n\x9a\xbbj\x197\xbf\x1c\x1c\x90\xf6\x12\xd5\x95,C\xab\xc7\x0d\xed\xbe\x8a\xc9T\x110\xc44\xfa\xec\xf1\\\xd6Mk\x18\x87\xbf]\xab\xb3\xd1*\x91^s\x99VWR\xb8\xa7\xd3\xa25f\xf5\xe3\x9a[w\xfd\x02L\xd2{Z\xb02#\xc8\x93\xd9h\xfe\xf00\xe6-\xe5\xb3,\x80\x96\xe9`,\x8e\xe3\xfeX\x9cd{\x06gm:l\x95\xe2\xfe\xff0}\x95|\xa01\x81\x9f\x9b^-\xd5uF\xc7\xea^\x9c:{\xbdf\nK\x83\xed\xe1ln\xf8\xbd^\xfd\xd9\x96\xab\x07hw6\x13\x07ewR\x0ch-	\x88\x1c\x88\xd9\xa7\xc2\xd5v\x80\xd3\x1aQ\xce\x8f\x10\xcc4g]\x96\x1c\xf6\xe1\x8c\xf8\"\xa0\x12X#`?fs_V\xfdb\x16\xb9\x00~\xfa1Q&,:\xb7{\xed3\xd2\xf6\xb3+\xcbt\xd8u\xf4	:\n:c!\xeek\x1f\xdc\xa6\x8f|r\x9b>\xf9\xa3\xdb\xf4\xf3\x9f\xa5\x8d\xf0\xc3\x99\xfa\xe7t]\xf8]\xbb\xeap\x90:mi\x0c\xe3\x19\xc2~\xd0N\xb2F\x03T\x0fJ\xcfB\xb9j\xf2\xcd\x14\xd2\xce\xa8\x1b\xb2\x83\xfa+W\xccC\xdc\x10P\xc8*K\xf1\xd8\x18\xef\xe3v\xf7\xb8\xd7w]HQ\x8a\x9a&\x95\xad\xd1qw\xb0B\xedX\xdc\xb7\xa0<b\xb0\xd3*\x9b\xc5\xf3\xca\xe9O{\xeb[7?x\xeb\xc3\xda#\x02<@\\\\D	9bC\x1f\xc4z\xc41\x9b\xfb\x1f\x8dl\xec\x97\xbd\x90\x1c\xec\x9fG\xc8Z9\xa3-\x82r\x9b}\x8a\x80\xab\xf7\xdf\x1bd\xa6\xe0\x14\x8c:\xa6Z\xab/\xb2\xa1J\xa8\xecx\xfb\xa9\x98f3\x18\x10{\xfdp\xaeR\xbe\xcb\xc4M\xf9\x11\x9e\x9dH\xb8C6\x17\x81%\x8c\xc1\xd8\xfd.\xcf\xae\xdf\x87IT\x96\x91\x1f\xc2\xaa*6\xe2\x1b\xd6x\xa4\xc6\xca\x04xh8s\xd9q\xbe\xd7K\x1b\xed\xe4\xd9\x8e\x9e>\xdb\x18\xde#`\x96\xf8-$:$F\xcat\x12\x98-\xb6VnXi\x1bF\x81\x15\xd2aq ;n\xbcX\xd9\xcd\x10\x0f\xc9\xd3o\x87\x9bX\xff'\xcfkT\xdb$8\x82\xcd\x05\x86\xa74U\xef\xd9\x88\xce\xf7*[xrL\xe9\xcc\xd1\x1a0\xd8@UBv\x9e\xb8a\xb1I\xe2\xd2\xf7\x86\xe4K\xe5d\x1b	\xbcS@\xcfc\x87\xd6J\xf2a\x8a\xa9,:tW:\x7f\xdc\xcc\xafj\xa0\x06\x92C\xe7\xe1Z\x9c2\xad\x9ax\xd2h\x9c\xfcP4j0]\xe1\xfc\x9c\xf2;\xd7Y\xda\\\xef%\xac\xe1\xa2\xe6\xfd\xb1\x84\x95\xd6\xfb\xa5\x9f\xd6\x16a\xc1\xe6\xe0G#x\x00\x1d\xca\xd7\xdd\xa02[\xbd\xaaqI]\xf3\xcf\\L\x8b\xdb\xb8\\\xac\xfd\xad\xb8_\x84E\xe4i\x87b/\xd8*\x83\xbd\xe0(8y\xf3M\x81\xb8\xf2\xdb\x94\xcasd\x10[~\xd2Q~_\x90\x1dk\xd6=\x19\x9eD\xb6\x90\xc5l\xdc,d\xb5\x91\x94)d\xf2X=\xba\xa7(\x82\x82\x07\xeb2\xc5P_\xd61\xa9\xdbF\xb2\x9d\xcd\xb5\xd9i+w\xa7\x81\xf9\xf8\x034\x94\xf7Z\xe5\xbfC\xdb\xad\x8b\xe1\x8c\x1d7\xe9\xd8Q\x83\xf0\x91f\xa9\xedl>\x85\x96\xa8r\xa0\xae}3S\x99\xf1\x9bNq\xeaU\x80	\xe4\x01%\x1c\x18\x12}\x0c\xa5\x868\xd29d\x0d\x17r\xa8\xa6\x0bu4\x96	\xe2\xcf\xbd\xc8\xcci\x95\x18\xa7t\xb3\xba\xb2Y2\x97\xa1\x98\xc6\xc5\xdb\xf0\xad\xbf\x05\xc6N\x01\xcb\x02\xc9p1[\xb1/N-\xa5\xe3\xdbt\x88\x8b\x10\xec\xd12\xb2\xe9\xc1\x9e	\xdaefo\xc3\xb7\xf2m\xf8v.\xf6\xefh7`\x8cY\x02\x15U\xaaC\x80\xcf\xbf]\xfae\xc7l=y\xae\x1e\x172~\xfb\x1f\xd6\xfe\xd8E\xe3(zx\xb0Wf\xbel\xe0\xfeQ\xf9\x80\xd5[S1@\x1b`q\xe67\xd1\x92\xc6\xb0xx\xb8\xdf7\x18 \xdcC4\x13\x14:\xd2\xac:\x16'\x7f\x08$'\xe7\xc3\xd7:hyb\xda\xfa\xcci\xd6M\xdb\xe0\xb3\x0b\xda\x86\xa1\x82\xb5uM\x12Z\xcax\x16\xcd\x1f\x1e\x9c\x19\x15A\xe1LaqZ\x9b\x81\x82\xc7<\xa0ye\x8d\\f$v:\xc6\x80\xcf>\xfc t\xe1\xa1\xac\xb5\xe9\x94\xf52#\x1b\x08N\xac\xdc\xcb<1\xbd\xf3\xd7X\xbck5[\xcb\xf5\\\xc8;\x7f\x81\xe7\x85\x9a-\xe4\x02\xe1\xb3\xd4\xcc:s\xccY6\x9dU\x9c%\xb7FvI\x9c\xb2\xe1Um\xf9G\xe0T	\x96;A\x14a\xbe[\xea\x1d\x94B\x9e\x94*\x1f\xd6\xfa\xfd\xb51\x18\xb9\xbf\x0dRx\xca\x05)\x83XgM\x0b`\xd6%@\x03\xe5	\xb9	`=\xack\x8cA\"\xab\x87\xf1\\\x9c\x82\x8b	\x10\xc0D\xaeT\xf8\xb4>\xa4\xcdS\x8a\xa4\xdb\xd4\x89\xad\\4:\x01\xa1\x90\x9f)\x1f\x9e\x15\xfd\x85\xedD:\x0e\x96\xb3\x16f\xd2\\\xa6\x13\x9b\xeex\xd3\xce\xe52\xc8\x1c\xaf\xb6\xb8\xa59\xa9\xd8	[\xca\x13\x0f\x0f#tl\xa3\xfc\xa8\x83\xd4\x8a\xb6\xccK^\xa9\x0fKB\xcf\xb8\x07\x1e[\xb0\xb1\x1e\x88\xbcL\xf8\xd1\x03\xdbu\xad\xaeHV8\xd5\x7f\x94KD\x0c\xf1\x8c\x08\xe0iiHf\xf4\"\x9cF\xfd\xbeX\x02t\x85&\xa8\xd7\xfb\xde\xa7\xa7\x8dL\x9b\"\xe5H8\xda	!\xa6\xd7\xd5N\x8bd(\xf3\x8aF\x17j$\x93\n\xd1\xb8x\x91L\x8b~_ \x94\xb1\xfe\xcc\xc3C\xab\xf6B8 \x99Tr#\x80\xfc\xc6[\xb7\x10s\xa5S\xed1\xa1\xbf\x126\xbf\xc2\x86G\xe1\xac\x98\x1b\xee\xc2\x01\xe4\x83\x83\x15\x00\x94\xd3\xf1p#a\x04\x94N\x86\x1b\xb8\x00\xb9}\x9b\xfa+\xb5:]\x19\x9c\xec`6\x17\xb3\xd1\\\xadHl2\x9b\xcb\x15\x8ck\xf0\x0f=}\xef#\x03\xbai\xfc\x82%\x8a\x90\xfdm5\xf1\xbd\x9e\xbf\x9aM\xe6p\xf2\xc1\xa2\x83-\x908\x99\x0c\xc8\x01k@V+\xc7NiI\xe9}JG)\x14'?\xa0Z)X\x1e9\xe3\xb5\x12{\xd8\x9a\xf9\xb8U\xeceM\xf3\xae\xaed\x8b \xabX\xeaE\xe2\xf3\x99U\x7f\x03{\x90N\xace\xc1G\xd2O\x109\xc9{\xed\xf0\xd2\x90\xc5`\xb8c\xc7\xb9\xfc\x0f\xf0 |&\xf8^s/x\x1c\xbf\xd19\x80\xf8\xe2\x96\xd5\x98\x8e\x10\xcc\x008\x82i\x9e\xfa\x0e\xc9k_,\x1aT\xc9\xba7\xce\xfa\xc4\xe4\xfa\x1e\x14p\xe67\xa0\x1b\x00yB\x988\x18\x7f\xd8%)\xc0\x1d\x91\xb1P\x1f^\x84HVcAf>\x03\x186\x99\xfc1\xe7\x8f)\x9f\x83 \xfa\xb6\x1aq\xe2\xdbW\xc0-\xff\x0e}K$\x0b\xc3\xa7\x14 P\xa1\x9a\x01\xf95\x19\xde\x91\xd1]2\xdc\xf5\x0d\xacq2\xbc\xeb'\x06\xd7\xd7\x18|\xd8\xdaq\xac\x98\xda\xe5B\x99\x97\x96\xca\xbc\"W\x1d\xa7=\xd9\x04]\xae\xa7\xab\xcf\x88\xb9W\xc3\xa2!\x96\x0f\x8d%\x9b\xdc\xc2I\x91\n`\xb1\x10\xfd\xe2.\xc8\x85\\r\x16\xcb\xac\xcc\xac\xd3\x13no\xf4\x1e\xc9\xab8\x0b\xed@F\xacY\xb5\x15\x89\xe7p,L\xff\xfd\xc30\xc2Q\xba4\xa2%#\x94\n\xb7W\x91\xf74\x04\x130\x1e\xd8\xba\xe6h\xb8\xf3c\x1c\xa8\xb1\x824\x84=\x01T,\x7fy\xedCx(-6\xc0\xe7\xfc\xd7\xa9?\xc1\xcc{>\xfa\x8b'\xe9\xdfy\x97\xf3z\x1e.\xe3m\x11x\x7f}\xfe\x17\xf8+\x87y\xf9*\xbdJ\xa2`2y.\xa3t\xa9\x1f\x06_>\x97\x8b$[\xfcF\x81/\x8eF\xf2:N\x83\x91\xbc\x0ew\xc1x4\x92t9}K2\x1d\xf8\x8c\x87\xbb\xb8\x80\x89\xb9u\xa1n\xfbd\xcff\xc3\x89\xf4\xfe\xf4?\xe3\xc5_C\xf8\x92\xcf\x86_K\xefO_=\xfb\xfa\xab\xff\xa1\xc7\xb1\xf4\xfe\xb4\x98<{\xfel\xec\xcd\xe7\xec\xba\xfdl\x04\xd8\x0f|\xaaQy\xe5\xf9\xde\xfc\x8c\xf7\xa7(\x8a\x8c\xeb\xf7\x84u\x13E\x96\xc4Ko\xbf\xa7v~\x8c\x17\xbfU\xedt;\xf2\xdcT\xfc\xf5g\xea\x1dw\xd5\xcbn\xf0\xa6\xe2e\xac\xad]\x83\xe7:\x18M\xe0\xc15\xc3c\x8f\xec(\xafJ\xf2G\xbd\xaf1m\xba\xe3_\xd7<\xdc\xeb\xef\x97q\x998\x03\x9d\xadV\x15$\xc3l$\xbd\xc1\x974\xf1\xfc\xd2\x9f\x9e={\xe6I\xe3\x03\x11\x8c\x9fc\x0d\x95a\\y\xbb;\xf1\xebI\x8a\x14P\x9c!\n\x04<\x92#\xe15\xd0\xa7\xf5\x13\x97\xfc\xd3b\xb1\xb0\x832\xb2Q\xb0A\xc1\xe5&\\b\x9f\x06\xb3\xe7rlc\xc51t\x04\xdaYk&\x8dM\xd5LL<KR\xdf\x9c\xa97ic\xcfE\x8bu\x98\x97\xc5\xf7\xd8s\x1c\x8b\xd83\x9e\xf5!-\xdf\x91m\x94\xa4\x05\xca\x80\xdf\x87\xbd\xe8\xf1\xa9X\x1b\x14,2 :\xd2\xcf\"NI\x0e\x91\xcbPEL\xcc\x10\xcc\x83>\"\x01\xb5\xb1\x1b\xc4~.\x8e\xc3c?|\xa9\xb2\x93g\xa7\xe3`\x02\xba\x1a\x0d\xef\x06i;gj\xdf\xae<\x93e9\x84\x0e\xe0c\xe6\x17p\xa8&`#<E\xc3]_W\x0e\x87\xf9\xbe\xae\xad\x95\xcf\xd5\x89\xe3\x8cKU	\xf5\xb2\xdcPY\xb5\xcc\xc9\xc7\x97A\xe3>\x9eUV\x17\xf2\xf5\x99\xf2\xaf\xf2\xc6\xd83\xa1;`<\xd9m\xc2>e\xad-\x07\xb4gb1l\xca\xc4\xc07\x7f\x83\xf3(f\x87\xd0K\xfd\x95\x1f\xc2\x98?@J\xdaN\x83	\x1be\x15\x85ks\xcboY\xee\xd0\xd5	7n|\xa6e\x9e\x83\xd8X\xf1\xd2t\x15\xe4>X\xd2H\xe0E\x03N\xad\x88\xa9'N\xc6_\x8f\xecHnm\x11C`\x1b\x05\xd6\xca\xdf\x0e\x12\xf1\x97\x8fg86\xe5\xd2\\\xc4h\xc9\x91\xbd\xceh\xbaza\xa1QWF\xd8\xb0\xb1\xc1\x1a|\x13\xc1\xc1Og+\x98D#\xc4\xfa\x18<<N\xd7\xeb\x0b\xdf\xb8\xab:\x14\x7fQ\xd1\xfb\xadJ\xfa\xeb\xe3\x8d\\\xec\x82l\xb8\xd8\xc9\xc5\x1d\xfe\xde9g@!\xf3Q\x90\x0d\xf3\xc1R\xe6\xf8\xbb\x97E\x9c\x00\xaf\x02\xd2\xf7\xe9\x95cjCN\x87\xd4\x8a1\xf8\x18'+\xaf\xbbP\xce\x8c\xac\xa9\x16N\xc2<\x11\x8d\xf1\xe6\x90\x9f\x92\x05\xf0\x15\xae\x9a\xdb=\xd6\xd2u\xedz\x01\x7f\xd9\x17jdT\x91\x90\x88\xce\xc6s\xcb\xa8Gj4\x8d\xaa\xb1\xc3\x8d\x03\xaa\x89Y\x84Qz\xa9\x00=>\x82\x88\xe1t\x14\xa4\xb3h0F\xb2xQV\xd5ET]\xf5\x88\"\xe3\xf9\x9ex\xc4\xc2DGH\xa6\x89\xda\xca\xad\xba\xd9\xd3\x1e\xe0\xd5\x88\xc3\xa6\xe0\xb5{-3\x99H\x82\xd0s\x8b0\x01{\xbc\xd0G\x10\xfd\xaa\x88Q+\xea\xcc\xd7D\xd0\x9d\\\xbb\x19\xe8\xf3\x1d\xbbA\x862\x97E\xb5'\x12wO@\x82\xb9\xd8\xc95\xfe\xdc\xc9\x05\x02\x95\xc8\xa5\xea\xf3\x02\xbe\x8e!)XU\xcf!X\xd7Mm\x1f\xd9#\xdb\x13&\xd6\x84\xb3\xc3\xd0(O\xc8\xebV\xc69{\xd0\xde\x98]\xe6\x1c\xcep1\xd4Q\x16\x9a\xc9;\xc4\xd7\xd8\xf0\xbd\x97e\x16\xb8\x0d]\"\xfd\xaa\x9d~\xabB\xf9I\xf9\xf9 \x14'7\xf2B}:\xb9\x93?\xa8M\xf7\x8eo\xba\xfc\xbeQWNo\x1e)\xf8Q\x8d\xa6\x1f_\xa8\x9b\xe9G\xb3U_\xdb\xd3\xc5\xbf\x15\x92\xa3\xac\x14q\xea\xdf\xd2m\x83{\xa0=^\xf5\xa2:\xa3\xad{Qm\xdd\xdd8x}\xbc\xe8o\xe5\xdd8xu\xbc\xe8\xaf\x81\x1e\xf3\xfa\xd8_\x0cv\x02\xa9\x93\xe0\x15?\xac\xf7\x8cD\xf3Cm\x97\xeasV)\xf5\xc3P{\x03\xf7zg\xd5\xee4\xa85\xa9\xff\xf1\xe4\x06\x17\xb0\x84\xf6\xdd\x19\xb9$\\\xb7\xdbw\xae~Z\xfa\xaf2\x94>\xf6W\x83\xa5\xe8/\x85\xe4r\x16\xb1\x07l\xf4o\x8aS\x0dS\xe4	\xf9^\xe9\xcfL\xf5G\x0c\x88\x80nu\x9a\xf9\xf7{y\xcd~\xad\xe7r\xc7\xbd\x1c\xfcF\xfd\xe4n\xe2i-\xdbP\x0b\xaf^\x0c\x86_2@\xc3\xab\x97\xc3/+\xf4\x06\xe3\xaf\xea\x00/\xbc\xd6\x855\xf2\xc3k*\xcd\xb0\x10\xd6\x0e\xd0\xf5\xaa}\xbf\x17\xee\x88\xd2\xd0\x98eIS\xd7\xeb}<R\xea\xa6\xda[o\xd5h\xfa\xf6\x85\xba\x9b\xbe}\xc2J\x90\xef\x9e>\xe7\x97\xee\x9c_\xea97-\xe3\xd9\x7f\xe3\xce\xf9\x1b;\xe7\xef\xba\xe6\xdc\xff\xd8\x7f{r'\xdc\x99\x7f'\xe4m_]\xeco\x07\xeaB\xdf\xd4o\xfb\xea\xd3~/\xeb\xa4\xeb\x11\x02\xd3A\\\x18V\x1b~\x9c \xdc\xc6\xa9\x98j\x1a\xba\xcb\x7f\xadf\x96\xc8\x10\xd1\xa9\x91\x9c\xb9\\\xa8Y(	\xfe\xd5\xbd\x93\xaf\xd4\xb2.\xdf\xe7\xdb\x93\x98.\xb5+\xe8\xb6\xe1\nZ	\xf2\xa2\xdb/\xde\x9c\xd9\xcdF<^\x10\x82\x0f\xddd~l\x111t\xfa\xc7\xcc_\xe2\xab\xfeJF\x02\x91o\xa0E@\x08d\xb3kb!\x97F\xd8[\xd9N\x83I\xea\xf06u@;\xb6\xc3\xab\xe6K1t\x13~\xfaG[\x90\x1eh\x01l\x80\x8c\xddI5sFR\xd1\xf1\xfd\x12\x9b\x94_\x88j\x0e\xadr\xf9D\xfb\xe0eS*+Y\x05\xce\xe7\x02_\x8a<\x8e\x85s\x81\x8e\xfa\xf7\xcc\x8b0+B\xbcP\xf0:\xf3\xc3:o\x94\x0d!'q2\xec)\x80\x1cX\x83U\x18	\xee7\xed\x0dKS\xef7\xe6\xd1\x17BZB	\x03Hp\xa8\xe0i`\x89a7\x8e\x87\x14O\xa6~s%\xd8\xd0\x8d\x006\x82\x14\xd8\xfd\xa6q\x07\x1f>\xf2qk\xbf\x86=\xa2\x96z\xe3m\xe1S\xf8\xd8X\x8b{;G\xb06tX\x80\xd2\xb5<\xab\xd8h6Dp\xf7N\xae\xc2\xban\xcc\xec\x1d\xc3\xf8r/\xe8\n\xaa\x85d\x8c\xb8\xe7\xee\xdbDq\xa2{5%m\x048\xcb\xfe\xeb\xcc\x87n\x92&\x87\x99\x0c\x9d6\xe6\xb4E\x83\xd7px\x0fl|\xb9\xc2\xc9\x91\xf9nH5L{\x0e\x86w\xb6\x90K'z\xa6\x986\x9dx\xcd1\xd3 \x94\xfa\xc0)\xe4\xfd.\xd8\xca\xbb@\x9f*Ah\x8d4F\xc292\xcc\xc1\xd0q\xf4\x98\x03\xa6vf\xac\x80_\xb7\x88>\xc4\x8b51\xcb\xc0\xc3\xb0\xf3\xa3\x99\xb7\xcfM\x10\x0f\xfc\x92\n\x93\xc9@}\x8c\x8a\xfa\x18\x81\xb2\x86]3\x13\xfe\x873Sm2s3\xa1\xc2K'\x83\x83\xb8q\xce\xaa\xb6\xc06jE\x0dXu\x93h\xcc\xe0\x95\x9e\xdd\x8d\x9c\xe5\xb2\xf8\xbfOe\xd8\x98\xca\x9f\x96\xfeF\x86\x1d\xbc	\xe6\xf0g\xf4%\xd0\x1a\x9a\x05+\x81\x16\x94\xc3a\xb6t\xd6\x92\xb3\x96\xff\xc1Z\xb8\xeaZ\x0b{![p\xd6\xabm\x9aFI\xcb\xedR\xdc\xbf\xee\x806\xfdO\xf1\xac5\xba\xbc\x81~\xe6\x13Z\xc38\x13S\x0e\xce\xd6/?\x87\xd0\xcc7\xa4.\xb9e\xab6\xe7UqO\xa8\xd0\x1a\xd1\x05_\xf2*L\xe8\xa9\xd1\x10\xdbL\xb2\x13\xb0d\xd4&\xc3Bq\x9d\xdd*\xfd\x07\x94\x9a\xb2\xe8	V)\xc8\x8bm^\xfd}J\xa6\xbea\x044\x1en\xad}\xdc\x08\x074\xd7\x87\xbd\x82\x99	\x07\xb9\xb4V\x87\x07\xd4\x0c\xd8\xbev/v6p\xb9\xd8^\xc3\x06\x8c\x07/6(}*;\xedk\xcbX?\x95\xa58\xc9\x8e\xc7\xa3\x91\x18\x96\x99v\xce\x9b\x88`$\xe3\xe1\x9fo\xc2\x9c\xb1\xec=~\x11\xc0\x85\x8f\x0b\x8a;d\xc2`\x7f\x83\xafG\xb2\xcc6\xc1W#I\x1c0\x9e5\xec\x19\x92\xae\xe3\x14\x16#\x81\x07)\xe5u\xb8\xd3\x0f\xe3\x11\x1e\x8b,/\x03\x8a\xdb\x17\xa5\x90\xf9y\xf2*\xdc\x04#\xa8|\xd3(il\x94\x1a\xb4\xe7\xa8\x829C@!x\xddH;\xa7-\x11\xe9\xa4&W> \x8b\xb5'kp_\x97U\xaeV\xab\xba0s\xfc\x19\x08P!_\x9d\xa9\xef\x1dh\x13yv\xd0\x95z\xfa\xea\x00\xa0I\xcbm\x81P\x8c4T\"\xb4I\x15D\x9b\x033f\xb9$>w\xeb*r\xf6\xc1m)\xb9\xcf\xce\xc44a\xa0\xa2\xe4t\x1c$2\xad8\x9f{\x18\xfc\xc1\xc5\xa0b\xaf\x88\xe9*\x82B\xa3\xfb\x15{8	T\x17\x03\xb6\xf7\x81\xe7\x12 \xe1\xc0~\xa2\xd3\x81\xcdH\xf6{\x99\xc9H\x90?qW\xb6\x11\xc96\xbf\xa3_c\xddQ\xe8pG\x0eSd\xb0\x1a:m\xfd\xa6N;s\xff>\x89\xd3\xe8\xef\x19<\xb7H\x8a\xcdx\xab\xeb\xbd\xdc\xd6Y\xab\x99\xb5a\x02\x0e\x83L\x1do.\xb5uNY\xb3(Z|!SFcA\xfe	\x9a<\xb0\xff\x15(\xd0\xab\xb3Z\xb6\xea\xe2\x85+,+B\xad\x94i=e\xf2\x94u\x91w\xac\x0bM\xf1\xdc%\xd3\x1e\xb8\x95{\x93\xe1y\xc9I\x10kle\xdc'\x9e)Y\x9b\xdf]\x90\x0fw\xf2.\xc8\x87w&\xdf\x80\x1f\x1a\xc8\xcf\x80\xdd\x0d\xe1\x96\xae\xdd2\x82\x19^\xca\xa1P\xfc}\x12\x8cG,\xdcOj\xd3\xcf\x90^\xc6l\xa51\x1bC\x93\xbdn\xbf\xc4\xb2\xa6\xc5#/r\x91GG'\xce\xb0?\xb0V\xea\x8b\x03\x18\xc3r+5L\xf0wQ\xb9XGy\xe0n]\xcap\xdcD\x0d\xf9\xfd\x08.\xd2E\x0c\x93\x95$\xa1\x90\xdb\"z\x93\x16\xf1\x92	\xda\xd1Q>\x8c\xe9y\xaf\x05\x1f\x9ap\xe6\x0ejW\x9b\xc9\xc8\x875PI\xda\xc3\x8cX\x95\x0e5\x96\xd4\x1b\x06\xb0b\xfc.\x86Z\xe3\x0e\x9a\xccm-36u\xc4\x8d:\xd6\x8db\xb5:\x16\x8d\xcc\x96\x90\x01$\xc6\xf1\x90tq\xf2\x0e\x8b\xd2\xea^\x98j\xf1\xa4\x97\x00Q\xf5\xbd\x86\xa8ji0\x0cg\xf5\x19\xfc\x07>\xca+\xbc\xfcK6\x18\xabD\xa5Sx\xfd\xacV~\xd6\x10)\xd8\xcb4\xae\x1b\xdf/\xe9\x00\x9fZ3\xbe\xea\xf6\x06\xa2\xc0\xc2\xed\xa8K,\xe08\xd8Z\x03\x82\xeam2\xf0v\x90\x15\xf0\x15\xae-fR\xde\xfc\\\xfc\xe8\xd5\xbf\xe3\x1b%\xd0\x1b\xba\xaf\xfe\xd5\x98\xa8\xb4\xed'sH\x15T{\x0d\x86\x1e\x9d\xca\x9c\xbd@\x8c\xd9?\xbf\xf6\xcdd\x89\x96\xb5)$&]\xb6\x1e\xfcB\xcb\xd8#\xae\xddtap|\x88+\xe3\x8b\x14\x98\x1a\x0fr\x8a\x9f\xa1\x92\"\x9a\xfb\x1d\xf0J3\xdc\xb6f\xaf\xf9\x02J\x170\x86\x97\x0b\xb5\x86PH'S3JN\xe6\x1cP\x97\x0d\xab\xbc\x144\xaf\xaa\xae:\xfd\xf8\xca\xcb\xf8\x7f\xdb^\xcf\xdfV\n\x1f\xbaE\xc3\x9eY\xe7\x12\xfe\x83\xc2e\xcd\xa0#s\x15\x0e\x17F\xb7iN\xbe\n7$\xc8\xf7C\xb6\xcd\x18,\x8f}\xeb\x120\x18\x0bqb\x9f\xe4F\x85\xc3;y\xd5q\x9ee2\x87\xb1[l9\xd5\x87\x87\x91\x9cm\xe5\x1ax6G#kW\xbd`\xbbj-h\xcdT8\xdc\xb9\xe6\xd3|\xa5\xd6\x19}\x9f\x87r\x90\x8b\x93\x89[\x8c\x18SS\xca\x16b7\x90\xd9,\x93\xd1\\\xce\xb2~.\xa3\xf9|?\xf5B\xcb\x91*\xa52XB\xa9\xc1J.\xd5`)7}e:.s\x95\x0f\xf3\x88\xe2e\xc2\xb2\xccHn\xaf\xd5hz\xfd\xc2\x06\x9a\xbc6\xf2\xdb\x1b\x95\xcf\xae	\x17yv\xdd\x1f\xcf\xe5\xaei\xbd~\xd3\xf2\xd60\x97b\x9e\xc9\xdd\xe9N\xad\x02\x9fT\x17;i\xda\x01\xb1S\xb3\xc1;5\xd81\xf4\xca\xa5\xba\xf2o\xe4F\xc8[u\xe5\xdf\xc9M\x7f'\xa6\x9b\xbe\xda\xf5\x972n\x98\x11\xdfHs\xb8_\x1a\xab\xe7[cN\xe6\xf4t/\xf6\x7f\x83\xa4\x12\"	\xb9.\xfc\xe8\x95\xb3\xe1h\x11\x9dwG\xfc\xfa\xf5\xa3\x8b\xd5\x8et\xde\xd8\xd0\x11\xab\xd4\x86\xa0c\xb4\xe0\x1d\x82\x8d.\xa3\x9d\xca\xf6\xd3s'Rs=8\xe1\xf9\x99$OHm>\x10\x17\x7f\xcb\xf2\xf8w\xb8\xd3vDY\xf3\xd66\x13\xbe\x88\xf4\x99\xa6\x11quP\x88\xba\xdd\xf2\x1e\xa7\xc3\xf9\x99\xfc\xf5\xa3\xee\xe4o]\x9d\x84\x83*\xb0\xc9\xd4\xbf\x96\x84Q\x06\xec\x19\xc0\x94\xe9\xe71=S<\xe8\x91\xf1\xd8\x81\xe1\x13LJ\xd9\x15\x06\xb3\x97\xa1t\x06!F>\xe7\x8dz\xa4\x00^\x10\xf26\x0ew~\xc8\xc9\xd9i\x16`7{a\x92`\xb9\xa6\xf4>\x17\x0c?\x15\xf4\xd5\x01\x9a\x02\x96\x91#\x8f\x17\xea\x17\xc2(\x14\xd3h\x96\xce\xfb\xaad\xb6*\xc3.\xdc\xe2\xc6\xab'}Z\x0c\x8b\xf8*}1:\x05\xd0U_%\x01@\xa2\x06*\x91x\x0f\xad\xc4_@\x9a\xafm\x8d\xdc,\x90\x15z\xf9H!~;\xc1S\xaf\x87\xc5&L_\x905k4\x1b\x0fRXG\xa6\xf3\xbe\xce?f\xb8\x86\xaa*\xbe\xdc\xea\x1aC\x82\x85\xd9\x84\xe9\xcb\xb0\xf1\xba\xfe\xceq\x08\x1c8\xf9\xfeL-\xe5\xdb3K\xef\xe4;\xf3;\xdc\xc9o\xf9\xf7*\xc9\xb2\\~\xe0\xa7E\x14'\xf2\xf73\xf5*\x93\xdfp\xd2\xfb7\xd3_\x9d\x1b\x9cb+\x86M\x98\x87I\x02\xf9\x8a\xbb\x02\x7f]\xca\xcb\x86\xbc\xa5\xce\x138\xce\x05\xb8\xbb\x99Z^\x99\x05>}\x8f\xe8R\xce\xdb\x96\x9f\x80\xa7\x1b\x19\x91\x90\xb3\x16;\xff\xf9\xb6\x1d\xa8\x01\xde\xb9\x06\x01\xea2\xdcQ\x14^\x1c\xe2~I\xf1\xd8\xcf\xcf\xfcR\xde%\xf0Z\x99\x8d$\xec\xdeP\x97\xef\xa1C\x08H p.U\xfewJ\x01\xd2\xfdn\x13M\xf3a\x96~\x13\xa6K\x18\x8f\xf3;\x9fpO\n\xf3\xbb\xef\xe9\x0c\x00\xdbI$\xc1\x88\x07\xf9\x91N-l^\xc4\x97\x1e\xd2\xdeT\xa1\xcc[\x1bM\x85\xed$\xf4\xc2\xb8\xe4\x19\x07NwT\x8d\x13\xad\xcez\xb5\x8b\nx\xaf\xe9\xa3\x9c\xe3\x19\xd0\x07\x01R%\xd9\xa9\x9e.%A\x9b\xcb\xe2\xe2\xe1o\xec,L\xa1\xec!\xa0\x89\xa8\xf5\xdf\x84EDb\x18\xbd\xff\xe9\x11\xb2\x97M\xbc\x8b\x92\xd7\xf15\xcd\x1cnY\xb3\xf1 \x83\xc1c9\xcb\xacm^\xf8\x120\xd4\xe1\x0b\x15\xf7um\xe7\xec\x94\x99\xbfTi\xaf\x97\xbfPi\xdf\x84\x9a9?\x18\xd4\xb4.m\xd3=\xdb\xcb\xce\xce7F)rX\x9c\x8a\xc1\x8cM0b=0\x85\x1f\xe3\x1e\xceK\xcdr\x17\xbe\xc0z\xa4O\x1e\xf0\x8aq\x04[v\xc5a\x05h\x8f_\xf8P\x0f\xb7i\x9c\xa5\xda\xb1\x003D\xf5\xa6\xb2\xe9C#\x84\xbcI|~GFz\xb1X\x9fL\xf3W\xe6Q\x01\xb1Q\x9775,\xcc\xd5?\x98q\xfa&\xdb\xe9\xa9\xe4\xe0TB\xb2\xb4\x87\xf6\x0f\x89k}a\xac\xcd(M\x0bj\xfdJy\xc2\x10_;B\xbc\xc4\x94|\x80	\xfb\xa1\x19\xc1\xd7\xf7\xb2\xb1\x86\xdc\xd2\x18\xa9\xd2\x84\x00\xe6\xa5i\xbc\xdd\xf12\\\xf2\xbd\x9d'=\xc0\xeaej\xc6\xf2qi\xc4\xe1v\xf3;\x1eO\xb9r\x8f1\xa5Tx:\n\xc6\xb2P\xf1,\x9b\xe5sp\x8a\xb3\x11\x84\xe0[\xd5\x98DfI\xe4Z\xfd\x9b\xf1R\xb5\x05\xc5\xb7\xbbM\x98.\x7ff\xd1<E\xe7\xe8*p\x06\xae\x8e\x1c\x02@J\xb6\x14\xb4\xa1U\x0b\xc0\x0c\xa0\xce\xde\xbe|\xd6\xebm_.z\xbd\xc5\xcbq\xaf\xb7F\xec\x87\xe2\xe5H\xae\xda\xaf\xfc\x1c\xa7K\xe8\xc0\xa7\xabS\xbf\xc4\x97W8d\xc8<=\x11\xc6l}4\xef\x97\"\xd0\xf9k\x98+\x8c\x05r\xfd\x95\x9a\xad\x8f;\xda\xcaV\xba\x0f\x0f\xdf\x9e\xf9\xdb\x93\x89\x10\x83\xf2d2\x17Net\xe6m\x94_\x0cJq\xe2o\x07\x0b1\xdd\xbcxFx\xe0|L^\xa9\xd9\xb7g\xfe\xefgd*\x7f\xb2\x96c!\xfac\xf9\x81\x93\xc6\x9c4\x18\xcf\xe5\xb5\xda\x9c\xac\x8fQ\x8c)\xc0=Gv\ne\x8d^\x049G]\x029	\xe2Y\xaa'\x8c\xcar`\xa6B\x1a\xeaC\xf9\xe3\x01JT$$\xc0,W\x89\xd5\xa0\x07K'\x81\xe62XS\xcaY\x96$\xe1\xa6\x88t\xda\xa6V\n\x03\x1f\xac\xb8\xd86-\x83\xad\xbc\x8d\xd37i\x1a\xe5o\xd2e\xbc\x88\x8a\xe0\xaa\xf5\xc2\xe8}V\x04\xd7\xd0EU\xbb\xa5\xb5\xe8\xddE\xde\xa0\x152n.L#\xccjScx\\\xe9\xaf0jE\xc7uy6\x82(\xc9\x8e\xd0\x1c\xf5\x9b\xb3\xe9t\x1c\x8c\xb4r\x98/M\x11NTp\x0f\xf1\x1c\xbb\xfe\xfd\x99\x1fW'\xaf=\xb5C\xe5\xa7\xc3\xfa\xf8\x9e\xfey\x19\xfc\xefR\x10t\xaf\xcc\xd5}\xb5\x07\x83\xfb]\x10V\x88\x8aw\x81\xdb\x9a\xbd4\x92\x16\x14\x1b\xc9;\xa7(\xcc\x94k\x15}sv2\xa9\xca#\x1c\x97\x9a\xe5\x88c\xbe\xeb\x97\x10\x84\xe1\xe7]\xbf\x84<l\xabC\xd5\xafU\x01\xd7\x8fe\x89x;r+\xe4B\xffJ\x0cA\x03}\xd6Dq\x16\xcd\x1d\x08\xc8DZ\xb1\xdbVZ,\x1a^2\x80\xf3~u\x13\xc6	\x16\x96^7\xe1\xb0;\x83V\x07	5/ u\x0f\x87\xb5g\x89\xe8\x1e\x1f\xf3-\xee\x12\xd1\x0f\xe1\xce\xd4\xd5\x95,\xcbx\xf1\xdb\xeb\x18d\x11\x82\xfd1\x8b\xc7\xaa\x84\xea@\xb8\x8aJ\xb0>\xd5\xa2+\x1bGe\xfdT\xdaK\xc79\xd2y\xc9\xd1>\xd9\x1b\xc7\x19\xae\x03\xc6\x8f\xb2\xb6l\x89\xd2E\x82\x1d-\xa9\x1c\\\x8b#\xb1\x97X\x98\x00\xa0\xb9\x89.\x10\xa3\xad\xf6	\xe8\xcd\xc5=xX\x05\x9e\xc0\x8f\xd5\xc8\xdc\xbd\xc1\xab\xa7U\x80\xfd:\xe0\xa7\xd9,a\x93\x8aKv&%\xcf\xd5\xd0Y\xbc\xe2\x9e}D\xcb\xfa1\x8b\x10\x87\xac\xed\xc9L'\xf8\xa4\x15\xd3\x86-\xdf:,0\xb2\xdf\xe4\xdbb\x1d-}H\x18\xe2\xe9\xf6E:\xdd\x9ak\xec\x1aZ\xe2D\xdc\xaf\x95\x17R\x97=[\x07\xc3eR\xf8\x83\xc2\xcf\xb1\x1cW\x88\x0cS\xb9;\xad^l\xc8\n\x15\x1e\x99q\xca\xef+\xa5\x8a\xd9\x8a\xe2^;\x83\xe8/f\xab\xb9\xa0\xef\xd8\x92\xfaN\xbf\xd7\xb6\x0dkeP\xef\xa7\x91\x8f\xb5\xbf\xdf\xcbz\xf3\xaby\xa8\xec\xaa\xca\xe6\xa1\xd8A\x9d\x8e\xc6\xb8*\x11\xaf\xce\x97\xf8\xf4EFa\xf6\xcd\x17	\xc8\x06CY\xce\xd29\x8ff\xb3\xfd\xb8\xe5\xc4p\xdd5La\xbc\x97\xcd\x15\xd6\xbd\x18\xaf3\x7fV\x82W\xef\xd8\xc2\x0en\x94\xdd\x07\x1d`7\xe6\x93~\x8b\n\x94\x1c2\xf7\"\x89\x97\xd1\xf2U\xeb08\xc0\xe7\xb5(s\xdc\xe2\x85S\xe6\x9c\xdd\xb3\xc2\xdc$A\xc8q\xaa\xc3\xca\x16\x0eP#Y/\xbb,\xe9\x14\xb7\x07\xd1`,\xe6XhG|G\xaf8y`\xca\xf2\x19\xfb)Z\x8771\xb4t\xe4\xf7\xda>\xd9R\x0dZ\x9eC~?\x8b\xe7\x03\xc3r\xe3\xf4\x1d\xb4\x1b\x8b\x83M&\xca+04\x9e\xdcr\x7fj\x07(4Lu\x006\x97\xfb\xa1A\xfd\xa8\x832\xbc\xca\xa3\x10\xb6\xa3\x1c\xd7\x02\x0e\xa8\xe8\xd1V,z\xbdm\xafW\xbc\xc8\x8e\x91v\xea'\xca\xfb\xd7\xf6z\xe3\xc9\\\x15\x03$N\xe6\"\xe0B/\xb3c\x7f<@9\xd1*H\x19\x00\x07\x0f|\xf3\xe6x.^j\x8f.J\xa0\x12\xe3\xb9\x10/8q$\xa4\x9f\x1f7\xe6iY\xaeO\xb6\xe2\xf4\xb73\x1f\xd1\x87B-J\x10A\x82\xfd\x95rP\xb0{g\xfe\xa6~\xaaf\xef\xce\xfc\x11\xf9P\x1d\x17'\xd9 ;\x99\x08\xcd\\\xbd=\xd3\xa8\xe3\x98\xe8~&\xe8\xaf~5cy\xdb}s\xd8\x83T\xda\x99L\x10\xa6\xea,\x1c\xe6\xd1\x15\xe2@\xe4\xd5]\xf7\x8fy\xf2UPFN\x05vm[\xd06\x1d\x86\xea\xd7%\xa4\xe0P.\xea\x93I\xd9w.\xbd~&\xd1\x1a\\@\x80c\x0b	y\xeb\xd6\x1a\xca\x90\xef\xb9\xa9\xf1\xfd\n\xc1\\\xb8\x82\xe6\x8ao\x89\xe8\x16V5K\x19Z\xd2\xe1PX	\xa1	~\xc9\xf6\xaa\x86\xffck\x921\xc3\x8d\x822\xd9d\xba\xa8B\xbd\xbfl\xe5,\xe1\x83\x8bu\xd9\x86oRq+\xc9z\xb3a\xc8\x7f:S\xdb\xa6S\xdb\xa7\x10\xa1\xae+\xe1\x86\xde\x91Z0\xa7i\xfd\x1bp\xe57aR\x04\xb39\x88\x10\xf6\x88F\xe8!\x0d\x8cK\xb0\x99\x82\xe5\x9f\xfc\xd9\x8c\xad\xe6X\xe3\x86\xe0\xdfI\x9cj\xc6\xc4\xda\xfd\xeb'\xe4i{\xd5\xa6C\x80\xac.W\xb7\xb6\xa8\xd1\xec:\x10\xe6s\xe1\x1b\x93\x0dV2\x85\xf5Fz@l,\x0c\xad\xafzt\x80r6:\x0ePv2\xb4\x8a\x849\x92R\xebt<\x18O\xd3\x97j4M\x07\x03q\x96\x91p\x8d\xa9|u\xae<\xf1;\xf8\xc4\x91\xa9\x97	\xa6=/\x19cIA\x10\xa9\x8d\x94,Qu\x0e\xdbx\xe5\x8fiqr%\xbc\x16#&d1@U\xe1\x07Q\xbe )\xa6\xa9\x80\xcfj}HW}\xc4y\x1a5\xcf\xd3xE\x9dtj\xa2\xc7Ve\xd3f\xe3\xb0\x16\xbf?3r9\xf6\xb3_\xc6\xd7\xbc\xd8\x1a\xcb\x8a\xc5\x00\x93Q\xdd\x90\x83\x9f\\\xff\xb5\xf1W#9\xfe\x9f\xbf\xca\xc9\xb3\x89\xf0\x8c\x9bYG\x0e/\x97`\xf8\x0c\xe2\x890)\xe3\xeb\x08&'\xbfCK=M\xfd\x9f\x1cy\xb5|\xf7Q\xc8\x8b\xd7\xce\x96\x97?\x9d\xc9N6T\x03V<<\xf8\x11\xb1\x99\xa7\x95`\xcetR\xec\xe5\xf7gB\xb6\xf6^U\xf72\xda@E\x99. \x16\x9a\xd9\x0c\xda\x90\xf3v\xb8b\x1a\xb0JF`\xbc\xf0\xaa]L\xf4C'\xeb\x9b\x18\xb6E\xe0}\xcav\x9eQXw\x04F~\x8aq\x10\xdf\x91]q\x86s\x86\x9b\x08\xc0U\n\xbb\x01RS\x9cT\xba\xb8\x8e\x9c\x14\"\x05\xc1s7\xe9\x03\x0e\x8e\xf1_\x9d\x94\xd7\x11\xc4\x99\x8b\xa8^\xaey\x80\x07\xb3\xc1p\xfc\\\x0eG\xcf\xe5\xf0\xcb\xb9\xf3:\x97y\x07[\xbe\x04\xae)\xb51{\xad\xed\xa88\x1aT]8,\xee\xb7?T\x8b\x83\"vktP\xa2;-s\xbc\xeb(\xbf\x8a\xf4\x00\xc3\x1ag/\x9d\x84C\xb4 \xa3\xdci\xd9\xeb\xa5~$	\xaa\x86yI|\xcd\n\xe0H\xc6e\x84\x81\x9d\xc7*+\xfd\x1aG\x899j\xd1\xbb#\\j\x0e\xc9\xa7=\x19\x0b\x0e\xbbM!\xc0+n\xb3\xd6\xf2\xa5?sx(\xcc\xbb'\x9d\x04\x96\xe5\xd4\x920\xe7\xb5\x14s\x18\xb4\xc4JN\x88u\x88\x8fq\xc3yw\x9b\xbe\xcf\xb3M\x94\x97w~\x046\xdd\x89\xcf\x8e[2\xf8l+\x85\x94\x8d1\x0b\xba\x83/U;\x08\xb73\x9e\x07wI\xd06\xd2\x97\xb6+\x96\xae\xf2F\xa5\x0d\x95\xd4KW\x8d\xb6W[\xa3\x1cnL\xc6\xc3\xc3\xc8\x8c\xf1pa\xf8\x03\xfa\x18\xf7\xa0\xaa\x8at\xdf\xc4\x9ex\xcb\xf8\xda\xeb\x93\xc8W\xff\x86\xd5\xb9\xce\x8a\x1b\xb5@\xcdW\x83a\xc4\x08W\xe7\xb6\x85`\xac'G\xcb?\x821\xe9\xf6\xfc\x00\xd2\xa4fY\xf9\xa6\xd5>}}H|\xf8\xb7\xc5\x8d\xac\xd5\xab\x17\x8e\xf7\xc7\x1bu\xb0A\xb5\x05\xcd\x88F\xc4\x1b\xfd|\xa6n\xe4wPD\xfd\xedL\xad\xe4?Y\xa9\x04m\xd4\x8f\xe6w\xb8\x93\xbf\xf0\xefMv+\xffu\xa6\xc6\xd1\x97\xf2\xd73\xf5\x95\xfc7\xfe\xf9\xdf3\xe5]\x11(\xe9{\xa0\xec\xfe\xf9L\xdd\xdf\xc2\x01z4\x97\x11\xfe\x82\xc5\x0efc<\x17\xf8;\x9e\xef\xe5\xdf\xa9\x90\x07\xd4\x0b\xb8;\xdfz2\x0d\xbc\xb4\xf0d\xa1\xff\xa4Q\xe0\xa5Qq\xeb\xc9\xe2\xd6\xfcJ\xf1\xeb\xb6@\x0c\xf2\x88\x7f\xed\xe5?\xce\xd4\xfd'\x88\x00\xd8\x99\xddr[\xc1\xc4\xc4\x83\xac\xb9{\x0f\x9f	6\x9f\xab'\x8f\x85\xb7\xaf\x84K\xd8\xd88#\xa9f\xac\xf9\xc0+b\xb8\xc5xl\x07\xf2.=\x03)\x0d\x80n^\x9e\xab\xd1\xf4\xef5\x1d\x9c\xabs\xfb\xfbRj\xd0c\x92T\xd4\xa8	\x13'\xdab\x97\xf4-\xccg\xafW\xea\x88\xd7p\xecpR\xff\xb1du\xb4\xa6\x90D\xa6\xde\x87i\x94\xb8\x04\x918vp:5V\x887\xf9\xe5\x86\x8a\x03\xb7q\xe9\x97\xce\xfa\x12\xf7\xd1\xac\x1cR\xee\x9b\xe5\x9c\x18\xbf\xbd\x81.q_\x04\x195$\x15\x19{y\x0d\xf2V\xfb>\xe9\x8c\xef\xf7L\xc5u\xd7\xbf7\xcbC\x95\xc3F\x8a1\x80\xael\x8e\x9c\xfa\x87\x97\xbf\xe7l=dbhWr\xc1\xd2J'\x1f\x1e\xf4r\xb3r\xc2\xd2\x9a\xe7AMLz\x9e\xa0\xd4\xba\x9b\x87\x07\xbd\x04\xcd1c\xe7\\_m\xce\xb3E\x98T\xe0\xd7f\xa8\xf5E\xed\xa6\xe1\n&\xee\xbfc\xf5\xd3\xe5\x02\x99d*`\xd5\x81g\x94T\x1b\x1b\xf3\xfb\x8b\xc8\xe5\xa5\x18E\x14X\xd3\xa7\xf8'\x08\xfb\x91\xe8{\x03\xaf\xef\xcc\xff\xde\xbe\x9b\xb9\xdeT8\x00\x1c \xd2^/\x9f\xc5s\xa5\xd4V\x148\x1f\xf0\xa4o\xc5(\x9e)J\xe4\xc3\xf0\xd4G\xee\xf0R\xaf<}n\xabT\"Q\x04\xe9\xcaOd\x84\x7fR!\xa69~db\xbf/\xd5\xaa\xb1px\xb6R?\xf6\xffq&\xf4	\xce\xc4%T\xde\xffo4\xa0\xda\x07t\xdf\x1bxV\xfd\xcb\xe3U\xd4\x1eq\x1e%\x94b4B:\x06t\x9c^\xd1`\x9a\xa5\x01m\xf1\x05\xc4vnSdl7\x14\xf0\xc5k\xdd\x82]\x98\x8c\x04\x87uf\x9b2X\xb5\xb1\xe5WU\x8b\xb8\xcfg%\xc2\x12o{\xbd\x84\x9d1\xb8\x102\xda\x16b\x80\xc4ol\x82\xfa\xc6v6\xbf\x7f4\x16rm7\xb7Y\xe0\\\x7f\xb5\x05\xcc\xaa\xeb\xb0\x1c\xa3B\xfcEn\x01\xe1\x9e\x93H\xd1\xff\xfbR~\xbcd\x9f\x82suO!D\x96\xd9m\x9d\xfb\xe2\xe8\xcb\xc3KD\xd8\xb9\x8a\xd3+\xf1~\xe5Z\xbb\x08\x1b[\xfd\xa8\x1cj\xd4\xc7\x87\x07\xfbsh\x83\xf5\x88\xfbW+\x83\xd1\xe6n\xe0J\xee\xc7\x12j\xdaS~\x89v\x16Q\xf9\x8b4\xbf~\x15\xd3\x8eI\xa6\xe5)\xfd\xfa\xf4\x13\x9dS\xc9\x8a\x9b\x08ep\xaf\xd7\xe8\x86:\x1aU{z\xf1\x9b\x9aEF\xa47\xc7\xe8\xd0`4\xb0\xaek\xac\xe8\x1fn<\xa29:-\x92\x8dQu\x86'V\xe7UAy4\x16\xd3\xb8\xd7[pRl[\xb7\xdd\x04\xefW{\x19\x9f+:\xc7~	\xde\xae`E\x8f\xdf\xbf\xe27\xe0\x90\xa0\xe4e\xf1R\x17A\xe2\x95\xb7Y\xf9?\x9f\xf9\x9fV\xce\xfe\xa8V\xe5\xbe;\x15\x9b7\x82\xec\xc1\x93\x1e\xd8\xda\xd4\x93^\x81\xff\xf0\x80\xa3\xd8K\xf1+\x85\xdb\n]\xf5\xcfxo~\x08\xd3\xab\xae\xcb\xfejU\xe1@}\xb1\xc3\xfd\x99\xa0\x07\xa0\xd3\xc2\xc5YF\x1c\xa9\x8f\xfe\x8e\xe7u\xc2yA&\xdcm\xf5\xc4\xd5\xca\xfe\xae\xca#\x90wp\xbd27\x84\xe0\x8c\xc2\xcb'wWY\xfa\xd8`1\xbe]t\xfbE~khKl}\xafnm\xc8w\x08\xb0\x80$\x04#\xe6;\xc8Cj\x1e\xd22\xfe\xcchp\xcd\xe5\x1eh\x14\xa6X\xadk\xe0\xd1\x98\nD\x8e\xff\x04\x1dz\xdc\x9a\xcbFk\xec6\x04\x9f\xb2\xcc\xe3U\x19\xfc|\xe6_\xac0\x89Bf)\xc7\xabB\xe2\x02\x89\xf7q\xf1m\xba\xb4\xeeyO\x19j\xb7)m\xaf\x8a7<\x11b\xef\xd4\xd61\x11{\x99\x9e\xabY\x05=\xa2/>\xc0H ]\x1c?C\x8f\x07\xc3\x82sug\x1d\xc5\\\x9e\xb83<_v\xfe\x04O1\xa6$t\x0cUq7H\x9a\xfaw6\x1d\xf8g\xee\x0b\xa1\x039P1O^\xf3[YJ\x84\x9b\x83 \xec\xbbM\xaa\x11M\x02\xd4\xf2\x1b=\"\xa9`2\x8d~\xfd\xe0F\xd3\x0b7\xb1\x89\xde\xd7\x0d!c\xa2|\xe0M\x8a\xe4jB\xe5;I\xbc^\x9b\xd1[\x1b/\nY\xba\xc6\xea&\xf0\xc7\xf7\xd4D\x18R\xb5\xad\x9c\x8c\xdbMC\xd2I\x9a5\x03t\x06\xb3]|\x03\x97Hx#\x18\xcbw\x0c,\xae#\xeb<*\xd6Y\xb2\x0c\xb6{Y\x0ckJ\x1f\x7f\x0d\x1bgj\xfd\xd9k\xbf\x04r\xe4\xd2O\xcf\xe5\nK\\\xae\x84l\xf4\x13\xc9>ySR\xb7a'o\x14\xf5+|\xe5\x9b\xfa\x84\xfa\x0b\x99\xc8R\xe6r\x0bt\x1d\x0c\xe6\x06Z\xcb#\xc8\x05\xd3ah\"\xe1i\x07\xc7rz\x97\xf9Y\xf3\x8br# \xd3?\xf0\x81\xd6\x06\xd1&\x94l\x1b\xa9G\x05@\x99\x903\xe3h.T\x0e5\x04\xa0\xe3d\xc2W\xa98\xf5\x9f\x8d\xe4p|lm\x1e\x0b\x81\xe1\xfds9\xd4$\x1d.\xe1\xf4\xc6]\x00M\x05\xbb\x84\x17\xc6\x12(\xdb#Z\xeb\x0ef\x8d[=#}59Nd\xe7\x12\x1f\x12[\xe2\xdf7\x18n\xd0\x8b\x0eFYv\xf0\xd5{\xda\xf6t\xf6\x16\xfe\xec\x9e\xef\x07\x81\xb7\x81Ya\x12o\x10	+x\xb7B\xc8\xc3\xb8\xf8H\x9c\xc27wg\xdb\xbc\xc8\xf2\xe0\xc3\xca\xdf\xcaP\xa6B\xb2\xe3B\x98\xd3\x8c\xbd+\xd7Q\xae\x07)\xf8\x16eFb?\x175.\xe9\xde2\xbd\x01I\xcf\x7f\xf1\xa4s\xc5\x8b\x9aW\xb0\x91u,\xa0\x83\xa3\xf0\x7fZ\xf91H\x9c\xd9\xbd\x9dT\xbf\xbeCe\xcaS(\xb3\x03\x1c\xee\x8c\xb5)\x1f3\xb2\x10+\x879N=L\x15 \x8f\xbb3\xb5\x9b\xfc|/\xa6\xfeQ\xcc\xa2\xae\xa1\x91\xc1\x92\xbc\x9a\x88\xf2\xc3C4\xac]+\x05G\xd1\x087\x87b\xa95%\x1b.\xa9|\xb3\x0cbD\x94\xb1R\x06,\x9bN7\x84N\xcaX\xc5\xdd\x82\x97B\x9b\x1a?\x0e\x8duy\xed\x12\xbcK\x87\xe2]\xae\xc3t\x99Dy\xf1\xf0\xe0\xd7\x13\xe0~\xb4\xf4\xc3s\x87-\xc1\xe9\x1c\x9b\x08\xaa \xccQ\xa3\x12\xdcc\xae\xfd\xd2\x12f\xfdW\xde\xe6|\x0c\\\x96\xeb<+\xcb$Z\xbe\xe6\xb0;F\xaa\xc2\xb2\xa9J\xfa\x06\x81\xab'\xa4\xb7\x8aw\xfag\xc7XQ\x83\x96~\xbd#n{)J\xb9m\xefj\xe5\xc70\xec\x14\x8d\xae\xb3G\xc8\xa1\xc69Cj\x86\xd3L\x7f%\xba\x91\x97\xcbG^2\xf1W:\x96N\xd8u\xae\xf2\xa8\x00\xd7C\x18\x85Yx\xf0\xea\xf03\xb3\xac,H\xaexp*\xfe:\xbb\xd5&\xacj\xd6\xc1\xec\x83\xbf3\\\xee\x01\x0e\xbcQ\x0d\x0e\xbf\xf6\x17\xad\xc7P\xe7G\xf0\x8e\x11U\x91Y;Y\xac\xcb\x89\xe8;\xa9d\xc7>F\xea\xcb\xe7\xac\xbe1\x08n\xd5\x1an\x9d\x8f\xc3\x83\xa6\x08~w\x7f\xa7\x16\xe4:\x1d\x1a\xb5\xb1\x99\x9f\xc6\xbcvh\x9a[:\xff\xbd\xd8w\x8d\x12\xaf\xa9\xee\xeb\x8d\xb5Kh\xbc\xd4\xeb\xd9\x81\xb5/\x1a\x1dn\xd3\x064\xfa\xcfG\x82\xc8\xab\x19\x82\xa9\xb6!\xc0\xb9lF\xe3\xc0~\x18.Y\xf3\xf16\xda\x95\xe4\x84\xcej\xe1\xb6n\xc4\xb3\xec\xc1\x81\xba\x10\xb48\x8d\xe8\xab\x9a\x07h\x0fy\xdc\x1ari\xf9\x86\xc0m4\x03\xf8\xee\xf7\xd3\xad\xa3-/\xc5\xfd?!\\\x95?\xe2\xdf\xbd\x90\x87\xa0T?\xab\x00\xf3\xe6\xf2\x86|7I\xdf\xf7j\xb1\x88\x8a\x82\xce\xdaV\x94\x8d'\xe1\xb1\xb2\x8e\xf8\"\xdb\xe6\xe4*\xc1\xf7\x91\xef\x88H\x81n\xca\x1f\x0b\xf3\x93\xae2\x1f\xc2[\xb6\xa9\xfc\xe6\xee	\xfa\xdc\xe6\xf20V\x93\xd6J\x1b\xb6\xc3\xc6\xdc\xf4\x0b-\xc7v\xaa\xad\x9b5f\xe2\xbe\xc4\xd1\x88\x90-,\xe5\xb7-\x8av~\x06\xa7\x1e\x99~\x06(\xb6\xd1 \xf7\xf02\xbf\xa8\xba`T\xf7\xd6\x1f\xef\xa5Q*\xbf3j\xd3\xd1sYO\x19w\xf9\xe8[-\xeb\x97\xcf\xeb\xd8\xa9\x87<\xf0a#\xb8\xc9\xb3\xab<*\x8a\xf8\x06\xba\xbd\x91,\xae\xb3\xac\\\x07G\xe3j\xdd}\x1bB\xf0\xac'>\xcc=C\xa2\xf3s5|&\x8b\xf3\x0e\xfc\xca\xaa\xabMe\x1eS\x9e\xb0\x0c?\x7f\x91\xb0\xa5\xcc\xb6B\x02\xffD\xbdq\x98\xc4\xbfG\xcbGnD\xae\x85\xa0\xadM6\xf1x\x9c\xca\x8b\x0e\xe4d\x99(Wa%\xb7\xea_+\xb6J\x08\xb5kj\xdevM\xfdu\xe5\xff\xb2\xf2C\x99I\xc0\xec\x17B\x86\xb2\x84\xd1\x9da\x13\xab\xc2V\x90\x9a\xa9\xbc\xed\x1b\x1a\xc3M\xf1G\xd4\x14Q=\xd3\xb0\x0b\xef*#\xf7\xf1\xcc\x82W\xf1\xf5x\xbd\xdf\xcb\xc3\xf7\x0f\xdc\xc1~]\xf9\x99\x0ce\xa4\x9b\xc6\xd7\xff\xb6\x14\xa5\xa3a\x14g\xc1\xc8\x0b\xea\"\xc9\xa3\xd6\x14\x89\xfb\xd6\xac\xa9\xa3\x11\xfb/\xfds\xe5\x17\xae\x14U\xdc;\xd1+m[\xc4\xbd\xf9\xdc\x19s\xfd>n\xfd\x1c\xed\xc1\xa6\xad\xcd\x01\x85\xe9T!\xf6\xd2\"\x8f\xae#X\x1e\xbf\xcf\xa3M\x98G\x1f\xba\xee\xcf\xdd-t\xd6\x1d\x1dq\xce\xf3\xf7\xcd\xcbs\xedS\x07\xbe\xe1\xda\xb0T\x94)\xeb8\xded\xa82w\xd1\xe5Xtti\x06XV\x98\x97\xd3\xe2\x05\xf4\x18\xcb\n\x0f?Q\xbf\xac\xfcT6W{!C\x99\x01\\\xcci\x1e\xc4\x94\xbfB\x9e\x9e\xca\x02a\xaa:9\xf3=_r\xaa\x91\x12\xf7\x8d\xad\xd4\xeb=2 \xcd\xc1\x83^t\xe6\xf8\xa0Kc`\xe3\x98\x13\x91K2\x87\xbe\xac+;\xc1\xf2\x17Q]\xfa\"\xe3\x1a\xc6|\x07\xc0EV\xcb\xa9\xbe-*O\x11\x86\xbb\x90\xb9\xcaj	\x1c\xbf\xc0\x16xx\x08g0\x8dG\xd3\x88p\xff\xc5\xd8\xe9\xce\x0d\xe0\x81\xef5(7\x01\xd7\x1af\xdf%\xe0\x9euFn7\xad\xe1\x9aO\xf2\x8e\xd6\xf1\xb6\xa8a\x8a-\xd5\xbd\x1e\xcb`kb[\xf1\x81\x11$\xf64	\x8a\xbd9\xff\x16\x1d\xa1\x88r!\xef\xcdaP\x1beq\xbfn\x1d\x97Q\xb5]\xcbj\x16\x96\xb3\x92\x98_3\xb1P\xb4#\xc6\xde:,\xe0\xb7\xab\xcfI\x83\xf1\x1f\x19\x9a\xa2G \xa6~\xfb\xc59\xee\xb2\x8e[d\xaa2\xb1\x8f\x0c\xd1c8\x89\xb8Z2$\x83\xe5=\x01\x13\xc2t	\x01\n\x1fQ\xc9\xb9:\xc4\x00\x15a\xfa[t\xe7\xb1U\x0d\xbc\xd9\xc8\xfc\xe403c\x19\xd3!\x02\xb7\x14\x0f\x0f%@<~+d\x856_Rp\x112&\x83\x99\x07\xb3\xf5_\x14\xf0\x9a\xd0\x97Gt\x8e\n\x93\xe6\x14\xe1Amxlg@\xed\xf5\xc2\xb1\xb4\xa0\xb7h|\x87	t\x19\xbf\x90e\x99\xe4\xa7_\x15n\x14{i\xa2\xefT\xb5U\xa2_f\xc1\x0c\x9a\x1b\x8e\x0fz\xc1\xc4^{\xe4\x1d\x13\xd2\xd0\x84\xa4F\xd4\xeb\xfc:,?fYR\xc6\xee]Jo\xcax\xa5#\xb4a\x05T{\xd4i\x00\xbb\x9ca\xed\xb8a\x82\xb2aA\x0cb\xdf\xfbb0\xf8\xc2\xebg\xac\xf01\xeb6\x1d\x92YW\xaf\xe7\x87}\xe5}\x11|\xe1\xf59I\xc8\x7ff0#\xe5\x82\xc9y\x0b5\xaa\xd6b\x8f\xb5\xa4\x7f\x8c\x83\xbb\xa1\x10\x19l\x87E\xab\x05\x8e\xf6\x81\xf7\xfc/\x1e\xd9m\xd1\x8f\x9c\xa4d\xde\x04\x98Ml\xbcE\xe9X\x1adv\x03\xc3:<|\x1fn\x82\xaf\xeb2t^\x8c&\x80t\x11<\x9b\x1c\x86r\xa2\x0fY\xab\xbb?\x8dF#\x17\x83~RC\xb7\x7f\xc4\x90\x8f\xc0\xeb\xf7.?ij|6\xfe\xf2\xab\xe7_9\xd6{\x13'\x1e\xce\xf0\xf9#\xc0Nnm\xf6\xed\xaf\xf6\xfb\x83<e\x95\xf1z\xcb\xc1\xb3\xc7\xd13\x9c\x18\xdbs\xf5mj\xf1Bwc8\x05\xe1\x9f\xdd\x04\xbf\xf0\xcfbC\xa9\x8b\x0d\xa5/6\x94\xb3\xd8P\x1em\xfd\xf2\xf3\x98\xf4\x91\xb6\xcd-O&S\x0b\x14\x1f\x0dwc\x00\xb9\x8f\x07p\xbc\x1f~\x8a~\x8f\xa3\xfc\x0c1\\(\x13\x9f\x95\xf8\x83\x02\xf4c7\xa1?w\x13z\xa6'\xfcv\x10\xe0mj\xffP\x95\xa6\x8a~,k\x9f\xa0gn\x8f~y\x91d\xb0\x1f\x86\x93&\xc8\xdd\xa5\x15\x92\x19\xcav\xe98\xfa\x1f\x10\x979\x87g\x15\xb4\xab\x12\xea\x13\xa7\\\x11H\x82\xae\xa4\xcb\x86,*\\\x87\xa4\x86n\xc9\xa7\x1a=i\x12 \xa6u\xa1\x9ca\xe3H\xe3 3\x0e\x9dl\xc4\xbd\x9e\x9c\x85C\xa05\xdc\xcda<\xde\x8eLoi#n\x0e\xdb\xf3i\\\x85lRQ\xf5[\xc6\xeeQ\xadj\x07\xb7	\xff\x06\x18\x05\xddF\x96\xbcD\xdd\x07\xb2\x0c9\xda\x9cg\x97?\x1d\xec\x11\xc7\x92\xba\xb21zpX;\xa9\xfad\x83S\x03C\xfah\xcaM\x00!n\xca\xaf\x84\x11\xa2_\x9c\xd4\xaa\xdb\xb8\xa9\xa6\xba+\xb5iTw]KAu7*r+BtK\xec ^\xe6\xd6\xaa\xca\x1f\xcb\x9b\xe1\xf2N\x9f\x96w\x8a\xad@\x16\xa7\x8b\xe3<H\x86;\xd1O\x86\xcb\x9d\xdc\x99\x8c\xe5\xe9\xf2\xb8\x08\x92\xe1\x9d\xe8\xdf\x0c\x8b\xbb>\xde=\x99\xc8K6\xe7\xb8:\xbd:\xce\x83\xd5p'o\xcd\x1b\xd7\xa7\xd7\xc7E\xb0\xd2o\x94\xf6\x8dO\xea\x0e\xee\x16\xa1\xe8_\x1e\x87\xf2B\xed\xe4\x0f\xea\xee8\xec_\xeaT\xf9F\xdd\x1aL\x93\xd8Q\xfb\xed\xc6\xc1\x1d0\x9aw\xd8\xfe\x97\xc0e\xbe\xc5\x86\x1f\x07\x9f\xb0\xe1\xc7\xc1\x05\x9e&\xc1\x0fx\x9a\x04o\xeaHH6\x1e*3V\x94iqg\x1b\x11)\xdd,\x9e\x83qG\xb0I\x176\xc5\x04\xa7\xecx\xb53Ne\x04\x980w\xc9\x19rZ\xc5lh\x81\xa3\xd1\x86Y\x02NDn;.\x82\xee\xfa'\x19\x05m \xb0\x19\x1d\x1b\xa8\xb6@dm\xf5;K\xde.Y\x13r\xb1Zc\x1cy\xcbp\xb0\x8c\x1c\xb6Pig\x9f8{I\x1b\xf7\x93\x83\xb7\x1e0b\xc5ix\x9c\x071\xc1\x9e\xe9\x94\xed\xe9\xf6\x18\x81\xd7\x0c*q\x8c\xb5\xc8Z\xa8x\xb8\xbc3\xf0\xeb\xee\xf7\x1ct\xbb\xc6\xd0!~\xa2\xea\x1c\xee\xc3\xef\x00\xacl\xc9`e+\x02\xa0n\xc0\x93\xb5@\xc9\x9c\x19`\xde\xe2c\xb4\x83\xfb<\x94!\x05\xdc\xe3\xe9\x19\n#\xb9\xacP\xba\xd8e#\xeaX'\x04pL\xcaI=\xf5K\x80_?:\xf5KT\x8dvh\n\x87\x05\xe8\xed\xad2\xd62\x1c\x10\x9d'\x8f\xc1\x1d\x03\xc5\xfa>\x1a\x92b\xbf\x02o\x81\xb4A\xdf\x03i\x02\x87\xbb\xbeb\xc5\x88~\xbe\xeb+\xd6j.\xe3\xbc\xbc\x03F\xc4\x01\x05\x12Z\x82\xb5	cJ\"\xd5\xcb\x00\xa6g\xd2\x0ea\x90\xd4\xe4n\xa9\x90\xb4\xf6~	\xdc\xcf\x9f\xe4:\xf5W7\xf5\xee\xa4\x80I@TY\n\xe1\xca\x1b!\xb8Z\x91\xe5\xca\xc3%\xd5\xdb[Q\x05\xbe\x08{EZ\xf1\x96\x15\xc1\x00\xd5\xa5\x0b\xff\xbb\xd2>\xa1\xb5\xf8X\xa2.\xbc\xe8\x12S\xd4\xee\xc2\xceq\xd9}\xef\xae\xd9\x0fW\x83\xc4\x96\xc3U\x02#~x\xfa\xef\x1f\xb1 6Q\xea\x8b\xed\xa7\x8f5\x9e\xa13l}4l\xdcU\xfc\xd2Y\xe6\xb3\x92/$\x92\x7f@\xcb\x02\xf3\xbb'b\x89\x99O7oZ\x8c%\xe6{\x96q\xf6l\xf4o\x9d\xa8\x81U2\xf5whK#1uy\x15\x95\xd1\x91\x06)\x8a\x89(\x94\x19\x8e\x85o\xeb\x86\xe3\x81x\x0f{\x04\x92\xbd\x82\xae8\xc5\xb4\xdc\xf8\x89\x90\xff\x80\x88d\x0bQ\"\xc5\xf2\x18\x1d)u\xe5'\xb5\x96\xf2}cd\x02\xb7\x1az\xaao%{\xc1\"\x82\xd3Q\xc0\xbdi\xb2\xf8\xf0\x8fjE\xd4\x96\xd1\x1f\x1b\xabzP\xed\xea\x16j=\x9axD\xc7'#\x99\xaa\xc1\xf8d4]\xd6\xee\xf0\xd5\xe5\xb6\xd5\x87i\xf4B;b\x03\x83\xf3\xa5v\xc2\x86\xc0O\xd6j`\xb6,#\xea\xfe\xb7Wf\xf5\xb2\x86\xe0:\xdcl\xe2\xf4\xea\x87\xa8\\gK+H\xa6\x8d\xcej\xf8Y,S\xa3q\x08\xca\x9a\xe0e/\xe0\xa0\xfd\x13ny\x1f3>Dkg\x17\xdf\x00\x81N\xdc\xa4\x9e\x92Q\x92X\xda\xc3\x1cT\x138\x8c%<,u\x08{\xbd\xae\x9a\xe0\xa3\xb8\xdf\x1b\xbb\xd2\xf5\xb9\xba\xbf\x84\x07\x1f\xb4O\xaf\xe3\xeb\xcf\x08\x0f\xec\x95\x1e&\x1b-\x80\xa2\x1d*a\x1c/j\xda\xce\xba@@\xa7\xc4\x8d\xafv\xb2w\xd7|\xe1\xae\xf6\x82\x15e\xe1B@\xc0\xf2\x8c9\xa4\x9f\xa7u\x00\xa3S\xdf0\xf95\xa8\x14\xc9x\x88\xefH\xf0\x94\xfc\x10\xb1\x16\xe5h$\x82Z\x05\x85[\x81\x81c\xc0\xeb\xe1\xa1\xd7mq\xf3\xe3\xe1\xc1\xfd2\xc3kUP/\xdb&\x86\x8by\x8f\xa0\\\x8c\x8b\xb13\x1b*\x99m\xe7\x08\xf24\x1b\x0f\xb6s\xb9R\xb3L\x86s \xa3\xcf\xb6sw(\xe4\x15b|\x0e\x1a\x89\xd7,\xd1\xc1\x16\xba\xee\xf5Xdu\xa3\xddQ\xaee\xc7\xb4NK#\x99\xb8\xf3\xf9\xa78\xf5A\xc2(\xd9\xfau\x9b\xe7mZ\xac\xe3\x15\xb0\x05Dp\xe7\x97\\\xb6*\xe5\xe6\xc7\xaa\x947\xac\x87\xc2\xbe\xd3mS7{4j\xc7\x0e\xfa\x9a\xd7\xa0MR9\xdfL\x1b`\xe1\xf7$Ft\x9csf\xda\x9co\xad\xd5E\x1f\x0b\x7f#dVMX\x10\xcb\x0c\xa62\xaf\xe3\xeb\"\xb8/Y\xd8s4&\xc9\x02\xac\xe5p\xb1^\xc6\xd7p\xe0\nf\x1e&\xc0\x9b\xef\xa5\xaeta+\xbd\x12\xb6\xd0\xce\xf4q?w\xbc\xe8\xc8?)\xd81\xb1\xea\x8fqt\xe3\x94\xe5	\xad\xf6Q\x1d-\xc5\x99p\xd3S\x1a\x8chA\xfb\xbc\xbe\xc9\xca\xbe\xd7\xd84\x9c\xc2\x8eA\x82\x14\xaf\xfb\xfdt\xed\x1f\x12\x19~\xcav\x9b$+[*S\xde\xbefWzWy\xbc\xf4\xe6\xb2{N\xcc\x98\x13\x06\xa5\xe5\x11\xcd\xd0\x8e\xcc\xe8y\xff\x18?\x96{\x1d-\xe30}\xac\xc4?\x9e=\xfa~\xb8\xeb\xfa\xb8;)\xec\xea\xf8\x87\x04d\x8b0/\xa3\"\x0e\xd3\xc9\xd2\xebB@'$\xdbW\x86\xbf\xaa$^\xfa[\x9f2F<\x99\xfdU>\x87l\xd3\xf0\xe56:\xe3g\xe0\xc5\x0f\x08\xbc&\xb2X\x87\xcb\xec\xf6\x9bd\x9b\x07\xcf\xf9\xe1\x9d\xb6\x10\xb0\x99\xfa\xf9W\xfb\xcc\xd2\xb1\x9a\x83\xce\x97\xc2\xeb\x92aEIX\x94\xf1\xe2\xdd\xb6\xec\x92c}=\xa2\x88\xd0k-\xd8\xc6\x9e]\x9c\xd7\x00\xce\xe7r\x89\x04sS\xf2d-o\xd5\xa5_\xb5+\xf1\xf3r\x1d\x8e:\xd2\x14\xebX\xd5\x0cKg@\xee\x1e\x1e\xb2V@\xc1\x16|V\x8d\x8d\xf1\x04!\x061Bo\xd8V\x83\xc2+\x04\xe2\x7f\"Lp\x9bf>c\xb7\xa9\xae\xe3|\x8c\x03\xc6\x9f\x8c'I\xfe\xdf\xbe\xe1\x80\xd8\xf2\x15(\x12\xfb\x0eE\xaa#\xce\x0b\x87W\xcd\xd7\x01\xe4\xdb\xd9\x98B\xb5[2\x8dO/7~A\x9c_)\x82\x18\xed-t\xf3\x9c\x1b\xf8!\xf4_r]0C\xe9?\x0e\x07\xdc\xd1R8?\xf4z\x87\x94\xab\x8f#\x02W\x8c\\%\xc4cK\x88\xe6l\x93\xccF\xc6\xbd^\xfc\x99\x907\xa4\xc2\xe1\xc6\xc0\x9e\xac\xba\xb4|\xb3\x17\xf2M\xda\xbd6\xbf\xc9v\xb8\xfdx\x06\x9f\xfe\xf3\x12X\xad\xb4\x06\x96\x0cA\xe4X9l\x0c\x1fw\x90\x03\xf6u\x97i\xbf?M_|I\x103V\xb4\xda,\xc5u8R\xd2i\xfa\"\xe6#\x86_}\xe4\x03\xf2`\xc5{\\\xe76\xf5-\xdc\x04O\xb8:WKh2\xbb\xd6f\x9d\xb9\x9dj\xd4\x8b\x0f\xe1m\x9d\xd3\xb7;\xdcV\xc1\x15\xa4*\x9eE\xael\xf3/\xa6Ss\xc3Y2,a\xe60\xb1\xf7\x9a\x18_\xdc]\x7f\xca\x12\x86\xf0\x870\xc2\xa8\x0f\xe8=\x7fs\x0e\xad'\xb1\x18q\xa1\xdb\xf3]\x9c\x94Q\x1e\xc1\xfb\x0d\xe4\x01+\xe51Pk:x\xb1\x823\xb37\x98\x8b.\xe5}\xfdK\xd8\xe6{\xfe_\x1dv\xdaT\xfai\x83\x8a\xae\xce\xddkF\xb5\xbeY\xa0K\x1f,\xa6\xe6\xa2\xd3\xeb\xf9\x17xM\xd6_#\xa2\xf8\xc3\xc6\x8f\xe0\xcd\x98\xed4\xad?\x8f\x8b\x12\xf0f\x94D\xa7	\xa7P\xec pZ\x87Y\x81\x05\xd9\x91\x82\xea\xff\xf6_b\x07\xb2M\xf4\xe8yN\x0b\xf9\xb1\x02Iv\x1b\x15\xe5c%\xd6\xf1\xd5\xfa@\x91\xffw\x0f\xfe\x8e\x83\x9e\xa3T\xeb\xe59r\x94Q\x8fi\xb2\xccKN\xaa\xf3\xeaS8\x85\xfd^~\ns\x0b\xbdF$\x12	qZOp\x9e\x920\xbf\x8a4+\x93_E\x95\xf7\xc1W\xa3Q\xdd\xb8*z\xe6>W\x05\xe1\xc9\xecd\x9c\xad\xb7\xe9oX\xd6\x81w\x9d-\x1d\x8e\xe2G\x12\xf3<f\x99\xd5\xc1}<\x1b\x8dH\xc9{A\x1c\x10.\xaavS\x18\xe1\x85^z{\xb6o'c\xee\xec\x10K\x11\xb5\x0em\xc3y\xa78Hr\xc8\xc3R\xed0\n\xf2\"\x1a\x9c\xcfu\x93\xf3\xb9y\x84\xf3\xb9C\x1e\x0b\x10\xf4\xdfQ\x83\xd0\xd6\x9eF\xde\\\xee\xba\x98\xa5\xda^=\xc00\xb91G^\xe7\xe1-\xc6\xdfg'\xe7\xe1e\\\x9ccn\x91qj\xfc?`CD\xa9~)\x027\xf1-\xf4\xcc\xa0\x81\x7f\xd8\xf6h\x91Dan\x1c*\xdb\x8d\xf9\xbc\x81\x91L\xad\x19S\xab\xc5\xadw\xb9\xf12\x12\xc1\x81\x12\xa6'\xe4O\xdchO\xd0E\x927\xf1&\xc2R\x84'N\xb4\xc3%=\xbf\x8a\xa6\x06<\xa79\x94\x0f\x0f\xd1\xff\xbf\x95X\x99s;%\x8d\x80\xd9\x8cP\x15xO7\xb1\xb31\xf6 \xac1@\x06;\x93l\x0d\xcd\x81\xc9\x8b\xd9\x8b\x8b\x8b\xf8z\x93D\xdfd;\xab\xb6\xc4K0\x05r\x95\x96\x91\xe6w\xe3\x06\xbf\xab\x0d\x1f\xa2\x8a\xdf\x8d\x99\xc5\x0ce\xde\xda91E\xbc\x0c\xd5\x9b\x0d\x82\xeaa\x874\xad\xf7r\x98w!<\x0cYI|\xdc\x90\x0d`\x0c[\xbf\x94\xbe\x1b\xc25\xaf\xc5\x81\xc6\x10Tu\x98\x19\x862\xd7\x8d)* \xfd\x8a\xb9\xce\xc5\xb4\xde\xf4\x90\x9b\x9e\xb4\xb6|(\xa6\xc5\xe9*\xf3\x8bf{\x93\xaa\xbd\xa1\x08\n\xf4,\xa1v\x172\x92a\xd5\xee\xa2\xb3\xdd\xa1,\xd8\xdb\xdf\x8c\xb5_<\xca9w\xf4Bs\xce\xe9\x01\xb3D\x87s\x8e\xec\x02\xa2]P[?\xf5\xbd\xf8j\xc3\x0e\x1ez\xc5`\xe5\xb5vRk\x11\xca\xa82\xf5\x8b\x8df\xcd^\xc4\xd2\xc3K\x0e;\xe5H\x91\xdc(\x8dv%\xe2$\xf0\n\x9ab\xfe1\xa4\xcd\xcbJ\x0c\xc8{\xbd,\xc2\xa6\x89_u	\xe0\x05\xb3\xefj~s\x08\xd0\xfaW\x1b\xe3\xedB\xdd\xc6\xf2l\x9b\x03Z\x07\x11\xde\x96{\xa9\x7fU%\xc4}\xb7\xa3\xa1;\x17\xe8\xf3g\xae\x14\xda\x9c\xec\xac\xa2\xe3\xd8\xa0\x7f\xf4b\xc1\xfb\xf9\xb20[\xfc\xd4\xbdb|\xc9W\x8c/\xf5\x0d\xc0\xe1\xfe\xbf\xe2\x9c\xaf(G\x04\xee[#\xce\x1b\xb5\xde\x1as\xce\xb8\x953\xe1\x9cI+\xe7\x19\xe7<39\xce\xe5E>\xa9\xad\xcf\xb9\x86\xe7&\xc7\xbeS\xef\x85\xfb\xd5\xbfr\xce_)\x07'\xb6\x90\x97\xe7\xaa5	\xc4\xe7\xfc\xe19\xb0\xdb\xa0~\xc1s\xaeb\xc2\xba\x97^\"\xee\x01,\xc6fi\xbf?7\n\x05\xfdT\x99\xe5d\x92\xf3\xabN\xd8$& }\xf5\x0c\x8c\xc7\xed\xe3\x97\xb4O\x8fd\x83\x99\xb8h\xe6\x1b<\xbd\x1f:3\xf0\xca\x9bsU3^\xad\xb1\x1e\x9b$L\x83\xbf\xff\xe0\x0b\xb9\x89rX\xa3\xd9K\x1f\xb8\xc7\xb6u\xab\x03\xd5\x11\xd7\x1c\xeb\x89\x9c\xf8\xe5\xcb\xd1\xe9\xc5y\xf0\xc3\xb9\xa8`9\x98\xf6\xb4\xca\xdd\x9e\x07\x9f\xce\xc5\x9e\xcd\xe1\x9d\xa31<xj\xc7+\xff\x0f\\ \xdf\x07\xb1?Fh$\x1a\xa3\xb7A\xec\x0f\xe8\xd1\xe1\xcf\xde\x07\xa9\xdfL{\x1b\xa4\xba T\xb1Q\xfb\xd2iq\xf6\x8e\xc2^\xef\x90u\xaaYb\x99!\x9fY\x9b|V\xa7\x188\xbb\xc4\xcfD\xc7\x91\x9c	\n\x8d1\xb5\xa7\x13\xf7=3w\xd7\xd8\xcfeX\xeb@\x90R\x12@\x9f\xe0\xb6\xfb\xf1\\y\xdbt\x19\xad\xe24ZzG\n\x12\x91l\xf5\xc5wI\x16\x96\xcf&\xaf\xf2<\xbc;u\x1f\x02J\x92\xaf\x9f\xb6l\x9aK\xa4\xe2uZ\xe6\xc1qm\x96S\xf5\xdb\xc6\xd8Z\x8ed\xa8\xc62w\xb4+E3L\x13\x80\xb3\xa1\x16m%\x87\xe4K*\xb7\x8a\xa2\"\xe98H\xa4e\x99 @x2{F\xd6\xbf\xf1\xb0\xb0,\xd5\xbd\xee\n_\x97\xa4\xc3`\x05\xe9\x0b5\x1e>\xc3\xc43\x83X<<$|\x8d\x7f\xf1\xa5\x99\xf8j\xd2\x0f,\xd4S\xb3\xf8\xa9\x83v1\xa42\x97	)#?\x9e\xfb\xcf\x8f\x195\xda ,\x03N\x13\xda\x1c^0yk\xc1\x00\xd0\x13\x9b\x07\xd6\xf1\xf2\x86\x1f\xb6x\xb8\xe3\x875\x1e8(\x91\xbf\xc0\xc3%?,%\xb8)\xc2\x89\xbc\x16\x061rg\x7f]\x02\x9av\xb6\xea\xf7\xe7\xeam\xf8V\xae\xfa\xeaK\x11\x98\x94\xf3\x8d\x1f\xcb\\\xde\xc8;\xb9\x16r3\xcb\xe6\xeaZnf\xe1\\i\xc0`\x07\x99\xdb\xdf\x10.\xb6\xbc\x12\x92_NO\x01\x1f\x1b\xa0V'e\xacS\xa8\x92\xcb\xa7U\xa2_\x11{w&5\xfd\xa7/\x17\x9eL\xc4\xde\xa5U\xb1C\xabr\xd7\"\xbcr\xb9J\xd1\x97X\xa6hF)\xf5P\xc4vPJq:C\xbb\xdf\x86o\xe7lDcP\xc5S\x87\xbe%\xeem\x14\x9b\x9a\xb5I2\xaf~OC|\xe9\xbb\xd4\xc7\xdf~z2\x91c\xa0\x9d\xc8\x9c\x93\xf1w\xc0\xc9#!\xe3\xd3\x921he.\x02\xfeM{\xda~u\xe5`\x0c\x95\\\x0b\xfe\xca\xb1\x90\xe5\xde\xac\xb8\x8dYM\x9b\xd6j\xba\xe2\x95Q \xb0\x94YZ[\xb9\xa9\x96\xd6Zn\xaa\xa5\xb5\x90\x9bji-\xf1pY\xf9\xee_\xcb\x1b\x04\xa7\xb2\x06|\xf4\xfcI\xe5\xfe%V\xc2\x85\xca\xfd[\xfc\xf8A\xe5\xfe\x1d~\xbcQ\xb9\xbf\xc3\x8f\x8f\x98\x8c\xc4\xff(/\x10\x01\x1a?>\xa1\x03\x1fu\x8fW\xfe\x1b!W\xfe\x05\xfe\xf9\x01\xff|\x12l<\xe7\x10\xc8\x8d\xbc\x07\x81\x0ch\x95n\xe4\xb5\xbc\xc1*\x85S\x0fTu\xd8\x9a\xc1\xf5\xcb\x9b\xd3\x8bY8\x0f>\x81Z\xe0B\x10|\x94V\x1e\xd0\xe9\xbdQ(\xbddd\xa2r\x92\xd4\x99%\x03\xc8\xfe\x81\xc2T%\xf6\xd7\xfd.(@|\xee\x82\x02\xc4\x87l;\x82\xf04\x0d@\x92\x06\x94\xc5\x16b\xe1)\xc8\xd3\x00\xc5\x82t\xbf\xf7\xef$\x86\x81)u\xd3i\xb2\xd4\x8a[M\x84E\xaf\xb7\xb4\x0f5\xd9\xe3\xad_\x8a^\xcf\xfbM\xeb\xa0A\xddq]\xa5\x1f\xaaF\xb5\xadM\xc7\x9bs\x92n\xbe>?\xec\x8a\x19\xadV\x80^]\x84%\xe166\xa4\x89$=\x94\xde&KB\x92,?\xe6k\xc9\xe3\xf6#\x07\xf7q\xfc-\x8dce]\xc6\xe3\x11GfE\x86\x06\x97\xf43\xe2\xbd\x9a\x0e\xb0C\xd6\xa7\xfb\xa3O\xb2<\xdel\x92\xc8s\xe5[\xc1H\xc2\xfb\xf0[*\x05@P}\x11\x83\xe1<\xca\xea\xf4\xe0~\x13\xe5q\xb6\x0c\xbed\xe4\xb4\xc9\xf0\xb9t \x19\xc8\\n/\x0b\x92gk\x83\xf7\x91q\xfaxu\xae\xdem*$\xbc\xe9\xab\xf3aQf\x1b]\xb1\x95A\xaa\xe6%\xc5\xe0\xcc\x8c\xcd\x9d\x93\xee\xfa{I\xaf\x87yy\xf0\xfd\xb2:up\x16\xeb6\xa1\xfbt\n\x13Cd\xa4\x0e\xd57d\xa6F\xd3\xec\xc5\xb3if\xdc\xb7B\xf5&\xf1#9\x18\xe3\xff\x139\x01\x84H\xc8xs\x1c\x14X\x83	\xbe\xcd.\x08L\x0e\xc2\xe1\xdf'\xc1\xff\xfcO\x85\x04\xc4\x835\x1b>\x97\xc3\xe7s\x1e\x8f\\\x0d\xb2\x93g\xc7%\x82^\xc7\xd9\xb2_\xf2\x8a\xd3Rp|\x83\xc6$\xf2=\x0f\xc7\xfb\xf0v\x1d\xc1\xc2K\x97\x96\xf7\\e9\xd4sI\xdf>\x99\xc8\xc6\xf3|/\x86\xcb(	\xef\xfc\\hg\x1c\x9f\x02[\xe9\xaa/\xd0~\xbf\xa3r\xe3\x1c0\xeaz\xdd\x889\xf6o7>\xf4p4\x17Z\xae\xf1\xc4\xc9\xc0\xbc^\xea\xde\x9e\xad\xaed\xdc\x9a\x05\x04\x14\xaa&\x0c\xfb\x8c\x1a\xe6I^\xb9\xd4[`\x02\xd1l\xa5\xcc\x9e\xb8\x93\x86s\x0d\\O4\x0b\x01\xd9V\xceB\x83\xc6\xac\x8d\x11:\x96\x9e/\xe4M\x16/M~{mA\xd8\xf7\x164\x96\xfb\x0c\xc1}\x02\xd3\xb5\xd6\xa2-5\xaen\x15\x87\x17\x88\xc7\xaf\xce\x87\xcb\xec6\xdd$\xe1\xdd\xe1\x17\xd8E\xcb\x1d\xd4VPms\x85v\xa2\xf0Nk#82r&\xbc\n\x9a.\xd8\x92\xbe9\xce\xec\x87W\xb9{E\xc2\xb8\xe1:\x8cv$y2<\x9c\xe8\xef7~G\x18\xe1j\xcb\xc3\xd2\xa9P\x1dE\xac9\x17\xfb\x16\xd0\n\xf6\xc0\xa2\xa5\xc0\x85\x03\xca\x9f\xab6\x06\xcc+\x15\xbc\x07UA\xfc\\\xde8\x89\xd6k]\xac\xc3$\xc9n}\xfe\xb4\xde6\x9e\xe0\xc0\x1e(\x08\xd3h\xe3\xc00\xdd\"\x9e\xe1\xebLG\x0e\x05\x8e\x8e\x90\x08\x03\xa8\x93\xc6s\x99\xe3\x9a\xbdO-:\xa4\xf2\xbb\xbe\xf2!\x83\x9f\x1d\x85\x8f\x12\xc7\x1cd\xefd\xfc\xf5\xc8\x84B\\\x03@3^\xf9\xeb\xa1KL\x99\xb5\xf0\xdc4\x04:u\xf7\xaa\xb1\x0ds\xc9\xedP\x0f\x11JZ\"\xdbY\xce\xe6RY\xbdY\xd48zv\xdcU\x98\xf7\x12J\xba\x14GE'\xcc\x8e\xc3_b\xf8{c\x94\x7f\xa7N\xcb\xf5P\x9f3\xcd\\J4E\xaa\xad\xabB\xb9\xd6\xb4V\x15\x15\"\xa1\x06\x96\x80\"\xca\x13n\xc2\xb64\xcf\xd5\xbe\xd1\xf9f[Hs,)\xa5\xeac,\x1aTEk\x04:\xc9\x92\xbff\xd9z\xe7&_\x0b\xd9 PjM\xb0\x87\xf7\x0d\xba\xe5\xb8\x1ewR\x13\x13\x89\xd6\xdds#\xf8\x98\xd85.\xee\x17\x15\x11\xf1\xab\xce\x1d5;\xd7\xeb=\xd6\xde\xbd\\\xd5\xeb4d\xe6IUv\xb4|\xaf\xc9I\x96\xba3%\x974.\xc2I\x86e\xcd\xcaI\xb5\xb3V+\xcbsgJ\xee\x1b\xe4_\xad\x89\xd6\xad\xc2e\xf4n[\xaa\x96\x18\xf3?\\0\x80\xbd\x01\xbf\xb0\xf5\xdfm\x10\x13[V\x1eY\x0d\x0e\xaf\x1b\x13A\xafe(F\xe8\xda\xfa\xe7\xc4\x7f\xb79\x84\xf6fL\x01:,|\x9cz\xa6\x99K\x9dS\xd1\x94\x07gV\xa6\xad\x8bY\xc8\xd9N6\x9dM\xaf\x89\xd2\xb3r\x84^7f\xfe\x0c\x1ew\xf5\x9a\xa2\xdf\x16\x90P\xc19\xdfp~\xbd^\xf5\x1b\x88ma\x0e\x14\xfa(]\x06\xa9!\x06{i\xda\xe8t\x82\xbb\xc0\xb7\xd0\xb4\xd2D\x91\x03~W\x93\x0f\x8bE\xa6\x11\x83\xaf\x7f\xc8\xc2k\xfb\xaa\xd185\xb07\xd5U\xe9\xb7\x0b[\x088\xee{D\xf0\xdfE5v\xbe\xe8\x92\x94W!\x18\xab\x9e\xf5z\xcd\x14f<a\xdfd\x05\xe2v*\xc4=d\x9a\x9b\xc2\xdf\xbc\xf6\x9b+\xca[\xc4\xf9\x02\x04\x9c.\x1bW\xad\x02\x1c,\xeb\xac&\x9eRF<\xf5c\x9cZ\xe9\x14\xfd\x1b8)\xf2\xbc\xfb%\x12c}\xf5\xa5\xfb\x96\x9b$\x7f;\xecz\x8d\x8bb\xf1\xb9\x8b\xceS\xc1g\xea\x9b	{y\xc8^\x16\xf8\xf3\xf00\x9b\xcboa\x11\xe2b<_n\xf2\x0cx6\xdf%aI\x10\xab\x05u\x80\xdd\x1a\x8c\xe6oesU4\xac\x1ex\x85V	\xe6Ts\xca\xe8$\xe9&a\x95Q\x93hw\xbbB@8~\xe24\x14B\xfe\xf6\x040\xc9\xc3!\x0d H\xef\xee<\xdb\x0c\x8b\xfb\xff\x0f\x0c\xc2\xbek\x10\x9c.\xd7\xc7\"\xdc\x00\xbd\xe8\x90\xab\xfeS&\xbb9I\x8d\x8e\x9d\xb6R\xd4\xef\xad\xa4\xdaL\x0b\xd9\xac\x83\x07\xa7\xfd^\xc7:\xd1I\xa4gj\xd4\xf2\x7f\x9c\x00!?;\xe4|0\x10\xc4\x11\xc3\x03T\xe3\xcak\n\x84\x97\x0c\xaaQ3\x82\xd4Z^\xde9-j\x0b\xcc\x1eM-\xe30\xbe\x1fE\x0cG\xf8E\x9c\x16e\x98.@V\xa8e\xa7&'\x88j\xac1	&\xe8\x8a\xc3\x10\x19\xba5gu\xe8kGX\xd79\x0f\xa7\xdd\xc9\xb3\xc9q\xd9\x1f\xcf\xd9\x18\xa2\xbb\xab~i\x1cz\x1aMp\xc6\x00\xa4\xde\xaa\xb4\x9a\xdf\xaen\xa7\xb1\xea.\x81f\xcce\xfaHn\x7fl\xef\xa3t\x11\x8d \x92\xc4?\xb4\xdf\xf1\x037\x90\xe6\xfb\xb3\xb8?9\xce8\x7f|(\xbf?\xae\x04\xb6V\xb2\x19\xaa\xcf\x8c	7\xc7\xc6-<\xdc*\xc8dg\xa3\xaa\x15\xf4\\}\xd3\xd4\xb0\x97\x07\xa8S\xc7Z\x1bU\xf8\xb4Uoz=?R\xcdD\xae\\H/\xdd^\x7f\x8a\xf2\xea\x04,qMs\xa7\x86\x8b\xca\x94v\xcd\xd99\x1cn\xb5o\xd39\xfd\x0e\xd5H\xe6j$\x0b5\x92\x89\x1aM\x93\x17\xf1T\xdc\x17\xfd>\x1d3[U\xce\x12(-\xd3Y\x0e\x99z\xd8\x8f$\xff\xdc\xda\xf0\x8fk5\x9a\xae_l\xa7k#KX\xf0[ri^\xcff!\xdeYH\xfe\xb1\xdc3\xbe\xc5}\xd5S\xbd\xa6\x034\xd39\xb5a\x84\xb5]\xad\"\x98\x96\xe4BV\xc5\x83L\x12\x9d\x0d\x8aG\xea\x82\xd5\x9b\xf3\n=\xeb\xb7\xcc\xc0\xec\xf7O\xf0u\xa2\xf1\xca?\xfa3\x0e\xc64':c\xc5\xbbq\x1d\x17\x06q$\xe3!\x8es\x067\x05\xd5\x913' \x0dd\xff)\xbcO1\xe1-\xa2a\xa4.o\xc3\xb7\xd3V\xd5#\xf6\x02c\xff\x1b\xd3\x04v\xf8:\x0d\xdb$\x08+3\x08\x03\x12\xd4\x8c\xe0H~\x18\xf3\xe4iT\x8fB@\xd2\x8d\x1a\x0e\x1eZ~\x10\x9bF\xc7.\x02\x81\xef\xad\xf2\x8c\xfck\xc8\xbd\x91\xf1\x06\xcb\x8cSBW\xabB\x1dH{\xbdP\x8b\xf1S\x1d,\xf5He6)\xd3\xd0(\xc3\x7feq\xea{_\xbc\xfc\x02\x1e\x87r\x93\x93;\xe9\x9b\xca`\xa4\xdaZ\x86~\x1e\x1d\x99\x1e\x19~\x92.vL\x7f\xdf3c\x1f\xdfD\x07\x1ct4u\xb7\\\x7f|S\x1bw\xa5\xcaS\xb7\x18\xe9\x97N\xc7\xd1\x97A\xf5U\xe7UO\x04e\xf3\xbb\x95\x0d\xe5S\x1b`\xdf\xf8|K&\x87Zb\xeb\xd0M\xfa\xac\xf0\xfc*\xca>/4o{\xc6T>\x80\x00:q\x1f\xf4\x85!\x98i4I\xc0h\xa5\x90Q:\xc2\xf0\xd9x$\xc7#\xc4\x0c\xccL\x0d\x11\xcb\xd5\x19dPZ\xf9:\xc5\x89	\xd3\xf2b\x13E\xcb\xaavs\xa5p\xab}&\x93,\x83\xbd0\xa2\xd4\xc4	\x87\xe0\x1eN\xf6\xc6\x08v\xdc4\x82\x9d\xc0\xe85K\xee\xc0\xf2\xebl\x07\xccf\\\xe1={Q\xba\xac\x83\xd0\x18\xc9\xf0\xf0\xf9\x1e\xf7\x9e\xf7\xe7\xea\x83+\xd4\x7f\x7f\xceh\xd5`\x03\x9c\xbb<\xcc \xed\xf9\x0f\xfas\xbbd\x9d\xe1^\xbe?7V\x95ZN\xa3\xad\xe1\x0fH<k\xbb7\x12.n\x00\xbd\xec	Y\x89\xdb\xec\x10\xd1\x86\xad\xa5zbz\xe7\xa7\xe2\xe1\x01a@S\x99\xce\x8dC*\x97ba\xe5\xc3\x83\xfd$[\x1dT\x82L\x997\xe5\xa9|C\xa9\xc4_G\n;^\x1f\x83|\x89\xcc\x11\xbc\x14:\x85L\x0e\x86\xcf\xe9\xbf\xb1\x1c\xcbP\x88\xe1\xef\x135\x1e\x8dd>\\l\x93\xc4\x8dZ\x01\xa9@.\x84\xcc{=?w\xd0	\x1c\x97(8\xc2J'\xcf\x9a\xe1\xd1\xad\xcc7\x16\xbbs\xd4R\x97\xbf\xa6\xfc\x1eU\xe3\x87]\xf9\xcdn\x19$\x81\xda\xb4U\xf2\xa3R\xc62\x12\x1d3k\x8b\xa8\x03\x92\x8c\xc6p\xc23\xc8\xd8\x1b!\xcf\x95M\xb3\xe4!\xc64@\xd2\xc9\xe4\xd8\n7\x0bC\xa0\xb17\x18\x17\x86g\xd6\xd9X\x04\xa0\xf1\xd1\xe7,RuxN\xac1'\xa6a%\x1c=\xceO\x9e\xed\x01)\xe3\x99r\x15\xc7\xb2\xa56\x0f\xe3\xab4\xcb#;\x7fz\x98l\xef\xb5\xb6\xdeO1e\xc9K\x1d\xd3\xd6\x10W\xec\x1b\xbd\x81\xfdT\x9c$\xc7\xe3\xe8\x99\x90\xf9\x91\xb1\xd3\xd5\xdd{x(l\n\xba'\xeeS\x12A\xda/X\xe1\xe3v\n\xdf\x8b\x85\xdaj\x83\xc4\xe1\xe5eI\x1f\\\xa8A~L\x8f\x9c\xaa\xf4Q\xbc\xb4\x80\xf8\xa4w*X3\x94\xcb\xfb\xcb\xcb2\x18[u\xd0B\x0c\x97\xdb<N\xaf*\x81\xbd\xb87r-\xbd\x81\x0d\x82\x1a\x84CbZ<<,\x87\xcb,\x8d\xba\xa0#\xa9\x88\\\x1a\x0d\x13K\x08Y\x8c\x9d\xf3ZCG\x15\xecn\xdf\x9f\x1b(>=P\xceb\xb2\xd3\xf5\xe9\x92\xe2\xfcl\xc6\x12\x7f\x16\x9b\xb1\xe8s\xd2\x82\xd36\x13\xbd@;\xe7\xa6\xb6>!\xbb@U\x1a\xd9M\xbfK\xb8n\xa6n\x15\xcd&\xe0\xa7}\x8eZ\xd2\xe7h&\xe2d\"}\x8e`\xd2\xe7\xc8&\xe2d2w\xbe\xdb\xd2\xedX\xab\xf7\x83\xba\x1c\xa8\xe4]\xbbx\x97h\"\xdf\xedV}&j\x03\xc5\xbc\x11z3\x86\x06\x0e?&\xb8\xe0\xe8\xf1\"\xd5\xd0\xe5eI[\xce\x9c\x072W\xff\x88\xa1\xdcI\xa7!.39\xf5\x8e\xa2#K\xc2\xd2\x86\xb5+\xee\x0f\xc8@l7\xfc\x13\xe3\x1f\xf6\xf7OT\xd1\xf1\xcaV\x15\x1d\xe5\xab@]j@\x9a\x96\xb0\x0c\xd3\x89\xbf\x95\x89\x18\x18\xcd\x0b\xf4\x9df\xaf\x8d\x9d~k\xe2\xd0\x9c\xc5\xceae:b\x95dl\xa2\xf5\x99Cf\xfay\xfa\xb7\x97[\xff\x03\x89\x9cig\xbd=W\xbf\xbb'\xe5\xdbs\x8e`\x15\xbd\xe7\x83\xb8\xd6\xdc\xcaV\xc76\x87[\x1a\x99+\xcf\xed\x85\xdf\x00\x96M\xa1k\xb7'FV_':\x02\xcbEi\x8cu\xf6\xf2\xed\xa3\xab\xd0\x08\x97+\x1d\xe3*\xf3\x1bc\xd8\xb45o\xb7u\xbf\x97\xa9\x8c\x9e\xd0\x92f\xde\xa1\xd1h4\xe0\xc9\x1aL>\xd0aV\x07\x97\x18+\xb0\x84A\x1d\x12\xd6\xec\x10\xadS\x11\x84\xe9\xa8\xac_W\x1e\x1e\xfcBe\x0eR\x91\xadC\x03\x14\x9d\x9bG\x1f\xa7\x8d[\xd0('\x86\x87\xdf\x00\x19\xde\x16\xfc~nb\xa7T6\x05\x1c\x98P\xb3\x96\x1c\xbd\x10h\x95\xf4\"\xb5\x9d^U	`\x89\xd8\xaa\xe4\xed\x1f\xda\x0e\x16\xceK\x9b\x9f\xc0%\xbf5\x9b\xb4\xa8\x7f\xaf\x16\xf5\xbbs\xf5\x8d\xbb\xa8\xdf=\x95\xfd\xfb}c\xe7\xfe\x9die\xe3|\xecj\xee\xa5n\x9a\x8a\xecu=V \":0\xf3x\x9a\xbd\x88:l\x01\xa2Y6\x80\x86\x17?\xe6\xd3\xb4\xaf>]\x92\xdf\x85	s\x9e\x8a}\xbc\xf2\x01#\x93ji\x83\x96\x9bXSh\xc8M\xe2Y6?Q)\xef{\x8d^_\xd8\xf8\x15\xfa\x9b*\xdd\xef\xe5\xbb'\x9cN|\xa0\xd1K{\xf9\xee\x0fQ\xeb\xd2\x98K\xf0XX\x99\x14\xb7If\xca6\xbc\xe2\x9f\x8c\xac(	\x8b\xf2\xbb<\xbc\x8e\x90\x17\xbdh$\xbe\x8f\xf2E\x94\xb24,\xaf\x8c\xdb\xc2\xfeXf\x83\xb1\x98\xe6\x14\x97\xff\xc8Og\xf9\xfc\x85\x8a\xc44\x1f\x0c\xc4\xd4)\x99\xcbl0\xd1\xf6\xdeVn\x93\xabp\x9a\xbf\xc8\xcc{/\xf1Z\xbf_\x7fm\x80\x0fL\xc4\xfe\xef~\x15\xbaQ\xc6\xb3\x1c\xc7A\x0e\xc9\x9a\x1f\x0d\xf0Qq\x82:\xfa\xe3\xb9~\xd2\xa4\xb5P\xba\x10L\xcd\xf0\x0eVDb\xd2\xc6\x9c6\x9e\x1f:Q\x80\xdf\xed\x9e(\xf51\xa9\xf8\x8f\xc6(\xa9\xa8v\xf8`g|\xb3\x91\x1f6\xbaM\xdf\xd6\xf01\xdd\xbbV\x85\xd59\x92Et\x85 \xf2\x9f\xf7\x95@A2\x12\xb5o\xd3\x04\x0eM\xc5\x14\x86=W\xa3i\xeeX\xf03\xe7\x8c\x81\xe8\x931L\xf6r$\xee\x1d\xef\x03H\xc1h\x80\xfb\xec\x98\x8d\x17B5\x9e\x86/\xb2iXw\xe3v\xcb\xee\xeba\xd1;\xbf[\xf0wy&\xfas\xb95?\xd6\xfc\x03M\xb2\xfe\x03\x85L\x84L\xd1@4b\xa1\xfc\xa2\xbf\x15'\x93\x81\x9f\x0c\xd6\xe28\x95K\xe5'\xfd5\xa5l\x07\x858N\xa7\xe5\xf0\xdf\xdbp	\x87\xca\x85\xc1\xea\\\xc8\xa5\xdc\xca5;\x1cXG\x04J\xda\xcbU\xacU\x12\xfa\xee]'/|\xf4[\x003\xbaE\xd2\xa8c?\xd5F=\xae\x8f:\xded\xb9\xe34\xafl\x91\x8cWWj\xbbZ\xbc\x1c\xd9h\xf9	\xa7\x03\xbe\xcf\x0c\xcax\xba~Q\x90\xdc1^\xf9\x8b\x940\xae\x16&{\xc9?\x8c\x01\xf6\x17!&\xa8>\x0f\x07[\xd1\xfeZ\xab^\xbb<\xe2\x95\xbf\xd2\xdf\xf6\x93\xfe\x82\xc7{)\x8e3\xe9o\xfbKz^\x0c\x12</\xe4\xb2j\xcd\xde\x04\x864\x0dw3\xa9\xa9\xfa\"5\x18Cl\xf0\xe1\\\xfd\xe4\x9e\x1b\x1f\xce\x87q\xf1>\xca\x8b\xb8 \x1cL;7\x86dZ\xecz+\x17\xdb\xcb\x0f\x07\xd8\x17f\xa0\xaf\x9a\xc0\xe7\xacj\xc4\xf1\xf3\xed\xb9\x7f\x0f\xa7X\x92\xf5@p\xa2\x01\xe8\x02\x8f\xa5F\xde\x9ea\xab\xf4\x89x\x8f\x85\x10\xb8 \\t\xf6\x17lv]\x05\xf3)\xa0\x9e\x00\x03\x037-\xd1t\xf4\xb2q ]\x7f0\xc8\xdc\xa8/mD\xfc\x1f\xa9wO\xe8\x19WK^^\x8e\xe8\x10\x19\xe6\xd2\xfa\xf2y\xf4\xdc\xe8\xcc\x9c/=<\xb4\xd3h\x84\xde\x81x\xb9\x91\x0c\x1b}i\xbd%D\xf0\xb4\xce\xb5zU\x17L\x7f{>u\xa1Ei\xe4\xa3?2\xf2\xb1\x8c\xe4\xd1Q\xab-B\xb6:\x7f\xda\x1a\x0eHZ\x10\x1b&	\xef\x00N\xe8\xc7\xb0\xbc\x0c\xfcxh\x17\x0b\xee\xf4\xb1\xc5+4\xcbE\xc6\xc3\xcbK\xba\xbdZ\\[<4\x07\x8dB\x8f}8\xe7\xa9sW\xf9\xe1	l6\xd1\xb1:j\xadp\xaa\xbcZ\x84\x07X\xe8\xc85\x1bt\x86\xdaP5\x0d\xdc	6PS9O8g\x16\xe7YF\xb6\"\x8dz\x1bTL\xac\x0b\xff\xf9\x18\xdf[2.k\x8d\xe3UG\xa3\xe9M\xf8\xffp\xf76Ln\xdb\xd6\xdf\xe8W\xf1\xf2I5\xa4\x05\xd1\xd2\xc6N\x1b\xca\xc8\x8ec;\x89\xdbu\x9cf7i\x12\xfd5;\\\x89\x92XQ\xa4JR\xbbR$}\xf7;\xbf\x83\x03\x10\xa4(\xc7m\xef\xf3\xbf37\xd3zE\x10\x00\xf1zpp^~\xc7\xe0\xd1\xb3\xecM3\xf1\xc3\x0c\xa8\x90\x96\x1c\x8c\x8a\x02UN\xd8\xc9`\x99\x1dO\xc4\x87\x831\x1bW\xd3\x93\xdaO\xa2\xac,\x9a0-Vp\xf5H\x9b-p1\x84\xb8\xd6w\xc4\xdaa\xe2F&\xc8\x93\xfe\xf5\xab7\x8cI\xd4b\xd7\x10w\xcb\xdaB!H\x0b\x9a\xb4\xe6\xde\xb5\x17\x07>Y\xca\x93\xc5:\x84i\x93O\x05y\xcd\xde'Q\xe1zGj\xe3\xef\x0d\xe7%\xb6\xee\xf8o\xdc\x96jK\"=\xeb\xa2\xc6\xfa+-\xde\xaf\x0eZ+(\xe2hl1\xa7\x05]\x97\xa2t\xda\xcb8\x88\x06\xe9`\x0c\x03\xb9\x91}\xb1\x90\xfa\xdd\xe2%\xe5\xa5\x83r\xd3ex\xc7\xba\xd2\xd9]x\xc3\xe2\xd4\xa0#\xe9^>\xdd0o\xd0\xf2\xfa\xf9\xd3\xa4\xe2\x82&\xb2/\xa6pHj\xff\xf0~\xde\xfc\xb0\xf2<\x02$c1\x9a@+8\xaf*\x9b\xc9\xfep\xf6r>\x9cu\xbb\xde\xb4\xe1\xe9\x93\x8ffcq1\x00:,\x17\x9c\x82\x7f5\xbf\x07\xe3c\xe8\x17\xed\x07\x8f\xf1\xcd\xc6H\x9di(\xbe\xbf\xae\xf0\x8c\x94\xdak\xe1\x893\x1dX\xf1\xd4\xc4\x86Uy\x80h\xf0\xe5|\xf8\xd0\xedz+\xa5\xbbj\xf6\xe0a\xec\xa9\xc8\xd0\xfb\x15\xc4B\xcd\xd7\xfd\xb1'V\xa3\xc1\xe9\x8b\x01K\xddw\x06'\xbb\x8d\xac\x0c\xbb\xbbN\xc7]\x8d.\xc7r\xe4\xaeX\xc4\xb62\"\xb6\x9eJ\x1b\x8c{+\x16\xb1=\xdd	\x9d\xd6\xd5i\xe0[\xb8L\x8f\xeb\xf0\x9e\xee\xc6\xde\xd1\x04\xee\xe1\x01^\x88\x15\xfb\xa2T\x86\x82\xbcw\xea6M\xde\xfe?\xb0\x02\xe4\xfbx\x9c\x12\x80\x02\x99\xcfk\xa1veD\x0b\xc5BM\xbdg\xa9uH\xb2m\xc2\x1f\x8aD:\xc5\xc3\x1c\xd6\xb0\x85\xbf\x0e)\xac#\xdea\xcb\xbb\xde09\x1cj\xc9\xd7\xe1.\x82\x88_\x11\x0d\x18\xfe\x0bV\xb4\x99;\xcfo\xd4\x86\xc3\x81\x8aN\xb2t\x16\xcfU\xa9f\x16\xb1_e\x18\x07Bo\xbb\x18T,\x90\xb11%\xa7\x1c\xa8,N\xea\n\xeb\x85\xfb\xc2\xdc\xad^%\xebE\x18\x18/\xaa\xea^\xf9l\xd0\xef\xfa_\xc23\xaa\x0f\xa2)Zm(\xad\xe6I\x1d\x91j\x16\xa7q\xb1\xa0\xb0A\xff\x06\xf2\x87\x16~\x19c\x99\xe1\xd9\xf9;\xcb\xb8\x19\xd3NE\xdc\xd50\xc6\xdeI\xbb\x06\xb5v\x9di\x10\x0f?/\x9cS~\n\xf9\xaa\xd6\x9e|\x84\"\xb0 vZ\x95Gs\x86:B\xb31\x95\xfc\xb4\xe5\xdc$\xfe\xc3*\x9e\xa0\xfe*\x90\xa9H\xf1\x88\x1f\x96\xb6Wu\x91\xaf\x0e\xfb\x0d\xe3\xe7\xf4\x8f\xac\x81\xfb\xfd\xba2T\x0dM\xb9N\xa7\xfa\xfd\xc7\x86\xaaf\xa0l\x01\x98\xdb:\x1fG\xd1\xd8\x95A\x1bkZ\x1f\x7f#t\xb1V;\xc9e/.\xa2\x93\x93\x12\x0e\x91\x8d\xc1b\x08\x16\xbe<\xc5\x9d\x0e\xfc\xebu<\xd0\x02\xdb\xa7\xect2\x93\x16\x17ZF\xdc\xe9\x84V\xaa\x85\xd9\x82\xc861\xf3\x82\x16\xb6\x8a\x1e\x07\x19^\xe18\xfcyM\x16)\xf7S7\xbbJ\xaf\xbe^\x07\xbf\xaf\x83\xf4\xea\xc7u\xf08\xd5c\xa3\xbf/S\xd1\xfc\xb8Q\xfb\xd9\x180\xe1\x19^\xf4\xe4\xc6\x10\xb3Y3b\xa2[\xe3f\x8d\xb6\xb9\xec]\xd4\xc9\x9f\xb6n\xb0\xf6Q\xd0&\x1cc\xa28\xd4$1\xb2i\x9f\"\x89\x87\xc39\x82W\xcb\xdcN\xf2,\xba\xf9Q[b=\xe8\x9dN\xfd\xb9\x9a\x9e\xc6\xe4T,\xbdM*\xce\x9b\x1b\xd3F\xf9\xfaZZl8\xeb\xe5\x1d\xbfX'q\xe9:\xbe\xe3\x89\x9f\xdbY\xc0SH\x83J\xd9\xf0-;\xf7Tjr\xac\xeaf*\x8c\x19\x1c\xcf\x9c\\l{\xaeE\x01\x16<\x01Y\xcc\xdc\xa8\x9a\x10\xf2\x0ej\x1fO\xd4r\x94Y\xed\xfd\xa0\xf9\xbe\xaa\x81\xbe*\xb2N';_\x8b\x95\xe7\xa4&=D\x8c\xb8\xff\xf5\xb5\xe7\x89=X\xb1\xb7\xe1d\x11\x84ua\xffU+\x11\xa8sQ\x11l\x0d\xbe]\xbbq\xcd\x15\x86\x07\x0e7G\x1e\xbb\xb6\xf7\xaa\xa1\x94G\xdb\x1c|}\xed\x0d1@|\xb7\xa9\xdb\x1c\xd4\x06\x92\xfa\x0feYkVkDi\x10\xb2?\xae\x93\x87\x8d\xea\xcd\xfe\xa8^\x9d\x19u\xb7d3\xc3\x1cz\xc7\x00+\x1b\xbc\xd4\xacp\x7f\xbf&3\xf9\x9f?\xe2r\xbb\x88\xc2r\x15\xae\xff t\xe9\x1f\xb8\xd3\x8a\xfd<J\x11\xa9I1\xe6\x01[\xb9\x1d\xff\xd0\xba\n\x9b\xaa\x94\xaf\x89Uv+c\xa7\xa6\xdb-\xac\xf6!\xb8\xc4\x18U\xe8||\x9a9IJ\xa4\xc7~E\xc3\xef$ay\xf2b0>	u\xf5\xef8\xf9ZfD\xf7\xc9&\xc7\xbc\x04\x9f\xf7\x05\xe9\x05\xe8\xe1\xb2/V\xe1\x96c\xdb\x05\x03\xb1\x8aS\xfd@n\xb9\xff\xb0dpR\x1f\xc4\xf6`7c\xd9\x1b#\\\n\x9eN\x91\xecM\xd2\xb7y8\x8d\x01\x83^\x8aL8q\xfa#\x82\xa0\x93\xe1\xc5\x99,\xd9\xa6\xfc0\xd3\xb96*\x97i{\x97\x1eu\xb74\x98\xf8$L\x1f\xc2B\x07\xee\xe1\xc3\xd4u.a\xc7\x01&\x87%\xd0\x0b\x15\\@Fb\xc1\xc1\x05d\xdc\xb8\x8cM\x87\xdd\xeeL\xdf\x8eJ\xdc\xc1\xe6r\x8d\x8b\xd7J\xae\xa1\x80~\x90\xa9\xbb\x1e]\x8e\xbd\xe1\xc4\x9f'\xd9}\x98\x10k*\x1f\xc4\xc4\x9f\xe6\xe1\xe3\xbbU8\x8f\xdc\\\xcc{\x1b\xb1\xeamH\x83t\xc1\xdf=\x1c.\xf4wyY<Y\x98\xcf\xef\xe4\x04\x0b\x8c\xcacq\xbb}\xd1\x17\\\xd04\xd7\x13[\xb9\xa3\xcb\x92\xb8\x93}\xf1(5\x08\xb7\xb8W\xe3T\xcd\xa4\xb8\xe1\x143\xd1\xbd\xfb\xe1\xdd\xcbG\x16@?\xc8\xed\xe8\xae\xfb\xf9\xf8\xd9\xe5\x8b/\xc4{\xf9\\9-\xce\x92,\xcb\xdd\xcb\x17/\x9e>\x90\xc1\xe3\x83\x96\xbb\xbf\x93\xa1\xfb\xe0]\x15A2| \xe9\xc5\x83|xz\xd3\xbd\xf7\x04\xaa\xe9\x8e\xe5\xbb\xd1\xfb\xb1\xf5\xbb;\xa8=]\xd6\xde}>~\xfa\xf0\xf4\xf2\xc5\x17\xea\x8ez\xd7\x95\xcfY\x08\xfcd\xe2\xaf7\xd6\x10\xec\x00\xe4\xec	\xd8\x17\xeb\xb5U-\xc3\x06\xb88\x16\xdekZ\x06Fvi\xa5\xc9\xf9\x1dX\x8f\xe8\xe3\xab)\x96\x97O\xa3a\xc9\xab$\x16%\x0f\xbb\xd4\xf6\x9e\xe5\xc9\xf2\xd2\xa7[\xaax\x00\xa0(\xd2\xcc\xc50\xc7H\xfd\x1ax\xb5\x8cM\nnG\xb2\xbe\x94\xf5+\xb2\xb7\x92\x1c \x0eQ\xc0\xe7q\xca\xa0R\xa9\x1f\xe6\x13\xb7\x17qt\xc2\xaa\x1f\xa2/.\xb5\xdb)\x0e\x0f\x91\xd6\xc0\xa8R\x1f\"/\xd0\xc1\xa3\xb0\xb7dcOW\x92\x15\xcd\xc6\xce9\xe3\x0f\xf16J\xa0\xf2HG\xf1\x18\xb6q\xa3x,\xb5\x0d\xf3_^'\xe1j\x1dM\x950e\xd0\xbf|N,\xc0\x88a\xb8\xc7\xda\xf2zX\xbc\xbc|\xf1\x05\x05@\x8dF\xf1\xd8-\x9e]\xbex!.\xfa0w\xca\xd8\xf2\x1a;\xcd\xfc\x1eX\xbf/\xad\xdf\x9f\x1b{\xda\x0c\x08i\xe6:n\x8e\x89\x8f]\xbf\x87\xcd8+\x8er]z\x8f\xf3\xc5\x8c\x87\x89\xc7r\x0b\x86\xb6\xbc\xa1s\xa82Q\x8a\xbd}\x0c\xa2m\xa2k\x1c\xbd3\xa2W\xcd\x19WG\x8b%G\xae$\x87YK\xd0\xd0a\x8d\xc4K)3B\xae8\x1c\x1c\xe8\xfe\xd3i\x98W\x89,\xb2V\x1d\xff\x90\xbe\xd6\x05_\xa5\xd3\xd7\x9c\x99\xcc\xef\xfa\xa2<\xbd\xe9y\xc1ok7\xf3:\x9dz%\xdfF\x88Q\\\x8a\x94L1N\xd5\x10\x1f\xbb\x91\xb6\x88\xa0\xad\nZK\x02\x0d\xf34\xb0n\xa7\xf3	=\x8bDZ\x8f)\x8a=p\x14\x1f\x1d\x8e\xc6\xda\xc7y\xc6\xa7\x99\x80\xdc\xb1e:\x10%\xb31#	\xcd\x88\xf6*\xa7\x10\x06\xb0\xfcu\x9d-\xdc\xa8\xed\x84\x1dH\x85\xdc \xc7\xd7a:%\x84%:'\x17\x8d$\xe3\xc6\xc1\x1e\xc24\x92t\x88\x99y\x13\xb3Z\x98\xdc\n~\xde;g\xdc)\xd6\xb2l\xb3;9[\xd4\xc8\x01\xb5\xa0\x9e\xc3\x81\xad\xda\xeb\xd1\xaf\x1fxD\xc4N6\x87\xea\xe1j4\xad\x03T\xd1 5\xd3v-i\x8aQ\xf3\xc6\xc1I\x0deLF\xf7\xe7\n\x00\x95g\xb8}\x99\x0e\xb7\xdai\xe3\xaem\x16\x1f\xc8\x17O!\x1bM1\x84\xee\x0e\xf4f\xeby\xde$K\xcb8\xddDD\xff\x1feR\x93`\x8et\xe6\xfeXl\xd1\nU\x14G\x9f7\xf6\x86w\xa7\xa1\xd3\x1ea\x8a\x90?\xbb\x14\xbb\xe0\x11\x92\xc9\xe2\xd9%c\xe2\xe4\x1a\x07\xa7\xd0a\xd2\x14\xbc\xc1\xd4\x9f\xd7\xf8\xedmef\xcc\x0cu[\x16\xcdkS\x04\x1c\x9c\xb2\xa7\x1d\x1c4:X\xb5\xf6\xf7\xcb`\xc0h\x8d\xba\xbft\xac\xd5\xbb;\x06\xf9H\xa1\x95%]\xe8\xff\xdb\x8d\xc6x\xdfK\xd35\xb5\xe0\xb6\xdepZ\xbf\x8eu:\xeeL\xde[K\xf2\xd36\xc3\xfd\xbf\xbd\x19\xec\x12\xd5fh\xad\x87_\xab\xdb\xf8\x8d\xda0?\x86\x8f*\xa6\xc2\xd6\x13\xef\xa5\xd3s\x867\x9d\x0e\xa8\xff\x85\xbc\x19]\x8e;\x1d\xf7=\xfd\xf0D\x9c\xb93\xb1\x16s\xb1\xfa\xa3\xe0u\xf5\x90u\xefO\xe2\xd5\xddU*\xaf\x19E\xa5\xbb\x13\x8d\xd1\xbbZ\x07\xa1\xbb?\x8a50$m\xd9\xa1\xccp\x9fv\xef\xa0\xb5#]8I\x1e\x10KHLHhs\xc7\xe1\xf0\xea\x80\xae[q\x07{\n\xfb\x049!\xb3\xc6\xfa\x84c\x05\xab\xf9/|\xbe\x18\x88\xf0\xe4Mu\x1f\xd0 \x80L\x06\xf5%c\x01\x19}\x94\x1f\x0e\x8dGl\xbf\x7f\xac\x87\x15\x8f\xa5M\xbf\xf53\x84\xe6\x15\x13\xa5\xdf\x9a\x04z]q\xd4\xfa}\x95\xa22\x84\xdbf\x06\x93\xe2h\xf3VZ\x04?\xc7\xd1#\xa6\xc8\x85\xc0\x08&\xcft\xbdi\xf1Y\x1f&p\xe8LvU\xd2\x86\xc3X\xb1EU\xb8u\x13\x7f\x0b`\xb0\x89\x9d\xb2C\xca\x94S\xe2\xd4M\x14'\xdbE^R\xbb\xf2\x19\x83\x13\xc4\xca\xa4(O7\xf1w*\xd7w\xf4\x0c~y-\xa7\xbd\x85\x98\xcbYo\x02\x0dP\xde\xa0\xb3\xb8\xd4z\xe2$5,[R5\x11\x17\x0f\x92^)n\xd1\x8a\xceT[\x1d%\x13\x1eRh\xb9\xa3H\xc4c\xc3jC\x1c\xd1\x93\x0b\x12H\xf4\xe0mg|\xa9\xb2\xa3\x01\xfcSq\xca\\\\\x98*\x06\xcfgr\x9em\n\x1cI1\x9d\xdeW\xdf\xad\xdd\x9d\x88\xb57Q\x8e\x85\xe6\x05\xdf\xa8\xc4yT\xfe\x10G\x93\x08\xa1\x06\\\xaf\xcaU\x10&W4\xf5\x86\x05\x8b\x92\xdd\x07\xda\xb5\x99B5AA\x853\x1c\xff\x1eA!\xb3\xe7\xf5\x1dX\x19\x88\xd3\x7f\x0f\xd7]\xe4\xa8\x96y\x10\x9e\xc9s\x14[\xb5\x0e\x14\xb1\xfekd\xa0\xa6h\x9e\x83\xb5>D\xe6b\x1b,\xc4.\x98\x88\x18\xf7\xc9@\xdf\x90\x8f\x15\xf6\x94\xb6\x08\xaeL7\xee>.W\xfc\xf6Z\xfe\xfc\xa6]>\xb3\x8e\x81O\x16\x87\xc9\xd7\xe1\x19D\xb4q\x13\xb4\xec#nK \x8b\xec\xc6\xa4`u\xec\x14m\x05i\xa7i\x9f\xca*\xe5}\x98\xcf\xe3Z\x9e\x1f\xa3u\x14\x96pb\xe2\x9a\xe9\xf9M\x9cG\x93\xca\xa3\x89\xdf\xbdN\xe2u\x95S\x07\x8f\x04\xd1\xb1k\xfc\x81\xc0\x1cRP\x8e\xe0y\xbf\x8f\xb8\x03\x08\x11\xee\xf4\x06}\xc4\x12\xd7:\x89\xf8!\nN\xfd\xc5\x06\x1fq\xc84\x92,bi'K\xba%\x88o[\xdc\xec\xebU\xd4<\xed\x8f\x9e\xf8G\x13\xf7\xd6\n\x9d\xe0\x8c\xc57\xd7r\xb4\xdf\xee\x02\x84m{\\\x04\x0e-!G\xc4\xec\x80\xb6\xce\x8a7Q1	F\x0e\x82\xa6\x13\xc2\xd6|QR\xbc0\x14\xda\xa9Bj\xbd\xe9R\x03\xabT\x99\xad	\xf0\xbf,\xb3\x953>\x8e\xc5w\xd7\xb4hg7\x96\x02\xb5\xbep>\xae55\xd7+m\xdb\xcd\xa7\x806a\x05\xc1\x10y\x0b\xe3.\nyq\x91\xfbV\x842\xd7\xf3\xe3\xe2;\x13\xc1\x8e\xec\xb2s\x1f\x0b\x15\xb98J\xe8F\xee\xa3	\xcd.\xef\xae\xd8\xa2\xa0z\xa3\xc56\xbd<r`TbV\x82R\xc5\xdd\xb8\xd9\x15An~\xea\xf0\x89#\x90\xe4\xc4\xdfv\x99D\x8f\xc5\x08\xe47\xf1w]M\x8f\xc7ca72(\xc4\x03\x87&\x0b\xbe\xb9\x1eu\x8b\xb1\xd0Q\xfd\x10&\xe2\x9b\xeb\xd1\xa0W\x8c\x8f\x86H~,\xc6SV\x01\xc7W1\x9e\xe29n{P\x11\xfd\xb4\xc6/\x11\x89\x0d\xee\xf19\xd27\"\xb6\x82\xc4T0\xf0\xa5\x08+\xe071\x9d\xbb!e\xfdO\xe3=\xb54,7\x0d+t\xc3r4,\x91\x1b4\xac\xf0`\x92\x9f\\H	S+\xb3\x9e\x88'\xbd)\xa1e6\xf8\xf2\xf0	o\xebA\xbaI\x12t\x9b\xff^\x15s7\x16\x1bQxA\xac;_\xe0B\xd9^:\xf6D\xfd\xcbD^\x10\x8eO\x16<21\x8d\x8c\xaa\xb3\x81\x99\xff\x1fF\x9bJ0\xd0\xd0\xec\xb6~\x98=\xb5\xe2,\xa5\x95(\xce\x03\xeb\xb3\xe2\x8e\xce\x80\x8a\xf8\x7f\xdd\xae\xc4\xb2\x14\x9eT\xc0(;Q\xd5\x90\xd5C\xe6\xd3\x8ew\x05\xc5\xceG\x03\x16{\xfbd\xee\xa6\x88\xcf\xa0\x99Zt\xca;z\x81VY*\xd9\x81\x8aN\xfb\xe0.\x12Q'\x19\x9eA\xd8\xa9%\x0b\x0b\xf0\x9a\xf9\xf0\xdf\xae\x1bVo\xea\xc2\xff\xeb\xad?	\x93D\xd1S\xdb\x89\n\xe7\x9bL\x0f\x07\xe6[\xb4\xbc\x8d\x1d\xa6\xb2\xc3A\x936F/\xcb!&\xa3%t\x1c\xfevm\x8b\xcf\xc9a7\xdf\xa0\xd5\xc1o\xd7\xc2\x8a\xf2_\xdb\xde\xa6u5\xb9\xa6\xb6y\xe7mMT\x15\x82\xa7\xea\xfbx:*\xd9\xfem\xd6\xe2\xda\xcf\xf4\x80Z\xd9\x14i\xf8V1\x8c\x8a7\xea\x8f\x8f\xa2\xcc\xbe%\xc1\xb6R\x93PK\xcb\xec:\x9b\xd8)\xb0j\xff\xedZ\xfcz\xeb\x89\xf5\xdc\xee-\xebm\xe2t\x9e\x10\xa9u\x04\xe24\x12\x1b\x17\xe5oSXONa\xd9a\x8f\xcaz.\xee\xea64\xa7\x94\xdf\xa8M\xd8\xc9\xe9\xb7k7\x15;B\xb3\x80\xa0q\xcc\xca;\x0e\x99\xaa\x9f\xf4\xf0a\xa1\x84\xb2\x16\x06U	\xd3\x86\x99\x9f\xa5\x10\xc8\xc8*\xa0\xf4=\x0e\xfc0\xdfq\xbcb?N	\x82P\xdb\xde\xf0#\xbd\xe2i\xe77\xea	\xd8b\x14\x9c\x12\x97(\x9f\xa6[\x96\";\x19|\xea\x17oF\x95][\\\xd4\xeeL\xf5(\xcc5\"\x01m\xd3\xc9\x9c\xb2\x19\x80\xa6\xea\x96\xb8\x0f1\x84\xeb\xaczcdA\xe3\xec\xfa\xab\xb6)\xc0A\x7f\x93\"\x8c\"\x1da\xc0\x14\xc1j#\x83\xe6#u\xc3\x13\x0f	K\xde\xab2V\x07\xd5X\xe0\x82\xc8~HyT\xe0x\xad>Y\xf9\xf3\x80-\x93\x7f\xcf\x10\x08mV\x9aX\xcc`D<Qfk\x9d\x82\xed\xe0	\xe2Lt\x12=8@}\xc7\xf6\xd4\xa9\xbcY=\x96\xb6p\xea#\x87\xa9\xe6\xc3\x9cS\x99\xa7\xf1\x8e\x82\x0f\xfe\xa8\xe5\xe0\x8fj\x07\xbf\x16\xf3\x86\xd3\x7fn\n%\x02T\xa8\x0bu\x14\xe5\xfan\xa4N\x1e\x85U\xe8\x0c\x11\xa0\x8cZwACI\xe0\x146\xf9\xa0X\xbd\xf1\xd5\x08\xf2]j\xf48\xa0\xdf\xccJP\xe4\x1c@H\xe4ED! 	3\x9f\xefH)\xb0\xb1\xd3\xd1\xa0\x97\x8du?\xd8\xf1j\x1b\x17\xd5\x154\"\xec\xedmP\xfa\xbb\xca6\xa6\x96\xa5\xfd\xbc\xa8\xdd\xc6\x10\x0e\xaf?\xee\xc6\x10?\x81\x8d\xab\xf7aX\xfa5\xe2#3K\xdd^\x0d^7:\x06-\xe9i\x0foD\xe9\xdb\xd4\xeaL\x15\xbd\x8fUA3\xd7\x9c\x8f\xda\xcca\x0e\xce\xc6\xbcm\xcf\xf9j\x1b\xd5\xe6W\xe5\x1aU\xdbcL\x152\\I{\xee\xbd\n\xa1\x0b\xfc5\xad\x87\xc6\xc7]o|<\x82\xa2\x96a\xac\x9c\xc8\xab\x0fU>b\\\x80\xb9\xdbX\xd6k\xd0\x07L=ps\xcc\x14\xee*\xf6\xb9z7\xae\x0d\xafKP<P\x1d\x8c\x06\xe3\xafd\xe4\xef\xd4\xcf\x97\xf8\xd9\x8dx\xfd\x05\x1f)>\xe0\xe2\xfd\xaax\xbfQ\xfc\xdc\xf1\xd6\xecZ\xad'#\xd6%\xf0\xe9\x165\xbf\xdb\xe8i\xa4{\xda\x0f\xd0\"(\xe0+\x01\xc4G\x86S}S\xc4\xcd\xf1Ma#\x9c\xc9\x8f|\x85G\xfc\xc9)4\x0e`\xcdd\xc9\xc6\x1c\xd9XF\xf5]a\xccl\xd5S\x17\x88`j\xfbJ\xc4\xb5\xcf\xaeb\x7f\xd7\x8dy\xec\x9e]\x06\xb1\xbf\xed\xc6\x8a*<\xbb\x14\xf0E|\x1d\xfay4\x87OM\xee\xf2Y\xed\x88\xbd\x02\xe8h\xdd\xc4\x15\x82M\xd9\xd4\x9e\xd9G\xbd)j\x94)\n9n\x0d.\x0f\xd4}\x18\xfa@\xd3\x91\\\xea\xce\xe9f\x02m\xc19\x00F\x90\x14\x97\xcd\x03-\xd4\xfe\x98!\xa9\xd9ZOC\x05\xe2e7F\x1b5\xb6\x1aj\x18\xda\xf4\xafM\x94\xefLo\nw\xbf\n\xe3\xf4\xf6\x84\x87Q\x97b\x16\xefU/8\x16\xb5\x88\xa7-\xef\xa6\x8ew\x04C5<UjI2\xcak\xa6\xe2\xce\x1e\x1f\x85\xe1t\x8a\xc0f\xad,\xfb\x10\x16\xc8\xfc\x84\xfb=(\x07\xccN\x1dA?o\xe3\xc9\xf2\x1abb~&\x0c\xa2\xb1\xf8\xe5Z~8\x91\xdd\xb4\xf3h\xaf\x93\xb0(\x02\xe7\xc6\xbce\xde\xedL,>Q\x03\xe2P\x17\x83av\xe2^\x15\xca\xf9\x1c\xbcZN\xeb\xe1\xf5\x1b\xba8\x0e\xa7\xeeO\xd7\x80\x15\x99\"\x06\x07\x07\xea\xa5\x8b\xfa\xb7\xa8\x06\x02H>\x92\xc9x\x0d\xddd\x9b?\xad\x154\xe9\xa8\xfb\x17\x96\x90\xbc6\x0c\xbd\xc6\xcd\x15:\xdc\xdeQTE\xda65\xf1mP\x11]D\xcc\xf2\xc4\xc5\xd7I\x98.]\xbe&\xb3\xc7\x03\x8b\xfcM]\x15\xba\x0c\xbf1\x06x\x8e	!V\xb1\x1b\xa1N`\xa5\xc80\x94m\x88V\xc1(\x1c\x1b\xf3\x906\xc1\x86En\x8aS~ \x01\x05R.\x12\xb48\xb14\n6\xe9\xdf#*\x1755\x88\x8f\x9e\x98 +y5(#\x98\x05[\x95\xd8\xb60M\"\xb4\x18\xcd\xc6\xaaE\xde\xb0\xb8r'0\xf0_\x8b	\xcc\xf9s\x7f'\xe0\xac \xd7b\xca\xcf\xdd\x9c\xe9\x91\x17\xa8\xac\xb9\xbfU\x99\x91G=ws\xb6v\xa1B\xec/;\x97\x9bn\xf7O\x06\xaa.\x19\xcd\xc7\x12\xff\x10x\x1e~\xb0\xf07z|rs\xe3~\x0b\xef4\\\xfd\x83\xfdv\x10\xe0Cb\x87\xbf\x83\xb1\xd8^\x06h\x93\xd8\xe1/\x0c\xacXr\x8a\x99\"\x91X\x90\xd5\xa4\xa3\x9e\xd2\xc4\xaa\x01\xd1\x81nh@\xaa+3\xc9=~\xbcA4\x98\xb1\xa8\xa3\xfe\x07\xe1hf\x9a=\x16\xf8\xca\x9b\xb0X(?\xa9k~\x02\x14\xc3\xd9\xef\x13\x8e\xd2?\xaf\xe5\xe6$\xbc\xbd\xb5qUXV\xcc$E\x19W;Y#\xc0\xd5\xc9\x8bJ\xd5@\x897;\x16S\x9d0\x19\xc4\xb8\xe0\xdb\xbf^K\xc5~;/\xfe\xe4\x10\xd7M?\x88\xc1V?\x99\xc7\xa6\xdf\xb8xi\x9b:\x0b(J\xdf\x92\xd5\xe9\x1a\xd8\xe7\xa1\xd0\xb4K\x03L\xf5\x85\xd96Z\xe6v\xc9\xf5\x16Y\x12O\x11\xcd\xbd\xe4P\xbb\xba\xc8Qh\xb2g\xd5Bs\x15|\xd1R\xddQ\xe5\xbf\xb6q\xad\xfa\x82\x8c\x81\x1f\xc2$p\xc2M\x99!P\x85\xde\xd8V\xa5U]\xaaI\xd3\x10\x16\xf4\x8eQ\xc3\xfa\x970hL\xdd\x7fZ\xb7\x7f\xf1\xe1\xd6\x137o\xf4\x99\xe1\x88\x7f^\x8b\xd6\xeby\xc4\xa6\x1d|\xb6_UWU=\xa6\xdeQ\xfcz\xad\xf6\xc4\xbf\xea\xb2\x0c\xa6L\x9a\xe5\xa8\xb9\xb4\x81\x96a\xde%D\x16\x17.c\xda\xe9\xd8\xd5\x94\xc5\xcd<\xed\xae\xbb_\x83\xd6\xc3\xf1\xdc\x06\xbdb9k.\x7f\x88!u\xf2\xaa:\xf3\xc3!\x7f\xd9?\x1c\x10E\xa2\xbd\x8a\xa2M\x86\x95C\xdezz\xfe\xa1\xde\xd8\xafx`-\xea\xf7\x18'\xbf\x91\xec\xe6\x1e\x88\xc0P;\x18'\x9d\x8eVW\xd3\x91\xe5\xa5\x0du=\xb5\x84\xb4\xb0\x85;s\x13\xeb@\xb5\xe6D\xb3\xdaO\xc2\xc6U\x19\xbc\x07\x05\xbb\xaf\x7f\x95/\xda\x1bI\xbc\xb4\xd6\x15\xd4\xb5|\xc3MS\x9dWT\xb8\xd589F\x1b\x7f\xdb\xdd\x18\x16m\xe3\xef\xba\x1b\xc3\xbf\x8d\x8f\xb5\xc9IE\x84\xa0\x07G\xf1\xb7k9\x15\x9f]\xcb\x07\xf1\xd7k\xf9}\xecz\xe2\xef\xd7\xd2}0\xb2u\xebLw\x8c\xfc\x19\xf7\x8aw\xe9,S\xc4\xa0\xa9\x93\xc1RW[@p\xe0\x89\x0f\xacD\xb1\xf0\xf0^\xf4yObG8\xa2HCR\x96p\x01\x9e$\xec\x18\x92\x9c\xb1\xc6\xa4\x0c\xcb\x0d\xd3\xa5\x04\x10z\xa3q\x15\xfcX%\x9b\xc77\x9b\xdc\x8e\x9f|\xd9\xaf\xed=\x1dp:\x0cC\x87/\xf3\x83:\x8d\x10\xca\xc2\xae\x9e?\x9f\xdf\x87\xee\xe0E_\xe8\xff\xf7\xfd\xcf= \xd8\xd5	\x01&\x84\xd4:\xaaM\xeb<\x9a\xc4\x98~=*+\xa5W\xfa\x9c\xc3\xb0;\xffg6\x9b9b\x1dN\xa7q:\x0fF/\xc4\x9f\xc5\x0b\xf1\xe7\xb1\xb8\x0f'K\xb0B\xe9\x94t|\xbax-\x12\x1d\xc6\xc2\xd2\xc8\x04}n8L\x03\x83\xcf\xf9\x81\x8bSw\x8fbA\xd1\xd94M\x1a\x88x\x92\xa5\x81\xf3~\xd0\xf7\xff,\x06\x03\xff\xcb\x87\xde\xc0\xff\xfc\xbb/\xfd\xcf\x1f\x06\xfe\xe7\x93\xdes\xffK\xf4\xb3\xf7\x17\xff/\xe2\xb9\xff\x9c\xfe~\xe9?\x9f\xf4\xc5\x0b\xf1\xb9\xff\xa5\xf8\xd2\x1f\x08N[\xa0\xc0s\xff\xcb^\xdf\xff\x1ci\xbd\xe7\xfes\xfa\xfb\xa5\xff\xfc\xf5\xe0K\xff\x85\x18|\xe1\x7f.\x06/\xfc/\xc4\xe0\xd2\xbf\x14\xe6\x9b\xbf?y?\xf8\xdc\xff\\\\>\xf7\x9f\x7f\xf7\x85\xffg\xb4\xe1r\xf1\x85\xff\x85yq\xd9\x9a<\xf8\xd2\xff\xa2\xfe\xc2\x11\xb8\x06\x04\xcf_\xe8a~\xd17\xe3\xfc\xf9\xe7\x9f;\x7f4>\xfc^i\x03\x7f1\xe3\xa9\x9e\x7f\xc5Q\xb2\xc8\xb3\xb2L\xa0\xae\xa3\xc3\x15\x9b\xc6\x13\xe5RNE\xb4\x94\xbb\xf6\x9d\xf3Q\xa5\x141V\xd6e\x88O'\xc7DPq\x1d\xb3\x91\x1c8\x99@\"~\x9a\\9\x19\x1f&	\xe2Q\x0do\xe7n\xbd\x19q\xed\xdc\x80pTa\xfe0\x92\xa0\x93D\xe1C\xc4\xa2a\x88,\xa7\xd1\xf6\x03\x02\xa1}%\xfb^\xa7\x13\xeb\xaeU\x82\x1dS\xf3d\x93\xe7\xb7\xaa5A)\xb6\x01\x81\xeek\xaf\xe5\x9d\xfd\xf8+\xa2d\x9d\xf1p\xf9a\xae\xc0\xf2\xe0h#jM\xf7D\xb4l\xaa.\xfd;\x92\x05\x82AG\xaf\xeb\x18\xe1'P\xfa\xfa\x03\xf5\x11\x89>Z1W\xd2\xd4\x89\xc6KE*\xd3\xa5\x8cE\xb6\x94\xab\xa1\xfb\xbdu\xc9\x92\xfb;l\xdb\xb5\xda\xfe\xe46\xc8\x9a\xa2\xbfE;N\xe4M\xa8\x1e\xa6y8\x9fc\xef_\x0cT\xf6\x9f+\xa2G\xcf7\x16\xe5\xbb[\x87\xbb$\x0b\xa7\x15\x016D\xcf\x80\x94\x06\x83\x17\x7ft\xd1\xe2\xab&\xb3\x05\xe4FFKJ\x11Y\x85T[\x89\x95\xde\xb3\x00\xbaJ\xe1\x11T/\x8c\x80v\x1d\xc3<Y\xdb\xd0\x98\xae`}\xd9\x89\xaa?\x17R\x86\xde\xbe\x91Uk\x93\xac~\xcbp\xc8\x97\x17\xcaJc[\x99\xed\xd0@\xe2\xd4\xbf\x08\x0f\x07g\x11Oi\x01\x87\xccI<\xc9;\x9d\xdcG*\x07`r\x8bN\xa7\xe0\x04oHoA\xb7aE\x89\x17\xea7\xdb\xdb\xec9T\xc9*\\Fo\x13e\xed\xe4&\"c\x1d\x0f\xc3>'\xfe\xdc\xcc\xeepSA'\xd6\xa6\x9d\xef\x9ad\xde]\xb9i\xd6\xf3\xc8\x0d\x9b\xe7\xe85\xf8P\xa1V\xa9\xb4iTF\xf9*N\xabtZ\xd6\xe8=\x07\xfa\x9e\xc8\x07\xf7\xc3\\Db\xc1\x96\x19j\xaf\xf2l\xbdM\xdc\\$bb0\xd3\xb4\x03\xe3}\x94X\xef\x94z\x91M\xcb\xd5x\xd3\x8d;\x7fT\xa54\xb2\x9c]gi\xead\x8c.\xabNzg\xdc\x8a\x19pt\xf8\xf5\xdc\xcd\x81\xa6\xd1\xe7rl\xf0\xf5\x1dM\xa8\x9by\xc7\x16\"\xc1\xcbE\x0dc\xd9\xba\xcdO\xb3\x9c\x0c\xda\x19\x18\xa4\xa6\xea\x11\xaaI\xba\xcbC\x04gXi)eJ|\xb6\xb5gR(y0\x0b\x08Y{\x91\xf1\xda\xbb\x18 I\x9d\xda0\x14\xd2\xee\x81L\xf8y|Ow/JeL\xe3-3\xdf\xaf\xb4S\xeb\x05\xa1\x9f3\xdaA!\x7f\xdd\xb8\xa5\xc7\xec:\xb8l\x02)\x10\x89\x12\x0fh\x0b&-y#\x83\xad\xf0\xbe\xa0HU=\x04K\xf4\x9e\x15_\xe5\xcc\x1d^\x0c\xf4\x0f\xc8\x00\xe3\xa3\xb0W~m\xd4\xb4\xea\xf3(\x1a\x8b\xe1\xacd\x87CAG9\x1ao\x04{\xf1\x12\x8d\xe77o\x15:\xcc\xef7#\xa5W\x1b\xbb\xe9\xd25\xe5<H\xf6MH\xf3\xda*k\xf9(E\xb6'\xc6L7@}\x8a\x92\xf8C\xf77\xea\x03\x94f\xaa\xcf<\xf1#\x8c\x02R\xac\xc0\xc6\xf69\xa3\\l\xf4b\x08\x8b\xd2\xb42\x9c4]P`$\x9e\x88\xddT\x83\x1bZ\xef\xf0L\x90\x1d\xb5]\xd9\xd2\xb7\x96\xfe\x00\xb4D\xc5n\xd6\x9f\xa4\x17\xd5\x07\xb3\xea\x83\xfc\x06O\xd5\x1d['\xeb\x84\xa3\x19\x85\xca\x06^mM\xabA$/\xbd`\xcb\x19>\xc4\x98\xd2\xa9>\xa8\x12F\xfdS\x93{\x9f\x1c#Z\xfe}\x17\xae\x0d\xb10\xe6\x01\x8a\xcekdt\xca\xef:*\xf1\xdc\xf1u\x91\xf1\x91F\x1e\xbe\x87C\xfb\x01\x91Z\xae\xcd)\x9f\x11l\x02J\xb5\x93:\x9e\x83*\x0e\xed\x17\x87\x83\x0bc\xd6F\x0b\xe5]\xe6\xf2w\xc1X;\x9e\xd8k\xf8'|\xc2\x114N\xe1\xbd\xc2]\xccR\xc3\xb4\xd5\x86\xf5\xc7G\xb7\xf4	\xac\xdd;\xeaL\xd3\xec1\x0d\xb2%7.K\xd5\xd8\xbe\xc9\xc3\xf9\xfb\xecA\x9d\xc0\xf0\x94\xf1\xc44\x8fg\xe5\x1f\xe4\xf4D\x96\xa2%\x88\xc5\xd4\x9a\xf3m\xcaQ\xb6 O\xa6m\x97z\x9e\xf8z\x0e\x01;B\xeb\xf2\xc5\\{\x07\xd4\x02\xa9\x9b'\xba\x938\x96\xd7f\x1dT\x9a\x9fpW1\x0f\xccx7\x9e\x7fu\xc6CkCeZ\x1c\xa0\x16;fH\x14l\xa3\xc1\xe0\x98\x90\x96\xc06w\xb8s\x13\x00q'r\x94\x88d\x0c\xdd@\x0d\xa0z\x04BH\xd1f\x07\xe3g\x97cO<\xe64n\xc2\xb9\x9bf\xf0\xb2	\xcb\xc9\xa2&\xc1\xe6\xea\xf5=\x00+\xab/\x9cY\xbc\xfd\x91\x82\xf7\xf1a\x86\xc9\xe6\xd5\x9f\xb1-\x92\xc8AQN\xdfX3\x0f\x1e\xf5\xc3\xdc=\xb3?.\xa2N\xa7\x857\x10\xb5\x1d\xf2;\x17\x87f\xb9\xbe\xfb\\\x9b\x7f\xa3\x1d$\xda7\xa2G{\xbe\xb9\xc2\x1a\xcdd\xe5\x87\xf5i\x06\xd6\xa9\x93\x03\x1d\x86!\x95\xe7\xc9\x82\xfb\xfb\x1c<\xd1\xa8\x14\xd1\xf8S\x9b\xc8[\xd1b\x86e*\xe2&P\xb5\x88\xd5l\xff>\xa7\xe5\x1b/\xdd\xd8\xf3\xc1u\xfd\x90gk\xdb3\xbeu\xaea4$\xda_U\x83a\x05~Q3P3\xbd\xb1\x1bXS\xbe3B\x96\xa1wS\xbd\xd4\x14\xa7s\xfe\xa6\x05\xdd\xb9\xa2(\xd4\x14\x92F7\x92\x00\x1e\xad\xee\x90l([\xfa\xb5g\x11j\x01\xceh\x8f\xb6\xc0\xf4/\"N\x07\xe2,\x92m\xbec]\xd5D_K)\xe18F(e\xd1$\x14\xd6\xca\xb0FC\x1f\x08\x88\xf1q:>\xf6Xp\xdfhHj\xd7\x12\x1e\x9f\x93\x1dS\x81\xdd\x9d\x1f:\xb0\xf8\xb7\xf1\x1a\xae\xd9\xa4\x13o\xac9u\xf5j]\x8e,\xf9\x06\x8fY\xebY\xe3Rc/\x1f\xeb\xb2b\xbc\xf1\xd8\xd6\x87\xee\xb2\xfad\xab\x9b\xbc\xf1\xb6\x89:\x9dX\xe3\xf87\xaf\x04\xa8\x0d\xb8O\x11+\xc3\xb0OR\xeb\xd1\xa0\xc00\x97^5\xc9:\xbd\xc4\xc9R\xa4d\xb0\xcf\xd9\xebz7\xc1\xd1\x11\\(q\x1f\xc1\x19\x90\xddX\xc6\x87C\x1fq\xb9\xcbQL\xebo4\xe8\xc5:27\xfc2\x8d\x85\x0b\xbd8\xc2\x94\xd32\xd6\x92\xdf\xcf\xc5\xb7\xb1\xfb\xfd\x9c\xf5zK\xf9\xfd\xdc\xe8'\xfe\x90\xe7\xd4Re\xacS\x91\xcb\x90lnE\xa1\xf5al\xbc\x95\xc8_q\xc1\x08\xc9\x0d\xe7C\xb9\x88rPq\xe0\x13\xcd\xa3R)\xc25s,62l(\xa6B\x16\xfa\xaa'\xba\xa5\x10\x83_t:Fd\xc2R\xdb\x85\xfc\x19\xd4ELd\xbe\x1c\x15c2^Mp\xff\x9a(\xeeK.\xa0\x80\xacny\x13\xe2mE\xa9\x19?99\xfe6w#\x01\xea\xec\xe6\xca\xbe	\x16\x84\xaa\xb7\xadk\xf7\x9cq\xb3\xcb\xbb\x18\x03\xc2\x15E\xec\x00\xf4.-\xe2iDXJ\xfa.\x90*\x96O\x99\xb93L\x04\xb3T\xbe\x12\x9e\x81{1\x1c\xe2ws\xae\x1e\xde\x9c\x9e\xd0h\xb7A\x15\xd5\xb6\xebr\x95\xc6\"\xfee\xff\x8a\x1d\x8a\x83~\xc5N\x7f\xbc;\x952\x98\xa78\xc4\x89\x9e\xc7S\x91\xcb\xecd\xf2p},0\xd5\xa1\xc8\x1aS\x9d\xb5Mu\"\x9d-\xf8?\x986\xaf`\xa7A\xde3\xba\x93\xc3\xcd(\x19we4J\xc6\x02?+\x07\x97\x1cT\x15I\x9e\xfd\"\xdc\xba9(0\x92\xb4s\x8d\x0b\xe8\x85.\x80\x19\x00\xd1?\x91\xa3\x85X\x8c\x87\x13\xd4\x86l\x1c	a\xb4\x7f\x88\xf22\x9e\xc0\xf5\x1f\xe1\xf5\x9dU<\x05/{\x14\xfbP%\x00,9\xca\x9d\xa3\xb6\x9a\xa8fbS\x0d~\x85:\xcc(\xa3DK\x83I\xe3\x00\x98\x8e\x12\xda\x84\"_JRV\x9e\x1d\xf3\x9f\xe6\xe4(3\xea\x8f\xc7\x82~\x0c\xc6c\xf1/\xa8\xdd\xcd\xba\xa9\xf4\xa3\x19\xeb?q[\xe0\x13\x0bZI\x87\x1d\xff\xe0X\xad\xb8\xb8\xb3\x9f3~G\x88\x9bP\xf7a\x05W\x8f\xcf\xf7\xd0\x18\xfe6\x7f\x04\x9a\x11\xfd\x91_\xd0\xe0^\xf6\xecR7:\x13\xa1n1ti\x1f\xde\x183\x15\xeb\xfc&\x03\x05\xd7\xa9\x1c\x81\xabW\x8e\x08\x97\x9e\xd8\x9cZIz{\xf7\xa2\xf4\xad\x03\xebp\xc05\xb9\x96\xc4\xbaZ\x0f\xf4\xdcN\x97{\xb6\xb7\x88d-\x1d \xc2K\x9c\x00\x17;7:)Eo\xe5(\x1a\xc3\x16^,\n\xf7\xf5;\xff\x87\x1f?\xbc~{s\xf3\xe1G\xff\xe6\xf6\xd5\xed\xbb\x9b\xdbw\xafkR]\x98z\xd6\xe5\xc9\xd6\xe7\x1c\xf8[\xd6\x958\xf2\x1f\x1b*\x06\x0d~\xf1\x11	/\xddFZ9\x12\xb67u\x82\xd3wG\xd1\x8e\x04T\xfa\x96\xc0XdrT\xfa\x80\xca\xdc\x8d\xe1\x88!`\xa6P\xe7\x87\x0e\x87\x95\xdb<\xe8El\xe2\xb0|rg\x89\x84{\xfbws\xf2Tw3\xf9\xafkwoi:\x83\x90\x05)\xf4$\x8cEy\x10V\xd6\xe5G\x11\xb1\xc4B_8\xa8::D4c%\x16\xb2\xa8\x1e&\xb2\x92\xac\xa7\x87\x83\xca>\x95{\x84V\xde\x1f\xc5\x1a[\xb2\x80\xbaS\xac\xc2u\xb0\x07\xbf\"I=\xc3\x8b'\xf8\xec\xda\xdd\xcd\xc5\xcc\x13H\xd4\xca\xb1\xcf\xae\xdd\xed\\\xac\xbd\xe3\xf0o\xd7na\xe6\xf5}\xb8n\xac\x07,\xbbX&\x886\xc4\x06tT\xaf\x9by\xf5\x92Z\xad7\x8a\xea\xf1\xd7\xaaIcr|3w7\xac\xc2\xbd\x98(\xe6\xe5bs8\x84l\xde\x92\xc3\x0c:\xe4\xa0k`4.\xa0\xe3\x05\x1ap.\xd3\x9a\xb5x\xe6\xe9\xd0ey\xa7\xb3\x9a\xbb\xf0\xca\x98+\xbc\xca\xa3\x89\xc8\xfe\x009,\x93\x9e\xbf]\xbb\x8b\x96\xb6\xc1\xa0\x06[\x85,~\xe0\xcaq1\x1bE\xe3N\xe7o\xd7n\\M\x83)\x17UG\xccl\x94\x8e\xd1\x0f\x88\xa8\x81\x8a\xa6\xe5R\x197?\x86\x02w\x1d\xc1\x05$\xe4\x9d\xcb6=\xeb0/\x10	I\xbdwC\xf1~\xeeF\x1e\xfe-=\xcf\xf3\xc4\xc3\xa8\xf4\x97\xd1n,C\xea\x8a\xf8\xdb\xb5\xfb\xd0h\xfaj\xee.0\xd8%:\xddG\xa7=q7wgb\x01\xc0\xce\xc7\xb9\xbb&Ys\xee\x89\xfb\xb9\xbb\x10}\xac\xf8\xa9F\xe8*\x96r\x04\xaf+g\xe7\x8cE\x82\x07\xf6\xbd\xd2\xa6\xc7c\xb1\xf9/\xf9\xaa\xa6n]\x14\xf23\xf0T\x83\xde\xdf\xe6n\xe8\xe10\xcdk\xca\xf1\x08\xa6jbS\xe7\xc40\xbc\x1b\x8bm\xda4\xd9\xa6\xc5r\xb4\x01\xdb\x94\x88\xe2?b\x9b\xe6t{\xfc\xb7\xd9\xa5\xf9\xdc\xfd\xff	st2O\xb9\xfc\x1b\xd1\x18\x9a/\nF\x91\xd8LN2\xca\x89\xc9\xc9a\xfc\x94[L\x0e8\x17J\xf2\xec\x17\xe1\xd6\x057CIZ\xe9A\xf5\x0ez9\x00*\xdc\x0d\x18\x9eM\x83\xe1\xe1q\x9c\xe0\x03(iq\x92\xc9\xbf\xcd\xbf\x9cc\x96\x88\xa7Y\xfcG<\xcd\xdf\xfe\xaf\xf14MF\xe6\xbf\xe1c\xfe\xf6i|L\x8b\xa1\xe5f\xe9\x89J\x1b\xcd\xa6CL;&\xcb\xb3q\xba\xcbE\xb4\x8a~\x8c\x1f\xa2S\x1f\\\xcbdl,`\x81\xfb>d\x1dg\x1d\xbb\xb6\xf4\xf6\x93\x13e-@\xbb\xea1\xad\xd5\xadT\x85E\x84\xde\xe2\x87<{\x88\xa7Q^\x99%\x99\xfb\xa61\x8b\xd6\xa1\x8a)\xde\xc2\xb6\xe1\xa8T!\x0f\x81\xc9bH\xabX\xce\xdc\xcff\xae\x07Z\\\xe3\xe8t\xd5\xa3\xcb\xf1\xd1\xf3\xa3\xb4\xc4\xb1\x0f\x0b\xd0\xd3L{t6 rN,\x01\xfc\xb8\x03\x8e*JFn \x10\xfc\xbdL\xf6\x06\"\xc4?*pE:\xecvY7W 2\xc5\xd8\xd75p\x89a\xf1\x15\xc9\xee%N\xd6\xbcBnQ\x11fQ<A\xc8\x068'\x86\x066\x19\xd1.\x921\x99A\x0b\x15S6\x1bv\xbb\x8bj\x04&2\x1e\x85\xd5\xb7F\x0b`\x12\x8b\xa9*\xd6h\x81X\xa3\xb9s\xd9\x1f\xce	\xb9l\x8e\xef\xd52\x8e\xe6(.\xa5\x9cx\xfb\xb5\x9c\x0f\xef\xf3(\\\x1e{\x03)\xe5\x1a<\xeb(\x1a\xc3\xee\x13\x7f\x91o\xa2~\x0d\xc6\xb2\xaf~]\x8e\xe5FD\xdd\xae\xc7\xea\xa5'\xe5G<\x97ETu\x03\xa6f\xff\x96u\xb5^)g\x0c\xac[_k\x1b\xeb\x9a\xf8 \x95s\x06WoY\x10O\xa0I~\x82\x98>j\xf9\xc0P\x035\xf3\x9atSXwa\xdf\x8f\xc6:\x8e#\xef\x17\xf9\xa3\xebY\x01\x843\xbd\x08\xba\xdd\xc4\xd3\xb1[G\xc9\x18\xd8p\x82\x8c\x98\xcd#\xd8'\xbf\xa8\x12D\xe1	\xa0`1A\xde\xdcB\xc5C'\x81\xc1K\xa86\xad3\xb6,\xc2\xdfPX\xff`\xa4\x96\xb5\x82\xbfQ\xd6O\xb7\x05|I\x05\xbf\xd0N\x8bD\xaaf\xc0:w\xcc;\xfc\xab_)\x0b\xd1\xc49\x8eE\x94N\xb2i\xc4\x1f\xd8\xab\xe9	\xb4\x11\xd7@\xc4e\xb4\x821yp\x89]\xb3 m_~\xebn\xea1\x82\x17U@\xe0\x8cp\xdb4f\xea\x0d1\xea\xd5q^-w\x06\xb0\xaf\xfc\xb9A\x01\x18\x98J\xc4\x98\x86T\xf6\x87\xe9\xcbh\xd8\xed\xa6^<J\xc725V\xd1Y+\x89\xa8\xcc*\xd9|\\u9\xf2,j\x11\xff\x11\xb5\x88\xd3i<\x89\x8a\x1a\xb1\x00\x1fY\xb3\n\xe4\xa5\n\x1f4\"\x19\xd6\xa2\xc8\xbdl\x94\x8f}\xae\xc6/\xb2\xbc\xb4ZhE=Um\x0cE\xe4\xf5\xf4Ox\x05\xeb\xe3-3\xdeH|{8\xf1\xf1D];\xb7\xc42+ey5*\xc7\xc1\xc8\x8a\xdb\x93\x8a\xac9\xc4\xec2_\x9f\x1c\x17\x9d\x18\x8d\x05L6\x99\"*BV\xd0\x127\x13\xb6\x91\xdfoV\xf7Q\xee\xbf\x7f\xf5\xcb\xdd\xcf\xaf\xae\x7fz+\x16\xa0B\x13\x19\x8e\x92\xaa\xc3\\\xc5T\xf6\x87\xd3\x97\x93a\xb7;U\xa4t\xc6J\x1e\x12\x7f\xdb%FS\x82\xe61j\xeeY/\xf2\x86\xeb\x97r\x03\x8d\xac\x9c\x89\x8d\\\x8bE\xed\x1b(q\xcc\xd5\xd6[h\x02\xb5\xe7\xdb\x1e2\x04\xb9H\xa3\xa2\x8cX\xec\x8b8xl\xe2\xa7ob\xd58\xd6\x14\x9c\xd5jd\x8bY,}\x9c0:\xe4s\xc3\x91RK\xbd\x85\x89\x96\xcfpR\xa9A\x16N!\"H\x01\xfdC\x01\x9e\xe3\xae\xf3$x\xe2t\xe1\x93\xd00\xc3\xac\xc5\x1en2\x88u\xf3o\xcbU5\x189\x03\xe0N\xd0\xbfc\xd1\xa0\xa1A\xbf\xb25z\x1b\xe2\xa5\xb2\xdf\x04^\x07\xdb@*\xb9a\xf0\\h\x8bg\xc3\xf41\x02\x84\xb6\xc5#`\xc2Y\xa60r\x82\xc1\xe0(4\x84R\xb0\xe7\xba\xb8\nb\xf2,\xb8\x07\x9bC\xa93\x1e\x95\x88~\x17\xe5\x85\x1c\x8d\xcfb\xe8\xeb\"Oj\xac\x00\x11\xe9\xa3y\x975\xd0dX\xfc\x8f\xbaq\xa7\xd1fg\x17RZ'\xd6F,\xb0\xfe\xc9\x19b&\x93Qlv\xafX\xcb\xfep\xfdr\xc6\x8bz\xb8\xd6pbs\x99\xfb\xf3Z\x98\x80\xd9h\x8d\xa8\x06r\xeeo\xc5\x83\x9c\xfb\xbb\xbe\xd8\xe1\xcfp\xa1\xd6\xe9h%\x1e\xc6@Q2O\xdd\x1d\x04\x93UE\x0c\xac\x8b\x8a\x0c\xd6\x17\xc1am\xc5\x9dxl\xf9 \xfc\xc4\xee\xabt\xd2\xc5\xa0x\x0f@\xbc75\x8c*\x8d\\\xf5^\xde0\x96\x11\xdf\x87hT\xc2\xe9\x14r\x8e\xc8\xdb\xbf\x93S\x8d\xf0\x98?\x0e\xb7\xf4c\xf6\xc6mDu\\\x88\xa2\x0c'\xcbh\xfa\x81C\xf3\x05\x13Q\xac\xb2\xac\\\x04\xfe\xf3\xea\xddM\x95D\xbf^\x936!\x8413pP~\xbf\x0c\x10\xb0]A\xdaLo\x0c\xa4\xcd6x\xf4\xb7\xbd\xf7\x80J\xf3w\xfd\xee\xa3\xbf{Fg\xcf;Rio\xf5\x8f;`0!\xe5\x9d'\xb68e\x81\xdeB\xb8\x97\x7f\x9d\xbb\xdbS\xa3lQV\x84\xdf\xdboY9c\n!\x88\x00\x19Q\xd1\xfc\xbe\x93\x19\xa4\x0c[\xf9\xce\x8a\xbb*\xee\xac\xc7\x81\xf5y\x1a\xb3wb\x96\xb9\xdbf\x00\xcc\x96\xa1\x823\x83\x87\xccw\xe2\xe3]\x16\xa5\x9a\xff\xdb\xdaT\xb6\xc1\x96\x897\xb5,\x16\xa0\xd90\xcd\xdc;\x9f>#n\xc4\x1e\xf0\xb8\x01/\x01\xecU\xc7\xbb\xa2\xb3\xe7\x1b\xb6\x7fV:$^D\x88X\x9e\xaf\xc2\xc4\xf1\x0e\x07ZeD\x0cy\x81\xa9[\x04\xea\xfb\xb9](\xce\xb3\xa2\xd4\xfd\xa1\xabP\xed6G\xf1\xe6\x1cV\xa1GXe[q[\xcf\xc0\xd3\xf2\xa4\xe0\x19s\xb3Q<V\x03\xc3a1\xd8O\x81\xcd\x0bI\xabF\x97\xe7\x93c\x8e7\x9b\x89\x9cB\x7f\xdf\xa5\xb3\x8c@\x1b7\x14\xd4IL\xe4\xc6\xb7\xc8\xeb\xb0`\xe3\x03M\x15\x1d\xe0\x0e,\xfc]\x17^F|\xb9\x86`p\x88\xbdr\xc3\x18\xd4Ls\x14\xf7\x03\xceR$\"\x15\xa92\xa4[\x01\xcc\x04\xa6\x1f\xb4\xf1<\x83\x98R\xa5\xab\x04\xbc\xe2>W\xaf\x98\x82y\xa7\xc0\x1e\xf6'e\"jtu\xdabv\xa7\x90\xe3g\x85{\x1eu\xe0\xeb\x1d\x98v\xb7F\xbf\xcd&\xaaN\xce\xda\xb1y\xea\xae&R\x88L!\xfe0~k\xc3\x94\x06[f\xeco\xc2\xfc\x9a5\xee\xc4Z\xc5\x95\xa7\xed0\xb5gE\x86\xa2\xe1a\x9bk?^\x17X\xb2\xf2MF\x7fE\xa6\xdd\xcfT\x1cbJ\x1e\xd8\xc9\x7f\x87H\xa94	=\x94\xea!\x8f\xe7\x05\xcd\xaaHZwR\x13\xa7\x9a\x8a\xe8\xd9\xaeGD~\xd1\xba\xea`\xaf\x06!\xfe\xba\xae\xb8\xf8\xefg\xc0\xbew\x0b\x0e<c\x82e\x89\x0c7\x1a\x86\xc3\xad}\x98\x8c\xd1\x949\xf7\x8f\xe1#\xdd\xc1\xc0\xfb\x90\x0bL\xdc\xc8n\"7\xc5\x862\x84\xb8&\xa5#\xb7\xd47'\xbcqs\xaf7\xf0\xfe\xa4\xc3 \x8eU07\x0b\x002\xd4\x87\x1d }lk\xa0\xd0cIt\x02\xe5y\xbdPb\x15\xc2\x10*UK\xf4\xaa\xb6T\xbd\xf3\xc0\xf2\xc5&\xbd\xdf\xe4E\xe9\x88\xbb\x07\x80\xf3e\x19\xc3\x88}\xf4\x8a\x8b\xd6\xc5R_\x16\xf0G\xd0q\x90Gi\xa0.\x9e\xc7a\xb4r\xd9\xcc\x18\xe3N\x1c\x9d\"\x00\x99%\x13\xcf\xf8\x85LE4a\xeb\xdb\xdb<\x8a\\\x15?\x16jQp\xb3G\x91\xad+\x8f\x98\xe9	\xd7\x94G\x14\xcd@\xb5\x9f\x81'0\xe9?\x84y\xb8*\x82\xd3u\xf2\xaf\xf7\x95\xc9\xbb\xde\xb4*\xb3rT\"\xe2eY\xcek#\x08\xce\xeaz~\x99GT\xf2\xfbl\x1a}\xbd3\xc13]\xc3\xff\xc2\xa3-\x8f\"\x1c\xc4\xa0\xac\xf2\xcd\x84;\xe5\x89\xe8\x0f8^R\x9e\x06#\xe7\x05\xb1\xb3\xf8w,\xf2p\x1ao\n`Z8\x7f~\x01\xfev\x92d\x93\xe5c\\\x90-\x1e!\xff\xbe\xc2M,\xf8\xb2\x0f4\xfbW|{]\xab\xc0\xb5?\x18?\x9eKQ\x94q\x92\xdc,\xb2\xc7\xdf\xa2<\xbb\xd9\xacP~\x11\xcf\x17	\xe8\xc0\x0fY\x12Ov\x08\xf7XL\x80\x12\x9c\x96\x8eH\xb3)\xd8\x83\xc92p\xf2,+o3\xf4Y\xfb\x85\xd09\xf9M\x96\xa32\x8c\x02\xfc\x00\x98\x07&\xe9h\x148h:\xbc\x1d5[\xad\xfd\x05\x07\xa2\xae-\xb6\xf8\xed\x98\x84\xda\x0eh5\xf9\x04\x07/*\xcfT\x8b\xd9>\x1e\x859\xe2\x83\xbd\xedK4\xa8\xb9\x1a9\x8f\x8b\xb8\x8c*O\xc5\x81]G\xd5\xf9`o2\xc0\xbc\xe41\x05Lu\x95\xe8\x7fy<Z\x0e\x0b\xc4\xd0G\xdbuH7 \xe7\xd4\x7f+\x18D\x9f\x9f\xf5\xeaz\xd1o\xb9\x90L6\xf7\xf1\xe4\xc3\xa6tHT\x07\xa5\x1a\xad\x0bD\xc2\x15E\x96\x97j^\x1c\xba\x10\xeb\xe5no\x06}\x19 n_\xef\xe7\xa3\xa8m\x8f\xdan(\xaf\xeaye\x19\x94\xb2^\\k~\x9bbL\xb5\x03\xb0 \x86%\xe4h\xe0\x9b\x0f\x07\xb8\xfa\x13\n\x07\xc4\x91\x9e\x01\xa2\xd7m\x91\x91\xa7u@\xd3\xa5\xdc\x7f\xff\xe1\xfb\xb7:t\xf7\x9b\xb77\xaf\xdf~\xff\xe6\xd5\xf7\xb7\xf5\xd5\xf7\xea\xfb\xd7oon?\xfc\x188X\x08E\x99\xe5\x8e\xb8y{\xfd\x0dbc$3hi\x97\xf2R\xac\x97\xf2\xb9\x98/e\xbc\xaa6\xf6p\xbed\x8e\x02\x0b\xb3\x12\xcd\xd2=Jd\xa2\xe0;\x17\xd6\xb7\x8cD\xe4\xaf\x01tI=\x15Y\xe5\xf4aA\xda\x89B\x16:9\x9aP\x12S\xeaF\xf4\xf5aR\xe9\\9\xb8\x1b\x9d\",\xf0\x8a*\x1e\x15\x0c\x97\x1d\xc5\xd3\x1b.\x0e\x07\x98\x1beI\xe4'\xd9\xdc\xad\xbdd\x11\xb8$\xd0Z\xd27\xd1^\xab\x0c\xb7\xa6b&\xd3\x95\x1b\x89>dlk\xb9\xb1,\x8e-n\xb8\xc1Y\x0e\xa72w\xc9\xb6\xe2\xafY\x9c\x06\xce=V\x9d\xa3\"\xab\xbbS\xa9\xd9_\xd8\xd5_\xad\x83\xd4\xb5j\x8d\xbb\x8e\x7f\xbeb\x00\xeb\x12\xf4\xfe\xe10;B1X^\xb9I\x15Ys\xe2\x89D\xd9\x8e\xfb\xb9\\\xf89\xfc\x0f\xdd\xc4\\\x1d\xf2`\xe1\xe7\xc7\xa3\xc8l\x804\x141\xa14\xa7\x9e\x178\xd9\xfd?\xa3	b\x88`\xd2\xb3\xd9\x93)}\xb1\xd3Q\x7f5d|3\x17\xdb\x97s^\xfb\x89J\\\xb9vS&G\x91\x9d|\xb8\x96\x81\xadI\xa6\x94\xd1\xc6\x10\"\x02\xe9fb&\xf88\x9c\xabI1ao\x94\xeeH\xdd@\xe7p\xe1Ul6'\x8b9d*t\xe2\xaedV+h\xe8\x96\"\xda\xc6\xe4\x10\x82\x85\xb7\xb0x(\xdcDD\"C\xdc\xbe\x93\xa5\xdc\xbe\xbekK\xfbd\xad\x1f\xc5|\xe9g\xe9[\xa6\x9d\xb2\x85\x03^\xc4\xc5\xd0l*\n\xa7z\xab\xc9\x04\xf1M8:*\xde	Q\x04\xd4\xae\xebt\xdcHmD\xac1N\xb4v\xaf\x0bgOa\xee|\x8e\x17\x84+\x04\xb4\xa52\xa2\xf4L\x91j\x0f\xfa\xecah\x0d\x94\xe3\x05\xe5\x85\x94\xd3\xa5\x0f\xca\x03\xe3\xf9\xf3\x854\xfdw<\xb0U\xaa\xe7\n\xd0\xb6\xea\xb7\xb7\xff\xf4\xbe\xc2\xb9G\xf7\xb5l\xed_Y\xdd3\xcd\x17\xbf\xd3s|\xf2\xd1\xfa\xd8\x981\x17vo\xb9\x927\xdc\x95O\xaf\xa3\xea<Ua/e\xd9\x94\xbc\xea\xe7'\x16\x8e\x08\xdd\x92-^\x08\xf4I\xca\xe8\x8aC\x08yAt\xb4\x04S\xf8fEQ\xa0{\xc8ZD6y\x8d\x0e\x1d\x0e\xd5b\xc0\xf3U\x18X\x85@\x95t\xb9\xa2V\x9b.d^'\xf2\xd6m\xbb\xfaW\xab\xd8Pr3?d\xf8\xa1\xfe1\x12\xa5*?\xf8aox1\x80\xb7\x95\x96. \xd8\xa3t\x18\x0d{S\xef\xb6&\xeeb\xc1\xe3\xe6h\x06\x0eb\x0b\x1a\xb9E\xa7\xe3.`-Z\x7f\x8b\"\x8bg\x83\xbf\xf4u\xe8\x13>\x1e6~\x94N\xa9\x86\xde\xc6\xafxC\xbeF,:\x1d#c\x9ex/\x17\xb5\xb6M\xebG\xd9\xc0\x1b\xc6\x99;e\xc1\xc9\xd4'\x18_\xa2\xbd\x87\xc3\xfe(BQ\x88=\xf3\xb2\xb7\x10\xa9\xe45\xd2\xc6\x92\x95\x84\x1d\xdc7e\xf6\x1a\x97\x95 \x12\x9b\"RV\x9dTWp\xd1g\xae`&\xd6\xd2\xb5\xdb\xdc\xad\xfa\x02\x0d\xfd\\\x89\xc7'Y\xe1\xae!e\xa4\xa7\"N\xf1\x84\xc8D\x95t\x02@\xd8\xa9\xebh\x0e\x12\xb2\x9b\xbe\xd8\"\x89\xb8N\xc7\x1b\"\xceR\xc1>\x97\x0fW\xee\x0c\x82\x8f\xeeNl\xe5\xfa+\x1e\xceg\x97W\x0c\xa7\xc7\"`/\xd8v:\xda\xba\xf1B\xca\xed\x95J\x97\xf8\xa9j\xe8ww\xc2\xaa\xa0\xd3q\xb7\x92+\xc1a\xa5~!?!\xf7o\xfc\xbc\xd7\x92\x9f*\xf5\xbc\xc0\x9da4\xf2.\xeaE\xf7\xb7R\x7f\x1c\xf0\xf3\xec\x0c\x82\x11tX\xa4\xa5$Q\xaf\xd0\xc3`\xdb\"\x95J]\xa7f\x8e\x80a\xd1\xe6\x9b\x86=N]\xe3\xf5\xa2\x0d\x99\xee\xe4\xec\xe9\xbc\xbb\xf1'[\xf1(gOW\xf8\xb9\x1b\xea&\x98A\x17\xa3;\xf1\xc8\xb2\xa0{\x8c\xb5\xba\x038\x9e\xb8\x91\xfd\xa1\xbe	H)\xef\xaf\xdc\x1b\xd9[{/{\xf6H\xddt%?z\x81S\x02	<-\xed\x02&oo\xedUC|u\xd3\xd3o\x82\x9b\x8fT\x98\x92\xd6\xa6\xe2\x13\xee\xf1Ey\xaf\xf7\x0e\xf6Q5\xaa\xda\xca\xc3\x117L\x03\xabc\xb5A\x02a\x91U\xc2a\x9ccec\x8b8\x9e\x9d\xb0)\xf5\xb3\xa1Y\xfc\xcc\x04\xc5\xd31pp\xe9\xb5XUo\x9fY\x07\xae\x0b]I\xcd\xc6\x00o\xd5\xa1\xe4z\xc7a\xec\xc7\x85q a\xa8Q\x17PQU\x1coj\x9b\x08=+\x05\xd7\x8d\\%\x98\xd6\xe9\x1c\x9a\xde\xf1{s,\xd8\x12\xe4\xcc:a =\xa6\x82\xd5!\xd4\xccjN4\xe4\x05\x8aj\xbc\x12\xf9\xa37\x9c\xe7M\x94\x1f#\x9ahjJ\xce\xa8F0\x0f:\xd7\x93\xcc\xd5\xae\x94\x93\xc3\xe1\">\x1cb\x03~\xe2\xe2^b\xd0\x8e/$\xe4*)\xfe\xc0v\x80\x00\n\xe8t\xf6\xe2+7m=\xa8\xe3\xea \x808D\x14Z(\xc3\xce\x0fo\x137\xb6N\x0d\xae\xc4\xf3\x82\xd0MU8\xe8'\xb0R\xd0v|\x90\x97\xc6\xe0fP\xd9p\xa1\x1d9\xff\xa0\xd6\xcc;V\xea\x9e\x10Goi\x18\x0b\x9c\x16Zb\xcaI\xc0d\xa5\xbe\xa8~k\xb1\xb1\xe1\xd7\xac[\x8ed_+\xe5\x11\x8f,>_vd\xc4\xaes\xb6\x9c-\x91Eu\x17\xd4\xf1\x1c\xf4m\x93N5K\x1c=\xd12\xb8v\xe9\x01\xc8\x1a\xb0\xf66\xed\x0c\xd4T)\x8dJM\x95f|W\xcd\x92\xe9k\x96?A\xc4\x04NZ\x0f\x0d\xb6\xa8\xb5,\xe2\x9a\xce\x0c\x97\x95\xa9\xebU\xe3\x98\xaaU\x931dP|\x85\xbf\xcaQ\x04\xbf\xa4LUJ4J\xc7\xdeQYP+\x95X\xa7\x83\xa7\x88\x9f\x0e\x07\x16\x82C\x12\x8aHh4\xa9\xa9\x91p\xa7F\xa0\xfd\xe0\xa6T\xb5-\xc7>\xbaS\x18\xcc\xeaV\x99\x95\x9c\xfa\xd3h].\x10\x92.\xf7\x1f\xe2\xbc\xdc\x84	\x05g\xb8\xaa?6\xf8\xba\xc6r\x0d\xdcz\xee\xfa\xfa\x13\x0b\x06\xa1\xb3\xb3\xc0\xd4\x95w\xc2]\x96\x12B\x07]0\xcc^Q\xc4\x8c^8\xedY5\x853\x9d\xc2<\xf8w\x95@	{-\xcc\xa3\xb4\xc4\x83w\x1c\xb6\xd6\"3Qo\x19\x11\x1b\xfen\xc6\n\x92z\x0e{/\xd4\xdfx\x8d\xbaxg\xb8\x89\xd8\x90\xa3R\xea\x1b*V{\xe0\x0d\xa5C$\xd68\x9e\xd3;\xd9IA\x9b\xa0\xaf\xb9\xc9\x9a\xf0lR\x93Y\xebWi\x85\xdb\xcd\x1e^\xd0-Q\x0b\\\xcd\xfas\xe7\xb2J\x1d\xf5\xc7z\xdf\xcf;\x9d\xb9}L\xe8xl\xe6\x92\xe8\x89\x93]tNO\"\xacr\xf6\x8b\xcakPD\xd5\x14\x1bI\xf1\xc5`\xa8A\xb9\x8c\xa3\\E!Z\xf6\xbaZ\xef\x17\xb1Y`\xd5J\xab.x\xb4\xdfJ\nOgHi\xc5\xe9{l\xf9\xa2\x85\x97\xac\xe2\xb5$\x98\xf0\xa4\xf1\xca\xfa\x1a\xac\xc83\xac\x03\x96*\x0f\xdbU\xdb\x95+\xdf-p\xe3\x94\xcd\x86\xb1`\xc4/\xd7QM\xa3x\xb7\x87\x83sw\x9f\x84\xe9\xd2\x19>\xc6\xe94{\xf4\xb3u\x94\xba9\xf0\xf0\x8f1\"\xb0\x1f=\x06\xec \xb5]s\x9bYo\xec\x9dv\xae\x80'\xec\x02\xd5\x0e\x89\xbc\xb3Ed\x04Wy38\xd5\xe4\xe2`\xd1\xc0 g\xa7\x90[x\xde\xa3Q\x1f\xe6?\x9a/8\x02Qv\x95U\xdb\x06>pT\xf7\xbbip\xf2\xa5x*\xd4Pb\xd6\x82\x12wc\x1626\xb1i+\x9bz#\xd5w\x8d\xac\x8b\xafY}\x8f}}\xd91\x00j\xae\x18|m&\x01\xe1\x8b\xdf;\x11\xf2\xc5\xe2_y\xe9\xa6O\xd3n\xf643w\xd9\xf0\xa5\x8c\xfd\xbc\xd3	\xbf\xc2_\x98x(\xda\xb6Z\xca\xb6n\x0e\x8dg\xcaji\xdcM\xd4_\x8c_\xc3\xcdDG]46\x835@V\x1a\x14G\x14\x9b{Fh\xe5\xcf9\x82\x0c\x0d\x83\xd2\xaa\xcc\xb2\x16\x7f?qK1Z-\xc7\x8c\xb7b\xccB\xa2\xfa,\x12\x10C\xe9O\xb5\x07\x9c\xbc\x9d\xb8\x99H\xe9~\xee]9y\x96$?\xad\x9d\xc0\x99\xe6q\x92\x80\xe5\x03\xb4QC\xf9\xc2\xb9-\x17\x08kX\x0c\xefg\x8d\xca\xc3\xff\xb7\xa3\xf2\xa0F\x05\xa8\x17eE\xa9\xa5\xee\x068\x0d\xd3TS\xf3O\x15\x99v\xfe\xd7[_\xda\xa7\x04H\x87\x1e\xe9\xdd\xd2\\\x90\x06\x7f\xe9\x0f\xd7\x85\xfb\xe0~\xf6FT\xe3\x81\xb0WH\xb4\x87V\x87\x9d<\xd1\xb4ZV\"L^M\x00\x00s\x055\x1aU\xa5\x9d\"\xc4\x02\x18|\xb9\xa9\x1c\xf5E:\xf6\x04<\xc3\x0e\x077\x92\xa3HD|1d\x05\xb8\xc6\x93g\x90E\x1dsK\xe4\x95M\x7f&H\x95\xfa&s#\x18\xcbA\x04\xab\x1e\x06c\xbc\xd9\xe0\x01G\x9d\xc8\x9f]\x82\xd1\xa4\xc7\x01?N$\x9b\x13:\x954\xc1\xf1\x9e\xee\x96:z\xa4-IA2\x9f\xe5L5*\x96\x96\x03AZ,-\xac\x9b\x88\x15\x13\x0f\xba*\xa8gX\\s!\x1f:\x9d|\xe5\xce\xc5\x83\xea\xf0\x16&\x80\xee\xdc\x1c\xce\xb5\x91\xbdPvr\xa5u=\xf1:\x9dm\xb7\xcb\xb3z'\xe7\xd6+\xf1h\xe6\xd8\xbd;\x1c\xb6\xde\xd3Kq\xaf[\xf3U_\xdc\xc8\xb9V\xe0\xbb\xf7W\xbdA0\x80\xad\x93\xbb\xe8m\xbcg\xee\xcd\xe10\xf0\xc4;\xddd\xa3At<qk\xfa\xd1\xd0\x0e\x92M\xcd\xbb\xabA\xd0\x1b\x88W\x16\xf7\x86M	\x98-CpAXpP\xac\x0de\xb5\x9b\x9d\x112\xf7]\xa7s{\xf5\x18\xa4O\x1f\x87\xd9\xcb\x199\xb1\xcd\xa0[\x8d\xbao\x9eV\x16\x10\xd4\x97\xde\xaa\xea\xc0Bn\xba\xef\x9f\x86\xb0H\xe9\xbe\x7f\xea\x86\xddA#:\xa7\x1e\xf79\xdf-\xfa$p\xa3\xf5`%\xd1\xb2\xd0N[:\x9drN\xec\x9c&ciY+\xecC\xac\x13r\x1a\xd5k)\x88\x84\x96L\x05\xb1\xa5\x8e}'&\xdb\xa0\x10\x93\x1d|E\xfa\xc1B\xe4\xc1\xe4Ha\xb0K\xb3\x02:\x9d\xea73p:*u\x7f8u\xcb\xf6\xa5\xf2\xd0\x95\xaf0\xf2\xdd\x07\x10&>\x8e\xe2^t<b\xf0\xefU\x0d\xaf\xe5F\\c\xc0\xc4R\x9a\x90\xc8\xc3\xf5i_\x96v_&U_&\xdd\xe5\xf9\xde\xbc\x16yp}\xf4\x8e\xaf\xdc\xb9\x98\xa0\x15u\xf2\xb2\x00y\x89^\xd5\x12i^\xb7\xcbZ(\xae\xb1\xb8CB%o\xa8\xbd{\xc4\xbb\x04RXg,\xee\x1b\x19u\xfa\xcdR:\xd1\xff\xf4\xff\xa7\xef\x88\xf7K\xb9\xb7\"\x9a\xd6x\x17\xd6^6b[\xf1Y\xbe\xd7\x8e\x87\x01\xcb\x1c\xacZ\x80V\x11\xf9[\x00\x00\xfa;\x8d\x0e\xa0\xa3\x913@\x00o\xb5\xa3\x08\xd7q\xa0*\x0bZ\x8d\xb0\xeb\x8efG\x85\xf3\xb8r\x93\x15lJ\xe03\x19e\xed\xadn\x1a\xef\x9dk\xf8<\xca\xfe7\x1a\xbc\xd1\x0d\xae\xack\xcf\xb5\xfb\xe3\x03]\x95\xff\xdfh\xf6B7{\x9d%a\xde\xbe>`)\x84C\xac\x8ag\x00\n\xf1J7\xd35\xa6\xcf\xda\x1d\x9f\xa7\xe2	\x0e\xa0\xafp\xec\xe0\x8e\xc2!F\x10\xef\xb0\xd9e\xfa\xb6\x83\xedT\x82\xdd\x9c\x10\xf2\xc9N\xe4\x01\xca\x8a\xbc\x1f\xa0\xa2\xdaRZU'\xb5\xc5\xb2\xa9\xb5\xa4\x1aK\xa7\x9f:BU2\xb5\xd7E\x8d^\x15\n\xceD\x91\x84{\xb9i7\xdb\x8dg\"\xd4T\x02l\x83'\xf2\xa3\xa7\xc7m\xa2\xc7m\xc2!\xb4?>\xd9\x95\xc9U:\x8f`\xf4r~\xbd\xea\n\xff\x8d\xd9\x17\x93(I\xfeQE\xafy\xad\x1f]\x8f^}g\xc5\xb0ym\x9e\xc1P\xe8\xe6\x00\x9a<\xcc\x11\x8c\x83(\x1fH^\x10C\xbd \x1e\xa3hY\x04\xb1O\x7f\xc54\xdc\x11\x10]\x10\xfba\x92\xbc	w\xc73;\\\xc4\xe7\xd6\x1e<\x18\xf0\xdfp[1\x8f\xc4\xbd\xfb\x93MQf\xab\x13\x075\xd0'G\xe8E\xa2\xf6sm\x97TCv\x12J\xf2\xd4D\xbeF\xc7j\xc6E\xcag\xed;\xc8\x81\xaf\x81C|\xd1\xff\x03w&\xee\xdfOl\xd0	aG\xb6\xc9'\x11\x8b\x0d\xc0\xe9[\x11\xe8u\xef($\x9aR\xfb\xb4\xcaj\x0d\x93`\xe2\xa7i\xc0\xd8\x86\x87\x06\xae\xb0\"\x97s @e0\xdc`-\xf7I|u\xc1\x01\xff\xd8\xb6\xd4t\x02W\xc2\x15\xc9\xf3D$r\xb89\x97\x02x\x1b-\x01\xfaP;e\xf6\xe7'\xf2\\\xef\xa44\xcb\x94NwDK\xf1\xd2\x03NB\xa8\xcb|$\x1e\xdd\x7f\x1dg\xde\xae\x0d\xbd\xfe\xb4\xb8\xf3\xda\xb7\x851\xea\xcc$\xccaK\x92\xd1%\x81\xe8	m\x9ca\xfe\x92\x82\xcc\x0f\xf3n\xd7\xd3\x83\x9b\x8b\x10\xb8\xe6\x0c\xa7J\xcd\x82\xf9^\x99\x87\x14<\xac6N\xa5\x1f\x17\xe4\xef~8\xb8e-\xec\xf2E_\x94\xa71\x97\x8f\x16Z\xe6\xd7\xc7\x13\x80\x8b\xea\x8c'\x8f\xeb!.\x1dW\xd1\xa8?\xeet\xf0\xaf\x1f%\xd4\xfd\xe2\xca8e\xcb\x91\xf99\xea\x8f\xc7\x81\xf5b\xafs\x07\xd1q\x0c\xf0\xdf\x8b\xc8T@\x97\xc4\x96\x9c\xa3h|\x1c\xebk\xd8\xbb\xa5\xac0\xc89\xb3\xd3tq\xd15\xc2\xb2K\x89=\xdf\xe9\xb8rw\x11\x03\x08\x15\xb7\x19C\x80\xd3\x00\xaf\xa2|\x1eq\xf9\x96\xdec\xd43zk\x9a;lK\xa4E!\xde\xb58\xb9Z\x1f8\xf5um\xd6\x12\x9d\xb5\xc9\xb4\xa40\xd6\xf7E*\xdd\xe8*\x0eJ\xcf\xb4\x84,\xa1\xf5\x83\x8c\xaeF\xe3\xa0z&\x07D6h\x99%\xd0\xcb\xa7n*B\x063\x94ob\x1c\\\xde\xf0U\xec\xe6\x1a\xcfOe>\x19=\xe8\x13\xa6n.Z\x1b\xc8\xfd\x02\xee\x82[\xa8\x031\xf6\xc4\x1bP\x9b\xd8\x13\xaf`n\x0e\xaa ^\xaf\xdc\x0cp\x071\xe0\xe5\xf0\xa1\xca\xbd\x0c\xa0}\x8a\x85\xef\x0d\x86\xc9W\xf0\x18\xeb\xf5<\x8e90J\xc6W\x99\x8fh\n\x93\xc8M\xc4\xc0\x0b\xa6Q\x12\x95\xd1\x13\xbc\xf1?\x0b\xa9EG\xa1\xbbx\xb2\xc7\xa7\x8d{8.Y\xde\x1e\xad-Y\\\xaeV\x84\x04\x81\xd2\xda\x11cu\xabo\x11C\x87\xf6#\xa4\x99%Y7u:)\x8b\xec\xac\xc1\x8dD\xe9	n^u\x03\xa9\xa2\xd5m\x8a\xe8msp\xab\x06\xd7\xa1\xe3NfAs\x1d\xe7&	\xe3%J\x18\x07\xeeN7O]\x01hE\xc9\x8b\x10\xd4Z\xfeh\xa8\x9e\x85@F7\xc33\x9aBK\xa4yRD\x8f\x0ba\xa8\x19\xd9x3\x97\xd6\x91\xb1e\xcc[^\xc5j\xd9\xe8\x08~I\x06\xe4]J;\x8aF\xceZ\x8b\xf4%\xba\xf4\xdb\xc6\xd8mH,y\x04\x01\x96\xa2\x1d\x1b1\xbb\xc3\xa9\x1b\x8b6\xfe\x8cW\x99@\xfd\xf1\xd4\x04\xdf\x89\x15E\xd7\xd4\x07&\xebt3\xce\xafR\xb6W\x0f\x08s\xc6\x81\x15A\xb5tT3\xe0VB\x86\x07\xc3\xd2_<\xe0:\xbbx \x16\xd8M\xfc\x13\xab\x03\xa9\xd2\x10|\x186\x86\xacy\xbdH\x10\xeb\xf6\xc3c\n<\xc3(/w\xeaK\xdf\xc4I\x82Ky\xc2\x96y\\!\x92\xa5J;[\xf4\xa6\xcc\xb3e\xa4\n\x17\xf4\xdb\x14W\xaf\xa4NW\xfa\xcf\x8d|\xb7\xe2\xa3Y\x11?\x98tDWN\x1e\xad\x93pB\n\x82\xe8\xca}\xbfrC\x91z\xe2\x06*\xb2BlDJv\x1ct\xfaS\x96N\x87\xb3\x04\xe1U\xa8\xcc\x076^`egp-5\xac\xb17\x84\xb2f\xe1\xdf\xddE\x13^x\xc4\xc6J\x0e\\(j\xaf\x14\x17+u C\x1c\x85Ga\xd6Vc\x15i\x92\x84\x99n\x10l\x0d\xdc\xc7\x07\xb3!\x96XE\xa1\x81\x00\xe8\x0d\x86\xe1W\xb2?\x0c{=\xe3\xd60\n\xc7\xda\x86\xca\xcd\xfdx\xea1\x10\x11:\x05\xdd\xafZ@\xc32t\x0b\x91\x8b\x04VOW\x9f\x1c7R\x87\xb9I\xec>S	\x80\xf4q\x91\xdaK5 \x87C\xffH\x92\x1c\xb1_<\x04\xb9\xbfxP\xae\xa0q:\xc7.\x0er_?b\xc0\x8e\xe2\xae\x01\x89\xd8\xa4V\xef\xc3\xf5PY(V\x8cJ\xe4\xed\xdf\x83\xfd\xc1\xa0\x8b\x06\xbd\xa9\xf8\x11\x93\xdd\xdb\xd7\x08\x87V\x0b\xdc\xda\xec\x80\xe2\xbe\xfdu\x12\xc6\xe9\xc9\x15\x80\xc5\xfbc;\xa6\x11\x97\xa3\xc8F\xf1<\xcd\xf2\x88\x02r\x83k?!\x8aD\xd7\xd0\x04Z\xcbo\xd4\x1d\xe1U:\xbd]D\xab\x88)\x93\x89\xdb/\xab\x9f\xb0\xe4:\x9ee/n\x97'A\xc5\xac\xac\x0eF\xe7\x1c'\xa0G\xc4RG\xdb\xd6\x04j_h{s\xb0\xbfXYP\xc8t:|\xf5q8\x98+\xcb&\xb9\xed\x98a\xc7\xab\x96{\x04\x98\xceX\xf6\x87\xf1K\x83L\x11k\x0f\xd5TB}o\xb9\xc1W\xe1\x01\xe2\xe2\x86k\x04\xc2\xaaj\xac\xfa\x060\x11#\xc0\xbf*\xb4\x88\xa3A\xaf\xe5\xd7\xa5\x81\\PU\x02\xd4\xc3\xeafp\xca\x9d\x8e\xc6\xe4\xcb\xcf\x8b\xec\xc7\xf0\x91\x9d\xf1No\xf8)\x19\x10\x0e9\xe4_\xc60\x93ht\xda\x828\xa2\xb7i\xdb;\x82k\xc8\xe1\xa8\xfd*\xcf\xc3\x9dk|\x17\x01\x17\x1e\xb3\x87\xdc7qRF9\x0d\x01\x99\xc5 ,Q:	K\xb7\xf0`\x83\xa2\xe8\xc2U$\xa3*=\x08\xa1v gD\xfc\x1a\x86\x9d\xce\xeb\xd8\x85\x7f7\xb3 \xaa\x0b\xd5\xb6	\x1f\xc28\x01n\xf4\xf7\xe1**d\xcc\x0c\xca\xcc\\)]\x07\xd3\x0fc\xb4\xa8v\x84qL\xce\xa2\xcc\xe3t\xee\\h\x0b\xef\x12\x90L\xca\x9a\xabJ\xc3]B\xfb$\x1d=\x01\xab\x86\xc7\x8cq\x83kV,\x86\x83\xb3oJ\xc6g\xc8^\xbe5\x9e\x05\xf9\x8eB-\x80\x96	\xb6ym\xbdP\x87\x9f\xb0\x8c;\x1d\x8d\xd9\x8d\x0f\xd4z\x1f\x8dX(\x8f\x05\xe1xcy18z\"\x1a\x95c\x8c\xbf\xd8\xa47\xa7\x8d\xd1_\xbc8\xbfq4^k\xa3\xb1T\xed\x00\x83\x93\xcd\xe7I\xa4\xb7\xc6\xa7v5j\x9e\xe6\x84\xc7\xc0\x8dU\xf4s\x84\xa7+G\xb7\xdb	\xb8a\xce\x18&=\".\xfe\xddo\xaa\xf9\xb9p[\xbe\xdd\xe9\\\xe0k\xe0\x05\xdc\xb6E((2\xcc\xc7\x1c\xb1*\x1c\x80\xb6\xd0m\xb0\xd0\xac<\x97\xcal\x0d\x80\x01XT\x9aPG\xcd\x08H\x14\x81\xa9\x0f\x98s\xd1\xf7\x9c\xba\x7f\xd2\xff\x99L&:II\xf6\x82>?\xd2a\x18\xf4M\x8c\xa5\x17\xe4\xf2\xf4m\xb8\x0e\x06}\xfa\xa92\\\xaat\x16\x82\x0d\x9e\x8b8\x05\xdb\xf7\x10\xd5\xbe\xa0\xb8$;.\x14\x05\x16\xd2\xcbZ\xad\x10t\x99!\xac4\x90\xc1\x80\xb4\xdc\x93\xc2\xe5\x13\xec\xd6Z\"\x90\xc9\x13\xe9Q\xd32Y@\xdc6u\xc4\x83{\xbd\x12N}1A\x05Y\xd5\xd2Z\xbe*\xca\x8b\xa3V\xe4\xa7\xb4\xf1\xd1M\xda,f\x96\x17\xab\x1e\xde,\xe5\x83x\x85\x18G\xaf\x972\x7f\x14\xd7K\xb9;s \xa7\xd1#\x18\xd47q\xc1a\xda\x07\x8dS\x96\x0f\x89*\xf6!\xdf\x15\xb2\xb4\x8c\xd2\xf2[\x13m\xe4\xf5R\x93\xbej\x19\xbc\x85\xff\x04\xc0-\xab\xbcgi\x8d]\xe1\xd9;\x8c>\xc6H\x01\xff.M\x89\xe0k5\x1e,\xafayb\x0eA\x95\xaem\x9f\xd3NG\xad\xd3\x0b\x82u\x04.\x87\xb1L\xe6\xacX\xe1 \x16\xc6`\xd8z\xa7\x03\xbd7\xcd\xa3U\xa7\x95\xc4O\xb5(\xb5\x03\xce\xb0\x88\xef\xebl\xab\x8c1\x94\xcb%Y\xfc3\x1fX\xd3\x0c3\xfbWS\x0f\x1f\x05[\x1f\xba\x0e\xef\x08\xb2\xe1\xff{\x06y\x00|\x9e\xd8\xb8^{\x17cTJ\x91\x8a\x04\xfa\xe1\xbfgn\xee\xf2\x978\xda\x9c\xfe\x88\x0e7G\xfe;\xa8\xc8\x163\x9eX9/\xfcmo\xe3o\xe1\xfe\xde\xdb\xf8;\x1d6\xbc\xb9.\xec\xc9\x97?\xac\x08y\x12\xacB5c\xf6\n\xd0\xf4\xda^!\xae6\x14\xb4e{v\xb5\x9d\xce\x898\xd4=\xcd\xe5\xe9E\xd4\xf8h\x13\xfd\xad\xb5\x05\"\xa4\x1bv\xce\xc8/\x0d6L\x00\xacd\x18\x9fckJo\x7fa\x16TM\xf4\x8c\xb5\xc5\"\x97\x12\xd7\x8a\xa3'^!dJ%\x036c\x93\x08\x03\xbc\xc8 \xeb\x0dN\xae\xb1q\x0f\x07\x07\x0b{\xd1\xe98\xff\x93\xd2/\x1da\x88\xd6\x92\xf6\xe9\xc7A\xe0.\x80\xfb\x88\x8a.\x94A\xd7\xc2\x83\x19\xf0D\x15\x98\xca\x89\xdd\x1e9\xc5\x13\xe3\x93\xb0\xe3\xb57tt;+\xd3r\xe8\xb6gr\xe6\x9a\xd2\xbc\xd2\xfb\x1e\x13\xa5u\xbd*E\x86nv\xab\xfb\x8cP&\x1c\x9a\xb7\x1f\x89\xd0\xcd\xebY\x0b\xce\xc4\xdc\x8b\xf2\x99~WF+w!6\x02B\xee\xb5\x98\x03\xe3C\x1bm\xb3\xad\xd8\x9b\xa5\xfb\xfdJ,p{m\x9a\x83\xbfY\xba\x1fVb\xc2^-\xa2\xa8\xe5\x80y\xf8\x9b\xa5\xfb\xb6\x9eA\x84P(\xbb\x0b\x90\x186a<\xb7\x02\xe0\xe4e\x8d.\xbc\xae\xce\xb1\xb1\x99l{\x07\xfd\x8e4!\xddx\xd20c\x93\x97}\x8e\x84\xc2\xae+&\xb6\x06O\xd1D{\xc7\x7fd\xb4\xec\xa1\xa6\x01(\xc5\xf4\xdf\x1d\xbaX,>2n\xe6mm\xd0\x98	U\x83\x97\x91\xd1-X\xd6\xd7Kw\xcf\xab\x19\xb7<#n<\nk\xaaO6\xb0\xc8D.\na\xed\x13\x8615\x0c\x82\x83A\xb4\x12\x15\xa3@\xf6\xd6:\xd5f\x18\x1c\xacv~\xa1V\xdc\xdf\xa2h\xfd\xaaXcI\x02\xa5*\xb5oO%\xec1T\xeb\xc5\xaa\x16\x06\xda0\x1c\x8e'\x1ed\\\x0b.\xb3\xab\xe74\x91\x03\xb7r\xc7B\x05z\x85\x99\xce\xe4\xc3\xe1\x90\x899\x0d\xd3;\xb8S\x82\x8dZ\x88\x89X_%\xc1\x94\x8d\xb3g\x87\xc3\xcc\xf3\xc4\x05\xcco:\x1d7W\xa1\xdb\x94\x7f\xb1\x949\x9f\x85w\xd2\xff\xcb\xd3\xc9P'\x03\xbe\xd7\xcd\xa53\x89\xf3	<\xae\xaa\x8f\xe4\xc2]\xf4\xee\xe0v\xe3N\xd4\xdf;q\xd7\xf2A\x92%=\xb2\xd3\x8e\x94\xb2\xb8Zt_\x04\xbd\x17\xe2^\x16\xe2F\x8f\xa2\x89\xac\xe9x\xe2\xbd,\x87\xfaRch\xc6M\xa7ss\x05\\\"\x16?\xb9\x0e]f\x8e\x0e[\xac\x94We\xe08^\xd0Bnn:\x1d\xf7\xbd\xbc\x81\xcb57\xbf\x0e%\x94f\xf0\x17^\xb1\x8f\xd0{\xb1\x0d\x1e\xc5.\x98<\xbb\x14Z\xe2\x16\xac\xaf(\x9a\xf6m\xb4-\x89ct\xbd`j\xf9\x12\xdd\x7f\x14\xe1\xe6\xc8T\xed\x9d\x0eM\xc5^\xb1\xf3S\x03\x06\x11\xa7\x0fq\x11s\x0c!\xcdf\xeex\xa5\x81k\xf1\xaeBw\xcf\x0cPPZ\x11I\xb7\xcd\x81\x04\xf19\x1cZ 75\xc8Y\x19\xe5\x0c\x01\xb17\xc1@`\xdc\x160\xc1uX\x1d\xfa\x0e\xa4%H\x1b\x11C\x08'4(\xc5g\x0fa\x0e8B<:\xe3\xe3Q\xec\xf8\xf2\xa3\xb0\x7f*\xa4:^9\x98\x03\xd0B2\xa6\xae\x9d\x84\xa5\xafP\x0cp}\x03|\x93~\xda\x88\xf6\x93\x97\xea\x9b\x13\x1c\xd0\x1c\xb5\xde\xddU\xd4\x91}\xcb\xc5\xfc\xc8\xf2\xa2\xb6\xa3\xddp\x7f\xad\xd5\x0f\xf3\xf7n\x83\xa1\x13\xa9\xe6 \xf9\x96\xe1\x00\xf1\x848&\x113\x8b\xa4\xad\xf8\xd2\xd3\xd9\xd5#\x91\x9e\xb2L\xbd\xcc\xdf\x8a^\xd6d\x96N\xaa\xc0Ec\xd1\xae\xdf\x9b\xc5\x08'\xcb\xf3X\xd8\x96\xb1<\x9dG\x92\xe5j'\x0cH\xa5g$\xddhaJ*Q)\xc4F\xba\x08\x89\x8dpZ!\x0eH\x8d\xc2)\x89\x06\x1f7\x17\x03\xee(\xe6\x11\xfa\xc8\xf7\x06\xdf\xf6F\xd9q\xb2\xf4-\xca5\xdbS\xf3\x83b\xb1\x86\x12\x03\xb2\x9cp\xb9\x94\xb7\xcb\x86\x0b\x14\x8b\x0b\x8b	Lo\x1d\x01\xbfy\xfai\xe0F\xac\xe9\xf6\xf6\xb5+r=\x9f,On\xba\x8d\x1ct\xc3\x9cG_o\xca2K\xdf\xf1\x0d\xf3\x85\x95\xf6\xad\x06\xcc\xad\x92t|\xea\xc0\xc1\xe0\xd3\x0b\xedD\x9b\x07\x0eb\x87\x81\x88\x1f\x9f\xed\xcb\xac\x0c\x93\xa3\xca\xf1\x0e\xe0\x06\xc1\xbe\xbaK\x07#\xe7}_\xf4\xaf\x07\x97\xa27\xa0?\x83\xfe\xef\x8eP\x89=N\xedq\xf2X\xe8\xdb\x88.vIe\xfb\xa2wY\x95\xd2iH\x1a\x1f\xcdg\xf5U\xf8r\xf6\xfc\xc5\x8b\xe7Us\xde5\xae\xca\x885l^\x92\xbcu\xa0F\xe2V\x9ffU\x18fu}>\x87\xf2\xf1\x97~\xbb\xa0\xb6b\xb9S\xb8n\x0d\x97\xa7BV\x94\"\x87\x1db\xd0\xc5\x8f+\xe2t\x81\xd0\xe4\x9d\x13\xd6B/\xd6R\x93\x95\x99\x1d\xd6Le\xd5\x8a!9\xee<*?\xd0\xcd.8]\xac\xb5[ \x93\x93\x8an\\\xed\x15\x86\xed@0\xfe\xaa\xce}\x0c\xf8M\x9f\xdfXB\x14\x08\x15\xc4\x0ft)\xff\xbe\x15\x04\xfe\x83\x8d\x13\xffv)\xaf\xff`\x834\xae\x018a\x9a\xf7\xf7\xb7\xa7\xe3\x83,\xfa\xeaz\xc7\x8bV\xed\x9a~\xfb\xb5\x8e\xbd\x0f\xa2\xbc\xba\xf0\xff`.\xfc\xf5\x97U\xa9\x13\xe2~\xe6\xce\x88\xf2\xd8\xbb\xed\xb5\xe3\x84|mr\x9c\xbbI\xb6t\xb2\xcaXu\xb5\xfe\xa5\x96\x8bf\xa3/M\xa4\xc0\xf6\x1e\xdf\xdd\xc1}\x00\xdb\x86\xedO>~\xf3\xd4s\xf3\x04\xb2Z>\xb02Yv\x1dC\xbc\x1c\x91\xc8\xbb\xcc\xe5\xdb\xa7\xde\x97\x05\x1d\xff#JU\x8b\xd6\xf5\x88H\x8fG\xf1X\xec\xd9\x7f&X\xb9\xa1\x7f\x872\xdff\"$\x0b\"8\xd1\xee\xb7A\xafP\xc1\xf3v\xf85\x18?\xbbd\x1bO$k\xa1\x000\xe5\x8f\x00sA\xbd\xb2\x149\xcdS\xe2\x1d\xd9T\x19\xde\x93\xadcm:\\\xed`6\\h\x9dd\x1d\x0b\xa4\xea\x1d\x86\x8f:8\xdc\xb9\x05\x04\xa9\x85\x1c\x15\xa2\x18{\"S\x99~\xc8\xa3\x07G\xf45R\x98\x85\x9f\xe0\xd4(\x95\xe3\x0d\xf3\x1aG\x88\xae\x04&\x8f\xc3\xb8%{\xc3\x08&\x0d6\x90\x80NU\xea7\x19a\x12\x9f\xe7\x04-\x86Q\x8bF\x8f\x15p\xd3\xd13\xad\xff\x9e>=\xf0\xfes\xde\xc5\xa80\xebkT\x84gF\x98!\x12\xcdJ!\xa2$\n\xf9\xfd\x12\xf8\xfe	\xfe\x0ez9\x02@|P\xbf>\x919\xca\xaf\x98=bc7\xe5\x1fjX%\x91\xbfwm\x92'B\xcd_\x9d\x9c\xb64\xdd5]q\xdd\xf4\x17\xf8\xc2\xa7\xec\x92\x98\xca6\xca \x17\xa3b\xfcU<*\x80\xe4:\xea-\xc0{-\xfc\xddx8CL\x83\xd4\xc4S\x18\xe5c\x96G\x8c\xfa\xa2?\x16s9\xeaM\x90y\xe2\xef\xc6b%\xdf\xb8'\xcd\xd5Mm2\x8a\xaa\xf9\xd3N\xc7%v\xa0\x12\x16\x9e2\x0c\x94\xf7jN\xa1\x1c\xd0\xc6\xdedT\x8c\x835=\xe3gw\xe5\x899\xcdBW.F\xc9\xf8\xd9eoB\x7fL\x04L\xdd~\x07\x8e\xb2\xd9I\xe2\x1aw\xedf\"Gl{\x90\x1fc?q\xdbt\x1f\xe4\x1e\x0c\xd1\x0e\xb8\xac\xa3b,\xa7Whe\x801\x15\x0f\xb5\x08Zh\x9d@\xdb\x104ec\x85\xa3\xe8\x8b\xc9h3\xee\xaa^\xc08\xd1\"\x8b\xa8L0\x1a\xf4\xae\xfa\xd4p\x87o\x99\xa8Rf`z+\xd1\xf7\xc4\x0e\xdf\xc5\xc7EV\x03y\xad\xdf\xb8vG\xaf\xf11T\xca\xba\xba\xb3\xb7\x12\x1a\xa9}\xedff\xedZfP\xb7\xbc\xd0\xe6Q\xf9C\xc8\xf6\xbdu\x84\x97\x0b\xb9\xf51\xd9txv:\xb3\x0c\xa1\x82\xf5L\x05[\x9f/u\x9am<\x8a\x8b\x8bi\xa7\xa3\xbd\x15Y\x7f\xaa\xeb\xfe9\x8e\x1e\xddRl=\xf1p\x14L\xc1?v\xbb\xa9\xb5+\xf2F\xe5\x98\x9dB(Hn=\xfcR\x9d}\xb8\xd1\xecu\x83#N\xf5\xedp\x1aD~<=VF<v\x1b\xdb\x0dy\xdah\xd0p\xea\x8e,\xda]\x11B+dQ\xc5\x17R\xdb\xa3Qj\x1f\x84c2\xd5\x98`\x06Y\x10\x96z\xd0\xbe\xbaa\x15_\xd6\x81\xd9\x8c#\xb2\xab\xb2~\xa2pt\xdb\x8b\xbe\x174\xde\xd4\xd8_\xda\x9a\xd8=\n\x7fJfW\x0e\xc7\xfa\x83\xe3\x9f\xbaB\x18T\x90\xb4\xd1\x9a\xeaX\xf1\xb49\xaf\xeb\xd4\xae\x05\x1c\xef\xd8\xac\x12\x91sO\xc3\xab\xb0;\x08\xfat\x12\xe2-\x19`CMA\xd1\xc4\xab\xde\xe1\x84q\xc4\x9d\x9byW\x99%)\xd1w\x0d\x82\xaa\xa8\x92\xf5\xc5\xa3\xf0\x82\xcc\xd5\x99\x82\\PzP\x90x\xcd^8\xd6dZ\xa0\x01\x91\xa5\x86<\xa5\xc0\xfe$\xc9\xd2\xc8\x98\xba?\x89F\xb3q\xd7\nY3Z\x8cED\x1c\x03\x85\xf9\x11\xa1>\x8b\\\xa7\xb1\xe2h\xec\x0d|o\x93_$\xa0\x85\x93\xaf\x8bM\xeb9h\x91\x1b\xb1h=\xfa&8\xf2\x16\x88\xabAG\xdf\x02\x07\xc5\x07JY\xc3\xa8\x87\xf7\xa8_\x90\xe5\xa27lc?\xaf\x8a\xb3\x14\xe5TR\xa1\x04m\xa1\x845M\x10\xca\x1aZ\x1e\x06g.7*\xde\xe2$\x8a\x137\x19M\xc6\xcf6^`\x87\x8b_\xb5\x9e\x7f\x0f2\xb4\x87\xba\xbb\x1a\xcd\xc6\xc3\xf5h1\x96\xbd\x87^2\x9a\xc1\xee\x82\xea\x9d%Y\x96\xbb\xf3\xa7\xee\x03\xe5\xe9n\x9e]z\xcf\xf0\x1d\xf8-\xe0o\xa73\xbf2\xd4\xb7/\x0c)\x9f\xf7\x06\xb0\xfb\x0cz\x83\xe1)\xc5\x9e\x8c\xe5F\xecF\xd3\xb1LF\xd3\xb1\xd8\x8dfc\xd9\xc3\xe7\xe9\xdbC\x86-\xd7\xdd\xcd\xa3TY\xf3\xb5\x8f\\\xc5\xf4`\xc9\x0dS\x9f\xe2\xe1\x14\xe8\xe8\x0ej{l\x15\x85\x1c\xb4\x85\xc1g&[\x06\x1a\xbd\x91\xf2\x8e\x05\x1a\xbdA\xa7\x93bA\xa6\xa3\xc9\xf8\xa5D\xf3\xba;\xea\xab\xab\x03\xa9j\x8f\xb8\xad\x1a\xe5G\x19\xb9w\xa3\xed\x98\x9a\x89\xfc\xf2\x11\x85\x1eG\x93q\x0f%\xc5\xf6\xa5\xecw:H\xfc\x8a\xea\xf3R\xaa\x88\x1c\xe7I\xac2\xdc~\xd5\xeft\xa8\x96\xde`\xec\xd5:1\xf4\xb6\xbd\xde0\x95\xf8\xc2i\xdb\x8fG\xf6j\xdb7\x8e\x8a`-\x0c\xc9\x08ba\x08D0\x17\x9a\xa0\x9a\x95\x16\xa4BS\xd6*-k(\xb05\xcd\xe7G}\x81l\xa5\xe5Ma\n\x9f+\x14\xd9\xf6\xac_\xaf\x96\xfaT~\xbd\xfc\x89SOm\x88n\xfcS\xd9\x0e\xc2\x99`v*\xeb1-\"o\x1a\x8e\xd9q\x03O\x9cC,\x8b\x86\xbf\x18\x8b\x06}\x8dTG\xbc`\xd0\xbb\x80\x84~\x8e~\xfc\x90\x06\x8e	\xf1~`]H\x98<\x86\xbb\xe2\xc6.? [\xb8$\xdcA\\\xaf\xc338\xf0\xd1\xa7P*\x17\x03\xfa\xdc\x9b\x08`\xaa}\x81\xd8\xc6\xea\xf7\xa0\x8f/\x03>\xd5\x96\x9c\x009\x9f\xac*\xa0\x82G\xe1J\xdb\xc9\xf2\x1b2\xa4x\xd1\x17\xea\x7f}\xff\xcf'\xd6\x14\x10\xcap\x12[S<\xe7\xc7Sk\x8ah[\xe6\xe1\xeb\xa2\xb8\x85h\xdcq\x845\x98\xda\xfa\x0e2\x05\xf5B\x1bw\x18y\xcfIBC\x00ty\n\xf3\xcao\x00\x1dKQ\x81\xe20!\xc8\xd7I\x9e\x15E\xc38\xe3\xcb/\xbft\xf8^:\xe0\x885\xd3\xb0XD\xd3\x9a)\x07<\x08\xcbS\xd1\xd4l6\xb3\xc3T<7H\x05?\xc2\x18\xe2\xf7\xa5\xfc.\x13_C\xc2\xe2\x08\xa7\xf7\x18\xdd/\xe3\xb2\x87\x9f\xab\xecw\xfa\x9b\xf5\x9c\xf1\xf0[\x0bPU\xee\xad8\xc8\xc1\xb7+qgMT_;\xc2\x9f\xb1\xb14\xf74\x15\xdfF\x9d\xd7\x8c$7\xcd&\x1bx\x01\xf8\xbcv\xc1\xfb\xe9\xd8\x98\x9b2\x9aR6h\x99bcv\xec\x84\xf7E\x96lJ\xb2t\x8a\xcd	\xd0\xe9\xd4^D\xd6\x0b\xb7\xca%\x9d<JB\x88\xfc\x1c\xefH\xab\xb3v\xf0\x93\x05\xe7m\xbc\x8a\x80\x17\xc1\xe1\xae\xe3i\xc4)&\x16*^D\xc90b\xe8\xd0\x89ZC\xd2\xdc8\x02\xdd\x92!\xef\x8f\xe0\x1e\x1e\xd7C\xb5\x12{T*(\xb2$\x9e\x0e	\x9f\xb8W\xac\xc3	\x945\x8fy\xb8\x1e\xfe\xde\xa3s:\xf8R\xfd7t\xba?\xc3X\xb9\xeb\x0c\x95\xe9Q\x97\xbe\x7f\xb7\xed:\xeb\xed\x10\xf6G:eG)NW\xdf\xdc\xec\x05N\xbaeBm\xa0\xaf\xfb\xdc0\x19\xf91n\xe0\xdf\xdd\xbe\xbf\xber\xa8\x95\x8e\xc6\xe5e\xe4\xd0E\xf6\x08\xad\x85(\x0c[R\x1b2\x1e\x8c\xaa\x1a:\x13\xa4,\xaf\x1c\x07\x00\x0cET\xbe5k\xe5\xa4`\xb5\x8e \xa8\x9eG\xc4\xb7\x9c1\xd6\x8d\x12f\xb4F\xa5?I \xac\xa4=-\xf4\x93Rl\x8e\x8fb\x95=D\xb7Y\x9b\x9d\xbd	b\xfe{\x0e\x0e\x13\xc0C\xb4\xdf\xb1F\xa4y\xd2\xd8\x18\xeb,'\xd8\x92\x0f\xb3\x19\xb4\xb7\xf0\xcew\xcb\xae\x8c\x81\xc4RD\xe5u4\x83K\xb6y\xbe\xcd\xd6ZA\xa2\x07\x85\xc6z\x98\xf9\x988H\xb6\xd6[Gd~\x99\xade\x84\xb9\xd2c\xbc5N\x05;\xb8\xd7`\xc9\xd9cP\xab\xcd\xcc\\\xcb,\x0dT\xd9\xeb\xb0\xac\xc9T`\x88arid\xd78\xe5\xd9\xect\x1a3\x01s\xca+k\xf5\x13\xcd6-T\xeba Nv\x87\xc4\x10\xf0\xd6QRf\x1fo\x19\xf9\xbc\xf4\xbc\xc0\xa4\xb9\xe0\xbec:K\xec~\xb2\n\xa9j\xeb\xf1H\xe3\xf9\xf3R\xae\xc4\xb7 ]\xffX\xca7\x99\xf8f\xd9\xd06B\xa87\x80<o\xc0$\xf3R\xcb\xf1.\xeb~%\xe6\x1c\xad\x0b\x875\xa6\xc1Ev\xa7\xd0?\xf82\x87\xaf|\xbbb\xcb\x94l\xe5z\"\xd2\xfc0\xd7\xc4c(\xe3\xa3\x96vZ\xe3n\x8c\xb5t\xbd\x9d\x0e~=nCo\x7fb\xbbc\x9b\xf9p\xe5u\x7f\x13\x83\xc4\xc6\x99,\x906rR\x01\xfb\xf0\xf5\xee5\xbb\xdb\xdaA\xf5ONn}\x0b9y\xc1p\x84\xa9\xac\xb5\x83;9L\xb5\xdf$@\xc1\xec}m\x08\x8e\xde\xcc\x8e\x96q\x13j\x94\nf\x8e\x90|\x11\x9b\xaaQ\xed\xcb(Z\xe3\xdb\xd0\xfc\x89\x96\x8c\xe7\xce\x13{t\xb0U]\xc7\xb0-\x8e7\xbc\x9d\xbb\xc4\xccp\x98+\xbdA\xc2u,~^V\x1c7\xcb\xa0M|WB2\xd7\x96&n\xe4}%\xfb\x8c\x90^\xe6;je,R/\xa8B\"k\xbbp\xda\"nj\xcc5 +\xd0=\xfb\x94\x1e\x88H\xd6\xe6W\x07\x1c@\x8b\xc1\x88\xb3	\x00\x872)\xca_:\x9df\xd2\xafV\x9c\xda\xf2dDlM\xf0\xb0\xe5\x80\xcb\xa3Y\x1e\x15\x0b\xc5\x9c\xf0+O\x9c\x7fg\xefs\xab\x7f\xa9\xbf\n\xd3M\x98$4Z\xb7\xf1\x9a\x05\xfd{\xa8\xd7i\x81\xfe\"v\xfa\xe7\xaf\xe0o\xe1zP/R\x8d\x97\x96\x9d\xc3\xd41\xf5\x81\x17\xa5\xcd\x9a7\xf1\xb4\xd3\xa9o\xd4L~\xb3rS\xe3 |W\xc6\x93eTJ\xc7a`\x91\xd4\x9f\xd6\xf6\x06\x0d\xab\xcf\xcbH\x0fg\xeao\xab\x9f;m\\\xff\xcdr\x98W\xcc\xc3(\xf5\x01\xea\xb8\x1b\x8b\xbc\xda\n\xb9\xaeI\x9a:E}\xe5\xec\xd5\xf9\xf0K\x80\xe2\xea\xf7\xafA\xea\xef\x18\xdc*\xc8azh\xe0\xe0B\xef\x13K\xebf\x05\x95P\x97\xe3\x9d\xef\x8f\xa2\xde\xe3\xa09\x04\xda\xfe\x82\xbdJM\xcb\xd53\xdac\xe0\xd0\xf4\x98X!\xc6+\x0b\xd4;=\x83\x00O\xa8O|\xaau\xe6\xec\xa4\xf9\xafk7\x85\n\x12\xc2\x0c\x12,A\xdd\xb21\x0f\x03-\xb5K\xf44l:\x9d\xfa\x0e4#\x91\x98q\xd8\xb4\x8e\x02\x8f+\x0eM3\"\xd5\x18\xb7\xcd7\x18\x803\xa2\xc2\xd3\xf8\xf0\x02K\x1a\xc8\x05\xa9\xbf3\x9e\x0e'\x8d\xfc\x94\xe9\xe2\x86\x925\xd4o\xb9\x0b\xc4|\xb6\x93ty\xa9y\x8csW\xeb\x87\xbdu\xbe#\xfe\xb4m\xebX\xacH\x93\x923?pB\xff;\x9dS\x12\xdb\xe9d\xbe\xe1*\xdcZu\x06\xd2\xcfu\xcc\xe5\xae\"\xfd\xb4\xe7m2\xa5\xce\xa4\x96\xed\\\xf1\x1a.\xefdP\xd1\x96\xa5u\xb6\x97\xb5\xe5)\xf4\x96\xd7\x82F:\xc0+`\xb5O\x08\xb7\x8f\x96^\xc8L/\xc5P\xff`sE\xd6\xdaisR\xfa\x0e\x1cv\xe2\x99[\xe0\"\x82\x08\x85RJ\xb7\x94\xffX\xb9#+\xa8\xa26S\xa4\x91\xa3pABG\xc1\xb7`\x1c\xe0\x97\xe5\xf9+\xe4\x11\xe5\xd8\xf3j\xa7\x9c\xa3\xf7\xd5\x93\x7fc\xc5Z\xa3\x13d\xc2 \xd4\x06a\xdb\xfe9z\x02\xb8\x84B\xaf\xe9\xda\xa8W\x1e\xabja\x1a\x9f\xaa\xda\xa2\xf0\xf6\xb55\xc0\x1c\xf2/\xbcW\x90\xfa\xabI\xfdU\xf3\x1c\x0d\x1a\xa58tvC\xaa\x98B+\x1e(l\xcc\xbd \xd6\x93cA\xef^\xb9\x9f\xc2\x18\xa1:5\x87\xef*\x8e\x01\xcah\x01\xcc\xde\x18\xea\x03\xee\xd4\xa7\xd7g\x96Y[\x95\x9fT\x0b\xed\x83\x8869\xb5\xf0C\xfe>{\x88\xda\xdd\xec+\xeb9\xe2\xd2\x1do\x18I\xf2\\\x84\x85\xa9h9\xed\x91\xf56^e\x1b\xdc\xa9\xbf\xea_5S\xed\xd3\x1d\xeck\x00TZ\xd1\x1c\xf8\xf6\xc6\xd4\xd8\x98\x06\x0fI\x0b^dr\x14\xf1\xac\xff\"\xf4\xaf_\xa1\xdc\xa0\x98\xc3\x14\x82\x15;&\xaa\x9fG\x00\x94\x1b~\xbblx\xc4#\x81\x97\x0c\xd6D\xed\x1dZ\x14)+\xd3j\xdf\x97\x14|\xfeM\xbc\xea:(\xe0\x08\x95\xc0\xc2SxJP,S\xb4r\x8ce\x1d\xe9\x85\xc5\xc4\xb4\x90\xdf\xccq\x86Q)\x11\x0b\x8dl\xca\xc2F\x84]\x15\\\xc75\xb0\xaa>\xacK\x8f0\xbdN\xb3\x99\xb6\xb2\x84;\xa9\xdb\xa7S\x93H\xb7S\x9d\xb9b#\xc3j\x81+\x0c}\xb1\xc0q\x994l\xcd7\x84%\xad\xba*M\xa7\x81\xef\xab{\xcb\xa9\xf4\x9b\xd3!\xab\xe4d\xfc\xd4\xb9\xa7\x9c\xf6n\xca)?\xa3{\xf21qy\x18R\xcf~A\xfd\x96\x85\x80\xa3\xb3	O+2\xf5+\xf1k\x11h\xdd\x0d\xb4\x1e\x9eV)%\xb2\x18r0i7\xb9\xfa-s\x13\xaf\xeb\xbc\xbc\xcf\x9f<\xfb\xca\x81\xf9k7\xf3\xff\x99\xc5\xa9\xab\xd3\xb0A4\xd3-B\x0b\x7f)\x94a-\xa7\xceo\x0c&4\xc9\xb3T\x1bj\x8f\xb9\xd6\"\xd2\x14L\x11S>8\xbf\xcf\xca\xd7\xca\xcb\xe9C\x8a%\xe4\x96\xdeUM\x85\xc95\xbb\xb0\xc8\xce\xc0\xe0d\x80x\xaa\xed\x04\xaeJ\xe4|!\xa6\xef\xdf\xd6\xde\xb9\x05tEJ\x0f\x91\x87\xe9\x94\xae\x9eUu	\x98g\xd1N\xbdj;\xb3aJa\xae\x18\xc0zi\x9e\x97-\xc7\x199~`\xc5Q\xa9\xc3!$\x1d\x9dY\x82\"\xe1'Z/\x1cY\x12\x8b\x9c\xa0\xbf\xb1\x8d7\xf5\xe3\xae\xf0\x80\x15\x03]\xd0\xc7O<{\xb4\xe8\xd3\x88\x1a+\x17\x8dSP_\x8e\xa4\x9c\x1c\x0et\xe5\xc3\x91k\xdc7\xf2\xc6\xa6(\xe0\x084\x93yc\x0d\x16\x02a\xcb`\xd7NU\xdc9\xdd\x9cl~\xba\xce\x9d\xd3e\x1c\x15\x8b\n\xbb\x8b\xd3\x15\xd22\x7f\x0b1\x13S\xb1\x16\xfa\x88\xfb\xc5\xfc\xfaUT\xfaA\xa1\xcfP\x08\xfbc-\xeb\xa7\xeaH\xd6\xaf\x07Z\xed\xf5\xa0\xb0\xce\xedM-Ba\xe15\x0e\xf8\x1a\xc0\xee\xd1\x9c$mg\xd3\x99\x05c(0\x86\xf9\xc4b\xddp\x97\xe90\x95Ze\x83\xef\x1a#\xd0\xec\xc8\xa6R\xec\x8d\x9b\xd67AK\xd0!\x0bY\x99+t\xa0\x12\xadm\x82\xd3\xf9\x08Owl\xcb|`7\x85\x0d#re\x1f\x0e\xb9\xe8\xfe(\x8a?\x9a\xaa\xa8}\x8e\x9a\x03-Z\xbe\xde\x18a\xad\nF\x14\xb2\x8aw\xd2WVm[c\xe9i\xe0.e\xa5\xea\x1b}R?`\xb9\xab\x1a\x86\xbf\xe6v0\xcce~8p\x1d\xbaK,\xebY(\x00\xcfM\xa7s2\xcb\x1bo!\x7f\xca\xdc\x8d\x88A\xa8+|l\xdd\x9f\xca\x83\x81\x9dO&\xb2.b\x01\xbe\xacv\x80\xe6.\x12.G%J\x86\xbb}+\x01-EN\x1a\xf3D\xc4pKb:?\xac\x8dV\n|P\xc8e\x00HY\xabu\x81Xa\x18B\xb7ic\xd2^\x7f\xe5\xc4\xaf3\x9c\x994\xcd\x08\xf0\xfdi]\xf3ZL\xea\xc9\xdaqq\x18\xc9\xe8\xdc\xe8o8\xb2W\xfc;D	\xac\xaf7\x81j\xaa\xa0	\x8d\xd0-b\n\x9dz~\xaa\x147&	\xf1\xcc\xcd\x11\x08\x8d\x82Z\xdeB\xd7\x86\x85\xef\xe6\x80\xe5R\xbf=\xd12\x91\xc0M\x89d\xe4\x8eb\x91\x8eE(2\\\xa1\xa7b\x8f0~hd0*D2\x16\xbcA)e\xa3-\x05`\x80\x04\x00./\x96\xffX*\xdc\xde\xc2\x13\xa9z\x18\x8cER-\xa1Gd\xdb3\xdc\x9f\xdc\xe0d\xd3`\x7fr\x03Q\x00V\xd3\x0c^\x9b\x91`\xaf\xcdB\x8bt\xe1v\x11\xcb\x19\xae\xc7r\xe6\xef\xc4Bq\xf4\x13\xfaCR\x94\x13r\x15u:\xf9\x95\x1bKw-\x7f\x01w<\x15\x1b\xcf\xc3GS\xb9\x06`b\xa0\xde}\x07\xe0\x96T\xf5\x19\x07\xf8\xe2\nU\x06\x97}1\xd1\xbfj\xc50\xc8`t\xe2\x9e\xfc\xe7\xca]xW\xe8\xc8\xb3K+\xd4\xcf\x82\x92\x82\xbe'&P\xe2S\xbe	\xf2\xc1\xec4p\xee\xb3\x12\x98y8\xb6(\x0d\x19y\x05\xb0\x0eUo\xf6\xb5\xfc\xad\xde8o\x18\xcbu\xd5\x96c\xe6+\xad\x072U\xe6Jg\xd8\x96\xe0\x94U>w1\x11\xb1\xbc\xb8\xb0\\+\xac\xc0\x17\xda\xec%\xeet\xbe]\xba\x16|CT\x19\xb9G\x16\x83\x8e[\xad\x08\xa5[\x8e\xd21~{\xb5w\xa3\xf1\xd0\x8d;\x06QKJ\x19\xf2O\x8f\xea\xcf\xc4)\xceX*\xc3QDu\x91E\xc4	\x9bMa\xa7s\x99\x9e\xf2\xe9\xe6{\xcc\xaf\x03\xbbF\xfd\x82\xef\x08\xd8Y05\x94\xaa\x1f\xf4\x8bwS\x93\xfcn\n\xe1\x88\x19\x98\xfc\x13\x1a\x9c\x8f\xa2\xf1\x90\xbek\x9d\xd8T\xa1\xf5\x8cO\x99\xb3\x9e^\x9a'\x0e\xf5{\xf6\x1eY\x8a\x8b\x8b\xf8(\xee\xea:$\xed#\xd2V\x02+[\x94\xfadC\xb9\xf6\x93\xed\x04\xf6\x86z\xc5\x82\xd8\xc3A)6L\xdcO\xe6\x1ex\x01\x92\x00\xc9\xf5\xc4\x0fM\xe1<]s\xc9\xcd\xbf\xc9\xfd(yW\xc5\x0d\xb1\x8eY\xabp\x02-\x1db\xa1\xa3\x8d\x1b\xef\xedkU\x9a.q\x95\xe6\xf9\\\x95,R;\xa9\xf2\xb3\xb3\x1a\xf1\xcfVb\x0dy\xd2mv\x1eG\x93\xc6\x85P>}+/\x8d\xe2H\xc3\xa8\xeb\xc3r\x1a\xaf\xae\xfa\xc1`\xac\xe4\xb8\x1a\xf35\xf8\xf5\xb6j\x00\xc3\xbe\x92\x18\x82c\x04\xf3\xd7k\xb9\x18\x06\x16\xcdB\xc8\xa5\xcfV\xe2\xd7[O\xfc\xf5lO\xfe\xfa\x7f\xb9'\x04S{\xbe#\x04P\xfd	\xfd\xf8+\xf5\x83\x8e\x89\xef\x96\xf2d\x9d\x83\x83\x97%\x9c\xc1\xd5N\x99\x18\x8f\x8e\xc9N\xff\xbaS\x83\x86k\x1c1\xa9\x9f\xad8=\xd4\xc8\xbf\x94\xfc\xd7\xd5Iv\xd5w\xd9\xc8m\xa5\x1e\x87\xdf-\xed\x01\xae\xc1\x00[\xc2G\x8e\xd4\x05\x13\n\xdb\xbe\xe2\xbb\xa5\x98\xc6\xab\x88\xa2\x0b\x17\x81Y\x1c\xc2\xa1o9cA\x92Aev~&\x1e\x86\xb1ZD\x1byBi\xa9X\x86\xbb'\xdd\xe2\xba\xe8\xe8\xd6\x81=\xac\xfeU\xaf\x07\xe3*\x12Gc\xc1{\xfb?\xa8\x1d\xf9\xdd\xf2\xa3_\xe0,\xf4\x15\x8e~_;\xb1\xf8\xdcA\xe9\x11\xaei%\xcbs\xc6\x9c=\xb2\xb2\xeb\xdc\xa3fo\x9b\x93]\x15\xfezw\x03\xc4\xdd\x96\xd1$(\xa7F9#\xe8\xaa\x96\x939\x18\xfd\x02\x15\x11\xee\"v\xb6AI\x8aI\x81\xda\xfe\x0e\xf8S\xaa)\xbae\xa7\x9dy\xd2\x98\x9a\xa3\xa8\x81X\x9f-P5\x91J|(\x17Q^\xffB}\xe1T_\xd0]\xa2`\xcfW\xcd\xda\x02\xd5b\xc0\xfc\xd5\xab\xab\xcd\x95_\x1f_xW@\xe8\x9d8\xe0\xa9\x0e\x87\xd6,e\xbc\x8a\x9a\xefu\x9b8\xdc;_\xb6\xea\xd3n\xfa\xc1\xbaR\x0b\xf4\x84#_\xf3\xc2rK\x16\xc3X\xed\xaf\xc0\xab\xef\xa9G\xc0h\x8b\xc6\"S\xc3\x85']\xc2\x8c\xa0\x1by\xf0\x0f\xb6\x90\xa0?B:\xeb\xd0\xdc\xcd\xc1\xf4m\x92O;\xc5D\xef\xa9\xe6\x833\xd1X\xb8\x88^#\"\x0f\xdb\xe5<\xedf\xa9\xfdyzp\xda\x10\xfbTq\xe33\x0d\xb1(\xb6\x1bsCL;^\xd7a\xb3\xab\xd5\x85\xd8;L\x99\xa1\xf0\x1e\x0d\xf4\xe3N\xef\xa7\x06\x04;;\x1dk\x08v\x1d\xa7\x87b\x85h\xc1X\x15A$\xdcZ\xa9dMK\xc2\xc1\xabP\xe6\xbd\xcf\xbf\xe8\x07\xb9\x0c\xbb\x9f\x7f\xd1g\xcdd\x15\xf1'z\x1au\xe3\xa7\xb17\x8c\x9e\xc9B\xc4\xcfda\x81\xbdR\xb6\xb0\x0c\xd3K\xb7\x07\x0d\xc13J\xf8\xe1\xdd\xd3\xc1_\xfab#\x93\x97!\x05\xcb\x18&/\xc3\xc3!\xf9*\x1fzIW~\xfeE\xff\xe9\x86\x97\x13\xdd\x9d\x8e\xc2\x9e\xfe3c\xc3cR\x0b\xf0\xcau\x98(\xa8\xb1\xf74\xea\xea1\xec\x99p\xa8V\xf2n\xcc\x064\xbf-\xe5\xe6}\xc3\x81\x92N#\xcc \xdb7b\xa3`;i\xbe\x90$4\xe7v2\x0b\x12}2imw\xccf<s\xb2\xa8\x08,wSuL\x12\x9b+\xe2i\xcb\x9b\xe9\x11\xfb\x1dv;\xa9\xfb\x9bu\x8c\x8a\x0f|\xe0\xff\xb4\x94\x1c\xc8bo\xc5\xb1\xf8\xb2o\xc5\xae\x80\xa1\xeb:\x89\xcb\xef	*.\x18\\R\x1fI8\x1d\xec)\xc0'\x0cLa\xb3CK<\xd8\xdb\xb9_\x1c\x8f\xc3\x9b7.\x9f\xb6\xe2\xb7\xa5\xf8\xfbJ\xfc\xb4T+\xdd\x13x\xa5Od\xf3N%\xd8&\xbb\xdc\xff\x86\xc1\xae5\xea\x8e\xd9\xcc\xceX45\x80j:fq:\xc5\x145\xe6Bo#^\x12\xf5)\xa9\xdb%\xd75&\xa5\xdf\x9c`\x17\xb1\xd4P\x9e\xee\xfd\xf0\x04\xc6\x03\x1dA\xe7\xad\x89\xfbB\xb9\xf8\x05#\xe7E\xffO\x8e\xa0\x7f\xc7z,\x9d\xbf\xf4\xff\xe4h\x83\xd3_\x96ro\xf126[\xe4W\xe9B\x01\x9c\xb4j\x95Fc\xd3\xcdF\xdf\xf4\x10[\xa5*\xb9d\xf4\xf8\xe4\xbb%\x9cd2\xb6\xd4\x90\xd1\x03\x9b\x83d'!\x1fr\x995N\x16\xf8b\xf8\xb5\xe1\xd7\x93\x8e\xf7\x04<u\xf2\xbe\x9aOo\x18?\xb8\x80\x92\x12\xf1\x83\x9bC\xf0\\>\xb8\x19\x1cB=a\xd0\x1da\xb7\xd8\x98u\x99	\xdc\xe3\xc9\xea\x0b\x86\x05Vl&K\xacF\xd6\x17\xdcy\x1c\xc7Z`P\xafKK\x0e\xa0\xa9\xfd\xd4m\xaa\x1dm\xcd\x0e\x05\x06\xc4\xb4\x9e<\x85\x0f\x10\x80\x93\"\xbf\xb9he|\x92\x84\xcb]|<\x0e_\x87\x06'A7]\xfc\xb2T\xfb\xf9\x9f\xb0D\xa6\xfdI\xd6\xd6\x8e\xd9\xaa\xfc\xfb\x96\xcc\xcf\x1d\xda\xa2\x9c\x85~\xbf\xca\xa3\xd0\x19\x0f?\xbciP\xb6j\x1el^\xfbu\x12\x16E\xe0\xfc\xa07\xa0\xd6\xff\xb7\x99\xedU\x82\xd9\x16\x0b\xbdSIl\xca:2:\xa4h\xf0D\xcb*\xb3#\x88\x90P\x02\xde\xb6\xf1dY\xd0\x86Tkn\xe6\xa6\xda\xf8\x94\x88\x15R\xab\x86in\xca-%\xa2\x99zj\x02X:`\xf8{\x1f\xd2Q\xd2\xd2y\xa2<z\xc3\xf0\x01\xfa\x81\xf0\x01\x18\xfdS\xf7\x9f\xcb\xaa\xc2\xc8 tE]\x87\xc4\xa5\x90\x86k\xce4.\xbeF\xdcB\xd7c\x83\x06\x1az\xc0$+V\x8d8\xefhL\xc1\x19r\xc2Mc\xda\x01Y\xaa\x9e\xcd\xf3V)\x96\xd7\xb2\xce\xec\xc3\xda\x9e]\x97a\xc2\x11=>\x99\x19\xf7\xc6\xfdd\x1bD\x1c=%\xd2\xd1S\xb2\x07\xe2\xbb\xd8\x93\x99\xf6\xf0\xb5\xae\xc3\xf5\xc4\xef\x97\xc1\xc0vg\x1c\x86l\xfc\x0cG9Ko_\x81\xb7\x85\x1e7\x1e\x13\xf3\xc9\x8dGfros3v\x8c%\x85\x8d\xe3\xa9(VO9U	\x87H\xa9\xa1\x9b.\x129k`w3\xb1C\xefoL\xef\xd3\x077\x12p\x03\xef\x86cM7H\x10T\xc3\xaa\x9bN\xdd\x1fo\xdcD\xec\xa9\x97A\xee\xda\xe1\xef\xad\xc1m\x8e\xd3^Afk\xef\xc0\xd3\xf9\xf0\x19K\x0b_\xd4#D\xeb\xf3d\x88\x84\x89\x99I\x95\xbd\x0e\xcbh\x0e\xc8\xc0\xc2\xbd@\x80\x99\xd3y\xe7\x18\xfa\x85	\x80\x1f\xe6\xf3\x18\xba\x89\xa9-\xf1\x8bu\xbd\x89\xcc\xc5F\xc6\xd5\x1a\x17\x8bj0'2\xf2m\xe6\xca\x1d-\xba\x85`\xa24\x86\xd0\x9cV\xd0\x0c\x7fvb\xcd\x0c\xdd}\xe1N\xc0\x8eN\xbdg\x8b\x97\xfe\xe7W\xda\x7f=@\xeaWS\x8e\xf3\xae\x85\xba:\x0e\xbd*6\x18\xf7f\xba\x18c\"\x05\x93\xd1`\xfc\xd5\xec\xca)\xb3\xb5cD\xbc\xf0\xd5\x0cG\x9b\xb1\xfa\xd77>\x1bP\x87h\x15Y\xfd\x8d\xc8E\xae\x0fuv\x0e_I\xed\xcao/\xea\xc6\nXy\"\xcd\xdc\x15\xc7\xedO`\x7f\x89\x8e\x00\xf5	\xdc\xf9Yo\xff\xc3\xe1\x0f\xa7\x9f\n\x07\xb1\xaf5KS\x9a=\xcb\xf5\x7f\xdd\x82\x1007Jz(\xc74\x0d\xff\xa4\xbdUQ|\xaf}!k2k\x10\xf9D!\xfb\xc3P\x86O\xe2T\x05\xff\xcffO\x08\x01\xf9*\x0cF\xe1\xd8b\xe6Gp\xf8\xef\x0f7/5\x00\xfap\xa3q\xa3\x17\xb2\xe8v\xff\xa4\xa5\xcf\xc3\x04\x1e\x85\xf8\x072c\x81\x1f\x0cu|\xbaCS\x11c\x0e\xf5\xf6\xf4\x8e\xf8\x9e\xfaL\xa2\xab\xc3gZ6\xedh3\xae\xf6\xad\xde\x90\xe1hc\xda1>\x8a&\x81\xf3*\xe2&~\xd7\xbb\xf7w\x877\xa99#[\xc6\x1aqP\xb9\xde\xb3\x03O\xc7\xab=\xf0a\x1e\x85\x15y\xfe\xaf\x06\x9e\xef4\xb8\xdf\x88\x85\xec\xe1n\xa9F\xed\xe9FL\xaa\x9b\x1db\\	\x15\xd2j\xca\xa9\xe1\xd6N5\xf1\x1c\x0d\xebO\x10u\x83\xe1\xba\x9a\xd7\xb5\x9e\xd7ys^\xe7c\x99\x8c\xe6z^\xe7\xd6\xbc\xae>r\xee\xf4\x83\x89\xc8\x83\xa9\x1dCoQ\xc5\xd0\xeb\xc5\xa3\xb5\xd5\x17\xdd\xac`\xd6\x80\xc3X\xc8ZNZ)k\xd9\x1f\xae\xab\x95\xb2>\xb3R\xd6\xf6J\xc1Q\x16\x84\xa3\xf5\xc9:y\xa5g\xab\xb6N\xb044o\xfek\x0b\xdf\x85\xa3\xcc\xe6\xbd\xbe'\xe43\xf1/d\xfdd\x06\xccb\x94\xff\x978\xb0Xs`\xf0KW\x1c\x18\x0b\x0c,i\x91\x8e7Zg\xba\x98\xf7\xd7\x82\x05\xac\xb8B\xc6v\x92Hd\xfe\x00\x03\x06\x91\xc3\x08\x0b\xfb\xfe\xf5\x1b\xb7\x84\x0en\xea\xfe\xba\x14\x1b\xa0\xac rxc[\x93\x8d\x06\x03\xaeyb\xea\xfe\xab\xcez59\xafN\xe7\">e\xbd\xea\xacV\n\xb3\x01\x11\x1a\x8a\xfaQ\x92\xda8\x8by\x17\xff1Q5a\xac5QMd\x7fX\xc8\xe2\x94\xa8\x16\xc1\xa8\xa8\xf6\xf6\x06Du!\xfb\xc3\xc5K\xad\x14\x1b.\xf4\xe6\x9b\xc8\xa4\xdb\xfd\x93F\x95\x1fn\xc8}z4Q\x9b\x0f?\xaa\xcd\xf7Q\xa6/\x03\xfd\xa5MS\xdfP\xb4\x81\xd4\xc77\xfa#\x8b\xd6\x0d\xb4\x19-\xec\x0d\xc4\xa4\xb6\x18-L\xeb\xc6\x82v\x15\x18\xc4\xa3\x08\xcf\x13\xdd?\xa2\xb3\x98\x00@Xr\xb5gg\xe3\xa3\x94\xb6>\x1b\xff\x0fo\xef\xd6\xdb\xb8\x92\xad	\xfe\x954\xabZ \xb7CL\xd1\x97\xbcP\x19)\xe4e_\xb2\xf6\xb6SggV\xee\xaa\xd2\xd1\x18\xb4D[\xac\x94H\x15I\xd9\xd6\xb6\x04\xf4`\xd0@\x1f\xa0\xe7\xad\x81y83\x8f\xf34\xe8\xf73\x07\x98?3\xe8\xee\xdf1\xf8V\xac\x08\x06)\xca\x99\xbbNc^l\x91\x0c\x06\xe3\xbab]\xbf\xf5\x9bg#3\xa4ULd\xd0\x9fTS3\xd1S3mN\xcdt,W\xa3\xa9\x9e\x9a\xe9W\xd3\xc5\x19\xcd\xcfD\x7f\xcf\"\x90\xbd\x8a@\x9a\xcc\xa4;\x04\xb1z\x95&s\"{\xfdI5\x99\x93=\x939\xd9\xa1\x86\xc5hRM%O\xe0\x97\xa9\xe1\x8f\x9f\xe5\xf9\xb5!d\x8b\xe8\xb3I\xc7\xf3\xc0\xc6J\xc8\"\xdcgU\x11\xd9\xac\xa7\xc9B\xc3\xec\x9b\xd0\xe0\x8f3D\xd1ds>\xc4\xe8\xe0S,]N\xf3\xab(\xd6\\6t\xcaQMf\xec\xe72\x1aQ\xb6\x84\x8f\x99s\xf8c\xe6\xd2\xb7\xbc\xb1\x1b\xab\xaaV\x1ao\x14R0T\x10\xca\xa9G\x078\x19|\xd8O\xd7\xb01L\xe4\xef?\x8fVch)\x90\xbbE\xcc\xbc\xfeD\xf1\x8c\x12\x11\x95\x9c\xbd\xec\xc7x-'>*$\xb75\xa2\xd7r\xb2\xfd\xfe\x9a\x9c\xa4\xe1\xc8T@2IDO\x14\x82?OiZ}\xcd\xc7\xe3\xbc\xf1\xc4\xef?\xcb\xfby\x1b\xdb'2\x033g\xc6\x10y\xc8\x17\x03\x16\xe6!\xcf9B\xad\xb9?^\xbbM\x06\x9fx\x81d\xec\x89\xdd\x07\x01=\xf0\xb6:\x96\xfb\x8d\x82y\x15{\xe5\xc9\x04\xd1\xd4\xc5,\x9a6<\xdeu;1|Z\xed\x1c\xdd\xb9\x01\x86	F\x83t\xca\x0e@F\xffL\x13\xdc\xeb?\xd8\xb1\x0f\xf1\xa4\x04,\x8bj\xce_\xa9kw\xe8\xc6Z\x18\x06G\xb8\xdd\xa4\x1b=>\xf2\xbe\xc9\x85\x1b=>\xea&\xde7y\xd5\xa3\x07\xaa\xa0\xd7Dr\x18=>\x12=a\xf6\x1c\x96;\x14&Z\x17\xf3\xaaq:\xbb-\xfa\x91\x1f?\xb7&\x0f\xd7\x9e\xa55\x0f\xa8L\xd6<\x9fD$\xef\xb7\"\x97?.\\4\xcer1\x85\xf2	\xbeY\xbb\xca\x8d\x83x7AI\x89\x83pG\xdd\xd4\xe9\xb0&\x89\xd6L\xf3)\xadZ\xc0\xd3\xc6\x96\x16\xad5\xc3yM\x9b\xb6\xfb\x19+\xf7\x15\xa3\xc0\xb0\x8fk\xd2RX\xf3\x0e\xda\x14\xe6\x82^\xff\x19\xe1\xdbp\x90\x1d\xfdm\xe1\x16\xde\x18PN3\xb9b\x87C$\xb7f\x9c\xd5\xa9L\xea\x14\xa0\xf0\xc4U3\xf7\xfafSW\xbb.\x91\xc2\xfa\x8a\x96\x0c\xc0\x98\xe9\"\x18\x03\xcc~\xa1\xb9\xe3\xcb(?KR\x0d\xfc\x8c\xfcJ7\xf5G\x9c\x0b}\xb3\xe9\xf5#\xa0M\xe1\x0f\xc8\xbf9\xdb\x11(\xbe\x88\x96o\xb5\xca\xd6\x9db\x93z\xe2\xaey\x9f|\x17<q!\xffR\xb8\xb1X\x8b;$.\x9f\xdat\x0c-\xbd\x94=\xf1\x01b8\xb0~\\\xaf\x7f\xf9\xe2C\xffR\x9fGg\xac\xd2\\\x8bK\xe4,W\x17w\xe2\x92H\x1a'M?c\xd5\xdbGy\x86HM\x07\xa2v\xea\x88\xb7\xf2\xb6\x7f\x01\x07\xe3\xd1|<z7\xdel\xf4/y\xbf\x0coE\x1a\xden\x91\xd5\x9co\x8e>r^\xfaW\xe2\x8d\xf8I|F\xfd\xcc\xbcb)\xa8.R\x93\x86rZ7\xcb\x9dy\xdd[q.\xebv\xb8w^\xdf\xa8\x14\x86\xde\x0b\x9c\x03C\xe9\x0e_\xf4X\x0f\xb4\xf0\xc4+\xf9V\xbc\x91o\x0f\x87\xe2\xb3t\x7f\x92\xe7\xdd\x99\xd7\x9d\x08\xab\xc9\xa3\x8fc\xf9F\x05\xaa\xd1\x97\xdf\x9b/\xd3\x1c\xb9gp\xc5\xec\xde\x8aoeQ\xcf\x07l\x7f\xfc\xbd\xf7\xe2\xa6\xd3q\xdfK\xf7\xbd\xf9\xf8\x8d'\xdeH\xf7\x95\xfc\xf6p\xe6\x1dN\xc4O\xf2\xad\xf8,\xdf\x1e\xbeo~\xfc\xb3\xb7\x8d\xe1J	\x17a\x95\xab\xc1\xbd\x14\xa0\x96Kpa\xd7HW\xfcJ\xe4\xe1\x1b\xfbx\xef\xfe\xa4	\x0cIt\xe6\xac\xef~\xb6\xef\xc3\x0f\xd9\x80\x9f\x0b,J\xc4\x8aU\x199\xd4f\xdezb\xe6\xeeX\xab(+nsg\x12\x87\xc68\x82\xe0cu\xb6\xaczl\xb7w\xbf:\xb3\xac\x0ex\xaa\xbc\"I\xbe\xb0\xd3]\x9e%n\xa9\xd3\x8c\x8b\x91\x120\xc6\x0f%\xb9\xb2P.8\xd7\xa5\xf2\xb8\xe8\x97Da\xb3\xb4\x90\x8bWX\xbe\xdf%\xf3y<\xfdY\xdds\xcdSQbS	\x051|\x16-\xb5uUU\x86.!=\x99\\Vo`SZ\xc4\xcbJC\x9cP%\xa0\x93\xc0*PW\x825K\xb0VB\x19,~vut\x9e2\x8a\xe8T?g\x91\xf5\x0do\x071\xb8f\xfb\xd1H2\xbbyp\xccEDh\xf1d\xe5\x0f\xfd\xa7\xa7\x86\xd1\x0b\xc4\"Z\x02jE\xa7hS\xaao5\x8flS\xa2\xdf\xbff\xd9\x02\xba[\xd4\xf0S\xb2HJ=\xd5\x97\xf1\xdcd\xa6\x11\x1a\x89\xb7\xd7\xeb9[a\xf2\xc8\x87\xf76\xe2\x8b\x7f\xca\x000\x8c!\xf3\xbb\x93\x93\x13\xc7\xbc\x1a\xc7\xb1\xb3\x15:\x1b}x_\xfbBO\x17\xcb\xaf/]`\xd6P\xfe\x9e\xda\x97\xaa\x02\x91{tz*\x8e\x82S\x94\xf2\x9fy\x0e\xac\x8bj\x9a\xc3\x91r.Q\xf3\xbfk\xcc\xd3\xf6\xad\x96\xb9\xc7\xe2\xa1|J<\x93Fi\xdd\xcck\x85EVS\xcb\xd5X\x18c\nb\xbf\x05\xab%n\xc9\xe1\x85\xd4u\xdf9\x84Dj\xf9\x8e\x8b\xd4\xa4\xd6\xd2\x0c\xcd\xae\xffw2pSd\x08.W\x85\x8cE\x82p\xf8p\xc7\x0b7\x19$\x16@[\xb4\x03o\x7f\x93%\xd3G\nh\xe4/\x98\xff\x1d\xefE6 cq\x00-\x04^\xe4j\xcd\xec+\xa9\x96\x94DrQ\xf1\xd7\xb76\xad!z\xd2\x02\x02Q\xe1>\xfce\xe6\xc6\xa0\xb5\xecr\x8eC.\x8fn5\xe2B%\x84$\xea\xb7\xc9\xb3b5\xc6\xd2\xb6\x1d\xa4\x9b\x0d>ZK\x9f\x84\x0c<\xc4]l6;A\xb1F-g\x7f\xbd_\xd6\xd0\xf23\x7f\x9aG\xb7\xcc\xa2\xa2 \x10\nj/\xb4iO*%\x85\xda\x96\x9a\x13PW\x8e\xb7mq\xf5\xf4\xeek\xed\xe8tj\xcdb\xed\x0cp\x1c<1\xbfq\x9b\xa9\x9fD5\xe8\xf5\xf4Q\xec\x90y\x1dg\xf5\x0cR\\M\xc1	\x9f\xaa\xd7\xf7\xbdX\xbdcr@Yo\xfd1\xdd}\xcf\xca\x1e\xc5\xea\xb0?@\xc7\x05\xe8<G\xe0(Z_\x039\xd4\x01\x94\x83#\x1c\x8a\xa4t\xc6\xe2\x1f\x80BR\x0e\x954\xf3\xa7\xf0\xfa\xc6\xedy\x02\x92\xcd\x9f\xf1;\xf0\x04*\x08\xef\x97\x0d\xdf\x0eQK\x18\x8f\xb8RM\x01\x7f\x8e'\xa5v\x8ds\xe1\x16#\xd8\xf1-\x8f'\xbf\xa1\x86\ni\xad\xc4\x04r\xfb\xff\x074\xa4\xd3y;w\x13D\xa1]\xc7\xe2\x8b\xed\xd3\"\x01\x15\x07;uPbm+Y\xfc\x85\x0c8r\xf9 \xd089>\x82\xb5K\x7fM\xc8\x88\x8a	.L\xcaS1\x97\x10\x85\x98\xec\x1c\x1c\xb8o\xe7.d\xce\xc8\xdbl\xd4\xcf\xc3\xdc\xba\x10\xd1aa.\xf0\x84.\x7f_\xfa\xca\xbb\x00\x84N\x0f\xf4\x1c\x1d\x99\xa3#\x9b\xcd\x0dL\xf3\x91zC\xa4\xd6\x0d\xd4gn\xd0S\xae\xd5*uXX7\xf1\xae\xa2a\xdb\xad\x88\x87r*\x92\xa1\x9c\x8bt(oD6\x94Zx'\x81\x8b\x16\x99\xf1\xd5\x02\xbe'\n\\\xe7\xc9\xd4\x11\xce\x1d{\x88\xac\xf9?Q+\x95Q\x9c\xde\x83\xac\"jjV\xdb\xe8\xed\\.\xa2%\xfc1\x86\xf2\xf6\xa6\xe2|\xfa\xf9\x10\xac\xc1\xfbU\xb9\\\x95?c\x82\n\xcbq\xd6dV^ \x9e\xdc.\x84g\xa2\x05\xd4\xc6e\xe9G\x95\xdal\xea\xd7r\x84\xb8u\xd2\xe9\xc5\x9e8\xb0\x9fy\xf7\xf6\x95\x8c9\xfc{6\x1c\x95\xfee\xbe*f\x10\x0e\xc7nO\x80m\xe9\x97\xfe\xc5\x05-'\x85\xc2$\x19\x04\"\x9c4\x8a\xb3\xfb>x**.F\x81\x80K\xd8\xdd\xfa,I\xcf\xa2;\xe01\xf0\xcf\xed\x166\xa9|\xb8\xdb\xd7\xda\x80\xa0\x9f\xf1\xb0\xe1B\xc3\xf6~\x90?\xb8\x87|\xa4\xf8=\xa0\x06\xe0\x0d\x0f!\xeb\x07=\xd0u$wL\x0d\xb4\x80\x1d\xf1k|T\x9a\x1d\x0e(\x05\x075\xde\xeb'0\xac\xeb.\xa9\xf03\xc3:\xab\x89|\x97\xee\x9b\xc7\xd6F'\xfb\x1b\x8d(4\xf5]\xc9\xff\x89\xcdL\xb8/H.\xeb/\xa34\x9e\xbf\x83\x80\xca\xbf\xf6\xcf\x9a\xe9\xb5\xb6TS#5h\xa55\x97}\xfe\x9a\xcc\x06{g3\xd3R\xf2\xc7\x1b\xb7\x9aA\x91\x99\x9f\x9e\x17\xea\xa9\xaf\x84\x0b\x9a\xdb\xcf\xf1\x10M}\xbf,\x9b\x03\xc4\x84\xd8\x04\xdf\x99\xf1\x00FQ5Su\x1d\x07U\x06r]\xb9\x87\xf2P\x84fP4\xe3\xfcZ\xaf\xcb\x10\xa13\xf0\xf7\x9d0js\xf8\xfe\n\xea\xb7\xa4P+\xe7\xf5\xfa\x0d\xa1\xed\x86?_\x91\x85\xa1\x1a<\x8e\x89d\xe5s\x94S\x95\xe4o\xaaL\x15\xe1\xb7\xa8H\xafd\x06\x1cVz\x13\xd9N\x96\xdb\xe7?\xd1\xbd9\xe8!\xd2d\xb3\x01j\xf2\xb0\xbevc\xbf)z\x01\x1f\x89>\\\xaf\xaf\xf6e;\xd5J\xeb@\xa7\xf2\x03\xb4\x87%\x96F\xaf\x9fU&\xbbL\xab\x02\"\x99\x8c2\x84\xf0\x98\x05\x88\xc5\x9a\x13K\x15\xe9[\x88\xbc\xd1\x90\x18\x11I\xce\x8f\xa0\xb6Pu\xce\x87v\xa5\xc9\x95;\x1f\x8e\xb2\xb1\x9b\x8a\xc8\xc0hD\x0c=z\xd0S\xde\x92\xc5P\xde\x83\x10\xb7\xb2\x86\xa5\x7fg<\xf2`n*\xfd\xb5uMf\xd4<\xa1d\x9a\xf3\xc2\xa4\xcfC\x80\xa9\xbc\xdf\xf6\xddd\xb3I7\x9b\x0c@r\xf1\xb0\xe1\xf8\xc1\x0e\xfb\xa4Q\xa6J\x94'\x18\x9cV`'B\x02\xf7\xd8\x13\xf9\x08\xbf\x90Y\x15	a\x87n\xfaw\xd4Q4\xea\xb0\x1c-J\xef^}\x0f\x89\xf903\xf9\xa8\xe4\xb2\xa20?\xb7\x08\xb8\xaf\xa7\xccf*\x93\xb5\xe9\xc4\"8\xf1\xe1;\xe0^\xdfDy\x19\x17I\x94\xd6}\x9b\xb0-\xdd\x04cR\x1a\xd7l\xe7\xcea\x14\x14,\xb7\xcd\x06\xcb\xd2~\xbc\xb6\x1f{\x9d\x0e\xa3\x07P85{\xda\x9b-JGk\xb7\xeb\x1c\xa2\x0f\xc2\xccQX\x8a\xda\x86\xb3\xae\xc3\x08L\x82~\x1a\x17a$l*\x10\xae\x864K\x82\xd6\xc3\xdbx2\x8f\xf2x\x1a\xf2x\x89u\xed.\x0f\x1d\xfb\xcf\\\xc7MpBPl\xff:\xcex\xdd4G\xb5M\xd3\xd8\xdf\xeda\x9cU\x1d\x8c\xb3f\x7fv\xfa\x97\xd8\x9dC\xea\x8c\x9d\xee\xc5\x19Z\xbc\xdd\x8a\xf9P\x8e\xea-\xde\xdd\x0d\x8d\xcdP\xdf\x0b\x9a\xc6d\x9dNB\x08\xc4Is\x8dz\x02\x0fSz\x98\xee>\xcc:\x9dL9;\xea\xa9\xdb\x8a6\xd1\xad\x1aF\xfe$\xc2\x13\x13v\x93Tc\xb2\x1d\x8b\xd5\xce\x167\x87B\xe5\xa1\xffa\xcd\xfb\x90Dw;\xba\xb61\x855E\x90~U\xb0\xb2xO|.\x7f-n\x06\xe8^g\xf0\xec\x13\xf1v+fF\xccH\x87\xee\xd9\x8d0\x92\x86\xba\xd4\xc2F;\x0f\x1e\x8f\xb2\xe1\xa8\x1c\x8f\xdd\x11\x9c+\xb0\x92\xe1\x9c?\xea\x8d\x91\x92\xa0\xf94\xe0\xa7\x01\x9eFrty\xc3\x96\x15X\x0f\xc7\x9eP\xd7P*S\x11\xcd\x91\xdf\xab3:\x8c*&+\xb2\x84\x8f\xf6\x86\x8dF\xc1\xe3\x9e\xe8\x06\x8f{cQ\xfdlVY\xf3\x8a\xe3s\xd8j\xb69\xb4\x1eA@\x18\xf5\xac\xf4\x0b|\x83\x9a\xee\x91\xf3H\xe31\xdd\xe0x\x01*\x1c\xe8\xc7\xec[\xa2:j\xde\xae?\x0e\xf81\xde\xce\xb66{\xb9\xddn\xc5\xc4\x9e\xb2w\xf5){\xb7\x7f\xca\xd8\xb5\x7f\x04\xf9\n\xaeh\x98\xb2ob\xee\x08\xdd\x0c\xec\x9b\xc1x,F\x10\x13U\xd9\x00e\x03.K\xed\xb3n\x06\xe3\xf1x\xff\x94\xf0*\xbc\xaa1\xb9\"5-*\xad\xd6\xa4\xe6\x0b\xba\xfa\x94\xaa\x87ZWL!\xbd$)1)\x8ep\xb2U\xf9\xfeJ]\x8c\xc5\xd5P:\x17\x17\xf1\x84\xae\xb5t\xbe\xe4\x9b\xefR\xeb\xf6\xb7\x10\xcf\x1dq=\x94o\xde\xf9\x9f\xde}\xf8\xe3\xab\x9f\xfc\xd7?\xff\xf1\xc3\x0f\xfd\xab\xc2\xbd\x1e\xd66<VT\xd3#\xdc\n? \x96\xd4\x8e\xe0\x84So\xa7\xe3\x94\xd1\xe7X\xe1Q*\xc6\x0b\x8a\xfe\x84\x940\x88\xce*b\xc5\xba)\xc5\xa7\xcb\xb5P\x91\xcf\xf1z\x90(\x81\x83A\xf3\xee\x0d\xbf\x1aRzv7fF\x988\xbb\xb3(\x8d\xae\xe3\x9c\xdcEno\xdcXk\x88K\xcfkp\xef@\xd2\xc9\xe3\x08\x89\xc9An\xc5r\xb7\xafZf\x80\x9f-\x0e\xd3\xdf\xd4\xf1Z\xae\xa5y\xa5pt\xa2\xf9\x1c}[l67\xa3r\xbc\xd5o<ZUe\x0e\x0et\xb4w\xf5xV\xa7\xb7M\xad|\xffv#\x13\x7f\x16\x91dJ\xa3\x19Oa\x06sc\x0f\x8a\x0f\xb0\x0d\x00\xd8\xbb\x89? \x0c\xc3\xb5-j\xfa\x13\xea\x03ND\xc5\xd0\xc4\x12\xc0\xf7\xa3x,\x03\x0c\x91i\xc9\xc4\xad\x99f?\xdf\x800\x00B\xb1\xd39\x18\x82\xb5L\xc1iM\xdd\xcb\xaa\xe6\xd4\xbb\x8fF\xa9%\xb1t:m\xd3Vg\xa8\x01.)`\x84d \xa3\xd4\x13\xb7\x1b\xb9r3L\xd7\xdc\xc5Wp\xc16\xa9\\Y\xf1\x94\x95\xb0\x9f\xef2J?!8\x00^\xd3\xa8\xd2\xbdC\xa2|\xea\x17!\xa6,\xa5ZW\x9c{D\xa8\x0b\x86\xb7\xa7\x0b\xf8d\x85\xb1R\xf0\xd3\xc2	\x13\xbd\x84<\x93\xce\x1d:\xe7>'Y^\xea<\x0bf\x11.\xe4\xb5\xea\xf4OI\xfaY\xdc\xc0q\xe7\x0e\x7f.\xf0\xe7V\xf6\xfa\xc8\x0c\x9e\xc9k\xbf\x9c\xe5YY\xcec\x0c\x95\x88\xac\x1b\x047\xa6\xea\xbd\x94Wf	\x9bq6+\xe8\xd1\xf9\x8d\x9b\xbb\xf7\xb6\xbe+\\4\xa4=\x8a;\x01\x10\x94\xf8 '\xd6^\x99\xda[\x1eR\xe8\"Z.\x93\xf4\xfa,.g\xd9T:W\xc9\x1di\xfe\xfakw\xe1A\xe2^\xd4\xcacU\xcb`o \x85\xb5\x8a/\xb0\xb6F\xe3\xbe\xb3\x8c\xf2h>\x8f9\xe39C\xf8\x0f\xd4\x8a\x0f'|\x92}M\x8d\xf7\x1c\xce?\x0d\x89\xe1\xb4\x82\xfb\xc3\x98\xafh\x1e\x95\xed\xc7B\xf6\xc1\xbc-}=\x8dj\x029\x89z\x8a\x15N\xd2\x12\xdaK\x8a\xb3j\xef\xe4\x12;l`\xf7\x9f\xa9;\x16\xd8\xc0\xb5\xc0\xf1T\xa5Q\x0dB\x08L\x87!\xe0^hS\xf0m\xd82\xab?\xdd(U\x9c(\xbd\x7fS\xdd}\x97\x9a}\x1b\xd2\x06\xeat\xaen\xdc\xe9P|\x00\xfe\x15\xb6\x84'^a\x1f\xe3CP\xbb\xb1\xaava\x8e\xd9\xd7\xcc\x12\xbdn\xf2Bf\xf9\xbd\xbf\xd1\xca\x0d\xaf\xed\x1c\xac\x84\xd4\x98\x0c\xfaTR\xa4\xb2G\xbe\x0c,:\xa6/\xb2~\n\x99\x94\x90\\\xf6q0Z`M\xc7}\x88\x0e/\xf8\x00\x87\xac\xc7L\nn{\x02\x7f_\xd2\xad\xc0<\x0c\xaa\x87\x01\xde\xa4\xe3\x9c\x1e\x06\xfcf\xc0\x0f_\xd2-~\x93N{\x89\xdb\x1e\x0b\xb1\xc0:y\x7f\xe3\xc68\x94op(\xffn:\x9d:\xe3~\x15\x98F\xfb~'0M\xab\x18[\xf5\x8ffW\x08G-\xdd\xddd\x13\x80e\xb8\xcc\xee\x94\x9d\xce\x90\x16ui/~:t\xc0>3I\x83=\x8b$(\xebz\xdd\xb86\xc4:d\xfd<\xdd\xa8\xe7\x9c0\xb06:'\x01\x95i\xb1\x07\x1a\xb3!%\x93\x08\x8ez\"8\xe9	\x98\xad{\xfeq3\x9fDK\x19X\xf6\x84M\x16C\x90\xa1\x9f\xa32v\xccm\x9d\xf1B\xd9E\xde\xa7o\x90A\x03A\x89\xbf\x86A|\xb2e\xaa=\x1a[=\xab\xc6Ms\x17\x1c(\xaa\xd5R(\xaeX\x88\x1dC\xb4}\x1cW\xe1\x94}\xc0\xb6Lo Q\x8b}\xbc\x99\xa6+\x89\xcf\xcf\xab_@U\xe9'~E\x07\xa4}\xb1\xd9\xb0\xe9\xf3f\xb8\x15)N\xfb\xf7\xb7\xe90\xcf\x96q^\xae\xe1\xa3\x7fU\xfe\x05\x9e&n\xea\xd3oy\x8at\x0b\xca+\xd9\x8aO\x07Q7\xdery\x1c\x15\xb2\xce\x8a\x9a]\xfc\xed\x8dkuM\x98@EU\xab\xc5\xb3\xd5\xeb\xc6+\xd6\xa0\xca\x9djDU\x04\xd3 \xabK5\x0d\x15\xa3P\x07\x92\xe7M\xd4bA\xb4\xed\xb2\x1a\xbb=Z&\x1a\xb6\x9d\xf4\x15\x82\x15\x8fTK\x95\xd4\x93x\xc5?L\x95\xa7\x14\xe0\x809a?\x7f\x8cA\xf5/25UT\x9f\x07\x0d\x08\xf9\xd0\xb4\xd8\x1f\xcd\xe8\xa1\xa4\x8e+\x14?\xdf\xb4\xbb?l\x19\x0f\xc5\xc8\xa2\xe1\xcf7|\xebS\x12\xdf\xe2j\xaf]\xb0\xd1\x11\x9f\x0b\xa2U\xba\xb9-\xcb\x95U\x92\xd40?\x99\xf6\xad\xab\x16\x8e\xaca\xbbpKa\x0f\xb5'\\\xf2\x1c\xfb6\x9dn6\xb1_\xdby\x1a\xcb\"Z\xeeC\xcc\xe5\x11\xd6\x8cWj\xf8\xaa\xd2\x13\xbf'\x8c\xb7t\xdb\x00\xaa\xe1W\xd8\xa2\xb8#n\xd0\x9e\x8c\xbf\xc4\xa6\xb7\xe4\x04\x82\xfe\xde\xd7;\xc5-\x99\xaf\xdbz\xbb\x1f\xd7\xa2\x94\xdd\x04\xed\xd0Q\xe1\xe5\x90\xff\xa7U?\x90lh\xd7\xaf\x87\xd0K\xde\x0de\xf1\xceg\xea\xad\x86\xbd\xff\xe9F\xa7c\xe1m\xc3\x1e\x17=\x95\x88\xa6\xc5J\x8aC\xf8O\xc8\xa9\x94\xa4\xf1\x9fw\xad\xa6H\xfb\x1f\xde\x83v\x87\xce\xd9S\xffX\x1c\x9f\xf8O\x1f\x9d\xf5\xfc\x13\x11\xf4>u{\xfe\xd1\xec\xb9\xff\xec\xd1\xd9\xb3\xe7\xfe\x13s\xa7K\xb7P\xe6I\xef&\xa8\x97\xd1wT\x99\xe0\xc8?\x16GG\xfe\xc9\xa7\xa0\xe7\x9f\xce\x82c?xtv|Lu\xf9\xa7\xb3\xa7\xa8\xe7\xe4\xb9\x1fX\x97O\x9f\xfa\xa7\xd6+\xdd\xe0\x98\xab9\x0e\xfc\xe0\xe6\x99\x7fDE\x9eZ\x97\xf4\xf4\xe4\xa9\xff\xe4&\x08\xfc\xe7\xf6GN\x9fS\xadO\xf8#\xb8|4{\x8a\x0e\xd2W\xaaw\xba\xc1\xb1cX\x10\xe7\xec\xf4\xd4?\xc2H<\x9f\x04\xfeS\xd1\x13\xc7h\xa1\x7fB\xff\x8f\xfd\xa0\xe8\xf2E\x97o<*\xf0\x0bw\xe9\xff\xb1\x1f|8=\xf6O\xa9\na*\xfb\xf5\xd1\xd9)\x06\xed4h\xafv\xd2\x13\x81\xff\xb4Y\xf7\xa4K\x85\x9b\x1fx\xf4\xe6\x04\xd3uz\xe4\x9f\x88\x93g\xfeSq\x1a\x08\xae\x1d\xdf9\xf5\x9f\x88\xe3\xa7~0\x0f\xfc\xd3.\x8d\xeb\x93\x1e:q\xec\x9f\xce\x03\xffI\xf7\x99\xfft\x8e\xfb\xe2\xe4\xd1\xd9\xe9s\x11<\x9fw\x03q\xea\x1f?:;:\x11\xc1\x13?\x98?A\xc5\xfes\xfc\xef\x1e\xe3\xc1\xc93\xffT\x04\x81\xff\xe4\xd1\xbc{\xea?G\xdb\x1e\x9d\x05\x18\xd7\xe0\xc8\x7f\xf6\xd3s\xb4\x81>\x18\x08\x1a\xe2\xe0\xd8?\x11G\xcf\xfdgs\xdc8\x9e?\xc1\xacc.P\x87\x08\x9e\xf9'\xf3@<\xa1\xb9:\xf2\x9f\x89\x93\xc0\x7f\xfe\xe8\xec\x08\xa5Nz\xfe\xc9\xa3\xb3#\xf4\xef\xa4\xe7\x1f\xcd\x9f\xe0\x1b<\x8b\xcf\xd1\xe5\x00-x\xd2}\xea?\x99wO\xfc\xe7\xdd\xc0?r\x88\xdd\xfcS\xe8\x9c\x054\xde=j\xdbS\x11\x9c\xfaO>\x05\xfe\xf3\x1f\x8e\x9e?:;>\xf1\x9f	\\\xa8/\x9c\x9e\xfa\xc7U\x81\x93S4\x80^:9\xf1O>\x9d>\xf3\x83\xea-\xba\xb2^C\x91G\xaa\x0c\xbfx\x84u\xdb\xf3\x8f\xe7\xdd\xe7\xfe\x89x\xee?\x99c\xcf\xd06\xc0T\x1e=\xc7\xda|\xe2\x9f>:{b\x8ar\xc9\x9f\x9e`	\x1f?\xc7\xb0=\x0d\xfc\xe7T\xf8\x87\xd3S\xff\xc4a.\xda9;~\xe6?S\x03{z\x84~\x1da\xa1\x1f\xdd<\x7ft\xf6\x04\xb3\x81\x81\xfbt|\xc4OO\x9e\xf8\xc7\xeay\x17\x83z\xe2?\x17\xc1\xd1\x0fA\xe0?\xc3\x0b\xf8_\xbd@O\xf1\x82z\x8e\x17N\x9e\xf9G4\x99\xdd\xe7\xfeq\xf7\xb9\xee\xd1\xd1#\xb4\xe2y\xf7\xd8\x7f\xfe)8\xd2\xc5\x8eU\x97\x8f\x85*\xd75\xe5\x04z\xf5\xe9\xe4	z\x01\xb2\x13:g\xa0*\xfe\xe9\xa7`\x16\xf4\xb0\xa4\x8ez\xe8\xc9L-\x82c\xfd\x0b\xfd\xe6r\x18[|H\x04O\xfd\xe3\x9b'X\x03\xb4\xb4\xab\xcb\x13q\x8c\x1aOzv\x95'\xbdG\xa6\xd2\x93\x9eU+\x97\xe5j\x8f\x02,\xc3\xe7\xb3'G\xfe\xf3\x9b\x93g\xfe\x93\x1f\x8e\x82O\xb8\xf3\xab\xa3\x00\xbaC\xe7\xec\xe8H\x04'\xfe\xd3\xf9q\x0fK\x1e\xf5\x1ca0O\xfc\xa7?\x1d\x1d\x89\x93SL\xd9	z\xf1\xc4\x7f\xf6\xe9\xc4?b\xf2s\xf4D\xe0\x82v\xdeI\xe0[WO{\xfe\xb1)\xfd\xc3\xe9S4\x04\x15\x1c\x9d\xfa\xcfo\x9e\xa1KT\xa2\xba\xc4\xc3\x93c\xff\xe8&8\xf2\x9fT\xd5\x9f\x9e\xfa\xcf\xac\xfa\xabKz\xdd\xbc@_p\xb6\xa2L\xcay\x1c&\xee\xdd\xd0\xa7\x9f\x9e\xb2\xa8]\x0c\xe5'\xdb\x13\xe1b\xe8+g)y1dOD\xb0\x18\xb2\xd5\x9d\x04\xc2_\xff\x8b'\xa9u\xc2\x95\xde=L\x11\x86s#K\xc4\x0eO\x07\xf1a\xb31\xf2C\xb4\x91|\xd6j}\x17\xf3\x86\x17\xa6\x1e\xa91l\x8d\xf8!31\xd5\xb9op4\xaa4\x9e\xa6!1\xa7\xf7{7\xc9R(\xbbVp\x89v\x95K\x11,\x12\x03g\xb1\x02t\x91\n{\xc8B\xf62\xa2GQ\x08\xc8\xa8\xd4\x1b8\xda9\x91\xf2r\xe5\x0b\xe0%\xc1\xe8{1\x84\xfc\x8e\xaa\x0b\xd9n\x89X\xe8\xf42\x1c\x94>\x81C\x13\xc2\xb0\x13\xd8\"\x995|\xa8\xfd\xa5w\x1f\x8fJH\x9a	4)\xf8\x0d6\x04\xf0\x82C\x9f\xd3\xac\xb7\xcf\x99l\x0c\x9d\xc9\xd9m\x86\xae_u6\x19\xb8\xf1\x1e\xbe\x0cf 0B\x9a\xd5!7\xa7\x9bhN.\x95\x9e\xd8\xf7\x1a3W\x93l\xb1\x88\xd2\xa9\x1eW#vm=/\xdc\xf7\xc5\x1d\xfd\xb0\xf8\x1c\xaf\xeb\x0c\xa2\x16\xd0\xcc\xc2\x08\xcd\x94&\x834L\x0f$2D&\xb6\x94Z=\xafO\xf9@\xaf\xbf\xb0\xba\x0f\xe8do+~\xbd1\x8c\xff\xa7\x1bO\xac\xda\xd5L\xc8\xd8\xc1\\[r\xe5\xae\xdd\x042W\"\x93\xc1(\x19\x87\xa31\xe0F\xec\xe0\xb3\x14J\xae\xa9m[a\xbb-\x8cUM!\x8e\x99B\xc7\x1b\x94\x9aA\x0ca\xc8\xdd\x89<\x03\xb4\xa9\x84\xc3@:\x89J76\x88\xee\x99j\x1e\xbf\xdb_\xbbd\xf8\xce(&\xcd\x13\x19\xa9\x18\xef\xaf\xe2\xa8\\\xe51e61\xdf\x91\xa3\x8ce\xd3Hf>\x17Ay\xfd[\xdeo\x11m\x13\xa9\xbeo6.\xff\xa2\xfb\x85\xcc\xd9\xad\x12x\xb7\xd8\xb9\xa3\xb1\xc7\xcaP\x96}\xa0L\x12+B\xc6@V.\xbd\xe9;\x9dF\xa9?|\xf6 \xf8}\x7f\xb3\x0f/\xf1\xfb\x1b\xce\xce\x08W\xe5t\x1a\xe5N\x1d\xbb\x8f\x00\xcc\x84Cf-\x87L\xac&V\xa2\xa8\xe7\x1d\xd6\xd2\xda\x88\x13\xf5\xab79o\x19~oM5\x8c\xf7\x96^\xc7\xedU\x18h?.A\xe5\x1b~\xc7\x8d\xb2D,\xd8KyR\xee-\x06\x1e\x9eJ\xbd\x89\xe7s\xe5`\xbd\xafhqk\n\xaa\xc8\x93\xfd%gTR\xa5)\xde[\n0\x12\xa9\xfa\xf8wI\x0e\x18\xd3\xf5\xfb\xab_\xe2\xf8\xf3\xde>]\xd5\x8a\xd1\x9bo\xa3\xb21ff\x03\xb8\xa5\xfc\x99\x8c\xaf\xa0\x87\xdf\xad\xe6\xf3?\xc7Q\xee\"\xa9.\x07l\xa6\xe5\xcc\xf5\x0e\x83~\"\x93\x17Ao\xe0\xf4\x9c\xc3$LX\x83\xa2\xcd\x18\xc8\x98\x9c\xcaT\x17H\xc3\xd4\xb8:b\xee\xa3ue\xfb\xcd*$	7;|\xda\xd5\xce\xd9\xf5\xde\xb9\x9e\xf7\xef\x9ez\xe2~\x1d\xc6b\x11&\x02\xd2\xe94Z\x87\x99\xc0\xa2PQ\xff\xc8\xda\x05\xf3	\x8e\x872FF\xe08\x8c\x0f\x9d\xaes\x98\xd0\xdf\x14\xfa\xe58,\xb74\x06\xe7\xf1]y\xfe6Z\xb7#\xd4\xc1\x0b\xc8%=g\x8fs\x1bX\xc3\x06\xb4<\xd7-IQ\x81{nK\x01\x1f\xedRF\xae\xb7\xb6\x81'v\xbdC\x0ddW\x7f\xc3\xa8\x1e\x1a-\xd2W\x8f\xe0\x0cW\xb5\x90!\xfdF\xf1\xd8F\xf5\x1b\xc5\xe3m\xdb\xb4KN\x8eI\x0b\xdc\x8ax\x9eFk\x06\x1fa\\x\x1eu\xc7\x13\xf6z\x93\x8d\xb7]G\xdd7\xc5\xc09\xd3Fh\x96\xe4xk\x13,\xe0\x98\xe4?\x1cI\xec\x9bW\x11\x91\xd5\xd8\xb2\\\x9b\xbd\xcfY\xfd\x03\x9d\x13\xddc#%\x83\x83\xe83\xd7\xd4\xe0\xdf\xc6\xf1\xe7b\xb3	\x90\xcf\xc4!\xdfZG8\xca\xb3\x16\xfe\xa6\xb21.\x880\x9b\xcf\x01\xe9\xedx\x1a\xd2[\xe4\xb21z\xaf\xb3\xbb\x9f(\xe0\x86\xd3*\x80\xda:\xb3,O~\x85k\xed\xdc \xd8\xf2\xf0\x0dF\xa9x:\x0eGOE:F\x16\xfc\x9e\x08\xec\xf8\x15\xef>qaB@\xb6\x90Q\x06\xd3\xbe\x8cF\xe5\xf8\x9bB\xb1\x1a\x9c\xb1\x83\x11\xc0c;\xd2\x90\xc1\xc0c;\xdcpkR\xa2\x83L\x01=\x1c\xd8[\x0f|\x16\xe7\x068\x9b\x95\xfa\xf6c\xfe,\xcfEqK:y}5#=\xfbC\x18\x8fk\x97\x0cz%A\xf8\xa9\xe4\xda\x94'\xd0\x8d%\x02	,\x0dm}\xfd\x8b\x9d\xb9#\xe3\x83\xbd\x9d9\x1d\xcd\x81\x9b\xd0\xf6z))\xdc\"/\xe9\nfU\xfc\x7f\x91\xfaq:\xa5\x9f\x87\xcf\x9e\x9c\xc4\xa7\xda\xc5mt\x1e\x9d\x8b\xf3\xe8|\xccG*lAk\x91\xb7\xad\xb1\x91]\xb1\xc8\xc6\x9e\x9f\x963\x90O\xa6Z\x06\x89~w\xa6\xb9\x0f\xf0#\xbf;\x8c\xbe\xe1a\xbb\xe5\xfdW\xdc>>\xe2\xa1\xa4\"\xeb\xc3\\\x17\x99\xe9\"\xb3\xc7G\xe3\xb0^O\xfe\xe5z\xa2\xd6z\xf6aajro#ab.,`\\r\x05\xc0(\xea\xc9\xae\x1f\x89Mu\xbb\xb5 \xe8\x19\x8f\x94N\x8b\xf1A\xa7(M\xe0L\xc1\x9b\xb8\xb8\xed2\x84\xb7\xa6\x01\xde\xe3#\xb1\x0e\xe1Ya\xca\xcc\xda\xca\xa8\xbd\xb0\xaf\x16\xbd/\xf6\xd5\xb0\xd5aV\x89(\xe7!\xf9\x06q\x11\x9a\x1f\xfa<_c2D\x99\xabB\x87\x0f\x15\xba\xdcS\xc8\x9eVq\xb9\xe7sv\xa1\xad=iq\xcb\xa4Y\xe9\xfc\xdd\nC\x94\xd7\x9c\xf7\x98\xab\xba\xf5\x0e\x83z\xee\x7f\xb7B\x18\xe5Uc\n\xcfPx\x97x\x12\x19\xfd\xe2\x9a7\x1b\x08\xed}\xbd\xc6>)^\xa5S\x1c\xf3\x89\x88\xbbA\x15\x90\xd3Z&\x16	\x95\x81\xa9\x07\x08\xa5\xe5\xc7l\x98\xdc\xc5\xf3\xf0\xc6\xfd\xe5F\xd4\xc2\x05<]\xe4\xbb<[\xd8\x85\xaa\x01\x8b\x1cO4O\x87=Nj6\xd5\xa7\x9e\"3\xb4\xa4\\n\xc8\xe0\xd0\xe9\x04\x10B\x0dS\x0c~\x80\xac\x93\x8f\xff\xa7\x7f\x9c\xde\x9fl\x7f\xff\xd8/\xe3\x02\xac>\x8c7r\x04^\xa3\x17t{\x81#\xf038\xea\x1e\x07NU|\xf4\x8f\x8f7\xdd\xf1?N\xef\x03qd\xbdkd\x98&\x0f\x11\x83\"\x82F\x95q\x1f\xb8\xf8\xcc{\xa556\xac\x96j\xd9\xe2j\xdcTt\x03\xaf_\xca\x91\x06\xaaR\x14Td\xb5\xcb\xf1\xb6\xadq{Z\xca\xbd\x14\xb1\x11JZ(gE?\"\x8b\x84\xbe\x8c\x0cM\xeet\x10\xc6y\x838\x1d\xa0\xd8lE\xed\xfd\xdd\xd5\xdewK9\xdae\xb0h\"Zn\x07co\x8c\xe0j\xf5Y,x>\x1c\xe8\xf8\x11\xd6\xb7\xf5Qd\xed&S\xfc\xb1:8\xba\xb5g\xbd\xda3\xda/\x15\x07\xa8\x9fz\x1ar\x07\xc3K\xb1\xffT)8O}\xde\xa9\xbc$\xa9a\x0d\xb3\xc3\xa4\x1b\x00\x0b\xdb<?\x902\xf2\xb4E\xde\x80$.b\xd7\xeb\x9a&\xbe\xec)\x9c\xdd\xc6k\x90B\xdb\x8a{\xdf\xe4/{}/\xe9\xca\\4?m\x03\x003\xa5H\x0e\xa9G\xd3h}\xf8\xc4{\xfc\x14\xf1\xfb\xf1 \xe8\x16a\xd1\x0d\xf4\x047\xa6\xf2\x9e\xb6PH\xder\xf55Gm\xb6\xef\x8cU\xd8t\x88\xa2\x88\x93\x0eQBD\xf3\xf9\xdbh\x1d&\x82X\xb6\xb0\x10|\xe6\x86sq\x85[\xa1n\x92\x98\xf3e@\x97\xc0\xffi#>\xd6Z\xb2\xe3\xb2Z\x16\xad\xf2\x97*_\xa6\x9a[\xec1P\xf9\x8b\xd4\xa7Oo6\x80\xfc\xe6\xc7\x9dN\xfc2\xf5\xa9\x0d\xcdH\xae\xa7\xdf\xb8e7\xf0\xba\xfc\xdaa\xcc\x08\x8eh\x9bk\xf3\x14\xf6.\x89\xcb\xda\xe3\xe9a\xc6\xbc\x17w\x8aVv\x04W\x86\xefo,\xb8Xi\x8b\xe36\x8c\xec\xf77\xfe\x8e\x80]/\xbc\xf3\x18\xef\xa8\xf8\xb0\x9a&\xeb+\xd0g+\x81\xdf\xbchB{\xd0\xf3\xefo(\xa2\x03\xb1<\x06\xf25\xdd\xf1\x96\x93\xd9~o\"\xef\xbe\xfa\x08\xa6\x85\xb9\xf4F\x0dH\x0f\xe5\xee\xba\xe1\xc9d\xa4_\xe0:\xc8\xc3\x02F\xf9\x9eV\xe3\xd5PY\xcd\xa7\xc4\xf77jc\xdc\x0ewa\xa3\xabR\xcd\x0f*\x17\x86\x9a\xe1\xb0\x169~\xa4\"\xc6\x9f\xf5D\x99-\xc3'=\xa1\xc5\x0d$\xd8Q\xd2BhK\x12\xc2 UU\xf1\xd8s\x8d\xc3d\"\xaf)\xf8\x9b\x99\xa2\x80\xb5%E6O\xa6\x88\xbf\xde\x0d\xd4\xfe\xdd\xd5\xd5\x95\xf6\xf1\xf8\x85_\xaa\x87\x87O&\x13\x07|\xdf\x9a\xd1\xa2\xf5\xa7\xb5|\x18\xf6\xaa\x94\xa9\x0emfG(\xb0\x9b\x90p\x90\x05\xc7\xf2\x87N\x9cVq\xe6h\xe5V,p\x8e5\xea\xddW\xd9\xa9\x88\x08S\xd1\x04\xd5\xd7\xea5\x01\xda\x1c;\xcf\xe1\xec\xea3\xeb8\xca\xf7}\x85\x8a\xf3'\x8e{\xfb\xea\xc1 \x88\xab,-\xbf\x8b\x16\xc9|\x1d:E\x94\x16]\xf8\xf1\\\xa9\xfb\xbf(\xf1\xcb\xb9\xcc\xe6\xd38W\xf7 :\x86GH\x1c\xbb\xe3\x16a'$N#\x1c\x95\xb7C\xbfX-\xe3\xfcU\xe5\x8a\xe0\xe05\xc7\xf2H\x10\xdf\xdd \n\xce\xdb\x8aE\x9c\xefr4\xd8[\xf7m\xf5X\xa5w\xaa\xab;\x91l=q9\x94\xf7\xdf\x9e\x87#\xe7\x0f\x11,\xd9\xdf\xc5\x97\x8ep\xce\xb0\xc0\x9dWK\xfc=\x8b\xd6\x8ep\xfe\xb0\xc2\xd3?\xac\x00J\xfcju\xed\x08\xe7\x03\x99\xb8\xdf\xc3\x1f\xc99\xcfn\x1c\xe1\xbc\x8d'\xceX\xbcAe\xff\xf5_\xfe\xfd\x7f\xff\xdf\xff\xa3#\x9c\xff\xfa\xaf\xff\x89\x7f\xfc\xcb?\xa9\x1f\xff\xed\x9f\xffY?\xfa\xcf|\xe7?\xfc\x17]\xe6\x7f\xd1w\xfe/\xbe\xf3\x7f\xff\x1f|\xe7\x7f\xfd\x9f\xcd\x0fS9~\xab\xfa\xc7[\xf1Aw\xe4\x03\x1a\xed\x08\xe7\xa3#\x9c_\xf8\xffwh\xb0n\xdc\x7f\xff\xdf\xfeOT\xfd/\xff\x1e\x7f\xff\xf5?\xe1\xef\xbf\xfc\x13\xaa\xfb\xe7\x7f\xc6\xef\x7f\xfd\xcf\xf8\xfd\x1f\xfe\x8b3\xdeZ\x1e/\xd5\xc6\xbf(\xe7\xc4t2n\xc3\xc5e\xfdRo\x94\xf7W\xc4\xb65\xee\x0e\xad\xa2-\xc8\xcb\x8d\xc3\x8a\xc2\xd7\xfb)\xbbs\x10\xfc\x9ff\xfbv\x08\x9e\xc6\xa44z\xd1\n\xdcOi\x16M\xfa@\xf5\xdd\xb7\xd1\x1ar\x9d[R\xd8\xaf\x11)\x01E\x88\x88\xbb\x82\x1e\xe4\xcdGP\n~\x8c\xef\xca\xf6\xa7\xd4c~\xbc\xf3\x10\x07t\xed\xd5\xad\xa87e\xe7\xd8\xd6\x8cP\xda\xe2f-X\xa5\xf8E5\x13\x0eb\x9f\x15\xb1\x1a\xa7\x899\xabJ7Kz\x9c\xd8:\xa6\xfb\xc5\x0b\x19\x1b\xd6\xb5_\xa8\x17\xb4\xea\xd0-D\xc0\x8a>N_\xabA\xa8iHG\xc5X\x1c\xa0\xc0\x9c\xf1\x12/+H\xbf\xbb\x90\x82\xaf\xd7!\xc2\xaf\x99pGZl\xcd\xb7bBn\xf9\xa1\xc3\x07\x89\xa3\xc1\x9d\x91\xf5\x0d@\x8b\xee\xca\xdb\x9a\x81\xa3\x89j\x0c[\xcd\xdd=\x83\x11.\xb2U\xc14E\xea@\xd6)B\xb5\xd4\xe1	\xab\xa4Z\xa8\xba\xa0\xdd\xb9XwN\xaf\xc5\xc8\xbf\xe00M\xf5\xd2\xfb\xab\xf7i\x8c\xd9f;f?\xf2\xcd\x9eaV\x80\x98\xf8\xc8\xbf\xb8l\xdc\xd6\xe8|]\xb8{\xce\x11\xd2v\x01\x8c\x87\x0f8\nq\xf8\xb9\x99\xf2\x83e$A\x90\xbb\xbcmq\x14\xb5\xc5aNR\x1bb\xbb\x01&+\xe6\x06\x08\xc2\x94\xc6\xd9\xe7\x00\xb9\xaa\xa8t\x95\xbc\x87t\x7f\xe00\xce\xc6\xbe\x9d;\x8dA\xdf}0\xd4/\x18\x9c\xa9\x99^\x84\x02\xf0\x7f3Zb\xb5\x85\x08\x18\xc0\xfb\xcc\x9d\xd5\xb8jO\x80mE\x8a\xbd\x99lL*/\xe95\xe9\xc1\xf5\xd5\x82E\xa0\xa9\x9caf\xe3\xfe\xb4\x922\xa7u)S4k\x9cz\xdb\xcc\xcdk\x9b\xa1j\x1evE\xbdas\xf6=kL\xa3Z2\xdfN\xafc\x05\xb4V\xb8\xd6\x1a\x11+\x91x4\xcf\xbf\xe9\xf5\xcb\x9d\xd7\xb7\xa2Ql\x87\xc2\xb0Q\x90\xe4\x0b\xad\xf3-G:\xe6\xa3\x1b\x98\xbbc\x915\xf5\xbc	R\x94in\x9e\x02\xa7\xb2\xb1\xe4\xff\xdd\xf8\xf1\x91\xa0h)\xbaG\xff\x0f\xe9\xdeV\xd4\x87bO\x93@5n?\xb8\xf7\xbf\x1e\x81=d\xcc>>gJ\x8d\xf8\x1e\x1b\xa2\xa0\xfb\xda\xb2	\xf7|`w\xd3\xf4c\x996(\x82Y\x97H	.\"\x80K\xbfx\xda\x8ftxr\xde\xa0\x8aJk(\"\x10\xd3:A\xcc\xe9	\x11\x8e~6:\xfa\x06\x14e=\x96\x85_\xce\x85\xb9>\x0c\xc6\xb2\x18\xed\x8c\xb2s9wB\xa7\xcc\x9d\xb1\xf6\xf1\xce\xb6\xe2\xc2pnmh?\xac\xb7j\"\xf0\xc0\x1c;\xf8\x13\xa2\xbec/l\x81\xf1)\x07\xd0\xe9pl\xc9Y\xb4\xdc\x8a\x8b5\x9fw:/*;y6\x06U\x01$\xc6\xd8\xbcj\xb9\xb0\x9add\xb8W\x0d\x84>\xee\xeb_\x10+\x07.\xbc\xd9\x0fe&je\x01\x9d>\xf6B\x07\x02\x83*\x07\xf5SWfVjMz\xb7\xb7\xf7]\xd4\xdb\x95\x19\xb5#\x97\x06\x8b\x91jT\xf8\x0c\\\x15.6\x1b\xb7Bn<\xf2\x84J\x11\x03\x0e?\xaf8\xe7\x98\x17\xdd}\x85w\xae\xcc\x03;\x90\xe7\xd1(\x18o\xabCJ\xb3\x0c;c\xa6\xa5E\x9bT\x1b\xde]!x2\xca\xb6\x8du\xcclN\x05\x95\xaf\xb9\x9cZj\xdf\x9c\xfad\xaf%\xb8%hTz\x1a\x06\xad\xfd\x18\xd5)z\xe3\xb2\"\x05\x0d:\x8f\xd0P1\x97.~`\"\n\xec\xf2\xde\x18z\xec\x15\xdf\x0d\xf8n@wg\xad\x04DL\xe4=\x04\xc2\xd1\\\x14\xa3\x19\xe2\x19\xc6B\xad\x10u+\xe8\xf2M\xb49\x1c\xf1\x8d\xdeX\xac\xc6\x82f\x10\xf7\xf4\x9d\xad\x98\x1a.f\xdd?T\x84y\xfd\xd2\x10~\xb8*L\xe5\x94\x0f\x03p7\x1c\xc3t\xa5\x87\xd0l+\x02\x12\xbf\xa7\xd7B\xf3:\xa9l\xf8E#\x90M\xb7\xe2\x9au+\xf5M\xe9^\x89\xa5'*\xd4\xfe_\x8f\xc2\xe3\x1e\xf2\x1aU\xf8\xfc\x99\xa0\xe5\x04\x94|\xb1\xf0\xa3\xb2\xccY\xf9\xbfg\xdf\xb9\x0b1\x19\xe5c\x91\x08 \xdf@u\x06\x96hA,\xd1B3\x9f_\xb3W\xd1\xe9H\xaa\x85 rI\xeb\x02\\\x03\xc6qN\x9a;\xbde\x9as\xe6\xce\xb1\xe7`\xd6\x8a\xa4\xdev\x9e`Q\x18\x9b\x15\xc6<\xa9w\xb9\xe7\x85n\xa1_\xc8\xa5\xce\x92\xd0|!\x92\xbc\x1d=O\xdc\xdf\x85\x05\xf8Cam\xb4\x96\xc4\x02y\xb5\xc3\x0c\xdb\xbdO\x96\xb06X%\x83;\xac\x8d\xdc\xd9a\xac\xc7t\x1d\x9ea\xc7\xe4k\xb16\x1ds\xc4\xf6\xa6\x03^\x11\xe7P\x00\x1d \xce\xa9\xb1\x9b\x1a{h\xdc\xbf\xd0N1\x97\xc3Q\xe6\x97\xd9\x1f\x97\xcb8\x7f\x13\x158r\x81\xc2\xa2\x93tW\xc3U\xf0\xaeiLL\x8c\xdb\xfd\xbcV\xb0\x9b\x87\xb99\xc2\xa6f\xb6\xa4\x94sq%{\xfd\xab\x17\x00\xc36;\xbc\x7f\xa5\x0f\xb6\xa5\xc4\x83\xd1\x959\xfe\xab\x15^\xe7\xe9FWc\x0c\"\xc3\x95-\x1a\x85\x14\xd3\x812\xcb\xd1d,\xdd\xc5h2>\\\x812\\\x1d\x06\xe3\xd1\x04d\x81X\xd9\x1b=p\xf6\xf6[\xcbltx\xed/\xba\xc1X\xdc\xc9\xfb\xf5z\xbd\x0e\xaf\xfd\xb5X\xafC\xf7\x1a\x1c\x9d\xb1S\x1fy\xe2\xec,\xbc\xf6\x17\xe2,\xc4\x1bfk\xae\xb7\xe2V\xb7I\xd7\xac\xb6\xe6\x0d\x90I/w\xb6f\xe4\xa6\x99{\xc9\xc93R\xde\x9c\xb7\xc6$\xbco\x8b\xb9K1\x15\xb1(D\xee\xc1\xe5\n{\xf2\x12h\x97\xe2\xe2\x96\x85\xbe\xdf\xb0'y\x96D\xb5[\xea\x1bS\xac\xacIN\xda\xb7i<p\x0bY\x1c\xa6\x87\xee\x8a\x94\xe5\xde7\x109\x1e\x1f\x89H\xae\x06\xbc\xd5\xf8|\xf5Bw.\xe7\xf5\xa2HO-r\x14\xe5m\x8c$\xb9K\xe7\xef\xd9\x9bZ\xea\xfd\xaa\xd3\xafr\xca\xd8s\xf8\xed\x11v\xb2\x96\xad\xc8\xf7x)\xd0f\xcc\x1a{\x98y\xfb\xa6\xc3M\xff\xc2\x9dC\xa9:\x97\x1f\x86\xa3\xf9\xdeM9\x91{\xc5\x80\xa7\xdd\x98\xd5\xe4\xc4\xf7\x89\xa9\x1c\xe5;2\xb8\xbe\xa1%\xf0q\x7f\x05\xf4\xde\x95\x98\xee\xf2\x80\xbd\x10\x02a5\xedE\xa7\xe3\xee~\xbf\x12\xdfE\xd7}z\x18+]\xbc\xc7\x8dX\xc9\xee\xaa\xe2h\xd5\xfe\x7fj\xef\xf9zu\x13q\x85m\x9f\xd7\xf8\xd8e\xc5\xc72\xba\xa2X\xc8\xab\xfe\xc2re\xba:\x9c\xc1c\xa9\xcf\xfb\xbam\x83\xdd4N\xbf\xf9h16{l\xcf\x8eq\xafE\" \xdbL\xcd\xb1w\x83-\xb6\xb5\x93\xee\x91\xd3\xb1cC\xc2\xf2\x83\xcb\xec\xce\x11\xc9u\x9a\xe51\xa9)\x0fz\x0f\x81\x9c>1 \xa78vB\xc7\x11\n\xb9(t.\x91\x12\xcb\x11\xc5\xeaR?\xc1\xcf\xfaC\x9c\xa9\xa1\xd2r\xf7\xc4e4\xf9\x0c-V:}cA\x85\xf6\x04\x83\x89\xb6(\x9f\xf9\x16\xa9j\xa0l\x8e\xa6\xd3$\xbd\x0eOI\x9b\xfd}\xb4\x0c\x83\x9e0Y\x82\xc2{\xa3y\x0d\x9e\xb5jf\xb5j\xf8\xf8\xf8\xd8\xd9\xea\x86s\\\xa4~\x16E\x91\x03P4\xb1n\x8e\xe4\x1e\x15\xddoM\x8ffty\x0d\xad\x95\xe9\x07\xa5\xcf\xb2\x8fi\xbb\xa1\x8e\xc9\x1c\xe5:\x86\xeeP\xce\x19\xfe\x1an\xbe\x8e\x8a\x18J\n\xda\xfbz\xd5\xd12\x0b\xd3\xcc\xbd\xdf\x1aV\xcbz\xc7\xf1\xaa|HY#\x1f\xd2V\xdcO\x93\"\xba\x9c\xc7\xaf\xb3;\xac\x17\x95O\x0c\xd8G+I\x8edud\x171\xd3\xad\xe1\xa6\xc3d\xdf\xda\x90\x88\x1b2\xab\x921E\x8d\x8f\x8bu\xb8b\x10\xc4C\xae\x95g\xdf\xf1ZX!\"\xce\x0f4x\xaa\x9b6O\xd2\xcf\x8e\x956\xa7X]\xaa[\xfd\xb9F\x03=\x98\x8a\x89\xf9}%\xa6\x9d\xce\x9c\x82\x10'sJ\xc3g\xa8\xb8w\x7f\x9b\xa4\xd3\xec\xd6\xcf\x96q\xeaN\x05%b\xe6\xa1\xa5\xfd\xe0\xc0\x0fX\\u:\x93\xafx\xff\xca~\xdfl)U\x85\xda\xecsO\xcc:\x1d\xf5{\xa2\xa8\xcbR\xa6-\xf3p-\xcbVG\xb5\xfe\xb5O\xdaF\xb9d\xa0\xc9k\x1ea\xb9\xe4\x1f\x9cd\xeb\x1f2\xf7Z\xb0\xcfY\x0d\xdd\x9e\x95\x94I\xdd\xe7\x8c\x1b\xcd\xfb\xd4\xf1\x94\xec\xa5\x8fnxS\x9a\xc5\x0b\xca\xe0T\x99\xb64\xc7\xab\x18f>\xf6=\xc1\xf7\x01k6X\xf8w\x87r\xa1\x1alLAx\xd2\xe9\xb8\xf6\xb3\xc7G\x9e'\x00\xc2h\x95qY	\xe8\xd2\xf2\xa8>k8\xf3N\xc7-l\x8e\x9c\x1f\x10\xeb\xb8\xf0\xd7\xa8\x9c\xbb\xac\x0b\xe1\x11}\xd9z\x08\x81\xb9\x90\xc5f\xc3,B\xaa\xc4\x98\xea0\x16\xa3\x85\x7f'\x16\xfe\x9a\x99\xd9\x1bi\x89\xd0y\x0bg_l\xb1\x1cc\xb5\xf9\xdd\x1bOLjW\xad\xd3Ns\xb7\x96\x0bN<!\xeedYw\xb7\x1cq:\x1b\xe1d\xcbh\x92\x94kg\xec\xf5\xefT*@\x1e\xa6\x06\xadf\x01\xf9bW\x83\xbd\xf4\xef\xba\xeb\xd11\xb4\xd8K\x7f\xdd]ChRS\xc4k\xebp\x0d\xd9\x97\x8a\xf0\x08\xea5v\x88\xc2\x87\xeb\xd1\xd1X\xe4\x9a\x14)\x82\xaf\x12>;\x9eVn\xdd\x19\xd8l\xc8\x8c\xbf\xa4\xee\x85>\xf7.p\xeeybuf\xac\xb7\x1f\x14P\xc5[u\xa2\xc59\xdc[\xcb\x08(\xca\x96uZ;\xd0:\xc5<\x81\xd1n\xab\xfaw\x06\x94\x80\x89Fe;\x9aZ\x08\xa3*8\x81@\x00\xc6\xe2\xdd\xb0\xd5B~\xe5\x02AX\x8b	?\xc2\xc8-\xaf\xdcX\xba\x04\"\xa99tz\xc2\xac\xea#SM&\"\xef~Z\x8b\xf8\x16y\x85\xc8Ph\xe4i1\x89\x96I\x19\xcd\xc3d\x94\x8f\xb7\x94\x8fh\xfeB\xbb'\xf5\xe7\x87\x87^1\x8aG\xf3\xf1X\x96\x87\xe9h>\xeeg\xfe$\x9a\xcf]\xcaJ\xe9m\xb7\xee\xc8\xb9s\x84\x03;\xde\xaf\x068\xd5\xe4\xd3\xd7\xfdt\xc6bD\x11'\xcaP.\xe8\xb7\x03\xb3\xa4\xbaL\xa6\xf0i\xfa\x08\\\xd7\xb7C\xf9&\x13\xaf\xea#\xa2\x8c\x9c\xac\xedM\x16\x80\xf7\xd0\x01\xe0\x17\xa6Z\x1d\x07~A\x11\x01\xbf\x10	\xe4;\xcb8\xc7\xe6\xad\xdd\x9bFe\xa4\xf0\x1e\xb9\xd0\x82\xc0L?,#\x0eFb\x04p\x13\x9b\xa4\xe7\x9d\x18j\x99l\xfb\xaf\x86\xfbB\x17^\x0d\xc5,+\xcax\xfa\xdav\x181n\xd6m\xf5IYj7\xfa\xe8S\xd5\x81\xea\xf5\xc6\xdbV'\xf5B0\xef\x0f\xed\xee\xee\xad\xa16(\xb5:\x14\xcc\x92r\x9a\xa0\xdeZ\xdam\xed\xec6\x1a3\"\xbf\x1e'\xbd\x04\xed\xa4 U`\x8d\xe2l~\xb8q\x13\xf6\x9chH\x1a\x8e\xe7\xd5\xcc\x93z\x9aE&\xe3\x07\x92\xec\xa6\x87\x0e\xed!N\x86\xad*\xe7\x9b\xec\x94\x81T\xd8\xf5\xfb&\xcfns\x01I\xe9\x02\x0c\xcf\x9f\xe8$\xcfT\x05b\xdc5X\x8c\xc6A\x05\xe2?\x83%>\x18\xfc\xc1c\x83\xcfW\xb1\xea\xb5\x0e\xea\x0e4\xda\xa2&\xd3$,\xda\xf9\x0861\x99\xc0\x1a\xc3\x95J\xfb\xbb:8\xccn\xaa\xeb\xf5\x9d;\x1c7\xc9f\xe3\xac\xe9\xc7\xc0\x8d%\x01\x94\xf0\xf6,%\x97\x188k'\x04Vd\x88\x12V\xf2b\x141Y\x85\x92\x81\xde\xf7!\xdfd%\xb5^\x12i\xc3\xc1\xa7\xd4\x9d6\xcb\xa3\xf4\xee9Z.\x86\x17\x0dRg\xb3\x82V]\x93J\x8c\xd0'#\x1a\x983\xb3W[F>q\x9b\x1b\x1an\xa0\xd1|\xb2\x9aGe\x0c\xa7\xe5\xe6\xce\xa8\xbb.\xdb\xa4!i\x1b@\x85\xa1X\xc0no\xfc[k\xd4\xc1\x18\xcb\x11\xf2\x85\xb9s\xe1\xc27\xea\x89\xa0\xd7\x83\xb1cT\xb2)\xae\x84`\x0b\x14\xd6\xca\x1b\xea\xe3\xd0\x1d)i\x94\xc8\x80#\x9c8\x9d~\xe2 '\xd3Y\xc4l0b\x96\x89\xd9\x18\x00,\x17~s\x88\xd8\xf0\xc8;\x00#\xf6q\xd8\x12\x1c\x80\xee\x162\x1f\x95P}\xc0S\xbf\xef0=\xc0\x8aH	\xd7\x08\x15H9'q\x1d^\xfc\x10\x0b\xf4'>f\xee\\d\x82p\xfc=/\x9c\xcb\x8f\x99[\x88Xd\xb8\x01h\xd3\xb1,\x04\xea\x95\xf3\xad'\xee-j\x15\xbe\x1d\"_r\x8d\xfa\xe0\x1e9\xa3\xe5q\x11\xdb\xfa\x0c\xa2\x1a\xa5\x94m\x83\\\x9b\xb3V\xaae\xdc\x12\xaa	\x95\x7fT8%\xa2\xbem8\xd1\x17\xcfv\xcbZqK\x06\xf8\xd2UZ=\x92\x89o]q\xcd\xb5\xfea\xd8\xeck\xf1g\xd5\nO\xfc\x95\x7f\xa8\xbe\x97Y^\xf7\xcd\xde\xd3u\x0d\xecRkE\xdbw1\x85\xfa\x1b\x98\x19o+\xae\x92y\x19\xe7\xe8\xd9\x031A\xd5a\xf3R\xe6\xa3\xde\xb8\xd3)_\xc8\x1c\xc6\x97/\xcdG\xda H\x15\x05\xda=U\\#\x9a\xba\x8ej\x16\xee;Ul\x8c\xd5\xbd\xbeI\xb7\x16u:\x1f\x9b\xc8\xba\xbc\x12\xd8@\x1d\xd1vk\xa4OJ\xd1\xff\xbes\x1bG\x9f\xbf\xa3oa\xa1G\x83\x18|*\xf8\xbcx~e\xc5\x81V\xac\x12,p\xc0\x06\xec\xf5\x8b*\xc3_\xa1U;sN\xa2\x94\xc11\xa3\x84&\x8c\xd3(A\xa8\x92\xf3\x17\x18<1\x91\xf3\x97\x18<N\x1cw0\xebt\x0e&\xda\xcd\xb3\xd7_\x11u\xc3\xbe\x81CxB\xbf`qO\xf1K\x1b&#\x82wM\xb7^X\xef|J[\x04\xa1\xcc%\x11\xf3\xc8+\xd9\x137ri\x04\x1a8\xc6\\\x1d\xe6xP\x86\xe7\xd19%\xf2\xd29\x912\xc4/g\xa3t,s\x113T\x1ay\xfd\x00\x90O\xd1\x92\xfa\xc0\xa3P\xf9j\xb9\xcc\xb3\xbb\x04\xb6y\xc5Q\xb99#.\")\x1a\x06\xf1\x0dX\xbb\x9f\x86\xf2\xddP|\x1e\xcaJ\xab\xa4\xa9\xe6\x0ehV\x13'\xebW\xbe\xde\x8b\xcco1\xd4\xfb\xa1\xb4,E\xd4\x89v\x8c\xfc*\x9c\xacju\x86\xbcR+H*F\xe2\x82\x820\xa4\x0cMa\xd0\xeb\x89\x8a\x82\xab\xe7\x9a\x88\xb3\x9b`\x92\xe2\\\xd2>\x83w\xd6E\x92R\xb9\xda\xe3\xc6\x1dr\x8a\xa6\xc6\xe0\xfdV\xa7@\x9dV\x04\x03\xfc\x8eC\xbb_S\xaf\x00Mc?\xbb\xca\xaa;8\xd7\x86\x98\xca\xb8*f\x14I\xf8\xe0\x9c_El\xe0G\x86\xe4\x92\x07\xb5\x10;}\xe4\xc9\x919Q\x84\xf95\xe6\x80\xba\xbf\xdd\xc0S\x91\xea\"\x97B\x16\xaf^\xa5\xd3\x8f\xb3x\x11S\xfa%U\xe94{\x97&%y!\xb4\xbb*\xea\xcd\xaf\xaaL\xeb(T\xd8\xf3\xb5zbo+\xd4\xcf\x96*\xacW\xfb\xd9\x85?\x89\xd2\x9b\xa8\xf8\xb0Z.\xb3\xbc\x8c\xa7\x9b\x8d\x1b\xfby\x1c\xcd\xa1\xa7\x95\x07\x01W|\x81\xdd\xa6\x9a\xafG\xc4-=\xf1\xa3>g<\xf1f\xe8\x8e\xf8HwX\xd1\xac\xcf\xf3\x11\x0e\xf7\xda\x11o\x1f\xd3\"\xf1\xee90\xd9\x9c\x03\xb51\x1e%\x80\x10\x88\xc9\xc9dL\xc4^\xa3w\xb5\xcd\x9d\xa1\xc4\xac\x0843k\".\xe9\xf8Ut\xda\xd5\xf7\xae\x93\x9b\xf8U\xb5. \"4\xef\xedJ\x07;\xcbI\xcd5\xd8@U=j\xac\xc8m#\x11$\x95\xd5\x04A\xb5\x16\xc0\xea\xa8n\x0c4\xed\\F\xfe\xc5\xc5\xf4W\xdd\x845\xa2\x0d\xebwH\xb3\xf0j\xe82\x98''\xf1\xc9<\xaf_\x8e\xd4\xbdC\xa8\xc5\x92\xb1\xccMj\x03\xbb\xfb{\xba\xc4\xe8f\xbcT.\xfe\xba\x9a^\xc7\xafV%\xa1_\xbb^\xff\xa7\xa1\xd5'\xed\xe8\x1e\xfbFD\xed\x97\xa3d,?$.\xfe\x9b\xa9\xb2$c\xb26V[\xec\x83\xe2\xdb\x89-w\x01\x1dI\xde\x96TJ{/q1\xed\x87\xb9\x15\xf5F}\xa9\x1f\x07A\xad\xd1\x89w\xaf\xf9\xc9\xa4j\xf6\x98#]t\x8b5\xaf\xe4\xab\xf6\x186\x9f\xf8\xc5\xa4\xd3\x89\x07\xba\xa5a<Py[B\xe6&\x13\xb0.\xfc^\xcd\xceZ\x1b\x06t\xa3\xd9\xff\xdd\x9e\x1c\xf4\xf4D`\xbd\xeaO2\x0e\x87M\x06\xd2\xd6\x15\x85\xd3\x98\xa9G&k\xc1\x97\xb1\x96{\xfa\xe9(cYE\xdbr\x07n\xa2\xef\xd1\xb48c\x89#\xbe\x04A\x08\xdf \xbbCu\x12U[\x19\xda\x0b\xa8X\x9b\x0dU3\xe9&\xd6KT\xab\x1c\xa5\x0d)\x94?\xb1\xf5\xb6e\xa7cO\x19\xd0\x97MGR\xc8\xe5\xd9\x83\x1b\xa8o\xe5M\xeet\x0et\n!\x13\x1a\x14\xc9\x1e\xb9\xbd0\xa7\x13\xbd\xc8\xc9+\xcb\x99De|\x9d\xe5\xc4dd\xa3h\xcc\ni`\xb3x\xe8\x1a	#\x91\xd7Ox\xa7R?\xc62\x15\xfa\x0b\x98y\xea\x02\xaf\"Qv:\xb6\xb0\xda\xaa7(\xf5A\xa63\x97\xfe\x10\x15\xaf\xe6\xf3Wwq\x01\x8c\x9a\xf7\x005d\x12\xe9\xed\x8c\x0b#0\xd6\x1a\xa4\xcd$\xaeu\xb3bC\xf9\xe6\xd4\xeb\xcf\xdd\x94\x8a\xea\xc6\xed\xd7A\xa8wX\x03\x91A\xdd\x10\x91z\xa1\xa9Fx\xd13\xc3\x04tc\x1e\x85j\xa5\xef\x824\xa0\xfd\xe5\x17(\xa7w\x7f@8\x8fRQ5]\xad\xb0\xd6\xbf\xd9n4w\xe5\xa0Z\xeb\xb5\xa8\x90\xad\xd8;\xc8\xad\x11\xba\x07\xda\xf5\xeb\x91=\xecu\xd7+7m\x8cr\xdb\xc2L\x99\xb2g\xe3~\x04\xbf\\kaa\x7f\x10\xec\xcaA`z\x06\x90\x9c\xdd3\xd7j\xa2\xda\x10\xbb\xa0+\\\xd2\xf1\x8c\xf8Tgc\xcc\x89n\xdf\xae\xf1\x06z10\x8f`\x8f\xb1\xae]\xc6Ur\xeaN\xc7\xbax\xbb\xca\xa3\nc\x14\x91v\xbd\x9e\x8a\xe9\xd0\xc0\x14\xd5\xc9\xd8\xae\xeei\x1bn\xb5\xfb\x15me\x1a\xc0\xb4\xaf\xb9\xc6\xfb\x1a\xaf\x86V\xcbC\x14\x82\xc2\x92\xc75]W\xfd\xe0n]\x0e\xf6\x00\xd5\xce\x94\xd4\xbb\x7f34p\x16\xf5\x15a*\xca\x00\xe8D\x9a\xe5\x18\x9c\x00}Y$\xd5&\xe1\xbd\xc2\x8a,:\xe2\x1b\xad\xe0\xc1\xe1S\xb1bXF%\x9d\xf4\xf1x+\xdaG\xb7\x19tn\x7f\xc3\x8e:\xa7t\x17\x0dM\x10\xa5J\xfc9\xba%\x19i\xff\xb0\xf0z\xf9{\x19\xc1X\x9d\xca\x04\xc51\xea\x8d\xc7\x9dNu\x19\x8c\x91\x8b7Q\x0ft\x01\x81\xeb\x00J{\xf5\xdfP\x05\x00\xd7V\x9c)\x8d\x08+\x8b\x1b=\xd0j^\x93\xd9\xe8\xe7x	\xf6(\x85\xf7f\xc5\x10\x02s\x85N\x1f=\xfaZ\x02\xaf\xa9\xa0\xc1\x96\\\xc7\xaa\xafm#\xc5+\xf8@\"\xa5\x1d\xb1\x1f\xb1\xa9\x90\x97M\xf5A\xbc\xe1\xef*\xca]\xc3\x95|\xb9\xc5\\7\xb2f\xb6Ud\xd2e>\xd0o\xab\x07|\xfe\xea\x06\xd7\xf9\xd0\xbeE=j\xfc\xb0>n\x93GI\xfa(\xf6\x00B\xd1\xa4X\x89\xd7\xe9\x80\xc5\xf7\xb5-\x816\x91F\x9ex\x84G_[\xcd\xc1\x17\xea\xa9`\x8f\xb4hQu\xb0\xd2\x05U8*\xba\x941\x1bl=1\x1c\xca\x1f\x9b1\x8d\x95|\xdf\xea\xee`\xac:\xba\xdc\x19#\xe9\xda\xc4D\xdbu\x00\xf5\x9bX\xf6	\xcap[G\x1c\xb2\xc2SJN\xde\x99V\x9a\x9c\x8c\xdd\xcc5!$\xbe&\xb9r\x13p3\x14\xb6\x0f)\xcb\xbb\xcf$\xee\xf4/\xf38\xfa\xbc\xcd6\x1b\x1dd*\xef\x17\x9c\xe8'\xd2\x14\x00hi\x1aL\x1a\xc7\x7f\xba\xf5D\xe6W\x8fu\x9a\xbe\xad\xb5\x0cj}\xd5\nf\xee\xabH-\x00\xb9x\xff\xa9_\xc9JI\xdd\xb6\xc0\xccHF^^\x91\xf6\xec\"'\x887\x9c>\x87IW?\xeft\xa0\xa8\x89D:\xca}\xcd\xcd\x80\x98\xd4\xae\x01\xf0%\xf2&/c\xce\x86\x143\x7f>\x94\xee\xe7\xe1\x9e\xa9\xf7\xd9&\xba\x9bM\xba\xa1\x9c\xd1\x8eAd\xbb\x0f\x9d\xe5\xcc!G\x1f\xfa\xc1Vz\xfc\xd4vs\xfc\x86\xd5\x9f\xf1\xbd\xc9\xc0\xce\xbf\xeb\xc6fJ2\x1c\xb9'O\xc5\x93\xe7\xe2\xd9	\xf9\x06\xa1q\xafM\xb1\xf0\xde\x04\xefT\x9e;GW'\xa7\xa7':\x00\xd6?\x15l\xdc\x0e\xfdc\x053^\xf7\xf4i|\x83\x00\xcf\xad7\xb6\x0dw$@\xc7C\xab47\xb7\xa8\x82\xe0\xc9S\x11<;\x16G=\xd4q\xe29b\x16\xa5\xd3y\x0c0\xc2\xd09{\xe6\x1f\x89\xe0\x18\xb8\x9f\xfe\xf3\x1f\x9e\xf8\xc77\xcf\xfd\xa7?\x1c\xfb\xc1Mp\xe2?\x9f\x1d\xab\x1b3\xa0\x84v\xf1\xe3\xd8?\xfe\x84\xe2?<\xf3\x8f~}t\x06d\xd4\xa3\x13\xff\xe4\x87\x13\x14\x08\xfc\x93\xd9\x89\xff\xfc\x13\xee\xf0C\x80\xc1\xd6\x1f\xe2\xce\xaf\xba\x11\xe4\xde\xe5\x04\xbd\xde\xbf3wjC\xf0\xbb\xe8\xe9\xe5S\x8a\xf1\xa5\x84\xc7\xc3<\x9e$\x85\x89\x8c\xa5{\xdf\xd5\x83k1\xe5o\xe32J\xe6\x08A\xa6\xab\xa8\x8c>\xcc\"Xg\x1c\xb0a\x8e\xd0\x1a\x17\x94\xf85\xcb\x16?e\x93\xcfHQm\xb4\x17\xd5\x94\x91#\x16\x16\xe4\xe5\x07O\xbc\x1f\xca\x8f\x99\xf8\x96\xcc\xc9?\x0f\xe5B\xfc\n\x1d\xe4\xebaM\xea\x14\x9f\x86\xf2T|\x0f;=\x96\x80\xa3r\xa7\x0bgB\xdd+Jr\xccq\x8a	\xb5\xd9\x19\x8b_\x86r\xf8\xc5\x85\xbe\xa3\x8e\xd32\x0c\x90\x18\xe7\xd1\x1a\x8eD\x96\x1aNq\xe7\xcczr6Xz\xa0\xa6\xfe\xdbt\xaa\xad\x15E\xf2k\xfd\x19y\xb2\xd5\xee(\x8fG\xbe5\xcf&\xc4r\xf2\xe54\x8f\xae\xaf\x93\xf4Z_\x9a\xa1\x06\x11\xb5\xe0\xd4\x1f\xc8\xb7\xfcKKL\xb0\xa2\xec\xb5p\xe0[\xe5k/\x9c\x0b\x0d\x05	\x87	\x05@\xe9\xb0>\xce\xa6\x82\xcc\xf23\x0b\x0d]\x80\x06\xfe\xd7,9\x8b0eM\x82\xf6\xc4A\xa0\x13;\xb7\xd4\x87\xf5\xe4x\x03\x17\x11)\xfa1$\xa0\x94\x93\x12\xa9\xcc\xd0Z\xb3\xb6J\xa6\x9b\x0d\xfb\x90\xaf\x92\xf9\xf4S\x12\xdf\x1a%X\x85\xa4\xeaz^\xd8\xee\xa0\xb7\xe5\xa4\x04\xf6\xc9\xd9>^(U\x1b\xaf\xcb\x87\xc6\xcb\xdb\xb6\xa1\xc3\xb7\xd5\xcc\xc5~S\xd5Ug\xf70\x7f \x91K\xe4\x13\xad\x02\x85yP\xc0\x0f\x96?\xf1\x1a\xab\xdf\xd5*g\xe6\xcab\xb9\xbb\x01\xfc\xcb(\xff\x1eu\x93\xaa.\xbfe\xbb\x9aZM\x15m\xb6\xea\x85\xcf\xf3\x0f\xb4\xee\x1b7+\xa2\x81\x07\xe4\xc8\xa3\xe1\xf0.\xb4\xaf\x14}\n\x8ch\xbd\xd9{:][MZ\xcb\x14-\x13}\\_\x805\xa4\x03\x95]\x14\xd3\xdf\x00\xa5\x96\xc9\xda\x9a\x96\xf2\xf5pp\xafN\xbd\xd4\xa7\x93\xae\x9b\xf8w\xdd\x84\x9d\xe9p\x08\xa6\xec\xe3\xd4=\xeeu\x9f\xf2\x91\xa4\x9f\xf3\x81x\xdc\xdb\x86\\\xcbSz'\xf1\xd7\\\xf2\xb8\xa7\x0b%\\\xcf\x16\xa1\xcb\x91e\xd4\x9c\xba#v\x8e\x13\xe4l&va\xed\xccH\x01=c9\xc3N\x82\x81\x17\xc6+\xd8y3\x0bX.\x87\xa7_DIw\xd9y\xcf\xeb\xd7I\x92\xbc\xbf\x0bs\xffN\xac\xc3\xdc_k\xf3\x03\xe8\x9b\x1c\xe5\xdc\xaf\x9c\xdb:\x165\xe5\x9cM\x0f\xb4\x12\x14/V <[Q\x9f\xf5\x87\x16\xb6\x9e\xdb\x8aV&\xb2F\x96\xd3\x96\x05\xe1\xef\x91\xd8\xc9\xec\x98\x1a=_\x02T\xa9\x82\x1dp\xf7\xaf\x7f\x91K\xb7\x8d\"o6\xf7[\xcf\xcf\xb4\x0b\xc8;U[?RN\x80\xc9\x01\xd6\xcdf\x93\x0d\x90\x82\xf1\xf5\xb0\xd3\xc9\x06\xf7\xe4\x92\x10\xe6\x83Q\x17\x19\x9aC\xfc\xeb\x06\xe3mX\x0d\xe0\x81\x94\xc9f\xd3(\xda\xe5\xb2\xc1X\x98\xf0<\x13\xb3\xb7\x0d\xab\xb2\\\xf4\xabJ\xaa\x82\xf8\xbc\x8e\x86+w\\{G\xd1\x18i\xa8w\xbc\x1ac\xff\xae[\xf8w\"\xf6\xd7\xdd\x02\xde\x8d*\xdc\x16T\x8aS\xf4ZS\xca@|\xda\x08\x85\xb5\x00!y+vh\xc9o\xd9\xecT\x8fH\x1e\x9a8\x1d \x1b\xdf>:\x1f\xba\xf7\xc6\xa7P\xc7\xd4\xde\x85=\x01P\x13&\x0cP\x13\xf36\x84P\xae\x9d\x11\xef\xc1\x05\x1a\x7fE\xd3Vb]A\xfb\x7f=\n\xbb'\xbd\xad\x89~1\x9f\xfb\xed\x1fq(\x95\xcf2\xcaqxR\xcd=\xc1`\xd3a\x95\x02\x85R\xeaP\xb6R\xfa\xc5\xaa\x17\xcf\xc6T\xd0\xcbt\xcf\x806\x162\xdf\\\xe61>]\x11k\xb0\x1dZ\x7f`k\xd9\xcc\xd0\xfb\x80E\x89\x91\x0b8\xe1\xacW\x9c\xcd\x0e\x18\x8e\xf0%\xa0O\xbcM\x16\x83\xfa\xa5\xeb\x85\xa5\xda8o\x93E\xdfh\x17\x8c\xcc\xa4c\x91\xd9\xff\xcf\xcd\xe0\xd9\xe2\x1f\x7f\xe3\"\xbc\xb4\x8b\xd0S\xaf\x1f\xc9\x11~t\xe1\xc5\x82`Ke\xb9\x9c\x8b\x15\xdcx0\x7fc1S?\x11\xac9\x91#\xd2\xc0\x08$\xae\xea	\xdc\x9aB\x15\x7f%g\x88\xf8\xa1\x9c\xcf\x94K\x06\xa0\\K\xd9\x13\xd7*\xac\x83\x96e\xf5\xf41\xea\xf0\xfa\xca_\xcaE~f3\xc0\xc4F&W\xee\xf5\xcb^\xa7\x13\xff\xbbkoy(\xaf*\xc3}\"Y%\xb4\xd9(\xff\x83\xd2\xdbl\x1c\xa2\x97\x18\xbeA/|\x8f\xd4\xe5\x91X\xc1\xec\xd0G\x02\xf29\x0c5\xeeD\x89\xa8\xa3\xc9h\xc2bq\x97\x82M\x05`\x148I\xdah:\x9a\xee<\xf4\xc2\x83\xa4\xd3\x81+\x8a\xaebY{\x87\xae<Q=Lk\x0fq\x85.\x88\xb9L\xb6L\"\x16-\xfb\xb1\xff\x00\xe54\xdb\xef\xa2\x02\xc1\xe0@\xf6\x89\xde^\xb9\xab6\xd8\x02s\xae\x9cw_\xefn\xb2\x85e\x16\xad\x17\xf1\x8d\xa8\xe7\x90\xda\xe9\x95\xbet\xe1\x16n\xb6<v\xe9\x11\xed\xd2\xafi\xef\xedN{\xa7\xba\xbd\x0f\xb4\xe4\x01\x84\x89zC\x82\xe7\xd8\xae\x08\x14k\xdds_K\x069\x90\xa1&\x95\xa9\xc8Nb\xbb\xb5\xd6T\xa45\x15\x86\xd6\xae\x95{\xb5\x17\xda7\xb9\xaei\xcd8\xed\xa2\xb7\xcf\x97\xab\xc6\x80\xa0\x11\xc8\xf9Mi\xf4\xe3Ng\xee~?\xd4\xf1\x02\xe0\xee\x1d\xcf{\xd1c\x97\xd2\\G\x94V\x1e\x88JG#\"\xe5\xca'V\xf2\xf77\xdc\x00\xb8\xed\xb4\x00\x18`w\x1d\xc8U\xa73\xf3m\xbfL\x8a/\xa8\xdfr\x0b\xcf\xe7\x93\x1f\xee@\x96OR\xdd\x1fi\x05+%	\x86P*\x86\x05\xe7r\x0b\x95\xf0\xf66Y\x84<\"\x9a\x90\x85+\xd1d\x06\x10\xa9\xcb\x9a\x18\xad\x90I\xaa\xd8]\xc5+\xef\xa5\xd1\xd6\x91F\xb3\xad\xc4M\x02\x19I\xcc%\xc5\x0c\xd2\xbd\xb4\xe5\xbd\x8a\x87\xd1D\x9eD\xd4\xa8eA\xf5U|I	w+\x9d\x90\x0b\xa7&	\xa5:\xb3\x1c#\xd4\xfc\xe1\xc6\xb5y0OL\xf3\xe4\xaa\x0c\x7ff+\xc2E\x96\xbe\xcd\xa3\xeb\xb3\xecFI\xc3\x822\xdfy\"K\x17\xd9\xaah\xc8`\xa5w\xff3\xbc\xf7(\x95\x93\xb7\x15Y\x8a/\x92\x0e\xbe\xaaP\xafq#\x08\x83HrQ\xc4\xa5\x9b\x82\xea\xcb\xdf\xa6S\x1em\xfedv\x13\xe7_\xae\x8dZ\x97\xad\x1e\xfen\xe01!Pd\x8b\xada\x96\xa6\x88\xc3\x94t\x0c_\xe8$i\x91Lcg\xbb5\xd1{\xcc\"\xfc\x92~\x91)\xc9,\xa6$\xb3\x99\x92\xa2\xcc\xb3\xcf\xb1\xfe|+\xd5\xdcl\xf8\xa9\xa5\xd9B\x88\x98\x86\xb3	\x03Rp5\xe3\xf2\xd0NO\xfc\xba\xcf)5\x93\x17\x99\xcb\x15W\xba/\xc4\xc7\xee_\x1c\xd6\n\xfa\xc2J)\x7f\xd3*\xf9\xff}\xea\xb7\x88\x02\xee\x06\x16\xd7x\xa4Y\xc6\xa3\xadA\xa3\xa8G\xc7\xf1\x01i\xab\x7f\xe4\xdb\xc6\x10BsgT)\xd8\xa2\xa3@#\x9d\xf2\x8b4c\x92E\xaf\xc7Z\xf4\xfaf\xb7n\x91U\xf1C\x91uVY\n\xc1\x1d8v-\x00\xd4\xda\xc4\xab\x88)+(i\xe6\xd3\xca'\n!\x0b\xbd\x98c\x12.5`y\xd4\xf0i\xeaW\x01\x90T\x9a\xd2\xa9\x98\xd0@s*&\xe9MR$Lex\x89k\xf6\xdc\x04\xa2\xee\x06\xffql\x96\x05\x97\xa0\x83\xc0\xaa\x00\xc3y3\xc0\x90\x9edi\xa9\x9e\xe0\x17\x84~\x8ep\xd4tz+\xea\x8a\x92}T\x9a\xf4\x81-\xe4\xd4oX\xee\\\xcfH\xb25A\xc9\xb8@W\xcaD9\x02+\x88\x8d\xaf\x1d\xd2\xc9\x8b[\xd0\xdd\xa0q\x17b\x94R~\xed\xb6\xb4\x0d\xad\xdbx\x03\x9b\xf3\xc2\xd2bf\xed-\x14\x91L\x1e\xb6\xd8\xf95\x97\x7fB\x9f\xe3V\xf6?\xbfa\x8b1\x89\x00\xae\xa3U\xc4\x8e7\xa0c!,	-\xfe@F>;]\x0e\xde\x0f]s!r\xe5\xb2\xaet\xd4\xa6dtg\x95\x8c\xeevJ\xea\x15\xcd:+L\x92\x98\xd7\xa6\xec\xdb\xa1\x8bq\x06h\x13%\xa4\xe6\x11\x06`\x93\xbe\x1ekK\xe4A\xb1\xd9\x14\xa3\xde\xf8@J \xb8\xe1\"P\x17A5\xfe\x98Sk\xec\xeb\x12S\xed0OvG>E{\x12m\xad\xf3\xccL\x80\x16\xf4\xf7\xd2\xe2T\xc6\xcc\x06\x00\xbe_D\xb5\x19U\xe4\xa0\xcf1\x89\xac#\x18E\x00B\x00(\xb9\xd6\xc0\x84#lI\x81\xc3\xe5\xf1Q\x17\x8f\x8c\xeb\x8b\x88\x99\x19 \x8a\x02q\xd6\xbd\xbf\x0bi\xc4*\x02\x88\x01\xeb\xa6\xcdcJ\x13/\xb54\xf5a\xeb\xe2\x80o\xdc\xab\x0d\x99\xfe\xfd(\xd6]\x8c\xe5u\xe6\xa6V/}\xf0\xcb)k\xd0!%,\xc1\xa7\xddd.p\xd0\xca\x06\xc0\x83\x88!9\xda\xa3Bt\xf4\xf1\xd1\xe1\xa7\xa1\x98\xcbE\x06\xb9\xaa\x1c\x1f\xf2\xcb\xdd\",\x1aT\x18C\x15\x03\xb8\xaa\x1cWt\xb5\x81\xe4\xb7K\x98\"R\xf9\x0c4}\n\x13\x1b\xd0E=K\xea\xa4*DH\xc6\xd6\xdb~q\xbb\xd6VR&\xf5\xd0(>\xd0,\x01\xb6Y\xe4r\xe48\xc2q\xc6\x10\xb2y\x03V6\x1d\x87\xf9\xef\xe2K\xdb\x1bo\x17\xda\x9f\xde\x98\xfb\xed`\x9b*\xfd\x04m\xb5\x99,\x07\xeda\x1a\x0c+\xb4\xd2 \xd0+Z-v|FX\xf8\xbbavH\x1d\xa3\x01e\x14\x9c	u\xd8\x9d\xa1\x9e\xb9'\xda\x9e\x04c1\xf7\xc6*\xb5\xfc\x04\xfb\xcb^\x07\xa0wf\xbb\xf5c\xb4uN\x05D\xcf\x13\xf6%\x0e}\xbb\xe2\xaf'\xaf<\xdeu\xbb\x9ac\x94#\xaeS\xb7\xc2\x99\xa36\xb3r\xbb\xa8LQ\x14\xc2@\xc8\xf7\xa6\xb4\xce}\x12\xed\xea\x15\x06\x8e\x13\xd6\xbc\x01cN	\xe5\xc01\xba\xba1\x88U\x88\x14*Wcf\xa9<J\x98Q\xfc2\xfb\x0ey\xe6\xd5\x83E\x92\xba\x998\xeay\x9a,>\xbasSo\x00\x0bZ\xe4\x85\x17\xf4\xdb\xcf\xe3\xe5<\x9a\xc4\xae\xa3B\x8a\xb6\x0e\x9eE[Qc\xbak{\xbe\x94u\xeb\xd7fS\xee\xb7I\xd8\x8b\xbd\x0fe\x0c\xeb&\x0d\xff\xe0\x88\x83\xd2#\xfdO\xeb\x93\xad\xb0X\xce\xdaTZ.\xf9l\x86\x83\x03\x1d\x1a\x92\x12\x9d\x88E2\x16\xbb[\xd0HX4\x8a\xd9$\x9a\x9b$\xc2\xaeG\x87\x8a&\xe5\xf5S\xda-\x05\x88\xa6Q=\xd7\x16\x0f\xear\x1dmXu\xbc\xfe\x8e\x91\xeb \xf2D\xd6\xe9DZ\xc1\xbek;\xe2\x04\xc4,\x0eU]\xdd\xede\xc0\xdd\xb2g\xc8\x85\x9cs\xf0\xe5\x96}\xf1\xfb\x0de\xa55\xe4\xed*\xc5\x07\x06\xd8\xe49'\xbb\xce\xc7\x8cF\xdb-\xfd\xec\xea\xaa\x88\xcb?	\xfd\xeb\xcfD\xaf\x0e\\x\xc9\xbd\xe8m6\xf8\xff\x12k\x05?\x03\xbe\xc5\xd9\xe4\x99\x02\xb6\xf2B\xc4\x1b\x1c\xa6\x0c\xe1\x16\xb5O#\xf10\x02\x9f\xe8f-\xf3\xe4\x89\x87\xa7h+Z\xa6n\x8f\x97\x97b\\\xfa\xda\x0e\xbc'\x8b\x9f\x9e.GP\xf2f*\xbdJ\xa6B\xdf\x7f7\x0d[\xa65\x99\xb6\xa1\xf3\x83\x13\xa8[\xd2v\x9a\x86\xa1\xfe\x95I\xeb\xae\xe7\x8f\xad\x14R\xeaQ8\xc3\xea\xf0u\xd6OI\xcc\x0d\xa7\xc2\xde\xd1\xeb\x94\xacZ\x8e'%r\xfdp\x926\x8c\x9c'\x0e\xeak(Z\xd6 +\x92\xda]\x8d4\xd4/a\xd9\xf2\x8f\xbe\x89\xc5\x1a\xff\x12\x16\xb4\xfd'\xdf\xc4Z\x94\xf3\x9f|\x93lu\\W	\xe5\xe7~\xe7\x19\x16\xee\x9b\xbe2\x8c\x9f2\x85#\x84\xed\x14\x81\xdf\xef\xd33H\x9b\xbf\xcc\xe2\x98\xdc* \xe9\xf2\xbd\xb3\xec\x86\xa4\xe4%le\xa9v\xa1\xb5\x1fiU\xecwCy#~\x18J\xe7\x1f{\x17\xf1\xc4D\xb7^\xe4Y\xb4(\x1c\xf1\x178T\xfc\xf1!g\x08\xdd\xf0\xbd\xce\x10\xb4\xda\x1ep5\xf8\xe3\xd7\xb9\x1a\xd8<w\xd9\"\x15M\xbfj\xedT\x18\xeaWn\\\xdd\xaf\xe2\xe5\xe2\xb5[\xaa5\x04\x1b\xe7\xd4.\xc4BP\x84\x80CPWD/j\x8f\xcf\x7f\xb8q\x13qO\xab\xee\xdd4\x8c\xd7n\xe4!%\x85j\xc4\xb4\x083\x81TFQ\x92\x16\xbbY\xafpb\xf0\"\x89v\x16\xae\xcf\xef\xd1k\xea\xf4\xf0\xb6\xb5M\x88=\xa7=*\xe0J\xa1ML\xc6\xcb\x82N\x8fe\x94~\xcf\xb1\xca\xe1_4\x0b\x93\xa5C\x06A\x10\x18\x19ZUm\xa5\xb0B\x1b\xc5\xc8;'\xf7\xf5Oa\x16j\xee\xeb\x9f\x02k\x88\xc1\xda\xe6q\xfe~Y\x86\xf7;\xebVUa/\xe5\x9du\x9c\xfb\x8d;{\x97u\xee\xefy\xb25\xb2\x88\xf9\xd7\xe2jQ*\xff\n\x10\x806\xdf\x95d\xea\x89\xb6\xc5\xda\xe6\x8da\xafV0V\xc4/\x0c#\x8b$\xb3\xd7\"R\xf3\x8a\\\x14\x82\xc3\x9c\x9b\xfc\xefJ3\x97b\"K\xcb\xe1p\xd4#^|\xa2\xde\x9a\xca?\x0dG\xf1\xd8\x1dE\"\x1f\x8bQ!\xe6c1A0\x94'\xae\xe4\xd4/\x92\xeb4\x9a\x7fC\xbcl\x17\xac\xae\xf7\xcd\xd4_\x82\x0f|\xcc\xff\x7f\"B\xaa\xf9\xb1\xcfo\xdc+13\n\x02V\xbb\xda}\x9a	p\xde\x07R\xa2\xb6\xcd\x06\xdc7]\x04\xe3\xc1L\xbb\xd5R\x9f\xb1tZ;\xcd*\xfbZw\x0b\x99\x9b\xee\xce\xdb\xbak\x06Iu\x17#+F\x99\x88\xc6b\xaez;\x93\xee\x8a\xbb\xfb\xb27X\xa9\xce}\x80\x12\xf8\x90/TO\xbb|\x15\xf2\x7f}ME\xbd\xc7|\xa5\xca~\xe3\x02\x97\xb5\x8b6x\x87\xf8\xdbO\x95Qo\x11\xdd\xb9\xc1\xe3\x14\x9c>n+\xc4\xd6\xee\xcc\xfb&=\x9c\x01v\x95\xee\x04\xfa\x0e\x03\xde\xb5,\xac\xdf\xa4\x12\xb1\xe6\xa8'\n3G=11\xaa\x8e\x89Ve\xd4\xe7\xac\x10\x08R>\x90\x12\x8d\xddl\x10\xa8L\x17\xc1xP\x989\xdbz\xe2OCy\x0f\x90\x88\x96i\xd3\x14P\xc5\x8c\x89\x1c\xcem\x80\x0el?t\xabSV7\xba\x84|AvS\xa1\x90'\"\xe4\x9e\x19\xb8\xb9\x1ao:\xc1\xbb\xa4\xb2\xe2;j\x06da\\E\xaa	\x95\x85\x7f'r\x9el\x19i[\x8c\xc2j\x0c\xad*\x03T\x19\xecV\xa9N\xe9f\x9d\xeb\xb6:\xbbA\x18x\"\xdf\n\x82\xc6\xf87\x0f\x8c`\xe9W\xe1\x15a\xb2\xd5<\x1bE\xd9J=\x7f\xa5\x03\xcc\xea\x8f\xad\xd1\x1c\x98\x04|t\xd4 \xb9\xa8\x1a\xdeX6\x9e\xc4^-\xa8\x1ap\x1e\xc6\x19\xf8\xcb\x13\x80L\x03\xdd\xb951\xb4Q$\xdf\xf9{\xe7\x00d\xa3\xbb\xda\xa9u\xb5\xa7V3\x0bU\x10\xdd\xd7L\xc5\x97\x97\xa7(\xact\xde\xf6\x1a\xad\xe3\xc3F\x1c`5p\x8b\x9d\xf5Z\xd4\xd6\xabvm\xe2\xbbj\xb0\xe0\x06U\xec\x1d\xabbg\xbd\x16\xb5-\xa0U\xf5\xcd:\xd7mu\xaa\x91*\xb6\xdb\xfe\xacp\xef\x0d?\xad\x82\xbe\xac1\xd3|\xef\xcf\xd6\x16m`\xb8\xd8\x82\x80\xf5\xde~\xabo\xa9\xdc\xfd\xe1\xedP\xb3\xfb\x96\x1c\xa1\xfbuv_\xc2\x1bpK\x129\x18b\xc0\x131\xf2\xffL\x93\xab\xf5\xfbU\xb9\\\x95\xdf\xa6S0\xb9\xb0\xccD\xf3\xf9\xcf\x0dH\x11\xe2@\xff\x07ufoWr|\xd4ME\xec\x99\x1cT\x7fO5\x15V\x07\xd7ej\xfbb\xdbY\xf8}\xf8\x10\x11\x88Nn\x8f\xd8\x11i\xf5\xc8\xc2\xa3\x82\x84\xe3[1OZ\xe1\x96h\xb9.\x01I\xadB\x9b\x94:\xb7\x02?\x80:\x0e\x8c'\xb0\xc3<1)\xda\x87\xbe\xd2|\xfd\xe5\xc6]\xb8q}\xbaD,\xaa\xb7<\xf1n\xd8xS\x93\xc1\x9d\x108 \x1e\x02i\xa7Q\x9f\x15\xcbi\xb5\x86\x02>\xc3r\xdb\xa8<\xb5\xb3\x98\xa7\x028\x1a~\x9aM\xe3\x02\xb5Om\xb0\x0db\xe0\xe3\xb6\xc1\xd2\xe8?$\x07\x03\x01\xc8\x1e0~H\x17\xd5\xc0Q1\xbaG\xb0\x1a\xca\xfe\xf0Wx\xb4\xffy(w7.<#\xfd\x9b\xa4XE\xf3\xb3h\xd9G\xe6I\xc0\x17\xc8x0\x8aU\xf6\xfa\xbf\x0e\x1b\x82\x8er\xc3\x9a!\xe4Mg5)J\xc4J\x1e\xa8{\xcb$\x9e\xc4\x05\xa7DS\x17@\xa7\xd3%\xc54\x9e\xc7e\xfc\xc8\xbae|\x7f\xb9x?\xeet\xd0\x92Ng\xe7\xe3\xb7*\xbf/\x7f\x1d\xfd\xb7\xbe\xbc\x00*8\xa2\x16\xc1\xc9H\x1e\x1fO\xa8\xc2\xc0O\xb0\x8aFw\xbah\x04\x9c\xc28\x9d\x02\xe8\x12|~\xffAP?3VN\x9b\xf4W\xfa\xac\xefLb A\xea\x0e\x0d\xf4\x0fV8\xbc\xec\x85\xdc\xfd\xf3\xd5\xe22\xce_\xf6\xd0\xb2R+\xaa/\xe7\xf1@\x0d\xe3mR\xc4P\xa2fi\x99\xa4\xablUh\xc4\xc0\xbf\x0d\xe5\x9bw\xfe\xa7w\x1f\xfe\xf8\xea'\xff\xcd\xfb\xb3\xe1\xfb\xf3o\xcf?\xf6\x97\x85\xfb\xb7\xa1\xb8W\xd9\xf4\xde\xa7\xaf\xe6s&\xd9\x07\xbd\x1d\xc8\xa4j\xefT\x98R\xcd\x05\xdf\xda]~\x0f#\xbcL\x96\x84\xf8\n)-\xbe+\xfb\x07\xb1\x9f\x146m&\xf72\xf2t\x9d\xe3\xae\x89\x91Z\xde(\xac\xe42\xc6\xea\x11\xb1\xaf\xd0F?\xd1\xe8\x16\x02[Y\x07\x02~(\x91:4\xf6\x84\xa1@\x15V\x8f\xe54\xe3a\xfe\xe0\xd5\xe2\x89\xbfo\x14\xac\xba\xdaw\xff\xbe\xc1\xa0\x90\xbc\x9dnk=\x01\x11G\xd5\xaf\xb3\xb8\x8c\xdc\x85;Y\x93\xfd\x90\x12\x13z\x9b\xcd}QfK\x8a\x0f\xcbV%G\xf9\xe0\x12\xae\xd7-]N`\xd4LjY\x149G#\xab\xed\x81K\x9aU)\x19e\xeeS\xc4\xb9H9:\x0d\x1e_8\x1dLoAyT\x03M\x17\xe32rD\x8a\x82\xb4\xda~\x04G\xdf8\x1c+MH\xe2\xba\xbf\x1f\x8e\xca1\xf9!\x8fbc\xad|\x94b\x1bg\xde\xa0\x96S)\xcc\xb6[\xf1\xfb\xa1\xe4`\x9c\xfbh\x82\xb3&\x1c!\x8d\xe0\x93\x18\xd1N\xce\xef\xe2\xde\x15\x92\x04\x8eE\x92\x9a\xc7u\xe7\x901\xf2\xf5\xce\xb3\xfc\x87U\\U\xd1\x13\xc7Oz\xf6K`\xc2\xb8\xe0\x87\xa8\xe4\xb8\xea\xaa\xbc\x7f,\x82=\xc5\x7f\x82\x02.\x8d\x8b\xc2*\xfd\\\xf8\xa7{j\x7f5_\xce\xa2/U\xac\xa3\xbc\xbeP\xacX/.\xb3yU\xca\x99$\xf9\x04j\x18\x87\x9cj\xa0vt\xa04\x88\x16Y:\xad\x8f\x11!_\x99*\xe0\xccQU\x13\xf4\xc4\xe9\xee\xe0l\xc5\x1f\x86\xf2\x87W\xf08SK@\xfc\x03]\xe3\x1c\xe5\x1b\xe5\xb9\\\x8b\xf8\\NEr.\xdfd\"=G\xbcTv._\x8b\xe8\xdc\x82h\xb2v\x87\x8e\x17\x9f`\xd3\x8d*\x94%\xb3\xe1\xc3\x91\x93\xa4\xc4\x17\x00\x0cvU\xbe\xbfR\x17c\xa1\xec6 \x81\n\x8b\xe9\xc7x]\xec+-4\xcc\xb1p&FMD\x17\x80\x8e\x1d\x93\x96\x8b|`\xe0\xe0\xfe\xb8'\x82\xc7\xbd\xb1\x1d]\xf8\xf5\xc0\xe4:\xe4\xb0\x86\x0b\xa5\xba\xa5\xe29I\xc5\x01L\xa6\xb0\x07\x1c\xa6\xf0\xa8\xd7\x13f\x0b\x92'\x80\xe0\x0e\xab\x8b\xaa\xcf\xea\x9a\x01\xcb9\xb8\x03j	Df\xd8\x11\x8b=\x02\x1f'\xb31\xd7W\xc6\x0b\xa5M\xd6\xf5\x93<\x00M/#VU\xde\xfc\x7f\x0f\x06:\xe7N\xe7\xf2\xbf;}\xfa\xfc\xf8\xea\xd81\x0bH\xdf\x07p9\xbf\xbe\x8b\x97\x8eC\x81\xf1\xd2\x97\xdab\x18\xf6\x18\x10\x9dZmrBp\xaf\xe1cc~5\xc2\x06U\xd8\xde\xae\xa6\xd82\x99\x19\xfc>\xc65\xb2O\x15\x13IW-\x96\xe6\x93\xba\xcf\x90*\x8dq\xfe`\x02\xea\xf6\xa2Pa\x7fW\xb0\x0d\xd3\xd6\x83\xf6\x8b\xb8QI\x1d7\xea \xeet\xa6\xd0\x0csw\xda6\x87\xd7\xd6r\x0e\\1NW\xa6_\xda\xb9\x8b\x98\x81w\xdc3\x1d%\x85\xd8]\xf0fjT\xd4\xfe\x83\xf9\x8e\n\xab\x9b-\xb2\x1f\xbdjvv\xbf\x94\x0b\xb7d\xaf\xc7=\xa3=\xb9qmD\x8c\xaa\x80\x88\xe1x\xd72s\x8d7\xd4\xb4Ri+\xce\xfb\x83\xde\x8f\xc9d/\xd4\x14W`m]\x11[\xfc\x8f\xb1a\xd3~&G\x8e/#\xcf(\xf6]\x03\xbfzaL\xc0M$mV\xf2\xdc\x8e\xe8\x0c\xf6\xabis\xa8\xf5\xc0\x16h\x13\x0b\xf5\xa2\xd6 \xd3\xed\xd7k\xea\x8d\x9bX\x9ecu\x9e\xa4e\xea\x0eL\xee\xb8\x06vL\xb3\x8f@\xe5\xc5h\x18|'\xea\x9d\xda\xbb\xc4\xa4\xb5f\xfe\xd1\xd7\x8fj,2R\xdf\x8czc8\xa5\xa4N\xa8.\x03\\FwN\xe8\x1e\x96\xde\xae\xb5\xbf k?9Pd\"\x12y\x0d\xb4\xa9\x90\xa4\xb6W\xee\x08\xda\xb9\xca\x90~\xb1\x929\xe7\x03,\xe3\\w7\x91\xc9f3r^8\xc2y\xe9\x8c\xc5ZI\x156\x8av\x06LEp`\x832\xcc\x06\xa3\xd4\x85\x96\xc6\x13\xf8\x1f\x8c\xbdq\x88.\x89\x0bw\xe5\x0dV-\xbe\x06\xd9\x001!a\xe45\x9f\x1d\xf1\xc3\x00\x0f\xc3;\xbc\x9f\x0dVT9e*\xf7\xc2\x15tp\xd9\x00w\xf4HAf>t\x1e9\x87\x08;!\x83\xa8\x1e4\x08\xd1\xfc\xa47\xa6\\h\x87\xce\xa3\xae.\x19\xf1\xd6\xdd\x0d\x85\xda\xd9\x11\"\x96\xc9\xb9;\"\xc9I\x90P\x84\x80\xab\x06ME\xc4o\x93\x1fmYU\xf6F3g \xcc\xa0\xd5UQ\x05\xdb\x90L\xa0\xed\xb0\xd5]\x0d^Q\xd68];\x1f`Z\xab\x90\\\x90t\xe8I?{){\xfd\xac\xdb\xd5\xfa\xe6\x14\x98Ad\xf5mc\x9c\xfd\xa4x\xc3H\xae\x94\x1e(\xcb\xa7\xfa\xf3\x11\xa2#\xae\xdb\x86\x90\x0b4\xc7H\xdb\x1f\xb6\xa2\x8d\x9a\xda\x15\xe8\x9f@\xa0\xf0\xee\xcbs\x17x\xd2\xf3,W\xa2 s\x0bX\x95\xfa\xb7f\x99\xb9\x9c\xfe\x92\x15\xcf\xe8\x89\xaa\xb0ym\xb3\xe1\xf7H\x98p\x9dk@c\xea\x83\x1d\x81c\xf1\xb9[\xa7\xe15\xe9\x86\x1d\xc1F1\x19P.@`X\xfc\xfbqH\x15&\xc2Q\x1c\x81#\xe6^?\x1d\x10\xaa0\x9d\xb5\xa3\x18(|2\xf5B-\xf3\x8f\xe2\xb1\x89C`\x99\xdf\xa2f\"\xab-\xc9H\xdes\x17\xc2Lw\xc6f\x9c2\xbf\xba\xd8\x92\x9eV\x9d\x0bpU\xe2\x9f\xf2~\x0b\xe5lf\x9d1\xf4\xb4\xbaD\x89~\nx\x0blnDz\xb0\x031\xb5\x04\x0bC\xf9/\x91>\xab\xf2\xc6\xca=a_\x16\x9eh\x12?^\xa2HU\x9b\xc9\x12\x10,i\xa7s\x90u:\xae\xba\xc4\x08\xc5\xe7n\xba\x1b\xb9\xf5\xc3+?)>E\xf3d\nU\x93\x1b\xf3\x80'z\xc0c\xe0\xe1\xdbCN\xce\xa8\x00\xcc\xcbF\xf1X&\x9a\x01F\xc0\xcbf\x935a^L\xf2\x05\xaf\xed!-\x15\x12g\xf0\xdc\xcd|.\x0d?\xda\xde\x98dGok\x0f\x84h\xe7\xcd=\xb1K\x18\xaa\xa5L\xa2\xa2\xaf\xc4\x14\xd2\x93To\xda\x8fD\xba\xe7\x1dp-\x0f\xbc\x87\xc7z1a\x89\x9a\xf1\xd2\xbe\xe4\x0f,\xf9\xbaa\xd10\x7fH\xff\x15\x8d\xfb\xd0\xea\xa8\x9fz7\xce\x07Y8\xca\x90;	\xf3 e\xc1m@f\x0f\xfe\x89\xb8\xa3Di\xd6\xe6\x03K\x8a\x0bG\xd6\xc5\xd8k\xd6\x80^X\xb5\xe0\x12A\xc3\x89\x9bbf\xe6\x03X\xe3\xc2\x11\xfe\x92a\x0e\x15\x98/\xfeah\xde\xabM\x04\xa7\x9f A\x11\x07\xfaf\xe3\x14\x7f[Ey\xcc\x88oV\xe3J\x16\xfeW\xa6KhAE\xb8Wj\xa0f\xb2\x1b<\xee\xf5\xffa\xe8\xaej_*_\x02@l\xa6\xc0\xd8\xed\x1a\xe4\x1f\x9aE\x99\x94\xa6\xe7n	{\xe4\x8c\\\xcb\xa9KZ\x03\xcc$\xa3\xb6\xe3\xb6\xa2\xc6\xc4\xda\xa4\xb5\xc6\xb7\xcb\x11!\x91\x7f7\xcf\"\x86\xd47\xc9|H`A\xbe\xc3\xbd%\x94\x80\xe5x\xf0o\xaf\x88\x81\xfd-\xf6\xcc>\xb0O\xbdJ	H\xb8_\x1f\x08\x1e9\x9e\x86\xd9\xb9\x81\xb7\"\xad\x96\xbea\xb4Cav\x8e\xf0\x8ds9:\xea\x89\xe0\xa47\x16\xc5\xb9\x8c\xce\x1bN?F\xd2\xf6-\xc5`Sd\x8d\xc8\xc9\x97\xb1Q*\xc5\"\xc4C2\xc1\xeb\xfc\xef\x15h\xca\x8e@Y\x977g\xb0\x93\xfc\x94\xa4\x9fQ\xd6\\\x80\x19\xc0(7\xca\xbcO9\xa8\x0c\xb7\x1f\x16\x8d\x8a\xf3]\xff\x9dZ\xf9\x1aL\x05\xcbA\x85>\x92\xb5\xecb\xc9(\x16k\x0e\xb6y\x11-\x97Iz}\x16\x97\xb3l*	\xbe\x05\xd0-\xa5o16z\xd2u\x9d\xc6o\x9c\xaae\x17\xa1\x07\x16\\[\x1fj\x85\xed>\xf4-\xeeK/\xd2~\xc3T\xc8\xfe5\x1a\xbc\xa0:\xe3\x85\xcb2\n\xd9\xe09\x84\x17\xac)i\xb3\xc14\xe6\xe7\xb8\xac\xca\x05V\xb9@\x97\x0b\xa8\\06\xf1%\x0f\xc1\xbaY\x03\xa3\x83Gx\xa1\xd3J\xea\x1f\xc4\x9bM\xecc\xa5\x0d\xdc\x92\xfe\xcb@\xec\x14\x93\xa5\x17*\xad\xbe\x0b3\xfdK\x18\xd6\x01|Xu\x0d\xb7+\xcf\x08\\	\xf4H\xb9\xd1\xf2\x83$\xa5|\xc5\x8aY\xfe\n.+:\xafr\xaf\xb4\n\xb8l\xa1A\xaf\xac9\x12\xd3\xbd\x07D+\x9f[1\x14\xa3rl\xd1o\x982\xd0\x11\x9c\xc5\xd4_\xea\x964\x08\xb9u\xfaP[\xb8\xbb\xe3\xa79\xbe\xd6\xb5ym\xd1\x99vt\xbe\xfa4\xbe\xc9\\\x8b\x1a\xd2\x0c\xe1\xc4\xb7\xf2\x05\x19=.\x9a\xfc\xb2\xb4\x9a\x8f\xdfjV\xaa\xdbAu\xbb7~\x81y\xabJ\xeb9\xacnSi\xba]!\xfe)\x92h\x8f\xc1\xee8S;E\xb2\xd3!n*\x8d\xee\x0b	\x81i\xb3Q\xbfI\xb2\xc3\xd8\xbf\x94\x90\x966\x9b\xf2\x05\xd9\xc8\xbd\x81a\\B[\x05\x89\xc4\x07\xe9T\x89\xc1 n;\xfa\x043\xff\xa3\xf1\xd7K\xcf\x16\xe6\xae\xc1\x12t\xbd~Fo\x99y\xa8k\xfa3\x8b\x87R:.\xea\x10V\xd4\x0b\x1ak\x03\x13k\xf0/E\xacn\xdc\xb3\x82c\x1a&>\xfb\xdc2H\xbd\x15T\xb3m\x9c=v\x0f\xf5\xefG\xb1\xfat\xc6\xf5\x923}\x18\xb3\x06\x0f\xbch\x82\x03\xda\xa0\x0b\xca\xe9\x9a\"8\x9b\x04\x15&\x15\xfb\x91=\x9dzY\xc3E}\x84\xe8!\xe0\n\xf7(\x16[	t\x08\xfd\xe7\x9f\xfd\xfc\x058+\x83F\x0c\x17\xea|\xfc\x82r\xde{\xfd\xfc\xf0\xd0C~\xaa\x17\xe9(\x02mqqQS\x19\xab\xe4\x9b+\x19\xf4\xa3\x17E?:<\x14+\xd9\xf3VH\xe6\xa3AOc\x17o\xd7\xdf\x12\xfa\xa6^2\x95\x18\x8a\xba\xf2\x17s\xfa\xb6\xdd\xaet\xa4\x7fw\x83\xf1\x0b4\x04\x0b\x11)\x1c\xecO\xd5L#*\xfa\xa4\xf1e\xb4O\xb4\xf5D\xa7%\xd6\x15\xf0\x16`sRV\xb7&\xcd\x06\x88\xe0\xf5\x19\x00\xcd\x86\xf7\x10xD_oy8&\x94\xb4\xf9\xb9\\\x17;\xcc\x87#@\xe9ux1i\x81\x8a\xf0\x9e\x14\xd9\x01+\xb2\x03\xd2b\x07\x1at/h\xd1\xdd\xb2\xe6\x96\xf5Y:Y\x17\xc1\x8b\xa9\x95b\xbeG\"\xe2\x03~\xc0-\xa5e\xc9\xc0_U\xc4\x91\xe3\x0d\x18\xe6\xffg\xaa\xa8\x9d\xfc?\x80\xdcUG\x9d\xdaG\xae\xea\xed\x10\x89\xfc\xdb\x07\x97\xe5o\xd6\x8e\x83\xd8\xf6\xb0-v\xd1n j\xea\xf0\xecK\x95\x19\xb9\x1bX\xd0\x0d\x06\xd1%\x05\xfa\x132\xdc\xad\xc3\xd4_w\x93*	\x1e\xe3C\x1d\xe2\xe9!\xe8\x9e\xf6)\xd7\x00Q\x87(|\x98\x8c\x8e\xaaPn\xc4\xd8i%A\xc3^\x80L\x9f*\xd0;~8\x94\xbb\xf6\x98\xd9k\x04r\x13\xad\xa9|yw\x94\xc9\x0f(\x0c\x11R\xb8\xd5\xc3\xfc(CY\xef\x1e7eL:\x84H\xba\xa4\xce\x03\x08\xd2U\x96O\xd8`\x9c\xb7N\x04T\xfd\x10a\x1cuD3B\xbe\x91\x95d^	\x07\x1fT	\xcf\xd3\xf24\xca\xea\xe03.g\xdbH\x1c\xaf\x0fe\xf7<\xcb\xe5\x9c\x1a\x06\xcdc\xc5\x17\xb0*{\x14m6y\xdd\xb6\xed\x96\x9e9I\xa6\x90\xfe\x19\x8cC\xbd\x01\x1d@\x01\x10\x88]	u\x05\x894\xc17`\xe8y\xaf\x04\xf5\x8f\x19I\xef\x9d\x8e\x91\xf3\xe1&\xc9\xa9\xe2+\xe1\x1e\xa9\xa5\xfd\x8b\x8b\x08e\xbf\xcbr~\xd9\x13?\xbcb<\xf8\xe2}\xeaF\"\xf6:\x9d\xbc\xd3\xe1m\xc2\xfcuInr\x10\xeb\xa0\xcf1\x11\x9c\x14\x1d\xf3\xd5\x1bB{J\xf7\xcb\x08S\x8a!\xd9\xc9\x17\xfa\xf5)@)[\x87\xda\xd1\xe1k$f$#\xe5\x0cV\xcb\xc9\xb9a\x1d\xc5T\xff\x8e\xee\xc4\xd5\xb9\x0c\x8e\xc4\xf2\\>\x11\xd7\xe7r\xfeuBV\x9d~y\xf7\xd7-\x8c?\xca\xec\xca,\x17\xb4k-8G;\xe3\n\xce\xbdfT`u\xaf\x06\xfc\xb8*b%Z\xe9\x17lI\x8c\x99\x95\xeaU\x1d]e\x17N\xd2kk\xb4\xaa\xf9\xd2\x84\x140\x88*\xa3\x0f\xf8\x11\xc5\x15\xfdF4\xc4}p\x81\xedd\xd5\x16\x88\x1a\x843\xaea\x0bZ\xa3!\xcbZ\x8e\x04\xaf9:\xfay%\xf9\x9a2\xb5x\xfa\x06B\xe0\xeb(w\xab\x1c_v\x9ab\xfe\xba*\x86\xf9\x81\xc9\x03\xfc\x8f\xd0\x99c\xda\x9e\x19\xa8x+\x16\xcb\xa4\x9ai\xc2\x8e\xb9qKi]A\x9c\xa2\x13?\xe8\xd9\xfe\x981\x97\xb9\xe7\xf9wy\xb6\xa8\x97\xa8mS\xcao\xd3hpc)\x906\xde\xf8\xfa\xc4\xa3\xa0\x9b\x8c\xfb)E#\x1c\xc8t\x90\x1e\"\xbc\xd3\xa1\xb9\xcbZ\xe7.\xd2\xc9\xde\xd9\nL\xf9\xba\xb2\x9aJ\xcd\xda\x18&\xbe\xce\xc4\xc7\xd3\xc9\\E1\x92\xa6\xeb\xf1\x91@\x88t2\xe8Fa\x0e\xe3G4\x86\xf2u\xd5\xfe\x8a*\xaa\x93\xea\xaa\x1c\xcd(>\x93\xb5\x8d5i[z_\xc0\x8f0\xd0\x11\x1a#\x02\x96\x9a\xfd\x81\xd8\x0d\xf1~V\x85d\xafl\xd0\x88f\xa9U#8;\xad@#&\x15hD\x8511i`L\xd0\xd1kp~^G\xf9o#\xcfL\xb3\xc8\xc5\xd3L[V\x1f\xbcH6v\x9e\xc8\xe5\xd5Z\xdb)\x11\n\x93\xaa|\x80z~\xb9\xbc\xf2Q{\xcd7\xdd\x1c\xa7'\x066\xb1t\xc1\xf2z\x0d\xb1@?`\xe6\x1bw\xb1\nE4\xb8X\xbb\xf6L2:\x83\x86\xa8\x03\xf1\xb3h\xa5\x8aCA\xde\xfc/\x95\xe8y\xcc]#k[}\x1d\xe8\x01&L\x15\xe7\xbf\xfd\xf3\xffC,\xfa\xf4\xdc\x9d\xb3\xcb\xf6\x9c\x19,\x0f9*\xdc\x84#\x85?\xceV\x8bK\xa2\xcb\xfaN\x05\x98T\xbbCqZ\x16\x01W\xc3\xc4h\xa6\x85\xe8\x89T\xac\x80\x0b\xe1\xb5?\x0f\xcc\xf3z\x01:\x18\xa22\xcb\xddB\x95\xd0\xf8\xa7\x840b\x7f\xe4\x01\x8f\xf8}\xa3\x16\xd3\x98\xe6\x0f=G\x88\x0b\xe6m\x81\x95\x91z\xa29q\xb0\xeaz\xfd\x8aHA\x13\x04\x0e\xd5\xb4\xd2\xb6\xca\xd4\xcf\x88\xc6\x04\x89\x95\x81u\xa9AFM\xf3\xe4\xaa\x0c#\x8d\xb1\xf35\xe0Op\xbb\xcd\x1fB\x81i\xa2\xb7T\x1bq\xbe\xbb\x11\x9b\x084+-\xc0\x8d\x1a\xdb>\x1b\xa4\x8f\x8f\xc2\xc0?\xfd&\x15;\x8f@\xd2\xe0\x9f\x8f\xa7\xaaL\xc8\xb7\xf0R\n\x98\x88Im\xf3\xf6'\xbc\xbc\xd4\x12\x84\x85\xa8\x10\x93\xdd%\x87\x07\xb3\xfa\x03\xba\xb7\xda\x8a\xe6\x1a\xdaY\"\xda\x13\xf0z\xed\x8e\x18\xd5\xd0\xc1\x00;\xd0p\xd8S\n\x95\x8c\xc8\x18	\xa4\x8e\xb5\xa3\xa5\x9b\xad^\x99\x0c\xe5\x13}\xcd\x9c\xe7f\xce+)\xa9^\xfd\x17&1j\xa3\xa6\xd1\x97'1\xd7\x00/\xcdIL\x07\xc9\xe3\xa3py~x\x0c\xa8G}\xa4)z\xda\x9f\xc3%S\xedG\x99	\xeb\x8a\x86]\xe6;\xb7h\x8ad\xb1\x15\x16Mk\x99\x85\xe4\xcam0\x89\xd6\xbd*\xd0>\x16\x07|\xaeg{\x8e\xdaD\xa4c\xd1~:\x03\x15\xd2fR\x0c#U\x12vK\x1b\x07\xb3\x8d\xa5\x94\x07m\xd3\xd8\xc0\x17\xe0\x10\xf5\xfdA\xe5M\xb6\xb4\x11[nj\xd7\xc1\xe5\x8d\xcf!\xba\\\xdbbv\x99p\xad\x87\x82s\xcb\xe0\xa0\xce0\xeb\xe0\xf9\xc9<\x8e\xf2\x16&,\xbc[\xbb-_\xacp	\xb2\xdd\x97\x9a\xe0;\x80\xbfA\xfa\xa2\xaf\x84|j\xe7\x94\xebbE\xe9[ja\n\xe3(m\xad)\xfc\x9c{\xa24g;\xd0J\xfbM\x04\x029Z\x9d\x93^\x15j\x16L\xbf\xa0\xeb\xc0\\\x7f\x11\xfa)\x86\x93G\xcfN\xbb\xd2h{+\xec\xc1\xa8'\x92z\xcb\x0c\x84S)z\x9a8$v\x7f\xfa-C\x80\xd63\xb8R\xa4[\xcf\xe0JQ\xbd\xf5\x0f\x01'5\x1a\xcc\xe10Z\xbb)\xd2:\xbfD\x8d\x8fk\x8d\x17\x91,\x07Y\xb8\xd3OVS\xf0\x11\xfc:b=\x81\xbb;\x99\"\x11Qel\xaf\xf0\x84\x9ao&\"\x11Y]\x89\xd8O5\xefda\x07\x91\xba'\xc7\xc6&5\x8eqO\xce\xb5\xc9\x7f\xebUxK\x8e\x82Ku\x04\xbd@\xe4\x88\x80\xd7\xaa\xaf4k.vk.\x1e\xac\xb9\xb0k\xb6\x89\x083?\xe0\x10\xb6\xa29P{\xcf\xa2\xfbJ\x17\x14\xa6\xa2UG\x12\x1e\xf4\xb6\x86\x7f]D\x9f\x95{\xc0\xf7\xec\x1f\x03\x82\x06\xaef\xa4u\xd9M\xf5\x15\x99\xdb\x04k\x92\x94\x91/\xf3\xc4\x03\xa5\x83\x9d\xd2\xe3\xddYTc\xeb&\"\xd7F\x8d{=\x90!\x0e\xb97\x1fH\xc1\xde\x13\x81\x88<a\x86,,8\xd5F\xa1\x8a\x12(\xb1RG\x89h\x14\xb1\x8e\xd8\xa8y\xe1\x86\xb3\xdb\xe5\xda`j\xaf\x13\x82\xf4\xa4\xd6w\xd1a\xefq\xd0\xeb\xf59\x8c\x83} \xbe0BT\x880\xb0\x14DJ\xd8\xdbV\xfa\xf3L\x06\xfd\xec\x05\xea\xcct\xae\xe4H\xe2C\x87\xe97\x19Tu\x11\xd9\x98<\xca\xb2\xf3\xb5\xdf\x8dZ>\x9a\xa1\xe1[\x93'\xf9+k\xc2\xb7[*\x0b(\xd6\xc4Z\x8d<\x0bm\x03\xd8v\xf0i\xd2\xc0S<\xa2Tj]c\xf2\x1c\x0b\xba\xd1\xf8\x1d\xe8\xfb]c\x02\x1d\x8f\xedF<\xa8\x81cV[\xb47\xa8\x0eD\xcf\xcdz\x84\x05\x97\xdf\xba6o\x03K\xe6f\x93\x0c\x1a\xfc\x0e\\\\4X\xbc\x16\xb8a\xc76\x10\xf3u,\xf8\xae\x05\x1b_\xb9\xa9\xeb\xbau=0&p-\x06\xa7\x1e0\xf5\xad\xcf58}\xd7.Qk\x89UG\x1b*}\x85	\xd7\xc2`\xb53W\xd6\x043\xa3$\xd2\xb6\xf9&\xac-\x9b\x0d'(C\x8b\x07/\xfa\xb3\xf3&\xb8^\xaeQ\xd1\xb2Q>\xee\x17\x15\x81%\x94WG\x18\xcc=\xda\xf100\x89\xa2\xe2\xb6\xc9\xc2\x9a3\x1a9\x90\xa3Z\xc4L\xd8\x94\xae\x91\x8f\xbf\x12M<\xaf\x1f\x8d\xf2\x871\xe6\xc2\xd4o\xb8\xfe\xb6\x1cW\xd4\x9e\x16E\x08\xeb=,\xadG+\x0b\xdaX^\xb6\xca\x81d\xa0\xbc\xa1\xd6\xd1@{\x95\x9e\xc13\xa6N\xd0:\xd8~\x8c\x10\\\xdb\xa6\xf6\xa1\xf1\x80\x1a\xcb\x9c\xf5\x0d\x1d\x16\xb9*ag\xce\xe5\n\x0eL\x91PX\xae\xab\xfa\xb2\x98\xc9U\xc5\xd3\x83\xac\xcd\xbc\xfbY%\x1b\x05c9\x17\xb3\x16\xa8\xb0\xc0\x13\xb3\x96c\xf8f\xed\x1e\x1c\x80\x1f\x9b\xd3\xd7Y]1\x91\xf7\xfb\x0f\xba\xa9\xdcK.KC\xe1&^\x7f\xb6\xb3\xd0\xa6\xaa\xf6+\xac\"\xab\x17\xd5B\xc2\"\x9a\xd5\x16\x91X\xda\xfd\xa5\x92\xfdek\xf7\xa8\xea\xebv\x95\x1f\xcf\xe9\xaa\x9aS\xb1\xa8-\x85\xfe\xd2Z\xa8XQ!\xb0\xbe\x1d\xc7;\xccv\x96h\xdc\xba\x1c\xeb\xcbl1\xb8n[\xa1;\xa5\xb4V.\xbc\x16w\xe1\x95\xda\x1eW\x08Y\xa6\xb5\xb6GU\xdb\xce\xc7\xf7[\x95\xa0~\x96\xba\x8eA\xa9\xb6bM\x99\x10U\xaa|d7>(+M\xbf!J\xfb\x8e\x1cP\xa8\x9d\x91\x1e\xc5\x0cZ\xf6'\xa1\x7f\xfd\x19\xca\x97f\xbb\x04\xbe\xd6\xf3\xfa\xe0\xa0\xe5\xe4\xdc\x9d\x9e\xbb=\x81\x0bO\xc0\xca\x08\xd9\xbdU\xd6A\x11\xd1Sv\xfaN'5^\x1a\xd0PV}\xcdV\xa5\xd5U\xef\xbe\xd6\xcd\xa0\xd6M\xc4-\xef\x95\xc6\xb6\xde\xee,T\n\xf1\xf6y\xd0\xb0]\x7f\xc95\x14nc\xfc\xd8e\xc1\xd8[\xe0\xebT\xb5\xfc\x86t\xb4\x95\xccX\xff$A\x0c\xbd\xbf\x89s\x1d\x18\xd3\xe83\xbf\x98L+E\x1d\xdd\xd39\xa4\x1a=\xb5\x95\xfb[\xd16\xe0\xadq\xbem\xd4-\x95\x95\x90\x05\xb2\x94\xec\xf4S3\xd4#5\xd1c\xb1#t\xf1B\x80\x87\x01\xe2wH\x0d@\xdb:\x97\xeb\xb5\x0b\xb1\x85\x12g\x8c\xcan.\xca\xc3\xdc\x90J#\x91\x91\x90V4\x84\xb4\xc2\x16\xd2\xfax\xfa\x02_\x80\x13\x05\xb4\x83\xf0:\x85\x052\x18\xbf\xc4\x07\xe9v0\x96t7\xd6\x85$\x15\xe3D\xe9\xb5#\xc0\x9d\x0b\x94\x17\xce\x8bG\x8e\xc8=\xc2\xff\x94R>T\x1a\xac\xecKU\xba\xbd\xc2\xb9p\xfe\xdf\x7f\xfa\x8fTB\xeb\xf6\xf6\xdb\xe8\x90\nd\xdcw\xe3\xcd\xe6n\x0d\xa7z\xe4\xc7x\xa08C\xa3\xee\xf8%\xb9+\xfe\xd6T~N\xdc\x19\x088\x9f\xc5\xac@\xf9!\xb9\x9e\xbd\xcdnS\xd7\x99f\xb7)\xf0\"\x1d\xb1\\\xbbSl>O\xec+;K\xaegs\xa8\xb1\xb90\x8e\x18\x90\xb6\x87\xd6v\x9bm\x81\xfd\xf0\xdb\x99N\xac\xb7\xb8\xd3\xe1L\xae\xe4\x8fI^J\xda\xe6\xf4p\xa4SlGq\x11\xd4b\xd2\x8c\xc2N\xd9u>\xab2\xb9\xb8\xea;\xb0\xaa{\xc6B\x95\xecF\x96g\x9e\xb0Z\x84e\xdaWN\x94\x91\xb7\xd9\xb4\xad\xa7HD\x1e\x82E\xea\xbbx\x8f\xee\x86\xc9j\xbf\xac\xce\xc8N\xc7\xbah9)a4\xac\n\xd0\x89Z{\x85\xee\xb4\xbe\xb7\x15\x0d\xf2\xa1m\x87v\xe3x\xe5\xd9m\xaf\x1bf[\x17\xf1\xd7\xad\xb5\x12\x0b\x8d%P\xd9\xdb\x8a\xbd\xd4\xec75\xa8F\xafqf\xfdFR\xec\x89\xda[{\xc80F\xaf~V\xb6X\xccY\xfb\x9d\xc1\xb61\xc0rf\xc9\x14'\xa6\x91\xa5~\xfd0\xc2P\x0c\x9czu\x0e{\\\xe1\x18~\x9b\xe41\x89n\xce\x98\xc8#\xfc\xfav6g\xed\xfb\xde}\\\xe1X~I}Z\x8aK\xdc\x0b\x01\x0d\xd2\x8a_\xf7\x85\xa3F\xb4\x15\xa8\x0c\xd1my\x0d\xff\xadUn\xbd\xfe\xa4\xd8\xab\xfd%\x14?\x85\xc5Bw\xb4\x03\xac#\x94\xe6(t\xd4\x7f\xa7	\xce\xf2\x00\xb0\x8b!Qm\xc8.\x18o\xdf\xf2\xd8u\x81q\xa3\x14\xca`:<\xb1*\xdc?\x0f9I\xd1\x83\x9e\xfb\x15\xbcG\xd3q\xdfh\xa8\xb1\x90\x10R\xfe~\x19\xa7p\xdb_Dw\xfag\xcd\xb9\xbf\xf2\xd3?\xaa9\xf1\x07't\xa5\xfc\x98B+\xb4C\xd0\xc7)\x0e\x9f\xbeQ\x85+p\xb6\xd5\n\x99$<5\x1aspA\xa1\xb3X\xcd\xcbd	\xf6\x18usl\xb7!\x0ddj\x99e\xb7D\x8c\xbe\xc2\xf7\x7f\xf1\xdb}\xff/L\xe3\x8d\x91\xb4\x16\x0e\xc0P\xc9\xaa,p\xe5\xf8\xe74.\xe3|\x91\xa4d%\x04i;g\x18o\x94\x19W\x91%z\x95S\xa5zA\xd1V\xb3]\x1d\x98I\xaaF\xae_\x05&\xb0\xe6\xa8\xbe\xe24\x10v\x12\x17\xd0F L\xae\x19\x99\xc0%\xd6\x8e\xb0add\xe2f\x9e\x17\xba\x0f\xc6+\x88\x9d\xca\xac\xf5\xc5\xe03\x98o\xa9\x0dD\xe6\x8e\xb51\xb4u\x01PEL\xb4\x8c\xba\x19\x9a\x19\x04\x0e0SL^ \x9e(\xe1^\xf0\x15N\xf8\xcdd\xdb&\xe4\xa7\xect\x10!\xd7\xe9\xb8\xb7.~x\x03\xfc\xddML\x1e\xe26\x9c9j	\xb29t5\x81OU\n\xd4\x8ayR\xf0\xe8c/#\x82\x9a\xa7\xab\x16\xc77uc\x86\xe3\xa9u\xbe\x8e\xc9\x04\xe1\xa3\xd1\x0c\xf8\xc2!\x81\xfeX\x06j\xb3O\xdd\xa4\xaa\xc1\x1c\x04\xc8\x1d\xbe?z \xf1\xee\xa3\x8dT\x8eC\x88\xe3\x02\xf0\x94\xe6\x91\x80\xb8\xc2\x02\xc2A\xd4\xe9<\x18\x82@\xe9N\x00\xd5\x04G\xc8\xfb\xad\xe7\x8d\xd2\xb1\x0e\x10L+3\x01T'\x03\x1d\x9f\x19\x9a88Gd\x95zD\xfc\x9d1\x12[Q\xdf$\x8d\xbdm\xe9\xc6x\xa2R\xd9\\|\x99t\xe3A\x12\x96\x9e!\xa3\xf0\xe3T\xbc\x9c\xbe#\xb3:\\\x96\x05\\\xa4\x15\x1az\x9b\x9eE\xcb\x1f\x01\xc0\x0b\xb3\xf3\xce\x1a\xdal\xdc\x0cq\x97\x07&\xf8B8\n\x08\x10\xa3T}\x0f\xf4A\xeb\xa1\x0f\x82~\xf3\xdb\xff\x1fu\x7f\xbf\xd46\xb2\xf6\x8d\xc2\xff\xbfG\x01\xba\xe7\xf6\x92\xe2\xb6\xb0\x0c$DJ\x87J 3a\x06p&0Yk\xc6\x8f\x8b\x12\xb6\x8c5\xc8\x92G\x92\xc1\x0e\xf6\x01\xbc\xe7\xb1\xf7\x89\xed#\xd9\xf5\xbb\xba[j\xc96\xc9\xacg=\xbb\xea\xaeJp\xab\xbf?\xaf\xbe\xfa\xfa\xfc\x8e\xb6{!v\xb4\x7f\x8c\x00\xdfu\\_kS\xa8\x13W;\xacM\x9c:\x195pQB\xaa\xe3\\H\x897\x0d\xc3%|0\x987\x0d\x83*\xfd\xa4\xcetY_y\xd0\xaa\x13\xbfbUx\xa8\x97\xd0\x84\xf1\xa4\xa9\nU\x87<5\x8d\xc6\x9a9+i\xae*3\\\xed`j@\xecX\x1f\x8fkh\x17\x8c\xf1}\x1a0jq\x088}[e\xe4\xe3;\xb2gG3B\x08\xbc\x99\xd7o\x8f\x02/\x93\xcf\x8fFC\xef\xbaj\xaf`\xfeTW\xac\x0eE\x01\xba\xfa\xff\xf3\x14J\xd6\xa7)`\xb5#ja\xf7\xfd'\x14M\n\xdb\x82D4^\x1f\xbd\xa7\xe8\xeb:\xac\xb6 \xc7I\xfb\x9d<X\xee\x14\xca\xa72\xb6\x96\xae\x8e\xa6\x1dJ\x9e\xe9r	;^<,i\x01\x8dFX\xbe\xf4\xdb\xae)\xc5\xe0\xc9\xb6\xbeb\x0d}\xbffL\xa1,\x9c\xd8kC4\x9fT/\x80p{\xferi\xca\xf7f\xb9uME)\xc8xN:\xe8^\xa0\x91-@&\xe3\x99\x1b\x94\xa4	\x90\xd1x\xe6\x86Um\x1cP&\x04C,[1\xa5\xa2\xd3s\xcaHkE\xb6\x0f\xea\x1a\xed\n\x94\x11\x87\xaf\xd7\x97\x97e\xc1\x11-\xa0\x85\x12\x8c\xc0\x12)N\xa2\x1a8\xb1\x19\xd5@a\xdb8\xddU\x13\xed\xdb\xb38\x1b\x87\xa3\\\x9b	a\x88)\x85\xba\xb4\x89\xc2:c\xb2\xa8\xc5\xe9[\xbbD\x8aA\x1db\xa4e\x05)\x99qZY+\xda\x10\xeb\xe9\x85\xa5'\xa9\xbe\x9cQo\xd4a\x1f\x9a~\xe5\xd2\xafo\x188<\xa5=\xf16\x83&P/#\x97\n5\xcd\x1b\xa8\xe3\xa89\x01a\x0b;\xaa\xd8\xedR\xff&\xac\xe8\xdf\xc4+R\xa4y\xb8\xe4O:r]\xf4\xa4\"\xd8!o\xcb\xa0\xbe\x0c,\x94\xc2\xeba\x0c\xcb\x82\xca\x9e\x12l\xa3\xa8\xabU\x97\xedH\x94\xd5Eac\xd0K\n\xd1w\x93\xd4\x9d\xcf\xe2\xdcL\x98\xd3\xb6HHY\xcf\xca\x93\x82;\xebs\xb3p\xacd\xed%^\xd3/\xac\xb3\x84X$\xbf\xd1\x08\xdf\x1czV\xd8lzZ\x9fx\xc8|^\xc9+\xe9\x81m\x8fL\x8c\xe0\x1d\x83w\xabZ\xbca0w\xd3f\x93\xd5\x96\x11\x07\xa0\xcf\x06Q\x92)#j%\xdf8#Z2\x8bx\xdaLH\x01+kry\x1cg<\x85\xac\\\xcb\xa1\xf3\xe2fM\xdf{\xae\xa9\x8c\xcd\x8aF\xc0\"\\Y+\xa0\xd3\xf3ow\x12\xd5\xd3vT\xc5E\x1f\xd9O	\xb8\x0d\x15\xe3\xff\xd0\xe8$IE\xb1\xaau\xe6D	\xb2\xd4FZ\xe9\xcf\xb2\xe76\n\x90M\xed\xaa\xadl\xef\xda\x0e\x92\x93\x80n\xb8\x9b\xbb\x01B\x17\x13\x10$/\x11\xc4\xc7\xc5\x86\xfbs%\x9e\x91\xcfwn}\x17Sw\xd7\xd0o\xdcF\xb0\xed	\xa3\x0e\\\x820e\x80 \xe6OJ^O\xec\x94pUZ!\xc8m\xf2k\xd4h\x98\xb1\x9c^\x11\x81'Q\x0d\x054\xa8ZC\xa3	RDq\x83\xc4\xc5\x1a\xf0^\xc2\x92>\x8bmZV.v\x05\xe0\xcd\x93\xda}>\xd7s\xf7!\x80\xa82c\x0f\x80/\xe8@F\x03\x1bT3>7\x03\x98\x1d\xf3\xb67~\xd3\xf1\xc6\x90wP\x15\x0ex\xafg\xdc\xe5\xb0pw\x97\x1b\xc2\x96i\x9f\xf5\x8c\x88\xa2\"\x8a\xf2\xe7F\xbf\xdf\x1b\xf7\xd9\x10O\x8c7\xfb\x82t\xca\xb9\xdf\x1b\xf7\xbda\xb3i!\xc0\xf3\xde\xa07\xec\xc3&\xc4\xb8\xcf\xb3\xde\xb0\xcff\x08u8\xe7C\xaf,\x00\x0c\x15\xf1Qo\xdc\xb7V\xa0\x967\x1a~q5\xc2_-n\x1f\x90\xe2\x89\xba\xee\x97\xf7\x12\xa59Pbf2\x16\xe5\x1a\x0d\x14\xc0_d7\xd5\xe4\"\x87\xb5\x8a\xd5\xbb\xf1\xe3;;\x0d\xf24\x0c\x1e\xe4\x03#\x83\x1d%y:\xcb\xe7\x0fm\xb8`\xdbA\x92O@1\xe1,\xc4\xcc\xc1\x92\xd3\xff\xf3\xff\xff\xbf\x8c~\x0f\x17\x1f&\xee-\xc5\xfc\xdf\x14\xd3\xee\xf7\xfb\x9e:~\xf4\xb3\\n\xdc\xffjO\xd1\x86P\xa8\xb6[\x1eN\xb6\xeb\xb0B\xd9v\xb5\xf2\x9e\xd1\x1a\xd2	<J3i\xf3I!2\xa1\x97\xeb\x8a\x86\xca \xeev\xd13]\xab\"^\x93Rg\x92\xe2\x0d\x01e\x13tn\xa2\x7fk\xa2\xac\x85\xdc\x99t\xc0I\xccy\xf8\x87\xd6%\xfd\xa3\xaa\xa3Hi\x1bu\xc6#\x98\xcb\xcdP\x1b\x1b\xf3k\xa5\xd7X\xd0}\x84\xc3\x90\xdd\x99\xe5\xcd$R-\xf5\x0f\n\xad\x99\x9c\xbc?\xb0\x8c\x8daHghF\xf6C\x18<\x16\xef\x97r\xb1\xe5\x93:\xe2\xb1\x98O)\xdc\x9d>z3[:\xa4/\xa4\xce\x93\x18\x03)]\xd1\x97\xee\xd8j\xfcXs\xc6px\x87\xc1\xfc,\xa6\xd9*\x1aV\\\xf4`\x036\x17Y\x05\x86*\x05n\xce\n\xe2\x9a9c\x03\xd8,i3\xb0\xa9\x88)\xd5\xb7\x98\xc4\x91\x86\x9bX\x1f5\x0d\xc0\x81\xe5\xcd6\xab\x95H?\x82\x9c\xf3\xf4\xb8\x15\xba\xa8\xbf\x19\xb2\x85\x8b6\xf6:\xc4\xa3v\x85\x08\xfc\xf7	[\xa4\xa5\xe0zR\x8a\xab\xfb\x85\xf4\x9f\x8e\xe8\x00b\x1c\xdb\x87\xaeCj$\xb9\x94ZWg\xf4\xb9\xc5u\x8a\xc5M/\xd4\xfe\xc0\xed\x14\xe7\xd0\xc2b2FR\x10\x0dk\x9b>\x942\x06\xa8\xc4$H\xbeI8o\xac\xadiy\xb4\x007J\x82S\xa8\xe0\xc5\x16\x06\xd4\x1aWW\x0e\xea\x9b4\xf4\xe9\xc2\xdc\xc6\x91\x0b\x88(\xb6\xc6\x1c\x9e\xc0\x8e#\xd4!4\xe6\x9aUg~\x97\x99\nn\x1d\xac\\T\x8e\xe9\x160R\x80\x9e\xd2/\xbb|\xbfC\xdf\xb5\x90\xb1\x82\xac\x96\xf4C\xa0\x8c\x9c\x8d\x8a\xeb\x1d\xea:\xa58\xb0\xd2\x83\x0bl\xa27+\x1c:\xd4\xfc\x02\x860Z\xc9\x85\x9c\x86\x90\x88\xab\x1e\xf5\xca\xba(m\x92R\xaf\xcc\x97\xc7\x99\xa5\x9b\xc6P\x03j\x9e\xbf\xf9\x88\xc4\xc7\xe5!I\xc8X\x9e\xdbv\xf1\xb3\xd7a\x0b7,\x0f\xca7\x0fG|\x9c\xd4\x14\xae\x82\xf2\xb8\xa4\x9bT\x04\xd2\x1a\\\xc5I\x91\xfa\x0b`\x07\xd2\xc2)\xd8\xf9\xbd\xeb6\x12f\xad\x0b\xa8dZ\x1b\xed\xd6?\x11Dts\xb6\x11\x94\xb9\x01\x1e\x1c5\x1dF\xa5m\xae\x1d\xc6D\xc5\xd4e\xff\xea\x12X\x98\x9b\xdd\xc4:\xd6\x0c\xb6\xb8\xf4X\xe2\xc5[\xa8L\xb1\xd8S\x05\xa2\xbbd\xa1^\xec\x08r<_\x87\xa0\xb5}\x02d\x19sx\x16\x99[\x85\x96\x02%L\x8b\x8d\x07\xb0\x8b\x95f\xd0hg\xd0zg[E:\x03%\xedd\xd1\xd1\xd2\xee\x8du\xaa\xc4\xc6%\xc2}+\x1fi	\x0f\xcd\x92:e)\x93\xdduj\x91\xe5i\xa4\xc62?\xaa;6\x13X\x13\xdbm\xb3\xa1\x99hh\x0e\xc0X\x02\xaa7\x94'\xfc\x95e\xb9\"\x1f\xfe\xb2o\xf8\xde\xfb\x0f\xaaI$\x10i\xb2<\x8d\xaf\xb5\xb8\xe4?&l~\xc9\xffH\xd8\x8dn\x00z\xe2\xa7\xf7A\xba\xcd\xfa33\xe0\x13\xc8`\x06\xb9\xc0\xc1g\x90\x18\xfd\x9aBu\xa1\x83\xfc\xbc\xad]V&\x0b\x025\x1e\x10@\xd7iW\x0d\xdf/\xae\x82h\x04,\x046P\xdf\xc5\xe1\xc4\x07\x90\xff@W\x86F\xa6&H\x94\xdc\x90[\x06	\x0bw\x9d\n\xcf\xfbf\x9cd\x92\xfa\xa6\xa0_A\x10\x1c\xfbQ\x94<\x9a\x86\xaf\xea\x87C\x0104\xec\xf56\xa1\x11\xaduw\xc3\x805\xde\xd2 \x89\xb3<\x9d\x0d\xf2$UpQ\xb1'\x9b\x06\xad\x90\xe1\x85\xb0\xb8\xb4\xd1\x1amI\xd3\xc0\xb1\xae\x94\x96jx$\x16\x1a\xc2\x8b!$.\x8e\xcd\xec\xb8:\x97\xa1P\xd8s\xcd\xb8\xd1\xb8]\xc0\xe0\xfc\xd0\x14y\xb5\xedi=\xe5;a\x9c\xe5~<\x08\x92\xd1\xce\xbb4\xf5\x17\xc7\xe6\xedB\xdaI\xa5\x00\x84F\\\x84\xa0R\xe3\x9b\x19\x01\xfbD\xddp\x81\xc5J\x7f\x1a\xd5~j\x82\x1dp\x94P~1\xb8\x80q\xa5\xdf\x93\xcajC\xc4\xd1b\xd5%\xe3\xc0\x1fzi\x9fg` \xa5}\xe9I\x0dmY\xcaz\xedu\x92\x80\xa1\xb9\xed\xe4\x17\x94U%\xc1\x02\xc4\xd0\x7f\xa4\x87;\xd0\x93\x98c\x82\x8eGf\xc8\x16\x97\x96\xfdg\x12\xc6\xa6\xc1v\x0c\xcb]\\b\xe6\xa4\xfd\xffK\x1fV\xa2\x01\x1e\xe6\xd2\xf2 \x06R\x80Zi\xc1q\xb9L\xc0b\xf2\x9b\xdcxs\x9b\xee\xec\xbd\x85\x93^\x111\xbf\x84DJi\xe9\x11yv\xdc\x1dC\x1a\x0d,\"\xe7\x97\x10za~a\xa8\xb5\x1cX\x8d\x17\x80\xf5\\\xb1\xac\x9eK\xd1\xdd)\x9d\x93\xd3\xc9\xb1ys\xc9~\xbb\xb0\xd8M\xfd\xb5\x83\x13O\x82\xefkLl\xcdP\xfa\xa1\xb2hoL\xc3\xd8\xd0m\xd3\x1f\xb6Y.\xa7\xff)O\xc3\xbb\xbb u	)4V\x8c\x1e\xf1\xa5\xe1u\x85\x01\x82\x85E\xfe\"W\xc4\x7f\x96\x06\xc2u\xf3\xe3\x9d\x15\x0b&\xd3\xb1\x9f\x85\xb0FS\xa9\x85\x88pt\xbe\x1f/y\xc4n/\xf9\x03\xbb\xba\xe4O0\xdb~{i\x9e-\xc4\xb3\xdb\x02\xa7\xbd\x88\x80\xa3\x10\xe6\xc3E\xd0]\xa0\"\xe5'\x8c\xa7^\xe8\xb6q\x14\x04\xac[\x89\x90\xbb\x1b\xe0\x91P\xd8\x0b\x7f\n\x97I[\x8c\xd9T\xc4\x9f\xaa\xa5\xbc\x98\x0e\xbd\xc6[\xc6Myss\x1f\x04S\xbe\xebH\x82\x89dz\xe6:\xc0x\x16Z\xc4<\x87\x81\xdcX\"\xbf\x02\x85\x93ya\xec#(\x1f\xbdlC\x0fvU\x17dy\xdd\xc0\x03p\x19|\xaa\xf2j\xcc\xeb\x12H\xd8j\x17\xcf\xec0!\xca\xb1M\x0cG\xc9t\xc8^\xeb\x13$\xdf\xd2v\xb1[	\x86\xc22\x9b\xf9ia\x16.6\xc0E/\xef\x96\xca\xb4#\x0bHtC\xcb\x16\xbd\x10fJ\x08\xd3\x7f~Xu\xf8\xbe\xe6\xe3\x0e\xd4v\xe8%\xa6\x15\xff#\x19\xdf\xd0\x0b\xe5\xf5\x8dD\xd0H\x1d\xc0\xf4\x19@\xea\x0f\x11d(/\x17fB>E<\x12Q\xa1\x9af\x16[\x1bbl\xb1\xd9\xfa\x1a\xa2\x7f!\x9f\xd9\xf2\xa1AS$\x00\\X\xb9\xed\xca\xe3kX\x9e\xa1\xa6\xd9\xe0\x1c\xbb-\x19\xc1\xe7\x87\x19\xf3\xd8\x0c\xea\x90\xb2\x98gi\xdc%\xb7,tD\xdaHT\xda6\xecI\x83\x0f\xb1d`H\x07\xe6\xc5\x81\xb7%\xfa\xa6,\xe9H\xdf%2V\x81\x9bM\xfd6\xf02\xb3X$\x97Q\xcd\x91\xb6k\x81uFr\xcb\xcayB\xff~J\xfd\xe98\x1c|\xa8\x1b\x97\xccS\xc0\x82,\xa8E\x03x\xd2\x14\xf2@6\xa8\xceh\x9b\xc9\xdam\xa1\xa9\xac\xa89\xe2\x0bF\xa1\xf2j\x04\xd0\xf8YuCk[Y\xdf\xd6`\x9d\xaf\xb6@\xe9\xf30\x0e\xbe\x0bHk\xeeF\xfc4M\x1e\x8d\xbeL\xa1\x15\xe9\x1d1\xe7e_\xf3\xe5\xd0\xf97 8<1\xad\x18\xecpJ\xf0\xad\\\x19g\xe3`h<\x03\xbe\xf52dl\xc1\xdd_\xadX\x81\x7f}\xf0\x81c\xbb\xca\xc2\xa7\x82\xf5g\x97\x9a\xdf+\x16\x90\xf1\x04\xa9X\xa3\x1f\xb9\x88\xa7\x041\xf1Z\xde]\x980\xc3m\xd2u\x00[\xeb\xcb%Q_eP\x81\x7f\x95\x12\x0cC_\xe5\x93>x\xec\xf9\xbby\x98\x95\x9f\x0b|J\xca\xf3\x8c\x8dK\xfa\xb5L*sRAk\xc6+\xc9\xc7\xc6\xc2p\x8d9t~\xa4\x078\x1cm\x10\xdfdy\xa6\n\x96|\xd0\x01?]\x98)\xcb\xc8M\x827\xe3\x03A\xcd\x946\x06\x99\xfcD!6\xe6\xf7\x0b3c3\x16	\xf9\x9a!\x17\xde5g\xc7m\xd7a#\xee\xb4\x86l\xcaC3\xb5\xd8\x1d\x0chMi\xa6\xa8\x8b\x0c\xf6\x91\x92t\x08\xc2\xf6]\x19\x94\xb1\xbdQ\x9f\xd8o*	\xdf\xb0\x16\x8cF&\xca<L\xb1\x9b\x0c\xcb\x9b\xbc\xe5\xedF\xc3\x88I\xf8\xac\x84*\xc2\x94ps\\0\x94\nF\xd8\x84\\\x03XE\x83\xc3>Wm\x0d\xfb|\x0c\xfd\xcd)\xbbcb\x8fE\x82\xb5!\x10\xcb\xd4.?$\xcbc\xbc\xea+\x1ep\xca{\xd7 \x8b\x80\xa8m1\x19\x84z\xbbo>\xadX\xda\xeb\xf4-\x90\xd8;}1\x15Eh\xb94\x0c\x16\x9b\xf8\x96e\x8b\x0f\x94NW\x9b\xef8q>\xff#W\xdc\xb9\x94q\x13\xe2\xd5\xea\x82\xd7\xaf9 \xa577P\x9d\xa7\xf7\xea\xcdM\x9e(\xc1\x81\xe2\xa4\x04\xd6\xd3W\\(x\n\x88{\xf1\xeb\x02\xf6\xc0@\x01\xc7\xe7j#&\x10+x./\xc8\x9c\xf5\x12\xfb\xae\x1ae1\x7f-\xaa_X\xdc\xfd\xbe\x8b\xd7Z}\x03\x9d(\xee]5\xa0\x1d\x08\xe7\x86\xa5-\x93@\xf5\x81\xa6\xca\x0c-\xef\xebB\xe4\x10Wd\xa0F\"o\x97\xb0r3\xf9\x12F\x17\x90\x11\x80\xfb\xae\x97\xe0\xbc(\x80Y\xcdDD\xf1\xe5r\xaa\xf2\xd0e\xe5\xfa[o\xb6l\xc3\xcd\xb6\xb2\xa4\xc1\xbb\x0dh\x04\xf0\x07\xbc\xdbk0m\x03\x0eI\x04|4\x9bZ\xcbe\x84a\x9a)\xa1\x11\xb7C\xcb\xab\xdd\x843y\x13Jm\x86\xf7\xd8\x029^n\x03>\xb6i\x03\x0d9\x0e%\x1b\xf1\xb1\x0d\xb8\xeb\xa9\x9d\xc5\x07\x0c\xc1<\xe1CV\xe2##Q\xd3\xb4\xb8\xf5\xa4\x8d=vW\x8d\xc1ecX\xde\xc2\x9c\x82#8\xe5\xbd)\x9b\xf6-\xb6\x06\x17\xee\x1a\x0d\xf3\x8e\xf7\xee\xd8\x1d\xe8\xed\xd4\xa3\xda>\xce\xad\xa7\xc4\x1c0\xc1\xd8L\xcc!B\x10\xdfc\xa3\xf5\x8c\x18d\xc0G\xd5\x9d\x91\x93\xbfQ\xd3(\xee\x1e\x85}x\xa3\xda\x16\xc9\x99TK\x0e\x96\xcb\x81}WK+\x17\x91\x15\x05\xcbSR\xe4/\x8e\x04\x1b\xaeE\xf5-\xb6\xa1M\x0c\xfa\xaa\xd8\x88nQS\xd1\xab\xca6ee\xf6\xedYA\xa4O\xb4:\x87\xdf\xa8Se\xde\x9eQ\"^3\x1d\xf1\x1aa\xc90\xad\xcfbY,\xfc\x1bx\xd6L\xc3\xb3f\xff!<\x0b\xf0T\x03\xad\xcfaY\xef\xd2\xc0\x7f\x0e\xcbr\xb6cK\x05\x16\x03I\xef\xad\xa8S\x9eL\xb7\xbf|\xdb\xdbQ\xa7z\x15\xc5C\xf8\xfa\x92o\xa6E\xf9\x9c\xee<\xc8X\x80Y(?p\x85e\xfc\x9a\xc1\xff0\xdd\xb7\xe4^\x87B\x1e\xa4\x7fxf\xe2\x87\xe1\xce\xb7\x18\x14\x86)\xc6Q1`\xfb\xf1\xcc\xc4\x0f\xe4&\x10\xe1\x88\x08GDH@\x93\x98\xbd\xa7\x15lB\xf5\x15\x8ddg\xac\x90\x0b\xd5\xb2l\xb7\xcf\xc6\xf6\xbc\xcd}{\xce\xc6\xf6\x02\x81\x05b\x1cr\x97<\xb6\x17\x08,\xd8x\xc5N/\xc1(\x9e\xb7\x0df,\xda\xc4[\x9f;\xf5\xb0#\xe2)\x8fc\xf4\xfb\x9b/k\xb1\xcc\xff\x91\xcb\x1aU={Y\xaf\xbd\xf8\xe5\x8d\x9e\xf0\x91yzY\x12\xe4\x92\x82\xbc\xf3\xe3\x02>\x80\xc9JJ\x08\x07\xb0\xf5;9\x806^\xac\x0ej\xf9\xb0	6\x99\xfbHpn\xffSo\xdc\xbfs9\xf9\xc5\xe5\xe4\xb3':\xc8d\xfd\"}\\m\xbd\xa4\xf4\xd3/\xf7\xd1\xc7\x0do\xe21\xc0N\x15\xf6\x07\xd0\xc5\xae\xcfRu~K\x99\xf5\x1f\x17\xe6\x98\xb0\x0b\xe0	+K\xeagk\x107P\xf7\xc0\x96;\x1c%\x86\xe1hd\xa2\xbf\x80`\x16\x0d\xa3N\xc2\x0d\x88\\z\x03\x9bVX\x13\xf7I\xac\x89;^\xbb\x17p\x98\x8bN\x94\x0bJ\x17\xb5\x82\x82h\x01\x8e\xa1%\x00\xd6Z+	N\xaa?\xeb{#\x84\xdc\x95\x19\xb3\xef\xe8\n=7*\xcd\xc6\xda\x14\xe9\xbd\x8b\xd1\x1dI%Z'\xbfn\xef\x0c^3\xb2zY\x9a\x06\x16\xcc\x83\xc1,\x87\x1f\x81\xf17/\x144\x11\xf3q\x1d\x07Tb\x13\xd2k\x1e\xc1P\xa3\x10\x9f\x90\xb1\n\xc6\xda*9++\x92\x8b]\xb8\xe0\x81%\xe7Y&\xf0\x0635\xf5j\nj\x86a\xa9\xd2\"\x9b\xc5\x9eF\xe0\xc9\x7f\xce\xcd\x8c\xd9\x07\x85\xe5\xe5\x0c{-\x17\xfa\xc7\x94ss\xaf\x9e\xa97L\xa0\xaf\x84\xa2\x95zH\xc9\xf6\x89F\xf3c\x90\x0f\xc6A\xea\x06\xe2\n\x92\xe2\xca\x10\xb9R\xb7\x1a\xb1\xf0h\xbcD\xd3\x86X\xbda\xb00\xfb\x1c\x0cD\xdan\x9b\xec\x82\x9fHyT\x8b\x050\xd9\x0c?L\xb5\xeb\x9a\xa9\x15\xe6\xe3b\xbb\xfc\xef\xd2D\x00N5\xc8*\xee\xea\xe7\x1d:\xc3\xf2\x18\x90\x90\x8a\xe2\xb9\x14\xc2\xcf\xa2p\x08\x11ur@./\x00\x95\xffd\\\xd1A\xabFk\x1ah\xd2\x98\xf3\xbbx\xf8\xdbf]4\xc5c\xbf\x0b\xf2R!\xad\xa8\xaf\xb4\xc5\x12*M\xd9\xdc\x1e\xcc\xd24\x88sz\xc7\x82\x05\x8b\xd3u\xa2\xc5\x99\xd5,\x16\xdb\x95$\xb4(\x81\x05\xd4\xdd\xb6\x05\xee\x8f\xf4v~\xe1\xcfM\xfa\xce\x82\xfcS\xe4/\x84D\xca\xaecA\x03\x16\xf2\xcc\x12\x93\xd1\xfb\xc4R\xf3Io\xc1-\x04)\xf4\xd8\x15y\xde\xdf0whf\xcb\xfc\x95I\xdf\xd6\xe2\xfb\xe6\xcciS6U#[\x1b\xa9\x96fI\xac\xe8\xdd%\x9f]\xd4.\xfeb\xf0\xba\x07Y\xe1;V\x1e\x8d\x0d\xbc\x8f\x03\xa6\x18\x18\xfe<\xcc\xae\x8b\x0ddT\x1c\xef@z\xc25:\xed\xff6J\xaf\xaf$\xd1 #\xa5\xf9\xfc\xb6\xb2\xed\x8eti\xce\xfd@\xf7\xb6*\x8d\x8d+\x93\xfc\xca$\n\x8e#\xa6\x15\x98e\x1a<\x86\xf1\x10!l\x054\x8e\x89Q6\xfc\xdcN\xb0\xcf*\xeb\xda\xd6\x91\xcd\x82+#=\x05\xfcW\xbb\xdd6V\xe4f\xd7\xed\xf57\x18\xf7\xaf:f\x95\x12\xa4\xe0|e\xec{\x18\xbc7\xe0\xa3\x00\x06mg\x9fZO\xef6\xa8\x10j\xdc\xcc\x0d\n\x84z\xad\xb5\x83\xa3\x11\xee\xad'\xe9@\x07jo\xba:L\xf5h\xe9\x92z4J{\x90\xccJ[t\xf2T`\xb2\x8fsn\xe6\xff\x1d4\x03\xeb\xbf\x03\xd7\xcc\xdf\xc2d\x92\x99\xf3\xa0\x05\xf1\xeb7\x90\xaa\xccy[\x89Bmh\x8a\xe7\xc4\xd4\xdb\xdc\xdd\x02;\xd9\xd6\xd1\x15+\x8f\xfb\xb6b\xb5\xdaM\xeb\xed\x86q\xb5\x1c\xe2\x1a\x16\x90\xa22ez\xebj\xdb\xf1\xdd]\xd1\xf3\x0dET\xb7ww7\x95\\\xb1b\xad\xf4.k\x0cz)\x85\x81\xf7\x19\xe6\x1e\xea\x1e$\xe3\xa2N\x1b\x93\xac0\xb1\xe9\xe0\xb1\x05\xb2PRXz\x01\x92m\xa1\xb4\xd7\xeb{U9S\xa5f\x16\xb3\x94\x9fA\x8e\xcc\x83\x80\xf2\xb1\x19\x93\x0e\x92%\xe5[\x037\xe6\x01\x93j\x08\xb1\xc5n\x88\xac\"\x89\xb7\x8d\xc6\xae0\x1a\x8083\xe5\x06\x18\xba\"k\xda4\x80\x0c\xb2\x80\xfbD\xd4I\xf9\x93\xea\x96k\x08\x16Qd0\x00\x1d	1V\xbd\xb8\xbf\\*\n\x88'\x85\x1e1j\xc2\x13\xd3k\xb3\xf7\x84Q\xbaR\xcc\x99\x11\xd8JW}iH\xc4VSI\x0f\x80\xef\xe5\x0fcKl\x14C\xaf\xcf\xa3\x12\x8b0\x0d5\xf7\x86\xa5\xe4\xcb\xb4%P\xa2B\xb8\x96\xc7\xe6\xbb\xcb-@\xd6\x967o\x1d\xb4\xfe\x1bn\xac+/\xe4\xc23\xb6&\xdb\xa4;\xce\xde\xfa<\x97$=\xa0Y\xf9\xe2D:d/\xe9*P\x82\xd6\x98\x13\n\xe8\x0bx\xdd\x91\xc4>\xe3\xbf\xf6\xdb\x07/\x0f\x0fJ\xdeH\xf9*\xc7\x967\x8a\xcbB\x89\x1b\xabx2V\x16\xb8\xed\xf5\x8a4\xe8\\K\xab\x0c\xdcY\xb1\xc18\x18\xdc\xd3\x13BBs5\xa4\xc1\x86\xd1\xec\x17]\x1et\xf6\x0f\xf7\x9djm\x87\xd5y&W\xe2\xce\xeb\x03v\xb8\xcf\x0e^\xb3\x9d\xb6}h\x19:A\xa3]~\x9c*'\xfc\xfb\xed\xf6:\xe7\xe6\xafY\x18\xe7\xe1\xe0,\xee\xcerc\xa5\xee3\xd5_99\xf8\x05(y\x9fS\xd5\xf4\x99\x06\x0f\xda\xe7e0\xcf\xe5\xa7\x12et;\x9dRa\xbd\xa3\x91Cp\xf7\x1a\xe2\x12\x1c`!\xa6~>v\xf7\xf6.\xf6\x1d\xfb%;\xdc?q^\xd9\x87\x18\xd8Kv\xe0\xd8\x87\xec%\xeb\xbc\xb2\x0f\xae(\xd6\xb1\x8f\x18es\xec\xa3\x93\x83C\xfb\x15\x02\xec\xf0\x95\xdda\xce\xbe\xbd/B\x94\x9d\x12\x0f\xf7\x99\xac\xf4\xeb\x8e\xa8~\xdf\xde\xdf9q\x8e\xec\x03\x84\x18Uy`;\x14@\xb1A[\xd4\xe3\xb4\xed#\xd6AJ\xf1\xe7\xe4\xe0\xc0~\xcd\x0e\xd1\xa3C\xd4}\xd0\xb6_\x89\x10\n\x9eP\x88\xea\xa2|\xa8]5\xf8u\xe7\xa2\x83\xb8\x8ec\xef\xef\x0c\xda\xad\x0ezk\xbfl\xed\xdb\x0e\xdb\xb7\x0f[\x9d\xc8ic\x9c\xb63p\xec\xa3\xd7\xaf\x99c;\x18\xd7\xeb\xd7\xacc\xbffmv\x10\xb5\x8a\x1c-\xc7\xa6\x0c\xad}\xfb\x90\xb5\xed\x0e~[\x9d/\xa8\xfb\xab\xc1\xa0\x08U\x9b\xd46z\xbdowN\x9c\x97\xf6\x11\x85\xd8\xa1\xbd\x8f\xa9}E\x81\xce+\xfb\xe5\x15\xa5u\x18e\xee\x9c\x1c\x1c\xb2\x0e;|i\x1f`2\x0eE\x88\xb2\x1d`]l\x99\x0fUaZ\x11\xde\xb7\x0f\xb1l\x18.\xbay\x84\x998\xa0\x00\xcam\x9e\xd5v9\xaf\x1d\xcc+f\x13\xb3\xfa\x1a\xbf(vr\x88Y\xa3\x9a\x0e0\xb1\x18\xb1j\x0d\x0d\xbfD\xc3\x87\xf6\xd1\xa0\xcd\xda\xf6\xcb\xb6\xd3j\xa3\xc7-\xe4p\xc6-\xc7\xde\x1f\xb40km\xc4\xb4\xda\xf6\xfek\xfc}\xddr\xbe8\xaf\xed\xc3\x01\xa2_\xb2\xb6}\xd0r\x1828\x1f\xf7_\x0e(?>\x91@\xbf\xce\x174\x81%|\x85\xbdwh\x1f\xed\xfc\xa7\xda\xeb\xbc\xda\xd4\xdey\xd1P\x19\xfaj\xb08\x98\xe7\xd5\x95u\x8ep\\\xda\xf6Q\xd4\xe9`\x17u\xd0\x88\xddi\xb5\xed\x0ek\xdb\xfb4\x1b\xe2\xf7\x15\x0d\x161\x98\x9dC\x19~u\xee\x1ca\x1f\xdb\x07\x03\x99\x82\xff\x1d\x99\x8a\xdfC\xec\xd6\xb2\x9a#\xd6\xb6\x8fZmL8\x9a`\xd4y\x1b\xb3\x87y\xdc\x8f:X \xfc\x89\xda\xac-\xf2\xd0\xff}9\x99\xf8}E\x93\x87\xdaP\x8e\xba\x82\xd8HF8\xe7\xcekl\x84\xce\xce@\xc5\xc8,\xd4\x1flx$\x1c\xd0$\x1f\xc9\xae\xe2\xf7H\x1c\xeaC\x07g\xeb\x08\x9b\xd6a\xc5\x04}5\xc0>\x7f\xa8N\xdf\xc1>;\xec\xd8G\xe7\x1dtn\xbf-vKG\x9b\x81\x83Ve\xf6\x98\x9c=5\xa7\xe7\x078\x9c/\xed\x83\x81\x9c<&\x8b\xb2\xea\xe4\xe9\x9dDG\xd1\xff\x0e\xf5\xffPVI\xb3\x87\xc5\xd8g\x9d#\xfb\x88fO\xae\xa2\xaa\xf0\xa0U\x99>&\xa7ON\xee\xabH\xcd\xde\x01\xa0\xc3\xe1\xc1\xce@F\xc8\x0c\x1d\xb9V\x9d\x01\x06\xdb\x96\xeb\xb8/\x7f\x8fN\x0ep\xa8q\x9e\xd9\x01\x80\xd2\xe1>\x93\xb3\xf3\xd5\xa8_\xa4\xd5{\xa8\x16[\xdc|[\x89\xe2\xb5;\xaeBX_K\xab^F\xb5\xe4ZG\xaa\xb1\xa2#\x9dU\xf9RZY\x90\xa7\xa3\xa7\xc3\xc9%\xffe\xebSse\xb1\xf3M\x04\xfa\xdf\xaf5O\xb8D\xb6\x97\xcf\x06`Q<^.\x0b\x1c\x10\x910\x1b ,v\xac\xbcs\xcd\xd4\x03\x7f\xd2$M\xdds\xf2MK\x86[\x88D\xa3c\xdc:\x1e7Q\xd6\xb8$\x1dKR\xc1\xf0\xbe\xf8X U\xeb\x85k\xd2\xe4e\xc7\xaa\xca!\xab\x15\x9b\x99\xe7\x97\xec\xf7k1=\xf7\x97|\xc2>\xc1\x8d\xd3\xa5t\xdd\xf4\xe9\xcc;\xf9&\xda\xb8\xf6\x12\xb5\x9e\xea~\xe5n\x80\xa7\xca')d\xd5A\xc0\x92\x9f\xc0BS\x19\x96\x8f=\x12\xb6)\"!\xb6J\x14k\xf9Ms@\x11[D\xfa@\x1dW\xdaC\x93\xba\xa3;!\x0f\xab\x0c\x9d\xa9\xfe\xe9\x04Nr>\xc7\x94`\xd08y$nrAp\x97}\x90\xbc\xc4\xd0*4\xb8\x85\x84,u\xcc4\x8aN\x1b\xa5\xf6W%C9\n\xcd\xba8f\xa9R\x1b\x04F@T\x07i\xb3\"B\xab\xed\xd3\xe25\xfaGbf6\x19\xeb\xb5\xd5\xe6\x82\xe0\xd7\x8a}\xba4{\x06\xaa\x02w\xcd`\x14\xbc\x0e\x07\xf7\x063\xa4\xbc>B\x95\xb974\xd3\xb8r={\x86T71\x9aA\xdfL\xc8\xf6j\xae$\x0d\xe5\xda\x88\x0c\xa8_4\x9f0\xc8\xc4(\xcd\xa2\x1b\x85	\xd2\x90V\xf2QE\xaf\xcf\xab<\x99~\xd3\xa8\xd55\xb6\x8aimY\xb2oY\xd9\x92\xa5W\xecF\xd0\x94\xdcM\xf4-\xb9\xec\xb4:\x85&\xd4\x16]\x8e?\xc1\xa6\x0b,\xa9\xe6	S\xa8\xf4\xb0\x80}\x93\xe3\x90\xd7\xce`|\x9c\xd8\x8bf\"\xfd\xd5\xecu\xde\x04\xbaK\xb5\xbd\xce\xb1\xd12\\\xa3i\xb8\x89=o&\xc2\xbd\x8d\xcaE0Mdj\x1a.2\x8a\x87n\x08\xb1\xa9\x90?\x95-\xb9O\xa0i\x19\xad\x82\x8ce4\x8d\x15S\xeaH\xd2a$\x92%\xc5\xabi\xac\xf0\xd4\xed\x85}e\xe2_\xaf\xac\xd0\xce)*\x08\xdf\xf2\xf6ri4\xc5 \x05>\xaf\xb4\xe6V,\xad\x94\xae\xe5\x057\xb50V]\xd6\xa8\x14\x83V,\xab\x94n\x97Y./\xf7:+\x16\xf1\x8aZ\x15\xe6S\x92\xe5\xe4l\xc1\x08\xb0F\xa3\xd7\xaf\x10\xc3\"\x93\xc0\xd5\x13\x0d\xb1\x8a\xe3\x99&\xedHb\x10n\x9b\x0d\xab\xf1?\xf9S\x8a\x1e\xf1A\x13\x92Y\x95-\"\x1e\x8b\xe0p\xb7\xbd)\x9f\xbe\xb8\xbc\xdcs\x8e\xda4\x97wl\xc2\x1e\xd8\x82\xcdU\xcb\xc5v\x02<a7|\xdch\xc8\x14\xed\x95%\x12\x1f\xeb\x89\xe2\xcd%\x12ok\x89\xf2\x05&\x12\xafx\x9b]\xf0H\xd2\xb1\x0b\xa3\xde\xf3\xe5R\xcd=\xbe\x8e\xcd\x1b!\xccA\xfa\xd3WM>\xb2\xd8c\xa3aNx\xef\xaa\x8c\xb9m4\xcc\x07\xde\xbbh\x0d\x10w\xd1\xe2#\xcbrUI=V\x95m?_\x96-P\xfdE_\x01WI\x0b\x80*\xc7\xa2Tg\x12\xdaL\xb8!\xdc\x84\x01\x8e\x9e\x93\xd1\n7R\xf4\x84\xb8\xb4'\x9e\xf5\xe2\xbe\xa0\x93~VQS\xf1\xfd)\xc9\xba\xd3\xdc\x0d\xc5\x97\xb4}\xad\xaf\x1ci\xd9a\xe1\xfc\xa2\x8e\xf7~F\x04\x91jF\xb5\xeb\xde\xa9\x02\x95\xd4[Y\x065\xa5\xa8	\xaf\xdb\x82(|G\xe8\xe7\xfb<.b&\x84\xcf\xeb1\x0fD\xae\x16\x96$\xdcE\x81\xfa\x90x\x89\xfa\x82\xe9\xb7!T\xb9\xd4\x1e\xaa\x01\xafu\x15LP\xe9Tf/\x87\x0e\xe2]\x18\xf3\xde\xa0\xd7\xee\x17\xc6\xef\x07=G~8}\xcd\xeb\xa7v\xa7\xf4z\xb9=g\xb9=o\xe6\xe2\x90\xf5Y/\xb7\x17,\xb7\x17\xcd\\\x9e?\xbdh\xa2n`\xc8~\xe5\xbd\xb8\xdf\xe4!\xe0K\x82F\xe8W	U\xd5\xaevuG\x96\xd7\"1\xb0\xd5NX\xd3\xaa\xcc\x95V\xa5TY&\xc5\xbd\x19\xcf \x98\xc03{\xd1\xccd\xef\xbcAnF,b\xbd\xd6\x8c\xb5\xc6}\x8b\x0d\xc5w\x0bP\xc5b*u\xc6\x90ff<\x83\xa2{\x1c\x98\x96eWMG\x9aR\\s\xc0c\x13.\xcbyl\xfa\xeb\xced-6\xe2\xb1\x99nJ\x98r\xbfX\x11v\xc7\xd3ry\xee \xa81\xc5\x1f\xb9<RdSZW\x10[\x19S \xc0\xfe\xc4\xb2\x12s\xca\x86l\xc0\x1c\xf6\xc0\x05\x84\x9d@\x1a\xceb\x89y\xc7F\x94\xe0\xb4\x1e4\xf9\xd4\x07\x0eaO\xe4\xf1\xb4\xb2\x16\xbb\x83H\xc8\x14\xbe\xfd&+_\x1a4U\xfd2\xd8\xd4b\xe9Z\xe4\x1dd\n\xd5	\xe4i\x19\xcc\x8b \x0bM\xdfb\x10a-\xb4\x0f\x81\x13l\xbcquY\xc9X	\xa6\x95dM\x96\xf0\x05\xb8\xfc1\xcc\x8cA\xfd3\x1c\xdcg%\xf6S ?!l\xf2\x91b\x94\xd9\x93\x08\xb9\x86\xc2\x94H\x12\x0cm\xc8\xab.T-Kk\x86\xb2\x18\x1a*M\x1c\xc2\xa4\x02\xf3I\x16'\xb1\xe3p\x10P\x07\xa4\xe5C\xe1	\xeb\xfcR\x15\x85\x1c\x88]\x9eftZv/\x95\x98h\xc0\xd2bJ\x9es \xd13n\x8cf\xdeW\n\xf8\xb2\x96\x9a,F@\xe6\x9et\xa4+\x8c5\xec\xa7x\xc1\xa0\xda\xa4fG:\x96@L\xd1Qm\xdcA\x80\xc4A\xa1\xf7{UJC\xcc\x1d\x17\xe6\xa0\xd9\xc2q\xdbl\xdeqa\x04\x9a-:\x90\x82\"\x16\xb7\xeb\x9bO\xa8\xea\xc4\x9fJ\xdb\x97\xc6\x8a\xe9\x1c\xf3\xa2\x1d\xc1\x80?W\x9fd\xd3\xa7\xf0\"\xf6\xb5\x03_\x1fV\xe1CQ!\xaa[\xc7TZ\x1a\x06\x1e\x1e\x96\xd8\xafZ\xa5O\x97\x1bl\x10\xc1\x1d\x04h\xf9\xd7\xc9	\x84\x9a \xff\x07?\x03\x15\xf9\x84\x1cXy\xaa\x8d\xa0x\xae\x1a\x90\xa6\xd1S\n\x19\x05=\xcb\x8c\x97\x04\xe7\x9e\x8f{Q\x9aPp\xef\xa5R\xda\xcd\x80\x98\xb2\xd7\xf29E\x184!\xd4c\xfe\x97\x92%\x9fY^\x90\x98c\x16\xd5\xa4\x16p.i\xfd$\x0d\xdd\xb0\x8e\xcdqiA\x99\xe7l\xac\xa9E\xc4\x96\xab'V\x92t%=}'\x01\xf0l\x98v\x00\xa1\xe2uAU\x98RdS\xec\x9fm\x0b#5\xb3\xa9\xda\xf5u)\xf8B\xb1\x9d\x87\x83{R\xf8[_\x12\xbf\xbe$J&d\xcbr\xa8\xe4Ym\xb9\xb5F\x80\x17*\x15\xf7r\xb9fX.\x05\xcb\xdc\xdc\xae \x18-\x0d\xcc}\xd7\x92\xfa\xe5\x0e\x87\xceX\x9c\x98c)\x17\x92\xb1\xa7\x8a\x87\x8f<\x18\xd2\x04\xe9\xee:\xec\x12\x7f\xd9\xa0V\x9f\xdb\x15\xc4\x05\x02 t|\xc7$	2fq\x02\x01\xfa\xa8\xd4\x9c*\x8e\x96|\xf8mXb\x15C\x979D\xb4\xc7\xa4\xba+\xf1\x8a\x01\xff\x1d\"o!\x9b\xb1r\xc6r\xa0fwa\xec\xf6\xfc\xbdNe\xf2\xc6\xc7\xad\xd4m3XZ&\xf3\x11\xa0\x04\xd1\xe0\xdd\xac\x98\xbdd\x05-*t{@\xdd\x1e@3B\x13\xbe&\x0c\x08\xf8\x10\xb0\x84r\xf2\xb3\x8aH\x8eD\x944\x18\xa3\x1d\x17\x16\xf1x\xd3\x0ey\xbe\xd0\x0c\x08m\x0b#\xf2\x99\xdf\xc7N\xb1u\xc6\xaa\x89\x17D\\\xc5d\x81\x82{\x10\xfc\xa9!y\xac\xd2?[\x91t\x0d\xf6\xec\xce\x19\xa8G\xa1\x85\x8b=\xb7k\xb8d\xadRE\xe8\xfcv\xa5\xe2\x11\xa9*\xd5\xf0\xd5Z\x8dF\xd3\x1c\x1f\x1b\x8a\xb3`\xb8\x86\xe2\xdc\x18V\xd9\x86\xf0\xab\x83Y\xd1\xac\xc1\xec\x8e-i\x0c]\xee\xb6\nqa\xc3\xa6\xdb\x045\xe2u\x1e\xf9&\xa0\xa0o\x82*\xdb\xad\xa0\xac\xb0\x88?%\xf1	\xd1_\xb4\xc6!t\\\xb8-\x85\xb6Xn\x93\xe3R~\x7fi\x16\x0e\xf2$1\xea4\xf5\xef\xe0\xcd\x18\x96F\xa4\xbb\xd2m\xd9\x82x\x88\x9c\xbf\x80<\xe0+\xbf}+\xa6\xcc,W:Pf\x82\x85#\xb9j\x95\xc9\x12\x97\xc1:1\xacpm^#\x9a\xc1\xa6Y}Q\xaac\xdeBP\xd9n\xe1`\x93$Q!\xc6\xe3\xe6U\xbf\x90+\xad\xfdrN\xb6H\xaa\xac\xf7\xe2\x86\xb8\xa6AzR\xb9\x1c\xcd^(\x1d\xa5\xfc\x8b\xa9\xd0\xef\xfd\x8d-\xc1\x8f\xec\xfap\xb7\xd4\x9a\xcb\xba\xfe\xc5T\xe8\xf7\xbe\xdc\xb8\x1bK\xb8\x9bd\xa2$\x1d3\x01\xb6\xa2\x10\n K1?I\xe4\xe9\x06N\xa8\xa3_\x8a3oy\xe1[\xd8\xb5#:\x0e\x02\x16\x0b\xdf@\x0c\\F\xb4\xfb\xd6\xc6U.\xde\x0ex4\x84\x1b7\x82=\x0c\xd3|!\x11U%\x99\x01\x03\xa4\x97\x81\x9f\x06\x19aHfI\xbe$\xf4\x94<d$\xb04\xb8~\xf4`\x1e\xb2\xe6A\xb4p\xb8Y\x853&$\x1e4\xba^9i\xdb7_A\x9d.\xe4V &'g\x0f\xad\xa5<\x03\xf2?	@w\xbd\xbf47\xdb\xc1\x9b\x94\xde\xc3\xeb\x9d\xca\xd7\x87\xd44\xe5S^\x08j\x11\xf4>n9\xaeS\xdc\x97\xb5\xce\x99\x86.\xc0%M\x9ah\x0b\xefnxpL\x123\xafS\xb2\xd1\xeay2\xf0\xa3\xf2u\xa9\xe0em\x85*5b%\x03e\x1b\xa1\x981	/c\xe8\xfa\x15\"8\xd8p\xea\xd5\x10\x92\x84\xee\xa6gQ\xa8\xdbT\xd51\xe2\x10\x90\x938\x00\xfemf\xa6\xad\xdc\xf2\xb27B\xdb8\x83\x14\x0d	\xd3(\xdf\x12X\xf7\x8a\xef\x0dm\xd5\xe0hE\x9dq\xac\x9c\x96\xa4\x0e<}\x10\xc7\x04\xb3Y]\xb5\xb2\xd6\x92\xacQY\x91\xea\x82z\xe2-\x9c\x1f\xe7<h: t\xe2K\x93\xfcz\xde\x92\x8b\x82sJZRBV\xc2\x9a7\x80\xb9u\x1d\x16Ix\x81Y\x12U\x97\xb70\x03\xc8#\x05<8\xee\x05}\xb7\xd7\xdf`\xab\xb2\xd1\xf8C\x18\xee\x90\"\x91]\xdd\xd2\x0bP|\x92|\xd4D!e\x12E\x87wq\x92\x06\xc0\x8f`\x96c\x9b\x07\x00\xeb\xa9\xfb\xb7\xcc\xff\x0fM]Hl\x14\xf8\xf9,\xdd$\xb0\x15\xf2\xf7\x0ff \xa4?\xcd\x9c\x85vE\x88\x08C\xaa\x8b\x15)\x86\xe0W\xf7%+\xa47%iO'\x9aKI\xcd\x14T\x1c!\xabI4d\xb6&\x96\x94\xe3\x10A\xec7\xce\xd7x\x83\xa5P\xd2g\x7f\x18\xce2\xb7\xcd*Z<\x9a@g!\xbd\xe2\x1c\x16\xd2+G$\xe2r\x1d\xe6\x11&\x97\x85\x83$\x96\x022\xd5v^\xbe|Y\xf0K\xe3$\x069[!\x99\xee\xd3\xd6R\xfb\x1f^\x1f\x9d\x1cJ\x05\xa1\xc5\xfa\x82o\xe5n\xa9e\xd8I\xccR\xc1\x1a\xf4\xec^\x02{\x95c\xb8\x1a\x1c\xd1\xc3\xe611\xa3\xde\xa0\xcfr\x96+\x9e\x17\x99\xd6\x1b4\x1a\xbbC\xc2\xeb\x7fX\x98\x03\xcb\xca\xf8\x13\x01\x14wT\xa0\xe5#%\x17)#\x98\xdc\x04\x97\x90{\x1b\xacJ\xda\xd2\x14[`@\xd5\xeeN\xa5\x18\x9a\x97Q\xf3Ss\x04\"\x04L^\x0e\xfa<\x13f=\xa1\xf3mf|\xd6\x1b\xf6\x95\xd4\x9a'\x8f4\x1f1\x9d7GH	\x0fW\x83\xe5rwx<\xd2\x1f\x9a\x8d\xc6nf\xcf\xe2Y\x063C\xc7\xa6o\x8eX\xc6\x06Jg\x8ff\xdc\xcfg\x19\xdf\xb4c5\xc1Ie\xcb\x17k\xf4\xc9\xcf\xc7\x99\x17RX\x96\xd6? \xdf\xce\xf4\x088\x03\x08X\xdc\xcb\xc9\xc9Z\xde\xb7\xa5Y\x15hH0e\xd8\xa3\xd1P!1\x15,\xb6,7\x93|+\x91\x88\x10\xb4a\x11/YX\x8dF\x11\xa4\x94\x92\xc2\xea\x9b1T\x08\x14\xddS\x7f\x02\x15\xfb\x8c\xc8\x0fzJ\xf1\xe0\xd1\xb3\x8c%*\x0d\x0bH\xc7e\xd0\xb4\\\xf5\xa8\x11\x98~\xf1\xc8\xc9q\x0c@\xe7~Za\xad\x8d,\x87K<Mo\\tj\xc4\xc7l\xca\x07\xde\x00\x9e\"\xcc1\xfc%\xf4\xfc>\x1f\xb1\x01~\xa6\xf4\xb0\xbb\xe3q9\xe5Pr\x1f\x9a\xe3\x12\xb8\xf8\xa55\xc7\x9b\x04*I+\xf64w[\x19\x99\x98\xa3\x1f\xa2E\xbb\x99\x12\xcc\xceV\x967,\x9d\x13\xaeSK\x86\xba&\xc7\xacFL\xc1\x9bsX\xe1\xf8\xd2\x81\x071\xcc\x1cB\xad\x16_|@\xb6`5\x0b\x82\x0fAZQc\xc0\xb8\xf2\xb5\xca\xf5~\x89\xd7>U\x84P\xc1\x13\xc8m\xfd\xb3\xe4\xd4\x94&#s\x1bZ*`=\x08\xfd\x94\xe5R\xc8\x83W(\x05Ex\xb9T\x9c;\xd8\xc3\xa8\x19=\xd4z\\\xef\x1a\x19\xd9\xc3E\x8cb\xd0\x89U\x1bZ\xdb\x10b\xeb\xdbFs\x0ce\x94\x18<ih\xed\xa4\xf4~\x1f\xa2\x10\x9a#\x08\x02+\x8cI\x01=\x12\x00\x026\xb6@s\x1e\xf7\xf9P\xeaWK\xc2?qs\xb1\xabR\x9d\xa5\xab\xaf\x892%\x9c\xf1\xa6\x8cU\x00\x9b\x08r2N\xc2(\xb1K\x0b\xb7\xa026[.\xcdj\x046'\x1b\x0bq\xe4\xa8\x9c\x1a\xba\xdd\xc6Bv\x18z\n\xa4\xb1}\x95U\x0b\x03\x0ef$\x04=\x16:eI\xa1\xef\x95\x14\xaaV\x0ffB\x86\xa4,]ej\xbd\x1a>f\xf7\x133%e\x7f9\x97\x9f&\x9b\xd8\x08\x0c\xee\xbdRB\xe8N\xc6a\xb4I\x91-W[\x16\xb0M\xdb\xf7\x98]\x98\xd8V\x8f\xed	@\xcb}`\xc6\x16=\x02\xf4'E\xb3\xf2\xc0`\x11\\sh\x19\x9cJ\x06\xa7\xdf\xcc\n\xc6\xf6\xdbPgk\x17\x86\xa2\xd5V\xe7\xe2\n\x8f\xe0\xa3\x83\xa8\xf03\x1e\x1d\xb7\x0e[\xaa\xb8\x9b5\x8f<\xbf\xe4\x7f\xbf\x0d5\xfe\xf7q\xbd\xb2\x9e\xe1\xb4\xa1\xb21\x83\xf5\xe8b\xf7s\x892X\xae\xdf**\"\x91\xffz\xf1\xf6ZI\xa0\x1c\x90\x8b!\xd1\x18Z\xcb\x9a\x9fzu\x03\x0f\xcd\xca\"\xd6\x0c\x81\xcb}\x80\xb2\x8d\x86\xfee\xe6\xe2\x9e\x93\xd5\xac6H<\xd0\xde\xdb^}h=\x85rsA\xb9Fn3\x14ZY[dY6\x08F|O#\xb2P\xa3Q\x04e3J\x9d\xf9\xc3%\xcf\xcel\x89\x9e\xda\x99\xff\x10\xbc\xcb\xce&\xfe]\xe0\xfd\xbc\xa8\xa2\x81\xbc@\xfa\x00\xf4]\xe3\xe2\x00\xc2\xb3\x1d\xfb\xf5y\xe75$R\x0f\xed\xc3\xf3C\x8a\xdb\xb7\x0f.\x0e\xec\x97\x90F{y\x8e\xc0\xe1\xd1\xf9\xe1\xbe}T\xfc\"\xe1\xa2\xf3\xda\xee\xb0\x83C\xdbA\xf9\x0ek\x1b\x8c6\xbc\xfb\xe1\xd2\xa6\x80\x10\xc77\xa6\xf1\x9d!\x8c\xd8\x19\x06\x0b\xe6\x83h6\x0c\n\xdd\xa5\xcc\xed\x15\x98V\x9fM\xc3y\x10}\x06\xc1\xd7uX\xe4\xc7w\xa8\x0b\xbf\x85\x0c=\xfbyQJs\x15\x98\x07\xdfMn\xec\x81\x1f?\xf8\xd9\xd5l:M\xd2<\x18VsJ\xf8\xb7\x1d\x11\x11\x1b\x02\xaeA	\xd6\xa1\xbf\x9a\x16\x1e\x8d\xc7n\xd3\xceE\xb4\x11\x0c\xc6~\n\xaf\xc4	\x1f&\x03\xf2\x0e$M1~\x88\x02|\x99\x86Oj\x94\xb2>tB\xda\xc3\xa0	\xf1\x12{\x98<\xc6Q\xe2\x0fy\xdc4l\xa3\xe9\xb3DzE\xe2\xc6\xcdm\xe4\xc7\xf7\x86\xe4n\x117\xee$\x89c2\xa0\x89\x87\xe6o\x9f\xcf%\x9a\xea\xaf!\xe2\xb2\xc1Z\xb4l;\xdf\x9c\x88\x11\xfd\xd7h4\xda\xb4>\xb2\xbe\xb5\x04\xc3\xd2\x97K\xe6*c\xa0\xe9\x01X\x97\xd8\xe34\x18\xf1\x94\x95\x86\xb3v\x15\xaar\x81\xeb\xf0\x03\x94\xe1\x96\xcb\xe4\xc6\xbeM\x93\xc7,H\xed0\xa8|\x06\xc3\xbb\xc0\nG&\x88\x03\xc9\xa3\x1d\xfb\x0f\xe1\x1d\\#\xda\x93\xec\xca\x7f\x08\xba)\x9c\x16\xbc\x8f\x92\xdb\xd2\xb2|\xc6\xfd<\xb95S\xe1\xe1\xc14\x98a\x11U\x07\xd6\xc4\x84\xff\x1eiU\xfb\xb70\xce\x8f\x04\xbf2\xb2\xbc\xa8\xd5\xf2\xacY/\xea\x83\xff<\xf6\xd3\x93d\x18\xbc\xcb\x91\x82J\xc7\x84K\xa3!\xb37\xeb[\xde7\xfbc\x8e\x99\\Z\xe1\xb9\x83 \xfe@\xed\x08lk\x03\x1c\xec\x7f\xbc\xb9M\x86\x8b\x1d\xa2\xc7sX\xae\x02	\xbf\xed\x19o\xdf\x84\x93\xbb\x9d,\x1dp\xe3\x1f\xcd\xb4\xf9\x0f\xa3\xcc2o\x11dv\x01x=c\x87v'r\xe1A\x01\xe65,\xf2X(\xb1\xf7\xf6\xcd\x1ej\x7f\xfb\x0f\xd5\xddd\x1a\xc4\xa6e\x17\x9b\xf61\x0d\xf3\xc0\x1cj=\x14O\x96rm\n<\x82$B\\Q\x0f\xbb\x9d\xdd\xdeFA\x86\xf7\xd8\x00\x061#\xbc\x00\x04o%)L\xb4\xd2\xda\x9a#\xc0\xf1\xaf\x0f\xa6\xa1\x01'\x83\xfd\xbc\x10w\xcf\xe7\n\xfc\x9a\xf8w\xe1\x00J\x83\xde\xaf[\xa1\x17v\x8f\xdb\xeb\x0b(F\x0cO\x82e\x0e\xeb\x1c\xd9\xaf\xc7\xafl'zm\xef\xb7:\x9d\xe8\x15$\x8b\x8f\xa2\xd7\xf6\xab\x16\xc4\x9a\xa3}\xe6t\xec\xa3\xb1s`\xbf\xa6\xfc\x87G\xe3C\xc7\x86\xf4\xac\x9f\xba\xc6\xc5K	\x0e\xc7N\xfb\xcb\xc1\xd1\xb8\xe5\xb4\xbf\xe0\xf3\xab\xd0\x1ep\xf6\xc7N\xfba\xff\x90\xe2\x9d\xfd\xaf\x17\x07\x90\xd7\xed \xf2\xe0%Ev\xbe^\xec\xcbZ\xf7\xedWP\x06\xf0\x07\xf7\xaeqq\x04\x89\xfd#\xfb j\x1d\xd9\x07\xec\xc0v\xa2\xfd\xb6\xfd\x929\x87\xf6\xfe\xf9\xcb6;\xe8\xd8\x87HrZHju\xa0\xe1\xe08\xe7\xaa\xd4\xd7\x9d\x8bCH\x15\xef\xb7)\x13;\xb0;Q\xcb\xd9'\xf1\xfe\xd7\x14z\xddzi\xbf\x16\xf9\xdb\xaa\x8dN\xa4~;\xa4\xee\xe0D\xa8\xb7\xe58\x91h\xa8s.+U\xd5w\x1c\xfbU\xd1\xc0\xf9>\xb4)P\xdb\xa1\xbd\xcfHJ\xfb\xa0\xe8\xc2\x81\xe8\xe7\x91\xbd\x8fz\x8a\x06U\x07\x8ep\x95\xd8\x9d\xc8\xd9'\xf1\xec\xd7\x08\x1c\xa0\x87\xaae\xf9\xeb\x88\x1e\xa0\xdd\xaf\x17\xfb\x90\x8b\xee\xd8\x9ds\x92\xbb\x86\xaa\x89\xec\xbfS\xd4\xa7\xc6s\x08!w\xfbUt\x885\xb6_\xd5j-kWU~\xc5\xb5\x14\x05C\xd7\xb8\xe8\xd0`:\xe3N\xc7>\xfa\xd29\xfc\xd8\xb1\xf7\xbft\xec\x0et\x19\x0e+	\xfb\x87\x14O\x05\xf6\x0f)\xff\x03\xfeP\x89}R~8\xac&\xec\x1f\"\xdeX\xc9+04?\xcbK\xd0\x92\xa4 \xf7iU\xb1\xf1\xfa\xb4\x12\x02\x10_/\xf9\xaf\xdaE\xe5}\xbd,\xde\x80|\xedYSR\xddH\xffO\x7f\x1f\xc2#\xa0\"5\x0e\x15E\x15'\xc5\xd0Mi[Op\x9e\x07\xb26=\x9b{y\x1f\x08K(\xba\xf2\xfe\x92?\xad\x91\xd7\xc1{$\n\x85q\xeb\xc3\n\x11/L\xa8\xc7\xe6S8t\xd5-\x8f\x82\x86\x90\xf6\x96\x00\x0ea\xf0~\xe8\x14\xc88\xfa k\xa3\xd2\x80\x9fJ(\xac\xfb\xc9D\x88&\xe8i\xf8\x86\xedQi?M\xd2D\xa8Q\xf1\xc8 v\x01\xce\xf1\xe6\xceS\xce\xed\xbd\xc7\xd8\xfe\xbf\xee<\xda\xd4\xfaN\xb5\x7f\xab\xf7\xcb\xe5\xd6e\x90\xd0\xe6\xe6&\x18\xdc\x14P\xf4\x86bon\x8c\xfa\xcc\xc9\x85(\x9b\x17g\xe4\x7f\xbb\xf9\xb5\x86\xa8^\xad\xa1\x15\xfbBFihA\x18U\x07\xfb3\xa2?\xcc\x10\xd9\xfb}\x9c\x83M8[i\xc9B;\x0bR\x02\xc54\xb4\x03f\x1b\xcd\x90\x10\x90\xf7\x97\x90\xab\x95\x92\x0d\xd2w\x9b\xdb\xeb\xaf\xbc\xa1\xf9E3&\x93[O\xb0\xdd\x14Zd\x8d\x0f\xdeO\xf5$aP\xa6xx\x9b9+\x1e\xddx\xa5\xb3zz\xc8\nj\x0f\x0cKmw\xbd\xab,\x85\xff5\x0b\xd2\x05\xbd\xfa9O\x84?e\xd9W\x1a\x8d\x9b\xac4\x7f\xbe\xc5\xe31\xb0\xb3\xd9-nK\xe1\xc4d\xc8\"N\xc35\x13\x92[\x89-\x0f^\x82\xe0\xa7]Yo\x87\xd4\x98\xa8W\xbc\xab#\xe9\x8cm\xc6\x835\x1b6\x98\xbdY\xa3a\x0c\xfc4\x0f\xb2\xd0\x8f;Cl\xc2\x19\xa9\\4\x1a\xe5\xbe\x08\xcbm\x19J\xfa\x80\x14\xf4}7\x0f\xb2\xf7\x8b+H\xc3\x9b\x85\x8a/\xd8r\xa8[\x92\x97`Wm\x18N\x9a\x068w\x06\x1b\xf2\xdc\xa6\xd9(\xdf\x08\xda\x84\x0d\xa4?$\xc2\x89M`\xe9M\x83f\x08 pX\x8d\x1e\x02\x07\x85\xaf\xb6\x81\xaa\x89rz>\xa8\xa0\xf8\x03\xb2A\xe1\\k\xc4\xdb\xde\xe8\x0d\x1fz#\xf2*4\xe8\xc3\x98\xa2\xfc\xc51\xf1d\xd8\xbe\x05\xde\xec\xa7\x8b\x9f\xfc)/\x86\xbd\x02\x1f\"\xd8b\x9b^pS.\xd4\xa1,X\x1a4\xa2\x10d\x0dI\x95\xf7\xe9\xc9;\xc8\xb6\x16\x94\x96#\x8a\xf3\xfd\xf7Mo\x04\x15\xbb\xe7\xb9]4\x8em\x0c$\xad\xa8\xdb`\xbfJ\x14\xed\xa7\n\x8a\x060\x8975\xfb\xe7%a\x8a\x02}~\xd9V\xa6\xc0[\x86\xc5~\xbc\xe4\xc6\xff\xca\x0d\xf6Qd\xf9\x1c\xdc}\x98OM\xa3g4\x7f\xbcl\x1a\xfd&l\xe1\x1b\x967\x9bo\xc3\xf1\xd2\xc0\x1fv\xe3\x88\x0c8\x08\x90\x051\xb1\x18|[:%$\x92\x8b)L\xe4\xd4Q\xa4|\xd7\x02\x7f\x00\x12\xb1\xffq\x7f\x7f\xa7\xf6up\x08L\xe3\xb5}8nu\x8ev.\x1c`[\x9d\x87\xc3\x97\x1f\x0f\x9d/\xce\x81}t\x0eL\x8du>\"\x01\xf7<}\xd9\x9d\x07\xa7c\xbf\xfax\xe8\xc8\n\xa0\xbd\x89\n\xd4\x9374\x7f*\xee{ \xf6\"\x02!k\x9dS\"^Z\xb9\xf2\xa0\xe1jDD?\x0d\xfc\xb5\\\x88|_at\xec\xef\xef\x1b\xecv\x96\xe7I\xbc\xa6\x7fE\xb1\x85\xdb#Y\xcf\x8a\xcd\xe6%\x92\xb1\xe55\xac\xe0\xcbNhZO\xb1\xa4]\x08\xfa\x96o\xb1\xb9}3L&\xc4!\\\xe9\xf65\x93I\xe9sXg\xfc\x0e\x93I\xa3Q\xadE\xa9\x9aL\x94\x9a\xc3\xb6\xa7\xf30|0,\xcf\x17\x02Z\xf6 \xcb0\x1e^\x88\xc4\xba\xfem\x96D\xb3<\xf0@)r\x0f\xa7s\x0f\n\x17\xf8\x95\n\x17\x08\x12\xfd\x89B\x06SU\xd5\xd6B\x99c\xdd\xfa\x1e\x96/\xf0m\xfd\x1c\x1f\x00\xc1Q\xb5`\xb9Q\xb4\xd7\xf7R;\x8c\xe3 \xfdx}q\xce\xe1\xbfh\xb9L*\xd4{\x96\xd6\xc7&\xdf};N{:\xdf\xe9\xb4\xa9\xd7E&\xbd\xaf\xc5\xbe1\xc4,F\xcf\xcf\"\x9bmMW{\xcb\xb0\xbc\xa8\xde\x1d\x002\xd8O\xb9\x8d\x92\xc1\xbd\xa7\xbd6Ap\x1fE\xc9\xa3\x0b-\x1b\xcf\x90/c\xd9\xb7\xdaI%\xb6\x85L\xaa\x9dW\xc3bC\x1e\xcfab\x02\x18\x86\xdaz\x9b\xd8\x18&\xed3i\x90\xc7\xb2\xbc5\x8e\xc7\xe88\xd2\xa6{\xe4^\x98#\xab\xd1\x88 9\x1e\xc4CI\xa0\x95\xce3\xab\xb13XdR\xa0F\xf5T}\x83\x7fS\x9f\x16m\"$\xa9\x94\xc2\xa3$\xce[#\x7f\x12F\x0bw\x92\xc4I6\xf5\x07\x81\x88\xcd\xc0\x11v\x0e\xa6s\x0f\x18OK\x95\xb2_\xa6\xc1\xc43\xd8\xec\x1bK\\d\xd0x\x96\xaa\xa3\x1b`\x83^\xa0\xba\xa7\xeb\x85\xca\xec$\x8f\xc0\x87\xd2# \xd6s\xca\x87\xf6$\xc8}v\xf7\xfc\xde\xf2\xee\xbe}B\xe5\x81l\x8b\xa3\xda\x96\xa7\xb2-w\xce\x84\x1b\xa3(\xf1s\x97\xa2=q\x08Z\xf4\xe1\xd2!\x10\xc3v\xc1\xcd\xf5\x06\xb34KRW\x8a\x04y\x8ag\xdc\x99\xcewp\xca\xb5\xe9\xee\x14%[\xa9`8\xefO\xe7\x06{x~8l\xf1\x8d\xe1N\x9a\xdc\xf0\xcaymIVsSN\xad\x06\x90\xf1\x8aA\xe6\x8d9\xaek'xN\xb8\xac7\x0b\xcd\x07\xa6h-\xa1\xc5f\xa1\xb9(\xbe\xebOA/O\x17O9\xdfpp\x06\xc7\x03\xc2\xf7\xf43\xe3FsS.4\x9bZ\xab\x01\x84.\x80\xf1\xe6\xe34y\x04\xb8\x07\x0e\xb2\xf3!M\x93\xd44@`\xdc\x01\xa9gg\x04\xbeR\xbe\x13 ~\xc7hBY\xb0\xd1x\x1e\xc99\x95\xc8\x81\xa1a59\x9e\x98&\xf0\x8b\x87\nXt\xfalQ\x89\xe8 \xa2\xba\xa3&\xeca-fw\xed\xa06\x1aw\x95s\xbd\xb0X5\xe2\x81\xa6s\xc6\x8c\xfb`\x01\xba\xab\xc62\xcc\xe9u\xfb\x9asn\xe6\xf6}\xb0\x00\xd1o\xb9\xcc\xed\xc7q8\x18KlV\x1a;\xa2\x13\xa2$\x7f\x84\xc3\xa1\x90\x9e\x04i\xce\xe2Z\xec\x87x\xe8\x95\x85\x04\xa6.\x00\x97\xd9f\xa1\x05<H\x8f\x8b-\xb6\xa1\xd6ZK\x1f\xe2!\x0f\x9b\x0e\xfb\x0c\xab<@8\xfd\xca \xd3zDT\x8f\xb8\x83Y~1\x9d\x02\x10\x91\x0bOh\xf0|\xa4\xcf\xd6Q\xbbi\xe0\xa4\xc4\x95\x8a}\xd99\xdc\xe9\xdc\xaf\xe1\x12\x02M\xa8\xa1\x12E\xf6F\xa3\xc4\x12\xb6\xe0\x02\xb5\xfa$\x97cS\x85:\x9b\x85pU\x85\x8a\x1al6\xd7\x8d\xae\xd5\xf7\xa2D\x9bU\xf6\x7f\xd7`\x1d1(5\x94Y\xbe\xa4*{IC\x8d\x84\xd1\xd0\xf7\x0b\xb0(Q\x0c\xeeo\xe4\xd3G\x8a\xda\x17\x0fW\xf4\x0c\xc6\xdb\xa4\xb7Z\xdd\xe5\x0f\x92\xdc1\xec\xdb!$\xac\x93B\x80cGf\xf6\x95\xc0J6\xf0\xf3\x1c\xa6\xfb\xc0\x9d\xa4\xd7\x88\x8e\xe9\xa7\xa6z\xfb\x86+\xa6\xa3\xfdJ\xda\xe9\x0fh\x9c\xff\x06\xbc\xbd}\x13\x0cn\xc6a\x96\xdfdy\x92\x06\x86w\xff\xa9\xa6|\x8e\x9e\xfc\x91$\x13\xb95\xa1\xb4\xff\xe9\xdby\xa8\x99\x7f\xad\xbd%P\x11\xfb\x13\xad\xff^\xb4\xfe\xbf\xda2GK5ucx\x93\xad\xaf\x05\xc2\xfb\x9f\xbe&P\xb5\xbdh\x93\xd5\x94q\xe7\xa5\xfdz\xe7\x02A\x86\xe0\x97\xf6\xce\xc5~\x07\x86]\xf6\xed\xc3\x8f\x87G_\x0e\x8f>\"\xb8\xf3\x05\xb1 \x01\x83zr\xd1\x81i\x98\x83\xf3\xd7D\xf0\xb5\x0f#\x07$@\xfc\xd9\xb9p`\xcb\x01\x91\x1fa\x17\xe5\xe1\xe0\xc0~\xb9\xf3\x11\x91\x0f\xad\xceK\x8d\xf2\xf7/\xf5\x12\x10T\xb5\xbf.\xf9D\xc3\xbd\xbd\xbf.\xa5$Je\x87\x93\xec\xf1\xd3\xba\xb2|)\x85\xa3\xa4\xe6x\xc8\xe6\xf0\xed/UUb\\\x15\x0b\x8a\xb0V\xec\xaf\xedT\x93_@\x18(m(\x88\xcc\xcf\x1c\xde\xdbt\x96\x8d\xa5~B\x14\xa4\xf6,\x9e\x08+oT\xf0\x99S\xbaVR\xe65\xe5\x84\xfcr\xc9\xc5R\xadIm\x08\x8bh7a\x86-\x81'\xf4C\xe0\xa9ao\xb9ur\xff>\xf8)Jn\xfd\xe8\x84\xb0\x03\x83\xdd\x07\x8br\xf3]\x118\x15\xa4\xbd\xf2\x1bU<\x04\xb8\x97@2\xd4\x05\xb3\xd5\x10Tc\xaa#Il\x0e\xe7\xa6n\xb3\xc0\x82\xa4\xf8_\x97\xf6M\x12\xbf\xc7L\xd5\xe0\x95\xfa\x827\xce\x92j%yy t(\xd5&z2A3\xc2\xcc\x19i\xa9\xc0\x1a0\xc4O\x0b_\xac~\x1e>\x90\xe2\xde\xa74\x99/\xccL\x88\xda\x87\xf1\x85?\xbf\x9a\xfa\xb1\xa9T\xdfg\xf6$\x8cI\x9f\x06\xd1\xcaQ\xf2\x0cn\xbd\x8bXr]y\x7f\x82+H\xb1TYZ\xeakI\x15<\x8b\xb5Y\xb56V\xad\xc6\xb2\x18\x08KI/\xb2\xc3a\x9f?\xa9\xe9=\x1b\xba\x88\x0110\x15\xbe^]rS\x18\xc4C\xf5E\xbe\xc6\x8b\xd9)v'f\xa7\xf4\xb6\xb9\x9d^\xa6Z2\x98\xa4|)\xcf\x7f\xba\xa5L\xe2\xceK\xa7!\xd2\xa2=\x0bjT \x92\x8a\xe0\xb0\x16\xcd\xe2\x15\x0cQ\xdba\xf6!\x1eBP@\xf7\xf6\x9f@|\xca\xe7\xfa\xca{\x9bw\xb9\xb8KN\xf0>\xcaL\xc8\x97\x02\xa5z|0\x1f\xe4\xbe\xa1\x85.\xa9p\xec)\x8c\x89\x15\xee\xf6\x84\x97\xc0\xfe\xca\xb2'@\xab\xba\xb3|:\xcb\xc9\x1d-\x08\x8d\xba\xe2\x1c\x0b\xa4U\x8d:M.&\x9a\x9c\xbaTr\x9b:\x87\xe9\xf1\x0c(\xfd Krl\x86&\xfc\xa9\xc4, \x89\xf6\xd04\x16\xe2\x8b\x9c\xd6\x85Bw\xf0_\xe4s\x05o\x95\xdf]ca\xac \xcb\x18\x03\xb6\xc0\xaa\xe9\xdc\xc4\x05\xc4\xb6\x9e\x91\x04\xe4\xab\xbf.7\x9d\x1f\xed|(\x9c\xaa\xd7\xf7\xfe\xbc\xac\x10Z1:\xe9\x18\x1b*\xd4X\x9c@.G\xa3\xf1\x0dHP\xee\x8c\x8a\"\x84\xf4]\x1b\xac4\x94\x01\xa7\xda`\x93\xf9\x9a\xe4\xb0\n\xef\x04\xd8\x97\xba\xd7\xd6\x84\xe7\x8a\xd0\x08\x07\"\xe4:\x8c\xce\x97\x0f\x83\x13\x11\xb4\xa1\xfd\xe5rA6\xbcM\x9f\xef:\\$\x91\x18*\xc2\xc7\xbd\xbe\xdb\xf3\xa1Y\xa0\x8f\x98 \x83t\x8cS\xab\xab\xe5\xecr\x1e\x99>S$\xd5\x94\xab\x8b^\x02\xb5\x1f0PX\"\xb9M\xe6t\x0b\x0e\xdd\xdf/\x9by3\\yi/\xe9s\xb8\x87$|\"\x05\xb2\xb8*O]h\x16\x10	n\xd0\x82\xbe\xb70c\x88G\xc7<>\xee\xc5B<\xfa\xcfK(\x98Y\xabR\xe9,\x96&'1}\xcb\xa5DH\xf0\xc1{}KU\xa1\xc5\xc6\xbc\x17K\x8b\x16\xd8\x93\xf2F\x07\xfa\x934\x1a\xe6\xc2$\xcf\x7f	\x87J+9\xfdK\x94\x80\xb3\x1c\xb0\xcf\x8b\x98\xa2R/0\x0dr\x17d\x00V\x06\xa6\xb1P\x1f\x85}\xff\x1f*\x18\x06\x94,\x924\xf0n\x9eG\x1e\x8c\x0b\x88\xb7\xec\xef\xdb\x07;\x17\x07\xaf`\xba\xea\xf5\xf8\xb5}\xf4\xe5\xc8~\xb5sq\xf8\x12\x8c\xc3\xb6\xed\xec\x9c\x1c\x02\x85x\x0d3i\xb0\xe7\xf4\x12\xa8\xc5\x11\xeb\xd8\xce\x89\xd3!\xebk0\x88\xf7\x929/\xc1\x1a\x86\xc9\xb4\xb6\xfd\x12H\x08\x08\x86\xce\xc7}\xdbyp\xdavg\xe7\x02\x19\xf7_\xdb\xaf\x07\x07\xc4\x9c\x05Zrh\x1f1\xe75\xa8\x91\xf8\xe3\x1c\xed\x0c\x9c\x03\xbb\xd3\x82\x0d\xbb\xce!\x02\xc4\xe1>\xb0_\xb5:G\xf6\xa1\"<\xfe \x91\x8d\x15\xbb\xf9\x16\x81\x0fK>\x02\xc5e+\x9dZ\xceU\xed\x04\xa9\x93S\xa4\xdeTp\xed\"Z\"\xd9\xc5\xb7\x00\x88\xdf\x81\\\xd7L.\xa7\x81\xa0\xb2\x19\n3\xfd\xf9\x92\xfdz\xc9\x8dY\x1a\xbb\xd9`\x1cL\xfc\xac5	\x07i\x92%\xa3\xbc5H&\xee\xc3\x04vC\xbb\xdc\x98\xc5\xc3`\x14\xc6\xc1\xb0\xa4#	q\x05\xc1Q\x91\xa2\x0bA\x97\xc3\xa7}\x97\xe7\xddF#\xef\x16\xb2\x10\xd8\x99a\xb7\xd1\xd8 Ld\xe1\xb9\xbd\x1bv	%'\xeaNf\x7fM\x1f&Q\xa3Q\x8d\x84\xcc\xa0A)\x06\xfb\xf5\xd2b?_\xf2\x0d\xea\xefa\xb7NI|Ce\\\xa3\x997\xff\xb13\x88\xfc,\xe3\xb2\x9a\xb7\xff\xb0V\xe5\x8b\xfd\xbb\xeb\x135\xcd'Q\x9cA2\xe4\xd7K\x88\x83\xacW\x8cs\x16w\xf9\x8f\xb7\xf6\xc9\xc5)K\xbaB\xdd\x84\xa8\xd5\xcc\x97_\xd9_i\xceR\xf9\xe1\xdff,\x93\xe1A\x92\xb1H\x86\xb30f3\x19\x9e\xf8t\xca7\xcd#A\xb2q\x97w\x9c\x97\xed6\x1bt\xf9\xb8\xbb\xd7a\xc3neTy\x8d\x08\xb0\x8d\xf0\xdb&\xb2o[\x91'\xa7\xf3\x82(G\xe4S\xe92\x08\x148>\xee\x82\xe7\xd0\x1cwU\xac4\xc5a\xb4\x19\xec9\x8f\xaa\x1d\x90x\xc8\x95x\x9b\xe7x\xb9N#\x7f\x10\x98{\x8d\xbd;f4\xfc\xc9\xd43\xb4X\x83b\xff\x9a%\xb9\x07&\xf5T\xafM\x1c<i\x08&\xbd\xbb5\x8df\x8f\"\xfb\x92_\xc2\x0c\xabiX\xc6\x8a\xddU\x8bYO\x019\xd7\x0dl\xa1Uq\x99\x0c\x83]R\xa4\xc9+\xafs\xe0\xfd\x93o\x17\xe5\xb2\xa8\xfe\x08G\xd1\x87m\x9d\xddq\x82\xc3\x17\xe6\xd4O\xb3\xe0G\xd0\xe8\xcc\x1c\xf6u\xac\xa6\x13\xecW\xa2\xa1X\xd3\xb6\x9a\xe1\x8a-\xea\xbd\x90\xa3\xae\x13l\xf3chLg\x02\x11\xeb\x8eL\xe3\xbf\x0d\xb0]\x8f+m\xed9\xed\xf6\x8b\xc0\xad\xc4\xb9\xf9\x8a\xcd\xd7\xfb\x8b=\x15\xf3wpN\xe9\x85\xbc\x19\xb2\x8aa&\xc7b\xd0\x99\xc2\xba\x83\xc6:\xed\x9a$N\x0b\x01Z\x16\xc3\xa9\x19\xb4\x0e\xa7\xfe \xcc\x17<|\x11\xf7\xf6\xfb\xd6\x8a\xddt7 ,\xef\xd0\x07\x89\xa4\xf6\xa6]\x13x\x14\xa1O,\xa0z\x82\xde~\xbf\xbfb\x8f\xdbz\x18\x90\xfcx\xe9\x08/\xb6\x10\xac\xf8\x14\xbe\x928\x1c\xf3y\x1b\x8e\xdc\xc8\x96O\xc6\xdb,\xe2\x0e\xa9\xd0\xaf\x994!\x9bJt\x02\xd8\x80\xcf$]\x07m(\xa7\x80\x1az\x98p\xe3\x0e\x04Q\xd0\xe6	\xb4\x0ey\x08?CB\xfb\x8d\x8dx/\xb6\xe7/\xc6,\xb6\x17/\x06}6\xa5\xef\x8e\x88\xe8\xbc\x18\xf4\xbda\xa3a\xfej\x8e\xd8\x88\x0d-\xf6\xab9eS6\x94\x1c\xe4;\xb2\x9d\xd2\x1aaN&d\xcc\xa45\xea9}\xcf\xf4\xb9s\xd4~Ap\xc5\xcf\xfd\xb8c\xde\xb1\x89\xb5'\x0d\xdbY$\x12\xec7\xf9\xfe\xcb\xb6\xc5\xfc7N\xd0z	\xb7\xbe\xbc-%\xd2\xb4.\xa3\xe7~$:^\xefju \x0f\xca\x08\x05[\xf01\x9b\xf3\x81\x97\xf2\x9e\x89\xae\xb5f\xf6\xdc\xda[0\x13}k\xcd\xec\x85\xb57\xef\xb3a\xa3Q\x8cj\xb1\xc7\x1fz\xed\xfe\x8bq\x97\xcd\x11t\x10\xa46o\xf8\xack.\xd8\xdc\xf22\xde\xde\xbba\x11\xef\xbc\x88\xedt\xef\xa6\x95\x11\x1c}\x045\x0d{\x0cf\xd4\n\xb3\xcf\xde\xa3\x9d%inn\xf2g\xbf\xa3\xf4][\x81\x0c\xac\xac\x82!}\xcb\x1f%\xee\xcb\xae\xe0_\xf0\x02\x7f\xcex\xdb;{s\xeb\x9d5\x9bb\xa7\\\xf3\xc7\xdeY\x9f\x9d\xf2\x9b\xaey-\xb6\xb8\xe5]\x08\xbc\xefZV\xfa\"jfMc\xc7h\x9e\x12\xaa\xd5\xde\xe5\xfc\xac\xd18\xdb\xe5\xfc\xb6\xe5,\x97W\"\xf7)\xe1z\xb7oyG\xd4\xfc\x8e_	;8\xec\x84_	\x1bE\xec\\\xc49\xfd\x17\x81:3\xec^\xa4\xeaq^N\xef\x11\x0eC,\x93 \x1f'C.0a\x96\xdb\xa3d0\xcb\xb8\x94\x19\xcfm?\xbe\x8b\x02\xee3u<\xdf\xa9P\x87\x9f\xa8`\xc6/JX\xa9\x9d\xd6\xfb2\xdc\xe1\xe7+Cn\x10<v\xe8\xc0SS\xea\xee\xe0iY\x87d\x06\xcd\xbb\xc2\xd5\xaf\xaa\x04\xe4\x80\xea\xd9\x15\x16\xf1wy@\xa2F\xa7~6\xa6\x8a\xe1\xb7\x93\xae(-A\xd6^\xf8\xa7\xe6<(\xd4DT\xa8z\xd4\x97K\xea\x80J\xac\xf4\xe3\xaa\xcb\xb7Y\x901\x00D\x0c\x0e\x13\xecB\xdfBJ\x1cg\xef\x17\xd7\xfe\x1d\x11\x1b\x05\x9dQ\xba\xce\xee\x05}\xb8\x97\xc4Cd\x97\xcbO3Y.w\x13\x08\x95\xa3\xfb\xa4\x85g\x1d\x9by/9\xa6\xda\x83\xa1\xe1\x02j'\xf7\x10\xc6\xe1F\x9e\xce\x02\x83\xa1he\x00\x8d\xc6\x04\x03\xf0-\xe6\xd3\xab\xe7v\x0e\xf7\xc0,9~\xec\xe2\xdd\xc2b\xcb\xbd\xa5\x90\xc5\xeeDF\xcb\xdd\xde\xc8\xc8\x8f\xb2\xc0`\xb2Jk\xc5.\xba\xbc\xd7\xeb3\xfa\xd7gg\xf5\x95\xc1l\x90\x8d+\xe6\x93}\xc3\x88\xc7]\xfb\x02\x82\xbd]\xfb\x84\x8d\xf1u\x0e\xb5\xa9\xae\xfd\x0e\xbc\xc6\xae\xfd+\x00\x1c\xa0\x9a$\xa5\xde\xc1\xb4S\x00\x02\n\x8e\x9c\xcf\xdb\x9e\xff\xe6\xce\xb3\x9e\xb2\xc7\x10\xd8V\xc2\xa7=\xbf\xd9\x84u}\xc3`!oC\x1ak\xe0g\xc1N\xe4\xc6\xdc\xd8\x99\xec \xd6a\xa9\xca\x97\xa9\xc0EW\x1c\x1c\x9e\xca\xa0\xd3\xe7\x99w\x9b\x06\xfe\xbdG\x15\x8c\xa9\x82\xe8\xdf\xaf`\xe8R=3\xaag@\xf5\xec\x13\x8c\"cw\xaa\x9a\xb9\n\xdc\xa8\xc0\xa3\x0cx	\xe7|xlN\xf8\x0d{\xe0\x8f\xec\x86\x9b7\xcd\xce\x8b\x85\xb5\xb7\xcf\x1e\xb9\xf9\xd8\xec\xbc\x98#\xbc\xe0f\xaa\xe2\xe7\xdc\xccd\xbc\xe5\x9a\x13Y\x13{\x90\x01Kv\xb5\xdd\xe7\x8b\xb2\xd7s\x04	v\xf0\x1b\x19t\xfa\xfc\x11\xc1\x0e\xc5Nd\xd0\xe9\xf3\x07\x96\xf2	\xcb\xf8\x83>U\x03\x17\xc3\xba\xe5miS\xcfag\xdca\xd7\xbcM\xce\xacoy\xd0;\xe8\xb3+\x1e\xf4\x0e\xfb\xec\x82\xfb\xe2R~\x81\x9b\xb9\x89\x9b\xf9\x05\xfeX\xecL\xa4t\xf0\xddA\xca>B\xfbps*\xd1Z\xba\x99Z\xc8\xbdwF\x04\x92\xbd\x0by\xb1\x9d\xaa\x91\xbeS\x81\x13\x158W\x81{\x19h^\xb3O*x\xdf\xbc\xf6\xfcf\x93\x96\xe5RF\xb2.?mf]\xf3\xdezq\xc2>\xf0w\xcd\x88\xc2\xe7l!\xe2?!~.\xe2?!\xfe3\xbf<6v\x1e\xfd\x1d\xc35v\xfc|\xc7\xf0\x14\x16nv[\x0b\x0b\xf7\xe5A\xa3a\x16\x91\x9fZ\xf7\xd6[\xbb\xed\x1c_6\x1af\xb7\xc9\xed\xb6\xd39\xb4\xdc\"\xfdC\xeb\x9d(\x84\x0c\xdd7\xa7\xcb\xe5.\x02oO\x8f\xe7-\x91\xd9\x9d\xcbR\xeee\xa3\xf1\xe1\xcd;\x91\xe3\xc3\xdbw\xc7\x0b\x95\xb0\x90Y\xa1\xe7IW\xc7g\x96tM\xd3<m\x9dX/.\x9a\xb7\xd6\x8bq\xb75\xe8Z\xcc`\x86Hy\xd7:\xb7^\x9c5\xaf\xd6SN\x9b[\xcb4\xb7\x94\xe9n\xcc\xffac\xde\xc5\xc6\xbcs=/\xcc\x99,X\xc6\xe7\xfa\xb6\x8b\xbb\xf6g\xday_9\xede\xf6\x1e\xbfN\xdf\xfb\x8aM+\x17\xf3k\xcf)\xc2\xef\x11\x8f\xc4f\x11\xe1\xf49r\xa8\x08\xec\xd7_\xcd\xaf\xec+\x0b\x80;\xbdg\xef\x19\xa0%\xca\xf0\xa4k\xe2W\xf5\xf3\xbd\x8c{\xaf\xc5\xa1*\xc4\xe1\xb7\xcc\xe7\xc8|e\x9c\\\x12\x01\x9fP)\x8d\x1a\xa5\x98\x009\xef\x9f\x8b{_\xe6+\xca\"\xce\xaaM\xce\x1fn\xd1\xce|\xc7 \xcc!|\xdb\xb6\x9edl\\\xa21_x\xdb\xfb\xf2&\xf4\xbe(\x8c\xe5'\xcc\xe4\x97>\xce\xef\xaf\xe6O\xec'L\x87,\x96t\xcd\x9f\xb4!\xcb\xed\xf3\x936\xba/o\xc2\x96sl0\xc35\xa0!\xa6\\\x17\x8f\xe4\xedkX+\xef,\xd6\x08!D\x08\xfdrq\xbe\x01\x9b'*&\xdd\xe2\x8a\xa5{\xf30\x89>D^HT\xf2\xdb94\x1e}\xb2~7\xec\x9a\xca\xdc\xb4\xc8\xc3C\x8b]u\xcd\x90\xd1uiHf\x8c\x8a\x13\x97Z\x11\xebIB\x1b\xda+\x91\xedD\xba\xbb\x8e\x95\x92\xd0\xfa5\xae*R\\C_\x11\x02\x83\xf2\xda\x96\xe4\xb4]\x85E\\&$m\xa9\xb44\xf1\xd4\xa17M\x0b\xef\x9d\x17x\xefx\xe9\x0b@\xc3\xb4kf\x96\xb5\xf2\xedGb\xfd\xf2\x948\xbf\x84\xc8J7\x85S?\x1f+]M\x84I\x94\xef\xc7[\xe9\xe6\xe7\xe6\x86L\x9b@]\xbb\xd10#\xfb6\xb8\x0bIy[\x99\x06\xbe\x9d\x85\xd1\x90\"\"\xc9\xdb\xc6l\x82\x03\x9d'\x10\x93\x0d\x07*\xa7V\x15\xdfu,\x16\x8a\xe6\xce\xba\xaad1kH\xa3%\xb3\xbf\xd2\xe3\x91?t\x05u]\x980\x10\xed|\x95?\x1d\x89v\x84\x16SX\x1cDf\x8e)u\x98\xfa\x8f\xca\x81\x9b2\x0d\xb2n.R\x10\xc1\xc4\x93\xb9\xccm\xadXe\x93%\xf1\xe7:\x1f\xcdz\"<F\xda\xda\xc6\xb6\xb2\xd8w\xd6\xf5n8\xacTt\xb7\xb9\"A\x02\xd0+\xa2}v\xad\xa3I\xc5\x0b<\xb9\xfdS\xb0\x01\xa4\xe6\x950\x8e!\xb0\xc5F\xc38\xbb\xf8	Xs\x11e\xe7\xc9o\xd3i\x90\x9e\xf80\x05\xbb\xf2~\x0e\xbe\xebH\x01K\xd5\x8f\x15\xd8\xdb!\xa9\"\x86#\xf3\xbak&\x1aa7K\x07\x88\xf5\x95\x1f\x9d\x1b\xcax\x95\x0e,y2E\x04\xa1\xa6\xc5\xf9\xa4(!\xaaP\x1a>\x80hS:\x8ba\xea\xe3\n[\x83\xd8j\xe2A\x1c\xf1T=\x88e\x14W\xeeed\xbc\xfa\x0e\xb8TRe!Wj\xb0L\x95\xc9\xca\xec\x11\xab\xf6\x96\xfb\xac\xde\xdb\xc2\xda\xba\xd6[\x1e\xae\x12\xee\x8bWG\xb26\xe4F\xc3\xfc\xbeA\x93@\xbb\xf4\xe7=\xe3\xb1=_.\xdb\xc0t\xed\x05\x02\x03\x1e\xcb1\x0cy\xac\xc60\xe2\xb1\x9d\x89Y\x9c\"(f\x8fA\xf5?\xa3\xe2\x13\x84\xa8\xfc\x03\x1f5\x1aS\xb6\x90\xed\xd2\xae\xf5\x16\xcb\xa5\xb9\xe0\xeb\x04K\x12\xd1\x04`\\\xa8CL\xf9\xb9\x14\x19\x9e\xb3\x1b\xbe\x90\xdb\xe0\x11\xe4\xd6[\xee\xb0+\xeex\xca$|q\xa4\x1b\x0ds^\x87\x8d\xb7\x00Ps\x80.\xfci\xce\x01\xba\xf0\xc7bW\"\xa5\xd3\x7f1\x07\"7\x07\"\x87?\x16{\xe4\xc8\xb1\\\"\xdeb\x8fb\x92.8\xd9\x94eg\xbc7k\x0e\xd8\xb8\xcf\xae)\xa69\xc4\x93Y\xc45\x87}\xefW\xf3\x82]\xb09\xee\xe53v&\x02\xd7\xecZ\x04N\xd9)\x1e\xfd\x18\xd6;\x90\x00.p1\x9e\xe1\xcf5\xfe\x88G\xf5\x89Hq\xfa\xec\x0c\x7f\xae\xf1\xe7\x94\xba|\x0eF\xd7\xb9\xbc-/\x1c\x87\x1b\x0c\xa3\xda\xbb\xa5\xfb\xcd\xb8p:\x14\xd3\xe9\xef]\xc9\x98\x8e\xc8\xe3\x94y:\"\xcf~\x91\xe7t\xce	\x95\x99\xbd\xb8m\xce{\x07}qY\x1a\xa7\x0b\x11=~q\xd5\x9c\xf7\x0e\xfb\x96\xc5nl)(\xc7\x8d\xf6\x8e\xd1L\xba\xe6;\x0b\xa0^\x84OD\xb8m\xb0\x1b\x10\xa7\xf2 \xe5\xc64M\xee\xc2\xa1{\xfa/\xd2\xd4+\x8c#\xd9\x17\x8a\xe8n_\xf8y\x1a\xceM\xa3y^\xdc\xbbM\x83\xed\\\x85_\xc3\xf8\xeeB<\xf8\x07Q8\xb5\x0c\xf9\xd0n4\xcc\x19W}ec^\xf4Ok\x16]\x80\x90 \x10\x9a\x19u\x0b1y2E\xc4XD\xd0\"\xdc\xeb\x07\xe3C\xc4>\xc9\xefA\x9aL?D\xde\xfdri\xdeo\xdd\xb1\x95\xa2\xfc\xde\x92\xe8\xf9\xbd\xd8\xab\xd8\x9e\x0f\xc4\x91\x83\xc6\xb5u)\x01@\xd25o_\x04/\x06{#\xd9\xafK\x05\x0f\x92\xaey\xf5\"|1\xdc\x9b\xca\x0e\x16`\xa9K\xd7%M!\xfb@=\xf4\xbav\"4~\x0b\xc8i=\x15q\xb8\xa2X\xc0\xbb\x05\x18\xea\xaa#\xfc\xf7{\xc1>\xe8\xe0\x93\x07\xecC\x0d\x1a\x15\x11W\xe9\x80'+\xd6\x05<\xe6\xc9\xea\xd3ri\x9a\x9f\xb6M\x9e%\xef^%\x03\xcc\x8dq8\x1c\x06\xb1\xc1\xf4\x05\xe0\x9f\xa4b'\xff$'\xf5s9\x00s\xd0\xbc\xa3y|qk\xb1\xcfZ\xff\xcdasB\xd3\xf8\xe2\n	\x7f{/\xe2@4[T\xf7\x8b\xdb\xa6\xc1p\x12\x9a-\xaa\xf2\xc5U\xd3\xb0\x0c\xf6I#\x86/\x97\x8b\n\x0d\xfd\x93\xc5\xee\xb5\xe4]\xfe\xa9\xd1\xf8T\xc9q/iF\x95\xc5\x18l\xdc\x0eC\x19[m\xe2\xdebTi\xd9\n\x98\xa0\x15r\xfc'\xb5=\xe5DbK\x88\xb9\xfc\x8a\xe3\xf1\x9e\xc7\x8aB\xe4\xbd\x7f\xe34\x1a\xe6\xd7&7\xecw\xd1t\xec\x9b2\x81\xd3\xa9\x06\xe9\xfc=\xb8\n;\x86\xc5\xbe6\xbfg\x1a\xa9\x16\xda\xad\xe7\x89?\x0cR\x13\x1b\xc2h&k\xe7\x9a\xe8\xaa\x96\xc1.\xd5\x1a}e\x8b\xbf\x8f\x93-\x14N\x16\x13N\xd6h\xfc\x0d\xa4l\xb5b?\x07\xff&\xfa%o)\xcc\xec\xfa\\\xcb\x18\x05\x1c\xca\xa8\x0dH\xdb\xcf\xc1\x7f\x10i;\xed\xb2w]H\x9a\x9cty\x9b\x9dw\xbf!\xa7|_\xc5\xf1\x00\xc1\x02\xfe\xae\xdb\xcb\xe9\x95\xb0\x1bXO'\xdd\xb7N\x1b\x94u\xaa\x90\xea\x16\x1b)d1?\xef\xcas	\xa6flCn\x9a\xe7,\xe4\"\xf8#\x89\xb1\xeb\x9a\xf3\xed~\xc9\x7f\\\x05\xfc\x89\n\xbb\"7a\\\xa5	\x1a\xf6\xe0\xa7\xa1\x1f\xe72\xf5\x8b\xf8\xd2\xd2\x1f	\xfd\x90\xc9\xff\xa4\x0f-\x15\xac:\xb7\xbd\xd4x>\xb2\x95\xf0k\xb0\\:\x1d\x8bI!{h\x9c\x15{w\xe7w\xffc\x10\x1a+FS\xc0\x03v\xd2m6\xd5\xcb0Xy\xbb\xe5d\x81nx\xfdH\xba\xaf+\xd3\x98\x04~6K\x03 \xe2\x06\xdbd\x19\"\xecz\xa7]\x80\xc4\xd3.\x0f\x9f\x05\x89\xcf\xe8\xa8\x80G\xd9\xea\xb4\xdbm\xc8\xb3K)x%\xbb\xde\x96\"\xefn\xbb\"\xe9\xfe8\x0e\xf3\xa0E|fw\x9a\x06\x9e\xc1\xc2\xae}\x9b\x0c\xab\x10\xe5\xb4kY\xb4\x86\xa7rAi\xaex\xa0\xad\x96\x9c\x84\xd3\xae&gm\x18\xec\xb4[\xa9H\x0d\x0d#\x00`\x82\xe8\x0d{\"\x98\xed\x9ev%\x13\x81p\xc8\x95\xc6\x9f\xf8$n\xb8\x1frv\xd9\xe5\xbd\xf3G\xf6C\xc0~\x0e\xd8Y\xcc\x86W}\xd6\xed\xf2\xb6\xd7\xed\xbe\xb9\xecJ&\x86\xd7\xed*\n\xc0\x87.\xbf\xec\xf6\xba\xdd~\xf9\xaa\xf0>t+O\xb2\x0d\xc2\x91R\x0c\xa7x^T\x9e\xa1\x8d\xc6%,^J\xbd\xa2\x84\x80J\xc9h\xf3\xc1Rj\x92/R\x9fn\xf7\xc4N!\xdd-\xdf\x0f'\x01\xc95y>\xd7\xf4\x013\xde\xf6\xb27)\xbd\xb4\x95\xd5\x08/\xc3\x10\x14e\x03\xaf\x0bJ\xede};O\xee\x838\x83\xb5b\xe07mo\xfc&R\x85\xc6\xcd\xa65\x93\xea\x96\xbdq\x9f\xfa\x06\xed&\x8a\x99\x15\x18\x94\xb5\xf2\xb9/\xbf\xfeWlH?\x07l\xc8Fr<\xe4\xfa\x82M\xe5W\xc5\x045\xbb\xe3\xf7]3\xa1\x0d`\xb1	\x97\x8a\x19\xc4\xf4\xb9\xb3\xe5\xa1\x94_\xe2\x08\xca\x0f\x9c\xb8\xe6?\xa6\xf3\x1d\xe3\x1f\xcd;[\x9c\xae\xe6?\x8c\x7fx!\xb4:'\x98\x98	\x1b\xb1\xa9\x98\xd9\x87\x1a\xa2\x8e\x19~h4v\xc1_\xfd\xd4\xb5\x07\xc9\x14v\x16\xd9\xa7n\xddk\xc3\x03\xbc\xbe~\xeaZ,\xb6\x06<\xb0\xe7l\xc8\x03{Q\"K\x0b9(e\xa1\x88\xcde\xc4i(\x18&hiQa\x9f@\xebPT\xd6\\t\xcd\x050\xf1@\xdc\xcdP-\n\xec\x85\x88v\xfa,\x90\xb7\xb3\xc5F|\xb4\\\n\xf3Be\xdb7\xfc10\x17,\x00\x8e?\xe07\xd4\xb9\x1b{!2\xdfTf~\xba\\\xde\xacO\xfej5\xe0\xf3\xc0\x1c\xb0P\xe2n#\xf4\xe0&0\x87,\x94M\xc3\x87\xc3\xb0\xc9\xd5\xe7^\x87\xa6\xf3\x91\xdd\xb2+v\xc1o\xe7\xecL\xe2\xb0T9\x1eR\xde\xd9\xf1-7\x1f\xb9y\xc5\xcf\xecQ\x98f9\xb1\xec-\xb2Z}\x15\xdeFa|W\xf9p\xcd3~!ur\xf1\x1a\xba0\xc9\xb1\xa7a\xb1[\x84Q\xb1\xfc\xbe\xc2wv\x1f<\"Ml\x94\x9e\xf1\xd0B\x8e\x96\x8f\x91\x1a}i\x83	\xef\xbb3\x8b=\xda\xaatr\xcfw\xdb\xec\xd6Nb\xfc\x9e\xd9\x90@\xc3\xbb\xa2m0\x88\\\x11[\xaf\xbd\xd3\xb6\xdb\x87\x06h/g\xec\x8ah0g\xecQ\xfe\xdeZ\xac6P~&\xf6\xd65\xef\x0d\x18=\xcb\xce\xe4\xf5\xf4\xd0h\xc4\xc7\xa6x\x88=X\xecJ\xb6z\x051\xc94\x9c\x13\x9b\xd6\xce\x93\x1f\xc3y04\xf7\xf1\x021\x9a\x0f\xbd\xce\x868gC\xdc~-\x0e\x82 h\x83\xf8\xb1\xdcL\xba&^wB\xb6\x01\xa2#\"\xc6\x111\xc8\xa8\x04H0\xf8S\x1b\x9b\x8a\x1bm\xe0}\xa7\xf4h\xa1\xb0\xe5\x9a\xa2\xdb\x8e\xca\x92tM\x855\x9e\xaa\xc7\x8dD\x18\xc5j@\xe4\x84\x8f\xba\xa6/`\xbb\\ :\xd9|\xa2\x81\xf6+L\xa7$?>\xe1\xc7\x15'\x06\xe6\xe3\x98\xc4\x06\xddD!\x8c\xca\x92\xae(%\xe9\x8a\xecI\x04d\xc9+\xfaX/\xcb\x14\xef\xd3M\nn\xa7\xaa\xef\xec\xef\x93\xe3\xce\x80\xc0}\xe8\xd6\x90\xaam\xc8[q \xd67\x0e\xf03\xaa\xa9J\n\xdb\x86\x87\x955\xadV\xc3\xab\xbfK#\x96\xdc\xd4gH\x88Os7\x10\x14\x99\x85\x1b\x08\x8a\x0c\x81\x03\xb7\xad\x0c$\xb6W[\xe8\x8c\xb0\x8c\xb0\x95\xd48\xbc\xfa\x16\xae\xfb\x9d%\xeb\x84\xc5-\x18\xe9\xeal\xbe\xcda\xe2\xd9\x1c\x0e\x13!W\xac\x8b\xccK\x1a#d\xca\xc8\xc7\xf6\x970x\x84\x0d\xae\xcfI\xa2\xb9w\xb3\x9e*\xae\xb1\x1f&\x91\xca\xb7V\xa8Kg\xd0\xddl\xfe\xa4\x96\xd7$\x9a\x982G\xf1$\x8e\xef9\xb0,%[\x81\x0f\x90\xb7D\xd2u2-R\xae\x93\xe9r\xd9^\xc1\xfa\xdb(\x0d\xb2\xf1\x96\x16\xa1\x05\xe3\xdf\x91J\xcf\xa9P\x8b=\x0f\xb3\xdc\xdcmc\xd9$\x162\xf5\xc38\xa7h,Y\xf9YV\x99\x97\x88\x83\"\xf6=L\"\xcc\x11x\xcb^\xf8F	\x9e{\xa1\xc2\x93 \x9c\x1b\xf6\xbd\xd8\x0e\xe3\x870\x0bo\x81i\xc7\xb6\xb04|l\xc6\xf6\xcd\x8d\x1fA[uq\x99\xe4_\xca\x0cj\x8f\xe0*\xde\x98	v\xff\xdc\xcd\xe5\xa1\xb2M;\xe5\x99\xc2\x0e\x8bK\xb4\xcb\x8c\xed\xdb`\x94\xa4\x01iA4\x1a\x95O\xd3bf\\\xf0`\xd07\n[B\x0d\x05b\xe5\xd04\x1b\xe5AZ\x94.\xbfL\xcb\xb2\xca\x96\xf8\xae#]\x15\xd2e\xf8	\xf3\xddh\x98k\x9b\xa9\x82\xd8\x06\x16\xab\x97\x01\x83\x01\x0b\x0e\xc4\xc7]\xb7\x06\x97\x13T\xe1\\\x1e\xf1\x9b\xd2\xee\xa1\x9b\xb3@&\x06e\xa2\xb2\xb1\xe8\x06\x05e\xf3\x86N\xfd.\x8c\xb5\x88l\xe2\xf4\xefr\xe5\x91Sd\xe0\xea}(i \x81\xa7\x99\xc5\xad\x8cHR\xa6$\xe1!\x17\xc4\x05\x85J\xf0\x80\xbeW\x1b\xcc\x0b\xca\xe6\xd2$\xc9\xab\xe8\x7fe\xf7\xad7X\xd9\xf5\x12\xca\xaay\xd8z\x9e\xa9st\x92\xc5\x94l\xcd(\x86\xbbbd\x90\\\xcf\xb5\xd6\x0fI\x14\xa0\xfe\xebd\x92\xb5\x8c8q\x9b:\xa8A\x0dTB6\x93\xa4\xe9\x0dz\xb7JQ>\x88\x06\x92\x12$U\xb0\\^\xccM\x85IZ-\xfa\x90\xcf5@\x92j\xccg\xc0uk\xd9\x16\x1dX\x1f\xf9\xdf\xed\xc1G\xf9\"\xa6V%\xd6Zm\xf1:\x99V#\xde\x93\x825\xfa\xb0bC\xb3g\x90\xd3\xd1E\x90\x1a\xcc\x08\xe3,H\x8b/\xe8\xd4\xe8\xe1\xf7\xb30\xca\xc3X\x8f\xea\xe6\xe3 U\x11\xaa\xa2\xcc`\xc6$\x19\xaa\xe8a\x10\xa9 -\xa1\xfa\xc8\x13i\x99\xd0`\x84x^'DM\xaa\xf9\xff\xd2\xef\x16<\xc3\xaf!\x90\xbe\xb2\xd8ch\xd2\x05\xc2\xce$\xa5\xfds\x97\x1b\xe3<\x9f\xba{{\x8f\x8f\x8f\xf6\xe3\xbe\x9d\xa4w{xF\xefe\x0fw\x06\xfbZ\x08-\xbf\xefrz\x0c\x94\x15\xd3#\x8a}\xe9*\xa1\xb3\x9f\xa4\\\xb2\x10k\xfe\xa7\xfc\x82\xc4\xf2\x8f2\x0cI\xe6\x8f2\xfc\xe9\x8c\xfd\xd1\xe5\x9d\x17\xea\xe3\xb7.\x04\x18\xf7>v\xd9\xbf\xba\xdc	Z\x07\xecO\xd0S\xbc?\xbb\x02\x9amB\x1b$\xe7#$3\xb0\xd9\xc3]\xc1U>\x9d\x17hy\x91\x04fr.\xb9\xad\x8a\xe2\x03\xbe\xa9\xd0\xf7*\xd8\xc7\x94\x83\xa0\x8c\x82\x89\xc8\x04K\x1d\x15\xd6\xab\xc6w\x8dY\xae\x98\xae\x9520$\x8b9N\xf8\xe79X\xf5\x89\x1d*I\xdcK\xff\xd2 \x99\xcc\xcb9\xb8\xdbC\x03\n\xa7\x1f\x10\x0e,\xf6	\xbfy\xf9\xfc\xab\xf2U\x1b\x8d\x1f\x88\xe8\xb5\x8e\xdeH*\xd7\xef4m\xbf\x7f\xcf\xb4\x05%\xdf0\xd4_}\x80`\xb4\xad$\xb3\x1c\x96\xcc \xeb\x99\x0e,]\xcc\x96\xb0\xb0\x84K,\xcc\xe7\xf283p\xce\xe5\x83,\xd3\x96\x06\x16\x893Z\x1aj\xb5\xb26\x99\xc5BH]\xdb7:\xbb\xae;73f\xc0\xb2%\xa9\xea\xeb\xa9X\xcdKJ&\x90h@<N|\x8b\x96\x0d\x96\xaa\x08(c\xa9\xf0\x023\x8d\x19\xce\xfe\xbd\x19\x96\xca\xa6\x98b\xf6KA\x99\xf9A\xa7\x14\xea\xa2\xc8r\xaa\xbdb_\x10\x01%V\xda:q\x8d\x80\x92XO	\xe8'\x92\xbe\x82R\xc8pE\xd3'\x84\xff0}\x88\x93\xb3W$s_T*E\xf4\xaa\xcf\xfc\x19\x8f+\xcf|bf\x16/\xee\xe2\xadn\xc4\xb3\xc9m\x90\x96Z\x1d%Y\x10j|\xc5\x07(\xddsiUa \xe9\x99\xcbe\xafJ\xaa4X\x8d\xfa\xa8\"P\x9d\x96.\x08\xa1\x88P\x92\xfa;\xb0\xeer\xf6\xc8\x86\xfc\xeb\xdc\x8c\xd7	\x01 0\x98!L:'l\xc0\xc6\x10\x8d\xa6\x07\x93\xe4X\x87#3Z'a`O\xce\x9b\x11\xe8\x170\xe2\xb5hF\x103*\xa8\x13SP'`\xa9xfy)\x9f\xdasH	\xda\x0b\xd1\x87\xe9:%\x08\xf2\xe0\xd3r\nW\x97\xd0\xd93\xe8Y\x8f\x03\xd3*<\xa5B\x82\x1b\x9cge\x1a\x07s\xc5\x07J\x96[\xae\x93\xb8\xf7\xd0sQ\xcf\\\xed^\x9fvlf\xb1\x0f\x08\x93o%\x96\xeb\x83\x1b^-\x97r\x93\x95\xdb\x19O\x0c\xeb\x13J\xe8{\xdcS\xfe\x08\xf4\xc8_Jr\xd2/k\xe4$=#\x0b\xf8/\xddr\xbe&\\K$7*\xd7\x89P\x106Aw\n\xec\x05\xdc\x9c\xcd\xf9\x04\x13\x1e\xd8\x0b>\x81J*J>\xc8Aw\xe9a\xef)\xe3\xed\x9c\xf3\x87c3\xe5\x12\xff\xda\xeb4S\x96i\x94\x9cff\xb9\x0f\x8d\x86\x99\x12=\x82\x12\x1f\xc8\x1a\xb7\x98\xca\x05o\x89j\x95\xe7:@\xa49yJ\xf5\x86\xb99\xd7g\x02\\\x11\x9a\x9d\xb9E=\xba\xe1\x89\xa2\xc3\x83 \xc8\x1e\xf9\x8d|#\xc0\xfb\xb0W\xb8\x15\xbe=6o9L\xe8\xa5\xb9\xc1\xee\xd0\x97&\xbf\x03S\xdcR\x8e;\x8aLA<\x94YZ\x1c^G\x91\xa5\x1c(\\\x0d\xdfr\xe5\x0cZUe\xa2\xae\x16\xe5\xde\xebH\xa1\xc8+\xde\xc6\xae0\x8a\xed$\xe4I\xafxK\xcdLsD\xe5\xaf\xd0N\x87\xda\x91\xd5\xaa\x9c\xa6\x96\xd5\xda\xebP\xee\x8cZk\xa3\xb5\x8eh\xcdE\xf4\x15\xc6\x03\xd1u\x05xv9O\xb0\xbf\xc4\xd7\x8fI\x8c\x98\x81\xe2\xf9S\xael\xea\xc7x\x81\x91\x91\xb7J\x0c\xbf \xe14\xc8\x90^\xbfy\xf4\xae\x9bM\xcb<\xe5\x17\xbd\xeb\xbeu|Z\xc1\x94]\x19/\x80\x1bj4\xea&5N\xe98\x9cn=b\"\x11\xbdl\xf9\xf1`\x0c\x0d\xf5[KE\x17\xc7\xe9T\x1c\xa7\xe6\xf5\x8bQ\xf3\xcab\xa7\x95WL\x8d\x19TP\xe9o\xd0cA\x8f\xf7\xae\xdf\\\xa8\xe7#\xc6\xe3W\x10f\x0c\x01J\x01\"\x03\x7f,\xa6\x91\x04\xf4\xcb9\xe4\x03iYbdV&L\x16\xb4\xd4\xfb\xf5\x8coJ&\xa1\xdc\xeb7g^\xb3y-V\xe2\xb4\x9a\xafw\xdd\xf7N\x1b\x0d\xf3\xd9\xb1C\xa8\xcf\xfb\xab\xc6\x0c\xf8\xa1\xcb\xfe\xfa&\xdaP\xa1\xd0@\xa4\xa6r\xd5HU\x1aBQ\xbe\x8fR\xb3\x01\x8f\xb1V\xec\xbd\x86\xc0\xf2\xa7a8\x1a\xb9\xf5\x1bV`zzlI\xfb\xe0\x9c\xc3?$\xbd\x08\x82\xbff~\x94\xf1P!x\xe30\xf3r\xdc\xccRq\x04\xd1	7\xa1d \xa3,\xb9\x12\xd0HW\xc1\x94;,\xe3I\xd3g\x11\xef=\xc5\xc1\xe3\xa7$s[\x0e+\xd4\xdd\xc9\xdae_ya\x08\xe6y\xea\x0f\xf2\x93d2Ib\x93\xae\x9d\x80\xe5\xacMd\x14|\xc24\xc8\xa7$k:oy\xd2h\xcc\xf0\xeb\x97\xa4\x0bxi`\x03\xde\xf6\x06o\x94f\xb67h6-\xe9\xa6aP\xa8G=\x85\xf10\x1c\x04\x99;f\x03\xd8\x8dpU\xf6U\x7f\x85}\xe4\xa5ox\xe6\x89%\xd4\xc8ReK!o9/R/|\xc3S/l*\xa5\x14pX\xa2^\xd8r\xc0e\x89za\xd3Aw\xcc\xf1\xf1X\xf6\xdbm[\xad\xd0\x9bA$\x91\xb2\xf1\x87$\x1c\xeeH?\x07C>\x83\xb7w5\xc27	\x1b\xc1\x8b{\xfb\x0d\x87\xe1\xec7>\xe6`\xb8\\\x8e\x88G\xb4\x8bP\x99\xfd\x8dj\xe1\xd8\xcc\xf8Os8\xf7\x8ci\xd0\xa5\x9d\x82\xac\xb41\x901\xd1..F\xcb5\xe1h\xc7\x92\xe5\x9b\xcd\xe7\x0b\xee\xb6eY\xcb\"\x91\xae\xea\x8a\xc1\xae'\xf9\xa7\xc8Tub\xa1\x06\xf8\xf5%\x19l\xe7\xcb\xdcl3\xbdV\xcb\x8bz\xa1\xf2\xfd\xb3Ca\xd1\xc8*m6W\x82\x886T\xa5\xe1\xe3\xbc2\xb2\xb5-.wlO\xed\xc2\x96\xd3\xf7`r\x02\xfa\xaf\xc1\x10\x9b\xbc\xb4\xfd\x87\xcf\xf0\xb8\x92\x97?\x89=\x01}\xa9Y\x9c7\x1dF\xe5\xdc@\xfaa\x18\xba\xe1\xca\xcd\xa51\x1c\x91uS\x16k\xc5*\x93S\xeb&\x8b\xcb\xdd\x84\xc7\x80<0\x10\xb7-\xceN.g\x11B\x83\xad\x98E\xbc\xed\xa5\xd8\x18\x8dF\xd6t\xde\xf8\x92\x04!N\xaa\x19\xf4R\xec\xb7\xb0\x975\x9d\xbe\xe5Yi\xb3\xc9\xb0\x9cQ\xb3)\xb7\xfdN\x04\xc1\xcar\xda+C\x88p\xf0e{<e\xd9\x8a\x11\xd3\xb0J\x88*A\x85:\xf4+\x86\xe7\xec\xf3Y\x04\xf6\xff\xb3\xc0\xfb\xdf\xcf\xd9\xaf\xebx\xbf\xac\xf6\xe7\xae\x0d\xa0%a\xd5\xca\xfb\xa7\x06\xcf\xe4\x05#\xdfV\xfct\xce*\xa9wA~\x1a\x8c\xb2M\xef]L\x12\x1eL\x92\x9cY\xf9\xde\xac\xa2d\x0c\x83Qf(p\xb1\xd3\xe6\xbcX\x96\xe3\xfc\xd84\xe9\x0dH\xf4\x8b\xf7DO\x14T\x9fJ\x07U\x1d,\xd0\x19d\x16\xf9\x93\xf5\xc3\x18\xaf\xba\xb0\xf8\xd8\xd0\xeb\xd0\x1e\x80\xa5\x96\x06\xb1\x14\x9b\x10S\xb4\xeb\x14<\xe0\xb8\xd85-\xc7\x8b\xdf\xf2\xb6\xd7j\x91\xd2f\xd0\x8b\xfb\x9a5\xe9\xdd\xb6\x1c\xc7\xae\xb3\xc2C\xd4\xc5U\xe4\x86\x90\x9c\xa8N\xa1\xd0\x89\xd7\xfa\"\xadL\xc8\xbbL\xce\x9c\x9chs\xd7\xa1s\x99\xf7\xa4\xc1\x8dd\x02\xed\xae~\xa3Q\x0ek-\xd1\xb26\xd8\x84\x0b\x1a\x0d0\xf5K,Y\x8a\xe9B[=\xb7pr\xd7\xea\xe1p\x85Z\xeb\xbd?\x1c\x9e&\x93\xcaLV\xfb\xdb\xb6*\x88Kn\xd5*\x10\xa7\xbb^\x87\xbc\xc5+59\x16\xf4\x03\xea\x9d\xa2[]\xc7l\xea\x19,V\x8fQ.\x0d+\xfd\xc0\x04'\x93\x8c\xaf\xf9\x8dZ\xeb\x046\x86b.\xf4\xfa^a\xb8Dn[e\xc7L\xcaMW\x9d\xb7H \xb9y\xff\x87\x96\x87\x8b}\x90\xc4\x03?7{}q\x94%a\xdc\x92\x9e\x1d+\x9d\x86Y\xf4wQ\xf4[<\xcb\x82\xe1\xe6\xae{\xb2?r|\xa6f\xfa\x1d\xea\xe4\xbd\x1c\xaek\xd3{ro\xdd\xe7F\xdbX\xd5g\x06\x8d\xfcV\xa9\x1ff\x02\xb5\xfd\xa1\x97w\x8czq\xb16\xcfuqms\xeb\xeb\xffL\xffC\xeb\xc9p\xa4\x02\xa3>\n\xc0[}G\x840\xc8]\xeb\xd5]\x00R\x04\x91\xd3\xf8&H\xab\xbf[\xcf\xe2\xe3?\xbbn%\xea\xe7\xe0\xf8\xf7Z\xd4\xf0\xea\xf8\xaf\xae\xfbgw\xbd\xa1kE\xf7\xa0\x05\xdf\xd8\x9c\xc4\xb8)\xd7\xc6\x9e~\xa30\xd1\x13Wlf\xfe\x08\x00m\xb1\x1f\xf5]\xe2\x0f\x87\xff\x0c\xf3q2\xcb\x7f\xdb\x02k\x00\x0c\x04\xc6\xac\x03\x1d\x0f\xb4c\xb0\x91\x8d\x82=\xacQn\x85\xd5!Y\xac\x17\xe3 j\xea\xe5e<\xa9\xfd.\x97\x9a&n-Mj\x00$\xcc\xd7R\x18^\xd7\xc5\xbehC6\x06\x96\x01\x8f\xcd\x84\x8b\x10KK\x80'b\xac\xe52\x94\xf0\x08j\x05n\xc2\xe9\xd3\xf4\xa1!\xa261\xec\x01\xa0\xb5L\x18?}\x97\xe7ix\x0b\xe7_F84,/\xb73=2f\xc6,\x8d\xcc\xff2\xa0,mA\xbb\x08\x8c\xea\xfa\xe4VV\x14\x95\x075]{\xa1\xfa\xac\xb4\x18j\x17\x96\x98\xb3\x9f\x94\x0e~I\xfaP3F\xa4FL\xa2\x849;\xf9\xa3i\x9cEQp\xe7G;Jw\x7f\x07\x19lC\x10\x07\xbd\xcd-!\xab\x1f\x95-)Q\xb0\xdc\x0eaa=\x1cJ\xee\x14\xc4I\xce\x86\xcd&\xd4\x90\xebS\xc4\x8c\xaf\xa9\xd1\xa4q\xdc|M\xcf\x86M\xa3\xa5\xba\xd02\x9a\xa8I\xbed\xc4\xb5\x06S\xd0\xd8d\xd2T\x1d]\x16\xa0\xdf\x04\xb5Y\\\xbb\x04+ \xa0r\x1eD\xd6\xd2j\x1d\xd3l'\x15\xbb\x97\xe6\x0b\x068ig(\x1d\x16O\xdb\xa0a\xa3Q\x9fzXE\xa8lT\xe4\xa9M\x1a\xf2\x1c\xabN\x88\xd1\x896,\xb7\xb8\x87(Z9\xfc\xcf\x01\xb87\x8e\xb6z\xe7\xc9[\x7f\xc3\xae\xa9-\xc2\xdc\x81\xca\xfc\xdcZ[\x9d\x05%,\xd6\x13\xe6\x1d$\xcc;\x1b\x8aP\xca\xa2\xf3\x1d\x9b\x8e\x9e\x06\xcf\xed<\xaf^\xf9`\xbe\xa5\xa3\x83\xc5\x96\x8e\xa6\x88O\xadUn\xdf\x11y\xee\xb8^\xa5\xdaj\xbf\xc5!\x1cm\x19\xb3,H\xaf \x12\xd9\x8d\x7f\xcb\x02\xc3r\xbfU@(=)B\xf9\xfb\x04>\x02\x82\n}\xa7@\xf4\xb0\x8bJ\xd3\x0d,\xe6`\x1f(|\xd4\x8b\xdf$^\xb3)mv\xfa\x1b\x0f6\xdc\xf3\x0b\x93\xe5\x959\x17r\x06\x06\x83@v\xd8\x8b\xfbR\xf0\xa0	\x8b'\xac\x9e\x19u\x08\x9b\xc2\x06\xa3\xcc\x14\xc6B\xeaH\x95\x8f)\xc3F\xe7\xf5s\xb5\xf1\xee&Z\x8e\x06\xed\x0b\xe6\x14\xc4\xb7# Y\x01\xd5\xd6h\xfcsS]\xda\xd1\x13\xf9,f\x96U\x88\x8b\xc2\xfa\xdbu\xacp{}\x14\xb7\xd7\xc7\xef\x06\x0d\xd5\xdb\x11\x88k \xdfg\xe5	\x0dpBo\xa0{\x02FW\xc6\x80e\xc8\x05\x965T/h\xd4\x12\xae\xd5\x12\xd6kiK\xa0\xa6\x86D\xa8\xed\xc7o\x1fsi\xde\x8dfY<$\xa0$\x8b\x0e\x91\xdd\x02^G\x9f\xc1\xa1\x02I&\xe3\xe1\xb1At\xb9\xd3db\xb8\x06V\xc8\xf0\xd2^\xd6\xc7\xa5\x88\xdf\x0d\x17\x1a\x8b)\x85\xf9\xe5e	\x93lUz%\x1c\xdf\x9a	_\x87\xec\x98\xb3\x96\x84\xf6\xe2R`\xcd\xca\x97\x19o\xbc\xd2\xd4,\x91zH\xbdO\xe0i\xd5\xdbg\xe8$\x97\xac&\xa9j\xaaahfJXwZ\x92\xc5\x1b\x8dTjM@\x10\xa6\xd0Gj4v%\x92=[c\x00k\xc8\xb4V\x91\xe5\x8ds\xfd\x9bm\xac\x97i9\xa0\xadJL^\x18W\xd6\xe2\xf9L\x99\x93W\xa9\xd2\x10\xbe6\x1a\x89\xc5a<q\x05\xea\xd4\xac+\x8f\xedA\x94\xc4\x01	^[\xa0\x10Tg\x11\xf3\xdb\x02\xeb\xd7(\xf0\x93\x04\xfa,\xa5i\xc4\xb7\xce\xda\x06\x86Q\x13\x9a\x03\x13\xea\xb4Ru\x05\x02\x15\xcfTN\x16B\xea\xdbz#L\xd1n\xeb\xca9i4*\x9f\xc5\x8e'\x7f:zJy\x89\xe3u\xf1m\xf8\x13\xa8{\x17\xbf\xf2T|\xbb\x80\xccHW\xf2\xcc\xfcC\x80\x9b?\xfe6\xb2\xfc\xdb\\!\xbe\x12y\x85\x0e\x85\x8c\xf1b\xfb&\x1b\xfb\xc3\xe4\xf14\x99\x1c\x9b!\xd7\xbfY\xfdtk\xe7R\xcbf-\x97:\xc2\x14Z\x96+\xe9\x00x\x9e+t\xaa\x8e\xd3\x02\xe1]\x07\x01\x9e\x82\xccR7\xa7\xb2cV\xab\xb5\xd1oY\xd6\xfa\x19\x17\xb5\xc1\xc5\x8e\x02\xfd\xea\x01\x1cj\x039\x1b\xf2\xea\xe7\xbf\x87b\x8a\nZF\xb3Z\x99\xc5j\xa0~\x0b\xaa\xf9\xc7\xb3\xa8f\xe9\xf5S\xad`82\x7f\x9b\x9b\xca\xc8\xa6$\xc3?\x8f\x84\x06\x95\xfd\x1b\x97\xb0_l\xd4reW\xea\xe4\x95\x02\x9a\xe4c\xaa\xb6\x10\x1b\xad0+n\x86>\x07J\xe0\xad\xa0\xa3\xd0\xf6\xa8-\xb9Q\xaf\xbe\xe8\xde\x96y\xd8H\x9f0F\xc1i\x9aL\xafh,0M\xce\xab$\xbaM\xbb\xa4R\x82\xc8_^q\xd5\xc1D\x8f\xda<,B\x08zd\xc7\xf2\x97\\\xa48.L\x9c\xd5S\x1c\x91\x82u\xca \xcd2L\x1e\xdfG\xb3t\xb9T_]\xc2\xb1\xfeU\x8f\xf8\xdd\x8a\xf9Z\x16\xa0u\xb5l\x88\xf4\x8bHT\xcd\xd2\xe2\x93\xdc4\x14\xf8\xf2nF,\xa5\xab\xa2\x13\xea\x91&\xf0\xe5r\x91ieXfy1\xd7\x8b\xd4\xbaQO(\xbaR&\x14\xdd)\xa3\xa8K\xab\xb0v\x94\x86\x90%\xd9\x8b,\xb6\x96\x00\xc1\x92\xbd\xd9z\xc2(J\x12\xe9\xc2\xc2`\xc5\xfd\xe5\xefu\xf6\"\xd2\x0dAh\xe6\xd5Ke\xf9\xf04x\x08\x89\x93m\xb0\xf1:Z?7\x98\xd1\"\xe3^\xebi\x8bg\xd2\xa4\xb0\x0c	]\x0d\x8202\xfd\xbd\xce\x8bN\x1b\xc2\xf7\x9b\xaaR\xb24\xdb\xf3\xebH\x07\xb1K\xca\x83\xc4\xeb`B\x01\xd7M\xe0P\x82	B\xe1\xca*\x9e\xbd}\x14\xaa[\xe4\x96\x02P\xf9\x87m6\xdf\xd3$\xc9\x95@\xa8\x147.\xcc\x08$\xd3<\xe3!\xf7\xcd\xa7\x15\x0b\xe1\x89Lq\x06\xc1\x94\x86,\x9c\xe5%\xb5\xd9!\xb3\x9f\x06{N\x80\xceb\xf5B0\\\x0d\xaa13\x1c\xdb\xd9\x90\x0e\xb6\xf6\xa74\x19\x85\x11\x1c\xf7\x8dfQ$2UMu\xe2\x95\xd6\x12\x16\x8a\x85\xd7\x96o\xd8\xee\x94\xba\xc3\xea\xb1w\xe1\xc7\xfe]\x90\x12\x1f\xe3\xc7\xb9\x19\x17\xe6\xa6\x15\x9a\xa9g\xf8\xa8g\x10\x93\xad'\xff!\x92\xbf\xe1\xc3\x89\x0c\x19xk\xfe\x98\n?GB\xc5\xb9\x1cF\x1aDd\x91\xdd`\x15\xae\x07X\xe9\xf4\xfd\xa0\xa4hSV\x15F&\x0cR\x8fPC\x13\xc2\xc8\xa6\x140)${e\xea\x8d\x14\xfd\x06\xa7\x9d\xc3oa\xfe\xa1\xdc\xb9U\xf1\xfc\xfc\xc33\xe2\xf9X\xf4\xbf!\x9e/G\xf1?V6\x7f\xb3`~\x16\xe4\xef\xab~\x91\xdc5E\x8ab\xecrK\x94\x0e\x7fx\xbeU\xb4\x7f\xd3&\xaeR\xec\xcd\xcd\x1bys\xa6\xcaf\xaeg\xf1\xa4\xb1\x95*\x9bM\x99^\xd1\xf7\x0bKJ9\x81B\xb91\x80\xed\xb47\x89\x17(5\x83\x94\xff97Gd\x08\x1c\xde\xcc~\x9d\x9b#k\xb9\xfc\x19?\xdeH\xd7=0G\xa5\xb4\xa0\x99\xe2Y&^?\xa3-C\x13\x88\x07\x92Gb.\x15\xfeR\x9f(\x95Q\xce8(\x13\x16\xfb\x9e\x9c\x92\x00\xb1i\xd2d\x9d\x19\x1bY\x16+:\x0e\xf1\x7f&U/G\x96\x10\xb3\x8a\xd8\x8c\xff\xda5c\xe6\x0ba\x1a1A39q4O\xe1\xc84\x07|\xd6\x0b\xfa\xca\x8b\xcd\x90\xa4a\x86\xbc\xed\x0d\xdf\x0c\x04\x1b\xdb\x1b\xaa\x19\x15\x93\xc8\xe3\xde\xc0\x96b\x10\xbda\xbfo\xb11\x17\xb3\xfa\x03\x04i3\x8b\xd1\xef\xd8ZmiU\xba\xc7D\xb38H\x03 \xba\xdf\xd5\xb0\xbf\xad\xe1\xe8\xf8/4\x99\xb1\xc8r\x7f\x91\x9d\xc8D\xdc\x98e\x96+\xd3\xc7E\xfa\xd8bE*\x8b\xf8x\xb9\xcc\x96\xcbh\xf3\xda\xd4Y\x0f4\xf7\x9b\x96f-c\xc4F\x9b\xc1|q\xb1\x16\x0e\xdc\x80\x86\x0d*k\x80I\xda\xb2\x0ek3\xe1E<\x13k\xb0\\\xca}\xbee0Z\xc3\x9b3|\xf7h\xd7j\xaa\x1e\xeeg\xea\xa9\xddyzM\xab\xd5\xc6N\xe9\xfc\xb7m\x00gS\x9ej\x976\xe5\xa8\\C\xbe\xd0w\x00\xbf\xc6\xddL\x18\xf8?-\x0dp:\xff\x9f\xc1\xfd\xdf\xaah\x14\xf2\xeae\x03L\x9b0\x10\xe9yQ\xea.h\xb2a\x02\x13,e\xb2\xc1\x95\x95\x06\x9fx^\x88\xe2S\xa4R\x0d\xb2\x98R\xef\x01\x81\x0dHF\x1b\xf2\xb2\xd5(\xc7bkm\x1b\xac\xa2\xca\xb4\xae\xcb\xf4]\xcaL\xa0Q\xd0\xa0\xbc\xa4\xaa\xbc\x94T\x95\x97\x94T\xb9~\x9dy\xfe\xe6\x07B\xbeN7Wo\x018)\xf9?\xa0\xa8\xa4&j\xebN\xc7\xb2\xb0\x90\xf7T\x17U\x87\xfa\xbd\x1c\xc6c{\x86\xa6]d0\xf9\xf5Q\xcb\x93\xf0\x9e\xa1\xa9\x18\x91\x02\x0d	^_'SQ\x8b_\xe6 \x95\xa32\x8bP\xff\xa1\\\xe5\xd6\x08z!l\xfe\x92\xa9\xb3]N\x9fr\xaf\xeeh\xb65(Zb\xc7\x85\xa6\x15\xcbJ\xe3#\xd2a\x06\xd4\xac\x80\xdaA\xd0m\x96\x07C\x12\xa4\x07YSTi\xa6\xbd\xb8\xbf\\\xfeknf\xa8\x90B\x12\x9d\xc6\xb7\xd52))\x11\x9a\xd3\xf2\xcb\x17_\xd0\x9c\xfa\xbbzkr\x83\xf0*\xb2\xb6Iim\x9b\x8a\x99\xc4\xef$\xd5t\x9b~\x99\xcc\x05{\xfe\xa4\xceD\xeaMi\xa4\xd7'\xe7T\xbe\xfc	O5-F>f\\\xd2\x16\x81\xaeRs>\x89\xbc\xc1\x18\xf3\x9e\xf3\xdf\xae\x7fl\x1d1\xa3Y\x19\x8a\x9d\xccr\xe1,\xf6\x7f\x84\x0e\x97\xfe\x00\x81\x0eW~S\xeap\xe1\x95\xc1\xf2\x0f \xd4\xca\xf7$7\x0el\xc7n\x83\xdb7\x0c \x1b\x14\xc4\x830\xc8\xf8\xd9\x19\xcb\xedO\x9f\xcf\xba\x9f\xcf\xae\x7f\xe7'\xf8\n\xe30\xe7\x9bE\x0d\xb3L\xba\x8a\x8d\xd5F\x8e%\x88\xc1K\xf1\xa7T\xe6\x96[r'\x01\x97\xdb\x08\x067F\xf3_g\xcd&\xfb\xe3\xac\x87\xa8>O\xd8g8\xe0\xf9\xfd\x8c\xe1\xdbb~\x86gX\x02\x97x\x83$\x8e\x83AUd\x03V'\xc0\xd5U7\x9b'\xf4C\xd9\xe3\x99\xa9\x11\x015j\x1d^\x16S\xa0\xb9*lA\xe8\x0f\xf0\xd3\xb8\xbb1\x9a\x7f\xa23k\x85eV\x0e\x87dr\x00\xbf\x9dafw\xdb,G\xd7\x86av\"{\x97B?h\x18f\xaa\xb7\xbf`\xe2\xe4\x11\xaat}\x93\xd7\x05\xfe\x07\xaa\xad\x8a\xb2\xfc\x94.\x97f.&\xb8\xa6\xc3\xf1S\xdah\xec\xe6v\x98\xe1i\x95\x102\xd0h\x14\xadA2\x91\xae\xf33Y\xe2\xfd\x024\xc6,\xab\xc7\x9eU\xe9*r\x84\xd4\x15\x0c.\x0d\xee\xc2,\x0f\xd2\xebq0	x\x94iQ\x9f\xd2`\x9a&\x83 \xcb\x92\x94\xcf\xaa)*z\\\x89N\xb2u\xb9\x17\xeb\xe9\xa73\x81\xf1\xe30\x97\x99\xa5c\xaa\x81^\xc1I\xcd\x07>\x1f\xca\xe1\xd4\x13N\xc3I\x10c\x7fo\xc2-N|\x14\x91;\xb6\xe0\xaaK\x0drU\xee,\x1e%\xc7\x1b\xe2\xa0Ll\x0f\x8b\xa8R\x0c\xb4\xec\xe5\xb9\xbfHf9\x1f\xe9=\xff\x12f3?\xe2S=\x0e\xa6\xbc`Nb\x82H\xe1\xc2\xb1\x90V&\xc7h\xfcaC\n\xe0=_\x94	\xc2\x8f\xa5\xc8?\xd7\xf2\x8f\xfdT\xe4\xbdAd\x16\xe4'~\xfc\xe0g'\x10yIt\xf7\x86\xd6\x13XM\x88\x0eD\x16\x83UW\xe2\xc2\x9fj\xd9\x85\xbc*f&\xf99Kb2n+\xfd@e\xa4\xf0\x17\xd8\xd94\x18\x84~\xf4.\x0d\xfc\x8c\x05\xbc\xc8k\xb1\xb5m\x0f\x8c(\xd0]\x03\xed*\xa9\xc8\x9f\xaf\xba\x97\x8d\x06\xfe\x82\x0d\x98\x05\xc7e\xd0\x0c,\x17\x80\xe5G\xd5)C\xae\xa1i4\x83\xa6ay\x86\x05^\xdd_\xd8\xc3\xfcI6\xee\x06L\xef\x97\x1b\xae\xe4\x01\xc1\xf0\x1e1G\xb8\x18\xe0\xb0\x8b\xff\x80S\xfbU\xfa\x81\xfc\x05\n\x19w\xa9?\x1d\x87\x03\xfe\xf5\n\xde9I\xdd\x8d\xff\x8e08\xa0~\xce\xc3\x0bh\n\x8d\xd3$\xcf\xa3\x80\xdf\x80\xb84\x0e\xa2i\x90\xf2\x8f\xd7,W&Q\xeeo\x08\xf0\x82\x1a\xc4/n\x94\xff\x04\xfe;\x824\xc6\x9f\x82\x04\xa3\xe4\xbf]\xeb1Y\x12\xf3\xbf\x103\xcb\xc3\x88\xff\x82\x10\x91\x9bR\x84\xc4\xc2?BU\x04\xbe\xb6\xf8\xef\x88\x0c\xe2\x07\x9e\xdc\xac,\xef\xff\xf7\xff\x0e\x00PK\x07\x08%\x91\x88E\xd3\xd1\x03\x00\x13\xf4\n\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xfd9R]\x1a\x96\xe7\xed\x08\x03\x00\x00l\x06\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x00\x00\x00\x00CompareTemplate.htmlUT\x05\x00\x01/r\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe79R]R\xf5R\xdc\xdf\x08\x00\x00\xd3\x1a\x00\x00\x0e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81S\x03\x00\x00ReportChart.jsUT\x05\x00\x01\x03r\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe92R]sD\xdb\x9e\x1d\x07\x00\x00\xd3,\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81w\x0c\x00\x00ReportHistoryTemplate.htmlUT\x05\x00\x01\xd6e\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xfd9R]\xadv\xd2F#\x03\x00\x00\xe5\x06\x00\x00\x13\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xe5\x13\x00\x00ReportTemplate.htmlUT\x05\x00\x01/r\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xc0<R]%\x91\x88E\xd3\xd1\x03\x00\x13\xf4\n\x00\x0e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81R\x17\x00\x00echarts.min.jsUT\x05\x00\x01Xw\xd4jPK\x05\x06\x00\x00\x00\x00\x05\x00\x05\x00p\x01\x00\x00j\xe9\x03\x00\x00\x00"
+		fs.Register(data)
+	}
+	
\ No newline at end of file
@@ -0,0 +1,59 @@
+package backtest
+
+import (
+	"sync"
+)
+
+// stepRunner 并行执行每个 StrategyTester 的一步，所有策略完成后返回
+type stepRunner struct {
+	jobs chan *StrategyTester
+	wg   sync.WaitGroup
+
+	mu         sync.Mutex
+	panicValue interface{} // 策略中的 panic，在回测协程中重新抛出
+}
+
+func (r *stepRunner) work() {
+	for t := range r.jobs {
+		r.step(t)
+	}
+}
+
+func (r *stepRunner) step(t *StrategyTester) {
+	defer r.wg.Done()
+	defer func() {
+		if v := recover(); v != nil {
+			r.mu.Lock()
+			if r.panicValue == nil {
+				r.panicValue = v
+			}
+			r.mu.Unlock()
+		}
+	}()
+	t.step()
+}
+
+func (r *stepRunner) run(testers []*StrategyTester) {
+	r.wg.Add(len(testers))
+	for _, t := range testers {
+		r.jobs <- t
+	}
+	r.wg.Wait()
+	if r.panicValue != nil {
+		panic(r.panicValue)
+	}
+}
+
+func (r *stepRunner) close() {
+	close(r.jobs)
+}
+
+func newStepRunner(workers int) *stepRunner {
+	r := &stepRunner{
+		jobs: make(chan *StrategyTester),
+	}
+	for i := 0; i < workers; i++ {
+		go r.work()
+	}
+	return r
+}
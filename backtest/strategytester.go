@@ -5,8 +5,7 @@ import (
 	"fmt"
 	. "github.com/coinrust/crex"
 	"github.com/coinrust/crex/log"
-	"github.com/spf13/cast"
-	"github.com/tidwall/gjson"
+	"github.com/coinrust/crex/utils"
 	"io/ioutil"
 	"math"
 	"os"
@@ -31,10 +30,12 @@ func NewStrategyTesterParams(strategy Strategy, exchanges []ExchangeSim) *Strate
 type StrategyTester struct {
 	*StrategyTesterParams
 
-	backtest  *Backtest
-	logs      LogItems
-	eLogFiles []string // 撮合日志记录文件
-	eLoggers  []ExchangeLogger
+	backtest     *Backtest
+	logs         LogItems
+	eLogFiles    []string // 撮合日志记录文件
+	eLoggers     []ExchangeLogger
+	tradeLoggers []*tradeLogger // 各交易所的委托和成交事件
+	logger       log.Logger     // 策略日志
 }
 
 func (t *StrategyTester) Setup() error {
@@ -52,19 +53,70 @@ func (t *StrategyTester) Setup() error {
 }
 
 func (t *StrategyTester) Init() {
+	t.initLogger()
+
+	// 每个策略使用独立的订单ID生成器，并行执行时结果不受调度顺序影响
+	idGen := utils.NewIdGenerate(t.backtest.start)
+	t.eLogFiles = nil
+	t.eLoggers = nil
+	t.tradeLoggers = nil
 	for i := 0; i < len(t.exchanges); i++ {
 		t.exchanges[i].SetBacktest(t.backtest)
+		if ex, ok := t.exchanges[i].(IdGenerateSetter); ok {
+			ex.SetIdGenerate(idGen)
+		}
+
+		// 未设置输出目录时不记录撮合日志，委托和成交事件仍记录在内存中
+		var eLogger ExchangeLogger = &EmptyLogger{}
+		if t.backtest.outputDir != "" {
+			name := fmt.Sprintf("trade_%v.log", i)
+			if len(t.backtest.strategyTesters) > 1 {
+				name = fmt.Sprintf("trade_%v_%v.log", t.index(), i)
+			}
+			path := filepath.Join(t.backtest.outputDir, name)
+			t.eLogFiles = append(t.eLogFiles, path)
+			btLogger := NewBtLogger(t.backtest,
+				path,
+				log.DebugLevel,
+				true,
+				false)
+			t.eLoggers = append(t.eLoggers, btLogger)
+			eLogger = btLogger
+		}
+		tradeLogger := newTradeLogger(t, eLogger)
+		t.exchanges[i].SetExchangeLogger(tradeLogger)
+		t.tradeLoggers = append(t.tradeLoggers, tradeLogger)
+	}
+}
 
-		path := filepath.Join(t.backtest.outputDir, fmt.Sprintf("trade_%v.log", i))
-		t.eLogFiles = append(t.eLogFiles, path)
-		eLogger := NewBtLogger(t.backtest,
-			path,
+// 多个策略时每个策略写入单独的日志文件 result_N.log
+func (t *StrategyTester) initLogger() {
+	b := t.backtest
+	switch {
+	case b.outputDir == "":
+		t.logger = &EmptyLogger{}
+	case len(b.strategyTesters) > 1:
+		t.logger = NewBtLogger(b,
+			filepath.Join(b.outputDir, fmt.Sprintf("result_%v.log", t.index())),
 			log.DebugLevel,
-			true,
+			false,
 			false)
-		t.exchanges[i].SetExchangeLogger(eLogger)
-		t.eLoggers = append(t.eLoggers, eLogger)
+	default:
+		t.logger = log.GetLogger()
 	}
+	if s, ok := t.strategy.(interface{ SetLogger(logger log.Logger) }); ok {
+		s.SetLogger(t.logger)
+	}
+}
+
+// 在回测中的序号
+func (t *StrategyTester) index() int {
+	for i, v := range t.backtest.strategyTesters {
+		if v == t {
+			return i
+		}
+	}
+	return 0
 }
 
 func validPrices(prices ...float64) bool {
@@ -192,10 +244,20 @@ func (t *StrategyTester) RunEventLoopOnce() {
 	}
 }
 
+// 执行一步: OnTick -> RunEventLoopOnce -> addItemStats
+func (t *StrategyTester) step() {
+	t.OnTick()
+	t.RunEventLoopOnce()
+	t.addItemStats()
+}
+
 func (t *StrategyTester) Sync() {
 	for _, v := range t.eLoggers {
 		v.Sync()
 	}
+	if t.logger != nil {
+		t.logger.Sync()
+	}
 }
 
 // ComputeStats Calculating Backtest Statistics
@@ -225,10 +287,27 @@ func (t *StrategyTester) ComputeStats() (result *Stats) {
 	result.EquityReturnPnt = result.EquityReturn / result.EntryEquity
 	result.AnnReturn = t.CalAnnReturn(result)
 	result.MaxDrawDown = t.CalMaxDrawDown()
+	result.FundingPnl = t.CalFundingPnl()
+	result.MaxDrawDownDuration = t.CalMaxDrawDownDuration()
+	t.CalRiskRatios(result)
+	t.CalTradeStats(result)
 
 	return
 }
 
+// 计算资金费用盈亏
+func (t *StrategyTester) CalFundingPnl() (result float64) {
+	if t.StrategyTesterParams == nil {
+		return
+	}
+	for _, v := range t.exchanges {
+		if ex, ok := v.(FundingExchangeSim); ok {
+			result += ex.GetFundingPnl()
+		}
+	}
+	return
+}
+
 // 计算年化收益
 func (t *StrategyTester) CalAnnReturn(s *Stats) float64 {
 	days := s.Duration.Hours() / 24.0
@@ -269,14 +348,187 @@ func (t *StrategyTester) CalMaxDrawDown() (result float64) {
 	return maxDrawDown
 }
 
+// 计算最长回撤持续时间: 从净值高点到恢复(或回测结束)的最长时间
+func (t *StrategyTester) CalMaxDrawDownDuration() (result time.Duration) {
+	var peak float64
+	var peakTime time.Time
+	for i, item := range t.logs {
+		value := item.TotalEquity()
+		// 回撤中或刚恢复
+		if i > 0 && (value < peak || t.logs[i-1].TotalEquity() < peak) {
+			if d := item.Time.Sub(peakTime); d > result {
+				result = d
+			}
+		}
+		if i == 0 || value >= peak {
+			peak = value
+			peakTime = item.Time
+		}
+	}
+	return
+}
+
+// 计算波动率、夏普比率、索提诺比率和卡玛比率
+// 使用相邻 LogItem 的净值收益率，按平均间隔年化，无风险利率为 0
+func (t *StrategyTester) CalRiskRatios(s *Stats) {
+	if s.MaxDrawDown > 0 {
+		s.CalmarRatio = s.AnnReturn / s.MaxDrawDown
+	}
+
+	n := len(t.logs)
+	if n < 3 || s.Duration <= 0 {
+		return
+	}
+	var returns []float64
+	for i := 1; i < n; i++ {
+		prev := t.logs[i-1].TotalEquity()
+		if prev == 0 {
+			continue
+		}
+		returns = append(returns, t.logs[i].TotalEquity()/prev-1)
+	}
+	if len(returns) < 2 {
+		return
+	}
+
+	var mean, variance, downside float64
+	for _, r := range returns {
+		mean += r
+		if r < 0 {
+			downside += r * r
+		}
+	}
+	mean /= float64(len(returns))
+	for _, r := range returns {
+		variance += (r - mean) * (r - mean)
+	}
+	std := math.Sqrt(variance / float64(len(returns)-1))
+	downsideDev := math.Sqrt(downside / float64(len(returns)))
+
+	interval := s.Duration / time.Duration(n-1)
+	periodsPerYear := float64(365*24*time.Hour) / float64(interval)
+	s.Volatility = std * math.Sqrt(periodsPerYear)
+	if std > 0 {
+		s.SharpeRatio = mean / std * math.Sqrt(periodsPerYear)
+	}
+	if downsideDev > 0 {
+		s.SortinoRatio = mean / downsideDev * math.Sqrt(periodsPerYear)
+	}
+}
+
+// 根据开平仓记录计算交易统计: 交易次数、胜率、平均盈亏、盈利因子、手续费、成交量和持仓时间占比
+// 盈亏使用扣除手续费后的净盈亏，只统计已平仓的交易
+func (t *StrategyTester) CalTradeStats(s *Stats) {
+	roundTrips, fills := t.readTradeHistory()
+
+	for _, v := range fills {
+		s.Turnover += t.getInstrument(v.Exchange, v.Symbol).Notional(v.Amount, v.Price)
+	}
+
+	var grossProfit, grossLoss float64
+	var wins, losses int
+	for _, v := range roundTrips {
+		s.Commission += v.Commission
+		if !v.Closed {
+			continue
+		}
+		s.NumTrades++
+		switch {
+		case v.NetPnl > 0:
+			wins++
+			grossProfit += v.NetPnl
+		case v.NetPnl < 0:
+			losses++
+			grossLoss += v.NetPnl
+		}
+	}
+
+	if s.NumTrades > 0 {
+		s.WinRate = float64(wins) / float64(s.NumTrades)
+	}
+	if wins > 0 {
+		s.AvgWin = grossProfit / float64(wins)
+	}
+	if losses > 0 {
+		s.AvgLoss = grossLoss / float64(losses)
+		s.ProfitFactor = grossProfit / -grossLoss
+	} else if wins > 0 {
+		s.ProfitFactor = math.Inf(1)
+	}
+	if s.Duration > 0 {
+		s.TimeInMarket = float64(roundTrips.holdingTime(s.Start, s.End)) / float64(s.Duration)
+	}
+}
+
+// 第 index 个交易所的合约规格，不支持时按正向合约、面值 1 处理
+func (t *StrategyTester) getInstrument(index int, symbol string) *Instrument {
+	if index < len(t.exchanges) {
+		if ex, ok := t.exchanges[index].(Exchange); ok {
+			if result, err := ex.GetInstrument(symbol); err == nil && result != nil {
+				return result
+			}
+		}
+	}
+	return &Instrument{Symbol: symbol, ContractValue: 1}
+}
+
+// TradeLedger 根据委托和成交事件重建的开平仓记录，按开仓时间排序
+func (t *StrategyTester) TradeLedger() (result RoundTrips, err error) {
+	result, _ = t.readTradeHistory()
+	return
+}
+
+// 读取所有交易所的开平仓记录(按开仓时间排序)和成交记录(按时间排序)
+func (t *StrategyTester) readTradeHistory() (roundTrips RoundTrips, fills []*Fill) {
+	for i := range t.tradeLoggers {
+		builder := t.buildTradeLedger(i, t.tradeEvents(i))
+		roundTrips = append(roundTrips, builder.RoundTrips()...)
+		fills = append(fills, builder.Fills()...)
+	}
+	sort.SliceStable(roundTrips, func(i, j int) bool {
+		return roundTrips[i].EntryTime.Before(roundTrips[j].EntryTime)
+	})
+	sort.SliceStable(fills, func(i, j int) bool {
+		return fills[i].Time.Before(fills[j].Time)
+	})
+	return
+}
+
+// 重建第 index 个交易所的开平仓记录，并根据持仓期间的价格计算 MAE/MFE
+func (t *StrategyTester) buildTradeLedger(index int, events []*SOrder) *TradeLedgerBuilder {
+	builder := NewTradeLedgerBuilder(index)
+	for _, so := range events {
+		builder.Add(so)
+	}
+
+	logs := t.logs
+	for _, r := range builder.RoundTrips() {
+		i := sort.Search(len(logs), func(i int) bool {
+			return !logs[i].RawTime.Before(r.EntryTime)
+		})
+		for ; i < len(logs); i++ {
+			if r.Closed && logs[i].RawTime.After(r.ExitTime) {
+				break
+			}
+			prices := logs[i].Prices
+			if index < len(prices) {
+				r.updateExcursion(prices[index])
+			} else if len(prices) > 0 {
+				r.updateExcursion(prices[0])
+			}
+		}
+	}
+	return builder
+}
+
 // HTMLReport 创建Html报告文件
 func (t *StrategyTester) HtmlReport() {
-	for _, v := range t.eLogFiles {
-		t.htmlReport(v)
+	for i, v := range t.eLogFiles {
+		t.htmlReport(i, v)
 	}
 }
 
-func (t *StrategyTester) htmlReport(path string) (err error) {
+func (t *StrategyTester) htmlReport(index int, path string) (err error) {
 	dir := filepath.Dir(path)
 	name := filepath.Base(path)
 	ext := filepath.Ext(path)
@@ -285,20 +537,17 @@ func (t *StrategyTester) htmlReport(path string) (err error) {
 	htmlPath := filepath.Join(dir, name+".html")
 	//slog.Printf("htmlPath: %v", htmlPath)
 
-	var sOrders []*SOrder
-	var dealOrders []*SOrder
-	sOrders, dealOrders, err = t.readTradeLog(path)
-	if err != nil {
-		return
-	}
+	events := t.tradeEvents(index)
+	sOrders, dealOrders := splitTradeEvents(events)
+	roundTrips := t.buildTradeLedger(index, events).RoundTrips()
 
 	var html string
-	html, err = t.buildReportHtml(sOrders, dealOrders)
+	html, err = t.buildReportHtml(sOrders, dealOrders, roundTrips)
 	err = ioutil.WriteFile(htmlPath, []byte(html), os.ModePerm)
 	return
 }
 
-func (t *StrategyTester) buildReportHtml(sOrders []*SOrder, dealOrders []*SOrder) (html string, err error) {
+func (t *StrategyTester) buildReportHtml(sOrders []*SOrder, dealOrders []*SOrder, roundTrips RoundTrips) (html string, err error) {
 	// <!--{order-row}-->
 	html = strings.ReplaceAll(reportHistoryTemplate, "<!--{Symbol}-->", t.backtest.symbol)
 	html = strings.ReplaceAll(html, "<!--{Period}-->", fmt.Sprintf("%v - %v", t.backtest.start.String(), t.backtest.end.String())) // 2018.11.01 - 2018.12.01
@@ -312,7 +561,35 @@ func (t *StrategyTester) buildReportHtml(sOrders []*SOrder, dealOrders []*SOrder
 	html = strings.ReplaceAll(html, "<!--Run Duration-->", stats.RunDuration.String())
 	html = strings.ReplaceAll(html, "<!--Buy & Hold Return-->", fmt.Sprintf("%.8f", stats.BaHReturn))
 	html = strings.ReplaceAll(html, "<!--Buy & Hold Return [%]-->", fmt.Sprintf("%.4f", stats.BaHReturnPnt*100))
-	s := t.buildSOrders(sOrders)
+	html = strings.ReplaceAll(html, "<!--Ann Return [%]-->", fmt.Sprintf("%.4f", stats.AnnReturn*100))
+	html = strings.ReplaceAll(html, "<!--Max Drawdown [%]-->", fmt.Sprintf("%.4f", stats.MaxDrawDown*100))
+	html = strings.ReplaceAll(html, "<!--Max Drawdown Duration-->", stats.MaxDrawDownDuration.String())
+	html = strings.ReplaceAll(html, "<!--Volatility [%]-->", fmt.Sprintf("%.4f", stats.Volatility*100))
+	html = strings.ReplaceAll(html, "<!--Sharpe Ratio-->", fmt.Sprintf("%.4f", stats.SharpeRatio))
+	html = strings.ReplaceAll(html, "<!--Sortino Ratio-->", fmt.Sprintf("%.4f", stats.SortinoRatio))
+	html = strings.ReplaceAll(html, "<!--Calmar Ratio-->", fmt.Sprintf("%.4f", stats.CalmarRatio))
+	html = strings.ReplaceAll(html, "<!--Trades-->", fmt.Sprint(stats.NumTrades))
+	html = strings.ReplaceAll(html, "<!--Win Rate [%]-->", fmt.Sprintf("%.4f", stats.WinRate*100))
+	html = strings.ReplaceAll(html, "<!--Profit Factor-->", formatProfitFactor(stats.ProfitFactor))
+	html = strings.ReplaceAll(html, "<!--Avg Win-->", fmt.Sprintf("%.8f", stats.AvgWin))
+	html = strings.ReplaceAll(html, "<!--Avg Loss-->", fmt.Sprintf("%.8f", stats.AvgLoss))
+	html = strings.ReplaceAll(html, "<!--Time in Market [%]-->", fmt.Sprintf("%.4f", stats.TimeInMarket*100))
+	html = strings.ReplaceAll(html, "<!--Commission-->", fmt.Sprintf("%.8f", stats.Commission))
+	html = strings.ReplaceAll(html, "<!--Turnover-->", fmt.Sprint(stats.Turnover))
+	html = strings.ReplaceAll(html, "<!--Funding PnL-->", fmt.Sprintf("%.8f", stats.FundingPnl))
+	s := t.buildRoundTrips(roundTrips)
+	html = strings.Replace(html, `<!--{round-trip-rows}-->`, s, -1)
+	var pnlTotal, commissionTotal, netPnlTotal float64
+	for _, v := range roundTrips {
+		pnlTotal += v.Pnl
+		commissionTotal += v.Commission
+		netPnlTotal += v.NetPnl
+	}
+	html = strings.Replace(html, `<!--{round-trip-pnl-total}-->`, fmt.Sprintf("%.8f", pnlTotal), -1)
+	html = strings.Replace(html, `<!--{round-trip-commission-total}-->`, fmt.Sprintf("%.8f", commissionTotal), -1)
+	html = strings.Replace(html, `<!--{round-trip-net-pnl-total}-->`, fmt.Sprintf("%.8f", netPnlTotal), -1)
+
+	s = t.buildSOrders(sOrders)
 	html = strings.Replace(html, `<!--{order-rows}-->`, s, -1)
 	s = t.buildSOrders(dealOrders)
 	html = strings.Replace(html, `<!--{deal-order-rows}-->`, s, -1)
@@ -339,6 +616,38 @@ func (t *StrategyTester) buildReportHtml(sOrders []*SOrder, dealOrders []*SOrder
 	return
 }
 
+func (t *StrategyTester) buildRoundTrips(roundTrips RoundTrips) string {
+	s := bytes.Buffer{}
+	for i, r := range roundTrips {
+		bgColor := "#FFFFFF"
+		if i%2 != 0 {
+			bgColor = "#F7F7F7"
+		}
+		var exitTime, exitPrice, holdingTime string
+		if r.Closed {
+			exitTime = r.ExitTime.Format("2006-01-02 15:04:05.000")
+			exitPrice = fmt.Sprintf("%v", r.ExitPrice)
+			holdingTime = r.HoldingTime.String()
+		}
+		s.WriteString(fmt.Sprintf(`<tr bgcolor="%v" align="right">`, bgColor))
+		s.WriteString(fmt.Sprintf(`<td>%v</td>`, r.EntryTime.Format("2006-01-02 15:04:05.000")))
+		s.WriteString(fmt.Sprintf(`<td>%v</td>`, exitTime))
+		s.WriteString(fmt.Sprintf(`<td>%v</td>`, r.Symbol))
+		s.WriteString(fmt.Sprintf(`<td>%v</td>`, r.Side()))
+		s.WriteString(fmt.Sprintf(`<td>%v</td>`, r.Size))
+		s.WriteString(fmt.Sprintf(`<td>%v</td>`, r.EntryPrice))
+		s.WriteString(fmt.Sprintf(`<td>%v</td>`, exitPrice))
+		s.WriteString(fmt.Sprintf(`<td>%v</td>`, holdingTime))
+		s.WriteString(fmt.Sprintf(`<td>%.4f</td>`, r.MAE*100))
+		s.WriteString(fmt.Sprintf(`<td>%.4f</td>`, r.MFE*100))
+		s.WriteString(fmt.Sprintf(`<td>%.8f</td>`, r.Pnl))
+		s.WriteString(fmt.Sprintf(`<td>%.8f</td>`, r.Commission))
+		s.WriteString(fmt.Sprintf(`<td>%.8f</td>`, r.NetPnl))
+		s.WriteString(`</tr>`)
+	}
+	return s.String()
+}
+
 func (t *StrategyTester) buildSOrders(sOrders []*SOrder) string {
 	s := bytes.Buffer{}
 	for i := 0; i < len(sOrders); i++ {
@@ -402,85 +711,23 @@ func (t *StrategyTester) buildSOrders(sOrders []*SOrder) string {
 	return s.String()
 }
 
-func (t *StrategyTester) readTradeLog(path string) (orders []*SOrder, dealOrders []*SOrder, err error) {
-	var data []byte
-	data, err = ioutil.ReadFile(path)
-	if err != nil {
-		return
-	}
-	ss := strings.Split(string(data), "\n")
-
-	for _, s := range ss {
-		if s == "" {
-			continue
-		}
-		var event string
-		var so *SOrder
-		event, so, err = t.parseSOrder(s)
-		if err != nil {
-			return
-		}
-		switch event {
+// 拆分为委托记录和成交记录
+func splitTradeEvents(events []*SOrder) (orders []*SOrder, dealOrders []*SOrder) {
+	for _, so := range events {
+		switch so.Event {
 		case SimEventOrder:
 			orders = append(orders, so)
 		case SimEventDeal:
 			dealOrders = append(dealOrders, so)
 		}
 	}
-
 	return
 }
 
-func (t *StrategyTester) parseSOrder(s string) (event string, so *SOrder, err error) {
-	ret := gjson.Parse(s)
-	if eventValue := ret.Get("event"); eventValue.Exists() {
-		var order Order
-		var orderbook OrderBook
-		var positions []*Position
-
-		event = eventValue.String()
-		tsString := ret.Get("ts").String() // 2019-10-01T08:00:00.143+0800
-		msg := ret.Get("msg").String()
-		orderJson := ret.Get("order").String()
-		orderbookJson := ret.Get("orderbook").String()
-		positionsJson := ret.Get("positions").String()
-		var balances []float64
-		if v := ret.Get("balances"); v.Exists() {
-			values := v.Value().([]interface{})
-			for _, v := range values {
-				balances = append(balances, cast.ToFloat64(v))
-			}
-		} else if v := ret.Get("balance"); v.Exists() {
-			balances = []float64{v.Float()}
-		}
-
-		err = json.Unmarshal([]byte(orderJson), &order)
-		if err != nil {
-			return
-		}
-		err = json.Unmarshal([]byte(orderbookJson), &orderbook)
-		if err != nil {
-			return
-		}
-		if positionsJson != "" {
-			err = json.Unmarshal([]byte(positionsJson), &positions)
-			if err != nil {
-				return
-			}
-		}
-		var ts time.Time
-		ts, err = time.Parse("2006-01-02T15:04:05.000Z0700", tsString)
-		if err != nil {
-			return
-		}
-		so = &SOrder{
-			Ts:        ts,
-			Order:     &order,
-			OrderBook: &orderbook,
-			Positions: positions,
-			Balances:  balances,
-			Comment:   msg,
-		}
+// 第 index 个交易所按时间顺序的委托和成交记录
+func (t *StrategyTester) tradeEvents(index int) (events []*SOrder) {
+	if index >= len(t.tradeLoggers) {
+		return
 	}
-	return
+	return t.tradeLoggers[index].events
 }
@@ -2,6 +2,14 @@ package backtest
 
 import (
 	"github.com/coinrust/crex"
+	"github.com/coinrust/crex/dataloader"
+	"github.com/coinrust/crex/exchanges/exsim"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 )
@@ -66,3 +74,202 @@ func TestStrategyTester_CalAnnReturn(t *testing.T) {
 	stats.AnnReturn = st.CalAnnReturn(stats)
 	stats.PrintResult()
 }
+
+func equityLogs(start time.Time, interval time.Duration, equities ...float64) (result crex.LogItems) {
+	for i, v := range equities {
+		tm := start.Add(time.Duration(i) * interval)
+		result = append(result, &crex.LogItem{
+			Time:    tm,
+			RawTime: tm,
+			Prices:  []float64{5000.0},
+			Stats:   []crex.LogStats{{Balance: v, Equity: v}},
+		})
+	}
+	return
+}
+
+func TestStrategyTester_CalMaxDrawDownDuration(t *testing.T) {
+	start := time.Date(2020, 5, 1, 0, 0, 0, 0, time.Local)
+	st := StrategyTester{}
+
+	// 第 1 小时开始回撤，第 4 小时恢复
+	st.logs = equityLogs(start, time.Hour, 100, 95, 90, 98, 100, 99, 101)
+	assert.Equal(t, 4*time.Hour, st.CalMaxDrawDownDuration())
+
+	// 未恢复时计算到结束
+	st.logs = equityLogs(start, time.Hour, 100, 101, 99, 98, 97, 96, 100.5)
+	assert.Equal(t, 5*time.Hour, st.CalMaxDrawDownDuration())
+
+	st.logs = equityLogs(start, time.Hour, 100, 101, 102)
+	assert.Equal(t, time.Duration(0), st.CalMaxDrawDownDuration())
+}
+
+func TestStrategyTester_CalRiskRatios(t *testing.T) {
+	start := time.Date(2020, 5, 1, 0, 0, 0, 0, time.Local)
+	st := StrategyTester{}
+	st.backtest = NewBacktest(nil, "", start, start.Add(4*24*time.Hour), nil, nil, "")
+	st.logs = equityLogs(start, 24*time.Hour, 100, 102, 101, 104, 103)
+
+	stats := st.ComputeStats()
+	returns := []float64{0.02, 101.0/102 - 1, 104.0/101 - 1, 103.0/104 - 1}
+	var mean float64
+	for _, r := range returns {
+		mean += r / 4
+	}
+	var variance, downside float64
+	for _, r := range returns {
+		variance += (r - mean) * (r - mean) / 3
+		if r < 0 {
+			downside += r * r / 4
+		}
+	}
+	std := math.Sqrt(variance)
+	assert.InDelta(t, std*math.Sqrt(365), stats.Volatility, 1e-9)
+	assert.InDelta(t, mean/std*math.Sqrt(365), stats.SharpeRatio, 1e-9)
+	assert.InDelta(t, mean/math.Sqrt(downside)*math.Sqrt(365), stats.SortinoRatio, 1e-9)
+	assert.InDelta(t, stats.AnnReturn/stats.MaxDrawDown, stats.CalmarRatio, 1e-9)
+	assert.True(t, stats.SortinoRatio > stats.SharpeRatio)
+	assert.Equal(t, 48*time.Hour, stats.MaxDrawDownDuration)
+}
+
+// 按 tick 序号下市价单
+type scheduleStrategy struct {
+	crex.StrategyBase
+
+	schedule map[int]crex.Direction
+	ticks    int
+}
+
+func (s *scheduleStrategy) OnInit() error {
+	return nil
+}
+
+func (s *scheduleStrategy) OnTick() error {
+	defer func() { s.ticks++ }()
+	direction, ok := s.schedule[s.ticks]
+	if !ok {
+		return nil
+	}
+	_, err := s.Exchange.PlaceOrder("BTC-PERPETUAL", direction, crex.OrderTypeMarket, 0, 10)
+	return err
+}
+
+func (s *scheduleStrategy) Run() error {
+	return nil
+}
+
+func (s *scheduleStrategy) OnExit() error {
+	return nil
+}
+
+func TestStrategyTester_CalTradeStats(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stats")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(48 * time.Hour)
+	data := testData(start)
+	ex := exsim.NewExSim(data, 1.0, 0, 0.00075, 1.0, false, false)
+	// 上涨时做多一小时(盈利)，下跌时做多一小时(亏损)
+	strategy := &scheduleStrategy{schedule: map[int]crex.Direction{
+		0:    crex.Buy,
+		60:   crex.Sell,
+		1500: crex.Buy,
+		1560: crex.Sell,
+	}}
+	b := NewBacktest([]*dataloader.Data{data}, "BTC", start, end, strategy, []crex.ExchangeSim{ex}, dir)
+	b.Run()
+
+	stats := b.ComputeStats()
+	assert.Equal(t, 2, stats.NumTrades)
+	assert.Equal(t, 0.5, stats.WinRate)
+	assert.True(t, stats.AvgWin > 0)
+	assert.True(t, stats.AvgLoss < 0)
+	assert.InDelta(t, stats.AvgWin/-stats.AvgLoss, stats.ProfitFactor, 1e-9)
+	assert.Equal(t, 40.0, stats.Turnover)
+	assert.InDelta(t, 4*10*0.00075/8000, stats.Commission, 1e-6)
+	assert.InDelta(t, 120.0/(48*60), stats.TimeInMarket, 0.005)
+	assert.True(t, stats.MaxDrawDownDuration > 0)
+
+	roundTrips, err := b.TradeLedger()
+	if !assert.Nil(t, err) || !assert.Equal(t, 2, len(roundTrips)) {
+		return
+	}
+	assert.True(t, roundTrips[0].NetPnl > 0)
+	assert.True(t, roundTrips[0].MFE > 0.005)
+	assert.True(t, roundTrips[1].NetPnl < 0)
+	assert.True(t, roundTrips[1].MAE < -0.005)
+	assert.Equal(t, stats.AvgWin, roundTrips[0].NetPnl)
+
+	filename := filepath.Join(dir, "round_trips.csv")
+	assert.Nil(t, roundTrips.SaveCSV(filename))
+	csvData, _ := ioutil.ReadFile(filename)
+	assert.Equal(t, 3, len(strings.Split(strings.TrimSpace(string(csvData)), "\n")))
+
+	b.HtmlReport()
+	html, _ := ioutil.ReadFile(filepath.Join(filepath.Dir(b.strategyTesters[0].eLogFiles[0]), "trade_0.html"))
+	assert.True(t, strings.Contains(string(html), "Round Trips"))
+	assert.False(t, strings.Contains(string(html), "<!--{round-trip-rows}-->"))
+}
+
+// 未设置输出目录时根据内存中的委托和成交事件统计
+func TestStrategyTester_CalTradeStatsWithoutOutputDir(t *testing.T) {
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(48 * time.Hour)
+	data := testData(start)
+	ex := exsim.NewExSim(data, 1.0, 0, 0.00075, 1.0, false, false)
+	strategy := &scheduleStrategy{schedule: map[int]crex.Direction{
+		0:    crex.Buy,
+		60:   crex.Sell,
+		1500: crex.Buy,
+		1560: crex.Sell,
+	}}
+	b := NewBacktest([]*dataloader.Data{data}, "BTC", start, end, strategy, []crex.ExchangeSim{ex}, "")
+	b.Run()
+
+	stats := b.ComputeStats()
+	assert.Equal(t, 2, stats.NumTrades)
+	assert.Equal(t, 0.5, stats.WinRate)
+	assert.InDelta(t, 4*10*0.00075/8000, stats.Commission, 1e-6)
+	roundTrips, err := b.TradeLedger()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(roundTrips))
+}
+
+// 只有盈利交易时盈利因子为 +Inf，正向合约的成交额为数量 * 价格 * 面值
+func TestStrategyTester_ProfitFactorWithoutLosses(t *testing.T) {
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(48 * time.Hour)
+	data := testData(start)
+	ex := exsim.NewExSim(data, 100000, 0, 0.00075, 0.1, false, true)
+	strategy := &scheduleStrategy{schedule: map[int]crex.Direction{
+		0:  crex.Buy,
+		60: crex.Sell,
+	}}
+	b := NewBacktest([]*dataloader.Data{data}, "BTC", start, end, strategy, []crex.ExchangeSim{ex}, "")
+	b.Run()
+
+	stats := b.ComputeStats()
+	assert.Equal(t, 1, stats.NumTrades)
+	assert.True(t, math.IsInf(stats.ProfitFactor, 1))
+	_, fills := b.strategyTesters[0].readTradeHistory()
+	if !assert.Equal(t, 2, len(fills)) {
+		return
+	}
+	assert.InDelta(t, 10*0.1*(fills[0].Price+fills[1].Price), stats.Turnover, 1e-9)
+
+	data2, err := json.Marshal(stats)
+	if !assert.Nil(t, err) {
+		return
+	}
+	assert.True(t, strings.Contains(string(data2), `"profit_factor":"inf"`))
+	var stats2 crex.Stats
+	assert.Nil(t, json.Unmarshal(data2, &stats2))
+	assert.True(t, math.IsInf(stats2.ProfitFactor, 1))
+	assert.Equal(t, "∞", formatProfitFactor(stats.ProfitFactor))
+	assert.Equal(t, "inf", formatFloat(stats.ProfitFactor))
+}
@@ -0,0 +1,89 @@
+package backtest
+
+import (
+	. "github.com/coinrust/crex"
+	"github.com/spf13/cast"
+)
+
+// tradeLogger 记录模拟交易所的委托和成交事件，用于统计和报告，同时转发给撮合日志
+// 未设置输出目录时也记录，统计结果不依赖日志文件
+type tradeLogger struct {
+	ExchangeLogger
+	tester *StrategyTester
+	events []*SOrder
+}
+
+func (l *tradeLogger) Infow(msg string, keysAndValues ...interface{}) {
+	l.ExchangeLogger.Infow(msg, keysAndValues...)
+	l.record(msg, keysAndValues)
+}
+
+// 按模拟交易所 logOrderInfo 的参数记录委托和成交事件
+// 模拟交易所会继续修改委托和持仓，记录时复制一份
+func (l *tradeLogger) record(msg string, keysAndValues []interface{}) {
+	so := &SOrder{
+		Ts:      l.tester.backtest.GetTime(),
+		Comment: msg,
+	}
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		key, _ := keysAndValues[i].(string)
+		switch value := keysAndValues[i+1]; key {
+		case SimEventKey:
+			so.Event, _ = value.(string)
+		case "order":
+			if order, ok := value.(*Order); ok && order != nil {
+				o := *order
+				so.Order = &o
+			}
+		case "orderbook":
+			if ob, ok := value.(*OrderBook); ok && ob != nil {
+				so.Price = ob.Price()
+			}
+		case "positions":
+			so.Positions = copyPositions(value)
+		case "balance":
+			if so.Balances == nil {
+				so.Balances = []float64{cast.ToFloat64(value)}
+			}
+		case "balances":
+			if balances, ok := value.([]float64); ok {
+				so.Balances = append([]float64{}, balances...)
+			}
+		}
+	}
+	// 资金费用结算没有委托信息
+	if (so.Event != SimEventOrder && so.Event != SimEventDeal) || so.Order == nil {
+		return
+	}
+	l.events = append(l.events, so)
+}
+
+func copyPositions(value interface{}) (result []*Position) {
+	switch positions := value.(type) {
+	case []*Position:
+		for _, v := range positions {
+			if v != nil {
+				p := *v
+				result = append(result, &p)
+			}
+		}
+	case []Position:
+		for i := range positions {
+			p := positions[i]
+			result = append(result, &p)
+		}
+	case *Position:
+		if positions != nil {
+			p := *positions
+			result = append(result, &p)
+		}
+	}
+	return
+}
+
+func newTradeLogger(tester *StrategyTester, l ExchangeLogger) *tradeLogger {
+	return &tradeLogger{
+		ExchangeLogger: l,
+		tester:         tester,
+	}
+}
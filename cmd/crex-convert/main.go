@@ -0,0 +1,83 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/coinrust/crex/dataloader"
+	"log"
+	"os"
+	"strings"
+	"time"
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, `crex-convert version: v1.0.0
+Usage: crex-convert [-h] -i input -o output [-t orderbook|trade]
+Convert CSV (.csv/.csv.gz) or MongoDB (-i mongodb://...) data to binary columnar files.
+Options:
+`)
+	flag.PrintDefaults()
+}
+
+func main() {
+	var input string
+	var output string
+	var dataType string
+	var database string
+	var exchangeName string
+	var symbol string
+	var start string
+	var end string
+	var help bool
+	flag.StringVar(&input, "i", "", "input file or mongodb uri, mongodb://localhost:27017")
+	flag.StringVar(&output, "o", "", "output file")
+	flag.StringVar(&dataType, "t", "orderbook", "data type, orderbook/trade")
+	flag.StringVar(&database, "db", "tick_db", "mongodb database")
+	flag.StringVar(&exchangeName, "e", "deribit", "exchange name")
+	flag.StringVar(&symbol, "s", "BTC-PERPETUAL", "symbol")
+	flag.StringVar(&start, "st", "2000-01-01 00:00:00", "start time, 2020-05-01 00:00:00")
+	flag.StringVar(&end, "et", "2100-01-01 00:00:00", "end time, 2020-05-01 00:00:00")
+	flag.BoolVar(&help, "h", false, "this help")
+	flag.Usage = usage
+
+	flag.Parse()
+	if help || input == "" || output == "" {
+		flag.Usage()
+		return
+	}
+	st, err := time.Parse("2006-01-02 15:04:05", start)
+	if err != nil {
+		log.Fatal(err)
+	}
+	et, err := time.Parse("2006-01-02 15:04:05", end)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	mongodb := strings.HasPrefix(input, "mongodb://")
+	var n int
+	switch dataType {
+	case "orderbook":
+		var loader dataloader.DataLoader
+		if mongodb {
+			loader = dataloader.NewMongoDBDataLoader(input, database, exchangeName, symbol)
+		} else {
+			loader = dataloader.NewCsvDataLoader(input)
+		}
+		n, err = dataloader.ConvertOrderBooks(loader, st, et, output, symbol)
+	case "trade":
+		var loader dataloader.TradeLoader
+		if mongodb {
+			loader = dataloader.NewMongoDBTradeLoader(input, database, exchangeName, symbol)
+		} else {
+			loader = dataloader.NewCsvTradeLoader(input, symbol)
+		}
+		n, err = dataloader.ConvertTrades(loader, st, et, output, symbol)
+	default:
+		log.Fatalf("invalid data type: %v", dataType)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%v %v records converted to %v\n", n, dataType, output)
+}
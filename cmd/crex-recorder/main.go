@@ -0,0 +1,124 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/BurntSushi/toml"
+	. "github.com/coinrust/crex"
+	"github.com/coinrust/crex/exchanges"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+type Config struct {
+	Output        string      `toml:"output"`         // 输出目录
+	Depth         int         `toml:"depth"`          // 订单薄档数
+	Rotate        string      `toml:"rotate"`         // 文件切分周期，如 1h
+	GapTimeout    string      `toml:"gap_timeout"`    // 超过此时间未收到推送视为断线
+	FlushInterval string      `toml:"flush_interval"` // 写入文件间隔
+	Exchanges     []RExchange `toml:"exchange"`
+}
+
+type RExchange struct {
+	Name      string   `toml:"name"`
+	DebugMode bool     `toml:"debug_mode"`
+	Testnet   bool     `toml:"testnet"`
+	ApiURL    string   `toml:"api_url"`   // 可选
+	WsURL     string   `toml:"ws_url"`    // 可选
+	ProxyURL  string   `toml:"proxy_url"` // 可选
+	Symbols   []string `toml:"symbols"`
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, `crex-recorder version: v1.0.0
+Usage: crex-recorder [-h] [-c config.toml]
+Options:
+`)
+	flag.PrintDefaults()
+}
+
+func parseDuration(s string, def time.Duration) (time.Duration, error) {
+	if s == "" {
+		return def, nil
+	}
+	return time.ParseDuration(s)
+}
+
+func main() {
+	var configFile string
+	var help bool
+	flag.StringVar(&configFile, "c", "config.toml", "config file")
+	flag.BoolVar(&help, "h", false, "this help")
+	flag.Usage = usage
+	flag.Parse()
+	if help {
+		flag.Usage()
+		return
+	}
+
+	c := Config{}
+	if _, err := toml.DecodeFile(configFile, &c); err != nil {
+		log.Fatal(err)
+	}
+	if len(c.Exchanges) == 0 {
+		log.Fatal("no exchange found")
+	}
+	if c.Output == "" {
+		c.Output = "."
+	}
+	if c.Depth <= 0 {
+		c.Depth = 10
+	}
+	rotate, err := parseDuration(c.Rotate, time.Hour)
+	if err != nil {
+		log.Fatal(err)
+	}
+	gapTimeout, err := parseDuration(c.GapTimeout, 30*time.Second)
+	if err != nil {
+		log.Fatal(err)
+	}
+	flushInterval, err := parseDuration(c.FlushInterval, 10*time.Second)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	recorder := NewRecorder(c.Output, c.Depth, rotate, gapTimeout)
+	for _, ex := range c.Exchanges {
+		var opts = []ApiOption{
+			ApiDebugModeOption(ex.DebugMode),
+			ApiTestnetOption(ex.Testnet),
+			ApiWebSocketOption(true),
+		}
+		if ex.ApiURL != "" {
+			opts = append(opts, ApiApiURLOption(ex.ApiURL))
+		}
+		if ex.WsURL != "" {
+			opts = append(opts, ApiWsURLOption(ex.WsURL))
+		}
+		if ex.ProxyURL != "" {
+			opts = append(opts, ApiProxyURLOption(ex.ProxyURL))
+		}
+		exchange := exchanges.NewExchange(ex.Name, opts...)
+		if err = recorder.Subscribe(ex.Name, exchange, ex.Symbols...); err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("recording %v %v", ex.Name, ex.Symbols)
+	}
+
+	sigC := make(chan os.Signal, 1)
+	signal.Notify(sigC, os.Interrupt, syscall.SIGTERM)
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			recorder.Flush()
+		case <-sigC:
+			recorder.Close()
+			return
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"fmt"
+	. "github.com/coinrust/crex"
+	"log"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Recorder 订阅订单薄和成交推送，写入回测数据文件
+// 订单薄文件格式同 CsvDataLoader: t,asks[0].price,asks[0].amount,...,bids[0].price,bids[0].amount,...
+// 成交文件格式同 CsvTradeLoader: t,id,direction,price,amount
+// 超过 gapTimeout 未收到推送(断线重连)时，恢复后在两个文件中写入断线标记: gap,开始时间,结束时间
+// 断线标记少于 5 列，加载器会忽略
+type Recorder struct {
+	output     string
+	depth      int
+	rotate     time.Duration
+	gapTimeout time.Duration
+	now        func() time.Time
+
+	mu      sync.Mutex
+	streams []*stream
+}
+
+// 单个标的的订单薄和成交文件
+type stream struct {
+	recorder *Recorder
+
+	mu     sync.Mutex
+	books  *rotateWriter
+	trades *rotateWriter
+	last   time.Time // 最近一次收到推送的时间
+}
+
+// Subscribe 订阅交易所标的行情
+func (r *Recorder) Subscribe(exchangeName string, exchange Exchange, symbols ...string) (err error) {
+	for _, symbol := range symbols {
+		s := r.newStream(exchangeName, symbol)
+		market := Market{Symbol: symbol}
+		if err = exchange.SubscribeLevel2Snapshots(market, s.onOrderBook); err != nil {
+			return
+		}
+		if err = exchange.SubscribeTrades(market, s.onTrades); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (r *Recorder) newStream(exchangeName string, symbol string) *stream {
+	dir := filepath.Join(r.output, exchangeName)
+	name := strings.ReplaceAll(symbol, "/", "-")
+	s := &stream{
+		recorder: r,
+		books:    newRotateWriter(dir, name+"_orderbook", orderBookHeader(r.depth), r.rotate),
+		trades:   newRotateWriter(dir, name+"_trades", "t,id,direction,price,amount", r.rotate),
+	}
+	r.mu.Lock()
+	r.streams = append(r.streams, s)
+	r.mu.Unlock()
+	return s
+}
+
+// Flush 将缓冲数据写入文件
+func (r *Recorder) Flush() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, s := range r.streams {
+		s.mu.Lock()
+		s.books.Flush()
+		s.trades.Flush()
+		s.mu.Unlock()
+	}
+}
+
+// Close 关闭所有文件
+func (r *Recorder) Close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, s := range r.streams {
+		s.mu.Lock()
+		s.books.Close()
+		s.trades.Close()
+		s.mu.Unlock()
+	}
+}
+
+func (s *stream) onOrderBook(ob *OrderBook) {
+	depth := s.recorder.depth
+	if len(ob.Asks) < depth {
+		depth = len(ob.Asks)
+	}
+	if len(ob.Bids) < depth {
+		depth = len(ob.Bids)
+	}
+	if depth == 0 {
+		return
+	}
+
+	now := s.recorder.now()
+	t := ob.Time
+	if t.IsZero() {
+		t = now
+	}
+	fields := []string{strconv.FormatInt(t.UnixNano()/int64(time.Millisecond), 10)}
+	for _, ask := range ob.Asks[:depth] {
+		fields = append(fields, formatFloat(ask.Price), formatFloat(ask.Amount))
+	}
+	for _, bid := range ob.Bids[:depth] {
+		fields = append(fields, formatFloat(bid.Price), formatFloat(bid.Amount))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkGap(now)
+	s.write(s.books, now, strings.Join(fields, ","))
+}
+
+func (s *stream) onTrades(trades []*Trade) {
+	now := s.recorder.now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkGap(now)
+	for _, trade := range trades {
+		ts := trade.Ts
+		if ts == 0 {
+			ts = now.UnixNano() / int64(time.Millisecond)
+		}
+		s.write(s.trades, now, fmt.Sprintf("%d,%s,%s,%s,%s",
+			ts, trade.ID, strings.ToLower(trade.Direction.String()),
+			formatFloat(trade.Price), formatFloat(trade.Amount)))
+	}
+}
+
+// 距上次推送超过 gapTimeout 时写入断线标记
+func (s *stream) checkGap(now time.Time) {
+	if !s.last.IsZero() && now.Sub(s.last) > s.recorder.gapTimeout {
+		line := fmt.Sprintf("gap,%d,%d",
+			s.last.UnixNano()/int64(time.Millisecond),
+			now.UnixNano()/int64(time.Millisecond))
+		s.write(s.books, now, line)
+		s.write(s.trades, now, line)
+	}
+	s.last = now
+}
+
+func (s *stream) write(w *rotateWriter, now time.Time, line string) {
+	if err := w.WriteLine(now, line); err != nil {
+		log.Printf("write %v error: %v", w.prefix, err)
+	}
+}
+
+func orderBookHeader(depth int) string {
+	fields := []string{"t"}
+	for i := 0; i < depth; i++ {
+		fields = append(fields, fmt.Sprintf("asks[%d].price", i), fmt.Sprintf("asks[%d].amount", i))
+	}
+	for i := 0; i < depth; i++ {
+		fields = append(fields, fmt.Sprintf("bids[%d].price", i), fmt.Sprintf("bids[%d].amount", i))
+	}
+	return strings.Join(fields, ",")
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// NewRecorder 创建行情记录器
+func NewRecorder(output string, depth int, rotate time.Duration, gapTimeout time.Duration) *Recorder {
+	return &Recorder{
+		output:     output,
+		depth:      depth,
+		rotate:     rotate,
+		gapTimeout: gapTimeout,
+		now:        time.Now,
+	}
+}
@@ -0,0 +1,278 @@
+package main
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	. "github.com/coinrust/crex"
+	"github.com/coinrust/crex/dataloader"
+	"github.com/coinrust/crex/exchanges/deribit"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"nhooyr.io/websocket"
+	"nhooyr.io/websocket/wsjson"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type fakeExchange struct {
+	Exchange
+	books  func(ob *OrderBook)
+	trades func(trades []*Trade)
+}
+
+func (e *fakeExchange) SubscribeLevel2Snapshots(market Market, callback func(ob *OrderBook)) error {
+	e.books = callback
+	return nil
+}
+
+func (e *fakeExchange) SubscribeTrades(market Market, callback func(trades []*Trade)) error {
+	e.trades = callback
+	return nil
+}
+
+func testOrderBook(t time.Time, bid float64) *OrderBook {
+	return &OrderBook{
+		Time: t,
+		Asks: []Item{{Price: bid + 0.5, Amount: 10}, {Price: bid + 1, Amount: 20}, {Price: bid + 1.5, Amount: 30}},
+		Bids: []Item{{Price: bid, Amount: 15}, {Price: bid - 0.5, Amount: 25}},
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recorder")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	start := time.Date(2019, 10, 1, 0, 59, 0, 0, time.UTC)
+	now := start
+	r := NewRecorder(dir, 3, time.Hour, 30*time.Second)
+	r.now = func() time.Time { return now }
+	ex := &fakeExchange{}
+	if err = r.Subscribe("deribit", ex, "BTC-PERPETUAL"); err != nil {
+		t.Error(err)
+		return
+	}
+
+	ex.books(testOrderBook(now, 8000))
+	ex.trades([]*Trade{{ID: "1", Direction: Buy, Price: 8000.5, Amount: 10, Ts: 1569891540000}})
+	now = now.Add(10 * time.Second)
+	ex.books(testOrderBook(now, 8001))
+	r.Flush()
+
+	// 断线 2 分钟，跨小时切分文件
+	now = now.Add(2 * time.Minute)
+	ex.books(testOrderBook(now, 8010))
+	ex.trades([]*Trade{{ID: "2", Direction: Sell, Price: 8010, Amount: 5}})
+	r.Close()
+
+	files, _ := filepath.Glob(filepath.Join(dir, "deribit", "*.csv.gz"))
+	assert.Equal(t, 4, len(files))
+
+	loader := dataloader.NewCsvDataLoader(filepath.Join(dir, "deribit", "BTC-PERPETUAL_orderbook_20191001_000000.csv.gz"))
+	loader.Setup(start, start.Add(time.Hour))
+	obs := loader.ReadOrderBooks()
+	if assert.Equal(t, 2, len(obs)) {
+		assert.Equal(t, start, obs[0].Time.UTC())
+		// 按较少一侧的档数输出
+		assert.Equal(t, []Item{{Price: 8000.5, Amount: 10}, {Price: 8001, Amount: 20}}, obs[0].Asks)
+		assert.Equal(t, []Item{{Price: 8001, Amount: 15}, {Price: 8000.5, Amount: 25}}, obs[1].Bids)
+	}
+
+	filename := filepath.Join(dir, "deribit", "BTC-PERPETUAL_orderbook_20191001_010000.csv.gz")
+	loader = dataloader.NewCsvDataLoader(filename)
+	loader.Setup(start, start.Add(time.Hour))
+	obs = loader.ReadOrderBooks()
+	if assert.Equal(t, 1, len(obs)) {
+		assert.Equal(t, 8010.0, obs[0].BidPrice())
+	}
+
+	tradeLoader := dataloader.NewCsvTradeLoader(filepath.Join(dir, "deribit", "BTC-PERPETUAL_trades_20191001_010000.csv.gz"), "BTC-PERPETUAL")
+	tradeLoader.Setup(start, start.Add(time.Hour))
+	trades := tradeLoader.ReadTrades()
+	if assert.Equal(t, 1, len(trades)) {
+		assert.Equal(t, "2", trades[0].ID)
+		assert.Equal(t, Sell, trades[0].Direction)
+		assert.Equal(t, now.UnixNano()/int64(time.Millisecond), trades[0].Ts)
+	}
+
+	// 断线标记
+	f, err := os.Open(filename)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	data, _ := ioutil.ReadAll(gz)
+	assert.Contains(t, string(data), "gap,1569891550000,1569891670000\n")
+}
+
+// 模拟 Deribit JSON-RPC 行情服务，第一个连接推送 count 条后断开
+type deribitServer struct {
+	count int
+	conns int32
+}
+
+type rpcRequest struct {
+	ID     *uint64         `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+func (s *deribitServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	conn, err := websocket.Accept(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close(websocket.StatusInternalError, "")
+	first := atomic.AddInt32(&s.conns, 1) == 1
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	subscribed := make(chan []string, 1)
+	go func() {
+		defer cancel()
+		for {
+			var req rpcRequest
+			if err := wsjson.Read(ctx, conn, &req); err != nil {
+				return
+			}
+			if req.ID == nil {
+				continue
+			}
+			var result interface{} = "ok"
+			if req.Method == "public/subscribe" {
+				var params struct {
+					Channels []string `json:"channels"`
+				}
+				json.Unmarshal(req.Params, &params)
+				result = params.Channels
+				select {
+				case subscribed <- params.Channels:
+				default:
+				}
+			}
+			wsjson.Write(ctx, conn, map[string]interface{}{"jsonrpc": "2.0", "id": *req.ID, "result": result})
+		}
+	}()
+
+	var channels []string
+	ticker := time.NewTicker(20 * time.Millisecond)
+	defer ticker.Stop()
+	for i := 0; ; i++ {
+		select {
+		case <-ctx.Done():
+			return
+		case c := <-subscribed:
+			channels = append(channels, c...)
+		case <-ticker.C:
+		}
+		if first && i >= s.count {
+			// 推送中途断开连接
+			conn.Close(websocket.StatusGoingAway, "")
+			return
+		}
+		ms := time.Now().UnixNano() / int64(time.Millisecond)
+		for _, ch := range channels {
+			var data interface{}
+			if strings.HasPrefix(ch, "book.") {
+				data = map[string]interface{}{
+					"timestamp":       ms,
+					"instrument_name": "BTC-PERPETUAL",
+					"prev_change_id":  0,
+					"change_id":       i + 1,
+					"asks":            [][]interface{}{{"new", 8000.5, 10}},
+					"bids":            [][]interface{}{{"new", 8000, 15}},
+				}
+			} else {
+				data = []map[string]interface{}{{
+					"trade_id":        fmt.Sprint(i),
+					"timestamp":       ms,
+					"price":           8000.5,
+					"direction":       "buy",
+					"amount":          10,
+					"instrument_name": "BTC-PERPETUAL",
+				}}
+			}
+			notification := map[string]interface{}{
+				"jsonrpc": "2.0",
+				"method":  "subscription",
+				"params":  map[string]interface{}{"channel": ch, "data": data},
+			}
+			if err := wsjson.Write(ctx, conn, notification); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func TestRecorder_Reconnect(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recorder")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	server := &deribitServer{count: 10}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	// 客户端断线 1 秒后重连
+	r := NewRecorder(dir, 1, time.Hour, 300*time.Millisecond)
+	ex := deribit.NewDeribit(&Parameters{WsURL: "ws" + strings.TrimPrefix(ts.URL, "http")})
+	if err = r.Subscribe("deribit", ex, "BTC-PERPETUAL"); err != nil {
+		t.Error(err)
+		return
+	}
+
+	reconnected := assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&server.conns) >= 2
+	}, 5*time.Second, 10*time.Millisecond)
+	// 重连后继续接收推送
+	time.Sleep(200 * time.Millisecond)
+	r.Close()
+	if !reconnected {
+		return
+	}
+
+	for _, pattern := range []string{"BTC-PERPETUAL_orderbook_*.csv.gz", "BTC-PERPETUAL_trades_*.csv.gz"} {
+		files, _ := filepath.Glob(filepath.Join(dir, "deribit", pattern))
+		var data string
+		for _, filename := range files {
+			data += readGzip(t, filename)
+		}
+		assert.Contains(t, data, "\ngap,", pattern)
+	}
+}
+
+func readGzip(t *testing.T, filename string) string {
+	f, err := os.Open(filename)
+	if err != nil {
+		t.Error(err)
+		return ""
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		t.Error(err)
+		return ""
+	}
+	data, _ := ioutil.ReadAll(gz)
+	return string(data)
+}
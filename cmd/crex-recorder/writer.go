@@ -0,0 +1,81 @@
+package main
+
+import (
+	"compress/gzip"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// 按周期切分的 gzip 文件
+// 文件名: {prefix}_{周期开始时间 UTC}.csv.gz，每个文件以标题行开始
+type rotateWriter struct {
+	dir    string
+	prefix string
+	header string
+	period time.Duration
+
+	start time.Time // 当前文件周期开始时间
+	file  *os.File
+	gw    *gzip.Writer
+}
+
+func (w *rotateWriter) WriteLine(now time.Time, line string) (err error) {
+	start := now.UTC().Truncate(w.period)
+	if w.gw == nil || !start.Equal(w.start) {
+		if err = w.rotate(start); err != nil {
+			return
+		}
+	}
+	_, err = w.gw.Write([]byte(line + "\n"))
+	return
+}
+
+func (w *rotateWriter) rotate(start time.Time) (err error) {
+	if err = w.Close(); err != nil {
+		return
+	}
+	if err = os.MkdirAll(w.dir, os.ModePerm); err != nil {
+		return
+	}
+	filename := filepath.Join(w.dir, fmt.Sprintf("%s_%s.csv.gz", w.prefix, start.Format("20060102_150405")))
+	// 重启后同一周期追加新的 gzip 段，读取时自动拼接
+	w.file, err = os.OpenFile(filename, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return
+	}
+	w.gw = gzip.NewWriter(w.file)
+	w.start = start
+	_, err = w.gw.Write([]byte(w.header + "\n"))
+	return
+}
+
+func (w *rotateWriter) Flush() error {
+	if w.gw == nil {
+		return nil
+	}
+	return w.gw.Flush()
+}
+
+func (w *rotateWriter) Close() (err error) {
+	if w.gw == nil {
+		return
+	}
+	err = w.gw.Close()
+	if e := w.file.Close(); err == nil {
+		err = e
+	}
+	w.gw = nil
+	w.file = nil
+	return
+}
+
+func newRotateWriter(dir string, prefix string, header string, period time.Duration) *rotateWriter {
+	return &rotateWriter{
+		dir:    dir,
+		prefix: prefix,
+		header: header,
+		period: period,
+	}
+}
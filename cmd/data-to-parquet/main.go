@@ -0,0 +1,93 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/coinrust/crex/dataloader"
+	"log"
+	"os"
+	"strings"
+	"time"
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, `data-to-parquet version: v1.0.0
+Usage: data-to-parquet [-h] -i input -o output [-t orderbook|kline]
+Export order books or klines from MongoDB (-i mongodb://...), CSV (.csv/.csv.gz) or binary (.bin) data to Parquet files.
+Options:
+`)
+	flag.PrintDefaults()
+}
+
+func main() {
+	var input string
+	var output string
+	var dataType string
+	var trades string
+	var depth int
+	var period string
+	var database string
+	var exchangeName string
+	var symbol string
+	var start string
+	var end string
+	var help bool
+	flag.StringVar(&input, "i", "", "input file or mongodb uri, mongodb://localhost:27017")
+	flag.StringVar(&output, "o", "", "output file")
+	flag.StringVar(&dataType, "t", "orderbook", "data type, orderbook/kline")
+	flag.StringVar(&trades, "trades", "", "trade file (.csv/.csv.gz/.bin) for kline volume")
+	flag.IntVar(&depth, "d", 20, "order book depth")
+	flag.StringVar(&period, "p", "1m", "kline period")
+	flag.StringVar(&database, "db", "tick_db", "mongodb database")
+	flag.StringVar(&exchangeName, "e", "deribit", "exchange name")
+	flag.StringVar(&symbol, "s", "BTC-PERPETUAL", "symbol")
+	flag.StringVar(&start, "st", "2000-01-01 00:00:00", "start time, 2020-05-01 00:00:00")
+	flag.StringVar(&end, "et", "2100-01-01 00:00:00", "end time, 2020-05-01 00:00:00")
+	flag.BoolVar(&help, "h", false, "this help")
+	flag.Usage = usage
+
+	flag.Parse()
+	if help || input == "" || output == "" {
+		flag.Usage()
+		return
+	}
+	st, err := time.Parse("2006-01-02 15:04:05", start)
+	if err != nil {
+		log.Fatal(err)
+	}
+	et, err := time.Parse("2006-01-02 15:04:05", end)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var data *dataloader.Data
+	switch {
+	case strings.HasPrefix(input, "mongodb://"):
+		data = dataloader.NewMongoDBData(input, database, exchangeName, symbol)
+	case strings.HasSuffix(input, ".bin"):
+		data = dataloader.NewBinaryData(input)
+	default:
+		data = dataloader.NewCsvData(input)
+	}
+	if trades != "" {
+		if strings.HasSuffix(trades, ".bin") {
+			data.SetTradeLoader(dataloader.NewBinaryTradeLoader(trades))
+		} else {
+			data.SetTradeLoader(dataloader.NewCsvTradeLoader(trades, symbol))
+		}
+	}
+
+	var n int
+	switch dataType {
+	case "orderbook":
+		n, err = dataloader.ExportOrderBooks(data, st, et, output, depth)
+	case "kline":
+		n, err = dataloader.ExportRecords(data, st, et, output, period)
+	default:
+		log.Fatalf("invalid data type: %v", dataType)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%v %v records exported to %v\n", n, dataType, output)
+}
@@ -24,9 +24,10 @@ func (t TradeMode) String() string {
 
 // 回测交易撮合事件类型
 const (
-	SimEventKey   = "event"
-	SimEventOrder = "order" // 委托
-	SimEventDeal  = "deal"  // 成交
+	SimEventKey     = "event"
+	SimEventOrder   = "order"   // 委托
+	SimEventDeal    = "deal"    // 成交
+	SimEventFunding = "funding" // 资金费用结算
 )
 
 // Direction 委托/持仓方向
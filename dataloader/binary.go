@@ -0,0 +1,394 @@
+package dataloader
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	. "github.com/coinrust/crex"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"time"
+)
+
+// 二进制列式数据文件，按数据块存储，文件尾部为数据块时间索引，加载时直接定位到开始时间所在的数据块
+// 文件结构: 文件头 | 数据块... | 索引 | 文件尾，数值均为小端序
+// 文件头: magic "CRXB" | 版本 uint16 | 类型 uint8 | 标的长度 uint16 | 标的
+// 数据块: 记录数 uint32 | 数据长度 uint32 | 列数据
+//   订单薄: 时间 []int64 | 卖盘档数 []uint16 | 买盘档数 []uint16 | 卖价 | 卖量 | 买价 | 买量
+//   成交: 时间 []int64 | 方向 []uint8 | 价格 []float64 | 数量 []float64 | ID 长度 []uint16 | ID
+// 索引: 每个数据块 位置 int64 | 开始时间 int64 | 结束时间 int64 (毫秒)
+// 文件尾: 索引位置 int64 | 数据块数 uint32 | magic "CRXB"
+
+const (
+	binaryMagic     = "CRXB"
+	binaryVersion   = 1
+	binaryBlockSize = 4096 // 每个数据块的记录数
+
+	binaryKindOrderBook uint8 = 1
+	binaryKindTrade     uint8 = 2
+
+	binaryBlockHeaderSize = 8
+	binaryIndexSize       = 24
+	binaryFooterSize      = 16
+)
+
+var (
+	ErrInvalidBinaryFile = errors.New("invalid binary file")
+	ErrUnsortedData      = errors.New("data must be sorted by time")
+)
+
+// 数据块索引
+type blockIndex struct {
+	offset int64
+	start  int64
+	end    int64
+}
+
+// 数据块写入
+type binaryWriter struct {
+	file   *os.File
+	w      *bufio.Writer
+	offset int64
+	index  []blockIndex
+
+	count int
+	start int64
+	end   int64
+}
+
+func (w *binaryWriter) write(b []byte) (err error) {
+	_, err = w.w.Write(b)
+	w.offset += int64(len(b))
+	return
+}
+
+// 记录时间，数据需按时间排序
+func (w *binaryWriter) addTime(t int64) error {
+	if (w.count > 0 || len(w.index) > 0) && t < w.end {
+		return ErrUnsortedData
+	}
+	if w.count == 0 {
+		w.start = t
+	}
+	w.end = t
+	w.count++
+	return nil
+}
+
+func (w *binaryWriter) writeBlock(columns ...[]byte) (err error) {
+	if w.count == 0 {
+		return
+	}
+	var length int
+	for _, column := range columns {
+		length += len(column)
+	}
+	w.index = append(w.index, blockIndex{offset: w.offset, start: w.start, end: w.end})
+	var header []byte
+	header = appendUint32(header, uint32(w.count))
+	header = appendUint32(header, uint32(length))
+	if err = w.write(header); err != nil {
+		return
+	}
+	for _, column := range columns {
+		if err = w.write(column); err != nil {
+			return
+		}
+	}
+	w.count = 0
+	return
+}
+
+func (w *binaryWriter) close() (err error) {
+	var b []byte
+	for _, v := range w.index {
+		b = appendUint64(b, uint64(v.offset))
+		b = appendUint64(b, uint64(v.start))
+		b = appendUint64(b, uint64(v.end))
+	}
+	b = appendUint64(b, uint64(w.offset))
+	b = appendUint32(b, uint32(len(w.index)))
+	b = append(b, binaryMagic...)
+	if err = w.write(b); err != nil {
+		w.file.Close()
+		return
+	}
+	if err = w.w.Flush(); err != nil {
+		w.file.Close()
+		return
+	}
+	return w.file.Close()
+}
+
+func newBinaryWriter(filename string, kind uint8, symbol string) (*binaryWriter, error) {
+	file, err := os.Create(filename)
+	if err != nil {
+		return nil, err
+	}
+	w := &binaryWriter{
+		file: file,
+		w:    bufio.NewWriter(file),
+	}
+	var header []byte
+	header = append(header, binaryMagic...)
+	header = appendUint16(header, binaryVersion)
+	header = append(header, kind)
+	header = appendUint16(header, uint16(len(symbol)))
+	header = append(header, symbol...)
+	if err = w.write(header); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return w, nil
+}
+
+// OrderBookWriter 订单薄二进制文件写入，数据需按时间排序
+type OrderBookWriter struct {
+	*binaryWriter
+	times, askN, bidN      []byte
+	askP, askA, bidP, bidA []byte
+}
+
+func (w *OrderBookWriter) Write(ob *OrderBook) (err error) {
+	t := ob.Time.UnixNano() / int64(time.Millisecond)
+	if err = w.addTime(t); err != nil {
+		return
+	}
+	w.times = appendUint64(w.times, uint64(t))
+	w.askN = appendUint16(w.askN, uint16(len(ob.Asks)))
+	w.bidN = appendUint16(w.bidN, uint16(len(ob.Bids)))
+	for _, v := range ob.Asks {
+		w.askP = appendFloat64(w.askP, v.Price)
+		w.askA = appendFloat64(w.askA, v.Amount)
+	}
+	for _, v := range ob.Bids {
+		w.bidP = appendFloat64(w.bidP, v.Price)
+		w.bidA = appendFloat64(w.bidA, v.Amount)
+	}
+	if w.count >= binaryBlockSize {
+		err = w.flush()
+	}
+	return
+}
+
+func (w *OrderBookWriter) flush() (err error) {
+	err = w.writeBlock(w.times, w.askN, w.bidN, w.askP, w.askA, w.bidP, w.bidA)
+	w.times, w.askN, w.bidN = w.times[:0], w.askN[:0], w.bidN[:0]
+	w.askP, w.askA, w.bidP, w.bidA = w.askP[:0], w.askA[:0], w.bidP[:0], w.bidA[:0]
+	return
+}
+
+// Close 写入剩余数据和索引
+func (w *OrderBookWriter) Close() (err error) {
+	if err = w.flush(); err != nil {
+		w.file.Close()
+		return
+	}
+	return w.close()
+}
+
+// TradeWriter 成交记录二进制文件写入，数据需按时间排序
+type TradeWriter struct {
+	*binaryWriter
+	times, directions, prices, amounts, idN, ids []byte
+}
+
+func (w *TradeWriter) Write(trade *Trade) (err error) {
+	if err = w.addTime(trade.Ts); err != nil {
+		return
+	}
+	w.times = appendUint64(w.times, uint64(trade.Ts))
+	w.directions = append(w.directions, uint8(trade.Direction))
+	w.prices = appendFloat64(w.prices, trade.Price)
+	w.amounts = appendFloat64(w.amounts, trade.Amount)
+	w.idN = appendUint16(w.idN, uint16(len(trade.ID)))
+	w.ids = append(w.ids, trade.ID...)
+	if w.count >= binaryBlockSize {
+		err = w.flush()
+	}
+	return
+}
+
+func (w *TradeWriter) flush() (err error) {
+	err = w.writeBlock(w.times, w.directions, w.prices, w.amounts, w.idN, w.ids)
+	w.times, w.directions, w.prices = w.times[:0], w.directions[:0], w.prices[:0]
+	w.amounts, w.idN, w.ids = w.amounts[:0], w.idN[:0], w.ids[:0]
+	return
+}
+
+// Close 写入剩余数据和索引
+func (w *TradeWriter) Close() (err error) {
+	if err = w.flush(); err != nil {
+		w.file.Close()
+		return
+	}
+	return w.close()
+}
+
+// 二进制数据文件读取
+type binaryFile struct {
+	file   *os.File
+	kind   uint8
+	symbol string
+	index  []blockIndex
+}
+
+// 第一个结束时间不早于 start 的数据块
+func (f *binaryFile) seek(start int64) int {
+	return sort.Search(len(f.index), func(i int) bool {
+		return f.index[i].end >= start
+	})
+}
+
+func (f *binaryFile) readBlock(i int) (count int, r *binaryReader, err error) {
+	header := make([]byte, binaryBlockHeaderSize)
+	if _, err = f.file.ReadAt(header, f.index[i].offset); err != nil {
+		return
+	}
+	count = int(binary.LittleEndian.Uint32(header))
+	data := make([]byte, binary.LittleEndian.Uint32(header[4:]))
+	if _, err = f.file.ReadAt(data, f.index[i].offset+binaryBlockHeaderSize); err != nil {
+		return
+	}
+	r = &binaryReader{b: data}
+	return
+}
+
+func (f *binaryFile) Close() error {
+	return f.file.Close()
+}
+
+func openBinaryFile(filename string, kind uint8) (result *binaryFile, err error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			file.Close()
+		}
+	}()
+	f := &binaryFile{file: file}
+
+	header := make([]byte, 9)
+	if _, err = io.ReadFull(file, header); err != nil {
+		return
+	}
+	if string(header[:4]) != binaryMagic || binary.LittleEndian.Uint16(header[4:]) != binaryVersion {
+		err = ErrInvalidBinaryFile
+		return
+	}
+	f.kind = header[6]
+	if f.kind != kind {
+		err = ErrInvalidBinaryFile
+		return
+	}
+	symbol := make([]byte, binary.LittleEndian.Uint16(header[7:]))
+	if _, err = io.ReadFull(file, symbol); err != nil {
+		return
+	}
+	f.symbol = string(symbol)
+
+	stat, err := file.Stat()
+	if err != nil {
+		return
+	}
+	footer := make([]byte, binaryFooterSize)
+	if _, err = file.ReadAt(footer, stat.Size()-binaryFooterSize); err != nil {
+		return
+	}
+	if string(footer[12:]) != binaryMagic {
+		err = ErrInvalidBinaryFile
+		return
+	}
+	indexOffset := int64(binary.LittleEndian.Uint64(footer))
+	n := int(binary.LittleEndian.Uint32(footer[8:]))
+	data := make([]byte, n*binaryIndexSize)
+	if _, err = file.ReadAt(data, indexOffset); err != nil {
+		return
+	}
+	r := &binaryReader{b: data}
+	for i := 0; i < n; i++ {
+		f.index = append(f.index, blockIndex{
+			offset: r.int64(),
+			start:  r.int64(),
+			end:    r.int64(),
+		})
+	}
+	result = f
+	return
+}
+
+// 数据块读取，越界时 err 为 ErrInvalidBinaryFile
+type binaryReader struct {
+	b   []byte
+	pos int
+	err error
+}
+
+func (r *binaryReader) next(n int) []byte {
+	if r.err != nil || r.pos+n > len(r.b) {
+		r.err = ErrInvalidBinaryFile
+		return make([]byte, n)
+	}
+	b := r.b[r.pos : r.pos+n]
+	r.pos += n
+	return b
+}
+
+func (r *binaryReader) int64() int64 {
+	return int64(binary.LittleEndian.Uint64(r.next(8)))
+}
+
+func (r *binaryReader) uint16() uint16 {
+	return binary.LittleEndian.Uint16(r.next(2))
+}
+
+func (r *binaryReader) uint8() uint8 {
+	return r.next(1)[0]
+}
+
+func (r *binaryReader) float64() float64 {
+	return math.Float64frombits(binary.LittleEndian.Uint64(r.next(8)))
+}
+
+func (r *binaryReader) string(n int) string {
+	return string(r.next(n))
+}
+
+func appendUint16(b []byte, v uint16) []byte {
+	return append(b, byte(v), byte(v>>8))
+}
+
+func appendUint32(b []byte, v uint32) []byte {
+	return append(b, byte(v), byte(v>>8), byte(v>>16), byte(v>>24))
+}
+
+func appendUint64(b []byte, v uint64) []byte {
+	return append(b, byte(v), byte(v>>8), byte(v>>16), byte(v>>24),
+		byte(v>>32), byte(v>>40), byte(v>>48), byte(v>>56))
+}
+
+func appendFloat64(b []byte, v float64) []byte {
+	return appendUint64(b, math.Float64bits(v))
+}
+
+// NewOrderBookWriter 创建订单薄二进制文件
+func NewOrderBookWriter(filename string, symbol string) (*OrderBookWriter, error) {
+	w, err := newBinaryWriter(filename, binaryKindOrderBook, symbol)
+	if err != nil {
+		return nil, err
+	}
+	return &OrderBookWriter{binaryWriter: w}, nil
+}
+
+// NewTradeWriter 创建成交记录二进制文件
+func NewTradeWriter(filename string, symbol string) (*TradeWriter, error) {
+	w, err := newBinaryWriter(filename, binaryKindTrade, symbol)
+	if err != nil {
+		return nil, err
+	}
+	return &TradeWriter{binaryWriter: w}, nil
+}
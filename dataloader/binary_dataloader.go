@@ -0,0 +1,273 @@
+package dataloader
+
+import (
+	. "github.com/coinrust/crex"
+	"log"
+	"time"
+)
+
+// 按数据块加载二进制文件
+type binaryLoader struct {
+	filename    string
+	kind        uint8
+	file        *binaryFile
+	block       int // 下一个数据块
+	hasMoreData bool
+	start       int64
+	end         int64
+}
+
+func (l *binaryLoader) setup(start time.Time, end time.Time) (err error) {
+	l.start = start.UnixNano() / int64(time.Millisecond)
+	l.end = end.UnixNano() / int64(time.Millisecond)
+	if l.file != nil {
+		l.file.Close()
+		l.file = nil
+	}
+	l.hasMoreData = false
+	l.file, err = openBinaryFile(l.filename, l.kind)
+	if err != nil {
+		return
+	}
+	l.block = l.file.seek(l.start)
+	l.hasMoreData = true
+	return
+}
+
+// 读取下一个时间区间内的数据块
+func (l *binaryLoader) next() (count int, r *binaryReader, ok bool) {
+	if !l.hasMoreData {
+		return
+	}
+	if l.block >= len(l.file.index) || l.file.index[l.block].start > l.end {
+		l.close()
+		return
+	}
+	count, r, err := l.file.readBlock(l.block)
+	if err != nil {
+		log.Printf("read block %v error: %v", l.block, err)
+		l.close()
+		return
+	}
+	l.block++
+	ok = true
+	return
+}
+
+func (l *binaryLoader) HasMoreData() bool {
+	return l.hasMoreData
+}
+
+func (l *binaryLoader) close() {
+	l.file.Close()
+	l.hasMoreData = false
+}
+
+// BinaryDataLoader 从二进制文件加载订单薄
+type BinaryDataLoader struct {
+	binaryLoader
+}
+
+func (l *BinaryDataLoader) Setup(start time.Time, end time.Time) error {
+	return l.setup(start, end)
+}
+
+func (l *BinaryDataLoader) ReadOrderBooks() (result []*OrderBook) {
+	for len(result) == 0 {
+		count, r, ok := l.next()
+		if !ok {
+			return
+		}
+		result = l.decode(count, r)
+	}
+	return
+}
+
+func (l *BinaryDataLoader) decode(count int, r *binaryReader) (result []*OrderBook) {
+	times := make([]int64, count)
+	for i := range times {
+		times[i] = r.int64()
+	}
+	askN := make([]int, count)
+	bidN := make([]int, count)
+	var asks, bids int
+	for i := range askN {
+		askN[i] = int(r.uint16())
+		asks += askN[i]
+	}
+	for i := range bidN {
+		bidN[i] = int(r.uint16())
+		bids += bidN[i]
+	}
+	askItems := make([]Item, asks)
+	bidItems := make([]Item, bids)
+	for i := range askItems {
+		askItems[i].Price = r.float64()
+	}
+	for i := range askItems {
+		askItems[i].Amount = r.float64()
+	}
+	for i := range bidItems {
+		bidItems[i].Price = r.float64()
+	}
+	for i := range bidItems {
+		bidItems[i].Amount = r.float64()
+	}
+	if r.err != nil {
+		log.Printf("decode block error: %v", r.err)
+		l.close()
+		return
+	}
+
+	obs := make([]OrderBook, count)
+	for i, t := range times {
+		ob := &obs[i]
+		ob.Asks, askItems = askItems[:askN[i]:askN[i]], askItems[askN[i]:]
+		ob.Bids, bidItems = bidItems[:bidN[i]:bidN[i]], bidItems[bidN[i]:]
+		if t < l.start {
+			continue
+		}
+		if t > l.end {
+			l.close()
+			return
+		}
+		ob.Symbol = l.file.symbol
+		ob.Time = time.Unix(0, t*int64(time.Millisecond))
+		result = append(result, ob)
+	}
+	return
+}
+
+func (l *BinaryDataLoader) ReadRecords(limit int) []*Record {
+	return nil
+}
+
+// BinaryTradeLoader 从二进制文件加载成交记录
+type BinaryTradeLoader struct {
+	binaryLoader
+}
+
+func (l *BinaryTradeLoader) Setup(start time.Time, end time.Time) error {
+	return l.setup(start, end)
+}
+
+func (l *BinaryTradeLoader) ReadTrades() (result []*Trade) {
+	for len(result) == 0 {
+		count, r, ok := l.next()
+		if !ok {
+			return
+		}
+		result = l.decode(count, r)
+	}
+	return
+}
+
+func (l *BinaryTradeLoader) decode(count int, r *binaryReader) (result []*Trade) {
+	trades := make([]Trade, count)
+	for i := range trades {
+		trades[i].Ts = r.int64()
+	}
+	for i := range trades {
+		trades[i].Direction = Direction(r.uint8())
+	}
+	for i := range trades {
+		trades[i].Price = r.float64()
+	}
+	for i := range trades {
+		trades[i].Amount = r.float64()
+	}
+	idN := make([]int, count)
+	for i := range idN {
+		idN[i] = int(r.uint16())
+	}
+	for i := range trades {
+		trades[i].ID = r.string(idN[i])
+	}
+	if r.err != nil {
+		log.Printf("decode block error: %v", r.err)
+		l.close()
+		return
+	}
+
+	for i := range trades {
+		trade := &trades[i]
+		if trade.Ts < l.start {
+			continue
+		}
+		if trade.Ts > l.end {
+			l.close()
+			return
+		}
+		trade.Symbol = l.file.symbol
+		result = append(result, trade)
+	}
+	return
+}
+
+// ConvertOrderBooks 将订单薄数据(CSV/MongoDB 等)转换为二进制文件
+func ConvertOrderBooks(loader DataLoader, start time.Time, end time.Time, filename string, symbol string) (n int, err error) {
+	if err = loader.Setup(start, end); err != nil {
+		return
+	}
+	w, err := NewOrderBookWriter(filename, symbol)
+	if err != nil {
+		return
+	}
+	for loader.HasMoreData() {
+		for _, ob := range loader.ReadOrderBooks() {
+			if err = w.Write(ob); err != nil {
+				w.Close()
+				return
+			}
+			n++
+		}
+	}
+	err = w.Close()
+	return
+}
+
+// ConvertTrades 将成交记录(CSV/MongoDB 等)转换为二进制文件
+func ConvertTrades(loader TradeLoader, start time.Time, end time.Time, filename string, symbol string) (n int, err error) {
+	if err = loader.Setup(start, end); err != nil {
+		return
+	}
+	w, err := NewTradeWriter(filename, symbol)
+	if err != nil {
+		return
+	}
+	for loader.HasMoreData() {
+		for _, trade := range loader.ReadTrades() {
+			if err = w.Write(trade); err != nil {
+				w.Close()
+				return
+			}
+			n++
+		}
+	}
+	err = w.Close()
+	return
+}
+
+// NewBinaryDataLoader 创建二进制订单薄加载器
+func NewBinaryDataLoader(filename string) *BinaryDataLoader {
+	return &BinaryDataLoader{
+		binaryLoader: binaryLoader{
+			filename: filename,
+			kind:     binaryKindOrderBook,
+		},
+	}
+}
+
+func NewBinaryData(filename string) *Data {
+	return NewData(NewBinaryDataLoader(filename))
+}
+
+// NewBinaryTradeLoader 创建二进制成交记录加载器
+func NewBinaryTradeLoader(filename string) *BinaryTradeLoader {
+	return &BinaryTradeLoader{
+		binaryLoader: binaryLoader{
+			filename: filename,
+			kind:     binaryKindTrade,
+		},
+	}
+}
@@ -0,0 +1,140 @@
+package dataloader
+
+import (
+	. "github.com/coinrust/crex"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestBinaryDataLoader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "binary")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	// 超过一个数据块，每秒一个订单薄
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	n := binaryBlockSize*2 + 100
+	filename := filepath.Join(dir, "orderbook.bin")
+	w, err := NewOrderBookWriter(filename, "BTC-PERPETUAL")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	for i := 0; i < n; i++ {
+		price := 8000 + float64(i)
+		w.Write(&OrderBook{
+			Time: start.Add(time.Duration(i) * time.Second),
+			Asks: []Item{{Price: price + 0.5, Amount: 10}, {Price: price + 1, Amount: 20}},
+			Bids: []Item{{Price: price, Amount: float64(i)}},
+		})
+	}
+	assert.Equal(t, ErrUnsortedData, w.Write(&OrderBook{Time: start}))
+	if err = w.Close(); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// 直接定位到第二个数据块
+	loader := NewBinaryDataLoader(filename)
+	from := start.Add(time.Duration(binaryBlockSize+10) * time.Second)
+	if err = loader.Setup(from, from.Add(time.Minute)); err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, 1, loader.block)
+	obs := loader.ReadOrderBooks()
+	assert.False(t, loader.HasMoreData())
+	if assert.Equal(t, 61, len(obs)) {
+		ob := obs[0]
+		assert.Equal(t, "BTC-PERPETUAL", ob.Symbol)
+		assert.Equal(t, from, ob.Time.UTC())
+		price := 8000 + float64(binaryBlockSize+10)
+		assert.Equal(t, []Item{{Price: price + 0.5, Amount: 10}, {Price: price + 1, Amount: 20}}, ob.Asks)
+		assert.Equal(t, []Item{{Price: price, Amount: float64(binaryBlockSize + 10)}}, ob.Bids)
+	}
+
+	// 跨数据块读取全部
+	data := NewBinaryData(filename)
+	data.Reset(start, start.Add(time.Duration(n)*time.Second))
+	count := 0
+	for data.Next() {
+		count++
+	}
+	assert.Equal(t, n, count+1)
+
+	_, err = openBinaryFile(filename, binaryKindTrade)
+	assert.Equal(t, ErrInvalidBinaryFile, err)
+}
+
+func TestBinaryTradeLoader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "binary")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	csvFilename, err := writeTestTrades(dir)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	start := time.Unix(1569888000, 0)
+	filename := filepath.Join(dir, "trades.bin")
+	n, err := ConvertTrades(NewCsvTradeLoader(csvFilename, "BTC-PERPETUAL"), time.Unix(0, 0), start.Add(time.Hour), filename, "BTC-PERPETUAL")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, 6, n)
+
+	loader := NewBinaryTradeLoader(filename)
+	if err = loader.Setup(start, start.Add(5*time.Second)); err != nil {
+		t.Error(err)
+		return
+	}
+	trades := loader.ReadTrades()
+	if assert.Equal(t, 4, len(trades)) {
+		assert.Equal(t, Trade{ID: "2", Direction: Buy, Price: 100.5, Amount: 20, Ts: 1569888000500, Symbol: "BTC-PERPETUAL"}, *trades[0])
+		assert.Equal(t, "5", trades[3].ID)
+		assert.Equal(t, Sell, trades[2].Direction)
+	}
+	assert.Equal(t, 0, len(loader.ReadTrades()))
+	assert.False(t, loader.HasMoreData())
+}
+
+func TestConvertOrderBooks(t *testing.T) {
+	dir, err := ioutil.TempDir("", "binary")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	csvFilename := "../data-samples/deribit/deribit_BTC-PERPETUAL_and_futures_tick_by_tick_book_snapshots_10_levels_2019-10-01_2019-11-01.csv"
+	start := time.Unix(0, 0)
+	end := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	filename := filepath.Join(dir, "orderbook.bin")
+	n, err := ConvertOrderBooks(NewCsvDataLoader(csvFilename), start, end, filename, "")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	expected := NewCsvDataLoader(csvFilename)
+	expected.Setup(start, end)
+	loader := NewBinaryDataLoader(filename)
+	loader.Setup(start, end)
+	obs := loader.ReadOrderBooks()
+	if assert.Equal(t, n, len(obs), strconv.Itoa(n)) {
+		assert.Equal(t, expected.ReadOrderBooks(), obs)
+	}
+}
@@ -5,7 +5,6 @@ import (
 	. "github.com/coinrust/crex"
 	"io"
 	"log"
-	"os"
 	"sort"
 	"strconv"
 	"strings"
@@ -13,7 +12,7 @@ import (
 )
 
 type CsvDataLoader struct {
-	file        *os.File
+	file        io.ReadCloser
 	reader      *bufio.Reader
 	filename    string
 	hasMoreData bool
@@ -70,7 +69,7 @@ func (l *CsvDataLoader) HasMoreData() bool {
 
 func (l *CsvDataLoader) open() {
 	var err error
-	l.file, err = os.Open(l.filename)
+	l.file, err = openFile(l.filename)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -139,12 +138,12 @@ func (l *CsvDataLoader) readLine(line string) (result *OrderBook, ok bool) {
 		})
 	}
 
-	if asks[0].Price > asks[1].Price {
+	if nDepth > 1 && asks[0].Price > asks[1].Price {
 		sort.Slice(asks, func(i, j int) bool {
 			return asks[i].Price < asks[j].Price
 		})
 	}
-	if bids[0].Price < bids[1].Price {
+	if nDepth > 1 && bids[0].Price < bids[1].Price {
 		sort.Slice(bids, func(i, j int) bool {
 			return bids[i].Price > bids[j].Price
 		})
@@ -168,10 +167,5 @@ func NewCsvDataLoader(filename string) *CsvDataLoader {
 
 func NewCsvData(filename string) *Data {
 	loader := NewCsvDataLoader(filename)
-	return &Data{
-		index:      0,
-		maxIndex:   0,
-		data:       nil,
-		dataLoader: loader,
-	}
+	return NewData(loader)
 }
@@ -0,0 +1,121 @@
+package dataloader
+
+import (
+	"bufio"
+	. "github.com/coinrust/crex"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CsvTradeLoader 从 CSV 文件加载成交记录
+// 文件格式: t,id,direction,price,amount (t 为毫秒时间戳，direction 为 buy/sell)
+type CsvTradeLoader struct {
+	file        io.ReadCloser
+	reader      *bufio.Reader
+	filename    string
+	symbol      string
+	hasMoreData bool
+	start       int64
+	end         int64
+}
+
+func (l *CsvTradeLoader) Setup(start time.Time, end time.Time) error {
+	l.start = start.UnixNano() / int64(time.Millisecond)
+	l.end = end.UnixNano() / int64(time.Millisecond)
+	if l.file != nil {
+		l.file.Close()
+	}
+	var err error
+	l.file, err = openFile(l.filename)
+	if err != nil {
+		return err
+	}
+	l.reader = bufio.NewReader(l.file)
+	l.hasMoreData = true
+	return nil
+}
+
+func (l *CsvTradeLoader) ReadTrades() (result []*Trade) {
+	if !l.hasMoreData {
+		return nil
+	}
+
+	for len(result) < 10000 {
+		rawLine, _, err := l.reader.ReadLine()
+		if err != nil {
+			l.close()
+			return
+		}
+
+		trade, ok := l.readLine(strings.TrimSpace(string(rawLine)))
+		if !ok {
+			continue
+		} else if trade == nil {
+			l.close()
+			return
+		}
+		result = append(result, trade)
+	}
+	return
+}
+
+func (l *CsvTradeLoader) HasMoreData() bool {
+	return l.hasMoreData
+}
+
+func (l *CsvTradeLoader) close() {
+	l.file.Close()
+	l.hasMoreData = false
+}
+
+func (l *CsvTradeLoader) readLine(line string) (result *Trade, ok bool) {
+	ss := strings.Split(line, ",")
+	if len(ss) < 5 || ss[0] == "t" { // 忽略标题行
+		return
+	}
+
+	t, err := strconv.ParseInt(ss[0], 10, 64)
+	if err != nil {
+		return
+	}
+	direction, err := parseTradeDirection(ss[2])
+	if err != nil {
+		return
+	}
+	price, err := strconv.ParseFloat(ss[3], 64)
+	if err != nil {
+		return
+	}
+	amount, err := strconv.ParseFloat(ss[4], 64)
+	if err != nil {
+		return
+	}
+
+	if t < l.start { // filter with timestamp
+		return
+	}
+	ok = true
+	if t > l.end { // filter with timestamp
+		return
+	}
+
+	result = &Trade{
+		ID:        ss[1],
+		Direction: direction,
+		Price:     price,
+		Amount:    amount,
+		Ts:        t,
+		Symbol:    l.symbol,
+	}
+	return
+}
+
+// NewCsvTradeLoader 创建 CSV 成交记录加载器
+func NewCsvTradeLoader(filename string, symbol string) *CsvTradeLoader {
+	return &CsvTradeLoader{
+		filename: filename,
+		symbol:   symbol,
+	}
+}
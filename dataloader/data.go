@@ -13,6 +13,15 @@ type Data struct {
 	dataLoader DataLoader
 
 	relData *Data // 关联 Data
+
+	recordBuilder *RecordBuilder          // 根据订单薄生成K线
+	recordLoaders map[string]RecordLoader // key: period 原生K线加载器
+	records       map[string][]*Record    // key: period 原生K线
+
+	tradeLoader TradeLoader // 成交记录加载器
+	tradeBuf    []*Trade    // 已加载未处理的成交
+	tradeIndex  int         // tradeBuf 中下一条成交的位置
+	trades      []*Trade    // 当前步的成交，订单薄步为空
 }
 
 func (d *Data) Len() int {
@@ -29,10 +38,44 @@ func (d *Data) GetMaxIndex() int {
 
 func (d *Data) Reset(start time.Time, end time.Time) {
 	d.dataLoader.Setup(start, end)
+	d.data = nil
 	d.readMore()
 	d.index = 0
 	d.offset = 0
 	d.maxIndex = len(d.data) - 1
+	d.resetRecords(start, end)
+	d.resetTrades(start, end)
+}
+
+func (d *Data) resetRecords(start time.Time, end time.Time) {
+	if d.recordBuilder == nil {
+		d.recordBuilder = NewRecordBuilder()
+	}
+	d.recordBuilder.Reset()
+	if ob := d.GetOrderBook(); ob != nil {
+		d.recordBuilder.Update(ob)
+	}
+	d.records = make(map[string][]*Record)
+	for period, loader := range d.recordLoaders {
+		if err := loader.Setup(start, end); err != nil {
+			continue
+		}
+		var records []*Record
+		for loader.HasMoreData() {
+			records = append(records, loader.ReadRecords(0)...)
+		}
+		d.records[period] = records
+	}
+}
+
+// SetRecordBuilder 设置K线生成器，默认生成所有周期
+func (d *Data) SetRecordBuilder(builder *RecordBuilder) {
+	d.recordBuilder = builder
+}
+
+// SetRecordLoader 设置指定周期的原生K线加载器，设置后该周期不再由订单薄生成
+func (d *Data) SetRecordLoader(period string, loader RecordLoader) {
+	d.recordLoaders[period] = loader
 }
 
 func (d *Data) GetOrderBookByNS(symbol string, ns int64) *OrderBook {
@@ -77,23 +120,156 @@ func (d *Data) GetOrderBookRaw(offset int) *OrderBook {
 	return d.data[index]
 }
 
+// GetRecords 返回当前时间之前已完成的 size 根1分钟K线
 func (d *Data) GetRecords(size int) []*Record {
-	return nil
+	ob := d.GetOrderBook()
+	if ob == nil {
+		return nil
+	}
+	records, _ := d.GetRecordsByNS(PERIOD_1MIN, ob.Time.UnixNano(), 0, 0, size)
+	return records
+}
+
+// GetRecordsByNS 返回 ns 之前已完成的K线，避免回测使用未来数据
+// from/end: 开始/结束时间(秒)，0 表示不限制
+// limit: 最多返回的数量，0 表示不限制
+func (d *Data) GetRecordsByNS(period string, ns int64, from int64, end int64, limit int) (result []*Record, err error) {
+	var records []*Record
+	if _, ok := d.recordLoaders[period]; ok {
+		records, err = closedRecords(period, d.records[period], ns)
+	} else if d.recordBuilder != nil {
+		records, err = d.recordBuilder.GetRecords(period, ns)
+	}
+	if err != nil {
+		return
+	}
+	result = filterRecords(records, from, end, limit)
+	return
+}
+
+// GetTime 当前步的时间，成交步为成交时间，否则为订单薄时间
+func (d *Data) GetTime() time.Time {
+	if len(d.trades) > 0 {
+		return tradeTime(d.trades[0])
+	}
+	if ob := d.GetOrderBook(); ob != nil {
+		return ob.Time
+	}
+	return time.Time{}
+}
+
+// SetTradeLoader 设置成交记录加载器，设置后 Next 按时间顺序交替推进成交和订单薄
+func (d *Data) SetTradeLoader(loader TradeLoader) {
+	d.tradeLoader = loader
+}
+
+// GetTrades 返回当前步的成交(同一毫秒的成交合并为一步)，订单薄步返回空
+func (d *Data) GetTrades() []*Trade {
+	return d.trades
+}
+
+func (d *Data) resetTrades(start time.Time, end time.Time) {
+	d.tradeBuf = nil
+	d.tradeIndex = 0
+	d.trades = nil
+	if d.tradeLoader == nil {
+		return
+	}
+	if err := d.tradeLoader.Setup(start, end); err != nil {
+		d.tradeLoader = nil
+		return
+	}
+	// 丢弃第一个订单薄之前的成交
+	ob := d.GetOrderBook()
+	for ob != nil {
+		trade := d.peekTrade()
+		if trade == nil || tradeTime(trade).After(ob.Time) {
+			break
+		}
+		d.tradeIndex++
+	}
+}
+
+func (d *Data) peekTrade() *Trade {
+	for d.tradeIndex >= len(d.tradeBuf) {
+		if !d.tradeLoader.HasMoreData() {
+			return nil
+		}
+		d.tradeBuf = d.tradeLoader.ReadTrades()
+		d.tradeIndex = 0
+	}
+	return d.tradeBuf[d.tradeIndex]
+}
+
+// 推进一步成交，同一毫秒的成交合并
+func (d *Data) nextTrades() {
+	var trades []*Trade
+	for {
+		trade := d.peekTrade()
+		if trade == nil || (len(trades) > 0 && trade.Ts != trades[0].Ts) {
+			break
+		}
+		trades = append(trades, trade)
+		d.tradeIndex++
+		if d.recordBuilder != nil {
+			d.recordBuilder.AddVolume(tradeTime(trade), trade.Amount)
+		}
+	}
+	d.trades = trades
+}
+
+// 下一个订单薄，不移动当前位置
+func (d *Data) peekOrderBook() *OrderBook {
+	if len(d.data) == 0 {
+		return nil
+	}
+	if d.index >= d.maxIndex {
+		o, n := d.readMore()
+		if n == 0 {
+			return nil
+		}
+		d.index = o - 1
+		d.maxIndex = n - 1
+	}
+	return d.data[d.index+1]
+}
+
+func tradeTime(trade *Trade) time.Time {
+	return time.Unix(0, trade.Ts*int64(time.Millisecond))
 }
 
 func (d *Data) Next() bool {
+	d.trades = nil
+	if d.tradeLoader != nil {
+		ob := d.peekOrderBook()
+		if ob == nil {
+			return false
+		}
+		if trade := d.peekTrade(); trade != nil && !tradeTime(trade).After(ob.Time) {
+			d.nextTrades()
+			return true
+		}
+	}
 	if d.index < d.maxIndex {
 		d.index++
+		d.updateRecords()
 		return true
 	}
 	if o, n := d.readMore(); n > 0 {
 		d.index = o
 		d.maxIndex = n - 1
+		d.updateRecords()
 		return true
 	}
 	return false
 }
 
+func (d *Data) updateRecords() {
+	if d.recordBuilder != nil {
+		d.recordBuilder.Update(d.data[d.index])
+	}
+}
+
 func (d *Data) readMore() (offset int, count int) {
 	if !d.dataLoader.HasMoreData() {
 		return 0, 0
@@ -128,9 +304,10 @@ func (d *Data) SetDataRel(relData *Data) {
 
 func NewData(loader DataLoader) *Data {
 	return &Data{
-		index:      0,
-		maxIndex:   0,
-		data:       nil,
-		dataLoader: loader,
+		index:         0,
+		maxIndex:      0,
+		data:          nil,
+		dataLoader:    loader,
+		recordLoaders: make(map[string]RecordLoader),
 	}
 }
@@ -1,7 +1,12 @@
 package dataloader
 
 import (
+	"compress/gzip"
+	"errors"
 	. "github.com/coinrust/crex"
+	"io"
+	"os"
+	"strings"
 	"time"
 )
 
@@ -11,3 +16,50 @@ type DataLoader interface {
 	ReadRecords(limit int) []*Record
 	HasMoreData() bool
 }
+
+// TradeLoader 成交记录加载
+type TradeLoader interface {
+	Setup(start time.Time, end time.Time) error
+	ReadTrades() []*Trade
+	HasMoreData() bool
+}
+
+// 解析主动成交方向: buy/sell
+func parseTradeDirection(s string) (Direction, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "buy":
+		return Buy, nil
+	case "sell":
+		return Sell, nil
+	default:
+		return Buy, errors.New("invalid direction")
+	}
+}
+
+// gzip 压缩文件
+type gzipFile struct {
+	*gzip.Reader
+	file *os.File
+}
+
+func (f *gzipFile) Close() error {
+	f.Reader.Close()
+	return f.file.Close()
+}
+
+// 打开数据文件，.gz 结尾的文件自动解压(支持多个 gzip 文件直接拼接)
+func openFile(filename string) (io.ReadCloser, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasSuffix(filename, ".gz") {
+		return file, nil
+	}
+	r, err := gzip.NewReader(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return &gzipFile{Reader: r, file: file}, nil
+}
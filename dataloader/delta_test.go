@@ -0,0 +1,71 @@
+package dataloader
+
+import (
+	. "github.com/coinrust/crex"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeTestDeltas(dir string) (string, error) {
+	filename := filepath.Join(dir, "deltas.csv")
+	data := "t,action,side,price,amount\n" +
+		"1569887998000,update,ask,100,1\n" + // snapshot 之前，忽略
+		"1569887999000,snapshot,ask,101,10\n" +
+		"1569887999000,snapshot,ask,102,20\n" +
+		"1569887999000,snapshot,bid,99,30\n" +
+		"1569888000500,update,ask,101,0\n" +
+		"1569888000500,update,bid,100,5\n" +
+		"1569888001000,update,ask,103,40\n" +
+		"1569888010000,update,bid,99,0\n"
+	return filename, ioutil.WriteFile(filename, []byte(data), os.ModePerm)
+}
+
+func TestCsvDeltaDataLoader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "deltas")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	filename, err := writeTestDeltas(dir)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	start := time.Unix(1569888000, 0)
+	loader := NewCsvDeltaDataLoader(filename, "BTC-PERPETUAL", 0)
+	if err = loader.Setup(start, start.Add(5*time.Second)); err != nil {
+		t.Error(err)
+		return
+	}
+	obs := loader.ReadOrderBooks()
+	assert.False(t, loader.HasMoreData())
+	if !assert.Equal(t, 2, len(obs)) {
+		return
+	}
+
+	// 区间前的 snapshot 已应用
+	assert.Equal(t, "BTC-PERPETUAL", obs[0].Symbol)
+	assert.Equal(t, start.Add(500*time.Millisecond), obs[0].Time)
+	assert.Equal(t, []Item{{Price: 102, Amount: 20}}, obs[0].Asks)
+	assert.Equal(t, []Item{{Price: 100, Amount: 5}, {Price: 99, Amount: 30}}, obs[0].Bids)
+
+	assert.Equal(t, start.Add(time.Second), obs[1].Time)
+	assert.Equal(t, []Item{{Price: 102, Amount: 20}, {Price: 103, Amount: 40}}, obs[1].Asks)
+	assert.Equal(t, 2, len(obs[1].Bids))
+
+	// 按档数输出
+	loader = NewCsvDeltaDataLoader(filename, "BTC-PERPETUAL", 1)
+	loader.Setup(start, start.Add(time.Minute))
+	obs = loader.ReadOrderBooks()
+	if assert.Equal(t, 3, len(obs)) {
+		assert.Equal(t, []Item{{Price: 102, Amount: 20}}, obs[2].Asks)
+		assert.Equal(t, []Item{{Price: 100, Amount: 5}}, obs[2].Bids)
+	}
+}
@@ -0,0 +1,163 @@
+package dataloader
+
+import (
+	"bufio"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FundingRate 资金费率结算
+type FundingRate struct {
+	Time time.Time // 结算时间
+	Rate float64   // 费率，正数多头支付空头，负数空头支付多头
+}
+
+// FundingSchedule 资金费率结算计划
+type FundingSchedule interface {
+	// GetFundingRates 返回 (from, to] 区间内的结算
+	GetFundingRates(symbol string, from time.Time, to time.Time) []*FundingRate
+}
+
+// 按固定间隔(从 Unix 零点对齐)生成结算时间
+func fundingTimes(interval time.Duration, from time.Time, to time.Time) (result []time.Time) {
+	if interval <= 0 {
+		return
+	}
+	n := from.UnixNano()/int64(interval) + 1
+	for {
+		tm := time.Unix(0, n*int64(interval))
+		if tm.After(to) {
+			break
+		}
+		result = append(result, tm)
+		n++
+	}
+	return
+}
+
+// FixedFundingSchedule 固定费率
+type FixedFundingSchedule struct {
+	Rate     float64
+	Interval time.Duration
+}
+
+func (s *FixedFundingSchedule) GetFundingRates(symbol string, from time.Time, to time.Time) (result []*FundingRate) {
+	for _, tm := range fundingTimes(s.Interval, from, to) {
+		result = append(result, &FundingRate{Time: tm, Rate: s.Rate})
+	}
+	return
+}
+
+// NewFixedFundingSchedule 创建固定费率结算计划
+// rate: 每次结算的费率
+// interval: 结算间隔，如 8 小时
+func NewFixedFundingSchedule(rate float64, interval time.Duration) *FixedFundingSchedule {
+	return &FixedFundingSchedule{
+		Rate:     rate,
+		Interval: interval,
+	}
+}
+
+// CsvFundingSchedule 从 CSV 文件读取的历史费率
+// 文件格式: t,rate (t 为毫秒时间戳)
+type CsvFundingSchedule struct {
+	rates []*FundingRate
+}
+
+func (s *CsvFundingSchedule) GetFundingRates(symbol string, from time.Time, to time.Time) (result []*FundingRate) {
+	i := sort.Search(len(s.rates), func(i int) bool {
+		return s.rates[i].Time.After(from)
+	})
+	for ; i < len(s.rates); i++ {
+		if s.rates[i].Time.After(to) {
+			break
+		}
+		result = append(result, s.rates[i])
+	}
+	return
+}
+
+// NewCsvFundingSchedule 从 CSV 文件创建结算计划
+func NewCsvFundingSchedule(filename string) (*CsvFundingSchedule, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var rates []*FundingRate
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		ss := strings.Split(strings.TrimSpace(scanner.Text()), ",")
+		if len(ss) < 2 || ss[0] == "t" { // 忽略标题行
+			continue
+		}
+		t, err := strconv.ParseInt(ss[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		rate, err := strconv.ParseFloat(ss[1], 64)
+		if err != nil {
+			return nil, err
+		}
+		rates = append(rates, &FundingRate{
+			Time: time.Unix(0, t*int64(time.Millisecond)),
+			Rate: rate,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	sort.Slice(rates, func(i, j int) bool {
+		return rates[i].Time.Before(rates[j].Time)
+	})
+	return &CsvFundingSchedule{rates: rates}, nil
+}
+
+// PremiumFundingSchedule 根据标记价格与指数价格的溢价计算费率
+// rate = clamp((mark - index) / index, -maxRate, maxRate)
+// mark/index 需要加入回测数据中，以便随回测时间推进
+type PremiumFundingSchedule struct {
+	mark     *Data
+	index    *Data
+	interval time.Duration
+	maxRate  float64 // 费率上限，0 表示不限制
+}
+
+func (s *PremiumFundingSchedule) GetFundingRates(symbol string, from time.Time, to time.Time) (result []*FundingRate) {
+	times := fundingTimes(s.interval, from, to)
+	if len(times) == 0 {
+		return
+	}
+	mark := s.mark.GetOrderBook()
+	index := s.index.GetOrderBook()
+	if mark == nil || index == nil || index.Price() == 0 {
+		return
+	}
+	rate := (mark.Price() - index.Price()) / index.Price()
+	if s.maxRate > 0 {
+		rate = math.Max(-s.maxRate, math.Min(s.maxRate, rate))
+	}
+	for _, tm := range times {
+		result = append(result, &FundingRate{Time: tm, Rate: rate})
+	}
+	return
+}
+
+// NewPremiumFundingSchedule 创建溢价费率结算计划
+// mark: 合约(标记价格)数据
+// index: 现货(指数价格)数据
+// interval: 结算间隔
+// maxRate: 费率上限，0 表示不限制
+func NewPremiumFundingSchedule(mark *Data, index *Data, interval time.Duration, maxRate float64) *PremiumFundingSchedule {
+	return &PremiumFundingSchedule{
+		mark:     mark,
+		index:    index,
+		interval: interval,
+		maxRate:  maxRate,
+	}
+}
@@ -0,0 +1,54 @@
+package dataloader
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFixedFundingSchedule(t *testing.T) {
+	s := NewFixedFundingSchedule(0.0001, 8*time.Hour)
+	from := time.Date(2019, 10, 1, 7, 0, 0, 0, time.UTC)
+	to := time.Date(2019, 10, 2, 8, 0, 0, 0, time.UTC)
+	rates := s.GetFundingRates("", from, to)
+	if !assert.Equal(t, 4, len(rates)) {
+		return
+	}
+	assert.Equal(t, time.Date(2019, 10, 1, 8, 0, 0, 0, time.UTC), rates[0].Time.UTC())
+	assert.Equal(t, time.Date(2019, 10, 2, 8, 0, 0, 0, time.UTC), rates[3].Time.UTC())
+	assert.Equal(t, 0.0001, rates[0].Rate)
+
+	assert.Equal(t, 0, len(s.GetFundingRates("", rates[3].Time, rates[3].Time.Add(time.Hour))))
+}
+
+func TestCsvFundingSchedule(t *testing.T) {
+	dir, err := ioutil.TempDir("", "funding")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "funding.csv")
+	data := "t,rate\n1569916800000,0.0001\n1569888000000,-0.0002\n1569945600000,0.0003\n"
+	err = ioutil.WriteFile(filename, []byte(data), os.ModePerm)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	s, err := NewCsvFundingSchedule(filename)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	rates := s.GetFundingRates("", time.Unix(1569888000, 0), time.Unix(1569945600, 0))
+	if !assert.Equal(t, 2, len(rates)) {
+		return
+	}
+	assert.Equal(t, 0.0001, rates[0].Rate)
+	assert.Equal(t, 0.0003, rates[1].Rate)
+}
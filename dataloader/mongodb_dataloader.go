@@ -176,10 +176,5 @@ func NewMongoDBDataLoader(uri string, db string, exchange string, symbol string)
 
 func NewMongoDBData(uri string, db string, exchange string, symbol string) *Data {
 	loader := NewMongoDBDataLoader(uri, db, exchange, symbol)
-	return &Data{
-		index:      0,
-		maxIndex:   0,
-		data:       nil,
-		dataLoader: loader,
-	}
+	return NewData(loader)
 }
@@ -0,0 +1,117 @@
+package dataloader
+
+import (
+	"context"
+	"fmt"
+	. "github.com/coinrust/crex"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"log"
+	"time"
+)
+
+type mTrade struct {
+	Timestamp int64   `bson:"t"`
+	ID        string  `bson:"i"`
+	Direction string  `bson:"d"` // buy/sell
+	Price     float64 `bson:"p"`
+	Amount    float64 `bson:"a"`
+}
+
+// MongoDBTradeLoader 从 MongoDB 加载成交记录
+// 集合名: exchange:symbol:trades
+type MongoDBTradeLoader struct {
+	client      *mongo.Client
+	database    *mongo.Database
+	collection  *mongo.Collection
+	exchange    string
+	symbol      string
+	start       int64
+	end         int64
+	limit       int
+	ctx         context.Context
+	cur         *mongo.Cursor
+	hasMoreData bool
+}
+
+func (l *MongoDBTradeLoader) Setup(start time.Time, end time.Time) error {
+	l.start = start.UnixNano() / int64(time.Millisecond)
+	l.end = end.UnixNano() / int64(time.Millisecond)
+	if l.cur != nil {
+		l.cur.Close(l.ctx)
+	}
+
+	name := fmt.Sprintf("%v:%v:trades", l.exchange, l.symbol)
+	l.collection = l.database.Collection(name)
+	findOptions := options.Find()
+	// Sort by `t` field asc
+	findOptions.SetSort(bson.D{{Key: "t", Value: 1}})
+	filter := bson.M{"t": bson.M{"$gte": l.start, "$lte": l.end}}
+	cur, err := l.collection.Find(l.ctx, filter, findOptions)
+	if err != nil {
+		return err
+	}
+	l.cur = cur
+	l.hasMoreData = true
+	return nil
+}
+
+func (l *MongoDBTradeLoader) ReadTrades() (result []*Trade) {
+	if !l.hasMoreData {
+		return nil
+	}
+
+	for len(result) < l.limit {
+		if !l.cur.Next(l.ctx) {
+			l.hasMoreData = false
+			break
+		}
+		var v mTrade
+		if err := l.cur.Decode(&v); err != nil {
+			log.Printf("%v", err)
+			continue
+		}
+		direction, err := parseTradeDirection(v.Direction)
+		if err != nil {
+			continue
+		}
+		result = append(result, &Trade{
+			ID:        v.ID,
+			Direction: direction,
+			Price:     v.Price,
+			Amount:    v.Amount,
+			Ts:        v.Timestamp,
+			Symbol:    l.symbol,
+		})
+	}
+
+	if err := l.cur.Err(); err != nil {
+		log.Fatal(err)
+	}
+	return
+}
+
+func (l *MongoDBTradeLoader) HasMoreData() bool {
+	return l.hasMoreData
+}
+
+// NewMongoDBTradeLoader 创建 MongoDB 成交记录加载器
+func NewMongoDBTradeLoader(uri string, db string, exchange string, symbol string) *MongoDBTradeLoader {
+	clientOptions := options.Client().ApplyURI(uri)
+	// compressors: snappy/zlib/zstd
+	clientOptions = clientOptions.SetCompressors([]string{"snappy"})
+	client, err := mongo.Connect(context.TODO(), clientOptions)
+	if err != nil {
+		return nil
+	}
+
+	return &MongoDBTradeLoader{
+		client:   client,
+		database: client.Database(db),
+		exchange: exchange,
+		symbol:   symbol,
+		ctx:      context.TODO(),
+		limit:    100000,
+	}
+}
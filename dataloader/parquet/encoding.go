@@ -0,0 +1,177 @@
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"io/ioutil"
+	"math"
+	"time"
+)
+
+var errInvalidPage = errors.New("parquet: invalid page data")
+
+// 解码 PLAIN 编码的 n 个值
+func decodePlain(column *Column, data []byte, n int) (result []interface{}, err error) {
+	result = make([]interface{}, 0, n)
+	pos := 0
+	need := func(size int) bool {
+		if pos+size > len(data) {
+			err = errInvalidPage
+			return false
+		}
+		return true
+	}
+	for i := 0; i < n; i++ {
+		switch column.Type {
+		case Boolean:
+			if i/8 >= len(data) {
+				return nil, errInvalidPage
+			}
+			result = append(result, data[i/8]>>(uint(i)%8)&1 == 1)
+		case Int32:
+			if !need(4) {
+				return
+			}
+			result = append(result, int32(binary.LittleEndian.Uint32(data[pos:])))
+			pos += 4
+		case Int64:
+			if !need(8) {
+				return
+			}
+			result = append(result, int64(binary.LittleEndian.Uint64(data[pos:])))
+			pos += 8
+		case Int96:
+			// 纳秒数(8 字节) + 儒略日(4 字节)
+			if !need(12) {
+				return
+			}
+			nanos := int64(binary.LittleEndian.Uint64(data[pos:]))
+			day := int64(binary.LittleEndian.Uint32(data[pos+8:]))
+			result = append(result, time.Unix((day-2440588)*86400, nanos).UTC())
+			pos += 12
+		case Float:
+			if !need(4) {
+				return
+			}
+			result = append(result, math.Float32frombits(binary.LittleEndian.Uint32(data[pos:])))
+			pos += 4
+		case Double:
+			if !need(8) {
+				return
+			}
+			result = append(result, math.Float64frombits(binary.LittleEndian.Uint64(data[pos:])))
+			pos += 8
+		case ByteArray, FixedLenByteArray:
+			size := column.TypeLength
+			if column.Type == ByteArray {
+				if !need(4) {
+					return
+				}
+				size = int(binary.LittleEndian.Uint32(data[pos:]))
+				pos += 4
+			}
+			if !need(size) {
+				return
+			}
+			if column.UTF8 {
+				result = append(result, string(data[pos:pos+size]))
+			} else {
+				result = append(result, append([]byte(nil), data[pos:pos+size]...))
+			}
+			pos += size
+		default:
+			return nil, ErrUnsupported
+		}
+	}
+	return
+}
+
+// PLAIN 编码
+func appendPlain(b []byte, column *Column, v interface{}) ([]byte, error) {
+	switch column.Type {
+	case Int32:
+		switch x := v.(type) {
+		case int32:
+			return appendUint32(b, uint32(x)), nil
+		case int:
+			return appendUint32(b, uint32(x)), nil
+		}
+	case Int64:
+		switch x := v.(type) {
+		case int64:
+			return appendUint64(b, uint64(x)), nil
+		case int:
+			return appendUint64(b, uint64(x)), nil
+		case time.Time:
+			if column.TimeUnit > 0 {
+				return appendUint64(b, uint64(x.UnixNano()/int64(column.TimeUnit))), nil
+			}
+		}
+	case Float:
+		if x, ok := v.(float32); ok {
+			return appendUint32(b, math.Float32bits(x)), nil
+		}
+	case Double:
+		if x, ok := v.(float64); ok {
+			return appendUint64(b, math.Float64bits(x)), nil
+		}
+	case ByteArray:
+		switch x := v.(type) {
+		case string:
+			b = appendUint32(b, uint32(len(x)))
+			return append(b, x...), nil
+		case []byte:
+			b = appendUint32(b, uint32(len(x)))
+			return append(b, x...), nil
+		}
+	}
+	return nil, ErrInvalidValue
+}
+
+func appendUint32(b []byte, v uint32) []byte {
+	return append(b, byte(v), byte(v>>8), byte(v>>16), byte(v>>24))
+}
+
+func appendUint64(b []byte, v uint64) []byte {
+	return append(b, byte(v), byte(v>>8), byte(v>>16), byte(v>>24),
+		byte(v>>32), byte(v>>40), byte(v>>48), byte(v>>56))
+}
+
+// 解压数据页，解压后的长度需等于页头中的长度
+func decompress(codec int, data []byte, size int) (result []byte, err error) {
+	switch codec {
+	case codecUncompressed:
+		result = data
+	case codecGzip:
+		var r *gzip.Reader
+		if r, err = gzip.NewReader(bytes.NewReader(data)); err != nil {
+			return
+		}
+		defer r.Close()
+		if result, err = ioutil.ReadAll(r); err != nil {
+			return
+		}
+	default:
+		return nil, ErrUnsupported
+	}
+	if len(result) != size {
+		return nil, errInvalidPage
+	}
+	return
+}
+
+// GZIP 压缩数据页
+func compress(data []byte) (result []byte, err error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err = w.Write(data); err != nil {
+		return
+	}
+	if err = w.Close(); err != nil {
+		return
+	}
+	result = buf.Bytes()
+	return
+}
@@ -0,0 +1,186 @@
+package parquet
+
+import (
+	"time"
+)
+
+// Type 物理类型
+type Type int
+
+const (
+	Boolean           Type = 0
+	Int32             Type = 1
+	Int64             Type = 2
+	Int96             Type = 3
+	Float             Type = 4
+	Double            Type = 5
+	ByteArray         Type = 6
+	FixedLenByteArray Type = 7
+)
+
+// 压缩方式
+const (
+	codecUncompressed = 0
+	codecSnappy       = 1
+	codecGzip         = 2
+	codecZstd         = 6
+)
+
+// 编码方式
+const (
+	encodingPlain         = 0
+	encodingRLE           = 3
+	encodingRLEDictionary = 8
+)
+
+// 页类型
+const (
+	pageData       = 0
+	pageDictionary = 2
+	pageDataV2     = 3
+)
+
+const (
+	repetitionRequired = 0
+	repetitionOptional = 1
+	repetitionRepeated = 2
+
+	convertedUTF8            = 0
+	convertedTimestampMillis = 9
+	convertedTimestampMicros = 10
+)
+
+// Column 列定义，只支持非嵌套的 REQUIRED 列
+type Column struct {
+	Name       string
+	Type       Type
+	TimeUnit   time.Duration // 时间戳列的单位，非时间戳列为 0
+	UTF8       bool          // 字符串列
+	TypeLength int           // FixedLenByteArray 的长度
+}
+
+type columnChunk struct {
+	codec           int
+	numValues       int64
+	dataPageOffset  int64
+	totalCompressed int64
+}
+
+type rowGroup struct {
+	numRows int64
+	columns []columnChunk
+}
+
+type fileMetaData struct {
+	numRows   int64
+	columns   []Column
+	rowGroups []rowGroup
+}
+
+// 解析 FileMetaData，只支持扁平的 schema 和 REQUIRED 列
+func parseFileMetaData(s tStructValue) (result *fileMetaData, err error) {
+	result = &fileMetaData{numRows: s.int64(3)}
+	schema := s.list(2)
+	if len(schema) == 0 {
+		return nil, ErrUnsupported
+	}
+	for _, v := range schema[1:] {
+		e, ok := v.(tStructValue)
+		if !ok {
+			return nil, errInvalidThrift
+		}
+		if e.int64(5) > 0 || e.int64(3) != repetitionRequired {
+			return nil, ErrUnsupported // 嵌套、可选或重复的列
+		}
+		column := Column{
+			Name:       e.string(4),
+			Type:       Type(e.int64(1)),
+			TypeLength: int(e.int64(2)),
+		}
+		if e.has(6) {
+			switch e.int64(6) {
+			case convertedUTF8:
+				column.UTF8 = true
+			case convertedTimestampMillis:
+				column.TimeUnit = time.Millisecond
+			case convertedTimestampMicros:
+				column.TimeUnit = time.Microsecond
+			}
+		}
+		// LogicalType: 1-STRING 8-TIMESTAMP{2: unit{1-MILLIS 2-MICROS 3-NANOS}}
+		if logical := e.structValue(10); logical != nil {
+			if logical.has(1) {
+				column.UTF8 = true
+			}
+			if ts := logical.structValue(8); ts != nil {
+				unit := ts.structValue(2)
+				switch {
+				case unit.has(1):
+					column.TimeUnit = time.Millisecond
+				case unit.has(2):
+					column.TimeUnit = time.Microsecond
+				case unit.has(3):
+					column.TimeUnit = time.Nanosecond
+				}
+			}
+		}
+		result.columns = append(result.columns, column)
+	}
+
+	for _, v := range s.list(4) {
+		rg, ok := v.(tStructValue)
+		if !ok {
+			return nil, errInvalidThrift
+		}
+		group := rowGroup{numRows: rg.int64(3)}
+		chunks := rg.list(1)
+		if len(chunks) != len(result.columns) {
+			return nil, errInvalidThrift
+		}
+		for _, c := range chunks {
+			chunk, _ := c.(tStructValue)
+			meta := chunk.structValue(3)
+			if meta == nil {
+				return nil, ErrUnsupported // 列数据在其他文件
+			}
+			if meta.int64(11) > 0 {
+				return nil, ErrUnsupported // 字典编码
+			}
+			codec := int(meta.int64(4))
+			if codec != codecUncompressed && codec != codecGzip {
+				return nil, ErrUnsupported
+			}
+			group.columns = append(group.columns, columnChunk{
+				codec:           codec,
+				numValues:       meta.int64(5),
+				totalCompressed: meta.int64(7),
+				dataPageOffset:  meta.int64(9),
+			})
+		}
+		result.rowGroups = append(result.rowGroups, group)
+	}
+	return
+}
+
+// 数据页头
+type pageHeader struct {
+	typ              int
+	uncompressedSize int
+	compressedSize   int
+	numValues        int
+	encoding         int
+}
+
+func parsePageHeader(s tStructValue) (result *pageHeader) {
+	result = &pageHeader{
+		typ:              int(s.int64(1)),
+		uncompressedSize: int(s.int64(2)),
+		compressedSize:   int(s.int64(3)),
+	}
+	if result.typ == pageData {
+		h := s.structValue(5)
+		result.numValues = int(h.int64(1))
+		result.encoding = int(h.int64(2))
+	}
+	return
+}
@@ -0,0 +1,265 @@
+package parquet
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWriter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "parquet")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "test.parquet")
+	columns := []Column{
+		{Name: "t", Type: Int64, TimeUnit: time.Millisecond},
+		{Name: "price", Type: Double},
+		{Name: "symbol", Type: ByteArray, UTF8: true},
+		{Name: "n", Type: Int32},
+		{Name: "f", Type: Float},
+	}
+	_, err = NewWriter(filename, []Column{{Name: "b", Type: Boolean}})
+	assert.Equal(t, ErrUnsupported, err)
+	_, err = NewWriter(filename, []Column{{Name: "t", Type: Double, TimeUnit: time.Millisecond}})
+	assert.Equal(t, ErrUnsupported, err)
+
+	w, err := NewWriter(filename, columns)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	w.SetRowGroupSize(3)
+	start := time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 7; i++ {
+		err = w.WriteRow(start.Add(time.Duration(i)*time.Second), 100+float64(i), "BTC", i, float32(i)/2)
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, ErrInvalidValue, w.WriteRow(start, 1.0, "BTC", 1))
+	assert.Equal(t, ErrInvalidValue, w.WriteRow(nil, 1.0, "BTC", 1, float32(1)))
+	assert.Equal(t, ErrInvalidValue, w.WriteRow(start, 1, "BTC", 1, float32(1)))
+	assert.Equal(t, ErrInvalidValue, w.WriteRow(start, nil, "BTC", 1, float32(1)))
+	if err = w.Close(); err != nil {
+		t.Error(err)
+		return
+	}
+
+	r, err := Open(filename)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer r.Close()
+	assert.Equal(t, columns, r.Columns())
+	assert.Equal(t, int64(7), r.NumRows())
+	assert.Equal(t, 3, r.NumRowGroups())
+	assert.Equal(t, int64(1), r.RowGroupNumRows(2))
+	assert.Equal(t, 3, r.ColumnIndex("n"))
+	assert.Equal(t, -1, r.ColumnIndex("volume"))
+
+	ts, err := r.ReadColumn(1, 0)
+	assert.Nil(t, err)
+	ms := start.UnixNano() / int64(time.Millisecond)
+	assert.Equal(t, []interface{}{ms + 3000, ms + 4000, ms + 5000}, ts)
+	prices, err := r.ReadColumn(1, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{103.0, 104.0, 105.0}, prices)
+	symbols, err := r.ReadColumn(2, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{"BTC"}, symbols)
+	ns, err := r.ReadColumn(0, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{int32(0), int32(1), int32(2)}, ns)
+	fs, err := r.ReadColumn(0, 4)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{float32(0), float32(0.5), float32(1)}, fs)
+	_, err = r.ReadColumn(0, 5)
+	assert.Equal(t, ErrColumnNotFound, err)
+}
+
+// 测试文件的列数据
+type testChunk struct {
+	codec  int32
+	pages  [][]byte // 页头和页数据
+	schema func(t *thriftWriter)
+}
+
+func testPage(typ int32, encoding int32, numValues int32, data []byte, compressed []byte) []byte {
+	h := &thriftWriter{}
+	h.beginStruct()
+	h.fieldI32(1, typ)
+	h.fieldI32(2, int32(len(data)))
+	h.fieldI32(3, int32(len(compressed)))
+	switch typ {
+	case pageData:
+		h.fieldStruct(5, func() {
+			h.fieldI32(1, numValues)
+			h.fieldI32(2, encoding)
+			h.fieldI32(3, encodingRLE)
+			h.fieldI32(4, encodingRLE)
+		})
+	case pageDictionary:
+		h.fieldStruct(7, func() {
+			h.fieldI32(1, numValues)
+			h.fieldI32(2, encoding)
+		})
+	case pageDataV2:
+		h.fieldStruct(8, func() {
+			h.fieldI32(1, numValues)
+			h.fieldI32(2, 0)
+			h.fieldI32(3, numValues)
+			h.fieldI32(4, encoding)
+			h.fieldI32(5, 0)
+			h.fieldI32(6, 0)
+		})
+	}
+	h.endStruct()
+	return append(h.buf, compressed...)
+}
+
+// 按规范的文件结构生成只有一个 Double 列的文件
+func testFile(t *testing.T, c *testChunk, numValues int64) string {
+	file := []byte(magic)
+	offset := len(file)
+	for _, v := range c.pages {
+		file = append(file, v...)
+	}
+	size := len(file) - offset
+
+	m := &thriftWriter{}
+	m.beginStruct()
+	m.fieldI32(1, 1)
+	m.fieldList(2, tStruct, 2, func(i int) {
+		if i == 0 {
+			m.fieldString(4, "schema")
+			m.fieldI32(5, 1)
+			return
+		}
+		c.schema(m)
+	})
+	m.fieldI64(3, numValues)
+	m.fieldList(4, tStruct, 1, func(int) {
+		m.fieldList(1, tStruct, 1, func(int) {
+			m.fieldI64(2, int64(offset))
+			m.fieldStruct(3, func() {
+				m.fieldI32(1, int32(Double))
+				m.fieldI32(4, c.codec)
+				m.fieldI64(5, numValues)
+				m.fieldI64(6, int64(size))
+				m.fieldI64(7, int64(size))
+				m.fieldI64(9, int64(offset))
+				if len(c.pages) > 1 {
+					m.fieldI64(11, int64(offset)) // 第一页为字典页
+				}
+			})
+		})
+		m.fieldI64(2, int64(size))
+		m.fieldI64(3, numValues)
+	})
+	m.endStruct()
+	file = append(append(file, m.buf...), appendUint32(nil, uint32(len(m.buf)))...)
+	file = append(file, magic...)
+
+	filename := filepath.Join(t.TempDir(), "test.parquet")
+	if err := ioutil.WriteFile(filename, file, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return filename
+}
+
+func doubleSchema(repetition int32) func(t *thriftWriter) {
+	return func(t *thriftWriter) {
+		t.fieldI32(1, int32(Double))
+		t.fieldI32(3, repetition)
+		t.fieldString(4, "price")
+	}
+}
+
+func plainDoubles(values ...float64) (result []byte) {
+	col := &Column{Type: Double}
+	for _, v := range values {
+		result, _ = appendPlain(result, col, v)
+	}
+	return
+}
+
+// 未压缩的 v1 数据页，不经过 Writer 生成
+func TestReadPlainPage(t *testing.T) {
+	data := plainDoubles(1.5, 2.5, 3.5)
+	filename := testFile(t, &testChunk{
+		codec:  codecUncompressed,
+		pages:  [][]byte{testPage(pageData, encodingPlain, 3, data, data)},
+		schema: doubleSchema(repetitionRequired),
+	}, 3)
+	r, err := Open(filename)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer r.Close()
+	assert.Equal(t, []Column{{Name: "price", Type: Double}}, r.Columns())
+	values, err := r.ReadColumn(0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{1.5, 2.5, 3.5}, values)
+}
+
+func TestReadUnsupported(t *testing.T) {
+	data := plainDoubles(1.5, 2.5)
+	plain := testPage(pageData, encodingPlain, 2, data, data)
+	tests := []struct {
+		name  string
+		chunk *testChunk
+	}{
+		{"snappy", &testChunk{codec: codecSnappy, pages: [][]byte{plain}, schema: doubleSchema(repetitionRequired)}},
+		{"zstd", &testChunk{codec: codecZstd, pages: [][]byte{plain}, schema: doubleSchema(repetitionRequired)}},
+		{"optional", &testChunk{pages: [][]byte{plain}, schema: doubleSchema(repetitionOptional)}},
+		{"repeated", &testChunk{pages: [][]byte{plain}, schema: doubleSchema(repetitionRepeated)}},
+		{"nested", &testChunk{pages: [][]byte{plain}, schema: func(t *thriftWriter) {
+			t.fieldI32(3, repetitionRequired)
+			t.fieldString(4, "group")
+			t.fieldI32(5, 1)
+		}}},
+		{"dictionary", &testChunk{pages: [][]byte{
+			testPage(pageDictionary, encodingPlain, 2, data, data),
+			testPage(pageData, encodingRLEDictionary, 2, []byte{1, 2, 1}, []byte{1, 2, 1}),
+		}, schema: doubleSchema(repetitionRequired)}},
+	}
+	for _, test := range tests {
+		_, err := Open(testFile(t, test.chunk, 2))
+		assert.Equal(t, ErrUnsupported, err, test.name)
+	}
+
+	// 页级别的特性在读取列时检查
+	for name, page := range map[string][]byte{
+		"v2":                   testPage(pageDataV2, encodingPlain, 2, data, data),
+		"dictionary encoding":  testPage(pageData, encodingRLEDictionary, 2, []byte{1, 2, 1}, []byte{1, 2, 1}),
+		"dictionary page only": testPage(pageDictionary, encodingPlain, 2, data, data),
+	} {
+		r, err := Open(testFile(t, &testChunk{pages: [][]byte{page}, schema: doubleSchema(repetitionRequired)}, 2))
+		if !assert.Nil(t, err, name) {
+			continue
+		}
+		_, err = r.ReadColumn(0, 0)
+		assert.Equal(t, ErrUnsupported, err, name)
+		r.Close()
+	}
+}
+
+func TestOpenInvalidFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "parquet")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "invalid.parquet")
+	ioutil.WriteFile(filename, []byte("PAR1 not a parquet file"), 0644)
+	_, err = Open(filename)
+	assert.Equal(t, ErrInvalidFile, err)
+}
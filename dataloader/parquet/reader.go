@@ -0,0 +1,162 @@
+// Package parquet 读写扁平结构的 Parquet 文件
+// 只支持 REQUIRED 列、PLAIN 编码、v1 数据页和 UNCOMPRESSED/GZIP 压缩，
+// 字典编码、v2 数据页、其他压缩方式、可选和嵌套的列返回 ErrUnsupported
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"os"
+)
+
+const magic = "PAR1"
+
+var (
+	ErrInvalidFile    = errors.New("parquet: invalid file")
+	ErrUnsupported    = errors.New("parquet: unsupported feature")
+	ErrInvalidValue   = errors.New("parquet: invalid value for column")
+	ErrColumnNotFound = errors.New("parquet: column not found")
+)
+
+// Reader Parquet 文件读取，按行组(row group)读取列数据
+type Reader struct {
+	file *os.File
+	meta *fileMetaData
+}
+
+// Columns 列定义
+func (r *Reader) Columns() []Column {
+	return r.meta.columns
+}
+
+// ColumnIndex 按列名查找列，不存在时返回 -1
+func (r *Reader) ColumnIndex(name string) int {
+	for i, v := range r.meta.columns {
+		if v.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func (r *Reader) NumRows() int64 {
+	return r.meta.numRows
+}
+
+func (r *Reader) NumRowGroups() int {
+	return len(r.meta.rowGroups)
+}
+
+// RowGroupNumRows 行组的行数
+func (r *Reader) RowGroupNumRows(rowGroup int) int64 {
+	return r.meta.rowGroups[rowGroup].numRows
+}
+
+// ReadColumn 读取行组中的一列
+// 值类型: Boolean-bool Int32-int32 Int64-int64 Int96-time.Time Float-float32 Double-float64
+// ByteArray/FixedLenByteArray-[]byte (UTF8 列为 string)
+func (r *Reader) ReadColumn(rowGroup int, column int) (result []interface{}, err error) {
+	if column < 0 || column >= len(r.meta.columns) {
+		return nil, ErrColumnNotFound
+	}
+	col := &r.meta.columns[column]
+	chunk := r.meta.rowGroups[rowGroup].columns[column]
+	buf := make([]byte, chunk.totalCompressed)
+	if _, err = r.file.ReadAt(buf, chunk.dataPageOffset); err != nil {
+		return
+	}
+
+	result = make([]interface{}, 0, chunk.numValues)
+	pos := 0
+	for int64(len(result)) < chunk.numValues && pos < len(buf) {
+		br := bytes.NewReader(buf[pos:])
+		var h tStructValue
+		if h, err = (&thriftReader{r: br}).readStruct(); err != nil {
+			return
+		}
+		pos = len(buf) - br.Len()
+		header := parsePageHeader(h)
+		if header.compressedSize < 0 || pos+header.compressedSize > len(buf) {
+			return nil, errInvalidPage
+		}
+		page := buf[pos : pos+header.compressedSize]
+		pos += header.compressedSize
+
+		switch header.typ {
+		case pageData:
+			if header.encoding != encodingPlain {
+				return nil, ErrUnsupported
+			}
+			var data []byte
+			if data, err = decompress(chunk.codec, page, header.uncompressedSize); err != nil {
+				return
+			}
+			var values []interface{}
+			if values, err = decodePlain(col, data, header.numValues); err != nil {
+				return
+			}
+			result = append(result, values...)
+		case pageDictionary, pageDataV2:
+			return nil, ErrUnsupported
+		}
+	}
+	if int64(len(result)) != chunk.numValues {
+		return nil, errInvalidPage
+	}
+	return
+}
+
+func (r *Reader) Close() error {
+	return r.file.Close()
+}
+
+// Open 打开 Parquet 文件并读取元数据
+func Open(filename string) (result *Reader, err error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			file.Close()
+		}
+	}()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return
+	}
+	size := stat.Size()
+	tail := make([]byte, 8)
+	if size < 12 {
+		err = ErrInvalidFile
+		return
+	}
+	if _, err = file.ReadAt(tail, size-8); err != nil {
+		return
+	}
+	if string(tail[4:]) != magic {
+		err = ErrInvalidFile
+		return
+	}
+	metaSize := int64(binary.LittleEndian.Uint32(tail))
+	if metaSize > size-12 {
+		err = ErrInvalidFile
+		return
+	}
+	data := make([]byte, metaSize)
+	if _, err = file.ReadAt(data, size-8-metaSize); err != nil {
+		return
+	}
+	s, err := (&thriftReader{r: bytes.NewReader(data)}).readStruct()
+	if err != nil {
+		return
+	}
+	meta, err := parseFileMetaData(s)
+	if err != nil {
+		return
+	}
+	result = &Reader{file: file, meta: meta}
+	return
+}
@@ -0,0 +1,280 @@
+package parquet
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+)
+
+// Thrift compact protocol，用于读写 Parquet 元数据
+
+const (
+	tStop      = 0
+	tTrue      = 1
+	tFalse     = 2
+	tByte      = 3
+	tI16       = 4
+	tI32       = 5
+	tI64       = 6
+	tDouble    = 7
+	tBinary    = 8
+	tList      = 9
+	tSet       = 10
+	tMap       = 11
+	tStruct    = 12
+	maxTDepth  = 64
+	maxTLength = 1 << 28
+)
+
+var errInvalidThrift = errors.New("parquet: invalid thrift data")
+
+// 解码后的结构体，key 为字段 ID
+// 值类型: bool, int64(byte/i16/i32/i64), float64, []byte, []interface{}(list/set), tStruct, nil(map)
+type tStructValue map[int16]interface{}
+
+func (s tStructValue) int64(id int16) int64 {
+	v, _ := s[id].(int64)
+	return v
+}
+
+func (s tStructValue) has(id int16) bool {
+	_, ok := s[id]
+	return ok
+}
+
+func (s tStructValue) bool(id int16, def bool) bool {
+	if v, ok := s[id].(bool); ok {
+		return v
+	}
+	return def
+}
+
+func (s tStructValue) string(id int16) string {
+	v, _ := s[id].([]byte)
+	return string(v)
+}
+
+func (s tStructValue) structValue(id int16) tStructValue {
+	v, _ := s[id].(tStructValue)
+	return v
+}
+
+func (s tStructValue) list(id int16) []interface{} {
+	v, _ := s[id].([]interface{})
+	return v
+}
+
+type thriftReader struct {
+	r     io.ByteReader
+	depth int
+}
+
+func (t *thriftReader) readStruct() (result tStructValue, err error) {
+	t.depth++
+	defer func() { t.depth-- }()
+	if t.depth > maxTDepth {
+		return nil, errInvalidThrift
+	}
+
+	result = tStructValue{}
+	var id int16
+	for {
+		var b byte
+		if b, err = t.r.ReadByte(); err != nil {
+			return
+		}
+		typ := b & 0x0f
+		if typ == tStop {
+			return
+		}
+		if delta := b >> 4; delta != 0 {
+			id += int16(delta)
+		} else {
+			var v int64
+			if v, err = binary.ReadVarint(t.r); err != nil {
+				return
+			}
+			id = int16(v)
+		}
+		var v interface{}
+		switch typ {
+		case tTrue:
+			v = true
+		case tFalse:
+			v = false
+		default:
+			if v, err = t.readValue(typ); err != nil {
+				return
+			}
+		}
+		result[id] = v
+	}
+}
+
+func (t *thriftReader) readValue(typ byte) (result interface{}, err error) {
+	switch typ {
+	case tTrue, tFalse:
+		// list 中的 bool 为单字节
+		var b byte
+		b, err = t.r.ReadByte()
+		result = b == tTrue
+	case tByte:
+		var b byte
+		b, err = t.r.ReadByte()
+		result = int64(int8(b))
+	case tI16, tI32, tI64:
+		result, err = binary.ReadVarint(t.r)
+	case tDouble:
+		var b [8]byte
+		for i := range b {
+			if b[i], err = t.r.ReadByte(); err != nil {
+				return
+			}
+		}
+		result = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	case tBinary:
+		var n uint64
+		if n, err = binary.ReadUvarint(t.r); err != nil {
+			return
+		}
+		if n > maxTLength {
+			return nil, errInvalidThrift
+		}
+		b := make([]byte, n)
+		for i := range b {
+			if b[i], err = t.r.ReadByte(); err != nil {
+				return
+			}
+		}
+		result = b
+	case tList, tSet:
+		var b byte
+		if b, err = t.r.ReadByte(); err != nil {
+			return
+		}
+		n := uint64(b >> 4)
+		if n == 15 {
+			if n, err = binary.ReadUvarint(t.r); err != nil {
+				return
+			}
+		}
+		if n > maxTLength {
+			return nil, errInvalidThrift
+		}
+		list := make([]interface{}, 0, n)
+		for i := uint64(0); i < n; i++ {
+			var v interface{}
+			if v, err = t.readValue(b & 0x0f); err != nil {
+				return
+			}
+			list = append(list, v)
+		}
+		result = list
+	case tMap:
+		var n uint64
+		if n, err = binary.ReadUvarint(t.r); err != nil {
+			return
+		}
+		if n == 0 {
+			return
+		}
+		var b byte
+		if b, err = t.r.ReadByte(); err != nil {
+			return
+		}
+		for i := uint64(0); i < n; i++ {
+			if _, err = t.readValue(b >> 4); err != nil {
+				return
+			}
+			if _, err = t.readValue(b & 0x0f); err != nil {
+				return
+			}
+		}
+	case tStruct:
+		result, err = t.readStruct()
+	default:
+		err = errInvalidThrift
+	}
+	return
+}
+
+// thriftWriter 按字段顺序写入结构体
+type thriftWriter struct {
+	buf  []byte
+	last []int16 // 嵌套结构体的上一个字段 ID
+}
+
+func (t *thriftWriter) varint(v int64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(b[:], v)
+	t.buf = append(t.buf, b[:n]...)
+}
+
+func (t *thriftWriter) uvarint(v uint64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], v)
+	t.buf = append(t.buf, b[:n]...)
+}
+
+func (t *thriftWriter) fieldHeader(id int16, typ byte) {
+	last := &t.last[len(t.last)-1]
+	if delta := id - *last; delta > 0 && delta <= 15 {
+		t.buf = append(t.buf, byte(delta)<<4|typ)
+	} else {
+		t.buf = append(t.buf, typ)
+		t.varint(int64(id))
+	}
+	*last = id
+}
+
+func (t *thriftWriter) beginStruct() {
+	t.last = append(t.last, 0)
+}
+
+func (t *thriftWriter) endStruct() {
+	t.buf = append(t.buf, tStop)
+	t.last = t.last[:len(t.last)-1]
+}
+
+func (t *thriftWriter) fieldI32(id int16, v int32) {
+	t.fieldHeader(id, tI32)
+	t.varint(int64(v))
+}
+
+func (t *thriftWriter) fieldI64(id int16, v int64) {
+	t.fieldHeader(id, tI64)
+	t.varint(v)
+}
+
+func (t *thriftWriter) fieldString(id int16, v string) {
+	t.fieldHeader(id, tBinary)
+	t.uvarint(uint64(len(v)))
+	t.buf = append(t.buf, v...)
+}
+
+func (t *thriftWriter) fieldStruct(id int16, write func()) {
+	t.fieldHeader(id, tStruct)
+	t.beginStruct()
+	write()
+	t.endStruct()
+}
+
+func (t *thriftWriter) fieldList(id int16, typ byte, n int, write func(i int)) {
+	t.fieldHeader(id, tList)
+	if n < 15 {
+		t.buf = append(t.buf, byte(n)<<4|typ)
+	} else {
+		t.buf = append(t.buf, 0xf0|typ)
+		t.uvarint(uint64(n))
+	}
+	for i := 0; i < n; i++ {
+		if typ == tStruct {
+			t.beginStruct()
+			write(i)
+			t.endStruct()
+		} else {
+			write(i)
+		}
+	}
+}
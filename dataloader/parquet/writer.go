@@ -0,0 +1,243 @@
+package parquet
+
+import (
+	"bufio"
+	"os"
+	"time"
+)
+
+const defaultRowGroupSize = 65536
+
+// Writer Parquet 文件写入，PLAIN 编码，GZIP 压缩，每个行组一个数据页
+type Writer struct {
+	file         *os.File
+	w            *bufio.Writer
+	offset       int64
+	columns      []Column
+	rowGroupSize int
+
+	values    [][]interface{} // 未写入的列数据
+	rows      int
+	numRows   int64
+	rowGroups []writtenRowGroup
+}
+
+type writtenColumn struct {
+	offset           int64
+	numValues        int64
+	uncompressedSize int64
+	compressedSize   int64
+}
+
+type writtenRowGroup struct {
+	numRows int64
+	columns []writtenColumn
+}
+
+// SetRowGroupSize 设置每个行组的行数
+func (w *Writer) SetRowGroupSize(rows int) {
+	w.rowGroupSize = rows
+}
+
+// WriteRow 写入一行，值的顺序同列定义，不支持空值
+// 值类型: Int32-int32/int Int64-int64/int/time.Time(时间戳列) Float-float32 Double-float64 ByteArray-string/[]byte
+func (w *Writer) WriteRow(values ...interface{}) (err error) {
+	if len(values) != len(w.columns) {
+		return ErrInvalidValue
+	}
+	for i, v := range values {
+		if _, err = appendPlain(nil, &w.columns[i], v); err != nil {
+			return
+		}
+	}
+	for i, v := range values {
+		w.values[i] = append(w.values[i], v)
+	}
+	w.rows++
+	if w.rows >= w.rowGroupSize {
+		err = w.flush()
+	}
+	return
+}
+
+func (w *Writer) write(b []byte) (err error) {
+	_, err = w.w.Write(b)
+	w.offset += int64(len(b))
+	return
+}
+
+// 写入行组
+func (w *Writer) flush() (err error) {
+	if w.rows == 0 {
+		return
+	}
+	group := writtenRowGroup{numRows: int64(w.rows)}
+	for i := range w.columns {
+		var column writtenColumn
+		if column, err = w.writeColumn(&w.columns[i], w.values[i]); err != nil {
+			return
+		}
+		group.columns = append(group.columns, column)
+		w.values[i] = w.values[i][:0]
+	}
+	w.rowGroups = append(w.rowGroups, group)
+	w.numRows += int64(w.rows)
+	w.rows = 0
+	return
+}
+
+func (w *Writer) writeColumn(col *Column, values []interface{}) (result writtenColumn, err error) {
+	var data []byte
+	for _, v := range values {
+		if data, err = appendPlain(data, col, v); err != nil {
+			return
+		}
+	}
+	compressed, err := compress(data)
+	if err != nil {
+		return
+	}
+
+	t := &thriftWriter{}
+	t.beginStruct()
+	t.fieldI32(1, pageData)
+	t.fieldI32(2, int32(len(data)))
+	t.fieldI32(3, int32(len(compressed)))
+	t.fieldStruct(5, func() {
+		t.fieldI32(1, int32(len(values)))
+		t.fieldI32(2, encodingPlain)
+		t.fieldI32(3, encodingRLE)
+		t.fieldI32(4, encodingRLE)
+	})
+	t.endStruct()
+
+	result = writtenColumn{
+		offset:           w.offset,
+		numValues:        int64(len(values)),
+		uncompressedSize: int64(len(t.buf) + len(data)),
+		compressedSize:   int64(len(t.buf) + len(compressed)),
+	}
+	if err = w.write(t.buf); err != nil {
+		return
+	}
+	err = w.write(compressed)
+	return
+}
+
+// Close 写入剩余数据和文件元数据
+func (w *Writer) Close() (err error) {
+	defer w.file.Close()
+	if err = w.flush(); err != nil {
+		return
+	}
+
+	t := &thriftWriter{}
+	t.beginStruct()
+	t.fieldI32(1, 1)
+	t.fieldList(2, tStruct, len(w.columns)+1, func(i int) {
+		if i == 0 {
+			t.fieldString(4, "schema")
+			t.fieldI32(5, int32(len(w.columns)))
+			return
+		}
+		writeSchemaElement(t, &w.columns[i-1])
+	})
+	t.fieldI64(3, w.numRows)
+	t.fieldList(4, tStruct, len(w.rowGroups), func(i int) {
+		group := w.rowGroups[i]
+		var totalSize int64
+		t.fieldList(1, tStruct, len(group.columns), func(j int) {
+			c := group.columns[j]
+			col := &w.columns[j]
+			totalSize += c.uncompressedSize
+			t.fieldI64(2, c.offset)
+			t.fieldStruct(3, func() {
+				t.fieldI32(1, int32(col.Type))
+				t.fieldList(2, tI32, 2, func(k int) {
+					t.varint([]int64{encodingPlain, encodingRLE}[k])
+				})
+				t.fieldList(3, tBinary, 1, func(int) {
+					t.uvarint(uint64(len(col.Name)))
+					t.buf = append(t.buf, col.Name...)
+				})
+				t.fieldI32(4, codecGzip)
+				t.fieldI64(5, c.numValues)
+				t.fieldI64(6, c.uncompressedSize)
+				t.fieldI64(7, c.compressedSize)
+				t.fieldI64(9, c.offset)
+			})
+		})
+		t.fieldI64(2, totalSize)
+		t.fieldI64(3, group.numRows)
+	})
+	t.fieldString(6, "crex")
+	t.endStruct()
+
+	if err = w.write(t.buf); err != nil {
+		return
+	}
+	if err = w.write(append(appendUint32(nil, uint32(len(t.buf))), magic...)); err != nil {
+		return
+	}
+	return w.w.Flush()
+}
+
+func writeSchemaElement(t *thriftWriter, col *Column) {
+	t.fieldI32(1, int32(col.Type))
+	t.fieldI32(3, repetitionRequired)
+	t.fieldString(4, col.Name)
+	switch {
+	case col.UTF8:
+		t.fieldI32(6, convertedUTF8)
+		t.fieldStruct(10, func() {
+			t.fieldStruct(1, func() {})
+		})
+	case col.TimeUnit > 0:
+		unit := map[time.Duration]int16{time.Millisecond: 1, time.Microsecond: 2, time.Nanosecond: 3}[col.TimeUnit]
+		if unit == 1 {
+			t.fieldI32(6, convertedTimestampMillis)
+		} else if unit == 2 {
+			t.fieldI32(6, convertedTimestampMicros)
+		}
+		t.fieldStruct(10, func() {
+			t.fieldStruct(8, func() {
+				t.fieldHeader(1, tTrue) // isAdjustedToUTC
+				t.fieldStruct(2, func() {
+					t.fieldStruct(unit, func() {})
+				})
+			})
+		})
+	}
+}
+
+// NewWriter 创建 Parquet 文件，只支持 Int32/Int64/Float/Double/ByteArray 类型的列
+// 时间戳列为 Int64 并设置 TimeUnit(毫秒/微秒/纳秒)
+func NewWriter(filename string, columns []Column) (*Writer, error) {
+	for _, v := range columns {
+		switch v.Type {
+		case Int32, Int64, Float, Double, ByteArray:
+		default:
+			return nil, ErrUnsupported
+		}
+		if v.TimeUnit > 0 && (v.Type != Int64 ||
+			(v.TimeUnit != time.Millisecond && v.TimeUnit != time.Microsecond && v.TimeUnit != time.Nanosecond)) {
+			return nil, ErrUnsupported
+		}
+	}
+	file, err := os.Create(filename)
+	if err != nil {
+		return nil, err
+	}
+	w := &Writer{
+		file:         file,
+		w:            bufio.NewWriter(file),
+		columns:      columns,
+		rowGroupSize: defaultRowGroupSize,
+		values:       make([][]interface{}, len(columns)),
+	}
+	if err = w.write([]byte(magic)); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return w, nil
+}
@@ -0,0 +1,410 @@
+package dataloader
+
+import (
+	"fmt"
+	. "github.com/coinrust/crex"
+	"github.com/coinrust/crex/dataloader/parquet"
+	"log"
+	"math"
+	"strconv"
+	"time"
+)
+
+// ParquetColumns Parquet 文件列名映射
+type ParquetColumns struct {
+	Time     string        // 时间列
+	TimeUnit time.Duration // 整数时间列的单位，列类型为时间戳时自动识别
+
+	// 订单薄列名，%d 为档位(从 0 开始)，档数按存在的列自动识别
+	AskPrice  string
+	AskAmount string
+	BidPrice  string
+	BidAmount string
+
+	// K线列名，Volume 可不存在
+	Open   string
+	High   string
+	Low    string
+	Close  string
+	Volume string
+}
+
+// DefaultParquetColumns 默认列名，同 CSV 数据格式: t,asks[0].price,...,bids[0].price,... 和 t,open,high,low,close,volume
+func DefaultParquetColumns() ParquetColumns {
+	return ParquetColumns{
+		Time:      "t",
+		TimeUnit:  time.Millisecond,
+		AskPrice:  "asks[%d].price",
+		AskAmount: "asks[%d].amount",
+		BidPrice:  "bids[%d].price",
+		BidAmount: "bids[%d].amount",
+		Open:      "open",
+		High:      "high",
+		Low:       "low",
+		Close:     "close",
+		Volume:    "volume",
+	}
+}
+
+// ParquetDataLoader 从 Parquet 文件加载订单薄或K线，按行组读取
+// 同时实现 DataLoader 和 RecordLoader
+type ParquetDataLoader struct {
+	filename string
+	symbol   string
+	columns  ParquetColumns
+	reader   *parquet.Reader
+	rowGroup int       // 下一个行组
+	records  []*Record // 已读取未返回的K线
+	eof      bool
+	start    time.Time
+	end      time.Time
+}
+
+func (l *ParquetDataLoader) Setup(start time.Time, end time.Time) (err error) {
+	l.close()
+	l.start = start
+	l.end = end
+	l.records = nil
+	l.rowGroup = 0
+	reader, err := parquet.Open(l.filename)
+	if err != nil {
+		return
+	}
+	if reader.ColumnIndex(l.columns.Time) < 0 {
+		reader.Close()
+		return fmt.Errorf("parquet column %v not found", l.columns.Time)
+	}
+	l.reader = reader
+	l.eof = false
+	return
+}
+
+// 读取下一个包含区间内数据的行组的时间列，columns 为需要读取的其他列
+func (l *ParquetDataLoader) next(columns []int) (times []time.Time, values [][]interface{}, ok bool) {
+	for !l.eof && l.rowGroup < l.reader.NumRowGroups() {
+		rowGroup := l.rowGroup
+		l.rowGroup++
+		col := l.reader.ColumnIndex(l.columns.Time)
+		raw, err := l.reader.ReadColumn(rowGroup, col)
+		if err != nil {
+			log.Printf("read parquet column %v error: %v", l.columns.Time, err)
+			l.close()
+			return
+		}
+		unit := l.reader.Columns()[col].TimeUnit
+		if unit == 0 {
+			unit = l.columns.TimeUnit
+		}
+		times = make([]time.Time, len(raw))
+		for i, v := range raw {
+			times[i] = toTime(v, unit)
+		}
+		if len(times) == 0 || times[len(times)-1].Before(l.start) {
+			continue
+		}
+		if times[0].After(l.end) {
+			break
+		}
+
+		values = make([][]interface{}, len(columns))
+		for i, c := range columns {
+			if values[i], err = l.reader.ReadColumn(rowGroup, c); err != nil {
+				log.Printf("read parquet column %v error: %v", l.reader.Columns()[c].Name, err)
+				l.close()
+				return
+			}
+		}
+		if l.rowGroup >= l.reader.NumRowGroups() {
+			l.close()
+		}
+		ok = true
+		return
+	}
+	l.close()
+	return
+}
+
+// 订单薄列，返回 [卖价, 卖量, 买价, 买量] * 档数
+func (l *ParquetDataLoader) orderBookColumns() (result []int) {
+	for i := 0; ; i++ {
+		columns := []int{
+			l.reader.ColumnIndex(fmt.Sprintf(l.columns.AskPrice, i)),
+			l.reader.ColumnIndex(fmt.Sprintf(l.columns.AskAmount, i)),
+			l.reader.ColumnIndex(fmt.Sprintf(l.columns.BidPrice, i)),
+			l.reader.ColumnIndex(fmt.Sprintf(l.columns.BidAmount, i)),
+		}
+		for _, v := range columns {
+			if v < 0 {
+				return
+			}
+		}
+		result = append(result, columns...)
+	}
+}
+
+func (l *ParquetDataLoader) ReadOrderBooks() (result []*OrderBook) {
+	if l.eof {
+		return nil
+	}
+	columns := l.orderBookColumns()
+	if len(columns) == 0 {
+		log.Printf("parquet order book columns not found")
+		l.close()
+		return
+	}
+	depth := len(columns) / 4
+
+	for len(result) == 0 && !l.eof {
+		times, values, ok := l.next(columns)
+		if !ok {
+			return
+		}
+		for row, t := range times {
+			if t.Before(l.start) {
+				continue
+			}
+			if t.After(l.end) {
+				l.close()
+				return
+			}
+			ob := &OrderBook{
+				Symbol: l.symbol,
+				Time:   t,
+			}
+			for i := 0; i < depth; i++ {
+				// 价格为 NaN 表示该档位不存在
+				if price, ok := toFloat64(values[i*4][row]); ok && !math.IsNaN(price) {
+					amount, _ := toFloat64(values[i*4+1][row])
+					ob.Asks = append(ob.Asks, Item{Price: price, Amount: amount})
+				}
+				if price, ok := toFloat64(values[i*4+2][row]); ok && !math.IsNaN(price) {
+					amount, _ := toFloat64(values[i*4+3][row])
+					ob.Bids = append(ob.Bids, Item{Price: price, Amount: amount})
+				}
+			}
+			result = append(result, ob)
+		}
+	}
+	return
+}
+
+// ReadRecords 读取K线，limit 为 0 时返回已读取的全部K线(至少一个行组)
+func (l *ParquetDataLoader) ReadRecords(limit int) (result []*Record) {
+	for !l.eof && (len(l.records) == 0 || len(l.records) < limit) {
+		l.readRecords()
+	}
+	if limit <= 0 || limit > len(l.records) {
+		limit = len(l.records)
+	}
+	result = l.records[:limit]
+	l.records = l.records[limit:]
+	return
+}
+
+func (l *ParquetDataLoader) readRecords() {
+	names := []string{l.columns.Open, l.columns.High, l.columns.Low, l.columns.Close, l.columns.Volume}
+	columns := make([]int, 0, len(names))
+	for i, name := range names {
+		c := l.reader.ColumnIndex(name)
+		if c < 0 {
+			if i == 4 { // 无成交量列
+				break
+			}
+			log.Printf("parquet column %v not found", name)
+			l.close()
+			return
+		}
+		columns = append(columns, c)
+	}
+
+	times, values, ok := l.next(columns)
+	if !ok {
+		return
+	}
+	for row, t := range times {
+		if t.Before(l.start) {
+			continue
+		}
+		if t.After(l.end) {
+			l.close()
+			return
+		}
+		var v [5]float64
+		for i := range values {
+			v[i], _ = toFloat64(values[i][row])
+		}
+		l.records = append(l.records, &Record{
+			Symbol:    l.symbol,
+			Timestamp: t.UTC(),
+			Open:      v[0],
+			High:      v[1],
+			Low:       v[2],
+			Close:     v[3],
+			Volume:    v[4],
+		})
+	}
+}
+
+func (l *ParquetDataLoader) HasMoreData() bool {
+	return !l.eof || len(l.records) > 0
+}
+
+func (l *ParquetDataLoader) close() {
+	if l.reader != nil && !l.eof {
+		l.reader.Close()
+	}
+	l.eof = true
+}
+
+func toTime(v interface{}, unit time.Duration) time.Time {
+	switch x := v.(type) {
+	case time.Time:
+		return x
+	case int64:
+		return time.Unix(0, x*int64(unit))
+	case int32:
+		return time.Unix(0, int64(x)*int64(unit))
+	}
+	return time.Time{}
+}
+
+func toFloat64(v interface{}) (float64, bool) {
+	switch x := v.(type) {
+	case float64:
+		return x, true
+	case float32:
+		return float64(x), true
+	case int64:
+		return float64(x), true
+	case int32:
+		return float64(x), true
+	case string:
+		f, err := strconv.ParseFloat(x, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// ExportOrderBooks 将 Data 中的订单薄写入 Parquet 文件，使用默认列名，depth 为档数，不足的档位为 NaN
+func ExportOrderBooks(data *Data, start time.Time, end time.Time, filename string, depth int) (n int, err error) {
+	if depth <= 0 {
+		err = fmt.Errorf("invalid depth: %v", depth)
+		return
+	}
+	names := DefaultParquetColumns()
+	columns := []parquet.Column{{Name: names.Time, Type: parquet.Int64, TimeUnit: time.Millisecond}}
+	for _, format := range []string{names.AskPrice, names.AskAmount, names.BidPrice, names.BidAmount} {
+		for i := 0; i < depth; i++ {
+			columns = append(columns, parquet.Column{Name: fmt.Sprintf(format, i), Type: parquet.Double})
+		}
+	}
+	w, err := parquet.NewWriter(filename, columns)
+	if err != nil {
+		return
+	}
+	levels := func(values []interface{}, items []Item, price bool) {
+		for i := 0; i < depth; i++ {
+			switch {
+			case i >= len(items):
+				values[i] = math.NaN()
+			case price:
+				values[i] = items[i].Price
+			default:
+				values[i] = items[i].Amount
+			}
+		}
+	}
+	values := make([]interface{}, len(columns))
+	data.Reset(start, end)
+	for ob := data.GetOrderBook(); ob != nil; ob = data.GetOrderBook() {
+		if len(data.GetTrades()) == 0 { // 跳过成交步
+			values[0] = ob.Time
+			levels(values[1:], ob.Asks, true)
+			levels(values[1+depth:], ob.Asks, false)
+			levels(values[1+depth*2:], ob.Bids, true)
+			levels(values[1+depth*3:], ob.Bids, false)
+			if err = w.WriteRow(values...); err != nil {
+				w.Close()
+				return
+			}
+			n++
+		}
+		if !data.Next() {
+			break
+		}
+	}
+	err = w.Close()
+	return
+}
+
+// ExportRecords 根据 Data 中的订单薄生成指定周期的K线并写入 Parquet 文件，成交量来自成交记录
+func ExportRecords(data *Data, start time.Time, end time.Time, filename string, period string) (n int, err error) {
+	if _, err = PeriodStartTime(period, start); err != nil {
+		return
+	}
+	names := DefaultParquetColumns()
+	columns := []parquet.Column{{Name: names.Time, Type: parquet.Int64, TimeUnit: time.Millisecond}}
+	for _, name := range []string{names.Open, names.High, names.Low, names.Close, names.Volume} {
+		columns = append(columns, parquet.Column{Name: name, Type: parquet.Double})
+	}
+	w, err := parquet.NewWriter(filename, columns)
+	if err != nil {
+		return
+	}
+	write := func(r *Record) error {
+		n++
+		return w.WriteRow(r.Timestamp, r.Open, r.High, r.Low, r.Close, r.Volume)
+	}
+
+	builder := NewRecordBuilder(period)
+	var last *Record // 当前未完成的K线
+	data.Reset(start, end)
+	for ob := data.GetOrderBook(); ob != nil; ob = data.GetOrderBook() {
+		if trades := data.GetTrades(); len(trades) > 0 {
+			for _, trade := range trades {
+				builder.AddVolume(tradeTime(trade), trade.Amount)
+			}
+		} else {
+			builder.Update(ob)
+		}
+		if records := builder.records[period]; len(records) > 0 && records[len(records)-1] != last {
+			if last != nil {
+				if err = write(last); err != nil {
+					w.Close()
+					return
+				}
+			}
+			last = records[len(records)-1]
+		}
+		if !data.Next() {
+			break
+		}
+	}
+	if last != nil {
+		if err = write(last); err != nil {
+			w.Close()
+			return
+		}
+	}
+	err = w.Close()
+	return
+}
+
+// NewParquetDataLoader 创建 Parquet 数据加载器，使用默认列名
+func NewParquetDataLoader(filename string, symbol string) *ParquetDataLoader {
+	return NewParquetDataLoaderWithColumns(filename, symbol, DefaultParquetColumns())
+}
+
+// NewParquetDataLoaderWithColumns 创建 Parquet 数据加载器，使用自定义列名
+func NewParquetDataLoaderWithColumns(filename string, symbol string, columns ParquetColumns) *ParquetDataLoader {
+	return &ParquetDataLoader{
+		filename: filename,
+		symbol:   symbol,
+		columns:  columns,
+		eof:      true,
+	}
+}
+
+func NewParquetData(filename string, symbol string) *Data {
+	return NewData(NewParquetDataLoader(filename, symbol))
+}
@@ -0,0 +1,159 @@
+package dataloader
+
+import (
+	. "github.com/coinrust/crex"
+	"github.com/coinrust/crex/dataloader/parquet"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestExportOrderBooks(t *testing.T) {
+	dir, err := ioutil.TempDir("", "parquet")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	obs := []*OrderBook{
+		testOrderBook(start, 100),
+		testOrderBook(start.Add(time.Second), 101),
+		testOrderBook(start.Add(2*time.Second), 102),
+	}
+	obs[1].Asks = append(obs[1].Asks, Item{Price: 102, Amount: 5})
+	obs[2].Bids = nil
+	filename := filepath.Join(dir, "orderbook.parquet")
+	n, err := ExportOrderBooks(NewData(&memDataLoader{obs: obs}), start, start.Add(time.Minute), filename, 2)
+	if !assert.Nil(t, err) || !assert.Equal(t, 3, n) {
+		return
+	}
+
+	loader := NewParquetDataLoader(filename, "BTC-PERPETUAL")
+	if err = loader.Setup(start.Add(time.Second), start.Add(time.Minute)); err != nil {
+		t.Error(err)
+		return
+	}
+	result := loader.ReadOrderBooks()
+	assert.False(t, loader.HasMoreData())
+	if assert.Equal(t, 2, len(result)) {
+		for i, ob := range result {
+			assert.True(t, obs[i+1].Time.Equal(ob.Time))
+			assert.Equal(t, obs[i+1].Symbol, ob.Symbol)
+			assert.Equal(t, obs[i+1].Asks, ob.Asks)
+			assert.Equal(t, obs[i+1].Bids, ob.Bids)
+		}
+	}
+	assert.Equal(t, 0, len(loader.ReadOrderBooks()))
+}
+
+// 自定义列名，微秒时间戳，跳过开始时间之前的行组
+func TestParquetDataLoaderColumns(t *testing.T) {
+	dir, err := ioutil.TempDir("", "parquet")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "orderbook.parquet")
+	w, err := parquet.NewWriter(filename, []parquet.Column{
+		{Name: "timestamp", Type: parquet.Int64, TimeUnit: time.Microsecond},
+		{Name: "ask_px_0", Type: parquet.Double},
+		{Name: "ask_sz_0", Type: parquet.Float},
+		{Name: "bid_px_0", Type: parquet.ByteArray, UTF8: true},
+		{Name: "bid_sz_0", Type: parquet.Int64},
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	w.SetRowGroupSize(10)
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 35; i++ {
+		w.WriteRow(start.Add(time.Duration(i)*time.Second), 100.5+float64(i), float32(1.5), "100", int64(i))
+	}
+	if err = w.Close(); err != nil {
+		t.Error(err)
+		return
+	}
+
+	columns := DefaultParquetColumns()
+	columns.Time = "timestamp"
+	columns.AskPrice = "ask_px_%d"
+	columns.AskAmount = "ask_sz_%d"
+	columns.BidPrice = "bid_px_%d"
+	columns.BidAmount = "bid_sz_%d"
+	loader := NewParquetDataLoaderWithColumns(filename, "BTC-PERPETUAL", columns)
+	if err = loader.Setup(start.Add(25*time.Second), start.Add(time.Hour)); err != nil {
+		t.Error(err)
+		return
+	}
+	result := loader.ReadOrderBooks()
+	assert.Equal(t, 3, loader.rowGroup)
+	if assert.Equal(t, 5, len(result)) {
+		ob := result[0]
+		assert.True(t, start.Add(25*time.Second).Equal(ob.Time))
+		assert.Equal(t, []Item{{Price: 125.5, Amount: 1.5}}, ob.Asks)
+		assert.Equal(t, []Item{{Price: 100, Amount: 25}}, ob.Bids)
+	}
+	assert.Equal(t, 5, len(loader.ReadOrderBooks()))
+	assert.False(t, loader.HasMoreData())
+
+	loader = NewParquetDataLoader(filename, "BTC-PERPETUAL")
+	assert.NotNil(t, loader.Setup(start, start.Add(time.Hour)))
+	assert.False(t, loader.HasMoreData())
+}
+
+func TestExportRecords(t *testing.T) {
+	dir, err := ioutil.TempDir("", "parquet")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	var obs []*OrderBook
+	for i := 0; i < 10; i++ {
+		obs = append(obs, testOrderBook(start.Add(time.Duration(i)*30*time.Second), float64(100+i)))
+	}
+	filename := filepath.Join(dir, "kline.parquet")
+	_, err = ExportRecords(NewData(&memDataLoader{obs: obs}), start, start.Add(time.Hour), filename, "2min")
+	assert.NotNil(t, err)
+	n, err := ExportRecords(NewData(&memDataLoader{obs: obs}), start, start.Add(time.Hour), filename, PERIOD_1MIN)
+	if !assert.Nil(t, err) || !assert.Equal(t, 5, n) {
+		return
+	}
+
+	loader := NewParquetDataLoader(filename, "BTC-PERPETUAL")
+	if err = loader.Setup(start.Add(time.Minute), start.Add(time.Hour)); err != nil {
+		t.Error(err)
+		return
+	}
+	records := loader.ReadRecords(2)
+	if assert.Equal(t, 2, len(records)) {
+		r := records[0]
+		assert.Equal(t, "BTC-PERPETUAL", r.Symbol)
+		assert.Equal(t, start.Add(time.Minute), r.Timestamp)
+		assert.Equal(t, 102.0, r.Open)
+		assert.Equal(t, 103.0, r.High)
+		assert.Equal(t, 102.0, r.Low)
+		assert.Equal(t, 103.0, r.Close)
+	}
+	assert.True(t, loader.HasMoreData())
+	assert.Equal(t, 2, len(loader.ReadRecords(0)))
+	assert.False(t, loader.HasMoreData())
+
+	// 作为原生K线加载
+	data := NewData(&memDataLoader{obs: obs})
+	data.SetRecordLoader(PERIOD_1MIN, NewParquetDataLoader(filename, "BTC-PERPETUAL"))
+	data.Reset(start, start.Add(time.Hour))
+	records, err = data.GetRecordsByNS(PERIOD_1MIN, start.Add(time.Hour).UnixNano(), 0, 0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(records))
+}
@@ -0,0 +1,246 @@
+package dataloader
+
+import (
+	"bufio"
+	. "github.com/coinrust/crex"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RecordBuilderMaxSize 每个周期最多保留的K线数量
+const RecordBuilderMaxSize = 10000
+
+// RecordBuilder 根据订单薄中间价生成K线
+type RecordBuilder struct {
+	periods []string
+	records map[string][]*Record // key: period 最后一项为当前未完成的K线
+	maxSize int
+}
+
+// Update 使用订单薄更新K线
+func (b *RecordBuilder) Update(ob *OrderBook) {
+	price := ob.Price()
+	if price <= 0 {
+		return
+	}
+	for _, period := range b.periods {
+		start, err := PeriodStartTime(period, ob.Time)
+		if err != nil {
+			continue
+		}
+		records := b.records[period]
+		if n := len(records); n > 0 {
+			last := records[n-1]
+			if last.Timestamp.Equal(start) {
+				if price > last.High {
+					last.High = price
+				}
+				if price < last.Low {
+					last.Low = price
+				}
+				last.Close = price
+				continue
+			} else if last.Timestamp.After(start) {
+				continue
+			}
+		}
+		records = append(records, &Record{
+			Symbol:    ob.Symbol,
+			Timestamp: start,
+			Open:      price,
+			High:      price,
+			Low:       price,
+			Close:     price,
+		})
+		if len(records) > b.maxSize {
+			records = records[len(records)-b.maxSize:]
+		}
+		b.records[period] = records
+	}
+}
+
+// AddVolume 增加成交量，只更新已有的K线
+func (b *RecordBuilder) AddVolume(tm time.Time, volume float64) {
+	for _, period := range b.periods {
+		start, err := PeriodStartTime(period, tm)
+		if err != nil {
+			continue
+		}
+		records := b.records[period]
+		if n := len(records); n > 0 && records[n-1].Timestamp.Equal(start) {
+			records[n-1].Volume += volume
+		}
+	}
+}
+
+// GetRecords 返回 ns 之前已完成的K线
+func (b *RecordBuilder) GetRecords(period string, ns int64) (result []*Record, err error) {
+	return closedRecords(period, b.records[period], ns)
+}
+
+// Reset 清空K线
+func (b *RecordBuilder) Reset() {
+	b.records = make(map[string][]*Record)
+}
+
+// NewRecordBuilder 创建K线生成器，不指定周期时生成所有周期
+func NewRecordBuilder(periods ...string) *RecordBuilder {
+	if len(periods) == 0 {
+		periods = Periods
+	}
+	return &RecordBuilder{
+		periods: periods,
+		records: make(map[string][]*Record),
+		maxSize: RecordBuilderMaxSize,
+	}
+}
+
+// RecordLoader K线数据加载
+type RecordLoader interface {
+	Setup(start time.Time, end time.Time) error
+	ReadRecords(limit int) []*Record
+	HasMoreData() bool
+}
+
+// CsvRecordLoader 从 CSV 文件加载K线
+// 文件格式: t,open,high,low,close,volume (t 为K线开始时间，毫秒时间戳)，支持 .gz 压缩文件
+type CsvRecordLoader struct {
+	file        io.ReadCloser
+	reader      *bufio.Reader
+	filename    string
+	symbol      string
+	hasMoreData bool
+	start       int64
+	end         int64
+}
+
+func (l *CsvRecordLoader) Setup(start time.Time, end time.Time) error {
+	l.start = start.UnixNano() / int64(time.Millisecond)
+	l.end = end.UnixNano() / int64(time.Millisecond)
+	if l.file != nil {
+		l.file.Close()
+	}
+	var err error
+	l.file, err = openFile(l.filename)
+	if err != nil {
+		return err
+	}
+	l.reader = bufio.NewReader(l.file)
+	l.hasMoreData = true
+	return nil
+}
+
+func (l *CsvRecordLoader) ReadRecords(limit int) (result []*Record) {
+	if !l.hasMoreData {
+		return nil
+	}
+
+	for limit <= 0 || len(result) < limit {
+		rawLine, _, err := l.reader.ReadLine()
+		if err != nil {
+			l.close()
+			return
+		}
+
+		record, ok := l.readLine(strings.TrimSpace(string(rawLine)))
+		if !ok {
+			continue
+		} else if record == nil {
+			l.close()
+			return
+		}
+		result = append(result, record)
+	}
+	return
+}
+
+func (l *CsvRecordLoader) HasMoreData() bool {
+	return l.hasMoreData
+}
+
+func (l *CsvRecordLoader) close() {
+	l.file.Close()
+	l.hasMoreData = false
+}
+
+func (l *CsvRecordLoader) readLine(line string) (result *Record, ok bool) {
+	ss := strings.Split(line, ",")
+	if len(ss) < 6 || ss[0] == "t" { // 忽略标题行
+		return
+	}
+
+	var values [6]float64
+	for i := 0; i < 6; i++ {
+		v, err := strconv.ParseFloat(ss[i], 64)
+		if err != nil {
+			return
+		}
+		values[i] = v
+	}
+
+	t := int64(values[0])
+	if t < l.start { // filter with timestamp
+		return
+	}
+	ok = true
+	if t > l.end { // filter with timestamp
+		return
+	}
+
+	result = &Record{
+		Symbol:    l.symbol,
+		Timestamp: time.Unix(0, t*int64(time.Millisecond)).UTC(),
+		Open:      values[1],
+		High:      values[2],
+		Low:       values[3],
+		Close:     values[4],
+		Volume:    values[5],
+	}
+	return
+}
+
+// NewCsvRecordLoader 创建 CSV K线加载器
+func NewCsvRecordLoader(filename string, symbol string) *CsvRecordLoader {
+	return &CsvRecordLoader{
+		filename: filename,
+		symbol:   symbol,
+	}
+}
+
+// 按时间范围和数量筛选K线
+// from/end: 开始/结束时间(秒)，0 表示不限制
+func filterRecords(records []*Record, from int64, end int64, limit int) (result []*Record) {
+	for _, v := range records {
+		ts := v.Timestamp.Unix()
+		if from > 0 && ts < from {
+			continue
+		}
+		if end > 0 && ts > end {
+			break
+		}
+		result = append(result, v)
+	}
+	if limit > 0 && len(result) > limit {
+		result = result[len(result)-limit:]
+	}
+	return
+}
+
+// 返回 ns 之前已完成的K线
+func closedRecords(period string, records []*Record, ns int64) (result []*Record, err error) {
+	if _, err = PeriodStartTime(period, time.Unix(0, ns)); err != nil {
+		return
+	}
+	n := len(records)
+	for n > 0 {
+		end, _ := PeriodEndTime(period, records[n-1].Timestamp)
+		if end.UnixNano() <= ns {
+			break
+		}
+		n--
+	}
+	result = records[:n]
+	return
+}
@@ -0,0 +1,191 @@
+package dataloader
+
+import (
+	"bytes"
+	"compress/gzip"
+	. "github.com/coinrust/crex"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type memDataLoader struct {
+	obs         []*OrderBook
+	hasMoreData bool
+}
+
+func (l *memDataLoader) Setup(start time.Time, end time.Time) error {
+	l.hasMoreData = true
+	return nil
+}
+
+func (l *memDataLoader) ReadOrderBooks() []*OrderBook {
+	l.hasMoreData = false
+	return l.obs
+}
+
+func (l *memDataLoader) ReadRecords(limit int) []*Record {
+	return nil
+}
+
+func (l *memDataLoader) HasMoreData() bool {
+	return l.hasMoreData
+}
+
+func testOrderBook(tm time.Time, price float64) *OrderBook {
+	return &OrderBook{
+		Symbol: "BTC-PERPETUAL",
+		Time:   tm,
+		Asks:   []Item{{Price: price + 0.5, Amount: 10}},
+		Bids:   []Item{{Price: price - 0.5, Amount: 10}},
+	}
+}
+
+func TestRecordBuilder(t *testing.T) {
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	b := NewRecordBuilder(PERIOD_1MIN, PERIOD_5MIN)
+	prices := []float64{100, 103, 98, 101, 105, 104}
+	for i, price := range prices {
+		tm := start.Add(time.Duration(i) * 30 * time.Second)
+		b.Update(testOrderBook(tm, price))
+		b.AddVolume(tm, float64(i+1))
+	}
+
+	// 当前处于第 3 分钟，只有前两根1分钟K线已完成
+	ns := start.Add(150 * time.Second).UnixNano()
+	records, err := b.GetRecords(PERIOD_1MIN, ns)
+	if !assert.Nil(t, err) || !assert.Equal(t, 2, len(records)) {
+		return
+	}
+	r := records[0]
+	assert.Equal(t, start, r.Timestamp)
+	assert.Equal(t, 100.0, r.Open)
+	assert.Equal(t, 103.0, r.High)
+	assert.Equal(t, 100.0, r.Low)
+	assert.Equal(t, 103.0, r.Close)
+	assert.Equal(t, 3.0, r.Volume)
+	r = records[1]
+	assert.Equal(t, 98.0, r.Open)
+	assert.Equal(t, 98.0, r.Low)
+	assert.Equal(t, 101.0, r.Close)
+
+	records, err = b.GetRecords(PERIOD_5MIN, ns)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(records))
+
+	_, err = b.GetRecords("2min", ns)
+	assert.NotNil(t, err)
+}
+
+func TestDataGetRecordsByNS(t *testing.T) {
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	var obs []*OrderBook
+	for i := 0; i < 10; i++ {
+		obs = append(obs, testOrderBook(start.Add(time.Duration(i)*30*time.Second), float64(100+i)))
+	}
+	data := NewData(&memDataLoader{obs: obs})
+	data.Reset(start, start.Add(time.Hour))
+
+	ns := data.GetOrderBook().Time.UnixNano()
+	records, err := data.GetRecordsByNS(PERIOD_1MIN, ns, 0, 0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(records))
+
+	for data.Next() {
+	}
+	ns = data.GetOrderBook().Time.UnixNano()
+	records, err = data.GetRecordsByNS(PERIOD_1MIN, ns, 0, 0, 0)
+	if !assert.Nil(t, err) || !assert.Equal(t, 4, len(records)) {
+		return
+	}
+	assert.Equal(t, 107.0, records[3].Close)
+
+	records, _ = data.GetRecordsByNS(PERIOD_1MIN, ns, 0, 0, 2)
+	if assert.Equal(t, 2, len(records)) {
+		assert.Equal(t, start.Add(2*time.Minute), records[0].Timestamp)
+	}
+	records, _ = data.GetRecordsByNS(PERIOD_1MIN, ns, start.Add(time.Minute).Unix(), start.Add(2*time.Minute).Unix(), 0)
+	assert.Equal(t, 2, len(records))
+	assert.Equal(t, 4, len(data.GetRecords(10)))
+}
+
+func TestCsvRecordLoaderGzip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "records")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "records.csv.gz")
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	w.Write([]byte("t,open,high,low,close,volume\n" +
+		"1569888000000,100,110,90,105,1000\n" +
+		"1569888060000,105,106,101,102,2000\n"))
+	w.Close()
+	if err = ioutil.WriteFile(filename, buf.Bytes(), os.ModePerm); err != nil {
+		t.Error(err)
+		return
+	}
+
+	start := time.Unix(1569888000, 0)
+	loader := NewCsvRecordLoader(filename, "BTC-PERPETUAL")
+	if err = loader.Setup(start, start.Add(time.Hour)); err != nil {
+		t.Error(err)
+		return
+	}
+	records := loader.ReadRecords(0)
+	if assert.Equal(t, 2, len(records)) {
+		assert.Equal(t, 105.0, records[0].Close)
+		assert.Equal(t, 2000.0, records[1].Volume)
+	}
+}
+
+func TestCsvRecordLoader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "records")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "records.csv")
+	data := "t,open,high,low,close,volume\n" +
+		"1569888000000,100,110,90,105,1000\n" +
+		"1569888060000,105,106,101,102,2000\n" +
+		"1569888120000,102,104,100,103,3000\n"
+	err = ioutil.WriteFile(filename, []byte(data), os.ModePerm)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	start := time.Unix(1569888000, 0)
+	loader := NewCsvRecordLoader(filename, "BTC-PERPETUAL")
+	if err = loader.Setup(start, start.Add(time.Hour)); err != nil {
+		t.Error(err)
+		return
+	}
+	records := loader.ReadRecords(2)
+	if !assert.Equal(t, 2, len(records)) {
+		return
+	}
+	assert.Equal(t, "BTC-PERPETUAL", records[0].Symbol)
+	assert.Equal(t, 110.0, records[0].High)
+	assert.Equal(t, 2000.0, records[1].Volume)
+	assert.Equal(t, 1, len(loader.ReadRecords(0)))
+	assert.False(t, loader.HasMoreData())
+
+	// 原生K线同样只返回已完成的部分
+	d := NewData(&memDataLoader{obs: []*OrderBook{testOrderBook(start.Add(150*time.Second), 100)}})
+	d.SetRecordLoader(PERIOD_1MIN, loader)
+	d.Reset(start, start.Add(time.Hour))
+	records, err = d.GetRecordsByNS(PERIOD_1MIN, start.Add(150*time.Second).UnixNano(), 0, 0, 0)
+	if assert.Nil(t, err) && assert.Equal(t, 2, len(records)) {
+		assert.Equal(t, 102.0, records[1].Close)
+	}
+}
@@ -0,0 +1,101 @@
+package dataloader
+
+import (
+	. "github.com/coinrust/crex"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeTestTrades(dir string) (string, error) {
+	filename := filepath.Join(dir, "trades.csv")
+	data := "t,id,direction,price,amount\n" +
+		"1569887999000,1,buy,100,10\n" +
+		"1569888000500,2,buy,100.5,20\n" +
+		"1569888000500,3,sell,99.5,30\n" +
+		"1569888001000,4,sell,99.5,40\n" +
+		"1569888002500,5,buy,101,50\n" +
+		"1569888010000,6,buy,101,60\n"
+	return filename, ioutil.WriteFile(filename, []byte(data), os.ModePerm)
+}
+
+func TestCsvTradeLoader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "trades")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	filename, err := writeTestTrades(dir)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	start := time.Unix(1569888000, 0)
+	loader := NewCsvTradeLoader(filename, "BTC-PERPETUAL")
+	if err = loader.Setup(start, start.Add(5*time.Second)); err != nil {
+		t.Error(err)
+		return
+	}
+	trades := loader.ReadTrades()
+	if !assert.Equal(t, 4, len(trades)) {
+		return
+	}
+	assert.Equal(t, "2", trades[0].ID)
+	assert.Equal(t, Buy, trades[0].Direction)
+	assert.Equal(t, Sell, trades[1].Direction)
+	assert.Equal(t, 99.5, trades[1].Price)
+	assert.Equal(t, 30.0, trades[1].Amount)
+	assert.Equal(t, int64(1569888000500), trades[1].Ts)
+	assert.Equal(t, "BTC-PERPETUAL", trades[1].Symbol)
+	assert.False(t, loader.HasMoreData())
+}
+
+func TestDataTrades(t *testing.T) {
+	dir, err := ioutil.TempDir("", "trades")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	filename, err := writeTestTrades(dir)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	start := time.Unix(1569888000, 0)
+	var obs []*OrderBook
+	for i := 0; i < 4; i++ {
+		obs = append(obs, testOrderBook(start.Add(time.Duration(i)*time.Second), 100))
+	}
+	data := NewData(&memDataLoader{obs: obs})
+	data.SetTradeLoader(NewCsvTradeLoader(filename, "BTC-PERPETUAL"))
+	data.Reset(start, start.Add(time.Minute))
+	assert.Equal(t, start, data.GetTime())
+	assert.Equal(t, 0, len(data.GetTrades()))
+
+	// 订单薄和成交按时间交替，同一毫秒的成交合并，同一时间成交先于订单薄
+	var steps []string
+	var volume float64
+	for data.Next() {
+		if trades := data.GetTrades(); len(trades) > 0 {
+			steps = append(steps, "trade")
+			for _, v := range trades {
+				volume += v.Amount
+			}
+			assert.Equal(t, trades[0].Ts, data.GetTime().UnixNano()/int64(time.Millisecond))
+		} else {
+			steps = append(steps, "book")
+			assert.Equal(t, data.GetOrderBook().Time, data.GetTime())
+		}
+	}
+	assert.Equal(t, []string{"trade", "trade", "book", "book", "trade", "book"}, steps)
+	assert.Equal(t, 140.0, volume)
+}
@@ -0,0 +1,143 @@
+package crex
+
+import (
+	"fmt"
+	"github.com/MauriceGit/skiplist"
+	"hash/crc32"
+	"strconv"
+	"strings"
+)
+
+// 订单薄价位
+type depthItem struct {
+	Price  float64
+	Amount float64
+}
+
+func (e depthItem) ExtractKey() float64 {
+	return e.Price
+}
+
+func (e depthItem) String() string {
+	return fmt.Sprintf("%.2f", e.Price)
+}
+
+// DepthOrderBook 全深度增量订单薄，与交易所数据格式无关
+type DepthOrderBook struct {
+	symbol string
+	asks   skiplist.SkipList
+	bids   skiplist.SkipList
+}
+
+func (d *DepthOrderBook) GetSymbol() string {
+	return d.symbol
+}
+
+// Snapshot 使用全量数据重建订单薄
+func (d *DepthOrderBook) Snapshot(asks []Item, bids []Item) {
+	d.asks = skiplist.New()
+	d.bids = skiplist.New()
+	d.Update(asks, bids)
+}
+
+// Update 增量更新，数量为 0 时删除该价位
+func (d *DepthOrderBook) Update(asks []Item, bids []Item) {
+	for _, v := range asks {
+		updateDepth(&d.asks, v)
+	}
+	for _, v := range bids {
+		updateDepth(&d.bids, v)
+	}
+}
+
+func updateDepth(list *skiplist.SkipList, v Item) {
+	item := depthItem{
+		Price:  v.Price,
+		Amount: v.Amount,
+	}
+	if v.Amount == 0 {
+		list.Delete(item)
+		return
+	}
+	if elem, ok := list.Find(item); ok {
+		list.ChangeValue(elem, item)
+	} else {
+		list.Insert(item)
+	}
+}
+
+// AskDepth 卖盘价位数
+func (d *DepthOrderBook) AskDepth() int {
+	return d.asks.GetNodeCount()
+}
+
+// BidDepth 买盘价位数
+func (d *DepthOrderBook) BidDepth() int {
+	return d.bids.GetNodeCount()
+}
+
+// GetOrderBook 返回前 depth 档订单薄，depth <= 0 时返回全部价位
+func (d *DepthOrderBook) GetOrderBook(depth int) (result OrderBook) {
+	result.Symbol = d.symbol
+	result.Asks = topItems(&d.asks, depth, false)
+	result.Bids = topItems(&d.bids, depth, true)
+	return
+}
+
+// 卖盘从低到高，买盘从高到低
+func topItems(list *skiplist.SkipList, depth int, desc bool) (result []Item) {
+	var first *skiplist.SkipListElement
+	if desc {
+		first = list.GetLargestNode()
+	} else {
+		first = list.GetSmallestNode()
+	}
+	if first == nil {
+		return
+	}
+	node := first
+	for depth <= 0 || len(result) < depth {
+		item := node.GetValue().(depthItem)
+		result = append(result, Item{
+			Price:  item.Price,
+			Amount: item.Amount,
+		})
+		if desc {
+			node = list.Prev(node)
+		} else {
+			node = list.Next(node)
+		}
+		if node == first {
+			break
+		}
+	}
+	return
+}
+
+// Checksum 前 depth 档的 CRC32 校验值
+// 校验字符串为买卖盘交替的 "买一价:买一量:卖一价:卖一量:买二价:..."，一侧不足时只取另一侧(同 OKEX)
+func (d *DepthOrderBook) Checksum(depth int) uint32 {
+	ob := d.GetOrderBook(depth)
+	var fields []string
+	for i := 0; i < len(ob.Bids) || i < len(ob.Asks); i++ {
+		if i < len(ob.Bids) {
+			fields = append(fields, formatChecksumFloat(ob.Bids[i].Price), formatChecksumFloat(ob.Bids[i].Amount))
+		}
+		if i < len(ob.Asks) {
+			fields = append(fields, formatChecksumFloat(ob.Asks[i].Price), formatChecksumFloat(ob.Asks[i].Amount))
+		}
+	}
+	return crc32.ChecksumIEEE([]byte(strings.Join(fields, ":")))
+}
+
+func formatChecksumFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func NewDepthOrderBook(symbol string) *DepthOrderBook {
+	return &DepthOrderBook{
+		symbol: symbol,
+		asks:   skiplist.New(),
+		bids:   skiplist.New(),
+	}
+}
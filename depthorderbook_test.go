@@ -0,0 +1,58 @@
+package crex_test
+
+import (
+	. "github.com/coinrust/crex"
+	"github.com/stretchr/testify/assert"
+	"hash/crc32"
+	"testing"
+)
+
+func TestDepthOrderBook(t *testing.T) {
+	dob := NewDepthOrderBook("BTC-PERPETUAL")
+	dob.Snapshot([]Item{
+		{Price: 101, Amount: 10},
+		{Price: 102, Amount: 20},
+		{Price: 103, Amount: 30},
+	}, []Item{
+		{Price: 99, Amount: 15},
+		{Price: 100, Amount: 5},
+	})
+	assert.Equal(t, 3, dob.AskDepth())
+	assert.Equal(t, 2, dob.BidDepth())
+
+	ob := dob.GetOrderBook(2)
+	assert.Equal(t, "BTC-PERPETUAL", ob.Symbol)
+	assert.Equal(t, []Item{{Price: 101, Amount: 10}, {Price: 102, Amount: 20}}, ob.Asks)
+	assert.Equal(t, []Item{{Price: 100, Amount: 5}, {Price: 99, Amount: 15}}, ob.Bids)
+
+	// 修改、删除和新增价位
+	dob.Update([]Item{
+		{Price: 101, Amount: 0},
+		{Price: 102, Amount: 25},
+	}, []Item{
+		{Price: 100.5, Amount: 1},
+		{Price: 98, Amount: 0}, // 不存在的价位
+	})
+	ob = dob.GetOrderBook(0)
+	assert.Equal(t, []Item{{Price: 102, Amount: 25}, {Price: 103, Amount: 30}}, ob.Asks)
+	assert.Equal(t, []Item{{Price: 100.5, Amount: 1}, {Price: 100, Amount: 5}, {Price: 99, Amount: 15}}, ob.Bids)
+
+	// 全量数据重建
+	dob.Snapshot([]Item{{Price: 200, Amount: 1}}, nil)
+	ob = dob.GetOrderBook(10)
+	assert.Equal(t, []Item{{Price: 200, Amount: 1}}, ob.Asks)
+	assert.Equal(t, 0, len(ob.Bids))
+}
+
+func TestDepthOrderBook_Checksum(t *testing.T) {
+	dob := NewDepthOrderBook("BTC-PERPETUAL")
+	dob.Snapshot([]Item{
+		{Price: 8000.5, Amount: 10},
+		{Price: 8001, Amount: 2.5},
+	}, []Item{
+		{Price: 7999.5, Amount: 3},
+	})
+	expected := crc32.ChecksumIEEE([]byte("7999.5:3:8000.5:10:8001:2.5"))
+	assert.Equal(t, expected, dob.Checksum(0))
+	assert.Equal(t, crc32.ChecksumIEEE([]byte("7999.5:3:8000.5:10")), dob.Checksum(1))
+}
@@ -7,5 +7,17 @@ var (
 	ErrWebSocketDisabled = errors.New("websocket disabled")
 	ErrApiKeysRequired   = errors.New("api keys required")
 
-	ErrInvalidAmount = errors.New("amount is not valid")
+	ErrInvalidAmount      = errors.New("amount is not valid")
+	ErrAmountTooSmall     = errors.New("amount is too small")
+	ErrAmountTooLarge     = errors.New("amount is too large")
+	ErrInvalidStopPx      = errors.New("stop price is not valid")
+	ErrInvalidOrderOption = errors.New("order option is not valid")
+	ErrInvalidPrice       = errors.New("price is not valid")
+	ErrInvalidNotional    = errors.New("notional is too small")
+
+	ErrRiskPositionLimit      = errors.New("position limit exceeded")
+	ErrRiskOpenOrdersLimit    = errors.New("open orders limit exceeded")
+	ErrRiskOrderNotionalLimit = errors.New("order notional limit exceeded")
+	ErrRiskOrderRateLimit     = errors.New("order rate limit exceeded")
+	ErrRiskDailyLossLimit     = errors.New("daily loss limit exceeded")
 )
@@ -79,4 +79,6 @@ func main() {
 	bt.ComputeStats().PrintResult()
 	bt.Plot()
 	bt.HtmlReport()
+	bt.Report()
+	bt.SaveResults()
 }
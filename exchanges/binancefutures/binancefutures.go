@@ -157,6 +157,59 @@ func (b *BinanceFutures) GetContractID() (symbol string, err error) {
 	return b.symbol, nil
 }
 
+func (b *BinanceFutures) GetInstruments() (result []*Instrument, err error) {
+	var info *futures.ExchangeInfo
+	info, err = b.client.NewExchangeInfoService().Do(context.Background())
+	if err != nil {
+		return
+	}
+	for i := range info.Symbols {
+		v := &info.Symbols[i]
+		if v.Status != "TRADING" {
+			continue
+		}
+		instrument := &Instrument{
+			Symbol:         v.Symbol,
+			BaseCurrency:   v.BaseAsset,
+			QuoteCurrency:  v.QuoteAsset,
+			SettleCurrency: v.MarginAsset,
+			ContractValue:  1,
+		}
+		if f := v.PriceFilter(); f != nil {
+			instrument.TickSize = utils.ParseFloat64(f.TickSize)
+		}
+		if f := v.LotSizeFilter(); f != nil {
+			instrument.LotSize = utils.ParseFloat64(f.StepSize)
+			instrument.MinSize = utils.ParseFloat64(f.MinQuantity)
+			instrument.MaxSize = utils.ParseFloat64(f.MaxQuantity)
+		}
+		if f := v.MinNotionalFilter(); f != nil {
+			instrument.MinNotional = utils.ParseFloat64(f.Notional)
+		}
+		if v.ContractType != futures.ContractTypePerpetual && v.DeliveryDate > 0 {
+			instrument.ExpiryTime = time.Unix(0, v.DeliveryDate*int64(time.Millisecond))
+		}
+		result = append(result, instrument)
+	}
+	return
+}
+
+func (b *BinanceFutures) GetInstrument(symbol string) (result *Instrument, err error) {
+	var instruments []*Instrument
+	instruments, err = b.GetInstruments()
+	if err != nil {
+		return
+	}
+	for _, v := range instruments {
+		if v.Symbol == symbol {
+			result = v
+			return
+		}
+	}
+	err = fmt.Errorf("not found")
+	return
+}
+
 func (b *BinanceFutures) SetLeverRate(value float64) (err error) {
 	return
 }
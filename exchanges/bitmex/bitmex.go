@@ -1,9 +1,11 @@
 package bitmex
 
 import (
+	"fmt"
 	. "github.com/coinrust/crex"
 	"github.com/frankrap/bitmex-api"
 	"github.com/frankrap/bitmex-api/swagger"
+	"math"
 	"sort"
 	"strings"
 	"time"
@@ -116,6 +118,56 @@ func (b *BitMEX) GetContractID() (symbol string, err error) {
 	return b.symbol, nil
 }
 
+func (b *BitMEX) GetInstruments() (result []*Instrument, err error) {
+	var ret []swagger.Instrument
+	ret, err = b.client.GetInstrument("", 500, true)
+	if err != nil {
+		return
+	}
+	for _, v := range ret {
+		if v.State != "Open" {
+			continue
+		}
+		result = append(result, b.convertInstrument(&v))
+	}
+	return
+}
+
+func (b *BitMEX) GetInstrument(symbol string) (result *Instrument, err error) {
+	var ret []swagger.Instrument
+	ret, err = b.client.GetInstrument(symbol, 1, true)
+	if err != nil {
+		return
+	}
+	if len(ret) == 0 || ret[0].Symbol != symbol {
+		err = fmt.Errorf("not found")
+		return
+	}
+	result = b.convertInstrument(&ret[0])
+	return
+}
+
+func (b *BitMEX) convertInstrument(v *swagger.Instrument) (result *Instrument) {
+	result = &Instrument{
+		Symbol:         v.Symbol,
+		BaseCurrency:   v.Underlying,
+		QuoteCurrency:  v.QuoteCurrency,
+		SettleCurrency: v.SettlCurrency,
+		TickSize:       v.TickSize,
+		LotSize:        float64(v.LotSize),
+		MinSize:        float64(v.LotSize),
+		Inverse:        v.IsInverse,
+		ExpiryTime:     v.Expiry,
+	}
+	// 反向合约单张面值为计价货币金额，正向/双币种合约面值按聪(XBt)折算为 XBT
+	if v.IsInverse && v.UnderlyingToSettleMultiplier != 0 {
+		result.ContractValue = math.Abs(float64(v.Multiplier / v.UnderlyingToSettleMultiplier))
+	} else {
+		result.ContractValue = math.Abs(float64(v.Multiplier)) / 1e8
+	}
+	return
+}
+
 func (b *BitMEX) SetLeverRate(value float64) (err error) {
 	return
 }
@@ -97,6 +97,50 @@ func (b *Bybit) GetContractID() (symbol string, err error) {
 	return b.symbol, nil
 }
 
+func (b *Bybit) GetInstruments() (result []*Instrument, err error) {
+	var ret []rest.SymbolInfo
+	_, _, ret, err = b.client.GetSymbols()
+	if err != nil {
+		return
+	}
+	for _, v := range ret {
+		instrument := &Instrument{
+			Symbol:         v.Name,
+			BaseCurrency:   v.BaseCurrency,
+			QuoteCurrency:  v.QuoteCurrency,
+			SettleCurrency: v.QuoteCurrency,
+			TickSize:       v.PriceFilter.TickSize,
+			LotSize:        float64(v.LotSizeFilter.QtyStep),
+			MinSize:        float64(v.LotSizeFilter.MinTradingQty),
+			MaxSize:        float64(v.LotSizeFilter.MaxTradingQty),
+			ContractValue:  1,
+		}
+		// USD 计价为反向合约，以基础货币结算
+		if v.QuoteCurrency == "USD" {
+			instrument.SettleCurrency = v.BaseCurrency
+			instrument.Inverse = true
+		}
+		result = append(result, instrument)
+	}
+	return
+}
+
+func (b *Bybit) GetInstrument(symbol string) (result *Instrument, err error) {
+	var instruments []*Instrument
+	instruments, err = b.GetInstruments()
+	if err != nil {
+		return
+	}
+	for _, v := range instruments {
+		if v.Symbol == symbol {
+			result = v
+			return
+		}
+	}
+	err = fmt.Errorf("not found")
+	return
+}
+
 func (b *Bybit) SetLeverRate(value float64) (err error) {
 	return
 }
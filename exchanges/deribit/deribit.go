@@ -7,6 +7,7 @@ import (
 	"github.com/frankrap/deribit-api"
 	"github.com/frankrap/deribit-api/models"
 	"log"
+	"strings"
 	"time"
 )
 
@@ -109,6 +110,68 @@ func (b *Deribit) GetContractID() (symbol string, err error) {
 	return
 }
 
+func (b *Deribit) GetInstruments() (result []*Instrument, err error) {
+	for _, currency := range []string{"BTC", "ETH"} {
+		var instruments []*Instrument
+		instruments, err = b.getInstruments(currency)
+		if err != nil {
+			return
+		}
+		result = append(result, instruments...)
+	}
+	return
+}
+
+func (b *Deribit) GetInstrument(symbol string) (result *Instrument, err error) {
+	currency := strings.Split(symbol, "-")[0]
+	var instruments []*Instrument
+	instruments, err = b.getInstruments(currency)
+	if err != nil {
+		return
+	}
+	for _, v := range instruments {
+		if v.Symbol == symbol {
+			result = v
+			return
+		}
+	}
+	err = fmt.Errorf("not found")
+	return
+}
+
+// 获取某币种的期货合约规格，数量单位为 USD
+func (b *Deribit) getInstruments(currency string) (result []*Instrument, err error) {
+	var ret []models.Instrument
+	ret, err = b.client.GetInstruments(&models.GetInstrumentsParams{
+		Currency: currency,
+		Kind:     "future",
+	})
+	if err != nil {
+		return
+	}
+	for _, v := range ret {
+		if !v.IsActive {
+			continue
+		}
+		instrument := &Instrument{
+			Symbol:         v.InstrumentName,
+			BaseCurrency:   v.BaseCurrency,
+			QuoteCurrency:  v.QuoteCurrency,
+			SettleCurrency: v.BaseCurrency,
+			TickSize:       v.TickSize,
+			LotSize:        v.ContractSize,
+			MinSize:        v.MinTradeAmount,
+			ContractValue:  1,
+			Inverse:        true,
+		}
+		if v.SettlementPeriod != "perpetual" {
+			instrument.ExpiryTime = time.Unix(0, v.ExpirationTimestamp*int64(time.Millisecond))
+		}
+		result = append(result, instrument)
+	}
+	return
+}
+
 func (b *Deribit) SetLeverRate(value float64) (err error) {
 	return
 }
@@ -385,19 +448,15 @@ func (b *Deribit) SubscribeLevel2Snapshots(market Market, callback func(ob *Orde
 		//The most recent timestamp to return the results for (milliseconds since the UNIX epoch)
 		tm := time.Unix(0, e.Timestamp*1000000)
 
-		if v, ok := b.dobMap[e.InstrumentName]; ok {
-			v.Update(e)
-			ob := v.GetOrderBook(20)
-			ob.Time = tm
-			callback(&ob)
-		} else {
-			dob := NewDepthOrderBook(e.InstrumentName)
-			dob.Update(e)
+		dob, ok := b.dobMap[e.InstrumentName]
+		if !ok {
+			dob = NewDepthOrderBook(e.InstrumentName)
 			b.dobMap[e.InstrumentName] = dob
-			ob := dob.GetOrderBook(20)
-			ob.Time = tm
-			callback(&ob)
 		}
+		updateDepthOrderBook(dob, e)
+		ob := dob.GetOrderBook(20)
+		ob.Time = tm
+		callback(&ob)
 	})
 	b.client.Subscribe([]string{ch})
 	return nil
@@ -448,6 +507,9 @@ func NewDeribit(params *Parameters) *Deribit {
 	if params.Testnet {
 		baseUri = "wss://test.deribit.com/ws/api/v2/"
 	}
+	if params.WsURL != "" {
+		baseUri = params.WsURL
+	}
 	cfg := &deribit.Configuration{
 		DebugMode:     params.DebugMode,
 		Addr:          baseUri,
@@ -6,9 +6,11 @@ import (
 	"github.com/chuckpreslar/emission"
 	. "github.com/coinrust/crex"
 	"github.com/coinrust/crex/dataloader"
+	"github.com/coinrust/crex/utils"
 	"github.com/spf13/cast"
 	"log"
 	"math"
+	"sort"
 	"time"
 )
 
@@ -28,23 +30,37 @@ type Positions []*Position // 单向持仓只有一项; 双向持仓 Index: 0-Lo
 
 // ExSim the exchange for backtest
 type ExSim struct {
-	data            *dataloader.Data
-	makerFeeRate    float64               // -0.00025	// Maker fee rate
-	takerFeeRate    float64               // 0.00075	// Taker fee rate
-	hedgedPosition  bool                  // 双向持仓
-	forwardContract bool                  // 合约类型 true-正向合约 false-反向合约
-	valueOfContract float64               // 合约面值
-	balance         float64               // 余额
-	orders          map[string]*Order     // All orders key: OrderID value: Order
-	openOrders      map[string]*Order     // Open orders
-	historyOrders   map[string]*Order     // History orders
-	positions       map[string]*Positions // Position key: symbol
-	symbol          string
-
-	leverRate float64 // 杠杆
-	emitter   *emission.Emitter
-	backtest  IBacktest
-	eLog      ExchangeLogger
+	data           *dataloader.Data
+	makerFeeRate   float64                     // -0.00025	// Maker fee rate
+	takerFeeRate   float64                     // 0.00075	// Taker fee rate
+	hedgedPosition bool                        // 双向持仓
+	instrument     *Instrument                 // 默认合约规格
+	instruments    map[string]*Instrument      // 合约规格 key: symbol
+	datas          map[string]*dataloader.Data // 数据 key: symbol
+	balance        float64                     // 余额
+	orders         map[string]*Order           // All orders key: OrderID value: Order
+	openOrders     map[string]*Order           // Open orders
+	historyOrders  map[string]*Order           // History orders
+	positions      map[string]*Positions       // Position key: symbol
+	trailingPrices map[string]float64          // 跟踪委托激活后的极值价格 key: OrderID
+	fillModel      FillModel                   // 限价单成交模型
+	queues         map[string]*queueInfo       // 挂单排队信息 key: OrderID
+	symbol         string
+
+	latency        *LatencyQueue   // 下单、撤单、修改委托延迟
+	latencyHandler *LatencyHandler // 请求到达交易所后的撮合
+
+	fundingSchedule dataloader.FundingSchedule // 资金费率结算计划
+	lastFundingTime time.Time                  // 上次检查结算的时间
+	fundingPnl      float64                    // 累计资金费用盈亏
+
+	leverRate      float64 // 杠杆
+	emitter        *emission.Emitter
+	lastOrderBooks map[*dataloader.Data]*OrderBook // 最后推送的订单薄
+	lastTrades     map[*dataloader.Data]*Trade     // 最后推送的成交
+	backtest       IBacktest
+	eLog           ExchangeLogger
+	idGen          *utils.IdGenerate // 订单ID生成器，为空时使用全局生成器
 }
 
 func (b *ExSim) GetName() (name string) {
@@ -56,30 +72,36 @@ func (b *ExSim) GetTime() (tm int64, err error) {
 	return
 }
 
+// GetBalance 返回账户余额，权益包含所有标持仓的浮动盈亏(全仓)
 func (b *ExSim) GetBalance(symbol string) (result *Balance, err error) {
 	result = &Balance{}
 	result.Available = b.balance
-	ob := b.getOrderBook()
-	positions := b.getPositions(ob.Symbol)
 
 	result.Equity = result.Available
-	for _, position := range *positions {
-		var price float64
-		side := position.Side()
-		if side == Buy {
-			price = ob.AskPrice()
-		} else if side == Sell {
-			price = ob.BidPrice()
-		}
-		pnl, _ := CalcPnl(side, math.Abs(position.Size)*b.valueOfContract, position.AvgPrice, price, b.forwardContract)
-		result.Equity += pnl
-		// OKEx 期货已用保证金计算公式:
-		// 开仓保证金=面值*张数*最新标记价格/杠杆
-		// USDT保证金:
-		// 面值: 0.01 BTC 0.1 ETH
-		if b.leverRate != 0 {
-			pValue := b.GetPValue(symbol)
-			result.Margin += pValue * math.Abs(position.Size) * price / b.leverRate
+	for _, positions := range b.positions {
+		for _, position := range *positions {
+			if position.Size == 0 {
+				continue
+			}
+			ob := b.getMatchOrderBook(position.Symbol)
+			if ob == nil {
+				continue
+			}
+			var price float64
+			side := position.Side()
+			if side == Buy {
+				price = ob.AskPrice()
+			} else if side == Sell {
+				price = ob.BidPrice()
+			}
+			instrument := b.getInstrument(position.Symbol)
+			pnl, _ := CalcPnl(side, math.Abs(position.Size)*instrument.ContractValue, position.AvgPrice, price, !instrument.Inverse)
+			result.Equity += pnl
+			// OKEx 期货已用保证金计算公式:
+			// 开仓保证金=面值*张数*最新标记价格/杠杆
+			if b.leverRate != 0 {
+				result.Margin += instrument.Value(position.Size, price) / b.leverRate
+			}
 		}
 	}
 	return
@@ -87,14 +109,14 @@ func (b *ExSim) GetBalance(symbol string) (result *Balance, err error) {
 
 // 返回面值
 func (b *ExSim) GetPValue(symbol string) float64 {
-	value := 0.1 // 面值
-	if symbol == "BTC" {
-		value = 0.01
-	}
-	return value
+	return b.getInstrument(symbol).ContractValue
 }
 
 func (b *ExSim) GetOrderBook(symbol string, depth int) (result *OrderBook, err error) {
+	if data, ok := b.datas[symbol]; ok {
+		result = data.GetOrderBookByNS("", b.backtest.GetTime().UnixNano())
+		return
+	}
 	result = b.data.GetOrderBookByNS(symbol, b.backtest.GetTime().UnixNano())
 	return
 }
@@ -103,8 +125,22 @@ func (b *ExSim) getOrderBook() *OrderBook {
 	return b.data.GetOrderBookByNS(b.symbol, b.backtest.GetTime().UnixNano())
 }
 
+// 获取撮合使用的订单薄，数据中没有标信息时使用默认订单薄
+func (b *ExSim) getMatchOrderBook(symbol string) *OrderBook {
+	if data, ok := b.datas[symbol]; ok {
+		return data.GetOrderBookByNS("", b.backtest.GetTime().UnixNano())
+	}
+	if symbol != "" {
+		if ob := b.data.GetOrderBookByNS(symbol, b.backtest.GetTime().UnixNano()); ob != nil {
+			return ob
+		}
+	}
+	return b.getOrderBook()
+}
+
+// GetRecords 只返回当前回测时间之前已完成的K线
 func (b *ExSim) GetRecords(symbol string, period string, from int64, end int64, limit int) (records []*Record, err error) {
-	return
+	return b.getData(symbol).GetRecordsByNS(period, b.backtest.GetTime().UnixNano(), from, end, limit)
 }
 
 func (b *ExSim) SetContractType(pair string, contractType string) (err error) {
@@ -145,8 +181,8 @@ func (b *ExSim) CloseShort(symbol string, orderType OrderType, price float64, si
 func (b *ExSim) PlaceOrder(symbol string, direction Direction, orderType OrderType, price float64,
 	size float64, opts ...PlaceOrderOption) (result *Order, err error) {
 	params := ParsePlaceOrderParameter(opts...)
-	id := GenOrderId()
-	ob := b.getOrderBook()
+	id := GenOrderIdBy(b.idGen)
+	ob := b.getMatchOrderBook(symbol)
 	order := &Order{
 		ID:           id,
 		ClientOId:    params.ClientOId,
@@ -172,6 +208,10 @@ func (b *ExSim) PlaceOrder(symbol string, direction Direction, orderType OrderTy
 		"size", size,
 		"params", params)
 
+	if err = b.validateOrder(order); err != nil {
+		return
+	}
+
 	// 如果是减仓单，判断是否足够
 	if params.ReduceOnly {
 		side := b.getOrderSide(order)
@@ -187,13 +227,25 @@ func (b *ExSim) PlaceOrder(symbol string, direction Direction, orderType OrderTy
 		}
 	}
 
-	_, err = b.matchOrder(order, true)
-	if err != nil {
-		b.eLog.Error(err)
-		return
+	// 条件委托
+	if isStopOrder(order) {
+		err = b.setupStopOrder(order, params)
+		if err != nil {
+			b.eLog.Error(err)
+			return
+		}
 	}
 
-	if order.IsOpen() {
+	if !b.latency.Place(order, b.backtest.GetTime()) {
+		// 无下单延迟时立即撮合，否则到达交易所后再撮合
+		_, err = b.matchOrder(order, true)
+		if err != nil {
+			b.eLog.Error(err)
+			return
+		}
+	}
+
+	if isActiveOrder(order) {
 		b.openOrders[id] = order
 	} else {
 		b.historyOrders[id] = order
@@ -217,10 +269,122 @@ func (b *ExSim) matchOrder(order *Order, immediate bool) (match bool, err error)
 		match, err = b.matchMarketOrder(order)
 	case OrderTypeLimit:
 		match, err = b.matchLimitOrder(order, immediate)
+	case OrderTypeStopMarket, OrderTypeStopLimit, OrderTypeTrailingStopMarket:
+		match, err = b.matchStopOrder(order, immediate)
 	}
 	return
 }
 
+// 是否条件委托
+func isStopOrder(order *Order) bool {
+	switch order.Type {
+	case OrderTypeStopMarket, OrderTypeStopLimit, OrderTypeTrailingStopMarket:
+		return true
+	}
+	return false
+}
+
+// 是否需要继续撮合(活跃委托、等待触发的条件委托或等待撤单的委托)
+func isActiveOrder(order *Order) bool {
+	return order.IsOpen() ||
+		order.Status == OrderStatusUntriggered ||
+		order.Status == OrderStatusCancelPending
+}
+
+// 设置条件委托参数
+func (b *ExSim) setupStopOrder(order *Order, params *PlaceOrderParameter) (err error) {
+	switch order.Type {
+	case OrderTypeStopMarket, OrderTypeStopLimit:
+		if params.StopPx <= 0 {
+			err = errors.New("invalid stop price")
+			return
+		}
+		order.StopPx = params.StopPx
+	case OrderTypeTrailingStopMarket:
+		if params.CallbackRate <= 0 {
+			err = errors.New("invalid callback rate")
+			return
+		}
+		order.ActivatePrice = cast.ToString(params.ActivationPrice)
+		order.PriceRate = cast.ToString(params.CallbackRate)
+	}
+	order.Status = OrderStatusUntriggered
+	return
+}
+
+// 撮合条件委托: Untriggered -> Triggered -> 按市价/限价成交
+func (b *ExSim) matchStopOrder(order *Order, immediate bool) (match bool, err error) {
+	// 下单时无法成交由调用方返回错误或拒绝，撮合循环中触发后无法成交需在此拒绝
+	resting := !immediate
+	if order.Status == OrderStatusUntriggered {
+		ob := b.getMatchOrderBook(order.Symbol)
+		if !b.checkStopTriggered(order, ob) {
+			return
+		}
+		delete(b.trailingPrices, order.ID)
+		order.Status = OrderStatusTriggered
+		order.UpdateTime = ob.Time
+		b.logOrderInfo("Trigger order", SimEventOrder, order)
+		var orders = []*Order{order}
+		b.emitter.Emit(WSEventOrder, orders)
+
+		// 触发后进入撮合
+		order.Status = OrderStatusNew
+		immediate = true
+	}
+
+	switch order.Type {
+	case OrderTypeStopMarket, OrderTypeTrailingStopMarket:
+		match, err = b.matchMarketOrder(order)
+	case OrderTypeStopLimit:
+		match, err = b.matchLimitOrder(order, immediate)
+	}
+	if err != nil && resting {
+		// 触发后无法成交(保证金或深度不足)时拒绝委托，避免一直停留在 New 状态
+		order.Status = OrderStatusRejected
+		order.UpdateTime = b.getMatchOrderBook(order.Symbol).Time
+		b.logOrderInfo("Reject order", SimEventOrder, order)
+		var orders = []*Order{order}
+		b.emitter.Emit(WSEventOrder, orders)
+	}
+	return
+}
+
+// 检查条件委托是否满足触发条件，触发价格使用盘口中间价
+func (b *ExSim) checkStopTriggered(order *Order, ob *OrderBook) bool {
+	price := ob.Price()
+	if price <= 0 {
+		return false
+	}
+
+	if order.Type == OrderTypeTrailingStopMarket {
+		extremePrice, activated := b.trailingPrices[order.ID]
+		if !activated {
+			activationPrice := cast.ToFloat64(order.ActivatePrice)
+			if activationPrice > 0 &&
+				(order.Direction == Sell && price < activationPrice ||
+					order.Direction == Buy && price > activationPrice) {
+				return false
+			}
+			extremePrice = price
+		}
+		callbackRate := cast.ToFloat64(order.PriceRate) / 100
+		if order.Direction == Sell {
+			extremePrice = math.Max(extremePrice, price)
+			order.StopPx = extremePrice * (1 - callbackRate)
+		} else {
+			extremePrice = math.Min(extremePrice, price)
+			order.StopPx = extremePrice * (1 + callbackRate)
+		}
+		b.trailingPrices[order.ID] = extremePrice
+	}
+
+	if order.Direction == Buy {
+		return price >= order.StopPx
+	}
+	return price <= order.StopPx
+}
+
 func (b *ExSim) matchMarketOrder(order *Order) (changed bool, err error) {
 	if !order.IsOpen() {
 		return
@@ -229,30 +393,19 @@ func (b *ExSim) matchMarketOrder(order *Order) (changed bool, err error) {
 	// 检查委托:
 	// Rejected, maximum size of future position is $1,000,000
 	// 开仓总量不能大于 1000000
-	// Invalid size - not multiple of contract size ($10)
-	// 数量必须是10的整数倍
-
-	if int(order.Amount)%10 != 0 {
-		err = errors.New("invalid size - not multiple of contract size ($10)")
-		return
-	}
+	// 数量精度在下单时按合约规格检查
 
 	side := b.getOrderSide(order)
 	position := b.getPosition(order.Symbol, side)
 
-	if int(position.Size+order.Amount) > PositionSizeLimit ||
-		int(position.Size-order.Amount) < -PositionSizeLimit {
-		err = errors.New("rejected, maximum size of future position is $1,000,000")
+	positionLimit := b.getInstrument(order.Symbol).MaxSize
+	if positionLimit > 0 && (position.Size+order.Amount > positionLimit ||
+		position.Size-order.Amount < -positionLimit) {
+		err = fmt.Errorf("rejected, maximum size of future position is %v", positionLimit)
 		return
 	}
 
-	var ob *OrderBook
-	//ob := b.getOrderBook()
-	if order.Symbol != "" {
-		ob, _ = b.GetOrderBook(order.Symbol, 25)
-	} else {
-		ob = b.getOrderBook()
-	}
+	ob := b.getMatchOrderBook(order.Symbol)
 
 	// 判断开仓数量
 	margin := b.balance
@@ -273,23 +426,6 @@ func (b *ExSim) matchMarketOrder(order *Order) (changed bool, err error) {
 		}
 
 		filledAmount, avgPrice = b.matchBid(order.Amount, ob.Asks...)
-
-		// trade fee
-		var fee float64
-		if b.forwardContract {
-			fee = filledAmount * b.valueOfContract * avgPrice * b.takerFeeRate
-		} else {
-			fee = filledAmount * b.valueOfContract / avgPrice * b.takerFeeRate
-		}
-
-		// Update balance
-		b.addBalance(-fee)
-
-		// Update position
-		pnl := b.updatePosition(order.Symbol, filledAmount*b.valueOfContract, avgPrice, side)
-		order.Pnl += pnl
-		order.Commission += fee
-		order.AvgPrice = avgPrice
 	} else if order.Direction == Sell {
 		maxSize = margin * 100 * ob.BidPrice()
 		if order.Amount > maxSize {
@@ -298,27 +434,14 @@ func (b *ExSim) matchMarketOrder(order *Order) (changed bool, err error) {
 		}
 
 		filledAmount, avgPrice = b.matchBid(order.Amount, ob.Bids...)
+	}
 
-		// trade fee
-		var fee float64
-		if b.forwardContract {
-			fee = filledAmount * b.valueOfContract * avgPrice * b.takerFeeRate
-		} else {
-			fee = filledAmount * b.valueOfContract / avgPrice * b.takerFeeRate
-		}
-
-		// Update balance
-		b.addBalance(-fee)
-
-		// Update position
-		pnl := b.updatePosition(order.Symbol, -filledAmount*b.valueOfContract, avgPrice, side)
-		order.Pnl += pnl
-		order.Commission += fee
-		order.AvgPrice = avgPrice
+	if filledAmount == 0 {
+		err = errors.New("size is bigger than orderbook")
+		return
 	}
-	order.FilledAmount = filledAmount
-	order.UpdateTime = ob.Time
-	order.Status = OrderStatusFilled
+
+	b.fillOrder(order, filledAmount, avgPrice, true, ob.Time)
 	changed = true
 	return
 }
@@ -348,105 +471,120 @@ func (b *ExSim) matchLimitOrder(order *Order, immediate bool) (match bool, err e
 		return
 	}
 
-	side := b.getOrderSide(order)
+	ob := b.getMatchOrderBook(order.Symbol)
 
-	var ob *OrderBook
-	//ob := b.getOrderBook()
-	if order.Symbol != "" {
-		ob, _ = b.GetOrderBook(order.Symbol, 25)
-	} else {
-		ob = b.getOrderBook()
-	}
+	// 对手盘中价格满足限价的部分
+	var items []Item
 	if order.Direction == Buy { // Bid order
-		filledAmount, avgPrice := b.matchBid(order.Amount, ob.Asks...)
-		//if order.Price < ob.AskPrice() {
-		if filledAmount == 0 {
-			return
-		}
-
-		if immediate && order.PostOnly {
-			order.UpdateTime = ob.Time
-			order.Status = OrderStatusRejected
-			match = true
-			return
-		}
-
-		// match trade
-		var fee float64
-
-		// trade fee
-		if immediate {
-			if b.forwardContract {
-				fee = filledAmount * b.valueOfContract * avgPrice * b.takerFeeRate
-			} else {
-				fee = filledAmount * b.valueOfContract / avgPrice * b.takerFeeRate
+		for _, v := range ob.Asks {
+			if v.Price > order.Price {
+				break
 			}
-		} else {
-			if b.forwardContract {
-				fee = filledAmount * b.valueOfContract * avgPrice * b.makerFeeRate
-			} else {
-				fee = filledAmount * b.valueOfContract / avgPrice * b.makerFeeRate
+			items = append(items, v)
+		}
+	} else { // Ask order
+		for _, v := range ob.Bids {
+			if v.Price < order.Price {
+				break
 			}
+			items = append(items, v)
 		}
+	}
+	if len(items) == 0 {
+		if b.fillModel == FillModelQueue {
+			match = b.matchQueue(order, ob)
+		}
+		return
+	}
 
-		// Update balance
-		b.addBalance(-fee)
-
-		// Update position
-		b.updatePosition(order.Symbol, filledAmount, avgPrice, side)
-
-		order.Commission -= fee
-		order.AvgPrice = avgPrice
-		order.FilledAmount = filledAmount
+	if immediate && order.PostOnly {
 		order.UpdateTime = ob.Time
-		order.Status = OrderStatusFilled
+		order.Status = OrderStatusRejected
 		match = true
-	} else { // Ask order
-		filledAmount, avgPrice := b.matchAsk(order.Amount, ob.Bids...)
-		//if order.Price > ob.BidPrice() {
-		if filledAmount == 0 {
-			return
+		return
+	}
+
+	remaining := order.Amount - order.FilledAmount
+	if immediate {
+		// 吃单: 按盘口逐档成交，未成交部分继续挂单
+		filledAmount, avgPrice := b.matchItems(remaining, items)
+		b.fillOrder(order, filledAmount, avgPrice, true, ob.Time)
+	} else if b.fillModel == FillModelQueue {
+		// 挂单: 对手盘穿过委托价，成交量不超过穿过的挂单量
+		var volume float64
+		for _, v := range items {
+			volume += v.Amount
+		}
+		b.fillOrder(order, math.Min(remaining, volume), order.Price, false, ob.Time)
+	} else {
+		// 挂单: 对手盘穿过委托价即按委托价全部成交
+		b.fillOrder(order, remaining, order.Price, false, ob.Time)
+	}
+	if b.fillModel == FillModelQueue {
+		// 价位已被穿过，前方排队视为全部成交，当前步的成交已计入
+		amount, _ := levelAmount(order, ob)
+		q := &queueInfo{amount: amount, ob: ob}
+		if trades := b.currentTrades(order.Symbol); len(trades) > 0 {
+			q.lastTrade = trades[0]
 		}
+		b.queues[order.ID] = q
+	}
+	match = true
+	return
+}
 
-		if immediate && order.PostOnly {
-			order.UpdateTime = ob.Time
-			order.Status = OrderStatusRejected
-			match = true
-			return
+// 按盘口逐档成交，数量不足时部分成交
+func (b *ExSim) matchItems(size float64, items []Item) (filledSize float64, avgPrice float64) {
+	var value float64
+	for _, v := range items {
+		amount := math.Min(size-filledSize, v.Amount)
+		value += amount * v.Price
+		filledSize += amount
+		if filledSize >= size {
+			break
 		}
+	}
+	if filledSize > 0 {
+		avgPrice = value / filledSize
+	}
+	return
+}
 
-		// match trade
-		var fee float64
+// 委托成交，更新余额、持仓和委托状态
+// taker: true-吃单 false-挂单
+func (b *ExSim) fillOrder(order *Order, size float64, price float64, taker bool, tm time.Time) {
+	feeRate := b.makerFeeRate
+	if taker {
+		feeRate = b.takerFeeRate
+	}
 
-		// trade fee
-		if immediate {
-			if b.forwardContract {
-				fee = filledAmount * b.valueOfContract * avgPrice * b.takerFeeRate
-			} else {
-				fee = filledAmount * b.valueOfContract / avgPrice * b.takerFeeRate
-			}
-		} else {
-			if b.forwardContract {
-				fee = filledAmount * b.valueOfContract * avgPrice * b.makerFeeRate
-			} else {
-				fee = filledAmount * b.valueOfContract / avgPrice * b.makerFeeRate
-			}
-		}
+	// trade fee
+	fee := b.getInstrument(order.Symbol).Value(size, price) * feeRate
 
-		// Update balance
-		b.addBalance(-fee)
+	// Update balance
+	b.addBalance(-fee)
 
-		// Update position
-		b.updatePosition(order.Symbol, -filledAmount, avgPrice, side)
+	// Update position
+	side := b.getOrderSide(order)
+	var pnl float64
+	if order.Direction == Buy {
+		pnl = b.updatePosition(order.Symbol, size, price, side)
+	} else {
+		pnl = b.updatePosition(order.Symbol, -size, price, side)
+	}
 
-		order.Commission -= fee
-		order.AvgPrice = avgPrice
-		order.FilledAmount = filledAmount
-		order.UpdateTime = ob.Time
+	order.Pnl += pnl
+	order.Commission += fee
+	order.AvgPrice = (order.AvgPrice*order.FilledAmount + price*size) / (order.FilledAmount + size)
+	order.FilledAmount += size
+	order.UpdateTime = tm
+	if order.FilledAmount >= order.Amount {
 		order.Status = OrderStatusFilled
-		match = true
+	} else {
+		order.Status = OrderStatusPartiallyFilled
 	}
-	return
+
+	b.emitPositions(order.Symbol)
 }
 
 func (b *ExSim) matchBid(size float64, asks ...Item) (filledSize float64, avgPrice float64) {
@@ -563,7 +701,7 @@ func (b *ExSim) addPosition(position *Position, size float64, price float64) (er
 
 	// 增加持仓
 	var positionCost float64
-	if b.forwardContract {
+	if !b.getInstrument(position.Symbol).Inverse {
 		if position.Size != 0 && position.AvgPrice != 0 {
 			positionCost = math.Abs(position.Size) * position.AvgPrice
 		}
@@ -603,23 +741,24 @@ func (b *ExSim) closePosition(position *Position, size float64, price float64) (
 		err = errors.New("方向错误")
 		return
 	}
+	instrument := b.getInstrument(position.Symbol)
 	remaining := math.Abs(size) - math.Abs(position.Size)
 	if remaining > 0 {
 		// 先平掉原有持仓
 		// 计算盈利
-		pnl, _ = CalcPnl(position.Side(), math.Abs(position.Size)*b.valueOfContract, position.AvgPrice, price, b.forwardContract)
+		pnl, _ = CalcPnl(position.Side(), math.Abs(position.Size)*instrument.ContractValue, position.AvgPrice, price, !instrument.Inverse)
 		b.addPnl(pnl)
 		position.AvgPrice = price
 		position.Size = position.Size + size
 	} else if remaining == 0 {
 		// 完全平仓
-		pnl, _ = CalcPnl(position.Side(), math.Abs(size)*b.valueOfContract, position.AvgPrice, price, b.forwardContract)
+		pnl, _ = CalcPnl(position.Side(), math.Abs(size)*instrument.ContractValue, position.AvgPrice, price, !instrument.Inverse)
 		b.addPnl(pnl)
 		position.AvgPrice = 0
 		position.Size = 0
 	} else {
 		// 部分平仓
-		pnl, _ = CalcPnl(position.Side(), math.Abs(size)*b.valueOfContract, position.AvgPrice, price, b.forwardContract)
+		pnl, _ = CalcPnl(position.Side(), math.Abs(size)*instrument.ContractValue, position.AvgPrice, price, !instrument.Inverse)
 		b.addPnl(pnl)
 		//position.AvgPrice = position.AvgPrice
 		position.Size = position.Size + size
@@ -683,9 +822,19 @@ func (b *ExSim) getPositions(symbol string) *Positions {
 	}
 }
 
+// 委托结束，移入历史委托
+func (b *ExSim) archiveOrder(order *Order) {
+	delete(b.openOrders, order.ID)
+	delete(b.trailingPrices, order.ID)
+	delete(b.queues, order.ID)
+	b.latency.Remove(order.ID)
+	b.historyOrders[order.ID] = order
+}
+
 func (b *ExSim) GetOpenOrders(symbol string, opts ...OrderOption) (result []*Order, err error) {
+	params := ParseOrderParameter(opts...)
 	for _, v := range b.openOrders {
-		if v.Symbol == symbol {
+		if v.Symbol == symbol && isStopOrder(v) == params.Stop {
 			result = append(result, v)
 		}
 	}
@@ -704,15 +853,14 @@ func (b *ExSim) GetOrder(symbol string, id string, opts ...OrderOption) (result
 
 func (b *ExSim) CancelOrder(symbol string, id string, opts ...OrderOption) (result *Order, err error) {
 	if order, ok := b.orders[id]; ok {
-		if !order.IsOpen() {
+		if !isActiveOrder(order) {
 			err = errors.New("status error")
 			return
 		}
 		switch order.Status {
-		case OrderStatusCreated, OrderStatusNew, OrderStatusPartiallyFilled:
-			order.Status = OrderStatusCancelled
+		case OrderStatusCreated, OrderStatusNew, OrderStatusPartiallyFilled, OrderStatusUntriggered:
+			b.cancelOrder(order)
 			result = order
-			delete(b.openOrders, id)
 		default:
 			err = errors.New("error")
 		}
@@ -722,59 +870,189 @@ func (b *ExSim) CancelOrder(symbol string, id string, opts ...OrderOption) (resu
 	return
 }
 
+// CancelAllOrders 撤销标的所有委托(包括条件委托)，使用 OrderStopOption(true) 时只撤销条件委托
 func (b *ExSim) CancelAllOrders(symbol string, opts ...OrderOption) (err error) {
-	var idsToBeRemoved []string
+	params := ParseOrderParameter(opts...)
 
 	for _, order := range b.openOrders {
-		if !order.IsOpen() {
+		if order.Symbol != symbol || params.Stop && !isStopOrder(order) {
+			continue
+		}
+		if !isActiveOrder(order) {
 			log.Printf("Order error: %#v", order)
 			continue
 		}
 		switch order.Status {
-		case OrderStatusCreated, OrderStatusNew, OrderStatusPartiallyFilled:
-			order.Status = OrderStatusCancelled
-			idsToBeRemoved = append(idsToBeRemoved, order.ID)
+		case OrderStatusCreated, OrderStatusNew, OrderStatusPartiallyFilled, OrderStatusUntriggered:
+			b.cancelOrder(order)
 		default:
 			err = errors.New("error")
 		}
 	}
+	return
+}
 
-	for _, id := range idsToBeRemoved {
-		delete(b.openOrders, id)
+// 撤销委托，有撤单延迟时撤单到达前委托继续撮合
+func (b *ExSim) cancelOrder(order *Order) {
+	if !b.latency.Cancel(order, b.backtest.GetTime()) {
+		order.Status = OrderStatusCancelled
+		b.archiveOrder(order)
 	}
-	return
+	var orders = []*Order{order}
+	b.emitter.Emit(WSEventOrder, orders)
 }
 
+// AmendOrder 修改委托价格和(或)数量，0 表示不修改
 func (b *ExSim) AmendOrder(symbol string, id string, price float64, size float64, opts ...OrderOption) (result *Order, err error) {
+	order, ok := b.openOrders[id]
+	if !ok {
+		err = errors.New("not found")
+		return
+	}
+	if order.Status == OrderStatusCancelPending || !isActiveOrder(order) {
+		err = errors.New("status error")
+		return
+	}
+	if size > 0 && size < order.FilledAmount {
+		err = errors.New("size is less than filled amount")
+		return
+	}
+
+	result = order
+	if b.latency.Amend(order, price, size, b.backtest.GetTime()) {
+		// 修改请求到达交易所后生效
+		return
+	}
+
+	var match bool
+	match, err = b.amendOrder(order, price, size)
+	if err != nil {
+		b.eLog.Error(err)
+		return
+	}
+	if match {
+		b.logOrderInfo("Match order", SimEventDeal, order)
+		var orders = []*Order{order}
+		b.emitter.Emit(WSEventOrder, orders)
+	}
+	if !isActiveOrder(order) {
+		b.archiveOrder(order)
+	}
 	return
 }
 
+// 修改委托: 价格变化时失去排队优先级，新价格可成交时立即撮合
+func (b *ExSim) amendOrder(order *Order, price float64, size float64) (match bool, err error) {
+	if size > 0 && size < order.FilledAmount {
+		err = errors.New("size is less than filled amount")
+		return
+	}
+
+	priceChanged := price > 0 && price != order.Price
+	if priceChanged {
+		order.Price = price
+		delete(b.queues, order.ID)
+	}
+	if size > 0 {
+		order.Amount = size
+	}
+	order.UpdateTime = b.backtest.GetTime()
+	if order.FilledAmount > 0 && order.FilledAmount >= order.Amount {
+		order.Status = OrderStatusFilled
+	}
+
+	b.logOrderInfo("Amend order", SimEventOrder, order)
+	var orders = []*Order{order}
+	b.emitter.Emit(WSEventOrder, orders)
+
+	if priceChanged {
+		match, err = b.matchOrder(order, true)
+	}
+	return
+}
+
+// GetPositions 返回持仓，未开过仓的标的返回空列表且 err 为 nil，与其他交易所一致
 func (b *ExSim) GetPositions(symbol string) (result []*Position, err error) {
 	positions, ok := b.positions[symbol]
 	if !ok {
-		err = errors.New("not found")
 		return
 	}
 	result = *positions
+	for _, position := range result {
+		position.LiquidationPrice = b.getLiquidationPrice(position)
+	}
 	return
 }
 
 func (b *ExSim) SubscribeTrades(market Market, callback func(trades []*Trade)) error {
+	b.emitter.On(WSEventTrade, func(trades []*Trade) {
+		var result []*Trade
+		for _, v := range trades {
+			if matchSymbol(market, v.Symbol) {
+				result = append(result, v)
+			}
+		}
+		if len(result) > 0 {
+			callback(result)
+		}
+	})
 	return nil
 }
 
 func (b *ExSim) SubscribeLevel2Snapshots(market Market, callback func(ob *OrderBook)) error {
+	b.emitter.On(WSEventL2Snapshot, func(ob *OrderBook) {
+		if matchSymbol(market, ob.Symbol) {
+			callback(ob)
+		}
+	})
 	return nil
 }
 
 func (b *ExSim) SubscribeOrders(market Market, callback func(orders []*Order)) error {
+	b.emitter.On(WSEventOrder, func(orders []*Order) {
+		var result []*Order
+		for _, v := range orders {
+			if matchSymbol(market, v.Symbol) {
+				result = append(result, v)
+			}
+		}
+		if len(result) > 0 {
+			callback(result)
+		}
+	})
 	return nil
 }
 
 func (b *ExSim) SubscribePositions(market Market, callback func(positions []*Position)) error {
+	b.emitter.On(WSEventPosition, func(positions []*Position) {
+		var result []*Position
+		for _, v := range positions {
+			if matchSymbol(market, v.Symbol) {
+				result = append(result, v)
+			}
+		}
+		if len(result) > 0 {
+			callback(result)
+		}
+	})
 	return nil
 }
 
+// 订阅的标是否匹配，数据中没有标信息时视为匹配
+func matchSymbol(market Market, symbol string) bool {
+	return market.Symbol == "" || symbol == "" || market.Symbol == symbol
+}
+
+// 推送持仓
+func (b *ExSim) emitPositions(symbol string) {
+	var positions []*Position
+	for _, v := range *b.getPositions(symbol) {
+		position := *v
+		positions = append(positions, &position)
+	}
+	b.emitter.Emit(WSEventPosition, positions)
+}
+
 func (b *ExSim) SetBacktest(backtest IBacktest) {
 	b.backtest = backtest
 }
@@ -783,32 +1061,260 @@ func (b *ExSim) SetExchangeLogger(l ExchangeLogger) {
 	b.eLog = l
 }
 
+// SetIdGenerate 设置订单ID生成器
+func (b *ExSim) SetIdGenerate(g *utils.IdGenerate) {
+	b.idGen = g
+}
+
 func (b *ExSim) RunEventLoopOnce() (err error) {
+	b.emitMarketData()
+
 	var match bool
+	now := b.backtest.GetTime()
 	for _, order := range b.openOrders {
-		match, err = b.matchOrder(order, false)
+		match, err = b.runOrder(order, now)
 		if match {
 			b.logOrderInfo("Match order", SimEventDeal, order)
 			var orders = []*Order{order}
 			b.emitter.Emit(WSEventOrder, orders)
 		}
+		if !isActiveOrder(order) {
+			b.archiveOrder(order)
+		}
+	}
+
+	b.settleFunding()
+	b.checkLiquidation()
+	return
+}
+
+// 撮合一个委托，处理下单、修改委托和撤单延迟
+func (b *ExSim) runOrder(order *Order, now time.Time) (match bool, err error) {
+	var cancelled bool
+	match, cancelled, err = b.latency.Run(order, now, b.latencyHandler)
+	if cancelled {
+		var orders = []*Order{order}
+		b.emitter.Emit(WSEventOrder, orders)
 	}
 	return
 }
 
+// 修改委托到达交易所，修改失败时按原委托继续撮合
+func (b *ExSim) arriveAmend(order *Order, price float64, size float64) (match bool, err error) {
+	match, err = b.amendOrder(order, price, size)
+	if err != nil {
+		b.eLog.Error(err)
+		err = nil
+	}
+	if !match {
+		match, err = b.matchOrder(order, false)
+	}
+	return
+}
+
+// 撮合已到达交易所的委托
+func (b *ExSim) runMatchOrder(order *Order) (match bool, err error) {
+	return b.matchOrder(order, false)
+}
+
+// 委托到达交易所，按到达时的订单薄撮合
+func (b *ExSim) arriveOrder(order *Order) (match bool, err error) {
+	if isStopOrder(order) {
+		order.Status = OrderStatusUntriggered
+	} else {
+		order.Status = OrderStatusNew
+	}
+	order.UpdateTime = b.backtest.GetTime()
+	match, err = b.matchOrder(order, true)
+	if err != nil {
+		b.eLog.Error(err)
+		order.Status = OrderStatusRejected
+		err = nil
+	}
+	if !match {
+		var orders = []*Order{order}
+		b.emitter.Emit(WSEventOrder, orders)
+	}
+	return
+}
+
+// SetFundingSchedule 设置资金费率结算计划
+func (b *ExSim) SetFundingSchedule(schedule dataloader.FundingSchedule) {
+	b.fundingSchedule = schedule
+}
+
+// GetFundingPnl 累计资金费用盈亏
+func (b *ExSim) GetFundingPnl() float64 {
+	return b.fundingPnl
+}
+
+// GetFeeRates Maker/Taker 费率
+func (b *ExSim) GetFeeRates() (makerFeeRate float64, takerFeeRate float64) {
+	return b.makerFeeRate, b.takerFeeRate
+}
+
+// 资金费用结算: 按持仓价值收取/支付资金费用，费率为正时多头支付空头
+func (b *ExSim) settleFunding() {
+	if b.fundingSchedule == nil {
+		return
+	}
+	now := b.backtest.GetTime()
+	if b.lastFundingTime.IsZero() {
+		b.lastFundingTime = now
+		return
+	}
+	if !now.After(b.lastFundingTime) {
+		return
+	}
+	for symbol, positions := range b.positions {
+		rates := b.fundingSchedule.GetFundingRates(symbol, b.lastFundingTime, now)
+		for _, rate := range rates {
+			for _, position := range *positions {
+				if position.Size == 0 {
+					continue
+				}
+				ob := b.getMatchOrderBook(symbol)
+				value := b.getInstrument(symbol).Value(position.Size, ob.Price())
+				funding := value * rate.Rate
+				if position.Side() == Buy {
+					funding = -funding
+				}
+				b.addBalance(funding)
+				b.fundingPnl += funding
+				b.logFundingInfo(position, rate, funding)
+			}
+		}
+	}
+	b.lastFundingTime = now
+}
+
+func (b *ExSim) logFundingInfo(position *Position, rate *dataloader.FundingRate, funding float64) {
+	if b.eLog == nil {
+		return
+	}
+
+	ob := b.getMatchOrderBook(position.Symbol)
+	positions := b.getPositions(position.Symbol)
+	b.eLog.Infow("Funding",
+		SimEventKey, SimEventFunding,
+		"symbol", position.Symbol,
+		"fundingTime", rate.Time,
+		"rate", rate.Rate,
+		"funding", funding,
+		"orderbook", ob,
+		"balance", b.balance,
+		"positions", []*Position(*positions))
+}
+
+// 计算持仓浮动盈亏，使用盘口中间价
+func (b *ExSim) getUnrealisedPnl(position *Position) (pnl float64) {
+	if position.Size == 0 {
+		return
+	}
+	ob := b.getMatchOrderBook(position.Symbol)
+	instrument := b.getInstrument(position.Symbol)
+	pnl, _ = CalcPnl(position.Side(), math.Abs(position.Size)*instrument.ContractValue, position.AvgPrice, ob.Price(), !instrument.Inverse)
+	return
+}
+
+// 计算强平价格(全仓): 保证金余额包含其他持仓的浮动盈亏
+func (b *ExSim) getLiquidationPrice(position *Position) float64 {
+	if position.Size == 0 {
+		return 0
+	}
+	marginBalance := b.balance
+	for _, positions := range b.positions {
+		for _, v := range *positions {
+			if v != position {
+				marginBalance += b.getUnrealisedPnl(v)
+			}
+		}
+	}
+	instrument := b.getInstrument(position.Symbol)
+	return CalcLiquidationPrice(position.Side(), math.Abs(position.Size)*instrument.ContractValue,
+		position.AvgPrice, marginBalance, !instrument.Inverse)
+}
+
+// 检查所有持仓，达到强平价格则按强平价格平仓
+// 按标的名称顺序检查，强平会改变余额，保证多个标的时结果确定
+func (b *ExSim) checkLiquidation() {
+	symbols := make([]string, 0, len(b.positions))
+	for symbol := range b.positions {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+	for _, symbol := range symbols {
+		for _, position := range *b.positions[symbol] {
+			if position.Size == 0 {
+				continue
+			}
+			liquidationPrice := b.getLiquidationPrice(position)
+			if liquidationPrice <= 0 {
+				continue
+			}
+			ob := b.getMatchOrderBook(position.Symbol)
+			price := ob.Price()
+			if position.Side() == Buy && price > liquidationPrice ||
+				position.Side() == Sell && price < liquidationPrice {
+				continue
+			}
+			b.liquidate(position, liquidationPrice, ob.Time)
+		}
+	}
+}
+
+// 强制平仓: 撤销该标的所有委托，按强平价格以市价单平仓
+func (b *ExSim) liquidate(position *Position, price float64, tm time.Time) {
+	for _, order := range b.openOrders {
+		if order.Symbol != position.Symbol {
+			continue
+		}
+		order.Status = OrderStatusCancelled
+		order.UpdateTime = tm
+		b.archiveOrder(order)
+		var orders = []*Order{order}
+		b.emitter.Emit(WSEventOrder, orders)
+	}
+
+	direction := Sell
+	if position.Side() == Sell {
+		direction = Buy
+	}
+	size := math.Abs(position.Size)
+	order := &Order{
+		ID:         GenOrderIdBy(b.idGen),
+		Time:       tm,
+		Symbol:     position.Symbol,
+		Price:      price,
+		Amount:     size,
+		Direction:  direction,
+		Type:       OrderTypeMarket,
+		ReduceOnly: true,
+		UpdateTime: tm,
+		Status:     OrderStatusNew,
+	}
+	b.fillOrder(order, size, price, true, tm)
+	b.orders[order.ID] = order
+	b.historyOrders[order.ID] = order
+
+	b.logOrderInfo("Liquidation", SimEventDeal, order)
+	var orders = []*Order{order}
+	b.emitter.Emit(WSEventOrder, orders)
+}
+
 func (b *ExSim) logOrderInfo(msg string, event string, order *Order) {
 	if b.eLog == nil {
 		return
 	}
 
-	ob := b.getOrderBook()
+	ob := b.getMatchOrderBook(order.Symbol)
 	positions := b.getPositions(order.Symbol)
 	b.eLog.Infow(msg,
 		SimEventKey, event,
 		"order", order,
 		"orderbook", ob,
 		"balance", b.balance,
-		"positions", *positions)
+		"positions", []*Position(*positions))
 }
 
 func (b *ExSim) IO(name string, params string) (string, error) {
@@ -824,25 +1330,42 @@ func (b *ExSim) IO(name string, params string) (string, error) {
 // cash: 初始资金
 // makerFeeRate: Maker 费率
 // takerFeeRate: Taker 费率
-// valueOfContract: 合约单张面值
+// valueOfContract: 合约单张面值，持仓数量(Position.Size)按合约张数计，面值只用于计算盈亏、手续费和保证金
 // hedgedPosition: 双向持仓
 // forwardContract: true-正向合约 false-反向合约
 func NewExSim(data *dataloader.Data, cash float64, makerFeeRate float64, takerFeeRate float64, valueOfContract float64, hedgedPosition bool, forwardContract bool) *ExSim {
 	if valueOfContract == 0 {
 		panic("valueOfContract is zero")
 	}
-	return &ExSim{
-		data:            data,
-		balance:         cash,
-		makerFeeRate:    makerFeeRate, // -0.00025 // Maker 费率
-		takerFeeRate:    takerFeeRate, // 0.00075	// Taker 费率
-		hedgedPosition:  hedgedPosition,
-		forwardContract: forwardContract,
-		valueOfContract: valueOfContract,
-		orders:          make(map[string]*Order),
-		openOrders:      make(map[string]*Order),
-		historyOrders:   make(map[string]*Order),
-		positions:       make(map[string]*Positions),
-		emitter:         emission.NewEmitter(),
+	b := &ExSim{
+		data:           data,
+		balance:        cash,
+		makerFeeRate:   makerFeeRate, // -0.00025 // Maker 费率
+		takerFeeRate:   takerFeeRate, // 0.00075	// Taker 费率
+		hedgedPosition: hedgedPosition,
+		instrument: &Instrument{
+			// 默认不限制数量精度，需要时通过 SetInstrument 设置，如 Deribit LotSize: 10
+			MaxSize:       PositionSizeLimit,
+			ContractValue: valueOfContract,
+			Inverse:       !forwardContract,
+		},
+		instruments:    make(map[string]*Instrument),
+		datas:          make(map[string]*dataloader.Data),
+		orders:         make(map[string]*Order),
+		openOrders:     make(map[string]*Order),
+		historyOrders:  make(map[string]*Order),
+		positions:      make(map[string]*Positions),
+		trailingPrices: make(map[string]float64),
+		queues:         make(map[string]*queueInfo),
+		latency:        NewLatencyQueue(),
+		emitter:        emission.NewEmitter(),
+		lastOrderBooks: make(map[*dataloader.Data]*OrderBook),
+		lastTrades:     make(map[*dataloader.Data]*Trade),
+	}
+	b.latencyHandler = &LatencyHandler{
+		Arrive: b.arriveOrder,
+		Amend:  b.arriveAmend,
+		Match:  b.runMatchOrder,
 	}
+	return b
 }
@@ -3,21 +3,23 @@ package exsim
 import (
 	. "github.com/coinrust/crex"
 	"github.com/coinrust/crex/dataloader"
+	"github.com/coinrust/crex/internal/testutil"
 	"github.com/coinrust/crex/math"
-	"github.com/coinrust/crex/utils"
 	"github.com/stretchr/testify/assert"
 	"testing"
 	"time"
 )
 
-func testExchange() Exchange {
-	start, _ := time.Parse("2006-01-02 15:04:05", "2019-10-01 00:00:00")
-	end, _ := time.Parse("2006-01-02 15:04:05", "2019-10-02 00:00:00")
-	SetIdGenerate(utils.NewIdGenerate(start))
-	data := dataloader.NewCsvData("../../data-samples/deribit/deribit_BTC-PERPETUAL_and_futures_tick_by_tick_book_snapshots_10_levels_2019-10-01_2019-11-01.csv")
-	data.Reset(start, end)
+func testExSim() (*ExSim, *dataloader.Data) {
+	data := testutil.SampleData()
 	ex := NewExSim(data, 10000, -0.00025, 0.00075, 1, false, false)
+	ex.SetBacktest(testutil.NewBacktest(data))
 	ex.SetExchangeLogger(&EmptyExchangeLogger{})
+	return ex, data
+}
+
+func testExchange() Exchange {
+	ex, _ := testExSim()
 	return ex
 }
 
@@ -29,6 +31,491 @@ func TestReduceOrder(t *testing.T) {
 	assert.Equal(t, err, ErrInvalidAmount)
 }
 
+func TestStopMarketOrder(t *testing.T) {
+	ex, _ := testExSim()
+	ob := ex.getOrderBook()
+
+	// 触发价已满足，立即触发并按市价成交
+	order, err := ex.PlaceOrder("BTC-PERPETUAL",
+		Buy, OrderTypeStopMarket, 0, 10,
+		OrderStopPxOption(ob.Price()-100))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, OrderStatusFilled, order.Status)
+	assert.Equal(t, 10.0, order.FilledAmount)
+
+	// 触发价未满足，等待触发
+	order, err = ex.PlaceOrder("BTC-PERPETUAL",
+		Buy, OrderTypeStopMarket, 0, 10,
+		OrderStopPxOption(ob.Price()+1000))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, OrderStatusUntriggered, order.Status)
+
+	orders, _ := ex.GetOpenOrders("BTC-PERPETUAL")
+	assert.Equal(t, 0, len(orders))
+	orders, _ = ex.GetOpenOrders("BTC-PERPETUAL", OrderStopOption(true))
+	assert.Equal(t, 1, len(orders))
+
+	_, err = ex.CancelOrder("BTC-PERPETUAL", order.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, OrderStatusCancelled, order.Status)
+}
+
+func TestStopLimitOrder(t *testing.T) {
+	ex, _ := testExSim()
+	ob := ex.getOrderBook()
+
+	_, err := ex.PlaceOrder("BTC-PERPETUAL",
+		Sell, OrderTypeStopLimit, ob.BidPrice()-1000, 10)
+	assert.NotNil(t, err)
+
+	order, err := ex.PlaceOrder("BTC-PERPETUAL",
+		Sell, OrderTypeStopLimit, ob.BidPrice()-1000, 10,
+		OrderStopPxOption(ob.Price()+100))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, OrderStatusFilled, order.Status)
+	assert.Equal(t, ob.BidPrice(), order.AvgPrice)
+}
+
+func testTrailingExSim(cash float64, bids ...float64) (*ExSim, *dataloader.Data) {
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	data := testSymbolData("BTC-PERPETUAL", start, bids...)
+	ex := NewExSim(data, cash, 0, 0, 1, false, false)
+	ex.SetBacktest(testutil.NewBacktest(data))
+	ex.SetExchangeLogger(&EmptyExchangeLogger{})
+	return ex, data
+}
+
+func TestTrailingStopMarketOrder(t *testing.T) {
+	// 中间价先上涨到 10200.5，再回落穿过 1% 回调
+	ex, data := testTrailingExSim(10, 10000, 10100, 10200, 10150, 10090)
+
+	order, err := ex.PlaceOrder("BTC-PERPETUAL",
+		Sell, OrderTypeTrailingStopMarket, 0, 10,
+		OrderCallbackRateOption(1))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, OrderStatusUntriggered, order.Status)
+	assert.InDelta(t, 10000.5*0.99, order.StopPx, 1e-6)
+
+	// 上涨时触发价跟随最高价上移
+	for _, price := range []float64{10100.5, 10200.5} {
+		data.Next()
+		assert.Nil(t, ex.RunEventLoopOnce())
+		assert.Equal(t, OrderStatusUntriggered, order.Status)
+		assert.InDelta(t, price*0.99, order.StopPx, 1e-6)
+	}
+
+	// 回落未达到触发价，触发价保持不变
+	data.Next()
+	assert.Nil(t, ex.RunEventLoopOnce())
+	assert.Equal(t, OrderStatusUntriggered, order.Status)
+	assert.InDelta(t, 10200.5*0.99, order.StopPx, 1e-6)
+
+	// 回落穿过触发价，按市价卖出
+	data.Next()
+	assert.Nil(t, ex.RunEventLoopOnce())
+	assert.Equal(t, OrderStatusFilled, order.Status)
+	assert.Equal(t, 10.0, order.FilledAmount)
+	assert.Equal(t, 10090.0, order.AvgPrice)
+
+	positions, _ := ex.GetPositions("BTC-PERPETUAL")
+	if assert.Equal(t, 1, len(positions)) {
+		assert.Equal(t, -10.0, positions[0].Size)
+		assert.Equal(t, 10090.0, positions[0].AvgPrice)
+	}
+	orders, _ := ex.GetOpenOrders("BTC-PERPETUAL", OrderStopOption(true))
+	assert.Equal(t, 0, len(orders))
+}
+
+func TestStopOrderRejected(t *testing.T) {
+	// 保证金只够开约 1000 张
+	ex, data := testTrailingExSim(0.001, 10000, 10100)
+
+	var updates []*Order
+	ex.SubscribeOrders(Market{Symbol: "BTC-PERPETUAL"}, func(v []*Order) {
+		updates = append(updates, v...)
+	})
+
+	order, err := ex.PlaceOrder("BTC-PERPETUAL",
+		Buy, OrderTypeStopMarket, 0, 2000,
+		OrderStopPxOption(10050))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, OrderStatusUntriggered, order.Status)
+
+	// 触发后保证金不足，委托被拒绝而不是停留在 New 状态
+	data.Next()
+	assert.NotNil(t, ex.RunEventLoopOnce())
+	assert.Equal(t, OrderStatusRejected, order.Status)
+	assert.Equal(t, 0.0, order.FilledAmount)
+	if assert.True(t, len(updates) > 0) {
+		assert.Equal(t, OrderStatusRejected, updates[len(updates)-1].Status)
+	}
+	orders, _ := ex.GetOpenOrders("BTC-PERPETUAL", OrderStopOption(true))
+	assert.Equal(t, 0, len(orders))
+	orders, _ = ex.GetOpenOrders("BTC-PERPETUAL")
+	assert.Equal(t, 0, len(orders))
+}
+
+func TestCancelAllOrders(t *testing.T) {
+	ex, _ := testTrailingExSim(10, 10000)
+
+	limit, err := ex.PlaceOrder("BTC-PERPETUAL", Buy, OrderTypeLimit, 9000, 10)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	stop, err := ex.PlaceOrder("BTC-PERPETUAL", Buy, OrderTypeStopMarket, 0, 10,
+		OrderStopPxOption(11000))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	// 只撤销条件委托
+	assert.Nil(t, ex.CancelAllOrders("BTC-PERPETUAL", OrderStopOption(true)))
+	assert.Equal(t, OrderStatusCancelled, stop.Status)
+	assert.Equal(t, OrderStatusNew, limit.Status)
+
+	// 默认撤销所有委托，包括条件委托
+	stop, err = ex.PlaceOrder("BTC-PERPETUAL", Buy, OrderTypeStopMarket, 0, 10,
+		OrderStopPxOption(11000))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Nil(t, ex.CancelAllOrders("BTC-PERPETUAL"))
+	assert.Equal(t, OrderStatusCancelled, stop.Status)
+	assert.Equal(t, OrderStatusCancelled, limit.Status)
+}
+
+func TestContractValue(t *testing.T) {
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	data := testSymbolData("BTC-PERPETUAL", start, 10000, 11000)
+	ex := NewExSim(data, 10, 0, 0.001, 0.5, false, false)
+	ex.SetBacktest(testutil.NewBacktest(data))
+	ex.SetExchangeLogger(&EmptyExchangeLogger{})
+
+	// 持仓数量以合约张数计，面值只用于计算盈亏和手续费
+	order, err := ex.PlaceOrder("BTC-PERPETUAL", Buy, OrderTypeMarket, 0, 10)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.InDelta(t, 10*0.5/10001.0*0.001, order.Commission, 1e-12)
+	positions, _ := ex.GetPositions("BTC-PERPETUAL")
+	assert.Equal(t, 10.0, positions[0].Size)
+
+	data.Next()
+	ex.RunEventLoopOnce()
+	order, err = ex.PlaceOrder("BTC-PERPETUAL", Sell, OrderTypeMarket, 0, 10)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	pnl, _ := CalcPnl(Buy, 10*0.5, 10001, 11000, false)
+	assert.InDelta(t, pnl, order.Pnl, 1e-12)
+	positions, _ = ex.GetPositions("BTC-PERPETUAL")
+	assert.Equal(t, 0.0, positions[0].Size)
+}
+
+func TestLiquidation(t *testing.T) {
+	ex, _ := testExSim()
+	_, err := ex.PlaceOrder("BTC-PERPETUAL",
+		Buy, OrderTypeMarket, 0, 1000)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	positions, _ := ex.GetPositions("BTC-PERPETUAL")
+	assert.Equal(t, 1000.0, positions[0].Size)
+	assert.True(t, positions[0].LiquidationPrice < 1) // 余额充足
+
+	// 减少余额，低于维持保证金时强平
+	ex.IO("AddBalance", "-9999.9996")
+	positions, _ = ex.GetPositions("BTC-PERPETUAL")
+	liquidationPrice := positions[0].LiquidationPrice
+	assert.True(t, liquidationPrice > 0)
+
+	err = ex.RunEventLoopOnce()
+	assert.Nil(t, err)
+	positions, _ = ex.GetPositions("BTC-PERPETUAL")
+	assert.Equal(t, 0.0, positions[0].Size)
+	assert.Equal(t, 0.0, positions[0].LiquidationPrice)
+}
+
+func TestGetPositionsEmpty(t *testing.T) {
+	ex, _ := testExSim()
+	positions, err := ex.GetPositions("BTC-PERPETUAL")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(positions))
+}
+
+// 多个标的同时达到强平价格时按标的名称顺序强平，结果确定
+func TestLiquidationOrder(t *testing.T) {
+	run := func() (symbols []string, balance float64) {
+		start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+		perp := testSymbolData("BTC-PERPETUAL", start, 10000, 5000)
+		future := testSymbolData("", start, 10000, 5000)
+		ex := NewExSim(perp, 1, 0, 0, 10, false, false)
+		ex.SetBacktest(testutil.NewBacktest(perp))
+		ex.SetExchangeLogger(&EmptyExchangeLogger{})
+		instrument := &Instrument{Symbol: "BTC-27DEC19", LotSize: 1, ContractValue: 10, Inverse: true}
+		if err := ex.AddSymbol(instrument, future); err != nil {
+			t.Fatal(err)
+		}
+		ex.SubscribeOrders(Market{}, func(orders []*Order) {
+			for _, v := range orders {
+				if v.ReduceOnly && v.Status == OrderStatusFilled {
+					symbols = append(symbols, v.Symbol)
+				}
+			}
+		})
+		for _, symbol := range []string{"BTC-PERPETUAL", "BTC-27DEC19"} {
+			if _, err := ex.PlaceOrder(symbol, Buy, OrderTypeMarket, 0, 5000); err != nil {
+				t.Fatal(err)
+			}
+		}
+		perp.Next()
+		future.Next()
+		ex.RunEventLoopOnce()
+		balance = ex.balance
+		return
+	}
+
+	symbols, balance := run()
+	if assert.True(t, len(symbols) > 0) {
+		assert.Equal(t, "BTC-27DEC19", symbols[0])
+	}
+	for i := 0; i < 20; i++ {
+		v, b := run()
+		assert.Equal(t, symbols, v)
+		assert.Equal(t, balance, b)
+	}
+}
+
+func TestFunding(t *testing.T) {
+	ex, data := testExSim()
+	ex.SetFundingSchedule(dataloader.NewFixedFundingSchedule(0.001, time.Second))
+	_, err := ex.PlaceOrder("BTC-PERPETUAL",
+		Buy, OrderTypeMarket, 0, 1000)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	balance := ex.balance
+	start := data.GetOrderBook().Time
+	for {
+		if err = ex.RunEventLoopOnce(); err != nil {
+			t.Error(err)
+			return
+		}
+		if !data.Next() {
+			break
+		}
+	}
+	end := data.GetOrderBook().Time
+	n := float64(end.Unix() - start.Unix())
+	t.Logf("fundingPnl: %v n: %v", ex.GetFundingPnl(), n)
+
+	// 多头支付资金费用
+	assert.True(t, ex.GetFundingPnl() < 0)
+	assert.InDelta(t, balance+ex.GetFundingPnl(), ex.balance, 1e-9)
+	assert.InDelta(t, -n*1000/ex.getOrderBook().Price()*0.001, ex.GetFundingPnl(), 1e-6)
+}
+
+func TestAmendOrder(t *testing.T) {
+	ex, data := testQueueExSim(FillModelQueue)
+	order, err := ex.PlaceOrder("BTC-PERPETUAL", Buy, OrderTypeLimit, 100, 30)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	// 修改数量保留排队位置
+	_, err = ex.AmendOrder("BTC-PERPETUAL", order.ID, 0, 20)
+	assert.Nil(t, err)
+	assert.Equal(t, 20.0, order.Amount)
+	assert.Equal(t, 50.0, ex.queues[order.ID].ahead)
+
+	// 修改价格失去排队位置，排在新价位末尾
+	_, err = ex.AmendOrder("BTC-PERPETUAL", order.ID, 99, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 99.0, order.Price)
+	assert.Equal(t, 100.0, ex.queues[order.ID].ahead)
+	data.Next()
+	ex.RunEventLoopOnce()
+	assert.Equal(t, 100.0, ex.queues[order.ID].ahead)
+
+	// 新价格可成交时立即撮合
+	_, err = ex.AmendOrder("BTC-PERPETUAL", order.ID, 101, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, OrderStatusFilled, order.Status)
+	assert.Equal(t, 101.0, order.AvgPrice)
+	_, err = ex.AmendOrder("BTC-PERPETUAL", order.ID, 102, 0)
+	assert.NotNil(t, err)
+}
+
+func TestAmendOrderBelowFilled(t *testing.T) {
+	ex, data := testQueueExSim(FillModelQueue)
+	order, err := ex.PlaceOrder("BTC-PERPETUAL", Buy, OrderTypeLimit, 100, 30)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	for i := 0; i < 5; i++ {
+		data.Next()
+		ex.RunEventLoopOnce()
+	}
+	assert.Equal(t, 5.0, order.FilledAmount)
+
+	_, err = ex.AmendOrder("BTC-PERPETUAL", order.ID, 0, 3)
+	assert.NotNil(t, err)
+
+	// 数量等于已成交数量，委托完成
+	_, err = ex.AmendOrder("BTC-PERPETUAL", order.ID, 0, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, OrderStatusFilled, order.Status)
+}
+
+func TestAmendLatency(t *testing.T) {
+	ex, data := testQueueExSim(FillModelOptimistic)
+	ex.SetLatency(nil, nil, FixedLatency(1500*time.Millisecond))
+	order, err := ex.PlaceOrder("BTC-PERPETUAL", Buy, OrderTypeLimit, 100, 30)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	_, err = ex.AmendOrder("BTC-PERPETUAL", order.ID, 101, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 100.0, order.Price)
+
+	data.Next()
+	ex.RunEventLoopOnce()
+	assert.Equal(t, OrderStatusNew, order.Status)
+
+	data.Next()
+	ex.RunEventLoopOnce()
+	assert.Equal(t, 101.0, order.Price)
+	assert.Equal(t, OrderStatusFilled, order.Status)
+}
+
+func TestSubscribe(t *testing.T) {
+	ex, data := testExSim()
+	market := Market{Symbol: "BTC-PERPETUAL"}
+
+	var obs []*OrderBook
+	var orders []*Order
+	var positions []*Position
+	ex.SubscribeLevel2Snapshots(market, func(ob *OrderBook) {
+		obs = append(obs, ob)
+	})
+	ex.SubscribeOrders(market, func(v []*Order) {
+		orders = append(orders, v...)
+	})
+	ex.SubscribePositions(market, func(v []*Position) {
+		positions = v
+	})
+	ex.SubscribeOrders(Market{Symbol: "ETH-PERPETUAL"}, func(v []*Order) {
+		t.Error("unexpected order")
+	})
+
+	ex.RunEventLoopOnce()
+	ex.RunEventLoopOnce()
+	assert.Equal(t, 1, len(obs))
+	data.Next()
+	ex.RunEventLoopOnce()
+	assert.Equal(t, 2, len(obs))
+	assert.Equal(t, data.GetOrderBook(), obs[1])
+
+	order, err := ex.PlaceOrder("BTC-PERPETUAL", Buy, OrderTypeMarket, 0, 10)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if assert.Equal(t, 1, len(orders)) {
+		assert.Equal(t, order.ID, orders[0].ID)
+		assert.Equal(t, OrderStatusFilled, orders[0].Status)
+	}
+	if assert.Equal(t, 1, len(positions)) {
+		assert.Equal(t, 10.0, positions[0].Size)
+	}
+}
+
+// testTradeLoader 内存成交记录
+type testTradeLoader struct {
+	trades      []*Trade
+	hasMoreData bool
+}
+
+func (l *testTradeLoader) Setup(start time.Time, end time.Time) error {
+	l.hasMoreData = true
+	return nil
+}
+
+func (l *testTradeLoader) ReadTrades() []*Trade {
+	l.hasMoreData = false
+	return l.trades
+}
+
+func (l *testTradeLoader) HasMoreData() bool {
+	return l.hasMoreData
+}
+
+func TestSubscribeTrades(t *testing.T) {
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	ms := start.UnixNano() / int64(time.Millisecond)
+	var obs []*OrderBook
+	for i := 0; i < 3; i++ {
+		obs = append(obs, &OrderBook{
+			Time: start.Add(time.Duration(i) * time.Second),
+			Asks: []Item{{Price: 101, Amount: 100}},
+			Bids: []Item{{Price: 100, Amount: 100}},
+		})
+	}
+	data := dataloader.NewData(testutil.NewDataLoader(obs))
+	data.SetTradeLoader(&testTradeLoader{trades: []*Trade{
+		{ID: "1", Direction: Buy, Price: 101, Amount: 10, Ts: ms + 500, Symbol: "BTC-PERPETUAL"},
+		{ID: "2", Direction: Sell, Price: 100, Amount: 20, Ts: ms + 1500, Symbol: "BTC-PERPETUAL"},
+	}})
+	data.Reset(start, start.Add(time.Hour))
+	ex := NewExSim(data, 10000, -0.00025, 0.00075, 1, false, false)
+	ex.SetBacktest(testutil.NewBacktest(data))
+	ex.SetExchangeLogger(&EmptyExchangeLogger{})
+
+	var trades []*Trade
+	ex.SubscribeTrades(Market{Symbol: "BTC-PERPETUAL"}, func(v []*Trade) {
+		trades = append(trades, v...)
+	})
+	ex.SubscribeTrades(Market{Symbol: "ETH-PERPETUAL"}, func(v []*Trade) {
+		t.Error("unexpected trade")
+	})
+
+	for data.Next() {
+		ex.RunEventLoopOnce()
+		ex.RunEventLoopOnce()
+	}
+	if assert.Equal(t, 2, len(trades)) {
+		assert.Equal(t, "1", trades[0].ID)
+		assert.Equal(t, "2", trades[1].ID)
+	}
+}
+
 // 计算公式:
 // https://www.reddit.com/r/DeribitExchange/
 // 选择菜单: Calculation Sheets-Liquidation Price
@@ -0,0 +1,121 @@
+package exsim
+
+import (
+	. "github.com/coinrust/crex"
+	"math"
+	"time"
+)
+
+// FillModel 限价挂单成交模型
+type FillModel int
+
+const (
+	FillModelOptimistic FillModel = iota // 对手盘穿过委托价即全部成交(默认)
+	FillModelQueue                       // 跟踪委托价位前方排队量，只有穿过的成交量才成交
+)
+
+func (m FillModel) String() string {
+	switch m {
+	case FillModelOptimistic:
+		return "Optimistic"
+	case FillModelQueue:
+		return "Queue"
+	default:
+		return "None"
+	}
+}
+
+// 挂单排队信息
+type queueInfo struct {
+	ahead     float64    // 排在前方的挂单量
+	amount    float64    // 该价位的挂单量(上一次盘口扣除之后的成交)
+	ob        *OrderBook // 已处理的盘口，成交步的盘口不变
+	lastTrade *Trade     // 已处理的最后一批成交
+}
+
+// SetFillModel 设置限价挂单成交模型
+func (b *ExSim) SetFillModel(model FillModel) {
+	b.fillModel = model
+}
+
+// 委托价位在盘口同方向的挂单量，ok: 该价位在盘口中
+func levelAmount(order *Order, ob *OrderBook) (amount float64, ok bool) {
+	items := ob.Bids
+	if order.Direction == Sell {
+		items = ob.Asks
+	}
+	for _, v := range items {
+		if v.Price == order.Price {
+			return v.Amount, true
+		}
+	}
+	return 0, false
+}
+
+// 按排队位置撮合挂单
+// 只有成交记录才消耗排队量: 委托价位的成交先消耗前方排队量，超出部分与本委托成交，穿过委托价的成交全部与本委托成交
+// 价位挂单量的其他减少视为撤单，按前后挂单量的比例分摊；增加的挂单排在本委托之后
+// 价位不在盘口中时(价格远离或超出快照深度)排队信息不变
+func (b *ExSim) matchQueue(order *Order, ob *OrderBook) (match bool) {
+	trades := b.currentTrades(order.Symbol)
+	amount, ok := levelAmount(order, ob)
+	q, exists := b.queues[order.ID]
+	if !exists {
+		// 新挂单排在该价位末尾，之前的成交与本委托无关
+		q = &queueInfo{ahead: amount, amount: amount, ob: ob}
+		if len(trades) > 0 {
+			q.lastTrade = trades[0]
+		}
+		b.queues[order.ID] = q
+		return
+	}
+
+	var volume float64
+	if len(trades) > 0 && trades[0] != q.lastTrade {
+		q.lastTrade = trades[0]
+		market := Market{Symbol: order.Symbol}
+		var level, through float64
+		for _, v := range trades {
+			// 主动成交方向与委托相反才会与委托价位成交
+			if v.Direction == order.Direction || !matchSymbol(market, v.Symbol) {
+				continue
+			}
+			if v.Price == order.Price {
+				level += v.Amount
+			} else if (order.Direction == Buy) == (v.Price < order.Price) {
+				through += v.Amount
+			}
+		}
+		volume = math.Max(level-q.ahead, 0) + through
+		q.ahead = math.Max(q.ahead-level, 0)
+		q.amount = math.Max(q.amount-level, 0)
+		if through > 0 {
+			q.ahead = 0
+		}
+	}
+
+	if ob != q.ob && ok {
+		if cancelled := q.amount - amount; cancelled > 0 && q.amount > 0 {
+			q.ahead -= cancelled * q.ahead / q.amount
+		}
+		q.amount = amount
+	}
+	q.ob = ob
+
+	if volume <= 0 {
+		return
+	}
+	remaining := order.Amount - order.FilledAmount
+	b.fillOrder(order, math.Min(remaining, volume), order.Price, false, ob.Time)
+	match = true
+	return
+}
+
+// 委托所在标的当前步的成交，不包括回测时间之后的成交
+func (b *ExSim) currentTrades(symbol string) []*Trade {
+	trades := b.getData(symbol).GetTrades()
+	if len(trades) == 0 || trades[0].Ts*int64(time.Millisecond) > b.backtest.GetTime().UnixNano() {
+		return nil
+	}
+	return trades
+}
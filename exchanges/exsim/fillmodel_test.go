@@ -0,0 +1,153 @@
+package exsim
+
+import (
+	. "github.com/coinrust/crex"
+	"github.com/coinrust/crex/dataloader"
+	"github.com/coinrust/crex/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// 买一价 100 的挂单量依次变化，最后卖单穿过 100
+func testQueueExSim(model FillModel) (*ExSim, *dataloader.Data) {
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	var obs []*OrderBook
+	add := func(bidAmount float64, ask Item) {
+		obs = append(obs, &OrderBook{
+			Time: start.Add(time.Duration(len(obs)) * time.Second),
+			Asks: []Item{ask, {Price: ask.Price + 1, Amount: 100}},
+			Bids: []Item{{Price: 100, Amount: bidAmount}, {Price: 99, Amount: 100}},
+		})
+	}
+	add(50, Item{Price: 101, Amount: 100}) // 排在 50 之后
+	add(30, Item{Price: 101, Amount: 100}) // 撤单 20，前方剩余 30
+	add(10, Item{Price: 101, Amount: 100}) // 撤单 20，前方剩余 10
+	add(60, Item{Price: 101, Amount: 100}) // 新增挂单排在后面
+	add(40, Item{Price: 101, Amount: 100}) // 撤单 20，按比例分摊，没有成交记录不成交
+	add(40, Item{Price: 100, Amount: 5})   // 穿过 5，成交 5
+	add(40, Item{Price: 99, Amount: 100})  // 穿过，全部成交
+
+	data := testutil.NewData(obs, start, start.Add(time.Hour))
+	ex := NewExSim(data, 10000, -0.00025, 0.00075, 1, false, false)
+	ex.SetBacktest(testutil.NewBacktest(data))
+	ex.SetExchangeLogger(&EmptyExchangeLogger{})
+	ex.SetFillModel(model)
+	return ex, data
+}
+
+func TestFillModelQueue(t *testing.T) {
+	ex, data := testQueueExSim(FillModelQueue)
+	order, err := ex.PlaceOrder("BTC-PERPETUAL", Buy, OrderTypeLimit, 100, 30)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, OrderStatusNew, order.Status)
+
+	filled := []float64{0, 0, 0, 0, 5, 30}
+	for i := 0; data.Next(); i++ {
+		ex.RunEventLoopOnce()
+		assert.Equal(t, filled[i], order.FilledAmount, "index %v", i)
+	}
+	assert.Equal(t, OrderStatusFilled, order.Status)
+	assert.Equal(t, 100.0, order.AvgPrice)
+}
+
+func TestFillModelOptimistic(t *testing.T) {
+	ex, data := testQueueExSim(FillModelOptimistic)
+	order, err := ex.PlaceOrder("BTC-PERPETUAL", Buy, OrderTypeLimit, 100, 30)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	filled := []float64{0, 0, 0, 0, 30, 30}
+	for i := 0; data.Next(); i++ {
+		ex.RunEventLoopOnce()
+		assert.Equal(t, filled[i], order.FilledAmount, "index %v", i)
+	}
+	assert.Equal(t, OrderStatusFilled, order.Status)
+}
+
+func newQueueExSim(obs []*OrderBook, trades []*Trade) (*ExSim, *dataloader.Data) {
+	start := obs[0].Time
+	data := dataloader.NewData(testutil.NewDataLoader(obs))
+	if len(trades) > 0 {
+		data.SetTradeLoader(&testTradeLoader{trades: trades})
+	}
+	data.Reset(start, start.Add(time.Hour))
+	ex := NewExSim(data, 10000, -0.00025, 0.00075, 1, false, false)
+	ex.SetBacktest(testutil.NewBacktest(data))
+	ex.SetExchangeLogger(&EmptyExchangeLogger{})
+	ex.SetFillModel(FillModelQueue)
+	return ex, data
+}
+
+// 价格远离委托价，委托价位不在盘口中，排队信息不变
+func TestFillModelQueue_PriceMovesAway(t *testing.T) {
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	ob := func(i int, bid float64, bidAmount float64) *OrderBook {
+		return &OrderBook{
+			Time: start.Add(time.Duration(i) * time.Second),
+			Asks: []Item{{Price: bid + 1, Amount: 100}, {Price: bid + 2, Amount: 100}},
+			Bids: []Item{{Price: bid, Amount: bidAmount}, {Price: bid - 1, Amount: 100}},
+		}
+	}
+	obs := []*OrderBook{ob(0, 100, 50), ob(1, 103, 100), ob(2, 100, 50)}
+	ex, data := newQueueExSim(obs, nil)
+	order, err := ex.PlaceOrder("BTC-PERPETUAL", Buy, OrderTypeLimit, 100, 30)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	for data.Next() {
+		ex.RunEventLoopOnce()
+		assert.Equal(t, 0.0, order.FilledAmount)
+		assert.Equal(t, 50.0, ex.queues[order.ID].ahead)
+	}
+	assert.Equal(t, OrderStatusNew, order.Status)
+}
+
+// 后方的撤单按比例分摊，只有成交记录消耗前方排队量
+func TestFillModelQueue_CancelBehind(t *testing.T) {
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	ms := start.UnixNano() / int64(time.Millisecond)
+	var obs []*OrderBook
+	for i, bidAmount := range []float64{20, 80, 40} {
+		obs = append(obs, &OrderBook{
+			Time: start.Add(time.Duration(i) * time.Second),
+			Asks: []Item{{Price: 101, Amount: 100}},
+			Bids: []Item{{Price: 100, Amount: bidAmount}, {Price: 99, Amount: 100}},
+		})
+	}
+	obs = append(obs, &OrderBook{
+		Time: start.Add(3 * time.Second),
+		Asks: []Item{{Price: 100, Amount: 100}},
+		Bids: []Item{{Price: 99, Amount: 100}},
+	})
+	ex, data := newQueueExSim(obs, []*Trade{
+		{ID: "1", Direction: Buy, Price: 100, Amount: 50, Ts: ms + 2100},  // 主动买入不与买单成交
+		{ID: "2", Direction: Sell, Price: 100, Amount: 15, Ts: ms + 2500}, // 前方 10，成交 5
+		{ID: "3", Direction: Sell, Price: 99, Amount: 100, Ts: ms + 2700}, // 穿过，全部成交
+	})
+	order, err := ex.PlaceOrder("BTC-PERPETUAL", Buy, OrderTypeLimit, 100, 30)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	// 20 之后；新增 60 排在后面；撤单 40 按 20:60 分摊，前方剩余 10
+	ahead := []float64{20, 10, 10, 0, 0, 0}
+	filled := []float64{0, 0, 0, 5, 30, 30}
+	for i := 0; data.Next(); i++ {
+		ex.RunEventLoopOnce()
+		ex.RunEventLoopOnce() // 同一步的成交只处理一次
+		assert.Equal(t, filled[i], order.FilledAmount, "index %v", i)
+		if q, ok := ex.queues[order.ID]; ok {
+			assert.InDelta(t, ahead[i], q.ahead, 1e-9, "index %v", i)
+		}
+	}
+	assert.Equal(t, OrderStatusFilled, order.Status)
+	assert.Equal(t, 100.0, order.AvgPrice)
+}
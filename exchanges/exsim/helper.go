@@ -45,6 +45,43 @@ func CalcPnl(side Direction, positionSize float64, entryPrice float64, exitPrice
 // 平均成交价
 // total_quantity / ((quantity_1 / price_1) + (quantity_2 / price_2)) = entry_price
 
+// 正向合约维持保证金率
+const ForwardMaintMarginRate = 0.005
+
+// 计算强平价格，保证金余额减去维持保证金后全部亏损时的价格
+// positionSize: 持仓大小(正向合约为币数量，反向合约为USD)
+// marginBalance: 可用于该持仓的保证金余额(全仓模式包含其他持仓的浮动盈亏)
+// 返回 0 表示不会强平
+func CalcLiquidationPrice(side Direction, positionSize float64, entryPrice float64, marginBalance float64, forwardContract bool) (liquidationPrice float64) {
+	if positionSize <= 0 || entryPrice <= 0 {
+		return
+	}
+	if forwardContract {
+		lose := marginBalance - positionSize*entryPrice*ForwardMaintMarginRate
+		if side == Buy {
+			liquidationPrice = entryPrice - lose/positionSize
+		} else {
+			liquidationPrice = entryPrice + lose/positionSize
+		}
+	} else {
+		lose := marginBalance - CalcMaintMargin(positionSize/entryPrice)
+		if side == Buy {
+			liquidationPrice = entryPrice / (((lose * entryPrice) / positionSize) + 1)
+		} else {
+			d := 1 - ((lose * entryPrice) / positionSize)
+			if d <= 0 {
+				// 没有用到杠杆
+				return
+			}
+			liquidationPrice = entryPrice / d
+		}
+	}
+	if liquidationPrice < 0 {
+		liquidationPrice = 0
+	}
+	return
+}
+
 // 少于此保证金，则强制挂平仓单
 func CalcMaintMargin(sizeCurrency float64) float64 {
 	return (0.0055 + (sizeCurrency * 0.00005)) * sizeCurrency
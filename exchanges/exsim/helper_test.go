@@ -2,6 +2,7 @@ package exsim
 
 import (
 	. "github.com/coinrust/crex"
+	"github.com/stretchr/testify/assert"
 	"testing"
 )
 
@@ -9,7 +10,24 @@ func TestCalcPnl(t *testing.T) {
 	size := 50.0
 	entryPrice := 10351.5
 	exitPrice := 10348.5
-	pnl, pnlUsd := CalcPnl(Buy, size, entryPrice, exitPrice)
+	pnl, pnlUsd := CalcPnl(Buy, size, entryPrice, exitPrice, false)
 	t.Logf("pnl: %.8f", pnl)
 	t.Logf("pnlUsd: %.8f", pnlUsd)
 }
+
+func TestCalcLiquidationPriceHelper(t *testing.T) {
+	// 反向合约: 与 CalcMarginInfo 结果接近(维持保证金系数不同)
+	info := CalcMarginInfo(0.05, 6500, 6500)
+	price := CalcLiquidationPrice(Buy, 6500, 6500, 0.05, false)
+	t.Logf("long: %v/%v", price, info.LiquidationPriceLong)
+	assert.InDelta(t, info.LiquidationPriceLong, price, 5)
+	price = CalcLiquidationPrice(Sell, 6500, 6500, 0.05, false)
+	t.Logf("short: %v/%v", price, info.LiquidationPriceShort)
+	assert.InDelta(t, info.LiquidationPriceShort, price, 5)
+
+	// 正向合约: 1 BTC, 1000 USDT
+	price = CalcLiquidationPrice(Buy, 1, 10000, 1000, true)
+	assert.InDelta(t, 9050.0, price, 1e-6)
+	price = CalcLiquidationPrice(Sell, 1, 10000, 1000, true)
+	assert.InDelta(t, 10950.0, price, 1e-6)
+}
@@ -0,0 +1,127 @@
+package exsim
+
+import (
+	"errors"
+	. "github.com/coinrust/crex"
+	"github.com/coinrust/crex/dataloader"
+	"time"
+)
+
+// AddSymbol 增加交易标，每个标使用独立的数据和合约规格，所有标共用账户余额(全仓)
+// 所有标的数据都需要加入回测数据中，以便随回测时间推进
+// 各标的结算货币需要相同，如同一币种的永续和交割合约
+func (b *ExSim) AddSymbol(instrument *Instrument, data *dataloader.Data) (err error) {
+	if instrument.Symbol == "" {
+		err = errors.New("symbol is empty")
+		return
+	}
+	if instrument.ContractValue == 0 {
+		err = errors.New("contract value is zero")
+		return
+	}
+	b.instruments[instrument.Symbol] = instrument
+	b.datas[instrument.Symbol] = data
+	return
+}
+
+// SetInstrument 设置默认数据对应标的合约规格
+func (b *ExSim) SetInstrument(instrument *Instrument) (err error) {
+	if instrument.ContractValue == 0 {
+		err = errors.New("contract value is zero")
+		return
+	}
+	b.instrument = instrument
+	return
+}
+
+// GetInstruments 返回默认标和所有已添加标的合约规格
+func (b *ExSim) GetInstruments() (result []*Instrument, err error) {
+	instrument := *b.instrument
+	if instrument.Symbol == "" {
+		instrument.Symbol = b.symbol
+	}
+	result = append(result, &instrument)
+	for _, v := range b.instruments {
+		result = append(result, v)
+	}
+	return
+}
+
+// GetInstrument 返回标的合约规格，未添加的标返回默认规格
+func (b *ExSim) GetInstrument(symbol string) (result *Instrument, err error) {
+	if instrument, ok := b.instruments[symbol]; ok {
+		result = instrument
+		return
+	}
+	instrument := *b.instrument
+	if instrument.Symbol == "" {
+		instrument.Symbol = symbol
+	}
+	result = &instrument
+	return
+}
+
+// 获取合约规格，未添加的标使用默认规格
+func (b *ExSim) getInstrument(symbol string) *Instrument {
+	if instrument, ok := b.instruments[symbol]; ok {
+		return instrument
+	}
+	return b.instrument
+}
+
+// 获取标对应的数据，未添加的标使用默认数据
+func (b *ExSim) getData(symbol string) *dataloader.Data {
+	if data, ok := b.datas[symbol]; ok {
+		return data
+	}
+	return b.data
+}
+
+// 推送所有标的订单薄和成交
+func (b *ExSim) emitMarketData() {
+	b.emitOrderBook("", b.data)
+	b.emitTrades(b.data)
+	for symbol, data := range b.datas {
+		b.emitOrderBook(symbol, data)
+		b.emitTrades(data)
+	}
+}
+
+// 推送订单薄，每个订单薄只推送一次
+func (b *ExSim) emitOrderBook(symbol string, data *dataloader.Data) {
+	ob := data.GetOrderBookByNS("", b.backtest.GetTime().UnixNano())
+	if ob == nil || ob == b.lastOrderBooks[data] {
+		return
+	}
+	b.lastOrderBooks[data] = ob
+	if ob.Symbol == "" && symbol != "" {
+		// 数据中没有标信息时补充
+		v := *ob
+		v.Symbol = symbol
+		ob = &v
+	}
+	b.emitter.Emit(WSEventL2Snapshot, ob)
+}
+
+// 推送当前步的成交，每笔成交只推送一次
+func (b *ExSim) emitTrades(data *dataloader.Data) {
+	trades := data.GetTrades()
+	if len(trades) == 0 || trades[0] == b.lastTrades[data] {
+		return
+	}
+	if trades[0].Ts*int64(time.Millisecond) > b.backtest.GetTime().UnixNano() {
+		return
+	}
+	b.lastTrades[data] = trades[0]
+	b.emitter.Emit(WSEventTrade, trades)
+}
+
+// 检查委托价格和数量，市价单不检查价格
+func (b *ExSim) validateOrder(order *Order) error {
+	var price float64
+	switch order.Type {
+	case OrderTypeLimit, OrderTypeStopLimit:
+		price = order.Price
+	}
+	return b.getInstrument(order.Symbol).ValidateOrder(price, order.Amount)
+}
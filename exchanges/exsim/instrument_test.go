@@ -0,0 +1,99 @@
+package exsim
+
+import (
+	. "github.com/coinrust/crex"
+	"github.com/coinrust/crex/dataloader"
+	"github.com/coinrust/crex/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// 每秒一个订单薄
+func testSymbolData(symbol string, start time.Time, bids ...float64) *dataloader.Data {
+	return testutil.NewData(testutil.OrderBooks(symbol, start, time.Second, 10000, bids...), start, start.Add(time.Hour))
+}
+
+func TestMultiSymbol(t *testing.T) {
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	perp := testSymbolData("BTC-PERPETUAL", start, 10000, 10500)
+	future := testSymbolData("", start, 10100, 10200)
+
+	ex := NewExSim(perp, 1, 0, 0, 10, false, false)
+	ex.SetBacktest(testutil.NewBacktest(perp))
+	ex.SetExchangeLogger(&EmptyExchangeLogger{})
+	instrument := &Instrument{Symbol: "BTC-27DEC19", TickSize: 0.5, LotSize: 1, ContractValue: 100, Inverse: true}
+	if err := ex.AddSymbol(instrument, future); err != nil {
+		t.Error(err)
+		return
+	}
+
+	ob, _ := ex.GetOrderBook("BTC-27DEC19", 1)
+	assert.Equal(t, 10100.0, ob.BidPrice())
+	assert.Equal(t, 100.0, ex.GetPValue("BTC-27DEC19"))
+	assert.Equal(t, 10.0, ex.GetPValue("BTC-PERPETUAL"))
+	instruments, _ := ex.GetInstruments()
+	assert.Equal(t, 2, len(instruments))
+	v, _ := ex.GetInstrument("BTC-PERPETUAL")
+	assert.Equal(t, "BTC-PERPETUAL", v.Symbol)
+	assert.True(t, v.Inverse)
+
+	// 跨期套利: 买入永续，卖出交割
+	_, err := ex.PlaceOrder("BTC-PERPETUAL", Buy, OrderTypeMarket, 0, 100)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	order, err := ex.PlaceOrder("BTC-27DEC19", Sell, OrderTypeMarket, 0, 10)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, 10100.0, order.AvgPrice)
+
+	_, err = ex.PlaceOrder("BTC-27DEC19", Sell, OrderTypeLimit, 10300.3, 10)
+	assert.Equal(t, ErrInvalidPrice, err)
+	_, err = ex.PlaceOrder("BTC-27DEC19", Sell, OrderTypeLimit, 10300.5, 2.5)
+	assert.Equal(t, ErrInvalidAmount, err)
+	_, err = ex.PlaceOrder("BTC-27DEC19", Sell, OrderTypeLimit, 10300.5, 10)
+	assert.Nil(t, err)
+
+	perp.Next()
+	future.Next()
+	ex.RunEventLoopOnce()
+
+	positions, _ := ex.GetPositions("BTC-PERPETUAL")
+	assert.Equal(t, 100.0, positions[0].Size)
+	positions, _ = ex.GetPositions("BTC-27DEC19")
+	assert.Equal(t, -10.0, positions[0].Size)
+
+	// 权益包含两个标的浮动盈亏
+	perpPnl, _ := CalcPnl(Buy, 100*10, 10001, 10501, false)
+	futurePnl, _ := CalcPnl(Sell, 10*100, 10100, 10200, false)
+	balance, _ := ex.GetBalance("BTC")
+	assert.Equal(t, 1.0, balance.Available)
+	assert.InDelta(t, 1+perpPnl+futurePnl, balance.Equity, 1e-12)
+}
+
+// 默认规格不限制数量精度，设置规格后所有委托类型都按 LotSize 检查
+func TestDefaultLotSize(t *testing.T) {
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	data := testSymbolData("BTC-PERPETUAL", start, 10000)
+	ex := NewExSim(data, 1, 0, 0, 10, false, false)
+	ex.SetBacktest(testutil.NewBacktest(data))
+	ex.SetExchangeLogger(&EmptyExchangeLogger{})
+
+	order, err := ex.PlaceOrder("BTC-PERPETUAL", Buy, OrderTypeLimit, 9000, 15)
+	if assert.Nil(t, err) {
+		assert.Equal(t, OrderStatusNew, order.Status)
+	}
+	_, err = ex.PlaceOrder("BTC-PERPETUAL", Buy, OrderTypeMarket, 0, 15)
+	assert.Nil(t, err)
+
+	err = ex.SetInstrument(&Instrument{LotSize: 10, ContractValue: 10, Inverse: true})
+	assert.Nil(t, err)
+	_, err = ex.PlaceOrder("BTC-PERPETUAL", Buy, OrderTypeLimit, 9000, 15)
+	assert.Equal(t, ErrInvalidAmount, err)
+	_, err = ex.PlaceOrder("BTC-PERPETUAL", Buy, OrderTypeMarket, 0, 15)
+	assert.Equal(t, ErrInvalidAmount, err)
+}
@@ -0,0 +1,10 @@
+package exsim
+
+import (
+	. "github.com/coinrust/crex"
+)
+
+// SetLatency 设置下单、撤单、修改委托的延迟，nil 表示无延迟
+func (b *ExSim) SetLatency(placeLatency Latency, cancelLatency Latency, amendLatency Latency) {
+	b.latency.SetLatency(placeLatency, cancelLatency, amendLatency)
+}
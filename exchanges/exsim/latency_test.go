@@ -0,0 +1,70 @@
+package exsim
+
+import (
+	. "github.com/coinrust/crex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestPlaceLatency(t *testing.T) {
+	ex, data := testQueueExSim(FillModelOptimistic)
+	ex.SetLatency(FixedLatency(1500*time.Millisecond), nil, nil)
+
+	// 下单时可立即成交，但委托 1.5 秒后才到达交易所
+	order, err := ex.PlaceOrder("BTC-PERPETUAL", Buy, OrderTypeLimit, 101, 10)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, OrderStatusCreated, order.Status)
+	orders, _ := ex.GetOpenOrders("BTC-PERPETUAL")
+	assert.Equal(t, 1, len(orders))
+
+	data.Next()
+	ex.RunEventLoopOnce()
+	assert.Equal(t, OrderStatusCreated, order.Status)
+
+	data.Next()
+	ex.RunEventLoopOnce()
+	assert.Equal(t, OrderStatusFilled, order.Status)
+	assert.Equal(t, 101.0, order.AvgPrice)
+	assert.Equal(t, data.GetOrderBook().Time, order.UpdateTime)
+}
+
+func TestCancelLatency(t *testing.T) {
+	ex, data := testQueueExSim(FillModelOptimistic)
+	ex.SetLatency(nil, FixedLatency(1500*time.Millisecond), nil)
+
+	order, err := ex.PlaceOrder("BTC-PERPETUAL", Buy, OrderTypeLimit, 100, 30)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	_, err = ex.CancelOrder("BTC-PERPETUAL", order.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, OrderStatusCancelPending, order.Status)
+
+	data.Next()
+	ex.RunEventLoopOnce()
+	assert.Equal(t, OrderStatusCancelPending, order.Status)
+
+	data.Next()
+	ex.RunEventLoopOnce()
+	assert.Equal(t, OrderStatusCancelled, order.Status)
+	orders, _ := ex.GetOpenOrders("BTC-PERPETUAL")
+	assert.Equal(t, 0, len(orders))
+
+	// 撤单到达前成交
+	order, err = ex.PlaceOrder("BTC-PERPETUAL", Buy, OrderTypeLimit, 100, 30)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	data.Next()
+	data.Next() // 下一个订单薄卖单穿过 100
+	ex.CancelOrder("BTC-PERPETUAL", order.ID)
+	data.Next()
+	ex.RunEventLoopOnce()
+	assert.Equal(t, OrderStatusFilled, order.Status)
+}
@@ -4,8 +4,10 @@ import (
 	"errors"
 	"fmt"
 	"github.com/beaquant/utils/logger"
+	"github.com/chuckpreslar/emission"
 	. "github.com/coinrust/crex"
 	"github.com/coinrust/crex/dataloader"
+	"github.com/coinrust/crex/utils"
 	"github.com/sirupsen/logrus"
 	"math"
 	"time"
@@ -23,6 +25,7 @@ type GenerateSim struct {
 	positions          map[string][]Position // Position key: symbol, index:0, long; 1, short, when dual side mode, its size is 2, otherwise size is 1
 	isDualSidePosition bool                  // dual side position
 	isForwardContract  bool                  // forward contract, otherwise reverse contract
+	instrument         *Instrument           // instrument spec, nil means no validation
 	totalFee           float64
 	shortCnt           float64
 	shortWinCnt        float64
@@ -33,6 +36,16 @@ type GenerateSim struct {
 	logger             *logrus.Logger
 	backtest           IBacktest
 	eLog               ExchangeLogger
+	fundingSchedule    dataloader.FundingSchedule // funding rate schedule
+	lastFundingTime    time.Time
+	lastFundingPrice   float64 // price of the tick at lastFundingTime
+	fundingPnl         float64
+	emitter            *emission.Emitter
+	lastOrderBook      *OrderBook        // the last emitted orderbook
+	lastTrade          *Trade            // the last emitted trade
+	idGen              *utils.IdGenerate // order id generator, nil means the global one
+	latency            *LatencyQueue     // requests not yet arrived at the exchange
+	latencyHandler     *LatencyHandler   // matching of arrived requests
 }
 
 func NewGenerateSim(data *dataloader.Data, cash float64, makerFeeRate float64, takerFeeRate float64, isForwardContract bool, posMode ...bool) *GenerateSim {
@@ -40,7 +53,7 @@ func NewGenerateSim(data *dataloader.Data, cash float64, makerFeeRate float64, t
 	if len(posMode) > 0 {
 		isDualSidePosition = posMode[0]
 	}
-	return &GenerateSim{
+	s := &GenerateSim{
 		data:               data,
 		balance:            cash,
 		makerFeeRate:       makerFeeRate, // -0.00025 // Maker 费率
@@ -52,13 +65,26 @@ func NewGenerateSim(data *dataloader.Data, cash float64, makerFeeRate float64, t
 		isDualSidePosition: isDualSidePosition,
 		isForwardContract:  isForwardContract,
 		logger:             logger.NewLogger("generatesim.log"),
+		emitter:            emission.NewEmitter(),
+		latency:            NewLatencyQueue(),
 	}
+	s.latencyHandler = &LatencyHandler{
+		Arrive: s.arriveOrder,
+		Amend:  s.arriveAmend,
+		Match:  s.runMatchOrder,
+	}
+	return s
 }
 
 func (s *GenerateSim) GetName() (name string) {
 	return "generate"
 }
 
+// GetFeeRates returns the maker and taker fee rates
+func (s *GenerateSim) GetFeeRates() (makerFeeRate float64, takerFeeRate float64) {
+	return s.makerFeeRate, s.takerFeeRate
+}
+
 func (s *GenerateSim) GetTime() (tm int64, err error) {
 	//if s.data != nil && s.data.GetOrderBook() != nil {
 	//	return s.data.GetOrderBook().Time.UnixNano() / int64(time.Millisecond), nil
@@ -98,8 +124,9 @@ func (s *GenerateSim) GetOrderBook(symbol string, depth int) (result *OrderBook,
 	return
 }
 
+// GetRecords returns only the bars closed before the current backtest time
 func (s *GenerateSim) GetRecords(symbol string, period string, from int64, end int64, limit int) (records []*Record, err error) {
-	return
+	return s.data.GetRecordsByNS(period, s.backtest.GetTime().UnixNano(), from, end, limit)
 }
 
 func (s *GenerateSim) SetContractType(pair string, contractType string) (err error) {
@@ -110,6 +137,35 @@ func (s *GenerateSim) GetContractID() (symbol string, err error) {
 	return
 }
 
+// SetInstrument set the instrument spec used to validate orders
+func (s *GenerateSim) SetInstrument(instrument *Instrument) {
+	s.instrument = instrument
+}
+
+func (s *GenerateSim) GetInstruments() (result []*Instrument, err error) {
+	var instrument *Instrument
+	instrument, err = s.GetInstrument("")
+	if err != nil {
+		return
+	}
+	result = []*Instrument{instrument}
+	return
+}
+
+// GetInstrument returns the configured instrument, or a default spec with size in contracts of value 1
+func (s *GenerateSim) GetInstrument(symbol string) (result *Instrument, err error) {
+	if s.instrument != nil {
+		result = s.instrument
+		return
+	}
+	result = &Instrument{
+		Symbol:        symbol,
+		ContractValue: 1,
+		Inverse:       !s.isForwardContract,
+	}
+	return
+}
+
 func (s *GenerateSim) SetLeverRate(value float64) (err error) {
 	return
 }
@@ -156,8 +212,17 @@ func (s *GenerateSim) PlaceOrder(symbol string, direction Direction, orderType O
 		err = errors.New("size is zero")
 		return
 	}
+	if s.instrument != nil {
+		var orderPrice float64
+		if orderType != OrderTypeMarket {
+			orderPrice = price
+		}
+		if err = s.instrument.ValidateOrder(orderPrice, size); err != nil {
+			return
+		}
+	}
 	params := ParsePlaceOrderParameter(opts...)
-	id := GenOrderId()
+	id := GenOrderIdBy(s.idGen)
 	ob := s.data.GetOrderBook()
 	order := &Order{
 		ID:           id,
@@ -184,10 +249,13 @@ func (s *GenerateSim) PlaceOrder(symbol string, direction Direction, orderType O
 		"params", params,
 	)
 
-	err = s.matchOrder(order, true)
-	if err != nil {
-		s.eLog.Error(err)
-		return
+	if !s.latency.Place(order, s.backtest.GetTime()) {
+		// without place latency the order is matched immediately, otherwise when it arrives
+		err = s.matchOrder(order, true)
+		if err != nil {
+			s.eLog.Error(err)
+			return
+		}
 	}
 
 	if order.IsOpen() {
@@ -199,6 +267,7 @@ func (s *GenerateSim) PlaceOrder(symbol string, direction Direction, orderType O
 	s.orders[id] = order
 	result = order
 	s.logOrderInfo("Place order", SimEventOrder, order)
+	s.emitOrder(order)
 	return
 }
 
@@ -290,6 +359,7 @@ func (s *GenerateSim) matchMarketOrder(order *Order) (err error) {
 	}
 	order.UpdateTime = ob.Time
 	order.Status = OrderStatusFilled
+	s.emitPositions(order.Symbol)
 	return
 }
 
@@ -307,7 +377,7 @@ func (s *GenerateSim) matchLimitOrder(order *Order, immediate bool) (err error)
 			}
 
 			// match trade
-			size := order.Amount
+			size := order.Amount - order.FilledAmount
 			var fee float64
 
 			// trade fee
@@ -323,6 +393,7 @@ func (s *GenerateSim) matchLimitOrder(order *Order, immediate bool) (err error)
 
 			// Update position
 			s.updatePosition(order.Symbol, size, order.Price, order.ReduceOnly)
+			s.fillLimitOrder(order, fee, ob.Time)
 		}
 	} else { // Ask order
 		if order.Price <= ob.BidPrice() {
@@ -332,7 +403,7 @@ func (s *GenerateSim) matchLimitOrder(order *Order, immediate bool) (err error)
 			}
 
 			// match trade
-			size := order.Amount
+			size := order.Amount - order.FilledAmount
 			var fee float64
 
 			// trade fee
@@ -348,11 +419,22 @@ func (s *GenerateSim) matchLimitOrder(order *Order, immediate bool) (err error)
 
 			// Update position
 			s.updatePosition(order.Symbol, -size, order.Price, order.ReduceOnly)
+			s.fillLimitOrder(order, fee, ob.Time)
 		}
 	}
 	return
 }
 
+// fillLimitOrder mark the limit order as filled at its price
+func (s *GenerateSim) fillLimitOrder(order *Order, fee float64, tm time.Time) {
+	order.FilledAmount = order.Amount
+	order.AvgPrice = order.Price
+	order.Commission += fee
+	order.UpdateTime = tm
+	order.Status = OrderStatusFilled
+	s.emitPositions(order.Symbol)
+}
+
 // 更新持仓
 func (s *GenerateSim) updatePosition(symbol string, size float64, price float64, isReduce bool) (amount float64, err error) {
 	position := s.getPosition(symbol)
@@ -556,9 +638,13 @@ func (s *GenerateSim) CancelOrder(symbol string, id string, opts ...OrderOption)
 		}
 		switch order.Status {
 		case OrderStatusCreated, OrderStatusNew, OrderStatusPartiallyFilled:
-			order.Status = OrderStatusCancelled
 			result = order
-			delete(s.openOrders, id)
+			if !s.latency.Cancel(order, s.backtest.GetTime()) {
+				order.Status = OrderStatusCancelled
+				s.latency.Remove(id)
+				delete(s.openOrders, id)
+			}
+			s.emitOrder(order)
 		default:
 			err = errors.New("error")
 		}
@@ -572,26 +658,90 @@ func (s *GenerateSim) CancelAllOrders(symbol string, opts ...OrderOption) (err e
 	var idsToBeRemoved []string
 
 	for _, order := range s.openOrders {
+		if order.Status == OrderStatusCancelPending {
+			continue
+		}
 		if !order.IsOpen() {
 			fmt.Printf("Order error: %#v\n", order)
 			continue
 		}
 		switch order.Status {
 		case OrderStatusCreated, OrderStatusNew, OrderStatusPartiallyFilled:
-			order.Status = OrderStatusCancelled
-			idsToBeRemoved = append(idsToBeRemoved, order.ID)
+			if !s.latency.Cancel(order, s.backtest.GetTime()) {
+				order.Status = OrderStatusCancelled
+				idsToBeRemoved = append(idsToBeRemoved, order.ID)
+			}
+			s.emitOrder(order)
 		default:
 			err = errors.New("error")
 		}
 	}
 
 	for _, id := range idsToBeRemoved {
+		s.latency.Remove(id)
 		delete(s.openOrders, id)
 	}
 	return
 }
 
+// AmendOrder change the price and/or size of an open order, zero means unchanged
 func (s *GenerateSim) AmendOrder(symbol string, id string, price float64, size float64, opts ...OrderOption) (result *Order, err error) {
+	order, ok := s.openOrders[id]
+	if !ok {
+		err = errors.New("not found")
+		return
+	}
+	if !order.IsOpen() {
+		err = errors.New("status error")
+		return
+	}
+	if size > 0 && size < order.FilledAmount {
+		err = errors.New("size is less than filled amount")
+		return
+	}
+
+	result = order
+	if s.latency.Amend(order, price, size, s.backtest.GetTime()) {
+		// the amendment takes effect when it arrives at the exchange
+		return
+	}
+
+	var match bool
+	match, err = s.amendOrder(order, price, size)
+	if err != nil {
+		s.eLog.Error(err)
+		return
+	}
+	if match {
+		s.logOrderInfo("Match order", SimEventDeal, order)
+		s.emitOrder(order)
+	}
+	if !order.IsOpen() {
+		s.archiveOrder(order)
+	}
+	return
+}
+
+// amendOrder applies the new price and/or size, a new price re-runs immediate matching
+func (s *GenerateSim) amendOrder(order *Order, price float64, size float64) (match bool, err error) {
+	priceChanged := price > 0 && price != order.Price
+	if priceChanged {
+		order.Price = price
+	}
+	if size > 0 {
+		order.Amount = size
+	}
+	order.UpdateTime = s.data.GetOrderBook().Time
+	if order.FilledAmount > 0 && order.FilledAmount >= order.Amount {
+		order.Status = OrderStatusFilled
+	}
+	s.logOrderInfo("Amend order", SimEventOrder, order)
+	s.emitOrder(order)
+
+	if priceChanged {
+		err = s.matchOrder(order, true)
+		match = err == nil && order.Status == OrderStatusFilled
+	}
 	return
 }
 
@@ -605,21 +755,98 @@ func (s *GenerateSim) GetPositions(symbol string) (result []*Position, err error
 }
 
 func (s *GenerateSim) SubscribeTrades(market Market, callback func(trades []*Trade)) error {
+	s.emitter.On(WSEventTrade, func(trades []*Trade) {
+		var result []*Trade
+		for _, v := range trades {
+			if matchSymbol(market, v.Symbol) {
+				result = append(result, v)
+			}
+		}
+		if len(result) > 0 {
+			callback(result)
+		}
+	})
 	return nil
 }
 
 func (s *GenerateSim) SubscribeLevel2Snapshots(market Market, callback func(ob *OrderBook)) error {
+	s.emitter.On(WSEventL2Snapshot, func(ob *OrderBook) {
+		if matchSymbol(market, ob.Symbol) {
+			callback(ob)
+		}
+	})
 	return nil
 }
 
 func (s *GenerateSim) SubscribeOrders(market Market, callback func(orders []*Order)) error {
+	s.emitter.On(WSEventOrder, func(orders []*Order) {
+		var result []*Order
+		for _, v := range orders {
+			if matchSymbol(market, v.Symbol) {
+				result = append(result, v)
+			}
+		}
+		if len(result) > 0 {
+			callback(result)
+		}
+	})
 	return nil
 }
 
 func (s *GenerateSim) SubscribePositions(market Market, callback func(positions []*Position)) error {
+	s.emitter.On(WSEventPosition, func(positions []*Position) {
+		var result []*Position
+		for _, v := range positions {
+			if matchSymbol(market, v.Symbol) {
+				result = append(result, v)
+			}
+		}
+		if len(result) > 0 {
+			callback(result)
+		}
+	})
 	return nil
 }
 
+// matchSymbol the data without symbol matches any market
+func matchSymbol(market Market, symbol string) bool {
+	return market.Symbol == "" || symbol == "" || market.Symbol == symbol
+}
+
+// emitOrderBook emit each orderbook only once
+func (s *GenerateSim) emitOrderBook() {
+	ob := s.data.GetOrderBook()
+	if ob == nil || ob == s.lastOrderBook {
+		return
+	}
+	s.lastOrderBook = ob
+	s.emitter.Emit(WSEventL2Snapshot, ob)
+}
+
+// emitTrades emit the trades of the current step only once
+func (s *GenerateSim) emitTrades() {
+	trades := s.data.GetTrades()
+	if len(trades) == 0 || trades[0] == s.lastTrade {
+		return
+	}
+	s.lastTrade = trades[0]
+	s.emitter.Emit(WSEventTrade, trades)
+}
+
+func (s *GenerateSim) emitOrder(order *Order) {
+	var orders = []*Order{order}
+	s.emitter.Emit(WSEventOrder, orders)
+}
+
+func (s *GenerateSim) emitPositions(symbol string) {
+	var positions []*Position
+	for _, v := range s.getPosition(symbol) {
+		position := v
+		positions = append(positions, &position)
+	}
+	s.emitter.Emit(WSEventPosition, positions)
+}
+
 func (s *GenerateSim) SetBacktest(backtest IBacktest) {
 	s.backtest = backtest
 }
@@ -628,15 +855,163 @@ func (s *GenerateSim) SetExchangeLogger(l ExchangeLogger) {
 	s.eLog = l
 }
 
+// SetIdGenerate sets the order id generator
+func (s *GenerateSim) SetIdGenerate(g *utils.IdGenerate) {
+	s.idGen = g
+}
+
+// SetLatency sets the latency of placing, cancelling and amending orders, nil means no latency
+func (s *GenerateSim) SetLatency(placeLatency Latency, cancelLatency Latency, amendLatency Latency) {
+	s.latency.SetLatency(placeLatency, cancelLatency, amendLatency)
+}
+
 func (s *GenerateSim) RunEventLoopOnce() (err error) {
+	s.emitOrderBook()
+	s.emitTrades()
+	now := s.backtest.GetTime()
 	for _, order := range s.openOrders {
-		if s.matchOrder(order, false) == nil {
-
+		match, cancelled, _ := s.latency.Run(order, now, s.latencyHandler)
+		if match {
+			s.logOrderInfo("Match order", SimEventDeal, order)
+			s.emitOrder(order)
+		}
+		if cancelled {
+			s.emitOrder(order)
 		}
+		if !order.IsOpen() && order.Status != OrderStatusCancelPending {
+			s.archiveOrder(order)
+		}
+	}
+	s.settleFunding()
+	return
+}
+
+// arriveOrder matches an order when it arrives at the exchange, a failed order is rejected
+func (s *GenerateSim) arriveOrder(order *Order) (match bool, err error) {
+	order.Status = OrderStatusNew
+	order.UpdateTime = s.backtest.GetTime()
+	if err = s.matchOrder(order, true); err != nil {
+		s.eLog.Error(err)
+		order.Status = OrderStatusRejected
+		err = nil
+	}
+	match = order.Status == OrderStatusFilled
+	if !match {
+		s.emitOrder(order)
+	}
+	return
+}
+
+// arriveAmend applies an amendment when it arrives, the order keeps matching if it fails
+func (s *GenerateSim) arriveAmend(order *Order, price float64, size float64) (match bool, err error) {
+	match, err = s.amendOrder(order, price, size)
+	if err != nil {
+		s.eLog.Error(err)
+		err = nil
+	}
+	if !match {
+		match, err = s.runMatchOrder(order)
+	}
+	return
+}
+
+// runMatchOrder matches an order resting on the exchange
+func (s *GenerateSim) runMatchOrder(order *Order) (match bool, err error) {
+	if !order.IsOpen() {
+		return
 	}
+	if err = s.matchOrder(order, false); err != nil {
+		return
+	}
+	match = order.Status == OrderStatusFilled
 	return
 }
 
+// archiveOrder moves a finished order to the history
+func (s *GenerateSim) archiveOrder(order *Order) {
+	delete(s.openOrders, order.ID)
+	s.latency.Remove(order.ID)
+	s.historyOrders[order.ID] = order
+}
+
+// SetFundingSchedule set the funding rate schedule for perpetual swaps
+func (s *GenerateSim) SetFundingSchedule(schedule dataloader.FundingSchedule) {
+	s.fundingSchedule = schedule
+}
+
+// GetFundingPnl returns the accumulated funding pnl
+func (s *GenerateSim) GetFundingPnl() float64 {
+	return s.fundingPnl
+}
+
+// settleFunding credit or debit balance by position notional at each settlement time.
+// A settlement between two ticks is priced at the earlier tick, the last quote
+// known at the settlement time.
+func (s *GenerateSim) settleFunding() {
+	if s.fundingSchedule == nil {
+		return
+	}
+	now := s.backtest.GetTime()
+	ob := s.data.GetOrderBook()
+	if s.lastFundingTime.IsZero() {
+		s.lastFundingTime = now
+		s.lastFundingPrice = ob.Price()
+		return
+	}
+	if !now.After(s.lastFundingTime) {
+		return
+	}
+	for symbol, position := range s.positions {
+		rates := s.fundingSchedule.GetFundingRates(symbol, s.lastFundingTime, now)
+		for _, rate := range rates {
+			price := s.lastFundingPrice
+			if !ob.Time.After(rate.Time) {
+				price = ob.Price()
+			}
+			for _, pos := range position {
+				if pos.Size == 0 {
+					continue
+				}
+				var value float64
+				if s.isForwardContract {
+					value = math.Abs(pos.Size) * price
+				} else {
+					value = math.Abs(pos.Size) / price
+				}
+				funding := value * rate.Rate
+				if pos.Side() == Buy {
+					funding = -funding
+				}
+				s.addBalance(funding)
+				s.fundingPnl += funding
+				s.logFundingInfo(symbol, rate, funding, price)
+			}
+		}
+	}
+	s.lastFundingTime = now
+	s.lastFundingPrice = ob.Price()
+}
+
+func (s *GenerateSim) logFundingInfo(symbol string, rate *dataloader.FundingRate, funding float64, price float64) {
+	if s.eLog == nil {
+		return
+	}
+
+	s.eLog.Infow(
+		"Funding",
+		SimEventKey,
+		SimEventFunding,
+		"symbol", symbol,
+		"fundingTime", rate.Time,
+		"rate", rate.Rate,
+		"price", price,
+		"funding", funding,
+		"orderbook", s.data.GetOrderBook(),
+		"balance", s.balance,
+		"positions", s.positions[symbol],
+	)
+}
+
 func (s *GenerateSim) GetWinRate() (longWinRate, shortWinRate, totalWinRate float64) {
 	return s.longWinCnt / s.longCnt, s.shortWinCnt / s.shortCnt, s.positionWinCnt / s.positionCnt
 }
@@ -1,7 +1,206 @@
 package generatesim
 
-import "testing"
+import (
+	. "github.com/coinrust/crex"
+	"github.com/coinrust/crex/dataloader"
+	"github.com/coinrust/crex/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func testGenerateSim() *GenerateSim {
+	data := testutil.SampleData()
+	ex := NewGenerateSim(data, 10000, -0.00025, 0.00075, true)
+	ex.SetBacktest(testutil.NewBacktest(data))
+	ex.SetExchangeLogger(&EmptyExchangeLogger{})
+	return ex
+}
 
 func TestGenerateSim_GetName(t *testing.T) {
 
 }
+
+func TestGenerateSim_AmendOrder(t *testing.T) {
+	ex := testGenerateSim()
+	ob := ex.data.GetOrderBook()
+	order, err := ex.PlaceOrder("BTC-PERPETUAL", Buy, OrderTypeLimit, ob.BidPrice()-100, 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, OrderStatusNew, order.Status)
+
+	_, err = ex.AmendOrder("BTC-PERPETUAL", order.ID, 0, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2.0, order.Amount)
+	assert.Equal(t, OrderStatusNew, order.Status)
+
+	// 新价格可成交时立即撮合
+	_, err = ex.AmendOrder("BTC-PERPETUAL", order.ID, ob.AskPrice(), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, OrderStatusFilled, order.Status)
+	assert.Equal(t, 2.0, order.FilledAmount)
+
+	orders, _ := ex.GetOpenOrders("BTC-PERPETUAL")
+	assert.Equal(t, 0, len(orders))
+	_, err = ex.AmendOrder("BTC-PERPETUAL", order.ID, ob.AskPrice(), 0)
+	assert.NotNil(t, err)
+}
+
+func TestGenerateSim_Subscribe(t *testing.T) {
+	ex := testGenerateSim()
+	market := Market{Symbol: "BTC-PERPETUAL"}
+
+	var obCount int
+	var orders []*Order
+	var positions []*Position
+	ex.SubscribeLevel2Snapshots(market, func(ob *OrderBook) {
+		obCount++
+	})
+	ex.SubscribeOrders(market, func(v []*Order) {
+		orders = append(orders, v...)
+	})
+	ex.SubscribePositions(market, func(v []*Position) {
+		positions = v
+	})
+
+	ex.RunEventLoopOnce()
+	ex.RunEventLoopOnce()
+	assert.Equal(t, 1, obCount)
+
+	_, err := ex.PlaceOrder("BTC-PERPETUAL", Buy, OrderTypeMarket, 0, 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, 1, len(orders))
+	if assert.Equal(t, 1, len(positions)) {
+		assert.Equal(t, 1.0, positions[0].Size)
+	}
+}
+
+// testData orderbooks with the given bid prices at the given interval
+func testData(start time.Time, interval time.Duration, bids ...float64) *dataloader.Data {
+	obs := testutil.OrderBooks("BTC-PERPETUAL", start, interval, 10000, bids...)
+	return testutil.NewData(obs, start, start.Add(time.Hour))
+}
+
+func TestGenerateSim_Funding(t *testing.T) {
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	data := testData(start, 2*time.Second, 10000, 20000)
+	ex := NewGenerateSim(data, 1, 0, 0, false)
+	ex.SetBacktest(testutil.NewBacktest(data))
+	ex.SetExchangeLogger(&EmptyExchangeLogger{})
+	ex.SetFundingSchedule(dataloader.NewFixedFundingSchedule(0.001, time.Second))
+
+	_, err := ex.PlaceOrder("BTC-PERPETUAL", Buy, OrderTypeMarket, 0, 1000)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	// funding settlement tolerates a missing exchange logger, like ExSim
+	ex.SetExchangeLogger(nil)
+	ex.RunEventLoopOnce()
+	data.Next()
+	ex.RunEventLoopOnce()
+
+	// the settlement at +1s is priced at the tick before it, the one at +2s at the current tick
+	expected := -1000/10000.5*0.001 - 1000/20000.5*0.001
+	assert.InDelta(t, expected, ex.GetFundingPnl(), 1e-12)
+}
+
+func testLatencySim(bids ...float64) (*GenerateSim, *dataloader.Data) {
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	data := testData(start, time.Second, bids...)
+	ex := NewGenerateSim(data, 10000, 0, 0, true)
+	ex.SetBacktest(testutil.NewBacktest(data))
+	ex.SetExchangeLogger(&EmptyExchangeLogger{})
+	return ex, data
+}
+
+func TestGenerateSim_PlaceLatency(t *testing.T) {
+	ex, data := testLatencySim(100, 100, 100)
+	ex.SetLatency(FixedLatency(1500*time.Millisecond), nil, nil)
+
+	// marketable when placed, but the order arrives 1.5s later
+	order, err := ex.PlaceOrder("BTC-PERPETUAL", Buy, OrderTypeLimit, 101, 10)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, OrderStatusCreated, order.Status)
+	orders, _ := ex.GetOpenOrders("BTC-PERPETUAL")
+	assert.Equal(t, 1, len(orders))
+
+	data.Next()
+	ex.RunEventLoopOnce()
+	assert.Equal(t, OrderStatusCreated, order.Status)
+
+	data.Next()
+	ex.RunEventLoopOnce()
+	assert.Equal(t, OrderStatusFilled, order.Status)
+	assert.Equal(t, data.GetOrderBook().Time, order.UpdateTime)
+	orders, _ = ex.GetOpenOrders("BTC-PERPETUAL")
+	assert.Equal(t, 0, len(orders))
+}
+
+func TestGenerateSim_CancelLatency(t *testing.T) {
+	ex, data := testLatencySim(100, 100, 100, 95)
+	ex.SetLatency(nil, FixedLatency(1500*time.Millisecond), nil)
+
+	order, err := ex.PlaceOrder("BTC-PERPETUAL", Buy, OrderTypeLimit, 90, 10)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	_, err = ex.CancelOrder("BTC-PERPETUAL", order.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, OrderStatusCancelPending, order.Status)
+	_, err = ex.CancelOrder("BTC-PERPETUAL", order.ID)
+	assert.NotNil(t, err)
+
+	data.Next()
+	ex.RunEventLoopOnce()
+	assert.Equal(t, OrderStatusCancelPending, order.Status)
+
+	data.Next()
+	ex.RunEventLoopOnce()
+	assert.Equal(t, OrderStatusCancelled, order.Status)
+	orders, _ := ex.GetOpenOrders("BTC-PERPETUAL")
+	assert.Equal(t, 0, len(orders))
+
+	// filled before the cancel arrives
+	order, err = ex.PlaceOrder("BTC-PERPETUAL", Buy, OrderTypeLimit, 96, 10)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	ex.CancelOrder("BTC-PERPETUAL", order.ID)
+	data.Next()
+	ex.RunEventLoopOnce()
+	assert.Equal(t, OrderStatusFilled, order.Status)
+}
+
+func TestGenerateSim_AmendLatency(t *testing.T) {
+	ex, data := testLatencySim(100, 100, 100)
+	ex.SetLatency(nil, nil, FixedLatency(1500*time.Millisecond))
+
+	order, err := ex.PlaceOrder("BTC-PERPETUAL", Buy, OrderTypeLimit, 90, 10)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	_, err = ex.AmendOrder("BTC-PERPETUAL", order.ID, 101, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 90.0, order.Price)
+
+	data.Next()
+	ex.RunEventLoopOnce()
+	assert.Equal(t, OrderStatusNew, order.Status)
+
+	data.Next()
+	ex.RunEventLoopOnce()
+	assert.Equal(t, 101.0, order.Price)
+	assert.Equal(t, OrderStatusFilled, order.Status)
+}
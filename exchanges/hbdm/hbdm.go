@@ -179,6 +179,55 @@ func (b *Hbdm) GetContractID() (symbol string, err error) {
 	return "", fmt.Errorf("not found")
 }
 
+func (b *Hbdm) GetInstruments() (result []*Instrument, err error) {
+	var ret hbdm.ContractInfoResult
+	ret, err = b.client.GetContractInfo("", "", "")
+	if err != nil {
+		return
+	}
+	if ret.Status != StatusOK {
+		err = fmt.Errorf("error code=%v msg=%v",
+			ret.ErrCode,
+			ret.ErrMsg)
+		return
+	}
+	for _, v := range ret.Data {
+		instrument := &Instrument{
+			Symbol:         v.ContractCode,
+			BaseCurrency:   v.Symbol,
+			QuoteCurrency:  "USD",
+			SettleCurrency: v.Symbol,
+			TickSize:       v.PriceTick,
+			LotSize:        1,
+			MinSize:        1,
+			ContractValue:  v.ContractSize,
+			Inverse:        true,
+		}
+		// 交割时间为交割日 16:00(UTC+8)
+		if tm, err := time.Parse("20060102", v.DeliveryDate); err == nil {
+			instrument.ExpiryTime = tm.Add(8 * time.Hour)
+		}
+		result = append(result, instrument)
+	}
+	return
+}
+
+func (b *Hbdm) GetInstrument(symbol string) (result *Instrument, err error) {
+	var instruments []*Instrument
+	instruments, err = b.GetInstruments()
+	if err != nil {
+		return
+	}
+	for _, v := range instruments {
+		if v.Symbol == symbol {
+			result = v
+			return
+		}
+	}
+	err = fmt.Errorf("not found")
+	return
+}
+
 // 设置杠杆大小
 func (b *Hbdm) SetLeverRate(value float64) (err error) {
 	b.leverRate = int(value)
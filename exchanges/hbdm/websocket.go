@@ -89,17 +89,14 @@ func (s *HbdmWebSocket) depthCallback(depth *hbdm.WSDepth) {
 func (s *HbdmWebSocket) depthHFCallback(depth *hbdm.WSDepthHF) {
 	// ch: market.BTC_CQ.depth.size_20.high_freq
 	symbol := depth.Ch
-	if v, ok := s.dobMap[symbol]; ok {
-		v.Update(depth)
-		ob := v.GetOrderBook(20)
-		s.emitter.Emit(WSEventL2Snapshot, &ob)
-	} else {
-		dob := NewDepthOrderBook(symbol)
-		dob.Update(depth)
+	dob, ok := s.dobMap[symbol]
+	if !ok {
+		dob = NewDepthOrderBook(symbol)
 		s.dobMap[symbol] = dob
-		ob := dob.GetOrderBook(20)
-		s.emitter.Emit(WSEventL2Snapshot, &ob)
 	}
+	updateDepthOrderBook(dob, depth)
+	ob := dob.GetOrderBook(20)
+	s.emitter.Emit(WSEventL2Snapshot, &ob)
 }
 
 func (s *HbdmWebSocket) tradeCallback(trade *hbdm.WSTrade) {
@@ -151,6 +151,16 @@ func (b *HbdmSwap) GetContractID() (symbol string, err error) {
 	return "", fmt.Errorf("not found")
 }
 
+func (b *HbdmSwap) GetInstruments() (result []*Instrument, err error) {
+	err = ErrNotImplemented
+	return
+}
+
+func (b *HbdmSwap) GetInstrument(symbol string) (result *Instrument, err error) {
+	err = ErrNotImplemented
+	return
+}
+
 // 设置杠杆大小
 func (b *HbdmSwap) SetLeverRate(value float64) (err error) {
 	b.leverRate = int(value)
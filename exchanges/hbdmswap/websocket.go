@@ -74,17 +74,14 @@ func (s *SwapWebSocket) depthCallback(depth *hbdmswap.WSDepth) {
 func (s *SwapWebSocket) depthHFCallback(depth *hbdmswap.WSDepthHF) {
 	// ch: market.BTC_USD.depth.size_20.high_freq
 	symbol := depth.Ch
-	if v, ok := s.dobMap[symbol]; ok {
-		v.Update(depth)
-		ob := v.GetOrderBook(20)
-		s.emitter.Emit(WSEventL2Snapshot, &ob)
-	} else {
-		dob := NewDepthOrderBook(symbol)
-		dob.Update(depth)
+	dob, ok := s.dobMap[symbol]
+	if !ok {
+		dob = NewDepthOrderBook(symbol)
 		s.dobMap[symbol] = dob
-		ob := dob.GetOrderBook(20)
-		s.emitter.Emit(WSEventL2Snapshot, &ob)
 	}
+	updateDepthOrderBook(dob, depth)
+	ob := dob.GetOrderBook(20)
+	s.emitter.Emit(WSEventL2Snapshot, &ob)
 }
 
 func (s *SwapWebSocket) tradeCallback(trade *hbdmswap.WSTrade) {
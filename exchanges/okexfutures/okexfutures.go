@@ -208,6 +208,49 @@ func (b *OkexFutures) GetContractID() (symbol string, err error) {
 	return "", fmt.Errorf("not found")
 }
 
+func (b *OkexFutures) GetInstruments() (result []*Instrument, err error) {
+	var ret []okex.FuturesInstrumentsResult
+	ret, err = b.client.GetFuturesInstruments()
+	if err != nil {
+		return
+	}
+	for _, v := range ret {
+		instrument := &Instrument{
+			Symbol:         v.InstrumentId,
+			BaseCurrency:   v.BaseCurrency,
+			QuoteCurrency:  v.QuoteCurrency,
+			SettleCurrency: v.SettlementCurrency,
+			TickSize:       v.TickSize,
+			LotSize:        v.TradeIncrement,
+			MinSize:        v.TradeIncrement,
+			ContractValue:  v.ContractVal,
+			Inverse:        v.IsInverse,
+		}
+		// 交割时间为交割日 16:00(UTC+8)
+		if tm, err := time.Parse("2006-01-02", v.Delivery); err == nil {
+			instrument.ExpiryTime = tm.Add(8 * time.Hour)
+		}
+		result = append(result, instrument)
+	}
+	return
+}
+
+func (b *OkexFutures) GetInstrument(symbol string) (result *Instrument, err error) {
+	var instruments []*Instrument
+	instruments, err = b.GetInstruments()
+	if err != nil {
+		return
+	}
+	for _, v := range instruments {
+		if v.Symbol == symbol {
+			result = v
+			return
+		}
+	}
+	err = fmt.Errorf("not found")
+	return
+}
+
 // 设置杠杆大小
 func (b *OkexFutures) SetLeverRate(value float64) (err error) {
 	b.leverRate = int(value)
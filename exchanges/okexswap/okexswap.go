@@ -178,6 +178,45 @@ func (b *OkexSwap) GetContractID() (symbol string, err error) {
 	return
 }
 
+func (b *OkexSwap) GetInstruments() (result []*Instrument, err error) {
+	var ret okex.SwapInstrumentList
+	ret, err = b.client.GetSwapInstruments()
+	if err != nil {
+		return
+	}
+	for _, v := range ret {
+		sizeIncrement := utils.ParseFloat64(v.SizeIncrement)
+		result = append(result, &Instrument{
+			Symbol:         v.InstrumentId,
+			BaseCurrency:   v.UnderlyingIndex,
+			QuoteCurrency:  v.QuoteCurrency,
+			SettleCurrency: v.Coin,
+			TickSize:       utils.ParseFloat64(v.TickSize),
+			LotSize:        sizeIncrement,
+			MinSize:        sizeIncrement,
+			ContractValue:  utils.ParseFloat64(v.ContractVal),
+			Inverse:        v.QuoteCurrency == "USD",
+		})
+	}
+	return
+}
+
+func (b *OkexSwap) GetInstrument(symbol string) (result *Instrument, err error) {
+	var instruments []*Instrument
+	instruments, err = b.GetInstruments()
+	if err != nil {
+		return
+	}
+	for _, v := range instruments {
+		if v.Symbol == symbol {
+			result = v
+			return
+		}
+	}
+	err = fmt.Errorf("not found")
+	return
+}
+
 // 设置杠杆大小
 func (b *OkexSwap) SetLeverRate(value float64) (err error) {
 	b.leverRate = int(value)
@@ -6,28 +6,32 @@ import (
 	"github.com/chuckpreslar/emission"
 	. "github.com/coinrust/crex"
 	"github.com/coinrust/crex/dataloader"
+	"github.com/coinrust/crex/utils"
 	"log"
 	"sync"
 	"time"
 )
 
 type SpotSim struct {
-	name          string
-	data          *dataloader.Data
-	makerFeeRate  float64 // -0.00025	// Maker fee rate
-	takerFeeRate  float64 // 0.00075	// Taker fee rate
-	initBalance   SpotBalance
-	balance       SpotBalance
-	backtest      IBacktest
-	eLog          ExchangeLogger
-	orders        sync.Map // All orders key: OrderID value: Order
-	openOrders    sync.Map // Open orders
-	historyOrders sync.Map // History orders
-	emitter       *emission.Emitter
+	name           string
+	data           *dataloader.Data
+	makerFeeRate   float64 // -0.00025	// Maker fee rate
+	takerFeeRate   float64 // 0.00075	// Taker fee rate
+	initBalance    SpotBalance
+	balance        SpotBalance
+	backtest       IBacktest
+	eLog           ExchangeLogger
+	orders         sync.Map // All orders key: OrderID value: Order
+	openOrders     sync.Map // Open orders
+	historyOrders  sync.Map // History orders
+	emitter        *emission.Emitter
+	idGen          *utils.IdGenerate // 订单ID生成器，为空时使用全局生成器
+	latency        *LatencyQueue     // 下单、撤单延迟
+	latencyHandler *LatencyHandler   // 请求到达交易所后的撮合
 }
 
 func New(name string, data *dataloader.Data, initBalance SpotBalance, makerFeeRate float64, takerFeeRate float64) *SpotSim {
-	return &SpotSim{
+	s := &SpotSim{
 		name:         name,
 		data:         data,
 		makerFeeRate: makerFeeRate,
@@ -35,7 +39,13 @@ func New(name string, data *dataloader.Data, initBalance SpotBalance, makerFeeRa
 		initBalance:  initBalance,
 		balance:      initBalance,
 		emitter:      emission.NewEmitter(),
+		latency:      NewLatencyQueue(),
 	}
+	s.latencyHandler = &LatencyHandler{
+		Arrive: s.arriveOrder,
+		Match:  s.runMatchOrder,
+	}
+	return s
 }
 
 // 获取 Exchange 名称
@@ -43,6 +53,11 @@ func (s *SpotSim) GetName() (name string) {
 	return s.name + "_spot_sim"
 }
 
+// 获取 Maker/Taker 费率
+func (s *SpotSim) GetFeeRates() (makerFeeRate float64, takerFeeRate float64) {
+	return s.makerFeeRate, s.takerFeeRate
+}
+
 // 获取交易所时间(ms)
 func (s *SpotSim) GetTime() (tm int64, err error) {
 	tm = s.backtest.GetTime().UnixNano() / int64(time.Millisecond)
@@ -67,8 +82,9 @@ func (s *SpotSim) getOrderBook() *OrderBook {
 
 // 获取K线数据
 // period: 数据周期. 分钟或者关键字1m(minute) 1h 1d 1w 1M(month) 1y 枚举值：1 3 5 15 30 60 120 240 360 720 "5m" "4h" "1d" ...
+// GetRecords 只返回当前回测时间之前已完成的K线
 func (s *SpotSim) GetRecords(symbol string, period string, from int64, end int64, limit int) (records []*Record, err error) {
-	return
+	return s.data.GetRecordsByNS(period, s.backtest.GetTime().UnixNano(), from, end, limit)
 }
 
 // 买
@@ -89,7 +105,7 @@ func (s *SpotSim) PlaceOrder(symbol string, direction Direction, orderType Order
 		return
 	}
 	params := ParsePlaceOrderParameter(opts...)
-	id := GenOrderId()
+	id := GenOrderIdBy(s.idGen)
 	order := &Order{
 		ID:           id,
 		Symbol:       symbol,
@@ -115,10 +131,13 @@ func (s *SpotSim) PlaceOrder(symbol string, direction Direction, orderType Order
 		"params", params,
 	)
 
-	_, err = s.matchOrder(order, true)
-	if err != nil {
-		s.eLog.Error(err)
-		return
+	if !s.latency.Place(order, s.backtest.GetTime()) {
+		// 无下单延迟时立即撮合，否则到达交易所后再撮合
+		_, err = s.matchOrder(order, true)
+		if err != nil {
+			s.eLog.Error(err)
+			return
+		}
 	}
 
 	if order.IsOpen() {
@@ -339,12 +358,18 @@ func (s *SpotSim) CancelAllOrders(symbol string, opts ...OrderOption) (err error
 
 	s.openOrders.Range(func(key, value interface{}) bool {
 		order := value.(*Order)
+		if order.Status == OrderStatusCancelPending {
+			return true
+		}
 		if !order.IsOpen() {
 			log.Printf("Order error: %#v", order)
 			return true
 		}
 		switch order.Status {
 		case OrderStatusCreated, OrderStatusNew, OrderStatusPartiallyFilled:
+			if s.latency.Cancel(order, s.backtest.GetTime()) {
+				return true
+			}
 			order.Status = OrderStatusCancelled
 			order.UpdateTime = s.backtest.GetTime()
 			idsToBeRemoved = append(idsToBeRemoved, order.ID)
@@ -356,6 +381,7 @@ func (s *SpotSim) CancelAllOrders(symbol string, opts ...OrderOption) (err error
 	})
 
 	for _, id := range idsToBeRemoved {
+		s.latency.Remove(id)
 		s.openOrders.Delete(id)
 		orderValue, ok := s.orders.Load(id)
 		if !ok {
@@ -380,9 +406,14 @@ func (s *SpotSim) CancelOrder(symbol string, id string, opts ...OrderOption) (re
 		}
 		switch order.Status {
 		case OrderStatusCreated, OrderStatusNew, OrderStatusPartiallyFilled:
+			result = order
+			if s.latency.Cancel(order, s.backtest.GetTime()) {
+				// 撤单到达交易所前委托继续撮合
+				return
+			}
 			order.Status = OrderStatusCancelled
 			order.UpdateTime = s.backtest.GetTime()
-			result = order
+			s.latency.Remove(id)
 			s.openOrders.Delete(id)
 			s.logOrderInfo("Cancel order", SimEventOrder, order)
 		default:
@@ -419,21 +450,60 @@ func (s *SpotSim) SetExchangeLogger(l ExchangeLogger) {
 	s.eLog = l
 }
 
+// SetIdGenerate 设置订单ID生成器
+func (s *SpotSim) SetIdGenerate(g *utils.IdGenerate) {
+	s.idGen = g
+}
+
+// SetLatency 设置下单、撤单延迟，nil 表示无延迟
+func (s *SpotSim) SetLatency(placeLatency Latency, cancelLatency Latency) {
+	s.latency.SetLatency(placeLatency, cancelLatency, nil)
+}
+
 func (s *SpotSim) RunEventLoopOnce() (err error) {
-	var match bool
+	var match, cancelled bool
+	now := s.backtest.GetTime()
 	s.openOrders.Range(func(key, value interface{}) bool {
 		order := value.(*Order)
-		match, err = s.matchOrder(order, false)
+		match, cancelled, err = s.latency.Run(order, now, s.latencyHandler)
 		if match {
 			s.logOrderInfo("Match order", SimEventDeal, order)
 			var orders = []*Order{order}
 			s.emitter.Emit(WSEventOrder, orders)
 		}
+		if cancelled {
+			s.logOrderInfo("Cancel order", SimEventOrder, order)
+			var orders = []*Order{order}
+			s.emitter.Emit(WSEventOrder, orders)
+		}
+		if !order.IsOpen() && order.Status != OrderStatusCancelPending {
+			s.openOrders.Delete(order.ID)
+			s.latency.Remove(order.ID)
+			s.historyOrders.Store(order.ID, order)
+		}
 		return true
 	})
 	return
 }
 
+// 委托到达交易所，按到达时的订单薄撮合，无法成交时拒绝
+func (s *SpotSim) arriveOrder(order *Order) (match bool, err error) {
+	order.Status = OrderStatusNew
+	order.UpdateTime = s.backtest.GetTime()
+	match, err = s.matchOrder(order, true)
+	if err != nil {
+		s.eLog.Error(err)
+		order.Status = OrderStatusRejected
+		err = nil
+	}
+	return
+}
+
+// 撮合已到达交易所的委托
+func (s *SpotSim) runMatchOrder(order *Order) (match bool, err error) {
+	return s.matchOrder(order, false)
+}
+
 func (s *SpotSim) logOrderInfo(msg string, event string, order *Order) {
 	ob := s.getOrderBook()
 	baseBalance := s.balance.Base.Available + s.balance.Base.Frozen
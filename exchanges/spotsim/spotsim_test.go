@@ -2,7 +2,11 @@ package spotsim
 
 import (
 	"github.com/coinrust/crex"
+	"github.com/coinrust/crex/dataloader"
+	"github.com/coinrust/crex/internal/testutil"
+	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 var ss = New(
@@ -19,3 +23,76 @@ var ss = New(
 func TestSpotSim_GetName(t *testing.T) {
 	t.Log(ss.GetName())
 }
+
+// 每秒一个订单薄，卖一价为买一价加 1
+func testSpotSim(bids ...float64) (*SpotSim, *dataloader.Data) {
+	obs := testutil.OrderBooks("BTC-USDT", testutil.Start, time.Second, 100, bids...)
+	data := testutil.NewData(obs, testutil.Start, testutil.Start.Add(time.Hour))
+	ex := New("huobi", data, crex.SpotBalance{
+		Base:  crex.SpotAsset{Name: "BTC", Available: 10},
+		Quote: crex.SpotAsset{Name: "USDT", Available: 10000},
+	}, 0, 0)
+	ex.SetBacktest(testutil.NewBacktest(data))
+	ex.SetExchangeLogger(&crex.EmptyExchangeLogger{})
+	return ex, data
+}
+
+func TestSpotSim_PlaceLatency(t *testing.T) {
+	ex, data := testSpotSim(100, 100, 100)
+	ex.SetLatency(crex.FixedLatency(1500*time.Millisecond), nil)
+
+	// 下单时可立即成交，但委托 1.5 秒后才到达交易所
+	order, err := ex.PlaceOrder("BTC-USDT", crex.Buy, crex.OrderTypeLimit, 101, 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, crex.OrderStatusCreated, order.Status)
+
+	data.Next()
+	ex.RunEventLoopOnce()
+	assert.Equal(t, crex.OrderStatusCreated, order.Status)
+
+	data.Next()
+	ex.RunEventLoopOnce()
+	assert.Equal(t, crex.OrderStatusFilled, order.Status)
+	assert.Equal(t, 101.0, order.AvgPrice)
+	orders, _ := ex.GetOpenOrders("BTC-USDT")
+	assert.Equal(t, 0, len(orders))
+}
+
+func TestSpotSim_CancelLatency(t *testing.T) {
+	ex, data := testSpotSim(100, 100, 100, 95)
+	ex.SetLatency(nil, crex.FixedLatency(1500*time.Millisecond))
+
+	order, err := ex.PlaceOrder("BTC-USDT", crex.Buy, crex.OrderTypeLimit, 90, 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	_, err = ex.CancelOrder("BTC-USDT", order.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, crex.OrderStatusCancelPending, order.Status)
+
+	data.Next()
+	ex.RunEventLoopOnce()
+	assert.Equal(t, crex.OrderStatusCancelPending, order.Status)
+
+	data.Next()
+	ex.RunEventLoopOnce()
+	assert.Equal(t, crex.OrderStatusCancelled, order.Status)
+	orders, _ := ex.GetOpenOrders("BTC-USDT")
+	assert.Equal(t, 0, len(orders))
+
+	// 撤单到达前成交
+	order, err = ex.PlaceOrder("BTC-USDT", crex.Buy, crex.OrderTypeLimit, 96, 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	ex.CancelAllOrders("BTC-USDT")
+	assert.Equal(t, crex.OrderStatusCancelPending, order.Status)
+	data.Next()
+	ex.RunEventLoopOnce()
+	assert.Equal(t, crex.OrderStatusFilled, order.Status)
+}
@@ -0,0 +1,262 @@
+package validator
+
+import (
+	. "github.com/coinrust/crex"
+	"github.com/coinrust/crex/utils"
+	"sync"
+)
+
+// Validator 委托校验层，下单前按合约规格规整价格和数量并检查委托参数，
+// 避免无效委托发送到交易所
+type Validator struct {
+	Exchange
+
+	mu          sync.RWMutex
+	instruments map[string]*Instrument // key: symbol
+}
+
+// SetInstrument 设置标的合约规格，覆盖交易所返回的规格
+func (v *Validator) SetInstrument(instrument *Instrument) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.instruments[instrument.Symbol] = instrument
+}
+
+// 获取合约规格，交易所不支持时不做价格和数量规整
+func (v *Validator) getInstrument(symbol string) (result *Instrument, err error) {
+	v.mu.RLock()
+	result, ok := v.instruments[symbol]
+	v.mu.RUnlock()
+	if ok {
+		return
+	}
+
+	result, err = v.Exchange.GetInstrument(symbol)
+	if err == ErrNotImplemented {
+		result, err = &Instrument{Symbol: symbol}, nil
+	}
+	if err != nil {
+		return
+	}
+
+	v.mu.Lock()
+	v.instruments[symbol] = result
+	v.mu.Unlock()
+	return
+}
+
+func (v *Validator) OpenLong(symbol string, orderType OrderType, price float64, size float64) (result *Order, err error) {
+	price, size, _, err = v.normalizeOrder(symbol, orderType, price, size)
+	if err != nil {
+		return
+	}
+	return v.Exchange.OpenLong(symbol, orderType, price, size)
+}
+
+func (v *Validator) OpenShort(symbol string, orderType OrderType, price float64, size float64) (result *Order, err error) {
+	price, size, _, err = v.normalizeOrder(symbol, orderType, price, size)
+	if err != nil {
+		return
+	}
+	return v.Exchange.OpenShort(symbol, orderType, price, size)
+}
+
+func (v *Validator) CloseLong(symbol string, orderType OrderType, price float64, size float64) (result *Order, err error) {
+	price, size, _, err = v.normalizeOrder(symbol, orderType, price, size)
+	if err != nil {
+		return
+	}
+	return v.Exchange.CloseLong(symbol, orderType, price, size)
+}
+
+func (v *Validator) CloseShort(symbol string, orderType OrderType, price float64, size float64) (result *Order, err error) {
+	price, size, _, err = v.normalizeOrder(symbol, orderType, price, size)
+	if err != nil {
+		return
+	}
+	return v.Exchange.CloseShort(symbol, orderType, price, size)
+}
+
+func (v *Validator) PlaceOrder(symbol string, direction Direction, orderType OrderType, price float64, size float64,
+	opts ...PlaceOrderOption) (result *Order, err error) {
+	price, size, opts, err = v.normalizeOrder(symbol, orderType, price, size, opts...)
+	if err != nil {
+		return
+	}
+	return v.Exchange.PlaceOrder(symbol, direction, orderType, price, size, opts...)
+}
+
+// AmendOrder 修改委托，price/size 为 0 时表示不修改
+func (v *Validator) AmendOrder(symbol string, id string, price float64, size float64, opts ...OrderOption) (result *Order, err error) {
+	if price < 0 {
+		err = ErrInvalidPrice
+		return
+	}
+	if size < 0 {
+		err = ErrInvalidAmount
+		return
+	}
+	var instrument *Instrument
+	instrument, err = v.getInstrument(symbol)
+	if err != nil {
+		return
+	}
+	if price > 0 {
+		if price, err = normalizePrice(instrument, price); err != nil {
+			return
+		}
+	}
+	if size > 0 {
+		if size, err = normalizeSize(instrument, size); err != nil {
+			return
+		}
+	}
+	return v.Exchange.AmendOrder(symbol, id, price, size, opts...)
+}
+
+// 规整委托价格和数量并检查委托参数，返回需要传给交易所的参数
+func (v *Validator) normalizeOrder(symbol string, orderType OrderType, price float64, size float64,
+	opts ...PlaceOrderOption) (newPrice float64, newSize float64, newOpts []PlaceOrderOption, err error) {
+	params := ParsePlaceOrderParameter(opts...)
+	if err = checkOrder(orderType, price, size, params); err != nil {
+		return
+	}
+
+	var instrument *Instrument
+	instrument, err = v.getInstrument(symbol)
+	if err != nil {
+		return
+	}
+
+	newPrice = price
+	if hasPrice(orderType) {
+		if newPrice, err = normalizePrice(instrument, price); err != nil {
+			return
+		}
+	}
+	if newSize, err = normalizeSize(instrument, size); err != nil {
+		return
+	}
+	if hasPrice(orderType) && instrument.MinNotional > 0 &&
+		instrument.Notional(newSize, newPrice) < instrument.MinNotional {
+		err = ErrInvalidNotional
+		return
+	}
+
+	newOpts = opts
+	if params.StopPx > 0 {
+		// 追加的选项覆盖原触发价格
+		var stopPx float64
+		if stopPx, err = normalizePrice(instrument, params.StopPx); err != nil {
+			return
+		}
+		newOpts = append(newOpts, OrderStopPxOption(stopPx))
+	}
+	return
+}
+
+// 检查委托类型与参数组合
+func checkOrder(orderType OrderType, price float64, size float64, params *PlaceOrderParameter) error {
+	if size <= 0 {
+		return ErrInvalidAmount
+	}
+	if price < 0 || (hasPrice(orderType) && price == 0) {
+		return ErrInvalidPrice
+	}
+	if params.PostOnly && orderType != OrderTypeLimit {
+		return ErrInvalidOrderOption
+	}
+	switch orderType {
+	case OrderTypeStopMarket, OrderTypeStopLimit:
+		if params.StopPx <= 0 {
+			return ErrInvalidStopPx
+		}
+	case OrderTypeTrailingStopMarket:
+		if params.CallbackRate <= 0 {
+			return ErrInvalidOrderOption
+		}
+	}
+	return nil
+}
+
+// 是否为限价类委托
+func hasPrice(orderType OrderType) bool {
+	return orderType == OrderTypeLimit || orderType == OrderTypeStopLimit
+}
+
+func normalizePrice(instrument *Instrument, price float64) (result float64, err error) {
+	result = instrument.RoundPrice(price)
+	if result <= 0 {
+		err = ErrInvalidPrice
+	}
+	return
+}
+
+func normalizeSize(instrument *Instrument, size float64) (result float64, err error) {
+	result = instrument.RoundSize(size)
+	if result <= 0 || result < instrument.MinSize {
+		err = ErrAmountTooSmall
+		return
+	}
+	if instrument.MaxSize > 0 && result > instrument.MaxSize {
+		err = ErrAmountTooLarge
+	}
+	return
+}
+
+// ValidatorSim 模拟交易所委托校验层，回测时使用
+type ValidatorSim struct {
+	*Validator
+	sim ExchangeSim
+}
+
+func (v *ValidatorSim) SetBacktest(backtest IBacktest) {
+	v.sim.SetBacktest(backtest)
+}
+
+func (v *ValidatorSim) SetExchangeLogger(l ExchangeLogger) {
+	v.sim.SetExchangeLogger(l)
+}
+
+func (v *ValidatorSim) RunEventLoopOnce() (err error) {
+	return v.sim.RunEventLoopOnce()
+}
+
+// SetIdGenerate 设置模拟交易所的订单ID生成器，模拟交易所不支持时忽略
+func (v *ValidatorSim) SetIdGenerate(g *utils.IdGenerate) {
+	if ex, ok := v.sim.(IdGenerateSetter); ok {
+		ex.SetIdGenerate(g)
+	}
+}
+
+// GetFundingPnl 累计资金费用盈亏，模拟交易所不支持时返回 0
+func (v *ValidatorSim) GetFundingPnl() float64 {
+	if ex, ok := v.sim.(FundingExchangeSim); ok {
+		return ex.GetFundingPnl()
+	}
+	return 0
+}
+
+// GetFeeRates Maker/Taker 费率，模拟交易所不支持时返回 0
+func (v *ValidatorSim) GetFeeRates() (makerFeeRate float64, takerFeeRate float64) {
+	if ex, ok := v.sim.(FeeExchangeSim); ok {
+		return ex.GetFeeRates()
+	}
+	return
+}
+
+// NewValidator 创建委托校验层
+func NewValidator(exchange Exchange) *Validator {
+	return &Validator{
+		Exchange:    exchange,
+		instruments: map[string]*Instrument{},
+	}
+}
+
+// NewValidatorSim 创建模拟交易所委托校验层，sim 需同时实现 Exchange
+func NewValidatorSim(sim ExchangeSim) *ValidatorSim {
+	return &ValidatorSim{
+		Validator: NewValidator(sim.(Exchange)),
+		sim:       sim,
+	}
+}
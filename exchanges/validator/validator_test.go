@@ -0,0 +1,192 @@
+package validator
+
+import (
+	. "github.com/coinrust/crex"
+	"github.com/coinrust/crex/backtest"
+	"github.com/coinrust/crex/dataloader"
+	"github.com/coinrust/crex/exchanges/exsim"
+	"github.com/coinrust/crex/exchanges/generatesim"
+	"github.com/coinrust/crex/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func testInstrument() *Instrument {
+	return &Instrument{
+		Symbol:        "BTC-PERPETUAL",
+		TickSize:      0.5,
+		LotSize:       10,
+		MinSize:       10,
+		MaxSize:       1000,
+		ContractValue: 10,
+		Inverse:       true,
+	}
+}
+
+func testExSim() Exchange {
+	data := testutil.SampleData()
+	ex := exsim.NewExSim(data, 10000, -0.00025, 0.00075, 10, false, false)
+	ex.SetBacktest(testutil.NewBacktest(data))
+	ex.SetExchangeLogger(&EmptyExchangeLogger{})
+	if err := ex.SetInstrument(testInstrument()); err != nil {
+		panic(err)
+	}
+	return ex
+}
+
+func testGenerateSim() Exchange {
+	data := testutil.SampleData()
+	ex := generatesim.NewGenerateSim(data, 10000, -0.00025, 0.00075, false)
+	ex.SetBacktest(testutil.NewBacktest(data))
+	ex.SetExchangeLogger(&EmptyExchangeLogger{})
+	ex.SetInstrument(testInstrument())
+	return ex
+}
+
+func testValidator(t *testing.T, v *Validator) {
+	symbol := "BTC-PERPETUAL"
+	// 远低于盘口的买单，不会成交
+	base := 5000.0
+	price := base + 0.3
+
+	order, err := v.PlaceOrder(symbol, Buy, OrderTypeLimit, price, 15)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, base+0.5, order.Price)
+	assert.Equal(t, 10.0, order.Amount)
+
+	order, err = v.OpenLong(symbol, OrderTypeLimit, base+0.1, 29)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, base, order.Price)
+	assert.Equal(t, 20.0, order.Amount)
+
+	order, err = v.AmendOrder(symbol, order.ID, base-0.8, 37)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, base-1, order.Price)
+	assert.Equal(t, 30.0, order.Amount)
+
+	_, err = v.PlaceOrder(symbol, Buy, OrderTypeLimit, price, 5)
+	assert.Equal(t, ErrAmountTooSmall, err)
+	_, err = v.PlaceOrder(symbol, Buy, OrderTypeLimit, price, 2000)
+	assert.Equal(t, ErrAmountTooLarge, err)
+	_, err = v.PlaceOrder(symbol, Buy, OrderTypeLimit, price, 0)
+	assert.Equal(t, ErrInvalidAmount, err)
+	_, err = v.PlaceOrder(symbol, Buy, OrderTypeLimit, 0, 10)
+	assert.Equal(t, ErrInvalidPrice, err)
+	_, err = v.PlaceOrder(symbol, Buy, OrderTypeMarket, 0, 10, OrderPostOnlyOption(true))
+	assert.Equal(t, ErrInvalidOrderOption, err)
+	_, err = v.PlaceOrder(symbol, Buy, OrderTypeStopMarket, 0, 10)
+	assert.Equal(t, ErrInvalidStopPx, err)
+	_, err = v.AmendOrder(symbol, order.ID, 0, 5)
+	assert.Equal(t, ErrAmountTooSmall, err)
+
+	orders, err := v.GetOpenOrders(symbol)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, 2, len(orders))
+}
+
+func TestValidator_ExSim(t *testing.T) {
+	testValidator(t, NewValidator(testExSim()))
+}
+
+func TestValidator_GenerateSim(t *testing.T) {
+	testValidator(t, NewValidator(testGenerateSim()))
+}
+
+func TestValidator_StopOrder(t *testing.T) {
+	v := NewValidator(testExSim())
+	order, err := v.PlaceOrder("BTC-PERPETUAL", Buy, OrderTypeStopMarket, 0, 10,
+		OrderStopPxOption(20000.2))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, 20000.0, order.StopPx)
+}
+
+func TestValidator_SetInstrument(t *testing.T) {
+	v := NewValidator(testExSim())
+	v.SetInstrument(&Instrument{Symbol: "BTC-PERPETUAL", TickSize: 1, LotSize: 20})
+	order, err := v.PlaceOrder("BTC-PERPETUAL", Sell, OrderTypeLimit, 20000.6, 35)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, 20001.0, order.Price)
+	assert.Equal(t, 20.0, order.Amount)
+}
+
+type validatorStrategy struct {
+	StrategyBase
+
+	orders []*Order
+}
+
+func (s *validatorStrategy) OnInit() error {
+	return nil
+}
+
+func (s *validatorStrategy) OnTick() error {
+	if len(s.orders) > 0 {
+		return nil
+	}
+	order, err := s.Exchange.PlaceOrder("BTC-PERPETUAL", Buy, OrderTypeMarket, 0, 15)
+	if err != nil {
+		return err
+	}
+	s.orders = append(s.orders, order)
+	order, err = s.Exchange.PlaceOrder("BTC-PERPETUAL", Buy, OrderTypeLimit, 5000.3, 29)
+	if err != nil {
+		return err
+	}
+	s.orders = append(s.orders, order)
+	return nil
+}
+
+func (s *validatorStrategy) Run() error {
+	return nil
+}
+
+func (s *validatorStrategy) OnExit() error {
+	return nil
+}
+
+func TestValidatorSim_Backtest(t *testing.T) {
+	end := testutil.Start.Add(time.Minute)
+	obs := testutil.OrderBooks("BTC-PERPETUAL", testutil.Start, time.Second, 1000, 8000, 8001, 8002)
+	data := testutil.NewData(obs, testutil.Start, end)
+	sim := exsim.NewExSim(data, 10000, -0.00025, 0.00075, 10, false, false)
+	if err := sim.SetInstrument(testInstrument()); err != nil {
+		t.Error(err)
+		return
+	}
+	ex := NewValidatorSim(sim)
+	strategy := &validatorStrategy{}
+	b := backtest.NewBacktest([]*dataloader.Data{data}, "BTC", testutil.Start, end,
+		strategy, []ExchangeSim{ex}, "")
+	b.Run()
+
+	if !assert.Equal(t, 2, len(strategy.orders)) {
+		return
+	}
+	assert.Equal(t, 10.0, strategy.orders[0].Amount)
+	assert.Equal(t, 5000.5, strategy.orders[1].Price)
+	assert.Equal(t, 20.0, strategy.orders[1].Amount)
+
+	positions, err := ex.GetPositions("BTC-PERPETUAL")
+	if assert.Nil(t, err) && assert.Equal(t, 1, len(positions)) {
+		assert.Equal(t, 10.0, positions[0].Size)
+	}
+}
@@ -3,15 +3,38 @@ package crex
 import (
 	"github.com/coinrust/crex/utils"
 	"strconv"
+	"sync"
 )
 
-var idGen *utils.IdGenerate
+var (
+	idGen   *utils.IdGenerate
+	idGenMu sync.RWMutex
+)
+
+// IdGenerateSetter 可设置订单ID生成器的模拟交易所，回测中每个策略使用独立的生成器
+type IdGenerateSetter interface {
+	SetIdGenerate(g *utils.IdGenerate)
+}
 
 func SetIdGenerate(g *utils.IdGenerate) {
+	idGenMu.Lock()
 	idGen = g
+	idGenMu.Unlock()
 }
 
 func GenOrderId() string {
-	id := idGen.Next()
+	idGenMu.RLock()
+	g := idGen
+	idGenMu.RUnlock()
+	id := g.Next()
+	return strconv.Itoa(int(id))
+}
+
+// GenOrderIdBy 使用指定的生成器生成订单ID，g 为空时使用全局生成器
+func GenOrderIdBy(g *utils.IdGenerate) string {
+	if g == nil {
+		return GenOrderId()
+	}
+	id := g.Next()
 	return strconv.Itoa(int(id))
 }
@@ -0,0 +1,97 @@
+package crex
+
+import (
+	"math"
+	"time"
+)
+
+// Instrument 交易标的规格
+type Instrument struct {
+	Symbol         string    // 标
+	BaseCurrency   string    // 基础货币 如 BTC
+	QuoteCurrency  string    // 计价货币 如 USD
+	SettleCurrency string    // 结算货币
+	TickSize       float64   // 最小价格变动，0 表示不限制
+	LotSize        float64   // 最小数量变动，0 表示不限制
+	MinSize        float64   // 最小下单数量
+	MaxSize        float64   // 最大持仓数量，0 表示不限制
+	ContractValue  float64   // 合约单张面值，正向合约为基础货币数量，反向合约为计价货币金额
+	MinNotional    float64   // 最小下单金额(计价货币)，0 表示不限制
+	Inverse        bool      // true-反向合约 false-正向合约
+	ExpiryTime     time.Time // 交割时间，永续合约为零值
+}
+
+// RoundPrice 按最小价格变动四舍五入
+func (i *Instrument) RoundPrice(price float64) float64 {
+	if i.TickSize <= 0 {
+		return price
+	}
+	return roundStep(math.Round(price/i.TickSize), i.TickSize)
+}
+
+// RoundSize 按最小数量变动向下取整
+func (i *Instrument) RoundSize(size float64) float64 {
+	if i.LotSize <= 0 {
+		return size
+	}
+	// 加上误差，避免 0.3/0.1=2.9999999999999996 取整为 2
+	return roundStep(math.Floor(size/i.LotSize+1e-9), i.LotSize)
+}
+
+// Value 合约价值(结算货币)
+func (i *Instrument) Value(size float64, price float64) float64 {
+	if i.Inverse {
+		if price == 0 {
+			return 0
+		}
+		return math.Abs(size) * i.ContractValue / price
+	}
+	return math.Abs(size) * i.ContractValue * price
+}
+
+// Notional 合约名义价值(计价货币)
+func (i *Instrument) Notional(size float64, price float64) float64 {
+	if i.Inverse {
+		return math.Abs(size) * i.ContractValue
+	}
+	return math.Abs(size) * i.ContractValue * price
+}
+
+// ValidateOrder 检查委托价格和数量，price 为 0 时(市价单)不检查价格
+func (i *Instrument) ValidateOrder(price float64, size float64) error {
+	if price != 0 && !isMultiple(price, i.TickSize) {
+		return ErrInvalidPrice
+	}
+	if size <= 0 || size < i.MinSize || !isMultiple(size, i.LotSize) {
+		return ErrInvalidAmount
+	}
+	if i.MaxSize > 0 && size > i.MaxSize {
+		return ErrInvalidAmount
+	}
+	if i.MinNotional > 0 && price != 0 && i.Notional(size, price) < i.MinNotional {
+		return ErrInvalidNotional
+	}
+	return nil
+}
+
+// 是否为 step 的整数倍，step 为 0 时不检查
+func isMultiple(x float64, step float64) bool {
+	if step <= 0 {
+		return true
+	}
+	n := x / step
+	return math.Abs(n-math.Round(n)) < 1e-8
+}
+
+// 消除浮点误差，如 3*0.1=0.30000000000000004
+func roundStep(n float64, step float64) float64 {
+	decimals := 0
+	for s := step; s < 1 && decimals < 12; s *= 10 {
+		if math.Abs(s-math.Round(s)) < 1e-9 {
+			break
+		}
+		decimals++
+	}
+	p := math.Pow(10, float64(decimals))
+	return math.Round(n*step*p) / p
+}
@@ -0,0 +1,103 @@
+// Package testutil 测试共用的回测时钟和内存数据
+package testutil
+
+import (
+	. "github.com/coinrust/crex"
+	"github.com/coinrust/crex/dataloader"
+	"github.com/coinrust/crex/utils"
+	"path/filepath"
+	"runtime"
+	"time"
+)
+
+// Start 测试数据的默认开始时间
+var Start = time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+
+// Backtest 以数据当前时间作为回测时钟
+type Backtest struct {
+	data *dataloader.Data
+}
+
+func (b *Backtest) GetTime() time.Time {
+	return b.data.GetTime()
+}
+
+// NewBacktest 创建回测时钟
+func NewBacktest(data *dataloader.Data) *Backtest {
+	return &Backtest{data: data}
+}
+
+// DataLoader 内存订单薄数据，只返回回测区间内的订单薄
+type DataLoader struct {
+	obs         []*OrderBook
+	start       time.Time
+	end         time.Time
+	hasMoreData bool
+}
+
+func (l *DataLoader) Setup(start time.Time, end time.Time) error {
+	l.start = start
+	l.end = end
+	l.hasMoreData = true
+	return nil
+}
+
+func (l *DataLoader) ReadOrderBooks() (result []*OrderBook) {
+	l.hasMoreData = false
+	for _, ob := range l.obs {
+		if !ob.Time.Before(l.start) && !ob.Time.After(l.end) {
+			result = append(result, ob)
+		}
+	}
+	return
+}
+
+func (l *DataLoader) ReadRecords(limit int) []*Record {
+	return nil
+}
+
+func (l *DataLoader) HasMoreData() bool {
+	return l.hasMoreData
+}
+
+// NewDataLoader 创建内存订单薄数据
+func NewDataLoader(obs []*OrderBook) *DataLoader {
+	return &DataLoader{obs: obs}
+}
+
+// OrderBooks 从 start 开始每隔 interval 生成一个订单薄，卖一价 = 买一价 + 1，买卖挂单量均为 amount
+func OrderBooks(symbol string, start time.Time, interval time.Duration, amount float64, bids ...float64) (result []*OrderBook) {
+	for i, bid := range bids {
+		result = append(result, &OrderBook{
+			Symbol: symbol,
+			Time:   start.Add(time.Duration(i) * interval),
+			Asks:   []Item{{Price: bid + 1, Amount: amount}},
+			Bids:   []Item{{Price: bid, Amount: amount}},
+		})
+	}
+	return
+}
+
+// NewData 创建内存数据并重置到 [start, end]，同时重置全局订单ID生成器使结果可复现
+func NewData(obs []*OrderBook, start time.Time, end time.Time) *dataloader.Data {
+	SetIdGenerate(utils.NewIdGenerate(start))
+	data := dataloader.NewData(NewDataLoader(obs))
+	data.Reset(start, end)
+	return data
+}
+
+// SampleData Deribit 样例数据 2019-10-01 全天，同时重置全局订单ID生成器
+func SampleData() *dataloader.Data {
+	end := Start.Add(24 * time.Hour)
+	SetIdGenerate(utils.NewIdGenerate(Start))
+	data := dataloader.NewCsvData(filepath.Join(rootDir(), "data-samples", "deribit",
+		"deribit_BTC-PERPETUAL_and_futures_tick_by_tick_book_snapshots_10_levels_2019-10-01_2019-11-01.csv"))
+	data.Reset(Start, end)
+	return data
+}
+
+// 仓库根目录，测试在各自包目录下运行
+func rootDir() string {
+	_, filename, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(filename), "..", "..")
+}
@@ -0,0 +1,200 @@
+package crex
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// Latency 委托延迟模型，返回请求从发出到到达交易所的时间
+type Latency interface {
+	Delay() time.Duration
+}
+
+// FixedLatency 固定延迟
+type FixedLatency time.Duration
+
+func (l FixedLatency) Delay() time.Duration {
+	return time.Duration(l)
+}
+
+// UniformLatency 均匀分布延迟 [Min, Max)
+type UniformLatency struct {
+	Min  time.Duration
+	Max  time.Duration
+	rand *rand.Rand
+}
+
+func (l *UniformLatency) Delay() time.Duration {
+	if l.Max <= l.Min {
+		return l.Min
+	}
+	return l.Min + time.Duration(l.rand.Int63n(int64(l.Max-l.Min)))
+}
+
+// NewUniformLatency 创建均匀分布延迟
+// seed: 随机数种子，相同种子回测结果可复现
+func NewUniformLatency(min time.Duration, max time.Duration, seed int64) *UniformLatency {
+	return &UniformLatency{
+		Min:  min,
+		Max:  max,
+		rand: rand.New(rand.NewSource(seed)),
+	}
+}
+
+// NormalLatency 正态分布延迟，小于 Min 时取 Min
+type NormalLatency struct {
+	Mean   time.Duration
+	StdDev time.Duration
+	Min    time.Duration
+	rand   *rand.Rand
+}
+
+func (l *NormalLatency) Delay() time.Duration {
+	d := time.Duration(float64(l.Mean) + l.rand.NormFloat64()*float64(l.StdDev))
+	return time.Duration(math.Max(float64(d), float64(l.Min)))
+}
+
+// NewNormalLatency 创建正态分布延迟
+// seed: 随机数种子，相同种子回测结果可复现
+func NewNormalLatency(mean time.Duration, stdDev time.Duration, min time.Duration, seed int64) *NormalLatency {
+	return &NormalLatency{
+		Mean:   mean,
+		StdDev: stdDev,
+		Min:    min,
+		rand:   rand.New(rand.NewSource(seed)),
+	}
+}
+
+// 延迟撤单信息
+type pendingCancel struct {
+	time   time.Time   // 到达时间
+	status OrderStatus // 撤单前的委托状态
+}
+
+// 延迟修改委托信息
+type pendingAmend struct {
+	time  time.Time // 到达时间
+	price float64
+	size  float64
+}
+
+// LatencyHandler 请求到达交易所后的撮合函数
+type LatencyHandler struct {
+	Arrive func(order *Order) (match bool, err error)                              // 委托到达交易所
+	Amend  func(order *Order, price float64, size float64) (match bool, err error) // 修改委托到达交易所
+	Match  func(order *Order) (match bool, err error)                              // 撮合已在交易所的委托
+}
+
+// LatencyQueue 模拟交易所中未到达交易所的下单、撤单和修改委托请求
+// 各模拟交易所共用，未设置延迟时请求立即生效
+type LatencyQueue struct {
+	placeLatency  Latency                   // 下单延迟
+	cancelLatency Latency                   // 撤单延迟
+	amendLatency  Latency                   // 修改委托延迟
+	arrivals      map[string]time.Time      // 未到达交易所的委托 key: OrderID value: 到达时间
+	cancels       map[string]*pendingCancel // 未到达交易所的撤单 key: OrderID
+	amends        map[string]*pendingAmend  // 未到达交易所的修改委托 key: OrderID
+}
+
+// SetLatency 设置下单、撤单、修改委托的延迟，nil 表示无延迟
+func (q *LatencyQueue) SetLatency(placeLatency Latency, cancelLatency Latency, amendLatency Latency) {
+	q.placeLatency = placeLatency
+	q.cancelLatency = cancelLatency
+	q.amendLatency = amendLatency
+}
+
+// Place 下单，有下单延迟时委托状态为 Created 并返回 true，到达交易所后由 Run 撮合
+func (q *LatencyQueue) Place(order *Order, now time.Time) bool {
+	if q.placeLatency == nil {
+		return false
+	}
+	order.Status = OrderStatusCreated
+	q.arrivals[order.ID] = now.Add(q.placeLatency.Delay())
+	return true
+}
+
+// Cancel 撤单，有撤单延迟时委托状态为 CancelPending 并返回 true，撤单到达前委托继续撮合
+func (q *LatencyQueue) Cancel(order *Order, now time.Time) bool {
+	if q.cancelLatency == nil {
+		return false
+	}
+	q.cancels[order.ID] = &pendingCancel{
+		time:   now.Add(q.cancelLatency.Delay()),
+		status: order.Status,
+	}
+	order.Status = OrderStatusCancelPending
+	return true
+}
+
+// Amend 修改委托，有修改延迟时返回 true，修改请求到达交易所后由 Run 生效
+func (q *LatencyQueue) Amend(order *Order, price float64, size float64, now time.Time) bool {
+	if q.amendLatency == nil {
+		return false
+	}
+	q.amends[order.ID] = &pendingAmend{
+		time:  now.Add(q.amendLatency.Delay()),
+		price: price,
+		size:  size,
+	}
+	return true
+}
+
+// Remove 委托结束，删除未到达的请求
+func (q *LatencyQueue) Remove(id string) {
+	delete(q.arrivals, id)
+	delete(q.cancels, id)
+	delete(q.amends, id)
+}
+
+// Run 撮合一个委托，处理到达交易所的下单、修改委托和撤单
+// cancelled: 撤单到达交易所，委托已撤销
+func (q *LatencyQueue) Run(order *Order, now time.Time, h *LatencyHandler) (match bool, cancelled bool, err error) {
+	cancel, cancelPending := q.cancels[order.ID]
+	if cancelPending {
+		// 撤单到达前委托保持原状态
+		order.Status = cancel.status
+	}
+
+	if arrivalTime, ok := q.arrivals[order.ID]; ok {
+		if !now.Before(arrivalTime) {
+			delete(q.arrivals, order.ID)
+			match, err = h.Arrive(order)
+		}
+	} else if amend, ok := q.amends[order.ID]; ok && !now.Before(amend.time) {
+		delete(q.amends, order.ID)
+		match, err = h.Amend(order, amend.price, amend.size)
+	} else {
+		match, err = h.Match(order)
+	}
+
+	if cancelPending {
+		if !isPendingOrder(order) {
+			// 撤单到达前已完成
+			delete(q.cancels, order.ID)
+		} else if !now.Before(cancel.time) {
+			delete(q.cancels, order.ID)
+			order.Status = OrderStatusCancelled
+			order.UpdateTime = now
+			cancelled = true
+		} else {
+			cancel.status = order.Status
+			order.Status = OrderStatusCancelPending
+		}
+	}
+	return
+}
+
+// 是否仍在交易所中(活跃委托或等待触发的条件委托)
+func isPendingOrder(order *Order) bool {
+	return order.IsOpen() || order.Status == OrderStatusUntriggered
+}
+
+// NewLatencyQueue 创建请求延迟队列
+func NewLatencyQueue() *LatencyQueue {
+	return &LatencyQueue{
+		arrivals: make(map[string]time.Time),
+		cancels:  make(map[string]*pendingCancel),
+		amends:   make(map[string]*pendingAmend),
+	}
+}
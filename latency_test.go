@@ -0,0 +1,101 @@
+package crex_test
+
+import (
+	. "github.com/coinrust/crex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestUniformLatency(t *testing.T) {
+	l := NewUniformLatency(10*time.Millisecond, 100*time.Millisecond, 1)
+	for i := 0; i < 100; i++ {
+		d := l.Delay()
+		assert.True(t, d >= 10*time.Millisecond && d < 100*time.Millisecond)
+	}
+}
+
+// 记录到达交易所的请求，Match 时按价格成交
+type testLatencyHandler struct {
+	arrived int
+	amended int
+	fill    bool
+}
+
+func (h *testLatencyHandler) handler() *LatencyHandler {
+	return &LatencyHandler{
+		Arrive: func(order *Order) (bool, error) {
+			h.arrived++
+			order.Status = OrderStatusNew
+			return h.match(order)
+		},
+		Amend: func(order *Order, price float64, size float64) (bool, error) {
+			h.amended++
+			order.Price = price
+			return h.match(order)
+		},
+		Match: h.match,
+	}
+}
+
+func (h *testLatencyHandler) match(order *Order) (bool, error) {
+	if !h.fill || !order.IsOpen() {
+		return false, nil
+	}
+	order.Status = OrderStatusFilled
+	return true, nil
+}
+
+func TestLatencyQueue(t *testing.T) {
+	start := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	h := &testLatencyHandler{}
+	q := NewLatencyQueue()
+	order := &Order{ID: "1", Status: OrderStatusNew}
+
+	// 未设置延迟时请求立即生效
+	assert.False(t, q.Place(order, start))
+	assert.False(t, q.Cancel(order, start))
+	assert.False(t, q.Amend(order, 100, 0, start))
+
+	q.SetLatency(FixedLatency(time.Second), FixedLatency(time.Second), FixedLatency(time.Second))
+	assert.True(t, q.Place(order, start))
+	assert.Equal(t, OrderStatusCreated, order.Status)
+
+	match, cancelled, err := q.Run(order, start.Add(500*time.Millisecond), h.handler())
+	assert.Nil(t, err)
+	assert.False(t, match || cancelled)
+	assert.Equal(t, 0, h.arrived)
+	assert.Equal(t, OrderStatusCreated, order.Status)
+
+	q.Run(order, start.Add(time.Second), h.handler())
+	assert.Equal(t, 1, h.arrived)
+	assert.Equal(t, OrderStatusNew, order.Status)
+
+	// 修改委托到达后生效
+	assert.True(t, q.Amend(order, 101, 0, start.Add(time.Second)))
+	q.Run(order, start.Add(1500*time.Millisecond), h.handler())
+	assert.Equal(t, 0, h.amended)
+	q.Run(order, start.Add(2*time.Second), h.handler())
+	assert.Equal(t, 1, h.amended)
+	assert.Equal(t, 101.0, order.Price)
+
+	// 撤单到达前委托保持 CancelPending，到达后撤销
+	assert.True(t, q.Cancel(order, start.Add(2*time.Second)))
+	assert.Equal(t, OrderStatusCancelPending, order.Status)
+	_, cancelled, _ = q.Run(order, start.Add(2500*time.Millisecond), h.handler())
+	assert.False(t, cancelled)
+	assert.Equal(t, OrderStatusCancelPending, order.Status)
+	_, cancelled, _ = q.Run(order, start.Add(3*time.Second), h.handler())
+	assert.True(t, cancelled)
+	assert.Equal(t, OrderStatusCancelled, order.Status)
+	assert.Equal(t, start.Add(3*time.Second), order.UpdateTime)
+
+	// 撤单到达前成交
+	order = &Order{ID: "2", Status: OrderStatusNew}
+	q.Cancel(order, start)
+	h.fill = true
+	match, cancelled, _ = q.Run(order, start, h.handler())
+	assert.True(t, match)
+	assert.False(t, cancelled)
+	assert.Equal(t, OrderStatusFilled, order.Status)
+}
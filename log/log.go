@@ -1,5 +1,7 @@
 package log
 
+import "sync"
+
 const (
 	DebugLevel = "debug"
 	InfoLevel  = "info"
@@ -8,14 +10,27 @@ const (
 	PanicLevel = "panic"
 )
 
-var logger Logger
+var (
+	logger Logger
+	mu     sync.RWMutex
+)
 
 func SetLogger(myLogger Logger) {
+	mu.Lock()
 	logger = myLogger
+	mu.Unlock()
+}
+
+// GetLogger 返回全局日志
+func GetLogger() Logger {
+	mu.RLock()
+	defer mu.RUnlock()
+	return logger
 }
 
 // Debug Using：log.Debug("test")
 func Debug(args ...interface{}) {
+	logger := GetLogger()
 	if logger == nil {
 		return
 	}
@@ -25,6 +40,7 @@ func Debug(args ...interface{}) {
 
 // Debugf Using：log.Debugf("test:%s", err)
 func Debugf(template string, args ...interface{}) {
+	logger := GetLogger()
 	if logger == nil {
 		return
 	}
@@ -34,6 +50,7 @@ func Debugf(template string, args ...interface{}) {
 
 // Debugw Using：log.Debugw("test", "field1", "value1", "field2", "value2")
 func Debugw(msg string, keysAndValues ...interface{}) {
+	logger := GetLogger()
 	if logger == nil {
 		return
 	}
@@ -42,6 +59,7 @@ func Debugw(msg string, keysAndValues ...interface{}) {
 }
 
 func Info(args ...interface{}) {
+	logger := GetLogger()
 	if logger == nil {
 		return
 	}
@@ -50,6 +68,7 @@ func Info(args ...interface{}) {
 }
 
 func Infof(template string, args ...interface{}) {
+	logger := GetLogger()
 	if logger == nil {
 		return
 	}
@@ -58,6 +77,7 @@ func Infof(template string, args ...interface{}) {
 }
 
 func Infow(msg string, keysAndValues ...interface{}) {
+	logger := GetLogger()
 	if logger == nil {
 		return
 	}
@@ -66,6 +86,7 @@ func Infow(msg string, keysAndValues ...interface{}) {
 }
 
 func Warn(args ...interface{}) {
+	logger := GetLogger()
 	if logger == nil {
 		return
 	}
@@ -74,6 +95,7 @@ func Warn(args ...interface{}) {
 }
 
 func Warnf(template string, args ...interface{}) {
+	logger := GetLogger()
 	if logger == nil {
 		return
 	}
@@ -82,6 +104,7 @@ func Warnf(template string, args ...interface{}) {
 }
 
 func Warnw(msg string, keysAndValues ...interface{}) {
+	logger := GetLogger()
 	if logger == nil {
 		return
 	}
@@ -90,6 +113,7 @@ func Warnw(msg string, keysAndValues ...interface{}) {
 }
 
 func Error(args ...interface{}) {
+	logger := GetLogger()
 	if logger == nil {
 		return
 	}
@@ -98,6 +122,7 @@ func Error(args ...interface{}) {
 }
 
 func Errorf(template string, args ...interface{}) {
+	logger := GetLogger()
 	if logger == nil {
 		return
 	}
@@ -106,6 +131,7 @@ func Errorf(template string, args ...interface{}) {
 }
 
 func Errorw(msg string, keysAndValues ...interface{}) {
+	logger := GetLogger()
 	if logger == nil {
 		return
 	}
@@ -114,6 +140,7 @@ func Errorw(msg string, keysAndValues ...interface{}) {
 }
 
 func Sync() {
+	logger := GetLogger()
 	if logger == nil {
 		return
 	}
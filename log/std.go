@@ -0,0 +1,58 @@
+package log
+
+// Std 转发到全局日志的 Logger，策略未设置独立日志时使用
+var Std Logger = stdLogger{}
+
+type stdLogger struct{}
+
+func (stdLogger) Debug(args ...interface{}) {
+	Debug(args...)
+}
+
+func (stdLogger) Debugf(template string, args ...interface{}) {
+	Debugf(template, args...)
+}
+
+func (stdLogger) Debugw(msg string, keysAndValues ...interface{}) {
+	Debugw(msg, keysAndValues...)
+}
+
+func (stdLogger) Info(args ...interface{}) {
+	Info(args...)
+}
+
+func (stdLogger) Infof(template string, args ...interface{}) {
+	Infof(template, args...)
+}
+
+func (stdLogger) Infow(msg string, keysAndValues ...interface{}) {
+	Infow(msg, keysAndValues...)
+}
+
+func (stdLogger) Warn(args ...interface{}) {
+	Warn(args...)
+}
+
+func (stdLogger) Warnf(template string, args ...interface{}) {
+	Warnf(template, args...)
+}
+
+func (stdLogger) Warnw(msg string, keysAndValues ...interface{}) {
+	Warnw(msg, keysAndValues...)
+}
+
+func (stdLogger) Error(args ...interface{}) {
+	Error(args...)
+}
+
+func (stdLogger) Errorf(template string, args ...interface{}) {
+	Errorf(template, args...)
+}
+
+func (stdLogger) Errorw(msg string, keysAndValues ...interface{}) {
+	Errorw(msg, keysAndValues...)
+}
+
+func (stdLogger) Sync() {
+	Sync()
+}
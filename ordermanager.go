@@ -0,0 +1,289 @@
+package crex
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// OrderManager 本地委托和持仓状态，通过 SubscribeOrders/SubscribePositions 推送更新，
+// 并定时从 REST 同步，以补上断线重连期间丢失的推送
+type OrderManager struct {
+	exchange Exchange
+	symbols  []string
+
+	mu           sync.RWMutex
+	orders       map[string]*Order      // 活跃委托 key: id
+	clientOrders map[string]*Order      // 活跃委托 key: ClientOId
+	positions    map[string][]*Position // key: symbol
+
+	orderCallbacks    []func(order *Order)
+	positionCallbacks []func(symbol string, positions []*Position)
+
+	resyncInterval time.Duration
+	stopC          chan struct{}
+}
+
+// SetResyncInterval 设置定时同步间隔，默认 1 分钟，0 表示不定时同步，需在 Start 前设置
+func (m *OrderManager) SetResyncInterval(interval time.Duration) {
+	m.resyncInterval = interval
+}
+
+// OnOrder 委托变化回调，委托成交、撤销后回调最终状态并从活跃委托中移除
+func (m *OrderManager) OnOrder(callback func(order *Order)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.orderCallbacks = append(m.orderCallbacks, callback)
+}
+
+// OnPositions 持仓变化回调
+func (m *OrderManager) OnPositions(callback func(symbol string, positions []*Position)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.positionCallbacks = append(m.positionCallbacks, callback)
+}
+
+// Start 订阅委托和持仓，并从 REST 同步一次
+func (m *OrderManager) Start() (err error) {
+	for _, symbol := range m.symbols {
+		market := Market{Symbol: symbol}
+		if err = m.exchange.SubscribeOrders(market, func(orders []*Order) {
+			m.UpdateOrders(orders...)
+		}); err != nil {
+			return
+		}
+		symbol := symbol
+		if err = m.exchange.SubscribePositions(market, func(positions []*Position) {
+			m.updatePositions(symbol, positions)
+		}); err != nil {
+			return
+		}
+	}
+
+	if err = m.Resync(); err != nil {
+		return
+	}
+
+	if m.resyncInterval > 0 {
+		m.stopC = make(chan struct{})
+		go m.loop(m.resyncInterval, m.stopC)
+	}
+	return
+}
+
+// Stop 停止定时同步
+func (m *OrderManager) Stop() {
+	if m.stopC != nil {
+		close(m.stopC)
+		m.stopC = nil
+	}
+}
+
+func (m *OrderManager) loop(interval time.Duration, stopC chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			m.Resync()
+		case <-stopC:
+			return
+		}
+	}
+}
+
+// Resync 从 REST 同步活跃委托和持仓，本地活跃但交易所已不活跃的委托查询最终状态
+func (m *OrderManager) Resync() (err error) {
+	for _, symbol := range m.symbols {
+		var orders []*Order
+		orders, err = m.exchange.GetOpenOrders(symbol)
+		if err != nil {
+			return
+		}
+
+		open := map[string]bool{}
+		for _, v := range orders {
+			open[v.ID] = true
+		}
+		for _, v := range m.GetOpenOrders(symbol) {
+			if open[v.ID] {
+				continue
+			}
+			order, err := m.exchange.GetOrder(symbol, v.ID)
+			if err != nil || order.IsOpen() {
+				// 无法获取最终状态时直接移除
+				m.removeOrder(v)
+				continue
+			}
+			orders = append(orders, order)
+		}
+		m.UpdateOrders(orders...)
+
+		var positions []*Position
+		positions, err = m.exchange.GetPositions(symbol)
+		if err != nil {
+			return
+		}
+		m.updatePositions(symbol, positions)
+	}
+	return
+}
+
+// UpdateOrders 更新委托，可用于更新下单返回的委托
+func (m *OrderManager) UpdateOrders(orders ...*Order) {
+	var changed []*Order
+	m.mu.Lock()
+	for _, v := range orders {
+		if order := m.updateOrder(v); order != nil {
+			changed = append(changed, order)
+		}
+	}
+	callbacks := m.orderCallbacks
+	m.mu.Unlock()
+
+	for _, order := range changed {
+		for _, callback := range callbacks {
+			callback(order)
+		}
+	}
+}
+
+// 更新委托，有变化时返回委托副本
+func (m *OrderManager) updateOrder(order *Order) *Order {
+	if order == nil || order.ID == "" {
+		return nil
+	}
+	old, ok := m.orders[order.ID]
+	if ok {
+		// 忽略过期的推送
+		if !old.UpdateTime.IsZero() && order.UpdateTime.Before(old.UpdateTime) {
+			return nil
+		}
+		if !orderChanged(old, order) {
+			return nil
+		}
+	}
+
+	o := *order
+	if isOpenOrder(&o) {
+		m.orders[o.ID] = &o
+		if o.ClientOId != "" {
+			m.clientOrders[o.ClientOId] = &o
+		}
+	} else if ok {
+		m.deleteOrder(&o)
+	}
+	return &o
+}
+
+func (m *OrderManager) removeOrder(order *Order) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deleteOrder(order)
+}
+
+func (m *OrderManager) deleteOrder(order *Order) {
+	delete(m.orders, order.ID)
+	if order.ClientOId != "" {
+		delete(m.clientOrders, order.ClientOId)
+	}
+}
+
+func (m *OrderManager) updatePositions(symbol string, positions []*Position) {
+	var result []*Position
+	for _, v := range positions {
+		p := *v
+		if p.Symbol == "" {
+			p.Symbol = symbol
+		}
+		result = append(result, &p)
+	}
+
+	m.mu.Lock()
+	m.positions[symbol] = result
+	callbacks := m.positionCallbacks
+	m.mu.Unlock()
+
+	for _, callback := range callbacks {
+		callback(symbol, result)
+	}
+}
+
+// GetOpenOrders 返回活跃委托(含未触发的条件委托)，按委托时间排序
+func (m *OrderManager) GetOpenOrders(symbol string) (result []*Order) {
+	m.mu.RLock()
+	for _, v := range m.orders {
+		if symbol == "" || v.Symbol == "" || v.Symbol == symbol {
+			o := *v
+			result = append(result, &o)
+		}
+	}
+	m.mu.RUnlock()
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Time.Before(result[j].Time)
+	})
+	return
+}
+
+// GetOrder 按 ID 查询活跃委托
+func (m *OrderManager) GetOrder(id string) (result *Order, ok bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	order, ok := m.orders[id]
+	if ok {
+		o := *order
+		result = &o
+	}
+	return
+}
+
+// GetOrderByClientOId 按客户端订单ID查询活跃委托
+func (m *OrderManager) GetOrderByClientOId(clientOId string) (result *Order, ok bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	order, ok := m.clientOrders[clientOId]
+	if ok {
+		o := *order
+		result = &o
+	}
+	return
+}
+
+// GetPositions 返回标的持仓
+func (m *OrderManager) GetPositions(symbol string) (result []*Position) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, v := range m.positions[symbol] {
+		p := *v
+		result = append(result, &p)
+	}
+	return
+}
+
+// 活跃委托或等待触发的条件委托
+func isOpenOrder(order *Order) bool {
+	return order.IsOpen() || order.Status == OrderStatusUntriggered
+}
+
+func orderChanged(old *Order, order *Order) bool {
+	return old.Status != order.Status ||
+		old.FilledAmount != order.FilledAmount ||
+		old.AvgPrice != order.AvgPrice ||
+		old.Price != order.Price ||
+		old.Amount != order.Amount ||
+		old.StopPx != order.StopPx
+}
+
+// NewOrderManager 创建委托和持仓状态管理
+func NewOrderManager(exchange Exchange, symbols ...string) *OrderManager {
+	return &OrderManager{
+		exchange:     exchange,
+		symbols:      symbols,
+		orders:       map[string]*Order{},
+		clientOrders: map[string]*Order{},
+		positions:    map[string][]*Position{},
+		// 交易所 WebSocket 断线后自动重连但不通知，依靠定时同步恢复状态
+		resyncInterval: time.Minute,
+	}
+}
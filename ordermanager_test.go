@@ -0,0 +1,114 @@
+package crex_test
+
+import (
+	. "github.com/coinrust/crex"
+	"github.com/coinrust/crex/dataloader"
+	"github.com/coinrust/crex/exchanges/exsim"
+	"github.com/coinrust/crex/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func testExSim(bids ...float64) (*exsim.ExSim, *dataloader.Data) {
+	start := testutil.Start
+	data := testutil.NewData(testutil.OrderBooks("BTC-PERPETUAL", start, time.Second, 100000, bids...),
+		start, start.Add(time.Hour))
+	ex := exsim.NewExSim(data, 10, 0, 0, 10, false, false)
+	ex.SetBacktest(testutil.NewBacktest(data))
+	ex.SetExchangeLogger(&EmptyExchangeLogger{})
+	return ex, data
+}
+
+func TestOrderManager(t *testing.T) {
+	symbol := "BTC-PERPETUAL"
+	ex, data := testExSim(10000, 9900)
+	m := NewOrderManager(ex, symbol)
+	var changes []*Order
+	m.OnOrder(func(order *Order) {
+		changes = append(changes, order)
+	})
+	var positions []*Position
+	m.OnPositions(func(s string, v []*Position) {
+		assert.Equal(t, symbol, s)
+		positions = v
+	})
+	if err := m.Start(); err != nil {
+		t.Error(err)
+		return
+	}
+	defer m.Stop()
+
+	order, err := ex.PlaceOrder(symbol, Buy, OrderTypeLimit, 9950, 10, OrderClientOIdOption("c1"))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	orders := m.GetOpenOrders(symbol)
+	if !assert.Equal(t, 1, len(orders)) {
+		return
+	}
+	assert.Equal(t, order.ID, orders[0].ID)
+	v, ok := m.GetOrderByClientOId("c1")
+	assert.True(t, ok)
+	assert.Equal(t, order.ID, v.ID)
+	assert.Equal(t, 1, len(changes))
+
+	// 价格下跌后成交，委托移除并产生持仓
+	data.Next()
+	ex.RunEventLoopOnce()
+	_, ok = m.GetOrder(order.ID)
+	assert.False(t, ok)
+	_, ok = m.GetOrderByClientOId("c1")
+	assert.False(t, ok)
+	last := changes[len(changes)-1]
+	assert.Equal(t, OrderStatusFilled, last.Status)
+	assert.Equal(t, 10.0, last.FilledAmount)
+	if assert.Equal(t, 1, len(positions)) {
+		assert.Equal(t, 10.0, positions[0].Size)
+	}
+	assert.Equal(t, 10.0, m.GetPositions(symbol)[0].Size)
+
+	// 撤单
+	order, _ = ex.PlaceOrder(symbol, Buy, OrderTypeLimit, 9000, 10)
+	assert.Equal(t, 1, len(m.GetOpenOrders(symbol)))
+	ex.CancelOrder(symbol, order.ID)
+	assert.Equal(t, 0, len(m.GetOpenOrders(symbol)))
+	assert.Equal(t, OrderStatusCancelled, changes[len(changes)-1].Status)
+}
+
+func TestOrderManager_Resync(t *testing.T) {
+	symbol := "BTC-PERPETUAL"
+	ex, _ := testExSim(10000)
+	m := NewOrderManager(ex, symbol)
+
+	// 未订阅时的委托和持仓，通过 REST 同步
+	ex.PlaceOrder(symbol, Buy, OrderTypeMarket, 0, 20)
+	order, _ := ex.PlaceOrder(symbol, Buy, OrderTypeLimit, 9000, 10)
+	assert.Equal(t, 0, len(m.GetOpenOrders(symbol)))
+
+	if err := m.Resync(); err != nil {
+		t.Error(err)
+		return
+	}
+	orders := m.GetOpenOrders(symbol)
+	if assert.Equal(t, 1, len(orders)) {
+		assert.Equal(t, order.ID, orders[0].ID)
+	}
+	assert.Equal(t, 20.0, m.GetPositions(symbol)[0].Size)
+
+	// 本地活跃但交易所已撤销的委托
+	ex.CancelOrder(symbol, order.ID)
+	var last *Order
+	m.OnOrder(func(order *Order) {
+		last = order
+	})
+	if err := m.Resync(); err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, 0, len(m.GetOpenOrders(symbol)))
+	if assert.NotNil(t, last) {
+		assert.Equal(t, OrderStatusCancelled, last.Status)
+	}
+}
@@ -0,0 +1,101 @@
+package crex
+
+import (
+	"fmt"
+	"time"
+)
+
+// Periods 所有K线周期
+var Periods = []string{
+	PERIOD_1MIN,
+	PERIOD_3MIN,
+	PERIOD_5MIN,
+	PERIOD_15MIN,
+	PERIOD_30MIN,
+	PERIOD_60MIN,
+	PERIOD_1H,
+	PERIOD_2H,
+	PERIOD_3H,
+	PERIOD_4H,
+	PERIOD_6H,
+	PERIOD_8H,
+	PERIOD_12H,
+	PERIOD_1DAY,
+	PERIOD_3DAY,
+	PERIOD_1WEEK,
+	PERIOD_1MONTH,
+	PERIOD_1YEAR,
+}
+
+// 固定时长的K线周期
+var periodDurations = map[string]time.Duration{
+	PERIOD_1MIN:  time.Minute,
+	PERIOD_3MIN:  3 * time.Minute,
+	PERIOD_5MIN:  5 * time.Minute,
+	PERIOD_15MIN: 15 * time.Minute,
+	PERIOD_30MIN: 30 * time.Minute,
+	PERIOD_60MIN: time.Hour,
+	PERIOD_1H:    time.Hour,
+	PERIOD_2H:    2 * time.Hour,
+	PERIOD_3H:    3 * time.Hour,
+	PERIOD_4H:    4 * time.Hour,
+	PERIOD_6H:    6 * time.Hour,
+	PERIOD_8H:    8 * time.Hour,
+	PERIOD_12H:   12 * time.Hour,
+	PERIOD_1DAY:  24 * time.Hour,
+	PERIOD_3DAY:  72 * time.Hour,
+	PERIOD_1WEEK: 7 * 24 * time.Hour,
+}
+
+// 1970-01-01 是周四，周K线从周一开始
+const weekOffset = 4 * 24 * time.Hour
+
+// PeriodStartTime 返回 tm 所在K线周期的开始时间(UTC)
+func PeriodStartTime(period string, tm time.Time) (result time.Time, err error) {
+	tm = tm.UTC()
+	switch period {
+	case PERIOD_1MONTH:
+		result = time.Date(tm.Year(), tm.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case PERIOD_1YEAR:
+		result = time.Date(tm.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+	case PERIOD_1WEEK:
+		d := periodDurations[period]
+		ns := tm.UnixNano() - int64(weekOffset)
+		result = time.Unix(0, ns-mod(ns, int64(d))+int64(weekOffset)).UTC()
+	default:
+		d, ok := periodDurations[period]
+		if !ok {
+			err = fmt.Errorf("invalid period: %v", period)
+			return
+		}
+		ns := tm.UnixNano()
+		result = time.Unix(0, ns-mod(ns, int64(d))).UTC()
+	}
+	return
+}
+
+// PeriodEndTime 返回 tm 所在K线周期的结束时间，即下一周期的开始时间(UTC)
+func PeriodEndTime(period string, tm time.Time) (result time.Time, err error) {
+	var start time.Time
+	start, err = PeriodStartTime(period, tm)
+	if err != nil {
+		return
+	}
+	switch period {
+	case PERIOD_1MONTH:
+		result = start.AddDate(0, 1, 0)
+	case PERIOD_1YEAR:
+		result = start.AddDate(1, 0, 0)
+	default:
+		result = start.Add(periodDurations[period])
+	}
+	return
+}
+
+func mod(a int64, b int64) int64 {
+	m := a % b
+	if m < 0 {
+		m += b
+	}
+	return m
+}
@@ -0,0 +1,318 @@
+package risk
+
+import (
+	. "github.com/coinrust/crex"
+	"github.com/coinrust/crex/dataloader"
+	"github.com/coinrust/crex/utils"
+	"sync"
+)
+
+// RiskExchange 期货交易所风控层，下单前检查风控限制并根据成交统计已实现盈亏
+type RiskExchange struct {
+	Exchange
+	*Manager
+	refresher
+
+	account     *account
+	mu          sync.RWMutex
+	instruments map[string]*Instrument // key: symbol
+}
+
+// Start 定时查询委托成交，见 refresher
+func (r *RiskExchange) Start() {
+	r.start(r.refreshOrders)
+}
+
+// 获取合约规格，交易所不支持时按正向合约、面值 1 处理
+func (r *RiskExchange) getInstrument(symbol string) *Instrument {
+	r.mu.RLock()
+	result, ok := r.instruments[symbol]
+	r.mu.RUnlock()
+	if ok {
+		return result
+	}
+
+	result, err := r.Exchange.GetInstrument(symbol)
+	if err != nil || result == nil {
+		return &Instrument{Symbol: symbol, ContractValue: 1}
+	}
+
+	r.mu.Lock()
+	r.instruments[symbol] = result
+	r.mu.Unlock()
+	return result
+}
+
+// 委托名义价值(计价货币)，市价单使用对手价估算，无盘口时返回 0
+func (r *RiskExchange) notional(instrument *Instrument, symbol string, direction Direction, orderType OrderType,
+	price float64, size float64) (result float64, err error) {
+	if price <= 0 || orderType == OrderTypeMarket || orderType == OrderTypeStopMarket {
+		var ob *OrderBook
+		ob, err = r.Exchange.GetOrderBook(symbol, 1)
+		if err != nil || ob == nil {
+			return
+		}
+		if direction == Buy {
+			price = ob.AskPrice()
+		} else {
+			price = ob.BidPrice()
+		}
+	}
+	result = instrument.Notional(size, price)
+	return
+}
+
+func (r *RiskExchange) placeOrder(symbol string, direction Direction, orderType OrderType, price float64, size float64,
+	reduceOnly bool, place func() (*Order, error)) (result *Order, err error) {
+	instrument := r.getInstrument(symbol)
+	config := r.GetConfig()
+	req := &orderRequest{
+		direction:  direction,
+		size:       size,
+		reduceOnly: reduceOnly,
+	}
+	if config.MaxOrderNotional > 0 {
+		if req.notional, err = r.notional(instrument, symbol, direction, orderType, price, size); err != nil {
+			return
+		}
+	}
+	if config.MaxOpenOrders > 0 {
+		var orders []*Order
+		if orders, err = r.Exchange.GetOpenOrders(symbol); err != nil {
+			return
+		}
+		req.openOrders = len(orders)
+	}
+	if config.MaxPosition > 0 && !reduceOnly {
+		var positions []*Position
+		if positions, err = r.Exchange.GetPositions(symbol); err != nil {
+			return
+		}
+		for _, v := range positions {
+			req.position += v.Size
+		}
+	}
+	if err = r.checkOrder(req); err != nil {
+		return
+	}
+
+	result, err = place()
+	if err != nil {
+		return
+	}
+	r.trackOrder(r.account, instrument, result)
+	return
+}
+
+func (r *RiskExchange) track(symbol string, orders ...*Order) {
+	for _, v := range orders {
+		if v == nil {
+			continue
+		}
+		if v.Symbol != "" {
+			symbol = v.Symbol
+		}
+		r.trackOrder(r.account, r.getInstrument(symbol), v)
+	}
+}
+
+// 查询交易过的标的活跃委托和仍在跟踪的委托，更新成交
+func (r *RiskExchange) refreshOrders() {
+	opened := map[string]bool{}
+	for symbol := range r.symbols(r.account) {
+		orders, err := r.Exchange.GetOpenOrders(symbol)
+		if err != nil {
+			continue
+		}
+		for _, v := range orders {
+			opened[v.ID] = true
+		}
+		r.track(symbol, orders...)
+	}
+	for id, symbol := range r.openOrders(r.account) {
+		if opened[id] {
+			continue
+		}
+		order, err := r.Exchange.GetOrder(symbol, id)
+		if err != nil {
+			continue
+		}
+		r.track(symbol, order)
+	}
+}
+
+func (r *RiskExchange) OpenLong(symbol string, orderType OrderType, price float64, size float64) (result *Order, err error) {
+	return r.placeOrder(symbol, Buy, orderType, price, size, false, func() (*Order, error) {
+		return r.Exchange.OpenLong(symbol, orderType, price, size)
+	})
+}
+
+func (r *RiskExchange) OpenShort(symbol string, orderType OrderType, price float64, size float64) (result *Order, err error) {
+	return r.placeOrder(symbol, Sell, orderType, price, size, false, func() (*Order, error) {
+		return r.Exchange.OpenShort(symbol, orderType, price, size)
+	})
+}
+
+func (r *RiskExchange) CloseLong(symbol string, orderType OrderType, price float64, size float64) (result *Order, err error) {
+	return r.placeOrder(symbol, Sell, orderType, price, size, true, func() (*Order, error) {
+		return r.Exchange.CloseLong(symbol, orderType, price, size)
+	})
+}
+
+func (r *RiskExchange) CloseShort(symbol string, orderType OrderType, price float64, size float64) (result *Order, err error) {
+	return r.placeOrder(symbol, Buy, orderType, price, size, true, func() (*Order, error) {
+		return r.Exchange.CloseShort(symbol, orderType, price, size)
+	})
+}
+
+func (r *RiskExchange) PlaceOrder(symbol string, direction Direction, orderType OrderType, price float64, size float64,
+	opts ...PlaceOrderOption) (result *Order, err error) {
+	params := ParsePlaceOrderParameter(opts...)
+	reduceOnly := params.ReduceOnly || params.ClosePosition
+	return r.placeOrder(symbol, direction, orderType, price, size, reduceOnly, func() (*Order, error) {
+		return r.Exchange.PlaceOrder(symbol, direction, orderType, price, size, opts...)
+	})
+}
+
+func (r *RiskExchange) AmendOrder(symbol string, id string, price float64, size float64, opts ...OrderOption) (result *Order, err error) {
+	var notional float64
+	if price > 0 && size > 0 {
+		notional = r.getInstrument(symbol).Notional(size, price)
+	}
+	if err = r.checkAmend(notional); err != nil {
+		return
+	}
+	result, err = r.Exchange.AmendOrder(symbol, id, price, size, opts...)
+	if err != nil {
+		return
+	}
+	r.track(symbol, result)
+	return
+}
+
+func (r *RiskExchange) GetOpenOrders(symbol string, opts ...OrderOption) (result []*Order, err error) {
+	result, err = r.Exchange.GetOpenOrders(symbol, opts...)
+	if err != nil {
+		return
+	}
+	r.track(symbol, result...)
+	return
+}
+
+func (r *RiskExchange) GetOrder(symbol string, id string, opts ...OrderOption) (result *Order, err error) {
+	result, err = r.Exchange.GetOrder(symbol, id, opts...)
+	if err != nil {
+		return
+	}
+	r.track(symbol, result)
+	return
+}
+
+func (r *RiskExchange) CancelOrder(symbol string, id string, opts ...OrderOption) (result *Order, err error) {
+	result, err = r.Exchange.CancelOrder(symbol, id, opts...)
+	if err != nil {
+		return
+	}
+	r.track(symbol, result)
+	return
+}
+
+// SubscribeOrders 订阅委托，推送的成交同时计入风控
+func (r *RiskExchange) SubscribeOrders(market Market, callback func(orders []*Order)) error {
+	return r.Exchange.SubscribeOrders(market, func(orders []*Order) {
+		r.track(market.Symbol, orders...)
+		callback(orders)
+	})
+}
+
+// RiskExchangeSim 模拟交易所风控层，回测时使用回测时间并在每次撮合后更新成交
+type RiskExchangeSim struct {
+	*RiskExchange
+	sim ExchangeSim
+}
+
+func (r *RiskExchangeSim) SetBacktest(backtest IBacktest) {
+	r.sim.SetBacktest(backtest)
+	r.SetClock(backtest.GetTime)
+}
+
+func (r *RiskExchangeSim) SetExchangeLogger(l ExchangeLogger) {
+	r.sim.SetExchangeLogger(l)
+}
+
+func (r *RiskExchangeSim) RunEventLoopOnce() (err error) {
+	err = r.sim.RunEventLoopOnce()
+	r.refreshOrders()
+	return
+}
+
+// GetFundingPnl 累计资金费用盈亏，模拟交易所不支持时返回 0
+func (r *RiskExchangeSim) GetFundingPnl() float64 {
+	if ex, ok := r.sim.(FundingExchangeSim); ok {
+		return ex.GetFundingPnl()
+	}
+	return 0
+}
+
+// GetFeeRates Maker/Taker 费率，模拟交易所不支持时返回 0
+func (r *RiskExchangeSim) GetFeeRates() (makerFeeRate float64, takerFeeRate float64) {
+	if ex, ok := r.sim.(FeeExchangeSim); ok {
+		return ex.GetFeeRates()
+	}
+	return
+}
+
+// SetIdGenerate 设置模拟交易所的订单ID生成器，模拟交易所不支持时忽略
+func (r *RiskExchangeSim) SetIdGenerate(g *utils.IdGenerate) {
+	if ex, ok := r.sim.(IdGenerateSetter); ok {
+		ex.SetIdGenerate(g)
+	}
+}
+
+// AddSymbol 模拟交易所增加交易标，风控使用该标的合约规格
+func (r *RiskExchangeSim) AddSymbol(instrument *Instrument, data *dataloader.Data) (err error) {
+	ex, ok := r.sim.(interface {
+		AddSymbol(instrument *Instrument, data *dataloader.Data) error
+	})
+	if !ok {
+		err = ErrNotImplemented
+		return
+	}
+	if err = ex.AddSymbol(instrument, data); err != nil {
+		return
+	}
+	r.mu.Lock()
+	r.instruments[instrument.Symbol] = instrument
+	r.mu.Unlock()
+	return
+}
+
+// NewRiskExchange 创建期货交易所风控层
+func NewRiskExchange(exchange Exchange, config Config) *RiskExchange {
+	return NewRiskExchangeWithManager(exchange, NewManager(config))
+}
+
+// NewRiskExchangeWithManager 使用已有的风控管理创建期货交易所风控层
+// 多个交易所共用同一个 Manager 时，当日亏损限制按所有交易所的已实现盈亏合计
+func NewRiskExchangeWithManager(exchange Exchange, m *Manager) *RiskExchange {
+	return &RiskExchange{
+		Exchange:    exchange,
+		Manager:     m,
+		refresher:   newRefresher(),
+		account:     newAccount(false),
+		instruments: map[string]*Instrument{},
+	}
+}
+
+// NewRiskExchangeSim 创建模拟交易所风控层，sim 需同时实现 Exchange
+func NewRiskExchangeSim(sim ExchangeSim, config Config) *RiskExchangeSim {
+	return NewRiskExchangeSimWithManager(sim, NewManager(config))
+}
+
+// NewRiskExchangeSimWithManager 使用已有的风控管理创建模拟交易所风控层
+func NewRiskExchangeSimWithManager(sim ExchangeSim, m *Manager) *RiskExchangeSim {
+	return &RiskExchangeSim{
+		RiskExchange: NewRiskExchangeWithManager(sim.(Exchange), m),
+		sim:          sim,
+	}
+}
@@ -0,0 +1,48 @@
+package risk
+
+import "time"
+
+// refresher 定时查询委托成交，策略不查询委托时风控也能统计成交
+// 同时查询交易过的标的活跃委托，跟踪交易所发起的委托(如强平)
+type refresher struct {
+	interval time.Duration
+	stopC    chan struct{}
+}
+
+// SetRefreshInterval 设置定时查询委托成交的间隔，默认 5 秒，需在 Start 前设置
+func (f *refresher) SetRefreshInterval(interval time.Duration) {
+	f.interval = interval
+}
+
+func (f *refresher) start(refresh func()) {
+	if f.stopC != nil || f.interval <= 0 {
+		return
+	}
+	f.stopC = make(chan struct{})
+	go f.loop(refresh, f.interval, f.stopC)
+}
+
+// Stop 停止定时查询
+func (f *refresher) Stop() {
+	if f.stopC != nil {
+		close(f.stopC)
+		f.stopC = nil
+	}
+}
+
+func (f *refresher) loop(refresh func(), interval time.Duration, stopC chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			refresh()
+		case <-stopC:
+			return
+		}
+	}
+}
+
+func newRefresher() refresher {
+	return refresher{interval: 5 * time.Second}
+}
@@ -0,0 +1,322 @@
+package risk
+
+import (
+	. "github.com/coinrust/crex"
+	"github.com/coinrust/crex/log"
+	"math"
+	"sync"
+	"time"
+)
+
+// Config 风控参数，0 表示不限制
+type Config struct {
+	MaxPosition        float64 `toml:"max_position"`          // 单个标最大持仓数量
+	MaxOpenOrders      int     `toml:"max_open_orders"`       // 单个标最大活跃委托数
+	MaxOrderNotional   float64 `toml:"max_order_notional"`    // 单笔委托最大名义价值(计价货币)
+	MaxOrdersPerSecond int     `toml:"max_orders_per_second"` // 每秒最大下单数
+	MaxDailyLoss       float64 `toml:"max_daily_loss"`        // 当日(UTC)最大已实现亏损(结算货币，不含手续费)，超过后禁止下单
+	StopStrategy       bool    `toml:"stop_strategy"`         // 触发风控时停止策略
+}
+
+// Enabled 是否设置了任一限制
+func (c *Config) Enabled() bool {
+	return c.MaxPosition > 0 ||
+		c.MaxOpenOrders > 0 ||
+		c.MaxOrderNotional > 0 ||
+		c.MaxOrdersPerSecond > 0 ||
+		c.MaxDailyLoss > 0
+}
+
+// 风控跟踪的持仓
+type position struct {
+	size  float64 // 正数多仓，负数空仓
+	price float64 // 开仓均价
+}
+
+// 风控跟踪的委托成交
+type orderFill struct {
+	symbol   string
+	filled   float64
+	avgPrice float64
+	open     bool
+}
+
+// 下单请求
+type orderRequest struct {
+	direction  Direction
+	size       float64
+	notional   float64
+	position   float64 // 当前净持仓
+	openOrders int     // 当前活跃委托数
+	reduceOnly bool
+}
+
+// 风控层对应的交易所账户，各交易所的持仓和委托分别跟踪
+type account struct {
+	spot      bool                  // 现货不能开空仓
+	positions map[string]*position  // key: symbol
+	fills     map[string]*orderFill // key: order id
+}
+
+// Manager 风控管理，记录下单频率并根据成交统计当日已实现盈亏
+// 多个风控层共用一个 Manager 时，下单频率和当日亏损合并计算
+type Manager struct {
+	config   Config
+	clock    func() time.Time
+	strategy Strategy
+
+	mu         sync.Mutex
+	orderTimes []time.Time
+	day        time.Time
+	dailyPnl   float64
+	killed     bool
+}
+
+// SetClock 设置时钟，回测时使用回测时间
+func (m *Manager) SetClock(clock func() time.Time) {
+	m.clock = clock
+}
+
+// SetStrategy 设置触发风控时需要停止的策略
+func (m *Manager) SetStrategy(strategy Strategy) {
+	m.strategy = strategy
+}
+
+// GetConfig 返回风控参数
+func (m *Manager) GetConfig() Config {
+	return m.config
+}
+
+// GetDailyPnl 当日已实现盈亏
+func (m *Manager) GetDailyPnl() float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.rollDay(m.clock())
+	return m.dailyPnl
+}
+
+// IsKilled 是否已触发当日亏损限制
+func (m *Manager) IsKilled() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.rollDay(m.clock())
+	return m.killed
+}
+
+// 检查下单请求，通过后记录下单时间
+func (m *Manager) checkOrder(req *orderRequest) (err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.clock()
+	m.rollDay(now)
+	if err = m.check(now, req); err != nil {
+		m.breach(err)
+		return
+	}
+	if m.config.MaxOrdersPerSecond > 0 {
+		m.orderTimes = append(m.orderTimes, now)
+	}
+	return
+}
+
+func (m *Manager) check(now time.Time, req *orderRequest) error {
+	if m.killed {
+		return ErrRiskDailyLossLimit
+	}
+	if limit := m.config.MaxOrdersPerSecond; limit > 0 {
+		// 只保留最近1秒的下单时间
+		from := now.Add(-time.Second)
+		i := 0
+		for i < len(m.orderTimes) && !m.orderTimes[i].After(from) {
+			i++
+		}
+		m.orderTimes = m.orderTimes[i:]
+		if len(m.orderTimes) >= limit {
+			return ErrRiskOrderRateLimit
+		}
+	}
+	if m.config.MaxOrderNotional > 0 && req.notional > m.config.MaxOrderNotional {
+		return ErrRiskOrderNotionalLimit
+	}
+	if m.config.MaxOpenOrders > 0 && req.openOrders >= m.config.MaxOpenOrders {
+		return ErrRiskOpenOrdersLimit
+	}
+	if m.config.MaxPosition > 0 && !req.reduceOnly {
+		size := req.size
+		if req.direction == Sell {
+			size = -size
+		}
+		// 只限制增加持仓的委托
+		projected := math.Abs(req.position + size)
+		if projected > m.config.MaxPosition && projected > math.Abs(req.position) {
+			return ErrRiskPositionLimit
+		}
+	}
+	return nil
+}
+
+// 检查修改委托请求，notional 为 0 时不检查名义价值
+func (m *Manager) checkAmend(notional float64) (err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.rollDay(m.clock())
+	if m.killed {
+		err = ErrRiskDailyLossLimit
+	} else if m.config.MaxOrderNotional > 0 && notional > m.config.MaxOrderNotional {
+		err = ErrRiskOrderNotionalLimit
+	}
+	if err != nil {
+		m.breach(err)
+	}
+	return
+}
+
+// 触发风控
+func (m *Manager) breach(err error) {
+	log.Warnf("risk: %v", err)
+	if m.config.StopStrategy && m.strategy != nil {
+		m.strategy.StopNow()
+	}
+}
+
+// 跨日(UTC)后重置当日盈亏
+func (m *Manager) rollDay(now time.Time) {
+	day := now.UTC().Truncate(24 * time.Hour)
+	if day.Equal(m.day) {
+		return
+	}
+	m.day = day
+	m.dailyPnl = 0
+	m.killed = false
+}
+
+// 根据委托的成交变化更新持仓和已实现盈亏
+func (m *Manager) trackOrder(a *account, instrument *Instrument, order *Order) {
+	if order == nil || order.ID == "" {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	fill, ok := a.fills[order.ID]
+	if !ok {
+		fill = &orderFill{symbol: order.Symbol}
+		if fill.symbol == "" {
+			fill.symbol = instrument.Symbol
+		}
+		a.fills[order.ID] = fill
+	}
+	fill.open = order.IsOpen() || order.Status == OrderStatusUntriggered
+	delta := order.FilledAmount - fill.filled
+	if delta <= 0 {
+		return
+	}
+	// 本次成交均价
+	price := (order.AvgPrice*order.FilledAmount - fill.avgPrice*fill.filled) / delta
+	fill.filled = order.FilledAmount
+	fill.avgPrice = order.AvgPrice
+	if price <= 0 {
+		return
+	}
+	m.rollDay(m.clock())
+	m.onFill(a, instrument, order.Direction, price, delta)
+}
+
+// 返回需要继续跟踪成交的委托，key: order id, value: symbol
+func (m *Manager) openOrders(a *account) map[string]string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	result := map[string]string{}
+	for id, v := range a.fills {
+		if v.open {
+			result[id] = v.symbol
+		}
+	}
+	return result
+}
+
+// 账户交易过的标，key: symbol
+func (m *Manager) symbols(a *account) map[string]bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	result := map[string]bool{}
+	for _, v := range a.fills {
+		result[v.symbol] = true
+	}
+	for symbol := range a.positions {
+		result[symbol] = true
+	}
+	return result
+}
+
+func (m *Manager) onFill(a *account, instrument *Instrument, direction Direction, price float64, size float64) {
+	p, ok := a.positions[instrument.Symbol]
+	if !ok {
+		p = &position{}
+		a.positions[instrument.Symbol] = p
+	}
+	if direction == Sell {
+		size = -size
+	}
+
+	// 开仓或加仓
+	if p.size == 0 || (p.size > 0) == (size > 0) {
+		if a.spot && size < 0 {
+			// 卖出的是未跟踪的持仓
+			return
+		}
+		total := p.size + size
+		p.price = (p.price*math.Abs(p.size) + price*math.Abs(size)) / math.Abs(total)
+		p.size = total
+		return
+	}
+
+	// 平仓
+	closed := math.Min(math.Abs(size), math.Abs(p.size))
+	var pnl float64
+	if instrument.Inverse {
+		pnl = closed * instrument.ContractValue * (1/p.price - 1/price)
+	} else {
+		pnl = closed * instrument.ContractValue * (price - p.price)
+	}
+	if p.size < 0 {
+		pnl = -pnl
+		p.size += closed
+	} else {
+		p.size -= closed
+	}
+	if remain := math.Abs(size) - closed; remain > 0 && !a.spot {
+		// 反手开仓
+		p.size = math.Copysign(remain, size)
+		p.price = price
+	} else if p.size == 0 {
+		p.price = 0
+	}
+	m.addPnl(pnl)
+}
+
+func (m *Manager) addPnl(pnl float64) {
+	m.dailyPnl += pnl
+	if m.config.MaxDailyLoss > 0 && !m.killed && m.dailyPnl <= -m.config.MaxDailyLoss {
+		m.killed = true
+		m.breach(ErrRiskDailyLossLimit)
+	}
+}
+
+// NewManager 创建风控管理
+func NewManager(config Config) *Manager {
+	return &Manager{
+		config: config,
+		clock:  time.Now,
+	}
+}
+
+func newAccount(spot bool) *account {
+	return &account{
+		spot:      spot,
+		positions: map[string]*position{},
+		fills:     map[string]*orderFill{},
+	}
+}
@@ -0,0 +1,281 @@
+package risk
+
+import (
+	"fmt"
+	. "github.com/coinrust/crex"
+	"github.com/coinrust/crex/dataloader"
+	"github.com/coinrust/crex/exchanges/exsim"
+	"github.com/coinrust/crex/exchanges/spotsim"
+	"github.com/coinrust/crex/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testStrategy struct {
+	StrategyBase
+}
+
+func (s *testStrategy) OnInit() error { return nil }
+func (s *testStrategy) OnTick() error { return nil }
+func (s *testStrategy) Run() error    { return nil }
+func (s *testStrategy) OnExit() error { return nil }
+
+var testStart = testutil.Start
+
+// 每分钟一个订单薄，卖一价 = 买一价 + 1
+func testData(symbol string, bids ...float64) *dataloader.Data {
+	obs := testutil.OrderBooks(symbol, testStart, time.Minute, 100000, bids...)
+	return testutil.NewData(obs, testStart, testStart.Add(48*time.Hour))
+}
+
+func testRiskExchangeSim(config Config, bids ...float64) (*RiskExchangeSim, *dataloader.Data) {
+	data := testData("BTC-PERPETUAL", bids...)
+	// 反向合约，面值 10 USD
+	ex := NewRiskExchangeSim(exsim.NewExSim(data, 1, 0, 0, 10, false, false), config)
+	ex.SetBacktest(testutil.NewBacktest(data))
+	ex.SetExchangeLogger(&EmptyExchangeLogger{})
+	return ex, data
+}
+
+func TestRiskExchange_Limits(t *testing.T) {
+	symbol := "BTC-PERPETUAL"
+	ex, data := testRiskExchangeSim(Config{
+		MaxPosition:        100,
+		MaxOpenOrders:      2,
+		MaxOrderNotional:   500,
+		MaxOrdersPerSecond: 3,
+	}, 10000, 10000, 10000)
+
+	_, err := ex.PlaceOrder(symbol, Buy, OrderTypeMarket, 0, 60)
+	assert.Equal(t, ErrRiskOrderNotionalLimit, err)
+	_, err = ex.OpenLong(symbol, OrderTypeMarket, 0, 50)
+	assert.Nil(t, err)
+	_, err = ex.OpenLong(symbol, OrderTypeMarket, 0, 50)
+	assert.Nil(t, err)
+	_, err = ex.OpenLong(symbol, OrderTypeLimit, 9000, 10)
+	assert.Equal(t, ErrRiskPositionLimit, err)
+	// 平仓不受持仓限制
+	_, err = ex.CloseLong(symbol, OrderTypeLimit, 11000, 10)
+	assert.Nil(t, err)
+	_, err = ex.CloseLong(symbol, OrderTypeLimit, 11000, 10)
+	assert.Equal(t, ErrRiskOrderRateLimit, err)
+
+	data.Next()
+	ex.RunEventLoopOnce()
+	_, err = ex.CloseLong(symbol, OrderTypeLimit, 11000, 10)
+	assert.Nil(t, err)
+	_, err = ex.CloseLong(symbol, OrderTypeLimit, 11000, 10)
+	assert.Equal(t, ErrRiskOpenOrdersLimit, err)
+}
+
+func TestRiskExchange_DailyLoss(t *testing.T) {
+	symbol := "BTC-PERPETUAL"
+	ex, data := testRiskExchangeSim(Config{
+		MaxDailyLoss: 0.01,
+		StopStrategy: true,
+	}, 10000, 9000)
+	strategy := &testStrategy{}
+	ex.SetStrategy(strategy)
+
+	_, err := ex.OpenLong(symbol, OrderTypeMarket, 0, 100)
+	assert.Nil(t, err)
+	// 下跌后挂单平仓，撮合后计入亏损
+	data.Next()
+	_, err = ex.CloseLong(symbol, OrderTypeLimit, 9000, 100)
+	assert.Nil(t, err)
+	ex.RunEventLoopOnce()
+
+	// 100 * 10 * (1/10001 - 1/9000)
+	assert.InDelta(t, -0.01112, ex.GetDailyPnl(), 1e-5)
+	assert.True(t, ex.IsKilled())
+	assert.True(t, strategy.IsStopped())
+	_, err = ex.OpenLong(symbol, OrderTypeMarket, 0, 10)
+	assert.Equal(t, ErrRiskDailyLossLimit, err)
+
+	// 次日恢复
+	ex.SetClock(func() time.Time {
+		return testStart.Add(24 * time.Hour)
+	})
+	assert.False(t, ex.IsKilled())
+	assert.Equal(t, 0.0, ex.GetDailyPnl())
+}
+
+func TestRiskExchange_SharedManager(t *testing.T) {
+	symbol := "BTC-PERPETUAL"
+	m := NewManager(Config{MaxDailyLoss: 0.02})
+	var exs []*RiskExchangeSim
+	var datas []*dataloader.Data
+	for i := 0; i < 2; i++ {
+		data := testData(symbol, 10000, 9000)
+		ex := NewRiskExchangeSimWithManager(exsim.NewExSim(data, 1, 0, 0, 10, false, false), m)
+		ex.SetBacktest(testutil.NewBacktest(data))
+		ex.SetExchangeLogger(&EmptyExchangeLogger{})
+		exs = append(exs, ex)
+		datas = append(datas, data)
+	}
+
+	// 每个交易所亏损约 0.0111，单独计算均未超过限制，合计超过
+	for i, ex := range exs {
+		_, err := ex.OpenLong(symbol, OrderTypeMarket, 0, 100)
+		assert.Nil(t, err)
+		datas[i].Next()
+		_, err = ex.CloseLong(symbol, OrderTypeMarket, 0, 100)
+		assert.Nil(t, err)
+		ex.RunEventLoopOnce()
+		if i == 0 {
+			assert.False(t, m.IsKilled())
+		}
+	}
+	assert.InDelta(t, -0.02224, m.GetDailyPnl(), 1e-5)
+	assert.True(t, m.IsKilled())
+	for _, ex := range exs {
+		_, err := ex.OpenLong(symbol, OrderTypeMarket, 0, 10)
+		assert.Equal(t, ErrRiskDailyLossLimit, err)
+	}
+}
+
+func TestRiskExchangeSim_Forward(t *testing.T) {
+	data := testData("BTC-PERPETUAL", 10000)
+	ex := NewRiskExchangeSim(exsim.NewExSim(data, 1, -0.00025, 0.00075, 10, false, false), Config{})
+	ex.SetBacktest(testutil.NewBacktest(data))
+	ex.SetExchangeLogger(&EmptyExchangeLogger{})
+
+	var sim ExchangeSim = ex
+	_, ok := sim.(IdGenerateSetter)
+	assert.True(t, ok)
+	makerFeeRate, takerFeeRate := sim.(FeeExchangeSim).GetFeeRates()
+	assert.Equal(t, -0.00025, makerFeeRate)
+	assert.Equal(t, 0.00075, takerFeeRate)
+
+	instrument := &Instrument{Symbol: "BTC-200925", ContractValue: 100, Inverse: true}
+	if !assert.Nil(t, ex.AddSymbol(instrument, testData("BTC-200925", 10000))) {
+		return
+	}
+	assert.Equal(t, instrument, ex.getInstrument("BTC-200925"))
+	result, err := ex.GetInstrument("BTC-200925")
+	assert.Nil(t, err)
+	assert.Equal(t, instrument, result)
+}
+
+func TestSpotRiskExchange(t *testing.T) {
+	symbol := "BTC-USDT"
+	data := testData(symbol, 10000, 9000)
+	sim := spotsim.New("binance", data, SpotBalance{
+		Base:  SpotAsset{Name: "BTC"},
+		Quote: SpotAsset{Name: "USDT", Available: 100000},
+	}, 0, 0)
+	ex := NewSpotRiskExchangeSim(sim, Config{
+		MaxPosition:  1,
+		MaxDailyLoss: 500,
+	})
+	ex.SetBacktest(testutil.NewBacktest(data))
+	ex.SetExchangeLogger(&EmptyExchangeLogger{})
+
+	_, err := ex.Buy(symbol, OrderTypeMarket, 0, 1)
+	assert.Nil(t, err)
+	_, err = ex.Buy(symbol, OrderTypeMarket, 0, 0.5)
+	assert.Equal(t, ErrRiskPositionLimit, err)
+
+	data.Next()
+	ex.RunEventLoopOnce()
+	_, err = ex.Sell(symbol, OrderTypeMarket, 0, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, -1001.0, ex.GetDailyPnl())
+	_, err = ex.Buy(symbol, OrderTypeMarket, 0, 0.1)
+	assert.Equal(t, ErrRiskDailyLossLimit, err)
+}
+
+// testLiveExchange 实盘交易所，委托由交易所异步成交
+type testLiveExchange struct {
+	Exchange
+
+	mu     sync.Mutex
+	orders map[string]*Order
+}
+
+func (e *testLiveExchange) GetInstrument(symbol string) (*Instrument, error) {
+	return &Instrument{Symbol: symbol, ContractValue: 1}, nil
+}
+
+func (e *testLiveExchange) PlaceOrder(symbol string, direction Direction, orderType OrderType, price float64, size float64,
+	opts ...PlaceOrderOption) (*Order, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	order := &Order{
+		ID:        fmt.Sprint(len(e.orders) + 1),
+		Symbol:    symbol,
+		Type:      orderType,
+		Direction: direction,
+		Price:     price,
+		Amount:    size,
+		Status:    OrderStatusNew,
+	}
+	e.orders[order.ID] = order
+	result := *order
+	return &result, nil
+}
+
+func (e *testLiveExchange) GetOpenOrders(symbol string, opts ...OrderOption) (result []*Order, err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, v := range e.orders {
+		if v.Symbol == symbol && v.IsOpen() {
+			order := *v
+			result = append(result, &order)
+		}
+	}
+	return
+}
+
+func (e *testLiveExchange) GetOrder(symbol string, id string, opts ...OrderOption) (*Order, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	order, ok := e.orders[id]
+	if !ok {
+		return nil, ErrNotImplemented
+	}
+	result := *order
+	return &result, nil
+}
+
+// 交易所成交委托，或发起新委托(如强平)
+func (e *testLiveExchange) fill(order *Order, filled float64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if order.ID == "" {
+		order.ID = fmt.Sprint(len(e.orders) + 1)
+		e.orders[order.ID] = order
+	}
+	order = e.orders[order.ID]
+	order.FilledAmount = filled
+	order.AvgPrice = order.Price
+	order.Status = OrderStatusPartiallyFilled
+	if filled >= order.Amount {
+		order.Status = OrderStatusFilled
+	}
+}
+
+// 策略不查询委托，定时查询统计成交并触发当日亏损限制
+func TestRiskExchange_Refresh(t *testing.T) {
+	symbol := "BTC-PERPETUAL"
+	live := &testLiveExchange{orders: map[string]*Order{}}
+	ex := NewRiskExchange(live, Config{MaxDailyLoss: 50})
+	ex.SetRefreshInterval(10 * time.Millisecond)
+	ex.Start()
+	defer ex.Stop()
+
+	order, err := ex.PlaceOrder(symbol, Buy, OrderTypeLimit, 100, 10)
+	if !assert.Nil(t, err) {
+		return
+	}
+	live.fill(order, 10)
+	// 交易所发起的平仓委托，部分成交，亏损 10 * (90 - 100)
+	live.fill(&Order{Symbol: symbol, Type: OrderTypeLimit, Direction: Sell, Price: 90, Amount: 20}, 10)
+
+	assert.Eventually(t, ex.IsKilled, time.Second, 10*time.Millisecond)
+	assert.Equal(t, -100.0, ex.GetDailyPnl())
+	_, err = ex.PlaceOrder(symbol, Buy, OrderTypeLimit, 100, 10)
+	assert.Equal(t, ErrRiskDailyLossLimit, err)
+}
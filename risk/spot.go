@@ -0,0 +1,230 @@
+package risk
+
+import (
+	. "github.com/coinrust/crex"
+	"github.com/coinrust/crex/utils"
+)
+
+// SpotRiskExchange 现货交易所风控层，持仓为基础货币余额
+type SpotRiskExchange struct {
+	SpotExchange
+	*Manager
+	refresher
+
+	account *account
+}
+
+// Start 定时查询委托成交，见 refresher
+func (r *SpotRiskExchange) Start() {
+	r.start(r.refreshOrders)
+}
+
+// 现货按正向、面值 1 计算名义价值和盈亏
+func spotInstrument(symbol string) *Instrument {
+	return &Instrument{Symbol: symbol, ContractValue: 1}
+}
+
+func (r *SpotRiskExchange) placeOrder(symbol string, direction Direction, orderType OrderType, price float64, size float64,
+	place func() (*Order, error)) (result *Order, err error) {
+	instrument := spotInstrument(symbol)
+	config := r.GetConfig()
+	req := &orderRequest{
+		direction: direction,
+		size:      size,
+	}
+	if config.MaxOrderNotional > 0 {
+		if price <= 0 || orderType == OrderTypeMarket || orderType == OrderTypeStopMarket {
+			var ob *OrderBook
+			ob, err = r.SpotExchange.GetOrderBook(symbol, 1)
+			if err != nil {
+				return
+			}
+			if ob != nil && direction == Buy {
+				price = ob.AskPrice()
+			} else if ob != nil {
+				price = ob.BidPrice()
+			}
+		}
+		req.notional = instrument.Notional(size, price)
+	}
+	if config.MaxOpenOrders > 0 {
+		var orders []*Order
+		if orders, err = r.SpotExchange.GetOpenOrders(symbol); err != nil {
+			return
+		}
+		req.openOrders = len(orders)
+	}
+	if config.MaxPosition > 0 {
+		var balance *SpotBalance
+		if balance, err = r.SpotExchange.GetBalance(symbol); err != nil {
+			return
+		}
+		req.position = balance.Base.Available + balance.Base.Frozen - balance.Base.Borrow
+	}
+	if err = r.checkOrder(req); err != nil {
+		return
+	}
+
+	result, err = place()
+	if err != nil {
+		return
+	}
+	r.trackOrder(r.account, instrument, result)
+	return
+}
+
+func (r *SpotRiskExchange) track(symbol string, orders ...*Order) {
+	for _, v := range orders {
+		if v == nil {
+			continue
+		}
+		if v.Symbol != "" {
+			symbol = v.Symbol
+		}
+		r.trackOrder(r.account, spotInstrument(symbol), v)
+	}
+}
+
+// 查询交易过的标的活跃委托和仍在跟踪的委托，更新成交
+func (r *SpotRiskExchange) refreshOrders() {
+	opened := map[string]bool{}
+	for symbol := range r.symbols(r.account) {
+		orders, err := r.SpotExchange.GetOpenOrders(symbol)
+		if err != nil {
+			continue
+		}
+		for _, v := range orders {
+			opened[v.ID] = true
+		}
+		r.track(symbol, orders...)
+	}
+	for id, symbol := range r.openOrders(r.account) {
+		if opened[id] {
+			continue
+		}
+		order, err := r.SpotExchange.GetOrder(symbol, id)
+		if err != nil {
+			continue
+		}
+		r.track(symbol, order)
+	}
+}
+
+func (r *SpotRiskExchange) Buy(symbol string, orderType OrderType, price float64, size float64) (result *Order, err error) {
+	return r.placeOrder(symbol, Buy, orderType, price, size, func() (*Order, error) {
+		return r.SpotExchange.Buy(symbol, orderType, price, size)
+	})
+}
+
+func (r *SpotRiskExchange) Sell(symbol string, orderType OrderType, price float64, size float64) (result *Order, err error) {
+	return r.placeOrder(symbol, Sell, orderType, price, size, func() (*Order, error) {
+		return r.SpotExchange.Sell(symbol, orderType, price, size)
+	})
+}
+
+func (r *SpotRiskExchange) PlaceOrder(symbol string, direction Direction, orderType OrderType, price float64, size float64,
+	opts ...PlaceOrderOption) (result *Order, err error) {
+	return r.placeOrder(symbol, direction, orderType, price, size, func() (*Order, error) {
+		return r.SpotExchange.PlaceOrder(symbol, direction, orderType, price, size, opts...)
+	})
+}
+
+func (r *SpotRiskExchange) GetOpenOrders(symbol string, opts ...OrderOption) (result []*Order, err error) {
+	result, err = r.SpotExchange.GetOpenOrders(symbol, opts...)
+	if err != nil {
+		return
+	}
+	r.track(symbol, result...)
+	return
+}
+
+func (r *SpotRiskExchange) GetHistoryOrders(symbol string, opts ...OrderOption) (result []*Order, err error) {
+	result, err = r.SpotExchange.GetHistoryOrders(symbol, opts...)
+	if err != nil {
+		return
+	}
+	r.track(symbol, result...)
+	return
+}
+
+func (r *SpotRiskExchange) GetOrder(symbol string, id string, opts ...OrderOption) (result *Order, err error) {
+	result, err = r.SpotExchange.GetOrder(symbol, id, opts...)
+	if err != nil {
+		return
+	}
+	r.track(symbol, result)
+	return
+}
+
+func (r *SpotRiskExchange) CancelOrder(symbol string, id string, opts ...OrderOption) (result *Order, err error) {
+	result, err = r.SpotExchange.CancelOrder(symbol, id, opts...)
+	if err != nil {
+		return
+	}
+	r.track(symbol, result)
+	return
+}
+
+// SpotRiskExchangeSim 现货模拟交易所风控层
+type SpotRiskExchangeSim struct {
+	*SpotRiskExchange
+	sim ExchangeSim
+}
+
+func (r *SpotRiskExchangeSim) SetBacktest(backtest IBacktest) {
+	r.sim.SetBacktest(backtest)
+	r.SetClock(backtest.GetTime)
+}
+
+func (r *SpotRiskExchangeSim) SetExchangeLogger(l ExchangeLogger) {
+	r.sim.SetExchangeLogger(l)
+}
+
+func (r *SpotRiskExchangeSim) RunEventLoopOnce() (err error) {
+	err = r.sim.RunEventLoopOnce()
+	r.refreshOrders()
+	return
+}
+
+// GetFeeRates Maker/Taker 费率，模拟交易所不支持时返回 0
+func (r *SpotRiskExchangeSim) GetFeeRates() (makerFeeRate float64, takerFeeRate float64) {
+	if ex, ok := r.sim.(FeeExchangeSim); ok {
+		return ex.GetFeeRates()
+	}
+	return
+}
+
+// SetIdGenerate 设置模拟交易所的订单ID生成器，模拟交易所不支持时忽略
+func (r *SpotRiskExchangeSim) SetIdGenerate(g *utils.IdGenerate) {
+	if ex, ok := r.sim.(IdGenerateSetter); ok {
+		ex.SetIdGenerate(g)
+	}
+}
+
+// NewSpotRiskExchange 创建现货交易所风控层
+func NewSpotRiskExchange(exchange SpotExchange, config Config) *SpotRiskExchange {
+	return NewSpotRiskExchangeWithManager(exchange, NewManager(config))
+}
+
+// NewSpotRiskExchangeWithManager 使用已有的风控管理创建现货交易所风控层，可与期货风控层共用
+func NewSpotRiskExchangeWithManager(exchange SpotExchange, m *Manager) *SpotRiskExchange {
+	return &SpotRiskExchange{
+		SpotExchange: exchange,
+		Manager:      m,
+		refresher:    newRefresher(),
+		account:      newAccount(true),
+	}
+}
+
+// NewSpotRiskExchangeSim 创建现货模拟交易所风控层，sim 需同时实现 SpotExchange
+func NewSpotRiskExchangeSim(sim ExchangeSim, config Config) *SpotRiskExchangeSim {
+	return NewSpotRiskExchangeSimWithManager(sim, NewManager(config))
+}
+
+// NewSpotRiskExchangeSimWithManager 使用已有的风控管理创建现货模拟交易所风控层
+func NewSpotRiskExchangeSimWithManager(sim ExchangeSim, m *Manager) *SpotRiskExchangeSim {
+	return &SpotRiskExchangeSim{
+		SpotRiskExchange: NewSpotRiskExchangeWithManager(sim.(SpotExchange), m),
+		sim:              sim,
+	}
+}
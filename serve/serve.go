@@ -7,6 +7,7 @@ import (
 	. "github.com/coinrust/crex"
 	"github.com/coinrust/crex/exchanges"
 	"github.com/coinrust/crex/log"
+	"github.com/coinrust/crex/risk"
 )
 
 var (
@@ -17,6 +18,7 @@ type SConfig struct {
 	Log       SLog                   `toml:"log"`
 	Exchanges []SExchange            `toml:"exchange"`
 	Options   map[string]interface{} `toml:"option"`
+	Risk      risk.Config            `toml:"risk"`
 }
 
 type SLog struct {
@@ -75,6 +77,9 @@ func SetupStrategyFromConfig(strategy Strategy) (err error) {
 		return
 	}
 	var exs []interface{}
+	// 所有交易所共用风控管理，当日亏损按账户合计
+	riskManager := risk.NewManager(c.Risk)
+	riskManager.SetStrategy(strategy)
 	for _, ex := range c.Exchanges {
 		var opts = []ApiOption{
 			ApiDebugModeOption(ex.DebugMode),
@@ -88,6 +93,12 @@ func SetupStrategyFromConfig(strategy Strategy) (err error) {
 		}
 		exchange := exchanges.NewExchange(ex.Name,
 			opts...)
+		if c.Risk.Enabled() {
+			// 定时查询委托成交，策略不查询委托时也能触发当日亏损限制
+			riskExchange := risk.NewRiskExchangeWithManager(exchange, riskManager)
+			riskExchange.Start()
+			exchange = riskExchange
+		}
 		exs = append(exs, exchange)
 	}
 	if err = strategy.Setup(TradeModeLiveTrading, exs...); err != nil {
@@ -1,7 +1,9 @@
 package crex
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
 	"time"
 )
 
@@ -21,6 +23,54 @@ type Stats struct {
 	EquityReturnPnt float64       `json:"equity_return_pnt"`
 	AnnReturn       float64       `json:"ann_return"`    // 年化收益率
 	MaxDrawDown     float64       `json:"max_draw_down"` // 最大回撤
+	FundingPnl      float64       `json:"funding_pnl"`   // 资金费用盈亏
+
+	Volatility          float64       `json:"volatility"`             // 年化波动率
+	SharpeRatio         float64       `json:"sharpe_ratio"`           // 年化夏普比率(无风险利率为 0)
+	SortinoRatio        float64       `json:"sortino_ratio"`          // 年化索提诺比率
+	CalmarRatio         float64       `json:"calmar_ratio"`           // 卡玛比率: 年化收益率/最大回撤
+	MaxDrawDownDuration time.Duration `json:"max_draw_down_duration"` // 最长回撤持续时间
+	NumTrades           int           `json:"num_trades"`             // 已平仓的交易次数
+	WinRate             float64       `json:"win_rate"`               // 胜率(按净盈亏)
+	AvgWin              float64       `json:"avg_win"`                // 平均盈利
+	AvgLoss             float64       `json:"avg_loss"`               // 平均亏损(负数)
+	ProfitFactor        float64       `json:"profit_factor"`          // 盈利因子: 总盈利/总亏损，有盈利无亏损时为 +Inf(JSON 中为 "inf")
+	Commission          float64       `json:"commission"`             // 总手续费
+	Turnover            float64       `json:"turnover"`               // 总成交额(计价货币，反向合约为合约面值)
+	TimeInMarket        float64       `json:"time_in_market"`         // 持仓时间占比
+}
+
+// JSON 中的盈利因子，+Inf 为 "inf"
+type statsJSON struct {
+	*statsAlias
+	ProfitFactor interface{} `json:"profit_factor"`
+}
+
+type statsAlias Stats
+
+func (s Stats) MarshalJSON() ([]byte, error) {
+	v := statsJSON{statsAlias: (*statsAlias)(&s), ProfitFactor: s.ProfitFactor}
+	if math.IsInf(s.ProfitFactor, 1) {
+		v.ProfitFactor = "inf"
+	}
+	return json.Marshal(v)
+}
+
+func (s *Stats) UnmarshalJSON(data []byte) error {
+	v := statsJSON{statsAlias: (*statsAlias)(s)}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch x := v.ProfitFactor.(type) {
+	case float64:
+		s.ProfitFactor = x
+	case string:
+		if x != "inf" {
+			return fmt.Errorf("invalid profit_factor: %v", x)
+		}
+		s.ProfitFactor = math.Inf(1)
+	}
+	return nil
 }
 
 func (s *Stats) PrintResult() {
@@ -39,4 +89,18 @@ func (s *Stats) PrintResult() {
 	fmt.Printf("Buy & Hold Return [%%]: \t%.4f%%\n", s.BaHReturnPnt*100)
 	fmt.Printf("Ann Return [%%]: \t\t%.4f%%\n", s.AnnReturn*100)
 	fmt.Printf("Max Drawdown [%%]: \t\t%.4f%%\n", s.MaxDrawDown*100)
+	fmt.Printf("Funding PnL: \t\t%.8f\n", s.FundingPnl)
+	fmt.Printf("Volatility (Ann.) [%%]: \t%.4f%%\n", s.Volatility*100)
+	fmt.Printf("Sharpe Ratio: \t\t%.4f\n", s.SharpeRatio)
+	fmt.Printf("Sortino Ratio: \t\t%.4f\n", s.SortinoRatio)
+	fmt.Printf("Calmar Ratio: \t\t%.4f\n", s.CalmarRatio)
+	fmt.Printf("Max Drawdown Duration: \t%v\n", s.MaxDrawDownDuration.String())
+	fmt.Printf("Trades: \t\t\t%v\n", s.NumTrades)
+	fmt.Printf("Win Rate [%%]: \t\t%.4f%%\n", s.WinRate*100)
+	fmt.Printf("Avg Win: \t\t\t%.8f\n", s.AvgWin)
+	fmt.Printf("Avg Loss: \t\t\t%.8f\n", s.AvgLoss)
+	fmt.Printf("Profit Factor: \t\t%.4f\n", s.ProfitFactor)
+	fmt.Printf("Commission: \t\t%.8f\n", s.Commission)
+	fmt.Printf("Turnover: \t\t\t%v\n", s.Turnover)
+	fmt.Printf("Time in Market [%%]: \t%.4f%%\n", s.TimeInMarket*100)
 }
@@ -2,6 +2,7 @@ package crex
 
 import (
 	"fmt"
+	"github.com/coinrust/crex/log"
 	"github.com/spf13/cast"
 	"reflect"
 	"strings"
@@ -46,6 +47,7 @@ type StrategyBase struct {
 	Exchanges []Exchange
 	Exchange  Exchange
 	stopped   bool
+	logger    log.Logger
 }
 
 // SetSelf 设置 self 对象
@@ -102,6 +104,19 @@ func (s *StrategyBase) Name() string {
 	return s.name
 }
 
+// SetLogger 设置策略日志，回测中每个策略使用独立的日志
+func (s *StrategyBase) SetLogger(logger log.Logger) {
+	s.logger = logger
+}
+
+// Logger 策略日志，未设置时使用全局日志
+func (s *StrategyBase) Logger() log.Logger {
+	if s.logger == nil {
+		return log.Std
+	}
+	return s.logger
+}
+
 // SpotStrategyBase Strategy base class
 type SpotStrategyBase struct {
 	self      interface{}
@@ -109,6 +124,7 @@ type SpotStrategyBase struct {
 	tradeMode TradeMode
 	Exchanges []SpotExchange
 	Exchange  SpotExchange
+	logger    log.Logger
 }
 
 // SetSelf 设置 self 对象
@@ -156,6 +172,19 @@ func (s *SpotStrategyBase) Name() string {
 	return s.name
 }
 
+// SetLogger 设置策略日志，回测中每个策略使用独立的日志
+func (s *SpotStrategyBase) SetLogger(logger log.Logger) {
+	s.logger = logger
+}
+
+// Logger 策略日志，未设置时使用全局日志
+func (s *SpotStrategyBase) Logger() log.Logger {
+	if s.logger == nil {
+		return log.Std
+	}
+	return s.logger
+}
+
 // 组合策略，期现等
 // CStrategyBase Strategy base class
 type CStrategyBase struct {
@@ -165,6 +194,7 @@ type CStrategyBase struct {
 	Exchanges     []Exchange
 	SpotExchanges []SpotExchange
 	stopped       bool
+	logger        log.Logger
 }
 
 // SetSelf 设置 self 对象
@@ -223,6 +253,19 @@ func (s *CStrategyBase) Name() string {
 	return s.name
 }
 
+// SetLogger 设置策略日志，回测中每个策略使用独立的日志
+func (s *CStrategyBase) SetLogger(logger log.Logger) {
+	s.logger = logger
+}
+
+// Logger 策略日志，未设置时使用全局日志
+func (s *CStrategyBase) Logger() log.Logger {
+	if s.logger == nil {
+		return log.Std
+	}
+	return s.logger
+}
+
 // SetOptions Sets the options for the strategy
 func setOptions(s interface{}, options map[string]interface{}) error {
 	if len(options) == 0 {
@@ -250,7 +293,7 @@ func setOptions(s interface{}, options map[string]interface{}) error {
 	for name, value := range options {
 		var fieldName string
 
-		key := strings.ReplaceAll(name, "_", "")
+		key := strings.ReplaceAll(strings.ToLower(name), "_", "")
 		if ipi, ok := rawOptions[key]; !ok {
 			continue
 		} else {
@@ -303,6 +346,11 @@ func setOptions(s interface{}, options map[string]interface{}) error {
 	return nil
 }
 
+// GetStrategyOptions 读取策略参数，不修改策略，未调用 SetSelf 时也可使用
+func GetStrategyOptions(s Strategy) (optionMap map[string]*StrategyOption) {
+	return getOptions(s)
+}
+
 func getOptions(s interface{}) (optionMap map[string]*StrategyOption) {
 	//log.Info("GetOptions")
 	optionMap = map[string]*StrategyOption{}